@@ -2,10 +2,17 @@ package serverconfig
 
 type DatasetConfig struct {
 	DataplatformProvider string `json:"dataplatformProvider"`
+	// ColumnMaskSecret keys the hashes of masked column values, hash masks hide the values entirely without it
+	ColumnMaskSecret string `json:"-"`
+	// FxRatesTable is the warehouse table or view mirroring app.fx_rates, amounts without a precomputed fx column are
+	// converted with the rates joined from it
+	FxRatesTable string `json:"fxRatesTable"`
 }
 
 func getDatasetConfig(configVariables *ConfigVariables) DatasetConfig {
 	return DatasetConfig{
 		DataplatformProvider: configVariables.DataplatformProvider,
+		ColumnMaskSecret:     configVariables.DatasetColumnMaskSecret,
+		FxRatesTable:         configVariables.DatasetFxRatesTable,
 	}
 }
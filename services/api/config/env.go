@@ -46,6 +46,10 @@ type ConfigVariables struct {
 	AWSDefaultBucketName string
 	// Dataplatform provider
 	DataplatformProvider string
+	// Key of the hashes masking the values of dataset columns
+	DatasetColumnMaskSecret string
+	// Warehouse table or view of the fx rates of the organizations
+	DatasetFxRatesTable string
 	// Sparkpost API Key
 	SparkpostAPIKey string
 	// Sparkpost API URL
@@ -180,6 +184,8 @@ func getServerConfigVariables() (*ConfigVariables, error) {
 		AWSSecretAccessKey:       getEnvVariableWithDefault("AWS_SECRET_ACCESS_KEY", ""),
 		AWSDefaultBucketName:     getEnvVariableWithDefault("AWS_DEFAULT_BUCKET_NAME", ""),
 		DataplatformProvider:     getEnvVariableWithDefault("DATAPLATFORM_PROVIDER", "databricks"),
+		DatasetColumnMaskSecret:  getEnvVariableWithDefault("DATASET_COLUMN_MASK_SECRET", ""),
+		DatasetFxRatesTable:      getEnvVariableWithDefault("DATASET_FX_RATES_TABLE", ""),
 		SparkpostAPIKey:          getEnvVariableWithDefault("SPARKPOST_API_KEY", ""),
 		SparkpostAPIURL:          getEnvVariableWithDefault("SPARKPOST_API_URL", ""),
 		ZampEmailUpdatesFrom:     getEnvVariableWithDefault("ZAMP_EMAIL_UPDATES_FROM", "noreply@zamp.ai"),
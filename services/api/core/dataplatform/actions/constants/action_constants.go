@@ -78,4 +78,6 @@ type UpsertRuleOperation string
 const (
 	UpsertRuleOperationCreate  UpsertRuleOperation = "create"
 	UpsertRuleOperationReorder UpsertRuleOperation = "reorder"
+	UpsertRuleOperationUpdate  UpsertRuleOperation = "update"
+	UpsertRuleOperationDelete  UpsertRuleOperation = "delete"
 )
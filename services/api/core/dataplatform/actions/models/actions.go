@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/constants"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
 )
 
 type CreateActionPayload struct {
@@ -27,10 +28,14 @@ type CreateMVActionPayload struct {
 	OrderByColumn    string            `json:"orderByColumn"`
 }
 
+// UpdateDatasetDataActionPayload is sent to the update job as is, ReferenceBanks are the banks the organization added
+// to the bank directory and are only used to validate bank columns before submitting it
 type UpdateDatasetDataActionPayload struct {
-	DatasetId    string         `json:"dataset_id"`
-	SqlCondition string         `json:"sql_condition"`
-	UpdateValues map[string]any `json:"update_values"`
+	DatasetId      string               `json:"dataset_id"`
+	SqlCondition   string               `json:"sql_condition"`
+	UpdateValues   map[string]any       `json:"update_values"`
+	ReferenceBanks []referencedata.Bank `json:"-"`
+	TagTaxonomy    []string             `json:"-"`
 }
 
 type Action struct {
@@ -49,8 +54,12 @@ type SubmitActionResponse struct {
 	RunId int64 `json:"runId"`
 }
 
+// SourceColumnUpdateValue is what the source column of a column holds about its last write. Values written by a rule
+// also record the kind of the assignment and the column copied or the dataset looked up.
 type SourceColumnUpdateValue struct {
 	SourceType      string `json:"source_type"`
 	SourceId        string `json:"source_id"`
 	SourceUpdatedAt string `json:"source_updated_at"`
+	ValueType       string `json:"value_type,omitempty"`
+	ValueSource     string `json:"value_source,omitempty"`
 }
@@ -22,6 +22,7 @@ import (
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	dataplatformconstants "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/constants"
 	"github.com/Zampfi/application-platform/services/api/pkg/dataplatform/providers/databricks"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"go.uber.org/zap"
 )
@@ -422,7 +423,7 @@ func verifyCountryColumn(ctx context.Context, updatedValues interface{}) error {
 		return errors.ErrInvalidCountryValue
 	}
 
-	if !slices.Contains(serviceconstants.Countries, valueString) {
+	if _, ok := referencedata.CountryByAlpha3(valueString); !ok {
 		logger.Error(errors.InvalidCountryValueErrMessage, zap.Error(errors.ErrInvalidCountryValue))
 		return errors.ErrInvalidCountryValue
 	}
@@ -431,7 +432,8 @@ func verifyCountryColumn(ctx context.Context, updatedValues interface{}) error {
 
 }
 
-func verifyBankColumn(ctx context.Context, updatedValues interface{}) error {
+// verifyBankColumn checks the value against the bank directory of the organization, the shared banks when it has none
+func verifyBankColumn(ctx context.Context, updatedValues interface{}, organizationBanks []referencedata.Bank) error {
 	logger := apicontext.GetLoggerFromCtx(ctx)
 
 	valueString, ok := updatedValues.(string)
@@ -440,7 +442,7 @@ func verifyBankColumn(ctx context.Context, updatedValues interface{}) error {
 		return errors.ErrInvalidBankValue
 	}
 
-	if !slices.Contains(serviceconstants.Banks, valueString) {
+	if _, ok := referencedata.NewDirectory(organizationBanks).Bank(valueString); !ok {
 		logger.Error(errors.InvalidBankValueErrMessage, zap.Error(errors.ErrInvalidBankValue))
 		return errors.ErrInvalidBankValue
 	}
@@ -457,7 +459,7 @@ func verifyCurrencyColumn(ctx context.Context, updatedValues interface{}) error
 		return errors.ErrInvalidCurrencyValue
 	}
 
-	if !slices.Contains(serviceconstants.Currencies, valueString) {
+	if _, ok := referencedata.CurrencyByCode(valueString); !ok {
 		logger.Error(errors.InvalidCurrencyValueErrMessage, zap.Error(errors.ErrInvalidCurrencyValue))
 		return errors.ErrInvalidCurrencyValue
 	}
@@ -465,15 +467,22 @@ func verifyCurrencyColumn(ctx context.Context, updatedValues interface{}) error
 	return nil
 }
 
-func verifyTagsColumn(ctx context.Context, updatedValues interface{}) error {
+// verifyTagsColumn checks the value is one of the paths of the tag taxonomy of the organization, any string is accepted
+// when it has no tags
+func verifyTagsColumn(ctx context.Context, updatedValues interface{}, tagTaxonomy []string) error {
 	logger := apicontext.GetLoggerFromCtx(ctx)
 
-	_, ok := updatedValues.(string)
+	tag, ok := updatedValues.(string)
 	if !ok {
 		logger.Error(errors.InvalidTagsValueErrMessage, zap.Error(errors.ErrInvalidTagsValue))
 		return errors.ErrInvalidTagsValue
 	}
 
+	if len(tagTaxonomy) > 0 && !slices.Contains(tagTaxonomy, tag) {
+		logger.Error(errors.InvalidTagsValueErrMessage, zap.String("tag", tag), zap.Error(errors.ErrInvalidTagsValue))
+		return errors.ErrInvalidTagsValue
+	}
+
 	return nil
 }
 
@@ -558,9 +567,9 @@ func (s *actionService) handleValidationsAndSourceUpdates(ctx context.Context, p
 		case constants.DatabricksColumnCustomTypeAmount:
 			validationError = verifyAmountColumn(ctx, value)
 		case constants.DatabricksColumnCustomTypeTags:
-			validationError = verifyTagsColumn(ctx, value)
+			validationError = verifyTagsColumn(ctx, value, actionMetadataPayload.TagTaxonomy)
 		case constants.DatabricksColumnCustomTypeBank:
-			validationError = verifyBankColumn(ctx, value)
+			validationError = verifyBankColumn(ctx, value, actionMetadataPayload.ReferenceBanks)
 		}
 
 		if validationError != nil {
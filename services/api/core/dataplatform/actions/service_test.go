@@ -15,6 +15,7 @@ import (
 	mockdataservice "github.com/Zampfi/application-platform/services/api/mocks/core/dataplatform/data"
 	mockdatabricksservice "github.com/Zampfi/application-platform/services/api/mocks/pkg/dataplatform/providers/databricks"
 	dataplatformmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
 	"github.com/databricks/databricks-sdk-go/service/jobs"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -1288,11 +1289,12 @@ func (s *ActionServiceTestSuite) TestVerifyCurrencyColumn() {
 
 func (s *ActionServiceTestSuite) TestVerifyBankColumn() {
 	tests := []struct {
-		name        string
-		bankColumn  interface{}
-		expected    bool
-		expectError bool
-		mockError   error
+		name              string
+		bankColumn        interface{}
+		organizationBanks []referencedata.Bank
+		expected          bool
+		expectError       bool
+		mockError         error
 	}{
 		{
 			name:        "Valid bank column",
@@ -1314,12 +1316,19 @@ func (s *ActionServiceTestSuite) TestVerifyBankColumn() {
 			expectError: true,
 			mockError:   errors.ErrInvalidBankValue,
 		},
+		{
+			name:              "Bank added by the organization",
+			bankColumn:        "ACME",
+			organizationBanks: []referencedata.Bank{{Code: "ACME", Name: "Acme Bank"}},
+			expected:          true,
+			expectError:       false,
+		},
 	}
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			ctx := context.Background()
-			result := verifyBankColumn(ctx, tt.bankColumn)
+			result := verifyBankColumn(ctx, tt.bankColumn, tt.organizationBanks)
 			if tt.expectError {
 				s.Error(result)
 				s.Equal(tt.mockError, result)
@@ -1385,6 +1394,7 @@ func (s *ActionServiceTestSuite) TestVerifyTagsColumn() {
 	tests := []struct {
 		name        string
 		tagsColumn  interface{}
+		tagTaxonomy []string
 		expected    bool
 		expectError bool
 		mockError   error
@@ -1402,12 +1412,27 @@ func (s *ActionServiceTestSuite) TestVerifyTagsColumn() {
 			expectError: true,
 			mockError:   errors.ErrInvalidTagsValue,
 		},
+		{
+			name:        "Tag of the taxonomy",
+			tagsColumn:  "Expenses.Payroll",
+			tagTaxonomy: []string{"Expenses", "Expenses.Payroll"},
+			expected:    true,
+			expectError: false,
+		},
+		{
+			name:        "Tag missing from the taxonomy",
+			tagsColumn:  "Expenses.Rent",
+			tagTaxonomy: []string{"Expenses", "Expenses.Payroll"},
+			expected:    false,
+			expectError: true,
+			mockError:   errors.ErrInvalidTagsValue,
+		},
 	}
 
 	for _, tt := range tests {
 		s.Run(tt.name, func() {
 			ctx := context.Background()
-			result := verifyTagsColumn(ctx, tt.tagsColumn)
+			result := verifyTagsColumn(ctx, tt.tagsColumn, tt.tagTaxonomy)
 			if tt.expectError {
 				s.Error(result)
 				s.Equal(tt.mockError, result)
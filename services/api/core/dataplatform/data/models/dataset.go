@@ -41,14 +41,26 @@ type ComputedColumn struct {
 	ForceRecompute bool   `json:"force_recompute"`
 }
 
+// Rule writes ValueToApply to the rows matching SqlCondition. A rule copying the value of another column of the row
+// sets ValueColumn, a rule looking the value up sets Lookup and only writes the rows the lookup finds a row for.
 type Rule struct {
 	Id           string                 `json:"id"`
 	Priority     int                    `json:"priority"`
 	ValueToApply string                 `json:"value_to_apply"`
+	ValueColumn  string                 `json:"value_column,omitempty"`
+	Lookup       *RuleLookup            `json:"lookup,omitempty"`
 	SqlCondition string                 `json:"sql_condition"`
 	SqlArgs      map[string]interface{} `json:"sql_args"`
 }
 
+// RuleLookup joins the rows of the dataset on JoinColumn with the rows of the lookup dataset on LookupColumn
+type RuleLookup struct {
+	DatasetId    string `json:"dataset_id"`
+	JoinColumn   string `json:"join_column"`
+	LookupColumn string `json:"lookup_column"`
+	ValueColumn  string `json:"value_column"`
+}
+
 type DatasetConfig struct {
 	Columns            map[string]DatasetColumnConfig `json:"columns"`
 	CustomColumnGroups []CustomColumnGroup            `json:"custom_column_groups"`
@@ -7,6 +7,9 @@ type ActionType string
 const (
 	ActionTypeDatasetExport     ActionType = "DATASET_EXPORT"
 	ActionTypeDatasetFileImport ActionType = "DATASET_FILE_IMPORT"
+
+	ActionTypeReconciliationManualMatch   ActionType = "RECONCILIATION_MANUAL_MATCH"
+	ActionTypeReconciliationManualUnmatch ActionType = "RECONCILIATION_MANUAL_UNMATCH"
 )
 
 var ValidDatasetActionStatuses = []string{
@@ -22,4 +25,6 @@ var ValidDatasetActionTypes = []string{
 	string(dataplatfromactionconstants.ActionTypeUpsertTemplate),
 	string(dataplatfromactionconstants.ActionTypeUpdateDataset),
 	string(ActionTypeDatasetExport),
+	string(ActionTypeReconciliationManualMatch),
+	string(ActionTypeReconciliationManualUnmatch),
 }
@@ -23,6 +23,9 @@ const ZampFxColumnPrefix = "_zamp_fx_json_"
 const GetDistinctValuesQuery = "SELECT DISTINCT %s FROM {{.zamp_%s}} where %s = False LIMIT %d"
 const GetDistinctValuesQueryWithoutLimit = "SELECT DISTINCT %s FROM {{.zamp_%s}} where %s = False"
 const GetRowDetailsQuery = "SELECT * FROM {{.zamp_%s}} WHERE _zamp_id = '%s'"
+const GetDistinctValuesWithFilterQuery = "SELECT DISTINCT %s FROM {{.zamp_%s}} where %s = False AND (%s) LIMIT %d"
+const GetDistinctValuesWithFilterQueryWithoutLimit = "SELECT DISTINCT %s FROM {{.zamp_%s}} where %s = False AND (%s)"
+const GetRowDetailsWithFilterQuery = "SELECT * FROM {{.zamp_%s}} WHERE _zamp_id = '%s' AND (%s)"
 const GetRowCountQuery = "SELECT COUNT(*) FROM (%s)"
 
 const (
@@ -43,16 +46,43 @@ const (
 	MetadataConfigCustomType           = "custom_type"
 	MetadataConfigIsHidden             = "is_hidden"
 	MetadataConfigIsEditable           = "is_editable"
+	MetadataConfigIsMasked             = "is_masked"
+	MetadataConfigMaskType             = "mask_type"
+	MetadataConfigOptionLabels         = "option_labels"
+)
+
+// FileImportMaxValidationErrors caps the reference data issues reported for the preview of an imported file
+const FileImportMaxValidationErrors = 50
+
+const (
+	MaskedValuePlaceholder = "****"
+	MaskedValueVisibleSize = 4
+	MaskedValueHashSize    = 16
+)
+
+const (
+	AuditLogEventColumnPolicyCreated = "dataset_column_policy_created"
+	AuditLogEventColumnPolicyUpdated = "dataset_column_policy_updated"
+	AuditLogEventColumnPolicyDeleted = "dataset_column_policy_deleted"
+	AuditLogEventRuleCreated         = "dataset_rule_created"
+	AuditLogEventRuleUpdated         = "dataset_rule_updated"
+	AuditLogEventRuleEnabled         = "dataset_rule_enabled"
+	AuditLogEventRuleDisabled        = "dataset_rule_disabled"
+	AuditLogEventRuleDeleted         = "dataset_rule_deleted"
+	AuditLogEventRuleRestored        = "dataset_rule_restored"
+	AuditLogEventRuleBundleImported  = "dataset_rule_bundle_imported"
 )
 
 const (
 	AmountColumn   = "amount_column"
 	CurrencyColumn = "currency_column"
+	DateColumn     = "date_column"
 )
 
 var CustomColumnGroupConfig = map[string]string{
 	AmountColumn:   "amount_column",
 	CurrencyColumn: "currency_column",
+	DateColumn:     "date_column",
 }
 
 const (
@@ -69,6 +99,7 @@ const (
 )
 
 const (
+	CustomColumnGroupTypeFX             = "FX"
 	CustomColumnGroupTypeCurrencyAmount = "currency_amount"
 	CustomColumnGroupTypeDateTime       = "date_time"
 	CustomColumnGroupTypeTags           = "tags"
@@ -84,9 +115,113 @@ const (
 const (
 	DatasetExportTimestampFormat = "2006-01-02_15-04-05"
 	DatasetExportFilePathFormat  = "export-data/dataset/%s/workflow/%s/%s"
-	DatasetExportFileNameFormat  = "%s_%s.csv"
+	DatasetExportFileNameFormat  = "%s_%s.%s"
+)
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV     ExportFormat = "csv"
+	ExportFormatXLSX    ExportFormat = "xlsx"
+	ExportFormatParquet ExportFormat = "parquet"
+	ExportFormatJSONL   ExportFormat = "jsonl"
+)
+
+var ExportFormatContentTypes = map[ExportFormat]string{
+	ExportFormatCSV:     "text/csv",
+	ExportFormatXLSX:    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	ExportFormatParquet: "application/vnd.apache.parquet",
+	ExportFormatJSONL:   "application/x-ndjson",
+}
+
+type ExportDestinationType string
+
+const (
+	ExportDestinationTypeStorage ExportDestinationType = "storage"
+	ExportDestinationTypeEmail   ExportDestinationType = "email"
+)
+
+const (
+	ExportStorageProviderGCS = "gcs"
+	ExportStorageProviderS3  = "s3"
+)
+
+const (
+	DatasetExportScheduleTemporalIdFormat    = "dataset-export-schedule-%s"
+	DatasetExportScheduleRunWorkflowIdFormat = "dataset-export-schedule-run-%s"
+	DatasetExportScheduleDefaultTimezone     = "UTC"
+	DatasetExportScheduleRunsLimit           = 50
+	DatasetExportScheduleMaxRecipients       = 50
+	DatasetExportEmailLinkTtl                = 7 * 24 * time.Hour
+	DatasetExportUploadUrlTtl                = 15 * time.Minute
 )
 
+const (
+	DatasetExportScheduleRunStatusRunning    = "RUNNING"
+	DatasetExportScheduleRunStatusSuccessful = "SUCCESSFUL"
+	DatasetExportScheduleRunStatusFailed     = "FAILED"
+)
+
+type DatasetAlertConditionType string
+
+const (
+	DatasetAlertConditionThreshold     DatasetAlertConditionType = "threshold"
+	DatasetAlertConditionPercentChange DatasetAlertConditionType = "percent_change"
+	DatasetAlertConditionAnomaly       DatasetAlertConditionType = "anomaly"
+)
+
+// DatasetAlertPeriod is the calendar period a metric is measured over, periods start on mondays for weeks
+// and on the first day for months in the timezone of the alert
+type DatasetAlertPeriod string
+
+const (
+	DatasetAlertPeriodHour  DatasetAlertPeriod = "hour"
+	DatasetAlertPeriodDay   DatasetAlertPeriod = "day"
+	DatasetAlertPeriodWeek  DatasetAlertPeriod = "week"
+	DatasetAlertPeriodMonth DatasetAlertPeriod = "month"
+)
+
+var DatasetAlertAggregationFunctions = []string{"SUM", "AVG", "MIN", "MAX", "COUNT"}
+
+const (
+	DatasetAlertTemporalIdFormat           = "dataset-alert-%s"
+	DatasetAlertEvaluationWorkflowIdFormat = "dataset-alert-evaluation-%s"
+	DatasetAlertMetricAlias                = "alert_metric_value"
+	DatasetAlertDefaultTimezone            = "UTC"
+	DatasetAlertDefaultBaselinePeriods     = 14
+	DatasetAlertMinBaselinePeriods         = 3
+	DatasetAlertMaxBaselinePeriods         = 30
+	DatasetAlertDefaultAnomalyScore        = 3
+	DatasetAlertMaxRecipients              = 50
+	DatasetAlertEventsLimit                = 50
+	DatasetAlertMaxSnooze                  = 30 * 24 * time.Hour
+	DatasetAlertWebhookTimeout             = 10 * time.Second
+)
+
+// DatasetRulePreviewSampleSize is the number of matching rows a rule preview shows with their values
+const DatasetRulePreviewSampleSize = 20
+
+// DatasetRuleAnalysisMaxRules caps the rules of a column analysed for conflicts, overlaps are counted pairwise
+const DatasetRuleAnalysisMaxRules = 25
+
+// DatasetRuleAnalysisSampleSize caps the rows of a rule the rows it shares with other rules are counted among
+const DatasetRuleAnalysisSampleSize = 1000
+
+// DatasetRuleStatsRunsLimit is the number of latest runs kept in the effectiveness stats of a rule
+const DatasetRuleStatsRunsLimit = 10
+
+// GetRuleAttributedRowsQuery counts the rows attributed to each rule of a column in a single scan of the dataset
+const GetRuleAttributedRowsQuery = "SELECT %s FROM {{.zamp_%s}} WHERE %s = False"
+
+// RuleAttributedRowsCount counts the rows whose source column names a rule
+const RuleAttributedRowsCount = "COUNT_IF(%s LIKE '%%%s%%') AS rule_%d"
+
+// DatasetRuleBundleVersion is the version of the rule bundle format written by exports and read by imports
+const DatasetRuleBundleVersion = 1
+
+// ZampFxRatesJoinPrefix prefixes the joins of the rates converting the amounts without a precomputed fx column
+const ZampFxRatesJoinPrefix = "_zamp_fx_rates_"
+
 const (
 	DatasetConfigIsFxEnabled         = "is_fx_enabled"
 	DatasetConfigIsFileImportEnabled = "is_file_import_enabled"
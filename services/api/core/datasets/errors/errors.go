@@ -29,6 +29,73 @@ const (
 	ErrFailedToUpdateDatasetActionMessage        = "ERR_FAILED_TO_UPDATE_DATASET_ACTION"
 	ErrInvalidDatasetTypeMessage                 = "ERR_INVALID_DATASET_TYPE"
 	ErrFailedToGetDatasetDagsMessage             = "ERR_FAILED_TO_GET_DATASET_DAGS"
+	ErrInvalidRowPolicyAudienceTypeMessage       = "ERR_INVALID_ROW_POLICY_AUDIENCE_TYPE"
+	ErrEmptyRowPolicyFiltersMessage              = "ERR_EMPTY_ROW_POLICY_FILTERS"
+	ErrInvalidRowPolicyColumnMessage             = "ERR_INVALID_ROW_POLICY_COLUMN"
+	ErrRowPolicyNotFoundMessage                  = "ERR_ROW_POLICY_NOT_FOUND"
+	ErrFailedToGetRowPoliciesMessage             = "ERR_FAILED_TO_GET_ROW_POLICIES"
+	ErrPreviewUserNoDatasetAccessMessage         = "ERR_PREVIEW_USER_NO_DATASET_ACCESS"
+	ErrNoUserForDataPoliciesMessage              = "ERR_NO_USER_FOR_DATA_POLICIES"
+	ErrInvalidColumnPolicyAudienceTypeMessage    = "ERR_INVALID_COLUMN_POLICY_AUDIENCE_TYPE"
+	ErrInvalidColumnPolicyColumnMessage          = "ERR_INVALID_COLUMN_POLICY_COLUMN"
+	ErrInvalidColumnPolicyActionMessage          = "ERR_INVALID_COLUMN_POLICY_ACTION"
+	ErrInvalidColumnPolicyMaskTypeMessage        = "ERR_INVALID_COLUMN_POLICY_MASK_TYPE"
+	ErrColumnPolicyNotFoundMessage               = "ERR_COLUMN_POLICY_NOT_FOUND"
+	ErrFailedToGetColumnPoliciesMessage          = "ERR_FAILED_TO_GET_COLUMN_POLICIES"
+	ErrColumnNotFilterableMessage                = "ERR_COLUMN_NOT_FILTERABLE"
+	ErrColumnNotAccessibleMessage                = "ERR_COLUMN_NOT_ACCESSIBLE"
+	ErrDatasetViewNotFoundMessage                = "ERR_DATASET_VIEW_NOT_FOUND"
+	ErrEmptyDatasetViewNameMessage               = "ERR_EMPTY_DATASET_VIEW_NAME"
+	ErrFailedToGetDatasetViewsMessage            = "ERR_FAILED_TO_GET_DATASET_VIEWS"
+	ErrInvalidDatasetViewPrivilegeMessage        = "ERR_INVALID_DATASET_VIEW_PRIVILEGE"
+	ErrInvalidDatasetViewAudienceTypeMessage     = "ERR_INVALID_DATASET_VIEW_AUDIENCE_TYPE"
+	ErrDatasetViewAudienceAlreadyAddedMessage    = "ERR_DATASET_VIEW_AUDIENCE_ALREADY_ADDED"
+	ErrDatasetViewAccessForbiddenMessage         = "ERR_DATASET_VIEW_ACCESS_FORBIDDEN"
+	ErrCannotRemoveDatasetViewOwnerMessage       = "ERR_CANNOT_REMOVE_DATASET_VIEW_OWNER"
+	ErrInvalidExportFormatMessage                = "ERR_INVALID_EXPORT_FORMAT"
+	ErrDatasetExportScheduleNotFoundMessage      = "ERR_DATASET_EXPORT_SCHEDULE_NOT_FOUND"
+	ErrEmptyDatasetExportScheduleNameMessage     = "ERR_EMPTY_DATASET_EXPORT_SCHEDULE_NAME"
+	ErrInvalidCronExpressionMessage              = "ERR_INVALID_CRON_EXPRESSION"
+	ErrInvalidTimezoneMessage                    = "ERR_INVALID_TIMEZONE"
+	ErrInvalidExportDestinationMessage           = "ERR_INVALID_EXPORT_DESTINATION"
+	ErrExportScheduleAccessForbiddenMessage      = "ERR_DATASET_EXPORT_SCHEDULE_ACCESS_FORBIDDEN"
+	ErrDatasetAlertNotFoundMessage               = "ERR_DATASET_ALERT_NOT_FOUND"
+	ErrEmptyDatasetAlertNameMessage              = "ERR_EMPTY_DATASET_ALERT_NAME"
+	ErrInvalidAlertMetricMessage                 = "ERR_INVALID_DATASET_ALERT_METRIC"
+	ErrInvalidAlertConditionMessage              = "ERR_INVALID_DATASET_ALERT_CONDITION"
+	ErrInvalidAlertRecipientsMessage             = "ERR_INVALID_DATASET_ALERT_RECIPIENTS"
+	ErrInvalidAlertWebhookUrlMessage             = "ERR_INVALID_DATASET_ALERT_WEBHOOK_URL"
+	ErrInvalidAlertSnoozeMessage                 = "ERR_INVALID_DATASET_ALERT_SNOOZE"
+	ErrDatasetAlertAccessForbiddenMessage        = "ERR_DATASET_ALERT_ACCESS_FORBIDDEN"
+	ErrFxRatesTableNotConfiguredMessage          = "ERR_FX_RATES_TABLE_NOT_CONFIGURED"
+	ErrFailedToGetTagsMessage                    = "ERR_FAILED_TO_GET_TAGS"
+	ErrInvalidTagValueMessage                    = "ERR_INVALID_TAG_VALUE"
+	ErrStatusWorkflowNotFoundMessage             = "ERR_STATUS_WORKFLOW_NOT_FOUND"
+	ErrStatusWorkflowExistsMessage               = "ERR_STATUS_WORKFLOW_EXISTS"
+	ErrInvalidStatusWorkflowColumnMessage        = "ERR_INVALID_STATUS_WORKFLOW_COLUMN"
+	ErrInvalidStatusWorkflowStatesMessage        = "ERR_INVALID_STATUS_WORKFLOW_STATES"
+	ErrInvalidStatusWorkflowTransitionsMessage   = "ERR_INVALID_STATUS_WORKFLOW_TRANSITIONS"
+	ErrFailedToGetStatusWorkflowsMessage         = "ERR_FAILED_TO_GET_STATUS_WORKFLOWS"
+	ErrInvalidStatusValueMessage                 = "ERR_INVALID_STATUS_VALUE"
+	ErrInvalidStatusTransitionMessage            = "ERR_INVALID_STATUS_TRANSITION"
+	ErrStatusTransitionForbiddenMessage          = "ERR_STATUS_TRANSITION_FORBIDDEN"
+	ErrDatasetRowNotFoundMessage                 = "ERR_DATASET_ROW_NOT_FOUND"
+	ErrFailedToGetRowAssignmentsMessage          = "ERR_FAILED_TO_GET_ROW_ASSIGNMENTS"
+	ErrInvalidRowAssigneeMessage                 = "ERR_INVALID_ROW_ASSIGNEE"
+	ErrRuleNotFoundMessage                       = "ERR_RULE_NOT_FOUND"
+	ErrEmptyRuleTitleMessage                     = "ERR_EMPTY_RULE_TITLE"
+	ErrInvalidRuleColumnMessage                  = "ERR_INVALID_RULE_COLUMN"
+	ErrEmptyRuleFiltersMessage                   = "ERR_EMPTY_RULE_FILTERS"
+	ErrInvalidRuleFilterColumnMessage            = "ERR_INVALID_RULE_FILTER_COLUMN"
+	ErrEmptyRuleValueMessage                     = "ERR_EMPTY_RULE_VALUE"
+	ErrRuleVersionNotFoundMessage                = "ERR_RULE_VERSION_NOT_FOUND"
+	ErrFailedToGetRuleVersionsMessage            = "ERR_FAILED_TO_GET_RULE_VERSIONS"
+	ErrInvalidRuleBundleMessage                  = "ERR_INVALID_RULE_BUNDLE"
+	ErrUnsupportedRuleBundleVersionMessage       = "ERR_UNSUPPORTED_RULE_BUNDLE_VERSION"
+	ErrFailedToImportRuleBundleMessage           = "ERR_FAILED_TO_IMPORT_RULE_BUNDLE"
+	ErrInvalidRuleAssignmentMessage              = "ERR_INVALID_RULE_ASSIGNMENT"
+	ErrInvalidRuleLookupMessage                  = "ERR_INVALID_RULE_LOOKUP"
+	ErrRuleAssignmentNotPreviewableMessage       = "ERR_RULE_ASSIGNMENT_NOT_PREVIEWABLE"
 )
 
 var (
@@ -58,4 +125,71 @@ var (
 	ErrInvalidDatalistinSortColumn        = errors.New(ErrInvalidDatalistinSortColumnMessage)
 	ErrInvalidDatasetType                 = errors.New(ErrInvalidDatasetTypeMessage)
 	ErrFailedToGetDatasetDags             = errors.New(ErrFailedToGetDatasetDagsMessage)
+	ErrInvalidRowPolicyAudienceType       = errors.New(ErrInvalidRowPolicyAudienceTypeMessage)
+	ErrEmptyRowPolicyFilters              = errors.New(ErrEmptyRowPolicyFiltersMessage)
+	ErrInvalidRowPolicyColumn             = errors.New(ErrInvalidRowPolicyColumnMessage)
+	ErrRowPolicyNotFound                  = errors.New(ErrRowPolicyNotFoundMessage)
+	ErrFailedToGetRowPolicies             = errors.New(ErrFailedToGetRowPoliciesMessage)
+	ErrPreviewUserNoDatasetAccess         = errors.New(ErrPreviewUserNoDatasetAccessMessage)
+	ErrNoUserForDataPolicies              = errors.New(ErrNoUserForDataPoliciesMessage)
+	ErrInvalidColumnPolicyAudienceType    = errors.New(ErrInvalidColumnPolicyAudienceTypeMessage)
+	ErrInvalidColumnPolicyColumn          = errors.New(ErrInvalidColumnPolicyColumnMessage)
+	ErrInvalidColumnPolicyAction          = errors.New(ErrInvalidColumnPolicyActionMessage)
+	ErrInvalidColumnPolicyMaskType        = errors.New(ErrInvalidColumnPolicyMaskTypeMessage)
+	ErrColumnPolicyNotFound               = errors.New(ErrColumnPolicyNotFoundMessage)
+	ErrFailedToGetColumnPolicies          = errors.New(ErrFailedToGetColumnPoliciesMessage)
+	ErrColumnNotFilterable                = errors.New(ErrColumnNotFilterableMessage)
+	ErrColumnNotAccessible                = errors.New(ErrColumnNotAccessibleMessage)
+	ErrDatasetViewNotFound                = errors.New(ErrDatasetViewNotFoundMessage)
+	ErrEmptyDatasetViewName               = errors.New(ErrEmptyDatasetViewNameMessage)
+	ErrFailedToGetDatasetViews            = errors.New(ErrFailedToGetDatasetViewsMessage)
+	ErrInvalidDatasetViewPrivilege        = errors.New(ErrInvalidDatasetViewPrivilegeMessage)
+	ErrInvalidDatasetViewAudienceType     = errors.New(ErrInvalidDatasetViewAudienceTypeMessage)
+	ErrDatasetViewAudienceAlreadyAdded    = errors.New(ErrDatasetViewAudienceAlreadyAddedMessage)
+	ErrDatasetViewAccessForbidden         = errors.New(ErrDatasetViewAccessForbiddenMessage)
+	ErrCannotRemoveDatasetViewOwner       = errors.New(ErrCannotRemoveDatasetViewOwnerMessage)
+	ErrInvalidExportFormat                = errors.New(ErrInvalidExportFormatMessage)
+	ErrDatasetExportScheduleNotFound      = errors.New(ErrDatasetExportScheduleNotFoundMessage)
+	ErrEmptyDatasetExportScheduleName     = errors.New(ErrEmptyDatasetExportScheduleNameMessage)
+	ErrInvalidCronExpression              = errors.New(ErrInvalidCronExpressionMessage)
+	ErrInvalidTimezone                    = errors.New(ErrInvalidTimezoneMessage)
+	ErrInvalidExportDestination           = errors.New(ErrInvalidExportDestinationMessage)
+	ErrExportScheduleAccessForbidden      = errors.New(ErrExportScheduleAccessForbiddenMessage)
+	ErrDatasetAlertNotFound               = errors.New(ErrDatasetAlertNotFoundMessage)
+	ErrEmptyDatasetAlertName              = errors.New(ErrEmptyDatasetAlertNameMessage)
+	ErrInvalidAlertMetric                 = errors.New(ErrInvalidAlertMetricMessage)
+	ErrInvalidAlertCondition              = errors.New(ErrInvalidAlertConditionMessage)
+	ErrInvalidAlertRecipients             = errors.New(ErrInvalidAlertRecipientsMessage)
+	ErrInvalidAlertWebhookUrl             = errors.New(ErrInvalidAlertWebhookUrlMessage)
+	ErrInvalidAlertSnooze                 = errors.New(ErrInvalidAlertSnoozeMessage)
+	ErrDatasetAlertAccessForbidden        = errors.New(ErrDatasetAlertAccessForbiddenMessage)
+	ErrFxRatesTableNotConfigured          = errors.New(ErrFxRatesTableNotConfiguredMessage)
+	ErrFailedToGetTags                    = errors.New(ErrFailedToGetTagsMessage)
+	ErrInvalidTagValue                    = errors.New(ErrInvalidTagValueMessage)
+	ErrStatusWorkflowNotFound             = errors.New(ErrStatusWorkflowNotFoundMessage)
+	ErrStatusWorkflowExists               = errors.New(ErrStatusWorkflowExistsMessage)
+	ErrInvalidStatusWorkflowColumn        = errors.New(ErrInvalidStatusWorkflowColumnMessage)
+	ErrInvalidStatusWorkflowStates        = errors.New(ErrInvalidStatusWorkflowStatesMessage)
+	ErrInvalidStatusWorkflowTransitions   = errors.New(ErrInvalidStatusWorkflowTransitionsMessage)
+	ErrFailedToGetStatusWorkflows         = errors.New(ErrFailedToGetStatusWorkflowsMessage)
+	ErrInvalidStatusValue                 = errors.New(ErrInvalidStatusValueMessage)
+	ErrInvalidStatusTransition            = errors.New(ErrInvalidStatusTransitionMessage)
+	ErrStatusTransitionForbidden          = errors.New(ErrStatusTransitionForbiddenMessage)
+	ErrDatasetRowNotFound                 = errors.New(ErrDatasetRowNotFoundMessage)
+	ErrFailedToGetRowAssignments          = errors.New(ErrFailedToGetRowAssignmentsMessage)
+	ErrInvalidRowAssignee                 = errors.New(ErrInvalidRowAssigneeMessage)
+	ErrRuleNotFound                       = errors.New(ErrRuleNotFoundMessage)
+	ErrEmptyRuleTitle                     = errors.New(ErrEmptyRuleTitleMessage)
+	ErrInvalidRuleColumn                  = errors.New(ErrInvalidRuleColumnMessage)
+	ErrEmptyRuleFilters                   = errors.New(ErrEmptyRuleFiltersMessage)
+	ErrInvalidRuleFilterColumn            = errors.New(ErrInvalidRuleFilterColumnMessage)
+	ErrEmptyRuleValue                     = errors.New(ErrEmptyRuleValueMessage)
+	ErrRuleVersionNotFound                = errors.New(ErrRuleVersionNotFoundMessage)
+	ErrFailedToGetRuleVersions            = errors.New(ErrFailedToGetRuleVersionsMessage)
+	ErrInvalidRuleBundle                  = errors.New(ErrInvalidRuleBundleMessage)
+	ErrUnsupportedRuleBundleVersion       = errors.New(ErrUnsupportedRuleBundleVersionMessage)
+	ErrFailedToImportRuleBundle           = errors.New(ErrFailedToImportRuleBundleMessage)
+	ErrInvalidRuleAssignment              = errors.New(ErrInvalidRuleAssignmentMessage)
+	ErrInvalidRuleLookup                  = errors.New(ErrInvalidRuleLookupMessage)
+	ErrRuleAssignmentNotPreviewable       = errors.New(ErrRuleAssignmentNotPreviewableMessage)
 )
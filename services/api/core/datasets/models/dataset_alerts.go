@@ -0,0 +1,171 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+// DatasetAlertMetric is a single aggregation over the filtered rows of a dataset. Conditions comparing periods
+// measure it over Period on TimeColumn, threshold conditions measure it over every row when Period is empty.
+type DatasetAlertMetric struct {
+	Column     string                       `json:"column"`
+	Function   AggregationFunction          `json:"function"`
+	Filters    FilterModel                  `json:"filters"`
+	TimeColumn string                       `json:"time_column,omitempty"`
+	Period     constants.DatasetAlertPeriod `json:"period,omitempty"`
+}
+
+// DatasetAlertCondition compares the metric to Value with Operator. Value is the metric itself for thresholds,
+// the change in percent against the previous period for percent changes and the absolute z-score of the metric
+// against the BaselinePeriods previous periods for anomalies, which ignore Operator.
+type DatasetAlertCondition struct {
+	Type            constants.DatasetAlertConditionType `json:"type"`
+	Operator        string                              `json:"operator,omitempty"`
+	Value           float64                             `json:"value"`
+	BaselinePeriods int                                 `json:"baseline_periods,omitempty"`
+}
+
+type DatasetAlert struct {
+	ID              uuid.UUID
+	DatasetId       uuid.UUID
+	OwnerId         uuid.UUID
+	Name            string
+	Metric          DatasetAlertMetric
+	Condition       DatasetAlertCondition
+	CronExpression  string
+	Timezone        string
+	Recipients      []string
+	WebhookUrl      string
+	IsEnabled       bool
+	State           string
+	LastValue       *float64
+	LastEvaluatedAt *time.Time
+	LastError       string
+	SnoozedUntil    *time.Time
+	CreatedBy       uuid.UUID
+	UpdatedBy       uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type DatasetAlertParams struct {
+	Name           string
+	Metric         DatasetAlertMetric
+	Condition      DatasetAlertCondition
+	CronExpression string
+	Timezone       string
+	Recipients     []string
+	WebhookUrl     string
+	IsEnabled      *bool
+}
+
+func (a *DatasetAlert) FromSchema(schema dbmodels.DatasetAlert) error {
+	var metric DatasetAlertMetric
+	if err := json.Unmarshal(schema.Metric, &metric); err != nil {
+		return err
+	}
+
+	var condition DatasetAlertCondition
+	if err := json.Unmarshal(schema.Condition, &condition); err != nil {
+		return err
+	}
+
+	var recipients []string
+	if len(schema.Recipients) > 0 {
+		if err := json.Unmarshal(schema.Recipients, &recipients); err != nil {
+			return err
+		}
+	}
+
+	a.ID = schema.ID
+	a.DatasetId = schema.DatasetId
+	a.OwnerId = schema.OwnerId
+	a.Name = schema.Name
+	a.Metric = metric
+	a.Condition = condition
+	a.CronExpression = schema.CronExpression
+	a.Timezone = schema.Timezone
+	a.Recipients = recipients
+	a.WebhookUrl = schema.WebhookUrl
+	a.IsEnabled = schema.IsEnabled
+	a.State = schema.State
+	a.LastValue = schema.LastValue
+	a.LastEvaluatedAt = schema.LastEvaluatedAt
+	a.LastError = schema.LastError
+	a.SnoozedUntil = schema.SnoozedUntil
+	a.CreatedBy = schema.CreatedBy
+	a.UpdatedBy = schema.UpdatedBy
+	a.CreatedAt = schema.CreatedAt
+	a.UpdatedAt = schema.UpdatedAt
+
+	return nil
+}
+
+// IsSnoozed reports whether notifications of the alert are muted at t
+func (a DatasetAlert) IsSnoozed(t time.Time) bool {
+	return a.SnoozedUntil != nil && a.SnoozedUntil.After(t)
+}
+
+type DatasetAlertEvent struct {
+	ID              uuid.UUID
+	AlertId         uuid.UUID
+	Status          string
+	Value           float64
+	ComparisonValue *float64
+	Score           *float64
+	Message         string
+	Notified        bool
+	CreatedAt       time.Time
+}
+
+func (e *DatasetAlertEvent) FromSchema(schema dbmodels.DatasetAlertEvent) {
+	e.ID = schema.ID
+	e.AlertId = schema.DatasetAlertId
+	e.Status = schema.Status
+	e.Value = schema.Value
+	e.ComparisonValue = schema.ComparisonValue
+	e.Score = schema.Score
+	e.Message = schema.Message
+	e.Notified = schema.Notified
+	e.CreatedAt = schema.CreatedAt
+}
+
+// DatasetAlertWorkflowParams are the arguments the temporal schedule starts every evaluation with
+type DatasetAlertWorkflowParams struct {
+	AlertId            uuid.UUID `json:"alert_id"`
+	TemporalScheduleId string    `json:"temporal_schedule_id"`
+	OrganizationId     uuid.UUID `json:"organization_id"`
+	OwnerId            uuid.UUID `json:"owner_id"`
+}
+
+// DatasetAlertMetricResult is the metric of the current period and, depending on the condition, what it was compared with
+type DatasetAlertMetricResult struct {
+	Value           float64  `json:"value"`
+	ComparisonValue *float64 `json:"comparison_value,omitempty"`
+	Score           *float64 `json:"score,omitempty"`
+	Breached        bool     `json:"breached"`
+	Message         string   `json:"message"`
+}
+
+// DatasetAlertEvaluation is the outcome of one evaluation. EventId is set when the state of the alert changed or when
+// the change to FIRING made while the alert was snoozed is notified now, Notify is set when that change has to be sent
+// to the recipients and the webhook of the alert.
+type DatasetAlertEvaluation struct {
+	Skip        bool                     `json:"skip"`
+	AlertId     uuid.UUID                `json:"alert_id"`
+	AlertName   string                   `json:"alert_name"`
+	DatasetId   uuid.UUID                `json:"dataset_id"`
+	DatasetName string                   `json:"dataset_name"`
+	State       string                   `json:"state"`
+	Result      DatasetAlertMetricResult `json:"result"`
+	EventId     uuid.UUID                `json:"event_id"`
+	EventStatus string                   `json:"event_status"`
+	Notify      bool                     `json:"notify"`
+	Recipients  []string                 `json:"recipients"`
+	WebhookUrl  string                   `json:"webhook_url"`
+	EvaluatedAt time.Time                `json:"evaluated_at"`
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type DatasetColumnPolicy struct {
+	ID           uuid.UUID
+	DatasetId    uuid.UUID
+	AudienceType dbmodels.AudienceType
+	AudienceId   uuid.UUID
+	Column       string
+	Action       dbmodels.ColumnPolicyAction
+	MaskType     *dbmodels.ColumnMaskType
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type DatasetColumnPolicyParams struct {
+	AudienceType dbmodels.AudienceType
+	AudienceId   uuid.UUID
+	Column       string
+	Action       dbmodels.ColumnPolicyAction
+	MaskType     *dbmodels.ColumnMaskType
+}
+
+// ColumnRestriction is the effective access of a user on a column after resolving all applicable column policies
+type ColumnRestriction struct {
+	Action   dbmodels.ColumnPolicyAction
+	MaskType dbmodels.ColumnMaskType
+}
+
+func (p *DatasetColumnPolicy) FromSchema(schema dbmodels.DatasetColumnPolicy) {
+	p.ID = schema.ID
+	p.DatasetId = schema.DatasetId
+	p.AudienceType = schema.ResourceAudienceType
+	p.AudienceId = schema.ResourceAudienceId
+	p.Column = schema.Column
+	p.Action = schema.Action
+	p.MaskType = schema.MaskType
+	p.CreatedBy = schema.CreatedBy
+	p.UpdatedBy = schema.UpdatedBy
+	p.CreatedAt = schema.CreatedAt
+	p.UpdatedAt = schema.UpdatedAt
+}
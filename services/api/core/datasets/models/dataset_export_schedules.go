@@ -0,0 +1,123 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type DatasetExportSchedule struct {
+	ID                uuid.UUID
+	DatasetId         uuid.UUID
+	OwnerId           uuid.UUID
+	Name              string
+	QueryConfig       DatasetParams
+	Format            constants.ExportFormat
+	CronExpression    string
+	Timezone          string
+	DestinationType   constants.ExportDestinationType
+	DestinationConfig DatasetExportScheduleDestination
+	IsEnabled         bool
+	CreatedBy         uuid.UUID
+	UpdatedBy         uuid.UUID
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// DatasetExportScheduleDestination holds Provider, Bucket and Prefix for storage destinations and Recipients for email ones
+type DatasetExportScheduleDestination struct {
+	Provider   string   `json:"provider,omitempty"`
+	Bucket     string   `json:"bucket,omitempty"`
+	Prefix     string   `json:"prefix,omitempty"`
+	Recipients []string `json:"recipients,omitempty"`
+}
+
+type DatasetExportScheduleParams struct {
+	Name              string
+	QueryConfig       DatasetParams
+	Format            constants.ExportFormat
+	CronExpression    string
+	Timezone          string
+	DestinationType   constants.ExportDestinationType
+	DestinationConfig DatasetExportScheduleDestination
+	IsEnabled         *bool
+}
+
+func (s *DatasetExportSchedule) FromSchema(schema dbmodels.DatasetExportSchedule) error {
+	var queryConfig DatasetParams
+	if err := json.Unmarshal(schema.QueryConfig, &queryConfig); err != nil {
+		return err
+	}
+
+	var destinationConfig DatasetExportScheduleDestination
+	if len(schema.DestinationConfig) > 0 {
+		if err := json.Unmarshal(schema.DestinationConfig, &destinationConfig); err != nil {
+			return err
+		}
+	}
+
+	s.ID = schema.ID
+	s.DatasetId = schema.DatasetId
+	s.OwnerId = schema.OwnerId
+	s.Name = schema.Name
+	s.QueryConfig = queryConfig
+	s.Format = constants.ExportFormat(schema.Format)
+	s.CronExpression = schema.CronExpression
+	s.Timezone = schema.Timezone
+	s.DestinationType = constants.ExportDestinationType(schema.DestinationType)
+	s.DestinationConfig = destinationConfig
+	s.IsEnabled = schema.IsEnabled
+	s.CreatedBy = schema.CreatedBy
+	s.UpdatedBy = schema.UpdatedBy
+	s.CreatedAt = schema.CreatedAt
+	s.UpdatedAt = schema.UpdatedAt
+
+	return nil
+}
+
+type DatasetExportScheduleRun struct {
+	ID          uuid.UUID
+	ScheduleId  uuid.UUID
+	WorkflowId  string
+	Status      string
+	FilePath    string
+	Error       string
+	StartedAt   time.Time
+	CompletedAt *time.Time
+}
+
+func (r *DatasetExportScheduleRun) FromSchema(schema dbmodels.DatasetExportScheduleRun) {
+	r.ID = schema.ID
+	r.ScheduleId = schema.DatasetExportScheduleId
+	r.WorkflowId = schema.WorkflowId
+	r.Status = schema.Status
+	r.FilePath = schema.FilePath
+	r.Error = schema.Error
+	r.StartedAt = schema.StartedAt
+	r.CompletedAt = schema.CompletedAt
+}
+
+// DatasetExportScheduleWorkflowParams are the arguments the temporal schedule starts every run with
+type DatasetExportScheduleWorkflowParams struct {
+	ScheduleId         uuid.UUID `json:"schedule_id"`
+	TemporalScheduleId string    `json:"temporal_schedule_id"`
+	OrganizationId     uuid.UUID `json:"organization_id"`
+	OwnerId            uuid.UUID `json:"owner_id"`
+}
+
+// DatasetExportScheduleRunPlan is what a run exports and where it delivers it, Skip is set when the schedule
+// was deleted, disabled or replaced by a newer temporal schedule since the run was fired
+type DatasetExportScheduleRunPlan struct {
+	Skip              bool                             `json:"skip"`
+	RunId             uuid.UUID                        `json:"run_id"`
+	WorkflowId        string                           `json:"workflow_id"`
+	ScheduleName      string                           `json:"schedule_name"`
+	DatasetId         uuid.UUID                        `json:"dataset_id"`
+	DatasetName       string                           `json:"dataset_name"`
+	ExportParams      DatasetExportParams              `json:"export_params"`
+	DestinationType   constants.ExportDestinationType  `json:"destination_type"`
+	DestinationConfig DatasetExportScheduleDestination `json:"destination_config"`
+}
@@ -7,6 +7,7 @@ import (
 	dataplatformdataconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
 	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
 	"github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
 	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
 	dataplatformmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
 	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
@@ -45,16 +46,16 @@ type UpdateColumn struct {
 }
 
 type FilterModel struct {
-	LogicalOperator LogicalOperator `json:"logical_operator"`
-	Conditions      []Filter        `json:"conditions"`
+	LogicalOperator LogicalOperator `json:"logical_operator" yaml:"logical_operator,omitempty"`
+	Conditions      []Filter        `json:"conditions" yaml:"conditions"`
 }
 
 type Filter struct {
-	LogicalOperator *LogicalOperator `json:"logical_operator"`
-	Column          string           `json:"column"`
-	Operator        string           `json:"operator"`
-	Value           interface{}      `json:"value"`
-	Conditions      []Filter         `json:"conditions"`
+	LogicalOperator *LogicalOperator `json:"logical_operator" yaml:"logical_operator,omitempty"`
+	Column          string           `json:"column" yaml:"column,omitempty"`
+	Operator        string           `json:"operator" yaml:"operator,omitempty"`
+	Value           interface{}      `json:"value" yaml:"value"`
+	Conditions      []Filter         `json:"conditions" yaml:"conditions,omitempty"`
 }
 
 type Aggregation struct {
@@ -94,6 +95,7 @@ type UpdateDatasetDataParams struct {
 	UserId          uuid.UUID
 	RuleTitle       string
 	RuleDescription string
+	RuleAssignments []rulemodels.RuleAssignment
 }
 
 type DatasetData struct {
@@ -169,10 +171,20 @@ func (d *DatasetData) GetTagsColumns() (string, bool) {
 }
 
 type DatasetExportParams struct {
-	QueryConfig DatasetParams `json:"query_config"`
-	ExportPath  string        `json:"export_path"`
+	QueryConfig DatasetParams          `json:"query_config"`
+	ExportPath  string                 `json:"export_path"`
+	Format      constants.ExportFormat `json:"format"`
 }
 
 type ExportMetadata struct {
-	FilePath string `json:"file_path"`
+	FilePath string                 `json:"file_path"`
+	Format   constants.ExportFormat `json:"format"`
+}
+
+// GetFormat falls back to csv for exports started before the format was recorded
+func (e ExportMetadata) GetFormat() constants.ExportFormat {
+	if e.Format == "" {
+		return constants.ExportFormatCSV
+	}
+	return e.Format
 }
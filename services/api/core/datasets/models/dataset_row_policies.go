@@ -0,0 +1,52 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type DatasetRowPolicy struct {
+	ID           uuid.UUID
+	DatasetId    uuid.UUID
+	AudienceType dbmodels.AudienceType
+	AudienceId   uuid.UUID
+	Title        string
+	Description  string
+	Filters      FilterModel
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type DatasetRowPolicyParams struct {
+	AudienceType dbmodels.AudienceType
+	AudienceId   uuid.UUID
+	Title        string
+	Description  string
+	Filters      FilterModel
+}
+
+func (p *DatasetRowPolicy) FromSchema(schema dbmodels.DatasetRowPolicy) error {
+	var filters FilterModel
+	if err := json.Unmarshal(schema.FilterConfig, &filters); err != nil {
+		return err
+	}
+
+	p.ID = schema.ID
+	p.DatasetId = schema.DatasetId
+	p.AudienceType = schema.ResourceAudienceType
+	p.AudienceId = schema.ResourceAudienceId
+	p.Title = schema.Title
+	p.Description = schema.Description
+	p.Filters = filters
+	p.CreatedBy = schema.CreatedBy
+	p.UpdatedBy = schema.UpdatedBy
+	p.CreatedAt = schema.CreatedAt
+	p.UpdatedAt = schema.UpdatedAt
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+)
+
+// DatasetRuleBundle is the portable document of the rules of a dataset. Rules are identified by their title within
+// their column and listed in priority order, so that a bundle exported from one organization imports into another.
+type DatasetRuleBundle struct {
+	Version    int                       `json:"version" yaml:"version"`
+	DatasetId  uuid.UUID                 `json:"dataset_id" yaml:"dataset_id"`
+	ExportedAt time.Time                 `json:"exported_at" yaml:"exported_at"`
+	Columns    []DatasetRuleBundleColumn `json:"columns" yaml:"columns"`
+}
+
+type DatasetRuleBundleColumn struct {
+	Column string                  `json:"column" yaml:"column"`
+	Rules  []DatasetRuleBundleRule `json:"rules" yaml:"rules"`
+}
+
+// DatasetRuleBundleRule is a rule of a bundle, a rule without is_enabled is imported enabled. Lookups of a rule with
+// assignments name their dataset by id and only import where that dataset exists.
+type DatasetRuleBundleRule struct {
+	Title       string                      `json:"title" yaml:"title"`
+	Description string                      `json:"description" yaml:"description,omitempty"`
+	Value       interface{}                 `json:"value" yaml:"value"`
+	Assignments []rulemodels.RuleAssignment `json:"assignments,omitempty" yaml:"assignments,omitempty"`
+	Filters     FilterModel                 `json:"filters" yaml:"filters"`
+	IsEnabled   *bool                       `json:"is_enabled" yaml:"is_enabled,omitempty"`
+}
+
+type ImportDatasetRuleBundleParams struct {
+	Bundle DatasetRuleBundle
+	DryRun bool
+}
+
+// DatasetRuleBundleImport is what importing a bundle changes. The columns of the bundle end up with exactly the rules
+// of the bundle, the other rules of these columns are removed. Actions are the re-applications of the changed columns
+// and are empty for a dry run.
+type DatasetRuleBundleImport struct {
+	DryRun    bool
+	Added     []DatasetRuleBundleDiff
+	Changed   []DatasetRuleBundleDiff
+	Removed   []DatasetRuleBundleDiff
+	Unchanged int
+	Actions   []DatasetAction
+}
+
+// DatasetRuleBundleDiff is a rule of the bundle or of the dataset, RuleId is the rule of the dataset when there is one
+type DatasetRuleBundleDiff struct {
+	Column  string
+	Title   string
+	RuleId  *uuid.UUID
+	Changes []DatasetRuleVersionChange
+}
@@ -1,7 +1,12 @@
 package models
 
 import (
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
 	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
 	"github.com/google/uuid"
 )
 
@@ -10,3 +15,162 @@ type UpdateRulePriorityParams struct {
 	Column         string                               `json:"column"`
 	RulePriorities storemodels.UpdateRulePriorityParams `json:"rule_priorities"`
 }
+
+// DatasetRuleParams is a rule writing Value to Column, or a rule with Assignments writing several columns. Column is
+// the assignment the rule is prioritized with, it defaults to the first assignment.
+type DatasetRuleParams struct {
+	Title       string
+	Description string
+	Column      string
+	Filters     FilterModel
+	Value       interface{}
+	Assignments []rulemodels.RuleAssignment
+}
+
+// DatasetRulePreviewParams is a candidate rule, RuleId is set when it is an edit of an existing rule. Priority is where
+// the candidate would sit among the rules of its column, it defaults to the priority of the edited rule or to the top
+type DatasetRulePreviewParams struct {
+	DatasetRuleParams
+	RuleId   *uuid.UUID
+	Priority int
+}
+
+type DatasetRulePreview struct {
+	Column          string
+	ProposedValue   interface{}
+	Priority        int
+	MatchCount      int64
+	OverriddenCount int64
+	Rows            []DatasetRulePreviewRow
+	Overrides       []DatasetRuleOverride
+}
+
+type DatasetRulePreviewRow struct {
+	RowId          string
+	CurrentValue   interface{}
+	ProposedValue  interface{}
+	EffectiveValue interface{}
+	OverriddenBy   *uuid.UUID
+}
+
+// DatasetRuleOverride is an enabled rule of higher priority than the candidate, MatchCount counts the rows both match
+type DatasetRuleOverride struct {
+	RuleId     uuid.UUID
+	Title      string
+	Priority   int
+	Value      string
+	MatchCount int64
+}
+
+// DatasetRuleChange is a rule after a change together with the action re-applying the rules of its column
+type DatasetRuleChange struct {
+	Rule     rulemodels.Rule
+	Action   DatasetAction
+	Warnings []DatasetRuleConflict
+}
+
+// RuleFilters returns the filters a rule was saved with, by column names and without the filter on deleted rows
+// every rule is wrapped in
+func RuleFilters(rule rulemodels.Rule) FilterModel {
+	filters := rule.FilterConfig.QueryConfig.Filters
+	conditions := filters.Conditions
+	logicalOperator := LogicalOperator(filters.LogicalOperator)
+
+	if len(conditions) == 1 && conditions[0].Column.Column == constants.ZampIsDeletedColumn {
+		if conditions[0].LogicalOperator != nil {
+			logicalOperator = LogicalOperator(*conditions[0].LogicalOperator)
+		}
+		conditions = conditions[0].Conditions
+	}
+
+	return FilterModel{
+		LogicalOperator: logicalOperator,
+		Conditions:      fromQueryBuilderFilters(conditions),
+	}
+}
+
+func fromQueryBuilderFilters(conditions []querybuildermodels.Filter) []Filter {
+	filters := make([]Filter, len(conditions))
+	for i, condition := range conditions {
+		filters[i] = Filter{
+			LogicalOperator: (*LogicalOperator)(condition.LogicalOperator),
+			Column:          condition.Column.Column,
+			Operator:        condition.Operator,
+			Value:           condition.Value,
+		}
+		if condition.Conditions != nil {
+			filters[i].Conditions = fromQueryBuilderFilters(condition.Conditions)
+		}
+	}
+	return filters
+}
+
+type DatasetRuleConflictType string
+
+const (
+	DatasetRuleConflictShadowed  DatasetRuleConflictType = "shadowed"
+	DatasetRuleConflictOverlap   DatasetRuleConflictType = "overlap"
+	DatasetRuleConflictZeroMatch DatasetRuleConflictType = "zero_match"
+)
+
+// DatasetRuleAnalysisSource tells whether a conflict was proven from the filters alone or counted on the data
+type DatasetRuleAnalysisSource string
+
+const (
+	DatasetRuleAnalysisSourceStatic DatasetRuleAnalysisSource = "static"
+	DatasetRuleAnalysisSourceData   DatasetRuleAnalysisSource = "data"
+)
+
+// DatasetRuleConflict is a problem of a rule, OtherRuleIds are the rules shadowing it or overlapping with it.
+// RowCount is the number of rows concerned, unknown for conflicts proven statically. Sampled is set when the rule
+// matches more rows than the sample the rows were counted on.
+type DatasetRuleConflict struct {
+	Type         DatasetRuleConflictType
+	RuleId       uuid.UUID
+	OtherRuleIds []uuid.UUID
+	RowCount     *int64
+	Sampled      bool
+	Source       DatasetRuleAnalysisSource
+}
+
+type DatasetRuleAnalysis struct {
+	Column    string
+	RuleCount int
+	Truncated bool
+	Conflicts []DatasetRuleConflict
+}
+
+// DatasetRuleVersion is a rule as it stood after a change, Changes lists what differs from the version before it
+type DatasetRuleVersion struct {
+	Version     int
+	ChangeType  storemodels.RuleChangeType
+	Title       string
+	Description string
+	Value       string
+	Assignments []rulemodels.RuleAssignment
+	Filters     FilterModel
+	IsEnabled   bool
+	CreatedAt   time.Time
+	CreatedBy   uuid.UUID
+	Changes     []DatasetRuleVersionChange
+}
+
+type DatasetRuleVersionChange struct {
+	Field string
+	From  interface{}
+	To    interface{}
+}
+
+// DatasetRuleStats tells how much a rule is worth keeping. AttributedRows counts the rows whose value of the column
+// was last written by the rule, unknown when the dataset does not track the source of the column. Runs are the latest
+// applications of the rules of the column with the rows the rule matched in each.
+type DatasetRuleStats struct {
+	RuleId         uuid.UUID
+	Title          string
+	Column         string
+	Priority       int
+	IsEnabled      bool
+	AttributedRows *int64
+	Runs           []rulemodels.RuleRun
+	IsStale        bool
+}
@@ -0,0 +1,121 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type StatusWorkflowState struct {
+	Name    string `json:"name"`
+	IsFinal bool   `json:"is_final"`
+}
+
+// StatusWorkflowTransition allows rows to move between two states, to the users holding the privilege on the dataset.
+// Transitions with teams are further limited to the users the dataset is shared with through one of the teams.
+type StatusWorkflowTransition struct {
+	From      string                     `json:"from"`
+	To        string                     `json:"to"`
+	Privilege dbmodels.ResourcePrivilege `json:"privilege"`
+	TeamIds   []uuid.UUID                `json:"team_ids,omitempty"`
+}
+
+type DatasetStatusWorkflow struct {
+	ID           uuid.UUID
+	DatasetId    uuid.UUID
+	Column       string
+	InitialState string
+	States       []StatusWorkflowState
+	Transitions  []StatusWorkflowTransition
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type DatasetStatusWorkflowParams struct {
+	Column       string
+	InitialState string
+	States       []StatusWorkflowState
+	Transitions  []StatusWorkflowTransition
+}
+
+func (w *DatasetStatusWorkflow) FromSchema(schema dbmodels.DatasetStatusWorkflow) error {
+	var states []StatusWorkflowState
+	if err := json.Unmarshal(schema.States, &states); err != nil {
+		return err
+	}
+
+	var transitions []StatusWorkflowTransition
+	if err := json.Unmarshal(schema.Transitions, &transitions); err != nil {
+		return err
+	}
+
+	w.ID = schema.ID
+	w.DatasetId = schema.DatasetId
+	w.Column = schema.ColumnName
+	w.InitialState = schema.InitialState
+	w.States = states
+	w.Transitions = transitions
+	w.CreatedBy = schema.CreatedBy
+	w.UpdatedBy = schema.UpdatedBy
+	w.CreatedAt = schema.CreatedAt
+	w.UpdatedAt = schema.UpdatedAt
+
+	return nil
+}
+
+func (w DatasetStatusWorkflow) GetState(name string) (StatusWorkflowState, bool) {
+	for _, state := range w.States {
+		if state.Name == name {
+			return state, true
+		}
+	}
+	return StatusWorkflowState{}, false
+}
+
+func (w DatasetStatusWorkflow) GetTransition(from string, to string) (StatusWorkflowTransition, bool) {
+	for _, transition := range w.Transitions {
+		if transition.From == from && transition.To == to {
+			return transition, true
+		}
+	}
+	return StatusWorkflowTransition{}, false
+}
+
+type DatasetRowAssignment struct {
+	ID         uuid.UUID
+	DatasetId  uuid.UUID
+	RowId      string
+	AssigneeId *uuid.UUID
+	DueDate    *time.Time
+	UpdatedBy  uuid.UUID
+	UpdatedAt  time.Time
+}
+
+type DatasetRowAssignmentParams struct {
+	AssigneeId *uuid.UUID
+	DueDate    *time.Time
+}
+
+func (a *DatasetRowAssignment) FromSchema(schema dbmodels.DatasetRowAssignment) {
+	a.ID = schema.ID
+	a.DatasetId = schema.DatasetId
+	a.RowId = schema.RowId
+	a.AssigneeId = schema.AssigneeId
+	a.DueDate = schema.DueDate
+	a.UpdatedBy = schema.UpdatedBy
+	a.UpdatedAt = schema.UpdatedAt
+}
+
+// DatasetQueueItem is a row assigned to a user along with its current values, Statuses holding the states of the
+// status columns of the dataset
+type DatasetQueueItem struct {
+	Assignment   DatasetRowAssignment
+	DatasetTitle string
+	Statuses     map[string]string
+	Row          map[string]interface{}
+	IsOverdue    bool
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type DatasetView struct {
+	ID            uuid.UUID
+	DatasetId     uuid.UUID
+	OwnerId       uuid.UUID
+	Name          string
+	Description   string
+	QueryConfig   DatasetParams
+	ColumnOrder   []string
+	DisplayConfig map[string]interface{}
+	IsDefault     bool
+	CreatedBy     uuid.UUID
+	UpdatedBy     uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type DatasetViewParams struct {
+	Name          string
+	Description   string
+	QueryConfig   DatasetParams
+	ColumnOrder   []string
+	DisplayConfig map[string]interface{}
+}
+
+func (v *DatasetView) FromSchema(schema dbmodels.DatasetView) error {
+	var queryConfig DatasetParams
+	if err := json.Unmarshal(schema.QueryConfig, &queryConfig); err != nil {
+		return err
+	}
+
+	var columnOrder []string
+	if len(schema.ColumnOrder) > 0 {
+		if err := json.Unmarshal(schema.ColumnOrder, &columnOrder); err != nil {
+			return err
+		}
+	}
+
+	var displayConfig map[string]interface{}
+	if len(schema.DisplayConfig) > 0 {
+		if err := json.Unmarshal(schema.DisplayConfig, &displayConfig); err != nil {
+			return err
+		}
+	}
+
+	v.ID = schema.ID
+	v.DatasetId = schema.DatasetId
+	v.OwnerId = schema.OwnerId
+	v.Name = schema.Name
+	v.Description = schema.Description
+	v.QueryConfig = queryConfig
+	v.ColumnOrder = columnOrder
+	v.DisplayConfig = displayConfig
+	v.CreatedBy = schema.CreatedBy
+	v.UpdatedBy = schema.UpdatedBy
+	v.CreatedAt = schema.CreatedAt
+	v.UpdatedAt = schema.UpdatedAt
+
+	return nil
+}
+
+// ApplyTo returns the query of the view with the request level settings (pagination, totals and currency) of params,
+// so that a view can be fetched page by page without repeating its filters, sorts and grouping
+func (v *DatasetView) ApplyTo(params DatasetParams) DatasetParams {
+	viewParams := v.QueryConfig
+	viewParams.Pagination = params.Pagination
+	viewParams.CountAll = params.CountAll
+	if params.FxCurrency != nil {
+		viewParams.FxCurrency = params.FxCurrency
+	}
+
+	return viewParams
+}
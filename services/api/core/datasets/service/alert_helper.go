@@ -0,0 +1,487 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	temporalclient "go.temporal.io/sdk/client"
+
+	"github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+	workersconstants "github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	temporalmodels "github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/models"
+)
+
+var alertOperatorSymbols = map[string]string{
+	querybuilderconstants.GreaterThanOperator:        ">",
+	querybuilderconstants.GreaterThanOrEqualOperator: ">=",
+	querybuilderconstants.LessThanOperator:           "<",
+	querybuilderconstants.LessThanOrEqualOperator:    "<=",
+	querybuilderconstants.EqualOperator:              "=",
+	querybuilderconstants.NotEqualOperator:           "!=",
+}
+
+// alertWindow is the part of a period a metric is measured over, From is inclusive and To exclusive
+type alertWindow struct {
+	From time.Time
+	To   time.Time
+}
+
+func (s *datasetService) getDatasetAlert(ctx context.Context, datasetId uuid.UUID, alertId uuid.UUID) (storemodels.DatasetAlert, error) {
+	alert, err := s.datasetStore.GetDatasetAlertById(ctx, alertId)
+	if err != nil {
+		return storemodels.DatasetAlert{}, errors.ErrDatasetAlertNotFound
+	}
+
+	if alert.DatasetId != datasetId {
+		return storemodels.DatasetAlert{}, errors.ErrDatasetAlertNotFound
+	}
+
+	return alert, nil
+}
+
+// ensureDatasetAlertEditor lets the owner and the admins of the dataset change or snooze an alert
+func (s *datasetService) ensureDatasetAlertEditor(ctx context.Context, alert storemodels.DatasetAlert, userId uuid.UUID) error {
+	if alert.OwnerId == userId {
+		return nil
+	}
+
+	adminPolicies, err := s.datasetStore.GetFlattenedResourceAudiencePolicies(ctx, storemodels.FlattenedResourceAudiencePoliciesFilters{
+		ResourceIds:   []uuid.UUID{alert.DatasetId},
+		UserIds:       []uuid.UUID{userId},
+		ResourceTypes: []string{string(storemodels.ResourceTypeDataset)},
+		Privileges:    []storemodels.ResourcePrivilege{storemodels.PrivilegeDatasetAdmin},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(adminPolicies) == 0 {
+		return errors.ErrDatasetAlertAccessForbidden
+	}
+
+	return nil
+}
+
+func validateDatasetAlertParams(params models.DatasetAlertParams) (models.DatasetAlertParams, error) {
+	params.Name = strings.TrimSpace(params.Name)
+	if params.Name == "" {
+		return models.DatasetAlertParams{}, errors.ErrEmptyDatasetAlertName
+	}
+
+	metric, err := validateDatasetAlertMetric(params.Metric)
+	if err != nil {
+		return models.DatasetAlertParams{}, err
+	}
+	params.Metric = metric
+
+	condition, err := validateDatasetAlertCondition(params.Condition, params.Metric)
+	if err != nil {
+		return models.DatasetAlertParams{}, err
+	}
+	params.Condition = condition
+
+	params.CronExpression = strings.Join(strings.Fields(params.CronExpression), " ")
+	if !isValidCronExpression(params.CronExpression) {
+		return models.DatasetAlertParams{}, errors.ErrInvalidCronExpression
+	}
+
+	if params.Timezone == "" {
+		params.Timezone = constants.DatasetAlertDefaultTimezone
+	}
+	if _, err := time.LoadLocation(params.Timezone); err != nil {
+		return models.DatasetAlertParams{}, errors.ErrInvalidTimezone
+	}
+
+	if len(params.Recipients) > constants.DatasetAlertMaxRecipients {
+		return models.DatasetAlertParams{}, errors.ErrInvalidAlertRecipients
+	}
+	recipients, ok := normalizeEmailRecipients(params.Recipients)
+	if !ok {
+		return models.DatasetAlertParams{}, errors.ErrInvalidAlertRecipients
+	}
+	params.Recipients = recipients
+
+	params.WebhookUrl = strings.TrimSpace(params.WebhookUrl)
+	if params.WebhookUrl != "" && !isValidAlertWebhookUrl(params.WebhookUrl) {
+		return models.DatasetAlertParams{}, errors.ErrInvalidAlertWebhookUrl
+	}
+
+	if len(params.Recipients) == 0 && params.WebhookUrl == "" {
+		return models.DatasetAlertParams{}, errors.ErrInvalidAlertRecipients
+	}
+
+	return params, nil
+}
+
+func validateDatasetAlertMetric(metric models.DatasetAlertMetric) (models.DatasetAlertMetric, error) {
+	metric.Column = strings.TrimSpace(metric.Column)
+	if metric.Column == "" {
+		return models.DatasetAlertMetric{}, errors.ErrInvalidAlertMetric
+	}
+
+	metric.Function = models.AggregationFunction(strings.ToUpper(strings.TrimSpace(string(metric.Function))))
+	if !slices.Contains(constants.DatasetAlertAggregationFunctions, string(metric.Function)) {
+		return models.DatasetAlertMetric{}, errors.ErrInvalidAlertMetric
+	}
+
+	metric.TimeColumn = strings.TrimSpace(metric.TimeColumn)
+	switch metric.Period {
+	case "":
+		if metric.TimeColumn != "" {
+			return models.DatasetAlertMetric{}, errors.ErrInvalidAlertMetric
+		}
+	case constants.DatasetAlertPeriodHour, constants.DatasetAlertPeriodDay, constants.DatasetAlertPeriodWeek, constants.DatasetAlertPeriodMonth:
+		if metric.TimeColumn == "" {
+			return models.DatasetAlertMetric{}, errors.ErrInvalidAlertMetric
+		}
+	default:
+		return models.DatasetAlertMetric{}, errors.ErrInvalidAlertMetric
+	}
+
+	return metric, nil
+}
+
+func validateDatasetAlertCondition(condition models.DatasetAlertCondition, metric models.DatasetAlertMetric) (models.DatasetAlertCondition, error) {
+	switch condition.Type {
+	case constants.DatasetAlertConditionThreshold, constants.DatasetAlertConditionPercentChange:
+		if _, ok := alertOperatorSymbols[condition.Operator]; !ok {
+			return models.DatasetAlertCondition{}, errors.ErrInvalidAlertCondition
+		}
+		if condition.Type == constants.DatasetAlertConditionPercentChange && metric.Period == "" {
+			return models.DatasetAlertCondition{}, errors.ErrInvalidAlertCondition
+		}
+		condition.BaselinePeriods = 0
+	case constants.DatasetAlertConditionAnomaly:
+		if metric.Period == "" {
+			return models.DatasetAlertCondition{}, errors.ErrInvalidAlertCondition
+		}
+
+		condition.Operator = ""
+		if condition.Value == 0 {
+			condition.Value = constants.DatasetAlertDefaultAnomalyScore
+		}
+		if condition.Value < 0 {
+			return models.DatasetAlertCondition{}, errors.ErrInvalidAlertCondition
+		}
+
+		if condition.BaselinePeriods == 0 {
+			condition.BaselinePeriods = constants.DatasetAlertDefaultBaselinePeriods
+		}
+		if condition.BaselinePeriods < constants.DatasetAlertMinBaselinePeriods || condition.BaselinePeriods > constants.DatasetAlertMaxBaselinePeriods {
+			return models.DatasetAlertCondition{}, errors.ErrInvalidAlertCondition
+		}
+	default:
+		return models.DatasetAlertCondition{}, errors.ErrInvalidAlertCondition
+	}
+
+	if math.IsNaN(condition.Value) || math.IsInf(condition.Value, 0) {
+		return models.DatasetAlertCondition{}, errors.ErrInvalidAlertCondition
+	}
+
+	return condition, nil
+}
+
+// isValidAlertWebhookUrl only accepts https endpoints as the payload carries dataset metrics
+func isValidAlertWebhookUrl(webhookUrl string) bool {
+	parsedUrl, err := url.Parse(webhookUrl)
+	if err != nil {
+		return false
+	}
+
+	return parsedUrl.Scheme == "https" && parsedUrl.Host != "" && parsedUrl.User == nil
+}
+
+func (s *datasetService) createDatasetAlertTemporalSchedule(ctx context.Context, alert storemodels.DatasetAlert) error {
+	_, err := s.temporalService.ExecuteScheduledWorkflow(ctx, temporalmodels.ExecuteWorkflowWithScheduleParams{
+		ScheduleOptions: temporalmodels.ScheduleOptions{
+			ID: alert.TemporalScheduleId,
+			Spec: temporalclient.ScheduleSpec{
+				CronExpressions: []string{alert.CronExpression},
+				TimeZoneName:    alert.Timezone,
+			},
+			Action: &temporalclient.ScheduleWorkflowAction{
+				ID:       fmt.Sprintf(constants.DatasetAlertEvaluationWorkflowIdFormat, alert.ID),
+				Workflow: workersconstants.DatasetAlertWorkflowName,
+				Args: []interface{}{
+					models.DatasetAlertWorkflowParams{
+						AlertId:            alert.ID,
+						TemporalScheduleId: alert.TemporalScheduleId,
+						OrganizationId:     alert.OrganizationId,
+						OwnerId:            alert.OwnerId,
+					},
+				},
+				TaskQueue: workersconstants.DefaultTaskQueueName,
+			},
+		},
+	})
+
+	return err
+}
+
+// evaluateDatasetAlertMetric measures the metric of alert for the period containing now and checks it against the condition
+func (s *datasetService) evaluateDatasetAlertMetric(ctx context.Context, merchantId uuid.UUID, alert models.DatasetAlert, now time.Time) (models.DatasetAlertMetricResult, error) {
+	if alert.Metric.Period == "" {
+		value, err := s.getDatasetAlertMetricValue(ctx, merchantId, alert.DatasetId, alert.Metric, nil)
+		if err != nil {
+			return models.DatasetAlertMetricResult{}, err
+		}
+
+		return evaluateAlertThreshold(alert.Condition, value), nil
+	}
+
+	location, err := time.LoadLocation(alert.Timezone)
+	if err != nil {
+		return models.DatasetAlertMetricResult{}, errors.ErrInvalidTimezone
+	}
+
+	previousPeriods := 0
+	switch alert.Condition.Type {
+	case constants.DatasetAlertConditionPercentChange:
+		previousPeriods = 1
+	case constants.DatasetAlertConditionAnomaly:
+		previousPeriods = alert.Condition.BaselinePeriods
+	}
+
+	windows := getAlertWindows(now.In(location), alert.Metric.Period, previousPeriods)
+	values := make([]float64, len(windows))
+	for i, window := range windows {
+		values[i], err = s.getDatasetAlertMetricValue(ctx, merchantId, alert.DatasetId, alert.Metric, &window)
+		if err != nil {
+			return models.DatasetAlertMetricResult{}, err
+		}
+	}
+
+	switch alert.Condition.Type {
+	case constants.DatasetAlertConditionPercentChange:
+		return evaluateAlertPercentChange(alert.Condition, values[0], values[1]), nil
+	case constants.DatasetAlertConditionAnomaly:
+		return evaluateAlertAnomaly(alert.Condition, values[0], values[1:]), nil
+	default:
+		return evaluateAlertThreshold(alert.Condition, values[0]), nil
+	}
+}
+
+func (s *datasetService) getDatasetAlertMetricValue(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, metric models.DatasetAlertMetric, window *alertWindow) (float64, error) {
+	data, err := s.GetDataByDatasetId(ctx, merchantId, datasetId.String(), getDatasetAlertMetricParams(metric, window))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data.Rows) == 0 {
+		return 0, nil
+	}
+
+	return parseAlertMetricValue(data.Rows[0][constants.DatasetAlertMetricAlias])
+}
+
+// getDatasetAlertMetricParams aggregates the rows matching the filters of metric, limited to window when it is set.
+// Window bounds are compared in UTC which is how the time columns of datasets are stored.
+func getDatasetAlertMetricParams(metric models.DatasetAlertMetric, window *alertWindow) models.DatasetParams {
+	var conditions []models.Filter
+	if len(metric.Filters.Conditions) > 0 {
+		conditions = append(conditions, models.Filter{
+			LogicalOperator: defaultLogicalOperator(metric.Filters.LogicalOperator),
+			Conditions:      metric.Filters.Conditions,
+		})
+	}
+
+	if window != nil {
+		conditions = append(conditions,
+			models.Filter{
+				Column:   metric.TimeColumn,
+				Operator: querybuilderconstants.GreaterThanOrEqualOperator,
+				Value:    window.From.UTC().Format(time.DateTime),
+			},
+			models.Filter{
+				Column:   metric.TimeColumn,
+				Operator: querybuilderconstants.LessThanOperator,
+				Value:    window.To.UTC().Format(time.DateTime),
+			},
+		)
+	}
+
+	return models.DatasetParams{
+		Aggregations: []models.Aggregation{
+			{
+				Column:   metric.Column,
+				Alias:    constants.DatasetAlertMetricAlias,
+				Function: metric.Function,
+			},
+		},
+		Filters: models.FilterModel{
+			LogicalOperator: models.LogicalOperator(querybuilderconstants.LogicalOperatorAnd),
+			Conditions:      conditions,
+		},
+	}
+}
+
+// getAlertWindows returns the elapsed part of the period containing now followed by the same part of the
+// previousPeriods periods before it, so that a period in progress is compared with like for like
+func getAlertWindows(now time.Time, period constants.DatasetAlertPeriod, previousPeriods int) []alertWindow {
+	start := getAlertPeriodStart(now, period)
+	elapsed := now.Sub(start)
+
+	windows := make([]alertWindow, 0, previousPeriods+1)
+	windows = append(windows, alertWindow{From: start, To: now})
+	for i := 1; i <= previousPeriods; i++ {
+		from := shiftAlertPeriod(start, period, -i)
+		to := from.Add(elapsed)
+		if next := shiftAlertPeriod(start, period, -i+1); to.After(next) {
+			to = next
+		}
+		windows = append(windows, alertWindow{From: from, To: to})
+	}
+
+	return windows
+}
+
+func getAlertPeriodStart(t time.Time, period constants.DatasetAlertPeriod) time.Time {
+	switch period {
+	case constants.DatasetAlertPeriodHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case constants.DatasetAlertPeriodWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+	case constants.DatasetAlertPeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+}
+
+func shiftAlertPeriod(t time.Time, period constants.DatasetAlertPeriod, n int) time.Time {
+	switch period {
+	case constants.DatasetAlertPeriodHour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case constants.DatasetAlertPeriodWeek:
+		return t.AddDate(0, 0, 7*n)
+	case constants.DatasetAlertPeriodMonth:
+		return t.AddDate(0, n, 0)
+	default:
+		return t.AddDate(0, 0, n)
+	}
+}
+
+// parseAlertMetricValue reads the aggregate returned by the data platform, aggregates over no rows are zero
+func parseAlertMetricValue(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	case []byte:
+		return strconv.ParseFloat(string(v), 64)
+	default:
+		return 0, fmt.Errorf("unsupported metric value type %T", value)
+	}
+}
+
+func compareAlertValue(operator string, value float64, target float64) bool {
+	switch operator {
+	case querybuilderconstants.GreaterThanOperator:
+		return value > target
+	case querybuilderconstants.GreaterThanOrEqualOperator:
+		return value >= target
+	case querybuilderconstants.LessThanOperator:
+		return value < target
+	case querybuilderconstants.LessThanOrEqualOperator:
+		return value <= target
+	case querybuilderconstants.EqualOperator:
+		return value == target
+	case querybuilderconstants.NotEqualOperator:
+		return value != target
+	default:
+		return false
+	}
+}
+
+func evaluateAlertThreshold(condition models.DatasetAlertCondition, value float64) models.DatasetAlertMetricResult {
+	return models.DatasetAlertMetricResult{
+		Value:    value,
+		Breached: compareAlertValue(condition.Operator, value, condition.Value),
+		Message:  fmt.Sprintf("value %s %s %s", formatAlertNumber(value), alertOperatorSymbols[condition.Operator], formatAlertNumber(condition.Value)),
+	}
+}
+
+// evaluateAlertPercentChange never breaches when the previous period is zero as the change is undefined
+func evaluateAlertPercentChange(condition models.DatasetAlertCondition, value float64, previous float64) models.DatasetAlertMetricResult {
+	result := models.DatasetAlertMetricResult{
+		Value:           value,
+		ComparisonValue: &previous,
+	}
+
+	if previous == 0 {
+		result.Message = fmt.Sprintf("value %s, no change computed as the previous period is 0", formatAlertNumber(value))
+		return result
+	}
+
+	change := (value - previous) / math.Abs(previous) * 100
+	result.Score = &change
+	result.Breached = compareAlertValue(condition.Operator, change, condition.Value)
+	result.Message = fmt.Sprintf("value %s changed %.2f%% from %s, condition change %s %s%%", formatAlertNumber(value), change, formatAlertNumber(previous), alertOperatorSymbols[condition.Operator], formatAlertNumber(condition.Value))
+
+	return result
+}
+
+// evaluateAlertAnomaly breaches when the z-score of value against the baseline reaches the condition value.
+// A constant baseline has no deviation, any other value is then an anomaly.
+func evaluateAlertAnomaly(condition models.DatasetAlertCondition, value float64, baseline []float64) models.DatasetAlertMetricResult {
+	var mean float64
+	for _, v := range baseline {
+		mean += v
+	}
+	mean /= float64(len(baseline))
+
+	var variance float64
+	for _, v := range baseline {
+		variance += (v - mean) * (v - mean)
+	}
+	stddev := math.Sqrt(variance / float64(len(baseline)))
+
+	result := models.DatasetAlertMetricResult{
+		Value:           value,
+		ComparisonValue: &mean,
+	}
+
+	if stddev == 0 {
+		result.Breached = value != mean
+		result.Message = fmt.Sprintf("value %s against a constant baseline of %s", formatAlertNumber(value), formatAlertNumber(mean))
+		return result
+	}
+
+	score := (value - mean) / stddev
+	result.Score = &score
+	result.Breached = math.Abs(score) >= condition.Value
+	result.Message = fmt.Sprintf("value %s is %.2f standard deviations from the baseline mean %s, condition |z| >= %s", formatAlertNumber(value), score, formatAlertNumber(mean), formatAlertNumber(condition.Value))
+
+	return result
+}
+
+// formatAlertNumber rounds to two decimals for messages, the exact values are kept on the event
+func formatAlertNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
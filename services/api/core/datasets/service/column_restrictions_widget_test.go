@@ -0,0 +1,58 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
+	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	widgets "github.com/Zampfi/application-platform/services/api/core/widgets/service"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+)
+
+func TestEnforceColumnRestrictionsOnMonthlyChart(t *testing.T) {
+	t.Parallel()
+
+	periodicity := "month"
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{
+				DatasetID: "transactions",
+				Ref:       "revenue",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.XAxisField: {{Column: "posted_at"}},
+					widgetconstants.YAxisField: {{Column: "amount", Aggregation: "sum"}},
+				},
+			}},
+		},
+	}
+
+	queries, err := widgets.BasicChartStrategy{BaseStrategy: *widgets.NewBaseStrategy()}.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{
+		TimeColumns: map[string]string{"transactions": "posted_at"},
+		Periodicity: &periodicity,
+		Calendar:    calendar.Settings{Timezone: "America/New_York", FiscalYearStartMonth: 4},
+	})
+	require.NoError(t, err)
+	params := queries["revenue"].Params
+	require.NotEqual(t, "posted_at", params.GroupBy[0].Column)
+
+	schema := map[string]bool{"posted_at": true, "amount": true, "account_number": true, "counterparty": true}
+	restrictions := map[string]models.ColumnRestriction{
+		"account_number": {Action: storemodels.ColumnPolicyActionMask, MaskType: storemodels.ColumnMaskTypeLast4},
+		"counterparty":   {Action: storemodels.ColumnPolicyActionHide},
+	}
+
+	masks, err := service.EnforceColumnRestrictions(params, restrictions, schema)
+	assert.NoError(t, err)
+	assert.Empty(t, masks)
+
+	// the bucket of a restricted time column would read its values in the clear
+	restrictions["posted_at"] = models.ColumnRestriction{Action: storemodels.ColumnPolicyActionMask, MaskType: storemodels.ColumnMaskTypeNull}
+	_, err = service.EnforceColumnRestrictions(params, restrictions, schema)
+	assert.Error(t, err)
+}
@@ -0,0 +1,447 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"go.uber.org/zap"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	dataplatformpkgmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
+	"github.com/Zampfi/application-platform/services/api/pkg/xlsx"
+)
+
+type exportColumnKind string
+
+const (
+	exportColumnKindString    exportColumnKind = "string"
+	exportColumnKindInteger   exportColumnKind = "integer"
+	exportColumnKindNumber    exportColumnKind = "number"
+	exportColumnKindBoolean   exportColumnKind = "boolean"
+	exportColumnKindDate      exportColumnKind = "date"
+	exportColumnKindTimestamp exportColumnKind = "timestamp"
+)
+
+var exportTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// exportColumn is a result column as it is written to an export file, Name is the key in the result rows
+// and Header the display name of the column
+type exportColumn struct {
+	Name   string
+	Header string
+	Kind   exportColumnKind
+}
+
+// exportColumnKindFromDatabaseType maps the database type names of databricks and pinot to the value kind of the column,
+// unknown types are exported as text
+func exportColumnKindFromDatabaseType(databaseType string) exportColumnKind {
+	baseType := strings.ToUpper(strings.TrimSpace(databaseType))
+	if idx := strings.IndexAny(baseType, "(<"); idx >= 0 {
+		baseType = baseType[:idx]
+	}
+
+	switch baseType {
+	case "BOOLEAN", "BOOL":
+		return exportColumnKindBoolean
+	case "TINYINT", "SMALLINT", "INT", "INTEGER", "BIGINT", "LONG", "BYTE", "SHORT":
+		return exportColumnKindInteger
+	case "FLOAT", "DOUBLE", "DECIMAL", "NUMERIC", "NUMBER", "REAL", "BIG_DECIMAL":
+		return exportColumnKindNumber
+	case "DATE":
+		return exportColumnKindDate
+	case "TIMESTAMP", "TIMESTAMP_NTZ", "TIMESTAMP_LTZ", "TIMESTAMPTZ", "DATETIME":
+		return exportColumnKindTimestamp
+	default:
+		return exportColumnKindString
+	}
+}
+
+// getExportColumns resolves the headers of the result columns from the aliases in the dataset display config.
+// The export still goes through with the raw column names when the display config cannot be read.
+func (s *datasetService) getExportColumns(ctx context.Context, datasetId string, columns []dataplatformpkgmodels.ColumnMetadata) []exportColumn {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	aliases := map[string]string{}
+	dataset, err := s.datasetStore.GetDatasetById(ctx, datasetId)
+	if err != nil {
+		logger.Warn("failed to get dataset for export headers", zap.String("dataset_id", datasetId), zap.Error(err))
+	} else {
+		var metadata models.DatasetMetadataConfig
+		if err := json.Unmarshal([]byte(dataset.Metadata), &metadata); err != nil {
+			logger.Warn("failed to unmarshal dataset metadata for export headers", zap.String("dataset_id", datasetId), zap.Error(err))
+		}
+		for _, displayConfig := range metadata.DisplayConfig {
+			if displayConfig.Alias != nil && strings.TrimSpace(*displayConfig.Alias) != "" {
+				aliases[displayConfig.Column] = strings.TrimSpace(*displayConfig.Alias)
+			}
+		}
+	}
+
+	return buildExportColumns(columns, aliases)
+}
+
+// buildExportColumns falls back to the column name when an alias is missing or already taken by another column,
+// headers have to be unique to be usable as jsonl keys and parquet fields
+func buildExportColumns(columns []dataplatformpkgmodels.ColumnMetadata, aliases map[string]string) []exportColumn {
+	exportColumns := make([]exportColumn, 0, len(columns))
+	usedHeaders := make(map[string]bool, len(columns))
+
+	for _, column := range columns {
+		header := column.Name
+		if alias, ok := aliases[column.Name]; ok && !usedHeaders[alias] {
+			header = alias
+		}
+		if usedHeaders[header] {
+			header = column.Name
+		}
+		for suffix := 2; usedHeaders[header]; suffix++ {
+			header = fmt.Sprintf("%s_%d", column.Name, suffix)
+		}
+		usedHeaders[header] = true
+
+		exportColumns = append(exportColumns, exportColumn{
+			Name:   column.Name,
+			Header: header,
+			Kind:   exportColumnKindFromDatabaseType(column.DatabaseType),
+		})
+	}
+
+	return exportColumns
+}
+
+func createExportFile(format datasetConstants.ExportFormat, columns []exportColumn, rows dataplatformpkgmodels.Rows) (*bytes.Buffer, error) {
+	switch format {
+	case datasetConstants.ExportFormatCSV, "":
+		return createCSVExport(columns, rows)
+	case datasetConstants.ExportFormatJSONL:
+		return createJSONLExport(columns, rows)
+	case datasetConstants.ExportFormatXLSX:
+		return createXLSXExport(columns, rows)
+	case datasetConstants.ExportFormatParquet:
+		return createParquetExport(columns, rows)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+func createCSVExport(columns []exportColumn, rows dataplatformpkgmodels.Rows) (*bytes.Buffer, error) {
+	var csvData bytes.Buffer
+	writer := csv.NewWriter(&csvData)
+
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.Header
+	}
+	if err := writer.Write(headers); err != nil {
+		return nil, fmt.Errorf("failed to write CSV headers: %w", err)
+	}
+
+	for _, row := range rows {
+		rowData := make([]string, len(columns))
+		for i, column := range columns {
+			if val, ok := row[column.Name]; ok && val != nil {
+				rowData[i] = fmt.Sprintf("%v", val)
+			}
+		}
+		if err := writer.Write(rowData); err != nil {
+			return nil, fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("error flushing CSV writer: %w", err)
+	}
+
+	return &csvData, nil
+}
+
+// createJSONLExport writes one object per row, keys are written by hand to keep the column order of the result
+func createJSONLExport(columns []exportColumn, rows dataplatformpkgmodels.Rows) (*bytes.Buffer, error) {
+	var jsonlData bytes.Buffer
+
+	keys := make([][]byte, len(columns))
+	for i, column := range columns {
+		key, err := json.Marshal(column.Header)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	for _, row := range rows {
+		jsonlData.WriteByte('{')
+		for i, column := range columns {
+			if i > 0 {
+				jsonlData.WriteByte(',')
+			}
+			value, err := json.Marshal(typedExportValue(column, row[column.Name]))
+			if err != nil {
+				return nil, fmt.Errorf("failed to write value of column %s: %w", column.Name, err)
+			}
+			jsonlData.Write(keys[i])
+			jsonlData.WriteByte(':')
+			jsonlData.Write(value)
+		}
+		jsonlData.WriteString("}\n")
+	}
+
+	return &jsonlData, nil
+}
+
+func createXLSXExport(columns []exportColumn, rows dataplatformpkgmodels.Rows) (*bytes.Buffer, error) {
+	sheet := xlsx.Sheet{
+		Name:   "Export",
+		Header: make([]string, len(columns)),
+		Rows:   make([][]interface{}, len(rows)),
+	}
+	for i, column := range columns {
+		sheet.Header[i] = column.Header
+	}
+	for i, row := range rows {
+		values := make([]interface{}, len(columns))
+		for j, column := range columns {
+			values[j] = typedExportValue(column, row[column.Name])
+		}
+		sheet.Rows[i] = values
+	}
+
+	var xlsxData bytes.Buffer
+	if err := xlsx.Write(&xlsxData, sheet); err != nil {
+		return nil, fmt.Errorf("failed to write XLSX: %w", err)
+	}
+
+	return &xlsxData, nil
+}
+
+func createParquetExport(columns []exportColumn, rows dataplatformpkgmodels.Rows) (*bytes.Buffer, error) {
+	fields := make([]arrow.Field, len(columns))
+	for i, column := range columns {
+		fields[i] = arrow.Field{Name: column.Header, Type: parquetExportType(column.Kind), Nullable: true}
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+
+	for _, row := range rows {
+		for i, column := range columns {
+			if err := appendParquetValue(builder.Field(i), column, row[column.Name]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	record := builder.NewRecord()
+	defer record.Release()
+
+	var parquetData bytes.Buffer
+	writer, err := pqarrow.NewFileWriter(schema, &parquetData, parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy)), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create parquet writer: %w", err)
+	}
+	if err := writer.Write(record); err != nil {
+		return nil, fmt.Errorf("failed to write parquet record: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close parquet writer: %w", err)
+	}
+
+	return &parquetData, nil
+}
+
+func parquetExportType(kind exportColumnKind) arrow.DataType {
+	switch kind {
+	case exportColumnKindInteger:
+		return arrow.PrimitiveTypes.Int64
+	case exportColumnKindNumber:
+		return arrow.PrimitiveTypes.Float64
+	case exportColumnKindBoolean:
+		return arrow.FixedWidthTypes.Boolean
+	case exportColumnKindDate:
+		return arrow.FixedWidthTypes.Date32
+	case exportColumnKindTimestamp:
+		return arrow.FixedWidthTypes.Timestamp_us
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+func appendParquetValue(builder array.Builder, column exportColumn, value interface{}) error {
+	if value == nil {
+		builder.AppendNull()
+		return nil
+	}
+
+	ok := true
+	switch b := builder.(type) {
+	case *array.Int64Builder:
+		var v int64
+		if v, ok = toExportInt(value); ok {
+			b.Append(v)
+		}
+	case *array.Float64Builder:
+		var v float64
+		if v, ok = toExportFloat(value); ok {
+			b.Append(v)
+		}
+	case *array.BooleanBuilder:
+		var v bool
+		if v, ok = toExportBool(value); ok {
+			b.Append(v)
+		}
+	case *array.Date32Builder:
+		var v time.Time
+		if v, ok = toExportTime(value); ok {
+			b.Append(arrow.Date32FromTime(v))
+		}
+	case *array.TimestampBuilder:
+		var v time.Time
+		if v, ok = toExportTime(value); ok {
+			b.Append(arrow.Timestamp(v.UnixMicro()))
+		}
+	case *array.StringBuilder:
+		b.Append(fmt.Sprintf("%v", value))
+	default:
+		return fmt.Errorf("unsupported parquet builder %T for column %s", builder, column.Name)
+	}
+
+	if !ok {
+		return fmt.Errorf("failed to convert value %v of column %s to %s", value, column.Name, column.Kind)
+	}
+	return nil
+}
+
+// typedExportValue converts value to the kind of the column for the typed formats, values that do not convert
+// are kept as they are rather than dropped
+func typedExportValue(column exportColumn, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	switch column.Kind {
+	case exportColumnKindInteger:
+		if v, ok := toExportInt(value); ok {
+			return v
+		}
+	case exportColumnKindNumber:
+		if v, ok := toExportFloat(value); ok {
+			return v
+		}
+	case exportColumnKindBoolean:
+		if v, ok := toExportBool(value); ok {
+			return v
+		}
+	case exportColumnKindDate, exportColumnKindTimestamp:
+		if v, ok := toExportTime(value); ok {
+			return v
+		}
+	}
+
+	return value
+}
+
+func toExportInt(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v), true
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, true
+		}
+	case string:
+		if i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func toExportFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f, true
+		}
+	case string:
+		if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return f, true
+		}
+	default:
+		if i, ok := toExportInt(value); ok {
+			return float64(i), true
+		}
+	}
+	return 0, false
+}
+
+func toExportBool(value interface{}) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+			return b, true
+		}
+	}
+	return false, false
+}
+
+func toExportTime(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v != nil {
+			return *v, true
+		}
+	case string:
+		for _, layout := range exportTimeLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
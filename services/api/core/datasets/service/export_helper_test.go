@@ -0,0 +1,165 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	dataplatformpkgmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
+)
+
+func TestExportColumnKindFromDatabaseType(t *testing.T) {
+	tests := map[string]exportColumnKind{
+		"BIGINT":        exportColumnKindInteger,
+		"int":           exportColumnKindInteger,
+		"DECIMAL(18,2)": exportColumnKindNumber,
+		"DOUBLE":        exportColumnKindNumber,
+		"BOOLEAN":       exportColumnKindBoolean,
+		"DATE":          exportColumnKindDate,
+		"TIMESTAMP_NTZ": exportColumnKindTimestamp,
+		"STRING":        exportColumnKindString,
+		"ARRAY<STRING>": exportColumnKindString,
+		"":              exportColumnKindString,
+	}
+
+	for databaseType, expected := range tests {
+		assert.Equal(t, expected, exportColumnKindFromDatabaseType(databaseType), databaseType)
+	}
+}
+
+func TestBuildExportColumns(t *testing.T) {
+	columns := []dataplatformpkgmodels.ColumnMetadata{
+		{Name: "amount", DatabaseType: "DECIMAL(18,2)"},
+		{Name: "fee", DatabaseType: "DECIMAL(18,2)"},
+		{Name: "Amount"},
+	}
+	aliases := map[string]string{
+		"amount": "Amount",
+		"fee":    "Amount",
+	}
+
+	exportColumns := buildExportColumns(columns, aliases)
+
+	assert.Equal(t, []exportColumn{
+		{Name: "amount", Header: "Amount", Kind: exportColumnKindNumber},
+		{Name: "fee", Header: "fee", Kind: exportColumnKindNumber},
+		{Name: "Amount", Header: "Amount_2", Kind: exportColumnKindString},
+	}, exportColumns)
+}
+
+func TestCreateParquetExport(t *testing.T) {
+	columns := []exportColumn{
+		{Name: "id", Header: "Id", Kind: exportColumnKindInteger},
+		{Name: "amount", Header: "Amount", Kind: exportColumnKindNumber},
+		{Name: "settled", Header: "Settled", Kind: exportColumnKindBoolean},
+		{Name: "paid_at", Header: "Paid at", Kind: exportColumnKindTimestamp},
+		{Name: "note", Header: "Note", Kind: exportColumnKindString},
+	}
+	rows := dataplatformpkgmodels.Rows{
+		{"id": int64(1), "amount": "12.50", "settled": true, "paid_at": "2025-03-01T10:00:00Z", "note": "first"},
+		{"id": int64(2), "amount": nil, "settled": "false", "paid_at": time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), "note": nil},
+	}
+
+	data, err := createExportFile(datasetConstants.ExportFormatParquet, columns, rows)
+	require.NoError(t, err)
+
+	reader, err := file.NewParquetReader(bytes.NewReader(data.Bytes()))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+
+	table, err := fileReader.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+
+	schema := table.Schema()
+	assert.Equal(t, int64(2), table.NumRows())
+	assert.Equal(t, "Id", schema.Field(0).Name)
+	assert.Equal(t, arrow.INT64, schema.Field(0).Type.ID())
+	assert.Equal(t, arrow.FLOAT64, schema.Field(1).Type.ID())
+	assert.Equal(t, arrow.BOOL, schema.Field(2).Type.ID())
+	assert.Equal(t, arrow.TIMESTAMP, schema.Field(3).Type.ID())
+	assert.Equal(t, arrow.STRING, schema.Field(4).Type.ID())
+
+	amounts := table.Column(1).Data().Chunk(0).(*array.Float64)
+	assert.Equal(t, 12.5, amounts.Value(0))
+	assert.True(t, amounts.IsNull(1))
+}
+
+func TestCreateParquetExportOfMaskedColumns(t *testing.T) {
+	result := dataplatformpkgmodels.QueryResult{
+		Columns: []dataplatformpkgmodels.ColumnMetadata{
+			{Name: "account", DatabaseType: "BIGINT"},
+			{Name: "amount", DatabaseType: "DECIMAL(18,2)"},
+			{Name: "paid_on", DatabaseType: "DATE"},
+			{Name: "fee", DatabaseType: "DOUBLE"},
+		},
+		Rows: dataplatformpkgmodels.Rows{
+			{"account": int64(123456789), "amount": "12.50", "paid_on": "2025-03-01", "fee": 1.5},
+		},
+	}
+
+	s := &datasetService{}
+	masked := s.maskQueryResult("dataset", result, map[string]storemodels.ColumnMaskType{
+		"account": storemodels.ColumnMaskTypeLast4,
+		"paid_on": storemodels.ColumnMaskTypeHash,
+		"fee":     storemodels.ColumnMaskTypeNull,
+	})
+
+	data, err := createExportFile(datasetConstants.ExportFormatParquet, buildExportColumns(masked.Columns, nil), masked.Rows)
+	require.NoError(t, err)
+
+	reader, err := file.NewParquetReader(bytes.NewReader(data.Bytes()))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+
+	table, err := fileReader.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+
+	schema := table.Schema()
+	assert.Equal(t, arrow.STRING, schema.Field(0).Type.ID())
+	assert.Equal(t, arrow.FLOAT64, schema.Field(1).Type.ID())
+	assert.Equal(t, arrow.STRING, schema.Field(2).Type.ID())
+	assert.Equal(t, arrow.FLOAT64, schema.Field(3).Type.ID())
+
+	accounts := table.Column(0).Data().Chunk(0).(*array.String)
+	assert.Equal(t, "****6789", accounts.Value(0))
+	assert.True(t, table.Column(3).Data().Chunk(0).IsNull(0))
+}
+
+func TestCreateParquetExport_InvalidValue(t *testing.T) {
+	columns := []exportColumn{{Name: "amount", Header: "Amount", Kind: exportColumnKindNumber}}
+	rows := dataplatformpkgmodels.Rows{{"amount": "n/a"}}
+
+	_, err := createExportFile(datasetConstants.ExportFormatParquet, columns, rows)
+	assert.ErrorContains(t, err, "failed to convert value n/a of column amount to number")
+}
+
+func TestCreateCSVExport(t *testing.T) {
+	columns := []exportColumn{
+		{Name: "amount", Header: "Amount", Kind: exportColumnKindNumber},
+		{Name: "note", Header: "note", Kind: exportColumnKindString},
+	}
+	rows := dataplatformpkgmodels.Rows{{"amount": 10.5, "note": nil}}
+
+	data, err := createExportFile(datasetConstants.ExportFormatCSV, columns, rows)
+	require.NoError(t, err)
+	assert.Equal(t, "Amount,note\n10.5,\n", data.String())
+}
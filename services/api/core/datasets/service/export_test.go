@@ -0,0 +1,11 @@
+package service
+
+import (
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+)
+
+// EnforceColumnRestrictions checks params built outside of the package against the restrictions of a user
+func EnforceColumnRestrictions(params models.DatasetParams, restrictions map[string]models.ColumnRestriction, sourceColumns map[string]bool) (map[string]storemodels.ColumnMaskType, error) {
+	return (&datasetService{}).enforceColumnRestrictions(params, restrictions, sourceColumns)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	dataplatformconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
+	dataplatformdataconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
+	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// fxAmountGroup is an amount column that can be converted to another currency. Precomputed groups read the converted
+// amounts from the fx column of the dataset, the others are converted with the rates of the organization.
+type fxAmountGroup struct {
+	amountColumn   string
+	currencyColumn string
+	dateColumn     string
+	precomputed    bool
+}
+
+// getFxAmountGroups returns the amount columns of the currency groups of the dataset. Without a date column in the
+// group the only date time column of the dataset is used, if there is exactly one.
+func getFxAmountGroups(datasetConfig dataplatformDataModels.DatasetConfig, columnDatatypes map[string]dataplatformdataconstants.Datatype) []fxAmountGroup {
+	var dateColumns []string
+	for columnName, columnConfig := range datasetConfig.Columns {
+		if columnConfig.CustomType == dataplatformconstants.DatabricksColumnCustomTypeDateTime {
+			dateColumns = append(dateColumns, columnName)
+		}
+	}
+
+	var groups []fxAmountGroup
+	for _, customColumnGroup := range datasetConfig.CustomColumnGroups {
+		if customColumnGroup.Type != datasetConstants.CustomColumnGroupTypeFX && customColumnGroup.Type != datasetConstants.CustomColumnGroupTypeCurrencyAmount {
+			continue
+		}
+
+		group := fxAmountGroup{
+			amountColumn:   customColumnGroup.Config[datasetConstants.AmountColumn],
+			currencyColumn: customColumnGroup.Config[datasetConstants.CurrencyColumn],
+			dateColumn:     customColumnGroup.Config[datasetConstants.DateColumn],
+		}
+		if group.amountColumn == "" || group.currencyColumn == "" {
+			continue
+		}
+		if group.dateColumn == "" && len(dateColumns) == 1 {
+			group.dateColumn = dateColumns[0]
+		}
+		_, group.precomputed = columnDatatypes[datasetConstants.ZampFxColumnPrefix+group.amountColumn]
+
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// fxRateJoin converts the amounts without a precomputed fx column to currency with the rates of the organization
+// joined from the warehouse rates table
+type fxRateJoin struct {
+	ratesTable     string
+	organizationId uuid.UUID
+	currency       string
+}
+
+// getFxRateJoin returns the join converting the amounts of the dataset without a precomputed fx column, nil when the
+// query converts none of them. They are converted by the level of the query reading the dataset, to the currency of
+// the innermost level asking for one, the levels above read the converted amounts.
+func (s *datasetService) getFxRateJoin(ctx context.Context, merchantId uuid.UUID, params models.DatasetParams, datasetConfig dataplatformDataModels.DatasetConfig, columnDatatypes map[string]dataplatformdataconstants.Datatype) (*fxRateJoin, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	var currency *string
+	for current := &params; current != nil; current = current.Subquery {
+		if current.FxCurrency != nil {
+			currency = current.FxCurrency
+		}
+	}
+	if currency == nil {
+		return nil, nil
+	}
+
+	precomputed := true
+	for _, group := range getFxAmountGroups(datasetConfig, columnDatatypes) {
+		precomputed = precomputed && group.precomputed
+	}
+	if precomputed {
+		return nil, nil
+	}
+
+	if s.serverDatasetConfig.FxRatesTable == "" {
+		logger.Error("no fx rates table to convert the amounts with", zap.String("currency", *currency))
+		return nil, errors.ErrFxRatesTableNotConfigured
+	}
+
+	return &fxRateJoin{
+		ratesTable:     s.serverDatasetConfig.FxRatesTable,
+		organizationId: merchantId,
+		currency:       strings.ToUpper(strings.TrimSpace(*currency)),
+	}, nil
+}
+
+// getFxRateJoins returns the joins of the rates the fx columns of a level of the query read, ordered by their alias
+func getFxRateJoins(customColumnConfig map[string]querybuildermodels.CustomDataTypeConfig) []querybuildermodels.JoinConfig {
+	var fxConfigs []*querybuildermodels.FxRateAmountCustomTypeConfig
+	for _, columnConfig := range customColumnConfig {
+		if fxConfig, ok := columnConfig.Config.(*querybuildermodels.FxRateAmountCustomTypeConfig); ok {
+			fxConfigs = append(fxConfigs, fxConfig)
+		}
+	}
+	sort.Slice(fxConfigs, func(i, j int) bool {
+		return fxConfigs[i].RatesAlias < fxConfigs[j].RatesAlias
+	})
+
+	var joins []querybuildermodels.JoinConfig
+	for _, fxConfig := range fxConfigs {
+		joins = append(joins, fxConfig)
+	}
+	return joins
+}
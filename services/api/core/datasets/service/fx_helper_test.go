@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	dataplatformconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
+	dataplatformdataconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
+	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	datasetErrors "github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func fxTestDatasetConfig() dataplatformDataModels.DatasetConfig {
+	return dataplatformDataModels.DatasetConfig{
+		Columns: map[string]dataplatformDataModels.DatasetColumnConfig{
+			"amount":           {CustomType: dataplatformconstants.DatabricksColumnCustomTypeAmount},
+			"fee":              {CustomType: dataplatformconstants.DatabricksColumnCustomTypeAmount},
+			"transaction_date": {CustomType: dataplatformconstants.DatabricksColumnCustomTypeDateTime},
+		},
+		CustomColumnGroups: []dataplatformDataModels.CustomColumnGroup{
+			{
+				Type: datasetConstants.CustomColumnGroupTypeFX,
+				Config: map[string]string{
+					datasetConstants.AmountColumn:   "amount",
+					datasetConstants.CurrencyColumn: "currency",
+				},
+			},
+			{
+				Type: datasetConstants.CustomColumnGroupTypeCurrencyAmount,
+				Config: map[string]string{
+					datasetConstants.AmountColumn:   "fee",
+					datasetConstants.CurrencyColumn: "fee_currency",
+					datasetConstants.DateColumn:     "settled_at",
+				},
+			},
+			{
+				Type:   datasetConstants.CustomColumnGroupTypeTags,
+				Config: map[string]string{"tags_column": "tags"},
+			},
+		},
+	}
+}
+
+func TestGetFxAmountGroups(t *testing.T) {
+	columnDatatypes := map[string]dataplatformdataconstants.Datatype{
+		"amount": dataplatformdataconstants.DoubleDataType,
+		"fee":    dataplatformdataconstants.DoubleDataType,
+		datasetConstants.ZampFxColumnPrefix + "amount": dataplatformdataconstants.StringDataType,
+	}
+
+	groups := getFxAmountGroups(fxTestDatasetConfig(), columnDatatypes)
+
+	assert.Equal(t, []fxAmountGroup{
+		{amountColumn: "amount", currencyColumn: "currency", dateColumn: "transaction_date", precomputed: true},
+		{amountColumn: "fee", currencyColumn: "fee_currency", dateColumn: "settled_at"},
+	}, groups)
+}
+
+func TestGetCustomColumnConfig_FxRates(t *testing.T) {
+	columnDatatypes := map[string]dataplatformdataconstants.Datatype{
+		"amount": dataplatformdataconstants.DoubleDataType,
+		"fee":    dataplatformdataconstants.DoubleDataType,
+		datasetConstants.ZampFxColumnPrefix + "amount": dataplatformdataconstants.StringDataType,
+	}
+	fxRates := &fxRateJoin{ratesTable: "`zamp`.`platform`.`fx_rates`", organizationId: uuid.New(), currency: "EUR"}
+	fxCurrency := "eur"
+
+	s := &datasetService{}
+
+	assert.Empty(t, s.getCustomColumnConfig(fxTestDatasetConfig(), models.DatasetParams{}, columnDatatypes, nil))
+
+	config := s.getCustomColumnConfig(fxTestDatasetConfig(), models.DatasetParams{FxCurrency: &fxCurrency}, columnDatatypes, fxRates)
+
+	assert.Equal(t, &querybuildermodels.AmountCustomTypeConfig{
+		CurrencyColumn: "currency",
+		FxCurrency:     "eur",
+		AmountColumn:   "amount",
+	}, config["amount"].Config)
+	assert.Equal(t, &querybuildermodels.FxRateAmountCustomTypeConfig{
+		CurrencyColumn: "fee_currency",
+		FxCurrency:     "EUR",
+		AmountColumn:   "fee",
+		DateColumn:     "settled_at",
+		RatesTable:     "`zamp`.`platform`.`fx_rates`",
+		RatesAlias:     datasetConstants.ZampFxRatesJoinPrefix + "1",
+		OrganizationId: fxRates.organizationId.String(),
+	}, config["fee"].Config)
+	assert.Equal(t, []querybuildermodels.JoinConfig{config["fee"].Config.(querybuildermodels.JoinConfig)}, getFxRateJoins(config))
+
+	// the levels above the one reading the dataset read the converted amounts
+	outer := s.getCustomColumnConfig(fxTestDatasetConfig(), models.DatasetParams{FxCurrency: &fxCurrency, Subquery: &models.DatasetParams{}}, columnDatatypes, fxRates)
+	assert.NotContains(t, outer, "fee")
+	assert.Nil(t, getFxRateJoins(outer))
+}
+
+func TestGetFxRateJoin(t *testing.T) {
+	eur := "eur"
+	gbp := "GBP"
+	merchantId := uuid.New()
+	ratesTable := "`zamp`.`platform`.`fx_rates`"
+
+	tests := []struct {
+		name            string
+		params          models.DatasetParams
+		columnDatatypes map[string]dataplatformdataconstants.Datatype
+		ratesTable      string
+		want            *fxRateJoin
+		wantErr         error
+	}{
+		{
+			name:       "amounts are converted to the currency of the innermost level",
+			params:     models.DatasetParams{FxCurrency: &gbp, Subquery: &models.DatasetParams{FxCurrency: &eur, Subquery: &models.DatasetParams{}}},
+			ratesTable: ratesTable,
+			want:       &fxRateJoin{ratesTable: ratesTable, organizationId: merchantId, currency: "EUR"},
+		},
+		{
+			name:       "no currency asked for",
+			params:     models.DatasetParams{Subquery: &models.DatasetParams{}},
+			ratesTable: ratesTable,
+		},
+		{
+			name:   "rates are not joined when every amount has a precomputed fx column",
+			params: models.DatasetParams{FxCurrency: &gbp},
+			columnDatatypes: map[string]dataplatformdataconstants.Datatype{
+				datasetConstants.ZampFxColumnPrefix + "amount": dataplatformdataconstants.StringDataType,
+				datasetConstants.ZampFxColumnPrefix + "fee":    dataplatformdataconstants.StringDataType,
+			},
+		},
+		{
+			name:    "no rates table",
+			params:  models.DatasetParams{FxCurrency: &gbp},
+			wantErr: datasetErrors.ErrFxRatesTableNotConfigured,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			s := &datasetService{serverDatasetConfig: serverconfig.DatasetConfig{FxRatesTable: tt.ratesTable}}
+			got, err := s.getFxRateJoin(context.Background(), merchantId, tt.params, fxTestDatasetConfig(), tt.columnDatatypes)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	dataplatformconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	referencedatamodels "github.com/Zampfi/application-platform/services/api/core/referencedata/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// referenceDataKinds maps the custom types of dataset columns holding reference data to the kind of data they hold
+var referenceDataKinds = map[dataplatformconstants.DatabricksColumnCustomType]referencedata.Kind{
+	dataplatformconstants.DatabricksColumnCustomTypeCountry:  referencedata.KindCountry,
+	dataplatformconstants.DatabricksColumnCustomTypeCurrency: referencedata.KindCurrency,
+	dataplatformconstants.DatabricksColumnCustomTypeBank:     referencedata.KindBank,
+}
+
+func (s *datasetService) getOrganizationBanks(ctx context.Context) ([]referencedata.Bank, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeBanks, err := s.datasetStore.GetReferenceBanks(ctx)
+	if err != nil {
+		logger.Error("failed to get reference banks", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	return referencedatamodels.ToDirectoryBanks(storeBanks), nil
+}
+
+// getFilterCustomType reads the custom type of a filter, display configs store it as a string pointer while dataset
+// columns use the custom type
+func getFilterCustomType(metadata map[string]interface{}) (dataplatformconstants.DatabricksColumnCustomType, bool) {
+	switch value := metadata[datasetConstants.MetadataConfigCustomType].(type) {
+	case dataplatformconstants.DatabricksColumnCustomType:
+		return value, true
+	case string:
+		return dataplatformconstants.DatabricksColumnCustomType(value), true
+	case *string:
+		if value == nil {
+			return "", false
+		}
+		return dataplatformconstants.DatabricksColumnCustomType(*value), true
+	default:
+		return "", false
+	}
+}
+
+func getReferenceDataKind(metadata map[string]interface{}) (referencedata.Kind, bool) {
+	customType, ok := getFilterCustomType(metadata)
+	if !ok {
+		return "", false
+	}
+
+	kind, ok := referenceDataKinds[customType]
+	return kind, ok
+}
+
+// populateOptionLabels adds the display names of the options of country, currency and bank filters to their metadata,
+// the banks of the organization are only loaded when a bank filter has options
+func (s *datasetService) populateOptionLabels(ctx context.Context, filterConfigs []models.FilterConfig) error {
+	filterKinds := map[int]referencedata.Kind{}
+	hasBankFilter := false
+	for i, config := range filterConfigs {
+		if kind, ok := getReferenceDataKind(config.Metadata); ok && len(config.Options) > 0 {
+			filterKinds[i] = kind
+			hasBankFilter = hasBankFilter || kind == referencedata.KindBank
+		}
+	}
+	if len(filterKinds) == 0 {
+		return nil
+	}
+
+	var organizationBanks []referencedata.Bank
+	if hasBankFilter {
+		var err error
+		if organizationBanks, err = s.getOrganizationBanks(ctx); err != nil {
+			return err
+		}
+	}
+	directory := referencedata.NewDirectory(organizationBanks)
+
+	for i, kind := range filterKinds {
+		labels := make(map[string]string, len(filterConfigs[i].Options))
+		for _, option := range filterConfigs[i].Options {
+			value, ok := option.(string)
+			if !ok {
+				continue
+			}
+			if name, ok := directory.DisplayName(kind, value); ok {
+				labels[value] = name
+			}
+		}
+
+		if len(labels) > 0 {
+			filterConfigs[i].Metadata[datasetConstants.MetadataConfigOptionLabels] = labels
+		}
+	}
+
+	return nil
+}
+
+// ValidateFileImportPreview checks the values of the country, currency and bank columns of the preview of an imported
+// file, it returns the issues found as messages users can act on
+func (s *datasetService) ValidateFileImportPreview(ctx context.Context, datasetId uuid.UUID, preview []map[string]interface{}) ([]string, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	datasetMetaInfo, err := s.datasetStore.GetDatasetById(ctx, datasetId.String())
+	if err != nil {
+		logger.Error("failed to get dataset meta info", zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetDatasetById
+	}
+
+	var datasetMetaData models.DatasetMetadataConfig
+	if err := json.Unmarshal([]byte(datasetMetaInfo.Metadata), &datasetMetaData); err != nil {
+		logger.Error("failed to unmarshal dataset metadata", zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToUnmarshalMetadata
+	}
+
+	referenceColumns := map[string]referencedata.Kind{}
+	hasBankColumn := false
+	for columnName, column := range datasetMetaData.Columns {
+		if kind, ok := referenceDataKinds[column.CustomType]; ok {
+			referenceColumns[columnName] = kind
+			hasBankColumn = hasBankColumn || kind == referencedata.KindBank
+		}
+	}
+	if len(referenceColumns) == 0 {
+		return nil, nil
+	}
+
+	var organizationBanks []referencedata.Bank
+	if hasBankColumn {
+		if organizationBanks, err = s.getOrganizationBanks(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return validateReferenceDataRows(referencedata.NewDirectory(organizationBanks), referenceColumns, preview), nil
+}
+
+// validateReferenceDataRows reports the values of the reference columns that are not stored codes, empty values are
+// left to the nullability of the column
+func validateReferenceDataRows(directory *referencedata.Directory, referenceColumns map[string]referencedata.Kind, rows []map[string]interface{}) []string {
+	columnNames := make([]string, 0, len(referenceColumns))
+	for columnName := range referenceColumns {
+		columnNames = append(columnNames, columnName)
+	}
+	sort.Strings(columnNames)
+
+	var issues []string
+	for i, row := range rows {
+		for _, columnName := range columnNames {
+			kind := referenceColumns[columnName]
+			value, ok := row[columnName]
+			if !ok || value == nil || value == "" {
+				continue
+			}
+
+			if code, ok := value.(string); ok && directory.Valid(kind, code) {
+				continue
+			}
+
+			issues = append(issues, fmt.Sprintf("row %d: %v is not a valid %s for column %s", i+1, value, kind, columnName))
+			if len(issues) >= datasetConstants.FileImportMaxValidationErrors {
+				return issues
+			}
+		}
+	}
+	return issues
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dataplatformconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mockDatasetService "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPopulateOptionLabels(t *testing.T) {
+	bankType := "bank"
+
+	tests := []struct {
+		name          string
+		filterConfigs []models.FilterConfig
+		mockSetup     func(*mockDatasetService.MockDatasetServiceStore)
+		want          []map[string]string
+		wantErr       bool
+	}{
+		{
+			name: "labels countries and currencies without loading banks",
+			filterConfigs: []models.FilterConfig{
+				{Column: "country", Options: []interface{}{"IND", "XYZ"}, Metadata: map[string]interface{}{datasetConstants.MetadataConfigCustomType: dataplatformconstants.DatabricksColumnCustomTypeCountry}},
+				{Column: "currency", Options: []interface{}{"EUR", nil}, Metadata: map[string]interface{}{datasetConstants.MetadataConfigCustomType: dataplatformconstants.DatabricksColumnCustomTypeCurrency}},
+				{Column: "status", Options: []interface{}{"open"}, Metadata: map[string]interface{}{}},
+			},
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {},
+			want:      []map[string]string{{"IND": "India"}, {"EUR": "Euro"}, nil},
+		},
+		{
+			name: "labels banks of the organization from display config types",
+			filterConfigs: []models.FilterConfig{
+				{Column: "bank", Options: []interface{}{"CRB", "ACME"}, Metadata: map[string]interface{}{datasetConstants.MetadataConfigCustomType: &bankType}},
+			},
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetReferenceBanks(mock.Anything).Return([]storemodels.ReferenceBank{{Code: "ACME", Name: "Acme Bank"}}, nil)
+			},
+			want: []map[string]string{{"CRB": "Cross River Bank", "ACME": "Acme Bank"}},
+		},
+		{
+			name: "store error",
+			filterConfigs: []models.FilterConfig{
+				{Column: "bank", Options: []interface{}{"CRB"}, Metadata: map[string]interface{}{datasetConstants.MetadataConfigCustomType: dataplatformconstants.DatabricksColumnCustomTypeBank}},
+			},
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetReferenceBanks(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			tt.mockSetup(mockStore)
+
+			s := &datasetService{datasetStore: mockStore}
+			err := s.populateOptionLabels(context.Background(), tt.filterConfigs)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			for i, want := range tt.want {
+				labels, ok := tt.filterConfigs[i].Metadata[datasetConstants.MetadataConfigOptionLabels]
+				if want == nil {
+					assert.False(t, ok)
+					continue
+				}
+				assert.Equal(t, want, labels)
+			}
+		})
+	}
+}
+
+func TestValidateFileImportPreview(t *testing.T) {
+	datasetId := uuid.New()
+	metadata := `{"columns": {"country": {"custom_type": "country"}, "bank": {"custom_type": "bank"}, "amount": {"custom_type": "amount"}}}`
+
+	mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+	mockStore.EXPECT().GetDatasetById(mock.Anything, datasetId.String()).Return(&storemodels.Dataset{Metadata: []byte(metadata)}, nil)
+	mockStore.EXPECT().GetReferenceBanks(mock.Anything).Return([]storemodels.ReferenceBank{{Code: "ACME", Name: "Acme Bank"}}, nil)
+
+	s := &datasetService{datasetStore: mockStore}
+	issues, err := s.ValidateFileImportPreview(context.Background(), datasetId, []map[string]interface{}{
+		{"country": "IND", "bank": "ACME", "amount": "abc"},
+		{"country": "India", "bank": "crb", "amount": 10},
+		{"country": nil, "bank": "", "amount": 10},
+		{"country": 356, "bank": "HDFC"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"row 2: crb is not a valid bank for column bank",
+		"row 2: India is not a valid country for column country",
+		"row 4: 356 is not a valid country for column country",
+	}, issues)
+}
+
+func TestValidateFileImportPreviewWithoutReferenceColumns(t *testing.T) {
+	datasetId := uuid.New()
+
+	mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+	mockStore.EXPECT().GetDatasetById(mock.Anything, datasetId.String()).Return(&storemodels.Dataset{Metadata: []byte(`{"columns": {"amount": {"custom_type": "amount"}}}`)}, nil)
+
+	s := &datasetService{datasetStore: mockStore}
+	issues, err := s.ValidateFileImportPreview(context.Background(), datasetId, []map[string]interface{}{{"amount": "abc"}})
+
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+}
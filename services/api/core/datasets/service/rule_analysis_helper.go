@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+)
+
+// getFilterConjuncts flattens a filter model into the conditions all of which a row has to match,
+// false when the model has alternatives and so cannot be reasoned about statically
+func getFilterConjuncts(filters models.FilterModel) ([]models.Filter, bool) {
+	if len(filters.Conditions) > 1 && !isAndOperator(filters.LogicalOperator) {
+		return nil, false
+	}
+
+	var conjuncts []models.Filter
+	for _, condition := range filters.Conditions {
+		if condition.Operator != "" {
+			if len(condition.Conditions) > 0 && (condition.LogicalOperator == nil || !isAndOperator(*condition.LogicalOperator)) {
+				return nil, false
+			}
+			conjuncts = append(conjuncts, models.Filter{Column: condition.Column, Operator: condition.Operator, Value: condition.Value})
+		}
+
+		if len(condition.Conditions) > 0 {
+			var logicalOperator models.LogicalOperator
+			if condition.LogicalOperator != nil {
+				logicalOperator = *condition.LogicalOperator
+			}
+			nested, ok := getFilterConjuncts(models.FilterModel{LogicalOperator: logicalOperator, Conditions: condition.Conditions})
+			if !ok {
+				return nil, false
+			}
+			conjuncts = append(conjuncts, nested...)
+		}
+	}
+
+	return conjuncts, true
+}
+
+func isAndOperator(logicalOperator models.LogicalOperator) bool {
+	return logicalOperator == "" || logicalOperator == models.LogicalOperator(querybuilderconstants.LogicalOperatorAnd)
+}
+
+// getConditionValues returns the values an eq or in condition accepts
+func getConditionValues(condition models.Filter) ([]string, bool) {
+	switch condition.Operator {
+	case querybuilderconstants.EqualOperator:
+		return []string{fmt.Sprintf("%v", condition.Value)}, true
+	case querybuilderconstants.InOperator:
+		values, ok := condition.Value.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		result := make([]string, len(values))
+		for i, value := range values {
+			result[i] = fmt.Sprintf("%v", value)
+		}
+		return result, true
+	default:
+		return nil, false
+	}
+}
+
+// conditionImplies tells whether every row matching the condition also matches the other one
+func conditionImplies(condition models.Filter, other models.Filter) bool {
+	if condition.Column != other.Column {
+		return false
+	}
+
+	if condition.Operator == other.Operator && fmt.Sprintf("%v", condition.Value) == fmt.Sprintf("%v", other.Value) {
+		return true
+	}
+
+	values, ok := getConditionValues(condition)
+	if !ok {
+		return false
+	}
+	otherValues, ok := getConditionValues(other)
+	if !ok {
+		return false
+	}
+
+	for _, value := range values {
+		if !slices.Contains(otherValues, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filtersImply tells whether every row matching the filters also matches the other filters, false when unknown
+func filtersImply(filters models.FilterModel, other models.FilterModel) bool {
+	conjuncts, ok := getFilterConjuncts(filters)
+	if !ok {
+		return false
+	}
+	otherConjuncts, ok := getFilterConjuncts(other)
+	if !ok {
+		return false
+	}
+
+	for _, otherCondition := range otherConjuncts {
+		implied := false
+		for _, condition := range conjuncts {
+			if conditionImplies(condition, otherCondition) {
+				implied = true
+				break
+			}
+		}
+		if !implied {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filtersDisjoint tells whether no row can match both filters, false when unknown
+func filtersDisjoint(filters models.FilterModel, other models.FilterModel) bool {
+	conjuncts, ok := getFilterConjuncts(filters)
+	if !ok {
+		return false
+	}
+	otherConjuncts, ok := getFilterConjuncts(other)
+	if !ok {
+		return false
+	}
+
+	for _, condition := range conjuncts {
+		values, ok := getConditionValues(condition)
+		if !ok {
+			continue
+		}
+		for _, otherCondition := range otherConjuncts {
+			if condition.Column != otherCondition.Column {
+				continue
+			}
+			otherValues, ok := getConditionValues(otherCondition)
+			if !ok {
+				continue
+			}
+			if !slices.ContainsFunc(values, func(value string) bool { return slices.Contains(otherValues, value) }) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// getShadowingRule returns the index of the first rule above the one at index i whose filters cover all of its rows
+func getShadowingRule(filters []models.FilterModel, i int) (int, bool) {
+	for j := 0; j < i; j++ {
+		if filtersImply(filters[i], filters[j]) {
+			return j, true
+		}
+	}
+	return 0, false
+}
+
+// getStaticRuleConflicts returns the conflicts of the rule at index i proven from the filters alone, the rule above it
+// shadowing it or else the rules of another value whose filters cover its own or are covered by them
+func getStaticRuleConflicts(rules []rulemodels.Rule, filters []models.FilterModel, i int) []models.DatasetRuleConflict {
+	if j, ok := getShadowingRule(filters, i); ok {
+		return []models.DatasetRuleConflict{{
+			Type:         models.DatasetRuleConflictShadowed,
+			RuleId:       rules[i].ID,
+			OtherRuleIds: []uuid.UUID{rules[j].ID},
+			Source:       models.DatasetRuleAnalysisSourceStatic,
+		}}
+	}
+
+	conflicts := []models.DatasetRuleConflict{}
+	for j, other := range rules {
+		if j == i || other.Value == rules[i].Value {
+			continue
+		}
+		if filtersImply(filters[j], filters[i]) || filtersImply(filters[i], filters[j]) {
+			conflicts = append(conflicts, models.DatasetRuleConflict{
+				Type:         models.DatasetRuleConflictOverlap,
+				RuleId:       rules[i].ID,
+				OtherRuleIds: []uuid.UUID{other.ID},
+				Source:       models.DatasetRuleAnalysisSourceStatic,
+			})
+		}
+	}
+
+	return conflicts
+}
+
+// getDatasetRowIds returns the ids of up to limit rows matching the filters
+func (s *datasetService) getDatasetRowIds(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, filters models.FilterModel, limit int) ([]string, error) {
+	data, err := s.getDataByDatasetId(ctx, merchantId, datasetId.String(), models.DatasetParams{
+		Columns:    []models.ColumnConfig{{Column: datasetConstants.ZampIDColumn}},
+		Filters:    filters,
+		Pagination: &models.Pagination{Page: 1, PageSize: limit},
+	}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rowIds := make([]string, 0, len(data.Rows))
+	for _, row := range data.Rows {
+		rowIds = append(rowIds, fmt.Sprintf("%v", row[datasetConstants.ZampIDColumn]))
+	}
+
+	return rowIds, nil
+}
+
+// analyzeDatasetRules looks for conflicts between the enabled rules of a column, given in priority order.
+// When focusRuleId is set only the conflicts of that rule are looked for.
+// A rule shadowed by the filters of a rule above it is reported without querying the data, the other rules are checked
+// on a sample of the rows they match, the rows other rules also match are counted among the sample.
+func (s *datasetService) analyzeDatasetRules(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, rules []rulemodels.Rule, focusRuleId *uuid.UUID) ([]models.DatasetRuleConflict, error) {
+	filters := make([]models.FilterModel, len(rules))
+	for i, rule := range rules {
+		filters[i] = models.RuleFilters(rule)
+	}
+
+	conflicts := []models.DatasetRuleConflict{}
+	for i, rule := range rules {
+		if focusRuleId != nil && rule.ID != *focusRuleId {
+			continue
+		}
+
+		if j, ok := getShadowingRule(filters, i); ok {
+			conflicts = append(conflicts, models.DatasetRuleConflict{
+				Type:         models.DatasetRuleConflictShadowed,
+				RuleId:       rule.ID,
+				OtherRuleIds: []uuid.UUID{rules[j].ID},
+				Source:       models.DatasetRuleAnalysisSourceStatic,
+			})
+			continue
+		}
+
+		sampleRowIds, err := s.getDatasetRowIds(ctx, merchantId, datasetId, filters[i], datasetConstants.DatasetRuleAnalysisSampleSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(sampleRowIds) == 0 {
+			var matchCount int64
+			conflicts = append(conflicts, models.DatasetRuleConflict{
+				Type:     models.DatasetRuleConflictZeroMatch,
+				RuleId:   rule.ID,
+				RowCount: &matchCount,
+				Source:   models.DatasetRuleAnalysisSourceData,
+			})
+			continue
+		}
+		sampleFilters := getRowIdsFilter(sampleRowIds)
+		sampled := len(sampleRowIds) == datasetConstants.DatasetRuleAnalysisSampleSize
+
+		// overlaps are reported once per pair, on the rule of lower priority unless the analysis is focused on a rule
+		var overlappingRuleIds []uuid.UUID
+		var overlappingFilters []models.FilterModel
+		for j, other := range rules {
+			if j == i || (focusRuleId == nil && j > i) || filtersDisjoint(filters[i], filters[j]) {
+				continue
+			}
+
+			overlapCount, err := s.countDatasetRows(ctx, merchantId, datasetId, andFilters(sampleFilters, filters[j]))
+			if err != nil {
+				return nil, err
+			}
+			if overlapCount == 0 {
+				continue
+			}
+
+			if j < i {
+				overlappingRuleIds = append(overlappingRuleIds, other.ID)
+				overlappingFilters = append(overlappingFilters, filters[j])
+			}
+
+			if other.Value == rule.Value {
+				continue
+			}
+			conflicts = append(conflicts, models.DatasetRuleConflict{
+				Type:         models.DatasetRuleConflictOverlap,
+				RuleId:       rule.ID,
+				OtherRuleIds: []uuid.UUID{other.ID},
+				RowCount:     &overlapCount,
+				Sampled:      sampled,
+				Source:       models.DatasetRuleAnalysisSourceData,
+			})
+		}
+
+		if len(overlappingFilters) == 0 {
+			continue
+		}
+
+		// no single rule covers this one, the rules above it may still do together
+		shadowedCount, err := s.countDatasetRows(ctx, merchantId, datasetId, andFilters(sampleFilters, orFilters(overlappingFilters...)))
+		if err != nil {
+			return nil, err
+		}
+		if shadowedCount == int64(len(sampleRowIds)) {
+			conflicts = append(conflicts, models.DatasetRuleConflict{
+				Type:         models.DatasetRuleConflictShadowed,
+				RuleId:       rule.ID,
+				OtherRuleIds: overlappingRuleIds,
+				RowCount:     &shadowedCount,
+				Sampled:      sampled,
+				Source:       models.DatasetRuleAnalysisSourceData,
+			})
+		}
+	}
+
+	return conflicts, nil
+}
+
+// getEnabledColumnRules returns the enabled rules of each column in priority order
+func (s *datasetService) getEnabledColumnRules(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, columns []string) (map[string][]rulemodels.Rule, error) {
+	rules, err := s.GetDatasetRules(ctx, merchantId, datasetId)
+	if err != nil {
+		return nil, err
+	}
+
+	columnRules := make(map[string][]rulemodels.Rule)
+	for _, rule := range rules {
+		if !rule.IsEnabled || (len(columns) > 0 && !slices.Contains(columns, rule.Column)) {
+			continue
+		}
+		columnRules[rule.Column] = append(columnRules[rule.Column], rule)
+	}
+
+	return columnRules, nil
+}
+
+// getDatasetRuleWarnings returns the conflicts of a rule just saved proven from the filters of the rules alone, saves
+// do not wait on queries of the data, the analysis of the column counts the rows
+func (s *datasetService) getDatasetRuleWarnings(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, rule rulemodels.Rule) []models.DatasetRuleConflict {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if !rule.IsEnabled {
+		return []models.DatasetRuleConflict{}
+	}
+
+	columnRules, err := s.getEnabledColumnRules(ctx, merchantId, datasetId, []string{rule.Column})
+	if err != nil {
+		logger.Warn("failed to get rules to analyse", zap.String("rule_id", rule.ID.String()), zap.String("error", err.Error()))
+		return []models.DatasetRuleConflict{}
+	}
+
+	rules := columnRules[rule.Column]
+	filters := make([]models.FilterModel, len(rules))
+	for i, columnRule := range rules {
+		filters[i] = models.RuleFilters(columnRule)
+	}
+
+	i := slices.IndexFunc(rules, func(columnRule rulemodels.Rule) bool { return columnRule.ID == rule.ID })
+	if i < 0 {
+		return []models.DatasetRuleConflict{}
+	}
+
+	return getStaticRuleConflicts(rules, filters, i)
+}
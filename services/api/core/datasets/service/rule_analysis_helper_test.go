@@ -0,0 +1,147 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+)
+
+func TestFiltersImply(t *testing.T) {
+	or := models.LogicalOperator("OR")
+	vendorIsAcme := models.Filter{Column: "vendor", Operator: "eq", Value: "Acme"}
+	amountOver100 := models.Filter{Column: "amount", Operator: "gt", Value: "100"}
+
+	tests := []struct {
+		name    string
+		filters models.FilterModel
+		other   models.FilterModel
+		want    bool
+	}{
+		{
+			name:    "Narrower rule is covered by a broader one",
+			filters: models.FilterModel{LogicalOperator: "AND", Conditions: []models.Filter{vendorIsAcme, amountOver100}},
+			other:   models.FilterModel{Conditions: []models.Filter{vendorIsAcme}},
+			want:    true,
+		},
+		{
+			name:    "Broader rule is not covered by a narrower one",
+			filters: models.FilterModel{Conditions: []models.Filter{vendorIsAcme}},
+			other:   models.FilterModel{LogicalOperator: "AND", Conditions: []models.Filter{vendorIsAcme, amountOver100}},
+			want:    false,
+		},
+		{
+			name:    "Equality is covered by a list containing the value",
+			filters: models.FilterModel{Conditions: []models.Filter{vendorIsAcme}},
+			other:   models.FilterModel{Conditions: []models.Filter{{Column: "vendor", Operator: "in", Value: []interface{}{"Acme", "Globex"}}}},
+			want:    true,
+		},
+		{
+			name:    "Nested groups of AND are flattened",
+			filters: models.FilterModel{Conditions: []models.Filter{{Conditions: []models.Filter{vendorIsAcme, amountOver100}}}},
+			other:   models.FilterModel{Conditions: []models.Filter{amountOver100}},
+			want:    true,
+		},
+		{
+			name:    "Alternatives are left to the data",
+			filters: models.FilterModel{Conditions: []models.Filter{{LogicalOperator: &or, Conditions: []models.Filter{vendorIsAcme, amountOver100}}}},
+			other:   models.FilterModel{Conditions: []models.Filter{vendorIsAcme}},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, filtersImply(tt.filters, tt.other))
+		})
+	}
+}
+
+func TestFiltersDisjoint(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters models.FilterModel
+		other   models.FilterModel
+		want    bool
+	}{
+		{
+			name:    "Different values of the same column",
+			filters: models.FilterModel{Conditions: []models.Filter{{Column: "vendor", Operator: "eq", Value: "Acme"}}},
+			other:   models.FilterModel{Conditions: []models.Filter{{Column: "vendor", Operator: "in", Value: []interface{}{"Globex", "Initech"}}}},
+			want:    true,
+		},
+		{
+			name:    "Lists sharing a value",
+			filters: models.FilterModel{Conditions: []models.Filter{{Column: "vendor", Operator: "in", Value: []interface{}{"Acme", "Globex"}}}},
+			other:   models.FilterModel{Conditions: []models.Filter{{Column: "vendor", Operator: "in", Value: []interface{}{"Globex"}}}},
+			want:    false,
+		},
+		{
+			name:    "Different columns are left to the data",
+			filters: models.FilterModel{Conditions: []models.Filter{{Column: "vendor", Operator: "eq", Value: "Acme"}}},
+			other:   models.FilterModel{Conditions: []models.Filter{{Column: "currency", Operator: "eq", Value: "EUR"}}},
+			want:    false,
+		},
+		{
+			name:    "Ranges are left to the data",
+			filters: models.FilterModel{Conditions: []models.Filter{{Column: "amount", Operator: "gt", Value: "100"}}},
+			other:   models.FilterModel{Conditions: []models.Filter{{Column: "amount", Operator: "lt", Value: "50"}}},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, filtersDisjoint(tt.filters, tt.other))
+		})
+	}
+}
+
+func TestGetStaticRuleConflicts(t *testing.T) {
+	rules := []rulemodels.Rule{
+		{ID: uuid.New(), Value: "Software"},
+		{ID: uuid.New(), Value: "Hardware"},
+		{ID: uuid.New(), Value: "Software"},
+		{ID: uuid.New(), Value: "Travel"},
+	}
+	filters := []models.FilterModel{
+		{Conditions: []models.Filter{{Column: "vendor", Operator: "eq", Value: "Acme"}}},
+		{Conditions: []models.Filter{{Column: "vendor", Operator: "in", Value: []interface{}{"Acme", "Globex"}}}},
+		{LogicalOperator: "AND", Conditions: []models.Filter{{Column: "vendor", Operator: "eq", Value: "Acme"}, {Column: "amount", Operator: "gt", Value: "100"}}},
+		{Conditions: []models.Filter{{Column: "currency", Operator: "eq", Value: "EUR"}}},
+	}
+
+	tests := []struct {
+		name string
+		i    int
+		want []models.DatasetRuleConflict
+	}{
+		{
+			name: "Rule covered by a rule above it is shadowed",
+			i:    2,
+			want: []models.DatasetRuleConflict{{Type: models.DatasetRuleConflictShadowed, RuleId: rules[2].ID, OtherRuleIds: []uuid.UUID{rules[0].ID}, Source: models.DatasetRuleAnalysisSourceStatic}},
+		},
+		{
+			name: "Rules of another value covering or covered by the rule overlap with it",
+			i:    1,
+			want: []models.DatasetRuleConflict{
+				{Type: models.DatasetRuleConflictOverlap, RuleId: rules[1].ID, OtherRuleIds: []uuid.UUID{rules[0].ID}, Source: models.DatasetRuleAnalysisSourceStatic},
+				{Type: models.DatasetRuleConflictOverlap, RuleId: rules[1].ID, OtherRuleIds: []uuid.UUID{rules[2].ID}, Source: models.DatasetRuleAnalysisSourceStatic},
+			},
+		},
+		{
+			name: "Rules on other columns are left to the analysis of the data",
+			i:    3,
+			want: []models.DatasetRuleConflict{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getStaticRuleConflicts(rules, filters, tt.i))
+		})
+	}
+}
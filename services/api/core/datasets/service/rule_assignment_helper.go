@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
+	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+)
+
+// normalizeDatasetRuleAssignments puts the assignment of the column a rule is prioritized with first and mirrors it in
+// the column and value of the rule, rules without assignments are left as they are
+func normalizeDatasetRuleAssignments(params models.DatasetRuleParams) (models.DatasetRuleParams, error) {
+	if len(params.Assignments) == 0 {
+		return params, nil
+	}
+
+	if params.Column == "" {
+		params.Column = params.Assignments[0].Column
+	}
+
+	var primary *rulemodels.RuleAssignment
+	others := make([]rulemodels.RuleAssignment, 0, len(params.Assignments)-1)
+	columns := make(map[string]bool, len(params.Assignments))
+	for _, assignment := range params.Assignments {
+		if assignment.Column == "" {
+			return params, fmt.Errorf("%w: assignment without a column", errors.ErrInvalidRuleAssignment)
+		}
+		if columns[assignment.Column] {
+			return params, fmt.Errorf("%w: column %s assigned twice", errors.ErrInvalidRuleAssignment, assignment.Column)
+		}
+		columns[assignment.Column] = true
+
+		if assignment.Column == params.Column {
+			primary = &assignment
+			continue
+		}
+		others = append(others, assignment)
+	}
+
+	if primary == nil {
+		return params, fmt.Errorf("%w: no assignment of column %s", errors.ErrInvalidRuleAssignment, params.Column)
+	}
+
+	params.Assignments = append([]rulemodels.RuleAssignment{*primary}, others...)
+	params.Value = primary.Value
+
+	return params, nil
+}
+
+// hasDatasetRuleLookup tells whether a rule looks a value up, such a rule only matches the rows the lookup finds a row
+// for and needs no filter of its own
+func hasDatasetRuleLookup(assignments []rulemodels.RuleAssignment) bool {
+	return slices.ContainsFunc(assignments, func(assignment rulemodels.RuleAssignment) bool {
+		return assignment.ValueType == rulemodels.RuleAssignmentValueTypeLookup
+	})
+}
+
+// validateDatasetRuleAssignments checks the columns the assignments write and read against the dataset schema, and the
+// columns of lookups against the schema of the dataset they look up and the columns the user can read of it
+func (s *datasetService) validateDatasetRuleAssignments(ctx context.Context, merchantId uuid.UUID, schema map[string]dataplatformDataModels.ColumnMetadata, assignments []rulemodels.RuleAssignment) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	lookupSchemas := make(map[uuid.UUID]map[string]dataplatformDataModels.ColumnMetadata)
+	lookupRestrictions := make(map[uuid.UUID]map[string]models.ColumnRestriction)
+	for _, assignment := range assignments {
+		if _, ok := schema[assignment.Column]; !ok {
+			return fmt.Errorf("%w: %s", errors.ErrInvalidRuleColumn, assignment.Column)
+		}
+
+		switch assignment.ValueType {
+		case rulemodels.RuleAssignmentValueTypeLiteral:
+			if strings.TrimSpace(assignment.Value) == "" {
+				return fmt.Errorf("%w: %s", errors.ErrEmptyRuleValue, assignment.Column)
+			}
+		case rulemodels.RuleAssignmentValueTypeColumn:
+			if _, ok := schema[assignment.SourceColumn]; !ok || assignment.SourceColumn == assignment.Column {
+				return fmt.Errorf("%w: %s copies %q", errors.ErrInvalidRuleAssignment, assignment.Column, assignment.SourceColumn)
+			}
+		case rulemodels.RuleAssignmentValueTypeLookup:
+			lookup := assignment.Lookup
+			if lookup == nil {
+				return fmt.Errorf("%w: %s has no lookup", errors.ErrInvalidRuleLookup, assignment.Column)
+			}
+			if _, ok := schema[lookup.JoinColumn]; !ok {
+				return fmt.Errorf("%w: join column %q", errors.ErrInvalidRuleLookup, lookup.JoinColumn)
+			}
+
+			lookupSchema, ok := lookupSchemas[lookup.DatasetId]
+			if !ok {
+				if err := s.checkRuleLookupDatasetAccess(ctx, lookup.DatasetId); err != nil {
+					return err
+				}
+
+				lookupInfo, err := s.dataplatformService.GetDatasetMetadata(ctx, merchantId.String(), lookup.DatasetId.String())
+				if err != nil {
+					logger.Error("failed to get lookup dataset metadata", zap.String("dataset_id", lookup.DatasetId.String()), zap.String("error", err.Error()))
+					return fmt.Errorf("%w: dataset %s", errors.ErrInvalidRuleLookup, lookup.DatasetId)
+				}
+				lookupSchema = lookupInfo.Schema
+				lookupSchemas[lookup.DatasetId] = lookupSchema
+
+				restrictions, err := s.getColumnRestrictions(ctx, lookup.DatasetId.String())
+				if err != nil {
+					return err
+				}
+				lookupRestrictions[lookup.DatasetId] = restrictions
+			}
+
+			for _, column := range []string{lookup.LookupColumn, lookup.ValueColumn} {
+				if _, ok := lookupSchema[column]; !ok {
+					return fmt.Errorf("%w: column %q of dataset %s", errors.ErrInvalidRuleLookup, column, lookup.DatasetId)
+				}
+				// the lookup copies the values in the clear into the dataset of the rule
+				if _, restricted := lookupRestrictions[lookup.DatasetId][column]; restricted {
+					return fmt.Errorf("%w: column %q of dataset %s is not accessible", errors.ErrInvalidRuleLookup, column, lookup.DatasetId)
+				}
+			}
+		default:
+			return fmt.Errorf("%w: %s has value type %q", errors.ErrInvalidRuleAssignment, assignment.Column, assignment.ValueType)
+		}
+	}
+
+	return nil
+}
+
+// checkRuleLookupDatasetAccess checks the user can read every row of the dataset a lookup reads from. The dataset is loaded
+// under the access control of the user, and a lookup joins all the rows of the dataset, so a user whose rows of it are
+// limited by row policies cannot look values up from it. Without a user access cannot be checked and the lookup is refused
+func (s *datasetService) checkRuleLookupDatasetAccess(ctx context.Context, datasetId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	_, userId, _ := apicontext.GetAuthFromContext(ctx)
+	if userId == nil {
+		return errors.ErrNoUserForDataPolicies
+	}
+
+	if _, err := s.datasetStore.GetDatasetById(ctx, datasetId.String()); err != nil {
+		logger.Error("failed to get lookup dataset", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return fmt.Errorf("%w: dataset %s", errors.ErrInvalidRuleLookup, datasetId)
+	}
+
+	rowPolicyFilter, err := s.getRowPolicyFilterForUser(ctx, datasetId.String(), *userId)
+	if err != nil {
+		return err
+	}
+	if rowPolicyFilter != nil {
+		return fmt.Errorf("%w: rows of dataset %s are restricted", errors.ErrInvalidRuleLookup, datasetId)
+	}
+
+	return nil
+}
+
+// resolveDatasetRuleAssignments applies the checks an update of a column goes through to the literal assignments of a
+// rule. Status and tags columns only take literals, the values a copy or a lookup writes cannot be checked upfront.
+func (s *datasetService) resolveDatasetRuleAssignments(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.DatasetRuleParams) ([]rulemodels.RuleAssignment, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if len(params.Assignments) == 0 {
+		return nil, nil
+	}
+
+	statusWorkflows, err := s.getStatusWorkflows(ctx, datasetId)
+	if err != nil {
+		return nil, err
+	}
+
+	datasetMetaInfo, err := s.datasetStore.GetDatasetById(ctx, datasetId.String())
+	if err != nil {
+		logger.Error("failed to get dataset meta info", zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetDatasetById
+	}
+
+	var datasetMetaData models.DatasetMetadataConfig
+	if err := json.Unmarshal([]byte(datasetMetaInfo.Metadata), &datasetMetaData); err != nil {
+		logger.Error("failed to unmarshal dataset metadata", zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToUnmarshalMetadata
+	}
+
+	assignments := make([]rulemodels.RuleAssignment, len(params.Assignments))
+	for i, assignment := range params.Assignments {
+		if assignment.ValueType != rulemodels.RuleAssignmentValueTypeLiteral {
+			_, hasWorkflow := statusWorkflows[assignment.Column]
+			if hasWorkflow || datasetMetaData.Columns[assignment.Column].CustomType == constants.DatabricksColumnCustomTypeTags {
+				return nil, fmt.Errorf("%w: %s only takes literal values", errors.ErrInvalidRuleAssignment, assignment.Column)
+			}
+			assignments[i] = assignment
+			continue
+		}
+
+		value, err := s.resolveDatasetRuleValue(ctx, merchantId, datasetId, models.DatasetRuleParams{
+			Column:  assignment.Column,
+			Filters: params.Filters,
+			Value:   assignment.Value,
+		})
+		if err != nil {
+			return nil, err
+		}
+		assignment.Value = fmt.Sprintf("%v", value)
+		assignments[i] = assignment
+	}
+
+	return assignments, nil
+}
+
+// getDatasetRulesColumns returns the column first and then the other columns the rules assign, the rule set of each
+// of them changes when the rules change
+func getDatasetRulesColumns(column string, rules ...rulemodels.Rule) []string {
+	columns := []string{column}
+	for _, rule := range rules {
+		for _, ruleColumn := range rule.GetColumns() {
+			if !slices.Contains(columns, ruleColumn) {
+				columns = append(columns, ruleColumn)
+			}
+		}
+	}
+
+	return columns
+}
+
+// getDatasetRuleSetsForDataPlatform returns for each column the enabled rules writing it in the order they apply. The
+// rules of the column come first by priority, rules of other columns assigning it follow in the order of their
+// own priorities.
+func (s *datasetService) getDatasetRuleSetsForDataPlatform(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, columns []string) (map[string][]dataplatformDataModels.Rule, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	datasetIdString := datasetId.String()
+
+	rules, err := s.ruleService.GetRules(ctx, storemodels.FilterRuleParams{
+		OrganizationId: merchantId,
+		DatasetColumns: []storemodels.DatasetColumn{
+			{
+				DatasetId: datasetId,
+				Columns:   columns,
+			},
+		},
+		IncludeAssignments: true,
+	})
+	if err != nil {
+		logger.Error("failed to get rules for dataset", zap.String("dataset_id", datasetIdString), zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	ruleSets := make(map[string][]dataplatformDataModels.Rule, len(columns))
+	for _, column := range columns {
+		ruleSets[column] = getDatasetRuleSetForDataPlatform(column, rules[datasetIdString][column])
+	}
+
+	return ruleSets, nil
+}
+
+func getDatasetRuleSetForDataPlatform(column string, rules []rulemodels.Rule) []dataplatformDataModels.Rule {
+	var ruleSet []dataplatformDataModels.Rule
+
+	lastPriority := 0
+	for _, rule := range rules {
+		if rule.Column != column {
+			continue
+		}
+		lastPriority = max(lastPriority, rule.Priority)
+		if rule.IsEnabled {
+			ruleSet = append(ruleSet, getDataPlatformRule(rule, column, rule.Priority))
+		}
+	}
+
+	for _, rule := range rules {
+		if rule.Column == column || !rule.IsEnabled {
+			continue
+		}
+		lastPriority++
+		ruleSet = append(ruleSet, getDataPlatformRule(rule, column, lastPriority))
+	}
+
+	return ruleSet
+}
+
+// getDataPlatformRule returns the assignment of the rule writing the column as the data platform applies it
+func getDataPlatformRule(rule rulemodels.Rule, column string, priority int) dataplatformDataModels.Rule {
+	dataplatformRule := dataplatformDataModels.Rule{
+		Id:           rule.ID.String(),
+		Priority:     priority,
+		SqlCondition: rule.FilterConfig.Sql,
+		SqlArgs:      rule.FilterConfig.Args,
+	}
+
+	assignment, _ := rule.GetAssignment(column)
+	switch assignment.ValueType {
+	case rulemodels.RuleAssignmentValueTypeColumn:
+		dataplatformRule.ValueColumn = assignment.SourceColumn
+	case rulemodels.RuleAssignmentValueTypeLookup:
+		dataplatformRule.Lookup = &dataplatformDataModels.RuleLookup{
+			DatasetId:    assignment.Lookup.DatasetId.String(),
+			JoinColumn:   assignment.Lookup.JoinColumn,
+			LookupColumn: assignment.Lookup.LookupColumn,
+			ValueColumn:  assignment.Lookup.ValueColumn,
+		}
+	default:
+		dataplatformRule.ValueToApply = assignment.Value
+	}
+
+	return dataplatformRule
+}
@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+
+	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
+	datasetErrors "github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	mockDataplatform "github.com/Zampfi/application-platform/services/api/mocks/core/dataplatform"
+	mock_store "github.com/Zampfi/application-platform/services/api/mocks/db/store"
+)
+
+func TestNormalizeDatasetRuleAssignments(t *testing.T) {
+	category := rulemodels.RuleAssignment{Column: "category", ValueType: rulemodels.RuleAssignmentValueTypeLiteral, Value: "Travel"}
+	costCenter := rulemodels.RuleAssignment{Column: "cost_center", ValueType: rulemodels.RuleAssignmentValueTypeColumn, SourceColumn: "department"}
+
+	tests := []struct {
+		name            string
+		params          models.DatasetRuleParams
+		wantColumn      string
+		wantValue       interface{}
+		wantAssignments []rulemodels.RuleAssignment
+		wantErr         error
+	}{
+		{
+			name:       "Rule without assignments",
+			params:     models.DatasetRuleParams{Column: "category", Value: "Travel"},
+			wantColumn: "category",
+			wantValue:  "Travel",
+		},
+		{
+			name:            "Column defaults to the first assignment",
+			params:          models.DatasetRuleParams{Assignments: []rulemodels.RuleAssignment{category, costCenter}},
+			wantColumn:      "category",
+			wantValue:       "Travel",
+			wantAssignments: []rulemodels.RuleAssignment{category, costCenter},
+		},
+		{
+			name:            "Assignment of the column moves first",
+			params:          models.DatasetRuleParams{Column: "cost_center", Assignments: []rulemodels.RuleAssignment{category, costCenter}},
+			wantColumn:      "cost_center",
+			wantValue:       "",
+			wantAssignments: []rulemodels.RuleAssignment{costCenter, category},
+		},
+		{
+			name:    "Column not assigned",
+			params:  models.DatasetRuleParams{Column: "memo", Assignments: []rulemodels.RuleAssignment{category}},
+			wantErr: datasetErrors.ErrInvalidRuleAssignment,
+		},
+		{
+			name:    "Column assigned twice",
+			params:  models.DatasetRuleParams{Assignments: []rulemodels.RuleAssignment{category, category}},
+			wantErr: datasetErrors.ErrInvalidRuleAssignment,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeDatasetRuleAssignments(tt.params)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantColumn, got.Column)
+			assert.Equal(t, tt.wantValue, got.Value)
+			assert.Equal(t, tt.wantAssignments, got.Assignments)
+		})
+	}
+}
+
+func TestValidateDatasetRuleAssignments(t *testing.T) {
+	userId := uuid.New()
+	vendorMasterId := uuid.New()
+	schema := map[string]dataplatformDataModels.ColumnMetadata{
+		"merchant":    {Type: "string"},
+		"department":  {Type: "string"},
+		"category":    {Type: "string"},
+		"cost_center": {Type: "string"},
+	}
+	lookup := func(valueColumn string) *rulemodels.RuleLookup {
+		return &rulemodels.RuleLookup{DatasetId: vendorMasterId, JoinColumn: "merchant", LookupColumn: "vendor_name", ValueColumn: valueColumn}
+	}
+
+	tests := []struct {
+		name          string
+		assignments   []rulemodels.RuleAssignment
+		metadataReads int
+		wantErr       error
+	}{
+		{
+			// the lookup dataset is read once however many assignments look it up
+			name:          "Literal, copy and lookups",
+			metadataReads: 1,
+			assignments: []rulemodels.RuleAssignment{
+				{Column: "category", ValueType: rulemodels.RuleAssignmentValueTypeLookup, Lookup: lookup("category")},
+				{Column: "cost_center", ValueType: rulemodels.RuleAssignmentValueTypeLookup, Lookup: lookup("cc")},
+				{Column: "department", ValueType: rulemodels.RuleAssignmentValueTypeLiteral, Value: "Finance"},
+				{Column: "merchant", ValueType: rulemodels.RuleAssignmentValueTypeColumn, SourceColumn: "department"},
+			},
+		},
+		{
+			name:        "Empty literal",
+			assignments: []rulemodels.RuleAssignment{{Column: "category", ValueType: rulemodels.RuleAssignmentValueTypeLiteral, Value: " "}},
+			wantErr:     datasetErrors.ErrEmptyRuleValue,
+		},
+		{
+			name:        "Copy of a column missing from the schema",
+			assignments: []rulemodels.RuleAssignment{{Column: "category", ValueType: rulemodels.RuleAssignmentValueTypeColumn, SourceColumn: "memo"}},
+			wantErr:     datasetErrors.ErrInvalidRuleAssignment,
+		},
+		{
+			name:        "Copy of the column itself",
+			assignments: []rulemodels.RuleAssignment{{Column: "category", ValueType: rulemodels.RuleAssignmentValueTypeColumn, SourceColumn: "category"}},
+			wantErr:     datasetErrors.ErrInvalidRuleAssignment,
+		},
+		{
+			name:          "Lookup of a column missing from the lookup dataset",
+			assignments:   []rulemodels.RuleAssignment{{Column: "category", ValueType: rulemodels.RuleAssignmentValueTypeLookup, Lookup: lookup("region")}},
+			metadataReads: 1,
+			wantErr:       datasetErrors.ErrInvalidRuleLookup,
+		},
+		{
+			name:        "Lookup without its join",
+			assignments: []rulemodels.RuleAssignment{{Column: "category", ValueType: rulemodels.RuleAssignmentValueTypeLookup}},
+			wantErr:     datasetErrors.ErrInvalidRuleLookup,
+		},
+		{
+			name:        "Assignment of a column missing from the schema",
+			assignments: []rulemodels.RuleAssignment{{Column: "memo", ValueType: rulemodels.RuleAssignmentValueTypeLiteral, Value: "x"}},
+			wantErr:     datasetErrors.ErrInvalidRuleColumn,
+		},
+		{
+			name:        "Unknown value type",
+			assignments: []rulemodels.RuleAssignment{{Column: "category", ValueType: "formula", Value: "x"}},
+			wantErr:     datasetErrors.ErrInvalidRuleAssignment,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDataplatformService := mockDataplatform.NewMockDataPlatformService(t)
+			if tt.metadataReads > 0 {
+				mockDataplatformService.EXPECT().GetDatasetMetadata(mock.Anything, mock.Anything, vendorMasterId.String()).Return(dataplatformDataModels.DatasetMetadata{
+					Schema: map[string]dataplatformDataModels.ColumnMetadata{
+						"vendor_name": {Type: "string"},
+						"category":    {Type: "string"},
+						"cc":          {Type: "string"},
+					},
+				}, nil).Times(tt.metadataReads)
+			}
+
+			mockStore := mock_store.NewMockStore(t)
+			if tt.metadataReads > 0 {
+				mockStore.EXPECT().GetDatasetById(mock.Anything, vendorMasterId.String()).Return(&storemodels.Dataset{ID: vendorMasterId}, nil).Times(tt.metadataReads)
+				mockStore.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil).Times(2 * tt.metadataReads)
+				mockStore.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, vendorMasterId, userId).Return([]storemodels.DatasetRowPolicy{}, nil).Times(tt.metadataReads)
+				mockStore.EXPECT().GetDatasetColumnPoliciesForUser(mock.Anything, vendorMasterId, userId).Return([]storemodels.DatasetColumnPolicy{}, nil).Times(tt.metadataReads)
+			}
+
+			s := &datasetService{dataplatformService: mockDataplatformService, datasetStore: mockStore}
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userId, []uuid.UUID{uuid.New()})
+			err := s.validateDatasetRuleAssignments(ctx, uuid.New(), schema, tt.assignments)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDatasetRuleAssignmentsUnknownLookupDataset(t *testing.T) {
+	userId := uuid.New()
+	mockStore := mock_store.NewMockStore(t)
+	mockStore.EXPECT().GetDatasetById(mock.Anything, mock.Anything).Return(&storemodels.Dataset{}, nil)
+	mockStore.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+	mockStore.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, mock.Anything, userId).Return([]storemodels.DatasetRowPolicy{}, nil)
+	mockDataplatformService := mockDataplatform.NewMockDataPlatformService(t)
+	mockDataplatformService.EXPECT().GetDatasetMetadata(mock.Anything, mock.Anything, mock.Anything).Return(dataplatformDataModels.DatasetMetadata{}, errors.New("dataset not found"))
+
+	s := &datasetService{dataplatformService: mockDataplatformService, datasetStore: mockStore}
+	ctx := apicontext.AddAuthToContext(context.Background(), "user", userId, []uuid.UUID{uuid.New()})
+	err := s.validateDatasetRuleAssignments(ctx, uuid.New(), ruleLookupSchema, ruleLookupAssignments(uuid.New()))
+
+	assert.ErrorIs(t, err, datasetErrors.ErrInvalidRuleLookup)
+}
+
+func TestValidateDatasetRuleAssignmentsLookupAccess(t *testing.T) {
+	userId := uuid.New()
+	lookupDatasetId := uuid.New()
+	lookupMetadata := dataplatformDataModels.DatasetMetadata{Schema: map[string]dataplatformDataModels.ColumnMetadata{
+		"vendor_name": {Type: "string"},
+		"category":    {Type: "string"},
+	}}
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mock_store.MockStore, *mockDataplatform.MockDataPlatformService)
+		wantErr   error
+	}{
+		{
+			name: "Dataset the user can read",
+			mockSetup: func(ms *mock_store.MockStore, md *mockDataplatform.MockDataPlatformService) {
+				ms.EXPECT().GetDatasetById(mock.Anything, lookupDatasetId.String()).Return(&storemodels.Dataset{ID: lookupDatasetId}, nil)
+				ms.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				ms.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, lookupDatasetId, userId).Return([]storemodels.DatasetRowPolicy{}, nil)
+				ms.EXPECT().GetDatasetColumnPoliciesForUser(mock.Anything, lookupDatasetId, userId).Return([]storemodels.DatasetColumnPolicy{}, nil)
+				md.EXPECT().GetDatasetMetadata(mock.Anything, mock.Anything, lookupDatasetId.String()).Return(lookupMetadata, nil)
+			},
+		},
+		{
+			// the access control of the user leaves the dataset out
+			name: "Dataset the user cannot read",
+			mockSetup: func(ms *mock_store.MockStore, md *mockDataplatform.MockDataPlatformService) {
+				ms.EXPECT().GetDatasetById(mock.Anything, lookupDatasetId.String()).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: datasetErrors.ErrInvalidRuleLookup,
+		},
+		{
+			name: "Dataset whose rows the user can only read some of",
+			mockSetup: func(ms *mock_store.MockStore, md *mockDataplatform.MockDataPlatformService) {
+				ms.EXPECT().GetDatasetById(mock.Anything, lookupDatasetId.String()).Return(&storemodels.Dataset{ID: lookupDatasetId}, nil)
+				ms.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				ms.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, lookupDatasetId, userId).Return([]storemodels.DatasetRowPolicy{
+					{FilterConfig: []byte(`{"logical_operator":"AND","conditions":[{"column":"vendor_name","operator":"eq","value":"Acme"}]}`)},
+				}, nil)
+			},
+			wantErr: datasetErrors.ErrInvalidRuleLookup,
+		},
+		{
+			name: "Value column masked for the user",
+			mockSetup: func(ms *mock_store.MockStore, md *mockDataplatform.MockDataPlatformService) {
+				ms.EXPECT().GetDatasetById(mock.Anything, lookupDatasetId.String()).Return(&storemodels.Dataset{ID: lookupDatasetId}, nil)
+				ms.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				ms.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, lookupDatasetId, userId).Return([]storemodels.DatasetRowPolicy{}, nil)
+				ms.EXPECT().GetDatasetColumnPoliciesForUser(mock.Anything, lookupDatasetId, userId).Return([]storemodels.DatasetColumnPolicy{
+					{Column: "category", Action: storemodels.ColumnPolicyActionMask},
+				}, nil)
+				md.EXPECT().GetDatasetMetadata(mock.Anything, mock.Anything, lookupDatasetId.String()).Return(lookupMetadata, nil)
+			},
+			wantErr: datasetErrors.ErrInvalidRuleLookup,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			mockDataplatformService := mockDataplatform.NewMockDataPlatformService(t)
+			tt.mockSetup(mockStore, mockDataplatformService)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userId, []uuid.UUID{uuid.New()})
+			s := &datasetService{dataplatformService: mockDataplatformService, datasetStore: mockStore}
+			err := s.validateDatasetRuleAssignments(ctx, uuid.New(), ruleLookupSchema, ruleLookupAssignments(lookupDatasetId))
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDatasetRuleAssignmentsLookupWithoutUser(t *testing.T) {
+	// access to the lookup dataset cannot be checked without a user, nothing is read
+	mockStore := mock_store.NewMockStore(t)
+
+	s := &datasetService{datasetStore: mockStore}
+	err := s.validateDatasetRuleAssignments(context.Background(), uuid.New(), ruleLookupSchema, ruleLookupAssignments(uuid.New()))
+
+	assert.ErrorIs(t, err, datasetErrors.ErrNoUserForDataPolicies)
+}
+
+var ruleLookupSchema = map[string]dataplatformDataModels.ColumnMetadata{
+	"merchant": {Type: "string"},
+	"category": {Type: "string"},
+}
+
+func ruleLookupAssignments(lookupDatasetId uuid.UUID) []rulemodels.RuleAssignment {
+	return []rulemodels.RuleAssignment{{
+		Column:    "category",
+		ValueType: rulemodels.RuleAssignmentValueTypeLookup,
+		Lookup:    &rulemodels.RuleLookup{DatasetId: lookupDatasetId, JoinColumn: "merchant", LookupColumn: "vendor_name", ValueColumn: "category"},
+	}}
+}
+
+func TestGetDatasetRuleSetForDataPlatform(t *testing.T) {
+	vendorMasterId := uuid.New()
+	filterConfig := rulemodels.FilterConfig{Sql: "merchant = 'Acme'"}
+
+	categoryRule := rulemodels.Rule{ID: uuid.New(), Column: "category", Value: "Office", Priority: 1, IsEnabled: true, FilterConfig: filterConfig}
+	disabledRule := rulemodels.Rule{ID: uuid.New(), Column: "category", Value: "Travel", Priority: 2, IsEnabled: false}
+	vendorRule := rulemodels.Rule{
+		ID:           uuid.New(),
+		Column:       "cost_center",
+		Priority:     1,
+		IsEnabled:    true,
+		FilterConfig: filterConfig,
+		Assignments: []rulemodels.RuleAssignment{
+			{Column: "cost_center", ValueType: rulemodels.RuleAssignmentValueTypeColumn, SourceColumn: "department"},
+			{Column: "category", ValueType: rulemodels.RuleAssignmentValueTypeLookup, Lookup: &rulemodels.RuleLookup{
+				DatasetId: vendorMasterId, JoinColumn: "merchant", LookupColumn: "vendor_name", ValueColumn: "category",
+			}},
+		},
+	}
+
+	// the rules of the column come first, the rules of other columns assigning it follow
+	got := getDatasetRuleSetForDataPlatform("category", []rulemodels.Rule{vendorRule, categoryRule, disabledRule})
+
+	assert.Equal(t, []dataplatformDataModels.Rule{
+		{Id: categoryRule.ID.String(), Priority: 1, ValueToApply: "Office", SqlCondition: filterConfig.Sql},
+		{Id: vendorRule.ID.String(), Priority: 3, SqlCondition: filterConfig.Sql, Lookup: &dataplatformDataModels.RuleLookup{
+			DatasetId: vendorMasterId.String(), JoinColumn: "merchant", LookupColumn: "vendor_name", ValueColumn: "category",
+		}},
+	}, got)
+
+	got = getDatasetRuleSetForDataPlatform("cost_center", []rulemodels.Rule{vendorRule})
+
+	assert.Equal(t, []dataplatformDataModels.Rule{
+		{Id: vendorRule.ID.String(), Priority: 1, ValueColumn: "department", SqlCondition: filterConfig.Sql},
+	}, got)
+}
+
+func TestGetDatasetRulesColumns(t *testing.T) {
+	vendorRule := rulemodels.Rule{Column: "category", Assignments: []rulemodels.RuleAssignment{
+		{Column: "category", ValueType: rulemodels.RuleAssignmentValueTypeLiteral, Value: "Travel"},
+		{Column: "cost_center", ValueType: rulemodels.RuleAssignmentValueTypeLiteral, Value: "CC-1"},
+	}}
+	singleRule := rulemodels.Rule{Column: "category", Value: "Office"}
+
+	assert.Equal(t, []string{"category", "cost_center"}, getDatasetRulesColumns("category", singleRule, vendorRule))
+	assert.Equal(t, []string{"category"}, getDatasetRulesColumns("category", singleRule))
+}
@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	ruleservice "github.com/Zampfi/application-platform/services/api/core/rules/service"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
+)
+
+// datasetRuleBundleEntry is a rule of a bundle checked against the target dataset and ready to be saved
+type datasetRuleBundleEntry struct {
+	title        string
+	description  string
+	value        string
+	assignments  []rulemodels.RuleAssignment
+	filters      models.FilterModel
+	filterConfig rulemodels.FilterConfig
+	isEnabled    bool
+}
+
+// datasetRuleBundlePlan is what importing the rules of a column changes, matches holds for each entry the rule of the
+// dataset it updates and changes what differs from that rule
+type datasetRuleBundlePlan struct {
+	column    string
+	entries   []datasetRuleBundleEntry
+	matches   []*rulemodels.Rule
+	changes   [][]models.DatasetRuleVersionChange
+	removed   []rulemodels.Rule
+	unchanged int
+}
+
+func (p datasetRuleBundlePlan) hasChanges() bool {
+	return p.unchanged < len(p.entries) || len(p.removed) > 0
+}
+
+// columns returns the column of the plan and the other columns its rules assign before and after the import
+func (p datasetRuleBundlePlan) columns() []string {
+	rules := slices.Clone(p.removed)
+	for i, entry := range p.entries {
+		rules = append(rules, rulemodels.Rule{Column: p.column, Assignments: entry.assignments})
+		if rule := p.matches[i]; rule != nil {
+			rules = append(rules, *rule)
+		}
+	}
+
+	return getDatasetRulesColumns(p.column, rules...)
+}
+
+// validateDatasetRuleBundle checks the shape of a bundle, its rules are checked against the dataset separately
+func validateDatasetRuleBundle(bundle models.DatasetRuleBundle) error {
+	if bundle.Version != datasetConstants.DatasetRuleBundleVersion {
+		return fmt.Errorf("%w: %d", errors.ErrUnsupportedRuleBundleVersion, bundle.Version)
+	}
+
+	if len(bundle.Columns) == 0 {
+		return fmt.Errorf("%w: no columns", errors.ErrInvalidRuleBundle)
+	}
+
+	columns := make(map[string]bool, len(bundle.Columns))
+	for _, bundleColumn := range bundle.Columns {
+		if bundleColumn.Column == "" {
+			return fmt.Errorf("%w: column without a name", errors.ErrInvalidRuleBundle)
+		}
+		if columns[bundleColumn.Column] {
+			return fmt.Errorf("%w: duplicate column %s", errors.ErrInvalidRuleBundle, bundleColumn.Column)
+		}
+		columns[bundleColumn.Column] = true
+
+		titles := make(map[string]bool, len(bundleColumn.Rules))
+		for _, rule := range bundleColumn.Rules {
+			title := strings.TrimSpace(rule.Title)
+			if titles[title] {
+				return fmt.Errorf("%w: duplicate rule %q in column %s", errors.ErrInvalidRuleBundle, title, bundleColumn.Column)
+			}
+			titles[title] = true
+		}
+	}
+
+	return nil
+}
+
+// prepareDatasetRuleBundleEntry validates a rule of a bundle the way a rule created on the dataset is validated
+func (s *datasetService) prepareDatasetRuleBundleEntry(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, column string, rule models.DatasetRuleBundleRule) (datasetRuleBundleEntry, error) {
+	params, err := normalizeDatasetRuleAssignments(models.DatasetRuleParams{
+		Title:       strings.TrimSpace(rule.Title),
+		Description: rule.Description,
+		Column:      column,
+		Filters:     rule.Filters,
+		Value:       rule.Value,
+		Assignments: rule.Assignments,
+	})
+	if err != nil {
+		return datasetRuleBundleEntry{}, fmt.Errorf("%w: rule %q of column %s", err, strings.TrimSpace(rule.Title), column)
+	}
+
+	columnDatatypes, err := s.validateDatasetRuleParams(ctx, merchantId, datasetId, params)
+	if err != nil {
+		return datasetRuleBundleEntry{}, fmt.Errorf("%w: rule %q of column %s", err, params.Title, column)
+	}
+
+	assignments, err := s.resolveDatasetRuleAssignments(ctx, merchantId, datasetId, params)
+	if err != nil {
+		return datasetRuleBundleEntry{}, fmt.Errorf("%w: rule %q of column %s", err, params.Title, column)
+	}
+
+	value, err := s.resolveDatasetRuleValue(ctx, merchantId, datasetId, params)
+	if err != nil {
+		return datasetRuleBundleEntry{}, fmt.Errorf("%w: rule %q of column %s", err, params.Title, column)
+	}
+
+	queryConfig, err := s.mapUpdateDatasetDataParamsToQueryConfig(datasetId, models.UpdateDatasetDataParams{Filters: params.Filters}, columnDatatypes, make(map[string]querybuildermodels.CustomDataTypeConfig))
+	if err != nil {
+		return datasetRuleBundleEntry{}, err
+	}
+
+	query, queryParams, err := s.queryBuilderService.ToFilterSQL(ctx, queryConfig.Filters)
+	if err != nil {
+		return datasetRuleBundleEntry{}, err
+	}
+
+	isEnabled := rule.IsEnabled == nil || *rule.IsEnabled
+
+	return datasetRuleBundleEntry{
+		title:       params.Title,
+		description: params.Description,
+		value:       fmt.Sprintf("%v", value),
+		assignments: assignments,
+		filters:     params.Filters,
+		filterConfig: rulemodels.FilterConfig{
+			QueryConfig: queryConfig,
+			Sql:         query,
+			Args:        queryParams,
+		},
+		isEnabled: isEnabled,
+	}, nil
+}
+
+// planDatasetRuleBundleColumn matches the rules of a bundle column with the rules of the dataset, given in priority
+// order, by title. Rules matched keep their id, the priority of a matched rule is reported as changed only when its
+// place among the other matched rules moves.
+func planDatasetRuleBundleColumn(column string, entries []datasetRuleBundleEntry, rules []rulemodels.Rule) datasetRuleBundlePlan {
+	plan := datasetRuleBundlePlan{
+		column:  column,
+		entries: entries,
+		matches: make([]*rulemodels.Rule, len(entries)),
+		changes: make([][]models.DatasetRuleVersionChange, len(entries)),
+	}
+
+	rulesByTitle := make(map[string]int, len(rules))
+	for i := len(rules) - 1; i >= 0; i-- {
+		rulesByTitle[strings.TrimSpace(rules[i].Title)] = i
+	}
+
+	matchedRules := make([]bool, len(rules))
+	var matchedOrder []int
+	for i, entry := range entries {
+		ruleIndex, ok := rulesByTitle[entry.title]
+		if !ok {
+			continue
+		}
+		plan.matches[i] = &rules[ruleIndex]
+		matchedRules[ruleIndex] = true
+		matchedOrder = append(matchedOrder, ruleIndex)
+	}
+
+	for i, matched := range matchedRules {
+		if !matched {
+			plan.removed = append(plan.removed, rules[i])
+		}
+	}
+
+	// the rank of each matched rule among the matched rules, in the dataset and in the bundle
+	datasetRanks := slices.Clone(matchedOrder)
+	slices.Sort(datasetRanks)
+
+	matchRank := 0
+	for i, entry := range entries {
+		rule := plan.matches[i]
+		if rule == nil {
+			continue
+		}
+
+		changes := getDatasetRuleBundleChanges(entry, *rule)
+		if datasetRanks[matchRank] != matchedOrder[matchRank] {
+			changes = append(changes, models.DatasetRuleVersionChange{Field: "priority", From: rule.Priority, To: i + 1})
+		}
+		matchRank++
+
+		plan.changes[i] = changes
+		if len(changes) == 0 {
+			plan.unchanged++
+		}
+	}
+
+	return plan
+}
+
+func getDatasetRuleBundleChanges(entry datasetRuleBundleEntry, rule rulemodels.Rule) []models.DatasetRuleVersionChange {
+	changes := []models.DatasetRuleVersionChange{}
+
+	if entry.description != rule.Description {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "description", From: rule.Description, To: entry.description})
+	}
+	if entry.value != rule.Value {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "value", From: rule.Value, To: entry.value})
+	}
+	if !equalRuleAssignments(entry.assignments, rule.Assignments) {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "assignments", From: rule.Assignments, To: entry.assignments})
+	}
+
+	ruleFilters := models.RuleFilters(rule)
+	if !equalRuleFilters(entry.filters, ruleFilters) {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "filters", From: ruleFilters, To: entry.filters})
+	}
+
+	if entry.isEnabled != rule.IsEnabled {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "is_enabled", From: rule.IsEnabled, To: entry.isEnabled})
+	}
+
+	return changes
+}
+
+// equalRuleFilters compares filters by their json, a missing top level operator is the and it defaults to
+func equalRuleFilters(filters models.FilterModel, other models.FilterModel) bool {
+	filters.LogicalOperator = *defaultLogicalOperator(filters.LogicalOperator)
+	other.LogicalOperator = *defaultLogicalOperator(other.LogicalOperator)
+
+	filtersJson, err := json.Marshal(filters)
+	if err != nil {
+		return false
+	}
+	otherJson, err := json.Marshal(other)
+	if err != nil {
+		return false
+	}
+
+	return string(filtersJson) == string(otherJson)
+}
+
+// equalRuleAssignments compares assignments by their json, no assignments and an empty list both mean the rule
+// writes its value to its column
+func equalRuleAssignments(assignments []rulemodels.RuleAssignment, other []rulemodels.RuleAssignment) bool {
+	if len(assignments) == 0 || len(other) == 0 {
+		return len(assignments) == len(other)
+	}
+
+	assignmentsJson, err := json.Marshal(assignments)
+	if err != nil {
+		return false
+	}
+	otherJson, err := json.Marshal(other)
+	if err != nil {
+		return false
+	}
+
+	return string(assignmentsJson) == string(otherJson)
+}
+
+func hasDatasetRuleBundleChange(changes []models.DatasetRuleVersionChange, fields ...string) bool {
+	return slices.ContainsFunc(changes, func(change models.DatasetRuleVersionChange) bool {
+		return slices.Contains(fields, change.Field)
+	})
+}
+
+// getDatasetRuleBundleDiffs lists the rules a plan adds, changes and removes
+func getDatasetRuleBundleDiffs(plan datasetRuleBundlePlan) (added []models.DatasetRuleBundleDiff, changed []models.DatasetRuleBundleDiff, removed []models.DatasetRuleBundleDiff) {
+	for i, entry := range plan.entries {
+		rule := plan.matches[i]
+		if rule == nil {
+			added = append(added, models.DatasetRuleBundleDiff{Column: plan.column, Title: entry.title, Changes: []models.DatasetRuleVersionChange{}})
+			continue
+		}
+		if len(plan.changes[i]) > 0 {
+			changed = append(changed, models.DatasetRuleBundleDiff{Column: plan.column, Title: entry.title, RuleId: &rule.ID, Changes: plan.changes[i]})
+		}
+	}
+
+	for _, rule := range plan.removed {
+		ruleId := rule.ID
+		removed = append(removed, models.DatasetRuleBundleDiff{Column: plan.column, Title: rule.Title, RuleId: &ruleId, Changes: []models.DatasetRuleVersionChange{}})
+	}
+
+	return added, changed, removed
+}
+
+// applyDatasetRuleBundlePlan saves the rules of a column as planned and sets their priorities to the bundle order
+func applyDatasetRuleBundlePlan(ctx context.Context, ruleService ruleservice.RuleService, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, plan datasetRuleBundlePlan) error {
+	for _, rule := range plan.removed {
+		if err := ruleService.DeleteRule(ctx, storemodels.DeleteRuleParams{RuleId: rule.ID, DeletedBy: userId}); err != nil {
+			return err
+		}
+	}
+
+	rulePriorities := make([]storemodels.RulePriority, 0, len(plan.entries))
+	for i, entry := range plan.entries {
+		var ruleId uuid.UUID
+		if rule := plan.matches[i]; rule != nil {
+			ruleId = rule.ID
+			if hasDatasetRuleBundleChange(plan.changes[i], "description", "value", "assignments", "filters") {
+				if err := ruleService.UpdateRule(ctx, ruleId, storemodels.UpdateRuleParams{
+					Title:        rule.Title,
+					Description:  entry.description,
+					Value:        entry.value,
+					FilterConfig: entry.filterConfig,
+					Assignments:  entry.assignments,
+					UpdatedBy:    userId,
+				}); err != nil {
+					return err
+				}
+			}
+			if hasDatasetRuleBundleChange(plan.changes[i], "is_enabled") {
+				if err := ruleService.SetRuleEnabled(ctx, ruleId, entry.isEnabled, userId); err != nil {
+					return err
+				}
+			}
+		} else {
+			ruleId = uuid.New()
+			if err := ruleService.CreateRule(ctx, storemodels.CreateRuleParams{
+				Id:             ruleId,
+				Title:          entry.title,
+				Description:    entry.description,
+				OrganizationId: merchantId,
+				DatasetId:      datasetId,
+				Column:         plan.column,
+				Value:          entry.value,
+				FilterConfig:   entry.filterConfig,
+				Assignments:    entry.assignments,
+				CreatedBy:      userId,
+			}); err != nil {
+				return err
+			}
+			if !entry.isEnabled {
+				if err := ruleService.SetRuleEnabled(ctx, ruleId, false, userId); err != nil {
+					return err
+				}
+			}
+		}
+
+		rulePriorities = append(rulePriorities, storemodels.RulePriority{RuleId: ruleId, Priority: i + 1})
+	}
+
+	if len(rulePriorities) == 0 {
+		return nil
+	}
+
+	return ruleService.UpdateRulePriority(ctx, storemodels.UpdateRulePriorityParams{
+		DatasetId:    datasetId,
+		RulePriority: rulePriorities,
+		UpdatedBy:    userId,
+	})
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
+)
+
+func TestValidateDatasetRuleBundle(t *testing.T) {
+	rule := models.DatasetRuleBundleRule{Title: "Travel", Value: "travel"}
+
+	tests := []struct {
+		name    string
+		bundle  models.DatasetRuleBundle
+		wantErr bool
+	}{
+		{
+			name:   "Valid bundle",
+			bundle: models.DatasetRuleBundle{Version: 1, Columns: []models.DatasetRuleBundleColumn{{Column: "category", Rules: []models.DatasetRuleBundleRule{rule}}}},
+		},
+		{
+			name:    "Unsupported version",
+			bundle:  models.DatasetRuleBundle{Version: 2, Columns: []models.DatasetRuleBundleColumn{{Column: "category"}}},
+			wantErr: true,
+		},
+		{
+			name:    "No columns",
+			bundle:  models.DatasetRuleBundle{Version: 1},
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate column",
+			bundle:  models.DatasetRuleBundle{Version: 1, Columns: []models.DatasetRuleBundleColumn{{Column: "category"}, {Column: "category"}}},
+			wantErr: true,
+		},
+		{
+			name:    "Duplicate rule title",
+			bundle:  models.DatasetRuleBundle{Version: 1, Columns: []models.DatasetRuleBundleColumn{{Column: "category", Rules: []models.DatasetRuleBundleRule{rule, rule}}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDatasetRuleBundle(tt.bundle)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPlanDatasetRuleBundleColumn(t *testing.T) {
+	vendorFilters := func(vendor string) models.FilterModel {
+		return models.FilterModel{LogicalOperator: "AND", Conditions: []models.Filter{{Column: "vendor", Operator: "eq", Value: vendor}}}
+	}
+	rule := func(title string, priority int, value string, vendor string) rulemodels.Rule {
+		return rulemodels.Rule{
+			ID:        uuid.New(),
+			Title:     title,
+			Value:     value,
+			Priority:  priority,
+			IsEnabled: true,
+			FilterConfig: rulemodels.FilterConfig{QueryConfig: querybuildermodels.QueryConfig{Filters: querybuildermodels.FilterModel{
+				LogicalOperator: "AND",
+				Conditions: []querybuildermodels.Filter{
+					{Column: querybuildermodels.ColumnConfig{Column: "vendor"}, Operator: "eq", Value: vendor},
+				},
+			}}},
+		}
+	}
+	entry := func(title string, value string, vendor string) datasetRuleBundleEntry {
+		return datasetRuleBundleEntry{title: title, value: value, filters: vendorFilters(vendor), isEnabled: true}
+	}
+
+	t.Run("Same rules import without changes", func(t *testing.T) {
+		rules := []rulemodels.Rule{rule("Travel", 1, "travel", "Uber"), rule("Office", 2, "office", "Staples")}
+		plan := planDatasetRuleBundleColumn("category", []datasetRuleBundleEntry{entry("Travel", "travel", "Uber"), entry("Office", "office", "Staples")}, rules)
+
+		assert.False(t, plan.hasChanges())
+		assert.Equal(t, 2, plan.unchanged)
+	})
+
+	t.Run("Rules are added, changed, moved and removed", func(t *testing.T) {
+		rules := []rulemodels.Rule{rule("Travel", 1, "travel", "Uber"), rule("Office", 2, "office", "Staples"), rule("Legacy", 3, "other", "Acme")}
+		plan := planDatasetRuleBundleColumn("category", []datasetRuleBundleEntry{
+			entry("Office", "office", "Staples"),
+			entry("Travel", "transport", "Uber"),
+			entry("Meals", "meals", "Doordash"),
+		}, rules)
+
+		assert.True(t, plan.hasChanges())
+		added, changed, removed := getDatasetRuleBundleDiffs(plan)
+
+		assert.Len(t, added, 1)
+		assert.Equal(t, "Meals", added[0].Title)
+
+		assert.Len(t, removed, 1)
+		assert.Equal(t, rules[2].ID, *removed[0].RuleId)
+
+		assert.Len(t, changed, 2)
+		assert.Equal(t, "Office", changed[0].Title)
+		assert.Equal(t, []models.DatasetRuleVersionChange{{Field: "priority", From: 2, To: 1}}, changed[0].Changes)
+		assert.Equal(t, "Travel", changed[1].Title)
+		assert.True(t, hasDatasetRuleBundleChange(changed[1].Changes, "value"))
+		assert.True(t, hasDatasetRuleBundleChange(changed[1].Changes, "priority"))
+		assert.False(t, hasDatasetRuleBundleChange(changed[1].Changes, "filters"))
+	})
+}
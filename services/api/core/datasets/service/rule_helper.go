@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	dataplatformactionconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/constants"
+	dataplatformactionmodels "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/models"
+	"github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
+	dataplatformConstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
+	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
+	dataplatformcoremodels "github.com/Zampfi/application-platform/services/api/core/dataplatform/models"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+)
+
+// getDatasetRule returns a live rule of the dataset, deleted rules and rules of other datasets are reported as missing
+func (s *datasetService) getDatasetRule(ctx context.Context, datasetId uuid.UUID, ruleId uuid.UUID) (rulemodels.Rule, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	rules, err := s.ruleService.GetRuleByIds(ctx, []uuid.UUID{ruleId})
+	if err != nil {
+		logger.Error("failed to get rule", zap.String("rule_id", ruleId.String()), zap.String("error", err.Error()))
+		return rulemodels.Rule{}, errors.ErrFailedToGetRule
+	}
+
+	if len(rules) == 0 || rules[0].DatasetId != datasetId || rules[0].DeletedAt != nil {
+		return rulemodels.Rule{}, errors.ErrRuleNotFound
+	}
+
+	return rules[0], nil
+}
+
+// validateDatasetRuleParams checks a rule against the schema of the dataset and returns the datatypes of its columns
+func (s *datasetService) validateDatasetRuleParams(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.DatasetRuleParams) (map[string]dataplatformConstants.Datatype, error) {
+	if strings.TrimSpace(params.Title) == "" {
+		return nil, errors.ErrEmptyRuleTitle
+	}
+
+	return s.validateDatasetRuleDefinition(ctx, merchantId, datasetId, params)
+}
+
+// validateDatasetRuleDefinition checks what a rule writes and which rows it matches, a candidate rule only
+// previewed does not need a title
+func (s *datasetService) validateDatasetRuleDefinition(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.DatasetRuleParams) (map[string]dataplatformConstants.Datatype, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	// the values of a rule with assignments are checked with the assignments
+	if value, ok := params.Value.(string); len(params.Assignments) == 0 && (params.Value == nil || (ok && strings.TrimSpace(value) == "")) {
+		return nil, errors.ErrEmptyRuleValue
+	}
+
+	if len(params.Filters.Conditions) == 0 && !hasDatasetRuleLookup(params.Assignments) {
+		return nil, errors.ErrEmptyRuleFilters
+	}
+
+	datasetInfo, err := s.dataplatformService.GetDatasetMetadata(ctx, merchantId.String(), datasetId.String())
+	if err != nil {
+		logger.Error("failed to get dataset metadata", zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetDatasetMetadata
+	}
+
+	if _, ok := datasetInfo.Schema[params.Column]; !ok {
+		return nil, fmt.Errorf("%w: %s", errors.ErrInvalidRuleColumn, params.Column)
+	}
+
+	for _, column := range getFilterColumns(params.Filters.Conditions) {
+		if _, ok := datasetInfo.Schema[column]; !ok {
+			return nil, fmt.Errorf("%w: %s", errors.ErrInvalidRuleFilterColumn, column)
+		}
+	}
+
+	if err := s.validateDatasetRuleAssignments(ctx, merchantId, datasetInfo.Schema, params.Assignments); err != nil {
+		return nil, err
+	}
+
+	columnDatatypes := make(map[string]dataplatformConstants.Datatype, len(datasetInfo.Schema))
+	for columnName, columnMetadata := range datasetInfo.Schema {
+		columnDatatypes[columnName] = dataplatformConstants.Datatype(columnMetadata.Type)
+	}
+
+	return columnDatatypes, nil
+}
+
+// resolveDatasetRuleValue applies the checks an update of the column goes through to the value of an edited rule
+func (s *datasetService) resolveDatasetRuleValue(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.DatasetRuleParams) (interface{}, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	statusWorkflows, err := s.getStatusWorkflows(ctx, datasetId)
+	if err != nil {
+		return nil, err
+	}
+	if workflow, ok := statusWorkflows[params.Column]; ok {
+		if err := s.enforceStatusWorkflow(ctx, merchantId, datasetId, workflow, models.UpdateDatasetDataParams{
+			Filters:    params.Filters,
+			Update:     models.UpdateColumn{Column: params.Column, Value: params.Value},
+			SourceType: datasetConstants.UpdateColumnSourceTypeRule,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	datasetMetaInfo, err := s.datasetStore.GetDatasetById(ctx, datasetId.String())
+	if err != nil {
+		logger.Error("failed to get dataset meta info", zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetDatasetById
+	}
+
+	var datasetMetaData models.DatasetMetadataConfig
+	if err := json.Unmarshal([]byte(datasetMetaInfo.Metadata), &datasetMetaData); err != nil {
+		logger.Error("failed to unmarshal dataset metadata", zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToUnmarshalMetadata
+	}
+
+	if datasetMetaData.Columns[params.Column].CustomType == constants.DatabricksColumnCustomTypeTags {
+		value, _, err := s.resolveTagValue(ctx, params.Value)
+		return value, err
+	}
+
+	return params.Value, nil
+}
+
+// applyDatasetRules sends the enabled rules of the columns to the data platform in a single action and records it, the
+// first column is the one the change is reported on
+func (s *datasetService) applyDatasetRules(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, columns []string, ruleId uuid.UUID, operation dataplatformactionconstants.UpsertRuleOperation) (models.DatasetAction, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	// a change of the whole rule set of the column has no single rule to report
+	deltaRuleId := ""
+	if ruleId != uuid.Nil {
+		deltaRuleId = ruleId.String()
+	}
+
+	datasetRules, err := s.getDatasetRuleSetsForDataPlatform(ctx, merchantId, datasetId, columns)
+	if err != nil {
+		logger.Error("failed to get dataset rules for data platfrom", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return models.DatasetAction{}, err
+	}
+
+	dataplatformAction, err := s.dataplatformService.UpdateDataset(ctx, dataplatformcoremodels.UpdateDatasetPayload{
+		MerchantID: merchantId.String(),
+		ActorId:    userId.String(),
+		ActionMetadataPayload: dataplatformactionmodels.UpdateDatasetEvent{
+			EventType: dataplatformactionconstants.UpdateDatasetEventTypeUpsertRules,
+			EventData: dataplatformactionmodels.UpdateDatasetActionPayload{
+				DatasetId: datasetId.String(),
+				DatasetConfig: dataplatformDataModels.DatasetConfig{
+					Rules: datasetRules,
+				},
+			},
+			EventMetadata: dataplatformactionmodels.UpsertRuleEventMetadata{
+				DeltaRuleId: deltaRuleId,
+				Column:      columns[0],
+				Type:        operation,
+			},
+		},
+	})
+	if err != nil {
+		logger.Error("failed to update dataset", zap.String("error", err.Error()))
+		return models.DatasetAction{}, errors.ErrFailedToUpdateDataset
+	}
+
+	action, err := s.dataplatformService.GetActionById(ctx, merchantId.String(), dataplatformAction.ActionID)
+	if err != nil {
+		return models.DatasetAction{}, err
+	}
+
+	isCompleted := slices.Contains(dataplatformactionconstants.ActionTerminationStatuses, action.ActionStatus)
+
+	err = s.datasetActionService.CreateDatasetAction(ctx, merchantId, storemodels.CreateDatasetActionParams{
+		ActionId:    action.ID,
+		ActionType:  string(action.ActionType),
+		DatasetId:   datasetId,
+		Status:      string(action.ActionStatus),
+		Config:      action.ActionMetadata,
+		ActionBy:    userId,
+		IsCompleted: isCompleted,
+	})
+	if err != nil {
+		logger.Error("failed to create dataset action", zap.String("error", err.Error()))
+		return models.DatasetAction{}, err
+	}
+
+	return models.DatasetAction{
+		ActionId:    action.ID,
+		ActionType:  action.ActionType,
+		DatasetId:   datasetId,
+		Status:      action.ActionStatus,
+		Config:      action.ActionMetadata,
+		ActionBy:    userId,
+		IsCompleted: isCompleted,
+	}, nil
+}
+
+// andFilters combines filter models into one matching the rows all of them match
+func andFilters(filters ...models.FilterModel) models.FilterModel {
+	return combineFilters(models.LogicalOperator(querybuilderconstants.LogicalOperatorAnd), filters)
+}
+
+// orFilters combines filter models into one matching the rows any of them matches
+func orFilters(filters ...models.FilterModel) models.FilterModel {
+	return combineFilters(models.LogicalOperator(querybuilderconstants.LogicalOperatorOr), filters)
+}
+
+func combineFilters(logicalOperator models.LogicalOperator, filters []models.FilterModel) models.FilterModel {
+	conditions := make([]models.Filter, 0, len(filters))
+	for _, filter := range filters {
+		if len(filter.Conditions) == 0 {
+			continue
+		}
+		conditions = append(conditions, models.Filter{
+			LogicalOperator: defaultLogicalOperator(filter.LogicalOperator),
+			Conditions:      filter.Conditions,
+		})
+	}
+
+	return models.FilterModel{
+		LogicalOperator: logicalOperator,
+		Conditions:      conditions,
+	}
+}
+
+// getHigherPriorityRules returns the enabled rules of the column applied before a rule sitting at the priority
+func (s *datasetService) getHigherPriorityRules(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, column string, priority int, excludeRuleId *uuid.UUID) ([]rulemodels.Rule, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	rulesByDataset, err := s.ruleService.GetRules(ctx, storemodels.FilterRuleParams{
+		OrganizationId: merchantId,
+		DatasetColumns: []storemodels.DatasetColumn{{DatasetId: datasetId, Columns: []string{column}}},
+	})
+	if err != nil {
+		logger.Error("failed to get rules of column", zap.String("column", column), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetRule
+	}
+
+	var rules []rulemodels.Rule
+	for _, rule := range rulesByDataset[datasetId.String()][column] {
+		if !rule.IsEnabled || rule.Priority >= priority || (excludeRuleId != nil && rule.ID == *excludeRuleId) {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// countDatasetRows counts the rows matching the filters, rules apply to every row so row policies are not applied
+func (s *datasetService) countDatasetRows(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, filters models.FilterModel) (int64, error) {
+	data, err := s.getDataByDatasetId(ctx, merchantId, datasetId.String(), models.DatasetParams{
+		Columns:    []models.ColumnConfig{{Column: datasetConstants.ZampIDColumn}},
+		Filters:    filters,
+		CountAll:   true,
+		Pagination: &models.Pagination{Page: 1, PageSize: 1},
+	}, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if data.TotalCount == nil {
+		return 0, nil
+	}
+
+	return *data.TotalCount, nil
+}
+
+// getRowIdsFilter matches the rows with the ids
+func getRowIdsFilter(rowIds []string) models.FilterModel {
+	return models.FilterModel{
+		LogicalOperator: models.LogicalOperator(querybuilderconstants.LogicalOperatorAnd),
+		Conditions: []models.Filter{
+			{Column: datasetConstants.ZampIDColumn, Operator: querybuilderconstants.InOperator, Value: rowIds},
+		},
+	}
+}
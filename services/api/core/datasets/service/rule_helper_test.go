@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
+	datasetErrors "github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	mockDataplatform "github.com/Zampfi/application-platform/services/api/mocks/core/dataplatform"
+	mockruleservice "github.com/Zampfi/application-platform/services/api/mocks/core/rules/service"
+)
+
+func TestValidateDatasetRuleParams(t *testing.T) {
+	valid := func() models.DatasetRuleParams {
+		return models.DatasetRuleParams{
+			Title:  "Payroll vendors",
+			Column: "category",
+			Filters: models.FilterModel{
+				LogicalOperator: "AND",
+				Conditions:      []models.Filter{{Column: "vendor", Operator: "eq", Value: "Acme Payroll"}},
+			},
+			Value: "Payroll",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*models.DatasetRuleParams)
+		wantErr error
+	}{
+		{
+			name:   "Valid rule",
+			modify: func(p *models.DatasetRuleParams) {},
+		},
+		{
+			name:    "Empty title",
+			modify:  func(p *models.DatasetRuleParams) { p.Title = " " },
+			wantErr: datasetErrors.ErrEmptyRuleTitle,
+		},
+		{
+			name:    "Empty value",
+			modify:  func(p *models.DatasetRuleParams) { p.Value = "" },
+			wantErr: datasetErrors.ErrEmptyRuleValue,
+		},
+		{
+			name:    "No filters",
+			modify:  func(p *models.DatasetRuleParams) { p.Filters.Conditions = nil },
+			wantErr: datasetErrors.ErrEmptyRuleFilters,
+		},
+		{
+			name:    "Column missing from the schema",
+			modify:  func(p *models.DatasetRuleParams) { p.Column = "memo" },
+			wantErr: datasetErrors.ErrInvalidRuleColumn,
+		},
+		{
+			name: "Nested filter on a column missing from the schema",
+			modify: func(p *models.DatasetRuleParams) {
+				p.Filters.Conditions = []models.Filter{{Conditions: []models.Filter{{Column: "memo", Operator: "eq", Value: "x"}}}}
+			},
+			wantErr: datasetErrors.ErrInvalidRuleFilterColumn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDataplatformService := mockDataplatform.NewMockDataPlatformService(t)
+			mockDataplatformService.EXPECT().GetDatasetMetadata(mock.Anything, mock.Anything, mock.Anything).Return(dataplatformDataModels.DatasetMetadata{
+				Schema: map[string]dataplatformDataModels.ColumnMetadata{
+					"vendor":   {Type: "string"},
+					"category": {Type: "string"},
+				},
+			}, nil).Maybe()
+
+			s := &datasetService{dataplatformService: mockDataplatformService}
+			params := valid()
+			tt.modify(&params)
+
+			columnDatatypes, err := s.validateDatasetRuleParams(context.Background(), uuid.New(), uuid.New(), params)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, columnDatatypes, 2)
+		})
+	}
+}
+
+func TestGetDatasetRule(t *testing.T) {
+	datasetId := uuid.New()
+	ruleId := uuid.New()
+	deletedAt := time.Now()
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mockruleservice.MockRuleService)
+		wantErr   error
+	}{
+		{
+			name: "Rule of the dataset",
+			mockSetup: func(m *mockruleservice.MockRuleService) {
+				m.EXPECT().GetRuleByIds(mock.Anything, []uuid.UUID{ruleId}).Return([]rulemodels.Rule{{ID: ruleId, DatasetId: datasetId}}, nil)
+			},
+		},
+		{
+			name: "Rule of another dataset",
+			mockSetup: func(m *mockruleservice.MockRuleService) {
+				m.EXPECT().GetRuleByIds(mock.Anything, []uuid.UUID{ruleId}).Return([]rulemodels.Rule{{ID: ruleId, DatasetId: uuid.New()}}, nil)
+			},
+			wantErr: datasetErrors.ErrRuleNotFound,
+		},
+		{
+			name: "Deleted rule",
+			mockSetup: func(m *mockruleservice.MockRuleService) {
+				m.EXPECT().GetRuleByIds(mock.Anything, []uuid.UUID{ruleId}).Return([]rulemodels.Rule{{ID: ruleId, DatasetId: datasetId, DeletedAt: &deletedAt}}, nil)
+			},
+			wantErr: datasetErrors.ErrRuleNotFound,
+		},
+		{
+			name: "Missing rule",
+			mockSetup: func(m *mockruleservice.MockRuleService) {
+				m.EXPECT().GetRuleByIds(mock.Anything, []uuid.UUID{ruleId}).Return(nil, nil)
+			},
+			wantErr: datasetErrors.ErrRuleNotFound,
+		},
+		{
+			name: "Store error",
+			mockSetup: func(m *mockruleservice.MockRuleService) {
+				m.EXPECT().GetRuleByIds(mock.Anything, []uuid.UUID{ruleId}).Return(nil, errors.New("db error"))
+			},
+			wantErr: datasetErrors.ErrFailedToGetRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuleService := mockruleservice.NewMockRuleService(t)
+			tt.mockSetup(mockRuleService)
+
+			s := &datasetService{ruleService: mockRuleService}
+			rule, err := s.getDatasetRule(context.Background(), datasetId, ruleId)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, ruleId, rule.ID)
+		})
+	}
+}
+
+func TestRuleFiltersRoundTrip(t *testing.T) {
+	s := &datasetService{}
+	or := models.LogicalOperator("OR")
+	filters := models.FilterModel{
+		LogicalOperator: "AND",
+		Conditions: []models.Filter{
+			{Column: "vendor", Operator: "eq", Value: "Acme Payroll"},
+			{LogicalOperator: &or, Conditions: []models.Filter{{Column: "amount", Operator: "gt", Value: float64(100)}}},
+		},
+	}
+
+	queryConfig, err := s.mapUpdateDatasetDataParamsToQueryConfig(uuid.New(), models.UpdateDatasetDataParams{Filters: filters}, nil, nil)
+	assert.NoError(t, err)
+
+	rule := rulemodels.Rule{FilterConfig: rulemodels.FilterConfig{QueryConfig: queryConfig}}
+	assert.Equal(t, filters, models.RuleFilters(rule))
+}
+
+func TestAndFilters(t *testing.T) {
+	and := models.LogicalOperator("AND")
+	or := models.LogicalOperator("OR")
+	candidate := models.FilterModel{LogicalOperator: "OR", Conditions: []models.Filter{{Column: "vendor", Operator: "eq", Value: "Acme"}}}
+	rule := models.FilterModel{Conditions: []models.Filter{{Column: "amount", Operator: "gt", Value: "100"}}}
+
+	got := andFilters(candidate, models.FilterModel{}, rule)
+
+	assert.Equal(t, models.FilterModel{
+		LogicalOperator: "AND",
+		Conditions: []models.Filter{
+			{LogicalOperator: &or, Conditions: candidate.Conditions},
+			{LogicalOperator: &and, Conditions: rule.Conditions},
+		},
+	}, got)
+	assert.Equal(t, models.LogicalOperator("OR"), orFilters(candidate, rule).LogicalOperator)
+}
+
+func TestGetHigherPriorityRules(t *testing.T) {
+	datasetId := uuid.New()
+	editedRuleId := uuid.New()
+	rules := []rulemodels.Rule{
+		{ID: uuid.New(), Priority: 1, IsEnabled: true},
+		{ID: uuid.New(), Priority: 2, IsEnabled: false},
+		{ID: editedRuleId, Priority: 3, IsEnabled: true},
+		{ID: uuid.New(), Priority: 4, IsEnabled: true},
+		{ID: uuid.New(), Priority: 5, IsEnabled: true},
+	}
+
+	mockRuleService := mockruleservice.NewMockRuleService(t)
+	mockRuleService.EXPECT().GetRules(mock.Anything, mock.Anything).Return(map[string]map[string][]rulemodels.Rule{
+		datasetId.String(): {"category": rules},
+	}, nil)
+
+	s := &datasetService{ruleService: mockRuleService}
+	got, err := s.getHigherPriorityRules(context.Background(), uuid.New(), datasetId, "category", 5, &editedRuleId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []rulemodels.Rule{rules[0], rules[3]}, got)
+}
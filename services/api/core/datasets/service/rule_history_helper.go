@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	dataplatformactionconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/constants"
+	dataplatformactionmodels "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/models"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+)
+
+// getDatasetRuleVersions returns the versions of a rule latest first, each with what changed from the one before it
+func getDatasetRuleVersions(ruleVersions []rulemodels.RuleVersion) []models.DatasetRuleVersion {
+	versions := make([]models.DatasetRuleVersion, len(ruleVersions))
+	for i, ruleVersion := range ruleVersions {
+		versions[i] = models.DatasetRuleVersion{
+			Version:     ruleVersion.Version,
+			ChangeType:  ruleVersion.ChangeType,
+			Title:       ruleVersion.Title,
+			Description: ruleVersion.Description,
+			Value:       ruleVersion.Value,
+			Assignments: ruleVersion.Assignments,
+			Filters:     models.RuleFilters(ruleVersion.Rule()),
+			IsEnabled:   ruleVersion.IsEnabled,
+			CreatedAt:   ruleVersion.CreatedAt,
+			CreatedBy:   ruleVersion.CreatedBy,
+			Changes:     []models.DatasetRuleVersionChange{},
+		}
+	}
+
+	for i := 0; i < len(versions)-1; i++ {
+		versions[i].Changes = getDatasetRuleVersionChanges(versions[i+1], versions[i])
+	}
+
+	return versions
+}
+
+func getDatasetRuleVersionChanges(previous models.DatasetRuleVersion, current models.DatasetRuleVersion) []models.DatasetRuleVersionChange {
+	changes := []models.DatasetRuleVersionChange{}
+
+	if previous.Title != current.Title {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "title", From: previous.Title, To: current.Title})
+	}
+	if previous.Description != current.Description {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "description", From: previous.Description, To: current.Description})
+	}
+	if previous.Value != current.Value {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "value", From: previous.Value, To: current.Value})
+	}
+	if !equalRuleAssignments(previous.Assignments, current.Assignments) {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "assignments", From: previous.Assignments, To: current.Assignments})
+	}
+
+	previousFilters, previousErr := json.Marshal(previous.Filters)
+	currentFilters, currentErr := json.Marshal(current.Filters)
+	if previousErr != nil || currentErr != nil || string(previousFilters) != string(currentFilters) {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "filters", From: previous.Filters, To: current.Filters})
+	}
+
+	if previous.IsEnabled != current.IsEnabled {
+		changes = append(changes, models.DatasetRuleVersionChange{Field: "is_enabled", From: previous.IsEnabled, To: current.IsEnabled})
+	}
+
+	return changes
+}
+
+// recordDatasetRuleRuns records the rows each enabled rule of the columns holds once an action applying the rules of
+// a column completed. Rows are attributed through the source the dataset keeps for each value written to a column, so
+// a row matched by several rules counts for the rule that won it by priority only. Runs only feed the effectiveness
+// of rules, failing to record them does not fail the action.
+func (s *datasetService) recordDatasetRuleRuns(ctx context.Context, actionId string) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	action, err := s.datasetActionService.GetDatasetActionFromActionId(ctx, actionId)
+	if err != nil {
+		logger.Warn("failed to get action to record rule runs of", zap.String("action_id", actionId), zap.String("error", err.Error()))
+		return
+	}
+
+	config, err := json.Marshal(action.Config)
+	if err != nil {
+		return
+	}
+
+	var event dataplatformactionmodels.UpdateDatasetEvent
+	if err := json.Unmarshal(config, &event); err != nil || event.EventType != dataplatformactionconstants.UpdateDatasetEventTypeUpsertRules || event.EventMetadata.Column == "" {
+		return
+	}
+
+	ctx = apicontext.AddAuthToContext(ctx, "user", action.ActionBy, []uuid.UUID{action.OrganizationId})
+
+	columnRules, err := s.getEnabledColumnRules(ctx, action.OrganizationId, action.DatasetId, nil)
+	if err != nil {
+		logger.Warn("failed to get rules to record runs of", zap.String("action_id", actionId), zap.String("error", err.Error()))
+		return
+	}
+
+	datasetInfo, err := s.dataplatformService.GetDatasetMetadata(ctx, action.OrganizationId.String(), action.DatasetId.String())
+	if err != nil {
+		logger.Warn("failed to get dataset metadata to record rule runs", zap.String("action_id", actionId), zap.String("error", err.Error()))
+		return
+	}
+
+	var runs []storemodels.CreateRuleRunParams
+	for _, column := range getDatasetRulesColumns(event.EventMetadata.Column, columnRules[event.EventMetadata.Column]...) {
+		rules := columnRules[column]
+		sourceColumn := datasetConstants.ZampUpdateColumnSourcePrefix + column
+		if _, ok := datasetInfo.Schema[sourceColumn]; !ok || len(rules) == 0 {
+			continue
+		}
+
+		attributedRows, err := s.countRuleAttributedRows(ctx, action.OrganizationId, action.DatasetId, sourceColumn, rules)
+		if err != nil {
+			logger.Warn("failed to count rows attributed to rules", zap.String("column", column), zap.String("error", err.Error()))
+			return
+		}
+
+		for _, rule := range rules {
+			runs = append(runs, storemodels.CreateRuleRunParams{
+				RuleId:      rule.ID,
+				DatasetId:   action.DatasetId,
+				ActionId:    actionId,
+				MatchedRows: attributedRows[rule.ID],
+			})
+		}
+	}
+
+	if len(runs) == 0 {
+		return
+	}
+
+	if err := s.ruleService.CreateRuleRuns(ctx, runs); err != nil {
+		logger.Warn("failed to record rule runs", zap.String("action_id", actionId), zap.String("error", err.Error()))
+	}
+}
+
+// countRuleAttributedRows counts in a single query the rows whose source column names each of the rules
+func (s *datasetService) countRuleAttributedRows(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, sourceColumn string, rules []rulemodels.Rule) (map[uuid.UUID]int64, error) {
+	counts := make([]string, len(rules))
+	for i, rule := range rules {
+		counts[i] = fmt.Sprintf(datasetConstants.RuleAttributedRowsCount, sourceColumn, rule.ID, i)
+	}
+
+	query := fmt.Sprintf(datasetConstants.GetRuleAttributedRowsQuery, strings.Join(counts, ", "), datasetId, datasetConstants.ZampIsDeletedColumn)
+	data, err := s.dataplatformService.Query(ctx, merchantId.String(), query, map[string]string{
+		datasetConstants.ZampDatasetPrefix + datasetId.String(): datasetId.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attributedRows := make(map[uuid.UUID]int64, len(rules))
+	if len(data.Rows) == 0 {
+		return attributedRows, nil
+	}
+	for i, rule := range rules {
+		count, ok := toExportInt(data.Rows[0][fmt.Sprintf("rule_%d", i)])
+		if !ok {
+			return nil, fmt.Errorf("invalid count of rows attributed to rule %s", rule.ID)
+		}
+		attributedRows[rule.ID] = count
+	}
+
+	return attributedRows, nil
+}
+
+// getDatasetRulesStats returns the effectiveness of the rules, rows are attributed to a rule through the source
+// the dataset keeps for each value written to the column
+func (s *datasetService) getDatasetRulesStats(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, rules []rulemodels.Rule) ([]models.DatasetRuleStats, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	datasetInfo, err := s.dataplatformService.GetDatasetMetadata(ctx, merchantId.String(), datasetId.String())
+	if err != nil {
+		logger.Error("failed to get dataset metadata", zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetDatasetMetadata
+	}
+
+	ruleIds := make([]uuid.UUID, len(rules))
+	for i, rule := range rules {
+		ruleIds[i] = rule.ID
+	}
+
+	ruleRuns, err := s.ruleService.GetRuleRuns(ctx, ruleIds, datasetConstants.DatasetRuleStatsRunsLimit)
+	if err != nil {
+		return nil, errors.ErrFailedToGetRule
+	}
+
+	stats := make([]models.DatasetRuleStats, len(rules))
+	for i, rule := range rules {
+		stats[i] = models.DatasetRuleStats{
+			RuleId:    rule.ID,
+			Title:     rule.Title,
+			Column:    rule.Column,
+			Priority:  rule.Priority,
+			IsEnabled: rule.IsEnabled,
+			Runs:      ruleRuns[rule.ID],
+		}
+		if stats[i].Runs == nil {
+			stats[i].Runs = []rulemodels.RuleRun{}
+		}
+
+		sourceColumn := datasetConstants.ZampUpdateColumnSourcePrefix + rule.Column
+		if _, ok := datasetInfo.Schema[sourceColumn]; ok {
+			attributedRows, err := s.countDatasetRows(ctx, merchantId, datasetId, models.FilterModel{
+				LogicalOperator: models.LogicalOperator(querybuilderconstants.LogicalOperatorAnd),
+				Conditions: []models.Filter{
+					{Column: sourceColumn, Operator: querybuilderconstants.ContainsOperator, Value: rule.ID.String()},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			stats[i].AttributedRows = &attributedRows
+		}
+
+		stats[i].IsStale = isDatasetRuleStale(stats[i])
+	}
+
+	return stats, nil
+}
+
+// isDatasetRuleStale tells whether a rule can be pruned, it neither holds any row now nor matched any in its last run
+func isDatasetRuleStale(stats models.DatasetRuleStats) bool {
+	if stats.AttributedRows == nil || *stats.AttributedRows > 0 {
+		return false
+	}
+
+	return len(stats.Runs) == 0 || stats.Runs[0].MatchedRows == 0
+}
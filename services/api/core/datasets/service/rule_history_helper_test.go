@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	datasetactionmodels "github.com/Zampfi/application-platform/services/api/core/datasets/actions/models"
+
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mockDataplatform "github.com/Zampfi/application-platform/services/api/mocks/core/dataplatform"
+	mock_datasetactionservice "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/actions/service"
+	dataplatformpkgmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
+	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
+)
+
+func TestGetDatasetRuleVersions(t *testing.T) {
+	ruleFilterConfig := func(vendor string) rulemodels.FilterConfig {
+		return rulemodels.FilterConfig{QueryConfig: querybuildermodels.QueryConfig{Filters: querybuildermodels.FilterModel{
+			LogicalOperator: "AND",
+			Conditions: []querybuildermodels.Filter{
+				{Column: querybuildermodels.ColumnConfig{Column: "vendor"}, Operator: "eq", Value: vendor},
+			},
+		}}}
+	}
+
+	// versions come latest first
+	versions := getDatasetRuleVersions([]rulemodels.RuleVersion{
+		{Version: 3, ChangeType: storemodels.RuleChangeTypeDisabled, Title: "Travel", Value: "travel", FilterConfig: ruleFilterConfig("Globex"), IsEnabled: false},
+		{Version: 2, ChangeType: storemodels.RuleChangeTypeUpdated, Title: "Travel", Value: "travel", FilterConfig: ruleFilterConfig("Globex"), IsEnabled: true},
+		{Version: 1, ChangeType: storemodels.RuleChangeTypeCreated, Title: "Acme", Value: "office", FilterConfig: ruleFilterConfig("Acme"), IsEnabled: true},
+	})
+
+	assert.Len(t, versions, 3)
+
+	assert.Equal(t, []models.DatasetRuleVersionChange{{Field: "is_enabled", From: true, To: false}}, versions[0].Changes)
+
+	fields := []string{}
+	for _, change := range versions[1].Changes {
+		fields = append(fields, change.Field)
+	}
+	assert.Equal(t, []string{"title", "value", "filters"}, fields)
+	assert.Equal(t, "Globex", versions[1].Filters.Conditions[0].Value)
+
+	assert.Empty(t, versions[2].Changes)
+}
+
+func TestIsDatasetRuleStale(t *testing.T) {
+	zero := int64(0)
+	some := int64(12)
+
+	tests := []struct {
+		name  string
+		stats models.DatasetRuleStats
+		want  bool
+	}{
+		{
+			name:  "Attribution unknown",
+			stats: models.DatasetRuleStats{},
+			want:  false,
+		},
+		{
+			name:  "Rows attributed",
+			stats: models.DatasetRuleStats{AttributedRows: &some},
+			want:  false,
+		},
+		{
+			name:  "Nothing attributed and never run",
+			stats: models.DatasetRuleStats{AttributedRows: &zero},
+			want:  true,
+		},
+		{
+			name:  "Nothing attributed but matched in the last run",
+			stats: models.DatasetRuleStats{AttributedRows: &zero, Runs: []rulemodels.RuleRun{{MatchedRows: 3}, {MatchedRows: 0}}},
+			want:  false,
+		},
+		{
+			name:  "Nothing attributed and no match in the last run",
+			stats: models.DatasetRuleStats{AttributedRows: &zero, Runs: []rulemodels.RuleRun{{MatchedRows: 0}, {MatchedRows: 3}}},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isDatasetRuleStale(tt.stats))
+		})
+	}
+}
+
+func TestCountRuleAttributedRows(t *testing.T) {
+	merchantId := uuid.New()
+	datasetId := uuid.New()
+	rules := []rulemodels.Rule{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	mockDataplatformService := mockDataplatform.NewMockDataPlatformService(t)
+	mockDataplatformService.EXPECT().Query(mock.Anything, merchantId.String(), mock.MatchedBy(func(query string) bool {
+		return strings.Contains(query, fmt.Sprintf("COUNT_IF(_zamp_source_json_category LIKE '%%%s%%') AS rule_0", rules[0].ID)) &&
+			strings.Contains(query, fmt.Sprintf("COUNT_IF(_zamp_source_json_category LIKE '%%%s%%') AS rule_1", rules[1].ID))
+	}), map[string]string{"zamp_" + datasetId.String(): datasetId.String()}).Return(dataplatformpkgmodels.QueryResult{
+		Rows: dataplatformpkgmodels.Rows{{"rule_0": int64(7), "rule_1": int64(0)}},
+	}, nil)
+
+	s := &datasetService{dataplatformService: mockDataplatformService}
+	attributedRows, err := s.countRuleAttributedRows(context.Background(), merchantId, datasetId, "_zamp_source_json_category", rules)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int64{rules[0].ID: 7, rules[1].ID: 0}, attributedRows)
+}
+
+func TestRecordDatasetRuleRunsSkipsOtherActions(t *testing.T) {
+	// only actions applying rules record runs, nothing past the action is read for the others
+	mockDatasetActionService := mock_datasetactionservice.NewMockDatasetActionService(t)
+	mockDatasetActionService.EXPECT().GetDatasetActionFromActionId(mock.Anything, "action-1").Return(&datasetactionmodels.DatasetAction{
+		ActionId: "action-1",
+		Config:   map[string]interface{}{"event_type": "upsert_rows"},
+	}, nil)
+
+	s := &datasetService{datasetActionService: mockDatasetActionService}
+	s.recordDatasetRuleRuns(context.Background(), "action-1")
+}
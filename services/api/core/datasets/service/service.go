@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"time"
 
 	"fmt"
+	"path"
 	"slices"
+	"strings"
 
 	"github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
 	"github.com/Zampfi/application-platform/services/api/helper"
@@ -31,10 +34,12 @@ import (
 	ruleservice "github.com/Zampfi/application-platform/services/api/core/rules/service"
 	datasetFileUploadsModels "github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
+	cloudserviceconstants "github.com/Zampfi/application-platform/services/api/pkg/cloudservices/constants"
 	cloudservicemodels "github.com/Zampfi/application-platform/services/api/pkg/cloudservices/models"
 	cloudservice "github.com/Zampfi/application-platform/services/api/pkg/cloudservices/service"
 	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
 	querybuilderservice "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/service"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
 	s3 "github.com/Zampfi/application-platform/services/api/pkg/s3"
 	"github.com/google/uuid"
 
@@ -47,6 +52,7 @@ import (
 	temporalmodels "github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/models"
 
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 type DatasetService interface {
@@ -72,7 +78,7 @@ type DatasetService interface {
 	RemoveAudienceFromDataset(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID) error
 	UpdateDatasetAudiencePrivilege(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege storemodels.ResourcePrivilege) (*storemodels.ResourceAudiencePolicy, error)
 	GetRulesByDatasetColumns(ctx context.Context, orgId uuid.UUID, datasetColumns []storemodels.DatasetColumn) (map[string]map[string][]rulemodels.Rule, error)
-	CreateDatasetExportAction(ctx context.Context, merchantId uuid.UUID, datasetId string, queryConfig models.DatasetParams, userId uuid.UUID) (string, error)
+	CreateDatasetExportAction(ctx context.Context, merchantId uuid.UUID, datasetId string, queryConfig models.DatasetParams, format datasetConstants.ExportFormat, userId uuid.UUID) (string, error)
 	DatasetExportTemporalActivity(ctx context.Context, params models.DatasetExportParams, datasetId uuid.UUID, userId uuid.UUID, orgIds []uuid.UUID, workflowId string) (string, error)
 	GetDownloadableDataExportUrl(ctx context.Context, workflowId string) (string, error)
 	GetRulesByIds(ctx context.Context, ruleIds []string) ([]rulemodels.Rule, error)
@@ -84,8 +90,69 @@ type DatasetService interface {
 	ImportDataFromFile(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, fileUploadId uuid.UUID) (err error)
 	GetFileUploadPreview(ctx context.Context, fileUploadId uuid.UUID) (datasetFileUploadsModels.DatasetPreview, error)
 	GetDatasetImportPath(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID) (*models.FileImportConfig, error)
+	ValidateFileImportPreview(ctx context.Context, datasetId uuid.UUID, preview []map[string]interface{}) ([]string, error)
 	DeleteDataset(ctx context.Context, merchantId uuid.UUID, datasetId string) (string, error)
 	GetDatasetDisplayConfig(ctx context.Context, merchantId uuid.UUID, datasetId string) ([]models.DisplayConfig, error)
+	GetDatasetRowPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetRowPolicy, error)
+	CreateDatasetRowPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetRowPolicyParams) (models.DatasetRowPolicy, error)
+	UpdateDatasetRowPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID, params models.DatasetRowPolicyParams) (models.DatasetRowPolicy, error)
+	DeleteDatasetRowPolicy(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID) error
+	PreviewDatasetDataForUser(ctx context.Context, merchantId uuid.UUID, datasetId string, previewUserId uuid.UUID, params models.DatasetParams) (models.DatasetData, error)
+	GetDatasetColumnPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetColumnPolicy, error)
+	CreateDatasetColumnPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)
+	UpdateDatasetColumnPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID, params models.DatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)
+	DeleteDatasetColumnPolicy(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID) (models.DatasetColumnPolicy, error)
+	GetDatasetViews(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) ([]models.DatasetView, error)
+	GetDatasetView(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID) (models.DatasetView, error)
+	CreateDatasetView(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetViewParams) (models.DatasetView, error)
+	UpdateDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, params models.DatasetViewParams) (models.DatasetView, error)
+	DeleteDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) error
+	GetDatasetViewAudiences(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID) ([]storemodels.ResourceAudiencePolicy, error)
+	AddAudienceToDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceType storemodels.AudienceType, audienceId uuid.UUID, privilege storemodels.ResourcePrivilege) (*storemodels.ResourceAudiencePolicy, error)
+	UpdateDatasetViewAudiencePrivilege(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceId uuid.UUID, privilege storemodels.ResourcePrivilege) (*storemodels.ResourceAudiencePolicy, error)
+	RemoveAudienceFromDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceType storemodels.AudienceType, audienceId uuid.UUID) error
+	GetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) (*models.DatasetView, error)
+	SetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) (models.DatasetView, error)
+	ClearDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) error
+	GetDatasetExportSchedules(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetExportSchedule, error)
+	GetDatasetExportSchedule(ctx context.Context, datasetId uuid.UUID, scheduleId uuid.UUID) (models.DatasetExportSchedule, error)
+	CreateDatasetExportSchedule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetExportScheduleParams) (models.DatasetExportSchedule, error)
+	UpdateDatasetExportSchedule(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, scheduleId uuid.UUID, params models.DatasetExportScheduleParams) (models.DatasetExportSchedule, error)
+	DeleteDatasetExportSchedule(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, scheduleId uuid.UUID) error
+	GetDatasetExportScheduleRuns(ctx context.Context, datasetId uuid.UUID, scheduleId uuid.UUID) ([]models.DatasetExportScheduleRun, error)
+	StartDatasetExportScheduleRunActivity(ctx context.Context, params models.DatasetExportScheduleWorkflowParams, workflowId string) (models.DatasetExportScheduleRunPlan, error)
+	CompleteDatasetExportScheduleRunActivity(ctx context.Context, params models.DatasetExportScheduleWorkflowParams, plan models.DatasetExportScheduleRunPlan, errorMessage string) error
+	GetDatasetAlerts(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetAlert, error)
+	GetDatasetAlert(ctx context.Context, datasetId uuid.UUID, alertId uuid.UUID) (models.DatasetAlert, error)
+	CreateDatasetAlert(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetAlertParams) (models.DatasetAlert, error)
+	UpdateDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID, params models.DatasetAlertParams) (models.DatasetAlert, error)
+	DeleteDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID) error
+	SnoozeDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID, until time.Time) (models.DatasetAlert, error)
+	UnsnoozeDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID) (models.DatasetAlert, error)
+	GetDatasetAlertEvents(ctx context.Context, datasetId uuid.UUID, alertId uuid.UUID) ([]models.DatasetAlertEvent, error)
+	EvaluateDatasetAlertActivity(ctx context.Context, params models.DatasetAlertWorkflowParams) (models.DatasetAlertEvaluation, error)
+	GetDatasetStatusWorkflows(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetStatusWorkflow, error)
+	CreateDatasetStatusWorkflow(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)
+	UpdateDatasetStatusWorkflow(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, workflowId uuid.UUID, params models.DatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)
+	DeleteDatasetStatusWorkflow(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, workflowId uuid.UUID) error
+	TransitionDatasetRowStatus(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, rowId string, column string, state string) (models.DatasetAction, error)
+	GetDatasetRowAssignment(ctx context.Context, datasetId uuid.UUID, rowId string) (*models.DatasetRowAssignment, error)
+	AssignDatasetRow(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, rowId string, params models.DatasetRowAssignmentParams) (models.DatasetRowAssignment, error)
+	GetMyQueue(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID) ([]models.DatasetQueueItem, error)
+	GetDatasetRules(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID) ([]rulemodels.Rule, error)
+	GetDatasetRule(ctx context.Context, datasetId uuid.UUID, ruleId uuid.UUID) (rulemodels.Rule, error)
+	CreateDatasetRule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetRuleParams) (models.DatasetRuleChange, error)
+	UpdateDatasetRule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, params models.DatasetRuleParams) (models.DatasetRuleChange, error)
+	SetDatasetRuleEnabled(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, isEnabled bool) (models.DatasetRuleChange, error)
+	DeleteDatasetRule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID) (models.DatasetRuleChange, error)
+	PreviewDatasetRule(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.DatasetRulePreviewParams) (models.DatasetRulePreview, error)
+	AnalyzeDatasetRules(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, column string) ([]models.DatasetRuleAnalysis, error)
+	GetDatasetRuleVersions(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID) ([]models.DatasetRuleVersion, error)
+	RestoreDatasetRuleVersion(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, version int) (models.DatasetRuleChange, error)
+	GetDatasetRuleStats(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID) (models.DatasetRuleStats, error)
+	GetDatasetRulesStats(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID) ([]models.DatasetRuleStats, error)
+	ExportDatasetRuleBundle(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, columns []string) (models.DatasetRuleBundle, error)
+	ImportDatasetRuleBundle(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.ImportDatasetRuleBundleParams) (models.DatasetRuleBundleImport, error)
 }
 
 type DatasetServiceStore interface {
@@ -94,6 +161,17 @@ type DatasetServiceStore interface {
 	store.DatasetFileUploadStore
 	store.TransactionStore
 	store.FlattenedResourceAudiencePoliciesStore
+	store.DatasetRowPolicyStore
+	store.DatasetColumnPolicyStore
+	store.DatasetViewStore
+	store.DatasetExportScheduleStore
+	store.DatasetAlertStore
+	store.DatasetStatusWorkflowStore
+	store.DatasetRowAssignmentStore
+	store.ReferenceBankStore
+	store.TagStore
+	store.OrganizationStore
+	store.TeamStore
 }
 
 type datasetService struct {
@@ -140,7 +218,26 @@ func NewDatasetService(
 func (s *datasetService) GetFilterConfigByDatasetId(ctx context.Context, merchantId uuid.UUID, datasetId string) ([]models.FilterConfig, map[string]interface{}, error) {
 	logger := apicontext.GetLoggerFromCtx(ctx)
 
-	filterConfigCacheKey, err := s.cacheClient.FormatKey(datasetConstants.DatasetFilterConfigCacheKey, datasetId)
+	rowPolicyFilterSQL, err := s.getRowPolicyFilterSQL(ctx, merchantId.String(), datasetId)
+	if err != nil {
+		logger.Error("failed to get row policy filter", zap.String("error", err.Error()))
+		return nil, nil, err
+	}
+
+	// column restrictions are applied on top of the cached config as they only hide columns or strip options
+	columnRestrictions, err := s.getColumnRestrictions(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get column restrictions", zap.String("error", err.Error()))
+		return nil, nil, err
+	}
+
+	// filter options depend on the rows the user can see, so restricted users get a cache entry per row policy filter
+	cacheKeyId := datasetId
+	if rowPolicyFilterSQL != "" {
+		cacheKeyId = fmt.Sprintf("%s:%x", datasetId, sha256.Sum256([]byte(rowPolicyFilterSQL)))
+	}
+
+	filterConfigCacheKey, err := s.cacheClient.FormatKey(datasetConstants.DatasetFilterConfigCacheKey, cacheKeyId)
 	if err != nil {
 		logger.Error("failed to format cache key", zap.String("error", err.Error()))
 		return nil, nil, fmt.Errorf("failed to format cache key")
@@ -150,7 +247,7 @@ func (s *datasetService) GetFilterConfigByDatasetId(ctx context.Context, merchan
 	if err := s.cacheClient.Get(ctx, filterConfigCacheKey, cacheFilterConfig); err != nil {
 		logger.Warn("failed to fetch filter config from cache", zap.String("dataset_id", datasetId), zap.String("error", err.Error()))
 	} else {
-		return cacheFilterConfig.FilterConfig, cacheFilterConfig.DatsetConfig, nil
+		return s.applyColumnRestrictionsToFilterConfig(cacheFilterConfig.FilterConfig, columnRestrictions), cacheFilterConfig.DatsetConfig, nil
 	}
 
 	datasetMetaInfo, err := s.datasetStore.GetDatasetById(ctx, datasetId)
@@ -172,17 +269,21 @@ func (s *datasetService) GetFilterConfigByDatasetId(ctx context.Context, merchan
 	}
 
 	datasetConfig := make(map[string]interface{})
-	datasetConfig[datasetConstants.DatasetConfigIsFxEnabled] = s.isFxEnabled(datasetInfo.Schema)
+	datasetConfig[datasetConstants.DatasetConfigIsFxEnabled] = s.isFxEnabled(datasetInfo.Schema, datasetMetaData.DatasetConfig)
 	datasetConfig[datasetConstants.DatasetConfigIsFileImportEnabled] = s.isFileImportEnabled(ctx, merchantId, datasetId)
 
 	filterConfigs := s.convertToFilterConfig(datasetInfo, datasetMetaData)
 
-	err = s.populateFilterOptions(ctx, merchantId, datasetId, filterConfigs)
+	err = s.populateFilterOptions(ctx, merchantId, datasetId, filterConfigs, rowPolicyFilterSQL)
 	if err != nil {
 		logger.Error("failed to populate filter options", zap.String("error", err.Error()))
 		return nil, nil, fmt.Errorf("failed to populate filter options")
 	}
 
+	if err := s.populateOptionLabels(ctx, filterConfigs); err != nil {
+		return nil, nil, fmt.Errorf("failed to populate filter option labels")
+	}
+
 	cacheFilterConfig.FilterConfig = filterConfigs
 	cacheFilterConfig.DatsetConfig = datasetConfig
 
@@ -190,12 +291,31 @@ func (s *datasetService) GetFilterConfigByDatasetId(ctx context.Context, merchan
 		logger.Warn("failed to set filter config in cache", zap.String("dataset_id", datasetId), zap.String("error", err.Error()))
 	}
 
-	return filterConfigs, datasetConfig, nil
+	return s.applyColumnRestrictionsToFilterConfig(filterConfigs, columnRestrictions), datasetConfig, nil
 }
 
 func (s *datasetService) GetDataByDatasetId(ctx context.Context, merchantId uuid.UUID, datasetId string, params models.DatasetParams) (models.DatasetData, error) {
 	logger := apicontext.GetLoggerFromCtx(ctx)
 
+	rowPolicyFilter, err := s.getRowPolicyFilter(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get row policy filter", zap.String("error", err.Error()))
+		return models.DatasetData{}, err
+	}
+
+	columnRestrictions, err := s.getColumnRestrictions(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get column restrictions", zap.String("error", err.Error()))
+		return models.DatasetData{}, err
+	}
+
+	return s.getDataByDatasetId(ctx, merchantId, datasetId, params, rowPolicyFilter, columnRestrictions)
+}
+
+func (s *datasetService) getDataByDatasetId(ctx context.Context, merchantId uuid.UUID, datasetId string, params models.DatasetParams,
+	rowPolicyFilter *models.FilterModel, columnRestrictions map[string]models.ColumnRestriction) (models.DatasetData, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
 	datasetMetaInfo, err := s.datasetStore.GetDatasetById(ctx, datasetId)
 	if err != nil {
 		logger.Error("failed to get dataset meta info", zap.String("error", err.Error()))
@@ -214,13 +334,24 @@ func (s *datasetService) GetDataByDatasetId(ctx context.Context, merchantId uuid
 		return models.DatasetData{}, errors.ErrFailedToGetDatasetMetadata
 	}
 
+	columnMasks, err := s.enforceColumnRestrictions(params, columnRestrictions, schemaColumns(datasetInfo))
+	if err != nil {
+		logger.Error("query references restricted columns", zap.String("error", err.Error()))
+		return models.DatasetData{}, err
+	}
+
 	columnDatatypes, err := s.getColumnDatatypes(datasetInfo)
 	if err != nil {
 		logger.Error("failed to get column datatypes", zap.String("error", err.Error()))
 		return models.DatasetData{}, err
 	}
 
-	queryConfigMapped := s.mapToQueryConfig(datasetId, params, datasetInfo, columnDatatypes, datasetMetaData)
+	fxRates, err := s.getFxRateJoin(ctx, merchantId, params, datasetMetaData.DatasetConfig, columnDatatypes)
+	if err != nil {
+		return models.DatasetData{}, err
+	}
+
+	queryConfigMapped := s.mapToQueryConfig(datasetId, s.applyRowPolicyFilter(params, rowPolicyFilter), datasetInfo, columnDatatypes, datasetMetaData, columnRestrictions, fxRates)
 
 	query, _, err := s.queryBuilderService.ToSQL(ctx, queryConfigMapped)
 	if err != nil {
@@ -275,7 +406,7 @@ func (s *datasetService) GetDataByDatasetId(ctx context.Context, merchantId uuid
 	}
 
 	queryResultWithConfig := models.DatasetData{
-		QueryResult: result,
+		QueryResult: s.maskQueryResult(datasetId, result, columnMasks),
 		Title:       datasetMetaInfo.Title,
 		Description: datasetMetaInfo.Description,
 		TotalCount:  totalCount,
@@ -412,6 +543,34 @@ func (s *datasetService) UpdateDatasetData(ctx context.Context, merchantId uuid.
 		return models.DatasetAction{}, errors.ErrFailedToUnmarshalMetadata
 	}
 
+	statusWorkflows, err := s.getStatusWorkflows(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get status workflows", zap.String("error", err.Error()))
+		return models.DatasetAction{}, err
+	}
+	if workflow, ok := statusWorkflows[params.Update.Column]; ok {
+		if err := s.enforceStatusWorkflow(ctx, merchantId, datasetId, workflow, params); err != nil {
+			return models.DatasetAction{}, err
+		}
+	}
+
+	var tagTaxonomy []string
+	if datasetMetaData.Columns[params.Update.Column].CustomType == constants.DatabricksColumnCustomTypeTags {
+		if params.Update.Value, tagTaxonomy, err = s.resolveTagValue(ctx, params.Update.Value); err != nil {
+			return models.DatasetAction{}, err
+		}
+	}
+
+	// edits made by a user reach only the rows their row policies let them read
+	if params.SourceType == datasetConstants.UpdateColumnSourceTypeUser {
+		rowPolicyFilter, err := s.getRowPolicyFilterForUser(ctx, datasetId.String(), params.UserId)
+		if err != nil {
+			logger.Error("failed to get row policy filter", zap.String("error", err.Error()))
+			return models.DatasetAction{}, err
+		}
+		params.Filters = s.applyRowPolicyFilter(models.DatasetParams{Filters: params.Filters}, rowPolicyFilter).Filters
+	}
+
 	customColumnConfig := make(map[string]querybuildermodels.CustomDataTypeConfig)
 
 	queryConfig, err := s.mapUpdateDatasetDataParamsToQueryConfig(datasetId, params, columnDatatypes, customColumnConfig)
@@ -431,6 +590,13 @@ func (s *datasetService) UpdateDatasetData(ctx context.Context, merchantId uuid.
 
 		logger.Info("UPDATE DATASET DATA", zap.String("filter query", query), zap.Any("queryParams", queryParams))
 
+		var organizationBanks []referencedata.Bank
+		if datasetMetaData.Columns[params.Update.Column].CustomType == constants.DatabricksColumnCustomTypeBank {
+			if organizationBanks, err = s.getOrganizationBanks(ctx); err != nil {
+				return models.DatasetAction{}, err
+			}
+		}
+
 		dataplatformAction, err = s.dataplatformService.UpdateDatasetData(ctx, dataplatformmodels.UpdateDatasetDataPayload{
 			MerchantID: merchantId.String(),
 			ActorId:    params.UserId.String(),
@@ -440,6 +606,8 @@ func (s *datasetService) UpdateDatasetData(ctx context.Context, merchantId uuid.
 				UpdateValues: map[string]any{
 					params.Update.Column: params.Update.Value,
 				},
+				ReferenceBanks: organizationBanks,
+				TagTaxonomy:    tagTaxonomy,
 			},
 		})
 		if err != nil {
@@ -778,45 +946,83 @@ func (s *datasetService) GetOptionsForColumn(
 ) ([]interface{}, error) {
 	switch filterType {
 	case datasetConstants.FilterTypeMultiSearch, datasetConstants.FilterTypeSelect:
-
-		query := ""
-		if respectThreshold {
-			query = fmt.Sprintf(datasetConstants.GetDistinctValuesQuery, column, datasetId, datasetConstants.ZampIsDeletedColumn, datasetConstants.MultiSelectThreshold)
-		} else {
-			query = fmt.Sprintf(datasetConstants.GetDistinctValuesQueryWithoutLimit, column, datasetId, datasetConstants.ZampIsDeletedColumn)
+		columnRestrictions, err := s.getColumnRestrictions(ctx, datasetId)
+		if err != nil {
+			return nil, err
 		}
 
-		var result dataplatformpkgmodels.QueryResult
-		var err error
-
-		switch s.serverDatasetConfig.DataplatformProvider {
-		case datasetConstants.DataplatformProviderDatabricks:
-			result, err = s.dataplatformService.Query(ctx, merchantId.String(), query, map[string]string{
-				datasetConstants.ZampDatasetPrefix + datasetId: datasetId,
-			})
-		case datasetConstants.DataplatformProviderPinot:
-			result, err = s.dataplatformService.QueryRealTime(ctx, merchantId.String(), query, map[string]string{
-				datasetConstants.ZampDatasetPrefix + datasetId: datasetId,
-			})
-		default:
-			return nil, errors.ErrInvalidDataplatformProvider
+		// restricted columns cannot be filtered on, listing their values would leak them
+		if _, ok := columnRestrictions[column]; ok {
+			return []interface{}{}, nil
 		}
 
+		tagOptions, ok, err := s.getTagOptions(ctx, datasetId, column)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get distinct values for %s: %w", column, err)
+			return nil, err
+		}
+		if ok {
+			return tagOptions, nil
 		}
 
-		var options []interface{}
-		for _, row := range result.Rows {
-			options = append(options, row[column])
+		rowPolicyFilterSQL, err := s.getRowPolicyFilterSQL(ctx, merchantId.String(), datasetId)
+		if err != nil {
+			return nil, err
 		}
-		return options, nil
+
+		return s.getOptionsForColumn(ctx, merchantId, datasetId, column, respectThreshold, rowPolicyFilterSQL)
 
 	default:
 		return []interface{}{}, nil
 	}
 }
 
+func (s *datasetService) getOptionsForColumn(
+	ctx context.Context,
+	merchantId uuid.UUID,
+	datasetId string,
+	column string,
+	respectThreshold bool,
+	rowPolicyFilterSQL string,
+) ([]interface{}, error) {
+	query := ""
+	switch {
+	case rowPolicyFilterSQL != "" && respectThreshold:
+		query = fmt.Sprintf(datasetConstants.GetDistinctValuesWithFilterQuery, column, datasetId, datasetConstants.ZampIsDeletedColumn, rowPolicyFilterSQL, datasetConstants.MultiSelectThreshold)
+	case rowPolicyFilterSQL != "":
+		query = fmt.Sprintf(datasetConstants.GetDistinctValuesWithFilterQueryWithoutLimit, column, datasetId, datasetConstants.ZampIsDeletedColumn, rowPolicyFilterSQL)
+	case respectThreshold:
+		query = fmt.Sprintf(datasetConstants.GetDistinctValuesQuery, column, datasetId, datasetConstants.ZampIsDeletedColumn, datasetConstants.MultiSelectThreshold)
+	default:
+		query = fmt.Sprintf(datasetConstants.GetDistinctValuesQueryWithoutLimit, column, datasetId, datasetConstants.ZampIsDeletedColumn)
+	}
+
+	var result dataplatformpkgmodels.QueryResult
+	var err error
+
+	switch s.serverDatasetConfig.DataplatformProvider {
+	case datasetConstants.DataplatformProviderDatabricks:
+		result, err = s.dataplatformService.Query(ctx, merchantId.String(), query, map[string]string{
+			datasetConstants.ZampDatasetPrefix + datasetId: datasetId,
+		})
+	case datasetConstants.DataplatformProviderPinot:
+		result, err = s.dataplatformService.QueryRealTime(ctx, merchantId.String(), query, map[string]string{
+			datasetConstants.ZampDatasetPrefix + datasetId: datasetId,
+		})
+	default:
+		return nil, errors.ErrInvalidDataplatformProvider
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get distinct values for %s: %w", column, err)
+	}
+
+	var options []interface{}
+	for _, row := range result.Rows {
+		options = append(options, row[column])
+	}
+	return options, nil
+}
+
 func (s *datasetService) GetDatasetAudiences(ctx context.Context, datasetId uuid.UUID) ([]storemodels.ResourceAudiencePolicy, error) {
 	return s.datasetStore.GetDatasetPolicies(ctx, datasetId)
 }
@@ -1172,7 +1378,15 @@ func (s *datasetService) UpdateDatasetAudiencePrivilege(ctx context.Context, dat
 }
 
 func (s *datasetService) UpdateDatasetActionStatus(ctx context.Context, actionId string, status string) error {
-	return s.datasetActionService.UpdateDatasetActionStatus(ctx, actionId, status)
+	if err := s.datasetActionService.UpdateDatasetActionStatus(ctx, actionId, status); err != nil {
+		return err
+	}
+
+	if status == string(dataplatformactionconstants.ActionStatusSuccessful) {
+		s.recordDatasetRuleRuns(ctx, actionId)
+	}
+
+	return nil
 }
 
 func (s *datasetService) GetRulesByDatasetColumns(ctx context.Context, organizationId uuid.UUID, datasetColumns []storemodels.DatasetColumn) (map[string]map[string][]rulemodels.Rule, error) {
@@ -1182,18 +1396,21 @@ func (s *datasetService) GetRulesByDatasetColumns(ctx context.Context, organizat
 	})
 }
 
-func (s *datasetService) CreateDatasetExportAction(ctx context.Context, merchantId uuid.UUID, datasetId string, params models.DatasetParams, userId uuid.UUID) (string, error) {
+func (s *datasetService) CreateDatasetExportAction(ctx context.Context, merchantId uuid.UUID, datasetId string, params models.DatasetParams, format datasetConstants.ExportFormat, userId uuid.UUID) (string, error) {
 	logger := apicontext.GetLoggerFromCtx(ctx)
 
-	workflowId := uuid.New().String()
-
-	timestamp := time.Now().UTC().Format(datasetConstants.DatasetExportTimestampFormat)
-	fileName := fmt.Sprintf(datasetConstants.DatasetExportFileNameFormat, timestamp, uuid.New().String())
+	if format == "" {
+		format = datasetConstants.ExportFormatCSV
+	}
+	if _, ok := datasetConstants.ExportFormatContentTypes[format]; !ok {
+		return "", errors.ErrInvalidExportFormat
+	}
 
-	filePath := fmt.Sprintf(datasetConstants.DatasetExportFilePathFormat, datasetId, workflowId, fileName)
+	workflowId := uuid.New().String()
 
 	metadata := models.ExportMetadata{
-		FilePath: filePath,
+		FilePath: getDatasetExportFilePath(datasetId, workflowId, format),
+		Format:   format,
 	}
 
 	datasetIdUUID, err := uuid.Parse(datasetId)
@@ -1228,6 +1445,7 @@ func (s *datasetService) CreateDatasetExportAction(ctx context.Context, merchant
 			models.DatasetExportParams{
 				QueryConfig: params,
 				ExportPath:  metadata.FilePath,
+				Format:      format,
 			},
 			datasetIdUUID,
 			userId,
@@ -1270,7 +1488,16 @@ func (s *datasetService) GetDownloadableDataExportUrl(ctx context.Context, workf
 		return "", fmt.Errorf("failed to unmarshal config to ExportMetadata: %w", err)
 	}
 
-	signedURL, err := s.cloudService.GetSignedUrlToDownload(ctx, metadata.FilePath, []cloudservicemodels.GetDownloadsignedUrlConfigs{})
+	signedURL, err := s.cloudService.GetSignedUrlToDownload(ctx, metadata.FilePath, []cloudservicemodels.GetDownloadsignedUrlConfigs{
+		{
+			Key:   cloudserviceconstants.SignedDownloadUrlConfigCustomName,
+			Value: path.Base(metadata.FilePath),
+		},
+		{
+			Key:   cloudserviceconstants.SignedDownloadUrlConfigContentType,
+			Value: datasetConstants.ExportFormatContentTypes[metadata.GetFormat()],
+		},
+	})
 
 	if err != nil {
 		return "", fmt.Errorf("failed to get signed url: %w", err)
@@ -1332,7 +1559,8 @@ func (s *datasetService) UpdateRulePriority(ctx context.Context, orgId uuid.UUID
 		return models.DatasetAction{}, err
 	}
 
-	createDatasetAction, err := s.handleRulePriorityUpdate(ctx, orgId, userId, params.DatasetId, params)
+	columns := getDatasetRulesColumns(params.Column, datasetRules...)
+	createDatasetAction, err := s.handleRulePriorityUpdate(ctx, orgId, userId, params.DatasetId, params, columns)
 	if err != nil {
 		logger.Error("failed to handle rule priority update", zap.Error(err))
 		return models.DatasetAction{}, err
@@ -1769,3 +1997,1766 @@ func (s *datasetService) getFlattenedDatasetPolicies(ctx context.Context, datase
 
 	return policies, nil
 }
+
+func (s *datasetService) GetDatasetRowPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetRowPolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storePolicies, err := s.datasetStore.GetDatasetRowPolicies(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get dataset row policies", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetRowPolicies
+	}
+
+	policies := make([]models.DatasetRowPolicy, 0, len(storePolicies))
+	for _, storePolicy := range storePolicies {
+		policy := models.DatasetRowPolicy{}
+		if err := policy.FromSchema(storePolicy); err != nil {
+			logger.Error("failed to parse dataset row policy", zap.String("policy_id", storePolicy.ID.String()), zap.String("error", err.Error()))
+			return nil, errors.ErrFailedToGetRowPolicies
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (s *datasetService) CreateDatasetRowPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.validateRowPolicyParams(ctx, merchantId, datasetId, params); err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	storePolicy, err := s.datasetStore.CreateDatasetRowPolicy(ctx, storemodels.CreateDatasetRowPolicyParams{
+		OrganizationId:       merchantId,
+		DatasetId:            datasetId,
+		ResourceAudienceType: params.AudienceType,
+		ResourceAudienceId:   params.AudienceId,
+		Title:                params.Title,
+		Description:          params.Description,
+		FilterConfig:         params.Filters,
+		CreatedBy:            userId,
+	})
+	if err != nil {
+		logger.Error("failed to create dataset row policy", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return models.DatasetRowPolicy{}, err
+	}
+
+	policy := models.DatasetRowPolicy{}
+	if err := policy.FromSchema(storePolicy); err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (s *datasetService) UpdateDatasetRowPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID, params models.DatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	existingPolicy, err := s.getDatasetRowPolicy(ctx, datasetId, policyId)
+	if err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	// audience of a policy is fixed, a different audience warrants a new policy
+	params.AudienceType = existingPolicy.ResourceAudienceType
+	params.AudienceId = existingPolicy.ResourceAudienceId
+
+	if err := s.validateRowPolicyParams(ctx, merchantId, datasetId, params); err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	storePolicy, err := s.datasetStore.UpdateDatasetRowPolicy(ctx, policyId, storemodels.UpdateDatasetRowPolicyParams{
+		Title:        params.Title,
+		Description:  params.Description,
+		FilterConfig: params.Filters,
+		UpdatedBy:    userId,
+	})
+	if err != nil {
+		logger.Error("failed to update dataset row policy", zap.String("policy_id", policyId.String()), zap.String("error", err.Error()))
+		return models.DatasetRowPolicy{}, err
+	}
+
+	policy := models.DatasetRowPolicy{}
+	if err := policy.FromSchema(storePolicy); err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (s *datasetService) DeleteDatasetRowPolicy(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if _, err := s.getDatasetRowPolicy(ctx, datasetId, policyId); err != nil {
+		return err
+	}
+
+	if err := s.datasetStore.DeleteDatasetRowPolicy(ctx, policyId, userId); err != nil {
+		logger.Error("failed to delete dataset row policy", zap.String("policy_id", policyId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// PreviewDatasetDataForUser returns the data of the dataset as seen by the given user after applying their row and column policies.
+// It is meant to be called by dataset admins, who are not subject to these policies themselves. A user without access to
+// the dataset sees none of it.
+func (s *datasetService) PreviewDatasetDataForUser(ctx context.Context, merchantId uuid.UUID, datasetId string, previewUserId uuid.UUID, params models.DatasetParams) (models.DatasetData, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	hasAccess, err := s.hasDatasetAccess(ctx, datasetId, previewUserId)
+	if err != nil {
+		logger.Error("failed to get dataset access", zap.String("dataset_id", datasetId), zap.String("user_id", previewUserId.String()), zap.String("error", err.Error()))
+		return models.DatasetData{}, err
+	}
+	if !hasAccess {
+		return models.DatasetData{}, errors.ErrPreviewUserNoDatasetAccess
+	}
+
+	rowPolicyFilter, err := s.getRowPolicyFilterForUser(ctx, datasetId, previewUserId)
+	if err != nil {
+		logger.Error("failed to get row policy filter", zap.String("dataset_id", datasetId), zap.String("user_id", previewUserId.String()), zap.String("error", err.Error()))
+		return models.DatasetData{}, err
+	}
+
+	columnRestrictions, err := s.getColumnRestrictionsForUser(ctx, datasetId, previewUserId)
+	if err != nil {
+		logger.Error("failed to get column restrictions", zap.String("dataset_id", datasetId), zap.String("user_id", previewUserId.String()), zap.String("error", err.Error()))
+		return models.DatasetData{}, err
+	}
+
+	return s.getDataByDatasetId(ctx, merchantId, datasetId, params, rowPolicyFilter, columnRestrictions)
+}
+
+func (s *datasetService) GetDatasetColumnPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetColumnPolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storePolicies, err := s.datasetStore.GetDatasetColumnPolicies(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get dataset column policies", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetColumnPolicies
+	}
+
+	policies := make([]models.DatasetColumnPolicy, 0, len(storePolicies))
+	for _, storePolicy := range storePolicies {
+		policy := models.DatasetColumnPolicy{}
+		policy.FromSchema(storePolicy)
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (s *datasetService) CreateDatasetColumnPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.validateColumnPolicyParams(ctx, merchantId, datasetId, params); err != nil {
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	storePolicy, err := s.datasetStore.CreateDatasetColumnPolicy(ctx, storemodels.CreateDatasetColumnPolicyParams{
+		OrganizationId:       merchantId,
+		DatasetId:            datasetId,
+		ResourceAudienceType: params.AudienceType,
+		ResourceAudienceId:   params.AudienceId,
+		Column:               params.Column,
+		Action:               params.Action,
+		MaskType:             params.MaskType,
+		CreatedBy:            userId,
+	})
+	if err != nil {
+		logger.Error("failed to create dataset column policy", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	policy := models.DatasetColumnPolicy{}
+	policy.FromSchema(storePolicy)
+
+	return policy, nil
+}
+
+func (s *datasetService) UpdateDatasetColumnPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID, params models.DatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	existingPolicy, err := s.getDatasetColumnPolicy(ctx, datasetId, policyId)
+	if err != nil {
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	// audience and column of a policy are fixed, a different target warrants a new policy
+	params.AudienceType = existingPolicy.ResourceAudienceType
+	params.AudienceId = existingPolicy.ResourceAudienceId
+	params.Column = existingPolicy.Column
+
+	if err := s.validateColumnPolicyParams(ctx, merchantId, datasetId, params); err != nil {
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	storePolicy, err := s.datasetStore.UpdateDatasetColumnPolicy(ctx, policyId, storemodels.UpdateDatasetColumnPolicyParams{
+		Action:    params.Action,
+		MaskType:  params.MaskType,
+		UpdatedBy: userId,
+	})
+	if err != nil {
+		logger.Error("failed to update dataset column policy", zap.String("policy_id", policyId.String()), zap.String("error", err.Error()))
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	policy := models.DatasetColumnPolicy{}
+	policy.FromSchema(storePolicy)
+
+	return policy, nil
+}
+
+// DeleteDatasetColumnPolicy returns the deleted policy so that callers can record what was removed
+func (s *datasetService) DeleteDatasetColumnPolicy(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID) (models.DatasetColumnPolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storePolicy, err := s.getDatasetColumnPolicy(ctx, datasetId, policyId)
+	if err != nil {
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	if err := s.datasetStore.DeleteDatasetColumnPolicy(ctx, policyId, userId); err != nil {
+		logger.Error("failed to delete dataset column policy", zap.String("policy_id", policyId.String()), zap.String("error", err.Error()))
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	policy := models.DatasetColumnPolicy{}
+	policy.FromSchema(storePolicy)
+
+	return policy, nil
+}
+
+func (s *datasetService) GetDatasetViews(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) ([]models.DatasetView, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeViews, err := s.datasetStore.GetDatasetViews(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get dataset views", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetDatasetViews
+	}
+
+	viewDefault, err := s.datasetStore.GetDefaultDatasetView(ctx, userId, datasetId)
+	if err != nil {
+		logger.Error("failed to get default dataset view", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetDatasetViews
+	}
+
+	views := make([]models.DatasetView, 0, len(storeViews))
+	for _, storeView := range storeViews {
+		view := models.DatasetView{}
+		if err := view.FromSchema(storeView); err != nil {
+			logger.Error("failed to parse dataset view", zap.String("view_id", storeView.ID.String()), zap.String("error", err.Error()))
+			return nil, errors.ErrFailedToGetDatasetViews
+		}
+		view.IsDefault = viewDefault != nil && viewDefault.DatasetViewId == view.ID
+		views = append(views, view)
+	}
+
+	return views, nil
+}
+
+func (s *datasetService) GetDatasetView(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID) (models.DatasetView, error) {
+	storeView, err := s.getDatasetView(ctx, datasetId, viewId)
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+
+	view := models.DatasetView{}
+	if err := view.FromSchema(storeView); err != nil {
+		return models.DatasetView{}, err
+	}
+
+	return view, nil
+}
+
+// CreateDatasetView saves the view and makes its creator the admin of the view, other users only see it once it is shared with them
+func (s *datasetService) CreateDatasetView(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetViewParams) (models.DatasetView, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	params, err := validateDatasetViewParams(params)
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+
+	var storeView storemodels.DatasetView
+	err = s.datasetStore.WithDatasetViewTransaction(ctx, func(vs store.DatasetViewStore) error {
+		storeView, err = vs.CreateDatasetView(ctx, storemodels.CreateDatasetViewParams{
+			OrganizationId: merchantId,
+			DatasetId:      datasetId,
+			OwnerId:        userId,
+			Name:           params.Name,
+			Description:    params.Description,
+			QueryConfig:    params.QueryConfig,
+			ColumnOrder:    params.ColumnOrder,
+			DisplayConfig:  params.DisplayConfig,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = vs.CreateDatasetViewPolicy(ctx, storeView.ID, storemodels.AudienceTypeUser, userId, storemodels.PrivilegeDatasetViewAdmin)
+		return err
+	})
+	if err != nil {
+		logger.Error("failed to create dataset view", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return models.DatasetView{}, err
+	}
+
+	view := models.DatasetView{}
+	if err := view.FromSchema(storeView); err != nil {
+		return models.DatasetView{}, err
+	}
+
+	return view, nil
+}
+
+func (s *datasetService) UpdateDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, params models.DatasetViewParams) (models.DatasetView, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.ensureDatasetViewAdmin(ctx, datasetId, viewId, userId); err != nil {
+		return models.DatasetView{}, err
+	}
+
+	params, err := validateDatasetViewParams(params)
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+
+	storeView, err := s.datasetStore.UpdateDatasetView(ctx, viewId, storemodels.UpdateDatasetViewParams{
+		Name:          params.Name,
+		Description:   params.Description,
+		QueryConfig:   params.QueryConfig,
+		ColumnOrder:   params.ColumnOrder,
+		DisplayConfig: params.DisplayConfig,
+		UpdatedBy:     userId,
+	})
+	if err != nil {
+		logger.Error("failed to update dataset view", zap.String("view_id", viewId.String()), zap.String("error", err.Error()))
+		return models.DatasetView{}, err
+	}
+
+	view := models.DatasetView{}
+	if err := view.FromSchema(storeView); err != nil {
+		return models.DatasetView{}, err
+	}
+
+	return view, nil
+}
+
+func (s *datasetService) DeleteDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.ensureDatasetViewAdmin(ctx, datasetId, viewId, userId); err != nil {
+		return err
+	}
+
+	if err := s.datasetStore.DeleteDatasetView(ctx, viewId, userId); err != nil {
+		logger.Error("failed to delete dataset view", zap.String("view_id", viewId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (s *datasetService) GetDatasetViewAudiences(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID) ([]storemodels.ResourceAudiencePolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if _, err := s.getDatasetView(ctx, datasetId, viewId); err != nil {
+		return nil, err
+	}
+
+	policies, err := s.datasetStore.GetDatasetViewPolicies(ctx, viewId)
+	if err != nil {
+		logger.Error("failed to get dataset view policies", zap.String("view_id", viewId.String()), zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (s *datasetService) AddAudienceToDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceType storemodels.AudienceType, audienceId uuid.UUID, privilege storemodels.ResourcePrivilege) (*storemodels.ResourceAudiencePolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if !slices.Contains(storemodels.DatasetViewPrivileges, privilege) {
+		return nil, errors.ErrInvalidDatasetViewPrivilege
+	}
+
+	if !slices.Contains([]storemodels.AudienceType{storemodels.AudienceTypeUser, storemodels.AudienceTypeTeam, storemodels.AudienceTypeOrganization}, audienceType) {
+		return nil, errors.ErrInvalidDatasetViewAudienceType
+	}
+
+	view, err := s.getDatasetView(ctx, datasetId, viewId)
+	if err != nil {
+		return nil, err
+	}
+
+	// a view can only be shared within the organization it was saved in
+	if audienceType == storemodels.AudienceTypeOrganization && audienceId != view.OrganizationId {
+		return nil, errors.ErrInvalidDatasetViewAudienceType
+	}
+
+	if err := s.ensureDatasetViewAdmin(ctx, datasetId, viewId, userId); err != nil {
+		return nil, err
+	}
+
+	var createdPolicy *storemodels.ResourceAudiencePolicy
+	err = s.datasetStore.WithDatasetViewTransaction(ctx, func(vs store.DatasetViewStore) error {
+		policies, err := vs.GetDatasetViewPolicies(ctx, viewId)
+		if err != nil {
+			return err
+		}
+
+		if ensureAudienceNotAlreadyAdded(audienceType, audienceId, policies) != nil {
+			return errors.ErrDatasetViewAudienceAlreadyAdded
+		}
+
+		createdPolicy, err = vs.CreateDatasetViewPolicy(ctx, viewId, audienceType, audienceId, privilege)
+		return err
+	})
+	if err != nil {
+		logger.Info("failed to add audience to dataset view", zap.String("view_id", viewId.String()), zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	return createdPolicy, nil
+}
+
+func (s *datasetService) UpdateDatasetViewAudiencePrivilege(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceId uuid.UUID, privilege storemodels.ResourcePrivilege) (*storemodels.ResourceAudiencePolicy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if !slices.Contains(storemodels.DatasetViewPrivileges, privilege) {
+		return nil, errors.ErrInvalidDatasetViewPrivilege
+	}
+
+	view, err := s.getDatasetView(ctx, datasetId, viewId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the owner always keeps admin access on their view
+	if audienceId == view.OwnerId {
+		return nil, errors.ErrCannotRemoveDatasetViewOwner
+	}
+
+	if err := s.ensureDatasetViewAdmin(ctx, datasetId, viewId, userId); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.datasetStore.UpdateDatasetViewPolicy(ctx, viewId, audienceId, privilege)
+	if err != nil {
+		logger.Info("failed to update dataset view audience", zap.String("view_id", viewId.String()), zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+func (s *datasetService) RemoveAudienceFromDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceType storemodels.AudienceType, audienceId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	view, err := s.getDatasetView(ctx, datasetId, viewId)
+	if err != nil {
+		return err
+	}
+
+	if audienceType == storemodels.AudienceTypeUser && audienceId == view.OwnerId {
+		return errors.ErrCannotRemoveDatasetViewOwner
+	}
+
+	if err := s.ensureDatasetViewAdmin(ctx, datasetId, viewId, userId); err != nil {
+		return err
+	}
+
+	if err := s.datasetStore.DeleteDatasetViewPolicy(ctx, viewId, audienceType, audienceId); err != nil {
+		logger.Info("failed to remove audience from dataset view", zap.String("view_id", viewId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// GetDefaultDatasetView returns nil when the user has no default view on the dataset,
+// a default pointing to a view that was deleted or unshared since is treated the same way
+func (s *datasetService) GetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) (*models.DatasetView, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	viewDefault, err := s.datasetStore.GetDefaultDatasetView(ctx, userId, datasetId)
+	if err != nil {
+		logger.Error("failed to get default dataset view", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	if viewDefault == nil {
+		return nil, nil
+	}
+
+	view, err := s.GetDatasetView(ctx, datasetId, viewDefault.DatasetViewId)
+	if err != nil {
+		if err == errors.ErrDatasetViewNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	view.IsDefault = true
+
+	return &view, nil
+}
+
+func (s *datasetService) SetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) (models.DatasetView, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	view, err := s.GetDatasetView(ctx, datasetId, viewId)
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+
+	if _, err := s.datasetStore.SetDefaultDatasetView(ctx, userId, datasetId, viewId); err != nil {
+		logger.Error("failed to set default dataset view", zap.String("view_id", viewId.String()), zap.String("error", err.Error()))
+		return models.DatasetView{}, err
+	}
+	view.IsDefault = true
+
+	return view, nil
+}
+
+func (s *datasetService) ClearDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.datasetStore.DeleteDefaultDatasetView(ctx, userId, datasetId); err != nil {
+		logger.Error("failed to clear default dataset view", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (s *datasetService) GetDatasetExportSchedules(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetExportSchedule, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeSchedules, err := s.datasetStore.GetDatasetExportSchedules(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get dataset export schedules", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	schedules := make([]models.DatasetExportSchedule, 0, len(storeSchedules))
+	for _, storeSchedule := range storeSchedules {
+		schedule := models.DatasetExportSchedule{}
+		if err := schedule.FromSchema(storeSchedule); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
+
+func (s *datasetService) GetDatasetExportSchedule(ctx context.Context, datasetId uuid.UUID, scheduleId uuid.UUID) (models.DatasetExportSchedule, error) {
+	storeSchedule, err := s.getDatasetExportSchedule(ctx, datasetId, scheduleId)
+	if err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	schedule := models.DatasetExportSchedule{}
+	if err := schedule.FromSchema(storeSchedule); err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// CreateDatasetExportSchedule saves the schedule and registers its temporal schedule in the same transaction,
+// runs export with the dataset access of the creator who becomes the owner of the schedule
+func (s *datasetService) CreateDatasetExportSchedule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	params, err := s.validateDatasetExportScheduleParamsForOrganization(ctx, merchantId, params)
+	if err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	var storeSchedule storemodels.DatasetExportSchedule
+	err = s.datasetStore.WithDatasetExportScheduleTransaction(ctx, func(es store.DatasetExportScheduleStore) error {
+		storeSchedule, err = es.CreateDatasetExportSchedule(ctx, storemodels.CreateDatasetExportScheduleParams{
+			OrganizationId:     merchantId,
+			DatasetId:          datasetId,
+			OwnerId:            userId,
+			Name:               params.Name,
+			QueryConfig:        params.QueryConfig,
+			Format:             string(params.Format),
+			CronExpression:     params.CronExpression,
+			Timezone:           params.Timezone,
+			DestinationType:    string(params.DestinationType),
+			DestinationConfig:  params.DestinationConfig,
+			TemporalScheduleId: fmt.Sprintf(datasetConstants.DatasetExportScheduleTemporalIdFormat, uuid.New().String()),
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.createDatasetExportTemporalSchedule(ctx, storeSchedule)
+	})
+	if err != nil {
+		logger.Error("failed to create dataset export schedule", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return models.DatasetExportSchedule{}, err
+	}
+
+	schedule := models.DatasetExportSchedule{}
+	if err := schedule.FromSchema(storeSchedule); err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// UpdateDatasetExportSchedule replaces the temporal schedule when the cron expression or the timezone change,
+// the previous temporal schedule keeps firing but its runs are skipped as they no longer match the schedule
+func (s *datasetService) UpdateDatasetExportSchedule(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, scheduleId uuid.UUID, params models.DatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	currentSchedule, err := s.getDatasetExportSchedule(ctx, datasetId, scheduleId)
+	if err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	if err := s.ensureDatasetExportScheduleEditor(ctx, currentSchedule, userId); err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	params, err = s.validateDatasetExportScheduleParamsForOrganization(ctx, currentSchedule.OrganizationId, params)
+	if err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	isEnabled := currentSchedule.IsEnabled
+	if params.IsEnabled != nil {
+		isEnabled = *params.IsEnabled
+	}
+
+	temporalScheduleId := currentSchedule.TemporalScheduleId
+	if params.CronExpression != currentSchedule.CronExpression || params.Timezone != currentSchedule.Timezone {
+		temporalScheduleId = fmt.Sprintf(datasetConstants.DatasetExportScheduleTemporalIdFormat, uuid.New().String())
+	}
+
+	var storeSchedule storemodels.DatasetExportSchedule
+	err = s.datasetStore.WithDatasetExportScheduleTransaction(ctx, func(es store.DatasetExportScheduleStore) error {
+		storeSchedule, err = es.UpdateDatasetExportSchedule(ctx, scheduleId, storemodels.UpdateDatasetExportScheduleParams{
+			Name:               params.Name,
+			QueryConfig:        params.QueryConfig,
+			Format:             string(params.Format),
+			CronExpression:     params.CronExpression,
+			Timezone:           params.Timezone,
+			DestinationType:    string(params.DestinationType),
+			DestinationConfig:  params.DestinationConfig,
+			TemporalScheduleId: temporalScheduleId,
+			IsEnabled:          isEnabled,
+			UpdatedBy:          userId,
+		})
+		if err != nil {
+			return err
+		}
+
+		if temporalScheduleId == currentSchedule.TemporalScheduleId {
+			return nil
+		}
+
+		return s.createDatasetExportTemporalSchedule(ctx, storeSchedule)
+	})
+	if err != nil {
+		logger.Error("failed to update dataset export schedule", zap.String("schedule_id", scheduleId.String()), zap.String("error", err.Error()))
+		return models.DatasetExportSchedule{}, err
+	}
+
+	schedule := models.DatasetExportSchedule{}
+	if err := schedule.FromSchema(storeSchedule); err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// DeleteDatasetExportSchedule soft deletes the schedule, runs its temporal schedule fires afterwards are skipped
+func (s *datasetService) DeleteDatasetExportSchedule(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, scheduleId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	schedule, err := s.getDatasetExportSchedule(ctx, datasetId, scheduleId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ensureDatasetExportScheduleEditor(ctx, schedule, userId); err != nil {
+		return err
+	}
+
+	if err := s.datasetStore.DeleteDatasetExportSchedule(ctx, scheduleId, userId); err != nil {
+		logger.Error("failed to delete dataset export schedule", zap.String("schedule_id", scheduleId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (s *datasetService) GetDatasetExportScheduleRuns(ctx context.Context, datasetId uuid.UUID, scheduleId uuid.UUID) ([]models.DatasetExportScheduleRun, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if _, err := s.getDatasetExportSchedule(ctx, datasetId, scheduleId); err != nil {
+		return nil, err
+	}
+
+	storeRuns, err := s.datasetStore.GetDatasetExportScheduleRuns(ctx, scheduleId, datasetConstants.DatasetExportScheduleRunsLimit)
+	if err != nil {
+		logger.Error("failed to get dataset export schedule runs", zap.String("schedule_id", scheduleId.String()), zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	runs := make([]models.DatasetExportScheduleRun, len(storeRuns))
+	for i, storeRun := range storeRuns {
+		runs[i].FromSchema(storeRun)
+	}
+
+	return runs, nil
+}
+
+func (s *datasetService) GetDatasetAlerts(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetAlert, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeAlerts, err := s.datasetStore.GetDatasetAlerts(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get dataset alerts", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	alerts := make([]models.DatasetAlert, 0, len(storeAlerts))
+	for _, storeAlert := range storeAlerts {
+		alert := models.DatasetAlert{}
+		if err := alert.FromSchema(storeAlert); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
+func (s *datasetService) GetDatasetAlert(ctx context.Context, datasetId uuid.UUID, alertId uuid.UUID) (models.DatasetAlert, error) {
+	storeAlert, err := s.getDatasetAlert(ctx, datasetId, alertId)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	alert := models.DatasetAlert{}
+	if err := alert.FromSchema(storeAlert); err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	return alert, nil
+}
+
+// CreateDatasetAlert saves the alert and registers the temporal schedule evaluating it in the same transaction,
+// the metric is evaluated with the dataset access of the creator who becomes the owner of the alert
+func (s *datasetService) CreateDatasetAlert(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetAlertParams) (models.DatasetAlert, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	params, err := validateDatasetAlertParams(params)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	var storeAlert storemodels.DatasetAlert
+	err = s.datasetStore.WithDatasetAlertTransaction(ctx, func(as store.DatasetAlertStore) error {
+		storeAlert, err = as.CreateDatasetAlert(ctx, storemodels.CreateDatasetAlertParams{
+			OrganizationId:     merchantId,
+			DatasetId:          datasetId,
+			OwnerId:            userId,
+			Name:               params.Name,
+			Metric:             params.Metric,
+			Condition:          params.Condition,
+			CronExpression:     params.CronExpression,
+			Timezone:           params.Timezone,
+			Recipients:         params.Recipients,
+			WebhookUrl:         params.WebhookUrl,
+			TemporalScheduleId: fmt.Sprintf(datasetConstants.DatasetAlertTemporalIdFormat, uuid.New().String()),
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.createDatasetAlertTemporalSchedule(ctx, storeAlert)
+	})
+	if err != nil {
+		logger.Error("failed to create dataset alert", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return models.DatasetAlert{}, err
+	}
+
+	alert := models.DatasetAlert{}
+	if err := alert.FromSchema(storeAlert); err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	return alert, nil
+}
+
+// UpdateDatasetAlert keeps the state of the alert, a changed definition is first evaluated against the state
+// reached by the previous one. Like export schedules, the temporal schedule is replaced when the cron or timezone change.
+func (s *datasetService) UpdateDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID, params models.DatasetAlertParams) (models.DatasetAlert, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	currentAlert, err := s.getDatasetAlert(ctx, datasetId, alertId)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	if err := s.ensureDatasetAlertEditor(ctx, currentAlert, userId); err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	params, err = validateDatasetAlertParams(params)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	isEnabled := currentAlert.IsEnabled
+	if params.IsEnabled != nil {
+		isEnabled = *params.IsEnabled
+	}
+
+	temporalScheduleId := currentAlert.TemporalScheduleId
+	if params.CronExpression != currentAlert.CronExpression || params.Timezone != currentAlert.Timezone {
+		temporalScheduleId = fmt.Sprintf(datasetConstants.DatasetAlertTemporalIdFormat, uuid.New().String())
+	}
+
+	var storeAlert storemodels.DatasetAlert
+	err = s.datasetStore.WithDatasetAlertTransaction(ctx, func(as store.DatasetAlertStore) error {
+		storeAlert, err = as.UpdateDatasetAlert(ctx, alertId, storemodels.UpdateDatasetAlertParams{
+			Name:               params.Name,
+			Metric:             params.Metric,
+			Condition:          params.Condition,
+			CronExpression:     params.CronExpression,
+			Timezone:           params.Timezone,
+			Recipients:         params.Recipients,
+			WebhookUrl:         params.WebhookUrl,
+			TemporalScheduleId: temporalScheduleId,
+			IsEnabled:          isEnabled,
+			UpdatedBy:          userId,
+		})
+		if err != nil {
+			return err
+		}
+
+		if temporalScheduleId == currentAlert.TemporalScheduleId {
+			return nil
+		}
+
+		return s.createDatasetAlertTemporalSchedule(ctx, storeAlert)
+	})
+	if err != nil {
+		logger.Error("failed to update dataset alert", zap.String("alert_id", alertId.String()), zap.String("error", err.Error()))
+		return models.DatasetAlert{}, err
+	}
+
+	alert := models.DatasetAlert{}
+	if err := alert.FromSchema(storeAlert); err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	return alert, nil
+}
+
+// DeleteDatasetAlert soft deletes the alert, evaluations its temporal schedule fires afterwards are skipped
+func (s *datasetService) DeleteDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	alert, err := s.getDatasetAlert(ctx, datasetId, alertId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ensureDatasetAlertEditor(ctx, alert, userId); err != nil {
+		return err
+	}
+
+	if err := s.datasetStore.DeleteDatasetAlert(ctx, alertId, userId); err != nil {
+		logger.Error("failed to delete dataset alert", zap.String("alert_id", alertId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// SnoozeDatasetAlert mutes the notifications of the alert until the given time. Evaluations keep tracking its state,
+// so an alert that starts firing while snoozed is not notified once the snooze ends unless it resolves and fires again.
+func (s *datasetService) SnoozeDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID, until time.Time) (models.DatasetAlert, error) {
+	now := time.Now()
+	if !until.After(now) || until.Sub(now) > datasetConstants.DatasetAlertMaxSnooze {
+		return models.DatasetAlert{}, errors.ErrInvalidAlertSnooze
+	}
+
+	return s.updateDatasetAlertSnooze(ctx, userId, datasetId, alertId, &until)
+}
+
+func (s *datasetService) UnsnoozeDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID) (models.DatasetAlert, error) {
+	return s.updateDatasetAlertSnooze(ctx, userId, datasetId, alertId, nil)
+}
+
+func (s *datasetService) updateDatasetAlertSnooze(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID, until *time.Time) (models.DatasetAlert, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	currentAlert, err := s.getDatasetAlert(ctx, datasetId, alertId)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	if err := s.ensureDatasetAlertEditor(ctx, currentAlert, userId); err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	storeAlert, err := s.datasetStore.UpdateDatasetAlertSnooze(ctx, alertId, until, userId)
+	if err != nil {
+		logger.Error("failed to update dataset alert snooze", zap.String("alert_id", alertId.String()), zap.String("error", err.Error()))
+		return models.DatasetAlert{}, err
+	}
+
+	alert := models.DatasetAlert{}
+	if err := alert.FromSchema(storeAlert); err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	return alert, nil
+}
+
+func (s *datasetService) GetDatasetAlertEvents(ctx context.Context, datasetId uuid.UUID, alertId uuid.UUID) ([]models.DatasetAlertEvent, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if _, err := s.getDatasetAlert(ctx, datasetId, alertId); err != nil {
+		return nil, err
+	}
+
+	storeEvents, err := s.datasetStore.GetDatasetAlertEvents(ctx, alertId, datasetConstants.DatasetAlertEventsLimit)
+	if err != nil {
+		logger.Error("failed to get dataset alert events", zap.String("alert_id", alertId.String()), zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	events := make([]models.DatasetAlertEvent, len(storeEvents))
+	for i, storeEvent := range storeEvents {
+		events[i].FromSchema(storeEvent)
+	}
+
+	return events, nil
+}
+
+func (s *datasetService) GetDatasetStatusWorkflows(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetStatusWorkflow, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeWorkflows, err := s.datasetStore.GetDatasetStatusWorkflows(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get dataset status workflows", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetStatusWorkflows
+	}
+
+	workflows := make([]models.DatasetStatusWorkflow, 0, len(storeWorkflows))
+	for _, storeWorkflow := range storeWorkflows {
+		workflow := models.DatasetStatusWorkflow{}
+		if err := workflow.FromSchema(storeWorkflow); err != nil {
+			return nil, errors.ErrFailedToGetStatusWorkflows
+		}
+		workflows = append(workflows, workflow)
+	}
+
+	return workflows, nil
+}
+
+// CreateDatasetStatusWorkflow puts a status column under a state machine, a column has at most one workflow
+func (s *datasetService) CreateDatasetStatusWorkflow(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	params, err := s.validateStatusWorkflowParams(ctx, merchantId, datasetId, params)
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	workflows, err := s.getStatusWorkflows(ctx, datasetId)
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+	if _, ok := workflows[params.Column]; ok {
+		return models.DatasetStatusWorkflow{}, errors.ErrStatusWorkflowExists
+	}
+
+	storeWorkflow, err := s.datasetStore.CreateDatasetStatusWorkflow(ctx, storemodels.CreateDatasetStatusWorkflowParams{
+		OrganizationId: merchantId,
+		DatasetId:      datasetId,
+		ColumnName:     params.Column,
+		InitialState:   params.InitialState,
+		States:         params.States,
+		Transitions:    params.Transitions,
+		CreatedBy:      userId,
+	})
+	if err != nil {
+		logger.Error("failed to create dataset status workflow", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	workflow := models.DatasetStatusWorkflow{}
+	if err := workflow.FromSchema(storeWorkflow); err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	return workflow, nil
+}
+
+// UpdateDatasetStatusWorkflow replaces the states and transitions of a workflow, the column it governs is fixed.
+// Rows left in a removed state can only be moved by editing them back into a known one through a new transition.
+func (s *datasetService) UpdateDatasetStatusWorkflow(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, workflowId uuid.UUID, params models.DatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	existingWorkflow, err := s.getDatasetStatusWorkflow(ctx, datasetId, workflowId)
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	params.Column = existingWorkflow.ColumnName
+	params, err = s.validateStatusWorkflowParams(ctx, merchantId, datasetId, params)
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	storeWorkflow, err := s.datasetStore.UpdateDatasetStatusWorkflow(ctx, workflowId, storemodels.UpdateDatasetStatusWorkflowParams{
+		InitialState: params.InitialState,
+		States:       params.States,
+		Transitions:  params.Transitions,
+		UpdatedBy:    userId,
+	})
+	if err != nil {
+		logger.Error("failed to update dataset status workflow", zap.String("workflow_id", workflowId.String()), zap.String("error", err.Error()))
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	workflow := models.DatasetStatusWorkflow{}
+	if err := workflow.FromSchema(storeWorkflow); err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	return workflow, nil
+}
+
+func (s *datasetService) DeleteDatasetStatusWorkflow(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, workflowId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if _, err := s.getDatasetStatusWorkflow(ctx, datasetId, workflowId); err != nil {
+		return err
+	}
+
+	if err := s.datasetStore.DeleteDatasetStatusWorkflow(ctx, workflowId, userId); err != nil {
+		logger.Error("failed to delete dataset status workflow", zap.String("workflow_id", workflowId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// TransitionDatasetRowStatus moves a single row of a workflow governed column to a new state. Unlike dataset updates
+// it is open to every user of the dataset, the privileges of the transitions decide who can make them.
+func (s *datasetService) TransitionDatasetRowStatus(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, rowId string, column string, state string) (models.DatasetAction, error) {
+	workflows, err := s.getStatusWorkflows(ctx, datasetId)
+	if err != nil {
+		return models.DatasetAction{}, err
+	}
+	if _, ok := workflows[column]; !ok {
+		return models.DatasetAction{}, fmt.Errorf("%w: %s", errors.ErrStatusWorkflowNotFound, column)
+	}
+
+	if err := s.ensureDatasetRowExists(ctx, merchantId, datasetId, rowId); err != nil {
+		return models.DatasetAction{}, err
+	}
+
+	return s.UpdateDatasetData(ctx, merchantId, datasetId, models.UpdateDatasetDataParams{
+		Filters:    getRowFilter(rowId),
+		Update:     models.UpdateColumn{Column: column, Value: state},
+		SourceType: datasetConstants.UpdateColumnSourceTypeUser,
+		UserId:     userId,
+	})
+}
+
+// GetDatasetRowAssignment returns the assignment of a row, nil when the row was never assigned
+func (s *datasetService) GetDatasetRowAssignment(ctx context.Context, datasetId uuid.UUID, rowId string) (*models.DatasetRowAssignment, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeAssignment, err := s.datasetStore.GetDatasetRowAssignment(ctx, datasetId, rowId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.Error("failed to get dataset row assignment", zap.String("row_id", rowId), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetRowAssignments
+	}
+
+	assignment := models.DatasetRowAssignment{}
+	assignment.FromSchema(storeAssignment)
+
+	return &assignment, nil
+}
+
+// AssignDatasetRow sets the assignee and due date of a row the user can read, a nil assignee unassigns it.
+// Assignees need access to the dataset to work the row.
+func (s *datasetService) AssignDatasetRow(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, rowId string, params models.DatasetRowAssignmentParams) (models.DatasetRowAssignment, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.ensureDatasetRowExists(ctx, merchantId, datasetId, rowId); err != nil {
+		return models.DatasetRowAssignment{}, err
+	}
+
+	if params.AssigneeId != nil {
+		if err := s.ensureDatasetAssignee(ctx, datasetId, *params.AssigneeId); err != nil {
+			return models.DatasetRowAssignment{}, err
+		}
+	}
+
+	storeAssignment, err := s.datasetStore.UpsertDatasetRowAssignment(ctx, storemodels.UpsertDatasetRowAssignmentParams{
+		OrganizationId: merchantId,
+		DatasetId:      datasetId,
+		RowId:          rowId,
+		AssigneeId:     params.AssigneeId,
+		DueDate:        params.DueDate,
+		UpdatedBy:      userId,
+	})
+	if err != nil {
+		logger.Error("failed to assign dataset row", zap.String("row_id", rowId), zap.String("error", err.Error()))
+		return models.DatasetRowAssignment{}, err
+	}
+
+	assignment := models.DatasetRowAssignment{}
+	assignment.FromSchema(storeAssignment)
+
+	return assignment, nil
+}
+
+// GetMyQueue lists the open rows assigned to the user across the datasets of the organization, earliest due first.
+// Rows whose status columns all reached a final state are done and left out.
+func (s *datasetService) GetMyQueue(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID) ([]models.DatasetQueueItem, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeAssignments, err := s.datasetStore.GetDatasetRowAssignmentsForAssignee(ctx, userId)
+	if err != nil {
+		logger.Error("failed to get dataset row assignments", zap.String("user_id", userId.String()), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetRowAssignments
+	}
+
+	assignments := []models.DatasetRowAssignment{}
+	assignmentsByDataset := map[uuid.UUID][]models.DatasetRowAssignment{}
+	for _, storeAssignment := range storeAssignments {
+		if storeAssignment.OrganizationId != merchantId {
+			continue
+		}
+		assignment := models.DatasetRowAssignment{}
+		assignment.FromSchema(storeAssignment)
+		assignments = append(assignments, assignment)
+		assignmentsByDataset[assignment.DatasetId] = append(assignmentsByDataset[assignment.DatasetId], assignment)
+	}
+
+	itemsByDataset := make(map[uuid.UUID]map[string]models.DatasetQueueItem, len(assignmentsByDataset))
+	for datasetId, datasetAssignments := range assignmentsByDataset {
+		// a dataset that cannot be read, e.g. one the user lost access to, leaves its rows out of the queue
+		items, err := s.getDatasetQueueItems(ctx, merchantId, datasetId, datasetAssignments)
+		if err != nil {
+			logger.Warn("skipping dataset of the queue", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+			continue
+		}
+		itemsByDataset[datasetId] = items
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	queue := []models.DatasetQueueItem{}
+	for _, assignment := range assignments {
+		item, ok := itemsByDataset[assignment.DatasetId][assignment.RowId]
+		if !ok {
+			continue
+		}
+		item.IsOverdue = assignment.DueDate != nil && assignment.DueDate.Before(today)
+		queue = append(queue, item)
+	}
+
+	return queue, nil
+}
+
+// GetDatasetRules lists the live rules of a dataset, by column and then by priority
+func (s *datasetService) GetDatasetRules(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID) ([]rulemodels.Rule, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	rulesByDataset, err := s.ruleService.GetRules(ctx, storemodels.FilterRuleParams{
+		OrganizationId: merchantId,
+		DatasetColumns: []storemodels.DatasetColumn{{DatasetId: datasetId}},
+	})
+	if err != nil {
+		logger.Error("failed to get dataset rules", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetRule
+	}
+
+	rulesByColumn := rulesByDataset[datasetId.String()]
+	columns := make([]string, 0, len(rulesByColumn))
+	for column := range rulesByColumn {
+		columns = append(columns, column)
+	}
+	slices.Sort(columns)
+
+	rules := []rulemodels.Rule{}
+	for _, column := range columns {
+		rules = append(rules, rulesByColumn[column]...)
+	}
+
+	return rules, nil
+}
+
+func (s *datasetService) GetDatasetRule(ctx context.Context, datasetId uuid.UUID, ruleId uuid.UUID) (rulemodels.Rule, error) {
+	return s.getDatasetRule(ctx, datasetId, ruleId)
+}
+
+// CreateDatasetRule goes through the same path as saving an update of the dataset as a rule, so the new rule
+// takes the highest priority of its column and is applied right away
+func (s *datasetService) CreateDatasetRule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.DatasetRuleParams) (models.DatasetRuleChange, error) {
+	params, err := normalizeDatasetRuleAssignments(params)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	if _, err := s.validateDatasetRuleParams(ctx, merchantId, datasetId, params); err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	assignments, err := s.resolveDatasetRuleAssignments(ctx, merchantId, datasetId, params)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	ruleId := uuid.New()
+	action, err := s.UpdateDatasetData(ctx, merchantId, datasetId, models.UpdateDatasetDataParams{
+		Filters:         params.Filters,
+		Update:          models.UpdateColumn{Column: params.Column, Value: params.Value},
+		SourceType:      datasetConstants.UpdateColumnSourceTypeRule,
+		SourceId:        ruleId,
+		UserId:          userId,
+		RuleTitle:       strings.TrimSpace(params.Title),
+		RuleDescription: params.Description,
+		RuleAssignments: assignments,
+	})
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	rule, err := s.getDatasetRule(ctx, datasetId, ruleId)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	return models.DatasetRuleChange{Rule: rule, Action: action, Warnings: s.getDatasetRuleWarnings(ctx, merchantId, datasetId, rule)}, nil
+}
+
+// UpdateDatasetRule replaces the title, description, filters and value or assignments of a rule, the column it is
+// prioritized with is fixed. Columns no longer assigned get their rules re-applied without the rule.
+func (s *datasetService) UpdateDatasetRule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, params models.DatasetRuleParams) (models.DatasetRuleChange, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	existingRule, err := s.getDatasetRule(ctx, datasetId, ruleId)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	params.Column = existingRule.Column
+	if params, err = normalizeDatasetRuleAssignments(params); err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	columnDatatypes, err := s.validateDatasetRuleParams(ctx, merchantId, datasetId, params)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	assignments, err := s.resolveDatasetRuleAssignments(ctx, merchantId, datasetId, params)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	if params.Value, err = s.resolveDatasetRuleValue(ctx, merchantId, datasetId, params); err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	queryConfig, err := s.mapUpdateDatasetDataParamsToQueryConfig(datasetId, models.UpdateDatasetDataParams{Filters: params.Filters}, columnDatatypes, make(map[string]querybuildermodels.CustomDataTypeConfig))
+	if err != nil {
+		logger.Error("failed to map rule filters to query config", zap.String("error", err.Error()))
+		return models.DatasetRuleChange{}, err
+	}
+
+	query, queryParams, err := s.queryBuilderService.ToFilterSQL(ctx, queryConfig.Filters)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	err = s.ruleService.UpdateRule(ctx, ruleId, storemodels.UpdateRuleParams{
+		Title:       strings.TrimSpace(params.Title),
+		Description: params.Description,
+		Value:       fmt.Sprintf("%v", params.Value),
+		FilterConfig: rulemodels.FilterConfig{
+			QueryConfig: queryConfig,
+			Sql:         query,
+			Args:        queryParams,
+		},
+		Assignments: assignments,
+		UpdatedBy:   userId,
+	})
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	columns := getDatasetRulesColumns(existingRule.Column, existingRule, rulemodels.Rule{Column: existingRule.Column, Assignments: assignments})
+	action, err := s.applyDatasetRules(ctx, merchantId, userId, datasetId, columns, ruleId, dataplatformactionconstants.UpsertRuleOperationUpdate)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	rule, err := s.getDatasetRule(ctx, datasetId, ruleId)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	return models.DatasetRuleChange{Rule: rule, Action: action, Warnings: s.getDatasetRuleWarnings(ctx, merchantId, datasetId, rule)}, nil
+}
+
+// SetDatasetRuleEnabled keeps a disabled rule and its priority, it is only left out when the rules are applied
+func (s *datasetService) SetDatasetRuleEnabled(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, isEnabled bool) (models.DatasetRuleChange, error) {
+	existingRule, err := s.getDatasetRule(ctx, datasetId, ruleId)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	if err := s.ruleService.SetRuleEnabled(ctx, ruleId, isEnabled, userId); err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	action, err := s.applyDatasetRules(ctx, merchantId, userId, datasetId, existingRule.GetColumns(), ruleId, dataplatformactionconstants.UpsertRuleOperationUpdate)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	rule, err := s.getDatasetRule(ctx, datasetId, ruleId)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	return models.DatasetRuleChange{Rule: rule, Action: action}, nil
+}
+
+// DeleteDatasetRule returns the rule as it was before the deletion
+func (s *datasetService) DeleteDatasetRule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID) (models.DatasetRuleChange, error) {
+	rule, err := s.getDatasetRule(ctx, datasetId, ruleId)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	if err := s.ruleService.DeleteRule(ctx, storemodels.DeleteRuleParams{RuleId: ruleId, DeletedBy: userId}); err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	action, err := s.applyDatasetRules(ctx, merchantId, userId, datasetId, rule.GetColumns(), ruleId, dataplatformactionconstants.UpsertRuleOperationDelete)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	return models.DatasetRuleChange{Rule: rule, Action: action}, nil
+}
+
+// PreviewDatasetRule runs a candidate rule against the live dataset without saving or applying it. Sample rows show
+// the value the candidate would write and the value they end up with once the rules applied before it have run.
+func (s *datasetService) PreviewDatasetRule(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.DatasetRulePreviewParams) (models.DatasetRulePreview, error) {
+	if params.RuleId != nil {
+		existingRule, err := s.getDatasetRule(ctx, datasetId, *params.RuleId)
+		if err != nil {
+			return models.DatasetRulePreview{}, err
+		}
+		params.Column = existingRule.Column
+		if params.Priority <= 0 {
+			params.Priority = existingRule.Priority
+		}
+	}
+	if params.Priority <= 0 {
+		params.Priority = 1
+	}
+
+	// the preview samples the column the rule is prioritized with, only a literal value is known upfront
+	var err error
+	if params.DatasetRuleParams, err = normalizeDatasetRuleAssignments(params.DatasetRuleParams); err != nil {
+		return models.DatasetRulePreview{}, err
+	}
+	if len(params.Assignments) > 0 && params.Assignments[0].ValueType != rulemodels.RuleAssignmentValueTypeLiteral {
+		return models.DatasetRulePreview{}, fmt.Errorf("%w: %s", errors.ErrRuleAssignmentNotPreviewable, params.Column)
+	}
+
+	if _, err := s.validateDatasetRuleDefinition(ctx, merchantId, datasetId, params.DatasetRuleParams); err != nil {
+		return models.DatasetRulePreview{}, err
+	}
+
+	proposedValue, err := s.resolveDatasetRuleValue(ctx, merchantId, datasetId, params.DatasetRuleParams)
+	if err != nil {
+		return models.DatasetRulePreview{}, err
+	}
+
+	sample, err := s.getDataByDatasetId(ctx, merchantId, datasetId.String(), models.DatasetParams{
+		Columns:    []models.ColumnConfig{{Column: datasetConstants.ZampIDColumn}, {Column: params.Column}},
+		Filters:    params.Filters,
+		CountAll:   true,
+		Pagination: &models.Pagination{Page: 1, PageSize: datasetConstants.DatasetRulePreviewSampleSize},
+	}, nil, nil)
+	if err != nil {
+		return models.DatasetRulePreview{}, err
+	}
+
+	preview := models.DatasetRulePreview{
+		Column:        params.Column,
+		ProposedValue: proposedValue,
+		Priority:      params.Priority,
+		Rows:          make([]models.DatasetRulePreviewRow, 0, len(sample.Rows)),
+		Overrides:     []models.DatasetRuleOverride{},
+	}
+	if sample.TotalCount != nil {
+		preview.MatchCount = *sample.TotalCount
+	}
+
+	rowIds := make([]string, 0, len(sample.Rows))
+	rowIndex := make(map[string]int, len(sample.Rows))
+	for _, row := range sample.Rows {
+		rowId := fmt.Sprintf("%v", row[datasetConstants.ZampIDColumn])
+		rowIndex[rowId] = len(preview.Rows)
+		rowIds = append(rowIds, rowId)
+		preview.Rows = append(preview.Rows, models.DatasetRulePreviewRow{
+			RowId:          rowId,
+			CurrentValue:   row[params.Column],
+			ProposedValue:  proposedValue,
+			EffectiveValue: proposedValue,
+		})
+	}
+
+	if preview.MatchCount == 0 {
+		return preview, nil
+	}
+
+	higherRules, err := s.getHigherPriorityRules(ctx, merchantId, datasetId, params.Column, params.Priority, params.RuleId)
+	if err != nil {
+		return models.DatasetRulePreview{}, err
+	}
+
+	// rules are in priority order, a sample row is attributed to the first one matching it
+	var overridingFilters []models.FilterModel
+	for _, rule := range higherRules {
+		ruleFilters := models.RuleFilters(rule)
+
+		matchCount, err := s.countDatasetRows(ctx, merchantId, datasetId, andFilters(params.Filters, ruleFilters))
+		if err != nil {
+			return models.DatasetRulePreview{}, err
+		}
+		if matchCount == 0 {
+			continue
+		}
+
+		preview.Overrides = append(preview.Overrides, models.DatasetRuleOverride{
+			RuleId:     rule.ID,
+			Title:      rule.Title,
+			Priority:   rule.Priority,
+			Value:      rule.Value,
+			MatchCount: matchCount,
+		})
+		overridingFilters = append(overridingFilters, ruleFilters)
+
+		if len(rowIds) == 0 {
+			continue
+		}
+
+		overridden, err := s.getDataByDatasetId(ctx, merchantId, datasetId.String(), models.DatasetParams{
+			Columns: []models.ColumnConfig{{Column: datasetConstants.ZampIDColumn}},
+			Filters: andFilters(params.Filters, ruleFilters, getRowIdsFilter(rowIds)),
+		}, nil, nil)
+		if err != nil {
+			return models.DatasetRulePreview{}, err
+		}
+
+		for _, row := range overridden.Rows {
+			index, ok := rowIndex[fmt.Sprintf("%v", row[datasetConstants.ZampIDColumn])]
+			if !ok || preview.Rows[index].OverriddenBy != nil {
+				continue
+			}
+			ruleId := rule.ID
+			preview.Rows[index].OverriddenBy = &ruleId
+			preview.Rows[index].EffectiveValue = rule.Value
+		}
+	}
+
+	// overlaps of the overriding rules with each other are counted once
+	if len(overridingFilters) == 1 {
+		preview.OverriddenCount = preview.Overrides[0].MatchCount
+	} else if len(overridingFilters) > 1 {
+		if preview.OverriddenCount, err = s.countDatasetRows(ctx, merchantId, datasetId, andFilters(params.Filters, orFilters(overridingFilters...))); err != nil {
+			return models.DatasetRulePreview{}, err
+		}
+	}
+
+	return preview, nil
+}
+
+// AnalyzeDatasetRules reports the shadowed, overlapping and zero-match rules of each column of the dataset, or of one
+// column when set. Columns with more rules than can be compared pairwise are analysed for their top rules only.
+func (s *datasetService) AnalyzeDatasetRules(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, column string) ([]models.DatasetRuleAnalysis, error) {
+	var columns []string
+	if column != "" {
+		columns = []string{column}
+	}
+
+	columnRules, err := s.getEnabledColumnRules(ctx, merchantId, datasetId, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	columns = make([]string, 0, len(columnRules))
+	for ruleColumn := range columnRules {
+		columns = append(columns, ruleColumn)
+	}
+	slices.Sort(columns)
+
+	analyses := make([]models.DatasetRuleAnalysis, 0, len(columns))
+	for _, ruleColumn := range columns {
+		rules := columnRules[ruleColumn]
+		analysis := models.DatasetRuleAnalysis{
+			Column:    ruleColumn,
+			RuleCount: len(rules),
+			Truncated: len(rules) > datasetConstants.DatasetRuleAnalysisMaxRules,
+		}
+		if analysis.Truncated {
+			rules = rules[:datasetConstants.DatasetRuleAnalysisMaxRules]
+		}
+
+		if analysis.Conflicts, err = s.analyzeDatasetRules(ctx, merchantId, datasetId, rules, nil); err != nil {
+			return nil, err
+		}
+		analyses = append(analyses, analysis)
+	}
+
+	return analyses, nil
+}
+
+// GetDatasetRuleVersions returns the history of a rule latest first, the history of a deleted rule is kept
+func (s *datasetService) GetDatasetRuleVersions(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID) ([]models.DatasetRuleVersion, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	rules, err := s.ruleService.GetRuleByIds(ctx, []uuid.UUID{ruleId})
+	if err != nil {
+		logger.Error("failed to get rule", zap.String("rule_id", ruleId.String()), zap.String("error", err.Error()))
+		return nil, errors.ErrFailedToGetRule
+	}
+	if len(rules) == 0 || rules[0].DatasetId != datasetId {
+		return nil, errors.ErrRuleNotFound
+	}
+
+	ruleVersions, err := s.ruleService.GetRuleVersions(ctx, ruleId)
+	if err != nil {
+		return nil, errors.ErrFailedToGetRuleVersions
+	}
+
+	return getDatasetRuleVersions(ruleVersions), nil
+}
+
+// RestoreDatasetRuleVersion puts a rule back to a version as a new change, the version has to still fit the schema
+func (s *datasetService) RestoreDatasetRuleVersion(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, version int) (models.DatasetRuleChange, error) {
+	existingRule, err := s.getDatasetRule(ctx, datasetId, ruleId)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	ruleVersions, err := s.ruleService.GetRuleVersions(ctx, ruleId)
+	if err != nil {
+		return models.DatasetRuleChange{}, errors.ErrFailedToGetRuleVersions
+	}
+
+	versionIndex := slices.IndexFunc(ruleVersions, func(ruleVersion rulemodels.RuleVersion) bool { return ruleVersion.Version == version })
+	if versionIndex < 0 {
+		return models.DatasetRuleChange{}, errors.ErrRuleVersionNotFound
+	}
+
+	restoredRule := ruleVersions[versionIndex].Rule()
+	restoredRule.Column = existingRule.Column
+	if _, err := s.validateDatasetRuleDefinition(ctx, merchantId, datasetId, models.DatasetRuleParams{
+		Column:      existingRule.Column,
+		Filters:     models.RuleFilters(restoredRule),
+		Value:       restoredRule.Value,
+		Assignments: restoredRule.Assignments,
+	}); err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	if _, err := s.ruleService.RestoreRuleVersion(ctx, ruleId, version, userId); err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	columns := getDatasetRulesColumns(existingRule.Column, existingRule, restoredRule)
+	action, err := s.applyDatasetRules(ctx, merchantId, userId, datasetId, columns, ruleId, dataplatformactionconstants.UpsertRuleOperationUpdate)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	rule, err := s.getDatasetRule(ctx, datasetId, ruleId)
+	if err != nil {
+		return models.DatasetRuleChange{}, err
+	}
+
+	return models.DatasetRuleChange{Rule: rule, Action: action, Warnings: s.getDatasetRuleWarnings(ctx, merchantId, datasetId, rule)}, nil
+}
+
+func (s *datasetService) GetDatasetRuleStats(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID) (models.DatasetRuleStats, error) {
+	rule, err := s.getDatasetRule(ctx, datasetId, ruleId)
+	if err != nil {
+		return models.DatasetRuleStats{}, err
+	}
+
+	stats, err := s.getDatasetRulesStats(ctx, merchantId, datasetId, []rulemodels.Rule{rule})
+	if err != nil {
+		return models.DatasetRuleStats{}, err
+	}
+
+	return stats[0], nil
+}
+
+// GetDatasetRulesStats returns the effectiveness of every rule of the dataset, stale rules are the ones to prune
+func (s *datasetService) GetDatasetRulesStats(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID) ([]models.DatasetRuleStats, error) {
+	rules, err := s.GetDatasetRules(ctx, merchantId, datasetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return []models.DatasetRuleStats{}, nil
+	}
+
+	return s.getDatasetRulesStats(ctx, merchantId, datasetId, rules)
+}
+
+// ExportDatasetRuleBundle returns the rules of the dataset, or of some of its columns, as a bundle
+func (s *datasetService) ExportDatasetRuleBundle(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, columns []string) (models.DatasetRuleBundle, error) {
+	rules, err := s.GetDatasetRules(ctx, merchantId, datasetId)
+	if err != nil {
+		return models.DatasetRuleBundle{}, err
+	}
+
+	bundle := models.DatasetRuleBundle{
+		Version:    datasetConstants.DatasetRuleBundleVersion,
+		DatasetId:  datasetId,
+		ExportedAt: time.Now().UTC(),
+		Columns:    []models.DatasetRuleBundleColumn{},
+	}
+
+	for _, rule := range rules {
+		if len(columns) > 0 && !slices.Contains(columns, rule.Column) {
+			continue
+		}
+
+		if len(bundle.Columns) == 0 || bundle.Columns[len(bundle.Columns)-1].Column != rule.Column {
+			bundle.Columns = append(bundle.Columns, models.DatasetRuleBundleColumn{Column: rule.Column, Rules: []models.DatasetRuleBundleRule{}})
+		}
+
+		isEnabled := rule.IsEnabled
+		bundleColumn := &bundle.Columns[len(bundle.Columns)-1]
+		bundleColumn.Rules = append(bundleColumn.Rules, models.DatasetRuleBundleRule{
+			Title:       rule.Title,
+			Description: rule.Description,
+			Value:       rule.Value,
+			Assignments: rule.Assignments,
+			Filters:     models.RuleFilters(rule),
+			IsEnabled:   &isEnabled,
+		})
+	}
+
+	return bundle, nil
+}
+
+// ImportDatasetRuleBundle makes the columns of a bundle hold exactly the rules of the bundle. Every rule is validated
+// against the dataset before anything is saved and all the rules are saved in one transaction, the changed columns
+// are re-applied once it is committed.
+func (s *datasetService) ImportDatasetRuleBundle(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.ImportDatasetRuleBundleParams) (models.DatasetRuleBundleImport, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := validateDatasetRuleBundle(params.Bundle); err != nil {
+		return models.DatasetRuleBundleImport{}, err
+	}
+
+	columns := make([]string, len(params.Bundle.Columns))
+	for i, bundleColumn := range params.Bundle.Columns {
+		columns[i] = bundleColumn.Column
+	}
+
+	rulesByDataset, err := s.ruleService.GetRules(ctx, storemodels.FilterRuleParams{
+		OrganizationId: merchantId,
+		DatasetColumns: []storemodels.DatasetColumn{{DatasetId: datasetId, Columns: columns}},
+	})
+	if err != nil {
+		logger.Error("failed to get rules of columns", zap.Strings("columns", columns), zap.String("error", err.Error()))
+		return models.DatasetRuleBundleImport{}, errors.ErrFailedToGetRule
+	}
+
+	result := models.DatasetRuleBundleImport{
+		DryRun:  params.DryRun,
+		Added:   []models.DatasetRuleBundleDiff{},
+		Changed: []models.DatasetRuleBundleDiff{},
+		Removed: []models.DatasetRuleBundleDiff{},
+		Actions: []models.DatasetAction{},
+	}
+
+	plans := make([]datasetRuleBundlePlan, 0, len(params.Bundle.Columns))
+	for _, bundleColumn := range params.Bundle.Columns {
+		entries := make([]datasetRuleBundleEntry, len(bundleColumn.Rules))
+		for i, rule := range bundleColumn.Rules {
+			if entries[i], err = s.prepareDatasetRuleBundleEntry(ctx, merchantId, datasetId, bundleColumn.Column, rule); err != nil {
+				return models.DatasetRuleBundleImport{}, err
+			}
+		}
+
+		plan := planDatasetRuleBundleColumn(bundleColumn.Column, entries, rulesByDataset[datasetId.String()][bundleColumn.Column])
+		added, changed, removed := getDatasetRuleBundleDiffs(plan)
+		result.Added = append(result.Added, added...)
+		result.Changed = append(result.Changed, changed...)
+		result.Removed = append(result.Removed, removed...)
+		result.Unchanged += plan.unchanged
+
+		if plan.hasChanges() {
+			plans = append(plans, plan)
+		}
+	}
+
+	if params.DryRun || len(plans) == 0 {
+		return result, nil
+	}
+
+	err = s.datasetStore.WithTx(ctx, func(tx store.Store) error {
+		txRuleService := ruleservice.NewRuleService(tx)
+		for _, plan := range plans {
+			if err := applyDatasetRuleBundlePlan(ctx, txRuleService, merchantId, userId, datasetId, plan); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("failed to import rule bundle", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
+		return models.DatasetRuleBundleImport{}, errors.ErrFailedToImportRuleBundle
+	}
+
+	for _, plan := range plans {
+		action, err := s.applyDatasetRules(ctx, merchantId, userId, datasetId, plan.columns(), uuid.Nil, dataplatformactionconstants.UpsertRuleOperationReorder)
+		if err != nil {
+			return models.DatasetRuleBundleImport{}, err
+		}
+		result.Actions = append(result.Actions, action)
+	}
+
+	return result, nil
+}
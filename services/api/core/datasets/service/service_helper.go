@@ -1,13 +1,18 @@
 package service
 
 import (
-	"bytes"
 	"context"
-	"encoding/csv"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net/mail"
+	"regexp"
 	"slices"
 	"strings"
+	"time"
+	"unicode"
 
 	dataplatformactionconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/constants"
 	dataplatformactionmodels "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/models"
@@ -20,15 +25,20 @@ import (
 	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
 	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
 	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/exports"
 	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
+	tagmodels "github.com/Zampfi/application-platform/services/api/core/tags/models"
 	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	dataplatformpkgmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
 	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
 	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
+	workersconstants "github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	temporalmodels "github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/models"
 
 	"github.com/google/uuid"
+	temporalclient "go.temporal.io/sdk/client"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -194,13 +204,14 @@ func (s *datasetService) addDefaultZampIsDeletedFilterModel(filterParams models.
 }
 
 func (s *datasetService) mapToQueryConfig(datasetId string, queryConfig models.DatasetParams, datasetInfo dataplatformDataModels.DatasetMetadata,
-	columnDatatypes map[string]dataplatformdataconstants.Datatype, datasetMetaData models.DatasetMetadataConfig) querybuildermodels.QueryConfig {
+	columnDatatypes map[string]dataplatformdataconstants.Datatype, datasetMetaData models.DatasetMetadataConfig, columnRestrictions map[string]models.ColumnRestriction,
+	fxRates *fxRateJoin) querybuildermodels.QueryConfig {
 
-	customColumnConfig := s.getCustomColumnConfig(datasetMetaData.DatasetConfig, queryConfig)
+	customColumnConfig := s.getCustomColumnConfig(datasetMetaData.DatasetConfig, queryConfig, columnDatatypes, fxRates)
 
 	filters := s.addDefaultZampIsDeletedFilterModel(queryConfig.Filters, columnDatatypes, customColumnConfig)
 
-	filteredColumns := s.buildFilteredColumns(queryConfig, datasetInfo, columnDatatypes, customColumnConfig)
+	filteredColumns := s.buildFilteredColumns(queryConfig, datasetInfo, columnDatatypes, customColumnConfig, columnRestrictions)
 
 	aggregations := s.getQueryBuilderAggregationModel(queryConfig.Aggregations, columnDatatypes, customColumnConfig)
 	groupBy := s.getQueryBuilderGroupByModel(queryConfig.GroupBy, columnDatatypes, customColumnConfig)
@@ -217,7 +228,7 @@ func (s *datasetService) mapToQueryConfig(datasetId string, queryConfig models.D
 	var subquery *querybuildermodels.QueryConfig
 	if queryConfig.Subquery != nil {
 		subquery = func() *querybuildermodels.QueryConfig {
-			subqueryConfig := s.mapToQueryConfig(datasetId, *queryConfig.Subquery, datasetInfo, columnDatatypes, datasetMetaData)
+			subqueryConfig := s.mapToQueryConfig(datasetId, *queryConfig.Subquery, datasetInfo, columnDatatypes, datasetMetaData, columnRestrictions, fxRates)
 			return &subqueryConfig
 		}()
 	}
@@ -234,6 +245,7 @@ func (s *datasetService) mapToQueryConfig(datasetId string, queryConfig models.D
 		TableConfig: querybuildermodels.TableConfig{
 			DatasetId: datasetId,
 			Columns:   filteredColumns,
+			Joins:     getFxRateJoins(customColumnConfig),
 		},
 		Subquery:     subquery,
 		Windows:      windows,
@@ -313,30 +325,46 @@ func (s *datasetService) getQueryBuilderGroupByModel(groupBy []models.GroupBy, c
 	return result
 }
 
-func (s *datasetService) getCustomColumnConfig(datasetConfig dataplatformDataModels.DatasetConfig, params models.DatasetParams) map[string]querybuildermodels.CustomDataTypeConfig {
+func (s *datasetService) getCustomColumnConfig(datasetConfig dataplatformDataModels.DatasetConfig, params models.DatasetParams,
+	columnDatatypes map[string]dataplatformdataconstants.Datatype, fxRates *fxRateJoin) map[string]querybuildermodels.CustomDataTypeConfig {
 	customColumnConfig := make(map[string]querybuildermodels.CustomDataTypeConfig)
+	// the amounts without a precomputed fx column are converted where the rates are joined, reading the dataset
+	joinsFxRates := fxRates != nil && params.Subquery == nil
+	if params.FxCurrency == nil && !joinsFxRates {
+		return customColumnConfig
+	}
 
-	FXColumnConfigMap := make(map[string]querybuildermodels.AmountCustomTypeConfig)
-	for _, customColumnGroup := range datasetConfig.CustomColumnGroups {
-		if customColumnGroup.Type == "FX" && params.FxCurrency != nil {
-			FXColumnConfigMap[customColumnGroup.Config[datasetConstants.AmountColumn]] = querybuildermodels.AmountCustomTypeConfig{
-				CurrencyColumn: customColumnGroup.Config[datasetConstants.CurrencyColumn],
+	FXColumnConfigMap := make(map[string]querybuildermodels.CustomDataTypeInterface)
+	for _, group := range getFxAmountGroups(datasetConfig, columnDatatypes) {
+		if group.precomputed {
+			if params.FxCurrency == nil {
+				continue
+			}
+			FXColumnConfigMap[group.amountColumn] = &querybuildermodels.AmountCustomTypeConfig{
+				CurrencyColumn: group.currencyColumn,
 				FxCurrency:     *params.FxCurrency,
-				AmountColumn:   customColumnGroup.Config[datasetConstants.AmountColumn],
+				AmountColumn:   group.amountColumn,
 			}
+			continue
+		}
+
+		if !joinsFxRates {
+			continue
+		}
+		FXColumnConfigMap[group.amountColumn] = &querybuildermodels.FxRateAmountCustomTypeConfig{
+			CurrencyColumn: group.currencyColumn,
+			FxCurrency:     fxRates.currency,
+			AmountColumn:   group.amountColumn,
+			DateColumn:     group.dateColumn,
+			RatesTable:     fxRates.ratesTable,
+			RatesAlias:     fmt.Sprintf("%s%d", datasetConstants.ZampFxRatesJoinPrefix, len(FXColumnConfigMap)),
+			OrganizationId: fxRates.organizationId.String(),
 		}
 	}
 
 	for columnName, columnConfig := range datasetConfig.Columns {
-		var customTypeConfig querybuildermodels.CustomDataTypeInterface
 		if columnConfig.CustomType == dataplatformconstants.DatabricksColumnCustomTypeAmount {
-			if _, ok := FXColumnConfigMap[columnName]; ok {
-				customTypeConfig = &querybuildermodels.AmountCustomTypeConfig{
-					CurrencyColumn: FXColumnConfigMap[columnName].CurrencyColumn,
-					FxCurrency:     FXColumnConfigMap[columnName].FxCurrency,
-					AmountColumn:   FXColumnConfigMap[columnName].AmountColumn,
-				}
-
+			if customTypeConfig, ok := FXColumnConfigMap[columnName]; ok {
 				customColumnConfig[columnName] = querybuildermodels.CustomDataTypeConfig{
 					Type:   dataplatformconstants.DatabricksColumnCustomType(columnConfig.CustomType),
 					Config: customTypeConfig,
@@ -472,16 +500,26 @@ func (s *datasetService) fetchRowDetails(ctx context.Context, merchantId string,
 }
 
 func (s *datasetService) processRowDetails(ctx context.Context, logger *zap.Logger, merchantId, datasetId, rowUUID string) (models.ParentDatasetInfo, error) {
-	var err error
 	var rowDetails dataplatformpkgmodels.QueryResult
 
+	rowPolicyFilterSQL, err := s.getRowPolicyFilterSQL(ctx, merchantId, datasetId)
+	if err != nil {
+		logger.Error("failed to get row policy filter", zap.Error(err))
+		return models.ParentDatasetInfo{}, fmt.Errorf("fetch row policy filter: %w", err)
+	}
+
+	query := fmt.Sprintf(datasetConstants.GetRowDetailsQuery, datasetId, rowUUID)
+	if rowPolicyFilterSQL != "" {
+		query = fmt.Sprintf(datasetConstants.GetRowDetailsWithFilterQuery, datasetId, rowUUID, rowPolicyFilterSQL)
+	}
+
 	switch s.serverDatasetConfig.DataplatformProvider {
 	case datasetConstants.DataplatformProviderDatabricks:
-		rowDetails, err = s.dataplatformService.Query(ctx, merchantId, fmt.Sprintf(datasetConstants.GetRowDetailsQuery, datasetId, rowUUID), map[string]string{
+		rowDetails, err = s.dataplatformService.Query(ctx, merchantId, query, map[string]string{
 			datasetConstants.ZampDatasetPrefix + datasetId: datasetId,
 		})
 	case datasetConstants.DataplatformProviderPinot:
-		rowDetails, err = s.dataplatformService.QueryRealTime(ctx, merchantId, fmt.Sprintf(datasetConstants.GetRowDetailsQuery, datasetId, rowUUID), map[string]string{
+		rowDetails, err = s.dataplatformService.QueryRealTime(ctx, merchantId, query, map[string]string{
 			datasetConstants.ZampDatasetPrefix + datasetId: datasetId,
 		})
 	default:
@@ -603,7 +641,19 @@ func (s *datasetService) mapUpdateDatasetDataParamsToQueryConfig(datasetId uuid.
 	}, nil
 }
 
-func (s *datasetService) populateFilterOptions(ctx context.Context, merchantId uuid.UUID, datasetId string, filterConfigs []models.FilterConfig) error {
+func (s *datasetService) populateFilterOptions(ctx context.Context, merchantId uuid.UUID, datasetId string, filterConfigs []models.FilterConfig, rowPolicyFilterSQL string) error {
+	// tags filters list the tags of the taxonomy of the organization rather than the values the column holds
+	var tagTaxonomy tagmodels.Taxonomy
+	for _, config := range filterConfigs {
+		if isTagsFilter(config) && (config.Type == datasetConstants.FilterTypeMultiSearch || config.Type == datasetConstants.FilterTypeSelect) {
+			var err error
+			if tagTaxonomy, err = s.getTagTaxonomy(ctx); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
 	errgrp := errgroup.Group{}
 	resultCh := make(chan struct {
 		Index   int
@@ -613,7 +663,19 @@ func (s *datasetService) populateFilterOptions(ctx context.Context, merchantId u
 	for i, config := range filterConfigs {
 		index, cfg := i, config
 		errgrp.Go(func() error {
-			options, err := s.GetOptionsForColumn(ctx, merchantId, datasetId, cfg.Column, cfg.Type, true)
+			if cfg.Type != datasetConstants.FilterTypeMultiSearch && cfg.Type != datasetConstants.FilterTypeSelect {
+				return nil
+			}
+
+			if isTagsFilter(cfg) && !tagTaxonomy.IsEmpty() {
+				resultCh <- struct {
+					Index   int
+					Options []interface{}
+				}{Index: index, Options: toTagOptions(tagTaxonomy)}
+				return nil
+			}
+
+			options, err := s.getOptionsForColumn(ctx, merchantId, datasetId, cfg.Column, true, rowPolicyFilterSQL)
 			if err != nil {
 				return fmt.Errorf("failed to get options for %s: %w", cfg.Column, err)
 			}
@@ -672,51 +734,16 @@ func (s *datasetService) buildCreateRuleParams(ruleId uuid.UUID, organizationId
 			Sql:         Sql,
 			Args:        args,
 		},
-		CreatedBy: params.UserId,
+		Assignments: params.RuleAssignments,
+		CreatedBy:   params.UserId,
 	}, nil
 }
 
-func (s *datasetService) getDatasetRulesForDataPlatfrom(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, column string) ([]dataplatformDataModels.Rule, error) {
-	logger := apicontext.GetLoggerFromCtx(ctx)
-
-	datasetIdString := datasetId.String()
-
-	rules, err := s.ruleService.GetRules(ctx, storemodels.FilterRuleParams{
-		OrganizationId: merchantId,
-		DatasetColumns: []storemodels.DatasetColumn{
-			{
-				DatasetId: datasetId,
-				Columns:   []string{column},
-			},
-		},
-	})
-	if err != nil {
-		logger.Error("failed to get rules for dataset", zap.String("dataset_id", datasetIdString), zap.String("error", err.Error()))
-		return nil, err
-	}
-
-	var datasetRules []dataplatformDataModels.Rule
-	if _, ok := rules[datasetIdString]; ok {
-		if _, ok := rules[datasetIdString][column]; ok {
-			for _, rule := range rules[datasetIdString][column] {
-				datasetRules = append(datasetRules, dataplatformDataModels.Rule{
-					Id:           rule.ID.String(),
-					Priority:     rule.Priority,
-					ValueToApply: rule.Value,
-					SqlCondition: rule.FilterConfig.Sql,
-					SqlArgs:      rule.FilterConfig.Args,
-				})
-			}
-		}
-	}
-
-	return datasetRules, nil
-}
-
 func (s *datasetService) handleRuleBasedDatasetUpdate(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.UpdateDatasetDataParams) (dataplatformactionmodels.CreateActionResponse, error) {
 	logger := apicontext.GetLoggerFromCtx(ctx)
 
-	datasetRules, err := s.getDatasetRulesForDataPlatfrom(ctx, merchantId, datasetId, params.Update.Column)
+	columns := getDatasetRulesColumns(params.Update.Column, rulemodels.Rule{Column: params.Update.Column, Assignments: params.RuleAssignments})
+	datasetRules, err := s.getDatasetRuleSetsForDataPlatform(ctx, merchantId, datasetId, columns)
 	if err != nil {
 		logger.Error("failed to get dataset rules for data platfrom", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
 		return dataplatformactionmodels.CreateActionResponse{}, err
@@ -730,9 +757,7 @@ func (s *datasetService) handleRuleBasedDatasetUpdate(ctx context.Context, merch
 			EventData: dataplatformactionmodels.UpdateDatasetActionPayload{
 				DatasetId: datasetId.String(),
 				DatasetConfig: dataplatformDataModels.DatasetConfig{
-					Rules: map[string][]dataplatformDataModels.Rule{
-						params.Update.Column: datasetRules,
-					},
+					Rules: datasetRules,
 				},
 			},
 			EventMetadata: dataplatformactionmodels.UpsertRuleEventMetadata{
@@ -750,10 +775,12 @@ func (s *datasetService) handleRuleBasedDatasetUpdate(ctx context.Context, merch
 	return dataplatformAction, nil
 }
 
-func (s *datasetService) handleRulePriorityUpdate(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.UpdateRulePriorityParams) (dataplatformactionmodels.CreateActionResponse, error) {
+// handleRulePriorityUpdate sends the rules of the column and of the other columns its rules assign, the rules of the
+// column are applied to the other columns in the order of their priorities
+func (s *datasetService) handleRulePriorityUpdate(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params models.UpdateRulePriorityParams, columns []string) (dataplatformactionmodels.CreateActionResponse, error) {
 	logger := apicontext.GetLoggerFromCtx(ctx)
 
-	datasetRules, err := s.getDatasetRulesForDataPlatfrom(ctx, merchantId, datasetId, params.Column)
+	datasetRules, err := s.getDatasetRuleSetsForDataPlatform(ctx, merchantId, datasetId, columns)
 	if err != nil {
 		logger.Error("failed to get dataset rules for data platfrom", zap.String("dataset_id", datasetId.String()), zap.String("error", err.Error()))
 		return dataplatformactionmodels.CreateActionResponse{}, err
@@ -767,9 +794,7 @@ func (s *datasetService) handleRulePriorityUpdate(ctx context.Context, merchantI
 			EventData: dataplatformactionmodels.UpdateDatasetActionPayload{
 				DatasetId: datasetId.String(),
 				DatasetConfig: dataplatformDataModels.DatasetConfig{
-					Rules: map[string][]dataplatformDataModels.Rule{
-						params.Column: datasetRules,
-					},
+					Rules: datasetRules,
 				},
 			},
 			EventMetadata: dataplatformactionmodels.UpsertRuleEventMetadata{
@@ -854,14 +879,20 @@ func (s *datasetService) getQueryBuilderColumnConfigModel(params []models.Column
 }
 
 func (s *datasetService) buildFilteredColumns(params models.DatasetParams, datasetInfo dataplatformDataModels.DatasetMetadata, columnDatatypes map[string]dataplatformdataconstants.Datatype,
-	customColumnConfig map[string]querybuildermodels.CustomDataTypeConfig) []querybuildermodels.ColumnConfig {
+	customColumnConfig map[string]querybuildermodels.CustomDataTypeConfig, columnRestrictions map[string]models.ColumnRestriction) []querybuildermodels.ColumnConfig {
 	var filteredColumns []querybuildermodels.ColumnConfig
 
 	if params.Columns != nil {
-		filteredColumns = s.getQueryBuilderColumnConfigModel(params.Columns, columnDatatypes, customColumnConfig)
+		var columns []models.ColumnConfig
+		for _, column := range params.Columns {
+			if !isHiddenByRestriction(columnRestrictions, column.Column) {
+				columns = append(columns, column)
+			}
+		}
+		filteredColumns = s.getQueryBuilderColumnConfigModel(columns, columnDatatypes, customColumnConfig)
 	} else {
 		for columnName := range datasetInfo.Schema {
-			if !s.isHiddenColumn(columnName) {
+			if !s.isHiddenColumn(columnName) && !isHiddenByRestriction(columnRestrictions, columnName) {
 				filteredColumns = append(filteredColumns, querybuildermodels.ColumnConfig{
 					Column: columnName,
 					Datatype: func() *dataplatformdataconstants.Datatype {
@@ -894,47 +925,15 @@ func (s *datasetService) isDrilldownEnabled(datasetInfo dataplatformDataModels.D
 	return false
 }
 
-func (s *datasetService) createCSVFromQueryResult(data models.DatasetData) (*bytes.Buffer, error) {
-	var csvData bytes.Buffer
-	writer := csv.NewWriter(&csvData)
-
-	headers := make([]string, len(data.QueryResult.Columns))
-	for i, col := range data.QueryResult.Columns {
-		headers[i] = col.Name
-	}
-	if err := writer.Write(headers); err != nil {
-		return nil, fmt.Errorf("failed to write CSV headers: %w", err)
-	}
-
-	for _, row := range data.QueryResult.Rows {
-		rowData := make([]string, len(headers))
-		for i, header := range headers {
-			if val, ok := row[header]; ok && val != nil {
-				rowData[i] = fmt.Sprintf("%v", val)
-			} else {
-				rowData[i] = ""
-			}
-		}
-		if err := writer.Write(rowData); err != nil {
-			return nil, fmt.Errorf("failed to write CSV row: %w", err)
-		}
-	}
-	writer.Flush()
-
-	if err := writer.Error(); err != nil {
-		return nil, fmt.Errorf("error flushing CSV writer: %w", err)
-	}
-
-	return &csvData, nil
-}
-
-func (s *datasetService) isFxEnabled(datasetSchema map[string]dataplatformDataModels.ColumnMetadata) bool {
+// isFxEnabled is true when the dataset has precomputed fx columns or currency amount groups the rates of the
+// organization can convert
+func (s *datasetService) isFxEnabled(datasetSchema map[string]dataplatformDataModels.ColumnMetadata, datasetConfig dataplatformDataModels.DatasetConfig) bool {
 	for columnName := range datasetSchema {
 		if strings.HasPrefix(columnName, constants.ZampFxColumnPrefix) {
 			return true
 		}
 	}
-	return false
+	return len(getFxAmountGroups(datasetConfig, nil)) > 0
 }
 
 func (s *datasetService) isFileImportEnabled(ctx context.Context, merchantId uuid.UUID, datasetId string) bool {
@@ -1088,3 +1087,1009 @@ func ensureUserIsNotChangingTheirOwnAdminPolicy(policyToBeUpdated storemodels.Re
 
 	return nil
 }
+
+func (s *datasetService) getDatasetRowPolicy(ctx context.Context, datasetId uuid.UUID, policyId uuid.UUID) (storemodels.DatasetRowPolicy, error) {
+	policy, err := s.datasetStore.GetDatasetRowPolicyById(ctx, policyId)
+	if err != nil {
+		return storemodels.DatasetRowPolicy{}, errors.ErrRowPolicyNotFound
+	}
+
+	if policy.DatasetId != datasetId {
+		return storemodels.DatasetRowPolicy{}, errors.ErrRowPolicyNotFound
+	}
+
+	return policy, nil
+}
+
+func (s *datasetService) validateRowPolicyParams(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.DatasetRowPolicyParams) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if !slices.Contains([]storemodels.AudienceType{storemodels.AudienceTypeUser, storemodels.AudienceTypeTeam, storemodels.AudienceTypeOrganization}, params.AudienceType) {
+		return errors.ErrInvalidRowPolicyAudienceType
+	}
+
+	if len(params.Filters.Conditions) == 0 {
+		return errors.ErrEmptyRowPolicyFilters
+	}
+
+	datasetInfo, err := s.dataplatformService.GetDatasetMetadata(ctx, merchantId.String(), datasetId.String())
+	if err != nil {
+		logger.Error("failed to get dataset metadata", zap.String("error", err.Error()))
+		return errors.ErrFailedToGetDatasetMetadata
+	}
+
+	for _, column := range getFilterColumns(params.Filters.Conditions) {
+		if _, ok := datasetInfo.Schema[column]; !ok {
+			return fmt.Errorf("%w: %s", errors.ErrInvalidRowPolicyColumn, column)
+		}
+	}
+
+	return nil
+}
+
+// getFilterColumns returns the columns referenced by the filter conditions, including nested ones
+func getFilterColumns(conditions []models.Filter) []string {
+	var columns []string
+	for _, condition := range conditions {
+		if condition.Column != "" {
+			columns = append(columns, condition.Column)
+		}
+		columns = append(columns, getFilterColumns(condition.Conditions)...)
+	}
+	return columns
+}
+
+// getRowPolicyFilter returns the row policy filter for the user in context, nil when their reads are unrestricted.
+// Reads without a user fail unless the caller marked the context for internal access.
+func (s *datasetService) getRowPolicyFilter(ctx context.Context, datasetId string) (*models.FilterModel, error) {
+	if apicontext.HasInternalAccessInContext(ctx) {
+		return nil, nil
+	}
+
+	_, userId, _ := apicontext.GetAuthFromContext(ctx)
+	if userId == nil {
+		return nil, errors.ErrNoUserForDataPolicies
+	}
+
+	return s.getRowPolicyFilterForUser(ctx, datasetId, *userId)
+}
+
+// getRowPolicyFilterForUser combines the row policies applicable to the user into a single filter.
+// A user matching several policies sees the union of their rows; dataset admins and users without
+// any applicable policy are not restricted and get a nil filter. A policy without conditions grants
+// no rows.
+func (s *datasetService) getRowPolicyFilterForUser(ctx context.Context, datasetId string, userId uuid.UUID) (*models.FilterModel, error) {
+	datasetUUID, err := uuid.Parse(datasetId)
+	if err != nil {
+		return nil, errors.ErrFailedToParseDatasetId
+	}
+
+	isAdmin, err := s.isDatasetAdmin(ctx, datasetUUID, userId)
+	if err != nil {
+		return nil, errors.ErrFailedToGetRowPolicies
+	}
+
+	if isAdmin {
+		return nil, nil
+	}
+
+	policies, err := s.datasetStore.GetDatasetRowPoliciesForUser(ctx, datasetUUID, userId)
+	if err != nil {
+		return nil, errors.ErrFailedToGetRowPolicies
+	}
+
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	rowPolicyFilter := models.FilterModel{
+		LogicalOperator: models.LogicalOperator(querybuilderconstants.LogicalOperatorOr),
+	}
+
+	for _, policy := range policies {
+		var policyFilter models.FilterModel
+		if err := json.Unmarshal(policy.FilterConfig, &policyFilter); err != nil {
+			return nil, errors.ErrFailedToGetRowPolicies
+		}
+
+		if len(policyFilter.Conditions) == 0 {
+			continue
+		}
+
+		rowPolicyFilter.Conditions = append(rowPolicyFilter.Conditions, models.Filter{
+			LogicalOperator: defaultLogicalOperator(policyFilter.LogicalOperator),
+			Conditions:      policyFilter.Conditions,
+		})
+	}
+
+	if len(rowPolicyFilter.Conditions) == 0 {
+		noRowsFilter := getNoRowsFilter()
+		return &noRowsFilter, nil
+	}
+
+	return &rowPolicyFilter, nil
+}
+
+// getNoRowsFilter matches no row, every row of a dataset has an id
+func getNoRowsFilter() models.FilterModel {
+	return models.FilterModel{
+		LogicalOperator: models.LogicalOperator(querybuilderconstants.LogicalOperatorAnd),
+		Conditions: []models.Filter{
+			{Column: datasetConstants.ZampIDColumn, Operator: querybuilderconstants.IsNullOperator},
+		},
+	}
+}
+
+// hasDatasetAccess tells whether the user has any privilege on the dataset
+func (s *datasetService) hasDatasetAccess(ctx context.Context, datasetId string, userId uuid.UUID) (bool, error) {
+	datasetUUID, err := uuid.Parse(datasetId)
+	if err != nil {
+		return false, errors.ErrFailedToParseDatasetId
+	}
+
+	policies, err := s.datasetStore.GetFlattenedResourceAudiencePolicies(ctx, storemodels.FlattenedResourceAudiencePoliciesFilters{
+		ResourceIds:   []uuid.UUID{datasetUUID},
+		UserIds:       []uuid.UUID{userId},
+		ResourceTypes: []string{string(storemodels.ResourceTypeDataset)},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(policies) > 0, nil
+}
+
+func (s *datasetService) isDatasetAdmin(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) (bool, error) {
+	adminPolicies, err := s.datasetStore.GetFlattenedResourceAudiencePolicies(ctx, storemodels.FlattenedResourceAudiencePoliciesFilters{
+		ResourceIds:   []uuid.UUID{datasetId},
+		UserIds:       []uuid.UUID{userId},
+		ResourceTypes: []string{string(storemodels.ResourceTypeDataset)},
+		Privileges:    []storemodels.ResourcePrivilege{storemodels.PrivilegeDatasetAdmin},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(adminPolicies) > 0, nil
+}
+
+// applyRowPolicyFilter AND-s the row policy filter into the innermost query, the one reading from the dataset
+func (s *datasetService) applyRowPolicyFilter(params models.DatasetParams, rowPolicyFilter *models.FilterModel) models.DatasetParams {
+	if rowPolicyFilter == nil {
+		return params
+	}
+
+	if params.Subquery != nil {
+		subquery := s.applyRowPolicyFilter(*params.Subquery, rowPolicyFilter)
+		params.Subquery = &subquery
+		return params
+	}
+
+	var conditions []models.Filter
+	if len(params.Filters.Conditions) > 0 {
+		conditions = append(conditions, models.Filter{
+			LogicalOperator: defaultLogicalOperator(params.Filters.LogicalOperator),
+			Conditions:      params.Filters.Conditions,
+		})
+	}
+
+	conditions = append(conditions, models.Filter{
+		LogicalOperator: defaultLogicalOperator(rowPolicyFilter.LogicalOperator),
+		Conditions:      rowPolicyFilter.Conditions,
+	})
+
+	params.Filters = models.FilterModel{
+		LogicalOperator: models.LogicalOperator(querybuilderconstants.LogicalOperatorAnd),
+		Conditions:      conditions,
+	}
+
+	return params
+}
+
+// getRowPolicyFilterSQL renders the row policy filter of the user in context as a SQL condition, empty when unrestricted
+func (s *datasetService) getRowPolicyFilterSQL(ctx context.Context, merchantId string, datasetId string) (string, error) {
+	rowPolicyFilter, err := s.getRowPolicyFilter(ctx, datasetId)
+	if err != nil || rowPolicyFilter == nil {
+		return "", err
+	}
+
+	datasetInfo, err := s.dataplatformService.GetDatasetMetadata(ctx, merchantId, datasetId)
+	if err != nil {
+		return "", errors.ErrFailedToGetDatasetMetadata
+	}
+
+	columnDatatypes, err := s.getColumnDatatypes(datasetInfo)
+	if err != nil {
+		return "", err
+	}
+
+	filterSQL, _, err := s.queryBuilderService.ToFilterSQL(ctx, querybuildermodels.FilterModel{
+		LogicalOperator: querybuildermodels.LogicalOperator(rowPolicyFilter.LogicalOperator),
+		Conditions:      s.getQueryBuilderFilterModel(rowPolicyFilter.Conditions, columnDatatypes, map[string]querybuildermodels.CustomDataTypeConfig{}),
+	})
+	if err != nil {
+		return "", errors.ErrFailedToBuildQuery
+	}
+
+	return filterSQL, nil
+}
+
+func defaultLogicalOperator(logicalOperator models.LogicalOperator) *models.LogicalOperator {
+	if logicalOperator == "" {
+		logicalOperator = models.LogicalOperator(querybuilderconstants.LogicalOperatorAnd)
+	}
+	return &logicalOperator
+}
+
+func (s *datasetService) getDatasetColumnPolicy(ctx context.Context, datasetId uuid.UUID, policyId uuid.UUID) (storemodels.DatasetColumnPolicy, error) {
+	policy, err := s.datasetStore.GetDatasetColumnPolicyById(ctx, policyId)
+	if err != nil {
+		return storemodels.DatasetColumnPolicy{}, errors.ErrColumnPolicyNotFound
+	}
+
+	if policy.DatasetId != datasetId {
+		return storemodels.DatasetColumnPolicy{}, errors.ErrColumnPolicyNotFound
+	}
+
+	return policy, nil
+}
+
+func (s *datasetService) validateColumnPolicyParams(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.DatasetColumnPolicyParams) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if !slices.Contains([]storemodels.AudienceType{storemodels.AudienceTypeUser, storemodels.AudienceTypeTeam, storemodels.AudienceTypeOrganization}, params.AudienceType) {
+		return errors.ErrInvalidColumnPolicyAudienceType
+	}
+
+	switch params.Action {
+	case storemodels.ColumnPolicyActionMask:
+		if params.MaskType == nil || !slices.Contains([]storemodels.ColumnMaskType{storemodels.ColumnMaskTypeLast4, storemodels.ColumnMaskTypeHash, storemodels.ColumnMaskTypeNull}, *params.MaskType) {
+			return errors.ErrInvalidColumnPolicyMaskType
+		}
+	case storemodels.ColumnPolicyActionAllow, storemodels.ColumnPolicyActionHide:
+		if params.MaskType != nil {
+			return errors.ErrInvalidColumnPolicyMaskType
+		}
+	default:
+		return errors.ErrInvalidColumnPolicyAction
+	}
+
+	datasetInfo, err := s.dataplatformService.GetDatasetMetadata(ctx, merchantId.String(), datasetId.String())
+	if err != nil {
+		logger.Error("failed to get dataset metadata", zap.String("error", err.Error()))
+		return errors.ErrFailedToGetDatasetMetadata
+	}
+
+	if _, ok := datasetInfo.Schema[params.Column]; !ok || s.isHiddenColumn(params.Column) {
+		return fmt.Errorf("%w: %s", errors.ErrInvalidColumnPolicyColumn, params.Column)
+	}
+
+	return nil
+}
+
+// getColumnRestrictions returns the column restrictions for the user in context, nil when their reads are unrestricted.
+// Reads without a user fail unless the caller marked the context for internal access.
+func (s *datasetService) getColumnRestrictions(ctx context.Context, datasetId string) (map[string]models.ColumnRestriction, error) {
+	if apicontext.HasInternalAccessInContext(ctx) {
+		return nil, nil
+	}
+
+	_, userId, _ := apicontext.GetAuthFromContext(ctx)
+	if userId == nil {
+		return nil, errors.ErrNoUserForDataPolicies
+	}
+
+	return s.getColumnRestrictionsForUser(ctx, datasetId, *userId)
+}
+
+// getColumnRestrictionsForUser resolves the column policies applicable to the user into one restriction per column.
+// When several policies target the same column the most permissive one wins, mirroring row policies where a user
+// sees the union of their rows. Columns that end up allowed are left out of the result.
+func (s *datasetService) getColumnRestrictionsForUser(ctx context.Context, datasetId string, userId uuid.UUID) (map[string]models.ColumnRestriction, error) {
+	datasetUUID, err := uuid.Parse(datasetId)
+	if err != nil {
+		return nil, errors.ErrFailedToParseDatasetId
+	}
+
+	isAdmin, err := s.isDatasetAdmin(ctx, datasetUUID, userId)
+	if err != nil {
+		return nil, errors.ErrFailedToGetColumnPolicies
+	}
+
+	if isAdmin {
+		return nil, nil
+	}
+
+	policies, err := s.datasetStore.GetDatasetColumnPoliciesForUser(ctx, datasetUUID, userId)
+	if err != nil {
+		return nil, errors.ErrFailedToGetColumnPolicies
+	}
+
+	return resolveColumnRestrictions(policies), nil
+}
+
+func resolveColumnRestrictions(policies []storemodels.DatasetColumnPolicy) map[string]models.ColumnRestriction {
+	resolved := make(map[string]models.ColumnRestriction)
+	for _, policy := range policies {
+		restriction := models.ColumnRestriction{Action: policy.Action}
+		if policy.MaskType != nil {
+			restriction.MaskType = *policy.MaskType
+		}
+
+		existing, ok := resolved[policy.Column]
+		if !ok || columnRestrictionRank(restriction) > columnRestrictionRank(existing) {
+			resolved[policy.Column] = restriction
+		}
+	}
+
+	restrictions := make(map[string]models.ColumnRestriction)
+	for column, restriction := range resolved {
+		if restriction.Action != storemodels.ColumnPolicyActionAllow {
+			restrictions[column] = restriction
+		}
+	}
+
+	if len(restrictions) == 0 {
+		return nil
+	}
+
+	return restrictions
+}
+
+// columnRestrictionRank orders restrictions from the most restrictive to the most permissive
+func columnRestrictionRank(restriction models.ColumnRestriction) int {
+	switch restriction.Action {
+	case storemodels.ColumnPolicyActionAllow:
+		return 4
+	case storemodels.ColumnPolicyActionMask:
+		switch restriction.MaskType {
+		case storemodels.ColumnMaskTypeLast4:
+			return 3
+		case storemodels.ColumnMaskTypeHash:
+			return 2
+		default:
+			return 1
+		}
+	default:
+		return 0
+	}
+}
+
+func isHiddenByRestriction(restrictions map[string]models.ColumnRestriction, column string) bool {
+	restriction, ok := restrictions[column]
+	return ok && restriction.Action == storemodels.ColumnPolicyActionHide
+}
+
+// restrictedAggregationFunctions are the aggregations a user with column restrictions can run. Of a masked column only
+// the count can be taken, the other aggregations would read its values
+var restrictedAggregationFunctions = []string{"SUM", "AVG", "MIN", "MAX", "COUNT"}
+
+// identifierRegex matches a column referenced by name rather than through an expression
+var identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// expressionColumns returns the columns of the source an expression of the query reads, matched without case like the
+// warehouse does. String literals are skipped, false when the expression reads every column through a wildcard
+func expressionColumns(expression string, sourceColumns map[string]bool) ([]string, bool) {
+	names := make(map[string]string, len(sourceColumns))
+	for column := range sourceColumns {
+		names[strings.ToLower(column)] = column
+	}
+
+	var columns []string
+	seen := make(map[string]bool)
+	addName := func(name string) {
+		column, ok := names[strings.ToLower(name)]
+		if ok && !seen[column] {
+			seen[column] = true
+			columns = append(columns, column)
+		}
+	}
+
+	runes := []rune(expression)
+	// the last rune outside spaces, a wildcard is a star where an argument or a column of a table starts
+	var previous rune
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '\'' || r == '"':
+			// literals escape characters with backslashes
+			i++
+			for i < len(runes) && runes[i] != r {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+		case r == '`':
+			j := i + 1
+			for j < len(runes) && runes[j] != '`' {
+				j++
+			}
+			addName(string(runes[i+1 : min(j, len(runes))]))
+			i = j + 1
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_') {
+				j++
+			}
+			addName(string(runes[i:j]))
+			i = j
+		case unicode.IsDigit(r):
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+		case r == '*' && (previous == 0 || previous == '(' || previous == ',' || previous == '.'):
+			return nil, false
+		default:
+			i++
+		}
+		if !unicode.IsSpace(r) {
+			previous = r
+		}
+	}
+
+	return columns, true
+}
+
+// enforceColumnRestrictions rejects queries that filter or sort on restricted columns or read hidden ones, and returns
+// the mask to apply to each output column of the query. Aliases are followed through subqueries so that a restricted
+// column cannot be unmasked by renaming it. The query builder writes columns into the query as they are, expressions
+// are parsed for the columns they read and rejected when they read a restricted one, which they would in the clear.
+func (s *datasetService) enforceColumnRestrictions(params models.DatasetParams, restrictions map[string]models.ColumnRestriction, sourceColumns map[string]bool) (map[string]storemodels.ColumnMaskType, error) {
+	if len(restrictions) == 0 {
+		return nil, nil
+	}
+
+	sourceRestrictions := restrictions
+	if params.Subquery != nil {
+		subqueryMasks, err := s.enforceColumnRestrictions(*params.Subquery, restrictions, sourceColumns)
+		if err != nil {
+			return nil, err
+		}
+
+		sourceRestrictions = make(map[string]models.ColumnRestriction)
+		for column, maskType := range subqueryMasks {
+			sourceRestrictions[column] = models.ColumnRestriction{Action: storemodels.ColumnPolicyActionMask, MaskType: maskType}
+		}
+		sourceColumns = queryOutputColumns(*params.Subquery, sourceColumns)
+	}
+
+	// checkReference tells whether the column is a column of the source, an expression is checked for the columns it
+	// reads instead
+	checkReference := func(column string) (bool, error) {
+		if sourceColumns[column] {
+			return true, nil
+		}
+		if identifierRegex.MatchString(column) {
+			return false, fmt.Errorf("%w: %s is not a column of the dataset", errors.ErrColumnNotAccessible, column)
+		}
+
+		reads, ok := expressionColumns(column, sourceColumns)
+		if !ok {
+			return false, fmt.Errorf("%w: %s reads every column of the dataset", errors.ErrColumnNotAccessible, column)
+		}
+		for _, read := range reads {
+			if _, ok := sourceRestrictions[read]; ok {
+				return false, fmt.Errorf("%w: %s", errors.ErrColumnNotAccessible, read)
+			}
+		}
+		return false, nil
+	}
+
+	// checkComparable rejects comparing the values of a restricted column, which would tell them apart
+	checkComparable := func(column string) error {
+		isColumn, err := checkReference(column)
+		if err != nil {
+			return err
+		}
+		if _, ok := sourceRestrictions[column]; ok && isColumn {
+			return fmt.Errorf("%w: %s", errors.ErrColumnNotFilterable, column)
+		}
+		return nil
+	}
+
+	for _, column := range getFilterColumns(params.Filters.Conditions) {
+		if err := checkComparable(column); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, column := range params.Columns {
+		if _, err := checkReference(column.Column); err != nil {
+			return nil, err
+		}
+	}
+
+	// the query can be sorted by what it selects as well as by the columns it reads
+	outputColumns := queryOutputColumns(params, sourceColumns)
+	for _, orderBy := range params.OrderBy {
+		if !sourceColumns[orderBy.Column] && outputColumns[orderBy.Column] {
+			if _, ok := sourceRestrictions[orderBy.Column]; ok {
+				return nil, fmt.Errorf("%w: %s", errors.ErrColumnNotFilterable, orderBy.Column)
+			}
+			continue
+		}
+		if err := checkComparable(orderBy.Column); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, groupBy := range params.GroupBy {
+		isColumn, err := checkReference(groupBy.Column)
+		if err != nil {
+			return nil, err
+		}
+		if isColumn && isHiddenByRestriction(sourceRestrictions, groupBy.Column) {
+			return nil, fmt.Errorf("%w: %s", errors.ErrColumnNotAccessible, groupBy.Column)
+		}
+	}
+
+	for _, aggregation := range params.Aggregations {
+		isColumn, err := checkReference(aggregation.Column)
+		if err != nil {
+			return nil, err
+		}
+		function := strings.ToUpper(string(aggregation.Function))
+		if !slices.Contains(restrictedAggregationFunctions, function) {
+			return nil, fmt.Errorf("%w: %s is not an aggregation of the dataset", errors.ErrColumnNotAccessible, aggregation.Function)
+		}
+
+		restriction, ok := sourceRestrictions[aggregation.Column]
+		if !ok || !isColumn {
+			continue
+		}
+		// the minimum, maximum or sum of a masked column gives its values away, only how many there are is left
+		if restriction.Action == storemodels.ColumnPolicyActionHide || function != "COUNT" {
+			return nil, fmt.Errorf("%w: %s", errors.ErrColumnNotAccessible, aggregation.Column)
+		}
+	}
+
+	for _, window := range params.Windows {
+		for _, partitionBy := range window.PartitionBy {
+			if err := checkComparable(partitionBy.Column); err != nil {
+				return nil, err
+			}
+		}
+		for _, orderBy := range window.OrderBy {
+			if err := checkComparable(orderBy.Column); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	masks := make(map[string]storemodels.ColumnMaskType)
+	addMask := func(column string, alias *string) {
+		restriction, ok := sourceRestrictions[column]
+		if !ok || restriction.Action != storemodels.ColumnPolicyActionMask {
+			return
+		}
+		if alias != nil {
+			masks[*alias] = restriction.MaskType
+			return
+		}
+		masks[column] = restriction.MaskType
+	}
+
+	if params.Columns == nil && len(params.GroupBy) == 0 && len(params.Aggregations) == 0 {
+		for column := range sourceRestrictions {
+			addMask(column, nil)
+		}
+	}
+
+	for _, column := range params.Columns {
+		addMask(column.Column, column.Alias)
+	}
+
+	for _, groupBy := range params.GroupBy {
+		addMask(groupBy.Column, groupBy.Alias)
+	}
+
+	return masks, nil
+}
+
+// schemaColumns are the names of the columns of the dataset
+func schemaColumns(datasetInfo dataplatformDataModels.DatasetMetadata) map[string]bool {
+	columns := make(map[string]bool, len(datasetInfo.Schema))
+	for column := range datasetInfo.Schema {
+		columns[column] = true
+	}
+	return columns
+}
+
+// queryOutputColumns are the names of the columns the query selects, the columns of its source when it selects all of
+// them
+func queryOutputColumns(params models.DatasetParams, sourceColumns map[string]bool) map[string]bool {
+	output := make(map[string]bool)
+	if params.Columns == nil && len(params.GroupBy) == 0 && len(params.Aggregations) == 0 {
+		for column := range sourceColumns {
+			output[column] = true
+		}
+	}
+
+	for _, column := range params.Columns {
+		if column.Alias != nil {
+			output[*column.Alias] = true
+			continue
+		}
+		output[column.Column] = true
+	}
+
+	for _, groupBy := range params.GroupBy {
+		if groupBy.Alias != nil {
+			output[*groupBy.Alias] = true
+			continue
+		}
+		output[groupBy.Column] = true
+	}
+
+	for _, aggregation := range params.Aggregations {
+		output[aggregation.Alias] = true
+	}
+
+	for _, window := range params.Windows {
+		output[window.Alias] = true
+	}
+
+	return output
+}
+
+// maskQueryResult masks the values of the masked columns, the columns whose values are replaced by text become
+// string columns so that typed exports do not read them as numbers or dates
+func (s *datasetService) maskQueryResult(datasetId string, result dataplatformpkgmodels.QueryResult, masks map[string]storemodels.ColumnMaskType) dataplatformpkgmodels.QueryResult {
+	if len(masks) == 0 {
+		return result
+	}
+
+	for i, column := range result.Columns {
+		if maskType, ok := masks[column.Name]; ok && maskType != storemodels.ColumnMaskTypeNull {
+			result.Columns[i].DatabaseType = string(dataplatformdataconstants.StringDataType)
+		}
+	}
+
+	for _, row := range result.Rows {
+		for column, maskType := range masks {
+			if value, ok := row[column]; ok {
+				row[column] = maskValue(s.serverDatasetConfig.ColumnMaskSecret, datasetId, value, maskType)
+			}
+		}
+	}
+
+	return result
+}
+
+// maskValue hashes with an HMAC keyed by the server secret so that values cannot be guessed back from their hashes, the
+// dataset id is hashed along so that hashed values stay comparable within a dataset only. Without a secret hashed
+// values are hidden entirely
+func maskValue(secret string, datasetId string, value interface{}, maskType storemodels.ColumnMaskType) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	stringValue := fmt.Sprintf("%v", value)
+
+	switch maskType {
+	case storemodels.ColumnMaskTypeLast4:
+		runes := []rune(stringValue)
+		if len(runes) <= datasetConstants.MaskedValueVisibleSize {
+			return datasetConstants.MaskedValuePlaceholder
+		}
+		return datasetConstants.MaskedValuePlaceholder + string(runes[len(runes)-datasetConstants.MaskedValueVisibleSize:])
+	case storemodels.ColumnMaskTypeHash:
+		if secret == "" {
+			return datasetConstants.MaskedValuePlaceholder
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(datasetId + ":" + stringValue))
+		return hex.EncodeToString(mac.Sum(nil))[:datasetConstants.MaskedValueHashSize]
+	default:
+		return nil
+	}
+}
+
+// applyColumnRestrictionsToFilterConfig removes hidden columns from the filter config and strips the options of masked
+// ones, which cannot be filtered on
+func (s *datasetService) applyColumnRestrictionsToFilterConfig(filterConfigs []models.FilterConfig, restrictions map[string]models.ColumnRestriction) []models.FilterConfig {
+	if len(restrictions) == 0 {
+		return filterConfigs
+	}
+
+	var result []models.FilterConfig
+	for _, config := range filterConfigs {
+		restriction, ok := restrictions[config.Column]
+		if !ok {
+			result = append(result, config)
+			continue
+		}
+
+		if restriction.Action == storemodels.ColumnPolicyActionHide {
+			continue
+		}
+
+		metadata := make(map[string]interface{}, len(config.Metadata)+2)
+		for key, value := range config.Metadata {
+			metadata[key] = value
+		}
+		metadata[datasetConstants.MetadataConfigIsMasked] = true
+		metadata[datasetConstants.MetadataConfigMaskType] = restriction.MaskType
+
+		config.Metadata = metadata
+		config.Options = []interface{}{}
+		result = append(result, config)
+	}
+
+	return result
+}
+
+// getDatasetView only finds views shared with the user in context, the store applies the view access filter
+func (s *datasetService) getDatasetView(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID) (storemodels.DatasetView, error) {
+	view, err := s.datasetStore.GetDatasetViewById(ctx, viewId)
+	if err != nil {
+		return storemodels.DatasetView{}, errors.ErrDatasetViewNotFound
+	}
+
+	if view.DatasetId != datasetId {
+		return storemodels.DatasetView{}, errors.ErrDatasetViewNotFound
+	}
+
+	return view, nil
+}
+
+func (s *datasetService) ensureDatasetViewAdmin(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID, userId uuid.UUID) error {
+	if _, err := s.getDatasetView(ctx, datasetId, viewId); err != nil {
+		return err
+	}
+
+	adminPolicies, err := s.datasetStore.GetFlattenedResourceAudiencePolicies(ctx, storemodels.FlattenedResourceAudiencePoliciesFilters{
+		ResourceIds:   []uuid.UUID{viewId},
+		UserIds:       []uuid.UUID{userId},
+		ResourceTypes: []string{string(storemodels.ResourceTypeDatasetView)},
+		Privileges:    []storemodels.ResourcePrivilege{storemodels.PrivilegeDatasetViewAdmin},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(adminPolicies) == 0 {
+		return errors.ErrDatasetViewAccessForbidden
+	}
+
+	return nil
+}
+
+// validateDatasetViewParams drops pagination from the saved query, a view always opens on the first page
+func validateDatasetViewParams(params models.DatasetViewParams) (models.DatasetViewParams, error) {
+	params.Name = strings.TrimSpace(params.Name)
+	if params.Name == "" {
+		return models.DatasetViewParams{}, errors.ErrEmptyDatasetViewName
+	}
+
+	params.QueryConfig.Pagination = nil
+	params.QueryConfig.CountAll = false
+
+	return params, nil
+}
+
+func getDatasetExportFilePath(datasetId string, workflowId string, format constants.ExportFormat) string {
+	timestamp := time.Now().UTC().Format(constants.DatasetExportTimestampFormat)
+	fileName := fmt.Sprintf(constants.DatasetExportFileNameFormat, timestamp, uuid.New().String(), format)
+
+	return fmt.Sprintf(constants.DatasetExportFilePathFormat, datasetId, workflowId, fileName)
+}
+
+func (s *datasetService) getDatasetExportSchedule(ctx context.Context, datasetId uuid.UUID, scheduleId uuid.UUID) (storemodels.DatasetExportSchedule, error) {
+	schedule, err := s.datasetStore.GetDatasetExportScheduleById(ctx, scheduleId)
+	if err != nil {
+		return storemodels.DatasetExportSchedule{}, errors.ErrDatasetExportScheduleNotFound
+	}
+
+	if schedule.DatasetId != datasetId {
+		return storemodels.DatasetExportSchedule{}, errors.ErrDatasetExportScheduleNotFound
+	}
+
+	return schedule, nil
+}
+
+// ensureDatasetExportScheduleEditor lets the owner and the admins of the dataset change a schedule
+func (s *datasetService) ensureDatasetExportScheduleEditor(ctx context.Context, schedule storemodels.DatasetExportSchedule, userId uuid.UUID) error {
+	if schedule.OwnerId == userId {
+		return nil
+	}
+
+	adminPolicies, err := s.datasetStore.GetFlattenedResourceAudiencePolicies(ctx, storemodels.FlattenedResourceAudiencePoliciesFilters{
+		ResourceIds:   []uuid.UUID{schedule.DatasetId},
+		UserIds:       []uuid.UUID{userId},
+		ResourceTypes: []string{string(storemodels.ResourceTypeDataset)},
+		Privileges:    []storemodels.ResourcePrivilege{storemodels.PrivilegeDatasetAdmin},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(adminPolicies) == 0 {
+		return errors.ErrExportScheduleAccessForbidden
+	}
+
+	return nil
+}
+
+// validateDatasetExportScheduleParamsForOrganization validates the schedule against the buckets the organization exports
+// to, the settings are only read for schedules delivering to storage
+func (s *datasetService) validateDatasetExportScheduleParamsForOrganization(ctx context.Context, organizationId uuid.UUID, params models.DatasetExportScheduleParams) (models.DatasetExportScheduleParams, error) {
+	exportSettings := exports.Settings{}
+	if params.DestinationType == constants.ExportDestinationTypeStorage {
+		var err error
+		exportSettings, err = s.getOrganizationExportSettings(ctx, organizationId)
+		if err != nil {
+			return models.DatasetExportScheduleParams{}, err
+		}
+	}
+
+	return validateDatasetExportScheduleParams(params, exportSettings)
+}
+
+func (s *datasetService) getOrganizationExportSettings(ctx context.Context, organizationId uuid.UUID) (exports.Settings, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	organization, err := s.datasetStore.GetOrganizationById(ctx, organizationId.String())
+	if err != nil {
+		logger.Error("failed to get organization", zap.String("organization_id", organizationId.String()), zap.String("error", err.Error()))
+		return exports.Settings{}, err
+	}
+
+	return exports.Parse(organization.ExportSettings)
+}
+
+// validateDatasetExportScheduleParams exports every matching row on each run, so pagination is dropped from the saved query
+func validateDatasetExportScheduleParams(params models.DatasetExportScheduleParams, exportSettings exports.Settings) (models.DatasetExportScheduleParams, error) {
+	params.Name = strings.TrimSpace(params.Name)
+	if params.Name == "" {
+		return models.DatasetExportScheduleParams{}, errors.ErrEmptyDatasetExportScheduleName
+	}
+
+	if params.Format == "" {
+		params.Format = constants.ExportFormatCSV
+	}
+	if _, ok := constants.ExportFormatContentTypes[params.Format]; !ok {
+		return models.DatasetExportScheduleParams{}, errors.ErrInvalidExportFormat
+	}
+
+	params.CronExpression = strings.Join(strings.Fields(params.CronExpression), " ")
+	if !isValidCronExpression(params.CronExpression) {
+		return models.DatasetExportScheduleParams{}, errors.ErrInvalidCronExpression
+	}
+
+	if params.Timezone == "" {
+		params.Timezone = constants.DatasetExportScheduleDefaultTimezone
+	}
+	if _, err := time.LoadLocation(params.Timezone); err != nil {
+		return models.DatasetExportScheduleParams{}, errors.ErrInvalidTimezone
+	}
+
+	destination, err := validateExportDestination(params.DestinationType, params.DestinationConfig, exportSettings)
+	if err != nil {
+		return models.DatasetExportScheduleParams{}, err
+	}
+	params.DestinationConfig = destination
+
+	params.QueryConfig.Pagination = nil
+	params.QueryConfig.CountAll = false
+
+	return params, nil
+}
+
+var cronFieldRegex = regexp.MustCompile(`^[0-9A-Za-z*/,?#-]+$`)
+
+var cronDescriptors = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// isValidCronExpression accepts the standard five field expressions and the descriptors supported by temporal schedules
+func isValidCronExpression(expression string) bool {
+	if slices.Contains(cronDescriptors, expression) {
+		return true
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return false
+	}
+
+	for _, field := range fields {
+		if !cronFieldRegex.MatchString(field) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateExportDestination resolves the bucket of storage destinations from the settings of the organization, a
+// schedule only chooses the folder it writes to in the bucket
+func validateExportDestination(destinationType constants.ExportDestinationType, destination models.DatasetExportScheduleDestination, exportSettings exports.Settings) (models.DatasetExportScheduleDestination, error) {
+	switch destinationType {
+	case constants.ExportDestinationTypeStorage:
+		if destination.Provider != constants.ExportStorageProviderGCS && destination.Provider != constants.ExportStorageProviderS3 {
+			return models.DatasetExportScheduleDestination{}, errors.ErrInvalidExportDestination
+		}
+
+		bucket, ok := exportSettings.Bucket(destination.Provider)
+		if !ok {
+			return models.DatasetExportScheduleDestination{}, errors.ErrInvalidExportDestination
+		}
+		if requested := strings.TrimSpace(destination.Bucket); requested != "" && requested != bucket {
+			return models.DatasetExportScheduleDestination{}, errors.ErrInvalidExportDestination
+		}
+
+		prefix, err := exports.CleanPrefix(destination.Prefix)
+		if err != nil {
+			return models.DatasetExportScheduleDestination{}, errors.ErrInvalidExportDestination
+		}
+
+		return models.DatasetExportScheduleDestination{
+			Provider: destination.Provider,
+			Bucket:   bucket,
+			Prefix:   prefix,
+		}, nil
+	case constants.ExportDestinationTypeEmail:
+		if len(destination.Recipients) == 0 || len(destination.Recipients) > constants.DatasetExportScheduleMaxRecipients {
+			return models.DatasetExportScheduleDestination{}, errors.ErrInvalidExportDestination
+		}
+
+		recipients, ok := normalizeEmailRecipients(destination.Recipients)
+		if !ok {
+			return models.DatasetExportScheduleDestination{}, errors.ErrInvalidExportDestination
+		}
+
+		return models.DatasetExportScheduleDestination{
+			Recipients: recipients,
+		}, nil
+	default:
+		return models.DatasetExportScheduleDestination{}, errors.ErrInvalidExportDestination
+	}
+}
+
+// normalizeEmailRecipients lowercases and dedupes the addresses of recipients, display names are dropped
+func normalizeEmailRecipients(recipients []string) ([]string, bool) {
+	emails := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		address, err := mail.ParseAddress(strings.TrimSpace(recipient))
+		if err != nil {
+			return nil, false
+		}
+
+		email := strings.ToLower(address.Address)
+		if !slices.Contains(emails, email) {
+			emails = append(emails, email)
+		}
+	}
+
+	return emails, true
+}
+
+// createDatasetExportTemporalSchedule registers the temporal schedule that fires the runs of schedule,
+// every run carries the temporal schedule id so that runs fired by a replaced schedule can be told apart
+func (s *datasetService) createDatasetExportTemporalSchedule(ctx context.Context, schedule storemodels.DatasetExportSchedule) error {
+	_, err := s.temporalService.ExecuteScheduledWorkflow(ctx, temporalmodels.ExecuteWorkflowWithScheduleParams{
+		ScheduleOptions: temporalmodels.ScheduleOptions{
+			ID: schedule.TemporalScheduleId,
+			Spec: temporalclient.ScheduleSpec{
+				CronExpressions: []string{schedule.CronExpression},
+				TimeZoneName:    schedule.Timezone,
+			},
+			Action: &temporalclient.ScheduleWorkflowAction{
+				ID:       fmt.Sprintf(constants.DatasetExportScheduleRunWorkflowIdFormat, schedule.ID),
+				Workflow: workersconstants.DatasetExportScheduleWorkflowName,
+				Args: []interface{}{
+					models.DatasetExportScheduleWorkflowParams{
+						ScheduleId:         schedule.ID,
+						TemporalScheduleId: schedule.TemporalScheduleId,
+						OrganizationId:     schedule.OrganizationId,
+						OwnerId:            schedule.OwnerId,
+					},
+				},
+				TaskQueue: workersconstants.DefaultTaskQueueName,
+			},
+		},
+	})
+
+	return err
+}
@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,15 +19,19 @@ import (
 	dataplatformDataTypesConstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
 	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
 	servicemodels "github.com/Zampfi/application-platform/services/api/core/dataplatform/models"
+	datasetactionservice "github.com/Zampfi/application-platform/services/api/core/datasets/actions/service"
 	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
 	datasetErrors "github.com/Zampfi/application-platform/services/api/core/datasets/errors"
 	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/exports"
 	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	cloudservicemodels "github.com/Zampfi/application-platform/services/api/pkg/cloudservices/models"
 	"github.com/Zampfi/application-platform/services/api/pkg/s3"
 	temporalmodels "github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/models"
+	temporalclient "go.temporal.io/sdk/client"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
 	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
@@ -123,6 +131,7 @@ func TestGetFilterConfigByDatasetId(t *testing.T) {
 						{"column4": false},
 					},
 				}, nil)
+				ds.EXPECT().GetTags(mock.Anything).Return(nil, nil)
 				cache.EXPECT().Set(mock.Anything, "dataset_filter_config:dataset1", mock.Anything, time.Minute*10).Return(errors.New("error"))
 			},
 			expectedError: false,
@@ -312,7 +321,7 @@ func TestGetFilterConfigByDatasetId(t *testing.T) {
 
 			svc := NewDatasetService(mockDS, mockQueryBuilder, mockDPS, mockRuleService, mockFileUploadsService, mockTemporalService, mockCloudService, mockS3Client, serverConfig, mockCacheClient)
 
-			result, _, err := svc.GetFilterConfigByDatasetId(context.Background(), tt.merchantId, tt.datasetId)
+			result, _, err := svc.GetFilterConfigByDatasetId(apicontext.AddInternalAccessToContext(context.Background()), tt.merchantId, tt.datasetId)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -576,11 +585,12 @@ func TestGetOptionsForColumn(t *testing.T) {
 			if tt.mockSetup != nil {
 				tt.mockSetup(mockDPS)
 			}
+			mockDS.EXPECT().GetDatasetById(mock.Anything, tt.datasetId).Return(&storemodels.Dataset{Metadata: json.RawMessage(`{}`)}, nil).Maybe()
 
 			svc := NewDatasetService(mockDS, mockQueryBuilder, mockDPS, mockRuleService, mockFileUploadsService, mockTemporalService, mockCloudService, mockS3Client, serverConfig, mockCacheClient)
 
 			result, err := svc.GetOptionsForColumn(
-				context.Background(),
+				apicontext.AddInternalAccessToContext(context.Background()),
 				tt.merchantId,
 				tt.datasetId,
 				tt.column,
@@ -2252,6 +2262,7 @@ func TestInitiateDatasetExport(t *testing.T) {
 		merchantId    uuid.UUID
 		datasetId     uuid.UUID
 		queryConfig   models.DatasetParams
+		format        datasetConstants.ExportFormat
 		userId        uuid.UUID
 		mockSetup     func(*mockDatasetService.MockDatasetServiceStore, *mock_temporal.MockTemporalService)
 		wantErr       bool
@@ -2276,6 +2287,35 @@ func TestInitiateDatasetExport(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:       "Success case - xlsx",
+			merchantId: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			datasetId:  uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+			userId:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+			format:     datasetConstants.ExportFormatXLSX,
+			mockSetup: func(ds *mockDatasetService.MockDatasetServiceStore, ts *mock_temporal.MockTemporalService) {
+				ds.EXPECT().CreateDatasetAction(mock.Anything, mock.Anything, mock.MatchedBy(func(params storemodels.CreateDatasetActionParams) bool {
+					metadata, ok := params.Config.(models.ExportMetadata)
+					return ok && metadata.Format == datasetConstants.ExportFormatXLSX && strings.HasSuffix(metadata.FilePath, ".xlsx")
+				})).Return(nil)
+				ts.EXPECT().ExecuteAsyncWorkflow(mock.Anything, mock.MatchedBy(func(params temporalmodels.ExecuteWorkflowParams) bool {
+					exportParams, ok := params.Args[0].(models.DatasetExportParams)
+					return ok && exportParams.Format == datasetConstants.ExportFormatXLSX && strings.HasSuffix(exportParams.ExportPath, ".xlsx")
+				})).Return(temporalmodels.WorkflowResponse{
+					WorkflowID: "test-workflow",
+				}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:          "Error - invalid format",
+			merchantId:    uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+			datasetId:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+			userId:        uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+			format:        "pdf",
+			wantErr:       true,
+			expectedError: "ERR_INVALID_EXPORT_FORMAT",
+		},
 		{
 			name:       "Error - dataset not found",
 			merchantId: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
@@ -2337,7 +2377,7 @@ func TestInitiateDatasetExport(t *testing.T) {
 			ctx := apicontext.AddLoggerToContext(context.Background(), zap.NewNop())
 			ctx = apicontext.AddAuthToContext(ctx, "user", tt.userId, []uuid.UUID{tt.merchantId})
 
-			workflowId, err := svc.CreateDatasetExportAction(ctx, tt.merchantId, tt.datasetId.String(), tt.queryConfig, tt.userId)
+			workflowId, err := svc.CreateDatasetExportAction(ctx, tt.merchantId, tt.datasetId.String(), tt.queryConfig, tt.format, tt.userId)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -2398,6 +2438,10 @@ func TestExportDatasetActivity(t *testing.T) {
 					},
 				}, nil)
 
+				ds.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				ds.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, mock.Anything, mock.Anything).Return([]storemodels.DatasetRowPolicy{}, nil)
+				ds.EXPECT().GetDatasetColumnPoliciesForUser(mock.Anything, mock.Anything, mock.Anything).Return([]storemodels.DatasetColumnPolicy{}, nil)
+
 				qb.EXPECT().ToSQL(mock.Anything, mock.Anything).Return("SELECT * FROM dataset", map[string]interface{}{}, nil)
 
 				dps.EXPECT().Query(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dataplatformmodels.QueryResult{
@@ -2412,6 +2456,46 @@ func TestExportDatasetActivity(t *testing.T) {
 			},
 			expectedURL: "https://exported-file.csv",
 		},
+		{
+			name:       "Success case - jsonl with display names",
+			userId:     uuid.New(),
+			orgIds:     []uuid.UUID{uuid.New()},
+			datasetId:  uuid.New(),
+			workflowId: "test-workflow",
+			params: models.DatasetExportParams{
+				ExportPath: "test/export/path.jsonl",
+				Format:     datasetConstants.ExportFormatJSONL,
+			},
+			mockSetup: func(ds *mockDatasetService.MockDatasetServiceStore, dps *mockDataplatform.MockDataPlatformService, cs *mock_cloudservice.MockCloudService, qb *mock_querybuilder.MockQueryBuilder) {
+				ds.EXPECT().GetDatasetById(mock.Anything, mock.Anything).Return(&storemodels.Dataset{
+					ID:       uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+					Metadata: json.RawMessage(`{"display_config": [{"column": "col1", "alias": "Amount", "is_hidden": false, "is_editable": false}, {"column": "col2", "is_hidden": false, "is_editable": false}]}`),
+				}, nil)
+				dps.EXPECT().GetDatasetMetadata(mock.Anything, mock.Anything, mock.Anything).Return(dataplatformDataModels.DatasetMetadata{
+					Schema: map[string]dataplatformDataModels.ColumnMetadata{
+						"col1": {Type: "bigint"},
+						"col2": {Type: "string"},
+					},
+				}, nil)
+
+				ds.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				ds.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, mock.Anything, mock.Anything).Return([]storemodels.DatasetRowPolicy{}, nil)
+				ds.EXPECT().GetDatasetColumnPoliciesForUser(mock.Anything, mock.Anything, mock.Anything).Return([]storemodels.DatasetColumnPolicy{}, nil)
+
+				qb.EXPECT().ToSQL(mock.Anything, mock.Anything).Return("SELECT * FROM dataset", map[string]interface{}{}, nil)
+
+				dps.EXPECT().Query(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dataplatformmodels.QueryResult{
+					Columns: []dataplatformmodels.ColumnMetadata{{Name: "col1", DatabaseType: "BIGINT"}, {Name: "col2", DatabaseType: "STRING"}},
+					Rows:    []map[string]interface{}{{"col1": "42", "col2": "val2"}},
+				}, nil)
+
+				cs.EXPECT().UploadFileToCloud(mock.Anything, "test/export/path.jsonl", []byte(`{"Amount":42,"col2":"val2"}`+"\n")).Return(cloudservicemodels.SignedUrlToUpload{
+					Url: "https://exported-file.jsonl",
+				}, nil)
+				ds.EXPECT().UpdateDatasetActionStatus(mock.Anything, "test-workflow", "SUCCESSFUL").Return(nil)
+			},
+			expectedURL: "https://exported-file.jsonl",
+		},
 		{
 			name:       "Error - query failure",
 			userId:     uuid.New(),
@@ -2439,6 +2523,10 @@ func TestExportDatasetActivity(t *testing.T) {
 					},
 				}, nil)
 
+				ds.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				ds.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, mock.Anything, mock.Anything).Return([]storemodels.DatasetRowPolicy{}, nil)
+				ds.EXPECT().GetDatasetColumnPoliciesForUser(mock.Anything, mock.Anything, mock.Anything).Return([]storemodels.DatasetColumnPolicy{}, nil)
+
 				qb.EXPECT().ToSQL(mock.Anything, mock.Anything).Return("SELECT * FROM dataset", map[string]interface{}{}, nil)
 
 				dps.EXPECT().Query(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dataplatformmodels.QueryResult{
@@ -2476,6 +2564,10 @@ func TestExportDatasetActivity(t *testing.T) {
 					},
 				}, nil)
 
+				ds.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				ds.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, mock.Anything, mock.Anything).Return([]storemodels.DatasetRowPolicy{}, nil)
+				ds.EXPECT().GetDatasetColumnPoliciesForUser(mock.Anything, mock.Anything, mock.Anything).Return([]storemodels.DatasetColumnPolicy{}, nil)
+
 				qb.EXPECT().ToSQL(mock.Anything, mock.Anything).Return("SELECT * FROM dataset", map[string]interface{}{}, nil)
 
 				dps.EXPECT().Query(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(dataplatformmodels.QueryResult{
@@ -3527,3 +3619,1679 @@ func TestGetDatasetImportPath(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRowPolicyFilterForUser(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New()
+	userId := uuid.New()
+
+	regionFilter := json.RawMessage(`{"logical_operator":"AND","conditions":[{"column":"region","operator":"eq","value":"EU"}]}`)
+	entityFilter := json.RawMessage(`{"logical_operator":"AND","conditions":[{"column":"entity","operator":"eq","value":"acme"}]}`)
+	emptyFilter := json.RawMessage(`{"logical_operator":"AND","conditions":[]}`)
+
+	tests := []struct {
+		name               string
+		mockSetup          func(*mockDatasetService.MockDatasetServiceStore)
+		wantNil            bool
+		wantNoRows         bool
+		wantConditionCount int
+		wantErr            error
+	}{
+		{
+			name: "dataset admin is not restricted",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).
+					Return([]storemodels.FlattenedResourceAudiencePolicy{{UserId: userId, Privilege: storemodels.PrivilegeDatasetAdmin}}, nil)
+			},
+			wantNil: true,
+		},
+		{
+			name: "no applicable policies",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).
+					Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				m.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, datasetId, userId).
+					Return([]storemodels.DatasetRowPolicy{}, nil)
+			},
+			wantNil: true,
+		},
+		{
+			name: "multiple policies are combined",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).
+					Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				m.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, datasetId, userId).
+					Return([]storemodels.DatasetRowPolicy{
+						{ID: uuid.New(), DatasetId: datasetId, FilterConfig: regionFilter},
+						{ID: uuid.New(), DatasetId: datasetId, FilterConfig: entityFilter},
+					}, nil)
+			},
+			wantConditionCount: 2,
+		},
+		{
+			name: "policy without conditions adds no rows",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).
+					Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				m.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, datasetId, userId).
+					Return([]storemodels.DatasetRowPolicy{
+						{ID: uuid.New(), DatasetId: datasetId, FilterConfig: emptyFilter},
+						{ID: uuid.New(), DatasetId: datasetId, FilterConfig: entityFilter},
+					}, nil)
+			},
+			wantConditionCount: 1,
+		},
+		{
+			name: "only policies without conditions grant no rows",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).
+					Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+				m.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, datasetId, userId).
+					Return([]storemodels.DatasetRowPolicy{
+						{ID: uuid.New(), DatasetId: datasetId, FilterConfig: emptyFilter},
+					}, nil)
+			},
+			wantNoRows: true,
+		},
+		{
+			name: "store error",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).
+					Return(nil, errors.New("test error"))
+			},
+			wantErr: datasetErrors.ErrFailedToGetRowPolicies,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			tt.mockSetup(mockStore)
+
+			service := &datasetService{datasetStore: mockStore}
+			got, err := service.getRowPolicyFilterForUser(context.Background(), datasetId.String(), userId)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.NotNil(t, got)
+			if tt.wantNoRows {
+				assert.Equal(t, getNoRowsFilter(), *got)
+				return
+			}
+
+			assert.Equal(t, models.LogicalOperator("OR"), got.LogicalOperator)
+			assert.Len(t, got.Conditions, tt.wantConditionCount)
+		})
+	}
+}
+
+func TestDataPoliciesWithoutUser(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New().String()
+	service := &datasetService{}
+
+	rowPolicyFilter, err := service.getRowPolicyFilter(context.Background(), datasetId)
+	assert.ErrorIs(t, err, datasetErrors.ErrNoUserForDataPolicies)
+	assert.Nil(t, rowPolicyFilter)
+
+	columnRestrictions, err := service.getColumnRestrictions(context.Background(), datasetId)
+	assert.ErrorIs(t, err, datasetErrors.ErrNoUserForDataPolicies)
+	assert.Nil(t, columnRestrictions)
+
+	internalCtx := apicontext.AddInternalAccessToContext(context.Background())
+
+	rowPolicyFilter, err = service.getRowPolicyFilter(internalCtx, datasetId)
+	assert.NoError(t, err)
+	assert.Nil(t, rowPolicyFilter)
+
+	columnRestrictions, err = service.getColumnRestrictions(internalCtx, datasetId)
+	assert.NoError(t, err)
+	assert.Nil(t, columnRestrictions)
+}
+
+func TestUpdateDatasetDataAppliesRowPolicies(t *testing.T) {
+	t.Parallel()
+
+	merchantId := uuid.New()
+	datasetId := uuid.New()
+	userId := uuid.New()
+
+	mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+	mockDPS := mockDataplatform.NewMockDataPlatformService(t)
+	mockQueryBuilder := mock_querybuilder.NewMockQueryBuilder(t)
+
+	mockDPS.EXPECT().GetDatasetMetadata(mock.Anything, merchantId.String(), datasetId.String()).Return(dataplatformDataModels.DatasetMetadata{
+		Schema: map[string]dataplatformDataModels.ColumnMetadata{
+			"region": {Type: "string"},
+			"status": {Type: "string"},
+		},
+	}, nil)
+	mockStore.EXPECT().GetDatasetById(mock.Anything, datasetId.String()).Return(&storemodels.Dataset{ID: datasetId, Metadata: json.RawMessage(`{}`)}, nil)
+	mockStore.EXPECT().GetDatasetStatusWorkflows(mock.Anything, datasetId).Return([]storemodels.DatasetStatusWorkflow{}, nil)
+	mockStore.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+	mockStore.EXPECT().GetDatasetRowPoliciesForUser(mock.Anything, datasetId, userId).Return([]storemodels.DatasetRowPolicy{
+		{ID: uuid.New(), DatasetId: datasetId, FilterConfig: json.RawMessage(`{"logical_operator":"AND","conditions":[{"column":"region","operator":"eq","value":"EU"}]}`)},
+	}, nil)
+
+	var filterColumns []string
+	mockQueryBuilder.EXPECT().ToFilterSQL(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, filters querybuildermodels.FilterModel) (string, map[string]interface{}, error) {
+		var collect func([]querybuildermodels.Filter)
+		collect = func(conditions []querybuildermodels.Filter) {
+			for _, condition := range conditions {
+				if condition.Column.Column != "" {
+					filterColumns = append(filterColumns, condition.Column.Column)
+				}
+				collect(condition.Conditions)
+			}
+		}
+		collect(filters.Conditions)
+		return "", nil, nil
+	})
+	mockDPS.EXPECT().UpdateDatasetData(mock.Anything, mock.Anything).Return(dataplatformactionmodels.CreateActionResponse{}, errors.New("test error"))
+
+	service := &datasetService{datasetStore: mockStore, dataplatformService: mockDPS, queryBuilderService: mockQueryBuilder}
+	_, err := service.UpdateDatasetData(apicontext.AddLoggerToContext(context.Background(), zap.NewNop()), merchantId, datasetId, models.UpdateDatasetDataParams{
+		Filters: models.FilterModel{
+			LogicalOperator: "AND",
+			Conditions:      []models.Filter{{Column: "status", Operator: "eq", Value: "open"}},
+		},
+		Update:     models.UpdateColumn{Column: "status", Value: "closed"},
+		SourceType: datasetConstants.UpdateColumnSourceTypeUser,
+		UserId:     userId,
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, filterColumns, "status")
+	assert.Contains(t, filterColumns, "region")
+}
+
+func TestPreviewDatasetDataForUserWithoutAccess(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New()
+	userId := uuid.New()
+	storeErr := errors.New("test error")
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{
+			name:    "user without a policy on the dataset",
+			wantErr: datasetErrors.ErrPreviewUserNoDatasetAccess,
+		},
+		{
+			name:    "store error",
+			err:     storeErr,
+			wantErr: storeErr,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			mockStore.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, storemodels.FlattenedResourceAudiencePoliciesFilters{
+				ResourceIds:   []uuid.UUID{datasetId},
+				UserIds:       []uuid.UUID{userId},
+				ResourceTypes: []string{string(storemodels.ResourceTypeDataset)},
+			}).Return([]storemodels.FlattenedResourceAudiencePolicy{}, tt.err)
+
+			service := &datasetService{datasetStore: mockStore}
+			_, err := service.PreviewDatasetDataForUser(context.Background(), uuid.New(), datasetId.String(), userId, models.DatasetParams{})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestApplyRowPolicyFilter(t *testing.T) {
+	t.Parallel()
+
+	and := models.LogicalOperator("AND")
+	rowPolicyFilter := &models.FilterModel{
+		LogicalOperator: "OR",
+		Conditions: []models.Filter{
+			{Column: "region", Operator: "eq", Value: "EU"},
+		},
+	}
+
+	s := &datasetService{}
+
+	t.Run("nil filter leaves params untouched", func(t *testing.T) {
+		params := models.DatasetParams{Filters: models.FilterModel{LogicalOperator: and}}
+		assert.Equal(t, params, s.applyRowPolicyFilter(params, nil))
+	})
+
+	t.Run("policy is AND-ed with existing filters", func(t *testing.T) {
+		params := models.DatasetParams{
+			Filters: models.FilterModel{
+				LogicalOperator: "OR",
+				Conditions:      []models.Filter{{Column: "amount", Operator: "gt", Value: 10}},
+			},
+		}
+
+		got := s.applyRowPolicyFilter(params, rowPolicyFilter)
+
+		assert.Equal(t, and, got.Filters.LogicalOperator)
+		assert.Len(t, got.Filters.Conditions, 2)
+		assert.Equal(t, models.LogicalOperator("OR"), *got.Filters.Conditions[0].LogicalOperator)
+		assert.Equal(t, params.Filters.Conditions, got.Filters.Conditions[0].Conditions)
+		assert.Equal(t, rowPolicyFilter.Conditions, got.Filters.Conditions[1].Conditions)
+	})
+
+	t.Run("policy is applied to the innermost subquery", func(t *testing.T) {
+		params := models.DatasetParams{
+			Subquery: &models.DatasetParams{},
+		}
+
+		got := s.applyRowPolicyFilter(params, rowPolicyFilter)
+
+		assert.Empty(t, got.Filters.Conditions)
+		assert.Len(t, got.Subquery.Filters.Conditions, 1)
+		assert.Equal(t, rowPolicyFilter.Conditions, got.Subquery.Filters.Conditions[0].Conditions)
+	})
+}
+
+func TestResolveColumnRestrictions(t *testing.T) {
+	t.Parallel()
+
+	hash := storemodels.ColumnMaskTypeHash
+	last4 := storemodels.ColumnMaskTypeLast4
+
+	policies := []storemodels.DatasetColumnPolicy{
+		{Column: "account_number", Action: storemodels.ColumnPolicyActionHide},
+		{Column: "account_number", Action: storemodels.ColumnPolicyActionMask, MaskType: &hash},
+		{Column: "account_number", Action: storemodels.ColumnPolicyActionMask, MaskType: &last4},
+		{Column: "counterparty", Action: storemodels.ColumnPolicyActionHide},
+		{Column: "amount", Action: storemodels.ColumnPolicyActionMask, MaskType: &hash},
+		{Column: "amount", Action: storemodels.ColumnPolicyActionAllow},
+	}
+
+	got := resolveColumnRestrictions(policies)
+
+	assert.Equal(t, map[string]models.ColumnRestriction{
+		"account_number": {Action: storemodels.ColumnPolicyActionMask, MaskType: storemodels.ColumnMaskTypeLast4},
+		"counterparty":   {Action: storemodels.ColumnPolicyActionHide},
+	}, got)
+	assert.Nil(t, resolveColumnRestrictions([]storemodels.DatasetColumnPolicy{{Column: "amount", Action: storemodels.ColumnPolicyActionAllow}}))
+}
+
+func TestEnforceColumnRestrictions(t *testing.T) {
+	t.Parallel()
+
+	alias := "acc"
+	restrictions := map[string]models.ColumnRestriction{
+		"account_number": {Action: storemodels.ColumnPolicyActionMask, MaskType: storemodels.ColumnMaskTypeLast4},
+		"counterparty":   {Action: storemodels.ColumnPolicyActionHide},
+	}
+
+	tests := []struct {
+		name      string
+		params    models.DatasetParams
+		wantMasks map[string]storemodels.ColumnMaskType
+		wantErr   error
+	}{
+		{
+			name:   "all columns",
+			params: models.DatasetParams{},
+			wantMasks: map[string]storemodels.ColumnMaskType{
+				"account_number": storemodels.ColumnMaskTypeLast4,
+			},
+		},
+		{
+			name: "masked column aliased in a subquery",
+			params: models.DatasetParams{
+				Columns: []models.ColumnConfig{{Column: "acc"}},
+				Subquery: &models.DatasetParams{
+					Columns: []models.ColumnConfig{{Column: "account_number", Alias: &alias}},
+				},
+			},
+			wantMasks: map[string]storemodels.ColumnMaskType{
+				"acc": storemodels.ColumnMaskTypeLast4,
+			},
+		},
+		{
+			name: "filter on masked column",
+			params: models.DatasetParams{
+				Filters: models.FilterModel{
+					Conditions: []models.Filter{{Column: "account_number", Operator: "eq", Value: "1234"}},
+				},
+			},
+			wantErr: datasetErrors.ErrColumnNotFilterable,
+		},
+		{
+			name: "filter on masked column through a subquery alias",
+			params: models.DatasetParams{
+				Filters: models.FilterModel{
+					Conditions: []models.Filter{{Column: "acc", Operator: "startswith", Value: "12"}},
+				},
+				Subquery: &models.DatasetParams{
+					Columns: []models.ColumnConfig{{Column: "account_number", Alias: &alias}},
+				},
+			},
+			wantErr: datasetErrors.ErrColumnNotFilterable,
+		},
+		{
+			name: "sort on hidden column",
+			params: models.DatasetParams{
+				OrderBy: []models.OrderBy{{Column: "counterparty", Order: "ASC"}},
+			},
+			wantErr: datasetErrors.ErrColumnNotFilterable,
+		},
+		{
+			name: "group by hidden column",
+			params: models.DatasetParams{
+				GroupBy: []models.GroupBy{{Column: "counterparty"}},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+		{
+			name: "count of masked column sorted by its alias",
+			params: models.DatasetParams{
+				GroupBy:      []models.GroupBy{{Column: "region"}},
+				Aggregations: []models.Aggregation{{Column: "account_number", Function: "count", Alias: "accounts"}},
+				OrderBy:      []models.OrderBy{{Column: "accounts", Order: "DESC"}},
+			},
+			wantMasks: map[string]storemodels.ColumnMaskType{},
+		},
+		{
+			name: "expression over masked column",
+			params: models.DatasetParams{
+				Columns: []models.ColumnConfig{{Column: "lower(account_number)", Alias: &alias}},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+		{
+			name: "expression over hidden column in a filter",
+			params: models.DatasetParams{
+				Filters: models.FilterModel{
+					Conditions: []models.Filter{{Column: "counterparty || ''", Operator: "startswith", Value: "Acme"}},
+				},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+		{
+			name: "expression over masked column in a sort",
+			params: models.DatasetParams{
+				OrderBy: []models.OrderBy{{Column: "substr(account_number, 1, 20)", Order: "ASC"}},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+		{
+			name: "expression over hidden column in a group by",
+			params: models.DatasetParams{
+				GroupBy: []models.GroupBy{{Column: "upper(counterparty)", Alias: &alias}},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+		{
+			name: "expression in an aggregation",
+			params: models.DatasetParams{
+				Aggregations: []models.Aggregation{{Column: "length(account_number)", Function: "sum", Alias: "total"}},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+		{
+			name: "time bucket of an unrestricted column",
+			params: models.DatasetParams{
+				Columns:      []models.ColumnConfig{{Column: "date_trunc('month', from_utc_timestamp(posted_at, 'America/New_York'))", Alias: &alias}},
+				GroupBy:      []models.GroupBy{{Column: "date_trunc('month', from_utc_timestamp(posted_at, 'America/New_York'))", Alias: &alias}},
+				Aggregations: []models.Aggregation{{Column: "amount", Function: "sum", Alias: "total"}},
+				OrderBy:      []models.OrderBy{{Column: "date_trunc('month', from_utc_timestamp(posted_at, 'America/New_York'))", Order: "ASC"}},
+			},
+			wantMasks: map[string]storemodels.ColumnMaskType{},
+		},
+		{
+			name: "restricted column named in a literal",
+			params: models.DatasetParams{
+				GroupBy: []models.GroupBy{{Column: "concat(region, ' counterparty \\' account_number')", Alias: &alias}},
+			},
+			wantMasks: map[string]storemodels.ColumnMaskType{},
+		},
+		{
+			name: "expression over masked column in another case",
+			params: models.DatasetParams{
+				GroupBy: []models.GroupBy{{Column: "trim(`Account_Number`)", Alias: &alias}},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+		{
+			name: "expression reading every column",
+			params: models.DatasetParams{
+				Columns: []models.ColumnConfig{{Column: "to_json(struct(*))", Alias: &alias}},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+		{
+			name: "product of unrestricted columns",
+			params: models.DatasetParams{
+				Columns: []models.ColumnConfig{{Column: "amount * 2", Alias: &alias}},
+			},
+			wantMasks: map[string]storemodels.ColumnMaskType{},
+		},
+		{
+			name: "maximum of masked column",
+			params: models.DatasetParams{
+				Aggregations: []models.Aggregation{{Column: "account_number", Function: "MAX", Alias: "highest"}},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+		{
+			name: "aggregation function the dataset does not have",
+			params: models.DatasetParams{
+				Aggregations: []models.Aggregation{{Column: "amount", Function: "collect_list", Alias: "amounts"}},
+			},
+			wantErr: datasetErrors.ErrColumnNotAccessible,
+		},
+	}
+
+	schema := map[string]bool{"account_number": true, "counterparty": true, "region": true, "amount": true}
+	s := &datasetService{}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			masks, err := s.enforceColumnRestrictions(tt.params, restrictions, schema)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantMasks, masks)
+		})
+	}
+}
+
+func TestMaskValue(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "****6789", maskValue("secret", "dataset", "123456789", storemodels.ColumnMaskTypeLast4))
+	assert.Equal(t, "****", maskValue("secret", "dataset", "123", storemodels.ColumnMaskTypeLast4))
+	assert.Equal(t, "****Tomé", maskValue("secret", "dataset", "São Tomé", storemodels.ColumnMaskTypeLast4))
+	assert.Nil(t, maskValue("secret", "dataset", "123456789", storemodels.ColumnMaskTypeNull))
+	assert.Nil(t, maskValue("secret", "dataset", nil, storemodels.ColumnMaskTypeLast4))
+
+	hashed := maskValue("secret", "dataset", "123456789", storemodels.ColumnMaskTypeHash)
+	assert.Len(t, hashed, datasetConstants.MaskedValueHashSize)
+	assert.Equal(t, hashed, maskValue("secret", "dataset", "123456789", storemodels.ColumnMaskTypeHash))
+	assert.NotEqual(t, hashed, maskValue("secret", "other-dataset", "123456789", storemodels.ColumnMaskTypeHash))
+
+	// the hash cannot be computed back without the secret of the server
+	unkeyed := sha256.Sum256([]byte("dataset:123456789"))
+	assert.NotEqual(t, hex.EncodeToString(unkeyed[:])[:datasetConstants.MaskedValueHashSize], hashed)
+	assert.NotEqual(t, hashed, maskValue("other-secret", "dataset", "123456789", storemodels.ColumnMaskTypeHash))
+	assert.Equal(t, "****", maskValue("", "dataset", "123456789", storemodels.ColumnMaskTypeHash))
+}
+
+func TestApplyColumnRestrictionsToFilterConfig(t *testing.T) {
+	t.Parallel()
+
+	s := &datasetService{}
+	filterConfigs := []models.FilterConfig{
+		{Column: "amount", Options: []interface{}{}},
+		{Column: "account_number", Options: []interface{}{"123456789"}, Metadata: map[string]interface{}{"is_editable": false}},
+		{Column: "counterparty", Options: []interface{}{"acme"}},
+	}
+
+	got := s.applyColumnRestrictionsToFilterConfig(filterConfigs, map[string]models.ColumnRestriction{
+		"account_number": {Action: storemodels.ColumnPolicyActionMask, MaskType: storemodels.ColumnMaskTypeHash},
+		"counterparty":   {Action: storemodels.ColumnPolicyActionHide},
+	})
+
+	assert.Len(t, got, 2)
+	assert.Equal(t, "amount", got[0].Column)
+	assert.Equal(t, "account_number", got[1].Column)
+	assert.Empty(t, got[1].Options)
+	assert.Equal(t, true, got[1].Metadata[datasetConstants.MetadataConfigIsMasked])
+	assert.Equal(t, false, got[1].Metadata["is_editable"])
+	assert.NotContains(t, filterConfigs[1].Metadata, datasetConstants.MetadataConfigIsMasked)
+}
+
+func TestGetDefaultDatasetView(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New()
+	userId := uuid.New()
+	viewId := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mockDatasetService.MockDatasetServiceStore)
+		wantNil   bool
+		wantErr   bool
+	}{
+		{
+			name: "no default view",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDefaultDatasetView(mock.Anything, userId, datasetId).Return(nil, nil)
+			},
+			wantNil: true,
+		},
+		{
+			name: "default view is returned",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDefaultDatasetView(mock.Anything, userId, datasetId).
+					Return(&storemodels.DatasetViewDefault{UserId: userId, DatasetId: datasetId, DatasetViewId: viewId}, nil)
+				m.EXPECT().GetDatasetViewById(mock.Anything, viewId).
+					Return(storemodels.DatasetView{ID: viewId, DatasetId: datasetId, Name: "EU payouts", QueryConfig: json.RawMessage(`{}`)}, nil)
+			},
+		},
+		{
+			name: "default view no longer shared with the user",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDefaultDatasetView(mock.Anything, userId, datasetId).
+					Return(&storemodels.DatasetViewDefault{UserId: userId, DatasetId: datasetId, DatasetViewId: viewId}, nil)
+				m.EXPECT().GetDatasetViewById(mock.Anything, viewId).
+					Return(storemodels.DatasetView{}, errors.New("record not found"))
+			},
+			wantNil: true,
+		},
+		{
+			name: "store error",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDefaultDatasetView(mock.Anything, userId, datasetId).Return(nil, errors.New("test error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			tt.mockSetup(mockStore)
+
+			service := &datasetService{datasetStore: mockStore}
+			got, err := service.GetDefaultDatasetView(context.Background(), userId, datasetId)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tt.wantNil {
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.Equal(t, viewId, got.ID)
+			assert.True(t, got.IsDefault)
+		})
+	}
+}
+
+func TestRemoveAudienceFromDatasetView(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New()
+	viewId := uuid.New()
+	ownerId := uuid.New()
+	viewerId := uuid.New()
+
+	tests := []struct {
+		name         string
+		audienceType storemodels.AudienceType
+		audienceId   uuid.UUID
+		mockSetup    func(*mockDatasetService.MockDatasetServiceStore)
+		wantErr      error
+	}{
+		{
+			name:         "owner cannot be removed",
+			audienceType: storemodels.AudienceTypeUser,
+			audienceId:   ownerId,
+			mockSetup:    func(m *mockDatasetService.MockDatasetServiceStore) {},
+			wantErr:      datasetErrors.ErrCannotRemoveDatasetViewOwner,
+		},
+		{
+			name:         "only view admins can unshare",
+			audienceType: storemodels.AudienceTypeUser,
+			audienceId:   viewerId,
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).
+					Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+			},
+			wantErr: datasetErrors.ErrDatasetViewAccessForbidden,
+		},
+		{
+			name:         "admin removes a viewer",
+			audienceType: storemodels.AudienceTypeUser,
+			audienceId:   viewerId,
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).
+					Return([]storemodels.FlattenedResourceAudiencePolicy{{UserId: ownerId, Privilege: storemodels.PrivilegeDatasetViewAdmin}}, nil)
+				m.EXPECT().DeleteDatasetViewPolicy(mock.Anything, viewId, storemodels.AudienceTypeUser, viewerId).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			mockStore.EXPECT().GetDatasetViewById(mock.Anything, viewId).
+				Return(storemodels.DatasetView{ID: viewId, DatasetId: datasetId, OwnerId: ownerId}, nil)
+			tt.mockSetup(mockStore)
+
+			service := &datasetService{datasetStore: mockStore}
+			err := service.RemoveAudienceFromDatasetView(context.Background(), ownerId, datasetId, viewId, tt.audienceType, tt.audienceId)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDatasetViewParams(t *testing.T) {
+	t.Parallel()
+
+	_, err := validateDatasetViewParams(models.DatasetViewParams{Name: "   "})
+	assert.ErrorIs(t, err, datasetErrors.ErrEmptyDatasetViewName)
+
+	params, err := validateDatasetViewParams(models.DatasetViewParams{
+		Name: " Open invoices ",
+		QueryConfig: models.DatasetParams{
+			OrderBy:    []models.OrderBy{{Column: "due_date", Order: "asc"}},
+			Pagination: &models.Pagination{Page: 3, PageSize: 50},
+			CountAll:   true,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Open invoices", params.Name)
+	assert.Nil(t, params.QueryConfig.Pagination)
+	assert.False(t, params.QueryConfig.CountAll)
+	assert.Len(t, params.QueryConfig.OrderBy, 1)
+}
+
+func TestDatasetViewApplyTo(t *testing.T) {
+	t.Parallel()
+
+	usd := "USD"
+	view := models.DatasetView{
+		QueryConfig: models.DatasetParams{
+			Filters: models.FilterModel{
+				LogicalOperator: "AND",
+				Conditions:      []models.Filter{{Column: "status", Operator: "eq", Value: "open"}},
+			},
+			GroupBy: []models.GroupBy{{Column: "currency"}},
+		},
+	}
+
+	got := view.ApplyTo(models.DatasetParams{
+		Filters:    models.FilterModel{LogicalOperator: "OR"},
+		Pagination: &models.Pagination{Page: 2, PageSize: 25},
+		CountAll:   true,
+		FxCurrency: &usd,
+	})
+
+	assert.Equal(t, view.QueryConfig.Filters, got.Filters)
+	assert.Equal(t, view.QueryConfig.GroupBy, got.GroupBy)
+	assert.Equal(t, 2, got.Pagination.Page)
+	assert.True(t, got.CountAll)
+	assert.Equal(t, &usd, got.FxCurrency)
+}
+
+func TestValidateDatasetExportScheduleParams(t *testing.T) {
+	t.Parallel()
+
+	valid := models.DatasetExportScheduleParams{
+		Name:            " Morning payouts ",
+		CronExpression:  "0  7 * * 1-5",
+		DestinationType: datasetConstants.ExportDestinationTypeEmail,
+		DestinationConfig: models.DatasetExportScheduleDestination{
+			Recipients: []string{"Finance <Finance@Example.com>", "finance@example.com", "ops@example.com"},
+		},
+		QueryConfig: models.DatasetParams{
+			Pagination: &models.Pagination{Page: 2, PageSize: 50},
+			CountAll:   true,
+		},
+	}
+
+	exportSettings := exports.Settings{Buckets: map[string]string{datasetConstants.ExportStorageProviderS3: "acme-exports"}}
+
+	params, err := validateDatasetExportScheduleParams(valid, exportSettings)
+	assert.NoError(t, err)
+	assert.Equal(t, "Morning payouts", params.Name)
+	assert.Equal(t, datasetConstants.ExportFormatCSV, params.Format)
+	assert.Equal(t, "0 7 * * 1-5", params.CronExpression)
+	assert.Equal(t, "UTC", params.Timezone)
+	assert.Equal(t, []string{"finance@example.com", "ops@example.com"}, params.DestinationConfig.Recipients)
+	assert.Nil(t, params.QueryConfig.Pagination)
+	assert.False(t, params.QueryConfig.CountAll)
+
+	tests := []struct {
+		name    string
+		update  func(p *models.DatasetExportScheduleParams)
+		wantErr error
+	}{
+		{
+			name:    "empty name",
+			update:  func(p *models.DatasetExportScheduleParams) { p.Name = " " },
+			wantErr: datasetErrors.ErrEmptyDatasetExportScheduleName,
+		},
+		{
+			name:    "unsupported format",
+			update:  func(p *models.DatasetExportScheduleParams) { p.Format = "pdf" },
+			wantErr: datasetErrors.ErrInvalidExportFormat,
+		},
+		{
+			name:    "cron with six fields",
+			update:  func(p *models.DatasetExportScheduleParams) { p.CronExpression = "0 0 7 * * *" },
+			wantErr: datasetErrors.ErrInvalidCronExpression,
+		},
+		{
+			name:    "unknown timezone",
+			update:  func(p *models.DatasetExportScheduleParams) { p.Timezone = "Mars/Olympus" },
+			wantErr: datasetErrors.ErrInvalidTimezone,
+		},
+		{
+			name: "invalid recipient",
+			update: func(p *models.DatasetExportScheduleParams) {
+				p.DestinationConfig.Recipients = []string{"not-an-email"}
+			},
+			wantErr: datasetErrors.ErrInvalidExportDestination,
+		},
+		{
+			name: "storage without provider",
+			update: func(p *models.DatasetExportScheduleParams) {
+				p.DestinationType = datasetConstants.ExportDestinationTypeStorage
+				p.DestinationConfig = models.DatasetExportScheduleDestination{Prefix: "payouts"}
+			},
+			wantErr: datasetErrors.ErrInvalidExportDestination,
+		},
+		{
+			name: "storage on a provider the organization has no bucket on",
+			update: func(p *models.DatasetExportScheduleParams) {
+				p.DestinationType = datasetConstants.ExportDestinationTypeStorage
+				p.DestinationConfig = models.DatasetExportScheduleDestination{Provider: datasetConstants.ExportStorageProviderGCS, Prefix: "payouts"}
+			},
+			wantErr: datasetErrors.ErrInvalidExportDestination,
+		},
+		{
+			name: "storage on a bucket of another organization",
+			update: func(p *models.DatasetExportScheduleParams) {
+				p.DestinationType = datasetConstants.ExportDestinationTypeStorage
+				p.DestinationConfig = models.DatasetExportScheduleDestination{Provider: datasetConstants.ExportStorageProviderS3, Bucket: "globex-exports", Prefix: "payouts"}
+			},
+			wantErr: datasetErrors.ErrInvalidExportDestination,
+		},
+		{
+			name: "storage prefix stepping out of the folder",
+			update: func(p *models.DatasetExportScheduleParams) {
+				p.DestinationType = datasetConstants.ExportDestinationTypeStorage
+				p.DestinationConfig = models.DatasetExportScheduleDestination{Provider: datasetConstants.ExportStorageProviderS3, Prefix: "payouts/../../globex"}
+			},
+			wantErr: datasetErrors.ErrInvalidExportDestination,
+		},
+		{
+			name: "storage on s3",
+			update: func(p *models.DatasetExportScheduleParams) {
+				p.CronExpression = "@daily"
+				p.DestinationType = datasetConstants.ExportDestinationTypeStorage
+				p.DestinationConfig = models.DatasetExportScheduleDestination{Provider: datasetConstants.ExportStorageProviderS3, Prefix: "/payouts/daily/"}
+			},
+		},
+		{
+			name: "storage on the bucket of the organization",
+			update: func(p *models.DatasetExportScheduleParams) {
+				p.DestinationType = datasetConstants.ExportDestinationTypeStorage
+				p.DestinationConfig = models.DatasetExportScheduleDestination{Provider: datasetConstants.ExportStorageProviderS3, Bucket: "acme-exports", Prefix: "payouts/daily"}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			params := valid
+			params.DestinationConfig.Recipients = slices.Clone(valid.DestinationConfig.Recipients)
+			tt.update(&params)
+
+			got, err := validateDatasetExportScheduleParams(params, exportSettings)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "acme-exports", got.DestinationConfig.Bucket)
+			assert.Equal(t, "payouts/daily", got.DestinationConfig.Prefix)
+			assert.Empty(t, got.DestinationConfig.Recipients)
+		})
+	}
+}
+
+func TestCreateDatasetExportSchedule(t *testing.T) {
+	t.Parallel()
+
+	merchantId := uuid.New()
+	userId := uuid.New()
+	datasetId := uuid.New()
+	scheduleId := uuid.New()
+
+	params := models.DatasetExportScheduleParams{
+		Name:            "Morning payouts",
+		Format:          datasetConstants.ExportFormatXLSX,
+		CronExpression:  "0 7 * * *",
+		Timezone:        "Asia/Kolkata",
+		DestinationType: datasetConstants.ExportDestinationTypeStorage,
+		DestinationConfig: models.DatasetExportScheduleDestination{
+			Provider: datasetConstants.ExportStorageProviderGCS,
+			Bucket:   "acme-exports",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		params    models.DatasetExportScheduleParams
+		mockSetup func(*mockDatasetService.MockDatasetServiceStore, *mock_temporal.MockTemporalService)
+		wantErr   error
+	}{
+		{
+			name:   "creates the schedule and its temporal schedule",
+			params: params,
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, mt *mock_temporal.MockTemporalService) {
+				m.EXPECT().WithDatasetExportScheduleTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(store.DatasetExportScheduleStore) error) error {
+						return fn(m)
+					})
+				m.EXPECT().CreateDatasetExportSchedule(mock.Anything, mock.MatchedBy(func(p storemodels.CreateDatasetExportScheduleParams) bool {
+					return p.OwnerId == userId && p.Format == "xlsx" && strings.HasPrefix(p.TemporalScheduleId, "dataset-export-schedule-") &&
+						p.DestinationConfig.(models.DatasetExportScheduleDestination).Bucket == "acme-exports"
+				})).RunAndReturn(func(ctx context.Context, p storemodels.CreateDatasetExportScheduleParams) (storemodels.DatasetExportSchedule, error) {
+					return storemodels.DatasetExportSchedule{
+						ID:                 scheduleId,
+						OrganizationId:     merchantId,
+						DatasetId:          datasetId,
+						OwnerId:            userId,
+						Name:               p.Name,
+						QueryConfig:        json.RawMessage(`{}`),
+						Format:             p.Format,
+						CronExpression:     p.CronExpression,
+						Timezone:           p.Timezone,
+						DestinationType:    p.DestinationType,
+						DestinationConfig:  json.RawMessage(`{"provider":"gcs","bucket":"acme-exports"}`),
+						TemporalScheduleId: p.TemporalScheduleId,
+						IsEnabled:          true,
+					}, nil
+				})
+				mt.EXPECT().ExecuteScheduledWorkflow(mock.Anything, mock.MatchedBy(func(p temporalmodels.ExecuteWorkflowWithScheduleParams) bool {
+					args := p.ScheduleOptions.Action.(*temporalclient.ScheduleWorkflowAction).Args
+					workflowParams := args[0].(models.DatasetExportScheduleWorkflowParams)
+					return p.ScheduleOptions.Spec.CronExpressions[0] == "0 7 * * *" &&
+						p.ScheduleOptions.Spec.TimeZoneName == "Asia/Kolkata" &&
+						workflowParams.ScheduleId == scheduleId &&
+						workflowParams.TemporalScheduleId == p.ScheduleOptions.ID
+				})).Return(temporalmodels.ScheduledWorkflowResponse{}, nil)
+			},
+		},
+		{
+			name:   "temporal failure rolls back the schedule",
+			params: params,
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, mt *mock_temporal.MockTemporalService) {
+				m.EXPECT().WithDatasetExportScheduleTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(store.DatasetExportScheduleStore) error) error {
+						return fn(m)
+					})
+				m.EXPECT().CreateDatasetExportSchedule(mock.Anything, mock.Anything).
+					Return(storemodels.DatasetExportSchedule{ID: scheduleId, QueryConfig: json.RawMessage(`{}`)}, nil)
+				mt.EXPECT().ExecuteScheduledWorkflow(mock.Anything, mock.Anything).
+					Return(temporalmodels.ScheduledWorkflowResponse{}, errors.New("temporal unavailable"))
+			},
+			wantErr: errors.New("temporal unavailable"),
+		},
+		{
+			name: "invalid cron expression",
+			params: models.DatasetExportScheduleParams{
+				Name:            "Morning payouts",
+				CronExpression:  "every morning",
+				DestinationType: datasetConstants.ExportDestinationTypeStorage,
+			},
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, mt *mock_temporal.MockTemporalService) {},
+			wantErr:   datasetErrors.ErrInvalidCronExpression,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			mockTemporalService := mock_temporal.NewMockTemporalService(t)
+			mockStore.EXPECT().GetOrganizationById(mock.Anything, merchantId.String()).Return(&storemodels.Organization{
+				ID:             merchantId,
+				ExportSettings: json.RawMessage(`{"buckets":{"gcs":"acme-exports"}}`),
+			}, nil).Maybe()
+			tt.mockSetup(mockStore, mockTemporalService)
+
+			service := &datasetService{datasetStore: mockStore, temporalService: mockTemporalService}
+			schedule, err := service.CreateDatasetExportSchedule(context.Background(), merchantId, userId, datasetId, tt.params)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, scheduleId, schedule.ID)
+			assert.Equal(t, datasetConstants.ExportFormatXLSX, schedule.Format)
+			assert.Equal(t, "acme-exports", schedule.DestinationConfig.Bucket)
+		})
+	}
+}
+
+func TestUpdateDatasetExportSchedule(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New()
+	scheduleId := uuid.New()
+	ownerId := uuid.New()
+
+	current := storemodels.DatasetExportSchedule{
+		ID:                 scheduleId,
+		DatasetId:          datasetId,
+		OwnerId:            ownerId,
+		QueryConfig:        json.RawMessage(`{}`),
+		CronExpression:     "0 7 * * *",
+		Timezone:           "UTC",
+		TemporalScheduleId: "dataset-export-schedule-current",
+		IsEnabled:          true,
+	}
+
+	params := models.DatasetExportScheduleParams{
+		Name:              "Morning payouts",
+		CronExpression:    "0 7 * * *",
+		DestinationType:   datasetConstants.ExportDestinationTypeEmail,
+		DestinationConfig: models.DatasetExportScheduleDestination{Recipients: []string{"finance@example.com"}},
+	}
+	disabled := false
+
+	tests := []struct {
+		name      string
+		userId    uuid.UUID
+		update    func(p *models.DatasetExportScheduleParams)
+		mockSetup func(*mockDatasetService.MockDatasetServiceStore, *mock_temporal.MockTemporalService)
+		wantErr   error
+	}{
+		{
+			name:   "same cron keeps the temporal schedule",
+			userId: ownerId,
+			update: func(p *models.DatasetExportScheduleParams) { p.IsEnabled = &disabled },
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, mt *mock_temporal.MockTemporalService) {
+				m.EXPECT().UpdateDatasetExportSchedule(mock.Anything, scheduleId, mock.MatchedBy(func(p storemodels.UpdateDatasetExportScheduleParams) bool {
+					return p.TemporalScheduleId == current.TemporalScheduleId && !p.IsEnabled
+				})).Return(current, nil)
+			},
+		},
+		{
+			name:   "new cron replaces the temporal schedule",
+			userId: ownerId,
+			update: func(p *models.DatasetExportScheduleParams) { p.CronExpression = "0 9 * * 1" },
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, mt *mock_temporal.MockTemporalService) {
+				m.EXPECT().UpdateDatasetExportSchedule(mock.Anything, scheduleId, mock.MatchedBy(func(p storemodels.UpdateDatasetExportScheduleParams) bool {
+					return p.TemporalScheduleId != current.TemporalScheduleId && p.IsEnabled
+				})).Return(current, nil)
+				mt.EXPECT().ExecuteScheduledWorkflow(mock.Anything, mock.Anything).Return(temporalmodels.ScheduledWorkflowResponse{}, nil)
+			},
+		},
+		{
+			name:   "dataset viewers cannot edit schedules of others",
+			userId: uuid.New(),
+			update: func(p *models.DatasetExportScheduleParams) {},
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, mt *mock_temporal.MockTemporalService) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).
+					Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+			},
+			wantErr: datasetErrors.ErrExportScheduleAccessForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			mockTemporalService := mock_temporal.NewMockTemporalService(t)
+			mockStore.EXPECT().GetDatasetExportScheduleById(mock.Anything, scheduleId).Return(current, nil)
+			mockStore.EXPECT().WithDatasetExportScheduleTransaction(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, fn func(store.DatasetExportScheduleStore) error) error {
+					return fn(mockStore)
+				}).Maybe()
+			tt.mockSetup(mockStore, mockTemporalService)
+
+			updateParams := params
+			tt.update(&updateParams)
+
+			service := &datasetService{datasetStore: mockStore, temporalService: mockTemporalService}
+			_, err := service.UpdateDatasetExportSchedule(context.Background(), tt.userId, datasetId, scheduleId, updateParams)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestStartDatasetExportScheduleRunActivity(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New()
+	scheduleId := uuid.New()
+	ownerId := uuid.New()
+	orgId := uuid.New()
+
+	params := models.DatasetExportScheduleWorkflowParams{
+		ScheduleId:         scheduleId,
+		TemporalScheduleId: "dataset-export-schedule-current",
+		OrganizationId:     orgId,
+		OwnerId:            ownerId,
+	}
+
+	schedule := storemodels.DatasetExportSchedule{
+		ID:                 scheduleId,
+		DatasetId:          datasetId,
+		OwnerId:            ownerId,
+		Name:               "Morning payouts",
+		QueryConfig:        json.RawMessage(`{"Filters":{"logical_operator":"AND"}}`),
+		Format:             "parquet",
+		DestinationType:    "email",
+		DestinationConfig:  json.RawMessage(`{"recipients":["finance@example.com"]}`),
+		TemporalScheduleId: "dataset-export-schedule-current",
+		IsEnabled:          true,
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mockDatasetService.MockDatasetServiceStore)
+		wantSkip  bool
+		wantErr   bool
+	}{
+		{
+			name: "records the run",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDatasetExportScheduleById(mock.Anything, scheduleId).Return(schedule, nil)
+				m.EXPECT().GetDatasetById(mock.Anything, datasetId.String()).Return(&storemodels.Dataset{Title: "Payouts"}, nil)
+				m.EXPECT().CreateDatasetAction(mock.Anything, orgId, mock.MatchedBy(func(p storemodels.CreateDatasetActionParams) bool {
+					return p.ActionId == "run-workflow" && p.ActionBy == ownerId
+				})).Return(nil)
+				m.EXPECT().CreateDatasetExportScheduleRun(mock.Anything, mock.MatchedBy(func(p storemodels.CreateDatasetExportScheduleRunParams) bool {
+					return p.WorkflowId == "run-workflow" && p.Status == datasetConstants.DatasetExportScheduleRunStatusRunning && strings.HasSuffix(p.FilePath, ".parquet")
+				})).Return(storemodels.DatasetExportScheduleRun{ID: uuid.New()}, nil)
+			},
+		},
+		{
+			name: "deleted schedule is skipped",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDatasetExportScheduleById(mock.Anything, scheduleId).Return(storemodels.DatasetExportSchedule{}, gorm.ErrRecordNotFound)
+			},
+			wantSkip: true,
+		},
+		{
+			name: "run fired by a replaced temporal schedule is skipped",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				replaced := schedule
+				replaced.TemporalScheduleId = "dataset-export-schedule-new"
+				m.EXPECT().GetDatasetExportScheduleById(mock.Anything, scheduleId).Return(replaced, nil)
+			},
+			wantSkip: true,
+		},
+		{
+			name: "store error",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDatasetExportScheduleById(mock.Anything, scheduleId).Return(storemodels.DatasetExportSchedule{}, errors.New("connection reset"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			tt.mockSetup(mockStore)
+
+			service := &datasetService{datasetStore: mockStore, datasetActionService: datasetactionservice.NewDatasetActionService(mockStore)}
+			plan, err := service.StartDatasetExportScheduleRunActivity(context.Background(), params, "run-workflow")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSkip, plan.Skip)
+			if !tt.wantSkip {
+				assert.Equal(t, "Payouts", plan.DatasetName)
+				assert.Equal(t, datasetConstants.ExportFormatParquet, plan.ExportParams.Format)
+				assert.Equal(t, []string{"finance@example.com"}, plan.DestinationConfig.Recipients)
+				assert.Equal(t, models.LogicalOperator("AND"), plan.ExportParams.QueryConfig.Filters.LogicalOperator)
+			}
+		})
+	}
+}
+
+func TestStartDatasetExportScheduleRunActivityToStorage(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New()
+	scheduleId := uuid.New()
+	orgId := uuid.New()
+
+	params := models.DatasetExportScheduleWorkflowParams{
+		ScheduleId:         scheduleId,
+		TemporalScheduleId: "dataset-export-schedule-current",
+		OrganizationId:     orgId,
+		OwnerId:            uuid.New(),
+	}
+
+	// the schedule was saved when the organization exported to another bucket
+	schedule := storemodels.DatasetExportSchedule{
+		ID:                 scheduleId,
+		DatasetId:          datasetId,
+		QueryConfig:        json.RawMessage(`{}`),
+		Format:             "csv",
+		DestinationType:    "storage",
+		DestinationConfig:  json.RawMessage(`{"provider":"gcs","bucket":"acme-old-exports","prefix":"payouts"}`),
+		TemporalScheduleId: "dataset-export-schedule-current",
+		IsEnabled:          true,
+	}
+
+	tests := []struct {
+		name           string
+		exportSettings json.RawMessage
+		wantBucket     string
+		wantErr        bool
+	}{
+		{
+			name:           "bucket of the organization",
+			exportSettings: json.RawMessage(`{"buckets":{"gcs":"acme-exports"}}`),
+			wantBucket:     "acme-exports",
+		},
+		{
+			name:           "organization no longer exporting to the provider",
+			exportSettings: json.RawMessage(`{"buckets":{"s3":"acme-exports"}}`),
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			mockStore.EXPECT().GetDatasetExportScheduleById(mock.Anything, scheduleId).Return(schedule, nil)
+			mockStore.EXPECT().GetDatasetById(mock.Anything, datasetId.String()).Return(&storemodels.Dataset{Title: "Payouts"}, nil)
+			mockStore.EXPECT().GetOrganizationById(mock.Anything, orgId.String()).Return(&storemodels.Organization{ID: orgId, ExportSettings: tt.exportSettings}, nil)
+			if !tt.wantErr {
+				mockStore.EXPECT().CreateDatasetAction(mock.Anything, orgId, mock.Anything).Return(nil)
+				mockStore.EXPECT().CreateDatasetExportScheduleRun(mock.Anything, mock.Anything).Return(storemodels.DatasetExportScheduleRun{ID: uuid.New()}, nil)
+			}
+
+			service := &datasetService{datasetStore: mockStore, datasetActionService: datasetactionservice.NewDatasetActionService(mockStore)}
+			plan, err := service.StartDatasetExportScheduleRunActivity(context.Background(), params, "run-workflow")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBucket, plan.DestinationConfig.Bucket)
+			assert.Equal(t, "payouts", plan.DestinationConfig.Prefix)
+		})
+	}
+}
+
+func TestCompleteDatasetExportScheduleRunActivity(t *testing.T) {
+	t.Parallel()
+
+	runId := uuid.New()
+	plan := models.DatasetExportScheduleRunPlan{RunId: runId, WorkflowId: "run-workflow"}
+	params := models.DatasetExportScheduleWorkflowParams{OwnerId: uuid.New(), OrganizationId: uuid.New()}
+
+	t.Run("successful run", func(t *testing.T) {
+		t.Parallel()
+
+		mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+		mockStore.EXPECT().UpdateDatasetExportScheduleRun(mock.Anything, runId, storemodels.UpdateDatasetExportScheduleRunParams{
+			Status: datasetConstants.DatasetExportScheduleRunStatusSuccessful,
+		}).Return(nil)
+
+		service := &datasetService{datasetStore: mockStore, datasetActionService: datasetactionservice.NewDatasetActionService(mockStore)}
+		assert.NoError(t, service.CompleteDatasetExportScheduleRunActivity(context.Background(), params, plan, ""))
+	})
+
+	t.Run("failed run also fails the export action", func(t *testing.T) {
+		t.Parallel()
+
+		mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+		mockStore.EXPECT().UpdateDatasetActionStatus(mock.Anything, "run-workflow", "FAILED").Return(nil)
+		mockStore.EXPECT().UpdateDatasetExportScheduleRun(mock.Anything, runId, storemodels.UpdateDatasetExportScheduleRunParams{
+			Status: datasetConstants.DatasetExportScheduleRunStatusFailed,
+			Error:  "failed to get dataset data",
+		}).Return(nil)
+
+		service := &datasetService{datasetStore: mockStore, datasetActionService: datasetactionservice.NewDatasetActionService(mockStore)}
+		assert.NoError(t, service.CompleteDatasetExportScheduleRunActivity(context.Background(), params, plan, "failed to get dataset data"))
+	})
+}
+
+func TestCreateDatasetAlert(t *testing.T) {
+	t.Parallel()
+
+	merchantId := uuid.New()
+	userId := uuid.New()
+	datasetId := uuid.New()
+	alertId := uuid.New()
+
+	params := models.DatasetAlertParams{
+		Name: "Unreconciled amount",
+		Metric: models.DatasetAlertMetric{
+			Column:     "amount",
+			Function:   "SUM",
+			TimeColumn: "created_at",
+			Period:     datasetConstants.DatasetAlertPeriodDay,
+		},
+		Condition: models.DatasetAlertCondition{
+			Type:     datasetConstants.DatasetAlertConditionPercentChange,
+			Operator: "gt",
+			Value:    25,
+		},
+		CronExpression: "0 * * * *",
+		Timezone:       "Asia/Kolkata",
+		WebhookUrl:     "https://hooks.example.com/alerts",
+	}
+
+	tests := []struct {
+		name      string
+		params    models.DatasetAlertParams
+		mockSetup func(*mockDatasetService.MockDatasetServiceStore, *mock_temporal.MockTemporalService)
+		wantErr   error
+	}{
+		{
+			name:   "creates the alert and its temporal schedule",
+			params: params,
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, mt *mock_temporal.MockTemporalService) {
+				m.EXPECT().WithDatasetAlertTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(store.DatasetAlertStore) error) error {
+						return fn(m)
+					})
+				m.EXPECT().CreateDatasetAlert(mock.Anything, mock.MatchedBy(func(p storemodels.CreateDatasetAlertParams) bool {
+					return p.OwnerId == userId && p.WebhookUrl == params.WebhookUrl && strings.HasPrefix(p.TemporalScheduleId, "dataset-alert-")
+				})).RunAndReturn(func(ctx context.Context, p storemodels.CreateDatasetAlertParams) (storemodels.DatasetAlert, error) {
+					return storemodels.DatasetAlert{
+						ID:                 alertId,
+						OrganizationId:     merchantId,
+						DatasetId:          datasetId,
+						OwnerId:            userId,
+						Name:               p.Name,
+						Metric:             json.RawMessage(`{"column":"amount","function":"SUM","time_column":"created_at","period":"day"}`),
+						Condition:          json.RawMessage(`{"type":"percent_change","operator":"gt","value":25}`),
+						CronExpression:     p.CronExpression,
+						Timezone:           p.Timezone,
+						Recipients:         json.RawMessage(`[]`),
+						WebhookUrl:         p.WebhookUrl,
+						TemporalScheduleId: p.TemporalScheduleId,
+						IsEnabled:          true,
+						State:              storemodels.DatasetAlertStateOk,
+					}, nil
+				})
+				mt.EXPECT().ExecuteScheduledWorkflow(mock.Anything, mock.MatchedBy(func(p temporalmodels.ExecuteWorkflowWithScheduleParams) bool {
+					action := p.ScheduleOptions.Action.(*temporalclient.ScheduleWorkflowAction)
+					workflowParams := action.Args[0].(models.DatasetAlertWorkflowParams)
+					return p.ScheduleOptions.Spec.CronExpressions[0] == "0 * * * *" &&
+						p.ScheduleOptions.Spec.TimeZoneName == "Asia/Kolkata" &&
+						action.ID == "dataset-alert-evaluation-"+alertId.String() &&
+						workflowParams.AlertId == alertId &&
+						workflowParams.TemporalScheduleId == p.ScheduleOptions.ID
+				})).Return(temporalmodels.ScheduledWorkflowResponse{}, nil)
+			},
+		},
+		{
+			name:   "temporal failure rolls back the alert",
+			params: params,
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, mt *mock_temporal.MockTemporalService) {
+				m.EXPECT().WithDatasetAlertTransaction(mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(store.DatasetAlertStore) error) error {
+						return fn(m)
+					})
+				m.EXPECT().CreateDatasetAlert(mock.Anything, mock.Anything).Return(storemodels.DatasetAlert{ID: alertId}, nil)
+				mt.EXPECT().ExecuteScheduledWorkflow(mock.Anything, mock.Anything).
+					Return(temporalmodels.ScheduledWorkflowResponse{}, errors.New("temporal unavailable"))
+			},
+			wantErr: errors.New("temporal unavailable"),
+		},
+		{
+			name: "percent change needs a period",
+			params: models.DatasetAlertParams{
+				Name:           "Unreconciled amount",
+				Metric:         models.DatasetAlertMetric{Column: "amount", Function: "SUM"},
+				Condition:      params.Condition,
+				CronExpression: "0 * * * *",
+				WebhookUrl:     params.WebhookUrl,
+			},
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, mt *mock_temporal.MockTemporalService) {},
+			wantErr:   datasetErrors.ErrInvalidAlertCondition,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			mockTemporalService := mock_temporal.NewMockTemporalService(t)
+			tt.mockSetup(mockStore, mockTemporalService)
+
+			service := &datasetService{datasetStore: mockStore, temporalService: mockTemporalService}
+			alert, err := service.CreateDatasetAlert(context.Background(), merchantId, userId, datasetId, tt.params)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, alertId, alert.ID)
+			assert.Equal(t, datasetConstants.DatasetAlertPeriodDay, alert.Metric.Period)
+			assert.Equal(t, datasetConstants.DatasetAlertConditionPercentChange, alert.Condition.Type)
+		})
+	}
+}
+
+func TestSnoozeDatasetAlert(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New()
+	alertId := uuid.New()
+	ownerId := uuid.New()
+
+	storeAlert := storemodels.DatasetAlert{
+		ID:         alertId,
+		DatasetId:  datasetId,
+		OwnerId:    ownerId,
+		Metric:     json.RawMessage(`{"column":"amount","function":"SUM"}`),
+		Condition:  json.RawMessage(`{"type":"threshold","operator":"gt","value":100}`),
+		Recipients: json.RawMessage(`["finance@example.com"]`),
+	}
+
+	tests := []struct {
+		name      string
+		userId    uuid.UUID
+		until     time.Time
+		mockSetup func(*mockDatasetService.MockDatasetServiceStore, time.Time)
+		wantErr   error
+	}{
+		{
+			name:   "owner snoozes the alert",
+			userId: ownerId,
+			until:  time.Now().Add(24 * time.Hour),
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, until time.Time) {
+				m.EXPECT().GetDatasetAlertById(mock.Anything, alertId).Return(storeAlert, nil)
+				m.EXPECT().UpdateDatasetAlertSnooze(mock.Anything, alertId, mock.MatchedBy(func(t *time.Time) bool {
+					return t != nil && t.Equal(until)
+				}), ownerId).RunAndReturn(func(ctx context.Context, id uuid.UUID, snoozedUntil *time.Time, updatedBy uuid.UUID) (storemodels.DatasetAlert, error) {
+					snoozed := storeAlert
+					snoozed.SnoozedUntil = snoozedUntil
+					return snoozed, nil
+				})
+			},
+		},
+		{
+			name:   "viewers cannot snooze alerts they do not own",
+			userId: uuid.New(),
+			until:  time.Now().Add(time.Hour),
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, until time.Time) {
+				m.EXPECT().GetDatasetAlertById(mock.Anything, alertId).Return(storeAlert, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]storemodels.FlattenedResourceAudiencePolicy{}, nil)
+			},
+			wantErr: datasetErrors.ErrDatasetAlertAccessForbidden,
+		},
+		{
+			name:      "snooze in the past",
+			userId:    ownerId,
+			until:     time.Now().Add(-time.Minute),
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, until time.Time) {},
+			wantErr:   datasetErrors.ErrInvalidAlertSnooze,
+		},
+		{
+			name:      "snooze longer than the maximum",
+			userId:    ownerId,
+			until:     time.Now().Add(datasetConstants.DatasetAlertMaxSnooze + time.Hour),
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore, until time.Time) {},
+			wantErr:   datasetErrors.ErrInvalidAlertSnooze,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			tt.mockSetup(mockStore, tt.until)
+
+			service := &datasetService{datasetStore: mockStore}
+			alert, err := service.SnoozeDatasetAlert(context.Background(), tt.userId, datasetId, alertId, tt.until)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, alert.IsSnoozed(time.Now()))
+		})
+	}
+}
+
+func TestEvaluateDatasetAlertActivity(t *testing.T) {
+	t.Parallel()
+
+	datasetId := uuid.New()
+	alertId := uuid.New()
+	ownerId := uuid.New()
+	orgId := uuid.New()
+
+	params := models.DatasetAlertWorkflowParams{
+		AlertId:            alertId,
+		TemporalScheduleId: "dataset-alert-current",
+		OrganizationId:     orgId,
+		OwnerId:            ownerId,
+	}
+
+	storeAlert := storemodels.DatasetAlert{
+		ID:                 alertId,
+		DatasetId:          datasetId,
+		OwnerId:            ownerId,
+		Name:               "Unreconciled amount",
+		Metric:             json.RawMessage(`{"column":"amount","function":"SUM"}`),
+		Condition:          json.RawMessage(`{"type":"threshold","operator":"gt","value":100}`),
+		Recipients:         json.RawMessage(`["finance@example.com"]`),
+		TemporalScheduleId: "dataset-alert-current",
+		IsEnabled:          true,
+		State:              storemodels.DatasetAlertStateFiring,
+	}
+
+	disabledAlert := storeAlert
+	disabledAlert.IsEnabled = false
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mockDatasetService.MockDatasetServiceStore)
+		wantSkip  bool
+		wantErr   bool
+	}{
+		{
+			name: "skips deleted alerts",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDatasetAlertById(mock.Anything, alertId).Return(storemodels.DatasetAlert{}, gorm.ErrRecordNotFound)
+			},
+			wantSkip: true,
+		},
+		{
+			name: "skips disabled alerts",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDatasetAlertById(mock.Anything, alertId).Return(disabledAlert, nil)
+			},
+			wantSkip: true,
+		},
+		{
+			name: "skips evaluations started by a replaced schedule",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				rescheduled := storeAlert
+				rescheduled.TemporalScheduleId = "dataset-alert-new"
+				m.EXPECT().GetDatasetAlertById(mock.Anything, alertId).Return(rescheduled, nil)
+			},
+			wantSkip: true,
+		},
+		{
+			name: "records the error and keeps the state when the metric cannot be evaluated",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDatasetAlertById(mock.Anything, alertId).Return(storeAlert, nil)
+				m.EXPECT().GetDatasetById(mock.Anything, datasetId.String()).Return(&storemodels.Dataset{Title: "Payouts"}, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+				m.EXPECT().UpdateDatasetAlertState(mock.Anything, alertId, mock.MatchedBy(func(p storemodels.UpdateDatasetAlertStateParams) bool {
+					return p.State == storemodels.DatasetAlertStateFiring && p.LastValue == nil && p.LastError != ""
+				})).Return(nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			tt.mockSetup(mockStore)
+
+			service := &datasetService{datasetStore: mockStore}
+			evaluation, err := service.EvaluateDatasetAlertActivity(context.Background(), params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSkip, evaluation.Skip)
+		})
+	}
+}
+
+func TestNotifyPendingDatasetAlertEvent(t *testing.T) {
+	t.Parallel()
+
+	alertId := uuid.New()
+	eventId := uuid.New()
+	stateParams := storemodels.UpdateDatasetAlertStateParams{State: storemodels.DatasetAlertStateFiring, LastEvaluatedAt: time.Now()}
+
+	tests := []struct {
+		name       string
+		events     []storemodels.DatasetAlertEvent
+		marked     bool
+		wantNotify bool
+	}{
+		{
+			name:       "notifies the event of an alert that went firing while snoozed",
+			events:     []storemodels.DatasetAlertEvent{{ID: eventId, Status: storemodels.DatasetAlertEventStatusFiring}},
+			marked:     true,
+			wantNotify: true,
+		},
+		{
+			name:   "does not notify an event another evaluation notified",
+			events: []storemodels.DatasetAlertEvent{{ID: eventId, Status: storemodels.DatasetAlertEventStatusFiring}},
+		},
+		{
+			name:   "does not notify a notified event again",
+			events: []storemodels.DatasetAlertEvent{{ID: eventId, Status: storemodels.DatasetAlertEventStatusFiring, Notified: true}},
+		},
+		{
+			name: "does not notify without events",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			mockStore.EXPECT().GetDatasetAlertEvents(mock.Anything, alertId, 1).Return(tt.events, nil)
+			mockStore.EXPECT().WithDatasetAlertTransaction(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, fn func(store.DatasetAlertStore) error) error {
+					return fn(mockStore)
+				})
+			if len(tt.events) > 0 && !tt.events[0].Notified {
+				mockStore.EXPECT().MarkDatasetAlertEventNotified(mock.Anything, eventId).Return(tt.marked, nil)
+			}
+			mockStore.EXPECT().UpdateDatasetAlertState(mock.Anything, alertId, stateParams).Return(nil)
+
+			service := &datasetService{datasetStore: mockStore}
+			evaluation, err := service.notifyPendingDatasetAlertEvent(context.Background(), models.DatasetAlertEvaluation{
+				AlertId: alertId,
+				State:   storemodels.DatasetAlertStateFiring,
+			}, stateParams)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantNotify, evaluation.Notify)
+			if tt.wantNotify {
+				assert.Equal(t, eventId, evaluation.EventId)
+				assert.Equal(t, storemodels.DatasetAlertEventStatusFiring, evaluation.EventStatus)
+			} else {
+				assert.Equal(t, uuid.Nil, evaluation.EventId)
+			}
+		})
+	}
+}
+
+func TestEnforceColumnRestrictionsWithoutRestrictions(t *testing.T) {
+	t.Parallel()
+
+	// users without restrictions keep querying with expressions
+	masks, err := (&datasetService{}).enforceColumnRestrictions(models.DatasetParams{
+		GroupBy: []models.GroupBy{{Column: "date_trunc('month', posted_at)"}},
+	}, nil, map[string]bool{"posted_at": true})
+
+	assert.NoError(t, err)
+	assert.Empty(t, masks)
+}
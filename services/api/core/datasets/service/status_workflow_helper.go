@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+)
+
+func (s *datasetService) getDatasetStatusWorkflow(ctx context.Context, datasetId uuid.UUID, workflowId uuid.UUID) (storemodels.DatasetStatusWorkflow, error) {
+	workflow, err := s.datasetStore.GetDatasetStatusWorkflowById(ctx, workflowId)
+	if err != nil {
+		return storemodels.DatasetStatusWorkflow{}, errors.ErrStatusWorkflowNotFound
+	}
+
+	if workflow.DatasetId != datasetId {
+		return storemodels.DatasetStatusWorkflow{}, errors.ErrStatusWorkflowNotFound
+	}
+
+	return workflow, nil
+}
+
+// getStatusWorkflows returns the workflows of a dataset keyed by the status column they govern
+func (s *datasetService) getStatusWorkflows(ctx context.Context, datasetId uuid.UUID) (map[string]models.DatasetStatusWorkflow, error) {
+	storeWorkflows, err := s.datasetStore.GetDatasetStatusWorkflows(ctx, datasetId)
+	if err != nil {
+		return nil, errors.ErrFailedToGetStatusWorkflows
+	}
+
+	workflows := make(map[string]models.DatasetStatusWorkflow, len(storeWorkflows))
+	for _, storeWorkflow := range storeWorkflows {
+		workflow := models.DatasetStatusWorkflow{}
+		if err := workflow.FromSchema(storeWorkflow); err != nil {
+			return nil, errors.ErrFailedToGetStatusWorkflows
+		}
+		workflows[workflow.Column] = workflow
+	}
+
+	return workflows, nil
+}
+
+// validateStatusWorkflowParams checks that the workflow is a well formed state machine over an existing column.
+// Transitions without a privilege or teams are open to every user of the dataset.
+func (s *datasetService) validateStatusWorkflowParams(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params models.DatasetStatusWorkflowParams) (models.DatasetStatusWorkflowParams, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	datasetInfo, err := s.dataplatformService.GetDatasetMetadata(ctx, merchantId.String(), datasetId.String())
+	if err != nil {
+		logger.Error("failed to get dataset metadata", zap.String("error", err.Error()))
+		return models.DatasetStatusWorkflowParams{}, errors.ErrFailedToGetDatasetMetadata
+	}
+
+	if _, ok := datasetInfo.Schema[params.Column]; !ok {
+		return models.DatasetStatusWorkflowParams{}, fmt.Errorf("%w: %s", errors.ErrInvalidStatusWorkflowColumn, params.Column)
+	}
+
+	if err := s.validateStatusWorkflowTeams(ctx, merchantId, params.Transitions); err != nil {
+		return models.DatasetStatusWorkflowParams{}, err
+	}
+
+	return validateStatusWorkflowDefinition(params)
+}
+
+// validateStatusWorkflowTeams checks that the teams transitions are limited to are teams of the organization
+func (s *datasetService) validateStatusWorkflowTeams(ctx context.Context, merchantId uuid.UUID, transitions []models.StatusWorkflowTransition) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if !slices.ContainsFunc(transitions, func(transition models.StatusWorkflowTransition) bool { return len(transition.TeamIds) > 0 }) {
+		return nil
+	}
+
+	teams, err := s.datasetStore.GetTeams(ctx, merchantId)
+	if err != nil {
+		logger.Error("failed to get organization teams", zap.String("error", err.Error()))
+		return err
+	}
+
+	teamIds := make(map[uuid.UUID]bool, len(teams))
+	for _, team := range teams {
+		teamIds[team.TeamID] = true
+	}
+
+	for _, transition := range transitions {
+		for _, teamId := range transition.TeamIds {
+			if !teamIds[teamId] {
+				return fmt.Errorf("%w: unknown team %s", errors.ErrInvalidStatusWorkflowTransitions, teamId)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateStatusWorkflowDefinition(params models.DatasetStatusWorkflowParams) (models.DatasetStatusWorkflowParams, error) {
+	if len(params.States) == 0 {
+		return models.DatasetStatusWorkflowParams{}, errors.ErrInvalidStatusWorkflowStates
+	}
+
+	stateNames := make([]string, 0, len(params.States))
+	for i, state := range params.States {
+		params.States[i].Name = strings.TrimSpace(state.Name)
+		if params.States[i].Name == "" || slices.Contains(stateNames, params.States[i].Name) {
+			return models.DatasetStatusWorkflowParams{}, fmt.Errorf("%w: %q", errors.ErrInvalidStatusWorkflowStates, state.Name)
+		}
+		stateNames = append(stateNames, params.States[i].Name)
+	}
+
+	if !slices.Contains(stateNames, params.InitialState) {
+		return models.DatasetStatusWorkflowParams{}, fmt.Errorf("%w: unknown initial state %q", errors.ErrInvalidStatusWorkflowStates, params.InitialState)
+	}
+
+	seen := map[[2]string]bool{}
+	for i, transition := range params.Transitions {
+		if !slices.Contains(stateNames, transition.From) || !slices.Contains(stateNames, transition.To) || transition.From == transition.To {
+			return models.DatasetStatusWorkflowParams{}, fmt.Errorf("%w: %s -> %s", errors.ErrInvalidStatusWorkflowTransitions, transition.From, transition.To)
+		}
+
+		key := [2]string{transition.From, transition.To}
+		if seen[key] {
+			return models.DatasetStatusWorkflowParams{}, fmt.Errorf("%w: duplicate %s -> %s", errors.ErrInvalidStatusWorkflowTransitions, transition.From, transition.To)
+		}
+		seen[key] = true
+
+		if transition.Privilege == "" {
+			params.Transitions[i].Privilege = storemodels.PrivilegeDatasetViewer
+		} else if !slices.Contains(storemodels.DatasetPrivileges, transition.Privilege) {
+			return models.DatasetStatusWorkflowParams{}, fmt.Errorf("%w: invalid privilege %q", errors.ErrInvalidStatusWorkflowTransitions, transition.Privilege)
+		}
+	}
+
+	return params, nil
+}
+
+// enforceStatusWorkflow rejects updates of a status column moving rows along transitions the workflow does not allow
+// or that the user lacks the privilege or team for. Rules only get the target state checked, their filters are applied to
+// rows yet to arrive.
+func (s *datasetService) enforceStatusWorkflow(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, workflow models.DatasetStatusWorkflow, params models.UpdateDatasetDataParams) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	target, ok := params.Update.Value.(string)
+	if !ok {
+		return errors.ErrInvalidStatusValue
+	}
+	if _, ok := workflow.GetState(target); !ok {
+		return fmt.Errorf("%w: %q", errors.ErrInvalidStatusValue, target)
+	}
+
+	if params.SourceType != datasetConstants.UpdateColumnSourceTypeUser {
+		return nil
+	}
+
+	// the update is not restricted by row policies, so neither is the lookup of the states it moves rows out of
+	data, err := s.getDataByDatasetId(ctx, merchantId, datasetId.String(), models.DatasetParams{
+		Columns: []models.ColumnConfig{{Column: workflow.Column}},
+		Filters: params.Filters,
+		GroupBy: []models.GroupBy{{Column: workflow.Column}},
+	}, nil, nil)
+	if err != nil {
+		logger.Error("failed to get current states", zap.String("column", workflow.Column), zap.String("error", err.Error()))
+		return err
+	}
+
+	restricted := []models.StatusWorkflowTransition{}
+	for _, row := range data.Rows {
+		current := getRowState(workflow, row)
+		if current == target {
+			continue
+		}
+
+		transition, ok := workflow.GetTransition(current, target)
+		if !ok {
+			return fmt.Errorf("%w: %s -> %s", errors.ErrInvalidStatusTransition, current, target)
+		}
+		if transition.Privilege == storemodels.PrivilegeDatasetAdmin || len(transition.TeamIds) > 0 {
+			restricted = append(restricted, transition)
+		}
+	}
+
+	if len(restricted) == 0 {
+		return nil
+	}
+
+	policies, err := s.datasetStore.GetFlattenedResourceAudiencePolicies(ctx, storemodels.FlattenedResourceAudiencePoliciesFilters{
+		ResourceIds:   []uuid.UUID{datasetId},
+		UserIds:       []uuid.UUID{params.UserId},
+		ResourceTypes: []string{string(storemodels.ResourceTypeDataset)},
+	})
+	if err != nil {
+		logger.Error("failed to get dataset policies of the user", zap.String("error", err.Error()))
+		return err
+	}
+
+	return checkStatusTransitionsAccess(restricted, policies)
+}
+
+// checkStatusTransitionsAccess checks the user holds, through the policies sharing the dataset with them, the
+// privilege of each transition and, for the transitions limited to teams, one of the teams
+func checkStatusTransitionsAccess(transitions []models.StatusWorkflowTransition, policies []storemodels.FlattenedResourceAudiencePolicy) error {
+	isAdmin := false
+	teamIds := map[uuid.UUID]bool{}
+	for _, policy := range policies {
+		if policy.Privilege == storemodels.PrivilegeDatasetAdmin {
+			isAdmin = true
+		}
+		if policy.ResourceAudienceType == string(storemodels.AudienceTypeTeam) {
+			teamIds[policy.ResourceAudienceId] = true
+		}
+	}
+
+	for _, transition := range transitions {
+		if transition.Privilege == storemodels.PrivilegeDatasetAdmin && !isAdmin {
+			return errors.ErrStatusTransitionForbidden
+		}
+		if len(transition.TeamIds) > 0 && !slices.ContainsFunc(transition.TeamIds, func(teamId uuid.UUID) bool { return teamIds[teamId] }) {
+			return fmt.Errorf("%w: %s -> %s is limited to other teams", errors.ErrStatusTransitionForbidden, transition.From, transition.To)
+		}
+	}
+
+	return nil
+}
+
+// getRowState returns the state of the status column of the row, rows that were never moved are in the initial state
+func getRowState(workflow models.DatasetStatusWorkflow, row map[string]interface{}) string {
+	value, ok := row[workflow.Column].(string)
+	if !ok || value == "" {
+		return workflow.InitialState
+	}
+	return value
+}
+
+// ensureDatasetRowExists checks that the row is readable by the user in context
+func (s *datasetService) ensureDatasetRowExists(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, rowId string) error {
+	data, err := s.GetDataByDatasetId(ctx, merchantId, datasetId.String(), models.DatasetParams{
+		Columns: []models.ColumnConfig{{Column: datasetConstants.ZampIDColumn}},
+		Filters: getRowFilter(rowId),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(data.Rows) == 0 {
+		return errors.ErrDatasetRowNotFound
+	}
+
+	return nil
+}
+
+func getRowFilter(rowId string) models.FilterModel {
+	return models.FilterModel{
+		LogicalOperator: models.LogicalOperator(querybuilderconstants.LogicalOperatorAnd),
+		Conditions: []models.Filter{
+			{Column: datasetConstants.ZampIDColumn, Operator: querybuilderconstants.EqualOperator, Value: rowId},
+		},
+	}
+}
+
+func (s *datasetService) ensureDatasetAssignee(ctx context.Context, datasetId uuid.UUID, assigneeId uuid.UUID) error {
+	policies, err := s.datasetStore.GetFlattenedResourceAudiencePolicies(ctx, storemodels.FlattenedResourceAudiencePoliciesFilters{
+		ResourceIds:   []uuid.UUID{datasetId},
+		UserIds:       []uuid.UUID{assigneeId},
+		ResourceTypes: []string{string(storemodels.ResourceTypeDataset)},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(policies) == 0 {
+		return errors.ErrInvalidRowAssignee
+	}
+
+	return nil
+}
+
+// getDatasetQueueItems reads the assigned rows of a dataset, leaving out the ones the user can no longer read and
+// the ones all status columns of which reached a final state
+func (s *datasetService) getDatasetQueueItems(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, assignments []models.DatasetRowAssignment) (map[string]models.DatasetQueueItem, error) {
+	dataset, err := s.datasetStore.GetDatasetById(ctx, datasetId.String())
+	if err != nil {
+		return nil, errors.ErrFailedToGetDatasetById
+	}
+
+	workflows, err := s.getStatusWorkflows(ctx, datasetId)
+	if err != nil {
+		return nil, err
+	}
+
+	rowIds := make([]string, len(assignments))
+	for i, assignment := range assignments {
+		rowIds[i] = assignment.RowId
+	}
+
+	data, err := s.GetDataByDatasetId(ctx, merchantId, datasetId.String(), models.DatasetParams{
+		Filters: getRowIdsFilter(rowIds),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make(map[string]map[string]interface{}, len(data.Rows))
+	for _, row := range data.Rows {
+		if rowId, ok := row[datasetConstants.ZampIDColumn].(string); ok {
+			rows[rowId] = row
+		}
+	}
+
+	items := make(map[string]models.DatasetQueueItem, len(assignments))
+	for _, assignment := range assignments {
+		row, ok := rows[assignment.RowId]
+		if !ok {
+			continue
+		}
+
+		statuses := make(map[string]string, len(workflows))
+		isDone := len(workflows) > 0
+		for column, workflow := range workflows {
+			statuses[column] = getRowState(workflow, row)
+			if state, ok := workflow.GetState(statuses[column]); !ok || !state.IsFinal {
+				isDone = false
+			}
+		}
+		if isDone {
+			continue
+		}
+
+		items[assignment.RowId] = models.DatasetQueueItem{
+			Assignment:   assignment,
+			DatasetTitle: dataset.Title,
+			Statuses:     statuses,
+			Row:          row,
+		}
+	}
+
+	return items, nil
+}
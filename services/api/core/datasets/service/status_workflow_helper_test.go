@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	datasetErrors "github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mockDatasetService "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
+	"gorm.io/gorm"
+)
+
+func testStatusWorkflow() models.DatasetStatusWorkflow {
+	return models.DatasetStatusWorkflow{
+		Column:       "status",
+		InitialState: "open",
+		States: []models.StatusWorkflowState{
+			{Name: "open"},
+			{Name: "in_review"},
+			{Name: "resolved", IsFinal: true},
+		},
+		Transitions: []models.StatusWorkflowTransition{
+			{From: "open", To: "in_review", Privilege: storemodels.PrivilegeDatasetViewer},
+			{From: "in_review", To: "resolved", Privilege: storemodels.PrivilegeDatasetAdmin},
+		},
+	}
+}
+
+func TestValidateStatusWorkflowDefinition(t *testing.T) {
+	valid := func() models.DatasetStatusWorkflowParams {
+		workflow := testStatusWorkflow()
+		return models.DatasetStatusWorkflowParams{
+			Column:       workflow.Column,
+			InitialState: workflow.InitialState,
+			States:       workflow.States,
+			Transitions: []models.StatusWorkflowTransition{
+				{From: "open", To: "in_review"},
+				{From: "in_review", To: "resolved", Privilege: storemodels.PrivilegeDatasetAdmin},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*models.DatasetStatusWorkflowParams)
+		wantErr error
+	}{
+		{
+			name:   "Valid workflow",
+			modify: func(p *models.DatasetStatusWorkflowParams) {},
+		},
+		{
+			name:    "No states",
+			modify:  func(p *models.DatasetStatusWorkflowParams) { p.States = nil },
+			wantErr: datasetErrors.ErrInvalidStatusWorkflowStates,
+		},
+		{
+			name: "Duplicate state",
+			modify: func(p *models.DatasetStatusWorkflowParams) {
+				p.States = append(p.States, models.StatusWorkflowState{Name: " open "})
+			},
+			wantErr: datasetErrors.ErrInvalidStatusWorkflowStates,
+		},
+		{
+			name:    "Unknown initial state",
+			modify:  func(p *models.DatasetStatusWorkflowParams) { p.InitialState = "new" },
+			wantErr: datasetErrors.ErrInvalidStatusWorkflowStates,
+		},
+		{
+			name: "Transition to an unknown state",
+			modify: func(p *models.DatasetStatusWorkflowParams) {
+				p.Transitions = append(p.Transitions, models.StatusWorkflowTransition{From: "open", To: "closed"})
+			},
+			wantErr: datasetErrors.ErrInvalidStatusWorkflowTransitions,
+		},
+		{
+			name: "Transition to the same state",
+			modify: func(p *models.DatasetStatusWorkflowParams) {
+				p.Transitions = append(p.Transitions, models.StatusWorkflowTransition{From: "open", To: "open"})
+			},
+			wantErr: datasetErrors.ErrInvalidStatusWorkflowTransitions,
+		},
+		{
+			name: "Duplicate transition",
+			modify: func(p *models.DatasetStatusWorkflowParams) {
+				p.Transitions = append(p.Transitions, models.StatusWorkflowTransition{From: "open", To: "in_review"})
+			},
+			wantErr: datasetErrors.ErrInvalidStatusWorkflowTransitions,
+		},
+		{
+			name: "Unknown privilege",
+			modify: func(p *models.DatasetStatusWorkflowParams) {
+				p.Transitions[0].Privilege = "owner"
+			},
+			wantErr: datasetErrors.ErrInvalidStatusWorkflowTransitions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := valid()
+			tt.modify(&params)
+
+			got, err := validateStatusWorkflowDefinition(params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, storemodels.PrivilegeDatasetViewer, got.Transitions[0].Privilege)
+			assert.Equal(t, storemodels.PrivilegeDatasetAdmin, got.Transitions[1].Privilege)
+		})
+	}
+}
+
+func TestGetRowState(t *testing.T) {
+	workflow := testStatusWorkflow()
+
+	assert.Equal(t, "in_review", getRowState(workflow, map[string]interface{}{"status": "in_review"}))
+	assert.Equal(t, "open", getRowState(workflow, map[string]interface{}{"status": nil}))
+	assert.Equal(t, "open", getRowState(workflow, map[string]interface{}{"status": ""}))
+	assert.Equal(t, "open", getRowState(workflow, map[string]interface{}{}))
+}
+
+func TestEnforceStatusWorkflow_TargetState(t *testing.T) {
+	s := &datasetService{}
+	workflow := testStatusWorkflow()
+
+	tests := []struct {
+		name       string
+		value      interface{}
+		sourceType datasetConstants.UpdateColumnSourceType
+		wantErr    error
+	}{
+		{
+			name:       "Rejects values that are not states",
+			value:      "closed",
+			sourceType: datasetConstants.UpdateColumnSourceTypeUser,
+			wantErr:    datasetErrors.ErrInvalidStatusValue,
+		},
+		{
+			name:       "Rejects non string values",
+			value:      1,
+			sourceType: datasetConstants.UpdateColumnSourceTypeUser,
+			wantErr:    datasetErrors.ErrInvalidStatusValue,
+		},
+		{
+			name:       "Rules only need a valid target state",
+			value:      "resolved",
+			sourceType: datasetConstants.UpdateColumnSourceTypeRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.enforceStatusWorkflow(context.Background(), uuid.New(), uuid.New(), workflow, models.UpdateDatasetDataParams{
+				Update:     models.UpdateColumn{Column: "status", Value: tt.value},
+				SourceType: tt.sourceType,
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckStatusTransitionsAccess(t *testing.T) {
+	approversId := uuid.New()
+	reviewersId := uuid.New()
+
+	adminTransition := models.StatusWorkflowTransition{From: "in_review", To: "resolved", Privilege: storemodels.PrivilegeDatasetAdmin}
+	teamTransition := models.StatusWorkflowTransition{From: "open", To: "in_review", Privilege: storemodels.PrivilegeDatasetViewer, TeamIds: []uuid.UUID{approversId}}
+
+	viewerThrough := func(audienceType storemodels.AudienceType, audienceId uuid.UUID) storemodels.FlattenedResourceAudiencePolicy {
+		return storemodels.FlattenedResourceAudiencePolicy{ResourceAudienceType: string(audienceType), ResourceAudienceId: audienceId, Privilege: storemodels.PrivilegeDatasetViewer}
+	}
+
+	tests := []struct {
+		name        string
+		transitions []models.StatusWorkflowTransition
+		policies    []storemodels.FlattenedResourceAudiencePolicy
+		wantErr     error
+	}{
+		{
+			name:        "Admin transition by an admin",
+			transitions: []models.StatusWorkflowTransition{adminTransition},
+			policies:    []storemodels.FlattenedResourceAudiencePolicy{{ResourceAudienceType: string(storemodels.AudienceTypeUser), Privilege: storemodels.PrivilegeDatasetAdmin}},
+		},
+		{
+			name:        "Admin transition by a viewer",
+			transitions: []models.StatusWorkflowTransition{adminTransition},
+			policies:    []storemodels.FlattenedResourceAudiencePolicy{viewerThrough(storemodels.AudienceTypeOrganization, uuid.New())},
+			wantErr:     datasetErrors.ErrStatusTransitionForbidden,
+		},
+		{
+			name:        "Team transition by a member of the team",
+			transitions: []models.StatusWorkflowTransition{teamTransition},
+			policies:    []storemodels.FlattenedResourceAudiencePolicy{viewerThrough(storemodels.AudienceTypeOrganization, uuid.New()), viewerThrough(storemodels.AudienceTypeTeam, approversId)},
+		},
+		{
+			name:        "Team transition by a member of another team",
+			transitions: []models.StatusWorkflowTransition{teamTransition},
+			policies:    []storemodels.FlattenedResourceAudiencePolicy{viewerThrough(storemodels.AudienceTypeTeam, reviewersId)},
+			wantErr:     datasetErrors.ErrStatusTransitionForbidden,
+		},
+		{
+			// admins are not members of the teams a transition is limited to by being admins
+			name:        "Team transition by an admin outside the team",
+			transitions: []models.StatusWorkflowTransition{teamTransition},
+			policies:    []storemodels.FlattenedResourceAudiencePolicy{{ResourceAudienceType: string(storemodels.AudienceTypeUser), Privilege: storemodels.PrivilegeDatasetAdmin}},
+			wantErr:     datasetErrors.ErrStatusTransitionForbidden,
+		},
+		{
+			name:        "Every transition of the update is checked",
+			transitions: []models.StatusWorkflowTransition{teamTransition, adminTransition},
+			policies:    []storemodels.FlattenedResourceAudiencePolicy{viewerThrough(storemodels.AudienceTypeTeam, approversId)},
+			wantErr:     datasetErrors.ErrStatusTransitionForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkStatusTransitionsAccess(tt.transitions, tt.policies)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateStatusWorkflowTeams(t *testing.T) {
+	merchantId := uuid.New()
+	teamId := uuid.New()
+
+	tests := []struct {
+		name        string
+		transitions []models.StatusWorkflowTransition
+		mockSetup   func(*mockDatasetService.MockDatasetServiceStore)
+		wantErr     error
+	}{
+		{
+			name:        "Transitions without teams",
+			transitions: []models.StatusWorkflowTransition{{From: "open", To: "in_review"}},
+			mockSetup:   func(m *mockDatasetService.MockDatasetServiceStore) {},
+		},
+		{
+			name:        "Team of the organization",
+			transitions: []models.StatusWorkflowTransition{{From: "open", To: "in_review", TeamIds: []uuid.UUID{teamId}}},
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetTeams(mock.Anything, merchantId).Return([]storemodels.Team{{TeamID: teamId}}, nil)
+			},
+		},
+		{
+			name:        "Unknown team",
+			transitions: []models.StatusWorkflowTransition{{From: "open", To: "in_review", TeamIds: []uuid.UUID{uuid.New()}}},
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetTeams(mock.Anything, merchantId).Return([]storemodels.Team{{TeamID: teamId}}, nil)
+			},
+			wantErr: datasetErrors.ErrInvalidStatusWorkflowTransitions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			tt.mockSetup(mockStore)
+
+			s := &datasetService{datasetStore: mockStore}
+			err := s.validateStatusWorkflowTeams(context.Background(), merchantId, tt.transitions)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetMyQueueSkipsUnreadableDatasets(t *testing.T) {
+	merchantId := uuid.New()
+	userId := uuid.New()
+	datasetId := uuid.New()
+
+	mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+	mockStore.EXPECT().GetDatasetRowAssignmentsForAssignee(mock.Anything, userId).Return([]storemodels.DatasetRowAssignment{
+		{DatasetId: datasetId, OrganizationId: merchantId, RowId: "row-1", AssigneeId: &userId},
+	}, nil)
+	mockStore.EXPECT().GetDatasetById(mock.Anything, datasetId.String()).Return(nil, gorm.ErrRecordNotFound)
+
+	s := &datasetService{datasetStore: mockStore}
+	queue, err := s.GetMyQueue(context.Background(), merchantId, userId)
+
+	assert.NoError(t, err)
+	assert.Empty(t, queue)
+}
+
+func TestGetDatasetRowAssignment(t *testing.T) {
+	datasetId := uuid.New()
+	assigneeId := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mockDatasetService.MockDatasetServiceStore)
+		want      *models.DatasetRowAssignment
+		wantErr   error
+	}{
+		{
+			name: "Assigned row",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDatasetRowAssignment(mock.Anything, datasetId, "row-1").
+					Return(storemodels.DatasetRowAssignment{DatasetId: datasetId, RowId: "row-1", AssigneeId: &assigneeId}, nil)
+			},
+			want: &models.DatasetRowAssignment{DatasetId: datasetId, RowId: "row-1", AssigneeId: &assigneeId},
+		},
+		{
+			name: "Row never assigned",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDatasetRowAssignment(mock.Anything, datasetId, "row-1").
+					Return(storemodels.DatasetRowAssignment{}, gorm.ErrRecordNotFound)
+			},
+		},
+		{
+			name: "Store failure",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetDatasetRowAssignment(mock.Anything, datasetId, "row-1").
+					Return(storemodels.DatasetRowAssignment{}, errors.New("db down"))
+			},
+			wantErr: datasetErrors.ErrFailedToGetRowAssignments,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := mockDatasetService.NewMockDatasetServiceStore(t)
+			tt.mockSetup(store)
+			s := &datasetService{datasetStore: store}
+
+			got, err := s.GetDatasetRowAssignment(context.Background(), datasetId, "row-1")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	dataplatformconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	tagmodels "github.com/Zampfi/application-platform/services/api/core/tags/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"go.uber.org/zap"
+)
+
+func (s *datasetService) getTagTaxonomy(ctx context.Context) (tagmodels.Taxonomy, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeTags, err := s.datasetStore.GetTags(ctx)
+	if err != nil {
+		logger.Error("failed to get tags", zap.String("error", err.Error()))
+		return tagmodels.Taxonomy{}, errors.ErrFailedToGetTags
+	}
+
+	taxonomy, err := tagmodels.NewTaxonomy(storeTags)
+	if err != nil {
+		logger.Error("failed to build tag taxonomy", zap.String("error", err.Error()))
+		return tagmodels.Taxonomy{}, errors.ErrFailedToGetTags
+	}
+
+	return taxonomy, nil
+}
+
+// resolveTagValue replaces a value written into a tags column by the path of the tag it spells, it also returns the
+// paths of the taxonomy for the action to check the value against. Organizations without tags keep writing any value.
+func (s *datasetService) resolveTagValue(ctx context.Context, value interface{}) (interface{}, []string, error) {
+	tag, ok := value.(string)
+	if !ok {
+		// left to the validation of the action, which rejects values that are not strings
+		return value, nil, nil
+	}
+
+	taxonomy, err := s.getTagTaxonomy(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if taxonomy.IsEmpty() {
+		return value, nil, nil
+	}
+
+	path, ok := taxonomy.Resolve(tag)
+	if !ok {
+		return nil, nil, errors.ErrInvalidTagValue
+	}
+
+	return path, taxonomy.Paths(), nil
+}
+
+// getTagOptions lists the paths of the taxonomy as the options of a tags column, ok is false when the column does not
+// hold tags or the organization has no tags and the distinct values of the column are listed instead
+func (s *datasetService) getTagOptions(ctx context.Context, datasetId string, column string) ([]interface{}, bool, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	datasetMetaInfo, err := s.datasetStore.GetDatasetById(ctx, datasetId)
+	if err != nil {
+		logger.Error("failed to get dataset meta info", zap.String("error", err.Error()))
+		return nil, false, errors.ErrFailedToGetDatasetById
+	}
+
+	var datasetMetaData models.DatasetMetadataConfig
+	if err := json.Unmarshal([]byte(datasetMetaInfo.Metadata), &datasetMetaData); err != nil {
+		logger.Error("failed to unmarshal dataset metadata", zap.String("error", err.Error()))
+		return nil, false, errors.ErrFailedToUnmarshalMetadata
+	}
+
+	if datasetMetaData.Columns[column].CustomType != dataplatformconstants.DatabricksColumnCustomTypeTags {
+		return nil, false, nil
+	}
+
+	taxonomy, err := s.getTagTaxonomy(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if taxonomy.IsEmpty() {
+		return nil, false, nil
+	}
+
+	return toTagOptions(taxonomy), true, nil
+}
+
+func toTagOptions(taxonomy tagmodels.Taxonomy) []interface{} {
+	options := make([]interface{}, len(taxonomy.Paths()))
+	for i, path := range taxonomy.Paths() {
+		options[i] = path
+	}
+	return options
+}
+
+func isTagsFilter(config models.FilterConfig) bool {
+	customType, ok := getFilterCustomType(config.Metadata)
+	return ok && customType == dataplatformconstants.DatabricksColumnCustomTypeTags
+}
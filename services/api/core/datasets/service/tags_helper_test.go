@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	datasetErrors "github.com/Zampfi/application-platform/services/api/core/datasets/errors"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	mockDatasetService "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func testTaxonomyTags() []storemodels.Tag {
+	return []storemodels.Tag{
+		{Path: "Expenses"},
+		{Path: "Expenses.Payroll", Aliases: json.RawMessage(`["Wages"]`)},
+	}
+}
+
+func TestResolveTagValue(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        interface{}
+		mockSetup    func(*mockDatasetService.MockDatasetServiceStore)
+		want         interface{}
+		wantTaxonomy []string
+		wantErr      error
+	}{
+		{
+			name:  "Resolves another spelling to the path of the tag",
+			value: " expenses.PAYROLL",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetTags(mock.Anything).Return(testTaxonomyTags(), nil)
+			},
+			want:         "Expenses.Payroll",
+			wantTaxonomy: []string{"Expenses", "Expenses.Payroll"},
+		},
+		{
+			name:  "Resolves an alias",
+			value: "wages",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetTags(mock.Anything).Return(testTaxonomyTags(), nil)
+			},
+			want:         "Expenses.Payroll",
+			wantTaxonomy: []string{"Expenses", "Expenses.Payroll"},
+		},
+		{
+			name:  "Rejects values missing from the taxonomy",
+			value: "Expenses.Rent",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetTags(mock.Anything).Return(testTaxonomyTags(), nil)
+			},
+			wantErr: datasetErrors.ErrInvalidTagValue,
+		},
+		{
+			name:  "Keeps any value without a taxonomy",
+			value: "payroll ",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetTags(mock.Anything).Return(nil, nil)
+			},
+			want: "payroll ",
+		},
+		{
+			name:      "Leaves values that are not strings to the action",
+			value:     1,
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {},
+			want:      1,
+		},
+		{
+			name:  "Store error",
+			value: "Expenses",
+			mockSetup: func(m *mockDatasetService.MockDatasetServiceStore) {
+				m.EXPECT().GetTags(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			wantErr: datasetErrors.ErrFailedToGetTags,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+			tt.mockSetup(mockStore)
+
+			s := &datasetService{datasetStore: mockStore}
+			got, taxonomy, err := s.resolveTagValue(context.Background(), tt.value)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTaxonomy, taxonomy)
+		})
+	}
+}
+
+func TestGetOptionsForColumn_TagsColumn(t *testing.T) {
+	datasetId := uuid.New().String()
+
+	mockStore := mockDatasetService.NewMockDatasetServiceStore(t)
+	mockStore.EXPECT().GetDatasetById(mock.Anything, datasetId).Return(&storemodels.Dataset{
+		Metadata: json.RawMessage(`{"columns": {"category": {"custom_type": "tags"}}}`),
+	}, nil)
+	mockStore.EXPECT().GetTags(mock.Anything).Return(testTaxonomyTags(), nil)
+
+	s := &datasetService{datasetStore: mockStore}
+	options, err := s.GetOptionsForColumn(apicontext.AddInternalAccessToContext(context.Background()), uuid.New(), datasetId, "category", datasetConstants.FilterTypeMultiSearch, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"Expenses", "Expenses.Payroll"}, options)
+}
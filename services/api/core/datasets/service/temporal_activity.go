@@ -3,12 +3,17 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
 	dataplatfromactionconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/constants"
+	datasetactionconstants "github.com/Zampfi/application-platform/services/api/core/datasets/actions/constants"
 	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
 	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	"github.com/google/uuid"
 )
@@ -31,16 +36,23 @@ func (s *datasetService) DatasetExportTemporalActivity(ctx context.Context, para
 		return "", fmt.Errorf("failed to get dataset data: %w", err)
 	}
 
-	csvData, err := s.createCSVFromQueryResult(data)
+	format := params.Format
+	if format == "" {
+		format = datasetConstants.ExportFormatCSV
+	}
+
+	columns := s.getExportColumns(ctx, datasetId.String(), data.QueryResult.Columns)
+
+	exportData, err := createExportFile(format, columns, data.QueryResult.Rows)
 	if err != nil {
-		logger.Error("failed to create CSV from data", zap.Error(err))
-		return "", fmt.Errorf("failed to create CSV from data: %w", err)
+		logger.Error("failed to create export file from data", zap.String("format", string(format)), zap.Error(err))
+		return "", fmt.Errorf("failed to create %s export from data: %w", format, err)
 	}
 
-	uploadResponse, err := s.cloudService.UploadFileToCloud(ctx, params.ExportPath, csvData.Bytes())
+	uploadResponse, err := s.cloudService.UploadFileToCloud(ctx, params.ExportPath, exportData.Bytes())
 	if err != nil {
-		logger.Error("failed to upload CSV to cloud storage", zap.Error(err))
-		return "", fmt.Errorf("failed to upload CSV to cloud storage: %w", err)
+		logger.Error("failed to upload export to cloud storage", zap.Error(err))
+		return "", fmt.Errorf("failed to upload export to cloud storage: %w", err)
 	}
 
 	err = s.datasetActionService.UpdateDatasetActionStatus(ctx, workflowId, string(dataplatfromactionconstants.ActionStatusSuccessful))
@@ -51,3 +63,275 @@ func (s *datasetService) DatasetExportTemporalActivity(ctx context.Context, para
 
 	return uploadResponse.Url, nil
 }
+
+// StartDatasetExportScheduleRunActivity records the run of a schedule and the export action it produces.
+// Runs of schedules the owner can no longer read are skipped like the runs of deleted schedules.
+func (s *datasetService) StartDatasetExportScheduleRunActivity(ctx context.Context, params models.DatasetExportScheduleWorkflowParams, workflowId string) (models.DatasetExportScheduleRunPlan, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	ctx = apicontext.AddAuthToContext(ctx, "user", params.OwnerId, []uuid.UUID{params.OrganizationId})
+
+	storeSchedule, err := s.datasetStore.GetDatasetExportScheduleById(ctx, params.ScheduleId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Info("skipping run of missing dataset export schedule", zap.String("schedule_id", params.ScheduleId.String()))
+			return models.DatasetExportScheduleRunPlan{Skip: true}, nil
+		}
+		return models.DatasetExportScheduleRunPlan{}, fmt.Errorf("failed to get dataset export schedule: %w", err)
+	}
+
+	if !storeSchedule.IsEnabled || storeSchedule.TemporalScheduleId != params.TemporalScheduleId {
+		logger.Info("skipping run of inactive dataset export schedule", zap.String("schedule_id", params.ScheduleId.String()), zap.String("temporal_schedule_id", params.TemporalScheduleId))
+		return models.DatasetExportScheduleRunPlan{Skip: true}, nil
+	}
+
+	schedule := models.DatasetExportSchedule{}
+	if err := schedule.FromSchema(storeSchedule); err != nil {
+		return models.DatasetExportScheduleRunPlan{}, fmt.Errorf("failed to parse dataset export schedule: %w", err)
+	}
+
+	dataset, err := s.datasetStore.GetDatasetById(ctx, schedule.DatasetId.String())
+	if err != nil {
+		return models.DatasetExportScheduleRunPlan{}, fmt.Errorf("failed to get dataset: %w", err)
+	}
+
+	// the bucket is resolved again for every run, the organization may have moved its exports since the schedule was saved
+	destination := schedule.DestinationConfig
+	if schedule.DestinationType == datasetConstants.ExportDestinationTypeStorage {
+		exportSettings, err := s.getOrganizationExportSettings(ctx, params.OrganizationId)
+		if err != nil {
+			return models.DatasetExportScheduleRunPlan{}, fmt.Errorf("failed to get organization export settings: %w", err)
+		}
+
+		destination.Bucket = ""
+		destination, err = validateExportDestination(schedule.DestinationType, destination, exportSettings)
+		if err != nil {
+			return models.DatasetExportScheduleRunPlan{}, fmt.Errorf("invalid destination of dataset export schedule: %w", err)
+		}
+	}
+
+	metadata := models.ExportMetadata{
+		FilePath: getDatasetExportFilePath(schedule.DatasetId.String(), workflowId, schedule.Format),
+		Format:   schedule.Format,
+	}
+
+	err = s.datasetActionService.CreateDatasetAction(ctx, params.OrganizationId, storemodels.CreateDatasetActionParams{
+		ActionId:    workflowId,
+		ActionType:  string(datasetactionconstants.ActionTypeDatasetExport),
+		DatasetId:   schedule.DatasetId,
+		Status:      string(dataplatfromactionconstants.ActionStatusInitiated),
+		Config:      metadata,
+		ActionBy:    schedule.OwnerId,
+		IsCompleted: false,
+	})
+	if err != nil {
+		logger.Error("failed to create dataset action", zap.Error(err))
+		return models.DatasetExportScheduleRunPlan{}, fmt.Errorf("failed to create dataset action: %w", err)
+	}
+
+	run, err := s.datasetStore.CreateDatasetExportScheduleRun(ctx, storemodels.CreateDatasetExportScheduleRunParams{
+		DatasetExportScheduleId: schedule.ID,
+		WorkflowId:              workflowId,
+		Status:                  datasetConstants.DatasetExportScheduleRunStatusRunning,
+		FilePath:                metadata.FilePath,
+	})
+	if err != nil {
+		logger.Error("failed to create dataset export schedule run", zap.Error(err))
+		return models.DatasetExportScheduleRunPlan{}, fmt.Errorf("failed to create dataset export schedule run: %w", err)
+	}
+
+	return models.DatasetExportScheduleRunPlan{
+		RunId:        run.ID,
+		WorkflowId:   workflowId,
+		ScheduleName: schedule.Name,
+		DatasetId:    schedule.DatasetId,
+		DatasetName:  dataset.Title,
+		ExportParams: models.DatasetExportParams{
+			QueryConfig: schedule.QueryConfig,
+			ExportPath:  metadata.FilePath,
+			Format:      schedule.Format,
+		},
+		DestinationType:   schedule.DestinationType,
+		DestinationConfig: destination,
+	}, nil
+}
+
+// CompleteDatasetExportScheduleRunActivity closes the run, an empty errorMessage marks it successful
+func (s *datasetService) CompleteDatasetExportScheduleRunActivity(ctx context.Context, params models.DatasetExportScheduleWorkflowParams, plan models.DatasetExportScheduleRunPlan, errorMessage string) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	ctx = apicontext.AddAuthToContext(ctx, "user", params.OwnerId, []uuid.UUID{params.OrganizationId})
+
+	status := datasetConstants.DatasetExportScheduleRunStatusSuccessful
+	if errorMessage != "" {
+		status = datasetConstants.DatasetExportScheduleRunStatusFailed
+
+		err := s.datasetActionService.UpdateDatasetActionStatus(ctx, plan.WorkflowId, string(dataplatfromactionconstants.ActionStatusFailed))
+		if err != nil {
+			logger.Error("failed to update dataset action", zap.Error(err))
+			return fmt.Errorf("failed to update dataset action: %w", err)
+		}
+	}
+
+	err := s.datasetStore.UpdateDatasetExportScheduleRun(ctx, plan.RunId, storemodels.UpdateDatasetExportScheduleRunParams{
+		Status: status,
+		Error:  errorMessage,
+	})
+	if err != nil {
+		logger.Error("failed to update dataset export schedule run", zap.Error(err))
+		return fmt.Errorf("failed to update dataset export schedule run: %w", err)
+	}
+
+	return nil
+}
+
+// EvaluateDatasetAlertActivity measures the metric of an alert and moves it between OK and FIRING. Only these
+// transitions are recorded as events and notified, which keeps a breached alert from notifying on every evaluation.
+// A transition to FIRING while the alert was snoozed is notified by the first evaluation after the snooze that finds
+// the alert still FIRING. Evaluations of deleted, disabled or rescheduled alerts are skipped.
+func (s *datasetService) EvaluateDatasetAlertActivity(ctx context.Context, params models.DatasetAlertWorkflowParams) (models.DatasetAlertEvaluation, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	ctx = apicontext.AddAuthToContext(ctx, "user", params.OwnerId, []uuid.UUID{params.OrganizationId})
+
+	storeAlert, err := s.datasetStore.GetDatasetAlertById(ctx, params.AlertId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			logger.Info("skipping evaluation of missing dataset alert", zap.String("alert_id", params.AlertId.String()))
+			return models.DatasetAlertEvaluation{Skip: true}, nil
+		}
+		return models.DatasetAlertEvaluation{}, fmt.Errorf("failed to get dataset alert: %w", err)
+	}
+
+	if !storeAlert.IsEnabled || storeAlert.TemporalScheduleId != params.TemporalScheduleId {
+		logger.Info("skipping evaluation of inactive dataset alert", zap.String("alert_id", params.AlertId.String()), zap.String("temporal_schedule_id", params.TemporalScheduleId))
+		return models.DatasetAlertEvaluation{Skip: true}, nil
+	}
+
+	alert := models.DatasetAlert{}
+	if err := alert.FromSchema(storeAlert); err != nil {
+		return models.DatasetAlertEvaluation{}, fmt.Errorf("failed to parse dataset alert: %w", err)
+	}
+
+	dataset, err := s.datasetStore.GetDatasetById(ctx, alert.DatasetId.String())
+	if err != nil {
+		return models.DatasetAlertEvaluation{}, fmt.Errorf("failed to get dataset: %w", err)
+	}
+
+	now := time.Now()
+	result, err := s.evaluateDatasetAlertMetric(ctx, params.OrganizationId, alert, now)
+	if err != nil {
+		logger.Error("failed to evaluate dataset alert metric", zap.String("alert_id", alert.ID.String()), zap.Error(err))
+
+		stateErr := s.datasetStore.UpdateDatasetAlertState(ctx, alert.ID, storemodels.UpdateDatasetAlertStateParams{
+			State:           alert.State,
+			LastEvaluatedAt: now,
+			LastError:       err.Error(),
+		})
+		if stateErr != nil {
+			logger.Error("failed to record dataset alert evaluation error", zap.Error(stateErr))
+		}
+
+		return models.DatasetAlertEvaluation{}, fmt.Errorf("failed to evaluate dataset alert metric: %w", err)
+	}
+
+	state := storemodels.DatasetAlertStateOk
+	if result.Breached {
+		state = storemodels.DatasetAlertStateFiring
+	}
+
+	evaluation := models.DatasetAlertEvaluation{
+		AlertId:     alert.ID,
+		AlertName:   alert.Name,
+		DatasetId:   alert.DatasetId,
+		DatasetName: dataset.Title,
+		State:       state,
+		Result:      result,
+		Recipients:  alert.Recipients,
+		WebhookUrl:  alert.WebhookUrl,
+		EvaluatedAt: now,
+	}
+
+	stateParams := storemodels.UpdateDatasetAlertStateParams{
+		State:           state,
+		LastValue:       &result.Value,
+		LastEvaluatedAt: now,
+	}
+
+	if state == alert.State {
+		if state == storemodels.DatasetAlertStateFiring && !alert.IsSnoozed(now) {
+			return s.notifyPendingDatasetAlertEvent(ctx, evaluation, stateParams)
+		}
+
+		if err := s.datasetStore.UpdateDatasetAlertState(ctx, alert.ID, stateParams); err != nil {
+			logger.Error("failed to update dataset alert state", zap.Error(err))
+			return models.DatasetAlertEvaluation{}, fmt.Errorf("failed to update dataset alert state: %w", err)
+		}
+
+		return evaluation, nil
+	}
+
+	evaluation.EventStatus = storemodels.DatasetAlertEventStatusResolved
+	if state == storemodels.DatasetAlertStateFiring {
+		evaluation.EventStatus = storemodels.DatasetAlertEventStatusFiring
+	}
+	evaluation.Notify = !alert.IsSnoozed(now)
+
+	err = s.datasetStore.WithDatasetAlertTransaction(ctx, func(as store.DatasetAlertStore) error {
+		event, err := as.CreateDatasetAlertEvent(ctx, storemodels.CreateDatasetAlertEventParams{
+			DatasetAlertId:  alert.ID,
+			Status:          evaluation.EventStatus,
+			Value:           result.Value,
+			ComparisonValue: result.ComparisonValue,
+			Score:           result.Score,
+			Message:         result.Message,
+			Notified:        evaluation.Notify,
+		})
+		if err != nil {
+			return err
+		}
+		evaluation.EventId = event.ID
+
+		return as.UpdateDatasetAlertState(ctx, alert.ID, stateParams)
+	})
+	if err != nil {
+		logger.Error("failed to record dataset alert transition", zap.String("alert_id", alert.ID.String()), zap.Error(err))
+		return models.DatasetAlertEvaluation{}, fmt.Errorf("failed to record dataset alert transition: %w", err)
+	}
+
+	return evaluation, nil
+}
+
+// notifyPendingDatasetAlertEvent records an evaluation finding an unsnoozed alert still FIRING. When the alert went
+// FIRING while snoozed, its latest event was not notified and is notified with this evaluation.
+func (s *datasetService) notifyPendingDatasetAlertEvent(ctx context.Context, evaluation models.DatasetAlertEvaluation, stateParams storemodels.UpdateDatasetAlertStateParams) (models.DatasetAlertEvaluation, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	events, err := s.datasetStore.GetDatasetAlertEvents(ctx, evaluation.AlertId, 1)
+	if err != nil {
+		logger.Error("failed to get dataset alert events", zap.String("alert_id", evaluation.AlertId.String()), zap.Error(err))
+		return models.DatasetAlertEvaluation{}, fmt.Errorf("failed to get dataset alert events: %w", err)
+	}
+
+	err = s.datasetStore.WithDatasetAlertTransaction(ctx, func(as store.DatasetAlertStore) error {
+		if len(events) > 0 && events[0].Status == storemodels.DatasetAlertEventStatusFiring && !events[0].Notified {
+			marked, err := as.MarkDatasetAlertEventNotified(ctx, events[0].ID)
+			if err != nil {
+				return err
+			}
+			if marked {
+				evaluation.EventId = events[0].ID
+				evaluation.EventStatus = storemodels.DatasetAlertEventStatusFiring
+				evaluation.Notify = true
+			}
+		}
+
+		return as.UpdateDatasetAlertState(ctx, evaluation.AlertId, stateParams)
+	})
+	if err != nil {
+		logger.Error("failed to update dataset alert state", zap.String("alert_id", evaluation.AlertId.String()), zap.Error(err))
+		return models.DatasetAlertEvaluation{}, fmt.Errorf("failed to update dataset alert state: %w", err)
+	}
+
+	return evaluation, nil
+}
@@ -0,0 +1,17 @@
+package constants
+
+// Columns the rates are read from when the config of a source does not name them
+const (
+	DefaultDateColumn          = "date"
+	DefaultBaseCurrencyColumn  = "base_currency"
+	DefaultQuoteCurrencyColumn = "quote_currency"
+	DefaultRateColumn          = "rate"
+)
+
+const (
+	FxMaxImportRows        = 200000
+	FxImportLoadPageSize   = 10000
+	FxRatesDefaultPageSize = 100
+	FxRatesMaxPageSize     = 1000
+	FxMaxFileSizeBytes     = 20 << 20
+)
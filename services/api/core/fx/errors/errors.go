@@ -0,0 +1,35 @@
+package errors
+
+import "errors"
+
+const (
+	ErrFxRateSourceNotFoundMessage      = "ERR_FX_RATE_SOURCE_NOT_FOUND"
+	ErrEmptyFxRateSourceNameMessage     = "ERR_EMPTY_FX_RATE_SOURCE_NAME"
+	ErrInvalidFxRateSourceTypeMessage   = "ERR_INVALID_FX_RATE_SOURCE_TYPE"
+	ErrInvalidFxRateSourceConfigMessage = "ERR_INVALID_FX_RATE_SOURCE_CONFIG"
+	ErrFxRateSourceConnectionMessage    = "ERR_FX_RATE_SOURCE_CONNECTION_NOT_FOUND"
+	ErrFxRateSourceDatasetMessage       = "ERR_FX_RATE_SOURCE_DATASET_NOT_FOUND"
+	ErrFxRateSourceTypeMismatchMessage  = "ERR_FX_RATE_SOURCE_TYPE_MISMATCH"
+	ErrInvalidFxRateFileMessage         = "ERR_INVALID_FX_RATE_FILE"
+	ErrInvalidFxRateRowMessage          = "ERR_INVALID_FX_RATE_ROW"
+	ErrFxRateImportTooManyRowsMessage   = "ERR_FX_RATE_IMPORT_TOO_MANY_ROWS"
+	ErrInvalidCurrencyMessage           = "ERR_INVALID_CURRENCY"
+	ErrFxRateNotFoundMessage            = "ERR_FX_RATE_NOT_FOUND"
+	ErrFxRateAccessForbiddenMessage     = "ERR_FX_RATE_ACCESS_FORBIDDEN"
+)
+
+var (
+	ErrFxRateSourceNotFound      = errors.New(ErrFxRateSourceNotFoundMessage)
+	ErrEmptyFxRateSourceName     = errors.New(ErrEmptyFxRateSourceNameMessage)
+	ErrInvalidFxRateSourceType   = errors.New(ErrInvalidFxRateSourceTypeMessage)
+	ErrInvalidFxRateSourceConfig = errors.New(ErrInvalidFxRateSourceConfigMessage)
+	ErrFxRateSourceConnection    = errors.New(ErrFxRateSourceConnectionMessage)
+	ErrFxRateSourceDataset       = errors.New(ErrFxRateSourceDatasetMessage)
+	ErrFxRateSourceTypeMismatch  = errors.New(ErrFxRateSourceTypeMismatchMessage)
+	ErrInvalidFxRateFile         = errors.New(ErrInvalidFxRateFileMessage)
+	ErrInvalidFxRateRow          = errors.New(ErrInvalidFxRateRowMessage)
+	ErrFxRateImportTooManyRows   = errors.New(ErrFxRateImportTooManyRowsMessage)
+	ErrInvalidCurrency           = errors.New(ErrInvalidCurrencyMessage)
+	ErrFxRateNotFound            = errors.New(ErrFxRateNotFoundMessage)
+	ErrFxRateAccessForbidden     = errors.New(ErrFxRateAccessForbiddenMessage)
+)
@@ -0,0 +1,109 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/fx/constants"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+// FxRateSourceConfig names the columns of the files or the dataset of a source holding the date, the currencies and
+// the rate of each row, one BaseCurrency is worth Rate QuoteCurrency. DateFormat is a Go time layout and defaults to
+// dates like 2006-01-02.
+type FxRateSourceConfig struct {
+	DateColumn          string `json:"date_column,omitempty"`
+	BaseCurrencyColumn  string `json:"base_currency_column,omitempty"`
+	QuoteCurrencyColumn string `json:"quote_currency_column,omitempty"`
+	RateColumn          string `json:"rate_column,omitempty"`
+	DateFormat          string `json:"date_format,omitempty"`
+}
+
+// WithDefaults fills the columns missing from the config with the default column names
+func (c FxRateSourceConfig) WithDefaults() FxRateSourceConfig {
+	if c.DateColumn == "" {
+		c.DateColumn = constants.DefaultDateColumn
+	}
+	if c.BaseCurrencyColumn == "" {
+		c.BaseCurrencyColumn = constants.DefaultBaseCurrencyColumn
+	}
+	if c.QuoteCurrencyColumn == "" {
+		c.QuoteCurrencyColumn = constants.DefaultQuoteCurrencyColumn
+	}
+	if c.RateColumn == "" {
+		c.RateColumn = constants.DefaultRateColumn
+	}
+	if c.DateFormat == "" {
+		c.DateFormat = time.DateOnly
+	}
+	return c
+}
+
+type FxRateSource struct {
+	ID             uuid.UUID
+	OrganizationId uuid.UUID
+	Name           string
+	SourceType     string
+	ConnectionId   *uuid.UUID
+	DatasetId      *uuid.UUID
+	Config         FxRateSourceConfig
+	LastImportedAt *time.Time
+	CreatedBy      uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type FxRateSourceParams struct {
+	Name         string
+	SourceType   string
+	ConnectionId *uuid.UUID
+	DatasetId    *uuid.UUID
+	Config       FxRateSourceConfig
+}
+
+func (s *FxRateSource) FromSchema(schema dbmodels.FxRateSource) error {
+	var config FxRateSourceConfig
+	if len(schema.Config) > 0 {
+		if err := json.Unmarshal(schema.Config, &config); err != nil {
+			return err
+		}
+	}
+
+	s.ID = schema.ID
+	s.OrganizationId = schema.OrganizationId
+	s.Name = schema.Name
+	s.SourceType = schema.SourceType
+	s.ConnectionId = schema.ConnectionId
+	s.DatasetId = schema.DatasetId
+	s.Config = config
+	s.LastImportedAt = schema.LastImportedAt
+	s.CreatedBy = schema.CreatedBy
+	s.CreatedAt = schema.CreatedAt
+	s.UpdatedAt = schema.UpdatedAt
+	return nil
+}
+
+type FxRateImportResult struct {
+	ImportedRates int
+	FromDate      *time.Time
+	ToDate        *time.Time
+}
+
+type FxRatesPage struct {
+	Rates      []dbmodels.FxRate
+	TotalCount int64
+	Page       int
+	PageSize   int
+}
+
+// EffectiveFxRate is the rate converting one BaseCurrency to QuoteCurrency on Date, taken from the latest rate on or
+// before it. Inverted is set when only the rate of the opposite pair was found.
+type EffectiveFxRate struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Date          time.Time
+	RateDate      time.Time
+	Rate          float64
+	Inverted      bool
+}
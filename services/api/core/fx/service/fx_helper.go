@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/fx/constants"
+	"github.com/Zampfi/application-platform/services/api/core/fx/errors"
+	"github.com/Zampfi/application-platform/services/api/core/fx/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func (s *fxService) getFxRateSource(ctx context.Context, sourceId uuid.UUID) (storemodels.FxRateSource, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	source, err := s.store.GetFxRateSourceById(ctx, sourceId)
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return storemodels.FxRateSource{}, errors.ErrFxRateSourceNotFound
+		}
+		logger.Error("failed to get fx rate source", zap.String("sourceId", sourceId.String()), zap.String("error", err.Error()))
+		return storemodels.FxRateSource{}, err
+	}
+
+	return source, nil
+}
+
+// getImportableFxRateSource returns the source when the user is an admin of the organization of the source
+func (s *fxService) getImportableFxRateSource(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID) (models.FxRateSource, error) {
+	storeSource, err := s.getFxRateSource(ctx, sourceId)
+	if err != nil {
+		return models.FxRateSource{}, err
+	}
+	if err := s.ensureOrganizationAdmin(ctx, storeSource.OrganizationId, userId); err != nil {
+		return models.FxRateSource{}, err
+	}
+
+	source := models.FxRateSource{}
+	if err := source.FromSchema(storeSource); err != nil {
+		return models.FxRateSource{}, err
+	}
+
+	return source, nil
+}
+
+// ensureOrganizationAdmin lets the admins of the organization change its rates, every query converting amounts of
+// the organization reads them
+func (s *fxService) ensureOrganizationAdmin(ctx context.Context, orgId uuid.UUID, userId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	policy, err := s.store.GetOrganizationPolicyByUser(ctx, orgId, userId)
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.ErrFxRateAccessForbidden
+		}
+		logger.Error("failed to get organization policy", zap.String("organizationId", orgId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	if policy == nil || policy.Privilege != storemodels.PrivilegeOrganizationSystemAdmin {
+		return errors.ErrFxRateAccessForbidden
+	}
+
+	return nil
+}
+
+// validateConnectorSource checks the connection and the dataset it syncs the rates into are visible to the user
+func (s *fxService) validateConnectorSource(ctx context.Context, params models.FxRateSourceParams) error {
+	if params.ConnectionId == nil || params.DatasetId == nil {
+		return errors.ErrInvalidFxRateSourceConfig
+	}
+
+	if _, err := s.store.GetConnectionByID(ctx, *params.ConnectionId); err != nil {
+		return errors.ErrFxRateSourceConnection
+	}
+
+	if _, err := s.store.GetDatasetById(ctx, params.DatasetId.String()); err != nil {
+		return errors.ErrFxRateSourceDataset
+	}
+
+	return nil
+}
+
+// loadConnectorFxRates pages through the rows of the dataset dated on or after since, all of them without since
+func (s *fxService) loadConnectorFxRates(ctx context.Context, orgId uuid.UUID, datasetId uuid.UUID, config models.FxRateSourceConfig, since *time.Time) ([]storemodels.FxRateParams, error) {
+	columns := []datasetmodels.ColumnConfig{
+		{Column: config.DateColumn},
+		{Column: config.BaseCurrencyColumn},
+		{Column: config.QuoteCurrencyColumn},
+		{Column: config.RateColumn},
+	}
+
+	var filters datasetmodels.FilterModel
+	if since != nil {
+		filters = datasetmodels.FilterModel{
+			LogicalOperator: datasetmodels.LogicalOperator(querybuilderconstants.LogicalOperatorAnd),
+			Conditions: []datasetmodels.Filter{{
+				Column:   config.DateColumn,
+				Operator: querybuilderconstants.GreaterThanOrEqualOperator,
+				Value:    since.Format(time.DateOnly),
+			}},
+		}
+	}
+
+	var rates []storemodels.FxRateParams
+	for page := 1; ; page++ {
+		data, err := s.datasetService.GetDataByDatasetId(ctx, orgId, datasetId.String(), datasetmodels.DatasetParams{
+			Columns: columns,
+			Filters: filters,
+			OrderBy: []datasetmodels.OrderBy{{
+				Column: config.DateColumn,
+				Order:  datasetmodels.OrderType(querybuilderconstants.OrderAsc),
+			}},
+			Pagination: &datasetmodels.Pagination{
+				Page:     page,
+				PageSize: constants.FxImportLoadPageSize,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rates)+len(data.Rows) > constants.FxMaxImportRows {
+			return nil, errors.ErrFxRateImportTooManyRows
+		}
+
+		for i, row := range data.Rows {
+			rate, err := toFxRateParams(row[config.DateColumn], row[config.BaseCurrencyColumn], row[config.QuoteCurrencyColumn], row[config.RateColumn], config.DateFormat)
+			if err != nil {
+				return nil, fmt.Errorf("%w: row %d: %s", errors.ErrInvalidFxRateRow, (page-1)*constants.FxImportLoadPageSize+i+1, err.Error())
+			}
+			rates = append(rates, rate)
+		}
+
+		if len(data.Rows) < constants.FxImportLoadPageSize {
+			return rates, nil
+		}
+	}
+}
+
+// parseFxRateFile reads a csv file with a header row naming the columns of the config, empty lines are skipped
+func parseFxRateFile(file io.Reader, config models.FxRateSourceConfig) ([]storemodels.FxRateParams, error) {
+	reader := csv.NewReader(file)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.ErrInvalidFxRateFile
+	}
+
+	positions := map[string]int{}
+	for i, column := range header {
+		positions[strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))] = i
+	}
+
+	indexes := make([]int, 4)
+	for i, column := range []string{config.DateColumn, config.BaseCurrencyColumn, config.QuoteCurrencyColumn, config.RateColumn} {
+		position, ok := positions[column]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing column %s", errors.ErrInvalidFxRateFile, column)
+		}
+		indexes[i] = position
+	}
+
+	var rates []storemodels.FxRateParams
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errors.ErrInvalidFxRateFile, err.Error())
+		}
+
+		if len(rates) >= constants.FxMaxImportRows {
+			return nil, errors.ErrFxRateImportTooManyRows
+		}
+
+		rate, err := toFxRateParams(record[indexes[0]], record[indexes[1]], record[indexes[2]], record[indexes[3]], config.DateFormat)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", errors.ErrInvalidFxRateRow, line, err.Error())
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, nil
+}
+
+// saveFxRates writes the rates of the source, the last rate of a pair and day wins when a file repeats it
+func (s *fxService) saveFxRates(ctx context.Context, orgId uuid.UUID, sourceId uuid.UUID, rates []storemodels.FxRateParams) (models.FxRateImportResult, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	type rateKey struct {
+		base  string
+		quote string
+		date  time.Time
+	}
+
+	positions := map[rateKey]int{}
+	var uniqueRates []storemodels.FxRateParams
+	for _, rate := range rates {
+		key := rateKey{base: rate.BaseCurrency, quote: rate.QuoteCurrency, date: rate.RateDate}
+		if position, ok := positions[key]; ok {
+			uniqueRates[position] = rate
+			continue
+		}
+		positions[key] = len(uniqueRates)
+		uniqueRates = append(uniqueRates, rate)
+	}
+
+	if err := s.store.UpsertFxRates(ctx, orgId, sourceId, uniqueRates); err != nil {
+		logger.Error("failed to save fx rates", zap.String("sourceId", sourceId.String()), zap.String("error", err.Error()))
+		return models.FxRateImportResult{}, err
+	}
+
+	if err := s.store.MarkFxRateSourceImported(ctx, sourceId, time.Now()); err != nil {
+		logger.Error("failed to mark fx rate source imported", zap.String("sourceId", sourceId.String()), zap.String("error", err.Error()))
+		return models.FxRateImportResult{}, err
+	}
+
+	result := models.FxRateImportResult{ImportedRates: len(uniqueRates)}
+	if len(uniqueRates) > 0 {
+		sort.Slice(uniqueRates, func(i, j int) bool {
+			return uniqueRates[i].RateDate.Before(uniqueRates[j].RateDate)
+		})
+		result.FromDate = &uniqueRates[0].RateDate
+		result.ToDate = &uniqueRates[len(uniqueRates)-1].RateDate
+	}
+
+	return result, nil
+}
+
+func toFxRateParams(date interface{}, baseCurrency interface{}, quoteCurrency interface{}, rate interface{}, dateFormat string) (storemodels.FxRateParams, error) {
+	rateDate, err := parseFxRateDate(date, dateFormat)
+	if err != nil {
+		return storemodels.FxRateParams{}, err
+	}
+
+	base, err := normalizeCurrency(fmt.Sprint(baseCurrency))
+	if err != nil {
+		return storemodels.FxRateParams{}, err
+	}
+	quote, err := normalizeCurrency(fmt.Sprint(quoteCurrency))
+	if err != nil {
+		return storemodels.FxRateParams{}, err
+	}
+	if base == quote {
+		return storemodels.FxRateParams{}, fmt.Errorf("rate of %s to itself", base)
+	}
+
+	value, err := parseFxRateValue(rate)
+	if err != nil {
+		return storemodels.FxRateParams{}, err
+	}
+
+	return storemodels.FxRateParams{
+		RateDate:      rateDate,
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Rate:          value,
+	}, nil
+}
+
+// parseFxRateDate keeps the day of the value, dates of datasets come back as times while files hold text
+func parseFxRateDate(value interface{}, layout string) (time.Time, error) {
+	var parsed time.Time
+	switch date := value.(type) {
+	case time.Time:
+		parsed = date
+	case string:
+		var err error
+		parsed, err = time.Parse(layout, strings.TrimSpace(date))
+		if err != nil {
+			parsed, err = time.Parse(time.RFC3339, strings.TrimSpace(date))
+			if err != nil {
+				return time.Time{}, fmt.Errorf("invalid date %q", date)
+			}
+		}
+	default:
+		return time.Time{}, fmt.Errorf("invalid date %v", value)
+	}
+
+	return time.Date(parsed.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.UTC), nil
+}
+
+func parseFxRateValue(value interface{}) (float64, error) {
+	var rate float64
+	switch v := value.(type) {
+	case float64:
+		rate = v
+	case float32:
+		rate = float64(v)
+	case int64:
+		rate = float64(v)
+	case int:
+		rate = float64(v)
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid rate %q", v)
+		}
+		rate = parsed
+	default:
+		return 0, fmt.Errorf("invalid rate %v", value)
+	}
+
+	if rate <= 0 {
+		return 0, fmt.Errorf("rate %v is not positive", rate)
+	}
+	return rate, nil
+}
+
+func normalizeCurrency(currency string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(currency))
+	if !currencyCodePattern.MatchString(normalized) {
+		return "", errors.ErrInvalidCurrency
+	}
+	return normalized, nil
+}
+
+func normalizeCurrencies(currencies []string) ([]string, error) {
+	normalized := make([]string, 0, len(currencies))
+	for _, currency := range currencies {
+		code, err := normalizeCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, code)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	goerrors "errors"
+	"io"
+	"strings"
+	"time"
+
+	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	"github.com/Zampfi/application-platform/services/api/core/fx/constants"
+	"github.com/Zampfi/application-platform/services/api/core/fx/errors"
+	"github.com/Zampfi/application-platform/services/api/core/fx/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type FxServiceStore interface {
+	store.FxRateStore
+	store.ConnectionStore
+	store.DatasetStore
+	store.OrganizationStore
+}
+
+type FxService interface {
+	GetFxRateSources(ctx context.Context) ([]models.FxRateSource, error)
+	GetFxRateSource(ctx context.Context, sourceId uuid.UUID) (models.FxRateSource, error)
+	CreateFxRateSource(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.FxRateSourceParams) (models.FxRateSource, error)
+	DeleteFxRateSource(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID) error
+	ImportFxRatesFromFile(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID, file io.Reader) (models.FxRateImportResult, error)
+	ImportFxRatesFromConnector(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID) (models.FxRateImportResult, error)
+	GetFxRates(ctx context.Context, filters storemodels.FxRateFilters) (models.FxRatesPage, error)
+	GetFxRate(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time) (models.EffectiveFxRate, error)
+}
+
+type fxService struct {
+	store          FxServiceStore
+	datasetService datasetservice.DatasetService
+}
+
+func NewFxService(appStore store.Store, datasetService datasetservice.DatasetService) *fxService {
+	return &fxService{store: appStore, datasetService: datasetService}
+}
+
+func (s *fxService) GetFxRateSources(ctx context.Context) ([]models.FxRateSource, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeSources, err := s.store.GetFxRateSources(ctx)
+	if err != nil {
+		logger.Error("failed to get fx rate sources", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	sources := make([]models.FxRateSource, len(storeSources))
+	for i, storeSource := range storeSources {
+		if err := sources[i].FromSchema(storeSource); err != nil {
+			return nil, err
+		}
+	}
+
+	return sources, nil
+}
+
+func (s *fxService) GetFxRateSource(ctx context.Context, sourceId uuid.UUID) (models.FxRateSource, error) {
+	storeSource, err := s.getFxRateSource(ctx, sourceId)
+	if err != nil {
+		return models.FxRateSource{}, err
+	}
+
+	source := models.FxRateSource{}
+	if err := source.FromSchema(storeSource); err != nil {
+		return models.FxRateSource{}, err
+	}
+
+	return source, nil
+}
+
+// CreateFxRateSource adds a source of the rates of the organization, only its admins can change its rates
+func (s *fxService) CreateFxRateSource(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.FxRateSourceParams) (models.FxRateSource, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.ensureOrganizationAdmin(ctx, orgId, userId); err != nil {
+		return models.FxRateSource{}, err
+	}
+
+	params.Name = strings.TrimSpace(params.Name)
+	if params.Name == "" {
+		return models.FxRateSource{}, errors.ErrEmptyFxRateSourceName
+	}
+
+	switch params.SourceType {
+	case storemodels.FxRateSourceTypeFile:
+		params.ConnectionId = nil
+		params.DatasetId = nil
+	case storemodels.FxRateSourceTypeConnector:
+		if err := s.validateConnectorSource(ctx, params); err != nil {
+			return models.FxRateSource{}, err
+		}
+	default:
+		return models.FxRateSource{}, errors.ErrInvalidFxRateSourceType
+	}
+
+	storeSource, err := s.store.CreateFxRateSource(ctx, storemodels.CreateFxRateSourceParams{
+		OrganizationId: orgId,
+		Name:           params.Name,
+		SourceType:     params.SourceType,
+		ConnectionId:   params.ConnectionId,
+		DatasetId:      params.DatasetId,
+		Config:         params.Config,
+		CreatedBy:      userId,
+	})
+	if err != nil {
+		logger.Error("failed to create fx rate source", zap.String("error", err.Error()))
+		return models.FxRateSource{}, err
+	}
+
+	source := models.FxRateSource{}
+	if err := source.FromSchema(storeSource); err != nil {
+		return models.FxRateSource{}, err
+	}
+
+	return source, nil
+}
+
+func (s *fxService) DeleteFxRateSource(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	source, err := s.getFxRateSource(ctx, sourceId)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureOrganizationAdmin(ctx, source.OrganizationId, userId); err != nil {
+		return err
+	}
+
+	if err := s.store.DeleteFxRateSource(ctx, sourceId, userId); err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.ErrFxRateSourceNotFound
+		}
+		logger.Error("failed to delete fx rate source", zap.String("sourceId", sourceId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// ImportFxRatesFromFile writes the rates of the file under the organization of the source
+func (s *fxService) ImportFxRatesFromFile(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID, file io.Reader) (models.FxRateImportResult, error) {
+	source, err := s.getImportableFxRateSource(ctx, userId, sourceId)
+	if err != nil {
+		return models.FxRateImportResult{}, err
+	}
+	if source.SourceType != storemodels.FxRateSourceTypeFile {
+		return models.FxRateImportResult{}, errors.ErrFxRateSourceTypeMismatch
+	}
+
+	rates, err := parseFxRateFile(file, source.Config.WithDefaults())
+	if err != nil {
+		return models.FxRateImportResult{}, err
+	}
+
+	return s.saveFxRates(ctx, source.OrganizationId, source.ID, rates)
+}
+
+// ImportFxRatesFromConnector reads the rates from the dataset the connection of the source syncs into. Only the rows
+// dated on or after the last imported day are read, that day is read again as it may have been partially synced.
+func (s *fxService) ImportFxRatesFromConnector(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID) (models.FxRateImportResult, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	source, err := s.getImportableFxRateSource(ctx, userId, sourceId)
+	if err != nil {
+		return models.FxRateImportResult{}, err
+	}
+	if source.SourceType != storemodels.FxRateSourceTypeConnector || source.DatasetId == nil {
+		return models.FxRateImportResult{}, errors.ErrFxRateSourceTypeMismatch
+	}
+
+	since, err := s.store.GetLatestFxRateDate(ctx, source.ID)
+	if err != nil {
+		logger.Error("failed to get latest fx rate date", zap.String("sourceId", sourceId.String()), zap.String("error", err.Error()))
+		return models.FxRateImportResult{}, err
+	}
+
+	rates, err := s.loadConnectorFxRates(ctx, source.OrganizationId, *source.DatasetId, source.Config.WithDefaults(), since)
+	if err != nil {
+		return models.FxRateImportResult{}, err
+	}
+
+	return s.saveFxRates(ctx, source.OrganizationId, source.ID, rates)
+}
+
+func (s *fxService) GetFxRates(ctx context.Context, filters storemodels.FxRateFilters) (models.FxRatesPage, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	var err error
+	if filters.BaseCurrencies, err = normalizeCurrencies(filters.BaseCurrencies); err != nil {
+		return models.FxRatesPage{}, err
+	}
+	if filters.QuoteCurrencies, err = normalizeCurrencies(filters.QuoteCurrencies); err != nil {
+		return models.FxRatesPage{}, err
+	}
+
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PageSize < 1 {
+		filters.PageSize = constants.FxRatesDefaultPageSize
+	}
+	if filters.PageSize > constants.FxRatesMaxPageSize {
+		filters.PageSize = constants.FxRatesMaxPageSize
+	}
+
+	rates, err := s.store.GetFxRates(ctx, filters)
+	if err != nil {
+		logger.Error("failed to get fx rates", zap.String("error", err.Error()))
+		return models.FxRatesPage{}, err
+	}
+
+	totalCount, err := s.store.CountFxRates(ctx, filters)
+	if err != nil {
+		logger.Error("failed to count fx rates", zap.String("error", err.Error()))
+		return models.FxRatesPage{}, err
+	}
+
+	return models.FxRatesPage{
+		Rates:      rates,
+		TotalCount: totalCount,
+		Page:       filters.Page,
+		PageSize:   filters.PageSize,
+	}, nil
+}
+
+// GetFxRate returns the rate of the pair on the date, falling back to the inverse of the opposite pair when the
+// organization only has rates the other way round
+func (s *fxService) GetFxRate(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time) (models.EffectiveFxRate, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	base, err := normalizeCurrency(baseCurrency)
+	if err != nil {
+		return models.EffectiveFxRate{}, err
+	}
+	quote, err := normalizeCurrency(quoteCurrency)
+	if err != nil {
+		return models.EffectiveFxRate{}, err
+	}
+
+	effectiveRate := models.EffectiveFxRate{BaseCurrency: base, QuoteCurrency: quote, Date: date}
+	if base == quote {
+		effectiveRate.RateDate = date
+		effectiveRate.Rate = 1
+		return effectiveRate, nil
+	}
+
+	rate, err := s.store.GetFxRateOnDate(ctx, base, quote, date)
+	if err == nil {
+		effectiveRate.RateDate = rate.RateDate
+		effectiveRate.Rate = rate.Rate
+		return effectiveRate, nil
+	}
+	if !goerrors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error("failed to get fx rate", zap.String("base", base), zap.String("quote", quote), zap.String("error", err.Error()))
+		return models.EffectiveFxRate{}, err
+	}
+
+	inverse, err := s.store.GetFxRateOnDate(ctx, quote, base, date)
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return models.EffectiveFxRate{}, errors.ErrFxRateNotFound
+		}
+		logger.Error("failed to get fx rate", zap.String("base", quote), zap.String("quote", base), zap.String("error", err.Error()))
+		return models.EffectiveFxRate{}, err
+	}
+	if inverse.Rate <= 0 {
+		return models.EffectiveFxRate{}, errors.ErrFxRateNotFound
+	}
+
+	effectiveRate.RateDate = inverse.RateDate
+	effectiveRate.Rate = 1 / inverse.Rate
+	effectiveRate.Inverted = true
+	return effectiveRate, nil
+}
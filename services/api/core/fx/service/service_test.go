@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/fx/errors"
+	"github.com/Zampfi/application-platform/services/api/core/fx/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mockDatasetService "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
+	mock_store "github.com/Zampfi/application-platform/services/api/mocks/db/store"
+	dataplatformmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateFxRateSource(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	connectionId := uuid.New()
+	datasetId := uuid.New()
+
+	tests := []struct {
+		name      string
+		params    models.FxRateSourceParams
+		privilege storemodels.ResourcePrivilege
+		mockSetup func(*mock_store.MockStore)
+		wantErr   error
+	}{
+		{
+			name:   "Creates a file source",
+			params: models.FxRateSourceParams{Name: " ECB ", SourceType: storemodels.FxRateSourceTypeFile, ConnectionId: &connectionId},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().CreateFxRateSource(mock.Anything, mock.MatchedBy(func(params storemodels.CreateFxRateSourceParams) bool {
+					return params.Name == "ECB" && params.ConnectionId == nil && params.OrganizationId == orgId && params.CreatedBy == userId
+				})).Return(storemodels.FxRateSource{ID: uuid.New(), Name: "ECB", SourceType: storemodels.FxRateSourceTypeFile}, nil)
+			},
+		},
+		{
+			name:   "Creates a connector source",
+			params: models.FxRateSourceParams{Name: "Bank feed", SourceType: storemodels.FxRateSourceTypeConnector, ConnectionId: &connectionId, DatasetId: &datasetId},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetConnectionByID(mock.Anything, connectionId).Return(&storemodels.Connection{ID: connectionId}, nil)
+				ms.EXPECT().GetDatasetById(mock.Anything, datasetId.String()).Return(&storemodels.Dataset{}, nil)
+				ms.EXPECT().CreateFxRateSource(mock.Anything, mock.Anything).Return(storemodels.FxRateSource{ID: uuid.New(), SourceType: storemodels.FxRateSourceTypeConnector}, nil)
+			},
+		},
+		{
+			name:      "Rejects a connector source without a dataset",
+			params:    models.FxRateSourceParams{Name: "Bank feed", SourceType: storemodels.FxRateSourceTypeConnector, ConnectionId: &connectionId},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrInvalidFxRateSourceConfig,
+		},
+		{
+			name:   "Rejects an unknown connection",
+			params: models.FxRateSourceParams{Name: "Bank feed", SourceType: storemodels.FxRateSourceTypeConnector, ConnectionId: &connectionId, DatasetId: &datasetId},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetConnectionByID(mock.Anything, connectionId).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: errors.ErrFxRateSourceConnection,
+		},
+		{
+			name:      "Rejects an unknown source type",
+			params:    models.FxRateSourceParams{Name: "ECB", SourceType: "API"},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrInvalidFxRateSourceType,
+		},
+		{
+			name:      "Rejects an empty name",
+			params:    models.FxRateSourceParams{Name: " ", SourceType: storemodels.FxRateSourceTypeFile},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrEmptyFxRateSourceName,
+		},
+		{
+			name:      "Rejects a member who is not an admin of the organization",
+			params:    models.FxRateSourceParams{Name: "ECB", SourceType: storemodels.FxRateSourceTypeFile},
+			privilege: storemodels.PrivilegeOrganizationMember,
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrFxRateAccessForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			privilege := storemodels.PrivilegeOrganizationSystemAdmin
+			if tt.privilege != "" {
+				privilege = tt.privilege
+			}
+
+			mockStore := mock_store.NewMockStore(t)
+			mockStore.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgId, userId).Return(&storemodels.ResourceAudiencePolicy{Privilege: privilege}, nil)
+			tt.mockSetup(mockStore)
+
+			s := &fxService{store: mockStore}
+			_, err := s.CreateFxRateSource(context.Background(), orgId, userId, tt.params)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestImportFxRatesFromFile(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	sourceId := uuid.New()
+	april4 := time.Date(2025, 4, 4, 0, 0, 0, 0, time.UTC)
+	april7 := time.Date(2025, 4, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		config    string
+		file      string
+		mockSetup func(*mock_store.MockStore)
+		want      models.FxRateImportResult
+		wantErr   error
+	}{
+		{
+			name:   "Imports the rates, the last rate of a repeated day wins",
+			config: `{}`,
+			file:   "date,base_currency,quote_currency,rate\n2025-04-07,usd,EUR,0.91\n2025-04-04,GBP,EUR,1.17\n2025-04-07,USD,EUR,0.92\n",
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().UpsertFxRates(mock.Anything, orgId, sourceId, []storemodels.FxRateParams{
+					{RateDate: april7, BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: 0.92},
+					{RateDate: april4, BaseCurrency: "GBP", QuoteCurrency: "EUR", Rate: 1.17},
+				}).Return(nil)
+				ms.EXPECT().MarkFxRateSourceImported(mock.Anything, sourceId, mock.Anything).Return(nil)
+			},
+			want: models.FxRateImportResult{ImportedRates: 2, FromDate: &april4, ToDate: &april7},
+		},
+		{
+			name:   "Reads the columns and the date format of the config",
+			config: `{"date_column":"Day","base_currency_column":"From","quote_currency_column":"To","rate_column":"Value","date_format":"02/01/2006"}`,
+			file:   "Day,From,To,Value\n04/04/2025,GBP,EUR,1.17\n",
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().UpsertFxRates(mock.Anything, orgId, sourceId, []storemodels.FxRateParams{
+					{RateDate: april4, BaseCurrency: "GBP", QuoteCurrency: "EUR", Rate: 1.17},
+				}).Return(nil)
+				ms.EXPECT().MarkFxRateSourceImported(mock.Anything, sourceId, mock.Anything).Return(nil)
+			},
+			want: models.FxRateImportResult{ImportedRates: 1, FromDate: &april4, ToDate: &april4},
+		},
+		{
+			name:      "Rejects a file without the rate column",
+			config:    `{}`,
+			file:      "date,base_currency,quote_currency\n2025-04-07,USD,EUR\n",
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrInvalidFxRateFile,
+		},
+		{
+			name:      "Rejects a negative rate",
+			config:    `{}`,
+			file:      "date,base_currency,quote_currency,rate\n2025-04-07,USD,EUR,-1\n",
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrInvalidFxRateRow,
+		},
+		{
+			name:      "Rejects an invalid currency",
+			config:    `{}`,
+			file:      "date,base_currency,quote_currency,rate\n2025-04-07,DOLLAR,EUR,0.9\n",
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrInvalidFxRateRow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			mockStore.EXPECT().GetFxRateSourceById(mock.Anything, sourceId).Return(storemodels.FxRateSource{
+				ID:             sourceId,
+				OrganizationId: orgId,
+				SourceType:     storemodels.FxRateSourceTypeFile,
+				Config:         []byte(tt.config),
+			}, nil)
+			mockStore.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgId, userId).Return(&storemodels.ResourceAudiencePolicy{Privilege: storemodels.PrivilegeOrganizationSystemAdmin}, nil)
+			tt.mockSetup(mockStore)
+
+			s := &fxService{store: mockStore}
+			got, err := s.ImportFxRatesFromFile(context.Background(), userId, sourceId, strings.NewReader(tt.file))
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestImportFxRatesFromConnector(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	sourceId := uuid.New()
+	datasetId := uuid.New()
+	april4 := time.Date(2025, 4, 4, 0, 0, 0, 0, time.UTC)
+	april7 := time.Date(2025, 4, 7, 0, 0, 0, 0, time.UTC)
+
+	mockStore := mock_store.NewMockStore(t)
+	mockDataset := mockDatasetService.NewMockDatasetService(t)
+
+	mockStore.EXPECT().GetFxRateSourceById(mock.Anything, sourceId).Return(storemodels.FxRateSource{
+		ID:             sourceId,
+		OrganizationId: orgId,
+		SourceType:     storemodels.FxRateSourceTypeConnector,
+		DatasetId:      &datasetId,
+	}, nil)
+	mockStore.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgId, userId).Return(&storemodels.ResourceAudiencePolicy{Privilege: storemodels.PrivilegeOrganizationSystemAdmin}, nil)
+	mockStore.EXPECT().GetLatestFxRateDate(mock.Anything, sourceId).Return(&april4, nil)
+	mockDataset.EXPECT().GetDataByDatasetId(mock.Anything, orgId, datasetId.String(), mock.MatchedBy(func(params datasetmodels.DatasetParams) bool {
+		return len(params.Filters.Conditions) == 1 && params.Filters.Conditions[0].Value == "2025-04-04" && params.Pagination.Page == 1
+	})).Return(datasetmodels.DatasetData{QueryResult: dataplatformmodels.QueryResult{Rows: dataplatformmodels.Rows{
+		{"date": april4, "base_currency": "USD", "quote_currency": "EUR", "rate": 0.91},
+		{"date": april7.Add(2 * time.Hour), "base_currency": "USD", "quote_currency": "EUR", "rate": "0.92"},
+	}}}, nil)
+	mockStore.EXPECT().UpsertFxRates(mock.Anything, orgId, sourceId, []storemodels.FxRateParams{
+		{RateDate: april4, BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: 0.91},
+		{RateDate: april7, BaseCurrency: "USD", QuoteCurrency: "EUR", Rate: 0.92},
+	}).Return(nil)
+	mockStore.EXPECT().MarkFxRateSourceImported(mock.Anything, sourceId, mock.Anything).Return(nil)
+
+	s := &fxService{store: mockStore, datasetService: mockDataset}
+	got, err := s.ImportFxRatesFromConnector(context.Background(), userId, sourceId)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, got.ImportedRates)
+}
+
+func TestFxRateSourceWritesOfNonAdmins(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	sourceId := uuid.New()
+
+	newStore := func(t *testing.T, policyErr error) *mock_store.MockStore {
+		mockStore := mock_store.NewMockStore(t)
+		mockStore.EXPECT().GetFxRateSourceById(mock.Anything, sourceId).Return(storemodels.FxRateSource{
+			ID:             sourceId,
+			OrganizationId: orgId,
+			SourceType:     storemodels.FxRateSourceTypeFile,
+		}, nil)
+		if policyErr != nil {
+			mockStore.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgId, userId).Return(nil, policyErr)
+		} else {
+			mockStore.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgId, userId).Return(&storemodels.ResourceAudiencePolicy{Privilege: storemodels.PrivilegeOrganizationMember}, nil)
+		}
+		return mockStore
+	}
+
+	t.Run("Import by a member", func(t *testing.T) {
+		s := &fxService{store: newStore(t, nil)}
+		_, err := s.ImportFxRatesFromFile(context.Background(), userId, sourceId, strings.NewReader("date,base_currency,quote_currency,rate\n"))
+		assert.ErrorIs(t, err, errors.ErrFxRateAccessForbidden)
+	})
+
+	t.Run("Delete by a user outside the organization", func(t *testing.T) {
+		s := &fxService{store: newStore(t, gorm.ErrRecordNotFound)}
+		err := s.DeleteFxRateSource(context.Background(), userId, sourceId)
+		assert.ErrorIs(t, err, errors.ErrFxRateAccessForbidden)
+	})
+}
+
+func TestGetFxRate(t *testing.T) {
+	date := time.Date(2025, 4, 6, 0, 0, 0, 0, time.UTC)
+	friday := time.Date(2025, 4, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		base      string
+		quote     string
+		mockSetup func(*mock_store.MockStore)
+		want      models.EffectiveFxRate
+		wantErr   error
+	}{
+		{
+			name:  "Uses the rate of the pair",
+			base:  "usd",
+			quote: "EUR",
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetFxRateOnDate(mock.Anything, "USD", "EUR", date).Return(storemodels.FxRate{RateDate: friday, Rate: 0.8}, nil)
+			},
+			want: models.EffectiveFxRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Date: date, RateDate: friday, Rate: 0.8},
+		},
+		{
+			name:  "Inverts the rate of the opposite pair",
+			base:  "USD",
+			quote: "EUR",
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetFxRateOnDate(mock.Anything, "USD", "EUR", date).Return(storemodels.FxRate{}, gorm.ErrRecordNotFound)
+				ms.EXPECT().GetFxRateOnDate(mock.Anything, "EUR", "USD", date).Return(storemodels.FxRate{RateDate: friday, Rate: 1.25}, nil)
+			},
+			want: models.EffectiveFxRate{BaseCurrency: "USD", QuoteCurrency: "EUR", Date: date, RateDate: friday, Rate: 0.8, Inverted: true},
+		},
+		{
+			name:      "Same currency",
+			base:      "EUR",
+			quote:     "eur",
+			mockSetup: func(ms *mock_store.MockStore) {},
+			want:      models.EffectiveFxRate{BaseCurrency: "EUR", QuoteCurrency: "EUR", Date: date, RateDate: date, Rate: 1},
+		},
+		{
+			name:  "No rate",
+			base:  "USD",
+			quote: "EUR",
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetFxRateOnDate(mock.Anything, "USD", "EUR", date).Return(storemodels.FxRate{}, gorm.ErrRecordNotFound)
+				ms.EXPECT().GetFxRateOnDate(mock.Anything, "EUR", "USD", date).Return(storemodels.FxRate{}, gorm.ErrRecordNotFound)
+			},
+			wantErr: errors.ErrFxRateNotFound,
+		},
+		{
+			name:      "Invalid currency",
+			base:      "US",
+			quote:     "EUR",
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrInvalidCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			tt.mockSetup(mockStore)
+
+			s := &fxService{store: mockStore}
+			got, err := s.GetFxRate(context.Background(), tt.base, tt.quote, date)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
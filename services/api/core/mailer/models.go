@@ -6,3 +6,28 @@ type InvitationEmailData struct {
 	OrganizationName   string
 	InvitationLink     string
 }
+
+type DatasetExportEmailData struct {
+	RecipientEmails []string
+	ScheduleName    string
+	DatasetName     string
+	DownloadLink    string
+	LinkExpiresOn   string
+}
+
+type DatasetExportFailureEmailData struct {
+	RecipientEmail string
+	ScheduleName   string
+	DatasetName    string
+	FailedAt       string
+	Error          string
+}
+
+type DatasetAlertEmailData struct {
+	RecipientEmails []string
+	AlertName       string
+	DatasetName     string
+	Status          string
+	Message         string
+	EvaluatedAt     string
+}
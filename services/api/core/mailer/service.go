@@ -3,6 +3,7 @@ package mailer
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	emailtemplates "github.com/Zampfi/application-platform/services/api/core/mailer/email_templates"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
@@ -12,6 +13,9 @@ import (
 
 type MailerService interface {
 	SendInvitationEmail(ctx context.Context, data InvitationEmailData) error
+	SendDatasetExportEmail(ctx context.Context, data DatasetExportEmailData) error
+	SendDatasetExportFailureEmail(ctx context.Context, data DatasetExportFailureEmailData) error
+	SendDatasetAlertEmail(ctx context.Context, data DatasetAlertEmailData) error
 }
 
 type mailerService struct {
@@ -55,3 +59,73 @@ func (m mailerService) SendInvitationEmail(ctx context.Context, data InvitationE
 
 	return nil
 }
+
+func (m mailerService) SendDatasetExportEmail(ctx context.Context, data DatasetExportEmailData) error {
+	ctxlogger := apicontext.GetLoggerFromCtx(ctx)
+
+	exportTemplate, err := m.templater.GetTemplate("dataset_export_delivery", m.emailTemplatesPath, map[string]string{
+		"schedule_name":   data.ScheduleName,
+		"dataset_name":    data.DatasetName,
+		"download_link":   data.DownloadLink,
+		"link_expires_on": data.LinkExpiresOn,
+	})
+	if err != nil {
+		ctxlogger.Error("failed to get dataset export template", zap.Error(err))
+		return err
+	}
+
+	err = m.sparkpostClient.SendEmail(ctx, m.fromEmail, fmt.Sprintf("Your scheduled export %s is ready", data.ScheduleName), exportTemplate, data.RecipientEmails)
+	if err != nil {
+		ctxlogger.Error("failed to send dataset export email", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (m mailerService) SendDatasetExportFailureEmail(ctx context.Context, data DatasetExportFailureEmailData) error {
+	ctxlogger := apicontext.GetLoggerFromCtx(ctx)
+
+	failureTemplate, err := m.templater.GetTemplate("dataset_export_failure", m.emailTemplatesPath, map[string]string{
+		"schedule_name": data.ScheduleName,
+		"dataset_name":  data.DatasetName,
+		"failed_at":     data.FailedAt,
+		"error":         data.Error,
+	})
+	if err != nil {
+		ctxlogger.Error("failed to get dataset export failure template", zap.Error(err))
+		return err
+	}
+
+	err = m.sparkpostClient.SendEmail(ctx, m.fromEmail, fmt.Sprintf("Scheduled export %s failed", data.ScheduleName), failureTemplate, []string{data.RecipientEmail})
+	if err != nil {
+		ctxlogger.Error("failed to send dataset export failure email", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (m mailerService) SendDatasetAlertEmail(ctx context.Context, data DatasetAlertEmailData) error {
+	ctxlogger := apicontext.GetLoggerFromCtx(ctx)
+
+	alertTemplate, err := m.templater.GetTemplate("dataset_alert", m.emailTemplatesPath, map[string]string{
+		"alert_name":   data.AlertName,
+		"dataset_name": data.DatasetName,
+		"status":       strings.ToLower(data.Status),
+		"evaluated_at": data.EvaluatedAt,
+		"message":      data.Message,
+	})
+	if err != nil {
+		ctxlogger.Error("failed to get dataset alert template", zap.Error(err))
+		return err
+	}
+
+	err = m.sparkpostClient.SendEmail(ctx, m.fromEmail, fmt.Sprintf("[%s] %s", data.Status, data.AlertName), alertTemplate, data.RecipientEmails)
+	if err != nil {
+		ctxlogger.Error("failed to send dataset alert email", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
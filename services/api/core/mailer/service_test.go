@@ -114,3 +114,71 @@ func TestMailerService_SendInvitationEmail(t *testing.T) {
 		})
 	}
 }
+
+func TestMailerService_SendDatasetAlertEmail(t *testing.T) {
+	testData := DatasetAlertEmailData{
+		RecipientEmails: []string{"finance@example.com", "ops@example.com"},
+		AlertName:       "Unreconciled amount",
+		DatasetName:     "Payouts",
+		Status:          "FIRING",
+		Message:         "value 62000 > 50000",
+		EvaluatedAt:     "2 Apr 2025 09:00 UTC",
+	}
+
+	expectedTemplateData := map[string]string{
+		"alert_name":   testData.AlertName,
+		"dataset_name": testData.DatasetName,
+		"status":       "firing",
+		"evaluated_at": testData.EvaluatedAt,
+		"message":      testData.Message,
+	}
+
+	tests := []struct {
+		name          string
+		mockSetup     func(templater *mock_emailtemplates.MockTemplater, sparkpostClient *mock_sparkpost.MockSparkPostClient)
+		expectedError error
+	}{
+		{
+			name: "successful alert email",
+			mockSetup: func(templater *mock_emailtemplates.MockTemplater, sparkpostClient *mock_sparkpost.MockSparkPostClient) {
+				templater.On("GetTemplate", "dataset_alert", "/templates", expectedTemplateData).
+					Return("<html>Test template</html>", nil).Once()
+				sparkpostClient.On("SendEmail",
+					context.Background(),
+					"from@test.com",
+					"[FIRING] Unreconciled amount",
+					"<html>Test template</html>",
+					testData.RecipientEmails,
+				).Return(nil).Once()
+			},
+		},
+		{
+			name: "template error",
+			mockSetup: func(templater *mock_emailtemplates.MockTemplater, sparkpostClient *mock_sparkpost.MockSparkPostClient) {
+				templater.On("GetTemplate", "dataset_alert", "/templates", mock.Anything).
+					Return("", fmt.Errorf("template error")).Once()
+			},
+			expectedError: fmt.Errorf("template error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTemplater := mock_emailtemplates.NewMockTemplater(t)
+			mockSparkpostClient := mock_sparkpost.NewMockSparkPostClient(t)
+			tt.mockSetup(mockTemplater, mockSparkpostClient)
+
+			service := NewMailerService(mockSparkpostClient, "from@test.com", "/templates")
+			service.(*mailerService).templater = mockTemplater
+
+			err := service.SendDatasetAlertEmail(context.Background(), testData)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package calendar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	PeriodDay     = "day"
+	PeriodWeek    = "week"
+	PeriodMonth   = "month"
+	PeriodQuarter = "quarter"
+	PeriodYear    = "year"
+)
+
+const (
+	Pattern445 = "4-4-5"
+	Pattern454 = "4-5-4"
+	Pattern544 = "5-4-4"
+)
+
+// weeksPerQuarter is the length of the quarters of a fiscal year following a pattern, the last quarter takes the week
+// left over in a 53 week year
+const weeksPerQuarter = 13
+
+var ErrInvalidSettings = errors.New("invalid calendar settings")
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// patternWeeks are the weeks of the months of a quarter for every pattern
+var patternWeeks = map[string][3]int{
+	Pattern445: {4, 4, 5},
+	Pattern454: {4, 5, 4},
+	Pattern544: {5, 4, 4},
+}
+
+// Settings is the calendar an organization reports in. The zero value is the calendar the server uses: server time,
+// years starting in January, weeks starting on Monday and calendar months. A pattern splits the fiscal year into 52 or
+// 53 weeks starting on the week start on or before the first day of the fiscal year, with quarters of 13 weeks
+type Settings struct {
+	Timezone             string `json:"timezone,omitempty"`
+	FiscalYearStartMonth int    `json:"fiscal_year_start_month,omitempty"`
+	WeekStart            string `json:"week_start,omitempty"`
+	Pattern              string `json:"pattern,omitempty"`
+}
+
+// Parse reads the settings stored with an organization, an organization without any has the zero value
+func Parse(raw json.RawMessage) (Settings, error) {
+	settings := Settings{}
+	if len(raw) == 0 {
+		return settings, nil
+	}
+
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return Settings{}, fmt.Errorf("failed to parse calendar settings: %w", err)
+	}
+	return settings, nil
+}
+
+// Validate checks the settings name a timezone, month, weekday and pattern the calendar knows
+func (s Settings) Validate() error {
+	if s.Timezone != "" {
+		if _, err := time.LoadLocation(s.Timezone); err != nil {
+			return fmt.Errorf("%w: unknown timezone %s", ErrInvalidSettings, s.Timezone)
+		}
+	}
+
+	if s.FiscalYearStartMonth < 0 || s.FiscalYearStartMonth > 12 {
+		return fmt.Errorf("%w: fiscal year start month must be between 1 and 12", ErrInvalidSettings)
+	}
+
+	if _, ok := weekdays[s.WeekStart]; s.WeekStart != "" && !ok {
+		return fmt.Errorf("%w: unknown week start %s", ErrInvalidSettings, s.WeekStart)
+	}
+
+	if _, ok := patternWeeks[s.Pattern]; s.Pattern != "" && !ok {
+		return fmt.Errorf("%w: unknown pattern %s", ErrInvalidSettings, s.Pattern)
+	}
+
+	return nil
+}
+
+// Location is the timezone of the calendar, server time when the organization has none
+func (s Settings) Location() *time.Location {
+	if s.Timezone == "" {
+		return time.Local
+	}
+
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.Local
+	}
+	return location
+}
+
+// Now is the current time in the timezone of the calendar
+func (s Settings) Now() time.Time {
+	return time.Now().In(s.Location())
+}
+
+// YearStartMonth is the month the fiscal year starts in
+func (s Settings) YearStartMonth() time.Month {
+	if s.FiscalYearStartMonth == 0 {
+		return time.January
+	}
+	return time.Month(s.FiscalYearStartMonth)
+}
+
+// FirstWeekday is the day weeks start on
+func (s Settings) FirstWeekday() time.Weekday {
+	if weekday, ok := weekdays[s.WeekStart]; ok {
+		return weekday
+	}
+	return time.Monday
+}
+
+// PeriodStart is the start of the period of the calendar the time is in
+func (s Settings) PeriodStart(t time.Time, period string) (time.Time, error) {
+	start, _, err := s.periodBounds(t, period)
+	return start, err
+}
+
+// PeriodEnd is the last second of the period of the calendar the time is in
+func (s Settings) PeriodEnd(t time.Time, period string) (time.Time, error) {
+	_, next, err := s.periodBounds(t, period)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return next.Add(-time.Second), nil
+}
+
+// periodBounds returns the start of the period the time is in and the start of the period after it
+func (s Settings) periodBounds(t time.Time, period string) (time.Time, time.Time, error) {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	switch period {
+	case PeriodDay:
+		return day, day.AddDate(0, 0, 1), nil
+
+	case PeriodWeek:
+		start := day.AddDate(0, 0, -s.daysSinceWeekStart(day.Weekday()))
+		return start, start.AddDate(0, 0, 7), nil
+
+	case PeriodMonth, PeriodQuarter, PeriodYear:
+		if weeks, ok := s.PatternWeeks(); ok {
+			return s.patternBounds(day, period, weeks)
+		}
+
+		months := map[string]int{PeriodMonth: 1, PeriodQuarter: 3, PeriodYear: 12}[period]
+		monthsIntoYear := (int(day.Month()) - int(s.YearStartMonth()) + 12) % 12
+		start := time.Date(day.Year(), day.Month()-time.Month(monthsIntoYear%months), 1, 0, 0, 0, 0, day.Location())
+		return start, start.AddDate(0, months, 0), nil
+
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid periodicity: %s", period)
+	}
+}
+
+// PatternWeeks are the weeks of the months of every quarter when the fiscal year follows a pattern
+func (s Settings) PatternWeeks() ([3]int, bool) {
+	weeks, ok := patternWeeks[s.Pattern]
+	return weeks, ok
+}
+
+func (s Settings) patternBounds(day time.Time, period string, weeks [3]int) (time.Time, time.Time, error) {
+	year := day.Year() + 1
+	yearStart := s.fiscalYearStart(year, day.Location())
+	for day.Before(yearStart) {
+		year--
+		yearStart = s.fiscalYearStart(year, day.Location())
+	}
+	nextYearStart := s.fiscalYearStart(year+1, day.Location())
+
+	week := int(day.Sub(yearStart).Round(24*time.Hour)/(24*time.Hour)) / 7
+	quarter := min(week/weeksPerQuarter, 3)
+	quarterStart := yearStart.AddDate(0, 0, 7*weeksPerQuarter*quarter)
+	quarterEnd := quarterStart.AddDate(0, 0, 7*weeksPerQuarter)
+	if quarter == 3 {
+		quarterEnd = nextYearStart
+	}
+
+	switch period {
+	case PeriodYear:
+		return yearStart, nextYearStart, nil
+
+	case PeriodQuarter:
+		return quarterStart, quarterEnd, nil
+
+	default:
+		monthStart := quarterStart
+		weekIntoQuarter := week - weeksPerQuarter*quarter
+		for month, monthWeeks := range weeks {
+			monthEnd := monthStart.AddDate(0, 0, 7*monthWeeks)
+			if month == len(weeks)-1 {
+				monthEnd = quarterEnd
+			}
+			if weekIntoQuarter < monthWeeks || month == len(weeks)-1 {
+				return monthStart, monthEnd, nil
+			}
+			weekIntoQuarter -= monthWeeks
+			monthStart = monthEnd
+		}
+		return monthStart, quarterEnd, nil
+	}
+}
+
+// fiscalYearStart is the start of the fiscal year beginning in the year with a pattern, the week start on or before the
+// first day of the fiscal year start month
+func (s Settings) fiscalYearStart(year int, location *time.Location) time.Time {
+	first := time.Date(year, s.YearStartMonth(), 1, 0, 0, 0, 0, location)
+	return first.AddDate(0, 0, -s.daysSinceWeekStart(first.Weekday()))
+}
+
+func (s Settings) daysSinceWeekStart(weekday time.Weekday) int {
+	return (int(weekday) - int(s.FirstWeekday()) + 7) % 7
+}
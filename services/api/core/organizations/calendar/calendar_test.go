@@ -0,0 +1,100 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettings_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		settings Settings
+		wantErr  bool
+	}{
+		{name: "zero value", settings: Settings{}},
+		{name: "fiscal calendar", settings: Settings{Timezone: "Asia/Kolkata", FiscalYearStartMonth: 4, WeekStart: "sunday", Pattern: Pattern445}},
+		{name: "unknown timezone", settings: Settings{Timezone: "Mars/Olympus"}, wantErr: true},
+		{name: "month out of range", settings: Settings{FiscalYearStartMonth: 13}, wantErr: true},
+		{name: "unknown week start", settings: Settings{WeekStart: "someday"}, wantErr: true},
+		{name: "unknown pattern", settings: Settings{Pattern: "6-6-1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.settings.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSettings)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSettings_PeriodBounds(t *testing.T) {
+	t.Parallel()
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	// a Wednesday
+	at := time.Date(2025, time.February, 12, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		settings  Settings
+		time      time.Time
+		period    string
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   bool
+	}{
+		{name: "day", time: at, period: PeriodDay, wantStart: date(2025, 2, 12), wantEnd: date(2025, 2, 13)},
+		{name: "week starting on monday", time: at, period: PeriodWeek, wantStart: date(2025, 2, 10), wantEnd: date(2025, 2, 17)},
+		{name: "week starting on sunday", settings: Settings{WeekStart: "sunday"}, time: at, period: PeriodWeek, wantStart: date(2025, 2, 9), wantEnd: date(2025, 2, 16)},
+		{name: "calendar quarter", time: at, period: PeriodQuarter, wantStart: date(2025, 1, 1), wantEnd: date(2025, 4, 1)},
+		{name: "fiscal year starting in april", settings: Settings{FiscalYearStartMonth: 4}, time: at, period: PeriodYear, wantStart: date(2024, 4, 1), wantEnd: date(2025, 4, 1)},
+		{name: "fiscal quarter starting in february", settings: Settings{FiscalYearStartMonth: 2}, time: at, period: PeriodQuarter, wantStart: date(2025, 2, 1), wantEnd: date(2025, 5, 1)},
+		{name: "4-4-5 year", settings: Settings{Pattern: Pattern445}, time: at, period: PeriodYear, wantStart: date(2024, 12, 30), wantEnd: date(2025, 12, 29)},
+		{name: "4-4-5 second month", settings: Settings{Pattern: Pattern445}, time: at, period: PeriodMonth, wantStart: date(2025, 1, 27), wantEnd: date(2025, 2, 24)},
+		{name: "4-4-5 third month", settings: Settings{Pattern: Pattern445}, time: date(2025, 3, 1), period: PeriodMonth, wantStart: date(2025, 2, 24), wantEnd: date(2025, 3, 31)},
+		{name: "5-4-4 quarter of a fiscal year starting in april", settings: Settings{FiscalYearStartMonth: 4, Pattern: Pattern544}, time: at, period: PeriodQuarter, wantStart: date(2024, 12, 30), wantEnd: date(2025, 3, 31)},
+		{name: "last quarter of a 53 week year", settings: Settings{Pattern: Pattern445}, time: date(2023, 12, 31), period: PeriodMonth, wantStart: date(2023, 11, 20), wantEnd: date(2024, 1, 1)},
+		{name: "unknown periodicity", time: at, period: "decade", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			start, err := tt.settings.PeriodStart(tt.time, tt.period)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			end, err := tt.settings.PeriodEnd(tt.time, tt.period)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd.Add(-time.Second), end)
+		})
+	}
+}
+
+func TestSettings_Location(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Local, Settings{}.Location())
+	assert.Equal(t, "Asia/Kolkata", Settings{Timezone: "Asia/Kolkata"}.Location().String())
+	assert.Equal(t, "Asia/Kolkata", Settings{Timezone: "Asia/Kolkata"}.Now().Location().String())
+}
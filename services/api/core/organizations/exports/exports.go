@@ -0,0 +1,85 @@
+package exports
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	datasetconstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+)
+
+var ErrInvalidPrefix = errors.New("invalid export prefix")
+var ErrInvalidSettings = errors.New("invalid export settings")
+
+// bucketNameRegex accepts the bucket names both gcs and s3 allow
+var bucketNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,61}[a-z0-9]$`)
+
+var providers = []string{datasetconstants.ExportStorageProviderGCS, datasetconstants.ExportStorageProviderS3}
+
+// Settings are the buckets of each storage provider the scheduled exports of an organization are delivered to. They
+// are configured on the server for the organization, users only choose the folder of a schedule within a bucket
+type Settings struct {
+	Buckets map[string]string `json:"buckets,omitempty"`
+}
+
+// Parse reads the settings stored with an organization, an organization without any has no bucket to export to
+func Parse(raw json.RawMessage) (Settings, error) {
+	settings := Settings{}
+	if len(raw) == 0 {
+		return settings, nil
+	}
+
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return Settings{}, fmt.Errorf("failed to parse export settings: %w", err)
+	}
+	return settings, nil
+}
+
+// Validate checks the settings name a valid bucket of a provider exports are delivered to, a provider is left out to
+// stop exporting to it
+func (s Settings) Validate() error {
+	for provider, bucket := range s.Buckets {
+		if !slices.Contains(providers, provider) {
+			return fmt.Errorf("%w: unknown provider %s", ErrInvalidSettings, provider)
+		}
+		if !bucketNameRegex.MatchString(bucket) {
+			return fmt.Errorf("%w: invalid %s bucket %q", ErrInvalidSettings, provider, bucket)
+		}
+	}
+
+	return nil
+}
+
+// Bucket is the bucket the organization exports to on the provider
+func (s Settings) Bucket(provider string) (string, bool) {
+	bucket := strings.TrimSpace(s.Buckets[provider])
+	return bucket, bucket != ""
+}
+
+// CleanPrefix trims the slashes around the folder of a schedule, a folder cannot step out of the bucket of the
+// organization with a .. segment
+func CleanPrefix(prefix string) (string, error) {
+	prefix = strings.Trim(strings.TrimSpace(prefix), "/")
+	for _, segment := range strings.Split(prefix, "/") {
+		if segment == ".." || segment == "." || (segment == "" && prefix != "") {
+			return "", fmt.Errorf("%w: %q", ErrInvalidPrefix, prefix)
+		}
+	}
+	return prefix, nil
+}
+
+// ObjectKey is the key of the file in the folder of a schedule
+func ObjectKey(prefix string, fileName string) (string, error) {
+	prefix, err := CleanPrefix(prefix)
+	if err != nil {
+		return "", err
+	}
+	if prefix == "" {
+		return fileName, nil
+	}
+	return path.Join(prefix, fileName), nil
+}
@@ -0,0 +1,64 @@
+package exports
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	settings, err := Parse(json.RawMessage(`{"buckets":{"gcs":"acme-exports","s3":" "}}`))
+	assert.NoError(t, err)
+
+	bucket, ok := settings.Bucket("gcs")
+	assert.True(t, ok)
+	assert.Equal(t, "acme-exports", bucket)
+
+	_, ok = settings.Bucket("s3")
+	assert.False(t, ok)
+
+	settings, err = Parse(nil)
+	assert.NoError(t, err)
+	_, ok = settings.Bucket("gcs")
+	assert.False(t, ok)
+
+	_, err = Parse(json.RawMessage(`{"buckets":["acme-exports"]}`))
+	assert.Error(t, err)
+}
+
+func TestObjectKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		want    string
+		wantErr bool
+	}{
+		{name: "no prefix", prefix: "", want: "export.csv"},
+		{name: "slashes around the prefix", prefix: " /payouts/daily/ ", want: "payouts/daily/export.csv"},
+		{name: "parent folder", prefix: "payouts/../../globex", wantErr: true},
+		{name: "prefix of the parent folder only", prefix: "..", wantErr: true},
+		{name: "current folder", prefix: "./payouts", wantErr: true},
+		{name: "empty folder", prefix: "payouts//daily", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ObjectKey(tt.prefix, "export.csv")
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPrefix)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Settings{}.Validate())
+	assert.NoError(t, Settings{Buckets: map[string]string{"gcs": "acme-exports", "s3": "acme.exports_eu"}}.Validate())
+	assert.ErrorIs(t, Settings{Buckets: map[string]string{"ftp": "acme-exports"}}.Validate(), ErrInvalidSettings)
+	assert.ErrorIs(t, Settings{Buckets: map[string]string{"gcs": ""}}.Validate(), ErrInvalidSettings)
+	assert.ErrorIs(t, Settings{Buckets: map[string]string{"s3": "Acme/Exports"}}.Validate(), ErrInvalidSettings)
+}
@@ -2,11 +2,14 @@ package organizations
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"slices"
 
 	serverconfig "github.com/Zampfi/application-platform/services/api/config"
 	"github.com/Zampfi/application-platform/services/api/core/mailer"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/exports"
 	"github.com/Zampfi/application-platform/services/api/core/organizations/teams"
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
@@ -34,6 +37,10 @@ type OrganizationService interface {
 	GetOrganizationMembershipRequestsAll(ctx context.Context) ([]models.OrganizationMembershipRequest, error)
 	ApprovePendingOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (*models.OrganizationMembershipRequest, error)
 	ValidateAudienceInOrganization(ctx context.Context, organizationId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error
+	GetCalendarSettings(ctx context.Context, organizationId uuid.UUID) (calendar.Settings, error)
+	UpdateCalendarSettings(ctx context.Context, organizationId uuid.UUID, settings calendar.Settings) (calendar.Settings, error)
+	GetExportSettings(ctx context.Context, organizationId uuid.UUID) (exports.Settings, error)
+	UpdateExportSettings(ctx context.Context, organizationId uuid.UUID, settings exports.Settings) (exports.Settings, error)
 	TeamService() teams.TeamService
 }
 
@@ -577,3 +584,130 @@ func (s *organizationService) ValidateAudienceInOrganization(ctx context.Context
 
 	return fmt.Errorf("audience not found in organization")
 }
+
+func (s *organizationService) GetCalendarSettings(ctx context.Context, organizationId uuid.UUID) (calendar.Settings, error) {
+
+	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
+
+	_, _, orgIds := apicontext.GetAuthFromContext(ctx)
+	if !slices.Contains(orgIds, organizationId) {
+		ctxLogger.Error("user does not have access to the organization", zap.String("organizationId", organizationId.String()))
+		return calendar.Settings{}, fmt.Errorf("forbidden")
+	}
+
+	organization, err := s.store.GetOrganizationById(ctx, organizationId.String())
+	if err != nil {
+		ctxLogger.Error("failed to get organization", zap.Error(err))
+		return calendar.Settings{}, err
+	}
+
+	return calendar.Parse(organization.CalendarSettings)
+}
+
+// UpdateCalendarSettings replaces the calendar the organization reports in, only its admins can change it
+func (s *organizationService) UpdateCalendarSettings(ctx context.Context, organizationId uuid.UUID, settings calendar.Settings) (calendar.Settings, error) {
+
+	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
+
+	_, currentUserId, orgIds := apicontext.GetAuthFromContext(ctx)
+	if currentUserId == nil || !slices.Contains(orgIds, organizationId) {
+		ctxLogger.Error("user does not have access to the organization", zap.String("organizationId", organizationId.String()))
+		return calendar.Settings{}, fmt.Errorf("forbidden")
+	}
+
+	policy, err := s.store.GetOrganizationPolicyByUser(ctx, organizationId, *currentUserId)
+	if err != nil {
+		ctxLogger.Error("failed to get organization policy", zap.Error(err))
+		return calendar.Settings{}, err
+	}
+
+	if policy == nil || policy.Privilege != models.PrivilegeOrganizationSystemAdmin {
+		ctxLogger.Error("user cannot change the calendar settings", zap.String("userId", currentUserId.String()))
+		return calendar.Settings{}, fmt.Errorf("forbidden")
+	}
+
+	if err := settings.Validate(); err != nil {
+		return calendar.Settings{}, err
+	}
+
+	calendarSettings, err := json.Marshal(settings)
+	if err != nil {
+		return calendar.Settings{}, fmt.Errorf("failed to marshal calendar settings: %w", err)
+	}
+
+	organization, err := s.store.UpdateOrganizationCalendarSettings(ctx, organizationId, calendarSettings)
+	if err != nil {
+		ctxLogger.Error("failed to update calendar settings", zap.Error(err))
+		return calendar.Settings{}, err
+	}
+
+	return calendar.Parse(organization.CalendarSettings)
+}
+
+// GetExportSettings returns the buckets the scheduled exports of the organization are delivered to, only its admins can
+// see them
+func (s *organizationService) GetExportSettings(ctx context.Context, organizationId uuid.UUID) (exports.Settings, error) {
+
+	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.checkOrganizationAdmin(ctx, organizationId); err != nil {
+		ctxLogger.Error("user cannot see the export settings", zap.String("organizationId", organizationId.String()))
+		return exports.Settings{}, err
+	}
+
+	organization, err := s.store.GetOrganizationById(ctx, organizationId.String())
+	if err != nil {
+		ctxLogger.Error("failed to get organization", zap.Error(err))
+		return exports.Settings{}, err
+	}
+
+	return exports.Parse(organization.ExportSettings)
+}
+
+// UpdateExportSettings replaces the buckets the scheduled exports of the organization are delivered to, only its admins
+// can change them
+func (s *organizationService) UpdateExportSettings(ctx context.Context, organizationId uuid.UUID, settings exports.Settings) (exports.Settings, error) {
+
+	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.checkOrganizationAdmin(ctx, organizationId); err != nil {
+		ctxLogger.Error("user cannot change the export settings", zap.String("organizationId", organizationId.String()))
+		return exports.Settings{}, err
+	}
+
+	if err := settings.Validate(); err != nil {
+		return exports.Settings{}, err
+	}
+
+	exportSettings, err := json.Marshal(settings)
+	if err != nil {
+		return exports.Settings{}, fmt.Errorf("failed to marshal export settings: %w", err)
+	}
+
+	organization, err := s.store.UpdateOrganizationExportSettings(ctx, organizationId, exportSettings)
+	if err != nil {
+		ctxLogger.Error("failed to update export settings", zap.Error(err))
+		return exports.Settings{}, err
+	}
+
+	return exports.Parse(organization.ExportSettings)
+}
+
+// checkOrganizationAdmin fails unless the user in context is a system admin of the organization
+func (s *organizationService) checkOrganizationAdmin(ctx context.Context, organizationId uuid.UUID) error {
+	_, currentUserId, orgIds := apicontext.GetAuthFromContext(ctx)
+	if currentUserId == nil || !slices.Contains(orgIds, organizationId) {
+		return fmt.Errorf("forbidden")
+	}
+
+	policy, err := s.store.GetOrganizationPolicyByUser(ctx, organizationId, *currentUserId)
+	if err != nil {
+		return err
+	}
+
+	if policy == nil || policy.Privilege != models.PrivilegeOrganizationSystemAdmin {
+		return fmt.Errorf("forbidden")
+	}
+
+	return nil
+}
@@ -2,12 +2,15 @@ package organizations
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
 
 	serverconfig "github.com/Zampfi/application-platform/services/api/config"
 	"github.com/Zampfi/application-platform/services/api/core/mailer"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/exports"
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
@@ -1646,3 +1649,208 @@ func TestOrganizationService_ValidateAudienceInOrganization(t *testing.T) {
 		})
 	}
 }
+
+func TestOrganizationService_UpdateCalendarSettings(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	actorID := uuid.New()
+	settings := calendar.Settings{Timezone: "Asia/Kolkata", FiscalYearStartMonth: 4}
+	stored := json.RawMessage(`{"timezone":"Asia/Kolkata","fiscal_year_start_month":4}`)
+
+	tests := []struct {
+		name      string
+		orgIDs    []uuid.UUID
+		settings  calendar.Settings
+		mockSetup func(*mock_store.MockStore)
+		want      calendar.Settings
+		wantErr   bool
+		errIs     error
+	}{
+		{
+			name:     "success",
+			orgIDs:   []uuid.UUID{orgID},
+			settings: settings,
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgID, actorID).Return(&models.ResourceAudiencePolicy{Privilege: models.PrivilegeOrganizationSystemAdmin}, nil)
+				m.EXPECT().UpdateOrganizationCalendarSettings(mock.Anything, orgID, stored).Return(&models.Organization{ID: orgID, CalendarSettings: stored}, nil)
+			},
+			want: settings,
+		},
+		{
+			name:      "not a member of the organization",
+			orgIDs:    []uuid.UUID{uuid.New()},
+			settings:  settings,
+			mockSetup: func(m *mock_store.MockStore) {},
+			wantErr:   true,
+		},
+		{
+			name:     "not an admin of the organization",
+			orgIDs:   []uuid.UUID{orgID},
+			settings: settings,
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgID, actorID).Return(&models.ResourceAudiencePolicy{Privilege: models.PrivilegeOrganizationMember}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "invalid settings",
+			orgIDs:   []uuid.UUID{orgID},
+			settings: calendar.Settings{Timezone: "Mars/Olympus"},
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgID, actorID).Return(&models.ResourceAudiencePolicy{Privilege: models.PrivilegeOrganizationSystemAdmin}, nil)
+			},
+			wantErr: true,
+			errIs:   calendar.ErrInvalidSettings,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mock_store.NewMockStore(t)
+			ctx := context.WithValue(apicontext.AddAuthToContext(context.Background(), "user", actorID, tt.orgIDs), "logger", zap.NewNop())
+			tt.mockSetup(mockStore)
+
+			service := organizationService{store: mockStore}
+			got, err := service.UpdateCalendarSettings(ctx, orgID, tt.settings)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOrganizationService_GetCalendarSettings(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	ctx := context.WithValue(apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{orgID}), "logger", zap.NewNop())
+
+	mockStore := mock_store.NewMockStore(t)
+	mockStore.EXPECT().GetOrganizationById(mock.Anything, orgID.String()).Return(&models.Organization{ID: orgID, CalendarSettings: json.RawMessage(`{"week_start":"sunday","pattern":"4-4-5"}`)}, nil).Once()
+	mockStore.EXPECT().GetOrganizationById(mock.Anything, orgID.String()).Return(&models.Organization{ID: orgID}, nil).Once()
+
+	service := organizationService{store: mockStore}
+
+	got, err := service.GetCalendarSettings(ctx, orgID)
+	assert.NoError(t, err)
+	assert.Equal(t, calendar.Settings{WeekStart: "sunday", Pattern: calendar.Pattern445}, got)
+
+	// an organization which never set its calendar uses the calendar of the server
+	got, err = service.GetCalendarSettings(ctx, orgID)
+	assert.NoError(t, err)
+	assert.Equal(t, calendar.Settings{}, got)
+}
+
+func TestOrganizationService_UpdateExportSettings(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	actorID := uuid.New()
+	settings := exports.Settings{Buckets: map[string]string{"gcs": "acme-exports"}}
+	stored := json.RawMessage(`{"buckets":{"gcs":"acme-exports"}}`)
+
+	tests := []struct {
+		name      string
+		orgIDs    []uuid.UUID
+		settings  exports.Settings
+		mockSetup func(*mock_store.MockStore)
+		want      exports.Settings
+		wantErr   bool
+		errIs     error
+	}{
+		{
+			name:     "success",
+			orgIDs:   []uuid.UUID{orgID},
+			settings: settings,
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgID, actorID).Return(&models.ResourceAudiencePolicy{Privilege: models.PrivilegeOrganizationSystemAdmin}, nil)
+				m.EXPECT().UpdateOrganizationExportSettings(mock.Anything, orgID, stored).Return(&models.Organization{ID: orgID, ExportSettings: stored}, nil)
+			},
+			want: settings,
+		},
+		{
+			name:      "not a member of the organization",
+			orgIDs:    []uuid.UUID{uuid.New()},
+			settings:  settings,
+			mockSetup: func(m *mock_store.MockStore) {},
+			wantErr:   true,
+		},
+		{
+			name:     "not an admin of the organization",
+			orgIDs:   []uuid.UUID{orgID},
+			settings: settings,
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgID, actorID).Return(&models.ResourceAudiencePolicy{Privilege: models.PrivilegeOrganizationMember}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:     "invalid settings",
+			orgIDs:   []uuid.UUID{orgID},
+			settings: exports.Settings{Buckets: map[string]string{"gcs": "Acme Exports"}},
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgID, actorID).Return(&models.ResourceAudiencePolicy{Privilege: models.PrivilegeOrganizationSystemAdmin}, nil)
+			},
+			wantErr: true,
+			errIs:   exports.ErrInvalidSettings,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mock_store.NewMockStore(t)
+			ctx := context.WithValue(apicontext.AddAuthToContext(context.Background(), "user", actorID, tt.orgIDs), "logger", zap.NewNop())
+			tt.mockSetup(mockStore)
+
+			service := organizationService{store: mockStore}
+			got, err := service.UpdateExportSettings(ctx, orgID, tt.settings)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOrganizationService_GetExportSettings(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	actorID := uuid.New()
+	ctx := context.WithValue(apicontext.AddAuthToContext(context.Background(), "user", actorID, []uuid.UUID{orgID}), "logger", zap.NewNop())
+
+	mockStore := mock_store.NewMockStore(t)
+	mockStore.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgID, actorID).Return(&models.ResourceAudiencePolicy{Privilege: models.PrivilegeOrganizationSystemAdmin}, nil).Once()
+	mockStore.EXPECT().GetOrganizationById(mock.Anything, orgID.String()).Return(&models.Organization{ID: orgID, ExportSettings: json.RawMessage(`{"buckets":{"s3":"acme-exports"}}`)}, nil).Once()
+	mockStore.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgID, actorID).Return(&models.ResourceAudiencePolicy{Privilege: models.PrivilegeOrganizationMember}, nil).Once()
+
+	service := organizationService{store: mockStore}
+
+	got, err := service.GetExportSettings(ctx, orgID)
+	assert.NoError(t, err)
+	assert.Equal(t, exports.Settings{Buckets: map[string]string{"s3": "acme-exports"}}, got)
+
+	// members which are not admins cannot see the buckets
+	_, err = service.GetExportSettings(ctx, orgID)
+	assert.Error(t, err)
+}
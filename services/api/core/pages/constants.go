@@ -0,0 +1,20 @@
+package pages
+
+// Audit log events of pages and their content, all logged against the page
+const (
+	AuditLogEventPageCreated              = "page_created"
+	AuditLogEventPageUpdated              = "page_updated"
+	AuditLogEventPageDuplicated           = "page_duplicated"
+	AuditLogEventPageDeleted              = "page_deleted"
+	AuditLogEventSheetCreated             = "sheet_created"
+	AuditLogEventSheetUpdated             = "sheet_updated"
+	AuditLogEventSheetDuplicated          = "sheet_duplicated"
+	AuditLogEventSheetDeleted             = "sheet_deleted"
+	AuditLogEventWidgetInstanceCreated    = "widget_instance_created"
+	AuditLogEventWidgetInstanceUpdated    = "widget_instance_updated"
+	AuditLogEventWidgetInstanceDuplicated = "widget_instance_duplicated"
+	AuditLogEventWidgetInstanceDeleted    = "widget_instance_deleted"
+)
+
+// DuplicateNameSuffix is appended to the name of a copy when no name is given
+const DuplicateNameSuffix = " (copy)"
@@ -0,0 +1,9 @@
+package pages
+
+import "errors"
+
+var (
+	ErrPageNotFound        = errors.New("page not found")
+	ErrPageAccessForbidden = errors.New("current user is not an admin of the page")
+	ErrEmptyPageName       = errors.New("page name cannot be empty")
+)
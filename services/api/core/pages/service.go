@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
@@ -14,6 +15,10 @@ import (
 
 type PagesServiceStore interface {
 	store.PageStore
+	store.SheetStore
+	store.WidgetStore
+	store.FlattenedResourceAudiencePoliciesStore
+	store.TransactionStore
 	GetPagesPolicies(ctx context.Context, pageId uuid.UUID) ([]models.ResourceAudiencePolicy, error)
 }
 
@@ -26,6 +31,10 @@ type PagesService interface {
 	BulkAddAudienceToPage(ctx context.Context, pageId uuid.UUID, payload BulkAddPageAudiencePayload) ([]*models.ResourceAudiencePolicy, BulkAddPageAudienceErrors)
 	RemoveAudienceFromPage(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID) error
 	UpdatePageAudiencePrivilege(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)
+	CreatePage(ctx context.Context, payload CreatePagePayload) (*models.Page, error)
+	UpdatePage(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams) (*models.Page, error)
+	DuplicatePage(ctx context.Context, pageId uuid.UUID, name string) (*models.Page, error)
+	DeletePage(ctx context.Context, pageId uuid.UUID) error
 }
 
 type pagesService struct {
@@ -413,20 +422,24 @@ func (s *pagesService) CreatePage(ctx context.Context, payload CreatePagePayload
 		return nil, fmt.Errorf("no user ID found in the context")
 	}
 
+	if strings.TrimSpace(payload.PageName) == "" {
+		return nil, ErrEmptyPageName
+	}
+
 	var createdPage *models.Page
 
 	err := s.store.WithPageTransaction(ctx, func(ps store.PageStore) error {
-		page, err := s.store.CreatePage(ctx, payload.PageName, payload.PageDescription)
+		page, err := ps.CreatePage(ctx, strings.TrimSpace(payload.PageName), payload.PageDescription)
 		if err != nil {
 			return err
 		}
 
-		_, err = s.store.CreatePagePolicy(ctx, page.ID, models.AudienceTypeUser, *currentUserId, models.PrivilegePageAdmin)
+		_, err = ps.CreatePagePolicy(ctx, page.ID, models.AudienceTypeUser, *currentUserId, models.PrivilegePageAdmin)
 		if err != nil {
 			return err
 		}
 
-		page = createdPage
+		createdPage = page
 
 		return nil
 	})
@@ -438,6 +451,120 @@ func (s *pagesService) CreatePage(ctx context.Context, payload CreatePagePayload
 	return createdPage, nil
 }
 
+func (s *pagesService) UpdatePage(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams) (*models.Page, error) {
+	ctxlogger := apicontext.GetLoggerFromCtx(ctx)
+
+	if params.Name != nil {
+		name := strings.TrimSpace(*params.Name)
+		if name == "" {
+			return nil, ErrEmptyPageName
+		}
+		params.Name = &name
+	}
+
+	if _, err := s.getPage(ctx, pageId); err != nil {
+		return nil, err
+	}
+
+	if err := EnsurePageAdmin(ctx, s.store, pageId); err != nil {
+		ctxlogger.Info("current user cannot update the page", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	page, err := s.store.UpdatePage(ctx, pageId, params)
+	if err != nil {
+		ctxlogger.Error("failed to update page", zap.Error(err))
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// DuplicatePage copies the page with its sheets and widget instances, the current user becomes the admin of the copy
+func (s *pagesService) DuplicatePage(ctx context.Context, pageId uuid.UUID, name string) (*models.Page, error) {
+	ctxlogger := apicontext.GetLoggerFromCtx(ctx)
+
+	_, currentUserId, _ := apicontext.GetAuthFromContext(ctx)
+	if currentUserId == nil {
+		return nil, fmt.Errorf("no user ID found in the context")
+	}
+
+	page, err := s.getPage(ctx, pageId)
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = page.Name + DuplicateNameSuffix
+	}
+
+	description := ""
+	if page.Description != nil {
+		description = *page.Description
+	}
+
+	var duplicatedPageId uuid.UUID
+	err = s.store.WithTx(ctx, func(tx store.Store) error {
+		duplicatedPage, err := tx.CreatePage(ctx, name, description)
+		if err != nil {
+			return fmt.Errorf("failed to create page: %w", err)
+		}
+		duplicatedPageId = duplicatedPage.ID
+
+		_, err = tx.CreatePagePolicy(ctx, duplicatedPage.ID, models.AudienceTypeUser, *currentUserId, models.PrivilegePageAdmin)
+		if err != nil {
+			return fmt.Errorf("failed to create page policy: %w", err)
+		}
+
+		sheets, err := tx.GetSheetsAll(ctx, models.SheetFilters{
+			PageIds:                []uuid.UUID{pageId},
+			IncludeWidgetInstances: true,
+			SortParams:             []models.SheetSortParams{{Column: "fractional_index"}},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get sheets: %w", err)
+		}
+
+		for _, sheet := range sheets {
+			if sheet.DeletedAt != nil {
+				continue
+			}
+			if _, err := CopySheet(ctx, tx, sheet, duplicatedPage.ID, sheet.Name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		ctxlogger.Error("failed to duplicate page", zap.Error(err))
+		return nil, err
+	}
+
+	return s.store.GetPageById(ctx, duplicatedPageId)
+}
+
+func (s *pagesService) DeletePage(ctx context.Context, pageId uuid.UUID) error {
+	ctxlogger := apicontext.GetLoggerFromCtx(ctx)
+
+	if _, err := s.getPage(ctx, pageId); err != nil {
+		return err
+	}
+
+	if err := EnsurePageAdmin(ctx, s.store, pageId); err != nil {
+		ctxlogger.Info("current user cannot delete the page", zap.String("error", err.Error()))
+		return err
+	}
+
+	if err := s.store.DeletePage(ctx, pageId); err != nil {
+		ctxlogger.Error("failed to delete page", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
 func (s *pagesService) GetPagesByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.Page, error) {
 	ctxlogger := apicontext.GetLoggerFromCtx(ctx)
 
@@ -2,11 +2,16 @@ package pages
 
 import (
 	"context"
+	"encoding/json"
+	goerrors "errors"
 	"fmt"
 
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
 	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 func ensureCurrentUsersAdminAccess(ctx context.Context, policies []models.ResourceAudiencePolicy) error {
@@ -61,3 +66,97 @@ func ensureUserIsNotChangingTheirOwnAdminPolicy(policyToBeUpdated models.Resourc
 
 	return nil
 }
+
+// EnsurePageAdmin returns ErrPageAccessForbidden unless the current user is an admin of the page, directly or through
+// one of their teams or organizations
+func EnsurePageAdmin(ctx context.Context, policyStore store.FlattenedResourceAudiencePoliciesStore, pageId uuid.UUID) error {
+	_, currentUserId, _ := apicontext.GetAuthFromContext(ctx)
+	if currentUserId == nil {
+		return fmt.Errorf("no user ID found in the context")
+	}
+
+	adminPolicies, err := policyStore.GetFlattenedResourceAudiencePolicies(ctx, models.FlattenedResourceAudiencePoliciesFilters{
+		ResourceIds:   []uuid.UUID{pageId},
+		UserIds:       []uuid.UUID{*currentUserId},
+		ResourceTypes: []string{string(models.ResourceTypePage)},
+		Privileges:    []models.ResourcePrivilege{models.PrivilegePageAdmin},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get page policies: %w", err)
+	}
+
+	if len(adminPolicies) == 0 {
+		return ErrPageAccessForbidden
+	}
+
+	return nil
+}
+
+// SheetCopyStore creates the copy of a sheet and of its widget instances
+type SheetCopyStore interface {
+	store.SheetStore
+	store.WidgetStore
+}
+
+// CopySheet creates a copy of the sheet and of its widget instances on the page, the layout and the filters of the
+// copy point at the copied widget instances. The widget instances of the source sheet have to be loaded.
+func CopySheet(ctx context.Context, copyStore SheetCopyStore, source models.Sheet, pageId uuid.UUID, name string) (*models.Sheet, error) {
+	sheetConfig := sheetmodels.SheetConfig{}
+	if len(source.SheetConfig) > 0 {
+		if err := json.Unmarshal(source.SheetConfig, &sheetConfig); err != nil {
+			return nil, fmt.Errorf("failed to parse sheet config: %w", err)
+		}
+	}
+
+	sheet, err := copyStore.CreateSheet(ctx, models.Sheet{
+		Name:        name,
+		Description: source.Description,
+		PageId:      pageId,
+		SheetConfig: source.SheetConfig,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sheet: %w", err)
+	}
+
+	widgetInstanceIds := map[uuid.UUID]uuid.UUID{}
+	for _, widgetInstance := range source.WidgetInstances {
+		if widgetInstance.DeletedAt != nil {
+			continue
+		}
+
+		copied, err := copyStore.CreateWidgetInstance(ctx, &models.WidgetInstance{
+			WidgetType:    widgetInstance.WidgetType,
+			SheetID:       sheet.ID,
+			Title:         widgetInstance.Title,
+			DataMappings:  widgetInstance.DataMappings,
+			DisplayConfig: widgetInstance.DisplayConfig,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create widget instance: %w", err)
+		}
+		widgetInstanceIds[widgetInstance.ID] = copied.ID
+	}
+
+	sheet.SheetConfig, err = json.Marshal(sheetConfig.RemapWidgets(widgetInstanceIds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sheet config: %w", err)
+	}
+
+	return copyStore.UpdateSheet(ctx, sheet)
+}
+
+func (s *pagesService) getPage(ctx context.Context, pageId uuid.UUID) (*models.Page, error) {
+	page, err := s.store.GetPageById(ctx, pageId)
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPageNotFound
+		}
+		return nil, err
+	}
+
+	if page.DeletedAt != nil {
+		return nil, ErrPageNotFound
+	}
+
+	return page, nil
+}
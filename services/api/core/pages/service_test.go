@@ -2,9 +2,12 @@ package pages
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
@@ -14,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 func setupTest(t *testing.T) (PagesService, *mock_store.MockStore, context.Context) {
@@ -1062,6 +1066,16 @@ func TestCreatePage(t *testing.T) {
 			wantErr:     true,
 			expectedErr: "failed to create page policy",
 		},
+		{
+			name: "error - empty page name",
+			payload: CreatePagePayload{
+				PageName: " ",
+			},
+			currentUserId: &currentUserId,
+			mockSetup:     func(m *mock_store.MockStore) {},
+			wantErr:       true,
+			expectedErr:   ErrEmptyPageName.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1094,6 +1108,242 @@ func TestCreatePage(t *testing.T) {
 			}
 
 			assert.NoError(t, err)
+			assert.Equal(t, "Test Page", page.Name)
+		})
+	}
+}
+
+func expectPageAdmin(m *mock_store.MockStore, pageId uuid.UUID, isAdmin bool) {
+	policies := []models.FlattenedResourceAudiencePolicy{}
+	if isAdmin {
+		policies = append(policies, models.FlattenedResourceAudiencePolicy{ResourceId: pageId, Privilege: models.PrivilegePageAdmin})
+	}
+	m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.MatchedBy(func(filters models.FlattenedResourceAudiencePoliciesFilters) bool {
+		return len(filters.ResourceIds) == 1 && filters.ResourceIds[0] == pageId
+	})).Return(policies, nil)
+}
+
+func TestUpdatePage(t *testing.T) {
+	t.Parallel()
+
+	pageId := uuid.New()
+	name := "Revenue"
+	blank := "  "
+	fractionalIndex := 2.5
+
+	tests := []struct {
+		name      string
+		params    models.UpdatePageParams
+		mockSetup func(*mock_store.MockStore)
+		wantErr   error
+	}{
+		{
+			name:   "success",
+			params: models.UpdatePageParams{Name: &name, FractionalIndex: &fractionalIndex},
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetPageById(mock.Anything, pageId).Return(&models.Page{ID: pageId}, nil)
+				expectPageAdmin(m, pageId, true)
+				m.EXPECT().UpdatePage(mock.Anything, pageId, models.UpdatePageParams{Name: &name, FractionalIndex: &fractionalIndex}).Return(&models.Page{ID: pageId, Name: name, FractionalIndex: fractionalIndex}, nil)
+			},
+		},
+		{
+			name:      "empty name",
+			params:    models.UpdatePageParams{Name: &blank},
+			mockSetup: func(m *mock_store.MockStore) {},
+			wantErr:   ErrEmptyPageName,
+		},
+		{
+			name:   "page not found",
+			params: models.UpdatePageParams{Name: &name},
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetPageById(mock.Anything, pageId).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: ErrPageNotFound,
+		},
+		{
+			name:   "not a page admin",
+			params: models.UpdatePageParams{Name: &name},
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetPageById(mock.Anything, pageId).Return(&models.Page{ID: pageId}, nil)
+				expectPageAdmin(m, pageId, false)
+			},
+			wantErr: ErrPageAccessForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mock_store.NewMockStore(t)
+			tt.mockSetup(mockStore)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()})
+			page, err := NewPagesService(mockStore).UpdatePage(ctx, pageId, tt.params)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, name, page.Name)
+			assert.Equal(t, fractionalIndex, page.FractionalIndex)
+		})
+	}
+}
+
+func TestDuplicatePage(t *testing.T) {
+	t.Parallel()
+
+	currentUserId := uuid.New()
+	pageId := uuid.New()
+	duplicatedPageId := uuid.New()
+	sheetId := uuid.New()
+	widgetInstanceId := uuid.New()
+	description := "Monthly numbers"
+
+	sourcePage := &models.Page{ID: pageId, Name: "Revenue", Description: &description}
+	sourceSheets := []models.Sheet{{
+		ID:              sheetId,
+		Name:            "Summary",
+		PageId:          pageId,
+		SheetConfig:     json.RawMessage(fmt.Sprintf(`{"version":"1.0","sheet_layout":[{"widget_group":["%s"]}]}`, widgetInstanceId)),
+		WidgetInstances: []models.WidgetInstance{{ID: widgetInstanceId, SheetID: sheetId, WidgetType: "bar_chart", Title: "Sales"}},
+	}}
+
+	tests := []struct {
+		name      string
+		copyName  string
+		mockSetup func(*mock_store.MockStore)
+		wantName  string
+		wantErr   error
+	}{
+		{
+			name: "copies the sheets and their widget instances",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetPageById(mock.Anything, pageId).Return(sourcePage, nil).Once()
+				m.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+					return fn(m)
+				})
+				m.EXPECT().CreatePage(mock.Anything, "Revenue (copy)", description).Return(&models.Page{ID: duplicatedPageId, Name: "Revenue (copy)"}, nil)
+				m.EXPECT().CreatePagePolicy(mock.Anything, duplicatedPageId, models.AudienceTypeUser, currentUserId, models.PrivilegePageAdmin).Return(&models.ResourceAudiencePolicy{}, nil)
+				m.EXPECT().GetSheetsAll(mock.Anything, mock.MatchedBy(func(filters models.SheetFilters) bool {
+					return filters.IncludeWidgetInstances && filters.PageIds[0] == pageId
+				})).Return(sourceSheets, nil)
+
+				copiedSheetId := uuid.New()
+				copiedWidgetInstanceId := uuid.New()
+				m.EXPECT().CreateSheet(mock.Anything, mock.MatchedBy(func(sheet models.Sheet) bool {
+					return sheet.PageId == duplicatedPageId && sheet.Name == "Summary"
+				})).Return(&models.Sheet{ID: copiedSheetId, PageId: duplicatedPageId, Name: "Summary"}, nil)
+				m.EXPECT().CreateWidgetInstance(mock.Anything, mock.MatchedBy(func(widgetInstance *models.WidgetInstance) bool {
+					return widgetInstance.SheetID == copiedSheetId && widgetInstance.Title == "Sales"
+				})).Return(&models.WidgetInstance{ID: copiedWidgetInstanceId, SheetID: copiedSheetId}, nil)
+				m.EXPECT().UpdateSheet(mock.Anything, mock.MatchedBy(func(sheet *models.Sheet) bool {
+					return sheet.ID == copiedSheetId && strings.Contains(string(sheet.SheetConfig), copiedWidgetInstanceId.String()) &&
+						!strings.Contains(string(sheet.SheetConfig), widgetInstanceId.String())
+				})).Return(&models.Sheet{ID: copiedSheetId}, nil)
+
+				m.EXPECT().GetPageById(mock.Anything, duplicatedPageId).Return(&models.Page{ID: duplicatedPageId, Name: "Revenue (copy)"}, nil)
+			},
+			wantName: "Revenue (copy)",
+		},
+		{
+			name:     "failed copy is rolled back",
+			copyName: "Board pack",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetPageById(mock.Anything, pageId).Return(sourcePage, nil)
+				m.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+					return fn(m)
+				})
+				m.EXPECT().CreatePage(mock.Anything, "Board pack", description).Return(&models.Page{ID: duplicatedPageId}, nil)
+				m.EXPECT().CreatePagePolicy(mock.Anything, duplicatedPageId, models.AudienceTypeUser, currentUserId, models.PrivilegePageAdmin).Return(&models.ResourceAudiencePolicy{}, nil)
+				m.EXPECT().GetSheetsAll(mock.Anything, mock.Anything).Return(nil, errors.New("connection reset"))
+			},
+			wantErr: errors.New("failed to get sheets: connection reset"),
+		},
+		{
+			name: "page not found",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetPageById(mock.Anything, pageId).Return(&models.Page{ID: pageId, DeletedAt: &time.Time{}}, nil)
+			},
+			wantErr: ErrPageNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mock_store.NewMockStore(t)
+			tt.mockSetup(mockStore)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", currentUserId, []uuid.UUID{uuid.New()})
+			page, err := NewPagesService(mockStore).DuplicatePage(ctx, pageId, tt.copyName)
+
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, page.Name)
+		})
+	}
+}
+
+func TestDeletePage(t *testing.T) {
+	t.Parallel()
+
+	pageId := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mock_store.MockStore)
+		wantErr   error
+	}{
+		{
+			name: "success",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetPageById(mock.Anything, pageId).Return(&models.Page{ID: pageId}, nil)
+				expectPageAdmin(m, pageId, true)
+				m.EXPECT().DeletePage(mock.Anything, pageId).Return(nil)
+			},
+		},
+		{
+			name: "not a page admin",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetPageById(mock.Anything, pageId).Return(&models.Page{ID: pageId}, nil)
+				expectPageAdmin(m, pageId, false)
+			},
+			wantErr: ErrPageAccessForbidden,
+		},
+		{
+			name: "page not found",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetPageById(mock.Anything, pageId).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: ErrPageNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mock_store.NewMockStore(t)
+			tt.mockSetup(mockStore)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()})
+			err := NewPagesService(mockStore).DeletePage(ctx, pageId)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
 		})
 	}
 }
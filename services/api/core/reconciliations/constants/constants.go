@@ -0,0 +1,42 @@
+package constants
+
+import "time"
+
+// MatchGrouping is how many rows of each side a single match of a rule can hold
+type MatchGrouping string
+
+const (
+	MatchGroupingOneToOne  MatchGrouping = "one_to_one"
+	MatchGroupingOneToMany MatchGrouping = "one_to_many"
+	MatchGroupingManyToOne MatchGrouping = "many_to_one"
+)
+
+// MatchTypeManual is the match type of the matches made by users, automatic matches use the grouping of their rule
+const MatchTypeManual = "manual"
+
+const (
+	ReconciliationRunWorkflowIdFormat = "reconciliation-run-%s"
+	ReconciliationMaxRules            = 20
+	ReconciliationMaxRowsPerSide      = 200000
+	ReconciliationLoadPageSize        = 10000
+	ReconciliationMaxGroupSize        = 50
+	ReconciliationMaxCandidates       = 1000
+	ReconciliationRunsLimit           = 50
+	ReconciliationDefaultPageSize     = 100
+	ReconciliationMaxPageSize         = 1000
+	ReconciliationMaxDateWindowDays   = 366
+	ReconciliationRunTimeout          = time.Hour
+)
+
+// The result dataset of a reconciliation holds one row per row of each match of its runs, the latest write of a row
+// of a match wins
+const (
+	ReconciliationResultDatasetTitleFormat       = "%s matches"
+	ReconciliationResultDatasetDescriptionFormat = "Matches of the reconciliation %s"
+	ReconciliationResultInsertBatchSize          = 500
+	ReconciliationResultOrderByColumn            = "updated_at"
+)
+
+var ReconciliationResultColumns = []string{"run_id", "match_id", "rule_name", "match_type", "status", "side", "row_id", "amount", "transaction_date", "reference", "updated_at"}
+
+var ReconciliationResultDedupColumns = []string{"run_id", "match_id", "side", "row_id"}
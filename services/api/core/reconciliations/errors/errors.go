@@ -0,0 +1,33 @@
+package errors
+
+import "errors"
+
+const (
+	ErrReconciliationNotFoundMessage        = "ERR_RECONCILIATION_NOT_FOUND"
+	ErrReconciliationAccessForbiddenMessage = "ERR_RECONCILIATION_ACCESS_FORBIDDEN"
+	ErrEmptyReconciliationNameMessage       = "ERR_EMPTY_RECONCILIATION_NAME"
+	ErrInvalidReconciliationSideMessage     = "ERR_INVALID_RECONCILIATION_SIDE"
+	ErrInvalidMatchRulesMessage             = "ERR_INVALID_RECONCILIATION_MATCH_RULES"
+	ErrReconciliationRunNotFoundMessage     = "ERR_RECONCILIATION_RUN_NOT_FOUND"
+	ErrReconciliationRunInProgressMessage   = "ERR_RECONCILIATION_RUN_IN_PROGRESS"
+	ErrReconciliationRunNotCompletedMessage = "ERR_RECONCILIATION_RUN_NOT_COMPLETED"
+	ErrReconciliationTooManyRowsMessage     = "ERR_RECONCILIATION_TOO_MANY_ROWS"
+	ErrReconciliationMatchNotFoundMessage   = "ERR_RECONCILIATION_MATCH_NOT_FOUND"
+	ErrInvalidManualMatchMessage            = "ERR_INVALID_RECONCILIATION_MANUAL_MATCH"
+	ErrReconciliationMatchUnmatchedMessage  = "ERR_RECONCILIATION_MATCH_ALREADY_UNMATCHED"
+)
+
+var (
+	ErrReconciliationNotFound        = errors.New(ErrReconciliationNotFoundMessage)
+	ErrReconciliationAccessForbidden = errors.New(ErrReconciliationAccessForbiddenMessage)
+	ErrEmptyReconciliationName       = errors.New(ErrEmptyReconciliationNameMessage)
+	ErrInvalidReconciliationSide     = errors.New(ErrInvalidReconciliationSideMessage)
+	ErrInvalidMatchRules             = errors.New(ErrInvalidMatchRulesMessage)
+	ErrReconciliationRunNotFound     = errors.New(ErrReconciliationRunNotFoundMessage)
+	ErrReconciliationRunInProgress   = errors.New(ErrReconciliationRunInProgressMessage)
+	ErrReconciliationRunNotCompleted = errors.New(ErrReconciliationRunNotCompletedMessage)
+	ErrReconciliationTooManyRows     = errors.New(ErrReconciliationTooManyRowsMessage)
+	ErrReconciliationMatchNotFound   = errors.New(ErrReconciliationMatchNotFoundMessage)
+	ErrInvalidManualMatch            = errors.New(ErrInvalidManualMatchMessage)
+	ErrReconciliationMatchUnmatched  = errors.New(ErrReconciliationMatchUnmatchedMessage)
+)
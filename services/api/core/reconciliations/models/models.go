@@ -0,0 +1,178 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/constants"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+// ReconciliationSide is how the rows of one dataset are read. AmountColumn, DateColumn and ReferenceColumn are
+// optional and only needed by the rules comparing amounts, dates or references, Filters limit the rows reconciled.
+type ReconciliationSide struct {
+	AmountColumn    string                    `json:"amount_column,omitempty"`
+	DateColumn      string                    `json:"date_column,omitempty"`
+	ReferenceColumn string                    `json:"reference_column,omitempty"`
+	Filters         datasetmodels.FilterModel `json:"filters"`
+}
+
+type MatchKey struct {
+	LeftColumn  string `json:"left_column"`
+	RightColumn string `json:"right_column"`
+}
+
+// MatchRule pairs rows whose Keys are equal, whose amounts differ by at most AmountTolerance, whose dates are at
+// most DateWindowDays apart and whose references are at least MinReferenceSimilarity alike, from 0 to 1. Amounts,
+// dates and references are only compared when set on the rule. Groupings other than one to one sum the amounts of
+// the rows sharing the keys of a single row of the other side.
+type MatchRule struct {
+	Name                   string                  `json:"name"`
+	Keys                   []MatchKey              `json:"keys"`
+	CompareAmount          bool                    `json:"compare_amount"`
+	AmountTolerance        float64                 `json:"amount_tolerance"`
+	DateWindowDays         *int                    `json:"date_window_days,omitempty"`
+	MinReferenceSimilarity *float64                `json:"min_reference_similarity,omitempty"`
+	Grouping               constants.MatchGrouping `json:"grouping"`
+}
+
+type Reconciliation struct {
+	ID              uuid.UUID
+	OwnerId         uuid.UUID
+	Name            string
+	Description     string
+	LeftDatasetId   uuid.UUID
+	RightDatasetId  uuid.UUID
+	Left            ReconciliationSide
+	Right           ReconciliationSide
+	Rules           []MatchRule
+	ResultDatasetId *uuid.UUID
+	CreatedBy       uuid.UUID
+	UpdatedBy       uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type ReconciliationParams struct {
+	Name           string
+	Description    string
+	LeftDatasetId  uuid.UUID
+	RightDatasetId uuid.UUID
+	Left           ReconciliationSide
+	Right          ReconciliationSide
+	Rules          []MatchRule
+}
+
+func (r *Reconciliation) FromSchema(schema dbmodels.Reconciliation) error {
+	var left ReconciliationSide
+	if err := json.Unmarshal(schema.LeftConfig, &left); err != nil {
+		return err
+	}
+
+	var right ReconciliationSide
+	if err := json.Unmarshal(schema.RightConfig, &right); err != nil {
+		return err
+	}
+
+	var rules []MatchRule
+	if err := json.Unmarshal(schema.Rules, &rules); err != nil {
+		return err
+	}
+
+	r.ID = schema.ID
+	r.OwnerId = schema.OwnerId
+	r.Name = schema.Name
+	r.Description = schema.Description
+	r.LeftDatasetId = schema.LeftDatasetId
+	r.RightDatasetId = schema.RightDatasetId
+	r.Left = left
+	r.Right = right
+	r.Rules = rules
+	r.ResultDatasetId = schema.ResultDatasetId
+	r.CreatedBy = schema.CreatedBy
+	r.UpdatedBy = schema.UpdatedBy
+	r.CreatedAt = schema.CreatedAt
+	r.UpdatedAt = schema.UpdatedAt
+
+	return nil
+}
+
+type ReconciliationMatch struct {
+	MatchId     uuid.UUID
+	RuleName    string
+	MatchType   string
+	Status      string
+	LeftRows    []dbmodels.ReconciliationRow
+	RightRows   []dbmodels.ReconciliationRow
+	LeftAmount  *float64
+	RightAmount *float64
+	CreatedAt   time.Time
+	CreatedBy   *uuid.UUID
+	UpdatedAt   time.Time
+	UpdatedBy   *uuid.UUID
+}
+
+func (m *ReconciliationMatch) FromSchema(schema dbmodels.ReconciliationMatch) error {
+	var leftRows []dbmodels.ReconciliationRow
+	if err := json.Unmarshal(schema.LeftRows, &leftRows); err != nil {
+		return err
+	}
+
+	var rightRows []dbmodels.ReconciliationRow
+	if err := json.Unmarshal(schema.RightRows, &rightRows); err != nil {
+		return err
+	}
+
+	m.MatchId = schema.MatchId
+	m.RuleName = schema.RuleName
+	m.MatchType = schema.MatchType
+	m.Status = schema.Status
+	m.LeftRows = leftRows
+	m.RightRows = rightRows
+	m.LeftAmount = schema.LeftAmount
+	m.RightAmount = schema.RightAmount
+	m.CreatedAt = schema.CreatedAt
+	m.CreatedBy = schema.CreatedBy
+	m.UpdatedAt = schema.UpdatedAt
+	m.UpdatedBy = schema.UpdatedBy
+
+	return nil
+}
+
+type ReconciliationMatchesPage struct {
+	Matches    []ReconciliationMatch
+	TotalCount int64
+}
+
+type ReconciliationExceptionsPage struct {
+	Exceptions []dbmodels.ReconciliationException
+	TotalCount int64
+}
+
+// ManualMatchParams are the open exceptions of a run matched together by a user
+type ManualMatchParams struct {
+	LeftRowIds  []string
+	RightRowIds []string
+}
+
+type ReconciliationRunWorkflowParams struct {
+	ReconciliationId uuid.UUID `json:"reconciliation_id"`
+	RunId            uuid.UUID `json:"run_id"`
+	OrganizationId   uuid.UUID `json:"organization_id"`
+	UserId           uuid.UUID `json:"user_id"`
+}
+
+// ReconciliationRunResult is what a run matched and left unmatched, it is recorded on the run when it completes.
+// Skipped rows are left unmatched without being compared and are not part of the exception counts.
+type ReconciliationRunResult struct {
+	Status              string `json:"status"`
+	LeftRowCount        int    `json:"left_row_count"`
+	RightRowCount       int    `json:"right_row_count"`
+	MatchCount          int    `json:"match_count"`
+	LeftExceptionCount  int    `json:"left_exception_count"`
+	RightExceptionCount int    `json:"right_exception_count"`
+	LeftSkippedCount    int    `json:"left_skipped_count"`
+	RightSkippedCount   int    `json:"right_skipped_count"`
+}
@@ -0,0 +1,486 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/constants"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+// amountEpsilon absorbs the float error of summed amounts so a tolerance of zero still matches equal decimals
+const amountEpsilon = 1e-9
+
+const keySeparator = "\x1f"
+
+// matcherRow is a row of one side with the values of every key column used by the rules, normalized for comparison.
+// skipped is set when a rule found too many rows of the other side to compare it with.
+type matcherRow struct {
+	row     storemodels.ReconciliationRow
+	values  map[string]string
+	matched bool
+	skipped bool
+}
+
+// matchOutcome holds the matches of a run and the rows it left unmatched, rows skipped by a rule and not matched by
+// any other one are kept apart from the exceptions
+type matchOutcome struct {
+	matches         []storemodels.CreateReconciliationMatchParams
+	leftExceptions  []storemodels.ReconciliationRow
+	rightExceptions []storemodels.ReconciliationRow
+	leftSkipped     []storemodels.ReconciliationRow
+	rightSkipped    []storemodels.ReconciliationRow
+}
+
+// matchCount is the number of matches of the outcome holding rows, unmatched records only carry decisions forward
+func (o matchOutcome) matchCount() int {
+	count := 0
+	for _, match := range o.matches {
+		if match.Status != storemodels.ReconciliationMatchStatusUnmatched {
+			count++
+		}
+	}
+
+	return count
+}
+
+// matchRows applies the rules in order, each rule only sees the rows the previous ones left unmatched. The manual
+// decisions of the previous run come first: manual matches are kept when all their rows are still there and pairs
+// that were manually unmatched are never matched again.
+func matchRows(rules []models.MatchRule, left []*matcherRow, right []*matcherRow, previous []models.ReconciliationMatch) matchOutcome {
+	outcome := matchOutcome{}
+
+	forbidden := map[string]bool{}
+	for _, match := range previous {
+		if match.Status != storemodels.ReconciliationMatchStatusUnmatched {
+			continue
+		}
+
+		for _, l := range match.LeftRows {
+			for _, r := range match.RightRows {
+				forbidden[pairKey(l.RowId, r.RowId)] = true
+			}
+		}
+		outcome.matches = append(outcome.matches, storemodels.CreateReconciliationMatchParams{
+			MatchId:     match.MatchId,
+			RuleName:    match.RuleName,
+			MatchType:   match.MatchType,
+			Status:      storemodels.ReconciliationMatchStatusUnmatched,
+			LeftRows:    match.LeftRows,
+			RightRows:   match.RightRows,
+			LeftAmount:  match.LeftAmount,
+			RightAmount: match.RightAmount,
+			CreatedBy:   match.UpdatedBy,
+		})
+	}
+
+	leftById := indexMatcherRows(left)
+	rightById := indexMatcherRows(right)
+	for _, match := range previous {
+		if match.Status != storemodels.ReconciliationMatchStatusManuallyMatched {
+			continue
+		}
+
+		leftRows, ok := lookupUnmatchedRows(leftById, match.LeftRows)
+		if !ok {
+			continue
+		}
+		rightRows, ok := lookupUnmatchedRows(rightById, match.RightRows)
+		if !ok {
+			continue
+		}
+
+		outcome.matches = append(outcome.matches, newMatch(match.RuleName, constants.MatchTypeManual, storemodels.ReconciliationMatchStatusManuallyMatched, leftRows, rightRows, match.CreatedBy))
+	}
+
+	for _, rule := range rules {
+		switch rule.Grouping {
+		case constants.MatchGroupingOneToMany:
+			outcome.matches = append(outcome.matches, matchGroups(rule, left, right, true, forbidden)...)
+		case constants.MatchGroupingManyToOne:
+			outcome.matches = append(outcome.matches, matchGroups(rule, right, left, false, forbidden)...)
+		default:
+			outcome.matches = append(outcome.matches, matchOneToOne(rule, left, right, forbidden)...)
+		}
+	}
+
+	outcome.leftExceptions, outcome.leftSkipped = unmatchedRows(left)
+	outcome.rightExceptions, outcome.rightSkipped = unmatchedRows(right)
+
+	return outcome
+}
+
+// unmatchedRows splits the rows left unmatched into exceptions and skipped rows
+func unmatchedRows(rows []*matcherRow) ([]storemodels.ReconciliationRow, []storemodels.ReconciliationRow) {
+	var exceptions, skipped []storemodels.ReconciliationRow
+	for _, row := range rows {
+		switch {
+		case row.matched:
+		case row.skipped:
+			skipped = append(skipped, row.row)
+		default:
+			exceptions = append(exceptions, row.row)
+		}
+	}
+
+	return exceptions, skipped
+}
+
+// matchOneToOne pairs every left row with the closest right row passing the rule: the smallest amount difference,
+// then the closest date, then the most similar reference. Rows are paired greedily in the order they were loaded. A
+// left row with more candidates sharing its keys and amount than ReconciliationMaxCandidates is skipped, the rule is
+// too loose to pick one of them.
+func matchOneToOne(rule models.MatchRule, left []*matcherRow, right []*matcherRow, forbidden map[string]bool) []storemodels.CreateReconciliationMatchParams {
+	buckets := bucketRows(right, rule.Keys, false)
+	if rule.CompareAmount {
+		for key, rows := range buckets {
+			withAmount := rows[:0]
+			for _, row := range rows {
+				if row.row.Amount != nil {
+					withAmount = append(withAmount, row)
+				}
+			}
+			sort.SliceStable(withAmount, func(i, j int) bool {
+				return *withAmount[i].row.Amount < *withAmount[j].row.Amount
+			})
+			buckets[key] = withAmount
+		}
+	}
+
+	var matches []storemodels.CreateReconciliationMatchParams
+	for _, l := range left {
+		if l.matched {
+			continue
+		}
+		key, ok := rowKey(l, rule.Keys, true)
+		if !ok {
+			continue
+		}
+
+		candidates := buckets[key]
+		if rule.CompareAmount {
+			if l.row.Amount == nil {
+				continue
+			}
+			low := *l.row.Amount - rule.AmountTolerance - amountEpsilon
+			high := *l.row.Amount + rule.AmountTolerance + amountEpsilon
+			start := sort.Search(len(candidates), func(i int) bool {
+				return *candidates[i].row.Amount >= low
+			})
+			end := sort.Search(len(candidates), func(i int) bool {
+				return *candidates[i].row.Amount > high
+			})
+			candidates = candidates[start:end]
+		}
+		if len(candidates) > constants.ReconciliationMaxCandidates {
+			l.skipped = true
+			continue
+		}
+
+		var best *matcherRow
+		var bestScore candidateScore
+		for _, r := range candidates {
+			if r.matched || !passesRule(rule, l, r, forbidden) {
+				continue
+			}
+
+			score := scoreCandidate(rule, l, r)
+			if best == nil || score.less(bestScore) {
+				best = r
+				bestScore = score
+			}
+		}
+
+		if best == nil {
+			continue
+		}
+
+		matches = append(matches, newMatch(rule.Name, string(constants.MatchGroupingOneToOne), storemodels.ReconciliationMatchStatusMatched, []*matcherRow{l}, []*matcherRow{best}, nil))
+	}
+
+	return matches
+}
+
+// matchGroups matches one anchor row with every row of the other side sharing its keys and passing the rule, when
+// amounts are compared the group only matches if its total is within the tolerance of the amount of the anchor.
+// anchorIsLeft tells which side the anchors come from so keys, pairs and the resulting match are oriented right. An
+// anchor with more rows passing the rule than ReconciliationMaxGroupSize is skipped.
+func matchGroups(rule models.MatchRule, anchors []*matcherRow, others []*matcherRow, anchorIsLeft bool, forbidden map[string]bool) []storemodels.CreateReconciliationMatchParams {
+	buckets := bucketRows(others, rule.Keys, !anchorIsLeft)
+
+	var matches []storemodels.CreateReconciliationMatchParams
+	for _, anchor := range anchors {
+		if anchor.matched {
+			continue
+		}
+		key, ok := rowKey(anchor, rule.Keys, anchorIsLeft)
+		if !ok {
+			continue
+		}
+
+		var group []*matcherRow
+		for _, other := range buckets[key] {
+			if other.matched {
+				continue
+			}
+
+			passes := false
+			if anchorIsLeft {
+				passes = passesRule(rule, anchor, other, forbidden)
+			} else {
+				passes = passesRule(rule, other, anchor, forbidden)
+			}
+			if passes {
+				group = append(group, other)
+			}
+		}
+
+		if len(group) == 0 {
+			continue
+		}
+		if len(group) > constants.ReconciliationMaxGroupSize {
+			anchor.skipped = true
+			continue
+		}
+
+		if rule.CompareAmount {
+			total, ok := sumAmounts(group)
+			if !ok || anchor.row.Amount == nil || math.Abs(total-*anchor.row.Amount) > rule.AmountTolerance+amountEpsilon {
+				continue
+			}
+		}
+
+		if anchorIsLeft {
+			matches = append(matches, newMatch(rule.Name, string(rule.Grouping), storemodels.ReconciliationMatchStatusMatched, []*matcherRow{anchor}, group, nil))
+		} else {
+			matches = append(matches, newMatch(rule.Name, string(rule.Grouping), storemodels.ReconciliationMatchStatusMatched, group, []*matcherRow{anchor}, nil))
+		}
+	}
+
+	return matches
+}
+
+// passesRule checks everything but the amounts of a left and right row, amounts are compared per grouping
+func passesRule(rule models.MatchRule, l *matcherRow, r *matcherRow, forbidden map[string]bool) bool {
+	if forbidden[pairKey(l.row.RowId, r.row.RowId)] {
+		return false
+	}
+
+	if rule.DateWindowDays != nil {
+		if l.row.Date == nil || r.row.Date == nil {
+			return false
+		}
+		if absDuration(l.row.Date.Sub(*r.row.Date)) > time.Duration(*rule.DateWindowDays)*24*time.Hour {
+			return false
+		}
+	}
+
+	if rule.MinReferenceSimilarity != nil && referenceSimilarity(l.row.Reference, r.row.Reference) < *rule.MinReferenceSimilarity {
+		return false
+	}
+
+	return true
+}
+
+type candidateScore struct {
+	amountDiff float64
+	dateDiff   time.Duration
+	similarity float64
+	rowId      string
+}
+
+func (s candidateScore) less(other candidateScore) bool {
+	if s.amountDiff != other.amountDiff {
+		return s.amountDiff < other.amountDiff
+	}
+	if s.dateDiff != other.dateDiff {
+		return s.dateDiff < other.dateDiff
+	}
+	if s.similarity != other.similarity {
+		return s.similarity > other.similarity
+	}
+	return s.rowId < other.rowId
+}
+
+func scoreCandidate(rule models.MatchRule, l *matcherRow, r *matcherRow) candidateScore {
+	score := candidateScore{rowId: r.row.RowId}
+	if rule.CompareAmount {
+		score.amountDiff = math.Abs(*l.row.Amount - *r.row.Amount)
+	}
+	if l.row.Date != nil && r.row.Date != nil {
+		score.dateDiff = absDuration(l.row.Date.Sub(*r.row.Date))
+	}
+	if rule.MinReferenceSimilarity != nil {
+		score.similarity = referenceSimilarity(l.row.Reference, r.row.Reference)
+	}
+
+	return score
+}
+
+// bucketRows groups the unmatched rows by the values of their key columns, rows with an empty key are left out
+func bucketRows(rows []*matcherRow, keys []models.MatchKey, isLeft bool) map[string][]*matcherRow {
+	buckets := map[string][]*matcherRow{}
+	for _, row := range rows {
+		if row.matched {
+			continue
+		}
+		key, ok := rowKey(row, keys, isLeft)
+		if !ok {
+			continue
+		}
+		buckets[key] = append(buckets[key], row)
+	}
+
+	return buckets
+}
+
+func rowKey(row *matcherRow, keys []models.MatchKey, isLeft bool) (string, bool) {
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		column := key.RightColumn
+		if isLeft {
+			column = key.LeftColumn
+		}
+
+		value := row.values[column]
+		if value == "" {
+			return "", false
+		}
+		values[i] = value
+	}
+
+	return strings.Join(values, keySeparator), true
+}
+
+func newMatch(ruleName string, matchType string, status string, left []*matcherRow, right []*matcherRow, createdBy *uuid.UUID) storemodels.CreateReconciliationMatchParams {
+	match := storemodels.CreateReconciliationMatchParams{
+		MatchId:   uuid.New(),
+		RuleName:  ruleName,
+		MatchType: matchType,
+		Status:    status,
+		CreatedBy: createdBy,
+	}
+
+	for _, row := range left {
+		row.matched = true
+		match.LeftRows = append(match.LeftRows, row.row)
+	}
+	for _, row := range right {
+		row.matched = true
+		match.RightRows = append(match.RightRows, row.row)
+	}
+
+	if total, ok := sumAmounts(left); ok {
+		match.LeftAmount = &total
+	}
+	if total, ok := sumAmounts(right); ok {
+		match.RightAmount = &total
+	}
+
+	return match
+}
+
+// sumAmounts totals the amounts of the rows, it is not ok when one of them has no amount
+func sumAmounts(rows []*matcherRow) (float64, bool) {
+	total := 0.0
+	for _, row := range rows {
+		if row.row.Amount == nil {
+			return 0, false
+		}
+		total += *row.row.Amount
+	}
+
+	return total, true
+}
+
+func indexMatcherRows(rows []*matcherRow) map[string]*matcherRow {
+	index := make(map[string]*matcherRow, len(rows))
+	for _, row := range rows {
+		index[row.row.RowId] = row
+	}
+
+	return index
+}
+
+func lookupUnmatchedRows(index map[string]*matcherRow, rows []storemodels.ReconciliationRow) ([]*matcherRow, bool) {
+	if len(rows) == 0 {
+		return nil, false
+	}
+
+	result := make([]*matcherRow, len(rows))
+	for i, row := range rows {
+		current, ok := index[row.RowId]
+		if !ok || current.matched {
+			return nil, false
+		}
+		result[i] = current
+	}
+
+	return result, true
+}
+
+func pairKey(leftRowId string, rightRowId string) string {
+	return leftRowId + keySeparator + rightRowId
+}
+
+// referenceSimilarity is one minus the edit distance of the normalized references over the length of the longest,
+// references are compared on their letters and digits only so "INV-001" and "inv 001" are identical
+func referenceSimilarity(a string, b string) float64 {
+	ra := []rune(normalizeReference(a))
+	rb := []rune(normalizeReference(b))
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func normalizeReference(reference string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(reference) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+func levenshtein(a []rune, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/constants"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMatcherRow(rowId string, invoice string, amount float64, date string, reference string) *matcherRow {
+	parsed, _ := time.Parse(time.DateOnly, date)
+	return &matcherRow{
+		row: storemodels.ReconciliationRow{
+			RowId:     rowId,
+			Amount:    &amount,
+			Date:      &parsed,
+			Reference: reference,
+		},
+		values: map[string]string{"invoice": invoice},
+	}
+}
+
+func matchedPairs(outcome matchOutcome) [][2][]string {
+	pairs := make([][2][]string, 0, len(outcome.matches))
+	for _, match := range outcome.matches {
+		if match.Status == storemodels.ReconciliationMatchStatusUnmatched {
+			continue
+		}
+		pairs = append(pairs, [2][]string{reconciliationRowIds(match.LeftRows), reconciliationRowIds(match.RightRows)})
+	}
+
+	return pairs
+}
+
+func TestMatchRows(t *testing.T) {
+	invoiceKey := []models.MatchKey{{LeftColumn: "invoice", RightColumn: "invoice"}}
+	window := 3
+	similarity := 0.8
+
+	tests := []struct {
+		name            string
+		rules           []models.MatchRule
+		left            []*matcherRow
+		right           []*matcherRow
+		previous        []models.ReconciliationMatch
+		wantPairs       [][2][]string
+		wantLeftExcept  []string
+		wantRightExcept []string
+	}{
+		{
+			name:  "Matches exact keys and amounts within the tolerance",
+			rules: []models.MatchRule{{Name: "exact", Keys: invoiceKey, CompareAmount: true, AmountTolerance: 0.5, Grouping: constants.MatchGroupingOneToOne}},
+			left: []*matcherRow{
+				testMatcherRow("l1", "inv-1", 100, "2025-04-01", ""),
+				testMatcherRow("l2", "inv-2", 50, "2025-04-01", ""),
+			},
+			right: []*matcherRow{
+				testMatcherRow("r1", "inv-1", 100.4, "2025-04-01", ""),
+				testMatcherRow("r2", "inv-2", 51, "2025-04-01", ""),
+			},
+			wantPairs:       [][2][]string{{{"l1"}, {"r1"}}},
+			wantLeftExcept:  []string{"l2"},
+			wantRightExcept: []string{"r2"},
+		},
+		{
+			name:  "Prefers the closest amount and then the closest date",
+			rules: []models.MatchRule{{Name: "window", Keys: invoiceKey, CompareAmount: true, AmountTolerance: 5, DateWindowDays: &window, Grouping: constants.MatchGroupingOneToOne}},
+			left: []*matcherRow{
+				testMatcherRow("l1", "inv-1", 100, "2025-04-10", ""),
+			},
+			right: []*matcherRow{
+				testMatcherRow("r1", "inv-1", 103, "2025-04-10", ""),
+				testMatcherRow("r2", "inv-1", 100, "2025-04-08", ""),
+				testMatcherRow("r3", "inv-1", 100, "2025-04-11", ""),
+				testMatcherRow("r4", "inv-1", 100, "2025-04-20", ""),
+			},
+			wantPairs:       [][2][]string{{{"l1"}, {"r3"}}},
+			wantRightExcept: []string{"r1", "r2", "r4"},
+		},
+		{
+			name:  "Matches fuzzy references when keys are not shared",
+			rules: []models.MatchRule{{Name: "fuzzy", CompareAmount: true, MinReferenceSimilarity: &similarity, Grouping: constants.MatchGroupingOneToOne}},
+			left: []*matcherRow{
+				testMatcherRow("l1", "", 75, "2025-04-01", "INV-2025-0042"),
+			},
+			right: []*matcherRow{
+				testMatcherRow("r1", "", 75, "2025-04-01", "payment 9999"),
+				testMatcherRow("r2", "", 75, "2025-04-01", "inv 2025 0042"),
+			},
+			wantPairs:       [][2][]string{{{"l1"}, {"r2"}}},
+			wantRightExcept: []string{"r1"},
+		},
+		{
+			name:  "Matches one left row with the right rows summing to its amount",
+			rules: []models.MatchRule{{Name: "split", Keys: invoiceKey, CompareAmount: true, Grouping: constants.MatchGroupingOneToMany}},
+			left: []*matcherRow{
+				testMatcherRow("l1", "inv-1", 100, "2025-04-01", ""),
+				testMatcherRow("l2", "inv-2", 80, "2025-04-01", ""),
+			},
+			right: []*matcherRow{
+				testMatcherRow("r1", "inv-1", 60, "2025-04-01", ""),
+				testMatcherRow("r2", "inv-1", 40, "2025-04-02", ""),
+				testMatcherRow("r3", "inv-2", 30, "2025-04-01", ""),
+			},
+			wantPairs:       [][2][]string{{{"l1"}, {"r1", "r2"}}},
+			wantLeftExcept:  []string{"l2"},
+			wantRightExcept: []string{"r3"},
+		},
+		{
+			name:  "Matches many left rows with one right row",
+			rules: []models.MatchRule{{Name: "batch", Keys: invoiceKey, CompareAmount: true, Grouping: constants.MatchGroupingManyToOne}},
+			left: []*matcherRow{
+				testMatcherRow("l1", "inv-1", 25, "2025-04-01", ""),
+				testMatcherRow("l2", "inv-1", 75, "2025-04-01", ""),
+			},
+			right: []*matcherRow{
+				testMatcherRow("r1", "inv-1", 100, "2025-04-01", ""),
+			},
+			wantPairs: [][2][]string{{{"l1", "l2"}, {"r1"}}},
+		},
+		{
+			name: "Later rules only see the rows left by earlier ones",
+			rules: []models.MatchRule{
+				{Name: "exact", Keys: invoiceKey, CompareAmount: true, Grouping: constants.MatchGroupingOneToOne},
+				{Name: "loose", CompareAmount: true, AmountTolerance: 1, Grouping: constants.MatchGroupingOneToOne},
+			},
+			left: []*matcherRow{
+				testMatcherRow("l1", "inv-1", 100, "2025-04-01", ""),
+				testMatcherRow("l2", "inv-9", 20, "2025-04-01", ""),
+			},
+			right: []*matcherRow{
+				testMatcherRow("r1", "inv-1", 100, "2025-04-01", ""),
+				testMatcherRow("r2", "", 20.5, "2025-04-01", ""),
+			},
+			wantPairs: [][2][]string{{{"l1"}, {"r1"}}, {{"l2"}, {"r2"}}},
+		},
+		{
+			name:  "Keeps previous manual decisions",
+			rules: []models.MatchRule{{Name: "exact", Keys: invoiceKey, CompareAmount: true, Grouping: constants.MatchGroupingOneToOne}},
+			left: []*matcherRow{
+				testMatcherRow("l1", "inv-1", 100, "2025-04-01", ""),
+				testMatcherRow("l2", "inv-2", 10, "2025-04-01", ""),
+			},
+			right: []*matcherRow{
+				testMatcherRow("r1", "inv-1", 100, "2025-04-01", ""),
+				testMatcherRow("r2", "inv-3", 12, "2025-04-01", ""),
+			},
+			previous: []models.ReconciliationMatch{
+				{
+					MatchId:   uuid.New(),
+					Status:    storemodels.ReconciliationMatchStatusUnmatched,
+					LeftRows:  []storemodels.ReconciliationRow{{RowId: "l1"}},
+					RightRows: []storemodels.ReconciliationRow{{RowId: "r1"}},
+				},
+				{
+					MatchId:   uuid.New(),
+					RuleName:  "manual",
+					Status:    storemodels.ReconciliationMatchStatusManuallyMatched,
+					LeftRows:  []storemodels.ReconciliationRow{{RowId: "l2"}},
+					RightRows: []storemodels.ReconciliationRow{{RowId: "r2"}},
+				},
+			},
+			wantPairs:       [][2][]string{{{"l2"}, {"r2"}}},
+			wantLeftExcept:  []string{"l1"},
+			wantRightExcept: []string{"r1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outcome := matchRows(tt.rules, tt.left, tt.right, tt.previous)
+
+			assert.Equal(t, tt.wantPairs, matchedPairs(outcome))
+			assert.Equal(t, len(tt.wantPairs), outcome.matchCount())
+			assert.ElementsMatch(t, tt.wantLeftExcept, reconciliationRowIds(outcome.leftExceptions))
+			assert.ElementsMatch(t, tt.wantRightExcept, reconciliationRowIds(outcome.rightExceptions))
+		})
+	}
+}
+
+func TestMatchRowsSkipsRowsWithTooManyCandidates(t *testing.T) {
+	rules := []models.MatchRule{{Name: "loose", CompareAmount: true, AmountTolerance: 10, Grouping: constants.MatchGroupingOneToOne}}
+	left := []*matcherRow{testMatcherRow("l1", "", 100, "2025-04-01", "")}
+	right := make([]*matcherRow, constants.ReconciliationMaxCandidates+1)
+	for i := range right {
+		right[i] = testMatcherRow(fmt.Sprintf("r%d", i), "", 100, "2025-04-01", "")
+	}
+
+	outcome := matchRows(rules, left, right, nil)
+
+	assert.Empty(t, matchedPairs(outcome))
+	assert.Empty(t, outcome.leftExceptions)
+	assert.Equal(t, []string{"l1"}, reconciliationRowIds(outcome.leftSkipped))
+	assert.Len(t, outcome.rightExceptions, len(right))
+	assert.Empty(t, outcome.rightSkipped)
+}
+
+func TestMatchRowsCarriesUnmatchedDecisions(t *testing.T) {
+	unmatched := models.ReconciliationMatch{
+		MatchId:   uuid.New(),
+		RuleName:  "exact",
+		Status:    storemodels.ReconciliationMatchStatusUnmatched,
+		LeftRows:  []storemodels.ReconciliationRow{{RowId: "l1"}},
+		RightRows: []storemodels.ReconciliationRow{{RowId: "r1"}},
+	}
+
+	outcome := matchRows(nil, nil, nil, []models.ReconciliationMatch{unmatched})
+
+	assert.Len(t, outcome.matches, 1)
+	assert.Equal(t, unmatched.MatchId, outcome.matches[0].MatchId)
+	assert.Equal(t, storemodels.ReconciliationMatchStatusUnmatched, outcome.matches[0].Status)
+	assert.Equal(t, 0, outcome.matchCount())
+}
+
+func TestReferenceSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, referenceSimilarity("INV-001", "inv 001"))
+	assert.InDelta(t, 0.8333, referenceSimilarity("inv001", "inv002"), 0.001)
+	assert.Equal(t, 0.0, referenceSimilarity("", "inv001"))
+}
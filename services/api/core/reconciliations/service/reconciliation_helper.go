@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/constants"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/errors"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+	"github.com/google/uuid"
+)
+
+var reconciliationDateLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
+func (s *reconciliationService) getReconciliation(ctx context.Context, reconciliationId uuid.UUID) (storemodels.Reconciliation, error) {
+	reconciliation, err := s.store.GetReconciliationById(ctx, reconciliationId)
+	if err != nil {
+		return storemodels.Reconciliation{}, errors.ErrReconciliationNotFound
+	}
+
+	return reconciliation, nil
+}
+
+// getReconciliationRun returns the run only when it belongs to the reconciliation
+func (s *reconciliationService) getReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID) (storemodels.Reconciliation, storemodels.ReconciliationRun, error) {
+	reconciliation, err := s.getReconciliation(ctx, reconciliationId)
+	if err != nil {
+		return storemodels.Reconciliation{}, storemodels.ReconciliationRun{}, err
+	}
+
+	run, err := s.store.GetReconciliationRunById(ctx, runId)
+	if err != nil || run.ReconciliationId != reconciliationId {
+		return storemodels.Reconciliation{}, storemodels.ReconciliationRun{}, errors.ErrReconciliationRunNotFound
+	}
+
+	return reconciliation, run, nil
+}
+
+// ensureReconciliationEditor lets the owner and the admins of either dataset change a reconciliation
+func (s *reconciliationService) ensureReconciliationEditor(ctx context.Context, reconciliation storemodels.Reconciliation, userId uuid.UUID) error {
+	if reconciliation.OwnerId == userId {
+		return nil
+	}
+
+	adminPolicies, err := s.store.GetFlattenedResourceAudiencePolicies(ctx, storemodels.FlattenedResourceAudiencePoliciesFilters{
+		ResourceIds:   []uuid.UUID{reconciliation.LeftDatasetId, reconciliation.RightDatasetId},
+		UserIds:       []uuid.UUID{userId},
+		ResourceTypes: []string{string(storemodels.ResourceTypeDataset)},
+		Privileges:    []storemodels.ResourcePrivilege{storemodels.PrivilegeDatasetAdmin},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(adminPolicies) == 0 {
+		return errors.ErrReconciliationAccessForbidden
+	}
+
+	return nil
+}
+
+func validateReconciliationParams(params models.ReconciliationParams) (models.ReconciliationParams, error) {
+	params.Name = strings.TrimSpace(params.Name)
+	if params.Name == "" {
+		return models.ReconciliationParams{}, errors.ErrEmptyReconciliationName
+	}
+	params.Description = strings.TrimSpace(params.Description)
+
+	if params.LeftDatasetId == uuid.Nil || params.RightDatasetId == uuid.Nil {
+		return models.ReconciliationParams{}, errors.ErrInvalidReconciliationSide
+	}
+
+	params.Left = normalizeReconciliationSide(params.Left)
+	params.Right = normalizeReconciliationSide(params.Right)
+
+	rules, err := validateMatchRules(params.Rules, params.Left, params.Right)
+	if err != nil {
+		return models.ReconciliationParams{}, err
+	}
+	params.Rules = rules
+
+	return params, nil
+}
+
+func normalizeReconciliationSide(side models.ReconciliationSide) models.ReconciliationSide {
+	side.AmountColumn = strings.TrimSpace(side.AmountColumn)
+	side.DateColumn = strings.TrimSpace(side.DateColumn)
+	side.ReferenceColumn = strings.TrimSpace(side.ReferenceColumn)
+	if side.Filters.LogicalOperator == "" {
+		side.Filters.LogicalOperator = datasetmodels.LogicalOperator(querybuilderconstants.LogicalOperatorAnd)
+	}
+
+	return side
+}
+
+// validateMatchRules checks each rule only uses columns configured on both sides. Groupings need keys since a group
+// is every row sharing the keys of its anchor, without keys a group would hold all the unmatched rows of a side. One
+// to one rules need keys or amounts to narrow the candidates of a row, dates and references are only compared on them.
+func validateMatchRules(rules []models.MatchRule, left models.ReconciliationSide, right models.ReconciliationSide) ([]models.MatchRule, error) {
+	if len(rules) == 0 || len(rules) > constants.ReconciliationMaxRules {
+		return nil, errors.ErrInvalidMatchRules
+	}
+
+	names := map[string]bool{}
+	for i, rule := range rules {
+		rule.Name = strings.TrimSpace(rule.Name)
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rule_%d", i+1)
+		}
+		if names[rule.Name] {
+			return nil, errors.ErrInvalidMatchRules
+		}
+		names[rule.Name] = true
+
+		for j, key := range rule.Keys {
+			key.LeftColumn = strings.TrimSpace(key.LeftColumn)
+			key.RightColumn = strings.TrimSpace(key.RightColumn)
+			if key.LeftColumn == "" || key.RightColumn == "" {
+				return nil, errors.ErrInvalidMatchRules
+			}
+			rule.Keys[j] = key
+		}
+
+		if rule.CompareAmount {
+			if left.AmountColumn == "" || right.AmountColumn == "" || rule.AmountTolerance < 0 {
+				return nil, errors.ErrInvalidMatchRules
+			}
+		} else {
+			rule.AmountTolerance = 0
+		}
+
+		if rule.DateWindowDays != nil {
+			if left.DateColumn == "" || right.DateColumn == "" || *rule.DateWindowDays < 0 || *rule.DateWindowDays > constants.ReconciliationMaxDateWindowDays {
+				return nil, errors.ErrInvalidMatchRules
+			}
+		}
+
+		if rule.MinReferenceSimilarity != nil {
+			if left.ReferenceColumn == "" || right.ReferenceColumn == "" || *rule.MinReferenceSimilarity <= 0 || *rule.MinReferenceSimilarity > 1 {
+				return nil, errors.ErrInvalidMatchRules
+			}
+		}
+
+		switch rule.Grouping {
+		case "":
+			rule.Grouping = constants.MatchGroupingOneToOne
+		case constants.MatchGroupingOneToOne:
+		case constants.MatchGroupingOneToMany, constants.MatchGroupingManyToOne:
+			if len(rule.Keys) == 0 {
+				return nil, errors.ErrInvalidMatchRules
+			}
+		default:
+			return nil, errors.ErrInvalidMatchRules
+		}
+
+		if len(rule.Keys) == 0 && !rule.CompareAmount {
+			return nil, errors.ErrInvalidMatchRules
+		}
+
+		rules[i] = rule
+	}
+
+	return rules, nil
+}
+
+func validateManualMatchParams(params models.ManualMatchParams) error {
+	if len(params.LeftRowIds) == 0 || len(params.RightRowIds) == 0 {
+		return errors.ErrInvalidManualMatch
+	}
+	if len(params.LeftRowIds)+len(params.RightRowIds) > 2*constants.ReconciliationMaxGroupSize {
+		return errors.ErrInvalidManualMatch
+	}
+
+	for _, rowIds := range [][]string{params.LeftRowIds, params.RightRowIds} {
+		seen := map[string]bool{}
+		for _, rowId := range rowIds {
+			if rowId == "" || seen[rowId] {
+				return errors.ErrInvalidManualMatch
+			}
+			seen[rowId] = true
+		}
+	}
+
+	return nil
+}
+
+// reconciliationKeyColumns lists the key columns of one side used by any of the rules
+func reconciliationKeyColumns(rules []models.MatchRule, isLeft bool) []string {
+	var columns []string
+	seen := map[string]bool{}
+	for _, rule := range rules {
+		for _, key := range rule.Keys {
+			column := key.RightColumn
+			if isLeft {
+				column = key.LeftColumn
+			}
+			if !seen[column] {
+				seen[column] = true
+				columns = append(columns, column)
+			}
+		}
+	}
+
+	return columns
+}
+
+// loadReconciliationRows reads the rows of one side page by page with the row and column policies of the user
+// running the reconciliation, only the columns the rules need are selected
+func (s *reconciliationService) loadReconciliationRows(ctx context.Context, orgId uuid.UUID, datasetId uuid.UUID, side models.ReconciliationSide, keyColumns []string) ([]*matcherRow, error) {
+	columns := []string{datasetConstants.ZampIDColumn}
+	seen := map[string]bool{datasetConstants.ZampIDColumn: true}
+	for _, column := range append([]string{side.AmountColumn, side.DateColumn, side.ReferenceColumn}, keyColumns...) {
+		if column != "" && !seen[column] {
+			seen[column] = true
+			columns = append(columns, column)
+		}
+	}
+
+	columnConfigs := make([]datasetmodels.ColumnConfig, len(columns))
+	for i, column := range columns {
+		columnConfigs[i] = datasetmodels.ColumnConfig{Column: column}
+	}
+
+	var rows []*matcherRow
+	for page := 1; ; page++ {
+		data, err := s.datasetService.GetDataByDatasetId(ctx, orgId, datasetId.String(), datasetmodels.DatasetParams{
+			Columns: columnConfigs,
+			Filters: side.Filters,
+			OrderBy: []datasetmodels.OrderBy{{
+				Column: datasetConstants.ZampIDColumn,
+				Order:  datasetmodels.OrderType(querybuilderconstants.OrderAsc),
+			}},
+			Pagination: &datasetmodels.Pagination{
+				Page:     page,
+				PageSize: constants.ReconciliationLoadPageSize,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows)+len(data.Rows) > constants.ReconciliationMaxRowsPerSide {
+			return nil, errors.ErrReconciliationTooManyRows
+		}
+
+		for _, values := range data.Rows {
+			row, err := newMatcherRow(values, side, keyColumns)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, row)
+		}
+
+		if len(data.Rows) < constants.ReconciliationLoadPageSize {
+			return rows, nil
+		}
+	}
+}
+
+func newMatcherRow(values map[string]interface{}, side models.ReconciliationSide, keyColumns []string) (*matcherRow, error) {
+	row := &matcherRow{
+		row: storemodels.ReconciliationRow{
+			RowId: normalizeValue(values[datasetConstants.ZampIDColumn]),
+		},
+		values: make(map[string]string, len(keyColumns)),
+	}
+	if row.row.RowId == "" {
+		return nil, fmt.Errorf("row without %s", datasetConstants.ZampIDColumn)
+	}
+
+	if side.AmountColumn != "" {
+		amount, err := parseReconciliationAmount(values[side.AmountColumn])
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount of row %s: %w", row.row.RowId, err)
+		}
+		row.row.Amount = amount
+	}
+
+	if side.DateColumn != "" {
+		date, err := parseReconciliationDate(values[side.DateColumn])
+		if err != nil {
+			return nil, fmt.Errorf("invalid date of row %s: %w", row.row.RowId, err)
+		}
+		row.row.Date = date
+	}
+
+	if side.ReferenceColumn != "" {
+		row.row.Reference = strings.TrimSpace(fmt.Sprint(nilToEmpty(values[side.ReferenceColumn])))
+	}
+
+	for _, column := range keyColumns {
+		row.values[column] = strings.ToLower(normalizeValue(values[column]))
+	}
+
+	return row, nil
+}
+
+// normalizeValue formats key values so the same value read from differently typed columns compares equal,
+// integral floats lose their decimals since numeric ids are often read as floats
+func normalizeValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case []byte:
+		return strings.TrimSpace(string(v))
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return normalizeValue(float64(v))
+	case json.Number:
+		return v.String()
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return strings.TrimSpace(fmt.Sprint(v))
+	}
+}
+
+func nilToEmpty(value interface{}) interface{} {
+	if value == nil {
+		return ""
+	}
+	return value
+}
+
+// parseReconciliationAmount reads an amount as returned by the data platform, empty amounts are nil
+func parseReconciliationAmount(value interface{}) (*float64, error) {
+	var amount float64
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		amount = v
+	case float32:
+		amount = float64(v)
+	case int:
+		amount = float64(v)
+	case int32:
+		amount = float64(v)
+	case int64:
+		amount = float64(v)
+	case json.Number:
+		parsed, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		amount = parsed
+	case string, []byte:
+		text := strings.TrimSpace(fmt.Sprintf("%s", v))
+		if text == "" {
+			return nil, nil
+		}
+		parsed, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, err
+		}
+		amount = parsed
+	default:
+		return nil, fmt.Errorf("unsupported amount type %T", value)
+	}
+
+	return &amount, nil
+}
+
+// parseReconciliationDate reads a date or timestamp as returned by the data platform, empty dates are nil
+func parseReconciliationDate(value interface{}) (*time.Time, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case time.Time:
+		return &v, nil
+	case string:
+		text := strings.TrimSpace(v)
+		if text == "" {
+			return nil, nil
+		}
+		for _, layout := range reconciliationDateLayouts {
+			if parsed, err := time.Parse(layout, text); err == nil {
+				return &parsed, nil
+			}
+		}
+		return nil, fmt.Errorf("unsupported date format %q", text)
+	default:
+		return nil, fmt.Errorf("unsupported date type %T", value)
+	}
+}
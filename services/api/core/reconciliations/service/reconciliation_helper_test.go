@@ -0,0 +1,153 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/constants"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/errors"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReconciliationParams(t *testing.T) {
+	window := 2
+	similarity := 1.5
+	threshold := 0.8
+
+	validParams := func() models.ReconciliationParams {
+		return models.ReconciliationParams{
+			Name:           " Bank vs ledger ",
+			LeftDatasetId:  uuid.New(),
+			RightDatasetId: uuid.New(),
+			Left:           models.ReconciliationSide{AmountColumn: "amount", DateColumn: "posted_at"},
+			Right:          models.ReconciliationSide{AmountColumn: "value", DateColumn: "booked_at"},
+			Rules: []models.MatchRule{
+				{Keys: []models.MatchKey{{LeftColumn: "invoice", RightColumn: "invoice_id"}}, CompareAmount: true},
+				{CompareAmount: true, AmountTolerance: 1, DateWindowDays: &window},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*models.ReconciliationParams)
+		wantErr error
+	}{
+		{
+			name:   "Valid params get default rule names and groupings",
+			modify: func(p *models.ReconciliationParams) {},
+		},
+		{
+			name:    "Empty name",
+			modify:  func(p *models.ReconciliationParams) { p.Name = " " },
+			wantErr: errors.ErrEmptyReconciliationName,
+		},
+		{
+			name:    "Missing dataset",
+			modify:  func(p *models.ReconciliationParams) { p.RightDatasetId = uuid.Nil },
+			wantErr: errors.ErrInvalidReconciliationSide,
+		},
+		{
+			name:    "No rules",
+			modify:  func(p *models.ReconciliationParams) { p.Rules = nil },
+			wantErr: errors.ErrInvalidMatchRules,
+		},
+		{
+			name: "Duplicate rule names",
+			modify: func(p *models.ReconciliationParams) {
+				p.Rules[0].Name = "same"
+				p.Rules[1].Name = "same"
+			},
+			wantErr: errors.ErrInvalidMatchRules,
+		},
+		{
+			name:    "Amount compared without amount column",
+			modify:  func(p *models.ReconciliationParams) { p.Right.AmountColumn = "" },
+			wantErr: errors.ErrInvalidMatchRules,
+		},
+		{
+			name:    "Similarity out of range",
+			modify:  func(p *models.ReconciliationParams) { p.Rules[0].MinReferenceSimilarity = &similarity },
+			wantErr: errors.ErrInvalidMatchRules,
+		},
+		{
+			name: "Grouping without keys",
+			modify: func(p *models.ReconciliationParams) {
+				p.Rules[1].Grouping = constants.MatchGroupingOneToMany
+			},
+			wantErr: errors.ErrInvalidMatchRules,
+		},
+		{
+			name: "One to one rule comparing only dates and references",
+			modify: func(p *models.ReconciliationParams) {
+				p.Left.ReferenceColumn = "memo"
+				p.Right.ReferenceColumn = "description"
+				p.Rules[1] = models.MatchRule{DateWindowDays: &window, MinReferenceSimilarity: &threshold}
+			},
+			wantErr: errors.ErrInvalidMatchRules,
+		},
+		{
+			name: "Rule without any criteria",
+			modify: func(p *models.ReconciliationParams) {
+				p.Rules[1] = models.MatchRule{Name: "empty"}
+			},
+			wantErr: errors.ErrInvalidMatchRules,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := validParams()
+			tt.modify(&params)
+
+			result, err := validateReconciliationParams(params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Bank vs ledger", result.Name)
+			assert.Equal(t, "rule_1", result.Rules[0].Name)
+			assert.Equal(t, "rule_2", result.Rules[1].Name)
+			assert.Equal(t, constants.MatchGroupingOneToOne, result.Rules[0].Grouping)
+		})
+	}
+}
+
+func TestValidateManualMatchParams(t *testing.T) {
+	assert.NoError(t, validateManualMatchParams(models.ManualMatchParams{LeftRowIds: []string{"l1"}, RightRowIds: []string{"r1", "r2"}}))
+	assert.ErrorIs(t, validateManualMatchParams(models.ManualMatchParams{LeftRowIds: []string{"l1"}}), errors.ErrInvalidManualMatch)
+	assert.ErrorIs(t, validateManualMatchParams(models.ManualMatchParams{LeftRowIds: []string{"l1", "l1"}, RightRowIds: []string{"r1"}}), errors.ErrInvalidManualMatch)
+}
+
+func TestNewMatcherRow(t *testing.T) {
+	side := models.ReconciliationSide{AmountColumn: "amount", DateColumn: "date", ReferenceColumn: "memo"}
+
+	row, err := newMatcherRow(map[string]interface{}{
+		datasetConstants.ZampIDColumn: "row-1",
+		"amount":                      "125.50",
+		"date":                        "2025-04-02",
+		"memo":                        " INV-7 ",
+		"invoice":                     "ABC",
+	}, side, []string{"invoice"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "row-1", row.row.RowId)
+	assert.Equal(t, 125.5, *row.row.Amount)
+	assert.Equal(t, time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC), *row.row.Date)
+	assert.Equal(t, "INV-7", row.row.Reference)
+	assert.Equal(t, "abc", row.values["invoice"])
+
+	_, err = newMatcherRow(map[string]interface{}{
+		datasetConstants.ZampIDColumn: "row-2",
+		"amount":                      "not a number",
+	}, side, nil)
+	assert.Error(t, err)
+
+	_, err = newMatcherRow(map[string]interface{}{"amount": 1.0}, side, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	dataplatformdataconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
+	dataplatformdatamodels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
+	datasetconstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/constants"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+var sqlStringReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+// registerResultDataset registers the dataset the runs of a reconciliation write their matches to. The matches carry
+// rows of both datasets so the result dataset is shared with the owner only instead of the whole organization.
+func (s *reconciliationService) registerResultDataset(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, name string) (uuid.UUID, error) {
+	description := fmt.Sprintf(constants.ReconciliationResultDatasetDescriptionFormat, name)
+	_, datasetId, err := s.datasetService.RegisterDataset(ctx, orgId, userId, datasetmodels.DatasetCreationInfo{
+		DatasetTitle:       fmt.Sprintf(constants.ReconciliationResultDatasetTitleFormat, name),
+		DatasetDescription: &description,
+		DatasetType:        storemodels.DatasetTypeSource,
+		DatabricksConfig: dataplatformdatamodels.DatabricksConfig{
+			DedupColumns:  constants.ReconciliationResultDedupColumns,
+			OrderByColumn: constants.ReconciliationResultOrderByColumn,
+		},
+		Provider: dataplatformdataconstants.ProviderDatabricks,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if _, err := s.datasetService.AddAudienceToDataset(ctx, datasetId, storemodels.AudienceTypeUser, userId, storemodels.PrivilegeDatasetAdmin); err != nil {
+		return uuid.Nil, err
+	}
+	if err := s.datasetService.RemoveAudienceFromDataset(ctx, datasetId, orgId); err != nil {
+		return uuid.Nil, err
+	}
+
+	return datasetId, nil
+}
+
+// writeResultRows replaces the rows of the matches of a run in the result dataset, or only those of matchId when it
+// is set. Rows are deleted before they are written so a retried run does not keep the matches of a failed attempt.
+func (s *reconciliationService) writeResultRows(ctx context.Context, orgId uuid.UUID, reconciliation storemodels.Reconciliation, runId uuid.UUID, matchId *uuid.UUID, matches []storemodels.CreateReconciliationMatchParams) error {
+	if reconciliation.ResultDatasetId == nil {
+		return nil
+	}
+
+	datasetId := reconciliation.ResultDatasetId.String()
+	table := fmt.Sprintf("{{.%s%s}}", datasetconstants.ZampDatasetPrefix, datasetId)
+
+	condition := fmt.Sprintf("run_id = '%s'", runId)
+	if matchId != nil {
+		condition += fmt.Sprintf(" AND match_id = '%s'", matchId)
+	}
+	if _, err := s.datasetService.ExecuteRawQuery(ctx, orgId, datasetId, fmt.Sprintf("DELETE FROM %s WHERE %s", table, condition), nil); err != nil {
+		return err
+	}
+
+	updatedAt := time.Now().UTC()
+	values := []string{}
+	params := map[string]interface{}{}
+	flush := func() error {
+		if len(values) == 0 {
+			return nil
+		}
+
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", table, strings.Join(constants.ReconciliationResultColumns, ", "), strings.Join(values, ", "))
+		if _, err := s.datasetService.ExecuteRawQuery(ctx, orgId, datasetId, query, params); err != nil {
+			return err
+		}
+
+		values = []string{}
+		params = map[string]interface{}{}
+		return nil
+	}
+
+	for _, match := range matches {
+		for _, side := range []string{storemodels.ReconciliationSideLeft, storemodels.ReconciliationSideRight} {
+			rows := match.LeftRows
+			if side == storemodels.ReconciliationSideRight {
+				rows = match.RightRows
+			}
+
+			for _, row := range rows {
+				values = append(values, resultRowValues(params, runId, match, side, row, updatedAt))
+				if len(values) == constants.ReconciliationResultInsertBatchSize {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return flush()
+}
+
+// resultRowValues renders one row of the result dataset. Values entered by users are passed as query params so they
+// are never parsed as part of the query template.
+func resultRowValues(params map[string]interface{}, runId uuid.UUID, match storemodels.CreateReconciliationMatchParams, side string, row storemodels.ReconciliationRow, updatedAt time.Time) string {
+	param := func(value string) string {
+		key := fmt.Sprintf("value_%d", len(params))
+		params[key] = sqlString(value)
+		return fmt.Sprintf("{{.%s}}", key)
+	}
+
+	amount := "NULL"
+	if row.Amount != nil {
+		amount = strconv.FormatFloat(*row.Amount, 'f', -1, 64)
+	}
+	date := "NULL"
+	if row.Date != nil {
+		date = sqlTimestamp(*row.Date)
+	}
+
+	return fmt.Sprintf("('%s', '%s', %s, %s, '%s', '%s', %s, %s, %s, %s, %s)",
+		runId, match.MatchId, param(match.RuleName), sqlString(match.MatchType), match.Status, side, param(row.RowId), amount, date, param(row.Reference), sqlTimestamp(updatedAt))
+}
+
+func sqlString(value string) string {
+	return "'" + sqlStringReplacer.Replace(value) + "'"
+}
+
+func sqlTimestamp(value time.Time) string {
+	return fmt.Sprintf("TIMESTAMP '%s'", value.UTC().Format(time.RFC3339Nano))
+}
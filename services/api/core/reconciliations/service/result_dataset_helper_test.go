@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/constants"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mock_datasetservice "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRegisterResultDataset(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	datasetId := uuid.New()
+
+	mockDatasetService := mock_datasetservice.NewMockDatasetService(t)
+	mockDatasetService.EXPECT().RegisterDataset(mock.Anything, orgId, userId, mock.MatchedBy(func(info datasetmodels.DatasetCreationInfo) bool {
+		return info.DatasetTitle == "Bank vs ledger matches" && info.DatasetType == storemodels.DatasetTypeSource &&
+			assert.ObjectsAreEqual(constants.ReconciliationResultDedupColumns, info.DatabricksConfig.DedupColumns)
+	})).Return("action-id", datasetId, nil)
+	mockDatasetService.EXPECT().AddAudienceToDataset(mock.Anything, datasetId, storemodels.AudienceTypeUser, userId, storemodels.PrivilegeDatasetAdmin).Return(&storemodels.ResourceAudiencePolicy{}, nil)
+	mockDatasetService.EXPECT().RemoveAudienceFromDataset(mock.Anything, datasetId, orgId).Return(nil)
+
+	service := &reconciliationService{datasetService: mockDatasetService}
+
+	resultDatasetId, err := service.registerResultDataset(context.Background(), orgId, userId, "Bank vs ledger")
+
+	assert.NoError(t, err)
+	assert.Equal(t, datasetId, resultDatasetId)
+}
+
+func TestWriteResultRows(t *testing.T) {
+	orgId := uuid.New()
+	runId := uuid.New()
+	resultDatasetId := uuid.New()
+	amount := 12.5
+
+	matches := make([]storemodels.CreateReconciliationMatchParams, constants.ReconciliationResultInsertBatchSize)
+	for i := range matches {
+		matches[i] = storemodels.CreateReconciliationMatchParams{
+			MatchId:   uuid.New(),
+			RuleName:  "{{.zamp_x}}",
+			MatchType: string(constants.MatchGroupingOneToOne),
+			Status:    storemodels.ReconciliationMatchStatusMatched,
+			LeftRows:  []storemodels.ReconciliationRow{{RowId: "l", Amount: &amount, Reference: `it's \ fine`}},
+			RightRows: []storemodels.ReconciliationRow{{RowId: "r"}},
+		}
+	}
+
+	table := "{{.zamp_" + resultDatasetId.String() + "}}"
+
+	mockDatasetService := mock_datasetservice.NewMockDatasetService(t)
+	mockDatasetService.EXPECT().ExecuteRawQuery(mock.Anything, orgId, resultDatasetId.String(), "DELETE FROM "+table+" WHERE run_id = '"+runId.String()+"'", map[string]interface{}(nil)).Return(datasetmodels.DatasetData{}, nil).Once()
+	mockDatasetService.EXPECT().ExecuteRawQuery(mock.Anything, orgId, resultDatasetId.String(), mock.MatchedBy(func(query string) bool {
+		return strings.HasPrefix(query, "INSERT INTO "+table+" (run_id, match_id,") && strings.Count(query, "'MATCHED'") == constants.ReconciliationResultInsertBatchSize &&
+			!strings.Contains(query, "zamp_x") && strings.Contains(query, ", 12.5, NULL, ")
+	}), mock.MatchedBy(func(params map[string]interface{}) bool {
+		return params["value_0"] == `'{{.zamp_x}}'` && params["value_2"] == `'it\'s \\ fine'`
+	})).Return(datasetmodels.DatasetData{}, nil).Twice()
+
+	service := &reconciliationService{datasetService: mockDatasetService}
+
+	err := service.writeResultRows(context.Background(), orgId, storemodels.Reconciliation{ResultDatasetId: &resultDatasetId}, runId, nil, matches)
+
+	assert.NoError(t, err)
+}
+
+func TestWriteResultRowsWithoutResultDataset(t *testing.T) {
+	service := &reconciliationService{datasetService: mock_datasetservice.NewMockDatasetService(t)}
+
+	err := service.writeResultRows(context.Background(), uuid.New(), storemodels.Reconciliation{}, uuid.New(), nil, []storemodels.CreateReconciliationMatchParams{{MatchId: uuid.New()}})
+
+	assert.NoError(t, err)
+}
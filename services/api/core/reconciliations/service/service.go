@@ -0,0 +1,568 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	dataplatformactionconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/constants"
+	datasetactionconstants "github.com/Zampfi/application-platform/services/api/core/datasets/actions/constants"
+	datasetactionservice "github.com/Zampfi/application-platform/services/api/core/datasets/actions/service"
+	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/constants"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/errors"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	workersconstants "github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	temporalsdk "github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal"
+	temporalmodels "github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/models"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type ReconciliationServiceStore interface {
+	store.ReconciliationStore
+	store.FlattenedResourceAudiencePoliciesStore
+	store.TransactionStore
+}
+
+type ReconciliationService interface {
+	GetReconciliations(ctx context.Context) ([]models.Reconciliation, error)
+	GetReconciliation(ctx context.Context, reconciliationId uuid.UUID) (models.Reconciliation, error)
+	CreateReconciliation(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.ReconciliationParams) (models.Reconciliation, error)
+	UpdateReconciliation(ctx context.Context, userId uuid.UUID, reconciliationId uuid.UUID, params models.ReconciliationParams) (models.Reconciliation, error)
+	DeleteReconciliation(ctx context.Context, userId uuid.UUID, reconciliationId uuid.UUID) error
+	StartReconciliationRun(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID) (storemodels.ReconciliationRun, error)
+	GetReconciliationRuns(ctx context.Context, reconciliationId uuid.UUID) ([]storemodels.ReconciliationRun, error)
+	GetReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID) (storemodels.ReconciliationRun, error)
+	GetReconciliationMatches(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID, filters storemodels.ReconciliationMatchFilters) (models.ReconciliationMatchesPage, error)
+	GetReconciliationExceptions(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID, filters storemodels.ReconciliationExceptionFilters) (models.ReconciliationExceptionsPage, error)
+	CreateManualMatch(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID, runId uuid.UUID, params models.ManualMatchParams) (models.ReconciliationMatch, error)
+	UnmatchReconciliationMatch(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID, runId uuid.UUID, matchId uuid.UUID) error
+
+	RunReconciliationActivity(ctx context.Context, params models.ReconciliationRunWorkflowParams) (models.ReconciliationRunResult, error)
+	FailReconciliationRunActivity(ctx context.Context, params models.ReconciliationRunWorkflowParams, message string) error
+}
+
+type reconciliationService struct {
+	store           ReconciliationServiceStore
+	datasetService  datasetservice.DatasetService
+	temporalService temporalsdk.TemporalService
+}
+
+func NewReconciliationService(appStore store.Store, datasetService datasetservice.DatasetService, temporalService temporalsdk.TemporalService) *reconciliationService {
+	return &reconciliationService{store: appStore, datasetService: datasetService, temporalService: temporalService}
+}
+
+func (s *reconciliationService) GetReconciliations(ctx context.Context) ([]models.Reconciliation, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeReconciliations, err := s.store.GetReconciliations(ctx)
+	if err != nil {
+		logger.Error("failed to get reconciliations", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	reconciliations := make([]models.Reconciliation, len(storeReconciliations))
+	for i, storeReconciliation := range storeReconciliations {
+		if err := reconciliations[i].FromSchema(storeReconciliation); err != nil {
+			return nil, err
+		}
+	}
+
+	return reconciliations, nil
+}
+
+func (s *reconciliationService) GetReconciliation(ctx context.Context, reconciliationId uuid.UUID) (models.Reconciliation, error) {
+	storeReconciliation, err := s.getReconciliation(ctx, reconciliationId)
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	reconciliation := models.Reconciliation{}
+	if err := reconciliation.FromSchema(storeReconciliation); err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	return reconciliation, nil
+}
+
+// CreateReconciliation saves the reconciliation with its creator as owner, the creator needs access to both datasets.
+// The result dataset the runs write their matches to is registered with it.
+func (s *reconciliationService) CreateReconciliation(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.ReconciliationParams) (models.Reconciliation, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	params, err := validateReconciliationParams(params)
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	resultDatasetId, err := s.registerResultDataset(ctx, orgId, userId, params.Name)
+	if err != nil {
+		logger.Error("failed to register reconciliation result dataset", zap.String("error", err.Error()))
+		return models.Reconciliation{}, err
+	}
+
+	storeReconciliation, err := s.store.CreateReconciliation(ctx, storemodels.CreateReconciliationParams{
+		OrganizationId:  orgId,
+		OwnerId:         userId,
+		Name:            params.Name,
+		Description:     params.Description,
+		LeftDatasetId:   params.LeftDatasetId,
+		RightDatasetId:  params.RightDatasetId,
+		LeftConfig:      params.Left,
+		RightConfig:     params.Right,
+		Rules:           params.Rules,
+		ResultDatasetId: &resultDatasetId,
+	})
+	if err != nil {
+		logger.Error("failed to create reconciliation", zap.String("error", err.Error()))
+		return models.Reconciliation{}, err
+	}
+
+	reconciliation := models.Reconciliation{}
+	if err := reconciliation.FromSchema(storeReconciliation); err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	return reconciliation, nil
+}
+
+// UpdateReconciliation replaces the sides and rules of a reconciliation, its datasets can not be changed since
+// the results of earlier runs refer to their rows
+func (s *reconciliationService) UpdateReconciliation(ctx context.Context, userId uuid.UUID, reconciliationId uuid.UUID, params models.ReconciliationParams) (models.Reconciliation, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	existing, err := s.getReconciliation(ctx, reconciliationId)
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	if err := s.ensureReconciliationEditor(ctx, existing, userId); err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	params.LeftDatasetId = existing.LeftDatasetId
+	params.RightDatasetId = existing.RightDatasetId
+	params, err = validateReconciliationParams(params)
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	storeReconciliation, err := s.store.UpdateReconciliation(ctx, reconciliationId, storemodels.UpdateReconciliationParams{
+		Name:        params.Name,
+		Description: params.Description,
+		LeftConfig:  params.Left,
+		RightConfig: params.Right,
+		Rules:       params.Rules,
+		UpdatedBy:   userId,
+	})
+	if err != nil {
+		logger.Error("failed to update reconciliation", zap.String("reconciliation_id", reconciliationId.String()), zap.String("error", err.Error()))
+		return models.Reconciliation{}, err
+	}
+
+	reconciliation := models.Reconciliation{}
+	if err := reconciliation.FromSchema(storeReconciliation); err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	return reconciliation, nil
+}
+
+func (s *reconciliationService) DeleteReconciliation(ctx context.Context, userId uuid.UUID, reconciliationId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	reconciliation, err := s.getReconciliation(ctx, reconciliationId)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ensureReconciliationEditor(ctx, reconciliation, userId); err != nil {
+		return err
+	}
+
+	if err := s.store.DeleteReconciliation(ctx, reconciliationId, userId); err != nil {
+		logger.Error("failed to delete reconciliation", zap.String("reconciliation_id", reconciliationId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// StartReconciliationRun records a new run and starts the workflow executing it, a reconciliation runs one at a time
+// since every run carries the manual decisions of the previous one forward
+func (s *reconciliationService) StartReconciliationRun(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID) (storemodels.ReconciliationRun, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if _, err := s.getReconciliation(ctx, reconciliationId); err != nil {
+		return storemodels.ReconciliationRun{}, err
+	}
+
+	_, err := s.store.GetLatestReconciliationRun(ctx, reconciliationId, storemodels.ReconciliationRunStatusRunning)
+	if err == nil {
+		return storemodels.ReconciliationRun{}, errors.ErrReconciliationRunInProgress
+	}
+	if err != gorm.ErrRecordNotFound {
+		logger.Error("failed to get running reconciliation run", zap.String("error", err.Error()))
+		return storemodels.ReconciliationRun{}, err
+	}
+
+	run, err := s.store.CreateReconciliationRun(ctx, reconciliationId, userId)
+	if err != nil {
+		logger.Error("failed to create reconciliation run", zap.String("error", err.Error()))
+		return storemodels.ReconciliationRun{}, err
+	}
+
+	workflowId := fmt.Sprintf(constants.ReconciliationRunWorkflowIdFormat, run.ID.String())
+	_, err = s.temporalService.ExecuteAsyncWorkflow(ctx, temporalmodels.ExecuteWorkflowParams{
+		Options: temporalmodels.StartWorkflowOptions{
+			ID:        workflowId,
+			TaskQueue: workersconstants.DefaultTaskQueueName,
+		},
+		Workflow: workersconstants.ReconciliationRunWorkflowName,
+		Args: []interface{}{
+			models.ReconciliationRunWorkflowParams{
+				ReconciliationId: reconciliationId,
+				RunId:            run.ID,
+				OrganizationId:   orgId,
+				UserId:           userId,
+			},
+		},
+	})
+	if err != nil {
+		logger.Error("failed to start reconciliation run workflow", zap.String("run_id", run.ID.String()), zap.Error(err))
+
+		failErr := s.store.CompleteReconciliationRun(ctx, run.ID, storemodels.CompleteReconciliationRunParams{
+			Status: storemodels.ReconciliationRunStatusFailed,
+			Error:  err.Error(),
+		})
+		if failErr != nil {
+			logger.Error("failed to record reconciliation run failure", zap.Error(failErr))
+		}
+		return storemodels.ReconciliationRun{}, err
+	}
+
+	if err := s.store.UpdateReconciliationRunWorkflowId(ctx, run.ID, workflowId); err != nil {
+		logger.Error("failed to record reconciliation run workflow id", zap.Error(err))
+		return storemodels.ReconciliationRun{}, err
+	}
+	run.WorkflowId = workflowId
+
+	return run, nil
+}
+
+func (s *reconciliationService) GetReconciliationRuns(ctx context.Context, reconciliationId uuid.UUID) ([]storemodels.ReconciliationRun, error) {
+	if _, err := s.getReconciliation(ctx, reconciliationId); err != nil {
+		return nil, err
+	}
+
+	return s.store.GetReconciliationRuns(ctx, reconciliationId, constants.ReconciliationRunsLimit)
+}
+
+func (s *reconciliationService) GetReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID) (storemodels.ReconciliationRun, error) {
+	_, run, err := s.getReconciliationRun(ctx, reconciliationId, runId)
+	return run, err
+}
+
+func (s *reconciliationService) GetReconciliationMatches(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID, filters storemodels.ReconciliationMatchFilters) (models.ReconciliationMatchesPage, error) {
+	if _, _, err := s.getReconciliationRun(ctx, reconciliationId, runId); err != nil {
+		return models.ReconciliationMatchesPage{}, err
+	}
+
+	filters.Page, filters.PageSize = normalizeReconciliationPage(filters.Page, filters.PageSize)
+
+	storeMatches, err := s.store.GetReconciliationMatches(ctx, runId, filters)
+	if err != nil {
+		return models.ReconciliationMatchesPage{}, err
+	}
+
+	count, err := s.store.CountReconciliationMatches(ctx, runId, filters)
+	if err != nil {
+		return models.ReconciliationMatchesPage{}, err
+	}
+
+	matches := make([]models.ReconciliationMatch, len(storeMatches))
+	for i, storeMatch := range storeMatches {
+		if err := matches[i].FromSchema(storeMatch); err != nil {
+			return models.ReconciliationMatchesPage{}, err
+		}
+	}
+
+	return models.ReconciliationMatchesPage{Matches: matches, TotalCount: count}, nil
+}
+
+func (s *reconciliationService) GetReconciliationExceptions(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID, filters storemodels.ReconciliationExceptionFilters) (models.ReconciliationExceptionsPage, error) {
+	if _, _, err := s.getReconciliationRun(ctx, reconciliationId, runId); err != nil {
+		return models.ReconciliationExceptionsPage{}, err
+	}
+
+	filters.Page, filters.PageSize = normalizeReconciliationPage(filters.Page, filters.PageSize)
+
+	exceptions, err := s.store.GetReconciliationExceptions(ctx, runId, filters)
+	if err != nil {
+		return models.ReconciliationExceptionsPage{}, err
+	}
+
+	count, err := s.store.CountReconciliationExceptions(ctx, runId, filters)
+	if err != nil {
+		return models.ReconciliationExceptionsPage{}, err
+	}
+
+	return models.ReconciliationExceptionsPage{Exceptions: exceptions, TotalCount: count}, nil
+}
+
+// CreateManualMatch matches open exceptions of a completed run together. The match is recorded as a dataset action
+// on both datasets, written to the result dataset and carried forward by the next runs as long as all its rows are
+// still there.
+func (s *reconciliationService) CreateManualMatch(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID, runId uuid.UUID, params models.ManualMatchParams) (models.ReconciliationMatch, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := validateManualMatchParams(params); err != nil {
+		return models.ReconciliationMatch{}, err
+	}
+
+	reconciliation, run, err := s.getReconciliationRun(ctx, reconciliationId, runId)
+	if err != nil {
+		return models.ReconciliationMatch{}, err
+	}
+	if run.Status != storemodels.ReconciliationRunStatusSuccessful {
+		return models.ReconciliationMatch{}, errors.ErrReconciliationRunNotCompleted
+	}
+
+	leftRows, leftSkipped, err := s.getOpenExceptionRows(ctx, runId, storemodels.ReconciliationSideLeft, params.LeftRowIds)
+	if err != nil {
+		return models.ReconciliationMatch{}, err
+	}
+	rightRows, rightSkipped, err := s.getOpenExceptionRows(ctx, runId, storemodels.ReconciliationSideRight, params.RightRowIds)
+	if err != nil {
+		return models.ReconciliationMatch{}, err
+	}
+
+	leftMatcherRows := make([]*matcherRow, len(leftRows))
+	for i, row := range leftRows {
+		leftMatcherRows[i] = &matcherRow{row: row}
+	}
+	rightMatcherRows := make([]*matcherRow, len(rightRows))
+	for i, row := range rightRows {
+		rightMatcherRows[i] = &matcherRow{row: row}
+	}
+	match := newMatch("", constants.MatchTypeManual, storemodels.ReconciliationMatchStatusManuallyMatched, leftMatcherRows, rightMatcherRows, &userId)
+
+	err = s.store.WithTx(ctx, func(tx store.Store) error {
+		if err := tx.CreateReconciliationMatches(ctx, runId, []storemodels.CreateReconciliationMatchParams{match}); err != nil {
+			return err
+		}
+
+		if err := resolveManualMatchExceptions(ctx, tx, runId, storemodels.ReconciliationSideLeft, params.LeftRowIds, match.MatchId); err != nil {
+			return err
+		}
+		if err := resolveManualMatchExceptions(ctx, tx, runId, storemodels.ReconciliationSideRight, params.RightRowIds, match.MatchId); err != nil {
+			return err
+		}
+
+		err := tx.AdjustReconciliationRunCounts(ctx, runId, storemodels.ReconciliationRunCountDeltas{
+			Matches:         1,
+			LeftExceptions:  leftSkipped - len(leftRows),
+			RightExceptions: rightSkipped - len(rightRows),
+			LeftSkipped:     -leftSkipped,
+			RightSkipped:    -rightSkipped,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := recordManualMatchAction(ctx, tx, orgId, userId, reconciliation, runId, match, datasetactionconstants.ActionTypeReconciliationManualMatch); err != nil {
+			return err
+		}
+
+		return s.writeResultRows(ctx, orgId, reconciliation, runId, &match.MatchId, []storemodels.CreateReconciliationMatchParams{match})
+	})
+	if err != nil {
+		logger.Error("failed to create manual reconciliation match", zap.String("run_id", runId.String()), zap.Error(err))
+		return models.ReconciliationMatch{}, err
+	}
+
+	return models.ReconciliationMatch{
+		MatchId:     match.MatchId,
+		MatchType:   match.MatchType,
+		Status:      match.Status,
+		LeftRows:    match.LeftRows,
+		RightRows:   match.RightRows,
+		LeftAmount:  match.LeftAmount,
+		RightAmount: match.RightAmount,
+		CreatedBy:   match.CreatedBy,
+		UpdatedBy:   match.CreatedBy,
+	}, nil
+}
+
+// UnmatchReconciliationMatch puts the rows of a match back in the exception queues. The match is kept as UNMATCHED,
+// in the run and in the result dataset, so the next runs do not pair the same rows again.
+func (s *reconciliationService) UnmatchReconciliationMatch(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID, runId uuid.UUID, matchId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	reconciliation, run, err := s.getReconciliationRun(ctx, reconciliationId, runId)
+	if err != nil {
+		return err
+	}
+	if run.Status != storemodels.ReconciliationRunStatusSuccessful {
+		return errors.ErrReconciliationRunNotCompleted
+	}
+
+	storeMatch, err := s.store.GetReconciliationMatch(ctx, runId, matchId)
+	if err != nil {
+		return errors.ErrReconciliationMatchNotFound
+	}
+	if storeMatch.Status == storemodels.ReconciliationMatchStatusUnmatched {
+		return errors.ErrReconciliationMatchUnmatched
+	}
+
+	match := models.ReconciliationMatch{}
+	if err := match.FromSchema(storeMatch); err != nil {
+		return err
+	}
+
+	err = s.store.WithTx(ctx, func(tx store.Store) error {
+		if err := tx.UpdateReconciliationMatchStatus(ctx, runId, matchId, storemodels.ReconciliationMatchStatusUnmatched, userId); err != nil {
+			return err
+		}
+		if err := tx.ReopenReconciliationExceptions(ctx, runId, storemodels.ReconciliationSideLeft, match.LeftRows); err != nil {
+			return err
+		}
+		if err := tx.ReopenReconciliationExceptions(ctx, runId, storemodels.ReconciliationSideRight, match.RightRows); err != nil {
+			return err
+		}
+		err := tx.AdjustReconciliationRunCounts(ctx, runId, storemodels.ReconciliationRunCountDeltas{
+			Matches:         -1,
+			LeftExceptions:  len(match.LeftRows),
+			RightExceptions: len(match.RightRows),
+		})
+		if err != nil {
+			return err
+		}
+
+		unmatched := storemodels.CreateReconciliationMatchParams{
+			MatchId:   match.MatchId,
+			RuleName:  match.RuleName,
+			MatchType: match.MatchType,
+			Status:    storemodels.ReconciliationMatchStatusUnmatched,
+			LeftRows:  match.LeftRows,
+			RightRows: match.RightRows,
+		}
+		if err := recordManualMatchAction(ctx, tx, orgId, userId, reconciliation, runId, unmatched, datasetactionconstants.ActionTypeReconciliationManualUnmatch); err != nil {
+			return err
+		}
+
+		return s.writeResultRows(ctx, orgId, reconciliation, runId, &match.MatchId, []storemodels.CreateReconciliationMatchParams{unmatched})
+	})
+	if err != nil {
+		logger.Error("failed to unmatch reconciliation match", zap.String("match_id", matchId.String()), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// getOpenExceptionRows returns the rows of the open exceptions and how many of them were skipped by the run
+func (s *reconciliationService) getOpenExceptionRows(ctx context.Context, runId uuid.UUID, side string, rowIds []string) ([]storemodels.ReconciliationRow, int, error) {
+	exceptions, err := s.store.GetReconciliationExceptions(ctx, runId, storemodels.ReconciliationExceptionFilters{
+		Sides:    []string{side},
+		Statuses: []string{storemodels.ReconciliationExceptionStatusOpen},
+		RowIds:   rowIds,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(exceptions) != len(rowIds) {
+		return nil, 0, errors.ErrInvalidManualMatch
+	}
+
+	skipped := 0
+	rows := make([]storemodels.ReconciliationRow, len(exceptions))
+	for i, exception := range exceptions {
+		if exception.Skipped {
+			skipped++
+		}
+		rows[i] = storemodels.ReconciliationRow{
+			RowId:     exception.RowId,
+			Amount:    exception.Amount,
+			Date:      exception.TransactionDate,
+			Reference: exception.Reference,
+		}
+	}
+
+	return rows, skipped, nil
+}
+
+// resolveManualMatchExceptions fails when one of the rows was matched by someone else since it was read
+func resolveManualMatchExceptions(ctx context.Context, tx store.Store, runId uuid.UUID, side string, rowIds []string, matchId uuid.UUID) error {
+	resolved, err := tx.ResolveReconciliationExceptions(ctx, runId, side, rowIds, matchId)
+	if err != nil {
+		return err
+	}
+	if resolved != int64(len(rowIds)) {
+		return errors.ErrInvalidManualMatch
+	}
+
+	return nil
+}
+
+// recordManualMatchAction records a manual match or unmatch on the datasets of both sides, in the same transaction
+// as the change itself
+func recordManualMatchAction(ctx context.Context, tx store.Store, orgId uuid.UUID, userId uuid.UUID, reconciliation storemodels.Reconciliation, runId uuid.UUID, match storemodels.CreateReconciliationMatchParams, actionType datasetactionconstants.ActionType) error {
+	config := map[string]interface{}{
+		"reconciliation_id": reconciliation.ID,
+		"run_id":            runId,
+		"match_id":          match.MatchId,
+		"left_row_ids":      reconciliationRowIds(match.LeftRows),
+		"right_row_ids":     reconciliationRowIds(match.RightRows),
+	}
+
+	datasetIds := []uuid.UUID{reconciliation.LeftDatasetId}
+	if reconciliation.RightDatasetId != reconciliation.LeftDatasetId {
+		datasetIds = append(datasetIds, reconciliation.RightDatasetId)
+	}
+
+	actionService := datasetactionservice.NewDatasetActionService(tx)
+	for _, datasetId := range datasetIds {
+		err := actionService.CreateDatasetAction(ctx, orgId, storemodels.CreateDatasetActionParams{
+			ActionId:    uuid.New().String(),
+			ActionType:  string(actionType),
+			DatasetId:   datasetId,
+			Status:      string(dataplatformactionconstants.ActionStatusSuccessful),
+			Config:      config,
+			ActionBy:    userId,
+			IsCompleted: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func reconciliationRowIds(rows []storemodels.ReconciliationRow) []string {
+	rowIds := make([]string, len(rows))
+	for i, row := range rows {
+		rowIds[i] = row.RowId
+	}
+
+	return rowIds
+}
+
+func normalizeReconciliationPage(page int, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = constants.ReconciliationDefaultPageSize
+	}
+	if pageSize > constants.ReconciliationMaxPageSize {
+		pageSize = constants.ReconciliationMaxPageSize
+	}
+
+	return page, pageSize
+}
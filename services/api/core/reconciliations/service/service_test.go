@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	datasetactionconstants "github.com/Zampfi/application-platform/services/api/core/datasets/actions/constants"
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/errors"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
+	mock_datasetservice "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
+	mock_store "github.com/Zampfi/application-platform/services/api/mocks/db/store"
+	workersconstants "github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	mock_temporal "github.com/Zampfi/workflow-sdk-go/mocks/workflowmanagers/temporal"
+	temporalmodels "github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestStartReconciliationRun(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	reconciliationId := uuid.New()
+	runId := uuid.New()
+
+	tests := []struct {
+		name           string
+		mockSetup      func(*mock_store.MockStore, *mock_temporal.MockTemporalService)
+		wantWorkflowId string
+		wantErr        error
+	}{
+		{
+			name: "Starts the run workflow",
+			mockSetup: func(ms *mock_store.MockStore, ts *mock_temporal.MockTemporalService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliationId).Return(storemodels.Reconciliation{ID: reconciliationId}, nil)
+				ms.EXPECT().GetLatestReconciliationRun(mock.Anything, reconciliationId, storemodels.ReconciliationRunStatusRunning).Return(storemodels.ReconciliationRun{}, gorm.ErrRecordNotFound)
+				ms.EXPECT().CreateReconciliationRun(mock.Anything, reconciliationId, userId).Return(storemodels.ReconciliationRun{ID: runId, ReconciliationId: reconciliationId, Status: storemodels.ReconciliationRunStatusRunning}, nil)
+				ts.EXPECT().ExecuteAsyncWorkflow(mock.Anything, mock.MatchedBy(func(params temporalmodels.ExecuteWorkflowParams) bool {
+					runParams, ok := params.Args[0].(models.ReconciliationRunWorkflowParams)
+					return ok && params.Workflow == workersconstants.ReconciliationRunWorkflowName && runParams.RunId == runId && runParams.OrganizationId == orgId
+				})).Return(temporalmodels.WorkflowResponse{}, nil)
+				ms.EXPECT().UpdateReconciliationRunWorkflowId(mock.Anything, runId, "reconciliation-run-"+runId.String()).Return(nil)
+			},
+			wantWorkflowId: "reconciliation-run-" + runId.String(),
+		},
+		{
+			name: "Rejects a second run while one is in progress",
+			mockSetup: func(ms *mock_store.MockStore, ts *mock_temporal.MockTemporalService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliationId).Return(storemodels.Reconciliation{ID: reconciliationId}, nil)
+				ms.EXPECT().GetLatestReconciliationRun(mock.Anything, reconciliationId, storemodels.ReconciliationRunStatusRunning).Return(storemodels.ReconciliationRun{ID: uuid.New()}, nil)
+			},
+			wantErr: errors.ErrReconciliationRunInProgress,
+		},
+		{
+			name: "Marks the run as failed when the workflow does not start",
+			mockSetup: func(ms *mock_store.MockStore, ts *mock_temporal.MockTemporalService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliationId).Return(storemodels.Reconciliation{ID: reconciliationId}, nil)
+				ms.EXPECT().GetLatestReconciliationRun(mock.Anything, reconciliationId, storemodels.ReconciliationRunStatusRunning).Return(storemodels.ReconciliationRun{}, gorm.ErrRecordNotFound)
+				ms.EXPECT().CreateReconciliationRun(mock.Anything, reconciliationId, userId).Return(storemodels.ReconciliationRun{ID: runId}, nil)
+				ts.EXPECT().ExecuteAsyncWorkflow(mock.Anything, mock.Anything).Return(temporalmodels.WorkflowResponse{}, assert.AnError)
+				ms.EXPECT().CompleteReconciliationRun(mock.Anything, runId, mock.MatchedBy(func(params storemodels.CompleteReconciliationRunParams) bool {
+					return params.Status == storemodels.ReconciliationRunStatusFailed
+				})).Return(nil)
+			},
+			wantErr: assert.AnError,
+		},
+		{
+			name: "Reconciliation not found",
+			mockSetup: func(ms *mock_store.MockStore, ts *mock_temporal.MockTemporalService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliationId).Return(storemodels.Reconciliation{}, gorm.ErrRecordNotFound)
+			},
+			wantErr: errors.ErrReconciliationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			mockTemporalService := mock_temporal.NewMockTemporalService(t)
+			tt.mockSetup(mockStore, mockTemporalService)
+
+			service := &reconciliationService{
+				store:           mockStore,
+				temporalService: mockTemporalService,
+			}
+
+			run, err := service.StartReconciliationRun(context.Background(), orgId, userId, reconciliationId)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantWorkflowId, run.WorkflowId)
+		})
+	}
+}
+
+func TestCreateManualMatch(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	resultDatasetId := uuid.New()
+	reconciliation := storemodels.Reconciliation{ID: uuid.New(), LeftDatasetId: uuid.New(), RightDatasetId: uuid.New(), ResultDatasetId: &resultDatasetId}
+	runId := uuid.New()
+	leftAmount := 100.0
+	rightAmounts := []float64{60, 40}
+
+	params := models.ManualMatchParams{LeftRowIds: []string{"l1"}, RightRowIds: []string{"r1", "r2"}}
+
+	openExceptions := func(ms *mock_store.MockStore) {
+		ms.EXPECT().GetReconciliationExceptions(mock.Anything, runId, mock.MatchedBy(func(filters storemodels.ReconciliationExceptionFilters) bool {
+			return filters.Sides[0] == storemodels.ReconciliationSideLeft
+		})).Return([]storemodels.ReconciliationException{{RowId: "l1", Amount: &leftAmount, Skipped: true}}, nil)
+		ms.EXPECT().GetReconciliationExceptions(mock.Anything, runId, mock.MatchedBy(func(filters storemodels.ReconciliationExceptionFilters) bool {
+			return filters.Sides[0] == storemodels.ReconciliationSideRight
+		})).Return([]storemodels.ReconciliationException{{RowId: "r1", Amount: &rightAmounts[0]}, {RowId: "r2", Amount: &rightAmounts[1]}}, nil)
+	}
+
+	tests := []struct {
+		name      string
+		params    models.ManualMatchParams
+		mockSetup func(*mock_store.MockStore, *mock_datasetservice.MockDatasetService)
+		wantErr   error
+	}{
+		{
+			name:   "Matches open exceptions, records the action on both datasets and writes the result dataset",
+			params: params,
+			mockSetup: func(ms *mock_store.MockStore, ds *mock_datasetservice.MockDatasetService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliation.ID).Return(reconciliation, nil)
+				ms.EXPECT().GetReconciliationRunById(mock.Anything, runId).Return(storemodels.ReconciliationRun{ID: runId, ReconciliationId: reconciliation.ID, Status: storemodels.ReconciliationRunStatusSuccessful}, nil)
+				openExceptions(ms)
+				ms.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+					return fn(ms)
+				})
+				ms.EXPECT().CreateReconciliationMatches(mock.Anything, runId, mock.MatchedBy(func(matches []storemodels.CreateReconciliationMatchParams) bool {
+					return len(matches) == 1 && matches[0].Status == storemodels.ReconciliationMatchStatusManuallyMatched && *matches[0].RightAmount == 100
+				})).Return(nil)
+				ms.EXPECT().ResolveReconciliationExceptions(mock.Anything, runId, storemodels.ReconciliationSideLeft, params.LeftRowIds, mock.Anything).Return(1, nil)
+				ms.EXPECT().ResolveReconciliationExceptions(mock.Anything, runId, storemodels.ReconciliationSideRight, params.RightRowIds, mock.Anything).Return(2, nil)
+				ms.EXPECT().AdjustReconciliationRunCounts(mock.Anything, runId, storemodels.ReconciliationRunCountDeltas{
+					Matches:         1,
+					RightExceptions: -2,
+					LeftSkipped:     -1,
+				}).Return(nil)
+				ms.EXPECT().CreateDatasetAction(mock.Anything, orgId, mock.MatchedBy(func(action storemodels.CreateDatasetActionParams) bool {
+					return action.ActionType == string(datasetactionconstants.ActionTypeReconciliationManualMatch) && action.ActionBy == userId
+				})).Return(nil).Times(2)
+				ds.EXPECT().ExecuteRawQuery(mock.Anything, orgId, resultDatasetId.String(), mock.MatchedBy(func(query string) bool {
+					return strings.HasPrefix(query, "DELETE FROM {{.zamp_"+resultDatasetId.String()+"}} WHERE run_id = '"+runId.String()+"' AND match_id = '")
+				}), map[string]interface{}(nil)).Return(datasetmodels.DatasetData{}, nil)
+				ds.EXPECT().ExecuteRawQuery(mock.Anything, orgId, resultDatasetId.String(), mock.MatchedBy(func(query string) bool {
+					return strings.HasPrefix(query, "INSERT INTO {{.zamp_"+resultDatasetId.String()+"}}") && strings.Count(query, "'MANUALLY_MATCHED'") == 3
+				}), mock.Anything).Return(datasetmodels.DatasetData{}, nil)
+			},
+		},
+		{
+			name:   "Rejects rows that are no longer open",
+			params: params,
+			mockSetup: func(ms *mock_store.MockStore, ds *mock_datasetservice.MockDatasetService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliation.ID).Return(reconciliation, nil)
+				ms.EXPECT().GetReconciliationRunById(mock.Anything, runId).Return(storemodels.ReconciliationRun{ID: runId, ReconciliationId: reconciliation.ID, Status: storemodels.ReconciliationRunStatusSuccessful}, nil)
+				ms.EXPECT().GetReconciliationExceptions(mock.Anything, runId, mock.Anything).Return([]storemodels.ReconciliationException{}, nil)
+			},
+			wantErr: errors.ErrInvalidManualMatch,
+		},
+		{
+			name:   "Rejects a match racing another one",
+			params: params,
+			mockSetup: func(ms *mock_store.MockStore, ds *mock_datasetservice.MockDatasetService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliation.ID).Return(reconciliation, nil)
+				ms.EXPECT().GetReconciliationRunById(mock.Anything, runId).Return(storemodels.ReconciliationRun{ID: runId, ReconciliationId: reconciliation.ID, Status: storemodels.ReconciliationRunStatusSuccessful}, nil)
+				openExceptions(ms)
+				ms.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+					return fn(ms)
+				})
+				ms.EXPECT().CreateReconciliationMatches(mock.Anything, runId, mock.Anything).Return(nil)
+				ms.EXPECT().ResolveReconciliationExceptions(mock.Anything, runId, storemodels.ReconciliationSideLeft, params.LeftRowIds, mock.Anything).Return(0, nil)
+			},
+			wantErr: errors.ErrInvalidManualMatch,
+		},
+		{
+			name:   "Rejects runs that did not complete",
+			params: params,
+			mockSetup: func(ms *mock_store.MockStore, ds *mock_datasetservice.MockDatasetService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliation.ID).Return(reconciliation, nil)
+				ms.EXPECT().GetReconciliationRunById(mock.Anything, runId).Return(storemodels.ReconciliationRun{ID: runId, ReconciliationId: reconciliation.ID, Status: storemodels.ReconciliationRunStatusRunning}, nil)
+			},
+			wantErr: errors.ErrReconciliationRunNotCompleted,
+		},
+		{
+			name:      "Rejects a match without rows on one side",
+			params:    models.ManualMatchParams{LeftRowIds: []string{"l1"}},
+			mockSetup: func(ms *mock_store.MockStore, ds *mock_datasetservice.MockDatasetService) {},
+			wantErr:   errors.ErrInvalidManualMatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			mockDatasetService := mock_datasetservice.NewMockDatasetService(t)
+			tt.mockSetup(mockStore, mockDatasetService)
+
+			service := &reconciliationService{store: mockStore, datasetService: mockDatasetService}
+
+			match, err := service.CreateManualMatch(context.Background(), orgId, userId, reconciliation.ID, runId, tt.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, storemodels.ReconciliationMatchStatusManuallyMatched, match.Status)
+			assert.Equal(t, []string{"r1", "r2"}, reconciliationRowIds(match.RightRows))
+			assert.Equal(t, 100.0, *match.LeftAmount)
+		})
+	}
+}
+
+func TestUnmatchReconciliationMatch(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	resultDatasetId := uuid.New()
+	reconciliation := storemodels.Reconciliation{ID: uuid.New(), LeftDatasetId: uuid.New(), RightDatasetId: uuid.New(), ResultDatasetId: &resultDatasetId}
+	runId := uuid.New()
+	matchId := uuid.New()
+	successfulRun := storemodels.ReconciliationRun{ID: runId, ReconciliationId: reconciliation.ID, Status: storemodels.ReconciliationRunStatusSuccessful}
+
+	match := storemodels.ReconciliationMatch{
+		MatchId:   matchId,
+		Status:    storemodels.ReconciliationMatchStatusMatched,
+		LeftRows:  []byte(`[{"row_id":"l1"}]`),
+		RightRows: []byte(`[{"row_id":"r1"},{"row_id":"r2"}]`),
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mock_store.MockStore, *mock_datasetservice.MockDatasetService)
+		wantErr   error
+	}{
+		{
+			name: "Reopens the exceptions of the match",
+			mockSetup: func(ms *mock_store.MockStore, ds *mock_datasetservice.MockDatasetService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliation.ID).Return(reconciliation, nil)
+				ms.EXPECT().GetReconciliationRunById(mock.Anything, runId).Return(successfulRun, nil)
+				ms.EXPECT().GetReconciliationMatch(mock.Anything, runId, matchId).Return(match, nil)
+				ms.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+					return fn(ms)
+				})
+				ms.EXPECT().UpdateReconciliationMatchStatus(mock.Anything, runId, matchId, storemodels.ReconciliationMatchStatusUnmatched, userId).Return(nil)
+				ms.EXPECT().ReopenReconciliationExceptions(mock.Anything, runId, storemodels.ReconciliationSideLeft, mock.Anything).Return(nil)
+				ms.EXPECT().ReopenReconciliationExceptions(mock.Anything, runId, storemodels.ReconciliationSideRight, mock.Anything).Return(nil)
+				ms.EXPECT().AdjustReconciliationRunCounts(mock.Anything, runId, storemodels.ReconciliationRunCountDeltas{
+					Matches:         -1,
+					LeftExceptions:  1,
+					RightExceptions: 2,
+				}).Return(nil)
+				ms.EXPECT().CreateDatasetAction(mock.Anything, orgId, mock.MatchedBy(func(action storemodels.CreateDatasetActionParams) bool {
+					return action.ActionType == string(datasetactionconstants.ActionTypeReconciliationManualUnmatch)
+				})).Return(nil).Times(2)
+				ds.EXPECT().ExecuteRawQuery(mock.Anything, orgId, resultDatasetId.String(), "DELETE FROM {{.zamp_"+resultDatasetId.String()+"}} WHERE run_id = '"+runId.String()+"' AND match_id = '"+matchId.String()+"'", map[string]interface{}(nil)).Return(datasetmodels.DatasetData{}, nil)
+				ds.EXPECT().ExecuteRawQuery(mock.Anything, orgId, resultDatasetId.String(), mock.MatchedBy(func(query string) bool {
+					return strings.Count(query, "'UNMATCHED'") == 3
+				}), mock.Anything).Return(datasetmodels.DatasetData{}, nil)
+			},
+		},
+		{
+			name: "Rejects a match that is already unmatched",
+			mockSetup: func(ms *mock_store.MockStore, ds *mock_datasetservice.MockDatasetService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliation.ID).Return(reconciliation, nil)
+				ms.EXPECT().GetReconciliationRunById(mock.Anything, runId).Return(successfulRun, nil)
+				ms.EXPECT().GetReconciliationMatch(mock.Anything, runId, matchId).Return(storemodels.ReconciliationMatch{MatchId: matchId, Status: storemodels.ReconciliationMatchStatusUnmatched}, nil)
+			},
+			wantErr: errors.ErrReconciliationMatchUnmatched,
+		},
+		{
+			name: "Match not found",
+			mockSetup: func(ms *mock_store.MockStore, ds *mock_datasetservice.MockDatasetService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliation.ID).Return(reconciliation, nil)
+				ms.EXPECT().GetReconciliationRunById(mock.Anything, runId).Return(successfulRun, nil)
+				ms.EXPECT().GetReconciliationMatch(mock.Anything, runId, matchId).Return(storemodels.ReconciliationMatch{}, gorm.ErrRecordNotFound)
+			},
+			wantErr: errors.ErrReconciliationMatchNotFound,
+		},
+		{
+			name: "Run of another reconciliation",
+			mockSetup: func(ms *mock_store.MockStore, ds *mock_datasetservice.MockDatasetService) {
+				ms.EXPECT().GetReconciliationById(mock.Anything, reconciliation.ID).Return(reconciliation, nil)
+				ms.EXPECT().GetReconciliationRunById(mock.Anything, runId).Return(storemodels.ReconciliationRun{ID: runId, ReconciliationId: uuid.New()}, nil)
+			},
+			wantErr: errors.ErrReconciliationRunNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			mockDatasetService := mock_datasetservice.NewMockDatasetService(t)
+			tt.mockSetup(mockStore, mockDatasetService)
+
+			service := &reconciliationService{store: mockStore, datasetService: mockDatasetService}
+
+			err := service.UnmatchReconciliationMatch(context.Background(), orgId, userId, reconciliation.ID, runId, matchId)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRunReconciliationActivitySkipsCompletedRuns(t *testing.T) {
+	params := models.ReconciliationRunWorkflowParams{
+		ReconciliationId: uuid.New(),
+		RunId:            uuid.New(),
+		OrganizationId:   uuid.New(),
+		UserId:           uuid.New(),
+	}
+
+	mockStore := mock_store.NewMockStore(t)
+	mockStore.EXPECT().GetReconciliationRunById(mock.Anything, params.RunId).Return(storemodels.ReconciliationRun{
+		ID:                 params.RunId,
+		Status:             storemodels.ReconciliationRunStatusSuccessful,
+		MatchCount:         7,
+		LeftExceptionCount: 2,
+		LeftSkippedCount:   1,
+	}, nil)
+
+	service := &reconciliationService{store: mockStore}
+
+	result, err := service.RunReconciliationActivity(context.Background(), params)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReconciliationRunResult{
+		Status:             storemodels.ReconciliationRunStatusSuccessful,
+		MatchCount:         7,
+		LeftExceptionCount: 2,
+		LeftSkippedCount:   1,
+	}, result)
+}
+
+func TestFailReconciliationRunActivity(t *testing.T) {
+	params := models.ReconciliationRunWorkflowParams{RunId: uuid.New(), OrganizationId: uuid.New(), UserId: uuid.New()}
+
+	mockStore := mock_store.NewMockStore(t)
+	mockStore.EXPECT().GetReconciliationRunById(mock.Anything, params.RunId).Return(storemodels.ReconciliationRun{ID: params.RunId, Status: storemodels.ReconciliationRunStatusRunning}, nil)
+	mockStore.EXPECT().CompleteReconciliationRun(mock.Anything, params.RunId, storemodels.CompleteReconciliationRunParams{
+		Status: storemodels.ReconciliationRunStatusFailed,
+		Error:  "too many rows",
+	}).Return(nil)
+
+	service := &reconciliationService{store: mockStore}
+
+	assert.NoError(t, service.FailReconciliationRunActivity(context.Background(), params, "too many rows"))
+}
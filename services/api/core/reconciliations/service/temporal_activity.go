@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// RunReconciliationActivity loads both sides with the access of the user who started the run, matches them, writes
+// the matches to the result dataset and stores the matches, the exceptions and the counts of the run in one
+// transaction. A retried attempt of a run that already completed returns its recorded counts instead of matching again.
+func (s *reconciliationService) RunReconciliationActivity(ctx context.Context, params models.ReconciliationRunWorkflowParams) (models.ReconciliationRunResult, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	ctx = apicontext.AddAuthToContext(ctx, "user", params.UserId, []uuid.UUID{params.OrganizationId})
+
+	run, err := s.store.GetReconciliationRunById(ctx, params.RunId)
+	if err != nil {
+		return models.ReconciliationRunResult{}, fmt.Errorf("failed to get reconciliation run: %w", err)
+	}
+	if run.Status != storemodels.ReconciliationRunStatusRunning {
+		logger.Info("reconciliation run already completed", zap.String("run_id", run.ID.String()), zap.String("status", run.Status))
+		return runResult(run), nil
+	}
+
+	storeReconciliation, err := s.store.GetReconciliationById(ctx, params.ReconciliationId)
+	if err != nil {
+		return models.ReconciliationRunResult{}, fmt.Errorf("failed to get reconciliation: %w", err)
+	}
+
+	reconciliation := models.Reconciliation{}
+	if err := reconciliation.FromSchema(storeReconciliation); err != nil {
+		return models.ReconciliationRunResult{}, fmt.Errorf("failed to parse reconciliation: %w", err)
+	}
+
+	previous, err := s.getPreviousManualDecisions(ctx, reconciliation.ID)
+	if err != nil {
+		return models.ReconciliationRunResult{}, fmt.Errorf("failed to get previous manual matches: %w", err)
+	}
+
+	left, err := s.loadReconciliationRows(ctx, params.OrganizationId, reconciliation.LeftDatasetId, reconciliation.Left, reconciliationKeyColumns(reconciliation.Rules, true))
+	if err != nil {
+		return models.ReconciliationRunResult{}, fmt.Errorf("failed to load left dataset: %w", err)
+	}
+
+	right, err := s.loadReconciliationRows(ctx, params.OrganizationId, reconciliation.RightDatasetId, reconciliation.Right, reconciliationKeyColumns(reconciliation.Rules, false))
+	if err != nil {
+		return models.ReconciliationRunResult{}, fmt.Errorf("failed to load right dataset: %w", err)
+	}
+
+	outcome := matchRows(reconciliation.Rules, left, right, previous)
+
+	result := models.ReconciliationRunResult{
+		Status:              storemodels.ReconciliationRunStatusSuccessful,
+		LeftRowCount:        len(left),
+		RightRowCount:       len(right),
+		MatchCount:          outcome.matchCount(),
+		LeftExceptionCount:  len(outcome.leftExceptions),
+		RightExceptionCount: len(outcome.rightExceptions),
+		LeftSkippedCount:    len(outcome.leftSkipped),
+		RightSkippedCount:   len(outcome.rightSkipped),
+	}
+
+	if err := s.writeResultRows(ctx, params.OrganizationId, storeReconciliation, run.ID, nil, outcome.matches); err != nil {
+		return models.ReconciliationRunResult{}, fmt.Errorf("failed to write reconciliation results: %w", err)
+	}
+
+	err = s.store.WithReconciliationTransaction(ctx, func(rs store.ReconciliationStore) error {
+		if err := rs.CreateReconciliationMatches(ctx, run.ID, outcome.matches); err != nil {
+			return err
+		}
+		if err := rs.CreateReconciliationExceptions(ctx, run.ID, storemodels.ReconciliationSideLeft, outcome.leftExceptions, false); err != nil {
+			return err
+		}
+		if err := rs.CreateReconciliationExceptions(ctx, run.ID, storemodels.ReconciliationSideRight, outcome.rightExceptions, false); err != nil {
+			return err
+		}
+		if err := rs.CreateReconciliationExceptions(ctx, run.ID, storemodels.ReconciliationSideLeft, outcome.leftSkipped, true); err != nil {
+			return err
+		}
+		if err := rs.CreateReconciliationExceptions(ctx, run.ID, storemodels.ReconciliationSideRight, outcome.rightSkipped, true); err != nil {
+			return err
+		}
+
+		return rs.CompleteReconciliationRun(ctx, run.ID, storemodels.CompleteReconciliationRunParams{
+			Status:              result.Status,
+			LeftRowCount:        result.LeftRowCount,
+			RightRowCount:       result.RightRowCount,
+			MatchCount:          result.MatchCount,
+			LeftExceptionCount:  result.LeftExceptionCount,
+			RightExceptionCount: result.RightExceptionCount,
+			LeftSkippedCount:    result.LeftSkippedCount,
+			RightSkippedCount:   result.RightSkippedCount,
+		})
+	})
+	if err != nil {
+		return models.ReconciliationRunResult{}, fmt.Errorf("failed to save reconciliation results: %w", err)
+	}
+
+	logger.Info("reconciliation run completed", zap.String("run_id", run.ID.String()), zap.Int("matches", result.MatchCount), zap.Int("left_exceptions", result.LeftExceptionCount), zap.Int("right_exceptions", result.RightExceptionCount), zap.Int("left_skipped", result.LeftSkippedCount), zap.Int("right_skipped", result.RightSkippedCount))
+
+	return result, nil
+}
+
+// FailReconciliationRunActivity records why a run failed, runs that completed in the meantime are left as they are
+func (s *reconciliationService) FailReconciliationRunActivity(ctx context.Context, params models.ReconciliationRunWorkflowParams, message string) error {
+	ctx = apicontext.AddAuthToContext(ctx, "user", params.UserId, []uuid.UUID{params.OrganizationId})
+
+	run, err := s.store.GetReconciliationRunById(ctx, params.RunId)
+	if err != nil {
+		return fmt.Errorf("failed to get reconciliation run: %w", err)
+	}
+	if run.Status != storemodels.ReconciliationRunStatusRunning {
+		return nil
+	}
+
+	return s.store.CompleteReconciliationRun(ctx, run.ID, storemodels.CompleteReconciliationRunParams{
+		Status: storemodels.ReconciliationRunStatusFailed,
+		Error:  message,
+	})
+}
+
+// getPreviousManualDecisions returns the manual matches and unmatches of the latest successful run
+func (s *reconciliationService) getPreviousManualDecisions(ctx context.Context, reconciliationId uuid.UUID) ([]models.ReconciliationMatch, error) {
+	previousRun, err := s.store.GetLatestReconciliationRun(ctx, reconciliationId, storemodels.ReconciliationRunStatusSuccessful)
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	storeMatches, err := s.store.GetReconciliationMatches(ctx, previousRun.ID, storemodels.ReconciliationMatchFilters{
+		Statuses: []string{storemodels.ReconciliationMatchStatusManuallyMatched, storemodels.ReconciliationMatchStatusUnmatched},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]models.ReconciliationMatch, len(storeMatches))
+	for i, storeMatch := range storeMatches {
+		if err := matches[i].FromSchema(storeMatch); err != nil {
+			return nil, err
+		}
+	}
+
+	return matches, nil
+}
+
+func runResult(run storemodels.ReconciliationRun) models.ReconciliationRunResult {
+	return models.ReconciliationRunResult{
+		Status:              run.Status,
+		LeftRowCount:        run.LeftRowCount,
+		RightRowCount:       run.RightRowCount,
+		MatchCount:          run.MatchCount,
+		LeftExceptionCount:  run.LeftExceptionCount,
+		RightExceptionCount: run.RightExceptionCount,
+		LeftSkippedCount:    run.LeftSkippedCount,
+		RightSkippedCount:   run.RightSkippedCount,
+	}
+}
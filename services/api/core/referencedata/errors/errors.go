@@ -0,0 +1,23 @@
+package errors
+
+import "errors"
+
+const (
+	ErrInvalidReferenceDataKindMessage = "ERR_INVALID_REFERENCE_DATA_KIND"
+	ErrReferenceDataNotFoundMessage    = "ERR_REFERENCE_DATA_NOT_FOUND"
+	ErrInvalidBankCodeMessage          = "ERR_INVALID_BANK_CODE"
+	ErrEmptyBankNameMessage            = "ERR_EMPTY_BANK_NAME"
+	ErrInvalidBankCountryMessage       = "ERR_INVALID_BANK_COUNTRY"
+	ErrBankCodeExistsMessage           = "ERR_BANK_CODE_EXISTS"
+	ErrBankNotFoundMessage             = "ERR_BANK_NOT_FOUND"
+)
+
+var (
+	ErrInvalidReferenceDataKind = errors.New(ErrInvalidReferenceDataKindMessage)
+	ErrReferenceDataNotFound    = errors.New(ErrReferenceDataNotFoundMessage)
+	ErrInvalidBankCode          = errors.New(ErrInvalidBankCodeMessage)
+	ErrEmptyBankName            = errors.New(ErrEmptyBankNameMessage)
+	ErrInvalidBankCountry       = errors.New(ErrInvalidBankCountryMessage)
+	ErrBankCodeExists           = errors.New(ErrBankCodeExistsMessage)
+	ErrBankNotFound             = errors.New(ErrBankNotFoundMessage)
+)
@@ -0,0 +1,68 @@
+package models
+
+import (
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
+	"github.com/google/uuid"
+)
+
+// Bank is a bank of the directory of an organization, ID is only set for the banks the organization added
+type Bank struct {
+	ID      *uuid.UUID
+	Code    string
+	Name    string
+	Country string
+	BIC     string
+}
+
+func (b *Bank) FromSchema(schema dbmodels.ReferenceBank) {
+	id := schema.ID
+	b.ID = &id
+	b.Code = schema.Code
+	b.Name = schema.Name
+	b.Country = stringValue(schema.Country)
+	b.BIC = stringValue(schema.BIC)
+}
+
+func (b *Bank) FromReferenceData(bank referencedata.Bank) {
+	b.Code = bank.Code
+	b.Name = bank.Name
+	b.Country = bank.Country
+	b.BIC = bank.BIC
+}
+
+type BankParams struct {
+	Code    string
+	Name    string
+	Country string
+	BIC     string
+}
+
+// LookupResult is the stored form and display name of a value typed by a user, Code is the form datasets hold
+type LookupResult struct {
+	Kind  referencedata.Kind
+	Value string
+	Code  string
+	Name  string
+}
+
+// ToDirectoryBanks converts the banks an organization added into entries of its bank directory
+func ToDirectoryBanks(schemas []dbmodels.ReferenceBank) []referencedata.Bank {
+	banks := make([]referencedata.Bank, len(schemas))
+	for i, schema := range schemas {
+		banks[i] = referencedata.Bank{
+			Code:    schema.Code,
+			Name:    schema.Name,
+			Country: stringValue(schema.Country),
+			BIC:     stringValue(schema.BIC),
+		}
+	}
+	return banks
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	goerrors "errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Zampfi/application-platform/services/api/core/referencedata/errors"
+	"github.com/Zampfi/application-platform/services/api/core/referencedata/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var bankCodePattern = regexp.MustCompile(`^[A-Z0-9]{2,20}$`)
+
+type ReferenceDataServiceStore interface {
+	store.ReferenceBankStore
+}
+
+type ReferenceDataService interface {
+	GetCountries() []referencedata.Country
+	GetCurrencies() []referencedata.Currency
+	GetBanks(ctx context.Context) ([]models.Bank, error)
+	CreateBank(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.BankParams) (models.Bank, error)
+	DeleteBank(ctx context.Context, userId uuid.UUID, bankId uuid.UUID) error
+	Lookup(ctx context.Context, kind referencedata.Kind, value string) (models.LookupResult, error)
+}
+
+type referenceDataService struct {
+	store ReferenceDataServiceStore
+}
+
+func NewReferenceDataService(appStore store.Store) *referenceDataService {
+	return &referenceDataService{store: appStore}
+}
+
+func (s *referenceDataService) GetCountries() []referencedata.Country {
+	return referencedata.Countries()
+}
+
+func (s *referenceDataService) GetCurrencies() []referencedata.Currency {
+	return referencedata.Currencies()
+}
+
+// GetBanks returns the shared banks and the banks the organization added, ordered by code
+func (s *referenceDataService) GetBanks(ctx context.Context) ([]models.Bank, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeBanks, err := s.store.GetReferenceBanks(ctx)
+	if err != nil {
+		logger.Error("failed to get reference banks", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	sharedBanks := referencedata.Banks()
+	banks := make([]models.Bank, 0, len(sharedBanks)+len(storeBanks))
+	for _, sharedBank := range sharedBanks {
+		bank := models.Bank{}
+		bank.FromReferenceData(sharedBank)
+		banks = append(banks, bank)
+	}
+	for _, storeBank := range storeBanks {
+		bank := models.Bank{}
+		bank.FromSchema(storeBank)
+		banks = append(banks, bank)
+	}
+
+	sort.Slice(banks, func(i, j int) bool {
+		return banks[i].Code < banks[j].Code
+	})
+
+	return banks, nil
+}
+
+// CreateBank adds a bank to the directory of the organization. The country may be given as an alpha-2 or alpha-3 code
+// and is stored as alpha-3 like the country columns of datasets.
+func (s *referenceDataService) CreateBank(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.BankParams) (models.Bank, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	code := referencedata.NormalizeBankCode(params.Code)
+	if !bankCodePattern.MatchString(code) {
+		return models.Bank{}, errors.ErrInvalidBankCode
+	}
+
+	name := strings.TrimSpace(params.Name)
+	if name == "" {
+		return models.Bank{}, errors.ErrEmptyBankName
+	}
+
+	createParams := storemodels.CreateReferenceBankParams{
+		OrganizationId: orgId,
+		Code:           code,
+		Name:           name,
+		CreatedBy:      userId,
+	}
+
+	if strings.TrimSpace(params.Country) != "" {
+		country, ok := referencedata.LookupCountry(params.Country)
+		if !ok {
+			return models.Bank{}, errors.ErrInvalidBankCountry
+		}
+		createParams.Country = &country.Alpha3
+	}
+
+	if strings.TrimSpace(params.BIC) != "" {
+		bic, err := referencedata.ParseBIC(params.BIC)
+		if err != nil {
+			return models.Bank{}, err
+		}
+		createParams.BIC = &bic.Value
+	}
+
+	if referencedata.IsBuiltInBank(code) {
+		return models.Bank{}, errors.ErrBankCodeExists
+	}
+
+	storeBanks, err := s.store.GetReferenceBanks(ctx)
+	if err != nil {
+		logger.Error("failed to get reference banks", zap.String("error", err.Error()))
+		return models.Bank{}, err
+	}
+	for _, storeBank := range storeBanks {
+		if storeBank.Code == code {
+			return models.Bank{}, errors.ErrBankCodeExists
+		}
+	}
+
+	storeBank, err := s.store.CreateReferenceBank(ctx, createParams)
+	if err != nil {
+		logger.Error("failed to create reference bank", zap.String("code", code), zap.String("error", err.Error()))
+		return models.Bank{}, err
+	}
+
+	bank := models.Bank{}
+	bank.FromSchema(storeBank)
+	return bank, nil
+}
+
+func (s *referenceDataService) DeleteBank(ctx context.Context, userId uuid.UUID, bankId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.store.DeleteReferenceBank(ctx, bankId, userId); err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.ErrBankNotFound
+		}
+		logger.Error("failed to delete reference bank", zap.String("bankId", bankId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// Lookup resolves a value typed by a user, in any case and for countries as an alpha-2 or alpha-3 code, to the code
+// datasets store and its display name
+func (s *referenceDataService) Lookup(ctx context.Context, kind referencedata.Kind, value string) (models.LookupResult, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	result := models.LookupResult{Kind: kind, Value: value}
+	switch kind {
+	case referencedata.KindCountry:
+		country, ok := referencedata.LookupCountry(value)
+		if !ok {
+			return models.LookupResult{}, errors.ErrReferenceDataNotFound
+		}
+		result.Code = country.Alpha3
+		result.Name = country.Name
+	case referencedata.KindCurrency:
+		currency, ok := referencedata.LookupCurrency(value)
+		if !ok {
+			return models.LookupResult{}, errors.ErrReferenceDataNotFound
+		}
+		result.Code = currency.Code
+		result.Name = currency.Name
+	case referencedata.KindBank:
+		storeBanks, err := s.store.GetReferenceBanks(ctx)
+		if err != nil {
+			logger.Error("failed to get reference banks", zap.String("error", err.Error()))
+			return models.LookupResult{}, err
+		}
+		bank, ok := referencedata.NewDirectory(models.ToDirectoryBanks(storeBanks)).Bank(referencedata.NormalizeBankCode(value))
+		if !ok {
+			return models.LookupResult{}, errors.ErrReferenceDataNotFound
+		}
+		result.Code = bank.Code
+		result.Name = bank.Name
+	default:
+		return models.LookupResult{}, errors.ErrInvalidReferenceDataKind
+	}
+
+	return result, nil
+}
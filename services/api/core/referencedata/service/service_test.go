@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Zampfi/application-platform/services/api/core/referencedata/errors"
+	"github.com/Zampfi/application-platform/services/api/core/referencedata/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mock_store "github.com/Zampfi/application-platform/services/api/mocks/db/store"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestCreateBank(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+
+	tests := []struct {
+		name      string
+		params    models.BankParams
+		mockSetup func(*mock_store.MockStore)
+		want      models.Bank
+		wantErr   error
+	}{
+		{
+			name:   "Creates a bank with normalized code, country and bic",
+			params: models.BankParams{Code: " acme ", Name: " Acme Bank ", Country: "us", BIC: "acmeus33"},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetReferenceBanks(mock.Anything).Return(nil, nil)
+				ms.EXPECT().CreateReferenceBank(mock.Anything, mock.MatchedBy(func(params storemodels.CreateReferenceBankParams) bool {
+					return params.Code == "ACME" && params.Name == "Acme Bank" && *params.Country == "USA" && *params.BIC == "ACMEUS33" &&
+						params.OrganizationId == orgId && params.CreatedBy == userId
+				})).RunAndReturn(func(_ context.Context, params storemodels.CreateReferenceBankParams) (storemodels.ReferenceBank, error) {
+					return storemodels.ReferenceBank{Code: params.Code, Name: params.Name, Country: params.Country, BIC: params.BIC}, nil
+				})
+			},
+			want: models.Bank{Code: "ACME", Name: "Acme Bank", Country: "USA", BIC: "ACMEUS33"},
+		},
+		{
+			name:      "Rejects an invalid code",
+			params:    models.BankParams{Code: "A-B", Name: "Acme Bank"},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrInvalidBankCode,
+		},
+		{
+			name:      "Rejects an empty name",
+			params:    models.BankParams{Code: "ACME", Name: " "},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrEmptyBankName,
+		},
+		{
+			name:      "Rejects an unknown country",
+			params:    models.BankParams{Code: "ACME", Name: "Acme Bank", Country: "XYZ"},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrInvalidBankCountry,
+		},
+		{
+			name:      "Rejects an invalid bic",
+			params:    models.BankParams{Code: "ACME", Name: "Acme Bank", BIC: "ACME"},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   referencedata.ErrInvalidBICFormat,
+		},
+		{
+			name:      "Rejects the code of a shared bank",
+			params:    models.BankParams{Code: "crb", Name: "Cross River"},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrBankCodeExists,
+		},
+		{
+			name:   "Rejects the code of a bank of the organization",
+			params: models.BankParams{Code: "ACME", Name: "Acme Bank"},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetReferenceBanks(mock.Anything).Return([]storemodels.ReferenceBank{{Code: "ACME"}}, nil)
+			},
+			wantErr: errors.ErrBankCodeExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			tt.mockSetup(mockStore)
+
+			s := &referenceDataService{store: mockStore}
+			bank, err := s.CreateBank(context.Background(), orgId, userId, tt.params)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			bank.ID = nil
+			assert.Equal(t, tt.want, bank)
+		})
+	}
+}
+
+func TestGetBanks(t *testing.T) {
+	mockStore := mock_store.NewMockStore(t)
+	bankId := uuid.New()
+	mockStore.EXPECT().GetReferenceBanks(mock.Anything).Return([]storemodels.ReferenceBank{{ID: bankId, Code: "ACME", Name: "Acme Bank"}}, nil)
+
+	s := &referenceDataService{store: mockStore}
+	banks, err := s.GetBanks(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, banks, len(referencedata.Banks())+1)
+	assert.Equal(t, "ACME", banks[0].Code)
+	assert.Equal(t, &bankId, banks[0].ID)
+	assert.Nil(t, banks[1].ID)
+}
+
+func TestDeleteBank(t *testing.T) {
+	mockStore := mock_store.NewMockStore(t)
+	bankId := uuid.New()
+	userId := uuid.New()
+	mockStore.EXPECT().DeleteReferenceBank(mock.Anything, bankId, userId).Return(gorm.ErrRecordNotFound)
+
+	s := &referenceDataService{store: mockStore}
+
+	assert.ErrorIs(t, s.DeleteBank(context.Background(), userId, bankId), errors.ErrBankNotFound)
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      referencedata.Kind
+		value     string
+		mockSetup func(*mock_store.MockStore)
+		want      models.LookupResult
+		wantErr   error
+	}{
+		{
+			name:      "Country by alpha-2 code",
+			kind:      referencedata.KindCountry,
+			value:     "in",
+			mockSetup: func(ms *mock_store.MockStore) {},
+			want:      models.LookupResult{Kind: referencedata.KindCountry, Value: "in", Code: "IND", Name: "India"},
+		},
+		{
+			name:      "Currency",
+			kind:      referencedata.KindCurrency,
+			value:     "eur",
+			mockSetup: func(ms *mock_store.MockStore) {},
+			want:      models.LookupResult{Kind: referencedata.KindCurrency, Value: "eur", Code: "EUR", Name: "Euro"},
+		},
+		{
+			name:  "Bank of the organization",
+			kind:  referencedata.KindBank,
+			value: "acme",
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetReferenceBanks(mock.Anything).Return([]storemodels.ReferenceBank{{Code: "ACME", Name: "Acme Bank"}}, nil)
+			},
+			want: models.LookupResult{Kind: referencedata.KindBank, Value: "acme", Code: "ACME", Name: "Acme Bank"},
+		},
+		{
+			name:      "Unknown country",
+			kind:      referencedata.KindCountry,
+			value:     "XYZ",
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrReferenceDataNotFound,
+		},
+		{
+			name:      "Unknown kind",
+			kind:      referencedata.Kind("amount"),
+			value:     "10",
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   errors.ErrInvalidReferenceDataKind,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			tt.mockSetup(mockStore)
+
+			s := &referenceDataService{store: mockStore}
+			result, err := s.Lookup(context.Background(), tt.kind, tt.value)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
@@ -10,21 +10,23 @@ import (
 )
 
 type Rule struct {
-	ID             uuid.UUID    `json:"rule_id"`
-	OrganizationId uuid.UUID    `json:"organization_id"`
-	DatasetId      uuid.UUID    `json:"dataset_id"`
-	Column         string       `json:"column"`
-	Value          string       `json:"value"`
-	FilterConfig   FilterConfig `json:"filter_config"`
-	Title          string       `json:"title"`
-	Description    string       `json:"description"`
-	Priority       int          `json:"priority"`
-	CreatedAt      time.Time    `json:"created_at"`
-	CreatedBy      uuid.UUID    `json:"created_by"`
-	UpdatedAt      time.Time    `json:"updated_at"`
-	UpdatedBy      uuid.UUID    `json:"updated_by"`
-	DeletedAt      *time.Time   `json:"deleted_at"`
-	DeletedBy      *uuid.UUID   `json:"deleted_by"`
+	ID             uuid.UUID        `json:"rule_id"`
+	OrganizationId uuid.UUID        `json:"organization_id"`
+	DatasetId      uuid.UUID        `json:"dataset_id"`
+	Column         string           `json:"column"`
+	Value          string           `json:"value"`
+	FilterConfig   FilterConfig     `json:"filter_config"`
+	Assignments    []RuleAssignment `json:"assignments"`
+	Title          string           `json:"title"`
+	Description    string           `json:"description"`
+	Priority       int              `json:"priority"`
+	IsEnabled      bool             `json:"is_enabled"`
+	CreatedAt      time.Time        `json:"created_at"`
+	CreatedBy      uuid.UUID        `json:"created_by"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+	UpdatedBy      uuid.UUID        `json:"updated_by"`
+	DeletedAt      *time.Time       `json:"deleted_at"`
+	DeletedBy      *uuid.UUID       `json:"deleted_by"`
 }
 
 func (r *Rule) FromSchema(schema *dbmodels.Rule) error {
@@ -35,15 +37,22 @@ func (r *Rule) FromSchema(schema *dbmodels.Rule) error {
 		return err
 	}
 
+	assignments, err := unmarshalRuleAssignments(schema.Assignments)
+	if err != nil {
+		return err
+	}
+
 	r.ID = schema.ID
 	r.OrganizationId = schema.OrganizationId
 	r.DatasetId = schema.DatasetId
 	r.Column = schema.Column
 	r.Value = schema.Value
 	r.FilterConfig = filterConfig
+	r.Assignments = assignments
 	r.Title = schema.Title
 	r.Description = schema.Description
 	r.Priority = schema.Priority
+	r.IsEnabled = schema.IsEnabled
 	r.CreatedAt = schema.CreatedAt
 	r.CreatedBy = schema.CreatedBy
 	r.UpdatedAt = schema.UpdatedAt
@@ -54,8 +63,158 @@ func (r *Rule) FromSchema(schema *dbmodels.Rule) error {
 	return nil
 }
 
+// GetAssignments returns the columns the rule writes and where their values come from, a rule without assignments
+// writes its value to its column
+func (r Rule) GetAssignments() []RuleAssignment {
+	if len(r.Assignments) > 0 {
+		return r.Assignments
+	}
+
+	return []RuleAssignment{{Column: r.Column, ValueType: RuleAssignmentValueTypeLiteral, Value: r.Value}}
+}
+
+// GetAssignment returns the assignment of the rule writing the column
+func (r Rule) GetAssignment(column string) (RuleAssignment, bool) {
+	for _, assignment := range r.GetAssignments() {
+		if assignment.Column == column {
+			return assignment, true
+		}
+	}
+
+	return RuleAssignment{}, false
+}
+
+// GetColumns returns the columns the rule writes, its own column first
+func (r Rule) GetColumns() []string {
+	assignments := r.GetAssignments()
+	columns := make([]string, len(assignments))
+	for i, assignment := range assignments {
+		columns[i] = assignment.Column
+	}
+
+	return columns
+}
+
+type RuleAssignmentValueType string
+
+const (
+	RuleAssignmentValueTypeLiteral RuleAssignmentValueType = "literal"
+	RuleAssignmentValueTypeColumn  RuleAssignmentValueType = "column"
+	RuleAssignmentValueTypeLookup  RuleAssignmentValueType = "lookup"
+)
+
+// RuleAssignment is a column a rule writes. The value is Value for a literal, the value the row holds in SourceColumn
+// for a copy, or the value Lookup finds in a reference dataset.
+type RuleAssignment struct {
+	Column       string                  `json:"column" yaml:"column"`
+	ValueType    RuleAssignmentValueType `json:"value_type" yaml:"value_type"`
+	Value        string                  `json:"value,omitempty" yaml:"value,omitempty"`
+	SourceColumn string                  `json:"source_column,omitempty" yaml:"source_column,omitempty"`
+	Lookup       *RuleLookup             `json:"lookup,omitempty" yaml:"lookup,omitempty"`
+}
+
+// RuleLookup reads ValueColumn from the row of the reference dataset whose LookupColumn equals the JoinColumn of the
+// row the rule matches
+type RuleLookup struct {
+	DatasetId    uuid.UUID `json:"dataset_id" yaml:"dataset_id"`
+	JoinColumn   string    `json:"join_column" yaml:"join_column"`
+	LookupColumn string    `json:"lookup_column" yaml:"lookup_column"`
+	ValueColumn  string    `json:"value_column" yaml:"value_column"`
+}
+
+func unmarshalRuleAssignments(schema json.RawMessage) ([]RuleAssignment, error) {
+	if len(schema) == 0 {
+		return nil, nil
+	}
+
+	var assignments []RuleAssignment
+	if err := json.Unmarshal(schema, &assignments); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
 type FilterConfig struct {
 	QueryConfig querybuildermodels.QueryConfig `json:"query_config"`
 	Sql         string                         `json:"sql"`
 	Args        map[string]interface{}         `json:"args"`
 }
+
+type RuleVersion struct {
+	ID           uuid.UUID               `json:"rule_version_id"`
+	RuleId       uuid.UUID               `json:"rule_id"`
+	DatasetId    uuid.UUID               `json:"dataset_id"`
+	Version      int                     `json:"version"`
+	ChangeType   dbmodels.RuleChangeType `json:"change_type"`
+	Title        string                  `json:"title"`
+	Description  string                  `json:"description"`
+	Value        string                  `json:"value"`
+	FilterConfig FilterConfig            `json:"filter_config"`
+	Assignments  []RuleAssignment        `json:"assignments"`
+	IsEnabled    bool                    `json:"is_enabled"`
+	CreatedAt    time.Time               `json:"created_at"`
+	CreatedBy    uuid.UUID               `json:"created_by"`
+}
+
+func (v *RuleVersion) FromSchema(schema *dbmodels.RuleVersion) error {
+	filterConfig := FilterConfig{}
+
+	err := json.Unmarshal(schema.FilterConfig, &filterConfig)
+	if err != nil {
+		return err
+	}
+
+	assignments, err := unmarshalRuleAssignments(schema.Assignments)
+	if err != nil {
+		return err
+	}
+
+	v.ID = schema.ID
+	v.RuleId = schema.RuleId
+	v.DatasetId = schema.DatasetId
+	v.Version = schema.Version
+	v.ChangeType = schema.ChangeType
+	v.Title = schema.Title
+	v.Description = schema.Description
+	v.Value = schema.Value
+	v.FilterConfig = filterConfig
+	v.Assignments = assignments
+	v.IsEnabled = schema.IsEnabled
+	v.CreatedAt = schema.CreatedAt
+	v.CreatedBy = schema.CreatedBy
+
+	return nil
+}
+
+// Rule returns the rule as it stood at the version, the fields not versioned are left empty
+func (v *RuleVersion) Rule() Rule {
+	return Rule{
+		ID:           v.RuleId,
+		DatasetId:    v.DatasetId,
+		Value:        v.Value,
+		FilterConfig: v.FilterConfig,
+		Assignments:  v.Assignments,
+		Title:        v.Title,
+		Description:  v.Description,
+		IsEnabled:    v.IsEnabled,
+	}
+}
+
+type RuleRun struct {
+	ID          uuid.UUID `json:"rule_run_id"`
+	RuleId      uuid.UUID `json:"rule_id"`
+	DatasetId   uuid.UUID `json:"dataset_id"`
+	ActionId    string    `json:"action_id"`
+	MatchedRows int64     `json:"matched_rows"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (r *RuleRun) FromSchema(schema *dbmodels.RuleRun) {
+	r.ID = schema.ID
+	r.RuleId = schema.RuleId
+	r.DatasetId = schema.DatasetId
+	r.ActionId = schema.ActionId
+	r.MatchedRows = schema.MatchedRows
+	r.CreatedAt = schema.CreatedAt
+}
@@ -13,6 +13,7 @@ import (
 
 type RuleServiceStore interface {
 	store.RuleStore
+	store.RuleVersionStore
 }
 
 type RuleService interface {
@@ -21,7 +22,12 @@ type RuleService interface {
 	GetRuleByIds(ctx context.Context, ruleIds []uuid.UUID) ([]models.Rule, error)
 	UpdateRule(ctx context.Context, ruleId uuid.UUID, params dbmodels.UpdateRuleParams) error
 	UpdateRulePriority(ctx context.Context, params dbmodels.UpdateRulePriorityParams) error
+	SetRuleEnabled(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID) error
 	DeleteRule(ctx context.Context, params dbmodels.DeleteRuleParams) error
+	GetRuleVersions(ctx context.Context, ruleId uuid.UUID) ([]models.RuleVersion, error)
+	RestoreRuleVersion(ctx context.Context, ruleId uuid.UUID, version int, updatedBy uuid.UUID) (models.RuleVersion, error)
+	CreateRuleRuns(ctx context.Context, params []dbmodels.CreateRuleRunParams) error
+	GetRuleRuns(ctx context.Context, ruleIds []uuid.UUID, limit int) (map[uuid.UUID][]models.RuleRun, error)
 }
 
 type ruleService struct {
@@ -43,7 +49,7 @@ func (s *ruleService) CreateRule(ctx context.Context, params dbmodels.CreateRule
 		return err
 	}
 
-	return nil
+	return s.createRuleVersion(ctx, params.Id, dbmodels.RuleChangeTypeCreated, params.CreatedBy)
 }
 
 func (s *ruleService) GetRules(ctx context.Context, params dbmodels.FilterRuleParams) (map[string]map[string][]models.Rule, error) {
@@ -111,7 +117,7 @@ func (s *ruleService) UpdateRule(ctx context.Context, ruleId uuid.UUID, params d
 		return err
 	}
 
-	return nil
+	return s.createRuleVersion(ctx, ruleId, dbmodels.RuleChangeTypeUpdated, params.UpdatedBy)
 }
 
 func (s *ruleService) UpdateRulePriority(ctx context.Context, params dbmodels.UpdateRulePriorityParams) error {
@@ -126,6 +132,23 @@ func (s *ruleService) UpdateRulePriority(ctx context.Context, params dbmodels.Up
 	return nil
 }
 
+func (s *ruleService) SetRuleEnabled(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	err := s.store.SetRuleEnabled(ctx, ruleId, isEnabled, updatedBy)
+	if err != nil {
+		logger.Error("Failed to set rule enabled", zap.String("ruleId", ruleId.String()), zap.Bool("isEnabled", isEnabled), zap.Error(err))
+		return err
+	}
+
+	changeType := dbmodels.RuleChangeTypeDisabled
+	if isEnabled {
+		changeType = dbmodels.RuleChangeTypeEnabled
+	}
+
+	return s.createRuleVersion(ctx, ruleId, changeType, updatedBy)
+}
+
 func (s *ruleService) DeleteRule(ctx context.Context, params dbmodels.DeleteRuleParams) error {
 	logger := apicontext.GetLoggerFromCtx(ctx)
 
@@ -135,5 +158,115 @@ func (s *ruleService) DeleteRule(ctx context.Context, params dbmodels.DeleteRule
 		return err
 	}
 
+	return s.createRuleVersion(ctx, params.RuleId, dbmodels.RuleChangeTypeDeleted, params.DeletedBy)
+}
+
+func (s *ruleService) createRuleVersion(ctx context.Context, ruleId uuid.UUID, changeType dbmodels.RuleChangeType, createdBy uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	_, err := s.store.CreateRuleVersion(ctx, ruleId, changeType, createdBy)
+	if err != nil {
+		logger.Error("Failed to create rule version", zap.String("ruleId", ruleId.String()), zap.String("changeType", string(changeType)), zap.Error(err))
+		return err
+	}
+
 	return nil
 }
+
+func (s *ruleService) GetRuleVersions(ctx context.Context, ruleId uuid.UUID) ([]models.RuleVersion, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	versionSchemas, err := s.store.GetRuleVersions(ctx, ruleId)
+	if err != nil {
+		logger.Error("Failed to get rule versions", zap.String("ruleId", ruleId.String()), zap.Error(err))
+		return nil, err
+	}
+
+	versions := make([]models.RuleVersion, 0, len(versionSchemas))
+	for _, versionSchema := range versionSchemas {
+		var version models.RuleVersion
+		if err := version.FromSchema(&versionSchema); err != nil {
+			logger.Error("Failed to convert rule version schema to rule version", zap.String("ruleVersionId", versionSchema.ID.String()), zap.Error(err))
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+// RestoreRuleVersion puts back the definition a rule had at a version and records it as a new version
+func (s *ruleService) RestoreRuleVersion(ctx context.Context, ruleId uuid.UUID, version int, updatedBy uuid.UUID) (models.RuleVersion, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	ruleVersion, err := s.store.GetRuleVersion(ctx, ruleId, version)
+	if err != nil {
+		logger.Error("Failed to get rule version", zap.String("ruleId", ruleId.String()), zap.Int("version", version), zap.Error(err))
+		return models.RuleVersion{}, err
+	}
+
+	err = s.store.UpdateRule(ctx, ruleId, dbmodels.UpdateRuleParams{
+		Title:        ruleVersion.Title,
+		Description:  ruleVersion.Description,
+		Value:        ruleVersion.Value,
+		FilterConfig: ruleVersion.FilterConfig,
+		Assignments:  ruleVersion.Assignments,
+		UpdatedBy:    updatedBy,
+	})
+	if err != nil {
+		logger.Error("Failed to restore rule", zap.String("ruleId", ruleId.String()), zap.Int("version", version), zap.Error(err))
+		return models.RuleVersion{}, err
+	}
+
+	err = s.store.SetRuleEnabled(ctx, ruleId, ruleVersion.IsEnabled, updatedBy)
+	if err != nil {
+		logger.Error("Failed to restore rule enabled", zap.String("ruleId", ruleId.String()), zap.Int("version", version), zap.Error(err))
+		return models.RuleVersion{}, err
+	}
+
+	restoredSchema, err := s.store.CreateRuleVersion(ctx, ruleId, dbmodels.RuleChangeTypeRestored, updatedBy)
+	if err != nil {
+		logger.Error("Failed to create rule version", zap.String("ruleId", ruleId.String()), zap.Error(err))
+		return models.RuleVersion{}, err
+	}
+
+	var restored models.RuleVersion
+	if err := restored.FromSchema(&restoredSchema); err != nil {
+		logger.Error("Failed to convert rule version schema to rule version", zap.String("ruleVersionId", restoredSchema.ID.String()), zap.Error(err))
+		return models.RuleVersion{}, err
+	}
+
+	return restored, nil
+}
+
+func (s *ruleService) CreateRuleRuns(ctx context.Context, params []dbmodels.CreateRuleRunParams) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	err := s.store.CreateRuleRuns(ctx, params)
+	if err != nil {
+		logger.Error("Failed to create rule runs", zap.Int("count", len(params)), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetRuleRuns returns the latest runs of each rule keyed by rule, latest first
+func (s *ruleService) GetRuleRuns(ctx context.Context, ruleIds []uuid.UUID, limit int) (map[uuid.UUID][]models.RuleRun, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	runSchemas, err := s.store.GetRuleRuns(ctx, ruleIds, limit)
+	if err != nil {
+		logger.Error("Failed to get rule runs", zap.Any("ruleIds", ruleIds), zap.Error(err))
+		return nil, err
+	}
+
+	runs := make(map[uuid.UUID][]models.RuleRun, len(ruleIds))
+	for _, runSchema := range runSchemas {
+		var run models.RuleRun
+		run.FromSchema(&runSchema)
+		runs[run.RuleId] = append(runs[run.RuleId], run)
+	}
+
+	return runs, nil
+}
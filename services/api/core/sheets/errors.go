@@ -0,0 +1,9 @@
+package sheets
+
+import "errors"
+
+var (
+	ErrSheetNotFound      = errors.New("sheet not found")
+	ErrEmptySheetName     = errors.New("sheet name cannot be empty")
+	ErrInvalidSheetConfig = errors.New("invalid sheet config")
+)
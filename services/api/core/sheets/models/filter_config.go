@@ -1,7 +1,8 @@
 package models
 
 type FilterOptionsConfig struct {
-	NativeFilterConfig []FilterOptionsModel `json:"native_filter_config"`
+	NativeFilterConfig []FilterOptionsModel   `json:"native_filter_config"`
+	Variables          []VariableOptionsModel `json:"variables,omitempty"`
 }
 
 type FilterOptionsModel struct {
@@ -12,5 +13,12 @@ type FilterOptionsModel struct {
 	WidgetsInScope []string            `json:"widgets_in_scope"`
 	Targets        []FilterTarget      `json:"targets"`
 	Options        []interface{}       `json:"options,omitempty"`
+	OptionLabels   map[string]string   `json:"option_labels,omitempty"`
 	DefaultValue   *DefaultFilterValue `json:"default_value,omitempty"`
 }
+
+// VariableOptionsModel is a variable of the sheet with the values it can take, only enum variables have options
+type VariableOptionsModel struct {
+	SheetVariable
+	Options []interface{} `json:"options,omitempty"`
+}
@@ -0,0 +1,105 @@
+package models
+
+import "github.com/google/uuid"
+
+// SheetConfigVersion1 is the version of the sheet config new sheets are created with
+const SheetConfigVersion1 SheetConfigVersion = "1.0"
+
+// RemapWidgets returns a copy of the config whose layout and filters point at the widget instances the ids are mapped
+// to, references to widget instances missing from the map are dropped
+func (c SheetConfig) RemapWidgets(ids map[uuid.UUID]uuid.UUID) SheetConfig {
+	return c.mapWidgets(func(id uuid.UUID) (uuid.UUID, bool) {
+		newId, ok := ids[id]
+		return newId, ok
+	})
+}
+
+// WithoutWidget returns a copy of the config without any reference to the widget instance
+func (c SheetConfig) WithoutWidget(widgetInstanceId uuid.UUID) SheetConfig {
+	return c.mapWidgets(func(id uuid.UUID) (uuid.UUID, bool) {
+		return id, id != widgetInstanceId
+	})
+}
+
+// WithWidgetBelow returns a copy of the config with a layout group for the widget instance added under all other
+// groups, sized like the group holding the source widget instance
+func (c SheetConfig) WithWidgetBelow(sourceWidgetInstanceId uuid.UUID, widgetInstanceId uuid.UUID, name string) SheetConfig {
+	var layout *Layout
+	bottom := 0.0
+	for _, group := range c.SheetLayout {
+		if group.Layout == nil {
+			continue
+		}
+		bottom = max(bottom, group.Layout.Y+group.Layout.H)
+		for _, id := range group.WidgetGroup {
+			if id == sourceWidgetInstanceId && layout == nil {
+				layout = &Layout{X: group.Layout.X, W: group.Layout.W, H: group.Layout.H}
+			}
+		}
+	}
+	if layout == nil {
+		return c
+	}
+	layout.Y = bottom
+
+	config := c
+	config.SheetLayout = append(append([]WidgetGroupLayout{}, c.SheetLayout...), WidgetGroupLayout{
+		Name:          name,
+		Layout:        layout,
+		DefaultWidget: &widgetInstanceId,
+		WidgetGroup:   []uuid.UUID{widgetInstanceId},
+	})
+	return config
+}
+
+func (c SheetConfig) mapWidgets(mapId func(uuid.UUID) (uuid.UUID, bool)) SheetConfig {
+	config := c
+
+	if c.SheetLayout != nil {
+		config.SheetLayout = make([]WidgetGroupLayout, 0, len(c.SheetLayout))
+	}
+	for _, group := range c.SheetLayout {
+		mapped := group
+		mapped.WidgetGroup = make([]uuid.UUID, 0, len(group.WidgetGroup))
+		for _, id := range group.WidgetGroup {
+			if newId, ok := mapId(id); ok {
+				mapped.WidgetGroup = append(mapped.WidgetGroup, newId)
+			}
+		}
+		if len(mapped.WidgetGroup) == 0 {
+			continue
+		}
+
+		if group.DefaultWidget != nil {
+			newId, ok := mapId(*group.DefaultWidget)
+			if !ok {
+				newId = mapped.WidgetGroup[0]
+			}
+			mapped.DefaultWidget = &newId
+		}
+		config.SheetLayout = append(config.SheetLayout, mapped)
+	}
+
+	if c.NativeFilterConfig != nil {
+		config.NativeFilterConfig = make([]NativeFilterConfig, 0, len(c.NativeFilterConfig))
+	}
+	for _, filter := range c.NativeFilterConfig {
+		mapped := filter
+		if filter.WidgetsInScope != nil {
+			mapped.WidgetsInScope = make([]string, 0, len(filter.WidgetsInScope))
+		}
+		for _, scope := range filter.WidgetsInScope {
+			id, err := uuid.Parse(scope)
+			if err != nil {
+				mapped.WidgetsInScope = append(mapped.WidgetsInScope, scope)
+				continue
+			}
+			if newId, ok := mapId(id); ok {
+				mapped.WidgetsInScope = append(mapped.WidgetsInScope, newId.String())
+			}
+		}
+		config.NativeFilterConfig = append(config.NativeFilterConfig, mapped)
+	}
+
+	return config
+}
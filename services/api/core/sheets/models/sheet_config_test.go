@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSheetConfigRemapWidgets(t *testing.T) {
+	chart, table, dropped := uuid.New(), uuid.New(), uuid.New()
+	newChart, newTable := uuid.New(), uuid.New()
+
+	config := SheetConfig{
+		Version: "1.0",
+		SheetLayout: []WidgetGroupLayout{
+			{Name: "Overview", Layout: &Layout{W: 6, H: 4}, DefaultWidget: &table, WidgetGroup: []uuid.UUID{chart, table}},
+			{Name: "Stale", Layout: &Layout{Y: 4, W: 6, H: 4}, DefaultWidget: &dropped, WidgetGroup: []uuid.UUID{dropped}},
+		},
+		NativeFilterConfig: []NativeFilterConfig{
+			{Id: "region", Name: "Region", WidgetsInScope: []string{chart.String(), dropped.String(), "all"}},
+		},
+	}
+
+	got := config.RemapWidgets(map[uuid.UUID]uuid.UUID{chart: newChart, table: newTable})
+
+	assert.Equal(t, SheetConfig{
+		Version: "1.0",
+		SheetLayout: []WidgetGroupLayout{
+			{Name: "Overview", Layout: &Layout{W: 6, H: 4}, DefaultWidget: &newTable, WidgetGroup: []uuid.UUID{newChart, newTable}},
+		},
+		NativeFilterConfig: []NativeFilterConfig{
+			{Id: "region", Name: "Region", WidgetsInScope: []string{newChart.String(), "all"}},
+		},
+	}, got)
+
+	// the source config is left as it was
+	assert.Equal(t, []uuid.UUID{chart, table}, config.SheetLayout[0].WidgetGroup)
+	assert.Len(t, config.SheetLayout, 2)
+}
+
+func TestSheetConfigWithoutWidget(t *testing.T) {
+	chart, table := uuid.New(), uuid.New()
+
+	config := SheetConfig{
+		Version: "1.0",
+		SheetLayout: []WidgetGroupLayout{
+			{Name: "Overview", DefaultWidget: &chart, WidgetGroup: []uuid.UUID{chart, table}},
+			{Name: "Chart", WidgetGroup: []uuid.UUID{chart}},
+		},
+		NativeFilterConfig: []NativeFilterConfig{
+			{Id: "region", Name: "Region", WidgetsInScope: []string{chart.String()}},
+		},
+	}
+
+	got := config.WithoutWidget(chart)
+
+	// the default widget falls back to the first one left in the group
+	assert.Equal(t, []WidgetGroupLayout{{Name: "Overview", DefaultWidget: &table, WidgetGroup: []uuid.UUID{table}}}, got.SheetLayout)
+	assert.Equal(t, []string{}, got.NativeFilterConfig[0].WidgetsInScope)
+}
+
+func TestSheetConfigWithWidgetBelow(t *testing.T) {
+	chart, table, copied := uuid.New(), uuid.New(), uuid.New()
+
+	config := SheetConfig{
+		Version: "1.0",
+		SheetLayout: []WidgetGroupLayout{
+			{Name: "Chart", Layout: &Layout{X: 6, Y: 0, W: 6, H: 4}, WidgetGroup: []uuid.UUID{chart}},
+			{Name: "Table", Layout: &Layout{X: 0, Y: 4, W: 12, H: 8}, WidgetGroup: []uuid.UUID{table}},
+		},
+	}
+
+	got := config.WithWidgetBelow(chart, copied, "Chart (copy)")
+
+	assert.Len(t, config.SheetLayout, 2)
+	assert.Equal(t, WidgetGroupLayout{
+		Name:          "Chart (copy)",
+		Layout:        &Layout{X: 6, Y: 12, W: 6, H: 4},
+		DefaultWidget: &copied,
+		WidgetGroup:   []uuid.UUID{copied},
+	}, got.SheetLayout[2])
+
+	// a widget instance that is not laid out leaves the layout alone
+	assert.Equal(t, config, config.WithWidgetBelow(uuid.New(), copied, "Copy"))
+}
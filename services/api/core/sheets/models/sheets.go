@@ -30,6 +30,7 @@ type SheetConfig struct {
 	NativeFilterConfig []NativeFilterConfig `json:"native_filter_config"`
 	SheetLayout        []WidgetGroupLayout  `json:"sheet_layout"`
 	Currency           *SheetCurrencyConfig `json:"currency,omitempty"`
+	Variables          []SheetVariable      `json:"variables,omitempty"`
 }
 
 type SheetCurrencyConfig struct {
@@ -117,6 +118,7 @@ func (s *Sheet) ToDB() (*dbmodels.Sheet, error) {
 		FractionalIndex: s.FractionalIndex,
 		PageId:          s.PageId,
 		SheetConfig:     sheetConfig,
+		CreatedAt:       s.CreatedAt,
 	}, nil
 }
 
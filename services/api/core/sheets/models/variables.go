@@ -0,0 +1,105 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
+	"github.com/google/uuid"
+)
+
+// Types of the values of sheet variables, the values of enum variables are the values of a column of a dataset
+const (
+	VariableTypeString = "string"
+	VariableTypeNumber = "number"
+	VariableTypeDate   = "date"
+	VariableTypeEnum   = "enum"
+)
+
+// variableNameRegex matches the names widgets can reference a variable by, the names the parameters of widgets allow
+var variableNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// numberRegex matches the decimal numbers number variables take, they are used as they are in SQL expressions
+var numberRegex = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// reservedVariableNames are the names of the parameters every sheet has
+var reservedVariableNames = []string{
+	widgetconstants.ParameterMethodToday,
+	widgetconstants.ParameterMethodStartDay,
+	widgetconstants.ParameterMethodEndDay,
+}
+
+// SheetVariable is a value admins define on a sheet for its widgets to reference as {{.$<name>}} in their default
+// filters and field expressions. Users change it from its default from the sheet
+type SheetVariable struct {
+	Name         string          `json:"name"`
+	Label        string          `json:"label,omitempty"`
+	Type         string          `json:"type"`
+	DefaultValue string          `json:"default_value"`
+	Source       *VariableSource `json:"source,omitempty"`
+}
+
+// VariableSource is the column of a dataset the options of an enum variable are the values of
+type VariableSource struct {
+	DatasetId uuid.UUID `json:"dataset_id"`
+	Column    string    `json:"column"`
+}
+
+// Validate checks the variable has a name widgets can reference, a type it knows and a default value of the type
+func (v SheetVariable) Validate() error {
+	if !variableNameRegex.MatchString(v.Name) {
+		return fmt.Errorf("variable name %q can only have letters, digits and underscores", v.Name)
+	}
+
+	for _, reserved := range reservedVariableNames {
+		if widgetconstants.DefaultVariableSymbol+v.Name == reserved {
+			return fmt.Errorf("variable name %s is reserved", v.Name)
+		}
+	}
+
+	switch v.Type {
+	case VariableTypeEnum:
+		if v.Source == nil || v.Source.DatasetId == uuid.Nil || v.Source.Column == "" {
+			return fmt.Errorf("enum variable %s needs a dataset and a column for its options", v.Name)
+		}
+	case VariableTypeString, VariableTypeNumber, VariableTypeDate:
+		if v.Source != nil {
+			return fmt.Errorf("only enum variables have a source, %s is a %s variable", v.Name, v.Type)
+		}
+	default:
+		return fmt.Errorf("unknown type %q of variable %s", v.Type, v.Name)
+	}
+
+	if _, err := v.ParseValue(v.DefaultValue); err != nil {
+		return fmt.Errorf("default value of variable %s: %w", v.Name, err)
+	}
+
+	return nil
+}
+
+// ParseValue checks the value is one of the type of the variable and returns it the way widgets use it. Dates are given
+// as dates or times in the calendar of the organization and used as times
+func (v SheetVariable) ParseValue(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("variable %s needs a value", v.Name)
+	}
+
+	switch v.Type {
+	case VariableTypeNumber:
+		if !numberRegex.MatchString(value) {
+			return "", fmt.Errorf("%q is not a number", value)
+		}
+	case VariableTypeDate:
+		for _, layout := range []string{time.DateTime, time.DateOnly} {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t.Format(time.DateTime), nil
+			}
+		}
+		return "", fmt.Errorf("%q is not a date", value)
+	}
+
+	return value, nil
+}
@@ -3,15 +3,21 @@ package sheets
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	datasetsconstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
 	datasetsService "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	"github.com/Zampfi/application-platform/services/api/core/pages"
+	referencedatamodels "github.com/Zampfi/application-platform/services/api/core/referencedata/models"
 	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
+	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	"github.com/Zampfi/application-platform/services/api/pkg/cache"
 	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -19,6 +25,10 @@ import (
 
 type SheetsServiceStore interface {
 	store.SheetStore
+	store.WidgetStore
+	store.ReferenceBankStore
+	store.FlattenedResourceAudiencePoliciesStore
+	store.TransactionStore
 }
 
 type SheetsService interface {
@@ -27,6 +37,8 @@ type SheetsService interface {
 	GetSheetFilterConfigById(ctx context.Context, orgId uuid.UUID, sheetId uuid.UUID) (*sheetmodels.FilterOptionsConfig, error)
 	CreateSheet(ctx context.Context, sheet sheetmodels.Sheet) (*models.Sheet, error)
 	UpdateSheet(ctx context.Context, sheet *sheetmodels.Sheet) (*models.Sheet, error)
+	DuplicateSheet(ctx context.Context, sheetId uuid.UUID, name string) (*models.Sheet, error)
+	DeleteSheet(ctx context.Context, sheetId uuid.UUID) error
 }
 
 type sheetsService struct {
@@ -54,7 +66,7 @@ func (s *sheetsService) GetSheetsByPageId(ctx context.Context, pageId uuid.UUID)
 func (s *sheetsService) GetSheetById(ctx context.Context, pageId uuid.UUID) (*models.Sheet, error) {
 	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
 
-	sheet, err := s.store.GetSheetById(ctx, pageId)
+	sheet, err := s.getSheet(ctx, pageId)
 	if err != nil {
 		ctxLogger.Error("failed to get page", zap.Error(err))
 		return nil, err
@@ -103,6 +115,24 @@ func (s *sheetsService) GetSheetFilterConfigById(ctx context.Context, orgId uuid
 }
 
 func (s *sheetsService) CreateSheet(ctx context.Context, sheet sheetmodels.Sheet) (*models.Sheet, error) {
+	sheet.Name = strings.TrimSpace(sheet.Name)
+	if sheet.Name == "" {
+		return nil, ErrEmptySheetName
+	}
+
+	if sheet.SheetConfig.Version == "" {
+		sheet.SheetConfig.Version = string(sheetmodels.SheetConfigVersion1)
+	}
+
+	// a new sheet has no widget instances for its layout and filters to reference
+	if err := validateSheetConfig(sheet.SheetConfig, nil); err != nil {
+		return nil, err
+	}
+
+	if err := pages.EnsurePageAdmin(ctx, s.store, sheet.PageId); err != nil {
+		return nil, err
+	}
+
 	sheetModel, err := sheet.ToDB()
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert sheet to model: %w", err)
@@ -121,9 +151,13 @@ func isRangeOperator(op string) bool {
 
 func (s *sheetsService) UpdateSheet(ctx context.Context, updatedSheet *sheetmodels.Sheet) (*models.Sheet, error) {
 	// Get the sheet
-	sheet, err := s.store.GetSheetById(ctx, updatedSheet.ID)
+	sheet, err := s.getSheet(ctx, updatedSheet.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get sheet: %w", err)
+		return nil, err
+	}
+
+	if err := pages.EnsurePageAdmin(ctx, s.store, sheet.PageId); err != nil {
+		return nil, err
 	}
 
 	sheetModel := sheetmodels.Sheet{}
@@ -132,18 +166,31 @@ func (s *sheetsService) UpdateSheet(ctx context.Context, updatedSheet *sheetmode
 	}
 
 	if updatedSheet.SheetConfig.Version != "" {
+		if err := validateSheetConfig(updatedSheet.SheetConfig, activeWidgetInstanceIds(sheet)); err != nil {
+			return nil, err
+		}
 		sheetModel.SheetConfig = updatedSheet.SheetConfig
 	}
 
-	if updatedSheet.Name != "" {
-		sheetModel.Name = updatedSheet.Name
+	if name := strings.TrimSpace(updatedSheet.Name); name != "" {
+		sheetModel.Name = name
 	}
 
 	if updatedSheet.Description != nil {
 		sheetModel.Description = updatedSheet.Description
 	}
 
+	if updatedSheet.FractionalIndex != 0 {
+		sheetModel.FractionalIndex = updatedSheet.FractionalIndex
+	}
+
 	if updatedSheet.PageId != uuid.Nil {
+		// moving the sheet needs admin access on the page it moves to as well
+		if updatedSheet.PageId != sheet.PageId {
+			if err := pages.EnsurePageAdmin(ctx, s.store, updatedSheet.PageId); err != nil {
+				return nil, err
+			}
+		}
 		sheetModel.PageId = updatedSheet.PageId
 	}
 
@@ -151,7 +198,71 @@ func (s *sheetsService) UpdateSheet(ctx context.Context, updatedSheet *sheetmode
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert sheet to model: %w", err)
 	}
-	return s.store.UpdateSheet(ctx, sheetModelDB)
+
+	savedSheet, err := s.store.UpdateSheet(ctx, sheetModelDB)
+	if err != nil {
+		return nil, err
+	}
+
+	if updatedSheet.SheetConfig.Version != "" {
+		s.invalidateSheetFilterConfig(ctx, sheet.ID)
+	}
+
+	return savedSheet, nil
+}
+
+// DuplicateSheet copies the sheet and its widget instances onto the same page, the copy is placed after the other
+// sheets of the page
+func (s *sheetsService) DuplicateSheet(ctx context.Context, sheetId uuid.UUID, name string) (*models.Sheet, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	sheet, err := s.getSheet(ctx, sheetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pages.EnsurePageAdmin(ctx, s.store, sheet.PageId); err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = sheet.Name + pages.DuplicateNameSuffix
+	}
+
+	var duplicatedSheet *models.Sheet
+	err = s.store.WithTx(ctx, func(tx store.Store) error {
+		duplicatedSheet, err = pages.CopySheet(ctx, tx, *sheet, sheet.PageId, name)
+		return err
+	})
+	if err != nil {
+		logger.Error("failed to duplicate sheet", zap.Error(err))
+		return nil, err
+	}
+
+	return duplicatedSheet, nil
+}
+
+func (s *sheetsService) DeleteSheet(ctx context.Context, sheetId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	sheet, err := s.getSheet(ctx, sheetId)
+	if err != nil {
+		return err
+	}
+
+	if err := pages.EnsurePageAdmin(ctx, s.store, sheet.PageId); err != nil {
+		return err
+	}
+
+	if err := s.store.DeleteSheet(ctx, sheetId); err != nil {
+		logger.Error("failed to delete sheet", zap.Error(err))
+		return err
+	}
+
+	s.invalidateSheetFilterConfig(ctx, sheetId)
+
+	return nil
 }
 
 func (s *sheetsService) getSheetFilterConfigFromDB(ctx context.Context, orgId uuid.UUID, sheetModel sheetmodels.Sheet) (*sheetmodels.FilterOptionsConfig, error) {
@@ -230,10 +341,87 @@ func (s *sheetsService) getSheetFilterConfigFromDB(ctx context.Context, orgId uu
 		})
 	}
 
+	if len(sheetModel.SheetConfig.Variables) > 0 {
+		sheetFilterConfig.Variables = make([]sheetmodels.VariableOptionsModel, len(sheetModel.SheetConfig.Variables))
+	}
+	for i, variable := range sheetModel.SheetConfig.Variables {
+		sheetFilterConfig.Variables[i] = sheetmodels.VariableOptionsModel{SheetVariable: variable}
+		if variable.Type != sheetmodels.VariableTypeEnum || variable.Source == nil {
+			continue
+		}
+
+		filterErrgrp.Go(func() error {
+			options, err := s.datasetService.GetOptionsForColumn(ctx, orgId, variable.Source.DatasetId.String(), variable.Source.Column, datasetsconstants.FilterTypeSelect, false)
+			if err != nil {
+				return fmt.Errorf("failed to get options for variable %s: %w", variable.Name, err)
+			}
+			sheetFilterConfig.Variables[i].Options = options
+			return nil
+		})
+	}
+
 	if err := filterErrgrp.Wait(); err != nil {
 		logger.Error("failed to populate filter options", zap.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to populate filter options: %w", err)
 	}
 
+	if err := s.populateOptionLabels(ctx, sheetFilterConfig.NativeFilterConfig); err != nil {
+		return nil, fmt.Errorf("failed to populate filter option labels: %w", err)
+	}
+
 	return &sheetFilterConfig, nil
 }
+
+// populateOptionLabels adds the display names of the options of country and bank filters, the banks the organization
+// added to the directory are only loaded for bank filters
+func (s *sheetsService) populateOptionLabels(ctx context.Context, filters []sheetmodels.FilterOptionsModel) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	var directory *referencedata.Directory
+	for i, filter := range filters {
+		var kind referencedata.Kind
+		switch widgetconstants.UserFacingDatatype(filter.DataType) {
+		case widgetconstants.UserFacingDatatypeCountry:
+			kind = referencedata.KindCountry
+		case widgetconstants.UserFacingDatatypeBank:
+			kind = referencedata.KindBank
+		default:
+			continue
+		}
+		if len(filter.Options) == 0 {
+			continue
+		}
+
+		if kind == referencedata.KindBank && directory == nil {
+			storeBanks, err := s.store.GetReferenceBanks(ctx)
+			if err != nil {
+				logger.Error("failed to get reference banks", zap.String("error", err.Error()))
+				return err
+			}
+			directory = referencedata.NewDirectory(referencedatamodels.ToDirectoryBanks(storeBanks))
+		}
+
+		labels := map[string]string{}
+		for _, option := range filter.Options {
+			value, ok := option.(string)
+			if !ok {
+				continue
+			}
+			if kind == referencedata.KindCountry {
+				if country, ok := referencedata.CountryByAlpha3(value); ok {
+					labels[value] = country.Name
+				}
+				continue
+			}
+			if bank, ok := directory.Bank(value); ok {
+				labels[value] = bank.Name
+			}
+		}
+
+		if len(labels) > 0 {
+			filters[i].OptionLabels = labels
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package sheets
+
+import (
+	"context"
+	goerrors "errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func (s *sheetsService) getSheet(ctx context.Context, sheetId uuid.UUID) (*models.Sheet, error) {
+	sheet, err := s.store.GetSheetById(ctx, sheetId)
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSheetNotFound
+		}
+		return nil, err
+	}
+
+	if sheet.DeletedAt != nil {
+		return nil, ErrSheetNotFound
+	}
+
+	return sheet, nil
+}
+
+// invalidateSheetFilterConfig drops the cached filter options of the sheet so that they are rebuilt from its new config
+func (s *sheetsService) invalidateSheetFilterConfig(ctx context.Context, sheetId uuid.UUID) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	cacheKey, err := s.cacheClient.FormatKey("sheet_filter_config", sheetId.String())
+	if err != nil {
+		logger.Warn("failed to format cache key", zap.Error(err))
+		return
+	}
+
+	if err := s.cacheClient.Delete(ctx, cacheKey); err != nil {
+		logger.Warn("failed to delete sheet filter config from cache", zap.Error(err))
+	}
+}
+
+func activeWidgetInstanceIds(sheet *models.Sheet) []uuid.UUID {
+	ids := []uuid.UUID{}
+	for _, widgetInstance := range sheet.WidgetInstances {
+		if widgetInstance.DeletedAt == nil {
+			ids = append(ids, widgetInstance.ID)
+		}
+	}
+	return ids
+}
+
+// validateSheetConfig checks the layout, the filters and the variables of the config, every widget instance they
+// reference has to be one of the widget instances of the sheet
+func validateSheetConfig(config sheetmodels.SheetConfig, widgetInstanceIds []uuid.UUID) error {
+	if config.Version == "" {
+		return fmt.Errorf("%w: version is required", ErrInvalidSheetConfig)
+	}
+
+	for i, group := range config.SheetLayout {
+		if len(group.WidgetGroup) == 0 {
+			return fmt.Errorf("%w: layout group %d has no widget instances", ErrInvalidSheetConfig, i)
+		}
+
+		if group.Layout != nil {
+			if group.Layout.W <= 0 || group.Layout.H <= 0 {
+				return fmt.Errorf("%w: layout group %d needs a positive width and height", ErrInvalidSheetConfig, i)
+			}
+			if group.Layout.X < 0 || group.Layout.Y < 0 {
+				return fmt.Errorf("%w: layout group %d cannot have a negative position", ErrInvalidSheetConfig, i)
+			}
+		}
+
+		for _, id := range group.WidgetGroup {
+			if !slices.Contains(widgetInstanceIds, id) {
+				return fmt.Errorf("%w: widget instance %s is not on the sheet", ErrInvalidSheetConfig, id)
+			}
+		}
+
+		if group.DefaultWidget != nil && !slices.Contains(group.WidgetGroup, *group.DefaultWidget) {
+			return fmt.Errorf("%w: default widget %s of layout group %d is not in the group", ErrInvalidSheetConfig, *group.DefaultWidget, i)
+		}
+	}
+
+	filterIds := map[string]bool{}
+	for _, filter := range config.NativeFilterConfig {
+		if strings.TrimSpace(filter.Id) == "" {
+			return fmt.Errorf("%w: filters need an id", ErrInvalidSheetConfig)
+		}
+		if filterIds[filter.Id] {
+			return fmt.Errorf("%w: filter id %s is used more than once", ErrInvalidSheetConfig, filter.Id)
+		}
+		filterIds[filter.Id] = true
+
+		if strings.TrimSpace(filter.Name) == "" {
+			return fmt.Errorf("%w: filter %s needs a name", ErrInvalidSheetConfig, filter.Id)
+		}
+
+		for _, target := range filter.Targets {
+			if target.DatasetId == uuid.Nil || target.Column == "" {
+				return fmt.Errorf("%w: targets of filter %s need a dataset and a column", ErrInvalidSheetConfig, filter.Id)
+			}
+		}
+
+		for _, scope := range filter.WidgetsInScope {
+			id, err := uuid.Parse(scope)
+			if err == nil && !slices.Contains(widgetInstanceIds, id) {
+				return fmt.Errorf("%w: filter %s is scoped to widget instance %s which is not on the sheet", ErrInvalidSheetConfig, filter.Id, scope)
+			}
+		}
+	}
+
+	variableNames := map[string]bool{}
+	for _, variable := range config.Variables {
+		if err := variable.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidSheetConfig, err)
+		}
+		if variableNames[variable.Name] {
+			return fmt.Errorf("%w: variable %s is defined more than once", ErrInvalidSheetConfig, variable.Name)
+		}
+		variableNames[variable.Name] = true
+	}
+
+	return nil
+}
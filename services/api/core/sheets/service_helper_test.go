@@ -0,0 +1,154 @@
+package sheets
+
+import (
+	"testing"
+
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSheetConfig(t *testing.T) {
+	widgetInstanceId := uuid.New()
+	otherWidgetInstanceId := uuid.New()
+	datasetId := uuid.New()
+
+	validLayout := []sheetmodels.WidgetGroupLayout{{
+		Layout:        &sheetmodels.Layout{X: 0, Y: 0, W: 6, H: 4},
+		DefaultWidget: &widgetInstanceId,
+		WidgetGroup:   []uuid.UUID{widgetInstanceId},
+	}}
+	validFilter := sheetmodels.NativeFilterConfig{
+		Id:             "region",
+		Name:           "Region",
+		WidgetsInScope: []string{widgetInstanceId.String(), "all"},
+		Targets:        []sheetmodels.FilterTarget{{DatasetId: datasetId, Column: "region"}},
+	}
+	validVariables := []sheetmodels.SheetVariable{
+		{Name: "region", Type: sheetmodels.VariableTypeEnum, DefaultValue: "EMEA", Source: &sheetmodels.VariableSource{DatasetId: datasetId, Column: "region"}},
+		{Name: "min_amount", Type: sheetmodels.VariableTypeNumber, DefaultValue: "1000"},
+		{Name: "cutoff", Type: sheetmodels.VariableTypeDate, DefaultValue: "2024-01-01"},
+	}
+
+	tests := []struct {
+		name    string
+		config  sheetmodels.SheetConfig
+		wantErr bool
+	}{
+		{
+			name:   "valid config",
+			config: sheetmodels.SheetConfig{Version: "1.0", SheetLayout: validLayout, NativeFilterConfig: []sheetmodels.NativeFilterConfig{validFilter}},
+		},
+		{
+			name:    "missing version",
+			config:  sheetmodels.SheetConfig{},
+			wantErr: true,
+		},
+		{
+			name:    "empty layout group",
+			config:  sheetmodels.SheetConfig{Version: "1.0", SheetLayout: []sheetmodels.WidgetGroupLayout{{}}},
+			wantErr: true,
+		},
+		{
+			name: "layout group without a size",
+			config: sheetmodels.SheetConfig{Version: "1.0", SheetLayout: []sheetmodels.WidgetGroupLayout{{
+				Layout:      &sheetmodels.Layout{W: 0, H: 4},
+				WidgetGroup: []uuid.UUID{widgetInstanceId},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "layout group at a negative position",
+			config: sheetmodels.SheetConfig{Version: "1.0", SheetLayout: []sheetmodels.WidgetGroupLayout{{
+				Layout:      &sheetmodels.Layout{X: -1, W: 6, H: 4},
+				WidgetGroup: []uuid.UUID{widgetInstanceId},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "widget instance of another sheet",
+			config: sheetmodels.SheetConfig{Version: "1.0", SheetLayout: []sheetmodels.WidgetGroupLayout{{
+				WidgetGroup: []uuid.UUID{uuid.New()},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "default widget outside its group",
+			config: sheetmodels.SheetConfig{Version: "1.0", SheetLayout: []sheetmodels.WidgetGroupLayout{{
+				DefaultWidget: &otherWidgetInstanceId,
+				WidgetGroup:   []uuid.UUID{widgetInstanceId},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicated filter id",
+			config:  sheetmodels.SheetConfig{Version: "1.0", NativeFilterConfig: []sheetmodels.NativeFilterConfig{validFilter, validFilter}},
+			wantErr: true,
+		},
+		{
+			name:    "filter without a name",
+			config:  sheetmodels.SheetConfig{Version: "1.0", NativeFilterConfig: []sheetmodels.NativeFilterConfig{{Id: "region"}}},
+			wantErr: true,
+		},
+		{
+			name: "filter target without a column",
+			config: sheetmodels.SheetConfig{Version: "1.0", NativeFilterConfig: []sheetmodels.NativeFilterConfig{{
+				Id:      "region",
+				Name:    "Region",
+				Targets: []sheetmodels.FilterTarget{{DatasetId: datasetId}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:   "variables",
+			config: sheetmodels.SheetConfig{Version: "1.0", Variables: validVariables},
+		},
+		{
+			name:    "duplicated variable name",
+			config:  sheetmodels.SheetConfig{Version: "1.0", Variables: []sheetmodels.SheetVariable{validVariables[1], validVariables[1]}},
+			wantErr: true,
+		},
+		{
+			name:    "variable named like a date parameter",
+			config:  sheetmodels.SheetConfig{Version: "1.0", Variables: []sheetmodels.SheetVariable{{Name: "today", Type: sheetmodels.VariableTypeDate, DefaultValue: "2024-01-01"}}},
+			wantErr: true,
+		},
+		{
+			name:    "variable name widgets cannot reference",
+			config:  sheetmodels.SheetConfig{Version: "1.0", Variables: []sheetmodels.SheetVariable{{Name: "min amount", Type: sheetmodels.VariableTypeNumber, DefaultValue: "1"}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown variable type",
+			config:  sheetmodels.SheetConfig{Version: "1.0", Variables: []sheetmodels.SheetVariable{{Name: "flag", Type: "boolean", DefaultValue: "true"}}},
+			wantErr: true,
+		},
+		{
+			name:    "enum variable without a source",
+			config:  sheetmodels.SheetConfig{Version: "1.0", Variables: []sheetmodels.SheetVariable{{Name: "region", Type: sheetmodels.VariableTypeEnum, DefaultValue: "EMEA"}}},
+			wantErr: true,
+		},
+		{
+			name:    "default value of another type",
+			config:  sheetmodels.SheetConfig{Version: "1.0", Variables: []sheetmodels.SheetVariable{{Name: "min_amount", Type: sheetmodels.VariableTypeNumber, DefaultValue: "many"}}},
+			wantErr: true,
+		},
+		{
+			name:    "variable without a default value",
+			config:  sheetmodels.SheetConfig{Version: "1.0", Variables: []sheetmodels.SheetVariable{{Name: "cutoff", Type: sheetmodels.VariableTypeDate}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSheetConfig(tt.config, []uuid.UUID{widgetInstanceId, otherWidgetInstanceId})
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSheetConfig)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -10,8 +10,11 @@ import (
 	"time"
 
 	datasetsconstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/pages"
 	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
 	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	mock_datasetsService "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
 	mock_store "github.com/Zampfi/application-platform/services/api/mocks/db/store"
 	mockcache "github.com/Zampfi/application-platform/services/api/mocks/pkg/cache"
@@ -19,6 +22,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 func setupTest(t *testing.T) (SheetsService, *mock_store.MockStore, context.Context) {
@@ -373,6 +377,34 @@ func TestGetSheetFilterConfigByIdFromDB(t *testing.T) {
 	}
 }
 
+func TestGetSheetFilterConfigFromDBWithVariables(t *testing.T) {
+	orgId := uuid.New()
+	datasetId := uuid.New()
+	region := sheetmodels.SheetVariable{
+		Name:         "region",
+		Type:         sheetmodels.VariableTypeEnum,
+		DefaultValue: "EMEA",
+		Source:       &sheetmodels.VariableSource{DatasetId: datasetId, Column: "region"},
+	}
+	minAmount := sheetmodels.SheetVariable{Name: "min_amount", Type: sheetmodels.VariableTypeNumber, DefaultValue: "1000"}
+
+	mockDatasetService := mock_datasetsService.NewMockDatasetService(t)
+	mockDatasetService.EXPECT().GetOptionsForColumn(mock.Anything, orgId, datasetId.String(), "region", datasetsconstants.FilterTypeSelect, false).
+		Return([]interface{}{"EMEA", "APAC"}, nil)
+	service := NewSheetsService(mock_store.NewMockStore(t), mockDatasetService, mockcache.NewMockCacheClient(t))
+
+	config, err := service.getSheetFilterConfigFromDB(context.Background(), orgId, sheetmodels.Sheet{
+		ID:          uuid.New(),
+		SheetConfig: sheetmodels.SheetConfig{Version: "1.0", Variables: []sheetmodels.SheetVariable{region, minAmount}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []sheetmodels.VariableOptionsModel{
+		{SheetVariable: region, Options: []interface{}{"EMEA", "APAC"}},
+		{SheetVariable: minAmount},
+	}, config.Variables)
+}
+
 func TestCreateSheet(t *testing.T) {
 	t.Parallel()
 
@@ -384,6 +416,7 @@ func TestCreateSheet(t *testing.T) {
 		PageId: pageId,
 		Name:   "Test Sheet",
 	}
+	adminPolicies := []models.FlattenedResourceAudiencePolicy{{ResourceId: pageId}}
 
 	tests := []struct {
 		name      string
@@ -405,7 +438,10 @@ func TestCreateSheet(t *testing.T) {
 				Name:   "Test Sheet",
 			},
 			mockSetup: func(m *mock_store.MockStore) {
-				m.EXPECT().CreateSheet(mock.Anything, mock.Anything).Return(&testSheetDB, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(adminPolicies, nil)
+				m.EXPECT().CreateSheet(mock.Anything, mock.MatchedBy(func(sheet models.Sheet) bool {
+					return string(sheet.SheetConfig) == `{"version":"1.0","native_filter_config":null,"sheet_layout":null}`
+				})).Return(&testSheetDB, nil)
 			},
 			wantErr: false,
 		},
@@ -418,10 +454,44 @@ func TestCreateSheet(t *testing.T) {
 			},
 			want: nil,
 			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(adminPolicies, nil)
 				m.EXPECT().CreateSheet(mock.Anything, mock.Anything).Return(nil, errors.New("test error"))
 			},
 			wantErr: true,
 		},
+		{
+			name: "empty name",
+			input: sheetmodels.Sheet{
+				PageId: pageId,
+				Name:   "  ",
+			},
+			mockSetup: func(m *mock_store.MockStore) {},
+			wantErr:   true,
+		},
+		{
+			name: "layout of widget instances not on the sheet",
+			input: sheetmodels.Sheet{
+				PageId: pageId,
+				Name:   "Test Sheet",
+				SheetConfig: sheetmodels.SheetConfig{
+					Version:     "1.0",
+					SheetLayout: []sheetmodels.WidgetGroupLayout{{WidgetGroup: []uuid.UUID{uuid.New()}}},
+				},
+			},
+			mockSetup: func(m *mock_store.MockStore) {},
+			wantErr:   true,
+		},
+		{
+			name: "not a page admin",
+			input: sheetmodels.Sheet{
+				PageId: pageId,
+				Name:   "Test Sheet",
+			},
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]models.FlattenedResourceAudiencePolicy{}, nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -429,7 +499,7 @@ func TestCreateSheet(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			ctx := context.Background()
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()})
 
 			mockStore := mock_store.NewMockStore(t)
 			tt.mockSetup(mockStore)
@@ -462,13 +532,15 @@ func TestUpdateSheet(t *testing.T) {
 		Description: ptr("Original description"),
 		SheetConfig: json.RawMessage(`{"version": "1.0"}`),
 	}
+	adminPolicies := []models.FlattenedResourceAudiencePolicy{{ResourceId: pageId}}
 
 	tests := []struct {
-		name      string
-		input     *sheetmodels.Sheet
-		want      *models.Sheet
-		mockSetup func(*mock_store.MockStore)
-		wantErr   bool
+		name                 string
+		input                *sheetmodels.Sheet
+		want                 *models.Sheet
+		mockSetup            func(*mock_store.MockStore)
+		wantCacheInvalidated bool
+		wantErr              bool
 	}{
 		{
 			name: "successful update all fields",
@@ -488,6 +560,7 @@ func TestUpdateSheet(t *testing.T) {
 			},
 			mockSetup: func(m *mock_store.MockStore) {
 				m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(existingSheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(adminPolicies, nil).Times(2)
 				m.EXPECT().UpdateSheet(mock.Anything, mock.Anything).Return(&models.Sheet{
 					ID:          sheetID,
 					PageId:      newPageId,
@@ -496,7 +569,8 @@ func TestUpdateSheet(t *testing.T) {
 					SheetConfig: json.RawMessage(`{"version":"2.0"}`),
 				}, nil)
 			},
-			wantErr: false,
+			wantCacheInvalidated: true,
+			wantErr:              false,
 		},
 		{
 			name: "partial update",
@@ -513,6 +587,7 @@ func TestUpdateSheet(t *testing.T) {
 			},
 			mockSetup: func(m *mock_store.MockStore) {
 				m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(existingSheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(adminPolicies, nil)
 				m.EXPECT().UpdateSheet(mock.Anything, mock.Anything).Return(&models.Sheet{
 					ID:          sheetID,
 					PageId:      pageId,
@@ -544,10 +619,38 @@ func TestUpdateSheet(t *testing.T) {
 			want: nil,
 			mockSetup: func(m *mock_store.MockStore) {
 				m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(existingSheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(adminPolicies, nil)
 				m.EXPECT().UpdateSheet(mock.Anything, mock.Anything).Return(nil, errors.New("update failed"))
 			},
 			wantErr: true,
 		},
+		{
+			name: "not a page admin",
+			input: &sheetmodels.Sheet{
+				ID:   sheetID,
+				Name: "Updated Sheet",
+			},
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(existingSheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]models.FlattenedResourceAudiencePolicy{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "filter scoped to a widget instance not on the sheet",
+			input: &sheetmodels.Sheet{
+				ID: sheetID,
+				SheetConfig: sheetmodels.SheetConfig{
+					Version:            "1.0",
+					NativeFilterConfig: []sheetmodels.NativeFilterConfig{{Id: "f1", Name: "Region", WidgetsInScope: []string{uuid.NewString()}}},
+				},
+			},
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(existingSheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(adminPolicies, nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -555,11 +658,17 @@ func TestUpdateSheet(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			ctx := context.Background()
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()})
 			mockStore := mock_store.NewMockStore(t)
 			tt.mockSetup(mockStore)
 
-			service := &sheetsService{store: mockStore}
+			mockCache := mockcache.NewMockCacheClient(t)
+			if tt.wantCacheInvalidated {
+				mockCache.EXPECT().FormatKey("sheet_filter_config", sheetID.String()).Return("sheet_filter_config:"+sheetID.String(), nil)
+				mockCache.EXPECT().Delete(mock.Anything, "sheet_filter_config:"+sheetID.String()).Return(nil)
+			}
+
+			service := &sheetsService{store: mockStore, cacheClient: mockCache}
 			got, err := service.UpdateSheet(ctx, tt.input)
 
 			if tt.wantErr {
@@ -766,3 +875,208 @@ func TestGetSheetFilterConfigById(t *testing.T) {
 func ptr(s string) *string {
 	return &s
 }
+
+func TestPopulateOptionLabels(t *testing.T) {
+	tests := []struct {
+		name      string
+		filters   []sheetmodels.FilterOptionsModel
+		mockSetup func(*mock_store.MockStore)
+		want      []map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "labels countries without loading banks",
+			filters: []sheetmodels.FilterOptionsModel{
+				{Id: "country", DataType: "country", Options: []interface{}{"IND", "XYZ"}},
+				{Id: "status", DataType: "string", Options: []interface{}{"IND"}},
+			},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			want:      []map[string]string{{"IND": "India"}, nil},
+		},
+		{
+			name: "labels shared banks and banks of the organization",
+			filters: []sheetmodels.FilterOptionsModel{
+				{Id: "bank", DataType: "bank", Options: []interface{}{"CRB", "ACME", "XYZ"}},
+			},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetReferenceBanks(mock.Anything).Return([]models.ReferenceBank{{Code: "ACME", Name: "Acme Bank"}}, nil)
+			},
+			want: []map[string]string{{"CRB": "Cross River Bank", "ACME": "Acme Bank"}},
+		},
+		{
+			name: "store error",
+			filters: []sheetmodels.FilterOptionsModel{
+				{Id: "bank", DataType: "bank", Options: []interface{}{"CRB"}},
+			},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetReferenceBanks(mock.Anything).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			tt.mockSetup(mockStore)
+
+			service := &sheetsService{store: mockStore}
+			err := service.populateOptionLabels(context.Background(), tt.filters)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			for i, want := range tt.want {
+				if want == nil {
+					assert.Nil(t, tt.filters[i].OptionLabels)
+					continue
+				}
+				assert.Equal(t, want, tt.filters[i].OptionLabels)
+			}
+		})
+	}
+}
+
+func TestDuplicateSheet(t *testing.T) {
+	t.Parallel()
+
+	pageId := uuid.New()
+	sheetId := uuid.New()
+	duplicatedSheetId := uuid.New()
+	adminPolicies := []models.FlattenedResourceAudiencePolicy{{ResourceId: pageId}}
+
+	sourceSheet := &models.Sheet{
+		ID:          sheetId,
+		PageId:      pageId,
+		Name:        "Summary",
+		SheetConfig: json.RawMessage(`{"version":"1.0"}`),
+	}
+
+	tests := []struct {
+		name      string
+		copyName  string
+		mockSetup func(*mock_store.MockStore)
+		wantName  string
+		wantErr   error
+	}{
+		{
+			name: "defaults the name of the copy",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(sourceSheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(adminPolicies, nil)
+				m.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+					return fn(m)
+				})
+				m.EXPECT().CreateSheet(mock.Anything, mock.MatchedBy(func(sheet models.Sheet) bool {
+					return sheet.PageId == pageId && sheet.Name == "Summary (copy)"
+				})).Return(&models.Sheet{ID: duplicatedSheetId, PageId: pageId, Name: "Summary (copy)"}, nil)
+				m.EXPECT().UpdateSheet(mock.Anything, mock.Anything).Return(&models.Sheet{ID: duplicatedSheetId, PageId: pageId, Name: "Summary (copy)"}, nil)
+			},
+			wantName: "Summary (copy)",
+		},
+		{
+			name:     "not a page admin",
+			copyName: "Summary 2",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(sourceSheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			wantErr: pages.ErrPageAccessForbidden,
+		},
+		{
+			name: "sheet not found",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: ErrSheetNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, mockStore, ctx := setupTest(t)
+			tt.mockSetup(mockStore)
+
+			ctx = apicontext.AddAuthToContext(ctx, "user", uuid.New(), []uuid.UUID{uuid.New()})
+			sheet, err := service.DuplicateSheet(ctx, sheetId, tt.copyName)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, sheet.Name)
+		})
+	}
+}
+
+func TestDeleteSheet(t *testing.T) {
+	t.Parallel()
+
+	pageId := uuid.New()
+	sheetId := uuid.New()
+	sheet := &models.Sheet{ID: sheetId, PageId: pageId}
+
+	tests := []struct {
+		name                 string
+		mockSetup            func(*mock_store.MockStore)
+		wantCacheInvalidated bool
+		wantErr              error
+	}{
+		{
+			name: "success",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(sheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]models.FlattenedResourceAudiencePolicy{{ResourceId: pageId}}, nil)
+				m.EXPECT().DeleteSheet(mock.Anything, sheetId).Return(nil)
+			},
+			wantCacheInvalidated: true,
+		},
+		{
+			name: "already deleted",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(&models.Sheet{ID: sheetId, DeletedAt: &time.Time{}}, nil)
+			},
+			wantErr: ErrSheetNotFound,
+		},
+		{
+			name: "not a page admin",
+			mockSetup: func(m *mock_store.MockStore) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(sheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			wantErr: pages.ErrPageAccessForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStore := mock_store.NewMockStore(t)
+			mockCacheService := mockcache.NewMockCacheClient(t)
+			service := NewSheetsService(mockStore, mock_datasetsService.NewMockDatasetService(t), mockCacheService)
+			tt.mockSetup(mockStore)
+			if tt.wantCacheInvalidated {
+				mockCacheService.EXPECT().FormatKey("sheet_filter_config", sheetId.String()).Return("sheet_filter_config:"+sheetId.String(), nil)
+				mockCacheService.EXPECT().Delete(mock.Anything, "sheet_filter_config:"+sheetId.String()).Return(nil)
+			}
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()})
+			err := service.DeleteSheet(ctx, sheetId)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package errors
+
+import "errors"
+
+const (
+	ErrTagNotFoundMessage        = "ERR_TAG_NOT_FOUND"
+	ErrTagParentNotFoundMessage  = "ERR_TAG_PARENT_NOT_FOUND"
+	ErrEmptyTagNameMessage       = "ERR_EMPTY_TAG_NAME"
+	ErrInvalidTagNameMessage     = "ERR_INVALID_TAG_NAME"
+	ErrInvalidTagColorMessage    = "ERR_INVALID_TAG_COLOR"
+	ErrInvalidTagAliasMessage    = "ERR_INVALID_TAG_ALIAS"
+	ErrTagExistsMessage          = "ERR_TAG_EXISTS"
+	ErrTagHasChildrenMessage     = "ERR_TAG_HAS_CHILDREN"
+	ErrInvalidTagMergeMessage    = "ERR_INVALID_TAG_MERGE"
+	ErrTagAccessForbiddenMessage = "ERR_TAG_ACCESS_FORBIDDEN"
+)
+
+var (
+	ErrTagNotFound        = errors.New(ErrTagNotFoundMessage)
+	ErrTagParentNotFound  = errors.New(ErrTagParentNotFoundMessage)
+	ErrEmptyTagName       = errors.New(ErrEmptyTagNameMessage)
+	ErrInvalidTagName     = errors.New(ErrInvalidTagNameMessage)
+	ErrInvalidTagColor    = errors.New(ErrInvalidTagColorMessage)
+	ErrInvalidTagAlias    = errors.New(ErrInvalidTagAliasMessage)
+	ErrTagExists          = errors.New(ErrTagExistsMessage)
+	ErrTagHasChildren     = errors.New(ErrTagHasChildrenMessage)
+	ErrInvalidTagMerge    = errors.New(ErrInvalidTagMergeMessage)
+	ErrTagAccessForbidden = errors.New(ErrTagAccessForbiddenMessage)
+)
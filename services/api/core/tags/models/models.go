@@ -0,0 +1,91 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type Tag struct {
+	ID          uuid.UUID
+	ParentId    *uuid.UUID
+	Name        string
+	Path        string
+	Color       *string
+	Description *string
+	Aliases     []string
+	CreatedBy   uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type TagParams struct {
+	ParentId    *uuid.UUID
+	Name        string
+	Color       *string
+	Description *string
+	Aliases     []string
+}
+
+type TagUpdateParams struct {
+	Color       *string
+	Description *string
+	Aliases     []string
+}
+
+// TagRewrite is a value tags columns of datasets hold that a rename or merge replaced
+type TagRewrite struct {
+	From string
+	To   string
+}
+
+// TagRewriteFailure is a value of a dataset column the rewrite of which failed, the value still resolves to the tag
+// through its aliases and can be rewritten again
+type TagRewriteFailure struct {
+	DatasetId uuid.UUID
+	Column    string
+	From      string
+	To        string
+	Error     string
+}
+
+// TagRewriteResult lists the dataset actions rewriting the values a rename or merge replaced, one per dataset column
+// holding tags and rewritten value, and the rewrites that failed
+type TagRewriteResult struct {
+	Tag              Tag
+	Rewrites         []TagRewrite
+	DatasetActionIds []string
+	Failures         []TagRewriteFailure
+}
+
+func (t *Tag) FromSchema(schema dbmodels.Tag) error {
+	aliases, err := UnmarshalAliases(schema.Aliases)
+	if err != nil {
+		return err
+	}
+
+	t.ID = schema.ID
+	t.ParentId = schema.ParentId
+	t.Name = schema.Name
+	t.Path = schema.Path
+	t.Color = schema.Color
+	t.Description = schema.Description
+	t.Aliases = aliases
+	t.CreatedBy = schema.CreatedBy
+	t.CreatedAt = schema.CreatedAt
+	t.UpdatedAt = schema.UpdatedAt
+	return nil
+}
+
+func UnmarshalAliases(aliases json.RawMessage) ([]string, error) {
+	values := []string{}
+	if len(aliases) == 0 {
+		return values, nil
+	}
+	if err := json.Unmarshal(aliases, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"strings"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+)
+
+// TagPathSeparator separates the levels of a tag path, the widgets flatten tags into one column per level on it
+const TagPathSeparator = "."
+
+// Taxonomy resolves the values users write into tags columns to the paths of the tags of an organization
+type Taxonomy struct {
+	paths []string
+	index map[string]string
+}
+
+func NewTaxonomy(tags []dbmodels.Tag) (Taxonomy, error) {
+	taxonomy := Taxonomy{
+		paths: make([]string, 0, len(tags)),
+		index: make(map[string]string, len(tags)),
+	}
+
+	// paths are indexed after the aliases so an alias never shadows the path of another tag
+	for _, tag := range tags {
+		aliases, err := UnmarshalAliases(tag.Aliases)
+		if err != nil {
+			return Taxonomy{}, err
+		}
+		for _, alias := range aliases {
+			taxonomy.index[TagKey(alias)] = tag.Path
+		}
+	}
+	for _, tag := range tags {
+		taxonomy.paths = append(taxonomy.paths, tag.Path)
+		taxonomy.index[TagKey(tag.Path)] = tag.Path
+	}
+
+	return taxonomy, nil
+}
+
+// Resolve returns the path of the tag the value is the path or an alias of, ignoring case and the spaces around and
+// within levels
+func (t Taxonomy) Resolve(value string) (string, bool) {
+	path, ok := t.index[TagKey(value)]
+	return path, ok
+}
+
+func (t Taxonomy) Paths() []string {
+	return t.paths
+}
+
+// IsEmpty is true for organizations without tags, their tags columns keep accepting any value
+func (t Taxonomy) IsEmpty() bool {
+	return len(t.paths) == 0
+}
+
+// TagKey is the form two spellings of a tag share, "Expenses . PAYROLL " and "expenses.payroll" have the same key
+func TagKey(value string) string {
+	levels := strings.Split(value, TagPathSeparator)
+	for i, level := range levels {
+		levels[i] = strings.ToLower(strings.Join(strings.Fields(level), " "))
+	}
+	return strings.Join(levels, TagPathSeparator)
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxonomyResolve(t *testing.T) {
+	taxonomy, err := NewTaxonomy([]dbmodels.Tag{
+		{Path: "Expenses"},
+		{Path: "Expenses.Payroll", Aliases: json.RawMessage(`["Salaries", "Expenses.Wages"]`)},
+		{Path: "Salaries"},
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		value  string
+		want   string
+		wantOk bool
+	}{
+		{value: "Expenses.Payroll", want: "Expenses.Payroll", wantOk: true},
+		{value: " expenses . PAYROLL ", want: "Expenses.Payroll", wantOk: true},
+		{value: "expenses.wages", want: "Expenses.Payroll", wantOk: true},
+		{value: "salaries", want: "Salaries", wantOk: true},
+		{value: "Expenses.Rent", wantOk: false},
+		{value: "", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := taxonomy.Resolve(tt.value)
+		assert.Equal(t, tt.wantOk, ok, tt.value)
+		assert.Equal(t, tt.want, got, tt.value)
+	}
+
+	assert.Equal(t, []string{"Expenses", "Expenses.Payroll", "Salaries"}, taxonomy.Paths())
+	assert.False(t, taxonomy.IsEmpty())
+}
+
+func TestNewTaxonomy_InvalidAliases(t *testing.T) {
+	_, err := NewTaxonomy([]dbmodels.Tag{{Path: "Expenses", Aliases: json.RawMessage(`{"alias": "x"}`)}})
+	assert.Error(t, err)
+
+	taxonomy, err := NewTaxonomy(nil)
+	assert.NoError(t, err)
+	assert.True(t, taxonomy.IsEmpty())
+}
+
+func TestTagKey(t *testing.T) {
+	assert.Equal(t, "payroll", TagKey("Payroll"))
+	assert.Equal(t, "payroll", TagKey("payroll "))
+	assert.Equal(t, "payroll", TagKey("PAYROLL"))
+	assert.Equal(t, "expenses.travel and meals", TagKey(" Expenses .Travel  and Meals"))
+}
@@ -0,0 +1,590 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	dataplatformconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	"github.com/Zampfi/application-platform/services/api/core/tags/errors"
+	"github.com/Zampfi/application-platform/services/api/core/tags/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+type TagServiceStore interface {
+	store.TagStore
+	store.DatasetStore
+	store.OrganizationStore
+}
+
+type TagService interface {
+	GetTags(ctx context.Context) ([]models.Tag, error)
+	CreateTag(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.TagParams) (models.Tag, error)
+	UpdateTag(ctx context.Context, userId uuid.UUID, tagId uuid.UUID, params models.TagUpdateParams) (models.Tag, error)
+	DeleteTag(ctx context.Context, userId uuid.UUID, tagId uuid.UUID) error
+	RenameTag(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, tagId uuid.UUID, name string) (models.TagRewriteResult, error)
+	MergeTags(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, sourceTagId uuid.UUID, targetTagId uuid.UUID) (models.TagRewriteResult, error)
+}
+
+type tagService struct {
+	store          TagServiceStore
+	datasetService datasetservice.DatasetService
+}
+
+func NewTagService(appStore store.Store, datasetService datasetservice.DatasetService) *tagService {
+	return &tagService{store: appStore, datasetService: datasetService}
+}
+
+func (s *tagService) GetTags(ctx context.Context) ([]models.Tag, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeTags, err := s.store.GetTags(ctx)
+	if err != nil {
+		logger.Error("failed to get tags", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	tags := make([]models.Tag, len(storeTags))
+	for i, storeTag := range storeTags {
+		if err := tags[i].FromSchema(storeTag); err != nil {
+			return nil, err
+		}
+	}
+
+	return tags, nil
+}
+
+// CreateTag adds a tag under the parent tag or at the root of the taxonomy. Neither its path nor its aliases may be
+// another spelling of the path or an alias of an existing tag.
+func (s *tagService) CreateTag(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.TagParams) (models.Tag, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.ensureOrganizationAdmin(ctx, orgId, userId); err != nil {
+		return models.Tag{}, err
+	}
+
+	name, err := validateTagName(params.Name)
+	if err != nil {
+		return models.Tag{}, err
+	}
+	if err := validateTagColor(params.Color); err != nil {
+		return models.Tag{}, err
+	}
+	aliases, err := validateTagAliases(params.Aliases)
+	if err != nil {
+		return models.Tag{}, err
+	}
+
+	storeTags, err := s.store.GetTags(ctx)
+	if err != nil {
+		logger.Error("failed to get tags", zap.String("error", err.Error()))
+		return models.Tag{}, err
+	}
+
+	path := name
+	if params.ParentId != nil {
+		parent, ok := findTag(storeTags, *params.ParentId)
+		if !ok {
+			return models.Tag{}, errors.ErrTagParentNotFound
+		}
+		path = parent.Path + models.TagPathSeparator + name
+	}
+
+	if err := checkTagConflicts(storeTags, nil, append([]string{path}, aliases...)); err != nil {
+		return models.Tag{}, err
+	}
+
+	storeTag, err := s.store.CreateTag(ctx, storemodels.CreateTagParams{
+		OrganizationId: orgId,
+		ParentId:       params.ParentId,
+		Name:           name,
+		Path:           path,
+		Color:          params.Color,
+		Description:    params.Description,
+		Aliases:        aliases,
+		CreatedBy:      userId,
+	})
+	if err != nil {
+		logger.Error("failed to create tag", zap.String("path", path), zap.String("error", err.Error()))
+		return models.Tag{}, err
+	}
+
+	tag := models.Tag{}
+	if err := tag.FromSchema(storeTag); err != nil {
+		return models.Tag{}, err
+	}
+	return tag, nil
+}
+
+func (s *tagService) UpdateTag(ctx context.Context, userId uuid.UUID, tagId uuid.UUID, params models.TagUpdateParams) (models.Tag, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := validateTagColor(params.Color); err != nil {
+		return models.Tag{}, err
+	}
+	aliases, err := validateTagAliases(params.Aliases)
+	if err != nil {
+		return models.Tag{}, err
+	}
+
+	storeTags, err := s.store.GetTags(ctx)
+	if err != nil {
+		logger.Error("failed to get tags", zap.String("error", err.Error()))
+		return models.Tag{}, err
+	}
+	existingTag, ok := findTag(storeTags, tagId)
+	if !ok {
+		return models.Tag{}, errors.ErrTagNotFound
+	}
+	if err := s.ensureOrganizationAdmin(ctx, existingTag.OrganizationId, userId); err != nil {
+		return models.Tag{}, err
+	}
+	if err := checkTagConflicts(storeTags, []uuid.UUID{tagId}, aliases); err != nil {
+		return models.Tag{}, err
+	}
+
+	storeTag, err := s.store.UpdateTag(ctx, tagId, storemodels.UpdateTagParams{
+		Color:       params.Color,
+		Description: params.Description,
+		Aliases:     aliases,
+		UpdatedBy:   userId,
+	})
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return models.Tag{}, errors.ErrTagNotFound
+		}
+		logger.Error("failed to update tag", zap.String("tagId", tagId.String()), zap.String("error", err.Error()))
+		return models.Tag{}, err
+	}
+
+	tag := models.Tag{}
+	if err := tag.FromSchema(storeTag); err != nil {
+		return models.Tag{}, err
+	}
+	return tag, nil
+}
+
+// DeleteTag removes a tag without children, values datasets hold are left as they are
+func (s *tagService) DeleteTag(ctx context.Context, userId uuid.UUID, tagId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeTags, err := s.store.GetTags(ctx)
+	if err != nil {
+		logger.Error("failed to get tags", zap.String("error", err.Error()))
+		return err
+	}
+	tag, ok := findTag(storeTags, tagId)
+	if !ok {
+		return errors.ErrTagNotFound
+	}
+	if err := s.ensureOrganizationAdmin(ctx, tag.OrganizationId, userId); err != nil {
+		return err
+	}
+	if len(getTagDescendants(storeTags, tag)) > 0 {
+		return errors.ErrTagHasChildren
+	}
+
+	if err := s.store.DeleteTag(ctx, tagId, userId); err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.ErrTagNotFound
+		}
+		logger.Error("failed to delete tag", zap.String("tagId", tagId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// RenameTag renames a tag, moving its descendants with it, and rewrites the values of tags columns holding the old
+// paths. The old path of the tag is kept as an alias so values written with it still resolve.
+func (s *tagService) RenameTag(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, tagId uuid.UUID, name string) (models.TagRewriteResult, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.ensureOrganizationAdmin(ctx, orgId, userId); err != nil {
+		return models.TagRewriteResult{}, err
+	}
+
+	name, err := validateTagName(name)
+	if err != nil {
+		return models.TagRewriteResult{}, err
+	}
+
+	storeTags, err := s.store.GetTags(ctx)
+	if err != nil {
+		logger.Error("failed to get tags", zap.String("error", err.Error()))
+		return models.TagRewriteResult{}, err
+	}
+	tag, ok := findTag(storeTags, tagId)
+	if !ok {
+		return models.TagRewriteResult{}, errors.ErrTagNotFound
+	}
+
+	path := name
+	if index := strings.LastIndex(tag.Path, models.TagPathSeparator); index >= 0 {
+		path = tag.Path[:index+1] + name
+	}
+
+	descendants := getTagDescendants(storeTags, tag)
+	rewrites := []models.TagRewrite{{From: tag.Path, To: path}}
+	movedTagIds := []uuid.UUID{tag.ID}
+	for _, descendant := range descendants {
+		rewrites = append(rewrites, models.TagRewrite{From: descendant.Path, To: path + strings.TrimPrefix(descendant.Path, tag.Path)})
+		movedTagIds = append(movedTagIds, descendant.ID)
+	}
+
+	newPaths := make([]string, len(rewrites))
+	for i, rewrite := range rewrites {
+		newPaths[i] = rewrite.To
+	}
+	if err := checkTagConflicts(storeTags, movedTagIds, newPaths); err != nil {
+		return models.TagRewriteResult{}, err
+	}
+
+	aliases, err := models.UnmarshalAliases(tag.Aliases)
+	if err != nil {
+		return models.TagRewriteResult{}, err
+	}
+	aliases = mergeTagAliases(path, aliases, []string{tag.Path})
+
+	columns, err := s.getTagsColumns(ctx, orgId)
+	if err != nil {
+		return models.TagRewriteResult{}, err
+	}
+
+	if err := s.store.RenameTag(ctx, tagId, name, path, aliases, userId); err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return models.TagRewriteResult{}, errors.ErrTagNotFound
+		}
+		logger.Error("failed to rename tag", zap.String("tagId", tagId.String()), zap.String("error", err.Error()))
+		return models.TagRewriteResult{}, err
+	}
+
+	return s.completeTagRewrite(ctx, orgId, userId, tagId, columns, rewrites)
+}
+
+// MergeTags folds the source tag into the target, the children of the source move under the target and its path and
+// aliases become aliases of the target. Values of tags columns holding the source or its descendants are rewritten.
+func (s *tagService) MergeTags(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, sourceTagId uuid.UUID, targetTagId uuid.UUID) (models.TagRewriteResult, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	if err := s.ensureOrganizationAdmin(ctx, orgId, userId); err != nil {
+		return models.TagRewriteResult{}, err
+	}
+
+	if sourceTagId == targetTagId {
+		return models.TagRewriteResult{}, errors.ErrInvalidTagMerge
+	}
+
+	storeTags, err := s.store.GetTags(ctx)
+	if err != nil {
+		logger.Error("failed to get tags", zap.String("error", err.Error()))
+		return models.TagRewriteResult{}, err
+	}
+	source, ok := findTag(storeTags, sourceTagId)
+	if !ok {
+		return models.TagRewriteResult{}, errors.ErrTagNotFound
+	}
+	target, ok := findTag(storeTags, targetTagId)
+	if !ok {
+		return models.TagRewriteResult{}, errors.ErrTagNotFound
+	}
+	if isTagDescendant(source, target) {
+		return models.TagRewriteResult{}, errors.ErrInvalidTagMerge
+	}
+
+	sourceAliases, err := models.UnmarshalAliases(source.Aliases)
+	if err != nil {
+		return models.TagRewriteResult{}, err
+	}
+	targetAliases, err := models.UnmarshalAliases(target.Aliases)
+	if err != nil {
+		return models.TagRewriteResult{}, err
+	}
+
+	rewrites := []models.TagRewrite{{From: source.Path, To: target.Path}}
+	for _, alias := range sourceAliases {
+		rewrites = append(rewrites, models.TagRewrite{From: alias, To: target.Path})
+	}
+
+	descendants := getTagDescendants(storeTags, source)
+	movedTagIds := []uuid.UUID{source.ID}
+	newPaths := []string{}
+	for _, descendant := range descendants {
+		newPath := target.Path + strings.TrimPrefix(descendant.Path, source.Path)
+		rewrites = append(rewrites, models.TagRewrite{From: descendant.Path, To: newPath})
+		movedTagIds = append(movedTagIds, descendant.ID)
+		newPaths = append(newPaths, newPath)
+	}
+	if err := checkTagConflicts(storeTags, movedTagIds, newPaths); err != nil {
+		return models.TagRewriteResult{}, err
+	}
+
+	aliases := mergeTagAliases(target.Path, targetAliases, append([]string{source.Path}, sourceAliases...))
+
+	columns, err := s.getTagsColumns(ctx, orgId)
+	if err != nil {
+		return models.TagRewriteResult{}, err
+	}
+
+	if err := s.store.MergeTags(ctx, sourceTagId, targetTagId, aliases, userId); err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return models.TagRewriteResult{}, errors.ErrTagNotFound
+		}
+		logger.Error("failed to merge tags", zap.String("sourceTagId", sourceTagId.String()), zap.String("targetTagId", targetTagId.String()), zap.String("error", err.Error()))
+		return models.TagRewriteResult{}, err
+	}
+
+	return s.completeTagRewrite(ctx, orgId, userId, targetTagId, columns, rewrites)
+}
+
+// completeTagRewrite rewrites the values of the tags columns once the tag was renamed or merged. A failing rewrite does
+// not fail the others, the values it left keep resolving through the aliases of the tag and it is returned to retry
+func (s *tagService) completeTagRewrite(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, tagId uuid.UUID, columns []tagsColumn, rewrites []models.TagRewrite) (models.TagRewriteResult, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	storeTag, err := s.store.GetTagById(ctx, tagId)
+	if err != nil {
+		logger.Error("failed to get tag", zap.String("tagId", tagId.String()), zap.String("error", err.Error()))
+		return models.TagRewriteResult{}, err
+	}
+
+	result := models.TagRewriteResult{Rewrites: rewrites}
+	if err := result.Tag.FromSchema(storeTag); err != nil {
+		return models.TagRewriteResult{}, err
+	}
+
+	result.DatasetActionIds, result.Failures = s.rewriteDatasetTags(ctx, orgId, userId, columns, rewrites)
+
+	return result, nil
+}
+
+// ensureOrganizationAdmin lets the admins of the organization change its taxonomy, renames and merges rewrite the
+// values of every dataset of the organization
+func (s *tagService) ensureOrganizationAdmin(ctx context.Context, orgId uuid.UUID, userId uuid.UUID) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	policy, err := s.store.GetOrganizationPolicyByUser(ctx, orgId, userId)
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.ErrTagAccessForbidden
+		}
+		logger.Error("failed to get organization policy", zap.String("organizationId", orgId.String()), zap.String("error", err.Error()))
+		return err
+	}
+
+	if policy == nil || policy.Privilege != storemodels.PrivilegeOrganizationSystemAdmin {
+		return errors.ErrTagAccessForbidden
+	}
+
+	return nil
+}
+
+// tagsColumn is a column holding tags of a source dataset
+type tagsColumn struct {
+	datasetId uuid.UUID
+	column    string
+}
+
+// getTagsColumns returns the columns holding tags of the source datasets of the organization
+func (s *tagService) getTagsColumns(ctx context.Context, orgId uuid.UUID) ([]tagsColumn, error) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	datasets, err := s.store.GetDatasetsAll(ctx, storemodels.DatasetFilters{
+		OrganizationIds: []uuid.UUID{orgId},
+		Type:            []storemodels.DatasetType{storemodels.DatasetTypeSource},
+	})
+	if err != nil {
+		logger.Error("failed to get datasets", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	columns := []tagsColumn{}
+	for _, dataset := range datasets {
+		for _, column := range getDatasetTagsColumns(ctx, dataset) {
+			columns = append(columns, tagsColumn{datasetId: dataset.ID, column: column})
+		}
+	}
+
+	return columns, nil
+}
+
+// rewriteDatasetTags creates a dataset action per tags column and rewritten value, replacing the value in the rows
+// holding it, and returns the rewrites that failed
+func (s *tagService) rewriteDatasetTags(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, columns []tagsColumn, rewrites []models.TagRewrite) ([]string, []models.TagRewriteFailure) {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	actionIds := []string{}
+	failures := []models.TagRewriteFailure{}
+	for _, column := range columns {
+		for _, rewrite := range rewrites {
+			if rewrite.From == rewrite.To {
+				continue
+			}
+			action, err := s.datasetService.UpdateDatasetData(ctx, orgId, column.datasetId, datasetmodels.UpdateDatasetDataParams{
+				Filters: datasetmodels.FilterModel{
+					LogicalOperator: datasetmodels.LogicalOperator(querybuilderconstants.LogicalOperatorAnd),
+					Conditions: []datasetmodels.Filter{
+						{Column: column.column, Operator: querybuilderconstants.EqualOperator, Value: rewrite.From},
+					},
+				},
+				Update:     datasetmodels.UpdateColumn{Column: column.column, Value: rewrite.To},
+				SourceType: datasetConstants.UpdateColumnSourceTypeUser,
+				UserId:     userId,
+			})
+			if err != nil {
+				logger.Error("failed to rewrite dataset tags", zap.String("datasetId", column.datasetId.String()), zap.String("column", column.column), zap.String("from", rewrite.From), zap.String("error", err.Error()))
+				failures = append(failures, models.TagRewriteFailure{
+					DatasetId: column.datasetId,
+					Column:    column.column,
+					From:      rewrite.From,
+					To:        rewrite.To,
+					Error:     err.Error(),
+				})
+				continue
+			}
+			actionIds = append(actionIds, action.ActionId)
+		}
+	}
+
+	return actionIds, failures
+}
+
+func getDatasetTagsColumns(ctx context.Context, dataset storemodels.Dataset) []string {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	var metadata datasetmodels.DatasetMetadataConfig
+	if err := json.Unmarshal(dataset.Metadata, &metadata); err != nil {
+		logger.Warn("failed to unmarshal dataset metadata", zap.String("datasetId", dataset.ID.String()), zap.String("error", err.Error()))
+		return nil
+	}
+
+	columns := []string{}
+	for columnName, column := range metadata.Columns {
+		if column.CustomType == dataplatformconstants.DatabricksColumnCustomTypeTags {
+			columns = append(columns, columnName)
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+func validateTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.ErrEmptyTagName
+	}
+	if strings.Contains(name, models.TagPathSeparator) {
+		return "", errors.ErrInvalidTagName
+	}
+	return name, nil
+}
+
+func validateTagColor(color *string) error {
+	if color != nil && !tagColorPattern.MatchString(*color) {
+		return errors.ErrInvalidTagColor
+	}
+	return nil
+}
+
+// validateTagAliases trims the aliases and drops the spellings of an alias listed before
+func validateTagAliases(aliases []string) ([]string, error) {
+	values := []string{}
+	seen := map[string]bool{}
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		if models.TagKey(alias) == "" {
+			return nil, errors.ErrInvalidTagAlias
+		}
+		if seen[models.TagKey(alias)] {
+			continue
+		}
+		seen[models.TagKey(alias)] = true
+		values = append(values, alias)
+	}
+	return values, nil
+}
+
+// mergeTagAliases appends the added aliases that are not a spelling of the path or of an alias already listed
+func mergeTagAliases(path string, aliases []string, added []string) []string {
+	seen := map[string]bool{models.TagKey(path): true}
+	merged := []string{}
+	for _, alias := range append(append([]string{}, aliases...), added...) {
+		if seen[models.TagKey(alias)] {
+			continue
+		}
+		seen[models.TagKey(alias)] = true
+		merged = append(merged, alias)
+	}
+	return merged
+}
+
+// checkTagConflicts fails when a value is a spelling of the path or an alias of a tag other than the excluded ones
+func checkTagConflicts(tags []storemodels.Tag, excludedTagIds []uuid.UUID, values []string) error {
+	taken := map[string]bool{}
+	for _, tag := range tags {
+		if containsTagId(excludedTagIds, tag.ID) {
+			continue
+		}
+		taken[models.TagKey(tag.Path)] = true
+		aliases, err := models.UnmarshalAliases(tag.Aliases)
+		if err != nil {
+			return err
+		}
+		for _, alias := range aliases {
+			taken[models.TagKey(alias)] = true
+		}
+	}
+
+	for _, value := range values {
+		if taken[models.TagKey(value)] {
+			return errors.ErrTagExists
+		}
+	}
+	return nil
+}
+
+func findTag(tags []storemodels.Tag, tagId uuid.UUID) (storemodels.Tag, bool) {
+	for _, tag := range tags {
+		if tag.ID == tagId {
+			return tag, true
+		}
+	}
+	return storemodels.Tag{}, false
+}
+
+func getTagDescendants(tags []storemodels.Tag, tag storemodels.Tag) []storemodels.Tag {
+	descendants := []storemodels.Tag{}
+	for _, candidate := range tags {
+		if isTagDescendant(tag, candidate) {
+			descendants = append(descendants, candidate)
+		}
+	}
+	return descendants
+}
+
+func isTagDescendant(tag storemodels.Tag, candidate storemodels.Tag) bool {
+	return strings.HasPrefix(candidate.Path, tag.Path+models.TagPathSeparator)
+}
+
+func containsTagId(tagIds []uuid.UUID, tagId uuid.UUID) bool {
+	for _, id := range tagIds {
+		if id == tagId {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	tagErrors "github.com/Zampfi/application-platform/services/api/core/tags/errors"
+	"github.com/Zampfi/application-platform/services/api/core/tags/models"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mockDatasetService "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
+	mock_store "github.com/Zampfi/application-platform/services/api/mocks/db/store"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// expectOrganizationAdmin makes the user an admin of the organization
+func expectOrganizationAdmin(ms *mock_store.MockStore, orgId interface{}, userId interface{}) {
+	ms.EXPECT().GetOrganizationPolicyByUser(mock.Anything, orgId, userId).
+		Return(&storemodels.ResourceAudiencePolicy{Privilege: storemodels.PrivilegeOrganizationSystemAdmin}, nil)
+}
+
+func testTags() (storemodels.Tag, storemodels.Tag, storemodels.Tag) {
+	expenses := storemodels.Tag{ID: uuid.New(), Name: "Expenses", Path: "Expenses"}
+	payroll := storemodels.Tag{ID: uuid.New(), ParentId: &expenses.ID, Name: "Payroll", Path: "Expenses.Payroll", Aliases: json.RawMessage(`["Wages"]`)}
+	bonus := storemodels.Tag{ID: uuid.New(), ParentId: &payroll.ID, Name: "Bonus", Path: "Expenses.Payroll.Bonus"}
+	return expenses, payroll, bonus
+}
+
+func TestCreateTag(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	expenses, payroll, bonus := testTags()
+	color := "#1A2B3C"
+	invalidColor := "red"
+	missingParent := uuid.New()
+
+	tests := []struct {
+		name      string
+		params    models.TagParams
+		mockSetup func(*mock_store.MockStore)
+		wantErr   error
+	}{
+		{
+			name:   "Creates a child tag",
+			params: models.TagParams{ParentId: &expenses.ID, Name: " Rent ", Color: &color, Aliases: []string{"Office rent", "office RENT"}},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus}, nil)
+				ms.EXPECT().CreateTag(mock.Anything, mock.MatchedBy(func(params storemodels.CreateTagParams) bool {
+					return params.Name == "Rent" && params.Path == "Expenses.Rent" && params.OrganizationId == orgId &&
+						assert.ObjectsAreEqual([]string{"Office rent"}, params.Aliases)
+				})).Return(storemodels.Tag{ID: uuid.New(), Name: "Rent", Path: "Expenses.Rent"}, nil)
+			},
+		},
+		{
+			name:      "Rejects names holding the path separator",
+			params:    models.TagParams{Name: "Expenses.Rent"},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   tagErrors.ErrInvalidTagName,
+		},
+		{
+			name:      "Rejects empty names",
+			params:    models.TagParams{Name: "  "},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   tagErrors.ErrEmptyTagName,
+		},
+		{
+			name:      "Rejects invalid colors",
+			params:    models.TagParams{Name: "Rent", Color: &invalidColor},
+			mockSetup: func(ms *mock_store.MockStore) {},
+			wantErr:   tagErrors.ErrInvalidTagColor,
+		},
+		{
+			name:   "Rejects another spelling of an existing path",
+			params: models.TagParams{ParentId: &expenses.ID, Name: "payroll "},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus}, nil)
+			},
+			wantErr: tagErrors.ErrTagExists,
+		},
+		{
+			name:   "Rejects an alias of an existing tag",
+			params: models.TagParams{Name: "Salaries", Aliases: []string{"WAGES"}},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus}, nil)
+			},
+			wantErr: tagErrors.ErrTagExists,
+		},
+		{
+			name:   "Parent not found",
+			params: models.TagParams{ParentId: &missingParent, Name: "Rent"},
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses}, nil)
+			},
+			wantErr: tagErrors.ErrTagParentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			expectOrganizationAdmin(mockStore, orgId, userId)
+			tt.mockSetup(mockStore)
+
+			s := &tagService{store: mockStore}
+			tag, err := s.CreateTag(context.Background(), orgId, userId, tt.params)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "Expenses.Rent", tag.Path)
+		})
+	}
+}
+
+func TestDeleteTag(t *testing.T) {
+	userId := uuid.New()
+	expenses, payroll, bonus := testTags()
+
+	tests := []struct {
+		name      string
+		tagId     uuid.UUID
+		mockSetup func(*mock_store.MockStore)
+		wantErr   error
+	}{
+		{
+			name:  "Deletes a leaf tag",
+			tagId: bonus.ID,
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus}, nil)
+				ms.EXPECT().DeleteTag(mock.Anything, bonus.ID, userId).Return(nil)
+			},
+		},
+		{
+			name:  "Rejects tags with children",
+			tagId: payroll.ID,
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus}, nil)
+			},
+			wantErr: tagErrors.ErrTagHasChildren,
+		},
+		{
+			name:  "Tag not found",
+			tagId: bonus.ID,
+			mockSetup: func(ms *mock_store.MockStore) {
+				ms.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus}, nil)
+				ms.EXPECT().DeleteTag(mock.Anything, bonus.ID, userId).Return(gorm.ErrRecordNotFound)
+			},
+			wantErr: tagErrors.ErrTagNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			expectOrganizationAdmin(mockStore, bonus.OrganizationId, userId)
+			tt.mockSetup(mockStore)
+
+			s := &tagService{store: mockStore}
+			err := s.DeleteTag(context.Background(), userId, tt.tagId)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRenameTag(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	expenses, payroll, bonus := testTags()
+	datasetId := uuid.New()
+	dataset := storemodels.Dataset{
+		ID:       datasetId,
+		Metadata: json.RawMessage(`{"columns": {"category": {"custom_type": "tags"}, "amount": {"custom_type": "amount"}}}`),
+	}
+
+	mockStore := mock_store.NewMockStore(t)
+	mockDataset := mockDatasetService.NewMockDatasetService(t)
+
+	expectOrganizationAdmin(mockStore, orgId, userId)
+	mockStore.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus}, nil)
+	mockStore.EXPECT().RenameTag(mock.Anything, payroll.ID, "Salaries", "Expenses.Salaries", []string{"Wages", "Expenses.Payroll"}, userId).Return(nil)
+	mockStore.EXPECT().GetTagById(mock.Anything, payroll.ID).Return(storemodels.Tag{ID: payroll.ID, Name: "Salaries", Path: "Expenses.Salaries"}, nil)
+	mockStore.EXPECT().GetDatasetsAll(mock.Anything, storemodels.DatasetFilters{
+		OrganizationIds: []uuid.UUID{orgId},
+		Type:            []storemodels.DatasetType{storemodels.DatasetTypeSource},
+	}).Return([]storemodels.Dataset{dataset}, nil)
+
+	for from, to := range map[string]string{"Expenses.Payroll": "Expenses.Salaries", "Expenses.Payroll.Bonus": "Expenses.Salaries.Bonus"} {
+		from, to := from, to
+		mockDataset.EXPECT().UpdateDatasetData(mock.Anything, orgId, datasetId, mock.MatchedBy(func(params datasetmodels.UpdateDatasetDataParams) bool {
+			return params.Update.Column == "category" && params.Update.Value == to && params.UserId == userId &&
+				len(params.Filters.Conditions) == 1 && params.Filters.Conditions[0].Value == from
+		})).Return(datasetmodels.DatasetAction{ActionId: "action_" + to}, nil)
+	}
+
+	s := &tagService{store: mockStore, datasetService: mockDataset}
+	result, err := s.RenameTag(context.Background(), orgId, userId, payroll.ID, "Salaries")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Expenses.Salaries", result.Tag.Path)
+	assert.Equal(t, []models.TagRewrite{
+		{From: "Expenses.Payroll", To: "Expenses.Salaries"},
+		{From: "Expenses.Payroll.Bonus", To: "Expenses.Salaries.Bonus"},
+	}, result.Rewrites)
+	assert.Equal(t, []string{"action_Expenses.Salaries", "action_Expenses.Salaries.Bonus"}, result.DatasetActionIds)
+}
+
+func TestRenameTag_Conflict(t *testing.T) {
+	expenses, payroll, bonus := testTags()
+	rent := storemodels.Tag{ID: uuid.New(), ParentId: &expenses.ID, Name: "Rent", Path: "Expenses.Rent"}
+
+	mockStore := mock_store.NewMockStore(t)
+	expectOrganizationAdmin(mockStore, mock.Anything, mock.Anything)
+	mockStore.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus, rent}, nil)
+
+	s := &tagService{store: mockStore}
+	_, err := s.RenameTag(context.Background(), uuid.New(), uuid.New(), payroll.ID, "RENT")
+
+	assert.ErrorIs(t, err, tagErrors.ErrTagExists)
+}
+
+func TestMergeTags(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	expenses, payroll, bonus := testTags()
+	salaries := storemodels.Tag{ID: uuid.New(), ParentId: &expenses.ID, Name: "Salaries", Path: "Expenses.Salaries", Aliases: json.RawMessage(`["Payroll"]`)}
+
+	t.Run("Merges the source into the target", func(t *testing.T) {
+		mockStore := mock_store.NewMockStore(t)
+		expectOrganizationAdmin(mockStore, orgId, userId)
+		mockDataset := mockDatasetService.NewMockDatasetService(t)
+
+		mockStore.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus, salaries}, nil)
+		mockStore.EXPECT().MergeTags(mock.Anything, payroll.ID, salaries.ID, []string{"Payroll", "Expenses.Payroll", "Wages"}, userId).Return(nil)
+		mockStore.EXPECT().GetTagById(mock.Anything, salaries.ID).Return(salaries, nil)
+		mockStore.EXPECT().GetDatasetsAll(mock.Anything, mock.Anything).Return([]storemodels.Dataset{}, nil)
+
+		s := &tagService{store: mockStore, datasetService: mockDataset}
+		result, err := s.MergeTags(context.Background(), orgId, userId, payroll.ID, salaries.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []models.TagRewrite{
+			{From: "Expenses.Payroll", To: "Expenses.Salaries"},
+			{From: "Wages", To: "Expenses.Salaries"},
+			{From: "Expenses.Payroll.Bonus", To: "Expenses.Salaries.Bonus"},
+		}, result.Rewrites)
+		assert.Empty(t, result.DatasetActionIds)
+	})
+
+	t.Run("Rejects merging a tag into its descendant", func(t *testing.T) {
+		mockStore := mock_store.NewMockStore(t)
+		expectOrganizationAdmin(mockStore, orgId, userId)
+		mockStore.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus}, nil)
+
+		s := &tagService{store: mockStore}
+		_, err := s.MergeTags(context.Background(), orgId, userId, payroll.ID, bonus.ID)
+
+		assert.ErrorIs(t, err, tagErrors.ErrInvalidTagMerge)
+	})
+
+	t.Run("Rejects merging a tag into itself", func(t *testing.T) {
+		mockStore := mock_store.NewMockStore(t)
+		expectOrganizationAdmin(mockStore, orgId, userId)
+
+		s := &tagService{store: mockStore}
+		_, err := s.MergeTags(context.Background(), orgId, userId, payroll.ID, payroll.ID)
+
+		assert.ErrorIs(t, err, tagErrors.ErrInvalidTagMerge)
+	})
+
+	t.Run("Dataset rewrite error is returned with the rewrites that went through", func(t *testing.T) {
+		mockStore := mock_store.NewMockStore(t)
+		expectOrganizationAdmin(mockStore, orgId, userId)
+		mockDataset := mockDatasetService.NewMockDatasetService(t)
+		datasetId := uuid.New()
+
+		mockStore.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, salaries}, nil)
+		mockStore.EXPECT().MergeTags(mock.Anything, payroll.ID, salaries.ID, mock.Anything, userId).Return(nil)
+		mockStore.EXPECT().GetTagById(mock.Anything, salaries.ID).Return(salaries, nil)
+		mockStore.EXPECT().GetDatasetsAll(mock.Anything, mock.Anything).Return([]storemodels.Dataset{
+			{ID: datasetId, Metadata: json.RawMessage(`{"columns": {"category": {"custom_type": "tags"}}}`)},
+		}, nil)
+		mockDataset.EXPECT().UpdateDatasetData(mock.Anything, orgId, datasetId, mock.MatchedBy(func(params datasetmodels.UpdateDatasetDataParams) bool {
+			return params.Filters.Conditions[0].Value == "Expenses.Payroll"
+		})).Return(datasetmodels.DatasetAction{}, errors.New("action failed"))
+		mockDataset.EXPECT().UpdateDatasetData(mock.Anything, orgId, datasetId, mock.MatchedBy(func(params datasetmodels.UpdateDatasetDataParams) bool {
+			return params.Filters.Conditions[0].Value == "Wages"
+		})).Return(datasetmodels.DatasetAction{ActionId: "action_Wages"}, nil)
+
+		s := &tagService{store: mockStore, datasetService: mockDataset}
+		result, err := s.MergeTags(context.Background(), orgId, userId, payroll.ID, salaries.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"action_Wages"}, result.DatasetActionIds)
+		assert.Equal(t, []models.TagRewriteFailure{
+			{DatasetId: datasetId, Column: "category", From: "Expenses.Payroll", To: "Expenses.Salaries", Error: "action failed"},
+		}, result.Failures)
+	})
+
+	t.Run("Datasets error leaves the tags as they are", func(t *testing.T) {
+		mockStore := mock_store.NewMockStore(t)
+		expectOrganizationAdmin(mockStore, orgId, userId)
+
+		mockStore.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, salaries}, nil)
+		mockStore.EXPECT().GetDatasetsAll(mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+
+		s := &tagService{store: mockStore}
+		_, err := s.MergeTags(context.Background(), orgId, userId, payroll.ID, salaries.ID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestTagChangesRequireOrganizationAdmin(t *testing.T) {
+	orgId := uuid.New()
+	userId := uuid.New()
+	expenses, payroll, bonus := testTags()
+
+	tests := []struct {
+		name   string
+		policy *storemodels.ResourceAudiencePolicy
+		err    error
+	}{
+		{
+			name:   "Organization member",
+			policy: &storemodels.ResourceAudiencePolicy{Privilege: storemodels.PrivilegeOrganizationMember},
+		},
+		{
+			name: "No organization policy",
+			err:  gorm.ErrRecordNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStore := mock_store.NewMockStore(t)
+			mockStore.EXPECT().GetOrganizationPolicyByUser(mock.Anything, mock.Anything, userId).Return(tt.policy, tt.err)
+			mockStore.EXPECT().GetTags(mock.Anything).Return([]storemodels.Tag{expenses, payroll, bonus}, nil).Maybe()
+
+			s := &tagService{store: mockStore}
+
+			_, err := s.CreateTag(context.Background(), orgId, userId, models.TagParams{Name: "Rent"})
+			assert.ErrorIs(t, err, tagErrors.ErrTagAccessForbidden)
+
+			_, err = s.UpdateTag(context.Background(), userId, payroll.ID, models.TagUpdateParams{})
+			assert.ErrorIs(t, err, tagErrors.ErrTagAccessForbidden)
+
+			err = s.DeleteTag(context.Background(), userId, bonus.ID)
+			assert.ErrorIs(t, err, tagErrors.ErrTagAccessForbidden)
+
+			_, err = s.RenameTag(context.Background(), orgId, userId, payroll.ID, "Salaries")
+			assert.ErrorIs(t, err, tagErrors.ErrTagAccessForbidden)
+
+			_, err = s.MergeTags(context.Background(), orgId, userId, payroll.ID, expenses.ID)
+			assert.ErrorIs(t, err, tagErrors.ErrTagAccessForbidden)
+		})
+	}
+}
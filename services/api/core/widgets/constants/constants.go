@@ -5,6 +5,22 @@ const (
 	DimensionType = "dimension"
 )
 
+// Widget types
+const (
+	WidgetTypeBarChart        = "bar_chart"
+	WidgetTypeLineChart       = "line_chart"
+	WidgetTypeAreaChart       = "area_chart"
+	WidgetTypeStackedBarChart = "stacked_bar_chart"
+	WidgetTypePieChart        = "pie_chart"
+	WidgetTypeDonutChart      = "donut_chart"
+	WidgetTypePivotTable      = "pivot_table"
+	WidgetTypeKPI             = "kpi"
+	WidgetTypeDataTable       = "data_table"
+	WidgetTypeWaterfallChart  = "waterfall_chart"
+	WidgetTypeFunnelChart     = "funnel_chart"
+	WidgetTypeHeatmap         = "heatmap"
+)
+
 const ValuesField = "values"
 
 // Basic chart fields
@@ -25,6 +41,21 @@ const (
 	ColumnsField = "columns"
 )
 
+// Waterfall chart fields, the steps and their movements use the basic chart fields
+const (
+	OpeningValueField = "opening_value"
+)
+
+// Funnel chart fields
+const (
+	StagesField = "stages"
+)
+
+// Heatmap fields
+const (
+	DateField = "date"
+)
+
 // KPI fields
 const (
 	PrimaryValueField    = "primary_value"
@@ -37,6 +68,55 @@ const (
 	HEIRARCHY_SUFFIX = "LEVEL"
 )
 
+// Columns added to the results of waterfall and funnel charts
+const (
+	WATERFALL_STEP_COLUMN       = "__WATERFALL_STEP"
+	WATERFALL_START_COLUMN      = "__WATERFALL_START"
+	WATERFALL_END_COLUMN        = "__WATERFALL_END"
+	CONVERSION_RATE_COLUMN      = "__CONVERSION_RATE"
+	STEP_CONVERSION_RATE_COLUMN = "__STEP_CONVERSION_RATE"
+)
+
+// Columns marking the totals of pivot tables, the levels are how many of the row and column fields a row is grouped by
+const (
+	PIVOT_TOTAL_COLUMN        = "__TOTAL"
+	PIVOT_ROW_LEVEL_COLUMN    = "__ROW_LEVEL"
+	PIVOT_COLUMN_LEVEL_COLUMN = "__COLUMN_LEVEL"
+	PIVOT_TOTAL_REF_SEPARATOR = "__TOTAL_"
+)
+
+// Columns added to the data of widgets compared with other periods or measured against a target, a comparison adds
+// its columns for every measure of the widget
+const (
+	COMPARISON_REF_SEPARATOR = "__COMPARISON_"
+	TARGET_REF               = "__TARGET"
+	DELTA_SUFFIX             = "__DELTA"
+	DELTA_PERCENT_SUFFIX     = "__DELTA_PCT"
+	TARGET_COLUMN            = "__TARGET"
+	ATTAINMENT_COLUMN        = "__ATTAINMENT"
+)
+
+// Totals of a pivot table
+const (
+	PivotTotalRowSubtotal    = "row_subtotal"
+	PivotTotalColumnSubtotal = "column_subtotal"
+	PivotTotalGrandTotal     = "grand_total"
+)
+
+// Steps of a waterfall chart
+const (
+	WaterfallStepOpening  = "opening"
+	WaterfallStepMovement = "movement"
+	WaterfallStepClosing  = "closing"
+)
+
+const (
+	WaterfallOpeningLabel = "Opening"
+	WaterfallClosingLabel = "Closing"
+)
+
+const DefaultHeatmapPeriodicity = "day"
+
 var Periodicities map[string]bool = map[string]bool{
 	"day":     true,
 	"week":    true,
@@ -67,12 +147,18 @@ const (
 )
 
 const (
-	MAX_PAGE_SIZE = 50000
+	MAX_PAGE_SIZE           = 50000
+	DEFAULT_TABLE_PAGE_SIZE = 100
+
+	// MAX_CONCURRENT_SHEET_QUERIES bounds the queries a sheet sends to the warehouse at once
+	MAX_CONCURRENT_SHEET_QUERIES = 8
 )
 
 const (
 	ParameterMethodAddDays      = "addDays"
 	ParameterMethodAddSeconds   = "addSeconds"
+	ParameterMethodStartOf      = "startOf"
+	ParameterMethodEndOf        = "endOf"
 	DefaultVariableSymbol       = "$"
 	ParameterMethodToday        = DefaultVariableSymbol + "today"
 	ParameterMethodEndDay       = DefaultVariableSymbol + "end_date"
@@ -0,0 +1,45 @@
+package models
+
+const (
+	ComparisonPreviousPeriod     = "previous_period"
+	ComparisonSamePeriodLastYear = "same_period_last_year"
+	ComparisonCustom             = "custom"
+)
+
+const (
+	TargetConstant = "constant"
+	TargetDataset  = "dataset"
+)
+
+// Comparison is a period the values of a widget are compared with, the period of its data shifted back. The previous
+// period is as long as the period of the data and ends where it starts
+type Comparison struct {
+	Type   string        `json:"type"`
+	Name   string        `json:"name,omitempty"`
+	Offset *PeriodOffset `json:"offset,omitempty"`
+}
+
+// GetName names the columns of the comparison in the data of the widget
+func (c *Comparison) GetName() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return c.Type
+}
+
+// PeriodOffset is how far back a custom comparison goes, in days, weeks, months, quarters or years
+type PeriodOffset struct {
+	Value int    `json:"value"`
+	Unit  string `json:"unit"`
+}
+
+// Target is the goal a value of a widget is measured against, a constant or the aggregated value of a budget dataset
+// filtered by the sheet like the datasets of the widget. The value is the first measure of the widget unless the
+// target names another
+type Target struct {
+	Type      string   `json:"type"`
+	Value     *float64 `json:"value,omitempty"`
+	DatasetID string   `json:"dataset_id,omitempty"`
+	Field     *Field   `json:"field,omitempty"`
+	Measure   string   `json:"measure,omitempty"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import "github.com/google/uuid"
+
+const (
+	DrillThroughTargetSheet   = "sheet"
+	DrillThroughTargetDataset = "dataset"
+)
+
+// WidgetSelection is what the user clicked in a widget, like a bar of a chart, as the values of the fields of the
+// widget keyed by their alias
+type WidgetSelection struct {
+	WidgetInstanceID uuid.UUID              `json:"widget_instance_id"`
+	Values           map[string]interface{} `json:"values"`
+}
+
+// DrillThrough is where a widget opens the selection of the user, another sheet or the view of a dataset
+type DrillThrough struct {
+	TargetType string     `json:"target_type"`
+	SheetID    *uuid.UUID `json:"sheet_id,omitempty"`
+	DatasetID  string     `json:"dataset_id,omitempty"`
+}
+
+// DrillThroughTarget is the drill-through of a widget resolved for a selection, the filters are keyed by the datasets
+// of the target the same way the data of the widgets is requested
+type DrillThroughTarget struct {
+	TargetType string
+	SheetID    *uuid.UUID
+	PageID     *uuid.UUID
+	DatasetID  string
+	Filters    []WidgetFilters
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+)
+
+const (
+	TemplateCardinalitySingle   = "single"
+	TemplateCardinalityMultiple = "multiple"
+)
+
+// WidgetTemplateSchema is the part of the template schema of a widget type the data mappings of its instances are
+// checked against
+type WidgetTemplateSchema struct {
+	Mappings            WidgetTemplateMappings `json:"mappings"`
+	MappingsCardinality string                 `json:"mappings_cardinality"`
+}
+
+type WidgetTemplateMappings struct {
+	Fields map[string]WidgetTemplateField `json:"fields"`
+}
+
+type WidgetTemplateField struct {
+	Name                string   `json:"name"`
+	Type                string   `json:"type"`
+	Required            bool     `json:"required"`
+	Cardinality         string   `json:"cardinality"`
+	MaxFields           int      `json:"max_fields"`
+	AllowedAggregations []string `json:"allowed_aggregations"`
+}
+
+func (s *WidgetTemplateSchema) FromDB(dbModel *dbmodels.Widget) error {
+	if len(dbModel.TemplateSchema) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(dbModel.TemplateSchema, s); err != nil {
+		return fmt.Errorf("unmarshal template schema: %w", err)
+	}
+
+	return nil
+}
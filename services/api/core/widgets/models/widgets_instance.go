@@ -3,8 +3,10 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
 	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
 	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/google/uuid"
@@ -55,11 +57,24 @@ type WidgetInstance struct {
 	Title         string           `json:"title"`
 	DataMappings  DataMappings     `json:"data_mappings"`
 	DisplayConfig *json.RawMessage `json:"display_config,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
 }
 
 type DataMappings struct {
-	Version  DataMappingVersion  `json:"version"`
-	Mappings []DataMappingFields `json:"mappings"`
+	Version      DataMappingVersion  `json:"version"`
+	Mappings     []DataMappingFields `json:"mappings"`
+	DrillThrough *DrillThrough       `json:"drill_through,omitempty"`
+	Totals       *PivotTotals        `json:"totals,omitempty"`
+	Comparisons  []Comparison        `json:"comparisons,omitempty"`
+	Target       *Target             `json:"target,omitempty"`
+}
+
+// PivotTotals are the totals a pivot table has the warehouse compute along with its cells. Row subtotals add up every
+// level of the row fields by the column fields, column subtotals every level of the column fields by the row fields
+type PivotTotals struct {
+	RowSubtotals    bool `json:"row_subtotals"`
+	ColumnSubtotals bool `json:"column_subtotals"`
+	GrandTotal      bool `json:"grand_total"`
 }
 
 type DataMappingFields struct {
@@ -120,6 +135,7 @@ type Field struct {
 	Alias                   string                             `json:"alias,omitempty"`
 	Expression              string                             `json:"expression,omitempty"`
 	SortBy                  []SortBy                           `json:"sort_by,omitempty"`
+	Cumulative              bool                               `json:"cumulative,omitempty"`
 }
 
 func (f *Field) GetAlias() *string {
@@ -141,6 +157,7 @@ func (wi *WidgetInstance) FromDB(dbModel *dbmodels.WidgetInstance) error {
 	wi.WidgetType = dbModel.WidgetType
 	wi.SheetID = dbModel.SheetID
 	wi.Title = dbModel.Title
+	wi.CreatedAt = dbModel.CreatedAt
 
 	if err := json.Unmarshal(dbModel.DataMappings, &wi.DataMappings); err != nil {
 		return fmt.Errorf("unmarshal data mappings: %w", err)
@@ -161,6 +178,7 @@ func (wi *WidgetInstance) ToDB() (*dbmodels.WidgetInstance, error) {
 		Title:         wi.Title,
 		WidgetType:    wi.WidgetType,
 		DisplayConfig: wi.DisplayConfig,
+		CreatedAt:     wi.CreatedAt,
 	}
 
 	mappings, err := json.Marshal(wi.DataMappings)
@@ -264,10 +282,18 @@ func stringPtr(s *string) *string {
 }
 
 type GetWidgetInstanceDataQueryParams struct {
-	Filters     []WidgetFilters `json:"filters"`
-	TimeColumns []ColumnMapping `json:"time_columns"`
-	Periodicity *string         `json:"periodicity,omitempty"`
-	Currency    *string         `json:"currency,omitempty"`
+	Filters     []WidgetFilters   `json:"filters"`
+	TimeColumns []ColumnMapping   `json:"time_columns"`
+	Periodicity *string           `json:"periodicity,omitempty"`
+	Currency    *string           `json:"currency,omitempty"`
+	Selections  []WidgetSelection `json:"selections,omitempty"`
+	Variables   map[string]string `json:"variables,omitempty"`
+}
+
+// WidgetInstanceData is the data of a widget instance loaded with the rest of its sheet, or why it failed to load
+type WidgetInstanceData struct {
+	Data []datasetmodels.DatasetData
+	Err  error
 }
 
 type ColumnMapping struct {
@@ -291,6 +317,15 @@ type DatasetBuilderParams struct {
 	TimeColumns map[string]string
 	Periodicity *string
 	Currency    *string
+	Calendar    calendar.Settings
+	Variables   map[string]Variable
+}
+
+// Variable is the value a variable of the sheet has for the data being loaded, keyed by its name. The type is one of the
+// types of sheet variables
+type Variable struct {
+	Type  string
+	Value string
 }
 
 type GetDataByDatasetIDParams struct {
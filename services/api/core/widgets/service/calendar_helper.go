@@ -0,0 +1,123 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+)
+
+// truncateTime is the expression bucketing the time column into the periods of the calendar. The time is bucketed in
+// the timezone of the calendar, and the calendar of the server truncates it as it is
+func truncateTime(settings calendar.Settings, periodicity string, column string) string {
+	local := column
+	if location := settings.Location(); location != time.Local {
+		local = fmt.Sprintf("from_utc_timestamp(%s, '%s')", column, location.String())
+	}
+
+	switch periodicity {
+	case calendar.PeriodWeek:
+		if settings.FirstWeekday() == time.Monday {
+			break
+		}
+		return fmt.Sprintf("date_trunc('day', %s)", weekStartSQL(settings, local))
+
+	case calendar.PeriodMonth, calendar.PeriodQuarter, calendar.PeriodYear:
+		if weeks, ok := settings.PatternWeeks(); ok {
+			return fmt.Sprintf("date_trunc('day', %s)", patternPeriodStartSQL(settings, periodicity, weeks, local))
+		}
+
+		shift := int(settings.YearStartMonth()) - 1
+		if periodicity == calendar.PeriodMonth || shift == 0 {
+			break
+		}
+		// a fiscal period is the calendar period of the time moved back to a year starting in january
+		return fmt.Sprintf("date_trunc('day', add_months(date_trunc('%s', add_months(%s, -%d)), %d))", periodicity, local, shift, shift)
+	}
+
+	return fmt.Sprintf("date_trunc('%s', %s)", periodicity, local)
+}
+
+// weekStartSQL is the date of the start of the week of the date, dayofweek counts from 1 on sunday
+func weekStartSQL(settings calendar.Settings, date string) string {
+	return fmt.Sprintf("date_sub(%s, pmod(dayofweek(%s) - %d, 7))", date, date, int(settings.FirstWeekday())+1)
+}
+
+// patternPeriodStartSQL is the date of the start of the period of a fiscal year following a pattern. The fiscal year of
+// a date is the one of the last first day of the fiscal year start month on or before the end of the week of the date
+func patternPeriodStartSQL(settings calendar.Settings, periodicity string, weeks [3]int, local string) string {
+	date := fmt.Sprintf("to_date(%s)", local)
+	weekEnd := fmt.Sprintf("date_add(%s, 6)", weekStartSQL(settings, date))
+	yearStartMonth := int(settings.YearStartMonth())
+	firstDay := fmt.Sprintf("make_date(year(add_months(%s, -%d)), %d, 1)", weekEnd, yearStartMonth-1, yearStartMonth)
+	yearStart := weekStartSQL(settings, firstDay)
+	week := fmt.Sprintf("cast(datediff(%s, %s) / 7 as int)", date, yearStart)
+
+	switch periodicity {
+	case calendar.PeriodYear:
+		return yearStart
+
+	case calendar.PeriodQuarter:
+		return fmt.Sprintf("date_add(%s, 91 * least(cast(%s / 13 as int), 3))", yearStart, week)
+
+	default:
+		// the week of the year a month starts on for every week of a year of 53 weeks
+		monthStarts := make([]string, 0, 53)
+		for quarter := 0; quarter < 4; quarter++ {
+			monthStart := 13 * quarter
+			for month, monthWeeks := range weeks {
+				if quarter == 3 && month == len(weeks)-1 {
+					monthWeeks++
+				}
+				for range monthWeeks {
+					monthStarts = append(monthStarts, fmt.Sprint(monthStart))
+				}
+				monthStart += monthWeeks
+			}
+		}
+		return fmt.Sprintf("date_add(%s, 7 * element_at(array(%s), %s + 1))", yearStart, strings.Join(monthStarts, ", "), week)
+	}
+}
+
+// localizeTimeFilters reads the times the queries are filtered by on their time columns in the timezone of the calendar
+// and moves them to UTC, the time the warehouse keeps
+func localizeTimeFilters(settings calendar.Settings, timeColumns map[string]string, queries map[string]widgetmodels.GetDataByDatasetIDParams) {
+	location := settings.Location()
+	if location == time.Local {
+		return
+	}
+
+	toUTC := func(value string) (interface{}, bool) {
+		t, layout, ok := parseTime(value)
+		if !ok {
+			return value, false
+		}
+		if layout != time.RFC3339 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, location)
+		}
+		return t.UTC().Format(time.DateTime), true
+	}
+
+	for ref, query := range queries {
+		timeColumn := timeColumns[query.DatasetID]
+		if timeColumn == "" {
+			continue
+		}
+
+		query.Params.Filters = localizeFilterModel(query.Params.Filters, timeColumn, toUTC)
+		if query.Params.Subquery != nil {
+			subquery := *query.Params.Subquery
+			subquery.Filters = localizeFilterModel(subquery.Filters, timeColumn, toUTC)
+			query.Params.Subquery = &subquery
+		}
+		queries[ref] = query
+	}
+}
+
+func localizeFilterModel(filters datasetmodels.FilterModel, timeColumn string, toUTC func(string) (interface{}, bool)) datasetmodels.FilterModel {
+	filters.Conditions, _ = mapTimeConditions(filters.Conditions, timeColumn, toUTC)
+	return filters
+}
@@ -0,0 +1,219 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
+	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+)
+
+func TestTruncateTime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		settings    calendar.Settings
+		periodicity string
+		want        string
+	}{
+		{
+			name:        "calendar of the server",
+			periodicity: "week",
+			want:        "date_trunc('week', posted_at)",
+		},
+		{
+			name:        "timezone of the organization",
+			settings:    calendar.Settings{Timezone: "Asia/Kolkata"},
+			periodicity: "month",
+			want:        "date_trunc('month', from_utc_timestamp(posted_at, 'Asia/Kolkata'))",
+		},
+		{
+			name:        "weeks starting on sunday",
+			settings:    calendar.Settings{WeekStart: "sunday"},
+			periodicity: "week",
+			want:        "date_trunc('day', date_sub(posted_at, pmod(dayofweek(posted_at) - 1, 7)))",
+		},
+		{
+			name:        "fiscal year starting in april",
+			settings:    calendar.Settings{FiscalYearStartMonth: 4},
+			periodicity: "year",
+			want:        "date_trunc('day', add_months(date_trunc('year', add_months(posted_at, -3)), 3))",
+		},
+		{
+			name:        "months of a fiscal year stay calendar months",
+			settings:    calendar.Settings{FiscalYearStartMonth: 4},
+			periodicity: "month",
+			want:        "date_trunc('month', posted_at)",
+		},
+		{
+			name:        "4-4-5 year",
+			settings:    calendar.Settings{Pattern: calendar.Pattern445},
+			periodicity: "year",
+			want: "date_trunc('day', date_sub(make_date(year(add_months(date_add(date_sub(to_date(posted_at), pmod(dayofweek(to_date(posted_at)) - 2, 7)), 6), -0)), 1, 1), " +
+				"pmod(dayofweek(make_date(year(add_months(date_add(date_sub(to_date(posted_at), pmod(dayofweek(to_date(posted_at)) - 2, 7)), 6), -0)), 1, 1)) - 2, 7)))",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, truncateTime(tt.settings, tt.periodicity, "posted_at"))
+		})
+	}
+}
+
+func TestTruncateTime_PatternMonths(t *testing.T) {
+	t.Parallel()
+
+	got := truncateTime(calendar.Settings{Pattern: calendar.Pattern445}, "month", "posted_at")
+
+	// every week of a 53 week year maps to the week its month starts on, the last month takes the extra week
+	start := strings.Index(got, "array(") + len("array(")
+	monthStarts := strings.Split(got[start:start+strings.Index(got[start:], ")")], ", ")
+	assert.Len(t, monthStarts, 53)
+	assert.Equal(t, []string{"0", "0", "0", "0", "4", "4", "4", "4", "8", "8", "8", "8", "8", "13"}, monthStarts[:14])
+	assert.Equal(t, []string{"43", "47", "47", "47", "47", "47", "47"}, monthStarts[46:])
+}
+
+func TestLocalizeTimeFilters(t *testing.T) {
+	t.Parallel()
+
+	period := datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{
+		{Column: "posted_at", Operator: "inbetween", Value: []interface{}{"2025-04-01", "2025-04-30 23:59:59"}},
+		{Column: "status", Operator: "eq", Value: "2025-04-01"},
+	}}
+	queries := func() map[string]widgetmodels.GetDataByDatasetIDParams {
+		return map[string]widgetmodels.GetDataByDatasetIDParams{
+			"revenue": {DatasetID: "transactions", Params: datasetmodels.DatasetParams{
+				Filters:  period,
+				Subquery: &datasetmodels.DatasetParams{Filters: period},
+			}},
+			"__TARGET": {DatasetID: "budget", Params: datasetmodels.DatasetParams{Filters: period}},
+		}
+	}
+	timeColumns := map[string]string{"transactions": "posted_at"}
+
+	got := queries()
+	localizeTimeFilters(calendar.Settings{Timezone: "Asia/Kolkata"}, timeColumns, got)
+
+	localized := []datasetmodels.Filter{
+		{Column: "posted_at", Operator: "inbetween", Value: []interface{}{"2025-03-31 18:30:00", "2025-04-30 18:29:59"}},
+		{Column: "status", Operator: "eq", Value: "2025-04-01"},
+	}
+	assert.Equal(t, localized, got["revenue"].Params.Filters.Conditions)
+	assert.Equal(t, localized, got["revenue"].Params.Subquery.Filters.Conditions)
+	assert.Equal(t, period, got["__TARGET"].Params.Filters)
+	// the filters of the sheet are shared by its widgets
+	assert.Equal(t, "2025-04-01", period.Conditions[0].Value.([]interface{})[0])
+
+	got = queries()
+	localizeTimeFilters(calendar.Settings{}, timeColumns, got)
+	assert.Equal(t, queries(), got)
+}
+
+func TestBasicChartStrategy_ToDatasetParamsWithCalendar(t *testing.T) {
+	t.Parallel()
+
+	settings := calendar.Settings{Timezone: "America/New_York", FiscalYearStartMonth: 2}
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{
+				DatasetID: "transactions",
+				Ref:       "revenue",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.XAxisField: {{Column: "posted_at"}},
+					widgetconstants.YAxisField: {{Column: "amount", Aggregation: "sum"}},
+				},
+				DefaultFilters: &datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{
+					{Column: "posted_at", Operator: "gte", Value: []interface{}{"{{.$today.startOf(year)}}"}},
+				}},
+			}},
+		},
+	}
+	periodicity := "quarter"
+
+	got, err := BasicChartStrategy{BaseStrategy: *NewBaseStrategy()}.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{
+		TimeColumns: map[string]string{"transactions": "posted_at"},
+		Periodicity: &periodicity,
+		Calendar:    settings,
+	})
+	require.NoError(t, err)
+
+	params := got["revenue"].Params
+	assert.Equal(t, "date_trunc('day', add_months(date_trunc('quarter', add_months(from_utc_timestamp(posted_at, 'America/New_York'), -1)), 1))", params.GroupBy[0].Column)
+
+	yearStart, err := settings.PeriodStart(settings.Now(), "year")
+	require.NoError(t, err)
+	assert.Equal(t, []string{yearStart.Format(time.DateTime)}, params.Filters.Conditions[0].Value)
+	assert.Equal(t, time.February, yearStart.Month())
+}
+
+func TestApplyMethodWithCalendar(t *testing.T) {
+	t.Parallel()
+
+	baseTime := time.Date(2025, time.February, 12, 0, 0, 0, 0, time.UTC)
+	baseStrategy := BaseStrategy{calendar: calendar.Settings{FiscalYearStartMonth: 4, WeekStart: "sunday"}}
+
+	assert.Equal(t, time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC), baseStrategy.ApplyMethod(baseTime, widgetconstants.ParameterMethodStartOf, "year"))
+	assert.Equal(t, time.Date(2025, time.March, 31, 23, 59, 59, 0, time.UTC), baseStrategy.ApplyMethod(baseTime, widgetconstants.ParameterMethodEndOf, " quarter "))
+	assert.Equal(t, time.Date(2025, time.February, 9, 0, 0, 0, 0, time.UTC), baseStrategy.ApplyMethod(baseTime, widgetconstants.ParameterMethodStartOf, "week"))
+	assert.Equal(t, baseTime, baseStrategy.ApplyMethod(baseTime, widgetconstants.ParameterMethodStartOf, "decade"))
+}
+
+func TestBasicChartStrategy_ToDatasetParamsWithUnknownPeriod(t *testing.T) {
+	t.Parallel()
+
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{
+				DatasetID: "transactions",
+				Ref:       "revenue",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.XAxisField: {{Column: "posted_at"}},
+					widgetconstants.YAxisField: {{Column: "amount", Aggregation: "sum"}},
+				},
+				DefaultFilters: &datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{
+					{Column: "posted_at", Operator: "gte", Value: []interface{}{"{{.$today.startOf(decade)}}"}},
+				}},
+			}},
+		},
+	}
+
+	_, err := BasicChartStrategy{BaseStrategy: *NewBaseStrategy()}.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{})
+	assert.ErrorIs(t, err, ErrInvalidDataMappings)
+}
+
+func TestBasicChartStrategy_ToDatasetParamsWithUnknownPeriodInSheetFilters(t *testing.T) {
+	t.Parallel()
+
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{
+				DatasetID: "transactions",
+				Ref:       "revenue",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.XAxisField: {{Column: "posted_at"}},
+					widgetconstants.YAxisField: {{Column: "amount", Aggregation: "sum"}},
+				},
+			}},
+		},
+	}
+
+	_, err := BasicChartStrategy{BaseStrategy: *NewBaseStrategy()}.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{
+		Filters: map[string]widgetmodels.WidgetFilters{
+			"transactions": {Filters: datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{
+				{Column: "posted_at", Operator: "gte", Value: "{{.$today.endOf(decade)}}"},
+			}}},
+		},
+	})
+	assert.ErrorIs(t, err, ErrInvalidDataMappings)
+}
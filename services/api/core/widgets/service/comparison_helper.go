@@ -0,0 +1,559 @@
+package widgets
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	dataplatformdataConstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
+	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	dataplatformmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+)
+
+// timeLayouts are the layouts the values of the filters on time columns come in
+var timeLayouts = []string{time.DateTime, time.DateOnly, time.RFC3339, "2006-01-02T15:04:05"}
+
+// AddComparisons adds a query per comparison of every mapping queried, over the period of the mapping shifted back,
+// and the query of the target when it comes from a budget dataset. A mapping not filtered on its time column has no
+// period to compare
+func (b *BaseStrategy) AddComparisons(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams, queries map[string]widgetmodels.GetDataByDatasetIDParams) error {
+	for _, mapping := range instance.DataMappings.Mappings {
+		query, ok := queries[mapping.Ref]
+		timeColumn := datasetbuilderparams.TimeColumns[mapping.DatasetID]
+		if !ok || timeColumn == "" {
+			continue
+		}
+
+		for _, comparison := range instance.DataMappings.Comparisons {
+			params, shifted, err := shiftDatasetParams(query.Params, timeColumn, comparison)
+			if err != nil {
+				return err
+			}
+			if !shifted {
+				continue
+			}
+
+			queries[comparisonRef(mapping.Ref, comparison)] = widgetmodels.GetDataByDatasetIDParams{
+				DatasetID: query.DatasetID,
+				Params:    params,
+			}
+		}
+	}
+
+	target := instance.DataMappings.Target
+	if target == nil || target.Type != widgetmodels.TargetDataset {
+		return nil
+	}
+	if target.Field == nil {
+		return fmt.Errorf("target of dataset %s has no field", target.DatasetID)
+	}
+
+	targetMapping := widgetmodels.DataMappingFields{
+		DatasetID: target.DatasetID,
+		Ref:       widgetconstants.TARGET_REF,
+		Fields:    map[string][]widgetmodels.Field{widgetconstants.ValuesField: {*target.Field}},
+	}
+	result, err := b.ProcessDatasetParams(&targetMapping, datasetbuilderparams, func(params *datasetmodels.DatasetParams, mapping *widgetmodels.DataMappingFields, filters *datasetmodels.FilterModel, datasetBuilderParams *widgetmodels.DatasetBuilderParams) error {
+		if err := b.HandleAggregation(params, *target.Field, mapping, filters, datasetBuilderParams); err != nil {
+			return fmt.Errorf("failed to handle aggregation for target: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	queries[widgetconstants.TARGET_REF] = result
+	return nil
+}
+
+// MergeComparisons adds the values of the comparisons and the target to the rows of their mappings. A row is compared
+// with the row of the other period with the same values of the fields other than the time column, whose time bucket is
+// the bucket of the row moved back by the comparison, and gets the compared value, the absolute delta and the delta as
+// a share of the compared value for every measure
+func (b *BaseStrategy) MergeComparisons(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams, results map[string]datasetmodels.DatasetData) (map[string]datasetmodels.DatasetData, error) {
+	for _, mapping := range instance.DataMappings.Mappings {
+		data, ok := results[mapping.Ref]
+		if !ok {
+			continue
+		}
+
+		timeColumn := datasetbuilderparams.TimeColumns[mapping.DatasetID]
+		measures, dimensions := splitMappingFields(mapping, timeColumn)
+		bucket := timeBucket{
+			column:      timeBucketColumn(mapping, timeColumn),
+			settings:    datasetbuilderparams.Calendar,
+			periodicity: datasetbuilderparams.Periodicity,
+		}
+		for _, comparison := range instance.DataMappings.Comparisons {
+			compared, ok := results[comparisonRef(mapping.Ref, comparison)]
+			if !ok {
+				continue
+			}
+			delete(results, comparisonRef(mapping.Ref, comparison))
+
+			// the period of the rows is the one their query was filtered on
+			query, err := b.ProcessDatasetParams(&mapping, datasetbuilderparams, func(*datasetmodels.DatasetParams, *widgetmodels.DataMappingFields, *datasetmodels.FilterModel, *widgetmodels.DatasetBuilderParams) error {
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			shift, ok, err := comparisonShift(query.Params.Filters, timeColumn, comparison)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			if err := compareRows(data.Rows, compared.Rows, measures, dimensions, bucket, shift, comparison.GetName()); err != nil {
+				return nil, err
+			}
+			for _, measure := range measures {
+				column := comparisonColumn(measure, comparison.GetName())
+				data.Columns = append(data.Columns,
+					dataplatformmodels.ColumnMetadata{Name: column, DatabaseType: string(dataplatformdataConstants.DoubleDataType)},
+					dataplatformmodels.ColumnMetadata{Name: column + widgetconstants.DELTA_SUFFIX, DatabaseType: string(dataplatformdataConstants.DoubleDataType)},
+					dataplatformmodels.ColumnMetadata{Name: column + widgetconstants.DELTA_PERCENT_SUFFIX, DatabaseType: string(dataplatformdataConstants.DoubleDataType)},
+				)
+			}
+		}
+
+		results[mapping.Ref] = data
+	}
+
+	return b.mergeTarget(instance, datasetbuilderparams, results)
+}
+
+// mergeTarget measures the measure of the target against it, in the first mapping having the measure
+func (b *BaseStrategy) mergeTarget(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams, results map[string]datasetmodels.DatasetData) (map[string]datasetmodels.DatasetData, error) {
+	target := instance.DataMappings.Target
+	if target == nil {
+		return results, nil
+	}
+
+	var targetValue interface{}
+	switch target.Type {
+	case widgetmodels.TargetConstant:
+		if target.Value != nil {
+			targetValue = *target.Value
+		}
+	case widgetmodels.TargetDataset:
+		budget, ok := results[widgetconstants.TARGET_REF]
+		delete(results, widgetconstants.TARGET_REF)
+		if ok && len(budget.Rows) > 0 && target.Field != nil {
+			value, err := parseNumericValue(budget.Rows[0][*target.Field.GetAlias()])
+			if err != nil {
+				return nil, fmt.Errorf("failed to read target: %w", err)
+			}
+			targetValue = value
+		}
+	}
+
+	for _, mapping := range instance.DataMappings.Mappings {
+		data, ok := results[mapping.Ref]
+		if !ok {
+			continue
+		}
+
+		measures, _ := splitMappingFields(mapping, datasetbuilderparams.TimeColumns[mapping.DatasetID])
+		measure := target.Measure
+		if measure == "" && len(measures) > 0 {
+			measure = measures[0]
+		}
+		if !slices.Contains(measures, measure) {
+			continue
+		}
+
+		for _, row := range data.Rows {
+			if _, ok := row[measure]; !ok {
+				continue
+			}
+
+			row[widgetconstants.TARGET_COLUMN] = targetValue
+			row[widgetconstants.ATTAINMENT_COLUMN] = nil
+			if value, ok := targetValue.(float64); ok {
+				current, err := parseNumericValue(row[measure])
+				if err != nil {
+					return nil, fmt.Errorf("failed to read %s: %w", measure, err)
+				}
+				row[widgetconstants.ATTAINMENT_COLUMN] = conversionRate(current, value)
+			}
+		}
+
+		data.Columns = append(data.Columns,
+			dataplatformmodels.ColumnMetadata{Name: widgetconstants.TARGET_COLUMN, DatabaseType: string(dataplatformdataConstants.DoubleDataType)},
+			dataplatformmodels.ColumnMetadata{Name: widgetconstants.ATTAINMENT_COLUMN, DatabaseType: string(dataplatformdataConstants.DoubleDataType)},
+		)
+		results[mapping.Ref] = data
+		break
+	}
+
+	return results, nil
+}
+
+// timeBucket keys the rows by the time bucket of their time column, the period of the calendar the time is in when
+// the time column is truncated to periods
+type timeBucket struct {
+	column      string
+	settings    calendar.Settings
+	periodicity *string
+}
+
+// key returns the bucket of the row moved by shift, it tells whether the row has a time to move
+func (t timeBucket) key(row map[string]interface{}, shift func(time.Time) time.Time) (string, bool) {
+	if t.column == "" {
+		return "", true
+	}
+
+	bucket, ok := wallClockTime(row[t.column])
+	if !ok {
+		return "", false
+	}
+
+	bucket = shift(bucket)
+	if t.periodicity != nil {
+		start, err := t.settings.PeriodStart(bucket, *t.periodicity)
+		if err != nil {
+			return "", false
+		}
+		bucket = start
+	}
+	return bucket.Format(time.DateTime), true
+}
+
+// wallClockTime reads a time of the warehouse, times come truncated in the timezone of the calendar so the clock is
+// kept and the offset dropped
+func wallClockTime(value interface{}) (time.Time, bool) {
+	var t time.Time
+	switch v := value.(type) {
+	case time.Time:
+		t = v
+	case string:
+		var ok bool
+		if t, _, ok = parseTime(v); !ok {
+			return time.Time{}, false
+		}
+	default:
+		return time.Time{}, false
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC), true
+}
+
+// timeBucketColumn returns the alias of the field of the mapping on the time column, empty when it has none
+func timeBucketColumn(mapping widgetmodels.DataMappingFields, timeColumn string) string {
+	if timeColumn == "" {
+		return ""
+	}
+
+	names := make([]string, 0, len(mapping.Fields))
+	for name := range mapping.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, field := range mapping.Fields[name] {
+			if field.Aggregation == "" && (field.Column == timeColumn || field.GetExpression() == timeColumn) {
+				return *field.GetAlias()
+			}
+		}
+	}
+	return ""
+}
+
+func compareRows(rows dataplatformmodels.Rows, comparedRows dataplatformmodels.Rows, measures []string, dimensions []string, bucket timeBucket, shift func(time.Time) time.Time, name string) error {
+	rowKey := func(row map[string]interface{}, shift func(time.Time) time.Time) (string, bool) {
+		values := make([]string, len(dimensions), len(dimensions)+1)
+		for i, dimension := range dimensions {
+			values[i] = fmt.Sprint(row[dimension])
+		}
+		bucketKey, ok := bucket.key(row, shift)
+		return strings.Join(append(values, bucketKey), "\x00"), ok
+	}
+	// rows added for refs without data have no measures
+	hasMeasures := func(row map[string]interface{}) bool {
+		if len(measures) == 0 {
+			return false
+		}
+		_, ok := row[measures[0]]
+		return ok
+	}
+	unshifted := func(t time.Time) time.Time { return t }
+
+	comparedByKey := make(map[string]map[string]interface{})
+	for _, row := range comparedRows {
+		if !hasMeasures(row) {
+			continue
+		}
+		if key, ok := rowKey(row, unshifted); ok {
+			if _, exists := comparedByKey[key]; !exists {
+				comparedByKey[key] = row
+			}
+		}
+	}
+
+	for _, row := range rows {
+		if !hasMeasures(row) {
+			continue
+		}
+
+		var compared map[string]interface{}
+		if key, ok := rowKey(row, shift); ok {
+			compared = comparedByKey[key]
+		}
+
+		for _, measure := range measures {
+			column := comparisonColumn(measure, name)
+			row[column], row[column+widgetconstants.DELTA_SUFFIX], row[column+widgetconstants.DELTA_PERCENT_SUFFIX] = nil, nil, nil
+			if compared == nil {
+				continue
+			}
+
+			current, err := parseNumericValue(row[measure])
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", measure, err)
+			}
+			previous, err := parseNumericValue(compared[measure])
+			if err != nil {
+				return fmt.Errorf("failed to read %s of %s: %w", measure, name, err)
+			}
+
+			row[column] = previous
+			row[column+widgetconstants.DELTA_SUFFIX] = current - previous
+			row[column+widgetconstants.DELTA_PERCENT_SUFFIX] = conversionRate(current-previous, previous)
+		}
+	}
+
+	return nil
+}
+
+// splitMappingFields returns the aliases of the aggregated fields of the mapping and of the other fields but the time
+// column, in the order of the names of the fields
+func splitMappingFields(mapping widgetmodels.DataMappingFields, timeColumn string) ([]string, []string) {
+	names := make([]string, 0, len(mapping.Fields))
+	for name := range mapping.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	measures := []string{}
+	dimensions := []string{}
+	for _, name := range names {
+		for _, field := range mapping.Fields[name] {
+			switch {
+			case field.Aggregation != "":
+				measures = append(measures, *field.GetAlias())
+			case timeColumn == "" || (field.Column != timeColumn && field.GetExpression() != timeColumn):
+				dimensions = append(dimensions, *field.GetAlias())
+			}
+		}
+	}
+	return measures, dimensions
+}
+
+func comparisonRef(mappingRef string, comparison widgetmodels.Comparison) string {
+	return mappingRef + widgetconstants.COMPARISON_REF_SEPARATOR + comparison.GetName()
+}
+
+func comparisonColumn(measure string, name string) string {
+	return fmt.Sprintf("%s__%s", measure, name)
+}
+
+// shiftDatasetParams moves the conditions on the time column of the params to the period of the comparison, it tells
+// whether there was a period to move
+func shiftDatasetParams(params datasetmodels.DatasetParams, timeColumn string, comparison widgetmodels.Comparison) (datasetmodels.DatasetParams, bool, error) {
+	filters := params.Filters
+	if params.Subquery != nil {
+		filters = datasetmodels.FilterModel{Conditions: append(append([]datasetmodels.Filter{}, params.Filters.Conditions...), params.Subquery.Filters.Conditions...)}
+	}
+
+	shift, ok, err := comparisonShift(filters, timeColumn, comparison)
+	if err != nil || !ok {
+		return params, false, err
+	}
+
+	shifted := params
+	var shiftedFilters, shiftedSubquery bool
+	shifted.Filters.Conditions, shiftedFilters = shiftConditions(params.Filters.Conditions, timeColumn, shift)
+	if params.Subquery != nil {
+		subquery := *params.Subquery
+		subquery.Filters.Conditions, shiftedSubquery = shiftConditions(params.Subquery.Filters.Conditions, timeColumn, shift)
+		shifted.Subquery = &subquery
+	}
+
+	return shifted, shiftedFilters || shiftedSubquery, nil
+}
+
+// comparisonShift returns how the comparison moves a time back, the previous period needs both ends of the period
+func comparisonShift(filters datasetmodels.FilterModel, timeColumn string, comparison widgetmodels.Comparison) (func(time.Time) time.Time, bool, error) {
+	switch comparison.Type {
+	case widgetmodels.ComparisonPreviousPeriod:
+		start, end, endIncluded, ok := periodOf(filters.Conditions, timeColumn)
+		if !ok {
+			return nil, false, nil
+		}
+		// periods are whole days, the day of an included end counts in full and an excluded end starts the next period
+		days := int(math.Ceil(end.Sub(start).Hours() / 24))
+		if endIncluded {
+			days = int(end.Sub(start).Hours()/24) + 1
+		}
+		return func(t time.Time) time.Time { return t.AddDate(0, 0, -days) }, true, nil
+	case widgetmodels.ComparisonSamePeriodLastYear:
+		return func(t time.Time) time.Time { return t.AddDate(-1, 0, 0) }, true, nil
+	case widgetmodels.ComparisonCustom:
+		if comparison.Offset == nil {
+			return nil, false, fmt.Errorf("custom comparison %s has no offset", comparison.GetName())
+		}
+		value := comparison.Offset.Value
+		switch comparison.Offset.Unit {
+		case "day":
+			return func(t time.Time) time.Time { return t.AddDate(0, 0, -value) }, true, nil
+		case "week":
+			return func(t time.Time) time.Time { return t.AddDate(0, 0, -7*value) }, true, nil
+		case "month":
+			return func(t time.Time) time.Time { return t.AddDate(0, -value, 0) }, true, nil
+		case "quarter":
+			return func(t time.Time) time.Time { return t.AddDate(0, -3*value, 0) }, true, nil
+		case "year":
+			return func(t time.Time) time.Time { return t.AddDate(-value, 0, 0) }, true, nil
+		}
+		return nil, false, fmt.Errorf("custom comparison %s has an unknown unit %q", comparison.GetName(), comparison.Offset.Unit)
+	}
+
+	return nil, false, fmt.Errorf("unknown comparison %q", comparison.Type)
+}
+
+// periodOf returns the first and last time the conditions keep of the time column, and whether the last time is kept
+// along with the rest of its day, for an lte bound or a date of an inbetween condition
+func periodOf(conditions []datasetmodels.Filter, timeColumn string) (time.Time, time.Time, bool, bool) {
+	var start, end time.Time
+	var endIncluded bool
+	for _, condition := range conditions {
+		if len(condition.Conditions) > 0 {
+			if nestedStart, nestedEnd, nestedEndIncluded, ok := periodOf(condition.Conditions, timeColumn); ok {
+				start, end, endIncluded = nestedStart, nestedEnd, nestedEndIncluded
+			}
+			continue
+		}
+		if condition.Column != timeColumn {
+			continue
+		}
+
+		values := filterValues(condition.Value)
+		switch condition.Operator {
+		case querybuilderconstants.InBetweenOperator:
+			if len(values) == 2 {
+				var layout string
+				start, _, _ = parseTime(values[0])
+				end, layout, _ = parseTime(values[1])
+				endIncluded = layout == time.DateOnly
+			}
+		case querybuilderconstants.GreaterThanOperator, querybuilderconstants.GreaterThanOrEqualOperator:
+			if len(values) == 1 {
+				start, _, _ = parseTime(values[0])
+			}
+		case querybuilderconstants.LessThanOperator, querybuilderconstants.LessThanOrEqualOperator:
+			if len(values) == 1 {
+				end, _, _ = parseTime(values[0])
+				endIncluded = condition.Operator == querybuilderconstants.LessThanOrEqualOperator
+			}
+		}
+	}
+
+	return start, end, endIncluded, !start.IsZero() && !end.IsZero() && !end.Before(start)
+}
+
+// shiftConditions copies the conditions with the times of the time column moved, it tells whether any moved
+func shiftConditions(conditions []datasetmodels.Filter, timeColumn string, shift func(time.Time) time.Time) ([]datasetmodels.Filter, bool) {
+	return mapTimeConditions(conditions, timeColumn, func(value string) (interface{}, bool) {
+		t, layout, ok := parseTime(value)
+		if !ok {
+			return value, false
+		}
+		return shift(t).Format(layout), true
+	})
+}
+
+// mapTimeConditions copies the conditions with the values of the time column mapped, including the conditions of
+// groups, it tells whether any value was mapped
+func mapTimeConditions(conditions []datasetmodels.Filter, timeColumn string, mapValue func(string) (interface{}, bool)) ([]datasetmodels.Filter, bool) {
+	if conditions == nil {
+		return nil, false
+	}
+
+	mapped := make([]datasetmodels.Filter, len(conditions))
+	anyMapped := false
+	mapString := func(value string) interface{} {
+		result, ok := mapValue(value)
+		anyMapped = anyMapped || ok
+		return result
+	}
+	for i, condition := range conditions {
+		mapped[i] = condition
+		if len(condition.Conditions) > 0 {
+			var nestedMapped bool
+			mapped[i].Conditions, nestedMapped = mapTimeConditions(condition.Conditions, timeColumn, mapValue)
+			anyMapped = anyMapped || nestedMapped
+			continue
+		}
+		if condition.Column != timeColumn {
+			continue
+		}
+
+		switch value := condition.Value.(type) {
+		case string:
+			mapped[i].Value = mapString(value)
+		case []string:
+			values := make([]interface{}, len(value))
+			for j, v := range value {
+				values[j] = mapString(v)
+			}
+			mapped[i].Value = values
+		case []interface{}:
+			values := make([]interface{}, len(value))
+			for j, v := range value {
+				values[j] = v
+				if str, ok := v.(string); ok {
+					values[j] = mapString(str)
+				}
+			}
+			mapped[i].Value = values
+		}
+	}
+
+	return mapped, anyMapped
+}
+
+func filterValues(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, item := range v {
+			values[i] = fmt.Sprint(item)
+		}
+		return values
+	}
+	return nil
+}
+
+func parseTime(value string) (time.Time, string, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, layout, true
+		}
+	}
+	return time.Time{}, "", false
+}
@@ -0,0 +1,276 @@
+package widgets
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
+	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	dataplatformmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
+)
+
+func TestKPIStrategy_ToDatasetParamsWithComparisons(t *testing.T) {
+	target := 1000.0
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{
+				DatasetID: "transactions",
+				Ref:       "revenue",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.PrimaryValueField: {{Column: "amount", Aggregation: "sum"}},
+				},
+			}},
+			Comparisons: []widgetmodels.Comparison{
+				{Type: widgetmodels.ComparisonPreviousPeriod},
+				{Type: widgetmodels.ComparisonSamePeriodLastYear},
+				{Type: widgetmodels.ComparisonCustom, Name: "last_quarter", Offset: &widgetmodels.PeriodOffset{Value: 1, Unit: "quarter"}},
+			},
+			Target: &widgetmodels.Target{Type: widgetmodels.TargetDataset, DatasetID: "budget", Field: &widgetmodels.Field{Column: "planned", Aggregation: "sum"}},
+		},
+	}
+	builderParams := widgetmodels.DatasetBuilderParams{
+		Filters: map[string]widgetmodels.WidgetFilters{
+			"transactions": {DatasetID: "transactions", Filters: datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{
+				{Column: "posted_at", Operator: "inbetween", Value: []interface{}{"2025-02-01", "2025-02-28"}},
+				{Column: "status", Operator: "eq", Value: "posted"},
+			}}},
+		},
+		TimeColumns: map[string]string{"transactions": "posted_at"},
+	}
+
+	got, err := KPIStrategy{}.ToDatasetParams(instance, builderParams)
+	require.NoError(t, err)
+	assert.Len(t, got, 5)
+
+	period := func(ref string) interface{} {
+		return got[ref].Params.Filters.Conditions[0].Value
+	}
+	assert.Equal(t, []interface{}{"2025-02-01", "2025-02-28"}, period("revenue"))
+	assert.Equal(t, []interface{}{"2025-01-04", "2025-01-31"}, period("revenue__COMPARISON_previous_period"))
+	assert.Equal(t, []interface{}{"2024-02-01", "2024-02-28"}, period("revenue__COMPARISON_same_period_last_year"))
+	assert.Equal(t, []interface{}{"2024-11-01", "2024-11-28"}, period("revenue__COMPARISON_last_quarter"))
+	assert.Equal(t, "posted", got["revenue__COMPARISON_previous_period"].Params.Filters.Conditions[1].Value)
+
+	assert.Equal(t, "budget", got["__TARGET"].DatasetID)
+	assert.Equal(t, []datasetmodels.Aggregation{{Column: "planned", Function: "sum", Alias: "planned"}}, got["__TARGET"].Params.Aggregations)
+
+	// without a period on the time column there is nothing to compare
+	instance.DataMappings.Target = &widgetmodels.Target{Type: widgetmodels.TargetConstant, Value: &target}
+	got, err = KPIStrategy{}.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{})
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+}
+
+func TestBasicChartStrategy_MergeResults(t *testing.T) {
+	target := 100.0
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{
+				DatasetID: "transactions",
+				Ref:       "revenue",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.XAxisField:   {{Column: "posted_at"}},
+					widgetconstants.GroupByField: {{Column: "region"}},
+					widgetconstants.YAxisField:   {{Column: "amount", Aggregation: "sum"}},
+				},
+			}},
+			Comparisons: []widgetmodels.Comparison{{Type: widgetmodels.ComparisonSamePeriodLastYear, Name: "ly"}},
+			Target:      &widgetmodels.Target{Type: widgetmodels.TargetConstant, Value: &target},
+		},
+	}
+	results := map[string]datasetmodels.DatasetData{
+		"revenue": {QueryResult: dataplatformmodels.QueryResult{
+			Columns: []dataplatformmodels.ColumnMetadata{{Name: "posted_at"}, {Name: "region"}, {Name: "amount"}},
+			Rows: dataplatformmodels.Rows{
+				{"posted_at": "2025-01-01", "region": "EU", "amount": 120.0},
+				{"posted_at": "2025-02-01", "region": "EU", "amount": 80.0},
+				{"posted_at": "2025-01-01", "region": "US", "amount": 50.0},
+			},
+		}},
+		"revenue__COMPARISON_ly": {QueryResult: dataplatformmodels.QueryResult{
+			Rows: dataplatformmodels.Rows{
+				{"posted_at": "2024-01-01", "region": "EU", "amount": 100.0},
+				{"posted_at": "2024-02-01", "region": "EU", "amount": 0.0},
+			},
+		}},
+	}
+
+	got, err := BasicChartStrategy{}.MergeResults(instance, widgetmodels.DatasetBuilderParams{TimeColumns: map[string]string{"transactions": "posted_at"}}, results)
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, dataplatformmodels.Rows{
+		{"posted_at": "2025-01-01", "region": "EU", "amount": 120.0, "amount__ly": 100.0, "amount__ly__DELTA": 20.0, "amount__ly__DELTA_PCT": 0.2, "__TARGET": 100.0, "__ATTAINMENT": 1.2},
+		{"posted_at": "2025-02-01", "region": "EU", "amount": 80.0, "amount__ly": 0.0, "amount__ly__DELTA": 80.0, "amount__ly__DELTA_PCT": nil, "__TARGET": 100.0, "__ATTAINMENT": 0.8},
+		{"posted_at": "2025-01-01", "region": "US", "amount": 50.0, "amount__ly": nil, "amount__ly__DELTA": nil, "amount__ly__DELTA_PCT": nil, "__TARGET": 100.0, "__ATTAINMENT": 0.5},
+	}, got["revenue"].Rows)
+	assert.Equal(t, []string{"posted_at", "region", "amount", "amount__ly", "amount__ly__DELTA", "amount__ly__DELTA_PCT", "__TARGET", "__ATTAINMENT"}, columnNames(got["revenue"].Columns))
+}
+
+func TestBasicChartStrategy_MergeResultsByTimeBucket(t *testing.T) {
+	periodicity := "month"
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{
+				DatasetID: "transactions",
+				Ref:       "revenue",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.XAxisField:   {{Column: "posted_at"}},
+					widgetconstants.GroupByField: {{Column: "region"}},
+					widgetconstants.YAxisField:   {{Column: "amount", Aggregation: "sum"}},
+				},
+			}},
+			Comparisons: []widgetmodels.Comparison{{Type: widgetmodels.ComparisonCustom, Name: "two_months", Offset: &widgetmodels.PeriodOffset{Value: 2, Unit: "month"}}},
+		},
+	}
+	results := map[string]datasetmodels.DatasetData{
+		"revenue": {QueryResult: dataplatformmodels.QueryResult{
+			Rows: dataplatformmodels.Rows{
+				{"posted_at": "2025-03-01T00:00:00.000+00:00", "region": "EU", "amount": 120.0},
+				{"posted_at": "2025-04-01T00:00:00.000+00:00", "region": "EU", "amount": 80.0},
+				{"posted_at": "2025-04-01T00:00:00.000+00:00", "region": "US", "amount": 50.0},
+			},
+		}},
+		// january has no rows in EU, the february rows must not be taken for it
+		"revenue__COMPARISON_two_months": {QueryResult: dataplatformmodels.QueryResult{
+			Rows: dataplatformmodels.Rows{
+				{"posted_at": "2025-02-01T00:00:00.000+00:00", "region": "US", "amount": 10.0},
+				{"posted_at": "2025-02-01T00:00:00.000+00:00", "region": "EU", "amount": 40.0},
+			},
+		}},
+	}
+
+	got, err := BasicChartStrategy{}.MergeResults(instance, widgetmodels.DatasetBuilderParams{
+		TimeColumns: map[string]string{"transactions": "posted_at"},
+		Periodicity: &periodicity,
+		Calendar:    calendar.Settings{Timezone: "America/New_York"},
+	}, results)
+	require.NoError(t, err)
+	assert.Equal(t, dataplatformmodels.Rows{
+		{"posted_at": "2025-03-01T00:00:00.000+00:00", "region": "EU", "amount": 120.0, "amount__two_months": nil, "amount__two_months__DELTA": nil, "amount__two_months__DELTA_PCT": nil},
+		{"posted_at": "2025-04-01T00:00:00.000+00:00", "region": "EU", "amount": 80.0, "amount__two_months": 40.0, "amount__two_months__DELTA": 40.0, "amount__two_months__DELTA_PCT": 1.0},
+		{"posted_at": "2025-04-01T00:00:00.000+00:00", "region": "US", "amount": 50.0, "amount__two_months": 10.0, "amount__two_months__DELTA": 40.0, "amount__two_months__DELTA_PCT": 4.0},
+	}, got["revenue"].Rows)
+}
+
+func TestKPIStrategy_MergeResultsWithDatasetTarget(t *testing.T) {
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{
+				Ref: "revenue",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.PrimaryValueField: {{Column: "amount", Aggregation: "sum", Alias: "revenue"}},
+				},
+			}},
+			Target: &widgetmodels.Target{Type: widgetmodels.TargetDataset, DatasetID: "budget", Field: &widgetmodels.Field{Column: "planned", Aggregation: "sum"}},
+		},
+	}
+	results := map[string]datasetmodels.DatasetData{
+		"revenue":  {QueryResult: dataplatformmodels.QueryResult{Rows: dataplatformmodels.Rows{{"revenue": 450.0}}}},
+		"__TARGET": {QueryResult: dataplatformmodels.QueryResult{Rows: dataplatformmodels.Rows{{"planned": 500.0}}}},
+	}
+
+	got, err := KPIStrategy{}.MergeResults(instance, widgetmodels.DatasetBuilderParams{}, results)
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, dataplatformmodels.Rows{{"revenue": 450.0, "__TARGET": 500.0, "__ATTAINMENT": 0.9}}, got["revenue"].Rows)
+}
+
+func TestComparisonShift(t *testing.T) {
+	period := datasetmodels.FilterModel{Conditions: []datasetmodels.Filter{
+		{Column: "posted_at", Operator: "gte", Value: "2025-03-01 00:00:00"},
+		{Column: "posted_at", Operator: "lte", Value: "2025-03-31 23:59:59"},
+	}}
+
+	tests := []struct {
+		name       string
+		filters    datasetmodels.FilterModel
+		comparison widgetmodels.Comparison
+		want       []datasetmodels.Filter
+		wantOk     bool
+		wantErr    bool
+	}{
+		{
+			name:       "previous period of a month",
+			filters:    period,
+			comparison: widgetmodels.Comparison{Type: widgetmodels.ComparisonPreviousPeriod},
+			want: []datasetmodels.Filter{
+				{Column: "posted_at", Operator: "gte", Value: "2025-01-29 00:00:00"},
+				{Column: "posted_at", Operator: "lte", Value: "2025-02-28 23:59:59"},
+			},
+			wantOk: true,
+		},
+		{
+			name: "previous period of a month with an excluded end",
+			filters: datasetmodels.FilterModel{Conditions: []datasetmodels.Filter{
+				{Column: "posted_at", Operator: "gte", Value: "2025-03-01"},
+				{Column: "posted_at", Operator: "lt", Value: "2025-04-01"},
+			}},
+			comparison: widgetmodels.Comparison{Type: widgetmodels.ComparisonPreviousPeriod},
+			want: []datasetmodels.Filter{
+				{Column: "posted_at", Operator: "gte", Value: "2025-01-29"},
+				{Column: "posted_at", Operator: "lt", Value: "2025-03-01"},
+			},
+			wantOk: true,
+		},
+		{
+			name: "previous period of dates in between",
+			filters: datasetmodels.FilterModel{Conditions: []datasetmodels.Filter{
+				{Column: "posted_at", Operator: "inbetween", Value: []interface{}{"2025-03-01", "2025-03-31"}},
+			}},
+			comparison: widgetmodels.Comparison{Type: widgetmodels.ComparisonPreviousPeriod},
+			want: []datasetmodels.Filter{
+				{Column: "posted_at", Operator: "inbetween", Value: []interface{}{"2025-01-29", "2025-02-28"}},
+			},
+			wantOk: true,
+		},
+		{
+			name:       "custom offset in weeks",
+			filters:    period,
+			comparison: widgetmodels.Comparison{Type: widgetmodels.ComparisonCustom, Offset: &widgetmodels.PeriodOffset{Value: 2, Unit: "week"}},
+			want: []datasetmodels.Filter{
+				{Column: "posted_at", Operator: "gte", Value: "2025-02-15 00:00:00"},
+				{Column: "posted_at", Operator: "lte", Value: "2025-03-17 23:59:59"},
+			},
+			wantOk: true,
+		},
+		{
+			name:       "previous period without an end",
+			filters:    datasetmodels.FilterModel{Conditions: period.Conditions[:1]},
+			comparison: widgetmodels.Comparison{Type: widgetmodels.ComparisonPreviousPeriod},
+		},
+		{
+			name:       "no condition on the time column",
+			filters:    datasetmodels.FilterModel{Conditions: []datasetmodels.Filter{{Column: "status", Operator: "eq", Value: "2025-01-01"}}},
+			comparison: widgetmodels.Comparison{Type: widgetmodels.ComparisonSamePeriodLastYear},
+		},
+		{
+			name:       "unknown unit",
+			filters:    period,
+			comparison: widgetmodels.Comparison{Type: widgetmodels.ComparisonCustom, Offset: &widgetmodels.PeriodOffset{Value: 1, Unit: "decade"}},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := fmt.Sprint(tt.filters.Conditions)
+			got, ok, err := shiftDatasetParams(datasetmodels.DatasetParams{Filters: tt.filters}, "posted_at", tt.comparison)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, got.Filters.Conditions)
+				assert.Equal(t, original, fmt.Sprint(tt.filters.Conditions))
+			}
+		})
+	}
+}
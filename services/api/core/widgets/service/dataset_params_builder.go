@@ -2,25 +2,37 @@ package widgets
 
 import (
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	dataplatformdataConstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
 	datasetconstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
 	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
 	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	dataplatformmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
 	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
 )
 
 var widgetStrategies = map[string]DatasetParamsBuilder{
-	"bar_chart":   BasicChartStrategy{BaseStrategy: *NewBaseStrategy()},
-	"line_chart":  BasicChartStrategy{BaseStrategy: *NewBaseStrategy()},
-	"pie_chart":   PieChartStrategy{BaseStrategy: *NewBaseStrategy()},
-	"donut_chart": PieChartStrategy{BaseStrategy: *NewBaseStrategy()},
-	"pivot_table": PivotTableStrategy{BaseStrategy: *NewBaseStrategy()},
-	"kpi":         KPIStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeBarChart:        BasicChartStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeLineChart:       BasicChartStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeAreaChart:       BasicChartStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeStackedBarChart: BasicChartStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypePieChart:        PieChartStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeDonutChart:      PieChartStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypePivotTable:      PivotTableStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeKPI:             KPIStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeDataTable:       DataTableStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeWaterfallChart:  WaterfallChartStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeFunnelChart:     FunnelChartStrategy{BaseStrategy: *NewBaseStrategy()},
+	widgetconstants.WidgetTypeHeatmap:         HeatmapStrategy{BaseStrategy: *NewBaseStrategy()},
 }
 
 func NewDatasetParamsBuilder(widgetType string) (DatasetParamsBuilder, error) {
@@ -35,6 +47,19 @@ type DatasetParamsBuilder interface {
 	ToDatasetParams(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams) (map[string]widgetmodels.GetDataByDatasetIDParams, error)
 }
 
+// DatasetDataTransformer is implemented by the strategies of widgets computing values the queries cannot, like running
+// totals. The data is in the order of the mappings of the instance
+type DatasetDataTransformer interface {
+	TransformData(instance *widgetmodels.WidgetInstance, data []datasetmodels.DatasetData) ([]datasetmodels.DatasetData, error)
+}
+
+// DatasetResultsMerger is implemented by the strategies of widgets querying more than the data of their mappings, like
+// the totals of a pivot table. The results are keyed by the refs of the queries and come back keyed by the refs of the
+// mappings
+type DatasetResultsMerger interface {
+	MergeResults(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams, results map[string]datasetmodels.DatasetData) (map[string]datasetmodels.DatasetData, error)
+}
+
 // ProcessFieldsFunc is a function type for processing specific fields in a dataset params
 type ProcessFieldsFunc func(*datasetmodels.DatasetParams, *widgetmodels.DataMappingFields, *datasetmodels.FilterModel, *widgetmodels.DatasetBuilderParams) error
 
@@ -44,6 +69,13 @@ type ParameterProcessor interface {
 	Process(match string, parts []string, populationValues []string) string
 }
 
+// parameterProcessors build the processors parameters are populated with, a parameter is populated by the first one
+// that can process it
+var parameterProcessors = []func(b *BaseStrategy) ParameterProcessor{
+	func(b *BaseStrategy) ParameterProcessor { return &DateParameterProcessor{BaseStrategy: b} },
+	func(b *BaseStrategy) ParameterProcessor { return &VariableParameterProcessor{BaseStrategy: b} },
+}
+
 // DateParameterProcessor processes date-related parameters
 type DateParameterProcessor struct {
 	BaseStrategy *BaseStrategy
@@ -76,11 +108,69 @@ func (p *DateParameterProcessor) Process(match string, parts []string, populatio
 	return computedTime.Format(time.DateTime)
 }
 
+// VariableParameterProcessor processes the variables of the sheet, date variables take the methods of the date
+// parameters
+type VariableParameterProcessor struct {
+	BaseStrategy *BaseStrategy
+}
+
+// CanProcess checks if the parameter is a variable of the sheet
+func (p *VariableParameterProcessor) CanProcess(paramName string) bool {
+	_, ok := p.BaseStrategy.variable(paramName)
+	return ok
+}
+
+// Process processes a variable match
+func (p *VariableParameterProcessor) Process(match string, parts []string, populationValues []string) string {
+	variable, _ := p.BaseStrategy.variable(parts[1])
+	if len(parts) < 3 || parts[2] == "" {
+		return variable.Value
+	}
+
+	if variable.Type != sheetmodels.VariableTypeDate {
+		return match
+	}
+	baseTime, err := time.ParseInLocation(time.DateTime, variable.Value, p.BaseStrategy.calendar.Location())
+	if err != nil {
+		return match
+	}
+
+	args := ""
+	if len(parts) >= 4 {
+		args = parts[3]
+	}
+	return p.BaseStrategy.ApplyMethod(baseTime, parts[2], args).Format(time.DateTime)
+}
+
+// sqlLiteralProcessor populates parameters as literals of SQL expressions, the values of number variables are used as
+// they are and every other value is quoted
+type sqlLiteralProcessor struct {
+	ParameterProcessor
+	BaseStrategy *BaseStrategy
+}
+
+// Process processes a parameter match with the processor it wraps
+func (p sqlLiteralProcessor) Process(match string, parts []string, populationValues []string) string {
+	value := p.ParameterProcessor.Process(match, parts, populationValues)
+	if value == match {
+		return match
+	}
+
+	if variable, ok := p.BaseStrategy.variable(parts[1]); ok && variable.Type == sheetmodels.VariableTypeNumber {
+		return value
+	}
+	return sqlStringLiteral(value)
+}
+
 // BaseStrategy provides common functionality for all dataset parameter builder strategies
 type BaseStrategy struct {
 	// Pre-compiled regex patterns for better performance
 	parameterizedValueRegex *regexp.Regexp
 	defaultParametersRegex  *regexp.Regexp
+	// calendar of the organization and variables of the sheet the params are built for, set from the builder params.
+	// Strategies are copied for every widget they build the params of
+	calendar  calendar.Settings
+	variables map[string]widgetmodels.Variable
 }
 
 // NewBaseStrategy creates a new BaseStrategy with pre-compiled regex patterns
@@ -223,12 +313,51 @@ func (b *BaseStrategy) IsParametrizedString(value string) bool { // Supports for
 
 // PopulateParams populates parameters in a string using registered processors
 func (b *BaseStrategy) PopulateParams(value string, sheetConditionValues []string) string {
-	processors := []ParameterProcessor{
-		&DateParameterProcessor{BaseStrategy: b},
-		// Add more processors here as needed
+	return b.processWithProcessors(value, sheetConditionValues, b.parameterProcessors())
+}
+
+// PopulateExpressionParams populates the parameters of a field expression as SQL literals
+func (b *BaseStrategy) PopulateExpressionParams(expression string) string {
+	processors := b.parameterProcessors()
+	for i := range processors {
+		processors[i] = sqlLiteralProcessor{ParameterProcessor: processors[i], BaseStrategy: b}
 	}
 
-	return b.processWithProcessors(value, sheetConditionValues, processors)
+	return b.processWithProcessors(expression, nil, processors)
+}
+
+func (b *BaseStrategy) parameterProcessors() []ParameterProcessor {
+	processors := make([]ParameterProcessor, 0, len(parameterProcessors))
+	for _, newProcessor := range parameterProcessors {
+		processors = append(processors, newProcessor(b))
+	}
+	return processors
+}
+
+// variable returns the variable of the sheet a parameter names
+func (b *BaseStrategy) variable(paramName string) (widgetmodels.Variable, bool) {
+	name, ok := strings.CutPrefix(paramName, widgetconstants.DefaultVariableSymbol)
+	if !ok {
+		return widgetmodels.Variable{}, false
+	}
+	variable, ok := b.variables[name]
+	return variable, ok
+}
+
+// populateFieldExpressions returns a copy of the mapping with the parameters of the expressions of its fields populated
+func (b *BaseStrategy) populateFieldExpressions(mapping *widgetmodels.DataMappingFields) *widgetmodels.DataMappingFields {
+	populated := *mapping
+	populated.Fields = make(map[string][]widgetmodels.Field, len(mapping.Fields))
+	for name, fields := range mapping.Fields {
+		populated.Fields[name] = make([]widgetmodels.Field, len(fields))
+		for i, field := range fields {
+			if field.Expression != "" {
+				field.Expression = b.PopulateExpressionParams(field.Expression)
+			}
+			populated.Fields[name][i] = field
+		}
+	}
+	return &populated
 }
 
 // processWithProcessors processes a string with the given processors
@@ -265,7 +394,7 @@ func (b *BaseStrategy) PopulateDateParams(value string, populationValues []strin
 
 // GetBaseTime gets the base time for date parameters
 func (b *BaseStrategy) GetBaseTime(paramName string, populationValues []string) time.Time {
-	now := time.Now()
+	now := b.calendar.Now()
 	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 
 	switch paramName {
@@ -334,6 +463,18 @@ func (b *BaseStrategy) ApplyMethod(baseTime time.Time, method string, args strin
 		}
 		return baseTime.Add(time.Duration(seconds) * time.Second)
 
+	case widgetconstants.ParameterMethodStartOf, widgetconstants.ParameterMethodEndOf:
+		periodBound := b.calendar.PeriodStart
+		if method == widgetconstants.ParameterMethodEndOf {
+			periodBound = b.calendar.PeriodEnd
+		}
+		bound, err := periodBound(baseTime, strings.TrimSpace(args))
+		if err != nil {
+			// unknown periods fail the params in checkPeriodMethods before parameters are populated
+			return baseTime
+		}
+		return bound
+
 	default:
 		// Log error and return default
 		fmt.Printf("Error: Unknown method '%s'\n", method)
@@ -353,19 +494,19 @@ func (b *BaseStrategy) AddTimeColumn(params *datasetmodels.DatasetParams, timeCo
 
 	for i := range params.Columns {
 		if params.Columns[i].Column == timeColumnMap[datasetID] {
-			params.Columns[i].Column = fmt.Sprintf("date_trunc('%s', %s)", *periodicity, params.Columns[i].Column)
+			params.Columns[i].Column = truncateTime(b.calendar, *periodicity, params.Columns[i].Column)
 		}
 	}
 
 	for i := range params.GroupBy {
 		if params.GroupBy[i].Column == timeColumnMap[datasetID] {
-			params.GroupBy[i].Column = fmt.Sprintf("date_trunc('%s', %s)", *periodicity, params.GroupBy[i].Column)
+			params.GroupBy[i].Column = truncateTime(b.calendar, *periodicity, params.GroupBy[i].Column)
 		}
 	}
 
 	for i := range params.OrderBy {
 		if params.OrderBy[i].Column == timeColumnMap[datasetID] {
-			params.OrderBy[i].Column = fmt.Sprintf("date_trunc('%s', %s)", *periodicity, params.OrderBy[i].Column)
+			params.OrderBy[i].Column = truncateTime(b.calendar, *periodicity, params.OrderBy[i].Column)
 		}
 	}
 
@@ -402,7 +543,7 @@ func (b *BaseStrategy) HandleAggregation(params *datasetmodels.DatasetParams, fi
 			if len(params.Subquery.Windows) > 0 {
 				for i := range params.Subquery.Windows[0].PartitionBy {
 					if len(datasetBuilderParams.TimeColumns) > 0 && datasetBuilderParams.Periodicity != nil && params.Subquery.Windows[0].PartitionBy[i].Column == datasetBuilderParams.TimeColumns[mapping.DatasetID] {
-						params.Subquery.Windows[0].PartitionBy[i].Column = fmt.Sprintf("date_trunc('%s', %s)", *datasetBuilderParams.Periodicity, params.Subquery.Windows[0].PartitionBy[i].Column)
+						params.Subquery.Windows[0].PartitionBy[i].Column = truncateTime(datasetBuilderParams.Calendar, *datasetBuilderParams.Periodicity, params.Subquery.Windows[0].PartitionBy[i].Column)
 					}
 				}
 			}
@@ -515,6 +656,31 @@ func (b *BaseStrategy) AddSortBy(params *datasetmodels.DatasetParams, sortBy []w
 	}
 }
 
+// SortByFirst sorts the rows by the columns before the sorts of the mapping, for charts whose values are built up
+// row after row
+func (b *BaseStrategy) SortByFirst(params *datasetmodels.DatasetParams, aliases []*string) {
+	orderBy := make([]datasetmodels.OrderBy, 0, len(aliases)+len(params.OrderBy))
+	sortedColumns := make(map[string]bool)
+	for _, alias := range aliases {
+		if sortedColumns[*alias] {
+			continue
+		}
+		orderBy = append(orderBy, datasetmodels.OrderBy{
+			Column: *alias,
+			Order:  datasetmodels.OrderType(querybuilderconstants.OrderAsc),
+			Alias:  alias,
+		})
+		sortedColumns[*alias] = true
+	}
+
+	for _, sort := range params.OrderBy {
+		if !sortedColumns[sort.Column] {
+			orderBy = append(orderBy, sort)
+		}
+	}
+	params.OrderBy = orderBy
+}
+
 // AddCurrency adds currency to dataset params
 func (b *BaseStrategy) AddCurrency(params *datasetmodels.DatasetParams, currency *string) {
 	if params.Subquery != nil {
@@ -534,13 +700,55 @@ func (b *BaseStrategy) InitializeDatasetParams(filters datasetmodels.FilterModel
 	}
 }
 
+// checkPeriodMethods fails when a parameter of the default filters, the sheet filters or field expressions of the mapping
+// takes the start or end of a period the calendar does not have
+func (b *BaseStrategy) checkPeriodMethods(mapping *widgetmodels.DataMappingFields, sheetFilters datasetmodels.FilterModel) error {
+	var values []string
+	if mapping.DefaultFilters != nil {
+		for _, condition := range mapping.DefaultFilters.Conditions {
+			values = append(values, filterValues(condition.Value)...)
+		}
+	}
+	for _, condition := range sheetFilters.Conditions {
+		values = append(values, filterValues(condition.Value)...)
+	}
+	for _, fields := range mapping.Fields {
+		for _, field := range fields {
+			values = append(values, field.Expression)
+		}
+	}
+
+	if b.defaultParametersRegex == nil {
+		b.defaultParametersRegex = regexp.MustCompile(widgetconstants.DefaultParametersRegex)
+	}
+	for _, value := range values {
+		for _, parts := range b.defaultParametersRegex.FindAllStringSubmatch(value, -1) {
+			if parts[2] != widgetconstants.ParameterMethodStartOf && parts[2] != widgetconstants.ParameterMethodEndOf {
+				continue
+			}
+			if _, err := b.calendar.PeriodStart(b.calendar.Now(), strings.TrimSpace(parts[3])); err != nil {
+				return fmt.Errorf("%w: %s of %s: %w", ErrInvalidDataMappings, parts[2], parts[1], err)
+			}
+		}
+	}
+
+	return nil
+}
+
 // ProcessDatasetParams is a template method that handles the common flow of operations for processing dataset parameters
 func (b *BaseStrategy) ProcessDatasetParams(mapping *widgetmodels.DataMappingFields, datasetbuilderparams widgetmodels.DatasetBuilderParams, processFields ProcessFieldsFunc) (widgetmodels.GetDataByDatasetIDParams, error) {
+	b.calendar = datasetbuilderparams.Calendar
+	b.variables = datasetbuilderparams.Variables
 	var sheetFilters datasetmodels.FilterModel
 	if filterSet, exists := datasetbuilderparams.Filters[mapping.DatasetID]; exists {
 		sheetFilters = filterSet.Filters
 	}
 
+	if err := b.checkPeriodMethods(mapping, sheetFilters); err != nil {
+		return widgetmodels.GetDataByDatasetIDParams{}, err
+	}
+	mapping = b.populateFieldExpressions(mapping)
+
 	populatedDefaultFilters := b.ParametrizeDefaultFilters(mapping.DefaultFilters, mapping.DatasetID, datasetbuilderparams)
 	combinedFilters := b.MergeFilters(populatedDefaultFilters, &sheetFilters)
 	params := b.InitializeDatasetParams(combinedFilters)
@@ -605,9 +813,86 @@ func (b BasicChartStrategy) ToDatasetParams(instance *widgetmodels.WidgetInstanc
 		return nil, err
 	}
 
-	return map[string]widgetmodels.GetDataByDatasetIDParams{
+	// running totals add up the rows along the x-axis
+	if columns := cumulativeColumns(instance.DataMappings.Mappings[0]); columns != nil {
+		b.SortByFirst(&result.Params, columns)
+	}
+
+	queries := map[string]widgetmodels.GetDataByDatasetIDParams{
 		instance.DataMappings.Mappings[0].Ref: result,
-	}, nil
+	}
+	if err := b.AddComparisons(instance, datasetbuilderparams, queries); err != nil {
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+// MergeResults adds the comparisons and the target of the chart to its data
+func (b BasicChartStrategy) MergeResults(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams, results map[string]datasetmodels.DatasetData) (map[string]datasetmodels.DatasetData, error) {
+	return b.MergeComparisons(instance, datasetbuilderparams, results)
+}
+
+// cumulativeColumns returns the x-axis and group by columns of a mapping with a cumulative y-axis, nil otherwise
+func cumulativeColumns(mapping widgetmodels.DataMappingFields) []*string {
+	yAxis, ok := mapping.Fields[widgetconstants.YAxisField]
+	if !ok || len(yAxis) == 0 || !yAxis[0].Cumulative {
+		return nil
+	}
+
+	columns := []*string{}
+	if xAxis, ok := mapping.Fields[widgetconstants.XAxisField]; ok && len(xAxis) > 0 {
+		columns = append(columns, xAxis[0].GetAlias())
+	}
+	for _, field := range mapping.Fields[widgetconstants.GroupByField] {
+		columns = append(columns, field.GetAlias())
+	}
+	return columns
+}
+
+// TransformData turns the y-axis into a running total when it is cumulative, the total restarts for every group.
+// The rows are added up in the order of the x-axis whatever order they come in.
+func (b BasicChartStrategy) TransformData(instance *widgetmodels.WidgetInstance, data []datasetmodels.DatasetData) ([]datasetmodels.DatasetData, error) {
+	if len(instance.DataMappings.Mappings) == 0 || len(data) == 0 {
+		return data, nil
+	}
+
+	mapping := instance.DataMappings.Mappings[0]
+	columns := cumulativeColumns(mapping)
+	if columns == nil {
+		return data, nil
+	}
+
+	sortColumns := make([]string, len(columns))
+	for i, column := range columns {
+		sortColumns[i] = *column
+	}
+	sortRows(data[0].Rows, sortColumns)
+
+	valueColumn := *mapping.Fields[widgetconstants.YAxisField][0].GetAlias()
+	groupColumns := []string{}
+	for _, field := range mapping.Fields[widgetconstants.GroupByField] {
+		groupColumns = append(groupColumns, *field.GetAlias())
+	}
+
+	runningTotals := make(map[string]float64)
+	for _, row := range data[0].Rows {
+		groupValues := make([]string, len(groupColumns))
+		for i, column := range groupColumns {
+			groupValues[i] = fmt.Sprint(row[column])
+		}
+		group := strings.Join(groupValues, "\x00")
+
+		value, err := parseNumericValue(row[valueColumn])
+		if err != nil {
+			return nil, fmt.Errorf("failed to accumulate %s: %w", valueColumn, err)
+		}
+
+		runningTotals[group] += value
+		row[valueColumn] = runningTotals[group]
+	}
+
+	return data, nil
 }
 
 type PieChartStrategy struct {
@@ -652,53 +937,188 @@ type PivotTableStrategy struct {
 	BaseStrategy
 }
 
+// ToDatasetParams groups the values by the row and column fields, every total the widget asks for is a query of its
+// own grouped by the fields it does not add up
 func (p PivotTableStrategy) ToDatasetParams(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams) (map[string]widgetmodels.GetDataByDatasetIDParams, error) {
 	result := make(map[string]widgetmodels.GetDataByDatasetIDParams)
 
 	for _, mapping := range instance.DataMappings.Mappings {
-		datasetResult, err := p.ProcessDatasetParams(&mapping, datasetbuilderparams, func(params *datasetmodels.DatasetParams, mapping *widgetmodels.DataMappingFields, filters *datasetmodels.FilterModel, datasetBuilderParams *widgetmodels.DatasetBuilderParams) error {
-			// Handle row fields
-			if rows, ok := mapping.Fields[widgetconstants.RowsField]; ok {
-				for _, row := range rows {
-					expression := row.GetExpression()
-					if expression == "" {
-						expression = row.Column
-					}
-					params.GroupBy = append(params.GroupBy, datasetmodels.GroupBy{Column: expression, Alias: row.GetAlias()})
-				}
+		datasetResult, err := p.ProcessDatasetParams(&mapping, datasetbuilderparams, p.processFields)
+		if err != nil {
+			return nil, err
+		}
+
+		result[mapping.Ref] = datasetResult
+
+		for _, total := range pivotTotals(instance.DataMappings.Totals, len(mapping.Fields[widgetconstants.RowsField]), len(mapping.Fields[widgetconstants.ColumnsField])) {
+			totalMapping := p.rollUp(mapping, total)
+			totalResult, err := p.ProcessDatasetParams(&totalMapping, datasetbuilderparams, p.processFields)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build pivot-table %s: %w", total.kind, err)
 			}
 
-			// Handle column fields
-			if cols, ok := mapping.Fields[widgetconstants.ColumnsField]; ok {
-				for _, col := range cols {
-					expression := col.GetExpression()
-					if expression == "" {
-						expression = col.Column
-					}
-					params.GroupBy = append(params.GroupBy, datasetmodels.GroupBy{Column: expression, Alias: col.GetAlias()})
-				}
+			result[total.ref(mapping.Ref)] = totalResult
+		}
+	}
+
+	return result, nil
+}
+
+func (p PivotTableStrategy) processFields(params *datasetmodels.DatasetParams, mapping *widgetmodels.DataMappingFields, filters *datasetmodels.FilterModel, datasetBuilderParams *widgetmodels.DatasetBuilderParams) error {
+	// Handle row fields
+	if rows, ok := mapping.Fields[widgetconstants.RowsField]; ok {
+		for _, row := range rows {
+			expression := row.GetExpression()
+			if expression == "" {
+				expression = row.Column
 			}
+			params.GroupBy = append(params.GroupBy, datasetmodels.GroupBy{Column: expression, Alias: row.GetAlias()})
+		}
+	}
 
-			// Handle value fields
-			if values, ok := mapping.Fields[widgetconstants.ValuesField]; ok {
-				for _, value := range values {
-					if err := p.HandleAggregation(params, value, mapping, filters, datasetBuilderParams); err != nil {
-						return fmt.Errorf("failed to handle aggregation for pivot-table value: %w", err)
-					}
+	// Handle column fields
+	if cols, ok := mapping.Fields[widgetconstants.ColumnsField]; ok {
+		for _, col := range cols {
+			expression := col.GetExpression()
+			if expression == "" {
+				expression = col.Column
+			}
+			params.GroupBy = append(params.GroupBy, datasetmodels.GroupBy{Column: expression, Alias: col.GetAlias()})
+		}
+	}
+
+	// Handle value fields
+	if values, ok := mapping.Fields[widgetconstants.ValuesField]; ok {
+		for _, value := range values {
+			if err := p.HandleAggregation(params, value, mapping, filters, datasetBuilderParams); err != nil {
+				return fmt.Errorf("failed to handle aggregation for pivot-table value: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// rollUp returns the mapping of the total, grouped by the first row and column fields only. Sorting by a field the
+// total adds up is left out
+func (p PivotTableStrategy) rollUp(mapping widgetmodels.DataMappingFields, total pivotTotal) widgetmodels.DataMappingFields {
+	rows := mapping.Fields[widgetconstants.RowsField]
+	columns := mapping.Fields[widgetconstants.ColumnsField]
+
+	addedUp := make(map[string]bool)
+	for _, field := range append(slices.Clone(rows[total.rows:]), columns[total.columns:]...) {
+		addedUp[*field.GetAlias()] = true
+	}
+
+	rolledUp := mapping
+	rolledUp.Fields = maps.Clone(mapping.Fields)
+	rolledUp.Fields[widgetconstants.RowsField] = rows[:total.rows]
+	rolledUp.Fields[widgetconstants.ColumnsField] = columns[:total.columns]
+	rolledUp.SortBy = slices.DeleteFunc(slices.Clone(mapping.SortBy), func(sortBy widgetmodels.SortBy) bool {
+		return addedUp[sortBy.GetColumn()]
+	})
+
+	return rolledUp
+}
+
+// MergeResults adds the rows of the totals to the cells of their mapping. The fields a total adds up have no value in
+// its rows and every row says which total it is, if any, and how many row and column fields it is grouped by
+func (p PivotTableStrategy) MergeResults(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams, results map[string]datasetmodels.DatasetData) (map[string]datasetmodels.DatasetData, error) {
+	for _, mapping := range instance.DataMappings.Mappings {
+		rows := mapping.Fields[widgetconstants.RowsField]
+		columns := mapping.Fields[widgetconstants.ColumnsField]
+
+		totals := pivotTotals(instance.DataMappings.Totals, len(rows), len(columns))
+		data, ok := results[mapping.Ref]
+		if len(totals) == 0 || !ok {
+			continue
+		}
+
+		for _, row := range data.Rows {
+			row[widgetconstants.PIVOT_TOTAL_COLUMN] = nil
+			row[widgetconstants.PIVOT_ROW_LEVEL_COLUMN] = len(rows)
+			row[widgetconstants.PIVOT_COLUMN_LEVEL_COLUMN] = len(columns)
+		}
+
+		for _, total := range totals {
+			totalData, ok := results[total.ref(mapping.Ref)]
+			if !ok {
+				continue
+			}
+			delete(results, total.ref(mapping.Ref))
+
+			for _, row := range totalData.Rows {
+				for _, field := range append(slices.Clone(rows[total.rows:]), columns[total.columns:]...) {
+					row[*field.GetAlias()] = nil
 				}
+				if _, ok := row[widgetconstants.REF_PREFIX]; ok {
+					row[widgetconstants.REF_PREFIX] = mapping.Ref
+				}
+				row[widgetconstants.PIVOT_TOTAL_COLUMN] = total.kind
+				row[widgetconstants.PIVOT_ROW_LEVEL_COLUMN] = total.rows
+				row[widgetconstants.PIVOT_COLUMN_LEVEL_COLUMN] = total.columns
+				data.Rows = append(data.Rows, row)
 			}
+		}
 
-			return nil
-		})
+		data.Columns = append(data.Columns,
+			dataplatformmodels.ColumnMetadata{Name: widgetconstants.PIVOT_TOTAL_COLUMN, DatabaseType: string(dataplatformdataConstants.StringDataType)},
+			dataplatformmodels.ColumnMetadata{Name: widgetconstants.PIVOT_ROW_LEVEL_COLUMN, DatabaseType: string(dataplatformdataConstants.IntegerDataType)},
+			dataplatformmodels.ColumnMetadata{Name: widgetconstants.PIVOT_COLUMN_LEVEL_COLUMN, DatabaseType: string(dataplatformdataConstants.IntegerDataType)},
+		)
+		results[mapping.Ref] = data
+	}
 
-		if err != nil {
-			return nil, err
+	return results, nil
+}
+
+// pivotTotal is a total of a pivot table, grouped by as many of the row and column fields as its levels
+type pivotTotal struct {
+	kind    string
+	rows    int
+	columns int
+}
+
+func (t pivotTotal) ref(mappingRef string) string {
+	return fmt.Sprintf("%s%s%d_%d", mappingRef, widgetconstants.PIVOT_TOTAL_REF_SEPARATOR, t.rows, t.columns)
+}
+
+// pivotTotals lists the totals of a mapping with as many row and column fields, from the innermost level out. A total
+// grouped like the cells or like another total is left out
+func pivotTotals(totals *widgetmodels.PivotTotals, rows int, columns int) []pivotTotal {
+	if totals == nil {
+		return nil
+	}
+
+	result := []pivotTotal{}
+	seen := map[[2]int]bool{{rows, columns}: true}
+	add := func(kind string, rowLevel int, columnLevel int) {
+		if seen[[2]int{rowLevel, columnLevel}] {
+			return
 		}
+		seen[[2]int{rowLevel, columnLevel}] = true
 
-		result[mapping.Ref] = datasetResult
+		if rowLevel == 0 && columnLevel == 0 {
+			kind = widgetconstants.PivotTotalGrandTotal
+		}
+		result = append(result, pivotTotal{kind: kind, rows: rowLevel, columns: columnLevel})
 	}
 
-	return result, nil
+	if totals.RowSubtotals {
+		for level := rows - 1; level >= 0; level-- {
+			add(widgetconstants.PivotTotalRowSubtotal, level, columns)
+		}
+	}
+	if totals.ColumnSubtotals {
+		for level := columns - 1; level >= 0; level-- {
+			add(widgetconstants.PivotTotalColumnSubtotal, rows, level)
+		}
+	}
+	if totals.GrandTotal {
+		add(widgetconstants.PivotTotalGrandTotal, 0, 0)
+	}
+
+	return result
 }
 
 type KPIStrategy struct {
@@ -724,6 +1144,346 @@ func (k KPIStrategy) ToDatasetParams(instance *widgetmodels.WidgetInstance, data
 		return nil, err
 	}
 
+	queries := map[string]widgetmodels.GetDataByDatasetIDParams{
+		instance.DataMappings.Mappings[0].Ref: result,
+	}
+	if err := k.AddComparisons(instance, datasetbuilderparams, queries); err != nil {
+		return nil, err
+	}
+
+	return queries, nil
+}
+
+// MergeResults adds the comparisons and the target of the KPI to its value
+func (k KPIStrategy) MergeResults(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams, results map[string]datasetmodels.DatasetData) (map[string]datasetmodels.DatasetData, error) {
+	return k.MergeComparisons(instance, datasetbuilderparams, results)
+}
+
+type DataTableStrategy struct {
+	BaseStrategy
+}
+
+// ToDatasetParams lists the rows of the dataset one page at a time, columns with an aggregation summarise the rows by
+// the other columns instead
+func (d DataTableStrategy) ToDatasetParams(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams) (map[string]widgetmodels.GetDataByDatasetIDParams, error) {
+	if len(instance.DataMappings.Mappings) == 0 {
+		return nil, fmt.Errorf("no mappings found for DataTable widget")
+	}
+
+	result, err := d.ProcessDatasetParams(&instance.DataMappings.Mappings[0], datasetbuilderparams, func(params *datasetmodels.DatasetParams, mapping *widgetmodels.DataMappingFields, filters *datasetmodels.FilterModel, datasetBuilderParams *widgetmodels.DatasetBuilderParams) error {
+		columns, ok := mapping.Fields[widgetconstants.ColumnsField]
+		if !ok || len(columns) == 0 {
+			return fmt.Errorf("columns are required for data-table widget")
+		}
+
+		dimensions := []widgetmodels.Field{}
+		for _, column := range columns {
+			if column.Aggregation == "" {
+				dimensions = append(dimensions, column)
+				continue
+			}
+			if err := d.HandleAggregation(params, column, mapping, filters, datasetBuilderParams); err != nil {
+				return fmt.Errorf("failed to handle aggregation for data-table column: %w", err)
+			}
+		}
+
+		for _, dimension := range dimensions {
+			if len(params.Aggregations) > 0 {
+				params.GroupBy = append(params.GroupBy, datasetmodels.GroupBy{Column: dimension.GetExpression(), Alias: dimension.GetAlias()})
+			} else {
+				params.Columns = append(params.Columns, datasetmodels.ColumnConfig{Column: dimension.GetExpression(), Alias: dimension.GetAlias()})
+			}
+		}
+
+		params.Pagination = d.GetPagination(datasetBuilderParams.Filters[mapping.DatasetID].Pagination)
+		params.CountAll = true
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]widgetmodels.GetDataByDatasetIDParams{
+		instance.DataMappings.Mappings[0].Ref: result,
+	}, nil
+}
+
+// GetPagination returns the page requested for the table, the first page of the default size when none is
+func (d DataTableStrategy) GetPagination(pagination *widgetmodels.PaginationParams) *datasetmodels.Pagination {
+	page := datasetmodels.Pagination{
+		Page:     1,
+		PageSize: widgetconstants.DEFAULT_TABLE_PAGE_SIZE,
+	}
+
+	if pagination == nil {
+		return &page
+	}
+
+	if pagination.Page > 0 {
+		page.Page = pagination.Page
+	}
+	if pagination.PageSize > 0 {
+		page.PageSize = min(pagination.PageSize, widgetconstants.MAX_PAGE_SIZE)
+	}
+
+	return &page
+}
+
+type WaterfallChartStrategy struct {
+	BaseStrategy
+}
+
+// ToDatasetParams queries the movements of every step, grouped by the x-axis, and the opening balance separately since
+// it usually comes from another dataset than the movements
+func (w WaterfallChartStrategy) ToDatasetParams(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams) (map[string]widgetmodels.GetDataByDatasetIDParams, error) {
+	if _, ok := w.GetMovementsMapping(instance); !ok {
+		return nil, fmt.Errorf("no mapping with x-axis and y-axis found for Waterfall widget")
+	}
+
+	result := make(map[string]widgetmodels.GetDataByDatasetIDParams)
+	for _, mapping := range instance.DataMappings.Mappings {
+		datasetResult, err := w.ProcessDatasetParams(&mapping, datasetbuilderparams, func(params *datasetmodels.DatasetParams, mapping *widgetmodels.DataMappingFields, filters *datasetmodels.FilterModel, datasetBuilderParams *widgetmodels.DatasetBuilderParams) error {
+			if openingValue, ok := mapping.Fields[widgetconstants.OpeningValueField]; ok && len(openingValue) > 0 {
+				if err := w.HandleAggregation(params, openingValue[0], mapping, filters, datasetBuilderParams); err != nil {
+					return fmt.Errorf("failed to handle aggregation for waterfall opening value: %w", err)
+				}
+				return nil
+			}
+
+			xAxis, ok := mapping.Fields[widgetconstants.XAxisField]
+			if !ok || len(xAxis) == 0 {
+				return fmt.Errorf("x-axis is required for waterfall movements")
+			}
+			params.GroupBy = append(params.GroupBy, datasetmodels.GroupBy{Column: xAxis[0].GetExpression(), Alias: xAxis[0].GetAlias()})
+
+			yAxis, ok := mapping.Fields[widgetconstants.YAxisField]
+			if !ok || len(yAxis) == 0 {
+				return fmt.Errorf("y-axis is required for waterfall movements")
+			}
+			if err := w.HandleAggregation(params, yAxis[0], mapping, filters, datasetBuilderParams); err != nil {
+				return fmt.Errorf("failed to handle aggregation for waterfall y-axis: %w", err)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		// the balance moves step after step along the x-axis
+		if xAxis, ok := mapping.Fields[widgetconstants.XAxisField]; ok && len(xAxis) > 0 {
+			w.SortByFirst(&datasetResult.Params, []*string{xAxis[0].GetAlias()})
+		}
+
+		result[mapping.Ref] = datasetResult
+	}
+
+	return result, nil
+}
+
+// GetMovementsMapping returns the index of the mapping of the movements, the first one with both axes
+func (w WaterfallChartStrategy) GetMovementsMapping(instance *widgetmodels.WidgetInstance) (int, bool) {
+	for i, mapping := range instance.DataMappings.Mappings {
+		if len(mapping.Fields[widgetconstants.XAxisField]) > 0 && len(mapping.Fields[widgetconstants.YAxisField]) > 0 {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// TransformData puts the opening balance before the movements and the closing balance after them, every step gets
+// the value the bar starts and ends at. The movements are taken in the order of the x-axis whatever order they come in.
+func (w WaterfallChartStrategy) TransformData(instance *widgetmodels.WidgetInstance, data []datasetmodels.DatasetData) ([]datasetmodels.DatasetData, error) {
+	movementsIndex, ok := w.GetMovementsMapping(instance)
+	if !ok || movementsIndex >= len(data) {
+		return data, nil
+	}
+
+	opening := 0.0
+	for i, mapping := range instance.DataMappings.Mappings {
+		openingValue, ok := mapping.Fields[widgetconstants.OpeningValueField]
+		if !ok || len(openingValue) == 0 || i >= len(data) || len(data[i].Rows) == 0 {
+			continue
+		}
+		value, err := parseNumericValue(data[i].Rows[0][*openingValue[0].GetAlias()])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read waterfall opening value: %w", err)
+		}
+		opening += value
+	}
+
+	mapping := instance.DataMappings.Mappings[movementsIndex]
+	stepColumn := *mapping.Fields[widgetconstants.XAxisField][0].GetAlias()
+	valueColumn := *mapping.Fields[widgetconstants.YAxisField][0].GetAlias()
+	movements := data[movementsIndex]
+	sortRows(movements.Rows, []string{stepColumn})
+
+	newStep := func(label string, kind string, value float64, start float64, end float64) map[string]interface{} {
+		return map[string]interface{}{
+			stepColumn:                             label,
+			valueColumn:                            value,
+			widgetconstants.WATERFALL_STEP_COLUMN:  kind,
+			widgetconstants.WATERFALL_START_COLUMN: start,
+			widgetconstants.WATERFALL_END_COLUMN:   end,
+			widgetconstants.REF_PREFIX:             mapping.Ref,
+		}
+	}
+
+	rows := []map[string]interface{}{newStep(widgetconstants.WaterfallOpeningLabel, widgetconstants.WaterfallStepOpening, opening, 0, opening)}
+	balance := opening
+	for _, row := range movements.Rows {
+		// rows added for refs without data carry no step
+		if _, ok := row[stepColumn]; !ok {
+			continue
+		}
+
+		value, err := parseNumericValue(row[valueColumn])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read waterfall movement: %w", err)
+		}
+
+		row[widgetconstants.WATERFALL_STEP_COLUMN] = widgetconstants.WaterfallStepMovement
+		row[widgetconstants.WATERFALL_START_COLUMN] = balance
+		balance += value
+		row[widgetconstants.WATERFALL_END_COLUMN] = balance
+		rows = append(rows, row)
+	}
+	rows = append(rows, newStep(widgetconstants.WaterfallClosingLabel, widgetconstants.WaterfallStepClosing, balance, 0, balance))
+
+	movements.Rows = rows
+	movements.Columns = append(movements.Columns,
+		dataplatformmodels.ColumnMetadata{Name: widgetconstants.WATERFALL_STEP_COLUMN, DatabaseType: string(dataplatformdataConstants.StringDataType)},
+		dataplatformmodels.ColumnMetadata{Name: widgetconstants.WATERFALL_START_COLUMN, DatabaseType: string(dataplatformdataConstants.DoubleDataType)},
+		dataplatformmodels.ColumnMetadata{Name: widgetconstants.WATERFALL_END_COLUMN, DatabaseType: string(dataplatformdataConstants.DoubleDataType)},
+	)
+
+	return []datasetmodels.DatasetData{movements}, nil
+}
+
+type FunnelChartStrategy struct {
+	BaseStrategy
+}
+
+// ToDatasetParams groups the values by stage, the widest stage comes first unless the mapping sorts the stages
+func (f FunnelChartStrategy) ToDatasetParams(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams) (map[string]widgetmodels.GetDataByDatasetIDParams, error) {
+	if len(instance.DataMappings.Mappings) == 0 {
+		return nil, fmt.Errorf("no mappings found for Funnel widget")
+	}
+
+	result, err := f.ProcessDatasetParams(&instance.DataMappings.Mappings[0], datasetbuilderparams, func(params *datasetmodels.DatasetParams, mapping *widgetmodels.DataMappingFields, filters *datasetmodels.FilterModel, datasetBuilderParams *widgetmodels.DatasetBuilderParams) error {
+		stages, ok := mapping.Fields[widgetconstants.StagesField]
+		if !ok || len(stages) == 0 {
+			return fmt.Errorf("stages are required for funnel widget")
+		}
+		params.GroupBy = append(params.GroupBy, datasetmodels.GroupBy{Column: stages[0].GetExpression(), Alias: stages[0].GetAlias()})
+
+		values, ok := mapping.Fields[widgetconstants.ValuesField]
+		if !ok || len(values) == 0 {
+			return fmt.Errorf("values are required for funnel widget")
+		}
+		if err := f.HandleAggregation(params, values[0], mapping, filters, datasetBuilderParams); err != nil {
+			return fmt.Errorf("failed to handle aggregation for funnel value: %w", err)
+		}
+
+		if len(mapping.SortBy) == 0 {
+			valueAlias := values[0].GetAlias()
+			params.OrderBy = append(params.OrderBy, datasetmodels.OrderBy{
+				Column: *valueAlias,
+				Order:  datasetmodels.OrderType(querybuilderconstants.OrderDesc),
+				Alias:  valueAlias,
+			})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]widgetmodels.GetDataByDatasetIDParams{
+		instance.DataMappings.Mappings[0].Ref: result,
+	}, nil
+}
+
+// TransformData adds the share of the first stage and of the previous stage every stage converts
+func (f FunnelChartStrategy) TransformData(instance *widgetmodels.WidgetInstance, data []datasetmodels.DatasetData) ([]datasetmodels.DatasetData, error) {
+	if len(instance.DataMappings.Mappings) == 0 || len(data) == 0 {
+		return data, nil
+	}
+
+	values := instance.DataMappings.Mappings[0].Fields[widgetconstants.ValuesField]
+	if len(values) == 0 {
+		return data, nil
+	}
+	valueColumn := *values[0].GetAlias()
+
+	var first, previous float64
+	for i, row := range data[0].Rows {
+		value, err := parseNumericValue(row[valueColumn])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read funnel value: %w", err)
+		}
+
+		if i == 0 {
+			first, previous = value, value
+		}
+		row[widgetconstants.CONVERSION_RATE_COLUMN] = conversionRate(value, first)
+		row[widgetconstants.STEP_CONVERSION_RATE_COLUMN] = conversionRate(value, previous)
+		previous = value
+	}
+
+	data[0].Columns = append(data[0].Columns,
+		dataplatformmodels.ColumnMetadata{Name: widgetconstants.CONVERSION_RATE_COLUMN, DatabaseType: string(dataplatformdataConstants.DoubleDataType)},
+		dataplatformmodels.ColumnMetadata{Name: widgetconstants.STEP_CONVERSION_RATE_COLUMN, DatabaseType: string(dataplatformdataConstants.DoubleDataType)},
+	)
+
+	return data, nil
+}
+
+type HeatmapStrategy struct {
+	BaseStrategy
+}
+
+// ToDatasetParams buckets the values by the date, by day unless the sheet picks another periodicity
+func (h HeatmapStrategy) ToDatasetParams(instance *widgetmodels.WidgetInstance, datasetbuilderparams widgetmodels.DatasetBuilderParams) (map[string]widgetmodels.GetDataByDatasetIDParams, error) {
+	if len(instance.DataMappings.Mappings) == 0 {
+		return nil, fmt.Errorf("no mappings found for Heatmap widget")
+	}
+
+	result, err := h.ProcessDatasetParams(&instance.DataMappings.Mappings[0], datasetbuilderparams, func(params *datasetmodels.DatasetParams, mapping *widgetmodels.DataMappingFields, filters *datasetmodels.FilterModel, datasetBuilderParams *widgetmodels.DatasetBuilderParams) error {
+		date, ok := mapping.Fields[widgetconstants.DateField]
+		if !ok || len(date) == 0 {
+			return fmt.Errorf("date is required for heatmap widget")
+		}
+
+		periodicity := widgetconstants.DefaultHeatmapPeriodicity
+		if datasetBuilderParams.Periodicity != nil && widgetconstants.Periodicities[*datasetBuilderParams.Periodicity] {
+			periodicity = *datasetBuilderParams.Periodicity
+		}
+		params.GroupBy = append(params.GroupBy, datasetmodels.GroupBy{
+			Column: truncateTime(datasetBuilderParams.Calendar, periodicity, date[0].GetExpression()),
+			Alias:  date[0].GetAlias(),
+		})
+
+		values, ok := mapping.Fields[widgetconstants.ValuesField]
+		if !ok || len(values) == 0 {
+			return fmt.Errorf("values are required for heatmap widget")
+		}
+		if err := h.HandleAggregation(params, values[0], mapping, filters, datasetBuilderParams); err != nil {
+			return fmt.Errorf("failed to handle aggregation for heatmap value: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]widgetmodels.GetDataByDatasetIDParams{
 		instance.DataMappings.Mappings[0].Ref: result,
 	}, nil
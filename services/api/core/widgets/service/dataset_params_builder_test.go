@@ -1,6 +1,7 @@
 package widgets
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
 	"github.com/Zampfi/application-platform/services/api/core/widgets/models"
 	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	dataplatformmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
 	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
 )
 
@@ -33,6 +35,21 @@ func TestNewDatasetParamsBuilder(t *testing.T) {
 			widgetType:  "bar_chart",
 			expectError: false,
 		},
+		{
+			name:        "valid data table type",
+			widgetType:  widgetconstants.WidgetTypeDataTable,
+			expectError: false,
+		},
+		{
+			name:        "valid waterfall chart type",
+			widgetType:  widgetconstants.WidgetTypeWaterfallChart,
+			expectError: false,
+		},
+		{
+			name:        "valid heatmap type",
+			widgetType:  widgetconstants.WidgetTypeHeatmap,
+			expectError: false,
+		},
 		{
 			name:          "invalid widget type",
 			widgetType:    "invalid_type",
@@ -3912,3 +3929,585 @@ func TestKPIStrategy_ToDatasetParams_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestDataTableStrategy_ToDatasetParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		fields     map[string][]widgetmodels.Field
+		pagination *widgetmodels.PaginationParams
+		want       datasetmodels.DatasetParams
+		wantErr    bool
+	}{
+		{
+			name: "detail rows on the default page",
+			fields: map[string][]widgetmodels.Field{
+				widgetconstants.ColumnsField: {{Column: "invoice_id"}, {Column: "amount", Alias: "Amount"}},
+			},
+			want: datasetmodels.DatasetParams{
+				Columns: []datasetmodels.ColumnConfig{
+					{Column: "invoice_id", Alias: stringPtr("invoice_id")},
+					{Column: "amount", Alias: stringPtr("Amount")},
+				},
+				Aggregations: []datasetmodels.Aggregation{},
+				GroupBy:      []datasetmodels.GroupBy{},
+				Pagination:   &datasetmodels.Pagination{Page: 1, PageSize: widgetconstants.DEFAULT_TABLE_PAGE_SIZE},
+				CountAll:     true,
+			},
+		},
+		{
+			name: "aggregated columns summarise the others",
+			fields: map[string][]widgetmodels.Field{
+				widgetconstants.ColumnsField: {{Column: "vendor"}, {Column: "amount", Aggregation: "sum"}},
+			},
+			pagination: &widgetmodels.PaginationParams{Page: 3, PageSize: 25},
+			want: datasetmodels.DatasetParams{
+				Columns: []datasetmodels.ColumnConfig{},
+				Aggregations: []datasetmodels.Aggregation{
+					{Column: "amount", Function: "sum", Alias: "amount"},
+				},
+				GroupBy: []datasetmodels.GroupBy{
+					{Column: "vendor", Alias: stringPtr("vendor")},
+				},
+				OrderBy: []datasetmodels.OrderBy{
+					{Column: "vendor", Order: "ASC", Alias: stringPtr("vendor")},
+				},
+				Pagination: &datasetmodels.Pagination{Page: 3, PageSize: 25},
+				CountAll:   true,
+			},
+		},
+		{
+			name: "page size is capped",
+			fields: map[string][]widgetmodels.Field{
+				widgetconstants.ColumnsField: {{Column: "invoice_id"}},
+			},
+			pagination: &widgetmodels.PaginationParams{PageSize: widgetconstants.MAX_PAGE_SIZE + 1},
+			want: datasetmodels.DatasetParams{
+				Columns: []datasetmodels.ColumnConfig{
+					{Column: "invoice_id", Alias: stringPtr("invoice_id")},
+				},
+				Aggregations: []datasetmodels.Aggregation{},
+				GroupBy:      []datasetmodels.GroupBy{},
+				Pagination:   &datasetmodels.Pagination{Page: 1, PageSize: widgetconstants.MAX_PAGE_SIZE},
+				CountAll:     true,
+			},
+		},
+		{
+			name:    "missing columns",
+			fields:  map[string][]widgetmodels.Field{},
+			wantErr: true,
+		},
+	}
+
+	strategy := DataTableStrategy{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &widgetmodels.WidgetInstance{
+				DataMappings: widgetmodels.DataMappings{
+					Mappings: []widgetmodels.DataMappingFields{{DatasetID: "dataset1", Ref: "ref1", Fields: tt.fields}},
+				},
+			}
+
+			got, err := strategy.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{
+				Filters: map[string]widgetmodels.WidgetFilters{
+					"dataset1": {Pagination: tt.pagination},
+				},
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]widgetmodels.GetDataByDatasetIDParams{
+				"ref1": {DatasetID: "dataset1", Params: tt.want},
+			}, got)
+		})
+	}
+}
+
+func TestBasicChartStrategy_ToDatasetParamsCumulative(t *testing.T) {
+	newInstance := func(cumulative bool) *widgetmodels.WidgetInstance {
+		return &widgetmodels.WidgetInstance{
+			DataMappings: widgetmodels.DataMappings{
+				Mappings: []widgetmodels.DataMappingFields{
+					{
+						DatasetID: "dataset1",
+						Ref:       "ref1",
+						Fields: map[string][]widgetmodels.Field{
+							widgetconstants.XAxisField:   {{Column: "month"}},
+							widgetconstants.YAxisField:   {{Column: "revenue", Aggregation: "sum", Cumulative: cumulative}},
+							widgetconstants.GroupByField: {{Column: "region"}},
+						},
+						SortBy: []widgetmodels.SortBy{{Column: "revenue", Order: "DESC"}},
+					},
+				},
+			},
+		}
+	}
+
+	strategy := BasicChartStrategy{}
+
+	got, err := strategy.ToDatasetParams(newInstance(true), widgetmodels.DatasetBuilderParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []datasetmodels.OrderBy{
+		{Column: "month", Order: "ASC", Alias: stringPtr("month")},
+		{Column: "region", Order: "ASC", Alias: stringPtr("region")},
+		{Column: "revenue", Order: "DESC", Alias: stringPtr("revenue")},
+	}, got["ref1"].Params.OrderBy)
+
+	got, err = strategy.ToDatasetParams(newInstance(false), widgetmodels.DatasetBuilderParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []datasetmodels.OrderBy{
+		{Column: "revenue", Order: "DESC", Alias: stringPtr("revenue")},
+		{Column: "month", Order: "ASC", Alias: stringPtr("month")},
+		{Column: "region", Order: "ASC", Alias: stringPtr("region")},
+	}, got["ref1"].Params.OrderBy)
+}
+
+func TestBasicChartStrategy_TransformData(t *testing.T) {
+	newInstance := func(cumulative bool) *widgetmodels.WidgetInstance {
+		return &widgetmodels.WidgetInstance{
+			DataMappings: widgetmodels.DataMappings{
+				Mappings: []widgetmodels.DataMappingFields{
+					{
+						DatasetID: "dataset1",
+						Ref:       "ref1",
+						Fields: map[string][]widgetmodels.Field{
+							widgetconstants.XAxisField:   {{Column: "month"}},
+							widgetconstants.YAxisField:   {{Column: "revenue", Aggregation: "sum", Cumulative: cumulative}},
+							widgetconstants.GroupByField: {{Column: "region"}},
+						},
+					},
+				},
+			},
+		}
+	}
+	newData := func() []datasetmodels.DatasetData {
+		return []datasetmodels.DatasetData{{
+			QueryResult: dataplatformmodels.QueryResult{
+				Rows: []map[string]interface{}{
+					{"month": "2025-01", "region": "EU", "revenue": 10.0},
+					{"month": "2025-01", "region": "US", "revenue": "5"},
+					{"month": "2025-02", "region": "EU", "revenue": 20},
+					{"month": "2025-02", "region": "US", "revenue": nil},
+					{"month": "2025-03", "region": "EU", "revenue": json.Number("30")},
+				},
+			},
+		}}
+	}
+
+	strategy := BasicChartStrategy{}
+
+	got, err := strategy.TransformData(newInstance(true), newData())
+	assert.NoError(t, err)
+	assert.Equal(t, dataplatformmodels.Rows{
+		{"month": "2025-01", "region": "EU", "revenue": 10.0},
+		{"month": "2025-01", "region": "US", "revenue": 5.0},
+		{"month": "2025-02", "region": "EU", "revenue": 30.0},
+		{"month": "2025-02", "region": "US", "revenue": 5.0},
+		{"month": "2025-03", "region": "EU", "revenue": 60.0},
+	}, got[0].Rows)
+
+	// rows coming out of order are added up along the x-axis
+	outOfOrder := newData()
+	rows := outOfOrder[0].Rows
+	outOfOrder[0].Rows = []map[string]interface{}{rows[4], rows[1], rows[2], rows[0], rows[3]}
+	got, err = strategy.TransformData(newInstance(true), outOfOrder)
+	assert.NoError(t, err)
+	assert.Equal(t, dataplatformmodels.Rows{
+		{"month": "2025-01", "region": "EU", "revenue": 10.0},
+		{"month": "2025-01", "region": "US", "revenue": 5.0},
+		{"month": "2025-02", "region": "EU", "revenue": 30.0},
+		{"month": "2025-02", "region": "US", "revenue": 5.0},
+		{"month": "2025-03", "region": "EU", "revenue": 60.0},
+	}, got[0].Rows)
+
+	got, err = strategy.TransformData(newInstance(false), newData())
+	assert.NoError(t, err)
+	assert.Equal(t, newData(), got)
+
+	_, err = strategy.TransformData(newInstance(true), []datasetmodels.DatasetData{{
+		QueryResult: dataplatformmodels.QueryResult{
+			Rows: []map[string]interface{}{{"month": "2025-01", "region": "EU", "revenue": true}},
+		},
+	}})
+	assert.Error(t, err)
+}
+
+func TestWaterfallChartStrategy_ToDatasetParams(t *testing.T) {
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{
+				{
+					DatasetID: "balances",
+					Ref:       "opening",
+					Fields: map[string][]widgetmodels.Field{
+						widgetconstants.OpeningValueField: {{Column: "balance", Aggregation: "sum"}},
+					},
+				},
+				{
+					DatasetID: "transactions",
+					Ref:       "movements",
+					Fields: map[string][]widgetmodels.Field{
+						widgetconstants.XAxisField: {{Column: "category"}},
+						widgetconstants.YAxisField: {{Column: "amount", Aggregation: "sum"}},
+					},
+				},
+			},
+		},
+	}
+
+	strategy := WaterfallChartStrategy{}
+	got, err := strategy.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]widgetmodels.GetDataByDatasetIDParams{
+		"opening": {
+			DatasetID: "balances",
+			Params: datasetmodels.DatasetParams{
+				Columns: []datasetmodels.ColumnConfig{},
+				Aggregations: []datasetmodels.Aggregation{
+					{Column: "balance", Function: "sum", Alias: "balance"},
+				},
+				GroupBy: []datasetmodels.GroupBy{},
+			},
+		},
+		"movements": {
+			DatasetID: "transactions",
+			Params: datasetmodels.DatasetParams{
+				Columns: []datasetmodels.ColumnConfig{},
+				Aggregations: []datasetmodels.Aggregation{
+					{Column: "amount", Function: "sum", Alias: "amount"},
+				},
+				GroupBy: []datasetmodels.GroupBy{
+					{Column: "category", Alias: stringPtr("category")},
+				},
+				OrderBy: []datasetmodels.OrderBy{
+					{Column: "category", Order: "ASC", Alias: stringPtr("category")},
+				},
+			},
+		},
+	}, got)
+
+	// the steps follow the x-axis even when the movements are sorted by value
+	instance.DataMappings.Mappings[1].SortBy = []widgetmodels.SortBy{{Column: "amount", Order: "DESC"}}
+	got, err = strategy.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []datasetmodels.OrderBy{
+		{Column: "category", Order: "ASC", Alias: stringPtr("category")},
+		{Column: "amount", Order: "DESC", Alias: stringPtr("amount")},
+	}, got["movements"].Params.OrderBy)
+
+	_, err = strategy.ToDatasetParams(&widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{instance.DataMappings.Mappings[0]},
+		},
+	}, widgetmodels.DatasetBuilderParams{})
+	assert.Error(t, err)
+}
+
+func TestWaterfallChartStrategy_TransformData(t *testing.T) {
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{
+				{
+					Ref: "opening",
+					Fields: map[string][]widgetmodels.Field{
+						widgetconstants.OpeningValueField: {{Column: "balance", Aggregation: "sum"}},
+					},
+				},
+				{
+					Ref: "movements",
+					Fields: map[string][]widgetmodels.Field{
+						widgetconstants.XAxisField: {{Column: "category"}},
+						widgetconstants.YAxisField: {{Column: "amount", Aggregation: "sum"}},
+					},
+				},
+			},
+		},
+	}
+	data := []datasetmodels.DatasetData{
+		{
+			QueryResult: dataplatformmodels.QueryResult{
+				Rows: []map[string]interface{}{{"balance": 100.0, widgetconstants.REF_PREFIX: "opening"}},
+			},
+		},
+		{
+			QueryResult: dataplatformmodels.QueryResult{
+				Columns: []dataplatformmodels.ColumnMetadata{{Name: "category"}, {Name: "amount"}},
+				Rows: []map[string]interface{}{
+					{"category": "Sales", "amount": 50.0, widgetconstants.REF_PREFIX: "movements"},
+					{"category": "Payroll", "amount": -80.0, widgetconstants.REF_PREFIX: "movements"},
+				},
+			},
+		},
+	}
+
+	got, err := WaterfallChartStrategy{}.TransformData(instance, data)
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, dataplatformmodels.Rows{
+		{"category": "Opening", "amount": 100.0, "__WATERFALL_STEP": "opening", "__WATERFALL_START": 0.0, "__WATERFALL_END": 100.0, widgetconstants.REF_PREFIX: "movements"},
+		{"category": "Payroll", "amount": -80.0, "__WATERFALL_STEP": "movement", "__WATERFALL_START": 100.0, "__WATERFALL_END": 20.0, widgetconstants.REF_PREFIX: "movements"},
+		{"category": "Sales", "amount": 50.0, "__WATERFALL_STEP": "movement", "__WATERFALL_START": 20.0, "__WATERFALL_END": 70.0, widgetconstants.REF_PREFIX: "movements"},
+		{"category": "Closing", "amount": 70.0, "__WATERFALL_STEP": "closing", "__WATERFALL_START": 0.0, "__WATERFALL_END": 70.0, widgetconstants.REF_PREFIX: "movements"},
+	}, got[0].Rows)
+	assert.Equal(t, []string{"category", "amount", "__WATERFALL_STEP", "__WATERFALL_START", "__WATERFALL_END"}, columnNames(got[0].Columns))
+}
+
+func TestFunnelChartStrategy_ToDatasetParams(t *testing.T) {
+	newInstance := func(sortBy []widgetmodels.SortBy) *widgetmodels.WidgetInstance {
+		return &widgetmodels.WidgetInstance{
+			DataMappings: widgetmodels.DataMappings{
+				Mappings: []widgetmodels.DataMappingFields{
+					{
+						DatasetID: "dataset1",
+						Ref:       "ref1",
+						Fields: map[string][]widgetmodels.Field{
+							widgetconstants.StagesField: {{Column: "stage"}},
+							widgetconstants.ValuesField: {{Column: "invoice_id", Aggregation: "count", Alias: "invoices"}},
+						},
+						SortBy: sortBy,
+					},
+				},
+			},
+		}
+	}
+
+	strategy := FunnelChartStrategy{}
+
+	got, err := strategy.ToDatasetParams(newInstance(nil), widgetmodels.DatasetBuilderParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []datasetmodels.OrderBy{
+		{Column: "invoices", Order: "DESC", Alias: stringPtr("invoices")},
+		{Column: "stage", Order: "ASC", Alias: stringPtr("stage")},
+	}, got["ref1"].Params.OrderBy)
+	assert.Equal(t, []datasetmodels.GroupBy{{Column: "stage", Alias: stringPtr("stage")}}, got["ref1"].Params.GroupBy)
+
+	got, err = strategy.ToDatasetParams(newInstance([]widgetmodels.SortBy{{Column: "stage", Order: "ASC"}}), widgetmodels.DatasetBuilderParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, []datasetmodels.OrderBy{
+		{Column: "stage", Order: "ASC", Alias: stringPtr("stage")},
+	}, got["ref1"].Params.OrderBy)
+
+	_, err = strategy.ToDatasetParams(&widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{DatasetID: "dataset1", Ref: "ref1", Fields: map[string][]widgetmodels.Field{
+				widgetconstants.StagesField: {{Column: "stage"}},
+			}}},
+		},
+	}, widgetmodels.DatasetBuilderParams{})
+	assert.Error(t, err)
+}
+
+func TestFunnelChartStrategy_TransformData(t *testing.T) {
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{
+				{
+					Fields: map[string][]widgetmodels.Field{
+						widgetconstants.StagesField: {{Column: "stage"}},
+						widgetconstants.ValuesField: {{Column: "invoices", Aggregation: "count"}},
+					},
+				},
+			},
+		},
+	}
+	data := []datasetmodels.DatasetData{{
+		QueryResult: dataplatformmodels.QueryResult{
+			Rows: []map[string]interface{}{
+				{"stage": "Received", "invoices": int64(200)},
+				{"stage": "Approved", "invoices": int64(100)},
+				{"stage": "Paid", "invoices": int64(80)},
+			},
+		},
+	}}
+
+	got, err := FunnelChartStrategy{}.TransformData(instance, data)
+	assert.NoError(t, err)
+	assert.Equal(t, dataplatformmodels.Rows{
+		{"stage": "Received", "invoices": int64(200), "__CONVERSION_RATE": 1.0, "__STEP_CONVERSION_RATE": 1.0},
+		{"stage": "Approved", "invoices": int64(100), "__CONVERSION_RATE": 0.5, "__STEP_CONVERSION_RATE": 0.5},
+		{"stage": "Paid", "invoices": int64(80), "__CONVERSION_RATE": 0.4, "__STEP_CONVERSION_RATE": 0.8},
+	}, got[0].Rows)
+	assert.Equal(t, []string{"__CONVERSION_RATE", "__STEP_CONVERSION_RATE"}, columnNames(got[0].Columns))
+}
+
+func TestHeatmapStrategy_ToDatasetParams(t *testing.T) {
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{
+				{
+					DatasetID: "dataset1",
+					Ref:       "ref1",
+					Fields: map[string][]widgetmodels.Field{
+						widgetconstants.DateField:   {{Column: "posted_at"}},
+						widgetconstants.ValuesField: {{Column: "amount", Aggregation: "sum"}},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		periodicity *string
+		wantGroupBy string
+	}{
+		{name: "daily by default", wantGroupBy: "date_trunc('day', posted_at)"},
+		{name: "periodicity of the sheet", periodicity: stringPtr("week"), wantGroupBy: "date_trunc('week', posted_at)"},
+		{name: "unknown periodicity", periodicity: stringPtr("fortnight"), wantGroupBy: "date_trunc('day', posted_at)"},
+	}
+
+	strategy := HeatmapStrategy{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := strategy.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{Periodicity: tt.periodicity})
+			assert.NoError(t, err)
+			assert.Equal(t, datasetmodels.DatasetParams{
+				Columns: []datasetmodels.ColumnConfig{},
+				Aggregations: []datasetmodels.Aggregation{
+					{Column: "amount", Function: "sum", Alias: "amount"},
+				},
+				GroupBy: []datasetmodels.GroupBy{
+					{Column: tt.wantGroupBy, Alias: stringPtr("posted_at")},
+				},
+				OrderBy: []datasetmodels.OrderBy{
+					{Column: "posted_at", Order: "ASC", Alias: stringPtr("posted_at")},
+				},
+			}, got["ref1"].Params)
+		})
+	}
+
+	_, err := strategy.ToDatasetParams(&widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{DatasetID: "dataset1", Ref: "ref1", Fields: map[string][]widgetmodels.Field{
+				widgetconstants.ValuesField: {{Column: "amount", Aggregation: "sum"}},
+			}}},
+		},
+	}, widgetmodels.DatasetBuilderParams{})
+	assert.Error(t, err)
+}
+
+func pivotWithTotals(totals *widgetmodels.PivotTotals) *widgetmodels.WidgetInstance {
+	return &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Totals: totals,
+			Mappings: []widgetmodels.DataMappingFields{{
+				DatasetID: "transactions",
+				Ref:       "cells",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.RowsField:    {{Column: "region"}, {Column: "vendor"}},
+					widgetconstants.ColumnsField: {{Column: "quarter"}},
+					widgetconstants.ValuesField:  {{Column: "amount", Aggregation: "avg"}},
+				},
+				SortBy: []widgetmodels.SortBy{{Column: "vendor", Order: "DESC"}},
+			}},
+		},
+	}
+}
+
+func TestPivotTableStrategy_ToDatasetParamsWithTotals(t *testing.T) {
+	instance := pivotWithTotals(&widgetmodels.PivotTotals{RowSubtotals: true, ColumnSubtotals: true, GrandTotal: true})
+
+	got, err := PivotTableStrategy{}.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{})
+	assert.NoError(t, err)
+
+	groupBy := func(ref string) []string {
+		columns := []string{}
+		for _, group := range got[ref].Params.GroupBy {
+			columns = append(columns, group.Column)
+		}
+		return columns
+	}
+
+	assert.Len(t, got, 5)
+	assert.Equal(t, []string{"region", "vendor", "quarter"}, groupBy("cells"))
+	assert.Equal(t, []string{"region", "quarter"}, groupBy("cells__TOTAL_1_1"))
+	assert.Equal(t, []string{"quarter"}, groupBy("cells__TOTAL_0_1"))
+	assert.Equal(t, []string{"region", "vendor"}, groupBy("cells__TOTAL_2_0"))
+	assert.Equal(t, []string{}, groupBy("cells__TOTAL_0_0"))
+
+	// the totals keep the aggregation of the cells and drop the sorts by the fields they add up
+	assert.Equal(t, []datasetmodels.Aggregation{{Column: "amount", Function: "avg", Alias: "amount"}}, got["cells__TOTAL_0_0"].Params.Aggregations)
+	assert.Equal(t, []datasetmodels.OrderBy{
+		{Column: "region", Order: "ASC", Alias: stringPtr("region")},
+		{Column: "quarter", Order: "ASC", Alias: stringPtr("quarter")},
+	}, got["cells__TOTAL_1_1"].Params.OrderBy)
+
+	got, err = PivotTableStrategy{}.ToDatasetParams(pivotWithTotals(nil), widgetmodels.DatasetBuilderParams{})
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+}
+
+func TestPivotTotals(t *testing.T) {
+	tests := []struct {
+		name    string
+		totals  *widgetmodels.PivotTotals
+		rows    int
+		columns int
+		want    []pivotTotal
+	}{
+		{name: "no totals", rows: 2, columns: 1, want: nil},
+		{
+			name:    "row subtotals",
+			totals:  &widgetmodels.PivotTotals{RowSubtotals: true},
+			rows:    2,
+			columns: 1,
+			want:    []pivotTotal{{kind: "row_subtotal", rows: 1, columns: 1}, {kind: "row_subtotal", rows: 0, columns: 1}},
+		},
+		{
+			name:    "row subtotal without column fields is the grand total",
+			totals:  &widgetmodels.PivotTotals{RowSubtotals: true, GrandTotal: true},
+			rows:    1,
+			columns: 0,
+			want:    []pivotTotal{{kind: "grand_total", rows: 0, columns: 0}},
+		},
+		{
+			name:   "no fields to add up",
+			totals: &widgetmodels.PivotTotals{RowSubtotals: true, ColumnSubtotals: true, GrandTotal: true},
+			want:   []pivotTotal{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pivotTotals(tt.totals, tt.rows, tt.columns))
+		})
+	}
+}
+
+func TestPivotTableStrategy_MergeResults(t *testing.T) {
+	instance := pivotWithTotals(&widgetmodels.PivotTotals{ColumnSubtotals: true, GrandTotal: true})
+	results := map[string]datasetmodels.DatasetData{
+		"cells": {QueryResult: dataplatformmodels.QueryResult{
+			Columns: []dataplatformmodels.ColumnMetadata{{Name: "region"}, {Name: "vendor"}, {Name: "quarter"}, {Name: "amount"}},
+			Rows: dataplatformmodels.Rows{
+				{"region": "EU", "vendor": "Acme", "quarter": "Q1", "amount": 10.0, widgetconstants.REF_PREFIX: "cells"},
+			},
+		}},
+		"cells__TOTAL_2_0": {QueryResult: dataplatformmodels.QueryResult{
+			Rows: dataplatformmodels.Rows{{"region": "EU", "vendor": "Acme", "amount": 12.5, widgetconstants.REF_PREFIX: "cells__TOTAL_2_0"}},
+		}},
+		"cells__TOTAL_0_0": {QueryResult: dataplatformmodels.QueryResult{
+			Rows: dataplatformmodels.Rows{{"amount": 11.0, widgetconstants.REF_PREFIX: "cells__TOTAL_0_0"}},
+		}},
+	}
+
+	got, err := PivotTableStrategy{}.MergeResults(instance, widgetmodels.DatasetBuilderParams{}, results)
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, dataplatformmodels.Rows{
+		{"region": "EU", "vendor": "Acme", "quarter": "Q1", "amount": 10.0, widgetconstants.REF_PREFIX: "cells", "__TOTAL": nil, "__ROW_LEVEL": 2, "__COLUMN_LEVEL": 1},
+		{"region": "EU", "vendor": "Acme", "quarter": nil, "amount": 12.5, widgetconstants.REF_PREFIX: "cells", "__TOTAL": "column_subtotal", "__ROW_LEVEL": 2, "__COLUMN_LEVEL": 0},
+		{"region": nil, "vendor": nil, "quarter": nil, "amount": 11.0, widgetconstants.REF_PREFIX: "cells", "__TOTAL": "grand_total", "__ROW_LEVEL": 0, "__COLUMN_LEVEL": 0},
+	}, got["cells"].Rows)
+	assert.Equal(t, []string{"region", "vendor", "quarter", "amount", "__TOTAL", "__ROW_LEVEL", "__COLUMN_LEVEL"}, columnNames(got["cells"].Columns))
+}
+
+func columnNames(columns []dataplatformmodels.ColumnMetadata) []string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.Name
+	}
+	return names
+}
@@ -0,0 +1,12 @@
+package widgets
+
+import "errors"
+
+var (
+	ErrWidgetInstanceNotFound = errors.New("widget instance not found")
+	ErrUnknownWidgetType      = errors.New("unknown widget type")
+	ErrInvalidDataMappings    = errors.New("invalid data mappings")
+	ErrInvalidSelection       = errors.New("invalid selection")
+	ErrNoDrillThrough         = errors.New("widget instance has no drill-through")
+	ErrInvalidVariable        = errors.New("invalid variable")
+)
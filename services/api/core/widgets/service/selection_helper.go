@@ -0,0 +1,327 @@
+package widgets
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	datasetconstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
+	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	querybuilderconstants "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
+	"github.com/google/uuid"
+)
+
+// selectedField is a field of a widget the user selected a value of, with the dataset of the mapping of the field.
+// period is set when the value is the start of a period of the calendar, a bucket of a time column or a date-range field
+type selectedField struct {
+	datasetID string
+	field     models.Field
+	value     interface{}
+	period    string
+}
+
+// applySelections filters the data of the widget by the selections made in the other widgets of its sheet, the
+// selections of the widget itself are left out so the user can change them
+func applySelections(sheet *dbmodels.Sheet, widgetInstance models.WidgetInstance, params models.GetWidgetInstanceDataQueryParams, calendarSettings calendar.Settings, datasetFilters map[string]models.WidgetFilters) error {
+	sheetConfig, err := parseSheetConfig(sheet)
+	if err != nil {
+		return err
+	}
+
+	timeColumns := make(map[string]string, len(params.TimeColumns))
+	for _, timeColumn := range params.TimeColumns {
+		timeColumns[timeColumn.DatasetID] = timeColumn.Column
+	}
+
+	selected := []selectedField{}
+	for _, selection := range params.Selections {
+		if selection.WidgetInstanceID == widgetInstance.ID {
+			continue
+		}
+
+		source, ok, err := findSheetWidgetInstance(sheet, selection.WidgetInstanceID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%w: widget instance %s is not on the sheet", ErrInvalidSelection, selection.WidgetInstanceID)
+		}
+
+		fields, err := resolveSelection(source, selection, timeColumns, params.Periodicity)
+		if err != nil {
+			return err
+		}
+		selected = append(selected, fields...)
+	}
+
+	filters, err := selectionFilters(sheetConfig, calendarSettings, widgetInstance.ID, widgetInstance.DataMappings.Mappings, selected)
+	if err != nil {
+		return err
+	}
+
+	for datasetID, conditions := range filters {
+		widgetFilters := datasetFilters[datasetID]
+		widgetFilters.DatasetID = datasetID
+		widgetFilters.Filters = withConditions(widgetFilters.Filters, conditions)
+		datasetFilters[datasetID] = widgetFilters
+	}
+
+	return nil
+}
+
+func parseSheetConfig(sheet *dbmodels.Sheet) (sheetmodels.SheetConfig, error) {
+	sheetConfig := sheetmodels.SheetConfig{}
+	if len(sheet.SheetConfig) > 0 {
+		if err := json.Unmarshal(sheet.SheetConfig, &sheetConfig); err != nil {
+			return sheetConfig, fmt.Errorf("failed to parse sheet config: %w", err)
+		}
+	}
+	return sheetConfig, nil
+}
+
+func findSheetWidgetInstance(sheet *dbmodels.Sheet, widgetInstanceID uuid.UUID) (models.WidgetInstance, bool, error) {
+	for _, dbWidgetInstance := range sheet.WidgetInstances {
+		if dbWidgetInstance.ID != widgetInstanceID || dbWidgetInstance.DeletedAt != nil {
+			continue
+		}
+
+		widgetInstance := models.WidgetInstance{}
+		if err := widgetInstance.FromDB(&dbWidgetInstance); err != nil {
+			return models.WidgetInstance{}, false, fmt.Errorf("failed to parse widget instance %s: %w", widgetInstanceID, err)
+		}
+		return widgetInstance, true, nil
+	}
+
+	return models.WidgetInstance{}, false, nil
+}
+
+// resolveSelection returns the fields the values of the selection are for, a value is for every mapping of the widget
+// with a field of its alias. Fields of an expression have no column to filter by and cannot be selected
+func resolveSelection(widgetInstance models.WidgetInstance, selection models.WidgetSelection, timeColumns map[string]string, periodicity *string) ([]selectedField, error) {
+	aliases := make([]string, 0, len(selection.Values))
+	for alias := range selection.Values {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	selected := []selectedField{}
+	for _, alias := range aliases {
+		found := false
+		for _, mapping := range widgetInstance.DataMappings.Mappings {
+			field, ok := findFieldByAlias(mapping, alias)
+			if !ok {
+				continue
+			}
+			found = true
+			if field.Column == "" {
+				return nil, fmt.Errorf("%w: field %s of widget instance %s has no column to filter by", ErrInvalidSelection, alias, widgetInstance.ID)
+			}
+			selected = append(selected, selectedField{
+				datasetID: mapping.DatasetID,
+				field:     field,
+				value:     selection.Values[alias],
+				period:    selectionPeriod(field, timeColumns[mapping.DatasetID], periodicity),
+			})
+		}
+
+		if !found {
+			return nil, fmt.Errorf("%w: widget instance %s has no field %s", ErrInvalidSelection, widgetInstance.ID, alias)
+		}
+	}
+
+	return selected, nil
+}
+
+// selectionPeriod is the period the values of the field are the starts of. The time column is bucketed by the
+// periodicity of the sheet, other date-range fields by day
+func selectionPeriod(field models.Field, timeColumn string, periodicity *string) string {
+	if field.Column == timeColumn && periodicity != nil && widgetconstants.Periodicities[*periodicity] {
+		return *periodicity
+	}
+	if field.DrilldownFilterType == datasetconstants.FilterTypeDateRange {
+		return calendar.PeriodDay
+	}
+	return ""
+}
+
+func findFieldByAlias(mapping models.DataMappingFields, alias string) (models.Field, bool) {
+	names := make([]string, 0, len(mapping.Fields))
+	for name := range mapping.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, field := range mapping.Fields[name] {
+			if *field.GetAlias() == alias {
+				return field, true
+			}
+		}
+	}
+	return models.Field{}, false
+}
+
+// selectionFilters maps the selected fields onto the datasets of the mappings, a field filters its own dataset by its
+// column and the other datasets by the columns a native filter of the sheet targets alongside it
+func selectionFilters(sheetConfig sheetmodels.SheetConfig, calendarSettings calendar.Settings, widgetInstanceID uuid.UUID, mappings []models.DataMappingFields, selected []selectedField) (map[string][]datasetmodels.Filter, error) {
+	filters := make(map[string][]datasetmodels.Filter)
+	for _, mapping := range mappings {
+		if _, ok := filters[mapping.DatasetID]; ok {
+			continue
+		}
+
+		conditions := []datasetmodels.Filter{}
+		filtered := map[string]bool{}
+		for _, selection := range selected {
+			column, ok := selectionColumn(sheetConfig, widgetInstanceID, selection, mapping.DatasetID)
+			if !ok || filtered[column] {
+				continue
+			}
+
+			condition, err := selectionCondition(calendarSettings, column, selection)
+			if err != nil {
+				return nil, err
+			}
+			filtered[column] = true
+			conditions = append(conditions, condition)
+		}
+
+		if len(conditions) > 0 {
+			filters[mapping.DatasetID] = conditions
+		}
+	}
+
+	return filters, nil
+}
+
+func selectionColumn(sheetConfig sheetmodels.SheetConfig, widgetInstanceID uuid.UUID, selection selectedField, datasetID string) (string, bool) {
+	if selection.datasetID == datasetID {
+		return selection.field.Column, true
+	}
+
+	for _, filter := range sheetConfig.NativeFilterConfig {
+		if len(filter.WidgetsInScope) > 0 && !slices.Contains(filter.WidgetsInScope, widgetInstanceID.String()) {
+			continue
+		}
+
+		targetsSelection := slices.ContainsFunc(filter.Targets, func(target sheetmodels.FilterTarget) bool {
+			return target.DatasetId.String() == selection.datasetID && target.Column == selection.field.Column
+		})
+		if !targetsSelection {
+			continue
+		}
+
+		for _, target := range filter.Targets {
+			if target.DatasetId.String() == datasetID {
+				return target.Column, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// selectionCondition filters the column by the selected value with the drilldown operator of the field, or the one of
+// its drilldown filter type when it has none. A single value of a period is the start of the period and keeps the
+// times from it to the start of the next period, a list of values is matched with in when the field has no operator
+func selectionCondition(calendarSettings calendar.Settings, column string, selection selectedField) (datasetmodels.Filter, error) {
+	value := selection.value
+	values, isList := value.([]interface{})
+
+	if selection.period != "" && (!isList || len(values) == 1) {
+		if isList {
+			value = values[0]
+		}
+		return periodCondition(calendarSettings, column, selection.period, value)
+	}
+
+	operator := selection.field.DrilldownFilterOperator
+	if operator == "" {
+		switch selection.field.DrilldownFilterType {
+		case datasetconstants.FilterTypeMultiSearch:
+			operator = querybuilderconstants.InOperator
+		case datasetconstants.FilterTypeDateRange:
+			operator = querybuilderconstants.InBetweenOperator
+		default:
+			operator = querybuilderconstants.EqualOperator
+			if isList {
+				operator = querybuilderconstants.InOperator
+			}
+		}
+	}
+
+	if operator == querybuilderconstants.InOperator && !isList {
+		values = []interface{}{value}
+	}
+	if operator == querybuilderconstants.InOperator {
+		value = values
+	}
+
+	return datasetmodels.Filter{Column: column, Operator: operator, Value: value}, nil
+}
+
+// periodCondition keeps the times of the column from the start of the period of the value to the start of the next
+// one. The times are in the calendar of the organization, as the buckets the value was selected from
+func periodCondition(calendarSettings calendar.Settings, column string, period string, value interface{}) (datasetmodels.Filter, error) {
+	str, ok := value.(string)
+	if !ok {
+		return datasetmodels.Filter{}, fmt.Errorf("%w: %v is not the start of a %s", ErrInvalidSelection, value, period)
+	}
+	t, _, ok := parseTime(str)
+	if !ok {
+		return datasetmodels.Filter{}, fmt.Errorf("%w: %s is not the start of a %s", ErrInvalidSelection, str, period)
+	}
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+
+	start, err := calendarSettings.PeriodStart(t, period)
+	if err != nil {
+		return datasetmodels.Filter{}, fmt.Errorf("%w: %w", ErrInvalidSelection, err)
+	}
+	end, err := calendarSettings.PeriodEnd(t, period)
+	if err != nil {
+		return datasetmodels.Filter{}, fmt.Errorf("%w: %w", ErrInvalidSelection, err)
+	}
+	next := end.Add(time.Second)
+
+	and := datasetmodels.LogicalOperator(querybuilderconstants.LogicalOperatorAnd)
+	return datasetmodels.Filter{
+		LogicalOperator: &and,
+		Conditions: []datasetmodels.Filter{
+			{Column: column, Operator: querybuilderconstants.GreaterThanOrEqualOperator, Value: start.Format(time.DateTime)},
+			{Column: column, Operator: querybuilderconstants.LessThanOperator, Value: next.Format(time.DateTime)},
+		},
+	}, nil
+}
+
+// conditionColumn is the column a condition made from a selection filters, the one of the bounds of a period
+func conditionColumn(condition datasetmodels.Filter) string {
+	if condition.Column == "" && len(condition.Conditions) > 0 {
+		return condition.Conditions[0].Column
+	}
+	return condition.Column
+}
+
+// withConditions ands the conditions to the filters, filters joined by or are kept together as one condition
+func withConditions(filters datasetmodels.FilterModel, conditions []datasetmodels.Filter) datasetmodels.FilterModel {
+	and := datasetmodels.LogicalOperator(querybuilderconstants.LogicalOperatorAnd)
+	if len(filters.Conditions) == 0 {
+		return datasetmodels.FilterModel{LogicalOperator: and, Conditions: conditions}
+	}
+
+	if filters.LogicalOperator == "" || filters.LogicalOperator == and {
+		return datasetmodels.FilterModel{LogicalOperator: and, Conditions: append(slices.Clone(filters.Conditions), conditions...)}
+	}
+
+	logicalOperator := filters.LogicalOperator
+	return datasetmodels.FilterModel{
+		LogicalOperator: and,
+		Conditions:      append([]datasetmodels.Filter{{LogicalOperator: &logicalOperator, Conditions: filters.Conditions}}, conditions...),
+	}
+}
@@ -0,0 +1,256 @@
+package widgets
+
+import (
+	"testing"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
+	"github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveSelection(t *testing.T) {
+	t.Parallel()
+
+	widgetInstance := models.WidgetInstance{
+		ID: uuid.New(),
+		DataMappings: models.DataMappings{Mappings: []models.DataMappingFields{
+			{DatasetID: "opening", Fields: map[string][]models.Field{
+				"rows": {{Column: "account_number"}},
+			}},
+			{DatasetID: "cashflow", Fields: map[string][]models.Field{
+				"rows":    {{Column: "account_number"}},
+				"columns": {{Column: "posted_at", Alias: "date", DrilldownFilterOperator: "inbetween"}},
+			}},
+		}},
+	}
+
+	got, err := resolveSelection(widgetInstance, models.WidgetSelection{Values: map[string]interface{}{
+		"account_number": "ACC-1",
+		"date":           []interface{}{"2025-01-01", "2025-01-31"},
+	}}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []selectedField{
+		{datasetID: "opening", field: models.Field{Column: "account_number"}, value: "ACC-1"},
+		{datasetID: "cashflow", field: models.Field{Column: "account_number"}, value: "ACC-1"},
+		{datasetID: "cashflow", field: models.Field{Column: "posted_at", Alias: "date", DrilldownFilterOperator: "inbetween"}, value: []interface{}{"2025-01-01", "2025-01-31"}},
+	}, got)
+
+	_, err = resolveSelection(widgetInstance, models.WidgetSelection{Values: map[string]interface{}{"vendor": "Acme"}}, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidSelection)
+
+	// the time column is bucketed by the periodicity of the sheet
+	monthly := "month"
+	got, err = resolveSelection(widgetInstance, models.WidgetSelection{Values: map[string]interface{}{"date": "2025-01-01"}}, map[string]string{"cashflow": "posted_at"}, &monthly)
+	assert.NoError(t, err)
+	assert.Equal(t, "month", got[0].period)
+
+	// fields of an expression have no column to filter by
+	widgetInstance.DataMappings.Mappings[0].Fields["columns"] = []models.Field{{Expression: "date_trunc('month', posted_at)", Alias: "month"}}
+	_, err = resolveSelection(widgetInstance, models.WidgetSelection{Values: map[string]interface{}{"month": "2025-01-01"}}, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidSelection)
+}
+
+func TestSelectionFilters(t *testing.T) {
+	t.Parallel()
+
+	transactions := uuid.New()
+	invoices := uuid.New()
+	targetWidget := uuid.New()
+	selected := []selectedField{
+		{datasetID: transactions.String(), field: models.Field{Column: "vendor", DrilldownFilterOperator: "in"}, value: "Acme"},
+	}
+	vendorFilter := sheetmodels.NativeFilterConfig{
+		Id: "vendor",
+		Targets: []sheetmodels.FilterTarget{
+			{DatasetId: transactions, Column: "vendor"},
+			{DatasetId: invoices, Column: "supplier_name"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		sheetConfig sheetmodels.SheetConfig
+		datasetID   string
+		want        map[string][]datasetmodels.Filter
+	}{
+		{
+			name:      "dataset of the selection",
+			datasetID: transactions.String(),
+			want: map[string][]datasetmodels.Filter{
+				transactions.String(): {{Column: "vendor", Operator: "in", Value: []interface{}{"Acme"}}},
+			},
+		},
+		{
+			name:        "dataset linked by a native filter",
+			sheetConfig: sheetmodels.SheetConfig{NativeFilterConfig: []sheetmodels.NativeFilterConfig{vendorFilter}},
+			datasetID:   invoices.String(),
+			want: map[string][]datasetmodels.Filter{
+				invoices.String(): {{Column: "supplier_name", Operator: "in", Value: []interface{}{"Acme"}}},
+			},
+		},
+		{
+			name: "native filter out of the scope of the widget",
+			sheetConfig: sheetmodels.SheetConfig{NativeFilterConfig: []sheetmodels.NativeFilterConfig{func() sheetmodels.NativeFilterConfig {
+				filter := vendorFilter
+				filter.WidgetsInScope = []string{uuid.NewString()}
+				return filter
+			}()}},
+			datasetID: invoices.String(),
+			want:      map[string][]datasetmodels.Filter{},
+		},
+		{
+			name:      "dataset not linked",
+			datasetID: invoices.String(),
+			want:      map[string][]datasetmodels.Filter{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := selectionFilters(tt.sheetConfig, calendar.Settings{}, targetWidget, []models.DataMappingFields{{DatasetID: tt.datasetID}}, selected)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSelectionCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		selected selectedField
+		want     datasetmodels.Filter
+	}{
+		{
+			name:     "single value without an operator",
+			selected: selectedField{field: models.Field{Column: "region"}, value: "EU"},
+			want:     datasetmodels.Filter{Column: "region", Operator: "eq", Value: "EU"},
+		},
+		{
+			name:     "several values without an operator",
+			selected: selectedField{field: models.Field{Column: "region"}, value: []interface{}{"EU", "US"}},
+			want:     datasetmodels.Filter{Column: "region", Operator: "in", Value: []interface{}{"EU", "US"}},
+		},
+		{
+			name:     "single value of a multi-select field",
+			selected: selectedField{field: models.Field{Column: "region", DrilldownFilterType: "multi-select", DrilldownFilterOperator: "in"}, value: "EU"},
+			want:     datasetmodels.Filter{Column: "region", Operator: "in", Value: []interface{}{"EU"}},
+		},
+		{
+			name:     "range of a date-range field",
+			selected: selectedField{field: models.Field{Column: "posted_at", DrilldownFilterType: "date-range", DrilldownFilterOperator: "inbetween"}, value: []interface{}{"2025-01-01", "2025-01-31"}},
+			want:     datasetmodels.Filter{Column: "posted_at", Operator: "inbetween", Value: []interface{}{"2025-01-01", "2025-01-31"}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := selectionCondition(calendar.Settings{}, tt.selected.field.Column, tt.selected)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSelectionConditionOfPeriods(t *testing.T) {
+	t.Parallel()
+
+	and := datasetmodels.LogicalOperator("AND")
+	between := func(start string, next string) datasetmodels.Filter {
+		return datasetmodels.Filter{LogicalOperator: &and, Conditions: []datasetmodels.Filter{
+			{Column: "posted_at", Operator: "gte", Value: start},
+			{Column: "posted_at", Operator: "lt", Value: next},
+		}}
+	}
+
+	tests := []struct {
+		name     string
+		calendar calendar.Settings
+		selected selectedField
+		want     datasetmodels.Filter
+		wantErr  bool
+	}{
+		{
+			name:     "month bucket",
+			selected: selectedField{field: models.Field{Column: "posted_at"}, value: "2025-02-01T00:00:00Z", period: calendar.PeriodMonth},
+			want:     between("2025-02-01 00:00:00", "2025-03-01 00:00:00"),
+		},
+		{
+			name:     "week bucket of a calendar starting weeks on sunday",
+			calendar: calendar.Settings{WeekStart: "sunday"},
+			selected: selectedField{field: models.Field{Column: "posted_at"}, value: "2025-03-02 00:00:00", period: calendar.PeriodWeek},
+			want:     between("2025-03-02 00:00:00", "2025-03-09 00:00:00"),
+		},
+		{
+			name:     "quarter bucket of a fiscal year starting in april",
+			calendar: calendar.Settings{FiscalYearStartMonth: 4},
+			selected: selectedField{field: models.Field{Column: "posted_at"}, value: []interface{}{"2025-04-01"}, period: calendar.PeriodQuarter},
+			want:     between("2025-04-01 00:00:00", "2025-07-01 00:00:00"),
+		},
+		{
+			name:     "day of a date-range field",
+			selected: selectedField{field: models.Field{Column: "posted_at", DrilldownFilterType: "date-range", DrilldownFilterOperator: "inbetween"}, value: "2025-03-15", period: calendar.PeriodDay},
+			want:     between("2025-03-15 00:00:00", "2025-03-16 00:00:00"),
+		},
+		{
+			name:     "range of a date-range field is kept as it is",
+			selected: selectedField{field: models.Field{Column: "posted_at", DrilldownFilterType: "date-range"}, value: []interface{}{"2025-01-01", "2025-01-31"}, period: calendar.PeriodDay},
+			want:     datasetmodels.Filter{Column: "posted_at", Operator: "inbetween", Value: []interface{}{"2025-01-01", "2025-01-31"}},
+		},
+		{
+			name:     "value that is not a time",
+			selected: selectedField{field: models.Field{Column: "posted_at"}, value: "last month", period: calendar.PeriodMonth},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := selectionCondition(tt.calendar, tt.selected.field.Column, tt.selected)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSelection)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithConditions(t *testing.T) {
+	t.Parallel()
+
+	and := datasetmodels.LogicalOperator("AND")
+	or := datasetmodels.LogicalOperator("OR")
+	selection := []datasetmodels.Filter{{Column: "vendor", Operator: "eq", Value: "Acme"}}
+	status := datasetmodels.Filter{Column: "status", Operator: "eq", Value: "open"}
+	region := datasetmodels.Filter{Column: "region", Operator: "eq", Value: "EU"}
+
+	assert.Equal(t, datasetmodels.FilterModel{LogicalOperator: and, Conditions: selection}, withConditions(datasetmodels.FilterModel{}, selection))
+
+	assert.Equal(t, datasetmodels.FilterModel{
+		LogicalOperator: and,
+		Conditions:      []datasetmodels.Filter{status, selection[0]},
+	}, withConditions(datasetmodels.FilterModel{LogicalOperator: and, Conditions: []datasetmodels.Filter{status}}, selection))
+
+	assert.Equal(t, datasetmodels.FilterModel{
+		LogicalOperator: and,
+		Conditions: []datasetmodels.Filter{
+			{LogicalOperator: &or, Conditions: []datasetmodels.Filter{status, region}},
+			selection[0],
+		},
+	}, withConditions(datasetmodels.FilterModel{LogicalOperator: or, Conditions: []datasetmodels.Filter{status, region}}, selection))
+}
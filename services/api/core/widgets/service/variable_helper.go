@@ -0,0 +1,51 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
+	"github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+)
+
+// sheetVariables returns the values of the variables of the sheet for the data being loaded
+func sheetVariables(sheet *dbmodels.Sheet, values map[string]string) (map[string]models.Variable, error) {
+	sheetConfig, err := parseSheetConfig(sheet)
+	if err != nil {
+		return nil, err
+	}
+	return resolveVariables(sheetConfig, values)
+}
+
+// resolveVariables returns the values the variables of the sheet take for the data being loaded, the values the user
+// chose and the default values of the variables the user left as they are
+func resolveVariables(sheetConfig sheetmodels.SheetConfig, values map[string]string) (map[string]models.Variable, error) {
+	variables := make(map[string]models.Variable, len(sheetConfig.Variables))
+	for _, sheetVariable := range sheetConfig.Variables {
+		value := sheetVariable.DefaultValue
+		if chosen, ok := values[sheetVariable.Name]; ok {
+			value = chosen
+		}
+
+		parsed, err := sheetVariable.ParseValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidVariable, err)
+		}
+		variables[sheetVariable.Name] = models.Variable{Type: sheetVariable.Type, Value: parsed}
+	}
+
+	for name := range values {
+		if _, ok := variables[name]; !ok {
+			return nil, fmt.Errorf("%w: %s is not a variable of the sheet", ErrInvalidVariable, name)
+		}
+	}
+
+	return variables, nil
+}
+
+// sqlStringLiteral quotes the value as a string literal of the warehouse, which escapes quotes with backslashes
+func sqlStringLiteral(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+	return "'" + escaped + "'"
+}
@@ -0,0 +1,128 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
+	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
+	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	"github.com/google/uuid"
+)
+
+func TestResolveVariables(t *testing.T) {
+	t.Parallel()
+
+	sheetConfig := sheetmodels.SheetConfig{Variables: []sheetmodels.SheetVariable{
+		{Name: "region", Type: sheetmodels.VariableTypeEnum, DefaultValue: "EMEA", Source: &sheetmodels.VariableSource{DatasetId: uuid.New(), Column: "region"}},
+		{Name: "min_amount", Type: sheetmodels.VariableTypeNumber, DefaultValue: "1000"},
+		{Name: "cutoff", Type: sheetmodels.VariableTypeDate, DefaultValue: "2024-01-01"},
+	}}
+
+	tests := []struct {
+		name    string
+		values  map[string]string
+		want    map[string]widgetmodels.Variable
+		wantErr bool
+	}{
+		{
+			name: "default values",
+			want: map[string]widgetmodels.Variable{
+				"region":     {Type: sheetmodels.VariableTypeEnum, Value: "EMEA"},
+				"min_amount": {Type: sheetmodels.VariableTypeNumber, Value: "1000"},
+				"cutoff":     {Type: sheetmodels.VariableTypeDate, Value: "2024-01-01 00:00:00"},
+			},
+		},
+		{
+			name:   "values chosen by the user",
+			values: map[string]string{"region": "APAC", "cutoff": "2024-06-30 12:00:00"},
+			want: map[string]widgetmodels.Variable{
+				"region":     {Type: sheetmodels.VariableTypeEnum, Value: "APAC"},
+				"min_amount": {Type: sheetmodels.VariableTypeNumber, Value: "1000"},
+				"cutoff":     {Type: sheetmodels.VariableTypeDate, Value: "2024-06-30 12:00:00"},
+			},
+		},
+		{
+			name:    "value of another type",
+			values:  map[string]string{"min_amount": "1000 OR 1=1"},
+			wantErr: true,
+		},
+		{
+			name:    "variable the sheet does not have",
+			values:  map[string]string{"currency": "USD"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := resolveVariables(sheetConfig, tt.values)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidVariable)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBasicChartStrategy_ToDatasetParamsWithVariables(t *testing.T) {
+	t.Parallel()
+
+	instance := &widgetmodels.WidgetInstance{
+		DataMappings: widgetmodels.DataMappings{
+			Mappings: []widgetmodels.DataMappingFields{{
+				DatasetID: "transactions",
+				Ref:       "revenue",
+				Fields: map[string][]widgetmodels.Field{
+					widgetconstants.XAxisField: {{Column: "size", Expression: "CASE WHEN amount >= {{.$min_amount}} THEN {{.$label}} ELSE 'other' END"}},
+					widgetconstants.YAxisField: {{Column: "amount", Aggregation: "sum"}},
+				},
+				DefaultFilters: &datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{
+					{Column: "region", Operator: "in", Value: []interface{}{"{{.$region}}"}},
+					{Column: "posted_at", Operator: "gte", Value: []interface{}{"{{.$cutoff.addDays(-7)}}"}},
+				}},
+			}},
+		},
+	}
+
+	got, err := BasicChartStrategy{BaseStrategy: *NewBaseStrategy()}.ToDatasetParams(instance, widgetmodels.DatasetBuilderParams{
+		Variables: map[string]widgetmodels.Variable{
+			"region":     {Type: sheetmodels.VariableTypeEnum, Value: "EMEA"},
+			"min_amount": {Type: sheetmodels.VariableTypeNumber, Value: "1000"},
+			"label":      {Type: sheetmodels.VariableTypeString, Value: `large 'deals'`},
+			"cutoff":     {Type: sheetmodels.VariableTypeDate, Value: "2024-01-08 00:00:00"},
+		},
+	})
+	require.NoError(t, err)
+
+	params := got["revenue"].Params
+	assert.Equal(t, `CASE WHEN amount >= 1000 THEN 'large \'deals\'' ELSE 'other' END`, params.GroupBy[0].Column)
+	assert.Equal(t, []string{"EMEA"}, params.Filters.Conditions[0].Value)
+	assert.Equal(t, []string{"2024-01-01 00:00:00"}, params.Filters.Conditions[1].Value)
+
+	// the instance keeps its parameters for the next time its data is loaded
+	assert.Equal(t, "CASE WHEN amount >= {{.$min_amount}} THEN {{.$label}} ELSE 'other' END", instance.DataMappings.Mappings[0].Fields[widgetconstants.XAxisField][0].Expression)
+}
+
+func TestPopulateParamsWithVariables(t *testing.T) {
+	t.Parallel()
+
+	baseStrategy := NewBaseStrategy()
+	baseStrategy.variables = map[string]widgetmodels.Variable{
+		"region": {Type: sheetmodels.VariableTypeString, Value: "EMEA"},
+	}
+
+	assert.Equal(t, "EMEA", baseStrategy.PopulateParams("{{.$region}}", nil))
+	// methods are only for dates, and parameters no processor knows are left as they are
+	assert.Equal(t, "{{.$region.addDays(1)}}", baseStrategy.PopulateParams("{{.$region.addDays(1)}}", nil))
+	assert.Equal(t, "{{.$currency}}", baseStrategy.PopulateParams("{{.$currency}}", nil))
+	assert.Equal(t, "region = 'EMEA' AND currency = {{.$currency}}", baseStrategy.PopulateExpressionParams("region = {{.$region}} AND currency = {{.$currency}}"))
+}
@@ -0,0 +1,332 @@
+package widgets
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	"github.com/Zampfi/application-platform/services/api/core/pages"
+	"github.com/Zampfi/application-platform/services/api/core/sheets"
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
+	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (s *widgetsService) getWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) (*dbmodels.WidgetInstance, error) {
+	widgetInstance, err := s.store.GetWidgetInstanceByID(ctx, widgetInstanceID)
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWidgetInstanceNotFound
+		}
+		return nil, err
+	}
+
+	if widgetInstance.DeletedAt != nil {
+		return nil, ErrWidgetInstanceNotFound
+	}
+
+	return &widgetInstance, nil
+}
+
+// getCalendarSettings returns the calendar the organization reports its periods in
+func (s *widgetsService) getCalendarSettings(ctx context.Context, orgId uuid.UUID) (calendar.Settings, error) {
+	organization, err := s.store.GetOrganizationById(ctx, orgId.String())
+	if err != nil {
+		return calendar.Settings{}, fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	return calendar.Parse(organization.CalendarSettings)
+}
+
+func (s *widgetsService) getSheet(ctx context.Context, sheetId uuid.UUID) (*dbmodels.Sheet, error) {
+	sheet, err := s.store.GetSheetById(ctx, sheetId)
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, sheets.ErrSheetNotFound
+		}
+		return nil, fmt.Errorf("failed to get sheet: %w", err)
+	}
+
+	if sheet.DeletedAt != nil {
+		return nil, sheets.ErrSheetNotFound
+	}
+
+	return sheet, nil
+}
+
+// getAdminSheet returns the sheet once it is known the current user is an admin of its page
+func (s *widgetsService) getAdminSheet(ctx context.Context, sheetId uuid.UUID) (*dbmodels.Sheet, error) {
+	sheet, err := s.getSheet(ctx, sheetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pages.EnsurePageAdmin(ctx, s.store, sheet.PageId); err != nil {
+		return nil, err
+	}
+
+	return sheet, nil
+}
+
+// updateSheetConfig saves the sheet with the config, the widget instances loaded with the sheet are left out of the save
+func updateSheetConfig(ctx context.Context, sheetStore store.SheetStore, sheet *dbmodels.Sheet, sheetConfig sheetmodels.SheetConfig) error {
+	rawConfig, err := json.Marshal(sheetConfig)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sheet config: %w", err)
+	}
+
+	updatedSheet := *sheet
+	updatedSheet.WidgetInstances = nil
+	updatedSheet.SheetConfig = rawConfig
+	if _, err := sheetStore.UpdateSheet(ctx, &updatedSheet); err != nil {
+		return fmt.Errorf("failed to update sheet config: %w", err)
+	}
+
+	return nil
+}
+
+func (s *widgetsService) validateWidgetInstance(ctx context.Context, widgetInstance models.WidgetInstance) error {
+	widgetTemplate, err := s.store.GetWidgetTemplate(ctx, widgetInstance.WidgetType)
+	if err != nil {
+		if goerrors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %s", ErrUnknownWidgetType, widgetInstance.WidgetType)
+		}
+		return fmt.Errorf("failed to get widget template: %w", err)
+	}
+
+	// a template without a strategy would save widget instances that can never load their data
+	if _, err := NewDatasetParamsBuilder(widgetInstance.WidgetType); err != nil {
+		return fmt.Errorf("%w: %s", ErrUnknownWidgetType, widgetInstance.WidgetType)
+	}
+
+	if err := validateDataMappings(widgetInstance.DataMappings); err != nil {
+		return err
+	}
+
+	templateSchema := models.WidgetTemplateSchema{}
+	if err := templateSchema.FromDB(&widgetTemplate); err != nil {
+		return fmt.Errorf("failed to parse widget template: %w", err)
+	}
+
+	return validateTemplateFields(templateSchema, widgetInstance.DataMappings)
+}
+
+// validateTemplateFields checks the data mappings against the fields of the template, a required field has to be
+// mapped by at least one of the mappings
+func validateTemplateFields(templateSchema models.WidgetTemplateSchema, dataMappings models.DataMappings) error {
+	if templateSchema.MappingsCardinality == models.TemplateCardinalitySingle && len(dataMappings.Mappings) > 1 {
+		return fmt.Errorf("%w: the widget type takes a single mapping", ErrInvalidDataMappings)
+	}
+
+	for name, templateField := range templateSchema.Mappings.Fields {
+		mapped := false
+		for i, mapping := range dataMappings.Mappings {
+			fields := mapping.Fields[name]
+			if len(fields) == 0 {
+				continue
+			}
+			mapped = true
+
+			if templateField.Cardinality == models.TemplateCardinalitySingle && len(fields) > 1 {
+				return fmt.Errorf("%w: field %s of mapping %d takes a single field", ErrInvalidDataMappings, name, i)
+			}
+			if templateField.MaxFields > 0 && len(fields) > templateField.MaxFields {
+				return fmt.Errorf("%w: field %s of mapping %d takes at most %d fields", ErrInvalidDataMappings, name, i, templateField.MaxFields)
+			}
+
+			for _, field := range fields {
+				if !isAllowedAggregation(templateField, field.Aggregation) {
+					return fmt.Errorf("%w: aggregation %s is not allowed for field %s", ErrInvalidDataMappings, field.Aggregation, name)
+				}
+			}
+		}
+
+		if templateField.Required && !mapped {
+			return fmt.Errorf("%w: field %s is required", ErrInvalidDataMappings, name)
+		}
+	}
+
+	return nil
+}
+
+// isAllowedAggregation leaves the window functions out of the check, the templates only list the aggregate functions
+func isAllowedAggregation(templateField models.WidgetTemplateField, aggregation string) bool {
+	if aggregation == "" || len(templateField.AllowedAggregations) == 0 {
+		return true
+	}
+
+	if aggregation == widgetconstants.WindowFunctionFirst || aggregation == widgetconstants.WindowFunctionLast {
+		return true
+	}
+
+	return slices.Contains(templateField.AllowedAggregations, aggregation)
+}
+
+func validateDataMappings(dataMappings models.DataMappings) error {
+	if dataMappings.Version != models.DataMappingVersion1 {
+		return fmt.Errorf("%w: unsupported version %q", ErrInvalidDataMappings, dataMappings.Version)
+	}
+
+	if len(dataMappings.Mappings) == 0 {
+		return fmt.Errorf("%w: at least one mapping is required", ErrInvalidDataMappings)
+	}
+
+	refs := map[string]bool{}
+	for i, mapping := range dataMappings.Mappings {
+		if mapping.Ref != "" {
+			if refs[mapping.Ref] {
+				return fmt.Errorf("%w: ref %s is used by more than one mapping", ErrInvalidDataMappings, mapping.Ref)
+			}
+			refs[mapping.Ref] = true
+		}
+
+		if mapping.DatasetID == "" && mapping.SourceDatasets == nil {
+			return fmt.Errorf("%w: mapping %d needs a dataset or source datasets", ErrInvalidDataMappings, i)
+		}
+
+		if mapping.SourceDatasets != nil {
+			if err := validateSourceDatasets(i, *mapping.SourceDatasets); err != nil {
+				return err
+			}
+		}
+
+		for name, fields := range mapping.Fields {
+			for _, field := range fields {
+				if field.Column == "" && field.Expression == "" {
+					return fmt.Errorf("%w: field %s of mapping %d needs a column or an expression", ErrInvalidDataMappings, name, i)
+				}
+				if err := validateSortBy(i, field.SortBy); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := validateSortBy(i, mapping.SortBy); err != nil {
+			return err
+		}
+	}
+
+	if err := validateComparisons(dataMappings.Comparisons); err != nil {
+		return err
+	}
+
+	if dataMappings.Target != nil {
+		if err := validateTarget(*dataMappings.Target); err != nil {
+			return err
+		}
+	}
+
+	if dataMappings.DrillThrough != nil {
+		return validateDrillThrough(*dataMappings.DrillThrough)
+	}
+
+	return nil
+}
+
+func validateComparisons(comparisons []models.Comparison) error {
+	names := map[string]bool{}
+	for _, comparison := range comparisons {
+		switch comparison.Type {
+		case models.ComparisonPreviousPeriod, models.ComparisonSamePeriodLastYear:
+		case models.ComparisonCustom:
+			if comparison.Offset == nil || comparison.Offset.Value <= 0 || !widgetconstants.Periodicities[comparison.Offset.Unit] {
+				return fmt.Errorf("%w: custom comparison %s needs a positive offset in days, weeks, months, quarters or years", ErrInvalidDataMappings, comparison.GetName())
+			}
+		default:
+			return fmt.Errorf("%w: unknown comparison %q", ErrInvalidDataMappings, comparison.Type)
+		}
+
+		if names[comparison.GetName()] {
+			return fmt.Errorf("%w: comparison %s is used more than once", ErrInvalidDataMappings, comparison.GetName())
+		}
+		names[comparison.GetName()] = true
+	}
+
+	return nil
+}
+
+func validateTarget(target models.Target) error {
+	switch target.Type {
+	case models.TargetConstant:
+		if target.Value == nil {
+			return fmt.Errorf("%w: constant target needs a value", ErrInvalidDataMappings)
+		}
+	case models.TargetDataset:
+		if target.DatasetID == "" || target.Field == nil || target.Field.Column == "" || target.Field.Aggregation == "" {
+			return fmt.Errorf("%w: target from a dataset needs the dataset and an aggregated field", ErrInvalidDataMappings)
+		}
+	default:
+		return fmt.Errorf("%w: unknown target %q", ErrInvalidDataMappings, target.Type)
+	}
+
+	return nil
+}
+
+func validateDrillThrough(drillThrough models.DrillThrough) error {
+	switch drillThrough.TargetType {
+	case models.DrillThroughTargetSheet:
+		if drillThrough.SheetID == nil || *drillThrough.SheetID == uuid.Nil {
+			return fmt.Errorf("%w: drill-through to a sheet needs the sheet", ErrInvalidDataMappings)
+		}
+	case models.DrillThroughTargetDataset:
+		if drillThrough.DatasetID == "" {
+			return fmt.Errorf("%w: drill-through to a dataset needs the dataset", ErrInvalidDataMappings)
+		}
+	default:
+		return fmt.Errorf("%w: unknown drill-through target %q", ErrInvalidDataMappings, drillThrough.TargetType)
+	}
+
+	return nil
+}
+
+func validateSourceDatasets(mappingIndex int, sourceDatasets models.SourceDatasets) error {
+	if len(sourceDatasets.Datasets) == 0 {
+		return fmt.Errorf("%w: source datasets of mapping %d are empty", ErrInvalidDataMappings, mappingIndex)
+	}
+
+	aliases := map[string]bool{}
+	for _, dataset := range sourceDatasets.Datasets {
+		if dataset.ID == "" || dataset.Alias == "" {
+			return fmt.Errorf("%w: source datasets of mapping %d need an id and an alias", ErrInvalidDataMappings, mappingIndex)
+		}
+		if aliases[dataset.Alias] {
+			return fmt.Errorf("%w: alias %s of mapping %d is used more than once", ErrInvalidDataMappings, dataset.Alias, mappingIndex)
+		}
+		aliases[dataset.Alias] = true
+	}
+
+	for _, join := range sourceDatasets.Joins {
+		if !aliases[join.LeftDatasetAlias] || !aliases[join.RightDatasetAlias] {
+			return fmt.Errorf("%w: join of %s and %s in mapping %d uses an unknown alias", ErrInvalidDataMappings, join.LeftDatasetAlias, join.RightDatasetAlias, mappingIndex)
+		}
+		if len(join.Conditions) == 0 {
+			return fmt.Errorf("%w: join of %s and %s in mapping %d has no conditions", ErrInvalidDataMappings, join.LeftDatasetAlias, join.RightDatasetAlias, mappingIndex)
+		}
+	}
+
+	return nil
+}
+
+func validateSortBy(mappingIndex int, sortBy []models.SortBy) error {
+	for _, sort := range sortBy {
+		if sort.GetColumn() == "" {
+			return fmt.Errorf("%w: sort of mapping %d needs a column", ErrInvalidDataMappings, mappingIndex)
+		}
+
+		switch strings.ToLower(sort.Order) {
+		case "", "asc", "desc":
+		default:
+			return fmt.Errorf("%w: sort order %q of mapping %d is not asc or desc", ErrInvalidDataMappings, sort.Order, mappingIndex)
+		}
+	}
+
+	return nil
+}
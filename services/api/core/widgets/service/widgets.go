@@ -2,11 +2,18 @@ package widgets
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
 
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
 	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	"github.com/Zampfi/application-platform/services/api/core/pages"
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
 	widgetconstants "github.com/Zampfi/application-platform/services/api/core/widgets/constants"
 	"github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	"github.com/google/uuid"
@@ -16,13 +23,21 @@ import (
 
 type WidgetsServiceStore interface {
 	store.WidgetStore
+	store.SheetStore
+	store.OrganizationReadStore
+	store.FlattenedResourceAudiencePoliciesStore
+	store.TransactionStore
 }
 
 type WidgetsService interface {
 	GetWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) (models.WidgetInstance, error)
 	GetWidgetInstanceData(ctx context.Context, orgId uuid.UUID, widgetInstanceID uuid.UUID, params models.GetWidgetInstanceDataQueryParams) ([]datasetmodels.DatasetData, error)
+	GetSheetData(ctx context.Context, orgId uuid.UUID, sheetId uuid.UUID, params models.GetWidgetInstanceDataQueryParams) (map[uuid.UUID]models.WidgetInstanceData, error)
 	CreateWidgetInstance(ctx context.Context, widgetInstance models.WidgetInstance) (*models.WidgetInstance, error)
 	UpdateWidgetInstance(ctx context.Context, widgetInstance models.WidgetInstance) (*models.WidgetInstance, error)
+	DuplicateWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) (*models.WidgetInstance, error)
+	DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error
+	GetDrillThroughTarget(ctx context.Context, orgId uuid.UUID, widgetInstanceID uuid.UUID, selection models.WidgetSelection, params models.GetWidgetInstanceDataQueryParams) (models.DrillThroughTarget, error)
 }
 
 type widgetsService struct {
@@ -36,14 +51,14 @@ func NewWidgetsService(appStore store.Store, datasetService datasetservice.Datas
 
 func (s *widgetsService) GetWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) (models.WidgetInstance, error) {
 	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
-	widgetInstance, err := s.store.GetWidgetInstanceByID(ctx, widgetInstanceID)
+	widgetInstance, err := s.getWidgetInstance(ctx, widgetInstanceID)
 	if err != nil {
 		ctxLogger.Error("failed to get widget instance", zap.String("error", err.Error()))
 		return models.WidgetInstance{}, err
 	}
 
 	widgetInstanceModel := models.WidgetInstance{}
-	if err := widgetInstanceModel.FromDB(&widgetInstance); err != nil {
+	if err := widgetInstanceModel.FromDB(widgetInstance); err != nil {
 		ctxLogger.Error("failed to parse widget instance", zap.String("error", err.Error()))
 		return models.WidgetInstance{}, err
 	}
@@ -54,10 +69,7 @@ func (s *widgetsService) GetWidgetInstance(ctx context.Context, widgetInstanceID
 func (s *widgetsService) GetWidgetInstanceData(ctx context.Context, orgId uuid.UUID, widgetInstanceID uuid.UUID, params models.GetWidgetInstanceDataQueryParams) ([]datasetmodels.DatasetData, error) {
 	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
 
-	datasetFilters := make(map[string]models.WidgetFilters)
-	for _, filter := range params.Filters {
-		datasetFilters[filter.DatasetID] = filter
-	}
+	datasetFilters := newDatasetFilters(params.Filters)
 
 	widgetInstance, err := s.store.GetWidgetInstanceByID(ctx, widgetInstanceID)
 	if err != nil {
@@ -71,23 +83,32 @@ func (s *widgetsService) GetWidgetInstanceData(ctx context.Context, orgId uuid.U
 		return []datasetmodels.DatasetData{}, err
 	}
 
-	datasetParamsBuilder, err := NewDatasetParamsBuilder(widgetInstanceModel.WidgetType)
+	sheet, err := s.getSheet(ctx, widgetInstanceModel.SheetID)
 	if err != nil {
-		ctxLogger.Error("failed to get dataset params builder", zap.String("error", err.Error()))
+		ctxLogger.Error("failed to get sheet of the widget instance", zap.String("error", err.Error()))
 		return []datasetmodels.DatasetData{}, err
 	}
 
-	timeColumnMap := make(map[string]string)
-	for _, timeColumn := range params.TimeColumns {
-		timeColumnMap[timeColumn.DatasetID] = timeColumn.Column
+	variables, err := sheetVariables(sheet, params.Variables)
+	if err != nil {
+		ctxLogger.Info("failed to resolve variables", zap.String("error", err.Error()))
+		return []datasetmodels.DatasetData{}, err
 	}
 
-	datasetParams, err := datasetParamsBuilder.ToDatasetParams(&widgetInstanceModel, models.DatasetBuilderParams{
-		Filters:     datasetFilters,
-		TimeColumns: timeColumnMap,
-		Periodicity: params.Periodicity,
-		Currency:    params.Currency,
-	})
+	calendarSettings, err := s.getCalendarSettings(ctx, orgId)
+	if err != nil {
+		ctxLogger.Error("failed to get calendar settings", zap.String("error", err.Error()))
+		return []datasetmodels.DatasetData{}, err
+	}
+
+	if len(params.Selections) > 0 {
+		if err := applySelections(sheet, widgetInstanceModel, params, calendarSettings, datasetFilters); err != nil {
+			ctxLogger.Info("failed to apply selections", zap.String("error", err.Error()))
+			return []datasetmodels.DatasetData{}, err
+		}
+	}
+
+	datasetParamsBuilder, builderParams, datasetParams, err := resolveDatasetParams(&widgetInstanceModel, datasetFilters, params, calendarSettings, variables)
 	if err != nil {
 		ctxLogger.Error("failed to get dataset params", zap.String("error", err.Error()))
 		return []datasetmodels.DatasetData{}, err
@@ -101,15 +122,11 @@ func (s *widgetsService) GetWidgetInstanceData(ctx context.Context, orgId uuid.U
 		ref  string
 	}
 
+	multipleRefs := hasMultipleMappingRefs(&widgetInstanceModel, datasetParams)
 	dataResultsChan := make(chan datasetResult, len(datasetParams))
 	for ref, params := range datasetParams {
 		params := params
 		ref := ref
-		//params.Params.FxCurrency = nil
-		params.Params.Pagination = &datasetmodels.Pagination{
-			Page:     1,
-			PageSize: widgetconstants.MAX_PAGE_SIZE,
-		}
 		errGroup.Go(func() error {
 			data, err := s.datasetService.GetDataByDatasetId(ctx, orgId, params.DatasetID, params.Params)
 			if err != nil {
@@ -117,14 +134,7 @@ func (s *widgetsService) GetWidgetInstanceData(ctx context.Context, orgId uuid.U
 				return err
 			}
 
-			tagColumn, ok := data.GetTagsColumns()
-			if ok {
-				data = s.flattenTags(&data, tagColumn)
-			}
-
-			data = s.addRefToDataResults(&data, &ref, len(datasetParams) > 1)
-
-			dataResultsChan <- datasetResult{data: data, ref: ref}
+			dataResultsChan <- datasetResult{data: s.prepareDatasetData(data, ref, multipleRefs), ref: ref}
 			return nil
 		})
 	}
@@ -142,18 +152,154 @@ func (s *widgetsService) GetWidgetInstanceData(ctx context.Context, orgId uuid.U
 		resultMap[result.ref] = result.data
 	}
 
-	dataResults := make([]datasetmodels.DatasetData, 0, len(datasetParams))
-	for _, mapping := range widgetInstanceModel.DataMappings.Mappings {
-		if data, exists := resultMap[mapping.Ref]; exists {
-			dataResults = append(dataResults, data)
-		}
+	dataResults, err := assembleWidgetData(&widgetInstanceModel, datasetParamsBuilder, builderParams, resultMap)
+	if err != nil {
+		ctxLogger.Error("failed to transform widget data", zap.String("error", err.Error()))
+		return []datasetmodels.DatasetData{}, err
 	}
+
 	return dataResults, nil
 }
 
+// GetSheetData loads the data of every widget instance of the sheet with the filters of the sheet. The widget instances
+// share the queries they have in common, a query runs once however many widget instances need it, and a widget
+// instance failing to load leaves the others loaded
+func (s *widgetsService) GetSheetData(ctx context.Context, orgId uuid.UUID, sheetId uuid.UUID, params models.GetWidgetInstanceDataQueryParams) (map[uuid.UUID]models.WidgetInstanceData, error) {
+	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
+
+	sheet, err := s.getSheet(ctx, sheetId)
+	if err != nil {
+		ctxLogger.Error("failed to get sheet", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	calendarSettings, err := s.getCalendarSettings(ctx, orgId)
+	if err != nil {
+		ctxLogger.Error("failed to get calendar settings", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	variables, err := sheetVariables(sheet, params.Variables)
+	if err != nil {
+		ctxLogger.Info("failed to resolve variables", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	type sheetWidget struct {
+		instance     models.WidgetInstance
+		builder      DatasetParamsBuilder
+		params       models.DatasetBuilderParams
+		queries      map[string]string
+		multipleRefs bool
+		err          error
+	}
+
+	queries := make(map[string]models.GetDataByDatasetIDParams)
+	widgets := make([]*sheetWidget, 0, len(sheet.WidgetInstances))
+	for _, dbWidgetInstance := range sheet.WidgetInstances {
+		if dbWidgetInstance.DeletedAt != nil {
+			continue
+		}
+
+		widget := &sheetWidget{queries: make(map[string]string)}
+		widgets = append(widgets, widget)
+		if widget.err = widget.instance.FromDB(&dbWidgetInstance); widget.err != nil {
+			widget.instance.ID = dbWidgetInstance.ID
+			continue
+		}
+
+		datasetFilters := newDatasetFilters(params.Filters)
+		if len(params.Selections) > 0 {
+			if widget.err = applySelections(sheet, widget.instance, params, calendarSettings, datasetFilters); widget.err != nil {
+				continue
+			}
+		}
+
+		var datasetParams map[string]models.GetDataByDatasetIDParams
+		widget.builder, widget.params, datasetParams, widget.err = resolveDatasetParams(&widget.instance, datasetFilters, params, calendarSettings, variables)
+		if widget.err != nil {
+			continue
+		}
+
+		widget.multipleRefs = hasMultipleMappingRefs(&widget.instance, datasetParams)
+		for ref, datasetParam := range datasetParams {
+			key, err := datasetQueryKey(datasetParam)
+			if err != nil {
+				widget.err = err
+				break
+			}
+			queries[key] = datasetParam
+			widget.queries[ref] = key
+		}
+	}
+
+	type queryResult struct {
+		data datasetmodels.DatasetData
+		err  error
+	}
+
+	var mu sync.Mutex
+	results := make(map[string]queryResult, len(queries))
+	errGroup := errgroup.Group{}
+	errGroup.SetLimit(widgetconstants.MAX_CONCURRENT_SHEET_QUERIES)
+	for key, query := range queries {
+		errGroup.Go(func() error {
+			data, err := s.datasetService.GetDataByDatasetId(ctx, orgId, query.DatasetID, query.Params)
+			if err != nil {
+				ctxLogger.Error("failed to get data by dataset id", zap.String("error", err.Error()), zap.String("dataset_id", query.DatasetID))
+			}
+
+			mu.Lock()
+			results[key] = queryResult{data: data, err: err}
+			mu.Unlock()
+			return nil
+		})
+	}
+	_ = errGroup.Wait()
+
+	ctxLogger.Info("loaded sheet data", zap.String("sheet_id", sheetId.String()), zap.Int("widget_instances", len(widgets)), zap.Int("queries", len(queries)))
+
+	sheetData := make(map[uuid.UUID]models.WidgetInstanceData, len(widgets))
+	for _, widget := range widgets {
+		if widget.err != nil {
+			sheetData[widget.instance.ID] = models.WidgetInstanceData{Err: widget.err}
+			continue
+		}
+
+		resultMap := make(map[string]datasetmodels.DatasetData, len(widget.queries))
+		for ref, key := range widget.queries {
+			result := results[key]
+			if result.err != nil {
+				widget.err = result.err
+				break
+			}
+			// the rows of a shared query are changed for every widget instance, like with the ref of the mapping
+			resultMap[ref] = s.prepareDatasetData(cloneDatasetData(result.data), ref, widget.multipleRefs)
+		}
+		if widget.err != nil {
+			sheetData[widget.instance.ID] = models.WidgetInstanceData{Err: widget.err}
+			continue
+		}
+
+		data, err := assembleWidgetData(&widget.instance, widget.builder, widget.params, resultMap)
+		sheetData[widget.instance.ID] = models.WidgetInstanceData{Data: data, Err: err}
+	}
+
+	return sheetData, nil
+}
+
 func (s *widgetsService) CreateWidgetInstance(ctx context.Context, widgetInstance models.WidgetInstance) (*models.WidgetInstance, error) {
 	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
 
+	if _, err := s.getAdminSheet(ctx, widgetInstance.SheetID); err != nil {
+		ctxLogger.Info("current user cannot add widget instances to the sheet", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	if err := s.validateWidgetInstance(ctx, widgetInstance); err != nil {
+		return nil, err
+	}
+
 	widgetInstanceDB, err := widgetInstance.ToDB()
 	if err != nil {
 		ctxLogger.Error("failed to convert widget instance to db model", zap.String("error", err.Error()))
@@ -185,6 +331,11 @@ func (s *widgetsService) UpdateWidgetInstance(ctx context.Context, updatedInstan
 		return nil, err
 	}
 
+	if _, err := s.getAdminSheet(ctx, widgetInstance.SheetID); err != nil {
+		ctxLogger.Info("current user cannot update the widget instance", zap.String("error", err.Error()))
+		return nil, err
+	}
+
 	if updatedInstance.WidgetType != "" {
 		widgetInstance.WidgetType = updatedInstance.WidgetType
 	}
@@ -198,10 +349,20 @@ func (s *widgetsService) UpdateWidgetInstance(ctx context.Context, updatedInstan
 		widgetInstance.DisplayConfig = updatedInstance.DisplayConfig
 	}
 
-	if updatedInstance.SheetID != uuid.Nil {
+	if updatedInstance.SheetID != uuid.Nil && updatedInstance.SheetID != widgetInstance.SheetID {
+		if _, err := s.getAdminSheet(ctx, updatedInstance.SheetID); err != nil {
+			ctxLogger.Info("current user cannot move the widget instance to the sheet", zap.String("error", err.Error()))
+			return nil, err
+		}
 		widgetInstance.SheetID = updatedInstance.SheetID
 	}
 
+	if updatedInstance.WidgetType != "" || updatedInstance.DataMappings.Version != "" {
+		if err := s.validateWidgetInstance(ctx, widgetInstance); err != nil {
+			return nil, err
+		}
+	}
+
 	widgetInstanceDB, err := widgetInstance.ToDB()
 	if err != nil {
 		ctxLogger.Error("failed to convert widget instance to db model", zap.String("error", err.Error()))
@@ -224,3 +385,212 @@ func (s *widgetsService) UpdateWidgetInstance(ctx context.Context, updatedInstan
 
 	return &result, nil
 }
+
+// DuplicateWidgetInstance copies the widget instance onto its sheet, the copy gets its own layout group below the
+// others when the source is laid out
+func (s *widgetsService) DuplicateWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) (*models.WidgetInstance, error) {
+	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
+
+	widgetInstance, err := s.getWidgetInstance(ctx, widgetInstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet, err := s.getAdminSheet(ctx, widgetInstance.SheetID)
+	if err != nil {
+		ctxLogger.Info("current user cannot duplicate the widget instance", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	sheetConfig := sheetmodels.SheetConfig{}
+	if len(sheet.SheetConfig) > 0 {
+		if err := json.Unmarshal(sheet.SheetConfig, &sheetConfig); err != nil {
+			return nil, fmt.Errorf("failed to parse sheet config: %w", err)
+		}
+	}
+
+	var duplicatedInstance *dbmodels.WidgetInstance
+	err = s.store.WithTx(ctx, func(tx store.Store) error {
+		duplicatedInstance, err = tx.CreateWidgetInstance(ctx, &dbmodels.WidgetInstance{
+			WidgetType:    widgetInstance.WidgetType,
+			SheetID:       widgetInstance.SheetID,
+			Title:         widgetInstance.Title + pages.DuplicateNameSuffix,
+			DataMappings:  widgetInstance.DataMappings,
+			DisplayConfig: widgetInstance.DisplayConfig,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create widget instance: %w", err)
+		}
+
+		return updateSheetConfig(ctx, tx, sheet, sheetConfig.WithWidgetBelow(widgetInstance.ID, duplicatedInstance.ID, duplicatedInstance.Title))
+	})
+	if err != nil {
+		ctxLogger.Error("failed to duplicate widget instance", zap.Error(err))
+		return nil, err
+	}
+
+	result := models.WidgetInstance{}
+	if err := result.FromDB(duplicatedInstance); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DeleteWidgetInstance deletes the widget instance and drops it from the layout and the filters of its sheet
+func (s *widgetsService) DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error {
+	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
+
+	widgetInstance, err := s.getWidgetInstance(ctx, widgetInstanceID)
+	if err != nil {
+		return err
+	}
+
+	sheet, err := s.getAdminSheet(ctx, widgetInstance.SheetID)
+	if err != nil {
+		ctxLogger.Info("current user cannot delete the widget instance", zap.String("error", err.Error()))
+		return err
+	}
+
+	sheetConfig := sheetmodels.SheetConfig{}
+	if len(sheet.SheetConfig) > 0 {
+		if err := json.Unmarshal(sheet.SheetConfig, &sheetConfig); err != nil {
+			return fmt.Errorf("failed to parse sheet config: %w", err)
+		}
+	}
+
+	err = s.store.WithTx(ctx, func(tx store.Store) error {
+		if err := tx.DeleteWidgetInstance(ctx, widgetInstanceID); err != nil {
+			return fmt.Errorf("failed to delete widget instance: %w", err)
+		}
+
+		return updateSheetConfig(ctx, tx, sheet, sheetConfig.WithoutWidget(widgetInstanceID))
+	})
+	if err != nil {
+		ctxLogger.Error("failed to delete widget instance", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetDrillThroughTarget resolves the drill-through of the widget instance for the selection, the selection becomes the
+// filters of the datasets of the target sheet or of the target dataset. The time columns and the periodicity of the
+// params are the ones the widget was loaded with, a selected bucket of a time column keeps the times of its period
+func (s *widgetsService) GetDrillThroughTarget(ctx context.Context, orgId uuid.UUID, widgetInstanceID uuid.UUID, selection models.WidgetSelection, params models.GetWidgetInstanceDataQueryParams) (models.DrillThroughTarget, error) {
+	ctxLogger := apicontext.GetLoggerFromCtx(ctx)
+
+	dbWidgetInstance, err := s.getWidgetInstance(ctx, widgetInstanceID)
+	if err != nil {
+		ctxLogger.Error("failed to get widget instance", zap.String("error", err.Error()))
+		return models.DrillThroughTarget{}, err
+	}
+
+	widgetInstance := models.WidgetInstance{}
+	if err := widgetInstance.FromDB(dbWidgetInstance); err != nil {
+		ctxLogger.Error("failed to parse widget instance", zap.String("error", err.Error()))
+		return models.DrillThroughTarget{}, err
+	}
+
+	drillThrough := widgetInstance.DataMappings.DrillThrough
+	if drillThrough == nil {
+		return models.DrillThroughTarget{}, ErrNoDrillThrough
+	}
+
+	timeColumns := make(map[string]string, len(params.TimeColumns))
+	for _, timeColumn := range params.TimeColumns {
+		timeColumns[timeColumn.DatasetID] = timeColumn.Column
+	}
+
+	selection.WidgetInstanceID = widgetInstance.ID
+	selected, err := resolveSelection(widgetInstance, selection, timeColumns, params.Periodicity)
+	if err != nil {
+		return models.DrillThroughTarget{}, err
+	}
+
+	calendarSettings, err := s.getCalendarSettings(ctx, orgId)
+	if err != nil {
+		ctxLogger.Error("failed to get calendar settings", zap.String("error", err.Error()))
+		return models.DrillThroughTarget{}, err
+	}
+
+	sheet, err := s.getSheet(ctx, widgetInstance.SheetID)
+	if err != nil {
+		ctxLogger.Error("failed to get sheet of the widget instance", zap.String("error", err.Error()))
+		return models.DrillThroughTarget{}, err
+	}
+
+	sheetConfig, err := parseSheetConfig(sheet)
+	if err != nil {
+		return models.DrillThroughTarget{}, err
+	}
+
+	target := models.DrillThroughTarget{TargetType: drillThrough.TargetType, Filters: []models.WidgetFilters{}}
+	filters := make(map[string][]datasetmodels.Filter)
+	switch drillThrough.TargetType {
+	case models.DrillThroughTargetDataset:
+		target.DatasetID = drillThrough.DatasetID
+		filters, err = selectionFilters(sheetConfig, calendarSettings, widgetInstance.ID, []models.DataMappingFields{{DatasetID: drillThrough.DatasetID}}, selected)
+		if err != nil {
+			return models.DrillThroughTarget{}, err
+		}
+
+	case models.DrillThroughTargetSheet:
+		targetSheet, err := s.getSheet(ctx, *drillThrough.SheetID)
+		if err != nil {
+			ctxLogger.Error("failed to get drill-through sheet", zap.String("error", err.Error()))
+			return models.DrillThroughTarget{}, err
+		}
+
+		targetSheetConfig, err := parseSheetConfig(targetSheet)
+		if err != nil {
+			return models.DrillThroughTarget{}, err
+		}
+
+		target.SheetID = &targetSheet.ID
+		target.PageID = &targetSheet.PageId
+		for _, dbTargetWidget := range targetSheet.WidgetInstances {
+			targetWidget, ok, err := findSheetWidgetInstance(targetSheet, dbTargetWidget.ID)
+			if err != nil {
+				return models.DrillThroughTarget{}, err
+			}
+			if !ok {
+				continue
+			}
+
+			targetFilters, err := selectionFilters(targetSheetConfig, calendarSettings, targetWidget.ID, targetWidget.DataMappings.Mappings, selected)
+			if err != nil {
+				return models.DrillThroughTarget{}, err
+			}
+
+			// the widgets of the sheet share the filters of a dataset, a column is filtered once
+			for datasetID, conditions := range targetFilters {
+				for _, condition := range conditions {
+					if !slices.ContainsFunc(filters[datasetID], func(existing datasetmodels.Filter) bool {
+						return conditionColumn(existing) == conditionColumn(condition)
+					}) {
+						filters[datasetID] = append(filters[datasetID], condition)
+					}
+				}
+			}
+		}
+
+	default:
+		return models.DrillThroughTarget{}, fmt.Errorf("%w: unknown drill-through target %q", ErrInvalidDataMappings, drillThrough.TargetType)
+	}
+
+	datasetIDs := make([]string, 0, len(filters))
+	for datasetID := range filters {
+		datasetIDs = append(datasetIDs, datasetID)
+	}
+	slices.Sort(datasetIDs)
+
+	for _, datasetID := range datasetIDs {
+		target.Filters = append(target.Filters, models.WidgetFilters{
+			DatasetID: datasetID,
+			Filters:   withConditions(datasetmodels.FilterModel{}, filters[datasetID]),
+		})
+	}
+
+	return target, nil
+}
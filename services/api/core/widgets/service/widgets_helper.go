@@ -1,12 +1,20 @@
 package widgets
 
 import (
+	"cmp"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	dataplatformdataConstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
 	"github.com/Zampfi/application-platform/services/api/core/widgets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/widgets/models"
 	dataplatformmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
 )
 
@@ -80,3 +88,192 @@ func (s *widgetsService) addRefToDataResults(data *datasetmodels.DatasetData, re
 
 	return *data
 }
+
+// parseNumericValue reads a measure returned by the data platform, measures over no rows are zero
+func parseNumericValue(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		return v.Float64()
+	case string:
+		return strconv.ParseFloat(v, 64)
+	case []byte:
+		return strconv.ParseFloat(string(v), 64)
+	default:
+		return 0, fmt.Errorf("unsupported numeric value type %T", value)
+	}
+}
+
+// compareValues orders two values of a column ascending the way the warehouse does, nulls first, numbers by value
+// and anything else by its text
+func compareValues(a interface{}, b interface{}) int {
+	if a == nil || b == nil {
+		switch {
+		case a == b:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	if aTime, ok := a.(time.Time); ok {
+		if bTime, ok := b.(time.Time); ok {
+			return aTime.Compare(bTime)
+		}
+	}
+
+	aNumber, aErr := parseNumericValue(a)
+	bNumber, bErr := parseNumericValue(b)
+	if aErr == nil && bErr == nil {
+		return cmp.Compare(aNumber, bNumber)
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// sortRows orders the rows ascending by the columns, rows with equal values keep their order
+func sortRows(rows dataplatformmodels.Rows, columns []string) {
+	slices.SortStableFunc(rows, func(a map[string]interface{}, b map[string]interface{}) int {
+		for _, column := range columns {
+			if order := compareValues(a[column], b[column]); order != 0 {
+				return order
+			}
+		}
+		return 0
+	})
+}
+
+// conversionRate is the share of the base the value is, nil when there is no base to convert from
+func conversionRate(value float64, base float64) interface{} {
+	if base == 0 {
+		return nil
+	}
+	return value / base
+}
+
+func newDatasetFilters(filters []models.WidgetFilters) map[string]models.WidgetFilters {
+	datasetFilters := make(map[string]models.WidgetFilters)
+	for _, filter := range filters {
+		datasetFilters[filter.DatasetID] = filter
+	}
+	return datasetFilters
+}
+
+// resolveDatasetParams returns the queries of the widget instance keyed by their refs with the params they were built
+// with, a query without a page of its own gets all the rows. The times of the queries are in the calendar of the
+// organization until the queries are built
+func resolveDatasetParams(widgetInstance *models.WidgetInstance, datasetFilters map[string]models.WidgetFilters, params models.GetWidgetInstanceDataQueryParams, calendarSettings calendar.Settings, variables map[string]models.Variable) (DatasetParamsBuilder, models.DatasetBuilderParams, map[string]models.GetDataByDatasetIDParams, error) {
+	datasetParamsBuilder, err := NewDatasetParamsBuilder(widgetInstance.WidgetType)
+	if err != nil {
+		return nil, models.DatasetBuilderParams{}, nil, err
+	}
+
+	timeColumnMap := make(map[string]string)
+	for _, timeColumn := range params.TimeColumns {
+		timeColumnMap[timeColumn.DatasetID] = timeColumn.Column
+	}
+
+	builderParams := models.DatasetBuilderParams{
+		Filters:     datasetFilters,
+		TimeColumns: timeColumnMap,
+		Periodicity: params.Periodicity,
+		Currency:    params.Currency,
+		Calendar:    calendarSettings,
+		Variables:   variables,
+	}
+	datasetParams, err := datasetParamsBuilder.ToDatasetParams(widgetInstance, builderParams)
+	if err != nil {
+		return nil, models.DatasetBuilderParams{}, nil, err
+	}
+	localizeTimeFilters(calendarSettings, timeColumnMap, datasetParams)
+
+	for ref, datasetParam := range datasetParams {
+		if datasetParam.Params.Pagination == nil {
+			datasetParam.Params.Pagination = &datasetmodels.Pagination{
+				Page:     1,
+				PageSize: constants.MAX_PAGE_SIZE,
+			}
+			datasetParams[ref] = datasetParam
+		}
+	}
+
+	return datasetParamsBuilder, builderParams, datasetParams, nil
+}
+
+// datasetQueryKey identifies the query of the params, identical params make the same query
+func datasetQueryKey(datasetParams models.GetDataByDatasetIDParams) (string, error) {
+	key, err := json.Marshal(datasetParams)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal dataset params: %w", err)
+	}
+	return string(key), nil
+}
+
+// prepareDatasetData flattens the tags of the data and marks its rows with the ref of the mapping they are for
+func (s *widgetsService) prepareDatasetData(data datasetmodels.DatasetData, ref string, populateEmptyRowsWithRef bool) datasetmodels.DatasetData {
+	if tagColumn, ok := data.GetTagsColumns(); ok {
+		data = s.flattenTags(&data, tagColumn)
+	}
+
+	return s.addRefToDataResults(&data, &ref, populateEmptyRowsWithRef)
+}
+
+func cloneDatasetData(data datasetmodels.DatasetData) datasetmodels.DatasetData {
+	data.Columns = slices.Clone(data.Columns)
+	if data.Rows != nil {
+		rows := make(dataplatformmodels.Rows, len(data.Rows))
+		for i, row := range data.Rows {
+			rows[i] = maps.Clone(row)
+		}
+		data.Rows = rows
+	}
+	return data
+}
+
+// hasMultipleMappingRefs tells whether more than one mapping of the widget instance is queried, the queries adding to
+// the data of a mapping do not count
+func hasMultipleMappingRefs(widgetInstance *models.WidgetInstance, datasetParams map[string]models.GetDataByDatasetIDParams) bool {
+	count := 0
+	for _, mapping := range widgetInstance.DataMappings.Mappings {
+		if _, ok := datasetParams[mapping.Ref]; ok {
+			count++
+		}
+	}
+	return count > 1
+}
+
+// assembleWidgetData orders the data of the widget instance by its mappings and transforms it for the widget type
+func assembleWidgetData(widgetInstance *models.WidgetInstance, datasetParamsBuilder DatasetParamsBuilder, builderParams models.DatasetBuilderParams, resultMap map[string]datasetmodels.DatasetData) ([]datasetmodels.DatasetData, error) {
+	if merger, ok := datasetParamsBuilder.(DatasetResultsMerger); ok {
+		var err error
+		if resultMap, err = merger.MergeResults(widgetInstance, builderParams, resultMap); err != nil {
+			return nil, err
+		}
+	}
+
+	dataResults := make([]datasetmodels.DatasetData, 0, len(resultMap))
+	for _, mapping := range widgetInstance.DataMappings.Mappings {
+		if data, exists := resultMap[mapping.Ref]; exists {
+			dataResults = append(dataResults, data)
+		}
+	}
+
+	if transformer, ok := datasetParamsBuilder.(DatasetDataTransformer); ok {
+		return transformer.TransformData(widgetInstance, dataResults)
+	}
+
+	return dataResults, nil
+}
@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	dataplatformdataConstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/pages"
+	"github.com/Zampfi/application-platform/services/api/core/sheets"
 	models "github.com/Zampfi/application-platform/services/api/core/widgets/models"
 	dbModels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/store"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	mockDatasetService "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
 	mockWidgets "github.com/Zampfi/application-platform/services/api/mocks/core/widgets/service"
@@ -19,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestNewWidgetsService(t *testing.T) {
@@ -561,6 +568,8 @@ func TestGetWidgetInstanceData(t *testing.T) {
 
 			// Pass the testing.T to mockSetup
 			tt.mockSetup(t, mockStore, mockDatasetSvc, tt.setup)
+			mockStore.EXPECT().GetOrganizationById(mock.Anything, orgID.String()).Return(&dbModels.Organization{ID: orgID}, nil).Maybe()
+			mockStore.EXPECT().GetSheetById(mock.Anything, tt.setup.widget.SheetID).Return(&dbModels.Sheet{ID: tt.setup.widget.SheetID}, nil).Maybe()
 
 			service := &widgetsService{
 				store:          mockStore,
@@ -643,6 +652,8 @@ func TestCreateWidgetInstance(t *testing.T) {
 			input: testWidget,
 			want:  &testWidget,
 			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
+				expectSheetAdmin(m, uuid.Nil)
+				m.EXPECT().GetWidgetTemplate(mock.Anything, "bar_chart").Return(dbModels.Widget{}, nil)
 				m.EXPECT().CreateWidgetInstance(
 					mock.Anything,
 					mock.MatchedBy(func(wi *dbModels.WidgetInstance) bool {
@@ -657,6 +668,8 @@ func TestCreateWidgetInstance(t *testing.T) {
 			input: testWidget,
 			want:  nil,
 			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
+				expectSheetAdmin(m, uuid.Nil)
+				m.EXPECT().GetWidgetTemplate(mock.Anything, "bar_chart").Return(dbModels.Widget{}, nil)
 				m.EXPECT().CreateWidgetInstance(
 					mock.Anything,
 					mock.MatchedBy(func(wi *dbModels.WidgetInstance) bool {
@@ -685,6 +698,8 @@ func TestCreateWidgetInstance(t *testing.T) {
 			}(),
 			want: nil,
 			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
+				expectSheetAdmin(m, uuid.Nil)
+				m.EXPECT().GetWidgetTemplate(mock.Anything, "bar_chart").Return(dbModels.Widget{}, nil)
 				// We need to set up the mock even though it shouldn't be called
 				// because the test framework checks expectations before the function runs
 				m.EXPECT().CreateWidgetInstance(mock.Anything, mock.Anything).Return(nil, errors.New("should not be called")).Maybe()
@@ -706,6 +721,8 @@ func TestCreateWidgetInstance(t *testing.T) {
 				return &wi
 			}(),
 			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
+				expectSheetAdmin(m, uuid.Nil)
+				m.EXPECT().GetWidgetTemplate(mock.Anything, "bar_chart").Return(dbModels.Widget{}, nil)
 				m.EXPECT().CreateWidgetInstance(
 					mock.Anything,
 					mock.MatchedBy(func(wi *dbModels.WidgetInstance) bool {
@@ -720,6 +737,24 @@ func TestCreateWidgetInstance(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:  "unknown widget type",
+			input: testWidget,
+			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
+				expectSheetAdmin(m, uuid.Nil)
+				m.EXPECT().GetWidgetTemplate(mock.Anything, "bar_chart").Return(dbModels.Widget{}, gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "not a page admin",
+			input: testWidget,
+			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
+				m.EXPECT().GetSheetById(mock.Anything, uuid.Nil).Return(&dbModels.Sheet{PageId: uuid.New()}, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]dbModels.FlattenedResourceAudiencePolicy{}, nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -727,7 +762,7 @@ func TestCreateWidgetInstance(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			ctx := context.Background()
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()})
 
 			mockStore := mockWidgets.NewMockWidgetsServiceStore(t)
 			tt.mockSetup(mockStore)
@@ -804,6 +839,8 @@ func TestUpdateWidgetInstance(t *testing.T) {
 			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
 				// First call for GetWidgetInstanceByID
 				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(existingWidget, nil)
+				expectSheetAdmin(m, uuid.Nil)
+				m.EXPECT().GetWidgetTemplate(mock.Anything, "line_chart").Return(dbModels.Widget{}, nil)
 
 				// Expect UpdateWidgetInstance with updated widget type
 				m.EXPECT().UpdateWidgetInstance(
@@ -862,6 +899,8 @@ func TestUpdateWidgetInstance(t *testing.T) {
 			want: nil,
 			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
 				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(existingWidget, nil)
+				expectSheetAdmin(m, uuid.Nil)
+				m.EXPECT().GetWidgetTemplate(mock.Anything, "line_chart").Return(dbModels.Widget{}, nil)
 				// We need to set up the mock even though it shouldn't be called
 				// because the test framework checks expectations before the function runs
 				m.EXPECT().UpdateWidgetInstance(mock.Anything, mock.Anything).Return(nil, errors.New("should not be called")).Maybe()
@@ -877,6 +916,8 @@ func TestUpdateWidgetInstance(t *testing.T) {
 			want: nil,
 			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
 				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(existingWidget, nil)
+				expectSheetAdmin(m, uuid.Nil)
+				m.EXPECT().GetWidgetTemplate(mock.Anything, "line_chart").Return(dbModels.Widget{}, nil)
 				m.EXPECT().UpdateWidgetInstance(
 					mock.Anything,
 					mock.MatchedBy(func(wi *dbModels.WidgetInstance) bool {
@@ -901,6 +942,8 @@ func TestUpdateWidgetInstance(t *testing.T) {
 			},
 			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
 				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(existingWidget, nil)
+				expectSheetAdmin(m, uuid.Nil)
+				expectSheetAdmin(m, sheetID)
 				m.EXPECT().UpdateWidgetInstance(
 					mock.Anything,
 					mock.MatchedBy(func(wi *dbModels.WidgetInstance) bool {
@@ -937,6 +980,8 @@ func TestUpdateWidgetInstance(t *testing.T) {
 			want: nil,
 			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore) {
 				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(existingWidget, nil)
+				expectSheetAdmin(m, uuid.Nil)
+				m.EXPECT().GetWidgetTemplate(mock.Anything, "line_chart").Return(dbModels.Widget{}, nil)
 				m.EXPECT().UpdateWidgetInstance(
 					mock.Anything,
 					mock.Anything,
@@ -954,8 +999,9 @@ func TestUpdateWidgetInstance(t *testing.T) {
 			mockStore := mockWidgets.NewMockWidgetsServiceStore(t)
 			tt.mockSetup(mockStore)
 
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()})
 			service := &widgetsService{store: mockStore}
-			got, err := service.UpdateWidgetInstance(context.Background(), tt.input)
+			got, err := service.UpdateWidgetInstance(ctx, tt.input)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -967,3 +1013,923 @@ func TestUpdateWidgetInstance(t *testing.T) {
 		})
 	}
 }
+
+// expectSheetAdmin sets up the sheet lookup and the admin policy of its page for the current user
+func expectSheetAdmin(m *mockWidgets.MockWidgetsServiceStore, sheetID uuid.UUID) {
+	pageID := uuid.New()
+	m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(&dbModels.Sheet{ID: sheetID, PageId: pageID}, nil)
+	m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.MatchedBy(func(filters dbModels.FlattenedResourceAudiencePoliciesFilters) bool {
+		return len(filters.ResourceIds) == 1 && filters.ResourceIds[0] == pageID
+	})).Return([]dbModels.FlattenedResourceAudiencePolicy{{ResourceId: pageID}}, nil)
+}
+
+func TestDuplicateWidgetInstance(t *testing.T) {
+	t.Parallel()
+
+	widgetID := uuid.New()
+	duplicatedWidgetID := uuid.New()
+	sheetID := uuid.New()
+	pageID := uuid.New()
+
+	widgetInstance := dbModels.WidgetInstance{
+		ID:           widgetID,
+		SheetID:      sheetID,
+		WidgetType:   "bar_chart",
+		Title:        "Sales",
+		DataMappings: json.RawMessage(`{"version":"1","mappings":[]}`),
+	}
+	sheet := &dbModels.Sheet{
+		ID:          sheetID,
+		PageId:      pageID,
+		SheetConfig: json.RawMessage(fmt.Sprintf(`{"version":"1.0","sheet_layout":[{"layout":{"x":0,"y":0,"w":6,"h":4},"widget_group":["%s"]}]}`, widgetID)),
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mockWidgets.MockWidgetsServiceStore, *mockStore.MockStore)
+		wantErr   error
+	}{
+		{
+			name: "success",
+			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore, tx *mockStore.MockStore) {
+				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(widgetInstance, nil)
+				m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]dbModels.FlattenedResourceAudiencePolicy{{ResourceId: pageID}}, nil)
+				m.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+					return fn(tx)
+				})
+				tx.EXPECT().CreateWidgetInstance(mock.Anything, mock.MatchedBy(func(instance *dbModels.WidgetInstance) bool {
+					return instance.SheetID == sheetID && instance.Title == "Sales (copy)" && instance.WidgetType == "bar_chart"
+				})).Return(&dbModels.WidgetInstance{ID: duplicatedWidgetID, SheetID: sheetID, WidgetType: "bar_chart", Title: "Sales (copy)", DataMappings: widgetInstance.DataMappings}, nil)
+				tx.EXPECT().UpdateSheet(mock.Anything, mock.MatchedBy(func(updated *dbModels.Sheet) bool {
+					return updated.ID == sheetID && strings.Contains(string(updated.SheetConfig), duplicatedWidgetID.String())
+				})).Return(sheet, nil)
+			},
+		},
+		{
+			name: "widget instance not found",
+			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore, tx *mockStore.MockStore) {
+				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(dbModels.WidgetInstance{}, gorm.ErrRecordNotFound)
+			},
+			wantErr: ErrWidgetInstanceNotFound,
+		},
+		{
+			name: "sheet config update fails",
+			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore, tx *mockStore.MockStore) {
+				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(widgetInstance, nil)
+				m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]dbModels.FlattenedResourceAudiencePolicy{{ResourceId: pageID}}, nil)
+				m.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+					return fn(tx)
+				})
+				tx.EXPECT().CreateWidgetInstance(mock.Anything, mock.Anything).Return(&dbModels.WidgetInstance{ID: duplicatedWidgetID, SheetID: sheetID}, nil)
+				tx.EXPECT().UpdateSheet(mock.Anything, mock.Anything).Return(nil, gorm.ErrInvalidTransaction)
+			},
+			wantErr: gorm.ErrInvalidTransaction,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := mockWidgets.NewMockWidgetsServiceStore(t)
+			tx := mockStore.NewMockStore(t)
+			tt.mockSetup(ms, tx)
+
+			service := &widgetsService{store: ms}
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()})
+			got, err := service.DuplicateWidgetInstance(ctx, widgetID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, duplicatedWidgetID, got.ID)
+			assert.Equal(t, "Sales (copy)", got.Title)
+		})
+	}
+}
+
+func TestDeleteWidgetInstance(t *testing.T) {
+	t.Parallel()
+
+	widgetID := uuid.New()
+	sheetID := uuid.New()
+	pageID := uuid.New()
+
+	widgetInstance := dbModels.WidgetInstance{ID: widgetID, SheetID: sheetID}
+	sheet := &dbModels.Sheet{
+		ID:          sheetID,
+		PageId:      pageID,
+		SheetConfig: json.RawMessage(fmt.Sprintf(`{"version":"1.0","sheet_layout":[{"widget_group":["%s"]}]}`, widgetID)),
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mockWidgets.MockWidgetsServiceStore, *mockStore.MockStore)
+		wantErr   error
+	}{
+		{
+			name: "success",
+			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore, tx *mockStore.MockStore) {
+				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(widgetInstance, nil)
+				m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return([]dbModels.FlattenedResourceAudiencePolicy{{ResourceId: pageID}}, nil)
+				m.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(store.Store) error) error {
+					return fn(tx)
+				})
+				tx.EXPECT().DeleteWidgetInstance(mock.Anything, widgetID).Return(nil)
+				tx.EXPECT().UpdateSheet(mock.Anything, mock.MatchedBy(func(updated *dbModels.Sheet) bool {
+					return !strings.Contains(string(updated.SheetConfig), widgetID.String())
+				})).Return(sheet, nil)
+			},
+		},
+		{
+			name: "not a page admin",
+			mockSetup: func(m *mockWidgets.MockWidgetsServiceStore, tx *mockStore.MockStore) {
+				m.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(widgetInstance, nil)
+				m.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+				m.EXPECT().GetFlattenedResourceAudiencePolicies(mock.Anything, mock.Anything).Return(nil, nil)
+			},
+			wantErr: pages.ErrPageAccessForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := mockWidgets.NewMockWidgetsServiceStore(t)
+			tx := mockStore.NewMockStore(t)
+			tt.mockSetup(ms, tx)
+
+			service := &widgetsService{store: ms}
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()})
+			err := service.DeleteWidgetInstance(ctx, widgetID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateDataMappings(t *testing.T) {
+	t.Parallel()
+
+	field := map[string][]models.Field{"x_axis": {{Column: "month"}}}
+
+	tests := []struct {
+		name         string
+		dataMappings models.DataMappings
+		wantErr      bool
+	}{
+		{
+			name: "valid dataset mapping",
+			dataMappings: models.DataMappings{Version: models.DataMappingVersion1, Mappings: []models.DataMappingFields{
+				{DatasetID: "d1", Ref: "a", Fields: field, SortBy: []models.SortBy{{Column: "month", Order: "DESC"}}},
+			}},
+		},
+		{
+			name: "valid joined mapping",
+			dataMappings: models.DataMappings{Version: models.DataMappingVersion1, Mappings: []models.DataMappingFields{{
+				SourceDatasets: &models.SourceDatasets{
+					Datasets: []models.SourceDataset{{ID: "d1", Alias: "orders"}, {ID: "d2", Alias: "customers"}},
+					Joins: []models.SourceJoin{{
+						LeftDatasetAlias:  "orders",
+						RightDatasetAlias: "customers",
+						Conditions:        []models.SourceJoinCondition{{LeftColumn: "customer_id", RightColumn: "id"}},
+					}},
+				},
+				Fields: field,
+			}}},
+		},
+		{
+			name:         "unsupported version",
+			dataMappings: models.DataMappings{Version: "2", Mappings: []models.DataMappingFields{{DatasetID: "d1"}}},
+			wantErr:      true,
+		},
+		{
+			name:         "no mappings",
+			dataMappings: models.DataMappings{Version: models.DataMappingVersion1},
+			wantErr:      true,
+		},
+		{
+			name: "duplicated ref",
+			dataMappings: models.DataMappings{Version: models.DataMappingVersion1, Mappings: []models.DataMappingFields{
+				{DatasetID: "d1", Ref: "a"}, {DatasetID: "d2", Ref: "a"},
+			}},
+			wantErr: true,
+		},
+		{
+			name:         "mapping without a dataset",
+			dataMappings: models.DataMappings{Version: models.DataMappingVersion1, Mappings: []models.DataMappingFields{{Fields: field}}},
+			wantErr:      true,
+		},
+		{
+			name: "join on an unknown alias",
+			dataMappings: models.DataMappings{Version: models.DataMappingVersion1, Mappings: []models.DataMappingFields{{
+				SourceDatasets: &models.SourceDatasets{
+					Datasets: []models.SourceDataset{{ID: "d1", Alias: "orders"}},
+					Joins: []models.SourceJoin{{
+						LeftDatasetAlias:  "orders",
+						RightDatasetAlias: "customers",
+						Conditions:        []models.SourceJoinCondition{{LeftColumn: "customer_id", RightColumn: "id"}},
+					}},
+				},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "field without a column",
+			dataMappings: models.DataMappings{Version: models.DataMappingVersion1, Mappings: []models.DataMappingFields{
+				{DatasetID: "d1", Fields: map[string][]models.Field{"y_axis": {{Aggregation: "sum"}}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "unknown sort order",
+			dataMappings: models.DataMappings{Version: models.DataMappingVersion1, Mappings: []models.DataMappingFields{
+				{DatasetID: "d1", SortBy: []models.SortBy{{Column: "month", Order: "random"}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "valid drill-through",
+			dataMappings: models.DataMappings{
+				Version:      models.DataMappingVersion1,
+				Mappings:     []models.DataMappingFields{{DatasetID: "d1", Fields: field}},
+				DrillThrough: &models.DrillThrough{TargetType: models.DrillThroughTargetDataset, DatasetID: "d2"},
+			},
+		},
+		{
+			name: "valid comparisons and target",
+			dataMappings: models.DataMappings{
+				Version:  models.DataMappingVersion1,
+				Mappings: []models.DataMappingFields{{DatasetID: "d1", Fields: field}},
+				Comparisons: []models.Comparison{
+					{Type: models.ComparisonPreviousPeriod},
+					{Type: models.ComparisonCustom, Name: "two_quarters_ago", Offset: &models.PeriodOffset{Value: 2, Unit: "quarter"}},
+				},
+				Target: &models.Target{Type: models.TargetDataset, DatasetID: "budget", Field: &models.Field{Column: "amount", Aggregation: "sum"}},
+			},
+		},
+		{
+			name: "custom comparison without an offset",
+			dataMappings: models.DataMappings{
+				Version:     models.DataMappingVersion1,
+				Mappings:    []models.DataMappingFields{{DatasetID: "d1", Fields: field}},
+				Comparisons: []models.Comparison{{Type: models.ComparisonCustom}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "comparison used twice",
+			dataMappings: models.DataMappings{
+				Version:     models.DataMappingVersion1,
+				Mappings:    []models.DataMappingFields{{DatasetID: "d1", Fields: field}},
+				Comparisons: []models.Comparison{{Type: models.ComparisonPreviousPeriod}, {Type: models.ComparisonPreviousPeriod}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "constant target without a value",
+			dataMappings: models.DataMappings{
+				Version:  models.DataMappingVersion1,
+				Mappings: []models.DataMappingFields{{DatasetID: "d1", Fields: field}},
+				Target:   &models.Target{Type: models.TargetConstant},
+			},
+			wantErr: true,
+		},
+		{
+			name: "drill-through to a sheet without the sheet",
+			dataMappings: models.DataMappings{
+				Version:      models.DataMappingVersion1,
+				Mappings:     []models.DataMappingFields{{DatasetID: "d1", Fields: field}},
+				DrillThrough: &models.DrillThrough{TargetType: models.DrillThroughTargetSheet},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateDataMappings(tt.dataMappings)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidDataMappings)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateTemplateFields(t *testing.T) {
+	t.Parallel()
+
+	waterfall := models.WidgetTemplateSchema{
+		MappingsCardinality: models.TemplateCardinalityMultiple,
+		Mappings: models.WidgetTemplateMappings{Fields: map[string]models.WidgetTemplateField{
+			"x_axis":        {Required: true, Cardinality: models.TemplateCardinalitySingle},
+			"y_axis":        {Required: true, Cardinality: models.TemplateCardinalitySingle, AllowedAggregations: []string{"sum", "count"}},
+			"opening_value": {Cardinality: models.TemplateCardinalitySingle},
+		}},
+	}
+	stackedBar := models.WidgetTemplateSchema{
+		MappingsCardinality: models.TemplateCardinalitySingle,
+		Mappings: models.WidgetTemplateMappings{Fields: map[string]models.WidgetTemplateField{
+			"group_by": {Required: true, Cardinality: models.TemplateCardinalityMultiple, MaxFields: 1},
+		}},
+	}
+	movements := models.DataMappingFields{DatasetID: "d1", Ref: "movements", Fields: map[string][]models.Field{
+		"x_axis": {{Column: "category"}},
+		"y_axis": {{Column: "amount", Aggregation: "sum"}},
+	}}
+	opening := models.DataMappingFields{DatasetID: "d2", Ref: "opening", Fields: map[string][]models.Field{
+		"opening_value": {{Column: "balance", Aggregation: "sum"}},
+	}}
+
+	tests := []struct {
+		name     string
+		schema   models.WidgetTemplateSchema
+		mappings []models.DataMappingFields
+		wantErr  bool
+	}{
+		{
+			name:     "required fields spread over the mappings",
+			schema:   waterfall,
+			mappings: []models.DataMappingFields{opening, movements},
+		},
+		{
+			name:     "template without fields",
+			schema:   models.WidgetTemplateSchema{},
+			mappings: []models.DataMappingFields{opening},
+		},
+		{
+			name:     "missing required field",
+			schema:   waterfall,
+			mappings: []models.DataMappingFields{opening},
+			wantErr:  true,
+		},
+		{
+			name:   "aggregation not allowed",
+			schema: waterfall,
+			mappings: []models.DataMappingFields{{DatasetID: "d1", Fields: map[string][]models.Field{
+				"x_axis": {{Column: "category"}},
+				"y_axis": {{Column: "amount", Aggregation: "avg"}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:   "several fields for a single field",
+			schema: waterfall,
+			mappings: []models.DataMappingFields{{DatasetID: "d1", Fields: map[string][]models.Field{
+				"x_axis": {{Column: "category"}, {Column: "vendor"}},
+				"y_axis": {{Column: "amount", Aggregation: "sum"}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:   "more fields than allowed",
+			schema: stackedBar,
+			mappings: []models.DataMappingFields{{DatasetID: "d1", Fields: map[string][]models.Field{
+				"group_by": {{Column: "region"}, {Column: "vendor"}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:     "several mappings for a single mapping widget",
+			schema:   stackedBar,
+			mappings: []models.DataMappingFields{movements, opening},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateTemplateFields(tt.schema, models.DataMappings{Version: models.DataMappingVersion1, Mappings: tt.mappings})
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidDataMappings)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetWidgetInstanceDataWithSelections(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	sheetID := uuid.New()
+	sourceID := uuid.New()
+	targetID := uuid.New()
+	transactions := uuid.New()
+	invoices := uuid.New()
+
+	source := dbModels.WidgetInstance{
+		ID:           sourceID,
+		SheetID:      sheetID,
+		WidgetType:   "bar_chart",
+		DataMappings: json.RawMessage(fmt.Sprintf(`{"version":"1","mappings":[{"dataset_id":"%s","ref":"spend","fields":{"x_axis":[{"column":"vendor","drilldown_filter_operator":"in"}],"y_axis":[{"column":"amount","aggregation":"sum"}]}}]}`, transactions)),
+	}
+	target := dbModels.WidgetInstance{
+		ID:           targetID,
+		SheetID:      sheetID,
+		WidgetType:   "kpi",
+		DataMappings: json.RawMessage(fmt.Sprintf(`{"version":"1","mappings":[{"dataset_id":"%s","ref":"open","fields":{"primary_value":[{"column":"amount","aggregation":"sum"}]}}]}`, invoices)),
+	}
+	sheet := &dbModels.Sheet{
+		ID:              sheetID,
+		WidgetInstances: []dbModels.WidgetInstance{source, target},
+		SheetConfig: json.RawMessage(fmt.Sprintf(`{"version":"1.0","native_filter_config":[{"id":"vendor","targets":[{"dataset_id":"%s","column":"vendor"},{"dataset_id":"%s","column":"supplier_name"}]}]}`,
+			transactions, invoices)),
+	}
+
+	tests := []struct {
+		name      string
+		selection models.WidgetSelection
+		mockSetup func(*mockWidgets.MockWidgetsServiceStore, *mockDatasetService.MockDatasetService)
+		wantErr   error
+	}{
+		{
+			name:      "selection of another widget filters the linked dataset",
+			selection: models.WidgetSelection{WidgetInstanceID: sourceID, Values: map[string]interface{}{"vendor": "Acme"}},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore, mds *mockDatasetService.MockDatasetService) {
+				ms.EXPECT().GetWidgetInstanceByID(mock.Anything, targetID).Return(target, nil)
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+				mds.EXPECT().GetDataByDatasetId(mock.Anything, orgID, invoices.String(), mock.MatchedBy(func(params datasetmodels.DatasetParams) bool {
+					return assert.ObjectsAreEqual([]datasetmodels.Filter{
+						{Column: "status", Operator: "eq", Value: "open"},
+						{Column: "supplier_name", Operator: "in", Value: []interface{}{"Acme"}},
+					}, params.Filters.Conditions)
+				})).Return(datasetmodels.DatasetData{}, nil)
+			},
+		},
+		{
+			name:      "own selection is left out",
+			selection: models.WidgetSelection{WidgetInstanceID: targetID, Values: map[string]interface{}{"amount": 10}},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore, mds *mockDatasetService.MockDatasetService) {
+				ms.EXPECT().GetWidgetInstanceByID(mock.Anything, targetID).Return(target, nil)
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+				mds.EXPECT().GetDataByDatasetId(mock.Anything, orgID, invoices.String(), mock.MatchedBy(func(params datasetmodels.DatasetParams) bool {
+					return len(params.Filters.Conditions) == 1
+				})).Return(datasetmodels.DatasetData{}, nil)
+			},
+		},
+		{
+			name:      "widget of another sheet",
+			selection: models.WidgetSelection{WidgetInstanceID: uuid.New(), Values: map[string]interface{}{"vendor": "Acme"}},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore, mds *mockDatasetService.MockDatasetService) {
+				ms.EXPECT().GetWidgetInstanceByID(mock.Anything, targetID).Return(target, nil)
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+			},
+			wantErr: ErrInvalidSelection,
+		},
+		{
+			name:      "unknown field",
+			selection: models.WidgetSelection{WidgetInstanceID: sourceID, Values: map[string]interface{}{"region": "EU"}},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore, mds *mockDatasetService.MockDatasetService) {
+				ms.EXPECT().GetWidgetInstanceByID(mock.Anything, targetID).Return(target, nil)
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+			},
+			wantErr: ErrInvalidSelection,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := mockWidgets.NewMockWidgetsServiceStore(t)
+			mds := mockDatasetService.NewMockDatasetService(t)
+			tt.mockSetup(ms, mds)
+			ms.EXPECT().GetOrganizationById(mock.Anything, orgID.String()).Return(&dbModels.Organization{ID: orgID}, nil).Maybe()
+
+			service := &widgetsService{store: ms, datasetService: mds}
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{orgID})
+			_, err := service.GetWidgetInstanceData(ctx, orgID, targetID, models.GetWidgetInstanceDataQueryParams{
+				Filters: []models.WidgetFilters{{
+					DatasetID: invoices.String(),
+					Filters: datasetmodels.FilterModel{
+						LogicalOperator: "AND",
+						Conditions:      []datasetmodels.Filter{{Column: "status", Operator: "eq", Value: "open"}},
+					},
+				}},
+				Selections: []models.WidgetSelection{tt.selection},
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetSheetData(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	sheetID := uuid.New()
+	invoices := uuid.New()
+	payments := uuid.New()
+	kpi := func(datasetID uuid.UUID) dbModels.WidgetInstance {
+		return dbModels.WidgetInstance{
+			ID:           uuid.New(),
+			SheetID:      sheetID,
+			WidgetType:   "kpi",
+			DataMappings: json.RawMessage(fmt.Sprintf(`{"version":"1","mappings":[{"dataset_id":"%s","ref":"total","fields":{"primary_value":[{"column":"amount","aggregation":"sum"}]}}]}`, datasetID)),
+		}
+	}
+	openInvoices := kpi(invoices)
+	overdueInvoices := kpi(invoices)
+	paid := kpi(payments)
+	deleted := kpi(payments)
+	deletedAt := time.Now()
+	deleted.DeletedAt = &deletedAt
+
+	data := datasetmodels.DatasetData{QueryResult: dataplatformmodels.QueryResult{
+		Columns: []dataplatformmodels.ColumnMetadata{{Name: "amount"}},
+		Rows:    dataplatformmodels.Rows{{"amount": 120}},
+	}}
+
+	tests := []struct {
+		name      string
+		mockSetup func(*mockWidgets.MockWidgetsServiceStore, *mockDatasetService.MockDatasetService)
+		check     func(*testing.T, map[uuid.UUID]models.WidgetInstanceData)
+		wantErr   error
+	}{
+		{
+			name: "identical queries run once",
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore, mds *mockDatasetService.MockDatasetService) {
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(&dbModels.Sheet{
+					ID:              sheetID,
+					WidgetInstances: []dbModels.WidgetInstance{openInvoices, overdueInvoices, paid, deleted},
+				}, nil)
+				mds.EXPECT().GetDataByDatasetId(mock.Anything, orgID, invoices.String(), mock.Anything).Return(data, nil).Once()
+				mds.EXPECT().GetDataByDatasetId(mock.Anything, orgID, payments.String(), mock.Anything).Return(data, nil).Once()
+			},
+			check: func(t *testing.T, sheetData map[uuid.UUID]models.WidgetInstanceData) {
+				require.Len(t, sheetData, 3)
+				for _, widget := range []dbModels.WidgetInstance{openInvoices, overdueInvoices, paid} {
+					require.NoError(t, sheetData[widget.ID].Err)
+					require.Len(t, sheetData[widget.ID].Data, 1)
+					assert.Len(t, sheetData[widget.ID].Data[0].Rows, 1)
+				}
+				assert.NotContains(t, sheetData, deleted.ID)
+				// the widgets sharing a query get rows of their own
+				sheetData[openInvoices.ID].Data[0].Rows[0]["amount"] = 0
+				assert.Equal(t, 120, sheetData[overdueInvoices.ID].Data[0].Rows[0]["amount"])
+			},
+		},
+		{
+			name: "failing query fails its widgets only",
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore, mds *mockDatasetService.MockDatasetService) {
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(&dbModels.Sheet{
+					ID:              sheetID,
+					WidgetInstances: []dbModels.WidgetInstance{openInvoices, paid},
+				}, nil)
+				mds.EXPECT().GetDataByDatasetId(mock.Anything, orgID, invoices.String(), mock.Anything).Return(datasetmodels.DatasetData{}, errors.New("warehouse unavailable"))
+				mds.EXPECT().GetDataByDatasetId(mock.Anything, orgID, payments.String(), mock.Anything).Return(data, nil)
+			},
+			check: func(t *testing.T, sheetData map[uuid.UUID]models.WidgetInstanceData) {
+				assert.EqualError(t, sheetData[openInvoices.ID].Err, "warehouse unavailable")
+				assert.NoError(t, sheetData[paid.ID].Err)
+				assert.Len(t, sheetData[paid.ID].Data, 1)
+			},
+		},
+		{
+			name: "invalid mappings fail their widget only",
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore, mds *mockDatasetService.MockDatasetService) {
+				broken := kpi(invoices)
+				broken.WidgetType = "gauge"
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(&dbModels.Sheet{
+					ID:              sheetID,
+					WidgetInstances: []dbModels.WidgetInstance{broken, paid},
+				}, nil)
+				mds.EXPECT().GetDataByDatasetId(mock.Anything, orgID, payments.String(), mock.Anything).Return(data, nil)
+			},
+			check: func(t *testing.T, sheetData map[uuid.UUID]models.WidgetInstanceData) {
+				require.Len(t, sheetData, 2)
+				assert.NoError(t, sheetData[paid.ID].Err)
+				for id, widgetData := range sheetData {
+					if id != paid.ID {
+						assert.Error(t, widgetData.Err)
+					}
+				}
+			},
+		},
+		{
+			name: "sheet not found",
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore, mds *mockDatasetService.MockDatasetService) {
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: sheets.ErrSheetNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := mockWidgets.NewMockWidgetsServiceStore(t)
+			mds := mockDatasetService.NewMockDatasetService(t)
+			tt.mockSetup(ms, mds)
+			ms.EXPECT().GetOrganizationById(mock.Anything, orgID.String()).Return(&dbModels.Organization{ID: orgID}, nil).Maybe()
+
+			service := &widgetsService{store: ms, datasetService: mds}
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{orgID})
+			sheetData, err := service.GetSheetData(ctx, orgID, sheetID, models.GetWidgetInstanceDataQueryParams{})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, sheetData)
+		})
+	}
+}
+
+func TestGetDrillThroughTarget(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	sheetID := uuid.New()
+	targetSheetID := uuid.New()
+	targetPageID := uuid.New()
+	widgetID := uuid.New()
+	// the filters of the target come in the order of their datasets
+	transactions := uuid.MustParse("10000000-0000-0000-0000-000000000000")
+	invoices := uuid.MustParse("20000000-0000-0000-0000-000000000000")
+
+	newWidget := func(drillThrough string) dbModels.WidgetInstance {
+		return dbModels.WidgetInstance{
+			ID:         widgetID,
+			SheetID:    sheetID,
+			WidgetType: "bar_chart",
+			DataMappings: json.RawMessage(fmt.Sprintf(`{"version":"1","mappings":[{"dataset_id":"%s","fields":{"x_axis":[{"column":"vendor"}],"y_axis":[{"column":"amount","aggregation":"sum"}]}}]%s}`,
+				transactions, drillThrough)),
+		}
+	}
+	sheet := &dbModels.Sheet{ID: sheetID, SheetConfig: json.RawMessage(`{"version":"1.0"}`)}
+	targetSheet := &dbModels.Sheet{
+		ID:     targetSheetID,
+		PageId: targetPageID,
+		WidgetInstances: []dbModels.WidgetInstance{
+			{ID: uuid.New(), SheetID: targetSheetID, WidgetType: "data_table", DataMappings: json.RawMessage(fmt.Sprintf(`{"version":"1","mappings":[{"dataset_id":"%s","fields":{"columns":[{"column":"vendor"}]}}]}`, transactions))},
+			{ID: uuid.New(), SheetID: targetSheetID, WidgetType: "kpi", DataMappings: json.RawMessage(fmt.Sprintf(`{"version":"1","mappings":[{"dataset_id":"%s","fields":{"primary_value":[{"column":"amount","aggregation":"sum"}]}}]}`, invoices))},
+		},
+		SheetConfig: json.RawMessage(fmt.Sprintf(`{"version":"1.0","native_filter_config":[{"id":"vendor","targets":[{"dataset_id":"%s","column":"vendor"},{"dataset_id":"%s","column":"supplier_name"}]}]}`,
+			transactions, invoices)),
+	}
+	monthly := "month"
+	and := datasetmodels.LogicalOperator("AND")
+	vendorIs := func(column string) datasetmodels.FilterModel {
+		return datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{{Column: column, Operator: "eq", Value: "Acme"}}}
+	}
+
+	tests := []struct {
+		name      string
+		widget    dbModels.WidgetInstance
+		values    map[string]interface{}
+		params    models.GetWidgetInstanceDataQueryParams
+		mockSetup func(*mockWidgets.MockWidgetsServiceStore)
+		want      models.DrillThroughTarget
+		wantErr   error
+	}{
+		{
+			name:   "sheet target",
+			widget: newWidget(fmt.Sprintf(`,"drill_through":{"target_type":"sheet","sheet_id":"%s"}`, targetSheetID)),
+			values: map[string]interface{}{"vendor": "Acme"},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore) {
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+				ms.EXPECT().GetSheetById(mock.Anything, targetSheetID).Return(targetSheet, nil)
+			},
+			want: models.DrillThroughTarget{
+				TargetType: models.DrillThroughTargetSheet,
+				SheetID:    &targetSheetID,
+				PageID:     &targetPageID,
+				Filters: []models.WidgetFilters{
+					{DatasetID: transactions.String(), Filters: vendorIs("vendor")},
+					{DatasetID: invoices.String(), Filters: vendorIs("supplier_name")},
+				},
+			},
+		},
+		{
+			name:   "dataset target",
+			widget: newWidget(fmt.Sprintf(`,"drill_through":{"target_type":"dataset","dataset_id":"%s"}`, transactions)),
+			values: map[string]interface{}{"vendor": "Acme"},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore) {
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+			},
+			want: models.DrillThroughTarget{
+				TargetType: models.DrillThroughTargetDataset,
+				DatasetID:  transactions.String(),
+				Filters:    []models.WidgetFilters{{DatasetID: transactions.String(), Filters: vendorIs("vendor")}},
+			},
+		},
+		{
+			name: "bucket of the time column",
+			widget: dbModels.WidgetInstance{
+				ID:         widgetID,
+				SheetID:    sheetID,
+				WidgetType: "bar_chart",
+				DataMappings: json.RawMessage(fmt.Sprintf(`{"version":"1","mappings":[{"dataset_id":"%s","fields":{"x_axis":[{"column":"posted_at"}],"y_axis":[{"column":"amount","aggregation":"sum"}]}}],"drill_through":{"target_type":"dataset","dataset_id":"%s"}}`,
+					transactions, transactions)),
+			},
+			values: map[string]interface{}{"posted_at": "2025-03-01T00:00:00Z"},
+			params: models.GetWidgetInstanceDataQueryParams{
+				TimeColumns: []models.ColumnMapping{{DatasetID: transactions.String(), Column: "posted_at"}},
+				Periodicity: &monthly,
+			},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore) {
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+			},
+			want: models.DrillThroughTarget{
+				TargetType: models.DrillThroughTargetDataset,
+				DatasetID:  transactions.String(),
+				Filters: []models.WidgetFilters{{DatasetID: transactions.String(), Filters: datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{{
+					LogicalOperator: &and,
+					Conditions: []datasetmodels.Filter{
+						{Column: "posted_at", Operator: "gte", Value: "2025-03-01 00:00:00"},
+						{Column: "posted_at", Operator: "lt", Value: "2025-04-01 00:00:00"},
+					},
+				}}}}},
+			},
+		},
+		{
+			name:      "no drill-through",
+			widget:    newWidget(""),
+			values:    map[string]interface{}{"vendor": "Acme"},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore) {},
+			wantErr:   ErrNoDrillThrough,
+		},
+		{
+			name:      "unknown field",
+			widget:    newWidget(fmt.Sprintf(`,"drill_through":{"target_type":"dataset","dataset_id":"%s"}`, transactions)),
+			values:    map[string]interface{}{"region": "EU"},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore) {},
+			wantErr:   ErrInvalidSelection,
+		},
+		{
+			name:   "target sheet deleted",
+			widget: newWidget(fmt.Sprintf(`,"drill_through":{"target_type":"sheet","sheet_id":"%s"}`, targetSheetID)),
+			values: map[string]interface{}{"vendor": "Acme"},
+			mockSetup: func(ms *mockWidgets.MockWidgetsServiceStore) {
+				ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+				ms.EXPECT().GetSheetById(mock.Anything, targetSheetID).Return(nil, gorm.ErrRecordNotFound)
+			},
+			wantErr: sheets.ErrSheetNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := mockWidgets.NewMockWidgetsServiceStore(t)
+			ms.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(tt.widget, nil)
+			ms.EXPECT().GetOrganizationById(mock.Anything, orgID.String()).Return(&dbModels.Organization{ID: orgID}, nil).Maybe()
+			tt.mockSetup(ms)
+
+			service := &widgetsService{store: ms}
+			got, err := service.GetDrillThroughTarget(context.Background(), orgID, widgetID, models.WidgetSelection{Values: tt.values}, tt.params)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetWidgetInstanceDataInOrganizationCalendar(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	widgetID := uuid.New()
+	widget := dbModels.WidgetInstance{
+		ID:           widgetID,
+		WidgetType:   "bar_chart",
+		DataMappings: json.RawMessage(`{"version":"1","mappings":[{"dataset_id":"transactions","ref":"revenue","fields":{"x_axis":[{"column":"posted_at"}],"y_axis":[{"column":"amount","aggregation":"sum"}]}}]}`),
+	}
+	periodicity := "week"
+
+	ms := mockWidgets.NewMockWidgetsServiceStore(t)
+	mds := mockDatasetService.NewMockDatasetService(t)
+	ms.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(widget, nil)
+	ms.EXPECT().GetSheetById(mock.Anything, uuid.Nil).Return(&dbModels.Sheet{}, nil)
+	ms.EXPECT().GetOrganizationById(mock.Anything, orgID.String()).Return(&dbModels.Organization{
+		ID:               orgID,
+		CalendarSettings: json.RawMessage(`{"timezone":"Asia/Kolkata","week_start":"sunday"}`),
+	}, nil)
+	mds.EXPECT().GetDataByDatasetId(mock.Anything, orgID, "transactions", mock.MatchedBy(func(params datasetmodels.DatasetParams) bool {
+		local := "from_utc_timestamp(posted_at, 'Asia/Kolkata')"
+		return params.GroupBy[0].Column == fmt.Sprintf("date_trunc('day', date_sub(%s, pmod(dayofweek(%s) - 1, 7)))", local, local) &&
+			assert.ObjectsAreEqual([]interface{}{"2025-03-31 18:30:00", "2025-04-06 18:29:59"}, params.Filters.Conditions[0].Value)
+	})).Return(datasetmodels.DatasetData{}, nil)
+
+	service := &widgetsService{store: ms, datasetService: mds}
+	ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{orgID})
+	_, err := service.GetWidgetInstanceData(ctx, orgID, widgetID, models.GetWidgetInstanceDataQueryParams{
+		Filters: []models.WidgetFilters{{
+			DatasetID: "transactions",
+			Filters: datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{
+				{Column: "posted_at", Operator: "inbetween", Value: []interface{}{"2025-04-01", "2025-04-06 23:59:59"}},
+			}},
+		}},
+		TimeColumns: []models.ColumnMapping{{DatasetID: "transactions", Column: "posted_at"}},
+		Periodicity: &periodicity,
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetWidgetInstanceDataWithVariables(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	sheetID := uuid.New()
+	widgetID := uuid.New()
+	widget := dbModels.WidgetInstance{
+		ID:           widgetID,
+		SheetID:      sheetID,
+		WidgetType:   "bar_chart",
+		DataMappings: json.RawMessage(`{"version":"1","mappings":[{"dataset_id":"transactions","ref":"revenue","fields":{"x_axis":[{"column":"region"}],"y_axis":[{"column":"amount","aggregation":"sum"}]},"default_filters":{"logical_operator":"AND","conditions":[{"column":"amount","operator":"gte","value":["{{.$min_amount}}"]}]}}]}`),
+	}
+	sheet := &dbModels.Sheet{
+		ID:          sheetID,
+		SheetConfig: json.RawMessage(`{"version":"1.0","variables":[{"name":"min_amount","type":"number","default_value":"1000"}]}`),
+	}
+
+	tests := []struct {
+		name      string
+		variables map[string]string
+		wantValue []string
+		wantErr   error
+	}{
+		{
+			name:      "default value",
+			wantValue: []string{"1000"},
+		},
+		{
+			name:      "value chosen by the user",
+			variables: map[string]string{"min_amount": "250.5"},
+			wantValue: []string{"250.5"},
+		},
+		{
+			name:      "value of another type",
+			variables: map[string]string{"min_amount": "lots"},
+			wantErr:   ErrInvalidVariable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ms := mockWidgets.NewMockWidgetsServiceStore(t)
+			mds := mockDatasetService.NewMockDatasetService(t)
+			ms.EXPECT().GetWidgetInstanceByID(mock.Anything, widgetID).Return(widget, nil)
+			ms.EXPECT().GetSheetById(mock.Anything, sheetID).Return(sheet, nil)
+			if tt.wantErr == nil {
+				ms.EXPECT().GetOrganizationById(mock.Anything, orgID.String()).Return(&dbModels.Organization{ID: orgID}, nil)
+				mds.EXPECT().GetDataByDatasetId(mock.Anything, orgID, "transactions", mock.MatchedBy(func(params datasetmodels.DatasetParams) bool {
+					return assert.ObjectsAreEqual(tt.wantValue, params.Filters.Conditions[0].Value)
+				})).Return(datasetmodels.DatasetData{}, nil)
+			}
+
+			service := &widgetsService{store: ms, datasetService: mds}
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{orgID})
+			_, err := service.GetWidgetInstanceData(ctx, orgID, widgetID, models.GetWidgetInstanceDataQueryParams{Variables: tt.variables})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	DatasetAlertStateOk     = "OK"
+	DatasetAlertStateFiring = "FIRING"
+)
+
+const (
+	DatasetAlertEventStatusFiring   = "FIRING"
+	DatasetAlertEventStatusResolved = "RESOLVED"
+)
+
+// DatasetAlert evaluates a metric of a dataset on a cron and notifies its recipients when the metric starts or stops
+// breaching the condition. State is the outcome of the latest evaluation, notifications are only sent when it changes.
+type DatasetAlert struct {
+	ID                 uuid.UUID       `json:"dataset_alert_id" gorm:"column:dataset_alert_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId     uuid.UUID       `json:"organization_id" gorm:"column:organization_id"`
+	DatasetId          uuid.UUID       `json:"dataset_id" gorm:"column:dataset_id"`
+	OwnerId            uuid.UUID       `json:"owner_id" gorm:"column:owner_id"`
+	Name               string          `json:"name" gorm:"column:name"`
+	Metric             json.RawMessage `json:"metric" gorm:"column:metric"`
+	Condition          json.RawMessage `json:"condition" gorm:"column:condition"`
+	CronExpression     string          `json:"cron_expression" gorm:"column:cron_expression"`
+	Timezone           string          `json:"timezone" gorm:"column:timezone"`
+	Recipients         json.RawMessage `json:"recipients" gorm:"column:recipients"`
+	WebhookUrl         string          `json:"webhook_url" gorm:"column:webhook_url"`
+	TemporalScheduleId string          `json:"temporal_schedule_id" gorm:"column:temporal_schedule_id"`
+	IsEnabled          bool            `json:"is_enabled" gorm:"column:is_enabled"`
+	State              string          `json:"state" gorm:"column:state"`
+	LastValue          *float64        `json:"last_value" gorm:"column:last_value"`
+	LastEvaluatedAt    *time.Time      `json:"last_evaluated_at" gorm:"column:last_evaluated_at"`
+	LastError          string          `json:"last_error" gorm:"column:last_error"`
+	SnoozedUntil       *time.Time      `json:"snoozed_until" gorm:"column:snoozed_until"`
+	CreatedAt          time.Time       `json:"created_at" gorm:"column:created_at"`
+	CreatedBy          uuid.UUID       `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt          time.Time       `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy          uuid.UUID       `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt          *time.Time      `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy          *uuid.UUID      `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateDatasetAlertParams struct {
+	OrganizationId     uuid.UUID
+	DatasetId          uuid.UUID
+	OwnerId            uuid.UUID
+	Name               string
+	Metric             interface{}
+	Condition          interface{}
+	CronExpression     string
+	Timezone           string
+	Recipients         []string
+	WebhookUrl         string
+	TemporalScheduleId string
+}
+
+type UpdateDatasetAlertParams struct {
+	Name               string
+	Metric             interface{}
+	Condition          interface{}
+	CronExpression     string
+	Timezone           string
+	Recipients         []string
+	WebhookUrl         string
+	TemporalScheduleId string
+	IsEnabled          bool
+	UpdatedBy          uuid.UUID
+}
+
+// UpdateDatasetAlertStateParams records the outcome of an evaluation, LastValue is left untouched when nil
+type UpdateDatasetAlertStateParams struct {
+	State           string
+	LastValue       *float64
+	LastEvaluatedAt time.Time
+	LastError       string
+}
+
+func (DatasetAlert) TableName() string {
+	return "dataset_alerts"
+}
+
+func (a *DatasetAlert) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where("dataset_alerts.organization_id IN ?", orgIds).Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = dataset_alerts.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+// BeforeCreate requires read access on the dataset, the metric is evaluated with the policies of the owner
+func (a *DatasetAlert) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND deleted_at IS NULL", ResourceTypeDataset, a.DatasetId, userId).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
+
+func (a *DatasetAlert) BeforeUpdate(db *gorm.DB) error {
+	return a.ensureOwnerOrDatasetAdmin(db)
+}
+
+func (a *DatasetAlert) BeforeDelete(db *gorm.DB) error {
+	return a.ensureOwnerOrDatasetAdmin(db)
+}
+
+func (a *DatasetAlert) ensureOwnerOrDatasetAdmin(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	if a.OwnerId == *userId {
+		return nil
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND privilege = ? AND deleted_at IS NULL", ResourceTypeDataset, a.DatasetId, userId, PrivilegeDatasetAdmin).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset alert access forbidden")
+	}
+
+	return nil
+}
+
+// DatasetAlertEvent is a change of the state of an alert, Notified is false when the alert was snoozed at the time
+// until an evaluation after the snooze finds the alert still FIRING
+type DatasetAlertEvent struct {
+	ID              uuid.UUID `json:"dataset_alert_event_id" gorm:"column:dataset_alert_event_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	DatasetAlertId  uuid.UUID `json:"dataset_alert_id" gorm:"column:dataset_alert_id"`
+	Status          string    `json:"status" gorm:"column:status"`
+	Value           float64   `json:"value" gorm:"column:value"`
+	ComparisonValue *float64  `json:"comparison_value" gorm:"column:comparison_value"`
+	Score           *float64  `json:"score" gorm:"column:score"`
+	Message         string    `json:"message" gorm:"column:message"`
+	Notified        bool      `json:"notified" gorm:"column:notified"`
+	CreatedAt       time.Time `json:"created_at" gorm:"column:created_at"`
+}
+
+type CreateDatasetAlertEventParams struct {
+	DatasetAlertId  uuid.UUID
+	Status          string
+	Value           float64
+	ComparisonValue *float64
+	Score           *float64
+	Message         string
+	Notified        bool
+}
+
+func (DatasetAlertEvent) TableName() string {
+	return "dataset_alert_events"
+}
+
+func (e *DatasetAlertEvent) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."dataset_alerts" da
+			JOIN "app"."flattened_resource_audience_policies" frap
+			ON frap.resource_type = 'dataset'
+			AND frap.resource_id = da.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+			WHERE da.dataset_alert_id = dataset_alert_events.dataset_alert_id
+		)`, userId,
+	)
+}
+
+// BeforeCreate requires read access on the dataset of the alert, events are recorded by the worker as the alert owner
+func (e *DatasetAlertEvent) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where(
+		`resource_type = ? AND user_id = ? AND deleted_at IS NULL AND resource_id = (
+			SELECT dataset_id FROM "app"."dataset_alerts" WHERE dataset_alert_id = ?
+		)`, ResourceTypeDataset, userId, e.DatasetAlertId,
+	).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetAlert_TableName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "dataset_alerts", DatasetAlert{}.TableName())
+	assert.Equal(t, "dataset_alert_events", DatasetAlertEvent{}.TableName())
+}
+
+func TestStructImplementsBaseModel_DatasetAlert(t *testing.T) {
+	var _ pgclient.BaseModel = &DatasetAlert{}
+	var _ pgclient.BaseModel = &DatasetAlertEvent{}
+}
+
+func TestDatasetAlert_GetQueryFilters(t *testing.T) {
+	t.Parallel()
+
+	userId := uuid.New()
+	orgId := uuid.New()
+	db, mock := setupTestDB(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "dataset_alerts" WHERE dataset_alerts.organization_id IN ($1) AND EXISTS ( SELECT 1 FROM "app"."flattened_resource_audience_policies" frap WHERE frap.resource_type = 'dataset' AND frap.resource_id = dataset_alerts.dataset_id AND frap.user_id = $2 AND frap.deleted_at IS NULL )`)).
+		WithArgs(orgId, userId).
+		WillReturnRows(sqlmock.NewRows([]string{"dataset_alert_id", "dataset_id"}).
+			AddRow(uuid.New(), uuid.New()))
+
+	alert := &DatasetAlert{}
+	query := alert.GetQueryFilters(db.Model(alert), userId, []uuid.UUID{orgId})
+
+	var results []DatasetAlert
+	assert.NoError(t, query.Find(&results).Error)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDatasetAlert_BeforeDelete(t *testing.T) {
+	t.Parallel()
+
+	ownerId := uuid.New()
+	adminQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)
+
+	tests := []struct {
+		name      string
+		userId    uuid.UUID
+		setupMock func(mock sqlmock.Sqlmock, userId uuid.UUID)
+		wantErr   bool
+	}{
+		{
+			name:      "owner can delete",
+			userId:    ownerId,
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {},
+		},
+		{
+			name:   "dataset admin can delete",
+			userId: uuid.New(),
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(adminQuery).
+					WithArgs("dataset", sqlmock.AnyArg(), userId, "admin", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", uuid.New(), userId, "admin"))
+			},
+		},
+		{
+			name:   "failure - dataset viewer who does not own the alert",
+			userId: uuid.New(),
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(adminQuery).
+					WithArgs("dataset", sqlmock.AnyArg(), userId, "admin", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			db = db.WithContext(apicontext.AddAuthToContext(context.Background(), "user", tt.userId, []uuid.UUID{}))
+
+			alert := &DatasetAlert{
+				ID:        uuid.New(),
+				DatasetId: uuid.New(),
+				OwnerId:   ownerId,
+			}
+
+			tt.setupMock(mock, tt.userId)
+
+			err := alert.BeforeDelete(db)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, "dataset alert access forbidden")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDatasetAlertEvent_BeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND user_id = $2 AND deleted_at IS NULL AND resource_id = ( SELECT dataset_id FROM "app"."dataset_alerts" WHERE dataset_alert_id = $3 ) LIMIT $4`)
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock, userId uuid.UUID)
+		wantErr   bool
+	}{
+		{
+			name: "owner with dataset access",
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(frapQuery).
+					WithArgs("dataset", userId, sqlmock.AnyArg(), 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", uuid.New(), userId, "viewer"))
+			},
+		},
+		{
+			name: "failure - owner lost dataset access",
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(frapQuery).
+					WithArgs("dataset", userId, sqlmock.AnyArg(), 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			userId := uuid.New()
+			db = db.WithContext(apicontext.AddAuthToContext(context.Background(), "user", userId, []uuid.UUID{}))
+
+			tt.setupMock(mock, userId)
+
+			event := &DatasetAlertEvent{DatasetAlertId: uuid.New()}
+			err := event.BeforeCreate(db)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
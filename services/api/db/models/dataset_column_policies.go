@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ColumnPolicyAction string
+
+const (
+	ColumnPolicyActionAllow ColumnPolicyAction = "allow"
+	ColumnPolicyActionMask  ColumnPolicyAction = "mask"
+	ColumnPolicyActionHide  ColumnPolicyAction = "hide"
+)
+
+type ColumnMaskType string
+
+const (
+	ColumnMaskTypeLast4 ColumnMaskType = "last_4"
+	ColumnMaskTypeHash  ColumnMaskType = "hash"
+	ColumnMaskTypeNull  ColumnMaskType = "null"
+)
+
+// DatasetColumnPolicy controls how a single column of a dataset is returned to an audience (user, team or organization).
+// MaskType is only set when Action is mask.
+type DatasetColumnPolicy struct {
+	ID                   uuid.UUID          `json:"dataset_column_policy_id" gorm:"column:dataset_column_policy_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId       uuid.UUID          `json:"organization_id" gorm:"column:organization_id"`
+	DatasetId            uuid.UUID          `json:"dataset_id" gorm:"column:dataset_id"`
+	ResourceAudienceType AudienceType       `json:"resource_audience_type" gorm:"column:resource_audience_type"`
+	ResourceAudienceId   uuid.UUID          `json:"resource_audience_id" gorm:"column:resource_audience_id"`
+	Column               string             `json:"column_name" gorm:"column:column_name"`
+	Action               ColumnPolicyAction `json:"action" gorm:"column:action"`
+	MaskType             *ColumnMaskType    `json:"mask_type" gorm:"column:mask_type"`
+	CreatedAt            time.Time          `json:"created_at" gorm:"column:created_at"`
+	CreatedBy            uuid.UUID          `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt            time.Time          `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy            uuid.UUID          `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt            *time.Time         `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy            *uuid.UUID         `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateDatasetColumnPolicyParams struct {
+	OrganizationId       uuid.UUID
+	DatasetId            uuid.UUID
+	ResourceAudienceType AudienceType
+	ResourceAudienceId   uuid.UUID
+	Column               string
+	Action               ColumnPolicyAction
+	MaskType             *ColumnMaskType
+	CreatedBy            uuid.UUID
+}
+
+type UpdateDatasetColumnPolicyParams struct {
+	Action    ColumnPolicyAction
+	MaskType  *ColumnMaskType
+	UpdatedBy uuid.UUID
+}
+
+func (DatasetColumnPolicy) TableName() string {
+	return "dataset_column_policies"
+}
+
+func (p *DatasetColumnPolicy) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = dataset_column_policies.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+func (p *DatasetColumnPolicy) BeforeCreate(db *gorm.DB) error {
+	return p.ensureDatasetAdmin(db)
+}
+
+func (p *DatasetColumnPolicy) BeforeUpdate(db *gorm.DB) error {
+	return p.ensureDatasetAdmin(db)
+}
+
+func (p *DatasetColumnPolicy) BeforeDelete(db *gorm.DB) error {
+	return p.ensureDatasetAdmin(db)
+}
+
+func (p *DatasetColumnPolicy) ensureDatasetAdmin(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND privilege = ? AND deleted_at IS NULL", ResourceTypeDataset, p.DatasetId, userId, PrivilegeDatasetAdmin).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
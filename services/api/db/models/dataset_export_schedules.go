@@ -0,0 +1,195 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DatasetExportSchedule exports a saved dataset query on a cron and delivers the file to a bucket or to email recipients.
+// TemporalScheduleId is the temporal schedule currently firing runs for it, runs started by any other schedule are skipped.
+type DatasetExportSchedule struct {
+	ID                 uuid.UUID       `json:"dataset_export_schedule_id" gorm:"column:dataset_export_schedule_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId     uuid.UUID       `json:"organization_id" gorm:"column:organization_id"`
+	DatasetId          uuid.UUID       `json:"dataset_id" gorm:"column:dataset_id"`
+	OwnerId            uuid.UUID       `json:"owner_id" gorm:"column:owner_id"`
+	Name               string          `json:"name" gorm:"column:name"`
+	QueryConfig        json.RawMessage `json:"query_config" gorm:"column:query_config"`
+	Format             string          `json:"format" gorm:"column:format"`
+	CronExpression     string          `json:"cron_expression" gorm:"column:cron_expression"`
+	Timezone           string          `json:"timezone" gorm:"column:timezone"`
+	DestinationType    string          `json:"destination_type" gorm:"column:destination_type"`
+	DestinationConfig  json.RawMessage `json:"destination_config" gorm:"column:destination_config"`
+	TemporalScheduleId string          `json:"temporal_schedule_id" gorm:"column:temporal_schedule_id"`
+	IsEnabled          bool            `json:"is_enabled" gorm:"column:is_enabled"`
+	CreatedAt          time.Time       `json:"created_at" gorm:"column:created_at"`
+	CreatedBy          uuid.UUID       `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt          time.Time       `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy          uuid.UUID       `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt          *time.Time      `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy          *uuid.UUID      `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateDatasetExportScheduleParams struct {
+	OrganizationId     uuid.UUID
+	DatasetId          uuid.UUID
+	OwnerId            uuid.UUID
+	Name               string
+	QueryConfig        interface{}
+	Format             string
+	CronExpression     string
+	Timezone           string
+	DestinationType    string
+	DestinationConfig  interface{}
+	TemporalScheduleId string
+}
+
+type UpdateDatasetExportScheduleParams struct {
+	Name               string
+	QueryConfig        interface{}
+	Format             string
+	CronExpression     string
+	Timezone           string
+	DestinationType    string
+	DestinationConfig  interface{}
+	TemporalScheduleId string
+	IsEnabled          bool
+	UpdatedBy          uuid.UUID
+}
+
+func (DatasetExportSchedule) TableName() string {
+	return "dataset_export_schedules"
+}
+
+func (s *DatasetExportSchedule) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where("dataset_export_schedules.organization_id IN ?", orgIds).Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = dataset_export_schedules.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+// BeforeCreate requires read access on the dataset, the export runs with the policies of the owner
+func (s *DatasetExportSchedule) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND deleted_at IS NULL", ResourceTypeDataset, s.DatasetId, userId).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
+
+func (s *DatasetExportSchedule) BeforeUpdate(db *gorm.DB) error {
+	return s.ensureOwnerOrDatasetAdmin(db)
+}
+
+func (s *DatasetExportSchedule) BeforeDelete(db *gorm.DB) error {
+	return s.ensureOwnerOrDatasetAdmin(db)
+}
+
+func (s *DatasetExportSchedule) ensureOwnerOrDatasetAdmin(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	if s.OwnerId == *userId {
+		return nil
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND privilege = ? AND deleted_at IS NULL", ResourceTypeDataset, s.DatasetId, userId, PrivilegeDatasetAdmin).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset export schedule access forbidden")
+	}
+
+	return nil
+}
+
+// DatasetExportScheduleRun is one firing of an export schedule, WorkflowId is also the id of the dataset export action of the run
+type DatasetExportScheduleRun struct {
+	ID                      uuid.UUID  `json:"dataset_export_schedule_run_id" gorm:"column:dataset_export_schedule_run_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	DatasetExportScheduleId uuid.UUID  `json:"dataset_export_schedule_id" gorm:"column:dataset_export_schedule_id"`
+	WorkflowId              string     `json:"workflow_id" gorm:"column:workflow_id"`
+	Status                  string     `json:"status" gorm:"column:status"`
+	FilePath                string     `json:"file_path" gorm:"column:file_path"`
+	Error                   string     `json:"error" gorm:"column:error"`
+	StartedAt               time.Time  `json:"started_at" gorm:"column:started_at"`
+	CompletedAt             *time.Time `json:"completed_at" gorm:"column:completed_at"`
+}
+
+type CreateDatasetExportScheduleRunParams struct {
+	DatasetExportScheduleId uuid.UUID
+	WorkflowId              string
+	Status                  string
+	FilePath                string
+}
+
+type UpdateDatasetExportScheduleRunParams struct {
+	Status string
+	Error  string
+}
+
+func (DatasetExportScheduleRun) TableName() string {
+	return "dataset_export_schedule_runs"
+}
+
+func (r *DatasetExportScheduleRun) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."dataset_export_schedules" des
+			JOIN "app"."flattened_resource_audience_policies" frap
+			ON frap.resource_type = 'dataset'
+			AND frap.resource_id = des.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+			WHERE des.dataset_export_schedule_id = dataset_export_schedule_runs.dataset_export_schedule_id
+		)`, userId,
+	)
+}
+
+// BeforeCreate requires read access on the dataset of the schedule, runs are recorded by the worker as the schedule owner
+func (r *DatasetExportScheduleRun) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where(
+		`resource_type = ? AND user_id = ? AND deleted_at IS NULL AND resource_id = (
+			SELECT dataset_id FROM "app"."dataset_export_schedules" WHERE dataset_export_schedule_id = ?
+		)`, ResourceTypeDataset, userId, r.DatasetExportScheduleId,
+	).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
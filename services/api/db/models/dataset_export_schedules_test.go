@@ -0,0 +1,169 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetExportSchedule_TableName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "dataset_export_schedules", DatasetExportSchedule{}.TableName())
+	assert.Equal(t, "dataset_export_schedule_runs", DatasetExportScheduleRun{}.TableName())
+}
+
+func TestStructImplementsBaseModel_DatasetExportSchedule(t *testing.T) {
+	var _ pgclient.BaseModel = &DatasetExportSchedule{}
+	var _ pgclient.BaseModel = &DatasetExportScheduleRun{}
+}
+
+func TestDatasetExportSchedule_GetQueryFilters(t *testing.T) {
+	t.Parallel()
+
+	userId := uuid.New()
+	orgId := uuid.New()
+	db, mock := setupTestDB(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "dataset_export_schedules" WHERE dataset_export_schedules.organization_id IN ($1) AND EXISTS ( SELECT 1 FROM "app"."flattened_resource_audience_policies" frap WHERE frap.resource_type = 'dataset' AND frap.resource_id = dataset_export_schedules.dataset_id AND frap.user_id = $2 AND frap.deleted_at IS NULL )`)).
+		WithArgs(orgId, userId).
+		WillReturnRows(sqlmock.NewRows([]string{"dataset_export_schedule_id", "dataset_id"}).
+			AddRow(uuid.New(), uuid.New()))
+
+	schedule := &DatasetExportSchedule{}
+	query := schedule.GetQueryFilters(db.Model(schedule), userId, []uuid.UUID{orgId})
+
+	var results []DatasetExportSchedule
+	assert.NoError(t, query.Find(&results).Error)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDatasetExportSchedule_BeforeUpdate(t *testing.T) {
+	t.Parallel()
+
+	ownerId := uuid.New()
+	adminQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)
+
+	tests := []struct {
+		name      string
+		userId    uuid.UUID
+		setupMock func(mock sqlmock.Sqlmock, userId uuid.UUID)
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "owner can update",
+			userId:    ownerId,
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {},
+		},
+		{
+			name:   "dataset admin can update",
+			userId: uuid.New(),
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(adminQuery).
+					WithArgs("dataset", sqlmock.AnyArg(), userId, "admin", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", uuid.New(), userId, "admin"))
+			},
+		},
+		{
+			name:   "failure - dataset viewer who does not own the schedule",
+			userId: uuid.New(),
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(adminQuery).
+					WithArgs("dataset", sqlmock.AnyArg(), userId, "admin", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+			},
+			wantErr: true,
+			errMsg:  "dataset export schedule access forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", tt.userId, []uuid.UUID{})
+			db = db.WithContext(ctx)
+
+			schedule := &DatasetExportSchedule{
+				ID:        uuid.New(),
+				DatasetId: uuid.New(),
+				OwnerId:   ownerId,
+			}
+
+			tt.setupMock(mock, tt.userId)
+
+			err := schedule.BeforeUpdate(db)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDatasetExportScheduleRun_BeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND user_id = $2 AND deleted_at IS NULL AND resource_id = ( SELECT dataset_id FROM "app"."dataset_export_schedules" WHERE dataset_export_schedule_id = $3 ) LIMIT $4`)
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock, userId uuid.UUID)
+		wantErr   bool
+	}{
+		{
+			name: "owner with dataset access",
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(frapQuery).
+					WithArgs("dataset", userId, sqlmock.AnyArg(), 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", uuid.New(), userId, "viewer"))
+			},
+		},
+		{
+			name: "failure - owner lost dataset access",
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(frapQuery).
+					WithArgs("dataset", userId, sqlmock.AnyArg(), 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			userId := uuid.New()
+			db = db.WithContext(apicontext.AddAuthToContext(context.Background(), "user", userId, []uuid.UUID{}))
+
+			tt.setupMock(mock, userId)
+
+			run := &DatasetExportScheduleRun{DatasetExportScheduleId: uuid.New()}
+			err := run.BeforeCreate(db)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
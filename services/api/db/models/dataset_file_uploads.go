@@ -33,6 +33,7 @@ type DatasetFileUploadMetadata struct {
 	ExtractedMetadata     ExtractedMetadata      `json:"extracted_metadata"`
 	ColumnMapping         map[string]interface{} `json:"column_mapping"`
 	Error                 string                 `json:"error"`
+	ValidationErrors      []string               `json:"validation_errors,omitempty"`
 }
 
 type DatasetPreview struct {
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DatasetRowAssignment assigns a row of a dataset, identified by its zamp id, to a user with an optional due date.
+// Rows are unassigned by clearing the assignee rather than deleting the assignment.
+type DatasetRowAssignment struct {
+	ID             uuid.UUID  `json:"dataset_row_assignment_id" gorm:"column:dataset_row_assignment_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId uuid.UUID  `json:"organization_id" gorm:"column:organization_id"`
+	DatasetId      uuid.UUID  `json:"dataset_id" gorm:"column:dataset_id"`
+	RowId          string     `json:"row_id" gorm:"column:row_id"`
+	AssigneeId     *uuid.UUID `json:"assignee_id" gorm:"column:assignee_id"`
+	DueDate        *time.Time `json:"due_date" gorm:"column:due_date;type:date"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"column:created_at"`
+	CreatedBy      uuid.UUID  `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy      uuid.UUID  `json:"updated_by" gorm:"column:updated_by"`
+}
+
+type UpsertDatasetRowAssignmentParams struct {
+	OrganizationId uuid.UUID
+	DatasetId      uuid.UUID
+	RowId          string
+	AssigneeId     *uuid.UUID
+	DueDate        *time.Time
+	UpdatedBy      uuid.UUID
+}
+
+func (DatasetRowAssignment) TableName() string {
+	return "dataset_row_assignments"
+}
+
+func (a *DatasetRowAssignment) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = dataset_row_assignments.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+// BeforeCreate lets anyone with access to the dataset assign its rows, assigning work is part of working the dataset
+func (a *DatasetRowAssignment) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND deleted_at IS NULL", ResourceTypeDataset, a.DatasetId, userId).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetRowAssignment_TableName(t *testing.T) {
+	t.Parallel()
+	assignment := DatasetRowAssignment{}
+	assert.Equal(t, "dataset_row_assignments", assignment.TableName())
+}
+
+func TestStructImplementsBaseModel_DatasetRowAssignment(t *testing.T) {
+	var _ pgclient.BaseModel = &DatasetRowAssignment{}
+}
+
+func TestDatasetRowAssignment_BeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		hasFrap  bool
+		withUser bool
+		errMsg   string
+	}{
+		{
+			name:     "successful creation by a dataset viewer",
+			hasFrap:  true,
+			withUser: true,
+		},
+		{
+			name:   "failure - no user ID in context",
+			errMsg: "no user id found in context",
+		},
+		{
+			name:     "failure - no dataset access",
+			withUser: true,
+			errMsg:   "dataset access forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			userId := uuid.New()
+			ctx := context.Background()
+			if tt.withUser {
+				ctx = apicontext.AddAuthToContext(ctx, "role", userId, []uuid.UUID{})
+
+				rows := sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"})
+				if tt.hasFrap {
+					rows.AddRow("dataset", uuid.New(), userId, "viewer")
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)).
+					WithArgs("dataset", sqlmock.AnyArg(), userId, 1).
+					WillReturnRows(rows)
+			}
+
+			assignment := &DatasetRowAssignment{DatasetId: uuid.New(), RowId: "row-1"}
+			err := assignment.BeforeCreate(db.WithContext(ctx))
+
+			if tt.errMsg != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
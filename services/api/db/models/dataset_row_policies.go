@@ -0,0 +1,95 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DatasetRowPolicy restricts the rows of a dataset that an audience (user, team or organization) can read.
+// FilterConfig holds a dataset FilterModel which is AND-ed into every query issued on behalf of the audience.
+type DatasetRowPolicy struct {
+	ID                   uuid.UUID       `json:"dataset_row_policy_id" gorm:"column:dataset_row_policy_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId       uuid.UUID       `json:"organization_id" gorm:"column:organization_id"`
+	DatasetId            uuid.UUID       `json:"dataset_id" gorm:"column:dataset_id"`
+	ResourceAudienceType AudienceType    `json:"resource_audience_type" gorm:"column:resource_audience_type"`
+	ResourceAudienceId   uuid.UUID       `json:"resource_audience_id" gorm:"column:resource_audience_id"`
+	Title                string          `json:"title" gorm:"column:title"`
+	Description          string          `json:"description" gorm:"column:description"`
+	FilterConfig         json.RawMessage `json:"filter_config" gorm:"column:filter_config"`
+	CreatedAt            time.Time       `json:"created_at" gorm:"column:created_at"`
+	CreatedBy            uuid.UUID       `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt            time.Time       `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy            uuid.UUID       `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt            *time.Time      `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy            *uuid.UUID      `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateDatasetRowPolicyParams struct {
+	OrganizationId       uuid.UUID
+	DatasetId            uuid.UUID
+	ResourceAudienceType AudienceType
+	ResourceAudienceId   uuid.UUID
+	Title                string
+	Description          string
+	FilterConfig         interface{}
+	CreatedBy            uuid.UUID
+}
+
+type UpdateDatasetRowPolicyParams struct {
+	Title        string
+	Description  string
+	FilterConfig interface{}
+	UpdatedBy    uuid.UUID
+}
+
+func (DatasetRowPolicy) TableName() string {
+	return "dataset_row_policies"
+}
+
+func (p *DatasetRowPolicy) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = dataset_row_policies.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+func (p *DatasetRowPolicy) BeforeCreate(db *gorm.DB) error {
+	return p.ensureDatasetAdmin(db)
+}
+
+func (p *DatasetRowPolicy) BeforeUpdate(db *gorm.DB) error {
+	return p.ensureDatasetAdmin(db)
+}
+
+func (p *DatasetRowPolicy) BeforeDelete(db *gorm.DB) error {
+	return p.ensureDatasetAdmin(db)
+}
+
+func (p *DatasetRowPolicy) ensureDatasetAdmin(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND privilege = ? AND deleted_at IS NULL", ResourceTypeDataset, p.DatasetId, userId, PrivilegeDatasetAdmin).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
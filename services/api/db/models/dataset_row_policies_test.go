@@ -0,0 +1,119 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetRowPolicy_TableName(t *testing.T) {
+	t.Parallel()
+	policy := DatasetRowPolicy{}
+	assert.Equal(t, "dataset_row_policies", policy.TableName())
+}
+
+func TestStructImplementsBaseModel_DatasetRowPolicy(t *testing.T) {
+	var _ pgclient.BaseModel = &DatasetRowPolicy{}
+}
+
+func TestDatasetRowPolicy_GetQueryFilters(t *testing.T) {
+	t.Parallel()
+
+	userId := uuid.New()
+	db, mock := setupTestDB(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "dataset_row_policies" WHERE EXISTS ( SELECT 1 FROM "app"."flattened_resource_audience_policies" frap WHERE frap.resource_type = 'dataset' AND frap.resource_id = dataset_row_policies.dataset_id AND frap.user_id = $1 AND frap.deleted_at IS NULL )`)).
+		WithArgs(userId).
+		WillReturnRows(sqlmock.NewRows([]string{"dataset_row_policy_id", "dataset_id"}).
+			AddRow(uuid.New(), uuid.New()))
+
+	policy := &DatasetRowPolicy{}
+	query := policy.GetQueryFilters(db.Model(policy), userId, []uuid.UUID{uuid.New()})
+
+	var results []DatasetRowPolicy
+	assert.NoError(t, query.Find(&results).Error)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDatasetRowPolicy_BeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock, userId uuid.UUID)
+		setupCtx  func() (context.Context, uuid.UUID)
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name: "successful creation with admin privilege",
+			setupCtx: func() (context.Context, uuid.UUID) {
+				userId := uuid.New()
+				return apicontext.AddAuthToContext(context.Background(), "role", userId, []uuid.UUID{}), userId
+			},
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs("dataset", sqlmock.AnyArg(), userId, "admin", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", uuid.New(), userId, "admin"))
+			},
+		},
+		{
+			name: "failure - no user ID in context",
+			setupCtx: func() (context.Context, uuid.UUID) {
+				return context.Background(), uuid.Nil
+			},
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {},
+			wantErr:   true,
+			errMsg:    "no user id found in context",
+		},
+		{
+			name: "failure - no admin privilege found",
+			setupCtx: func() (context.Context, uuid.UUID) {
+				userId := uuid.New()
+				return apicontext.AddAuthToContext(context.Background(), "role", userId, []uuid.UUID{}), userId
+			},
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs("dataset", sqlmock.AnyArg(), userId, "admin", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+			},
+			wantErr: true,
+			errMsg:  "dataset access forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			ctx, userId := tt.setupCtx()
+			db = db.WithContext(ctx)
+
+			policy := &DatasetRowPolicy{
+				ID:        uuid.New(),
+				DatasetId: uuid.New(),
+			}
+
+			tt.setupMock(mock, userId)
+
+			err := policy.BeforeCreate(db)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DatasetStatusWorkflow is the state machine of a status column of a dataset. States is a json array of the states
+// the column can hold and Transitions a json array of the moves between them with the privilege each one requires.
+type DatasetStatusWorkflow struct {
+	ID             uuid.UUID       `json:"dataset_status_workflow_id" gorm:"column:dataset_status_workflow_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId uuid.UUID       `json:"organization_id" gorm:"column:organization_id"`
+	DatasetId      uuid.UUID       `json:"dataset_id" gorm:"column:dataset_id"`
+	ColumnName     string          `json:"column_name" gorm:"column:column_name"`
+	InitialState   string          `json:"initial_state" gorm:"column:initial_state"`
+	States         json.RawMessage `json:"states" gorm:"column:states"`
+	Transitions    json.RawMessage `json:"transitions" gorm:"column:transitions"`
+	CreatedAt      time.Time       `json:"created_at" gorm:"column:created_at"`
+	CreatedBy      uuid.UUID       `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt      time.Time       `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy      uuid.UUID       `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt      *time.Time      `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy      *uuid.UUID      `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateDatasetStatusWorkflowParams struct {
+	OrganizationId uuid.UUID
+	DatasetId      uuid.UUID
+	ColumnName     string
+	InitialState   string
+	States         interface{}
+	Transitions    interface{}
+	CreatedBy      uuid.UUID
+}
+
+type UpdateDatasetStatusWorkflowParams struct {
+	InitialState string
+	States       interface{}
+	Transitions  interface{}
+	UpdatedBy    uuid.UUID
+}
+
+func (DatasetStatusWorkflow) TableName() string {
+	return "dataset_status_workflows"
+}
+
+func (w *DatasetStatusWorkflow) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = dataset_status_workflows.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+func (w *DatasetStatusWorkflow) BeforeCreate(db *gorm.DB) error {
+	return w.ensureDatasetAdmin(db)
+}
+
+func (w *DatasetStatusWorkflow) BeforeUpdate(db *gorm.DB) error {
+	return w.ensureDatasetAdmin(db)
+}
+
+func (w *DatasetStatusWorkflow) BeforeDelete(db *gorm.DB) error {
+	return w.ensureDatasetAdmin(db)
+}
+
+func (w *DatasetStatusWorkflow) ensureDatasetAdmin(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND privilege = ? AND deleted_at IS NULL", ResourceTypeDataset, w.DatasetId, userId, PrivilegeDatasetAdmin).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
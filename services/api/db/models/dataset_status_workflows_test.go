@@ -0,0 +1,18 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetStatusWorkflow_TableName(t *testing.T) {
+	t.Parallel()
+	workflow := DatasetStatusWorkflow{}
+	assert.Equal(t, "dataset_status_workflows", workflow.TableName())
+}
+
+func TestStructImplementsBaseModel_DatasetStatusWorkflow(t *testing.T) {
+	var _ pgclient.BaseModel = &DatasetStatusWorkflow{}
+}
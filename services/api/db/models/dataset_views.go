@@ -0,0 +1,146 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DatasetView is a named query preset on a dataset. QueryConfig holds the dataset params (filters, sorts, grouping, columns)
+// while ColumnOrder and DisplayConfig only affect how the result is laid out on the client.
+// Access to a view is granted through resource audience policies of type dataset_view.
+type DatasetView struct {
+	ID             uuid.UUID       `json:"dataset_view_id" gorm:"column:dataset_view_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId uuid.UUID       `json:"organization_id" gorm:"column:organization_id"`
+	DatasetId      uuid.UUID       `json:"dataset_id" gorm:"column:dataset_id"`
+	OwnerId        uuid.UUID       `json:"owner_id" gorm:"column:owner_id"`
+	Name           string          `json:"name" gorm:"column:name"`
+	Description    string          `json:"description" gorm:"column:description"`
+	QueryConfig    json.RawMessage `json:"query_config" gorm:"column:query_config"`
+	ColumnOrder    json.RawMessage `json:"column_order" gorm:"column:column_order"`
+	DisplayConfig  json.RawMessage `json:"display_config" gorm:"column:display_config"`
+	CreatedAt      time.Time       `json:"created_at" gorm:"column:created_at"`
+	CreatedBy      uuid.UUID       `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt      time.Time       `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy      uuid.UUID       `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt      *time.Time      `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy      *uuid.UUID      `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateDatasetViewParams struct {
+	OrganizationId uuid.UUID
+	DatasetId      uuid.UUID
+	OwnerId        uuid.UUID
+	Name           string
+	Description    string
+	QueryConfig    interface{}
+	ColumnOrder    interface{}
+	DisplayConfig  interface{}
+}
+
+type UpdateDatasetViewParams struct {
+	Name          string
+	Description   string
+	QueryConfig   interface{}
+	ColumnOrder   interface{}
+	DisplayConfig interface{}
+	UpdatedBy     uuid.UUID
+}
+
+func (DatasetView) TableName() string {
+	return "dataset_views"
+}
+
+func (v *DatasetView) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset_view'
+			AND frap.resource_id = dataset_views.dataset_view_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+// BeforeCreate only requires read access on the dataset, any dataset user can save a view for themselves
+func (v *DatasetView) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND deleted_at IS NULL", ResourceTypeDataset, v.DatasetId, userId).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
+
+func (v *DatasetView) BeforeUpdate(db *gorm.DB) error {
+	return v.ensureViewAdmin(db)
+}
+
+func (v *DatasetView) BeforeDelete(db *gorm.DB) error {
+	return v.ensureViewAdmin(db)
+}
+
+func (v *DatasetView) ensureViewAdmin(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND privilege = ? AND deleted_at IS NULL", ResourceTypeDatasetView, v.ID, userId, PrivilegeDatasetViewAdmin).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset view access forbidden")
+	}
+
+	return nil
+}
+
+// DatasetViewDefault is the view a user gets when opening a dataset, there is at most one per user and dataset
+type DatasetViewDefault struct {
+	UserId        uuid.UUID `json:"user_id" gorm:"column:user_id;type:uuid;primaryKey"`
+	DatasetId     uuid.UUID `json:"dataset_id" gorm:"column:dataset_id;type:uuid;primaryKey"`
+	DatasetViewId uuid.UUID `json:"dataset_view_id" gorm:"column:dataset_view_id"`
+	CreatedAt     time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt     time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+func (DatasetViewDefault) TableName() string {
+	return "dataset_view_defaults"
+}
+
+func (d *DatasetViewDefault) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where("dataset_view_defaults.user_id = ?", userId)
+}
+
+// BeforeCreate ensures users only ever pick defaults for themselves
+func (d *DatasetViewDefault) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	if *userId != d.UserId {
+		return fmt.Errorf("cannot set the default view of another user")
+	}
+
+	return nil
+}
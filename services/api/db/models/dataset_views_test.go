@@ -0,0 +1,120 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetView_TableName(t *testing.T) {
+	t.Parallel()
+	view := DatasetView{}
+	assert.Equal(t, "dataset_views", view.TableName())
+}
+
+func TestStructImplementsBaseModel_DatasetView(t *testing.T) {
+	var _ pgclient.BaseModel = &DatasetView{}
+	var _ pgclient.BaseModel = &DatasetViewDefault{}
+}
+
+func TestDatasetView_GetQueryFilters(t *testing.T) {
+	t.Parallel()
+
+	userId := uuid.New()
+	db, mock := setupTestDB(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "dataset_views" WHERE EXISTS ( SELECT 1 FROM "app"."flattened_resource_audience_policies" frap WHERE frap.resource_type = 'dataset_view' AND frap.resource_id = dataset_views.dataset_view_id AND frap.user_id = $1 AND frap.deleted_at IS NULL )`)).
+		WithArgs(userId).
+		WillReturnRows(sqlmock.NewRows([]string{"dataset_view_id", "dataset_id"}).
+			AddRow(uuid.New(), uuid.New()))
+
+	view := &DatasetView{}
+	query := view.GetQueryFilters(db.Model(view), userId, []uuid.UUID{uuid.New()})
+
+	var results []DatasetView
+	assert.NoError(t, query.Find(&results).Error)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDatasetView_BeforeUpdate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock, userId uuid.UUID)
+		setupCtx  func() (context.Context, uuid.UUID)
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name: "view admin can update",
+			setupCtx: func() (context.Context, uuid.UUID) {
+				userId := uuid.New()
+				return apicontext.AddAuthToContext(context.Background(), "role", userId, []uuid.UUID{}), userId
+			},
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs("dataset_view", sqlmock.AnyArg(), userId, "admin", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset_view", uuid.New(), userId, "admin"))
+			},
+		},
+		{
+			name: "failure - no user ID in context",
+			setupCtx: func() (context.Context, uuid.UUID) {
+				return context.Background(), uuid.Nil
+			},
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {},
+			wantErr:   true,
+			errMsg:    "no user id found in context",
+		},
+		{
+			name: "failure - view shared as viewer only",
+			setupCtx: func() (context.Context, uuid.UUID) {
+				userId := uuid.New()
+				return apicontext.AddAuthToContext(context.Background(), "role", userId, []uuid.UUID{}), userId
+			},
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs("dataset_view", sqlmock.AnyArg(), userId, "admin", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+			},
+			wantErr: true,
+			errMsg:  "dataset view access forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			ctx, userId := tt.setupCtx()
+			db = db.WithContext(ctx)
+
+			view := &DatasetView{
+				ID:        uuid.New(),
+				DatasetId: uuid.New(),
+			}
+
+			tt.setupMock(mock, userId)
+
+			err := view.BeforeUpdate(db)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
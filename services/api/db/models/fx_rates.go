@@ -0,0 +1,136 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	FxRateSourceTypeFile      = "FILE"
+	FxRateSourceTypeConnector = "CONNECTOR"
+)
+
+// FxRateSource is where the rates of an organization come from, either files uploaded by users or a dataset kept in
+// sync by a connection. Config holds the columns the date, the currencies and the rate are read from.
+type FxRateSource struct {
+	ID             uuid.UUID       `json:"fx_rate_source_id" gorm:"column:fx_rate_source_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId uuid.UUID       `json:"organization_id" gorm:"column:organization_id"`
+	Name           string          `json:"name" gorm:"column:name"`
+	SourceType     string          `json:"source_type" gorm:"column:source_type"`
+	ConnectionId   *uuid.UUID      `json:"connection_id" gorm:"column:connection_id"`
+	DatasetId      *uuid.UUID      `json:"dataset_id" gorm:"column:dataset_id"`
+	Config         json.RawMessage `json:"config" gorm:"column:config"`
+	LastImportedAt *time.Time      `json:"last_imported_at" gorm:"column:last_imported_at"`
+	CreatedAt      time.Time       `json:"created_at" gorm:"column:created_at"`
+	CreatedBy      uuid.UUID       `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt      time.Time       `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy      uuid.UUID       `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt      *time.Time      `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy      *uuid.UUID      `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateFxRateSourceParams struct {
+	OrganizationId uuid.UUID
+	Name           string
+	SourceType     string
+	ConnectionId   *uuid.UUID
+	DatasetId      *uuid.UUID
+	Config         interface{}
+	CreatedBy      uuid.UUID
+}
+
+func (FxRateSource) TableName() string {
+	return "fx_rate_sources"
+}
+
+func (s *FxRateSource) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where("fx_rate_sources.organization_id IN ?", orgIds).Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'organization'
+			AND frap.resource_id = fx_rate_sources.organization_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+func (s *FxRateSource) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND deleted_at IS NULL", ResourceTypeOrganization, s.OrganizationId, userId).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("organization access forbidden")
+	}
+
+	return nil
+}
+
+// FxRate is the rate of one day for a currency pair of an organization, one BaseCurrency is worth Rate QuoteCurrency.
+// An organization has a single rate per pair and day, the latest import wins whatever its source.
+type FxRate struct {
+	ID             uuid.UUID `json:"fx_rate_id" gorm:"column:fx_rate_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId uuid.UUID `json:"organization_id" gorm:"column:organization_id"`
+	FxRateSourceId uuid.UUID `json:"fx_rate_source_id" gorm:"column:fx_rate_source_id"`
+	RateDate       time.Time `json:"rate_date" gorm:"column:rate_date;type:date"`
+	BaseCurrency   string    `json:"base_currency" gorm:"column:base_currency"`
+	QuoteCurrency  string    `json:"quote_currency" gorm:"column:quote_currency"`
+	Rate           float64   `json:"rate" gorm:"column:rate"`
+	CreatedAt      time.Time `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt      time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+type FxRateParams struct {
+	RateDate      time.Time
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          float64
+}
+
+type FxRateFilters struct {
+	BaseCurrencies  []string
+	QuoteCurrencies []string
+	StartDate       *time.Time
+	EndDate         *time.Time
+	Page            int
+	PageSize        int
+}
+
+func (FxRate) TableName() string {
+	return "fx_rates"
+}
+
+func (r *FxRate) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where("fx_rates.organization_id IN ?", orgIds).Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'organization'
+			AND frap.resource_id = fx_rates.organization_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+// BeforeCreate only requires a user, rates are written in batches by imports of a source the user can already see
+func (r *FxRate) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	return nil
+}
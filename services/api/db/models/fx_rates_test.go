@@ -0,0 +1,94 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFxRate_TableName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "fx_rate_sources", FxRateSource{}.TableName())
+	assert.Equal(t, "fx_rates", FxRate{}.TableName())
+}
+
+func TestStructImplementsBaseModel_FxRate(t *testing.T) {
+	var _ pgclient.BaseModel = &FxRateSource{}
+	var _ pgclient.BaseModel = &FxRate{}
+}
+
+func TestFxRateSource_BeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	orgId := uuid.New()
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)
+	frapColumns := []string{"resource_type", "resource_id", "user_id", "privilege"}
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock, userId uuid.UUID)
+		wantErr   bool
+	}{
+		{
+			name: "member of the organization",
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(frapQuery).
+					WithArgs("organization", orgId, userId, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns).AddRow("organization", orgId, userId, "member"))
+			},
+		},
+		{
+			name: "failure - not a member of the organization",
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(frapQuery).
+					WithArgs("organization", orgId, userId, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			userId := uuid.New()
+			db = db.WithContext(apicontext.AddAuthToContext(context.Background(), "user", userId, []uuid.UUID{orgId}))
+
+			source := &FxRateSource{
+				ID:             uuid.New(),
+				OrganizationId: orgId,
+			}
+
+			tt.setupMock(mock, userId)
+
+			err := source.BeforeCreate(db)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, "organization access forbidden")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFxRate_BeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupTestDB(t)
+
+	assert.Error(t, (&FxRate{}).BeforeCreate(db))
+
+	db = db.WithContext(apicontext.AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{uuid.New()}))
+	assert.NoError(t, (&FxRate{}).BeforeCreate(db))
+}
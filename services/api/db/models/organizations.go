@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -17,6 +18,8 @@ type Organization struct {
 	UpdatedAt                time.Time                       `json:"updated_at" gorm:"default:now()"`
 	DeletedAt                *time.Time                      `json:"deleted_at,omitempty"`
 	OwnerId                  uuid.UUID                       `json:"owner_id"`
+	CalendarSettings         json.RawMessage                 `json:"-" gorm:"column:calendar_settings;default:'{}'"`
+	ExportSettings           json.RawMessage                 `json:"-" gorm:"column:export_settings;default:'{}'"`
 	ResourceAudiencePolicies []ResourceAudiencePolicy        `json:"resource_audience_policies" gorm:"foreignKey:ResourceID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Invitations              []OrganizationInvitation        `json:"invitations" gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	MembershipRequests       []OrganizationMembershipRequest `json:"membership_requests" gorm:"foreignKey:OrganizationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
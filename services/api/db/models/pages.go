@@ -39,6 +39,13 @@ type PageSortParams struct {
 	Desc   bool
 }
 
+// UpdatePageParams holds the fields of a page to change, nil fields are left as they are
+type UpdatePageParams struct {
+	Name            *string
+	Description     *string
+	FractionalIndex *float64
+}
+
 func (o *Page) TableName() string {
 	return "pages"
 }
@@ -51,7 +58,7 @@ func (o *Page) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID
 			AND frap.resource_id = pages.page_id
 			AND frap.user_id = ?
 			AND frap.deleted_at IS NULL
-		)`, userId,
+		) AND pages.deleted_at IS NULL`, userId,
 	)
 }
 
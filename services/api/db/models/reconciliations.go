@@ -0,0 +1,346 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ReconciliationRunStatusRunning    = "RUNNING"
+	ReconciliationRunStatusSuccessful = "SUCCESSFUL"
+	ReconciliationRunStatusFailed     = "FAILED"
+)
+
+const (
+	ReconciliationMatchStatusMatched         = "MATCHED"
+	ReconciliationMatchStatusManuallyMatched = "MANUALLY_MATCHED"
+	ReconciliationMatchStatusUnmatched       = "UNMATCHED"
+)
+
+const (
+	ReconciliationSideLeft  = "LEFT"
+	ReconciliationSideRight = "RIGHT"
+)
+
+const (
+	ReconciliationExceptionStatusOpen     = "OPEN"
+	ReconciliationExceptionStatusResolved = "RESOLVED"
+)
+
+// Reconciliation matches the rows of the left dataset against the rows of the right dataset with an ordered list of
+// rules. LeftConfig and RightConfig hold the columns and filters of each side, Rules the match rules in order.
+// ResultDatasetId is the dataset the runs write their matches to, reconciliations created before it have none.
+type Reconciliation struct {
+	ID              uuid.UUID       `json:"reconciliation_id" gorm:"column:reconciliation_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId  uuid.UUID       `json:"organization_id" gorm:"column:organization_id"`
+	OwnerId         uuid.UUID       `json:"owner_id" gorm:"column:owner_id"`
+	Name            string          `json:"name" gorm:"column:name"`
+	Description     string          `json:"description" gorm:"column:description"`
+	LeftDatasetId   uuid.UUID       `json:"left_dataset_id" gorm:"column:left_dataset_id"`
+	RightDatasetId  uuid.UUID       `json:"right_dataset_id" gorm:"column:right_dataset_id"`
+	LeftConfig      json.RawMessage `json:"left_config" gorm:"column:left_config"`
+	RightConfig     json.RawMessage `json:"right_config" gorm:"column:right_config"`
+	Rules           json.RawMessage `json:"rules" gorm:"column:rules"`
+	ResultDatasetId *uuid.UUID      `json:"result_dataset_id" gorm:"column:result_dataset_id"`
+	CreatedAt       time.Time       `json:"created_at" gorm:"column:created_at"`
+	CreatedBy       uuid.UUID       `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt       time.Time       `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy       uuid.UUID       `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt       *time.Time      `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy       *uuid.UUID      `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateReconciliationParams struct {
+	OrganizationId  uuid.UUID
+	OwnerId         uuid.UUID
+	Name            string
+	Description     string
+	LeftDatasetId   uuid.UUID
+	RightDatasetId  uuid.UUID
+	LeftConfig      interface{}
+	RightConfig     interface{}
+	Rules           interface{}
+	ResultDatasetId *uuid.UUID
+}
+
+type UpdateReconciliationParams struct {
+	Name        string
+	Description string
+	LeftConfig  interface{}
+	RightConfig interface{}
+	Rules       interface{}
+	UpdatedBy   uuid.UUID
+}
+
+func (Reconciliation) TableName() string {
+	return "reconciliations"
+}
+
+func (r *Reconciliation) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where("reconciliations.organization_id IN ?", orgIds).Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = reconciliations.left_dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		) AND EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = reconciliations.right_dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId, userId,
+	)
+}
+
+// BeforeCreate requires read access on both datasets, runs read them with the access of the user starting the run
+func (r *Reconciliation) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	for _, datasetId := range []uuid.UUID{r.LeftDatasetId, r.RightDatasetId} {
+		fraps := []FlattenedResourceAudiencePolicy{}
+		err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND deleted_at IS NULL", ResourceTypeDataset, datasetId, userId).Limit(1).Find(&fraps).Error
+		if err != nil {
+			return err
+		}
+
+		if len(fraps) == 0 {
+			return fmt.Errorf("dataset access forbidden")
+		}
+	}
+
+	return nil
+}
+
+// BeforeUpdate lets the owner and the admins of either dataset change a reconciliation
+func (r *Reconciliation) BeforeUpdate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	if r.OwnerId == *userId {
+		return nil
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id IN ? AND user_id = ? AND privilege = ? AND deleted_at IS NULL", ResourceTypeDataset, []uuid.UUID{r.LeftDatasetId, r.RightDatasetId}, userId, PrivilegeDatasetAdmin).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("reconciliation access forbidden")
+	}
+
+	return nil
+}
+
+// ReconciliationRun is one execution of a reconciliation, the counts are filled in when the run completes
+type ReconciliationRun struct {
+	ID                  uuid.UUID  `json:"reconciliation_run_id" gorm:"column:reconciliation_run_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	ReconciliationId    uuid.UUID  `json:"reconciliation_id" gorm:"column:reconciliation_id"`
+	Status              string     `json:"status" gorm:"column:status"`
+	WorkflowId          string     `json:"workflow_id" gorm:"column:workflow_id"`
+	LeftRowCount        int        `json:"left_row_count" gorm:"column:left_row_count"`
+	RightRowCount       int        `json:"right_row_count" gorm:"column:right_row_count"`
+	MatchCount          int        `json:"match_count" gorm:"column:match_count"`
+	LeftExceptionCount  int        `json:"left_exception_count" gorm:"column:left_exception_count"`
+	RightExceptionCount int        `json:"right_exception_count" gorm:"column:right_exception_count"`
+	LeftSkippedCount    int        `json:"left_skipped_count" gorm:"column:left_skipped_count"`
+	RightSkippedCount   int        `json:"right_skipped_count" gorm:"column:right_skipped_count"`
+	Error               string     `json:"error" gorm:"column:error"`
+	StartedAt           time.Time  `json:"started_at" gorm:"column:started_at"`
+	CompletedAt         *time.Time `json:"completed_at" gorm:"column:completed_at"`
+	CreatedBy           uuid.UUID  `json:"created_by" gorm:"column:created_by"`
+}
+
+type CompleteReconciliationRunParams struct {
+	Status              string
+	LeftRowCount        int
+	RightRowCount       int
+	MatchCount          int
+	LeftExceptionCount  int
+	RightExceptionCount int
+	LeftSkippedCount    int
+	RightSkippedCount   int
+	Error               string
+}
+
+// ReconciliationRunCountDeltas are the changes a manual match or unmatch makes to the counts of a completed run
+type ReconciliationRunCountDeltas struct {
+	Matches         int
+	LeftExceptions  int
+	RightExceptions int
+	LeftSkipped     int
+	RightSkipped    int
+}
+
+func (ReconciliationRun) TableName() string {
+	return "reconciliation_runs"
+}
+
+func (r *ReconciliationRun) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."reconciliations" r
+			WHERE r.reconciliation_id = reconciliation_runs.reconciliation_id
+			AND r.organization_id IN ?
+		)`, orgIds,
+	)
+}
+
+// BeforeCreate requires read access on both datasets of the reconciliation since the run reads them as the creator
+func (r *ReconciliationRun) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	for _, column := range []string{"left_dataset_id", "right_dataset_id"} {
+		fraps := []FlattenedResourceAudiencePolicy{}
+		err := db.Where(
+			fmt.Sprintf(`resource_type = ? AND user_id = ? AND deleted_at IS NULL AND resource_id = (
+				SELECT %s FROM "app"."reconciliations" WHERE reconciliation_id = ?
+			)`, column), ResourceTypeDataset, userId, r.ReconciliationId,
+		).Limit(1).Find(&fraps).Error
+		if err != nil {
+			return err
+		}
+
+		if len(fraps) == 0 {
+			return fmt.Errorf("dataset access forbidden")
+		}
+	}
+
+	return nil
+}
+
+// ReconciliationRow is the part of a dataset row a reconciliation looks at, it is kept on matches and exceptions
+// so the rows can be reviewed and reopened without querying the datasets again
+type ReconciliationRow struct {
+	RowId     string     `json:"row_id"`
+	Amount    *float64   `json:"amount,omitempty"`
+	Date      *time.Time `json:"date,omitempty"`
+	Reference string     `json:"reference,omitempty"`
+}
+
+// ReconciliationMatch groups the left and right rows matched together by a run. Matches of the same run share
+// MatchId with nothing else, UNMATCHED matches are kept to stop later runs from pairing the rows again.
+type ReconciliationMatch struct {
+	ID                  uuid.UUID       `json:"reconciliation_match_id" gorm:"column:reconciliation_match_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	ReconciliationRunId uuid.UUID       `json:"reconciliation_run_id" gorm:"column:reconciliation_run_id"`
+	MatchId             uuid.UUID       `json:"match_id" gorm:"column:match_id"`
+	RuleName            string          `json:"rule_name" gorm:"column:rule_name"`
+	MatchType           string          `json:"match_type" gorm:"column:match_type"`
+	Status              string          `json:"status" gorm:"column:status"`
+	LeftRows            json.RawMessage `json:"left_rows" gorm:"column:left_rows"`
+	RightRows           json.RawMessage `json:"right_rows" gorm:"column:right_rows"`
+	LeftAmount          *float64        `json:"left_amount" gorm:"column:left_amount"`
+	RightAmount         *float64        `json:"right_amount" gorm:"column:right_amount"`
+	CreatedAt           time.Time       `json:"created_at" gorm:"column:created_at"`
+	CreatedBy           *uuid.UUID      `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt           time.Time       `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy           *uuid.UUID      `json:"updated_by" gorm:"column:updated_by"`
+}
+
+type CreateReconciliationMatchParams struct {
+	MatchId     uuid.UUID
+	RuleName    string
+	MatchType   string
+	Status      string
+	LeftRows    []ReconciliationRow
+	RightRows   []ReconciliationRow
+	LeftAmount  *float64
+	RightAmount *float64
+	CreatedBy   *uuid.UUID
+}
+
+type ReconciliationMatchFilters struct {
+	Statuses []string
+	Page     int
+	PageSize int
+}
+
+func (ReconciliationMatch) TableName() string {
+	return "reconciliation_matches"
+}
+
+func (m *ReconciliationMatch) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."reconciliation_runs" rr
+			JOIN "app"."reconciliations" r ON r.reconciliation_id = rr.reconciliation_id
+			WHERE rr.reconciliation_run_id = reconciliation_matches.reconciliation_run_id
+			AND r.organization_id IN ?
+		)`, orgIds,
+	)
+}
+
+// BeforeCreate only requires a user, matches are written in bulk by runs whose creation checked the dataset access
+func (m *ReconciliationMatch) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	return nil
+}
+
+// ReconciliationException is a row left unmatched by a run, it is resolved when the row is matched manually. Skipped
+// rows were not compared at all since a rule found more candidates for them than a run compares.
+type ReconciliationException struct {
+	ID                  uuid.UUID  `json:"reconciliation_exception_id" gorm:"column:reconciliation_exception_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	ReconciliationRunId uuid.UUID  `json:"reconciliation_run_id" gorm:"column:reconciliation_run_id"`
+	Side                string     `json:"side" gorm:"column:side"`
+	RowId               string     `json:"row_id" gorm:"column:row_id"`
+	Amount              *float64   `json:"amount" gorm:"column:amount"`
+	TransactionDate     *time.Time `json:"transaction_date" gorm:"column:transaction_date"`
+	Reference           string     `json:"reference" gorm:"column:reference"`
+	Status              string     `json:"status" gorm:"column:status"`
+	Skipped             bool       `json:"skipped" gorm:"column:skipped"`
+	MatchId             *uuid.UUID `json:"match_id" gorm:"column:match_id"`
+	CreatedAt           time.Time  `json:"created_at" gorm:"column:created_at"`
+	UpdatedAt           time.Time  `json:"updated_at" gorm:"column:updated_at"`
+}
+
+type ReconciliationExceptionFilters struct {
+	Sides    []string
+	Statuses []string
+	RowIds   []string
+	Page     int
+	PageSize int
+}
+
+func (ReconciliationException) TableName() string {
+	return "reconciliation_exceptions"
+}
+
+func (e *ReconciliationException) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."reconciliation_runs" rr
+			JOIN "app"."reconciliations" r ON r.reconciliation_id = rr.reconciliation_id
+			WHERE rr.reconciliation_run_id = reconciliation_exceptions.reconciliation_run_id
+			AND r.organization_id IN ?
+		)`, orgIds,
+	)
+}
+
+func (e *ReconciliationException) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconciliation_TableName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "reconciliations", Reconciliation{}.TableName())
+	assert.Equal(t, "reconciliation_runs", ReconciliationRun{}.TableName())
+	assert.Equal(t, "reconciliation_matches", ReconciliationMatch{}.TableName())
+	assert.Equal(t, "reconciliation_exceptions", ReconciliationException{}.TableName())
+}
+
+func TestStructImplementsBaseModel_Reconciliation(t *testing.T) {
+	var _ pgclient.BaseModel = &Reconciliation{}
+	var _ pgclient.BaseModel = &ReconciliationRun{}
+	var _ pgclient.BaseModel = &ReconciliationMatch{}
+	var _ pgclient.BaseModel = &ReconciliationException{}
+}
+
+func TestReconciliation_BeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	leftDatasetId := uuid.New()
+	rightDatasetId := uuid.New()
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)
+	frapColumns := []string{"resource_type", "resource_id", "user_id", "privilege"}
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock, userId uuid.UUID)
+		wantErr   bool
+	}{
+		{
+			name: "access on both datasets",
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(frapQuery).
+					WithArgs("dataset", leftDatasetId, userId, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns).AddRow("dataset", leftDatasetId, userId, "viewer"))
+				mock.ExpectQuery(frapQuery).
+					WithArgs("dataset", rightDatasetId, userId, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns).AddRow("dataset", rightDatasetId, userId, "viewer"))
+			},
+		},
+		{
+			name: "failure - no access on the right dataset",
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(frapQuery).
+					WithArgs("dataset", leftDatasetId, userId, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns).AddRow("dataset", leftDatasetId, userId, "viewer"))
+				mock.ExpectQuery(frapQuery).
+					WithArgs("dataset", rightDatasetId, userId, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			userId := uuid.New()
+			db = db.WithContext(apicontext.AddAuthToContext(context.Background(), "user", userId, []uuid.UUID{}))
+
+			reconciliation := &Reconciliation{
+				ID:             uuid.New(),
+				LeftDatasetId:  leftDatasetId,
+				RightDatasetId: rightDatasetId,
+			}
+
+			tt.setupMock(mock, userId)
+
+			err := reconciliation.BeforeCreate(db)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, "dataset access forbidden")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReconciliationRun_BeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	db, mock := setupTestDB(t)
+
+	userId := uuid.New()
+	reconciliationId := uuid.New()
+	db = db.WithContext(apicontext.AddAuthToContext(context.Background(), "user", userId, []uuid.UUID{}))
+
+	for _, column := range []string{"left_dataset_id", "right_dataset_id"} {
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND user_id = $2 AND deleted_at IS NULL AND resource_id = ( SELECT `+column+` FROM "app"."reconciliations" WHERE reconciliation_id = $3 ) LIMIT $4`)).
+			WithArgs("dataset", userId, reconciliationId, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+				AddRow("dataset", uuid.New(), userId, "viewer"))
+	}
+
+	run := &ReconciliationRun{ID: uuid.New(), ReconciliationId: reconciliationId}
+
+	assert.NoError(t, run.BeforeCreate(db))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
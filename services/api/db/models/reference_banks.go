@@ -0,0 +1,71 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ReferenceBank is a bank an organization added to the shared bank directory, datasets of the organization can then
+// hold its Code in bank columns
+type ReferenceBank struct {
+	ID             uuid.UUID  `json:"reference_bank_id" gorm:"column:reference_bank_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId uuid.UUID  `json:"organization_id" gorm:"column:organization_id"`
+	Code           string     `json:"code" gorm:"column:code"`
+	Name           string     `json:"name" gorm:"column:name"`
+	Country        *string    `json:"country" gorm:"column:country"`
+	BIC            *string    `json:"bic" gorm:"column:bic"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"column:created_at"`
+	CreatedBy      uuid.UUID  `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt      time.Time  `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy      uuid.UUID  `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt      *time.Time `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy      *uuid.UUID `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateReferenceBankParams struct {
+	OrganizationId uuid.UUID
+	Code           string
+	Name           string
+	Country        *string
+	BIC            *string
+	CreatedBy      uuid.UUID
+}
+
+func (ReferenceBank) TableName() string {
+	return "reference_banks"
+}
+
+func (b *ReferenceBank) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where("reference_banks.organization_id IN ?", orgIds).Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'organization'
+			AND frap.resource_id = reference_banks.organization_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+func (b *ReferenceBank) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND deleted_at IS NULL", ResourceTypeOrganization, b.OrganizationId, userId).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("organization access forbidden")
+	}
+
+	return nil
+}
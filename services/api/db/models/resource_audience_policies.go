@@ -30,6 +30,8 @@ const (
 	PrivilegePaymentsAdmin           ResourcePrivilege = "admin"
 	PrivilegePaymentsViewer          ResourcePrivilege = "viewer"
 	PrivilegePaymentsInitiator       ResourcePrivilege = "initiator"
+	PrivilegeDatasetViewAdmin        ResourcePrivilege = "admin"
+	PrivilegeDatasetViewViewer       ResourcePrivilege = "viewer"
 )
 
 const (
@@ -39,6 +41,7 @@ const (
 	ResourceTypeConnection   ResourceType = "connection"
 	ResourceTypeSchedule     ResourceType = "schedule"
 	ResourceTypePayments     ResourceType = "payments"
+	ResourceTypeDatasetView  ResourceType = "dataset_view"
 )
 
 var OrganizationPrivileges = []ResourcePrivilege{PrivilegeOrganizationMember, PrivilegeOrganizationSystemAdmin}
@@ -47,6 +50,7 @@ var PagePrivileges = []ResourcePrivilege{PrivilegePageAdmin, PrivilegePageRead}
 var ConnectionPrivileges = []ResourcePrivilege{PrivilegeConnectionAdmin, PrivilegeConnectionRead}
 var SchedulePrivileges = []ResourcePrivilege{PrivilegeScheduleAdmin, PrivilegeScheduleRead}
 var PaymentsPrivileges = []ResourcePrivilege{PrivilegePaymentsAdmin, PrivilegePaymentsViewer, PrivilegePaymentsInitiator}
+var DatasetViewPrivileges = []ResourcePrivilege{PrivilegeDatasetViewAdmin, PrivilegeDatasetViewViewer}
 
 const (
 	AudienceTypeUser         AudienceType = "user"
@@ -97,7 +101,7 @@ func (r *ResourceAudiencePolicy) BeforeUpdate(db *gorm.DB) error {
 
 	fraps := []FlattenedResourceAudiencePolicy{}
 	err := db.Where(`
-		(resource_id = ? ANd resource_type = ? AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = ? AND deleted_at IS NULL)
+		(resource_id = ? ANd resource_type = ? AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = ? AND deleted_at IS NULL)
 	`, r.ResourceID, r.ResourceType, userId).Limit(1).Find(&fraps).Error
 
 	if err != nil {
@@ -117,7 +121,7 @@ func (r *ResourceAudiencePolicy) BeforeDelete(db *gorm.DB) error {
 
 	fraps := []FlattenedResourceAudiencePolicy{}
 	err := db.Where(`
-		(resource_id = ? ANd resource_type = ? AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = ? AND deleted_at IS NULL)
+		(resource_id = ? ANd resource_type = ? AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = ? AND deleted_at IS NULL)
 	`, r.ResourceID, r.ResourceType, userId).Limit(1).Find(&fraps).Error
 
 	if err != nil {
@@ -126,7 +126,7 @@ func TestBeforeUpdate_RAP(t *testing.T) {
 	}
 
 	// successful query execution
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 		WithArgs(rap.ResourceID, rap.ResourceType, userId, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"resource_id"}).AddRow(rap.ResourceID))
 
@@ -135,7 +135,7 @@ func TestBeforeUpdate_RAP(t *testing.T) {
 	assert.Nil(t, err)
 
 	// no frap access
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 		WithArgs(rap.ResourceID, rap.ResourceType, rap.ResourceID).
 		WillReturnRows(sqlmock.NewRows([]string{"resource_id"}))
 
@@ -145,7 +145,7 @@ func TestBeforeUpdate_RAP(t *testing.T) {
 
 	// db error
 	err = nil
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 		WillReturnError(gorm.ErrInvalidDB)
 	err = rap.BeforeUpdate(db.WithContext(ctx))
 	assert.NotNil(t, err)
@@ -165,7 +165,7 @@ func TestBeforeDelete_RAP(t *testing.T) {
 	}
 
 	// successful query execution
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 		WithArgs(rap.ResourceID, rap.ResourceType, userId, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"resource_id"}).AddRow(rap.ResourceID))
 
@@ -174,7 +174,7 @@ func TestBeforeDelete_RAP(t *testing.T) {
 	assert.Nil(t, err)
 
 	// no frap access
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 		WithArgs(rap.ResourceID, rap.ResourceType, rap.ResourceID).
 		WillReturnRows(sqlmock.NewRows([]string{"resource_id"}))
 
@@ -184,7 +184,7 @@ func TestBeforeDelete_RAP(t *testing.T) {
 
 	// db error
 	err = nil
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 		WillReturnError(gorm.ErrInvalidDB)
 	err = rap.BeforeDelete(db.WithContext(ctx))
 	assert.NotNil(t, err)
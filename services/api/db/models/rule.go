@@ -17,9 +17,11 @@ type Rule struct {
 	Column         string          `gorm:"column:column"`
 	Value          string          `gorm:"column:value"`
 	FilterConfig   json.RawMessage `gorm:"column:filter_config"`
+	Assignments    json.RawMessage `gorm:"column:assignments"`
 	Title          string          `gorm:"column:title"`
 	Description    string          `gorm:"column:description"`
 	Priority       int             `gorm:"column:priority;unique"`
+	IsEnabled      bool            `gorm:"column:is_enabled"`
 	CreatedAt      time.Time       `gorm:"column:created_at"`
 	CreatedBy      uuid.UUID       `gorm:"column:created_by"`
 	UpdatedAt      time.Time       `gorm:"column:updated_at"`
@@ -37,6 +39,7 @@ type CreateRuleParams struct {
 	Column         string
 	Value          string
 	FilterConfig   interface{}
+	Assignments    interface{}
 	CreatedBy      uuid.UUID
 }
 
@@ -45,12 +48,16 @@ type UpdateRuleParams struct {
 	Description  string
 	Value        string
 	FilterConfig interface{}
+	Assignments  interface{}
 	UpdatedBy    uuid.UUID
 }
 
+// FilterRuleParams selects the rules of dataset columns. With IncludeAssignments the rules of other columns assigning
+// one of the columns are listed under that column too, after the rules of the column itself.
 type FilterRuleParams struct {
-	OrganizationId uuid.UUID
-	DatasetColumns []DatasetColumn
+	OrganizationId     uuid.UUID
+	DatasetColumns     []DatasetColumn
+	IncludeAssignments bool
 }
 
 type DatasetColumn struct {
@@ -0,0 +1,133 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RuleChangeType string
+
+const (
+	RuleChangeTypeCreated  RuleChangeType = "created"
+	RuleChangeTypeUpdated  RuleChangeType = "updated"
+	RuleChangeTypeEnabled  RuleChangeType = "enabled"
+	RuleChangeTypeDisabled RuleChangeType = "disabled"
+	RuleChangeTypeDeleted  RuleChangeType = "deleted"
+	RuleChangeTypeRestored RuleChangeType = "restored"
+)
+
+// RuleVersion is a snapshot of a rule taken after each change to it
+type RuleVersion struct {
+	ID           uuid.UUID       `gorm:"column:rule_version_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	RuleId       uuid.UUID       `gorm:"column:rule_id"`
+	DatasetId    uuid.UUID       `gorm:"column:dataset_id"`
+	Version      int             `gorm:"column:version"`
+	ChangeType   RuleChangeType  `gorm:"column:change_type"`
+	Title        string          `gorm:"column:title"`
+	Description  string          `gorm:"column:description"`
+	Value        string          `gorm:"column:value"`
+	FilterConfig json.RawMessage `gorm:"column:filter_config"`
+	Assignments  json.RawMessage `gorm:"column:assignments"`
+	IsEnabled    bool            `gorm:"column:is_enabled"`
+	CreatedAt    time.Time       `gorm:"column:created_at"`
+	CreatedBy    uuid.UUID       `gorm:"column:created_by"`
+}
+
+// RuleRun records the rows a rule matched when the rules of its column were applied
+type RuleRun struct {
+	ID          uuid.UUID `gorm:"column:rule_run_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	RuleId      uuid.UUID `gorm:"column:rule_id"`
+	DatasetId   uuid.UUID `gorm:"column:dataset_id"`
+	ActionId    string    `gorm:"column:action_id"`
+	MatchedRows int64     `gorm:"column:matched_rows"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
+
+type CreateRuleRunParams struct {
+	RuleId      uuid.UUID
+	DatasetId   uuid.UUID
+	ActionId    string
+	MatchedRows int64
+}
+
+func (RuleVersion) TableName() string {
+	return "rule_versions"
+}
+
+func (v *RuleVersion) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = rule_versions.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+func (v *RuleVersion) BeforeCreate(db *gorm.DB) error {
+	return ensureRuleDatasetAdmin(db, v.DatasetId)
+}
+
+func (v *RuleVersion) BeforeUpdate(db *gorm.DB) error {
+	return fmt.Errorf("forbidden: rule versions cannot be updated")
+}
+
+func (v *RuleVersion) BeforeDelete(db *gorm.DB) error {
+	return errors.New("forbidden: rule versions cannot be deleted")
+}
+
+func (RuleRun) TableName() string {
+	return "rule_runs"
+}
+
+func (r *RuleRun) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'dataset'
+			AND frap.resource_id = rule_runs.dataset_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+func (r *RuleRun) BeforeCreate(db *gorm.DB) error {
+	return ensureRuleDatasetAdmin(db, r.DatasetId)
+}
+
+func (r *RuleRun) BeforeUpdate(db *gorm.DB) error {
+	return fmt.Errorf("forbidden: rule runs cannot be updated")
+}
+
+func (r *RuleRun) BeforeDelete(db *gorm.DB) error {
+	return errors.New("forbidden: rule runs cannot be deleted")
+}
+
+// ensureRuleDatasetAdmin allows the history of rules to be written by the admins of their dataset only
+func ensureRuleDatasetAdmin(db *gorm.DB, datasetId uuid.UUID) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND privilege = ? AND deleted_at IS NULL", ResourceTypeDataset, datasetId, userId, PrivilegeDatasetAdmin).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("dataset access forbidden")
+	}
+
+	return nil
+}
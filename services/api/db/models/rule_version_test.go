@@ -0,0 +1,112 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleVersion_TableName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "rule_versions", RuleVersion{}.TableName())
+	assert.Equal(t, "rule_runs", RuleRun{}.TableName())
+}
+
+func TestStructImplementsBaseModel_RuleVersion(t *testing.T) {
+	var _ pgclient.BaseModel = &RuleVersion{}
+	var _ pgclient.BaseModel = &RuleRun{}
+}
+
+func TestRuleVersion_GetQueryFilters(t *testing.T) {
+	t.Parallel()
+
+	userId := uuid.New()
+	db, mock := setupTestDB(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "rule_versions" WHERE EXISTS ( SELECT 1 FROM "app"."flattened_resource_audience_policies" frap WHERE frap.resource_type = 'dataset' AND frap.resource_id = rule_versions.dataset_id AND frap.user_id = $1 AND frap.deleted_at IS NULL )`)).
+		WithArgs(userId).
+		WillReturnRows(sqlmock.NewRows([]string{"rule_version_id", "dataset_id"}).AddRow(uuid.New(), uuid.New()))
+
+	version := &RuleVersion{}
+	var results []RuleVersion
+	err := version.GetQueryFilters(db.Model(version), userId, nil).Find(&results).Error
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRuleVersion_BeforeCreate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		hasUser   bool
+		setupMock func(mock sqlmock.Sqlmock, userId uuid.UUID)
+		errMsg    string
+	}{
+		{
+			name:    "dataset admin",
+			hasUser: true,
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(ResourceTypeDataset, sqlmock.AnyArg(), userId, PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", uuid.New(), userId, "admin"))
+			},
+		},
+		{
+			name:      "no user in context",
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {},
+			errMsg:    "no user id found in context",
+		},
+		{
+			name:    "not a dataset admin",
+			hasUser: true,
+			setupMock: func(mock sqlmock.Sqlmock, userId uuid.UUID) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(ResourceTypeDataset, sqlmock.AnyArg(), userId, PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+			},
+			errMsg: "dataset access forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db, mock := setupTestDB(t)
+
+			userId := uuid.New()
+			ctx := context.Background()
+			if tt.hasUser {
+				ctx = apicontext.AddAuthToContext(ctx, "role", userId, []uuid.UUID{})
+			}
+			tt.setupMock(mock, userId)
+
+			err := (&RuleVersion{DatasetId: uuid.New()}).BeforeCreate(db.WithContext(ctx))
+
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRuleVersion_Immutable(t *testing.T) {
+	t.Parallel()
+
+	assert.EqualError(t, (&RuleVersion{}).BeforeUpdate(nil), "forbidden: rule versions cannot be updated")
+	assert.EqualError(t, (&RuleVersion{}).BeforeDelete(nil), "forbidden: rule versions cannot be deleted")
+	assert.EqualError(t, (&RuleRun{}).BeforeUpdate(nil), "forbidden: rule runs cannot be updated")
+	assert.EqualError(t, (&RuleRun{}).BeforeDelete(nil), "forbidden: rule runs cannot be deleted")
+}
@@ -54,7 +54,7 @@ func (s *Sheet) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUI
 			AND frap.resource_id = sheets.page_id
 			AND frap.user_id = ?
 			AND frap.deleted_at IS NULL
-		)`, userId,
+		) AND sheets.deleted_at IS NULL`, userId,
 	)
 }
 
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Tag is a tag of the taxonomy of an organization. Path is the dotted path of the tag from the root of the taxonomy,
+// the value tags columns of datasets hold, and Aliases a json array of other spellings resolving to the tag.
+type Tag struct {
+	ID             uuid.UUID       `json:"tag_id" gorm:"column:tag_id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationId uuid.UUID       `json:"organization_id" gorm:"column:organization_id"`
+	ParentId       *uuid.UUID      `json:"parent_id" gorm:"column:parent_id"`
+	Name           string          `json:"name" gorm:"column:name"`
+	Path           string          `json:"path" gorm:"column:path"`
+	Color          *string         `json:"color" gorm:"column:color"`
+	Description    *string         `json:"description" gorm:"column:description"`
+	Aliases        json.RawMessage `json:"aliases" gorm:"column:aliases"`
+	CreatedAt      time.Time       `json:"created_at" gorm:"column:created_at"`
+	CreatedBy      uuid.UUID       `json:"created_by" gorm:"column:created_by"`
+	UpdatedAt      time.Time       `json:"updated_at" gorm:"column:updated_at"`
+	UpdatedBy      uuid.UUID       `json:"updated_by" gorm:"column:updated_by"`
+	DeletedAt      *time.Time      `json:"deleted_at" gorm:"column:deleted_at"`
+	DeletedBy      *uuid.UUID      `json:"deleted_by" gorm:"column:deleted_by"`
+}
+
+type CreateTagParams struct {
+	OrganizationId uuid.UUID
+	ParentId       *uuid.UUID
+	Name           string
+	Path           string
+	Color          *string
+	Description    *string
+	Aliases        []string
+	CreatedBy      uuid.UUID
+}
+
+type UpdateTagParams struct {
+	Color       *string
+	Description *string
+	Aliases     []string
+	UpdatedBy   uuid.UUID
+}
+
+func (Tag) TableName() string {
+	return "tags"
+}
+
+func (t *Tag) GetQueryFilters(db *gorm.DB, userId uuid.UUID, orgIds []uuid.UUID) *gorm.DB {
+	return db.Where("tags.organization_id IN ?", orgIds).Where(
+		`EXISTS (
+			SELECT 1 FROM "app"."flattened_resource_audience_policies" frap
+			WHERE frap.resource_type = 'organization'
+			AND frap.resource_id = tags.organization_id
+			AND frap.user_id = ?
+			AND frap.deleted_at IS NULL
+		)`, userId,
+	)
+}
+
+func (t *Tag) BeforeCreate(db *gorm.DB) error {
+	_, userId, _ := apicontext.GetAuthFromContext(db.Statement.Context)
+	if userId == nil {
+		return fmt.Errorf("no user id found in context")
+	}
+
+	fraps := []FlattenedResourceAudiencePolicy{}
+	err := db.Where("resource_type = ? AND resource_id = ? AND user_id = ? AND deleted_at IS NULL", ResourceTypeOrganization, t.OrganizationId, userId).Limit(1).Find(&fraps).Error
+	if err != nil {
+		return err
+	}
+
+	if len(fraps) == 0 {
+		return fmt.Errorf("organization access forbidden")
+	}
+
+	return nil
+}
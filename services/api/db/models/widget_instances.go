@@ -35,7 +35,7 @@ func (WidgetInstance) GetQueryFilters(db *gorm.DB, userId uuid.UUID, organizatio
 			AND sheets.sheet_id = widget_instances.sheet_id
 			AND frap.user_id = ?
 			AND frap.deleted_at IS NULL
-		)`, userId.String(),
+		) AND widget_instances.deleted_at IS NULL`, userId.String(),
 	)
 
 }
@@ -0,0 +1,253 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DatasetAlertStore interface {
+	CreateDatasetAlert(ctx context.Context, params models.CreateDatasetAlertParams) (models.DatasetAlert, error)
+	GetDatasetAlertById(ctx context.Context, alertId uuid.UUID) (models.DatasetAlert, error)
+	GetDatasetAlerts(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetAlert, error)
+	UpdateDatasetAlert(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertParams) (models.DatasetAlert, error)
+	UpdateDatasetAlertState(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertStateParams) error
+	UpdateDatasetAlertSnooze(ctx context.Context, alertId uuid.UUID, snoozedUntil *time.Time, updatedBy uuid.UUID) (models.DatasetAlert, error)
+	DeleteDatasetAlert(ctx context.Context, alertId uuid.UUID, deletedBy uuid.UUID) error
+	CreateDatasetAlertEvent(ctx context.Context, params models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error)
+	GetDatasetAlertEvents(ctx context.Context, alertId uuid.UUID, limit int) ([]models.DatasetAlertEvent, error)
+	MarkDatasetAlertEventNotified(ctx context.Context, eventId uuid.UUID) (bool, error)
+	WithDatasetAlertTransaction(ctx context.Context, fn func(DatasetAlertStore) error) error
+}
+
+func (s *appStore) CreateDatasetAlert(ctx context.Context, params models.CreateDatasetAlertParams) (models.DatasetAlert, error) {
+	alert := models.DatasetAlert{
+		ID:                 uuid.New(),
+		OrganizationId:     params.OrganizationId,
+		DatasetId:          params.DatasetId,
+		OwnerId:            params.OwnerId,
+		Name:               params.Name,
+		CronExpression:     params.CronExpression,
+		Timezone:           params.Timezone,
+		WebhookUrl:         params.WebhookUrl,
+		TemporalScheduleId: params.TemporalScheduleId,
+		IsEnabled:          true,
+		State:              models.DatasetAlertStateOk,
+		CreatedAt:          time.Now(),
+		CreatedBy:          params.OwnerId,
+		UpdatedAt:          time.Now(),
+		UpdatedBy:          params.OwnerId,
+	}
+
+	var err error
+	if alert.Metric, err = json.Marshal(params.Metric); err != nil {
+		return models.DatasetAlert{}, err
+	}
+	if alert.Condition, err = json.Marshal(params.Condition); err != nil {
+		return models.DatasetAlert{}, err
+	}
+	if alert.Recipients, err = json.Marshal(params.Recipients); err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	if err := s.client.WithContext(ctx).Create(&alert).Error; err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	return alert, nil
+}
+
+func (s *appStore) GetDatasetAlertById(ctx context.Context, alertId uuid.UUID) (models.DatasetAlert, error) {
+	alert := models.DatasetAlert{}
+	err := s.client.WithContext(ctx).
+		Where("dataset_alert_id = ?", alertId).
+		Where("deleted_at IS NULL").
+		First(&alert).Error
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	return alert, nil
+}
+
+func (s *appStore) GetDatasetAlerts(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetAlert, error) {
+	var alerts []models.DatasetAlert
+	err := s.client.WithContext(ctx).
+		Where("dataset_id = ?", datasetId).
+		Where("deleted_at IS NULL").
+		Order("created_at desc").
+		Find(&alerts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+// UpdateDatasetAlert replaces the definition of an alert, its state is only changed by evaluations
+func (s *appStore) UpdateDatasetAlert(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertParams) (models.DatasetAlert, error) {
+	alert, err := s.GetDatasetAlertById(ctx, alertId)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	metric, err := json.Marshal(params.Metric)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+	condition, err := json.Marshal(params.Condition)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+	recipients, err := json.Marshal(params.Recipients)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(&alert).Where("dataset_alert_id = ?", alertId).Updates(map[string]interface{}{
+		"name":                 params.Name,
+		"metric":               metric,
+		"condition":            condition,
+		"cron_expression":      params.CronExpression,
+		"timezone":             params.Timezone,
+		"recipients":           recipients,
+		"webhook_url":          params.WebhookUrl,
+		"temporal_schedule_id": params.TemporalScheduleId,
+		"is_enabled":           params.IsEnabled,
+		"updated_by":           params.UpdatedBy,
+		"updated_at":           now,
+	}).Error
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	alert.Name = params.Name
+	alert.Metric = metric
+	alert.Condition = condition
+	alert.CronExpression = params.CronExpression
+	alert.Timezone = params.Timezone
+	alert.Recipients = recipients
+	alert.WebhookUrl = params.WebhookUrl
+	alert.TemporalScheduleId = params.TemporalScheduleId
+	alert.IsEnabled = params.IsEnabled
+	alert.UpdatedBy = params.UpdatedBy
+	alert.UpdatedAt = now
+
+	return alert, nil
+}
+
+func (s *appStore) UpdateDatasetAlertState(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertStateParams) error {
+	alert, err := s.GetDatasetAlertById(ctx, alertId)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"state":             params.State,
+		"last_evaluated_at": params.LastEvaluatedAt,
+		"last_error":        params.LastError,
+	}
+	if params.LastValue != nil {
+		updates["last_value"] = *params.LastValue
+	}
+
+	return s.client.WithContext(ctx).Model(&alert).Where("dataset_alert_id = ?", alertId).Updates(updates).Error
+}
+
+// UpdateDatasetAlertSnooze sets the time until which notifications of an alert are muted, nil unsnoozes it
+func (s *appStore) UpdateDatasetAlertSnooze(ctx context.Context, alertId uuid.UUID, snoozedUntil *time.Time, updatedBy uuid.UUID) (models.DatasetAlert, error) {
+	alert, err := s.GetDatasetAlertById(ctx, alertId)
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(&alert).Where("dataset_alert_id = ?", alertId).Updates(map[string]interface{}{
+		"snoozed_until": snoozedUntil,
+		"updated_by":    updatedBy,
+		"updated_at":    now,
+	}).Error
+	if err != nil {
+		return models.DatasetAlert{}, err
+	}
+
+	alert.SnoozedUntil = snoozedUntil
+	alert.UpdatedBy = updatedBy
+	alert.UpdatedAt = now
+
+	return alert, nil
+}
+
+func (s *appStore) DeleteDatasetAlert(ctx context.Context, alertId uuid.UUID, deletedBy uuid.UUID) error {
+	alert, err := s.GetDatasetAlertById(ctx, alertId)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Model(&alert).Where("dataset_alert_id = ?", alertId).Updates(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"deleted_by": deletedBy,
+	}).Error
+}
+
+func (s *appStore) CreateDatasetAlertEvent(ctx context.Context, params models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error) {
+	event := models.DatasetAlertEvent{
+		ID:              uuid.New(),
+		DatasetAlertId:  params.DatasetAlertId,
+		Status:          params.Status,
+		Value:           params.Value,
+		ComparisonValue: params.ComparisonValue,
+		Score:           params.Score,
+		Message:         params.Message,
+		Notified:        params.Notified,
+		CreatedAt:       time.Now(),
+	}
+
+	if err := s.client.WithContext(ctx).Create(&event).Error; err != nil {
+		return models.DatasetAlertEvent{}, err
+	}
+
+	return event, nil
+}
+
+// GetDatasetAlertEvents returns the latest events of an alert first
+func (s *appStore) GetDatasetAlertEvents(ctx context.Context, alertId uuid.UUID, limit int) ([]models.DatasetAlertEvent, error) {
+	var events []models.DatasetAlertEvent
+	err := s.client.WithContext(ctx).
+		Where("dataset_alert_id = ?", alertId).
+		Order("created_at desc").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// MarkDatasetAlertEventNotified marks an event notified, it returns false when the event already was so only one
+// evaluation notifies it
+func (s *appStore) MarkDatasetAlertEventNotified(ctx context.Context, eventId uuid.UUID) (bool, error) {
+	result := s.client.WithContext(ctx).
+		Model(&models.DatasetAlertEvent{}).
+		Where("dataset_alert_event_id = ? AND notified = ?", eventId, false).
+		Update("notified", true)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (s *appStore) WithDatasetAlertTransaction(ctx context.Context, fn func(DatasetAlertStore) error) error {
+	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txClient := pgclient.PostgresClient{DB: tx}
+		return fn(&appStore{client: &txClient})
+	})
+}
@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateDatasetAlert(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+
+	params := models.CreateDatasetAlertParams{
+		OrganizationId:     orgID,
+		DatasetId:          datasetID,
+		OwnerId:            userID,
+		Name:               "Unreconciled amount",
+		Metric:             map[string]interface{}{"column": "amount", "function": "SUM"},
+		Condition:          map[string]interface{}{"type": "threshold", "operator": "gt", "value": 50000},
+		CronExpression:     "0 * * * *",
+		Timezone:           "UTC",
+		Recipients:         []string{"finance@example.com"},
+		TemporalScheduleId: "dataset-alert-1",
+	}
+
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", datasetID, userID, "viewer"))
+				mock.ExpectQuery(`INSERT INTO "dataset_alerts"`).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_alert_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "no access to the dataset",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			alert, err := store.CreateDatasetAlert(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Unreconciled amount", alert.Name)
+				assert.Equal(t, models.DatasetAlertStateOk, alert.State)
+				assert.True(t, alert.IsEnabled)
+				assert.JSONEq(t, `["finance@example.com"]`, string(alert.Recipients))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetDatasetAlertEvents(t *testing.T) {
+	t.Parallel()
+
+	alertID := uuid.New()
+
+	expectedQuery := regexp.QuoteMeta(`SELECT * FROM "dataset_alert_events" WHERE dataset_alert_id = $1 ORDER BY created_at desc LIMIT $2`)
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "latest events first",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(alertID, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_alert_event_id", "dataset_alert_id", "status", "value", "created_at"}).
+						AddRow(uuid.New(), alertID, "RESOLVED", 120.5, time.Now()).
+						AddRow(uuid.New(), alertID, "FIRING", 62000, time.Now().Add(-time.Hour)))
+			},
+			wantLen: 2,
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			events, err := store.GetDatasetAlertEvents(context.Background(), alertID, 2)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, events, tt.wantLen)
+				assert.Equal(t, "RESOLVED", events[0].Status)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type DatasetColumnPolicyStore interface {
+	CreateDatasetColumnPolicy(ctx context.Context, params models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)
+	GetDatasetColumnPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetColumnPolicy, error)
+	GetDatasetColumnPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetColumnPolicy, error)
+	GetDatasetColumnPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetColumnPolicy, error)
+	UpdateDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)
+	DeleteDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error
+}
+
+func (s *appStore) CreateDatasetColumnPolicy(ctx context.Context, params models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	policy := models.DatasetColumnPolicy{
+		ID:                   uuid.New(),
+		OrganizationId:       params.OrganizationId,
+		DatasetId:            params.DatasetId,
+		ResourceAudienceType: params.ResourceAudienceType,
+		ResourceAudienceId:   params.ResourceAudienceId,
+		Column:               params.Column,
+		Action:               params.Action,
+		MaskType:             params.MaskType,
+		CreatedAt:            time.Now(),
+		CreatedBy:            params.CreatedBy,
+		UpdatedAt:            time.Now(),
+		UpdatedBy:            params.CreatedBy,
+	}
+
+	if err := s.client.WithContext(ctx).Create(&policy).Error; err != nil {
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (s *appStore) GetDatasetColumnPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetColumnPolicy, error) {
+	policy := models.DatasetColumnPolicy{}
+	err := s.client.WithContext(ctx).
+		Where("dataset_column_policy_id = ?", policyId).
+		Where("deleted_at IS NULL").
+		First(&policy).Error
+	if err != nil {
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (s *appStore) GetDatasetColumnPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetColumnPolicy, error) {
+	var policies []models.DatasetColumnPolicy
+	err := s.client.WithContext(ctx).
+		Where("dataset_id = ?", datasetId).
+		Where("deleted_at IS NULL").
+		Order("created_at asc").
+		Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// GetDatasetColumnPoliciesForUser returns the column policies of a dataset whose audience includes the user,
+// either directly, through one of the user's teams or through one of the user's organizations.
+func (s *appStore) GetDatasetColumnPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetColumnPolicy, error) {
+	var policies []models.DatasetColumnPolicy
+	err := s.client.WithContext(ctx).
+		Where("dataset_id = ?", datasetId).
+		Where("deleted_at IS NULL").
+		Where(
+			`(resource_audience_type = ? AND resource_audience_id = ?)
+			OR (resource_audience_type = ? AND resource_audience_id IN (
+				SELECT tm.team_id FROM "app"."team_memberships" tm WHERE tm.user_id = ? AND tm.deleted_at IS NULL
+			))
+			OR (resource_audience_type = ? AND resource_audience_id IN (
+				SELECT ofrap.resource_id FROM "app"."flattened_resource_audience_policies" ofrap
+				WHERE ofrap.resource_type = ? AND ofrap.user_id = ? AND ofrap.deleted_at IS NULL
+			))`,
+			models.AudienceTypeUser, userId,
+			models.AudienceTypeTeam, userId,
+			models.AudienceTypeOrganization, models.ResourceTypeOrganization, userId,
+		).
+		Order("created_at asc").
+		Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (s *appStore) UpdateDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	policy, err := s.GetDatasetColumnPolicyById(ctx, policyId)
+	if err != nil {
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(&policy).Where("dataset_column_policy_id = ?", policyId).Updates(map[string]interface{}{
+		"action":     params.Action,
+		"mask_type":  params.MaskType,
+		"updated_by": params.UpdatedBy,
+		"updated_at": now,
+	}).Error
+	if err != nil {
+		return models.DatasetColumnPolicy{}, err
+	}
+
+	policy.Action = params.Action
+	policy.MaskType = params.MaskType
+	policy.UpdatedBy = params.UpdatedBy
+	policy.UpdatedAt = now
+
+	return policy, nil
+}
+
+func (s *appStore) DeleteDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error {
+	policy, err := s.GetDatasetColumnPolicyById(ctx, policyId)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Model(&policy).Where("dataset_column_policy_id = ?", policyId).Updates(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"deleted_by": deletedBy,
+	}).Error
+}
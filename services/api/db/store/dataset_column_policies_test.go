@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateDatasetColumnPolicy(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+	maskType := models.ColumnMaskTypeLast4
+
+	params := models.CreateDatasetColumnPolicyParams{
+		OrganizationId:       orgID,
+		DatasetId:            datasetID,
+		ResourceAudienceType: models.AudienceTypeTeam,
+		ResourceAudienceId:   uuid.New(),
+		Column:               "account_number",
+		Action:               models.ColumnPolicyActionMask,
+		MaskType:             &maskType,
+		CreatedBy:            userID,
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, models.PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", datasetID, userID, "admin"))
+				mock.ExpectQuery(`INSERT INTO "dataset_column_policies"`).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_column_policy_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "not a dataset admin",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, models.PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			policy, err := store.CreateDatasetColumnPolicy(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "account_number", policy.Column)
+				assert.Equal(t, models.ColumnPolicyActionMask, policy.Action)
+				assert.Equal(t, &maskType, policy.MaskType)
+				assert.Equal(t, userID, policy.UpdatedBy)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetDatasetColumnPoliciesForUser(t *testing.T) {
+	t.Parallel()
+
+	datasetID := uuid.New()
+	userID := uuid.New()
+	policyID := uuid.New()
+
+	expectedQuery := regexp.QuoteMeta(`SELECT * FROM "dataset_column_policies" WHERE dataset_id = $1 AND deleted_at IS NULL AND ((resource_audience_type = $2 AND resource_audience_id = $3)`)
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(datasetID, models.AudienceTypeUser, userID, models.AudienceTypeTeam, userID, models.AudienceTypeOrganization, models.ResourceTypeOrganization, userID).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_column_policy_id", "dataset_id", "column_name", "action"}).
+						AddRow(policyID, datasetID, "account_number", "hide"))
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			policies, err := store.GetDatasetColumnPoliciesForUser(context.Background(), datasetID, userID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, policies, 1)
+				assert.Equal(t, policyID, policies[0].ID)
+				assert.Equal(t, models.ColumnPolicyActionHide, policies[0].Action)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
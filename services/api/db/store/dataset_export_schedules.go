@@ -0,0 +1,195 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DatasetExportScheduleStore interface {
+	CreateDatasetExportSchedule(ctx context.Context, params models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)
+	GetDatasetExportScheduleById(ctx context.Context, scheduleId uuid.UUID) (models.DatasetExportSchedule, error)
+	GetDatasetExportSchedules(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetExportSchedule, error)
+	UpdateDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, params models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)
+	DeleteDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, deletedBy uuid.UUID) error
+	CreateDatasetExportScheduleRun(ctx context.Context, params models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error)
+	UpdateDatasetExportScheduleRun(ctx context.Context, runId uuid.UUID, params models.UpdateDatasetExportScheduleRunParams) error
+	GetDatasetExportScheduleRuns(ctx context.Context, scheduleId uuid.UUID, limit int) ([]models.DatasetExportScheduleRun, error)
+	WithDatasetExportScheduleTransaction(ctx context.Context, fn func(DatasetExportScheduleStore) error) error
+}
+
+func (s *appStore) CreateDatasetExportSchedule(ctx context.Context, params models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	schedule := models.DatasetExportSchedule{
+		ID:                 uuid.New(),
+		OrganizationId:     params.OrganizationId,
+		DatasetId:          params.DatasetId,
+		OwnerId:            params.OwnerId,
+		Name:               params.Name,
+		Format:             params.Format,
+		CronExpression:     params.CronExpression,
+		Timezone:           params.Timezone,
+		DestinationType:    params.DestinationType,
+		TemporalScheduleId: params.TemporalScheduleId,
+		IsEnabled:          true,
+		CreatedAt:          time.Now(),
+		CreatedBy:          params.OwnerId,
+		UpdatedAt:          time.Now(),
+		UpdatedBy:          params.OwnerId,
+	}
+
+	var err error
+	if schedule.QueryConfig, err = json.Marshal(params.QueryConfig); err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+	if schedule.DestinationConfig, err = json.Marshal(params.DestinationConfig); err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	if err := s.client.WithContext(ctx).Create(&schedule).Error; err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+func (s *appStore) GetDatasetExportScheduleById(ctx context.Context, scheduleId uuid.UUID) (models.DatasetExportSchedule, error) {
+	schedule := models.DatasetExportSchedule{}
+	err := s.client.WithContext(ctx).
+		Where("dataset_export_schedule_id = ?", scheduleId).
+		Where("deleted_at IS NULL").
+		First(&schedule).Error
+	if err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+func (s *appStore) GetDatasetExportSchedules(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetExportSchedule, error) {
+	var schedules []models.DatasetExportSchedule
+	err := s.client.WithContext(ctx).
+		Where("dataset_id = ?", datasetId).
+		Where("deleted_at IS NULL").
+		Order("created_at desc").
+		Find(&schedules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func (s *appStore) UpdateDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, params models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	schedule, err := s.GetDatasetExportScheduleById(ctx, scheduleId)
+	if err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	queryConfig, err := json.Marshal(params.QueryConfig)
+	if err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+	destinationConfig, err := json.Marshal(params.DestinationConfig)
+	if err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(&schedule).Where("dataset_export_schedule_id = ?", scheduleId).Updates(map[string]interface{}{
+		"name":                 params.Name,
+		"query_config":         queryConfig,
+		"format":               params.Format,
+		"cron_expression":      params.CronExpression,
+		"timezone":             params.Timezone,
+		"destination_type":     params.DestinationType,
+		"destination_config":   destinationConfig,
+		"temporal_schedule_id": params.TemporalScheduleId,
+		"is_enabled":           params.IsEnabled,
+		"updated_by":           params.UpdatedBy,
+		"updated_at":           now,
+	}).Error
+	if err != nil {
+		return models.DatasetExportSchedule{}, err
+	}
+
+	schedule.Name = params.Name
+	schedule.QueryConfig = queryConfig
+	schedule.Format = params.Format
+	schedule.CronExpression = params.CronExpression
+	schedule.Timezone = params.Timezone
+	schedule.DestinationType = params.DestinationType
+	schedule.DestinationConfig = destinationConfig
+	schedule.TemporalScheduleId = params.TemporalScheduleId
+	schedule.IsEnabled = params.IsEnabled
+	schedule.UpdatedBy = params.UpdatedBy
+	schedule.UpdatedAt = now
+
+	return schedule, nil
+}
+
+func (s *appStore) DeleteDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, deletedBy uuid.UUID) error {
+	schedule, err := s.GetDatasetExportScheduleById(ctx, scheduleId)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Model(&schedule).Where("dataset_export_schedule_id = ?", scheduleId).Updates(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"deleted_by": deletedBy,
+	}).Error
+}
+
+func (s *appStore) CreateDatasetExportScheduleRun(ctx context.Context, params models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error) {
+	run := models.DatasetExportScheduleRun{
+		ID:                      uuid.New(),
+		DatasetExportScheduleId: params.DatasetExportScheduleId,
+		WorkflowId:              params.WorkflowId,
+		Status:                  params.Status,
+		FilePath:                params.FilePath,
+		StartedAt:               time.Now(),
+	}
+
+	if err := s.client.WithContext(ctx).Create(&run).Error; err != nil {
+		return models.DatasetExportScheduleRun{}, err
+	}
+
+	return run, nil
+}
+
+func (s *appStore) UpdateDatasetExportScheduleRun(ctx context.Context, runId uuid.UUID, params models.UpdateDatasetExportScheduleRunParams) error {
+	return s.client.WithContext(ctx).
+		Model(&models.DatasetExportScheduleRun{}).
+		Where("dataset_export_schedule_run_id = ?", runId).
+		Updates(map[string]interface{}{
+			"status":       params.Status,
+			"error":        params.Error,
+			"completed_at": time.Now(),
+		}).Error
+}
+
+// GetDatasetExportScheduleRuns returns the latest runs of a schedule first
+func (s *appStore) GetDatasetExportScheduleRuns(ctx context.Context, scheduleId uuid.UUID, limit int) ([]models.DatasetExportScheduleRun, error) {
+	var runs []models.DatasetExportScheduleRun
+	err := s.client.WithContext(ctx).
+		Where("dataset_export_schedule_id = ?", scheduleId).
+		Order("started_at desc").
+		Limit(limit).
+		Find(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+func (s *appStore) WithDatasetExportScheduleTransaction(ctx context.Context, fn func(DatasetExportScheduleStore) error) error {
+	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txClient := pgclient.PostgresClient{DB: tx}
+		return fn(&appStore{client: &txClient})
+	})
+}
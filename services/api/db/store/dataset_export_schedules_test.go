@@ -0,0 +1,158 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateDatasetExportSchedule(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+
+	params := models.CreateDatasetExportScheduleParams{
+		OrganizationId:     orgID,
+		DatasetId:          datasetID,
+		OwnerId:            userID,
+		Name:               "Morning payouts",
+		QueryConfig:        map[string]interface{}{"Filters": map[string]interface{}{"logical_operator": "AND"}},
+		Format:             "csv",
+		CronExpression:     "0 7 * * *",
+		Timezone:           "Europe/London",
+		DestinationType:    "email",
+		DestinationConfig:  map[string]interface{}{"recipients": []string{"finance@example.com"}},
+		TemporalScheduleId: "dataset-export-schedule-1",
+	}
+
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", datasetID, userID, "viewer"))
+				mock.ExpectQuery(`INSERT INTO "dataset_export_schedules"`).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_export_schedule_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "no access to the dataset",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			schedule, err := store.CreateDatasetExportSchedule(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Morning payouts", schedule.Name)
+				assert.Equal(t, userID, schedule.OwnerId)
+				assert.True(t, schedule.IsEnabled)
+				assert.JSONEq(t, `{"recipients":["finance@example.com"]}`, string(schedule.DestinationConfig))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetDatasetExportScheduleRuns(t *testing.T) {
+	t.Parallel()
+
+	scheduleID := uuid.New()
+
+	expectedQuery := regexp.QuoteMeta(`SELECT * FROM "dataset_export_schedule_runs" WHERE dataset_export_schedule_id = $1 ORDER BY started_at desc LIMIT $2`)
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "latest runs first",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(scheduleID, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_export_schedule_run_id", "dataset_export_schedule_id", "status", "started_at"}).
+						AddRow(uuid.New(), scheduleID, "FAILED", time.Now()).
+						AddRow(uuid.New(), scheduleID, "SUCCESSFUL", time.Now().Add(-24*time.Hour)))
+			},
+			wantLen: 2,
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			runs, err := store.GetDatasetExportScheduleRuns(context.Background(), scheduleID, 2)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, runs, tt.wantLen)
+				assert.Equal(t, "FAILED", runs[0].Status)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+type DatasetRowAssignmentStore interface {
+	UpsertDatasetRowAssignment(ctx context.Context, params models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error)
+	GetDatasetRowAssignment(ctx context.Context, datasetId uuid.UUID, rowId string) (models.DatasetRowAssignment, error)
+	GetDatasetRowAssignmentsForAssignee(ctx context.Context, assigneeId uuid.UUID) ([]models.DatasetRowAssignment, error)
+}
+
+// UpsertDatasetRowAssignment keeps a single assignment per row, assigning an assigned row replaces its assignee and due date
+func (s *appStore) UpsertDatasetRowAssignment(ctx context.Context, params models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error) {
+	assignment := models.DatasetRowAssignment{
+		ID:             uuid.New(),
+		OrganizationId: params.OrganizationId,
+		DatasetId:      params.DatasetId,
+		RowId:          params.RowId,
+		AssigneeId:     params.AssigneeId,
+		DueDate:        params.DueDate,
+		CreatedAt:      time.Now(),
+		CreatedBy:      params.UpdatedBy,
+		UpdatedAt:      time.Now(),
+		UpdatedBy:      params.UpdatedBy,
+	}
+
+	err := s.client.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "dataset_id"}, {Name: "row_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"assignee_id", "due_date", "updated_at", "updated_by"}),
+	}).Create(&assignment).Error
+	if err != nil {
+		return models.DatasetRowAssignment{}, err
+	}
+
+	return s.GetDatasetRowAssignment(ctx, params.DatasetId, params.RowId)
+}
+
+func (s *appStore) GetDatasetRowAssignment(ctx context.Context, datasetId uuid.UUID, rowId string) (models.DatasetRowAssignment, error) {
+	assignment := models.DatasetRowAssignment{}
+	err := s.client.WithContext(ctx).
+		Where("dataset_id = ?", datasetId).
+		Where("row_id = ?", rowId).
+		First(&assignment).Error
+	if err != nil {
+		return models.DatasetRowAssignment{}, err
+	}
+
+	return assignment, nil
+}
+
+// GetDatasetRowAssignmentsForAssignee returns the rows assigned to the user across datasets, earliest due first
+func (s *appStore) GetDatasetRowAssignmentsForAssignee(ctx context.Context, assigneeId uuid.UUID) ([]models.DatasetRowAssignment, error) {
+	var assignments []models.DatasetRowAssignment
+	err := s.client.WithContext(ctx).
+		Where("assignee_id = ?", assigneeId).
+		Order("due_date asc nulls last").
+		Order("created_at asc").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type DatasetRowPolicyStore interface {
+	CreateDatasetRowPolicy(ctx context.Context, params models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)
+	GetDatasetRowPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetRowPolicy, error)
+	GetDatasetRowPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetRowPolicy, error)
+	GetDatasetRowPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetRowPolicy, error)
+	UpdateDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)
+	DeleteDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error
+}
+
+func (s *appStore) CreateDatasetRowPolicy(ctx context.Context, params models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	filterConfig, err := json.Marshal(params.FilterConfig)
+	if err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	policy := models.DatasetRowPolicy{
+		ID:                   uuid.New(),
+		OrganizationId:       params.OrganizationId,
+		DatasetId:            params.DatasetId,
+		ResourceAudienceType: params.ResourceAudienceType,
+		ResourceAudienceId:   params.ResourceAudienceId,
+		Title:                params.Title,
+		Description:          params.Description,
+		FilterConfig:         filterConfig,
+		CreatedAt:            time.Now(),
+		CreatedBy:            params.CreatedBy,
+		UpdatedAt:            time.Now(),
+		UpdatedBy:            params.CreatedBy,
+	}
+
+	if err := s.client.WithContext(ctx).Create(&policy).Error; err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (s *appStore) GetDatasetRowPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetRowPolicy, error) {
+	policy := models.DatasetRowPolicy{}
+	err := s.client.WithContext(ctx).
+		Where("dataset_row_policy_id = ?", policyId).
+		Where("deleted_at IS NULL").
+		First(&policy).Error
+	if err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (s *appStore) GetDatasetRowPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetRowPolicy, error) {
+	var policies []models.DatasetRowPolicy
+	err := s.client.WithContext(ctx).
+		Where("dataset_id = ?", datasetId).
+		Where("deleted_at IS NULL").
+		Order("created_at asc").
+		Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// GetDatasetRowPoliciesForUser returns the policies of a dataset whose audience includes the user,
+// either directly, through one of the user's teams or through one of the user's organizations.
+func (s *appStore) GetDatasetRowPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetRowPolicy, error) {
+	var policies []models.DatasetRowPolicy
+	err := s.client.WithContext(ctx).
+		Where("dataset_id = ?", datasetId).
+		Where("deleted_at IS NULL").
+		Where(
+			`(resource_audience_type = ? AND resource_audience_id = ?)
+			OR (resource_audience_type = ? AND resource_audience_id IN (
+				SELECT tm.team_id FROM "app"."team_memberships" tm WHERE tm.user_id = ? AND tm.deleted_at IS NULL
+			))
+			OR (resource_audience_type = ? AND resource_audience_id IN (
+				SELECT ofrap.resource_id FROM "app"."flattened_resource_audience_policies" ofrap
+				WHERE ofrap.resource_type = ? AND ofrap.user_id = ? AND ofrap.deleted_at IS NULL
+			))`,
+			models.AudienceTypeUser, userId,
+			models.AudienceTypeTeam, userId,
+			models.AudienceTypeOrganization, models.ResourceTypeOrganization, userId,
+		).
+		Order("created_at asc").
+		Find(&policies).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+func (s *appStore) UpdateDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	policy, err := s.GetDatasetRowPolicyById(ctx, policyId)
+	if err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	filterConfig, err := json.Marshal(params.FilterConfig)
+	if err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(&policy).Where("dataset_row_policy_id = ?", policyId).Updates(map[string]interface{}{
+		"title":         params.Title,
+		"description":   params.Description,
+		"filter_config": filterConfig,
+		"updated_by":    params.UpdatedBy,
+		"updated_at":    now,
+	}).Error
+	if err != nil {
+		return models.DatasetRowPolicy{}, err
+	}
+
+	policy.Title = params.Title
+	policy.Description = params.Description
+	policy.FilterConfig = filterConfig
+	policy.UpdatedBy = params.UpdatedBy
+	policy.UpdatedAt = now
+
+	return policy, nil
+}
+
+func (s *appStore) DeleteDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error {
+	policy, err := s.GetDatasetRowPolicyById(ctx, policyId)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Model(&policy).Where("dataset_row_policy_id = ?", policyId).Updates(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"deleted_by": deletedBy,
+	}).Error
+}
@@ -0,0 +1,229 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateDatasetRowPolicy(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+	teamID := uuid.New()
+
+	tests := []struct {
+		name      string
+		params    models.CreateDatasetRowPolicyParams
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			params: models.CreateDatasetRowPolicyParams{
+				OrganizationId:       orgID,
+				DatasetId:            datasetID,
+				ResourceAudienceType: models.AudienceTypeTeam,
+				ResourceAudienceId:   teamID,
+				Title:                "EU entities",
+				FilterConfig:         map[string]interface{}{"logical_operator": "AND"},
+				CreatedBy:            userID,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, models.PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", datasetID, userID, "admin"))
+				mock.ExpectQuery(`INSERT INTO "dataset_row_policies"`).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_row_policy_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "not a dataset admin",
+			params: models.CreateDatasetRowPolicyParams{
+				OrganizationId:       orgID,
+				DatasetId:            datasetID,
+				ResourceAudienceType: models.AudienceTypeTeam,
+				ResourceAudienceId:   teamID,
+				Title:                "EU entities",
+				FilterConfig:         map[string]interface{}{"logical_operator": "AND"},
+				CreatedBy:            userID,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, models.PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			policy, err := store.CreateDatasetRowPolicy(ctx, tt.params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, datasetID, policy.DatasetId)
+				assert.Equal(t, userID, policy.UpdatedBy)
+				assert.JSONEq(t, `{"logical_operator": "AND"}`, string(policy.FilterConfig))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetDatasetRowPoliciesForUser(t *testing.T) {
+	t.Parallel()
+
+	datasetID := uuid.New()
+	userID := uuid.New()
+	policyID := uuid.New()
+	now := time.Now()
+
+	expectedQuery := regexp.QuoteMeta(`SELECT * FROM "dataset_row_policies" WHERE dataset_id = $1 AND deleted_at IS NULL AND ((resource_audience_type = $2 AND resource_audience_id = $3)`)
+
+	tests := []struct {
+		name          string
+		mockSetup     func(sqlmock.Sqlmock)
+		expectedCount int
+		wantErr       bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(datasetID, models.AudienceTypeUser, userID, models.AudienceTypeTeam, userID, models.AudienceTypeOrganization, models.ResourceTypeOrganization, userID).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_row_policy_id", "dataset_id", "resource_audience_type", "resource_audience_id", "filter_config", "created_at"}).
+						AddRow(policyID, datasetID, "user", userID, []byte(`{"logical_operator":"AND","conditions":[]}`), now))
+			},
+			expectedCount: 1,
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			policies, err := store.GetDatasetRowPoliciesForUser(context.Background(), datasetID, userID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, policies, tt.expectedCount)
+				assert.Equal(t, policyID, policies[0].ID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeleteDatasetRowPolicy(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+	policyID := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "dataset_row_policies" WHERE dataset_row_policy_id = $1 AND deleted_at IS NULL ORDER BY "dataset_row_policies"."dataset_row_policy_id" LIMIT $2`)).
+					WithArgs(policyID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_row_policy_id", "dataset_id"}).AddRow(policyID, datasetID))
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, models.PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", datasetID, userID, "admin"))
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "dataset_row_policies" SET "deleted_at"=$1,"deleted_by"=$2,"updated_at"=$3 WHERE dataset_row_policy_id = $4 AND "dataset_row_policy_id" = $5`)).
+					WithArgs(sqlmock.AnyArg(), userID, sqlmock.AnyArg(), policyID, policyID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "dataset_row_policies" WHERE dataset_row_policy_id = $1 AND deleted_at IS NULL ORDER BY "dataset_row_policies"."dataset_row_policy_id" LIMIT $2`)).
+					WithArgs(policyID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			err := store.DeleteDatasetRowPolicy(ctx, policyID, userID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
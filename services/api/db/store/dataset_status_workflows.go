@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type DatasetStatusWorkflowStore interface {
+	CreateDatasetStatusWorkflow(ctx context.Context, params models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)
+	GetDatasetStatusWorkflowById(ctx context.Context, workflowId uuid.UUID) (models.DatasetStatusWorkflow, error)
+	GetDatasetStatusWorkflows(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetStatusWorkflow, error)
+	UpdateDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, params models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)
+	DeleteDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, deletedBy uuid.UUID) error
+}
+
+func (s *appStore) CreateDatasetStatusWorkflow(ctx context.Context, params models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	states, err := json.Marshal(params.States)
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	transitions, err := json.Marshal(params.Transitions)
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	workflow := models.DatasetStatusWorkflow{
+		ID:             uuid.New(),
+		OrganizationId: params.OrganizationId,
+		DatasetId:      params.DatasetId,
+		ColumnName:     params.ColumnName,
+		InitialState:   params.InitialState,
+		States:         states,
+		Transitions:    transitions,
+		CreatedAt:      time.Now(),
+		CreatedBy:      params.CreatedBy,
+		UpdatedAt:      time.Now(),
+		UpdatedBy:      params.CreatedBy,
+	}
+
+	if err := s.client.WithContext(ctx).Create(&workflow).Error; err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	return workflow, nil
+}
+
+func (s *appStore) GetDatasetStatusWorkflowById(ctx context.Context, workflowId uuid.UUID) (models.DatasetStatusWorkflow, error) {
+	workflow := models.DatasetStatusWorkflow{}
+	err := s.client.WithContext(ctx).
+		Where("dataset_status_workflow_id = ?", workflowId).
+		Where("deleted_at IS NULL").
+		First(&workflow).Error
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	return workflow, nil
+}
+
+func (s *appStore) GetDatasetStatusWorkflows(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetStatusWorkflow, error) {
+	var workflows []models.DatasetStatusWorkflow
+	err := s.client.WithContext(ctx).
+		Where("dataset_id = ?", datasetId).
+		Where("deleted_at IS NULL").
+		Order("created_at asc").
+		Find(&workflows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return workflows, nil
+}
+
+func (s *appStore) UpdateDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, params models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	workflow, err := s.GetDatasetStatusWorkflowById(ctx, workflowId)
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	states, err := json.Marshal(params.States)
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	transitions, err := json.Marshal(params.Transitions)
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(&workflow).Where("dataset_status_workflow_id = ?", workflowId).Updates(map[string]interface{}{
+		"initial_state": params.InitialState,
+		"states":        states,
+		"transitions":   transitions,
+		"updated_by":    params.UpdatedBy,
+		"updated_at":    now,
+	}).Error
+	if err != nil {
+		return models.DatasetStatusWorkflow{}, err
+	}
+
+	workflow.InitialState = params.InitialState
+	workflow.States = states
+	workflow.Transitions = transitions
+	workflow.UpdatedBy = params.UpdatedBy
+	workflow.UpdatedAt = now
+
+	return workflow, nil
+}
+
+func (s *appStore) DeleteDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, deletedBy uuid.UUID) error {
+	workflow, err := s.GetDatasetStatusWorkflowById(ctx, workflowId)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Model(&workflow).Where("dataset_status_workflow_id = ?", workflowId).Updates(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"deleted_by": deletedBy,
+	}).Error
+}
@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDatasetStatusWorkflow(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+
+	params := models.CreateDatasetStatusWorkflowParams{
+		OrganizationId: orgID,
+		DatasetId:      datasetID,
+		ColumnName:     "status",
+		InitialState:   "open",
+		States:         []map[string]interface{}{{"name": "open"}, {"name": "resolved", "is_final": true}},
+		Transitions:    []map[string]interface{}{{"from": "open", "to": "resolved", "privilege": "viewer"}},
+		CreatedBy:      userID,
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, models.PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", datasetID, userID, "admin"))
+				mock.ExpectQuery(`INSERT INTO "dataset_status_workflows"`).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_status_workflow_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "not a dataset admin",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, models.PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			workflow, err := store.CreateDatasetStatusWorkflow(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "status", workflow.ColumnName)
+				assert.JSONEq(t, `[{"name":"open"},{"name":"resolved","is_final":true}]`, string(workflow.States))
+				assert.JSONEq(t, `[{"from":"open","to":"resolved","privilege":"viewer"}]`, string(workflow.Transitions))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpsertDatasetRowAssignment(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+	assigneeID := uuid.New()
+
+	gormDB, mock := getMockDB(t)
+	store := &appStore{
+		client: &pgclient.PostgresClient{DB: gormDB},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)).
+		WithArgs(models.ResourceTypeDataset, datasetID, userID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+			AddRow("dataset", datasetID, userID, "viewer"))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "dataset_row_assignments"`) + `.*` + regexp.QuoteMeta(`ON CONFLICT ("dataset_id","row_id") DO UPDATE SET "assignee_id"="excluded"."assignee_id","due_date"="excluded"."due_date","updated_at"="excluded"."updated_at","updated_by"="excluded"."updated_by"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"dataset_row_assignment_id"}).AddRow(uuid.New()))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "dataset_row_assignments" WHERE dataset_id = $1 AND row_id = $2 ORDER BY "dataset_row_assignments"."dataset_row_assignment_id" LIMIT $3`)).
+		WithArgs(datasetID, "row-1", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"dataset_row_assignment_id", "dataset_id", "row_id", "assignee_id"}).
+			AddRow(uuid.New(), datasetID, "row-1", assigneeID))
+
+	ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+	assignment, err := store.UpsertDatasetRowAssignment(ctx, models.UpsertDatasetRowAssignmentParams{
+		OrganizationId: orgID,
+		DatasetId:      datasetID,
+		RowId:          "row-1",
+		AssigneeId:     &assigneeID,
+		UpdatedBy:      userID,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "row-1", assignment.RowId)
+	assert.Equal(t, &assigneeID, assignment.AssigneeId)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDatasetRowAssignmentsForAssignee(t *testing.T) {
+	t.Parallel()
+
+	assigneeID := uuid.New()
+
+	gormDB, mock := getMockDB(t)
+	store := &appStore{
+		client: &pgclient.PostgresClient{DB: gormDB},
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "dataset_row_assignments" WHERE assignee_id = $1 ORDER BY due_date asc nulls last,created_at asc`)).
+		WithArgs(assigneeID).
+		WillReturnRows(sqlmock.NewRows([]string{"dataset_row_assignment_id", "dataset_id", "row_id", "assignee_id"}).
+			AddRow(uuid.New(), uuid.New(), "row-1", assigneeID).
+			AddRow(uuid.New(), uuid.New(), "row-2", assigneeID))
+
+	assignments, err := store.GetDatasetRowAssignmentsForAssignee(context.Background(), assigneeID)
+
+	assert.NoError(t, err)
+	assert.Len(t, assignments, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
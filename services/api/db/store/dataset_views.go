@@ -0,0 +1,193 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DatasetViewStore interface {
+	CreateDatasetView(ctx context.Context, params models.CreateDatasetViewParams) (models.DatasetView, error)
+	GetDatasetViewById(ctx context.Context, viewId uuid.UUID) (models.DatasetView, error)
+	GetDatasetViews(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetView, error)
+	UpdateDatasetView(ctx context.Context, viewId uuid.UUID, params models.UpdateDatasetViewParams) (models.DatasetView, error)
+	DeleteDatasetView(ctx context.Context, viewId uuid.UUID, deletedBy uuid.UUID) error
+	GetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) (*models.DatasetViewDefault, error)
+	SetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) (models.DatasetViewDefault, error)
+	DeleteDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) error
+	datasetViewPoliciesStore
+	WithDatasetViewTransaction(ctx context.Context, fn func(DatasetViewStore) error) error
+}
+
+func (s *appStore) CreateDatasetView(ctx context.Context, params models.CreateDatasetViewParams) (models.DatasetView, error) {
+	view := models.DatasetView{
+		ID:             uuid.New(),
+		OrganizationId: params.OrganizationId,
+		DatasetId:      params.DatasetId,
+		OwnerId:        params.OwnerId,
+		Name:           params.Name,
+		Description:    params.Description,
+		CreatedAt:      time.Now(),
+		CreatedBy:      params.OwnerId,
+		UpdatedAt:      time.Now(),
+		UpdatedBy:      params.OwnerId,
+	}
+
+	var err error
+	if view.QueryConfig, err = json.Marshal(params.QueryConfig); err != nil {
+		return models.DatasetView{}, err
+	}
+	if view.ColumnOrder, err = json.Marshal(params.ColumnOrder); err != nil {
+		return models.DatasetView{}, err
+	}
+	if view.DisplayConfig, err = json.Marshal(params.DisplayConfig); err != nil {
+		return models.DatasetView{}, err
+	}
+
+	if err := s.client.WithContext(ctx).Create(&view).Error; err != nil {
+		return models.DatasetView{}, err
+	}
+
+	return view, nil
+}
+
+func (s *appStore) GetDatasetViewById(ctx context.Context, viewId uuid.UUID) (models.DatasetView, error) {
+	view := models.DatasetView{}
+	err := s.client.WithContext(ctx).
+		Where("dataset_view_id = ?", viewId).
+		Where("deleted_at IS NULL").
+		First(&view).Error
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+
+	return view, nil
+}
+
+// GetDatasetViews returns the views of a dataset that are shared with the user in context
+func (s *appStore) GetDatasetViews(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetView, error) {
+	var views []models.DatasetView
+	err := s.client.WithContext(ctx).
+		Where("dataset_id = ?", datasetId).
+		Where("deleted_at IS NULL").
+		Order("name asc").
+		Find(&views).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return views, nil
+}
+
+func (s *appStore) UpdateDatasetView(ctx context.Context, viewId uuid.UUID, params models.UpdateDatasetViewParams) (models.DatasetView, error) {
+	view, err := s.GetDatasetViewById(ctx, viewId)
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+
+	queryConfig, err := json.Marshal(params.QueryConfig)
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+	columnOrder, err := json.Marshal(params.ColumnOrder)
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+	displayConfig, err := json.Marshal(params.DisplayConfig)
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(&view).Where("dataset_view_id = ?", viewId).Updates(map[string]interface{}{
+		"name":           params.Name,
+		"description":    params.Description,
+		"query_config":   queryConfig,
+		"column_order":   columnOrder,
+		"display_config": displayConfig,
+		"updated_by":     params.UpdatedBy,
+		"updated_at":     now,
+	}).Error
+	if err != nil {
+		return models.DatasetView{}, err
+	}
+
+	view.Name = params.Name
+	view.Description = params.Description
+	view.QueryConfig = queryConfig
+	view.ColumnOrder = columnOrder
+	view.DisplayConfig = displayConfig
+	view.UpdatedBy = params.UpdatedBy
+	view.UpdatedAt = now
+
+	return view, nil
+}
+
+func (s *appStore) DeleteDatasetView(ctx context.Context, viewId uuid.UUID, deletedBy uuid.UUID) error {
+	view, err := s.GetDatasetViewById(ctx, viewId)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Model(&view).Where("dataset_view_id = ?", viewId).Updates(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"deleted_by": deletedBy,
+	}).Error
+}
+
+// GetDefaultDatasetView returns nil when the user has not picked a default view for the dataset
+func (s *appStore) GetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) (*models.DatasetViewDefault, error) {
+	var defaults []models.DatasetViewDefault
+	err := s.client.WithContext(ctx).
+		Where("user_id = ? AND dataset_id = ?", userId, datasetId).
+		Limit(1).
+		Find(&defaults).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(defaults) == 0 {
+		return nil, nil
+	}
+
+	return &defaults[0], nil
+}
+
+func (s *appStore) SetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) (models.DatasetViewDefault, error) {
+	viewDefault := models.DatasetViewDefault{
+		UserId:        userId,
+		DatasetId:     datasetId,
+		DatasetViewId: viewId,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	err := s.client.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "dataset_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"dataset_view_id", "updated_at"}),
+	}).Create(&viewDefault).Error
+	if err != nil {
+		return models.DatasetViewDefault{}, err
+	}
+
+	return viewDefault, nil
+}
+
+func (s *appStore) DeleteDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) error {
+	return s.client.WithContext(ctx).
+		Where("user_id = ? AND dataset_id = ?", userId, datasetId).
+		Delete(&models.DatasetViewDefault{}).Error
+}
+
+func (s *appStore) WithDatasetViewTransaction(ctx context.Context, fn func(DatasetViewStore) error) error {
+	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txClient := pgclient.PostgresClient{DB: tx}
+		return fn(&appStore{client: &txClient})
+	})
+}
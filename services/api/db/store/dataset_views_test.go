@@ -0,0 +1,165 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateDatasetView(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+
+	params := models.CreateDatasetViewParams{
+		OrganizationId: orgID,
+		DatasetId:      datasetID,
+		OwnerId:        userID,
+		Name:           "Unreconciled payouts",
+		QueryConfig:    map[string]interface{}{"Filters": map[string]interface{}{"logical_operator": "AND"}},
+		ColumnOrder:    []string{"amount", "currency"},
+		DisplayConfig:  map[string]interface{}{"density": "compact"},
+	}
+
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", datasetID, userID, "viewer"))
+				mock.ExpectQuery(`INSERT INTO "dataset_views"`).
+					WillReturnRows(sqlmock.NewRows([]string{"dataset_view_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "no access to the dataset",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			view, err := store.CreateDatasetView(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Unreconciled payouts", view.Name)
+				assert.Equal(t, userID, view.OwnerId)
+				assert.JSONEq(t, `["amount","currency"]`, string(view.ColumnOrder))
+				assert.JSONEq(t, `{"density":"compact"}`, string(view.DisplayConfig))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetDefaultDatasetView(t *testing.T) {
+	t.Parallel()
+
+	datasetID := uuid.New()
+	userID := uuid.New()
+	viewID := uuid.New()
+
+	expectedQuery := regexp.QuoteMeta(`SELECT * FROM "dataset_view_defaults" WHERE user_id = $1 AND dataset_id = $2 LIMIT $3`)
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantNil   bool
+		wantErr   bool
+	}{
+		{
+			name: "default view set",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(userID, datasetID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "dataset_id", "dataset_view_id"}).
+						AddRow(userID, datasetID, viewID))
+			},
+		},
+		{
+			name: "no default view",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs(userID, datasetID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "dataset_id", "dataset_view_id"}))
+			},
+			wantNil: true,
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			viewDefault, err := store.GetDefaultDatasetView(context.Background(), userID, datasetID)
+
+			switch {
+			case tt.wantErr:
+				assert.Error(t, err)
+			case tt.wantNil:
+				assert.NoError(t, err)
+				assert.Nil(t, viewDefault)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, viewID, viewDefault.DatasetViewId)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
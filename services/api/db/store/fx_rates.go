@@ -0,0 +1,218 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const fxRateUpsertBatchSize = 1000
+
+type FxRateStore interface {
+	CreateFxRateSource(ctx context.Context, params models.CreateFxRateSourceParams) (models.FxRateSource, error)
+	GetFxRateSourceById(ctx context.Context, sourceId uuid.UUID) (models.FxRateSource, error)
+	GetFxRateSources(ctx context.Context) ([]models.FxRateSource, error)
+	DeleteFxRateSource(ctx context.Context, sourceId uuid.UUID, deletedBy uuid.UUID) error
+	MarkFxRateSourceImported(ctx context.Context, sourceId uuid.UUID, importedAt time.Time) error
+	UpsertFxRates(ctx context.Context, orgId uuid.UUID, sourceId uuid.UUID, rates []models.FxRateParams) error
+	GetFxRates(ctx context.Context, filters models.FxRateFilters) ([]models.FxRate, error)
+	CountFxRates(ctx context.Context, filters models.FxRateFilters) (int64, error)
+	GetFxRateOnDate(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time) (models.FxRate, error)
+	GetLatestFxRateDate(ctx context.Context, sourceId uuid.UUID) (*time.Time, error)
+}
+
+func (s *appStore) CreateFxRateSource(ctx context.Context, params models.CreateFxRateSourceParams) (models.FxRateSource, error) {
+	config, err := json.Marshal(params.Config)
+	if err != nil {
+		return models.FxRateSource{}, err
+	}
+
+	source := models.FxRateSource{
+		ID:             uuid.New(),
+		OrganizationId: params.OrganizationId,
+		Name:           params.Name,
+		SourceType:     params.SourceType,
+		ConnectionId:   params.ConnectionId,
+		DatasetId:      params.DatasetId,
+		Config:         config,
+		CreatedAt:      time.Now(),
+		CreatedBy:      params.CreatedBy,
+		UpdatedAt:      time.Now(),
+		UpdatedBy:      params.CreatedBy,
+	}
+
+	if err := s.client.WithContext(ctx).Create(&source).Error; err != nil {
+		return models.FxRateSource{}, err
+	}
+
+	return source, nil
+}
+
+func (s *appStore) GetFxRateSourceById(ctx context.Context, sourceId uuid.UUID) (models.FxRateSource, error) {
+	source := models.FxRateSource{}
+	err := s.client.WithContext(ctx).
+		Where("fx_rate_source_id = ?", sourceId).
+		Where("deleted_at IS NULL").
+		First(&source).Error
+	if err != nil {
+		return models.FxRateSource{}, err
+	}
+
+	return source, nil
+}
+
+func (s *appStore) GetFxRateSources(ctx context.Context) ([]models.FxRateSource, error) {
+	var sources []models.FxRateSource
+	err := s.client.WithContext(ctx).
+		Where("deleted_at IS NULL").
+		Order("created_at desc").
+		Find(&sources).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
+// DeleteFxRateSource deletes the source and the rates it imported, rates of the same days imported by other sources
+// are kept
+func (s *appStore) DeleteFxRateSource(ctx context.Context, sourceId uuid.UUID, deletedBy uuid.UUID) error {
+	source, err := s.GetFxRateSourceById(ctx, sourceId)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("fx_rate_source_id = ?", source.ID).Delete(&models.FxRate{}).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		return tx.Model(&source).Where("fx_rate_source_id = ?", source.ID).Updates(map[string]interface{}{
+			"deleted_at": now,
+			"deleted_by": deletedBy,
+			"updated_at": now,
+			"updated_by": deletedBy,
+		}).Error
+	})
+}
+
+func (s *appStore) MarkFxRateSourceImported(ctx context.Context, sourceId uuid.UUID, importedAt time.Time) error {
+	return s.client.WithContext(ctx).Model(&models.FxRateSource{}).
+		Where("fx_rate_source_id = ?", sourceId).
+		Updates(map[string]interface{}{
+			"last_imported_at": importedAt,
+			"updated_at":       time.Now(),
+		}).Error
+}
+
+// UpsertFxRates writes the rates of a source, a rate of a pair and day that already exists is replaced and moves to
+// the source importing it
+func (s *appStore) UpsertFxRates(ctx context.Context, orgId uuid.UUID, sourceId uuid.UUID, rates []models.FxRateParams) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	records := make([]models.FxRate, len(rates))
+	for i, rate := range rates {
+		records[i] = models.FxRate{
+			ID:             uuid.New(),
+			OrganizationId: orgId,
+			FxRateSourceId: sourceId,
+			RateDate:       rate.RateDate,
+			BaseCurrency:   rate.BaseCurrency,
+			QuoteCurrency:  rate.QuoteCurrency,
+			Rate:           rate.Rate,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+	}
+
+	return s.client.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}, {Name: "base_currency"}, {Name: "quote_currency"}, {Name: "rate_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "fx_rate_source_id", "updated_at"}),
+	}).CreateInBatches(&records, fxRateUpsertBatchSize).Error
+}
+
+func (s *appStore) GetFxRates(ctx context.Context, filters models.FxRateFilters) ([]models.FxRate, error) {
+	var rates []models.FxRate
+	query := applyFxRateFilters(s.client.WithContext(ctx).Model(&models.FxRate{}), filters).
+		Order("rate_date desc").
+		Order("base_currency asc").
+		Order("quote_currency asc")
+	if filters.PageSize > 0 {
+		query = query.Limit(filters.PageSize).Offset((filters.Page - 1) * filters.PageSize)
+	}
+
+	if err := query.Find(&rates).Error; err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+func (s *appStore) CountFxRates(ctx context.Context, filters models.FxRateFilters) (int64, error) {
+	var count int64
+	err := applyFxRateFilters(s.client.WithContext(ctx).Model(&models.FxRate{}), filters).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetFxRateOnDate returns the latest rate of the pair on or before the date, days without a rate like weekends use
+// the rate of the last day before them
+func (s *appStore) GetFxRateOnDate(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time) (models.FxRate, error) {
+	rate := models.FxRate{}
+	err := s.client.WithContext(ctx).
+		Where("base_currency = ? AND quote_currency = ?", baseCurrency, quoteCurrency).
+		Where("rate_date <= ?", date).
+		Order("rate_date desc").
+		First(&rate).Error
+	if err != nil {
+		return models.FxRate{}, err
+	}
+
+	return rate, nil
+}
+
+func (s *appStore) GetLatestFxRateDate(ctx context.Context, sourceId uuid.UUID) (*time.Time, error) {
+	var rates []models.FxRate
+	err := s.client.WithContext(ctx).
+		Where("fx_rate_source_id = ?", sourceId).
+		Order("rate_date desc").
+		Limit(1).
+		Find(&rates).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rates) == 0 {
+		return nil, nil
+	}
+
+	return &rates[0].RateDate, nil
+}
+
+func applyFxRateFilters(query *gorm.DB, filters models.FxRateFilters) *gorm.DB {
+	if len(filters.BaseCurrencies) > 0 {
+		query = query.Where("base_currency IN ?", filters.BaseCurrencies)
+	}
+	if len(filters.QuoteCurrencies) > 0 {
+		query = query.Where("quote_currency IN ?", filters.QuoteCurrencies)
+	}
+	if filters.StartDate != nil {
+		query = query.Where("rate_date >= ?", *filters.StartDate)
+	}
+	if filters.EndDate != nil {
+		query = query.Where("rate_date <= ?", *filters.EndDate)
+	}
+
+	return query
+}
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateFxRateSource(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	userID := uuid.New()
+
+	params := models.CreateFxRateSourceParams{
+		OrganizationId: orgID,
+		Name:           "ECB daily",
+		SourceType:     models.FxRateSourceTypeFile,
+		Config:         map[string]string{"rate_column": "rate"},
+		CreatedBy:      userID,
+	}
+
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)
+	frapColumns := []string{"resource_type", "resource_id", "user_id", "privilege"}
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeOrganization, orgID, userID, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns).AddRow("organization", orgID, userID, "member"))
+				mock.ExpectQuery(`INSERT INTO "fx_rate_sources"`).
+					WillReturnRows(sqlmock.NewRows([]string{"fx_rate_source_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "not a member of the organization",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeOrganization, orgID, userID, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			source, err := store.CreateFxRateSource(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "ECB daily", source.Name)
+				assert.JSONEq(t, `{"rate_column":"rate"}`, string(source.Config))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetFxRateOnDate(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2025, 4, 6, 0, 0, 0, 0, time.UTC)
+	friday := time.Date(2025, 4, 4, 0, 0, 0, 0, time.UTC)
+
+	expectedQuery := regexp.QuoteMeta(`SELECT * FROM "fx_rates" WHERE (base_currency = $1 AND quote_currency = $2) AND rate_date <= $3 ORDER BY rate_date desc,"fx_rates"."fx_rate_id" LIMIT $4`)
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantRate  float64
+		wantErr   bool
+	}{
+		{
+			name: "uses the last rate before the date",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("USD", "EUR", date, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"fx_rate_id", "rate_date", "base_currency", "quote_currency", "rate"}).
+						AddRow(uuid.New(), friday, "USD", "EUR", 0.9134))
+			},
+			wantRate: 0.9134,
+		},
+		{
+			name: "no rate",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(expectedQuery).
+					WithArgs("USD", "EUR", date, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			rate, err := store.GetFxRateOnDate(context.Background(), "USD", "EUR", date)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantRate, rate.Rate)
+				assert.Equal(t, friday, rate.RateDate)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/pgclient"
@@ -42,6 +43,8 @@ type OrganizationWriteStore interface {
 	CreateOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)
 	UpdatePendingOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)
 	CreateOrganization(ctx context.Context, name string, description *string, ownerId uuid.UUID) (*models.Organization, error)
+	UpdateOrganizationCalendarSettings(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage) (*models.Organization, error)
+	UpdateOrganizationExportSettings(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage) (*models.Organization, error)
 	WithOrganizationTransaction(ctx context.Context, fn func(OrganizationStore) error) error
 	organizationPoliciesWriteStore
 }
@@ -228,6 +231,48 @@ func (s *appStore) CreateSSOConfig(ctx context.Context, organizationId uuid.UUID
 	return config, nil
 }
 
+func (s *appStore) UpdateOrganizationCalendarSettings(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage) (*models.Organization, error) {
+	organization, err := s.GetOrganizationById(ctx, organizationId.String())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(organization).Where("organization_id = ?", organizationId).Updates(map[string]interface{}{
+		"calendar_settings": calendarSettings,
+		"updated_at":        now,
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	organization.CalendarSettings = calendarSettings
+	organization.UpdatedAt = now
+
+	return organization, nil
+}
+
+func (s *appStore) UpdateOrganizationExportSettings(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage) (*models.Organization, error) {
+	organization, err := s.GetOrganizationById(ctx, organizationId.String())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(organization).Where("organization_id = ?", organizationId).Updates(map[string]interface{}{
+		"export_settings": exportSettings,
+		"updated_at":      now,
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	organization.ExportSettings = exportSettings
+	organization.UpdatedAt = now
+
+	return organization, nil
+}
+
 func (s *appStore) WithOrganizationTransaction(ctx context.Context, fn func(OrganizationStore) error) error {
 	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txClient := pgclient.PostgresClient{DB: tx}
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/pgclient"
@@ -17,6 +18,8 @@ type PageStore interface {
 	GetPagesAll(ctx context.Context, filters models.PageFilters) ([]models.Page, error)
 	GetPagesByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.Page, error)
 	CreatePage(ctx context.Context, name string, description string) (*models.Page, error)
+	UpdatePage(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams) (*models.Page, error)
+	DeletePage(ctx context.Context, pageId uuid.UUID) error
 	pagePoliciesStore
 	WithPageTransaction(ctx context.Context, fn func(PageStore) error) error
 }
@@ -106,6 +109,48 @@ func (a *appStore) CreatePage(ctx context.Context, name string, description stri
 
 }
 
+func (s *appStore) UpdatePage(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams) (*models.Page, error) {
+	updates := map[string]interface{}{
+		"updated_at": time.Now(),
+	}
+	if params.Name != nil {
+		updates["name"] = *params.Name
+	}
+	if params.Description != nil {
+		updates["description"] = *params.Description
+	}
+	if params.FractionalIndex != nil {
+		updates["fractional_index"] = *params.FractionalIndex
+	}
+
+	err := s.client.WithContext(ctx).Model(&models.Page{}).Where("page_id = ?", pageId).Updates(updates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetPageById(ctx, pageId)
+}
+
+// DeletePage soft deletes the page together with its sheets and their widget instances
+func (s *appStore) DeletePage(ctx context.Context, pageId uuid.UUID) error {
+	now := time.Now()
+	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		pageSheets := tx.Model(&models.Sheet{}).Select("sheet_id").Where("page_id = ?", pageId)
+
+		err := tx.Model(&models.WidgetInstance{}).Where("sheet_id IN (?) AND deleted_at IS NULL", pageSheets).Update("deleted_at", &now).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.Sheet{}).Where("page_id = ? AND deleted_at IS NULL", pageId).Update("deleted_at", &now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Page{}).Where("page_id = ?", pageId).Update("deleted_at", &now).Error
+	})
+}
+
 func (s *appStore) WithPageTransaction(ctx context.Context, fn func(PageStore) error) error {
 	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		txClient := pgclient.PostgresClient{DB: tx}
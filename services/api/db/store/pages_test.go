@@ -560,3 +560,31 @@ func TestGetPagesByOrganizationId(t *testing.T) {
 		})
 	}
 }
+
+func TestAppStore_DeletePage(t *testing.T) {
+	t.Parallel()
+
+	pageId := uuid.New()
+
+	gormDB, mock := getMockDB(t)
+	store := &appStore{
+		client: &pgclient.PostgresClient{DB: gormDB},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "widget_instances" SET "deleted_at"=$1,"updated_at"=$2 WHERE sheet_id IN (SELECT "sheet_id" FROM "sheets" WHERE page_id = $3) AND deleted_at IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), pageId).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "sheets" SET "deleted_at"=$1,"updated_at"=$2 WHERE page_id = $3 AND deleted_at IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), pageId).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "pages" SET "deleted_at"=$1,"updated_at"=$2 WHERE page_id = $3`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), pageId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := store.DeletePage(context.Background(), pageId)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
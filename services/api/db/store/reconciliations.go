@@ -0,0 +1,457 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const reconciliationInsertBatchSize = 500
+
+type ReconciliationStore interface {
+	CreateReconciliation(ctx context.Context, params models.CreateReconciliationParams) (models.Reconciliation, error)
+	GetReconciliationById(ctx context.Context, reconciliationId uuid.UUID) (models.Reconciliation, error)
+	GetReconciliations(ctx context.Context) ([]models.Reconciliation, error)
+	UpdateReconciliation(ctx context.Context, reconciliationId uuid.UUID, params models.UpdateReconciliationParams) (models.Reconciliation, error)
+	DeleteReconciliation(ctx context.Context, reconciliationId uuid.UUID, deletedBy uuid.UUID) error
+	CreateReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, createdBy uuid.UUID) (models.ReconciliationRun, error)
+	GetReconciliationRunById(ctx context.Context, runId uuid.UUID) (models.ReconciliationRun, error)
+	GetReconciliationRuns(ctx context.Context, reconciliationId uuid.UUID, limit int) ([]models.ReconciliationRun, error)
+	GetLatestReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, status string) (models.ReconciliationRun, error)
+	UpdateReconciliationRunWorkflowId(ctx context.Context, runId uuid.UUID, workflowId string) error
+	CompleteReconciliationRun(ctx context.Context, runId uuid.UUID, params models.CompleteReconciliationRunParams) error
+	AdjustReconciliationRunCounts(ctx context.Context, runId uuid.UUID, deltas models.ReconciliationRunCountDeltas) error
+	CreateReconciliationMatches(ctx context.Context, runId uuid.UUID, params []models.CreateReconciliationMatchParams) error
+	GetReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error)
+	CountReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) (int64, error)
+	GetReconciliationMatch(ctx context.Context, runId uuid.UUID, matchId uuid.UUID) (models.ReconciliationMatch, error)
+	UpdateReconciliationMatchStatus(ctx context.Context, runId uuid.UUID, matchId uuid.UUID, status string, updatedBy uuid.UUID) error
+	CreateReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow, skipped bool) error
+	GetReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error)
+	CountReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) (int64, error)
+	ResolveReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rowIds []string, matchId uuid.UUID) (int64, error)
+	ReopenReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow) error
+	WithReconciliationTransaction(ctx context.Context, fn func(ReconciliationStore) error) error
+}
+
+func (s *appStore) CreateReconciliation(ctx context.Context, params models.CreateReconciliationParams) (models.Reconciliation, error) {
+	reconciliation := models.Reconciliation{
+		ID:              uuid.New(),
+		OrganizationId:  params.OrganizationId,
+		OwnerId:         params.OwnerId,
+		Name:            params.Name,
+		Description:     params.Description,
+		LeftDatasetId:   params.LeftDatasetId,
+		RightDatasetId:  params.RightDatasetId,
+		ResultDatasetId: params.ResultDatasetId,
+		CreatedAt:       time.Now(),
+		CreatedBy:       params.OwnerId,
+		UpdatedAt:       time.Now(),
+		UpdatedBy:       params.OwnerId,
+	}
+
+	var err error
+	if reconciliation.LeftConfig, err = json.Marshal(params.LeftConfig); err != nil {
+		return models.Reconciliation{}, err
+	}
+	if reconciliation.RightConfig, err = json.Marshal(params.RightConfig); err != nil {
+		return models.Reconciliation{}, err
+	}
+	if reconciliation.Rules, err = json.Marshal(params.Rules); err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	if err := s.client.WithContext(ctx).Create(&reconciliation).Error; err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	return reconciliation, nil
+}
+
+func (s *appStore) GetReconciliationById(ctx context.Context, reconciliationId uuid.UUID) (models.Reconciliation, error) {
+	reconciliation := models.Reconciliation{}
+	err := s.client.WithContext(ctx).
+		Where("reconciliation_id = ?", reconciliationId).
+		Where("deleted_at IS NULL").
+		First(&reconciliation).Error
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	return reconciliation, nil
+}
+
+func (s *appStore) GetReconciliations(ctx context.Context) ([]models.Reconciliation, error) {
+	var reconciliations []models.Reconciliation
+	err := s.client.WithContext(ctx).
+		Where("deleted_at IS NULL").
+		Order("created_at desc").
+		Find(&reconciliations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliations, nil
+}
+
+// UpdateReconciliation replaces the definition of a reconciliation, its datasets can not be changed
+func (s *appStore) UpdateReconciliation(ctx context.Context, reconciliationId uuid.UUID, params models.UpdateReconciliationParams) (models.Reconciliation, error) {
+	reconciliation, err := s.GetReconciliationById(ctx, reconciliationId)
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	leftConfig, err := json.Marshal(params.LeftConfig)
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+	rightConfig, err := json.Marshal(params.RightConfig)
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+	rules, err := json.Marshal(params.Rules)
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	now := time.Now()
+	err = s.client.WithContext(ctx).Model(&reconciliation).Where("reconciliation_id = ?", reconciliationId).Updates(map[string]interface{}{
+		"name":         params.Name,
+		"description":  params.Description,
+		"left_config":  leftConfig,
+		"right_config": rightConfig,
+		"rules":        rules,
+		"updated_by":   params.UpdatedBy,
+		"updated_at":   now,
+	}).Error
+	if err != nil {
+		return models.Reconciliation{}, err
+	}
+
+	reconciliation.Name = params.Name
+	reconciliation.Description = params.Description
+	reconciliation.LeftConfig = leftConfig
+	reconciliation.RightConfig = rightConfig
+	reconciliation.Rules = rules
+	reconciliation.UpdatedBy = params.UpdatedBy
+	reconciliation.UpdatedAt = now
+
+	return reconciliation, nil
+}
+
+func (s *appStore) DeleteReconciliation(ctx context.Context, reconciliationId uuid.UUID, deletedBy uuid.UUID) error {
+	reconciliation, err := s.GetReconciliationById(ctx, reconciliationId)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Model(&reconciliation).Where("reconciliation_id = ?", reconciliationId).Updates(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"deleted_by": deletedBy,
+	}).Error
+}
+
+func (s *appStore) CreateReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, createdBy uuid.UUID) (models.ReconciliationRun, error) {
+	run := models.ReconciliationRun{
+		ID:               uuid.New(),
+		ReconciliationId: reconciliationId,
+		Status:           models.ReconciliationRunStatusRunning,
+		StartedAt:        time.Now(),
+		CreatedBy:        createdBy,
+	}
+
+	if err := s.client.WithContext(ctx).Create(&run).Error; err != nil {
+		return models.ReconciliationRun{}, err
+	}
+
+	return run, nil
+}
+
+func (s *appStore) GetReconciliationRunById(ctx context.Context, runId uuid.UUID) (models.ReconciliationRun, error) {
+	run := models.ReconciliationRun{}
+	err := s.client.WithContext(ctx).
+		Where("reconciliation_run_id = ?", runId).
+		First(&run).Error
+	if err != nil {
+		return models.ReconciliationRun{}, err
+	}
+
+	return run, nil
+}
+
+// GetReconciliationRuns returns the latest runs of a reconciliation first
+func (s *appStore) GetReconciliationRuns(ctx context.Context, reconciliationId uuid.UUID, limit int) ([]models.ReconciliationRun, error) {
+	var runs []models.ReconciliationRun
+	err := s.client.WithContext(ctx).
+		Where("reconciliation_id = ?", reconciliationId).
+		Order("started_at desc").
+		Limit(limit).
+		Find(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+func (s *appStore) GetLatestReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, status string) (models.ReconciliationRun, error) {
+	run := models.ReconciliationRun{}
+	err := s.client.WithContext(ctx).
+		Where("reconciliation_id = ?", reconciliationId).
+		Where("status = ?", status).
+		Order("started_at desc").
+		First(&run).Error
+	if err != nil {
+		return models.ReconciliationRun{}, err
+	}
+
+	return run, nil
+}
+
+func (s *appStore) UpdateReconciliationRunWorkflowId(ctx context.Context, runId uuid.UUID, workflowId string) error {
+	return s.client.WithContext(ctx).Model(&models.ReconciliationRun{}).
+		Where("reconciliation_run_id = ?", runId).
+		Update("workflow_id", workflowId).Error
+}
+
+func (s *appStore) CompleteReconciliationRun(ctx context.Context, runId uuid.UUID, params models.CompleteReconciliationRunParams) error {
+	return s.client.WithContext(ctx).Model(&models.ReconciliationRun{}).
+		Where("reconciliation_run_id = ?", runId).
+		Updates(map[string]interface{}{
+			"status":                params.Status,
+			"left_row_count":        params.LeftRowCount,
+			"right_row_count":       params.RightRowCount,
+			"match_count":           params.MatchCount,
+			"left_exception_count":  params.LeftExceptionCount,
+			"right_exception_count": params.RightExceptionCount,
+			"left_skipped_count":    params.LeftSkippedCount,
+			"right_skipped_count":   params.RightSkippedCount,
+			"error":                 params.Error,
+			"completed_at":          time.Now(),
+		}).Error
+}
+
+// AdjustReconciliationRunCounts keeps the counts of a completed run in line with the manual matches made on it
+func (s *appStore) AdjustReconciliationRunCounts(ctx context.Context, runId uuid.UUID, deltas models.ReconciliationRunCountDeltas) error {
+	return s.client.WithContext(ctx).Model(&models.ReconciliationRun{}).
+		Where("reconciliation_run_id = ?", runId).
+		Updates(map[string]interface{}{
+			"match_count":           gorm.Expr("match_count + ?", deltas.Matches),
+			"left_exception_count":  gorm.Expr("left_exception_count + ?", deltas.LeftExceptions),
+			"right_exception_count": gorm.Expr("right_exception_count + ?", deltas.RightExceptions),
+			"left_skipped_count":    gorm.Expr("left_skipped_count + ?", deltas.LeftSkipped),
+			"right_skipped_count":   gorm.Expr("right_skipped_count + ?", deltas.RightSkipped),
+		}).Error
+}
+
+func (s *appStore) CreateReconciliationMatches(ctx context.Context, runId uuid.UUID, params []models.CreateReconciliationMatchParams) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	matches := make([]models.ReconciliationMatch, len(params))
+	for i, p := range params {
+		leftRows, err := json.Marshal(p.LeftRows)
+		if err != nil {
+			return err
+		}
+		rightRows, err := json.Marshal(p.RightRows)
+		if err != nil {
+			return err
+		}
+
+		matches[i] = models.ReconciliationMatch{
+			ID:                  uuid.New(),
+			ReconciliationRunId: runId,
+			MatchId:             p.MatchId,
+			RuleName:            p.RuleName,
+			MatchType:           p.MatchType,
+			Status:              p.Status,
+			LeftRows:            leftRows,
+			RightRows:           rightRows,
+			LeftAmount:          p.LeftAmount,
+			RightAmount:         p.RightAmount,
+			CreatedAt:           now,
+			CreatedBy:           p.CreatedBy,
+			UpdatedAt:           now,
+			UpdatedBy:           p.CreatedBy,
+		}
+	}
+
+	return s.client.WithContext(ctx).CreateInBatches(&matches, reconciliationInsertBatchSize).Error
+}
+
+func (s *appStore) reconciliationMatchesQuery(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) *gorm.DB {
+	db := s.client.WithContext(ctx).Model(&models.ReconciliationMatch{}).Where("reconciliation_run_id = ?", runId)
+	if len(filters.Statuses) > 0 {
+		db = db.Where("status IN ?", filters.Statuses)
+	}
+
+	return db
+}
+
+func (s *appStore) GetReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error) {
+	db := s.reconciliationMatchesQuery(ctx, runId, filters).Order("created_at, match_id")
+	if filters.PageSize > 0 {
+		db = db.Limit(filters.PageSize)
+		if filters.Page > 0 {
+			db = db.Offset((filters.Page - 1) * filters.PageSize)
+		}
+	}
+
+	var matches []models.ReconciliationMatch
+	if err := db.Find(&matches).Error; err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+func (s *appStore) CountReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) (int64, error) {
+	var count int64
+	if err := s.reconciliationMatchesQuery(ctx, runId, filters).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (s *appStore) GetReconciliationMatch(ctx context.Context, runId uuid.UUID, matchId uuid.UUID) (models.ReconciliationMatch, error) {
+	match := models.ReconciliationMatch{}
+	err := s.client.WithContext(ctx).
+		Where("reconciliation_run_id = ? AND match_id = ?", runId, matchId).
+		First(&match).Error
+	if err != nil {
+		return models.ReconciliationMatch{}, err
+	}
+
+	return match, nil
+}
+
+func (s *appStore) UpdateReconciliationMatchStatus(ctx context.Context, runId uuid.UUID, matchId uuid.UUID, status string, updatedBy uuid.UUID) error {
+	return s.client.WithContext(ctx).Model(&models.ReconciliationMatch{}).
+		Where("reconciliation_run_id = ? AND match_id = ?", runId, matchId).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_by": updatedBy,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+func (s *appStore) CreateReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow, skipped bool) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	exceptions := newReconciliationExceptions(runId, side, rows, skipped)
+	return s.client.WithContext(ctx).CreateInBatches(&exceptions, reconciliationInsertBatchSize).Error
+}
+
+func (s *appStore) reconciliationExceptionsQuery(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) *gorm.DB {
+	db := s.client.WithContext(ctx).Model(&models.ReconciliationException{}).Where("reconciliation_run_id = ?", runId)
+	if len(filters.Sides) > 0 {
+		db = db.Where("side IN ?", filters.Sides)
+	}
+	if len(filters.Statuses) > 0 {
+		db = db.Where("status IN ?", filters.Statuses)
+	}
+	if len(filters.RowIds) > 0 {
+		db = db.Where("row_id IN ?", filters.RowIds)
+	}
+
+	return db
+}
+
+func (s *appStore) GetReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error) {
+	db := s.reconciliationExceptionsQuery(ctx, runId, filters).Order("side, transaction_date, row_id")
+	if filters.PageSize > 0 {
+		db = db.Limit(filters.PageSize)
+		if filters.Page > 0 {
+			db = db.Offset((filters.Page - 1) * filters.PageSize)
+		}
+	}
+
+	var exceptions []models.ReconciliationException
+	if err := db.Find(&exceptions).Error; err != nil {
+		return nil, err
+	}
+
+	return exceptions, nil
+}
+
+func (s *appStore) CountReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) (int64, error) {
+	var count int64
+	if err := s.reconciliationExceptionsQuery(ctx, runId, filters).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ResolveReconciliationExceptions attaches the open exceptions of the rows to a manual match and returns how many
+// were resolved, callers compare it to the number of rows to detect rows that were not open anymore
+func (s *appStore) ResolveReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rowIds []string, matchId uuid.UUID) (int64, error) {
+	result := s.client.WithContext(ctx).Model(&models.ReconciliationException{}).
+		Where("reconciliation_run_id = ? AND side = ? AND row_id IN ? AND status = ?", runId, side, rowIds, models.ReconciliationExceptionStatusOpen).
+		Updates(map[string]interface{}{
+			"status":     models.ReconciliationExceptionStatusResolved,
+			"match_id":   matchId,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// ReopenReconciliationExceptions puts the rows of an unmatched match back in the exception queue, rows matched by
+// the run itself never had an exception so they are inserted while manually matched rows are reopened. Reopened rows
+// are not skipped anymore, a user compared them.
+func (s *appStore) ReopenReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	exceptions := newReconciliationExceptions(runId, side, rows, false)
+	return s.client.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "reconciliation_run_id"}, {Name: "side"}, {Name: "row_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "skipped", "match_id", "updated_at"}),
+	}).CreateInBatches(&exceptions, reconciliationInsertBatchSize).Error
+}
+
+func newReconciliationExceptions(runId uuid.UUID, side string, rows []models.ReconciliationRow, skipped bool) []models.ReconciliationException {
+	now := time.Now()
+	exceptions := make([]models.ReconciliationException, len(rows))
+	for i, row := range rows {
+		exceptions[i] = models.ReconciliationException{
+			ID:                  uuid.New(),
+			ReconciliationRunId: runId,
+			Side:                side,
+			RowId:               row.RowId,
+			Amount:              row.Amount,
+			TransactionDate:     row.Date,
+			Reference:           row.Reference,
+			Status:              models.ReconciliationExceptionStatusOpen,
+			Skipped:             skipped,
+			CreatedAt:           now,
+			UpdatedAt:           now,
+		}
+	}
+
+	return exceptions
+}
+
+func (s *appStore) WithReconciliationTransaction(ctx context.Context, fn func(ReconciliationStore) error) error {
+	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txClient := pgclient.PostgresClient{DB: tx}
+		return fn(&appStore{client: &txClient})
+	})
+}
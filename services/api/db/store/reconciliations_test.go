@@ -0,0 +1,158 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateReconciliation(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	userID := uuid.New()
+	leftDatasetID := uuid.New()
+	rightDatasetID := uuid.New()
+
+	params := models.CreateReconciliationParams{
+		OrganizationId: orgID,
+		OwnerId:        userID,
+		Name:           "Bank vs ledger",
+		LeftDatasetId:  leftDatasetID,
+		RightDatasetId: rightDatasetID,
+		LeftConfig:     map[string]interface{}{"amount_column": "amount"},
+		RightConfig:    map[string]interface{}{"amount_column": "net_amount"},
+		Rules:          []map[string]interface{}{{"name": "reference", "keys": []map[string]string{{"left_column": "ref", "right_column": "bank_ref"}}}},
+	}
+
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)
+	frapColumns := []string{"resource_type", "resource_id", "user_id", "privilege"}
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeDataset, leftDatasetID, userID, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns).AddRow("dataset", leftDatasetID, userID, "viewer"))
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeDataset, rightDatasetID, userID, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns).AddRow("dataset", rightDatasetID, userID, "viewer"))
+				mock.ExpectQuery(`INSERT INTO "reconciliations"`).
+					WillReturnRows(sqlmock.NewRows([]string{"reconciliation_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "no access to the left dataset",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeDataset, leftDatasetID, userID, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			reconciliation, err := store.CreateReconciliation(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "Bank vs ledger", reconciliation.Name)
+				assert.JSONEq(t, `{"amount_column":"amount"}`, string(reconciliation.LeftConfig))
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestResolveReconciliationExceptions(t *testing.T) {
+	t.Parallel()
+
+	runID := uuid.New()
+	matchID := uuid.New()
+
+	expectedQuery := regexp.QuoteMeta(`UPDATE "reconciliation_exceptions" SET "match_id"=$1,"status"=$2,"updated_at"=$3 WHERE reconciliation_run_id = $4 AND side = $5 AND row_id IN ($6,$7) AND status = $8`)
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		want      int64
+		wantErr   bool
+	}{
+		{
+			name: "resolves the open exceptions",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(expectedQuery).
+					WithArgs(matchID, models.ReconciliationExceptionStatusResolved, sqlmock.AnyArg(), runID, models.ReconciliationSideLeft, "row-1", "row-2", models.ReconciliationExceptionStatusOpen).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			want: 2,
+		},
+		{
+			name: "database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(expectedQuery).
+					WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			resolved, err := store.ResolveReconciliationExceptions(context.Background(), runID, models.ReconciliationSideLeft, []string{"row-1", "row-2"}, matchID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, resolved)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ReferenceBankStore interface {
+	CreateReferenceBank(ctx context.Context, params models.CreateReferenceBankParams) (models.ReferenceBank, error)
+	GetReferenceBanks(ctx context.Context) ([]models.ReferenceBank, error)
+	DeleteReferenceBank(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID) error
+}
+
+func (s *appStore) CreateReferenceBank(ctx context.Context, params models.CreateReferenceBankParams) (models.ReferenceBank, error) {
+	bank := models.ReferenceBank{
+		ID:             uuid.New(),
+		OrganizationId: params.OrganizationId,
+		Code:           params.Code,
+		Name:           params.Name,
+		Country:        params.Country,
+		BIC:            params.BIC,
+		CreatedAt:      time.Now(),
+		CreatedBy:      params.CreatedBy,
+		UpdatedAt:      time.Now(),
+		UpdatedBy:      params.CreatedBy,
+	}
+
+	if err := s.client.WithContext(ctx).Create(&bank).Error; err != nil {
+		return models.ReferenceBank{}, err
+	}
+
+	return bank, nil
+}
+
+func (s *appStore) GetReferenceBanks(ctx context.Context) ([]models.ReferenceBank, error) {
+	var banks []models.ReferenceBank
+	err := s.client.WithContext(ctx).
+		Where("deleted_at IS NULL").
+		Order("code").
+		Find(&banks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return banks, nil
+}
+
+func (s *appStore) DeleteReferenceBank(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID) error {
+	now := time.Now()
+	result := s.client.WithContext(ctx).Model(&models.ReferenceBank{}).
+		Where("reference_bank_id = ?", bankId).
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"deleted_at": now,
+			"deleted_by": deletedBy,
+			"updated_at": now,
+			"updated_by": deletedBy,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateReferenceBank(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	userID := uuid.New()
+	country := "USA"
+
+	params := models.CreateReferenceBankParams{
+		OrganizationId: orgID,
+		Code:           "ACME",
+		Name:           "Acme Bank",
+		Country:        &country,
+		CreatedBy:      userID,
+	}
+
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)
+	frapColumns := []string{"resource_type", "resource_id", "user_id", "privilege"}
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeOrganization, orgID, userID, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns).AddRow("organization", orgID, userID, "member"))
+				mock.ExpectQuery(`INSERT INTO "reference_banks"`).
+					WillReturnRows(sqlmock.NewRows([]string{"reference_bank_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "not a member of the organization",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(frapQuery).
+					WithArgs(models.ResourceTypeOrganization, orgID, userID, 1).
+					WillReturnRows(sqlmock.NewRows(frapColumns))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+
+			bank, err := store.CreateReferenceBank(ctx, params)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "ACME", bank.Code)
+				assert.Equal(t, &country, bank.Country)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeleteReferenceBank(t *testing.T) {
+	t.Parallel()
+
+	bankID := uuid.New()
+	userID := uuid.New()
+
+	expectedQuery := regexp.QuoteMeta(`UPDATE "reference_banks" SET "deleted_at"=$1,"deleted_by"=$2,"updated_at"=$3,"updated_by"=$4 WHERE reference_bank_id = $5 AND deleted_at IS NULL`)
+
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      error
+	}{
+		{name: "success", rowsAffected: 1},
+		{name: "already deleted", rowsAffected: 0, wantErr: gorm.ErrRecordNotFound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			mock.ExpectBegin()
+			mock.ExpectExec(expectedQuery).
+				WithArgs(sqlmock.AnyArg(), userID, sqlmock.AnyArg(), userID, bankID).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			mock.ExpectCommit()
+
+			err := store.DeleteReferenceBank(context.Background(), bankID, userID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
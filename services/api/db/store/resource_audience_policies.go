@@ -52,6 +52,13 @@ type pagePoliciesWriteStore interface {
 	DeletePagePolicy(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error
 }
 
+type datasetViewPoliciesStore interface {
+	GetDatasetViewPolicies(ctx context.Context, viewId uuid.UUID) ([]models.ResourceAudiencePolicy, error)
+	CreateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)
+	UpdateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)
+	DeleteDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error
+}
+
 type ConnectionPoliciesStore interface {
 	connectionPoliciesReadStore
 	connectionPoliciesWriteStore
@@ -109,6 +116,10 @@ func (s *appStore) GetConnectionPolicies(ctx context.Context, connectionId uuid.
 	return s.getResourcePolicies(ctx, connectionId, models.ResourceTypeConnection)
 }
 
+func (s *appStore) GetDatasetViewPolicies(ctx context.Context, viewId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	return s.getResourcePolicies(ctx, viewId, models.ResourceTypeDatasetView)
+}
+
 func (s *appStore) GetOrganizationPoliciesByEmail(ctx context.Context, organizationId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
 	return s.getResourcePoliciesByAudienceEmail(ctx, organizationId, models.ResourceTypeOrganization, email)
 }
@@ -137,6 +148,10 @@ func (s *appStore) CreateConnectionPolicy(ctx context.Context, connectionId uuid
 	return s.createResourcePolicy(ctx, models.ResourceTypeConnection, connectionId, audienceType, audienceId, privilege)
 }
 
+func (s *appStore) CreateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	return s.createResourcePolicy(ctx, models.ResourceTypeDatasetView, viewId, audienceType, audienceId, privilege)
+}
+
 func (s *appStore) createResourcePolicy(ctx context.Context, resourceType models.ResourceType, resourceId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
 	policy := models.ResourceAudiencePolicy{
 		ResourceAudienceType: audienceType,
@@ -168,6 +183,10 @@ func (s *appStore) UpdateConnectionPolicy(ctx context.Context, connectionId uuid
 	return s.updateResourcePolicy(ctx, models.ResourceTypeConnection, connectionId, audienceId, privilege)
 }
 
+func (s *appStore) UpdateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	return s.updateResourcePolicy(ctx, models.ResourceTypeDatasetView, viewId, audienceId, privilege)
+}
+
 func (s *appStore) updateResourcePolicy(ctx context.Context, resourceType models.ResourceType, resourceId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
 	policy := models.ResourceAudiencePolicy{
 		ResourceType:       resourceType,
@@ -196,6 +215,10 @@ func (s *appStore) DeletePagePolicy(ctx context.Context, pageId uuid.UUID, audie
 	return s.deleteResourcePolicy(ctx, models.ResourceTypePage, pageId, audienceType, audienceId)
 }
 
+func (s *appStore) DeleteDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	return s.deleteResourcePolicy(ctx, models.ResourceTypeDatasetView, viewId, audienceType, audienceId)
+}
+
 func (s *appStore) deleteResourcePolicy(ctx context.Context, resourceType models.ResourceType, resourceId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
 
 	policy := models.ResourceAudiencePolicy{
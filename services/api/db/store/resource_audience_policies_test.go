@@ -555,7 +555,7 @@ func TestUpdateOrganizationPolicy(t *testing.T) {
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
 
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 					WithArgs(resourceID, models.ResourceTypeOrganization, currentUserId, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"resource_id"}).AddRow(resourceID))
 				mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "resource_audience_policies" SET "privilege"=$1,"updated_at"=$2 WHERE resource_type = $3 AND resource_id = $4 AND resource_audience_id = $5`)).
@@ -581,7 +581,7 @@ func TestUpdateOrganizationPolicy(t *testing.T) {
 			audienceType: models.AudienceTypeUser,
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 					WithArgs(resourceID, models.ResourceTypeOrganization, currentUserId, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"resource_id"}).AddRow(resourceID))
 				mock.ExpectQuery(regexp.QuoteMeta(`UPDATE "resource_audience_policies" SET "privilege"=$1,"updated_at"=$2 WHERE resource_type = $3 AND resource_id = $4 AND resource_audience_id = $5`)).
@@ -933,7 +933,7 @@ func TestDeleteResourcePolicy(t *testing.T) {
 			resourceType: models.ResourceTypeOrganization,
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 					WithArgs(resourceID, models.ResourceTypeOrganization, audienceID, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"resource_id"}).AddRow(resourceID))
 				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND resource_audience_type = $3 AND resource_audience_id = $4`)).
@@ -951,7 +951,7 @@ func TestDeleteResourcePolicy(t *testing.T) {
 			resourceType: models.ResourceTypeOrganization,
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE (resource_id = $1 ANd resource_type = $2 AND ((resource_type = 'page' AND privilege = 'admin') OR (resource_type = 'dataset' AND privilege = 'admin') OR (resource_type = 'organization' AND privilege = 'system_admin') OR (resource_type = 'connection' AND privilege = 'admin') OR (resource_type = 'dataset_view' AND privilege = 'admin')) AND user_id = $3 AND deleted_at IS NULL) LIMIT $4`)).
 					WithArgs(resourceID, models.ResourceTypeOrganization, audienceID, 1).
 					WillReturnRows(sqlmock.NewRows([]string{"resource_id"}).AddRow(resourceID))
 				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND resource_audience_type = $3 AND resource_audience_id = $4`)).
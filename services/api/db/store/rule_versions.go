@@ -0,0 +1,127 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RuleVersionStore interface {
+	CreateRuleVersion(ctx context.Context, ruleId uuid.UUID, changeType models.RuleChangeType, createdBy uuid.UUID) (models.RuleVersion, error)
+	GetRuleVersions(ctx context.Context, ruleId uuid.UUID) ([]models.RuleVersion, error)
+	GetRuleVersion(ctx context.Context, ruleId uuid.UUID, version int) (models.RuleVersion, error)
+	CreateRuleRuns(ctx context.Context, params []models.CreateRuleRunParams) error
+	GetRuleRuns(ctx context.Context, ruleIds []uuid.UUID, limit int) ([]models.RuleRun, error)
+}
+
+// CreateRuleVersion snapshots the rule as it is stored now under the next version number
+func (s *appStore) CreateRuleVersion(ctx context.Context, ruleId uuid.UUID, changeType models.RuleChangeType, createdBy uuid.UUID) (models.RuleVersion, error) {
+	var version models.RuleVersion
+
+	err := s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var rule models.Rule
+		if err := tx.Where("rule_id = ?", ruleId).First(&rule).Error; err != nil {
+			return err
+		}
+
+		var latestVersion int
+		if err := tx.Model(&models.RuleVersion{}).
+			Where("rule_id = ?", ruleId).
+			Select("COALESCE(MAX(version), 0)").
+			Scan(&latestVersion).Error; err != nil {
+			return err
+		}
+
+		version = models.RuleVersion{
+			ID:           uuid.New(),
+			RuleId:       rule.ID,
+			DatasetId:    rule.DatasetId,
+			Version:      latestVersion + 1,
+			ChangeType:   changeType,
+			Title:        rule.Title,
+			Description:  rule.Description,
+			Value:        rule.Value,
+			FilterConfig: rule.FilterConfig,
+			Assignments:  rule.Assignments,
+			IsEnabled:    rule.IsEnabled,
+			CreatedAt:    time.Now(),
+			CreatedBy:    createdBy,
+		}
+
+		return tx.Create(&version).Error
+	})
+	if err != nil {
+		return models.RuleVersion{}, err
+	}
+
+	return version, nil
+}
+
+// GetRuleVersions returns the versions of a rule, latest first
+func (s *appStore) GetRuleVersions(ctx context.Context, ruleId uuid.UUID) ([]models.RuleVersion, error) {
+	var versions []models.RuleVersion
+	err := s.client.WithContext(ctx).
+		Where("rule_id = ?", ruleId).
+		Order("version desc").
+		Find(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+func (s *appStore) GetRuleVersion(ctx context.Context, ruleId uuid.UUID, version int) (models.RuleVersion, error) {
+	ruleVersion := models.RuleVersion{}
+	err := s.client.WithContext(ctx).
+		Where("rule_id = ?", ruleId).
+		Where("version = ?", version).
+		First(&ruleVersion).Error
+	if err != nil {
+		return models.RuleVersion{}, err
+	}
+
+	return ruleVersion, nil
+}
+
+func (s *appStore) CreateRuleRuns(ctx context.Context, params []models.CreateRuleRunParams) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	runs := make([]models.RuleRun, len(params))
+	for i, param := range params {
+		runs[i] = models.RuleRun{
+			ID:          uuid.New(),
+			RuleId:      param.RuleId,
+			DatasetId:   param.DatasetId,
+			ActionId:    param.ActionId,
+			MatchedRows: param.MatchedRows,
+			CreatedAt:   time.Now(),
+		}
+	}
+
+	return s.client.WithContext(ctx).Create(&runs).Error
+}
+
+// GetRuleRuns returns up to limit of the latest runs of each rule, latest first
+func (s *appStore) GetRuleRuns(ctx context.Context, ruleIds []uuid.UUID, limit int) ([]models.RuleRun, error) {
+	var runs []models.RuleRun
+	err := s.client.WithContext(ctx).
+		Where(`rule_run_id IN (
+			SELECT ranked.rule_run_id FROM (
+				SELECT rule_run_id, ROW_NUMBER() OVER (PARTITION BY rule_id ORDER BY created_at DESC) AS run_rank
+				FROM "app"."rule_runs" WHERE rule_id IN (?)
+			) ranked WHERE ranked.run_rank <= ?
+		)`, ruleIds, limit).
+		Order("created_at desc").
+		Find(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
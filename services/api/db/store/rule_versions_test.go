@@ -0,0 +1,144 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRuleVersion(t *testing.T) {
+	t.Parallel()
+
+	ruleID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		mockSetup   func(sqlmock.Sqlmock)
+		wantVersion int
+		wantErr     bool
+	}{
+		{
+			name: "next version",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "rules" WHERE rule_id = $1 ORDER BY "rules"."rule_id" LIMIT $2`)).
+					WithArgs(ruleID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"rule_id", "dataset_id", "title", "value", "filter_config", "is_enabled"}).
+						AddRow(ruleID, datasetID, "Vendor", "travel", []byte(`{"sql":"vendor = 'x'"}`), true))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(MAX(version), 0) FROM "rule_versions" WHERE rule_id = $1`)).
+					WithArgs(ruleID).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(2))
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs(models.ResourceTypeDataset, datasetID, userID, models.PrivilegeDatasetAdmin, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow("dataset", datasetID, userID, "admin"))
+				mock.ExpectQuery(`INSERT INTO "rule_versions"`).
+					WillReturnRows(sqlmock.NewRows([]string{"rule_version_id"}).AddRow(uuid.New()))
+				mock.ExpectCommit()
+			},
+			wantVersion: 3,
+		},
+		{
+			name: "rule not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "rules" WHERE rule_id = $1 ORDER BY "rules"."rule_id" LIMIT $2`)).
+					WithArgs(ruleID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"rule_id"}))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{})
+
+			version, err := store.CreateRuleVersion(ctx, ruleID, models.RuleChangeTypeUpdated, userID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantVersion, version.Version)
+				assert.Equal(t, "travel", version.Value)
+				assert.Equal(t, models.RuleChangeTypeUpdated, version.ChangeType)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetRuleVersions(t *testing.T) {
+	t.Parallel()
+
+	ruleID := uuid.New()
+	gormDB, mock := getMockDB(t)
+	store := &appStore{
+		client: &pgclient.PostgresClient{DB: gormDB},
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "rule_versions" WHERE rule_id = $1 ORDER BY version desc`)).
+		WithArgs(ruleID).
+		WillReturnRows(sqlmock.NewRows([]string{"rule_version_id", "rule_id", "version"}).
+			AddRow(uuid.New(), ruleID, 2).
+			AddRow(uuid.New(), ruleID, 1))
+
+	versions, err := store.GetRuleVersions(context.Background(), ruleID)
+
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateRuleRuns(t *testing.T) {
+	t.Parallel()
+
+	datasetID := uuid.New()
+	userID := uuid.New()
+	gormDB, mock := getMockDB(t)
+	store := &appStore{
+		client: &pgclient.PostgresClient{DB: gormDB},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+			AddRow("dataset", datasetID, userID, "admin"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"resource_type", "resource_id", "user_id", "privilege"}).
+			AddRow("dataset", datasetID, userID, "admin"))
+	mock.ExpectQuery(`INSERT INTO "rule_runs"`).
+		WillReturnRows(sqlmock.NewRows([]string{"rule_run_id"}).AddRow(uuid.New()).AddRow(uuid.New()))
+	mock.ExpectCommit()
+
+	ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{})
+	err := store.CreateRuleRuns(ctx, []models.CreateRuleRunParams{
+		{RuleId: uuid.New(), DatasetId: datasetID, ActionId: "action-1", MatchedRows: 10},
+		{RuleId: uuid.New(), DatasetId: datasetID, ActionId: "action-1", MatchedRows: 0},
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NoError(t, store.CreateRuleRuns(ctx, nil))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/Zampfi/application-platform/services/api/db/models"
@@ -20,6 +21,7 @@ type RuleStore interface {
 	GetRuleByIds(ctx context.Context, ruleIds []uuid.UUID) ([]models.Rule, error)
 	UpdateRule(ctx context.Context, ruleId uuid.UUID, params models.UpdateRuleParams) error
 	UpdateRulePriority(ctx context.Context, params models.UpdateRulePriorityParams) error
+	SetRuleEnabled(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID) error
 	DeleteRule(ctx context.Context, params models.DeleteRuleParams) error
 }
 
@@ -29,6 +31,11 @@ func (s *appStore) CreateRule(ctx context.Context, params models.CreateRuleParam
 		return err
 	}
 
+	assignments, err := marshalRuleAssignments(params.Assignments)
+	if err != nil {
+		return err
+	}
+
 	rule := models.Rule{
 		ID:             params.Id,
 		OrganizationId: params.OrganizationId,
@@ -36,9 +43,11 @@ func (s *appStore) CreateRule(ctx context.Context, params models.CreateRuleParam
 		Column:         params.Column,
 		Value:          params.Value,
 		FilterConfig:   filterConfig,
+		Assignments:    assignments,
 		Title:          params.Title,
 		Description:    params.Description,
 		Priority:       1,
+		IsEnabled:      true,
 		CreatedAt:      time.Now(),
 		CreatedBy:      params.CreatedBy,
 		UpdatedAt:      time.Now(),
@@ -83,7 +92,11 @@ func (s *appStore) GetRules(ctx context.Context, params models.FilterRuleParams)
 	}
 
 	if len(columns) > 0 {
-		db = db.Where("\"column\" IN (?)", columns)
+		if params.IncludeAssignments {
+			db = db.Where("\"column\" IN (?) OR EXISTS (SELECT 1 FROM jsonb_array_elements(assignments) AS assignment WHERE assignment->>'column' IN (?))", columns, columns)
+		} else {
+			db = db.Where("\"column\" IN (?)", columns)
+		}
 	}
 
 	db = db.Where("deleted_at IS NULL").Order("priority asc")
@@ -102,9 +115,54 @@ func (s *appStore) GetRules(ctx context.Context, params models.FilterRuleParams)
 		rulesMap[rule.DatasetId.String()][rule.Column] = append(rulesMap[rule.DatasetId.String()][rule.Column], rule)
 	}
 
+	if params.IncludeAssignments {
+		for _, rule := range rules {
+			for _, column := range getRuleAssignedColumns(rule) {
+				if column == rule.Column || !slices.Contains(columns, column) {
+					continue
+				}
+				rulesMap[rule.DatasetId.String()][column] = append(rulesMap[rule.DatasetId.String()][column], rule)
+			}
+		}
+	}
+
 	return rulesMap, nil
 }
 
+// getRuleAssignedColumns returns the columns a rule assigns, a rule that cannot be read assigns only its own column
+func getRuleAssignedColumns(rule models.Rule) []string {
+	var assignments []struct {
+		Column string `json:"column"`
+	}
+	if len(rule.Assignments) == 0 || json.Unmarshal(rule.Assignments, &assignments) != nil {
+		return []string{rule.Column}
+	}
+
+	columns := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		columns = append(columns, assignment.Column)
+	}
+
+	return columns
+}
+
+// marshalRuleAssignments stores a rule without assignments as an empty list, the rule then writes its value to its column
+func marshalRuleAssignments(assignments interface{}) (json.RawMessage, error) {
+	if assignments == nil {
+		return json.RawMessage("[]"), nil
+	}
+
+	assignmentsJson, err := json.Marshal(assignments)
+	if err != nil {
+		return nil, err
+	}
+	if string(assignmentsJson) == "null" {
+		return json.RawMessage("[]"), nil
+	}
+
+	return assignmentsJson, nil
+}
+
 func (s *appStore) GetRuleByIds(ctx context.Context, ruleIds []uuid.UUID) ([]models.Rule, error) {
 	db := s.client.WithContext(ctx)
 
@@ -144,11 +202,17 @@ func (s *appStore) UpdateRule(ctx context.Context, ruleId uuid.UUID, params mode
 		return err
 	}
 
+	assignments, err := marshalRuleAssignments(params.Assignments)
+	if err != nil {
+		return err
+	}
+
 	db = db.Model(rule).Where("rule_id = ?", ruleId).Updates(map[string]interface{}{
 		"title":         params.Title,
 		"description":   params.Description,
 		"value":         params.Value,
 		"filter_config": filterConfig,
+		"assignments":   assignments,
 		"updated_by":    params.UpdatedBy,
 		"updated_at":    time.Now(),
 	})
@@ -182,6 +246,24 @@ func (s *appStore) UpdateRulePriority(ctx context.Context, params models.UpdateR
 		Error
 }
 
+func (s *appStore) SetRuleEnabled(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID) error {
+	rule, err := s.GetRuleById(ctx, ruleId)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).
+		Model(&rule).
+		Where("rule_id = ?", ruleId).
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"is_enabled": isEnabled,
+			"updated_at": time.Now(),
+			"updated_by": updatedBy,
+		}).
+		Error
+}
+
 func (s *appStore) DeleteRule(ctx context.Context, params models.DeleteRuleParams) error {
 	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var rule models.Rule
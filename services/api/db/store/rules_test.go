@@ -311,6 +311,77 @@ func TestUpdateRulePriority(t *testing.T) {
 	}
 }
 
+func TestSetRuleEnabled(t *testing.T) {
+	t.Parallel()
+
+	ruleID := uuid.New()
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name      string
+		isEnabled bool
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name:      "success - disable rule",
+			isEnabled: false,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "rules" WHERE rule_id = \$1 ORDER BY "rules"."rule_id" LIMIT \$2`).
+					WithArgs(ruleID, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"rule_id", "organization_id", "dataset_id", "is_enabled"}).
+						AddRow(ruleID, orgID, datasetID, true))
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND privilege = $4 AND deleted_at IS NULL LIMIT $5`)).
+					WithArgs("dataset", datasetID, userID, "admin", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "resource_type", "resource_id", "user_id", "privilege"}).
+						AddRow(uuid.New(), "dataset", datasetID, userID, "admin"))
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "rules" SET "is_enabled"=$1,"updated_at"=$2,"updated_by"=$3 WHERE rule_id = $4 AND deleted_at IS NULL AND "rule_id" = $5`)).
+					WithArgs(false, sqlmock.AnyArg(), userID, ruleID, ruleID).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name:      "error - rule not found",
+			isEnabled: true,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT \* FROM "rules" WHERE rule_id = \$1 ORDER BY "rules"."rule_id" LIMIT \$2`).
+					WithArgs(ruleID, 1).
+					WillReturnError(gorm.ErrRecordNotFound)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			ctx := apicontext.AddAuthToContext(context.Background(), "role", userID, []uuid.UUID{orgID})
+
+			err := store.SetRuleEnabled(ctx, ruleID, tt.isEnabled, userID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestDeleteRule(t *testing.T) {
 	t.Parallel()
 
@@ -604,6 +675,47 @@ func TestGetRules(t *testing.T) {
 	}
 }
 
+func TestGetRulesIncludeAssignments(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	datasetID := uuid.New()
+	userID := uuid.New()
+	categoryRuleID := uuid.New()
+	vendorRuleID := uuid.New()
+
+	gormDB, mock := getMockDB(t)
+	store := &appStore{
+		client: &pgclient.PostgresClient{DB: gormDB},
+	}
+
+	rows := sqlmock.NewRows([]string{"rule_id", "organization_id", "dataset_id", "column", "value", "priority", "assignments"}).
+		AddRow(categoryRuleID, orgID, datasetID, "category", "Office", 1, []byte(`[]`)).
+		AddRow(vendorRuleID, orgID, datasetID, "cost_center", "", 1, []byte(`[{"column":"cost_center","value_type":"column","source_column":"department"},{"column":"category","value_type":"literal","value":"Travel"}]`))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "rules" WHERE organization_id = $1 AND dataset_id IN ($2) AND ("column" IN ($3) OR EXISTS (SELECT 1 FROM jsonb_array_elements(assignments) AS assignment WHERE assignment->>'column' IN ($4))) AND deleted_at IS NULL ORDER BY priority asc`)).
+		WithArgs(orgID, datasetID, "category", "category").
+		WillReturnRows(rows)
+
+	ctx := apicontext.AddAuthToContext(context.Background(), "role", userID, []uuid.UUID{orgID})
+
+	got, err := store.GetRules(ctx, models.FilterRuleParams{
+		OrganizationId:     orgID,
+		DatasetColumns:     []models.DatasetColumn{{DatasetId: datasetID, Columns: []string{"category"}}},
+		IncludeAssignments: true,
+	})
+
+	assert.NoError(t, err)
+	// the rule of another column assigning the requested column is listed under both
+	categoryRules := got[datasetID.String()]["category"]
+	if assert.Len(t, categoryRules, 2) {
+		assert.Equal(t, categoryRuleID, categoryRules[0].ID)
+		assert.Equal(t, vendorRuleID, categoryRules[1].ID)
+	}
+	assert.Len(t, got[datasetID.String()]["cost_center"], 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetRuleByIds(t *testing.T) {
 	t.Parallel()
 
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/google/uuid"
@@ -14,6 +15,7 @@ type SheetStore interface {
 	GetSheetsAll(ctx context.Context, filters models.SheetFilters) ([]models.Sheet, error)
 	CreateSheet(ctx context.Context, sheet models.Sheet) (*models.Sheet, error)
 	UpdateSheet(ctx context.Context, sheet *models.Sheet) (*models.Sheet, error)
+	DeleteSheet(ctx context.Context, sheetId uuid.UUID) error
 }
 
 func (s *appStore) GetSheetById(ctx context.Context, sheetId uuid.UUID) (*models.Sheet, error) {
@@ -87,3 +89,16 @@ func (s *appStore) UpdateSheet(ctx context.Context, sheet *models.Sheet) (*model
 	}
 	return sheet, nil
 }
+
+// DeleteSheet soft deletes the sheet together with its widget instances
+func (s *appStore) DeleteSheet(ctx context.Context, sheetId uuid.UUID) error {
+	now := time.Now()
+	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.WidgetInstance{}).Where("sheet_id = ? AND deleted_at IS NULL", sheetId).Update("deleted_at", &now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Sheet{}).Where("sheet_id = ?", sheetId).Update("deleted_at", &now).Error
+	})
+}
@@ -568,3 +568,65 @@ func TestAppStore_UpdateSheet(t *testing.T) {
 		})
 	}
 }
+
+func TestAppStore_DeleteSheet(t *testing.T) {
+	t.Parallel()
+
+	sheetId := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func(sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "soft deletes the sheet and its widget instances",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "widget_instances" SET "deleted_at"=$1,"updated_at"=$2 WHERE sheet_id = $3 AND deleted_at IS NULL`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sheetId).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "sheets" SET "deleted_at"=$1,"updated_at"=$2 WHERE sheet_id = $3`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sheetId).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "rolls back when the sheet update fails",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "widget_instances" SET "deleted_at"=$1,"updated_at"=$2`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sheetId).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "sheets" SET "deleted_at"=$1,"updated_at"=$2`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sheetId).
+					WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gormDB, mock := getMockDB(t)
+			store := &appStore{
+				client: &pgclient.PostgresClient{DB: gormDB},
+			}
+			tt.mockSetup(mock)
+
+			err := store.DeleteSheet(context.Background(), sheetId)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -33,6 +33,18 @@ type Store interface {
 	FileUploadStore
 	AuditLogStore
 	PaymentsConfigStore
+	DatasetRowPolicyStore
+	DatasetColumnPolicyStore
+	DatasetViewStore
+	DatasetExportScheduleStore
+	DatasetAlertStore
+	DatasetStatusWorkflowStore
+	DatasetRowAssignmentStore
+	ReconciliationStore
+	FxRateStore
+	ReferenceBankStore
+	TagStore
+	RuleVersionStore
 }
 
 type appStore struct {
@@ -0,0 +1,220 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type TagStore interface {
+	CreateTag(ctx context.Context, params models.CreateTagParams) (models.Tag, error)
+	GetTags(ctx context.Context) ([]models.Tag, error)
+	GetTagById(ctx context.Context, tagId uuid.UUID) (models.Tag, error)
+	UpdateTag(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams) (models.Tag, error)
+	RenameTag(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID) error
+	MergeTags(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID) error
+	DeleteTag(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID) error
+}
+
+func (s *appStore) CreateTag(ctx context.Context, params models.CreateTagParams) (models.Tag, error) {
+	aliases, err := marshalTagAliases(params.Aliases)
+	if err != nil {
+		return models.Tag{}, err
+	}
+
+	tag := models.Tag{
+		ID:             uuid.New(),
+		OrganizationId: params.OrganizationId,
+		ParentId:       params.ParentId,
+		Name:           params.Name,
+		Path:           params.Path,
+		Color:          params.Color,
+		Description:    params.Description,
+		Aliases:        aliases,
+		CreatedAt:      time.Now(),
+		CreatedBy:      params.CreatedBy,
+		UpdatedAt:      time.Now(),
+		UpdatedBy:      params.CreatedBy,
+	}
+
+	if err := s.client.WithContext(ctx).Create(&tag).Error; err != nil {
+		return models.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+func (s *appStore) GetTags(ctx context.Context) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := s.client.WithContext(ctx).
+		Where("deleted_at IS NULL").
+		Order("path").
+		Find(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (s *appStore) GetTagById(ctx context.Context, tagId uuid.UUID) (models.Tag, error) {
+	tag := models.Tag{}
+	err := s.client.WithContext(ctx).
+		Where("tag_id = ?", tagId).
+		Where("deleted_at IS NULL").
+		First(&tag).Error
+	if err != nil {
+		return models.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+func (s *appStore) UpdateTag(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams) (models.Tag, error) {
+	tag, err := s.GetTagById(ctx, tagId)
+	if err != nil {
+		return models.Tag{}, err
+	}
+
+	aliases, err := marshalTagAliases(params.Aliases)
+	if err != nil {
+		return models.Tag{}, err
+	}
+
+	updates := map[string]interface{}{
+		"color":       params.Color,
+		"description": params.Description,
+		"aliases":     aliases,
+		"updated_at":  time.Now(),
+		"updated_by":  params.UpdatedBy,
+	}
+	if err := s.client.WithContext(ctx).Model(&tag).Where("tag_id = ?", tag.ID).Updates(updates).Error; err != nil {
+		return models.Tag{}, err
+	}
+
+	return s.GetTagById(ctx, tagId)
+}
+
+// RenameTag gives the tag a new name and path, the paths of its descendants move with it
+func (s *appStore) RenameTag(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID) error {
+	tag, err := s.GetTagById(ctx, tagId)
+	if err != nil {
+		return err
+	}
+
+	aliasesJSON, err := marshalTagAliases(aliases)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := moveTagDescendants(tx, tag, path, updatedBy); err != nil {
+			return err
+		}
+
+		return tx.Model(&tag).Where("tag_id = ?", tag.ID).Updates(map[string]interface{}{
+			"name":       name,
+			"path":       path,
+			"aliases":    aliasesJSON,
+			"updated_at": time.Now(),
+			"updated_by": updatedBy,
+		}).Error
+	})
+}
+
+// MergeTags moves the children of the source tag under the target and deletes the source, the aliases of the target
+// are expected to already hold the ones of the source
+func (s *appStore) MergeTags(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID) error {
+	source, err := s.GetTagById(ctx, sourceTagId)
+	if err != nil {
+		return err
+	}
+	target, err := s.GetTagById(ctx, targetTagId)
+	if err != nil {
+		return err
+	}
+
+	aliasesJSON, err := marshalTagAliases(targetAliases)
+	if err != nil {
+		return err
+	}
+
+	return s.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Model(&models.Tag{}).
+			Where("parent_id = ?", source.ID).
+			Where("deleted_at IS NULL").
+			Updates(map[string]interface{}{"parent_id": target.ID, "updated_at": now, "updated_by": updatedBy}).Error; err != nil {
+			return err
+		}
+
+		if err := moveTagDescendants(tx, source, target.Path, updatedBy); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&target).Where("tag_id = ?", target.ID).Updates(map[string]interface{}{
+			"aliases":    aliasesJSON,
+			"updated_at": now,
+			"updated_by": updatedBy,
+		}).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&source).Where("tag_id = ?", source.ID).Updates(map[string]interface{}{
+			"deleted_at": now,
+			"deleted_by": updatedBy,
+			"updated_at": now,
+			"updated_by": updatedBy,
+		}).Error
+	})
+}
+
+func (s *appStore) DeleteTag(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID) error {
+	now := time.Now()
+	result := s.client.WithContext(ctx).Model(&models.Tag{}).
+		Where("tag_id = ?", tagId).
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"deleted_at": now,
+			"deleted_by": deletedBy,
+			"updated_at": now,
+			"updated_by": deletedBy,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// moveTagDescendants replaces the path of the tag at the start of the paths of its descendants. Paths are compared
+// with left() rather than LIKE so tag names need no escaping.
+func moveTagDescendants(tx *gorm.DB, tag models.Tag, path string, updatedBy uuid.UUID) error {
+	prefix := tag.Path + "."
+	prefixLength := utf8.RuneCountInString(prefix)
+
+	return tx.Model(&models.Tag{}).
+		Where("organization_id = ?", tag.OrganizationId).
+		Where("left(path, ?) = ?", prefixLength, prefix).
+		Where("deleted_at IS NULL").
+		Updates(map[string]interface{}{
+			"path":       gorm.Expr("? || substr(path, ?)", path+".", prefixLength+1),
+			"updated_at": time.Now(),
+			"updated_by": updatedBy,
+		}).Error
+}
+
+func marshalTagAliases(aliases []string) (json.RawMessage, error) {
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return json.Marshal(aliases)
+}
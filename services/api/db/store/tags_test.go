@@ -0,0 +1,104 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/Zampfi/application-platform/services/api/db/pgclient"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCreateTag(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	userID := uuid.New()
+
+	frapQuery := regexp.QuoteMeta(`SELECT * FROM "flattened_resource_audience_policies" WHERE resource_type = $1 AND resource_id = $2 AND user_id = $3 AND deleted_at IS NULL LIMIT $4`)
+	frapColumns := []string{"resource_type", "resource_id", "user_id", "privilege"}
+
+	gormDB, mock := getMockDB(t)
+	store := &appStore{
+		client: &pgclient.PostgresClient{DB: gormDB},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(frapQuery).
+		WithArgs(models.ResourceTypeOrganization, orgID, userID, 1).
+		WillReturnRows(sqlmock.NewRows(frapColumns).AddRow("organization", orgID, userID, "member"))
+	mock.ExpectQuery(`INSERT INTO "tags"`).
+		WillReturnRows(sqlmock.NewRows([]string{"tag_id"}).AddRow(uuid.New()))
+	mock.ExpectCommit()
+
+	ctx := apicontext.AddAuthToContext(context.Background(), "user", userID, []uuid.UUID{orgID})
+	tag, err := store.CreateTag(ctx, models.CreateTagParams{
+		OrganizationId: orgID,
+		Name:           "Payroll",
+		Path:           "Expenses.Payroll",
+		CreatedBy:      userID,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Expenses.Payroll", tag.Path)
+	assert.JSONEq(t, `[]`, string(tag.Aliases))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRenameTag(t *testing.T) {
+	t.Parallel()
+
+	orgID := uuid.New()
+	tagID := uuid.New()
+	userID := uuid.New()
+
+	gormDB, mock := getMockDB(t)
+	store := &appStore{
+		client: &pgclient.PostgresClient{DB: gormDB},
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "tags" WHERE tag_id = $1 AND deleted_at IS NULL ORDER BY "tags"."tag_id" LIMIT $2`)).
+		WithArgs(tagID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"tag_id", "organization_id", "name", "path"}).AddRow(tagID, orgID, "Payroll", "Expenses.Payroll"))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tags" SET "path"=$1 || substr(path, $2),"updated_at"=$3,"updated_by"=$4 WHERE organization_id = $5 AND left(path, $6) = $7 AND deleted_at IS NULL`)).
+		WithArgs("Expenses.Salaries.", 18, sqlmock.AnyArg(), userID, orgID, 17, "Expenses.Payroll.").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tags" SET "aliases"=$1,"name"=$2,"path"=$3,"updated_at"=$4,"updated_by"=$5 WHERE tag_id = $6 AND "tag_id" = $7`)).
+		WithArgs([]byte(`["Expenses.Payroll"]`), "Salaries", "Expenses.Salaries", sqlmock.AnyArg(), userID, tagID, tagID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := store.RenameTag(context.Background(), tagID, "Salaries", "Expenses.Salaries", []string{"Expenses.Payroll"}, userID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteTag(t *testing.T) {
+	t.Parallel()
+
+	tagID := uuid.New()
+	userID := uuid.New()
+
+	gormDB, mock := getMockDB(t)
+	store := &appStore{
+		client: &pgclient.PostgresClient{DB: gormDB},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "tags" SET "deleted_at"=$1,"deleted_by"=$2,"updated_at"=$3,"updated_by"=$4 WHERE tag_id = $5 AND deleted_at IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), userID, sqlmock.AnyArg(), userID, tagID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := store.DeleteTag(context.Background(), tagID, userID)
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
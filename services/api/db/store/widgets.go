@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ type WidgetStore interface {
 	GetWidgetTemplate(ctx context.Context, widgetType string) (models.Widget, error)
 	CreateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error)
 	UpdateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error)
+	DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error
 }
 
 func (s *appStore) GetWidgetInstanceByID(ctx context.Context, widgetInstanceID uuid.UUID) (models.WidgetInstance, error) {
@@ -44,3 +46,11 @@ func (s *appStore) UpdateWidgetInstance(ctx context.Context, widgetInstance *mod
 
 	return widgetInstance, db.Save(widgetInstance).Error
 }
+
+func (s *appStore) DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error {
+	now := time.Now()
+	return s.client.WithContext(ctx).
+		Model(&models.WidgetInstance{}).
+		Where("widget_instance_id = ?", widgetInstanceID).
+		Update("deleted_at", &now).Error
+}
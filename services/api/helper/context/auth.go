@@ -25,6 +25,20 @@ func AddAuthToContext(ctx context.Context, role string, userID uuid.UUID, userOr
 	return enrichedCtx
 }
 
+// internalAccessKey is kept out of the context variables, which are shared with the parent context
+type internalAccessKey struct{}
+
+// AddInternalAccessToContext marks reads made by the platform itself rather than on behalf of a user,
+// the data policies of the users are not applied to them
+func AddInternalAccessToContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internalAccessKey{}, true)
+}
+
+func HasInternalAccessInContext(ctx context.Context) bool {
+	internalAccess, _ := ctx.Value(internalAccessKey{}).(bool)
+	return internalAccess
+}
+
 func AddAuditInfoToContext(ctx context.Context, email string, ipAddress string, userAgent string) context.Context {
 	enrichedCtx := AddCtxVariableToCtx(ctx, contextKeyUserEmail, email)
 	enrichedCtx = AddCtxVariableToCtx(enrichedCtx, contextKeyUserIPAddress, ipAddress)
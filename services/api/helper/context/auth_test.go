@@ -76,6 +76,17 @@ func TestIsZampEmail(t *testing.T) {
 	assert.False(t, IsZampEmail("admin@zamp.com"))
 }
 
+func TestAddInternalAccessToContext(t *testing.T) {
+	ctx := AddAuthToContext(context.Background(), "user", uuid.New(), []uuid.UUID{})
+	assert.False(t, HasInternalAccessInContext(ctx))
+
+	internalCtx := AddInternalAccessToContext(ctx)
+	assert.True(t, HasInternalAccessInContext(internalCtx))
+
+	// the parent context is left untouched
+	assert.False(t, HasInternalAccessInContext(ctx))
+}
+
 func TestAddAuditInfoToContext(t *testing.T) {
 	ctx := context.Background()
 	email := "test@example.com"
@@ -1445,6 +1445,126 @@ func (_c *MockAuthServiceStore_GetUsersAll_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// UpdateOrganizationCalendarSettings provides a mock function with given fields: ctx, organizationId, calendarSettings
+func (_m *MockAuthServiceStore) UpdateOrganizationCalendarSettings(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, calendarSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationCalendarSettings")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, calendarSettings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, calendarSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, calendarSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationCalendarSettings'
+type MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationCalendarSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - calendarSettings json.RawMessage
+func (_e *MockAuthServiceStore_Expecter) UpdateOrganizationCalendarSettings(ctx interface{}, organizationId interface{}, calendarSettings interface{}) *MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call {
+	return &MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call{Call: _e.mock.On("UpdateOrganizationCalendarSettings", ctx, organizationId, calendarSettings)}
+}
+
+func (_c *MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage)) *MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockAuthServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationExportSettings provides a mock function with given fields: ctx, organizationId, exportSettings
+func (_m *MockAuthServiceStore) UpdateOrganizationExportSettings(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, exportSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationExportSettings")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, exportSettings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, exportSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, exportSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthServiceStore_UpdateOrganizationExportSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationExportSettings'
+type MockAuthServiceStore_UpdateOrganizationExportSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationExportSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - exportSettings json.RawMessage
+func (_e *MockAuthServiceStore_Expecter) UpdateOrganizationExportSettings(ctx interface{}, organizationId interface{}, exportSettings interface{}) *MockAuthServiceStore_UpdateOrganizationExportSettings_Call {
+	return &MockAuthServiceStore_UpdateOrganizationExportSettings_Call{Call: _e.mock.On("UpdateOrganizationExportSettings", ctx, organizationId, exportSettings)}
+}
+
+func (_c *MockAuthServiceStore_UpdateOrganizationExportSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage)) *MockAuthServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *MockAuthServiceStore_UpdateOrganizationExportSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockAuthServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthServiceStore_UpdateOrganizationExportSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockAuthServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
 func (_m *MockAuthServiceStore) UpdateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
 	ret := _m.Called(ctx, invitationId, status)
@@ -3,9 +3,10 @@
 package mock_service
 
 import (
-	context "context"
-
 	actionsmodels "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/models"
+	constants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+
+	context "context"
 
 	datasetsmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
 
@@ -17,6 +18,8 @@ import (
 
 	rulesmodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -95,6 +98,192 @@ func (_c *MockDatasetService_AddAudienceToDataset_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// AddAudienceToDatasetView provides a mock function with given fields: ctx, userId, datasetId, viewId, audienceType, audienceId, privilege
+func (_m *MockDatasetService) AddAudienceToDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, userId, datasetId, viewId, audienceType, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAudienceToDatasetView")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, userId, datasetId, viewId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, userId, datasetId, viewId, audienceType, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, userId, datasetId, viewId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_AddAudienceToDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAudienceToDatasetView'
+type MockDatasetService_AddAudienceToDatasetView_Call struct {
+	*mock.Call
+}
+
+// AddAudienceToDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetService_Expecter) AddAudienceToDatasetView(ctx interface{}, userId interface{}, datasetId interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockDatasetService_AddAudienceToDatasetView_Call {
+	return &MockDatasetService_AddAudienceToDatasetView_Call{Call: _e.mock.On("AddAudienceToDatasetView", ctx, userId, datasetId, viewId, audienceType, audienceId, privilege)}
+}
+
+func (_c *MockDatasetService_AddAudienceToDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetService_AddAudienceToDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(models.AudienceType), args[5].(uuid.UUID), args[6].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_AddAudienceToDatasetView_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetService_AddAudienceToDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_AddAudienceToDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetService_AddAudienceToDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AnalyzeDatasetRules provides a mock function with given fields: ctx, merchantId, datasetId, column
+func (_m *MockDatasetService) AnalyzeDatasetRules(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, column string) ([]datasetsmodels.DatasetRuleAnalysis, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, column)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnalyzeDatasetRules")
+	}
+
+	var r0 []datasetsmodels.DatasetRuleAnalysis
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) ([]datasetsmodels.DatasetRuleAnalysis, error)); ok {
+		return rf(ctx, merchantId, datasetId, column)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) []datasetsmodels.DatasetRuleAnalysis); ok {
+		r0 = rf(ctx, merchantId, datasetId, column)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetRuleAnalysis)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, column)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_AnalyzeDatasetRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnalyzeDatasetRules'
+type MockDatasetService_AnalyzeDatasetRules_Call struct {
+	*mock.Call
+}
+
+// AnalyzeDatasetRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId uuid.UUID
+//   - column string
+func (_e *MockDatasetService_Expecter) AnalyzeDatasetRules(ctx interface{}, merchantId interface{}, datasetId interface{}, column interface{}) *MockDatasetService_AnalyzeDatasetRules_Call {
+	return &MockDatasetService_AnalyzeDatasetRules_Call{Call: _e.mock.On("AnalyzeDatasetRules", ctx, merchantId, datasetId, column)}
+}
+
+func (_c *MockDatasetService_AnalyzeDatasetRules_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, column string)) *MockDatasetService_AnalyzeDatasetRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_AnalyzeDatasetRules_Call) Return(_a0 []datasetsmodels.DatasetRuleAnalysis, _a1 error) *MockDatasetService_AnalyzeDatasetRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_AnalyzeDatasetRules_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) ([]datasetsmodels.DatasetRuleAnalysis, error)) *MockDatasetService_AnalyzeDatasetRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignDatasetRow provides a mock function with given fields: ctx, merchantId, userId, datasetId, rowId, params
+func (_m *MockDatasetService) AssignDatasetRow(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, rowId string, params datasetsmodels.DatasetRowAssignmentParams) (datasetsmodels.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, rowId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignDatasetRow")
+	}
+
+	var r0 datasetsmodels.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, datasetsmodels.DatasetRowAssignmentParams) (datasetsmodels.DatasetRowAssignment, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, rowId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, datasetsmodels.DatasetRowAssignmentParams) datasetsmodels.DatasetRowAssignment); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, rowId, params)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetRowAssignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, datasetsmodels.DatasetRowAssignmentParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, rowId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_AssignDatasetRow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignDatasetRow'
+type MockDatasetService_AssignDatasetRow_Call struct {
+	*mock.Call
+}
+
+// AssignDatasetRow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - rowId string
+//   - params datasetsmodels.DatasetRowAssignmentParams
+func (_e *MockDatasetService_Expecter) AssignDatasetRow(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, rowId interface{}, params interface{}) *MockDatasetService_AssignDatasetRow_Call {
+	return &MockDatasetService_AssignDatasetRow_Call{Call: _e.mock.On("AssignDatasetRow", ctx, merchantId, userId, datasetId, rowId, params)}
+}
+
+func (_c *MockDatasetService_AssignDatasetRow_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, rowId string, params datasetsmodels.DatasetRowAssignmentParams)) *MockDatasetService_AssignDatasetRow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string), args[5].(datasetsmodels.DatasetRowAssignmentParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_AssignDatasetRow_Call) Return(_a0 datasetsmodels.DatasetRowAssignment, _a1 error) *MockDatasetService_AssignDatasetRow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_AssignDatasetRow_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, datasetsmodels.DatasetRowAssignmentParams) (datasetsmodels.DatasetRowAssignment, error)) *MockDatasetService_AssignDatasetRow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BulkAddAudienceToDataset provides a mock function with given fields: ctx, datasetId, payload
 func (_m *MockDatasetService) BulkAddAudienceToDataset(ctx context.Context, datasetId uuid.UUID, payload datasetsmodels.BulkAddDatasetAudiencePayload) ([]*models.ResourceAudiencePolicy, datasetsmodels.BulkAddDatasetAudienceErrors) {
 	ret := _m.Called(ctx, datasetId, payload)
@@ -155,6 +344,103 @@ func (_c *MockDatasetService_BulkAddAudienceToDataset_Call) RunAndReturn(run fun
 	return _c
 }
 
+// ClearDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId
+func (_m *MockDatasetService) ClearDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearDefaultDatasetView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetService_ClearDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearDefaultDatasetView'
+type MockDatasetService_ClearDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// ClearDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) ClearDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}) *MockDatasetService_ClearDefaultDatasetView_Call {
+	return &MockDatasetService_ClearDefaultDatasetView_Call{Call: _e.mock.On("ClearDefaultDatasetView", ctx, userId, datasetId)}
+}
+
+func (_c *MockDatasetService_ClearDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID)) *MockDatasetService_ClearDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_ClearDefaultDatasetView_Call) Return(_a0 error) *MockDatasetService_ClearDefaultDatasetView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetService_ClearDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetService_ClearDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteDatasetExportScheduleRunActivity provides a mock function with given fields: ctx, params, plan, errorMessage
+func (_m *MockDatasetService) CompleteDatasetExportScheduleRunActivity(ctx context.Context, params datasetsmodels.DatasetExportScheduleWorkflowParams, plan datasetsmodels.DatasetExportScheduleRunPlan, errorMessage string) error {
+	ret := _m.Called(ctx, params, plan, errorMessage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteDatasetExportScheduleRunActivity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, datasetsmodels.DatasetExportScheduleWorkflowParams, datasetsmodels.DatasetExportScheduleRunPlan, string) error); ok {
+		r0 = rf(ctx, params, plan, errorMessage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteDatasetExportScheduleRunActivity'
+type MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call struct {
+	*mock.Call
+}
+
+// CompleteDatasetExportScheduleRunActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params datasetsmodels.DatasetExportScheduleWorkflowParams
+//   - plan datasetsmodels.DatasetExportScheduleRunPlan
+//   - errorMessage string
+func (_e *MockDatasetService_Expecter) CompleteDatasetExportScheduleRunActivity(ctx interface{}, params interface{}, plan interface{}, errorMessage interface{}) *MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call {
+	return &MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call{Call: _e.mock.On("CompleteDatasetExportScheduleRunActivity", ctx, params, plan, errorMessage)}
+}
+
+func (_c *MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call) Run(run func(ctx context.Context, params datasetsmodels.DatasetExportScheduleWorkflowParams, plan datasetsmodels.DatasetExportScheduleRunPlan, errorMessage string)) *MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(datasetsmodels.DatasetExportScheduleWorkflowParams), args[2].(datasetsmodels.DatasetExportScheduleRunPlan), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call) Return(_a0 error) *MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call) RunAndReturn(run func(context.Context, datasetsmodels.DatasetExportScheduleWorkflowParams, datasetsmodels.DatasetExportScheduleRunPlan, string) error) *MockDatasetService_CompleteDatasetExportScheduleRunActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CopyDataset provides a mock function with given fields: ctx, merchantId, userId, params
 func (_m *MockDatasetService) CopyDataset(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, params datasetsmodels.CopyDatasetParams) (string, uuid.UUID, error) {
 	ret := _m.Called(ctx, merchantId, userId, params)
@@ -223,27 +509,27 @@ func (_c *MockDatasetService_CopyDataset_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// CreateDatasetExportAction provides a mock function with given fields: ctx, merchantId, datasetId, queryConfig, userId
-func (_m *MockDatasetService) CreateDatasetExportAction(ctx context.Context, merchantId uuid.UUID, datasetId string, queryConfig datasetsmodels.DatasetParams, userId uuid.UUID) (string, error) {
-	ret := _m.Called(ctx, merchantId, datasetId, queryConfig, userId)
+// CreateDatasetAlert provides a mock function with given fields: ctx, merchantId, userId, datasetId, params
+func (_m *MockDatasetService) CreateDatasetAlert(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetAlertParams) (datasetsmodels.DatasetAlert, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDatasetExportAction")
+		panic("no return value specified for CreateDatasetAlert")
 	}
 
-	var r0 string
+	var r0 datasetsmodels.DatasetAlert
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams, uuid.UUID) (string, error)); ok {
-		return rf(ctx, merchantId, datasetId, queryConfig, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetAlertParams) (datasetsmodels.DatasetAlert, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams, uuid.UUID) string); ok {
-		r0 = rf(ctx, merchantId, datasetId, queryConfig, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetAlertParams) datasetsmodels.DatasetAlert); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(datasetsmodels.DatasetAlert)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams, uuid.UUID) error); ok {
-		r1 = rf(ctx, merchantId, datasetId, queryConfig, userId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetAlertParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -251,108 +537,119 @@ func (_m *MockDatasetService) CreateDatasetExportAction(ctx context.Context, mer
 	return r0, r1
 }
 
-// MockDatasetService_CreateDatasetExportAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetExportAction'
-type MockDatasetService_CreateDatasetExportAction_Call struct {
+// MockDatasetService_CreateDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetAlert'
+type MockDatasetService_CreateDatasetAlert_Call struct {
 	*mock.Call
 }
 
-// CreateDatasetExportAction is a helper method to define mock.On call
+// CreateDatasetAlert is a helper method to define mock.On call
 //   - ctx context.Context
 //   - merchantId uuid.UUID
-//   - datasetId string
-//   - queryConfig datasetsmodels.DatasetParams
 //   - userId uuid.UUID
-func (_e *MockDatasetService_Expecter) CreateDatasetExportAction(ctx interface{}, merchantId interface{}, datasetId interface{}, queryConfig interface{}, userId interface{}) *MockDatasetService_CreateDatasetExportAction_Call {
-	return &MockDatasetService_CreateDatasetExportAction_Call{Call: _e.mock.On("CreateDatasetExportAction", ctx, merchantId, datasetId, queryConfig, userId)}
+//   - datasetId uuid.UUID
+//   - params datasetsmodels.DatasetAlertParams
+func (_e *MockDatasetService_Expecter) CreateDatasetAlert(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_CreateDatasetAlert_Call {
+	return &MockDatasetService_CreateDatasetAlert_Call{Call: _e.mock.On("CreateDatasetAlert", ctx, merchantId, userId, datasetId, params)}
 }
 
-func (_c *MockDatasetService_CreateDatasetExportAction_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, queryConfig datasetsmodels.DatasetParams, userId uuid.UUID)) *MockDatasetService_CreateDatasetExportAction_Call {
+func (_c *MockDatasetService_CreateDatasetAlert_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetAlertParams)) *MockDatasetService_CreateDatasetAlert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(datasetsmodels.DatasetParams), args[4].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetAlertParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_CreateDatasetExportAction_Call) Return(_a0 string, _a1 error) *MockDatasetService_CreateDatasetExportAction_Call {
+func (_c *MockDatasetService_CreateDatasetAlert_Call) Return(_a0 datasetsmodels.DatasetAlert, _a1 error) *MockDatasetService_CreateDatasetAlert_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_CreateDatasetExportAction_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams, uuid.UUID) (string, error)) *MockDatasetService_CreateDatasetExportAction_Call {
+func (_c *MockDatasetService_CreateDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetAlertParams) (datasetsmodels.DatasetAlert, error)) *MockDatasetService_CreateDatasetAlert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateDatasetFileUpload provides a mock function with given fields: ctx, datasetId, fileId, metadata
-func (_m *MockDatasetService) CreateDatasetFileUpload(ctx context.Context, datasetId uuid.UUID, fileId uuid.UUID, metadata json.RawMessage) error {
-	ret := _m.Called(ctx, datasetId, fileId, metadata)
+// CreateDatasetColumnPolicy provides a mock function with given fields: ctx, merchantId, userId, datasetId, params
+func (_m *MockDatasetService) CreateDatasetColumnPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetColumnPolicyParams) (datasetsmodels.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDatasetFileUpload")
+		panic("no return value specified for CreateDatasetColumnPolicy")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, json.RawMessage) error); ok {
-		r0 = rf(ctx, datasetId, fileId, metadata)
+	var r0 datasetsmodels.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetColumnPolicyParams) (datasetsmodels.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetColumnPolicyParams) datasetsmodels.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(datasetsmodels.DatasetColumnPolicy)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetColumnPolicyParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetService_CreateDatasetFileUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetFileUpload'
-type MockDatasetService_CreateDatasetFileUpload_Call struct {
+// MockDatasetService_CreateDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetColumnPolicy'
+type MockDatasetService_CreateDatasetColumnPolicy_Call struct {
 	*mock.Call
 }
 
-// CreateDatasetFileUpload is a helper method to define mock.On call
+// CreateDatasetColumnPolicy is a helper method to define mock.On call
 //   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
 //   - datasetId uuid.UUID
-//   - fileId uuid.UUID
-//   - metadata json.RawMessage
-func (_e *MockDatasetService_Expecter) CreateDatasetFileUpload(ctx interface{}, datasetId interface{}, fileId interface{}, metadata interface{}) *MockDatasetService_CreateDatasetFileUpload_Call {
-	return &MockDatasetService_CreateDatasetFileUpload_Call{Call: _e.mock.On("CreateDatasetFileUpload", ctx, datasetId, fileId, metadata)}
+//   - params datasetsmodels.DatasetColumnPolicyParams
+func (_e *MockDatasetService_Expecter) CreateDatasetColumnPolicy(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_CreateDatasetColumnPolicy_Call {
+	return &MockDatasetService_CreateDatasetColumnPolicy_Call{Call: _e.mock.On("CreateDatasetColumnPolicy", ctx, merchantId, userId, datasetId, params)}
 }
 
-func (_c *MockDatasetService_CreateDatasetFileUpload_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, fileId uuid.UUID, metadata json.RawMessage)) *MockDatasetService_CreateDatasetFileUpload_Call {
+func (_c *MockDatasetService_CreateDatasetColumnPolicy_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetColumnPolicyParams)) *MockDatasetService_CreateDatasetColumnPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(json.RawMessage))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetColumnPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_CreateDatasetFileUpload_Call) Return(_a0 error) *MockDatasetService_CreateDatasetFileUpload_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatasetService_CreateDatasetColumnPolicy_Call) Return(_a0 datasetsmodels.DatasetColumnPolicy, _a1 error) *MockDatasetService_CreateDatasetColumnPolicy_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_CreateDatasetFileUpload_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, json.RawMessage) error) *MockDatasetService_CreateDatasetFileUpload_Call {
+func (_c *MockDatasetService_CreateDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetColumnPolicyParams) (datasetsmodels.DatasetColumnPolicy, error)) *MockDatasetService_CreateDatasetColumnPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DatasetExportTemporalActivity provides a mock function with given fields: ctx, params, datasetId, userId, orgIds, workflowId
-func (_m *MockDatasetService) DatasetExportTemporalActivity(ctx context.Context, params datasetsmodels.DatasetExportParams, datasetId uuid.UUID, userId uuid.UUID, orgIds []uuid.UUID, workflowId string) (string, error) {
-	ret := _m.Called(ctx, params, datasetId, userId, orgIds, workflowId)
+// CreateDatasetExportAction provides a mock function with given fields: ctx, merchantId, datasetId, queryConfig, format, userId
+func (_m *MockDatasetService) CreateDatasetExportAction(ctx context.Context, merchantId uuid.UUID, datasetId string, queryConfig datasetsmodels.DatasetParams, format constants.ExportFormat, userId uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, queryConfig, format, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DatasetExportTemporalActivity")
+		panic("no return value specified for CreateDatasetExportAction")
 	}
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, datasetsmodels.DatasetExportParams, uuid.UUID, uuid.UUID, []uuid.UUID, string) (string, error)); ok {
-		return rf(ctx, params, datasetId, userId, orgIds, workflowId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams, constants.ExportFormat, uuid.UUID) (string, error)); ok {
+		return rf(ctx, merchantId, datasetId, queryConfig, format, userId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, datasetsmodels.DatasetExportParams, uuid.UUID, uuid.UUID, []uuid.UUID, string) string); ok {
-		r0 = rf(ctx, params, datasetId, userId, orgIds, workflowId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams, constants.ExportFormat, uuid.UUID) string); ok {
+		r0 = rf(ctx, merchantId, datasetId, queryConfig, format, userId)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, datasetsmodels.DatasetExportParams, uuid.UUID, uuid.UUID, []uuid.UUID, string) error); ok {
-		r1 = rf(ctx, params, datasetId, userId, orgIds, workflowId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams, constants.ExportFormat, uuid.UUID) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, queryConfig, format, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -360,60 +657,60 @@ func (_m *MockDatasetService) DatasetExportTemporalActivity(ctx context.Context,
 	return r0, r1
 }
 
-// MockDatasetService_DatasetExportTemporalActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DatasetExportTemporalActivity'
-type MockDatasetService_DatasetExportTemporalActivity_Call struct {
+// MockDatasetService_CreateDatasetExportAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetExportAction'
+type MockDatasetService_CreateDatasetExportAction_Call struct {
 	*mock.Call
 }
 
-// DatasetExportTemporalActivity is a helper method to define mock.On call
+// CreateDatasetExportAction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params datasetsmodels.DatasetExportParams
-//   - datasetId uuid.UUID
+//   - merchantId uuid.UUID
+//   - datasetId string
+//   - queryConfig datasetsmodels.DatasetParams
+//   - format constants.ExportFormat
 //   - userId uuid.UUID
-//   - orgIds []uuid.UUID
-//   - workflowId string
-func (_e *MockDatasetService_Expecter) DatasetExportTemporalActivity(ctx interface{}, params interface{}, datasetId interface{}, userId interface{}, orgIds interface{}, workflowId interface{}) *MockDatasetService_DatasetExportTemporalActivity_Call {
-	return &MockDatasetService_DatasetExportTemporalActivity_Call{Call: _e.mock.On("DatasetExportTemporalActivity", ctx, params, datasetId, userId, orgIds, workflowId)}
+func (_e *MockDatasetService_Expecter) CreateDatasetExportAction(ctx interface{}, merchantId interface{}, datasetId interface{}, queryConfig interface{}, format interface{}, userId interface{}) *MockDatasetService_CreateDatasetExportAction_Call {
+	return &MockDatasetService_CreateDatasetExportAction_Call{Call: _e.mock.On("CreateDatasetExportAction", ctx, merchantId, datasetId, queryConfig, format, userId)}
 }
 
-func (_c *MockDatasetService_DatasetExportTemporalActivity_Call) Run(run func(ctx context.Context, params datasetsmodels.DatasetExportParams, datasetId uuid.UUID, userId uuid.UUID, orgIds []uuid.UUID, workflowId string)) *MockDatasetService_DatasetExportTemporalActivity_Call {
+func (_c *MockDatasetService_CreateDatasetExportAction_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, queryConfig datasetsmodels.DatasetParams, format constants.ExportFormat, userId uuid.UUID)) *MockDatasetService_CreateDatasetExportAction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(datasetsmodels.DatasetExportParams), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].([]uuid.UUID), args[5].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(datasetsmodels.DatasetParams), args[4].(constants.ExportFormat), args[5].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_DatasetExportTemporalActivity_Call) Return(_a0 string, _a1 error) *MockDatasetService_DatasetExportTemporalActivity_Call {
+func (_c *MockDatasetService_CreateDatasetExportAction_Call) Return(_a0 string, _a1 error) *MockDatasetService_CreateDatasetExportAction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_DatasetExportTemporalActivity_Call) RunAndReturn(run func(context.Context, datasetsmodels.DatasetExportParams, uuid.UUID, uuid.UUID, []uuid.UUID, string) (string, error)) *MockDatasetService_DatasetExportTemporalActivity_Call {
+func (_c *MockDatasetService_CreateDatasetExportAction_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams, constants.ExportFormat, uuid.UUID) (string, error)) *MockDatasetService_CreateDatasetExportAction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteDataset provides a mock function with given fields: ctx, merchantId, datasetId
-func (_m *MockDatasetService) DeleteDataset(ctx context.Context, merchantId uuid.UUID, datasetId string) (string, error) {
-	ret := _m.Called(ctx, merchantId, datasetId)
+// CreateDatasetExportSchedule provides a mock function with given fields: ctx, merchantId, userId, datasetId, params
+func (_m *MockDatasetService) CreateDatasetExportSchedule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetExportScheduleParams) (datasetsmodels.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteDataset")
+		panic("no return value specified for CreateDatasetExportSchedule")
 	}
 
-	var r0 string
+	var r0 datasetsmodels.DatasetExportSchedule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (string, error)); ok {
-		return rf(ctx, merchantId, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetExportScheduleParams) (datasetsmodels.DatasetExportSchedule, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
-		r0 = rf(ctx, merchantId, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetExportScheduleParams) datasetsmodels.DatasetExportSchedule); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(datasetsmodels.DatasetExportSchedule)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, merchantId, datasetId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetExportScheduleParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -421,117 +718,108 @@ func (_m *MockDatasetService) DeleteDataset(ctx context.Context, merchantId uuid
 	return r0, r1
 }
 
-// MockDatasetService_DeleteDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDataset'
-type MockDatasetService_DeleteDataset_Call struct {
+// MockDatasetService_CreateDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetExportSchedule'
+type MockDatasetService_CreateDatasetExportSchedule_Call struct {
 	*mock.Call
 }
 
-// DeleteDataset is a helper method to define mock.On call
+// CreateDatasetExportSchedule is a helper method to define mock.On call
 //   - ctx context.Context
 //   - merchantId uuid.UUID
-//   - datasetId string
-func (_e *MockDatasetService_Expecter) DeleteDataset(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_DeleteDataset_Call {
-	return &MockDatasetService_DeleteDataset_Call{Call: _e.mock.On("DeleteDataset", ctx, merchantId, datasetId)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - params datasetsmodels.DatasetExportScheduleParams
+func (_e *MockDatasetService_Expecter) CreateDatasetExportSchedule(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_CreateDatasetExportSchedule_Call {
+	return &MockDatasetService_CreateDatasetExportSchedule_Call{Call: _e.mock.On("CreateDatasetExportSchedule", ctx, merchantId, userId, datasetId, params)}
 }
 
-func (_c *MockDatasetService_DeleteDataset_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string)) *MockDatasetService_DeleteDataset_Call {
+func (_c *MockDatasetService_CreateDatasetExportSchedule_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetExportScheduleParams)) *MockDatasetService_CreateDatasetExportSchedule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetExportScheduleParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_DeleteDataset_Call) Return(_a0 string, _a1 error) *MockDatasetService_DeleteDataset_Call {
+func (_c *MockDatasetService_CreateDatasetExportSchedule_Call) Return(_a0 datasetsmodels.DatasetExportSchedule, _a1 error) *MockDatasetService_CreateDatasetExportSchedule_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_DeleteDataset_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (string, error)) *MockDatasetService_DeleteDataset_Call {
+func (_c *MockDatasetService_CreateDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetExportScheduleParams) (datasetsmodels.DatasetExportSchedule, error)) *MockDatasetService_CreateDatasetExportSchedule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExecuteRawQuery provides a mock function with given fields: ctx, merchantId, datasetId, query, queryParams
-func (_m *MockDatasetService) ExecuteRawQuery(ctx context.Context, merchantId uuid.UUID, datasetId string, query string, queryParams map[string]interface{}) (datasetsmodels.DatasetData, error) {
-	ret := _m.Called(ctx, merchantId, datasetId, query, queryParams)
+// CreateDatasetFileUpload provides a mock function with given fields: ctx, datasetId, fileId, metadata
+func (_m *MockDatasetService) CreateDatasetFileUpload(ctx context.Context, datasetId uuid.UUID, fileId uuid.UUID, metadata json.RawMessage) error {
+	ret := _m.Called(ctx, datasetId, fileId, metadata)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExecuteRawQuery")
-	}
-
-	var r0 datasetsmodels.DatasetData
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, map[string]interface{}) (datasetsmodels.DatasetData, error)); ok {
-		return rf(ctx, merchantId, datasetId, query, queryParams)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, map[string]interface{}) datasetsmodels.DatasetData); ok {
-		r0 = rf(ctx, merchantId, datasetId, query, queryParams)
-	} else {
-		r0 = ret.Get(0).(datasetsmodels.DatasetData)
+		panic("no return value specified for CreateDatasetFileUpload")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, map[string]interface{}) error); ok {
-		r1 = rf(ctx, merchantId, datasetId, query, queryParams)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, json.RawMessage) error); ok {
+		r0 = rf(ctx, datasetId, fileId, metadata)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDatasetService_ExecuteRawQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteRawQuery'
-type MockDatasetService_ExecuteRawQuery_Call struct {
+// MockDatasetService_CreateDatasetFileUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetFileUpload'
+type MockDatasetService_CreateDatasetFileUpload_Call struct {
 	*mock.Call
 }
 
-// ExecuteRawQuery is a helper method to define mock.On call
+// CreateDatasetFileUpload is a helper method to define mock.On call
 //   - ctx context.Context
-//   - merchantId uuid.UUID
-//   - datasetId string
-//   - query string
-//   - queryParams map[string]interface{}
-func (_e *MockDatasetService_Expecter) ExecuteRawQuery(ctx interface{}, merchantId interface{}, datasetId interface{}, query interface{}, queryParams interface{}) *MockDatasetService_ExecuteRawQuery_Call {
-	return &MockDatasetService_ExecuteRawQuery_Call{Call: _e.mock.On("ExecuteRawQuery", ctx, merchantId, datasetId, query, queryParams)}
+//   - datasetId uuid.UUID
+//   - fileId uuid.UUID
+//   - metadata json.RawMessage
+func (_e *MockDatasetService_Expecter) CreateDatasetFileUpload(ctx interface{}, datasetId interface{}, fileId interface{}, metadata interface{}) *MockDatasetService_CreateDatasetFileUpload_Call {
+	return &MockDatasetService_CreateDatasetFileUpload_Call{Call: _e.mock.On("CreateDatasetFileUpload", ctx, datasetId, fileId, metadata)}
 }
 
-func (_c *MockDatasetService_ExecuteRawQuery_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, query string, queryParams map[string]interface{})) *MockDatasetService_ExecuteRawQuery_Call {
+func (_c *MockDatasetService_CreateDatasetFileUpload_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, fileId uuid.UUID, metadata json.RawMessage)) *MockDatasetService_CreateDatasetFileUpload_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(map[string]interface{}))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(json.RawMessage))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_ExecuteRawQuery_Call) Return(_a0 datasetsmodels.DatasetData, _a1 error) *MockDatasetService_ExecuteRawQuery_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDatasetService_CreateDatasetFileUpload_Call) Return(_a0 error) *MockDatasetService_CreateDatasetFileUpload_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDatasetService_ExecuteRawQuery_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, map[string]interface{}) (datasetsmodels.DatasetData, error)) *MockDatasetService_ExecuteRawQuery_Call {
+func (_c *MockDatasetService_CreateDatasetFileUpload_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, json.RawMessage) error) *MockDatasetService_CreateDatasetFileUpload_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDataByDatasetId provides a mock function with given fields: ctx, merchantId, datasetId, params
-func (_m *MockDatasetService) GetDataByDatasetId(ctx context.Context, merchantId uuid.UUID, datasetId string, params datasetsmodels.DatasetParams) (datasetsmodels.DatasetData, error) {
-	ret := _m.Called(ctx, merchantId, datasetId, params)
+// CreateDatasetRowPolicy provides a mock function with given fields: ctx, merchantId, userId, datasetId, params
+func (_m *MockDatasetService) CreateDatasetRowPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetRowPolicyParams) (datasetsmodels.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDataByDatasetId")
+		panic("no return value specified for CreateDatasetRowPolicy")
 	}
 
-	var r0 datasetsmodels.DatasetData
+	var r0 datasetsmodels.DatasetRowPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams) (datasetsmodels.DatasetData, error)); ok {
-		return rf(ctx, merchantId, datasetId, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRowPolicyParams) (datasetsmodels.DatasetRowPolicy, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams) datasetsmodels.DatasetData); ok {
-		r0 = rf(ctx, merchantId, datasetId, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRowPolicyParams) datasetsmodels.DatasetRowPolicy); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
-		r0 = ret.Get(0).(datasetsmodels.DatasetData)
+		r0 = ret.Get(0).(datasetsmodels.DatasetRowPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams) error); ok {
-		r1 = rf(ctx, merchantId, datasetId, params)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRowPolicyParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -539,60 +827,59 @@ func (_m *MockDatasetService) GetDataByDatasetId(ctx context.Context, merchantId
 	return r0, r1
 }
 
-// MockDatasetService_GetDataByDatasetId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDataByDatasetId'
-type MockDatasetService_GetDataByDatasetId_Call struct {
+// MockDatasetService_CreateDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetRowPolicy'
+type MockDatasetService_CreateDatasetRowPolicy_Call struct {
 	*mock.Call
 }
 
-// GetDataByDatasetId is a helper method to define mock.On call
+// CreateDatasetRowPolicy is a helper method to define mock.On call
 //   - ctx context.Context
 //   - merchantId uuid.UUID
-//   - datasetId string
-//   - params datasetsmodels.DatasetParams
-func (_e *MockDatasetService_Expecter) GetDataByDatasetId(ctx interface{}, merchantId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_GetDataByDatasetId_Call {
-	return &MockDatasetService_GetDataByDatasetId_Call{Call: _e.mock.On("GetDataByDatasetId", ctx, merchantId, datasetId, params)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - params datasetsmodels.DatasetRowPolicyParams
+func (_e *MockDatasetService_Expecter) CreateDatasetRowPolicy(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_CreateDatasetRowPolicy_Call {
+	return &MockDatasetService_CreateDatasetRowPolicy_Call{Call: _e.mock.On("CreateDatasetRowPolicy", ctx, merchantId, userId, datasetId, params)}
 }
 
-func (_c *MockDatasetService_GetDataByDatasetId_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, params datasetsmodels.DatasetParams)) *MockDatasetService_GetDataByDatasetId_Call {
+func (_c *MockDatasetService_CreateDatasetRowPolicy_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetRowPolicyParams)) *MockDatasetService_CreateDatasetRowPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(datasetsmodels.DatasetParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetRowPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetDataByDatasetId_Call) Return(_a0 datasetsmodels.DatasetData, _a1 error) *MockDatasetService_GetDataByDatasetId_Call {
+func (_c *MockDatasetService_CreateDatasetRowPolicy_Call) Return(_a0 datasetsmodels.DatasetRowPolicy, _a1 error) *MockDatasetService_CreateDatasetRowPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetDataByDatasetId_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams) (datasetsmodels.DatasetData, error)) *MockDatasetService_GetDataByDatasetId_Call {
+func (_c *MockDatasetService_CreateDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRowPolicyParams) (datasetsmodels.DatasetRowPolicy, error)) *MockDatasetService_CreateDatasetRowPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetActions provides a mock function with given fields: ctx, merchantId, filters
-func (_m *MockDatasetService) GetDatasetActions(ctx context.Context, merchantId uuid.UUID, filters models.DatasetActionFilters) ([]datasetsmodels.DatasetAction, error) {
-	ret := _m.Called(ctx, merchantId, filters)
+// CreateDatasetRule provides a mock function with given fields: ctx, merchantId, userId, datasetId, params
+func (_m *MockDatasetService) CreateDatasetRule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetRuleParams) (datasetsmodels.DatasetRuleChange, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetActions")
+		panic("no return value specified for CreateDatasetRule")
 	}
 
-	var r0 []datasetsmodels.DatasetAction
+	var r0 datasetsmodels.DatasetRuleChange
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]datasetsmodels.DatasetAction, error)); ok {
-		return rf(ctx, merchantId, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRuleParams) (datasetsmodels.DatasetRuleChange, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) []datasetsmodels.DatasetAction); ok {
-		r0 = rf(ctx, merchantId, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRuleParams) datasetsmodels.DatasetRuleChange); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]datasetsmodels.DatasetAction)
-		}
+		r0 = ret.Get(0).(datasetsmodels.DatasetRuleChange)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetActionFilters) error); ok {
-		r1 = rf(ctx, merchantId, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRuleParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -600,59 +887,59 @@ func (_m *MockDatasetService) GetDatasetActions(ctx context.Context, merchantId
 	return r0, r1
 }
 
-// MockDatasetService_GetDatasetActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActions'
-type MockDatasetService_GetDatasetActions_Call struct {
+// MockDatasetService_CreateDatasetRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetRule'
+type MockDatasetService_CreateDatasetRule_Call struct {
 	*mock.Call
 }
 
-// GetDatasetActions is a helper method to define mock.On call
+// CreateDatasetRule is a helper method to define mock.On call
 //   - ctx context.Context
 //   - merchantId uuid.UUID
-//   - filters models.DatasetActionFilters
-func (_e *MockDatasetService_Expecter) GetDatasetActions(ctx interface{}, merchantId interface{}, filters interface{}) *MockDatasetService_GetDatasetActions_Call {
-	return &MockDatasetService_GetDatasetActions_Call{Call: _e.mock.On("GetDatasetActions", ctx, merchantId, filters)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - params datasetsmodels.DatasetRuleParams
+func (_e *MockDatasetService_Expecter) CreateDatasetRule(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_CreateDatasetRule_Call {
+	return &MockDatasetService_CreateDatasetRule_Call{Call: _e.mock.On("CreateDatasetRule", ctx, merchantId, userId, datasetId, params)}
 }
 
-func (_c *MockDatasetService_GetDatasetActions_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, filters models.DatasetActionFilters)) *MockDatasetService_GetDatasetActions_Call {
+func (_c *MockDatasetService_CreateDatasetRule_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetRuleParams)) *MockDatasetService_CreateDatasetRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetActionFilters))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetRuleParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetActions_Call) Return(_a0 []datasetsmodels.DatasetAction, _a1 error) *MockDatasetService_GetDatasetActions_Call {
+func (_c *MockDatasetService_CreateDatasetRule_Call) Return(_a0 datasetsmodels.DatasetRuleChange, _a1 error) *MockDatasetService_CreateDatasetRule_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetActions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]datasetsmodels.DatasetAction, error)) *MockDatasetService_GetDatasetActions_Call {
+func (_c *MockDatasetService_CreateDatasetRule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRuleParams) (datasetsmodels.DatasetRuleChange, error)) *MockDatasetService_CreateDatasetRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetAudiences provides a mock function with given fields: ctx, datasetId
-func (_m *MockDatasetService) GetDatasetAudiences(ctx context.Context, datasetId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId)
+// CreateDatasetStatusWorkflow provides a mock function with given fields: ctx, merchantId, userId, datasetId, params
+func (_m *MockDatasetService) CreateDatasetStatusWorkflow(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetStatusWorkflowParams) (datasetsmodels.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetAudiences")
+		panic("no return value specified for CreateDatasetStatusWorkflow")
 	}
 
-	var r0 []models.ResourceAudiencePolicy
+	var r0 datasetsmodels.DatasetStatusWorkflow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetStatusWorkflowParams) (datasetsmodels.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetStatusWorkflowParams) datasetsmodels.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Get(0).(datasetsmodels.DatasetStatusWorkflow)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetStatusWorkflowParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -660,56 +947,59 @@ func (_m *MockDatasetService) GetDatasetAudiences(ctx context.Context, datasetId
 	return r0, r1
 }
 
-// MockDatasetService_GetDatasetAudiences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAudiences'
-type MockDatasetService_GetDatasetAudiences_Call struct {
+// MockDatasetService_CreateDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetStatusWorkflow'
+type MockDatasetService_CreateDatasetStatusWorkflow_Call struct {
 	*mock.Call
 }
 
-// GetDatasetAudiences is a helper method to define mock.On call
+// CreateDatasetStatusWorkflow is a helper method to define mock.On call
 //   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
 //   - datasetId uuid.UUID
-func (_e *MockDatasetService_Expecter) GetDatasetAudiences(ctx interface{}, datasetId interface{}) *MockDatasetService_GetDatasetAudiences_Call {
-	return &MockDatasetService_GetDatasetAudiences_Call{Call: _e.mock.On("GetDatasetAudiences", ctx, datasetId)}
+//   - params datasetsmodels.DatasetStatusWorkflowParams
+func (_e *MockDatasetService_Expecter) CreateDatasetStatusWorkflow(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_CreateDatasetStatusWorkflow_Call {
+	return &MockDatasetService_CreateDatasetStatusWorkflow_Call{Call: _e.mock.On("CreateDatasetStatusWorkflow", ctx, merchantId, userId, datasetId, params)}
 }
 
-func (_c *MockDatasetService_GetDatasetAudiences_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetService_GetDatasetAudiences_Call {
+func (_c *MockDatasetService_CreateDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetStatusWorkflowParams)) *MockDatasetService_CreateDatasetStatusWorkflow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetStatusWorkflowParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetAudiences_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetService_GetDatasetAudiences_Call {
+func (_c *MockDatasetService_CreateDatasetStatusWorkflow_Call) Return(_a0 datasetsmodels.DatasetStatusWorkflow, _a1 error) *MockDatasetService_CreateDatasetStatusWorkflow_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetAudiences_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockDatasetService_GetDatasetAudiences_Call {
+func (_c *MockDatasetService_CreateDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetStatusWorkflowParams) (datasetsmodels.DatasetStatusWorkflow, error)) *MockDatasetService_CreateDatasetStatusWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetCount provides a mock function with given fields: ctx, merchantId, params
-func (_m *MockDatasetService) GetDatasetCount(ctx context.Context, merchantId uuid.UUID, params datasetsmodels.DatsetListingParams) (int64, error) {
-	ret := _m.Called(ctx, merchantId, params)
+// CreateDatasetView provides a mock function with given fields: ctx, merchantId, userId, datasetId, params
+func (_m *MockDatasetService) CreateDatasetView(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetViewParams) (datasetsmodels.DatasetView, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetCount")
+		panic("no return value specified for CreateDatasetView")
 	}
 
-	var r0 int64
+	var r0 datasetsmodels.DatasetView
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) (int64, error)); ok {
-		return rf(ctx, merchantId, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetViewParams) (datasetsmodels.DatasetView, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) int64); ok {
-		r0 = rf(ctx, merchantId, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetViewParams) datasetsmodels.DatasetView); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(datasetsmodels.DatasetView)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) error); ok {
-		r1 = rf(ctx, merchantId, params)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetViewParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -717,59 +1007,59 @@ func (_m *MockDatasetService) GetDatasetCount(ctx context.Context, merchantId uu
 	return r0, r1
 }
 
-// MockDatasetService_GetDatasetCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetCount'
-type MockDatasetService_GetDatasetCount_Call struct {
+// MockDatasetService_CreateDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetView'
+type MockDatasetService_CreateDatasetView_Call struct {
 	*mock.Call
 }
 
-// GetDatasetCount is a helper method to define mock.On call
+// CreateDatasetView is a helper method to define mock.On call
 //   - ctx context.Context
 //   - merchantId uuid.UUID
-//   - params datasetsmodels.DatsetListingParams
-func (_e *MockDatasetService_Expecter) GetDatasetCount(ctx interface{}, merchantId interface{}, params interface{}) *MockDatasetService_GetDatasetCount_Call {
-	return &MockDatasetService_GetDatasetCount_Call{Call: _e.mock.On("GetDatasetCount", ctx, merchantId, params)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - params datasetsmodels.DatasetViewParams
+func (_e *MockDatasetService_Expecter) CreateDatasetView(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_CreateDatasetView_Call {
+	return &MockDatasetService_CreateDatasetView_Call{Call: _e.mock.On("CreateDatasetView", ctx, merchantId, userId, datasetId, params)}
 }
 
-func (_c *MockDatasetService_GetDatasetCount_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, params datasetsmodels.DatsetListingParams)) *MockDatasetService_GetDatasetCount_Call {
+func (_c *MockDatasetService_CreateDatasetView_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetViewParams)) *MockDatasetService_CreateDatasetView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(datasetsmodels.DatsetListingParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetViewParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetCount_Call) Return(_a0 int64, _a1 error) *MockDatasetService_GetDatasetCount_Call {
+func (_c *MockDatasetService_CreateDatasetView_Call) Return(_a0 datasetsmodels.DatasetView, _a1 error) *MockDatasetService_CreateDatasetView_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetCount_Call) RunAndReturn(run func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) (int64, error)) *MockDatasetService_GetDatasetCount_Call {
+func (_c *MockDatasetService_CreateDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetViewParams) (datasetsmodels.DatasetView, error)) *MockDatasetService_CreateDatasetView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetDisplayConfig provides a mock function with given fields: ctx, merchantId, datasetId
-func (_m *MockDatasetService) GetDatasetDisplayConfig(ctx context.Context, merchantId uuid.UUID, datasetId string) ([]datasetsmodels.DisplayConfig, error) {
-	ret := _m.Called(ctx, merchantId, datasetId)
+// DatasetExportTemporalActivity provides a mock function with given fields: ctx, params, datasetId, userId, orgIds, workflowId
+func (_m *MockDatasetService) DatasetExportTemporalActivity(ctx context.Context, params datasetsmodels.DatasetExportParams, datasetId uuid.UUID, userId uuid.UUID, orgIds []uuid.UUID, workflowId string) (string, error) {
+	ret := _m.Called(ctx, params, datasetId, userId, orgIds, workflowId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetDisplayConfig")
+		panic("no return value specified for DatasetExportTemporalActivity")
 	}
 
-	var r0 []datasetsmodels.DisplayConfig
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]datasetsmodels.DisplayConfig, error)); ok {
-		return rf(ctx, merchantId, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, datasetsmodels.DatasetExportParams, uuid.UUID, uuid.UUID, []uuid.UUID, string) (string, error)); ok {
+		return rf(ctx, params, datasetId, userId, orgIds, workflowId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []datasetsmodels.DisplayConfig); ok {
-		r0 = rf(ctx, merchantId, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, datasetsmodels.DatasetExportParams, uuid.UUID, uuid.UUID, []uuid.UUID, string) string); ok {
+		r0 = rf(ctx, params, datasetId, userId, orgIds, workflowId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]datasetsmodels.DisplayConfig)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, merchantId, datasetId)
+	if rf, ok := ret.Get(1).(func(context.Context, datasetsmodels.DatasetExportParams, uuid.UUID, uuid.UUID, []uuid.UUID, string) error); ok {
+		r1 = rf(ctx, params, datasetId, userId, orgIds, workflowId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -777,59 +1067,60 @@ func (_m *MockDatasetService) GetDatasetDisplayConfig(ctx context.Context, merch
 	return r0, r1
 }
 
-// MockDatasetService_GetDatasetDisplayConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetDisplayConfig'
-type MockDatasetService_GetDatasetDisplayConfig_Call struct {
+// MockDatasetService_DatasetExportTemporalActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DatasetExportTemporalActivity'
+type MockDatasetService_DatasetExportTemporalActivity_Call struct {
 	*mock.Call
 }
 
-// GetDatasetDisplayConfig is a helper method to define mock.On call
+// DatasetExportTemporalActivity is a helper method to define mock.On call
 //   - ctx context.Context
-//   - merchantId uuid.UUID
-//   - datasetId string
-func (_e *MockDatasetService_Expecter) GetDatasetDisplayConfig(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_GetDatasetDisplayConfig_Call {
-	return &MockDatasetService_GetDatasetDisplayConfig_Call{Call: _e.mock.On("GetDatasetDisplayConfig", ctx, merchantId, datasetId)}
+//   - params datasetsmodels.DatasetExportParams
+//   - datasetId uuid.UUID
+//   - userId uuid.UUID
+//   - orgIds []uuid.UUID
+//   - workflowId string
+func (_e *MockDatasetService_Expecter) DatasetExportTemporalActivity(ctx interface{}, params interface{}, datasetId interface{}, userId interface{}, orgIds interface{}, workflowId interface{}) *MockDatasetService_DatasetExportTemporalActivity_Call {
+	return &MockDatasetService_DatasetExportTemporalActivity_Call{Call: _e.mock.On("DatasetExportTemporalActivity", ctx, params, datasetId, userId, orgIds, workflowId)}
 }
 
-func (_c *MockDatasetService_GetDatasetDisplayConfig_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string)) *MockDatasetService_GetDatasetDisplayConfig_Call {
+func (_c *MockDatasetService_DatasetExportTemporalActivity_Call) Run(run func(ctx context.Context, params datasetsmodels.DatasetExportParams, datasetId uuid.UUID, userId uuid.UUID, orgIds []uuid.UUID, workflowId string)) *MockDatasetService_DatasetExportTemporalActivity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(datasetsmodels.DatasetExportParams), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].([]uuid.UUID), args[5].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetDisplayConfig_Call) Return(_a0 []datasetsmodels.DisplayConfig, _a1 error) *MockDatasetService_GetDatasetDisplayConfig_Call {
+func (_c *MockDatasetService_DatasetExportTemporalActivity_Call) Return(_a0 string, _a1 error) *MockDatasetService_DatasetExportTemporalActivity_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetDisplayConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]datasetsmodels.DisplayConfig, error)) *MockDatasetService_GetDatasetDisplayConfig_Call {
+func (_c *MockDatasetService_DatasetExportTemporalActivity_Call) RunAndReturn(run func(context.Context, datasetsmodels.DatasetExportParams, uuid.UUID, uuid.UUID, []uuid.UUID, string) (string, error)) *MockDatasetService_DatasetExportTemporalActivity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetFileUploads provides a mock function with given fields: ctx, datasetId
-func (_m *MockDatasetService) GetDatasetFileUploads(ctx context.Context, datasetId uuid.UUID) ([]datasetsmodels.DatasetFileUpload, error) {
-	ret := _m.Called(ctx, datasetId)
+// DeleteDataset provides a mock function with given fields: ctx, merchantId, datasetId
+func (_m *MockDatasetService) DeleteDataset(ctx context.Context, merchantId uuid.UUID, datasetId string) (string, error) {
+	ret := _m.Called(ctx, merchantId, datasetId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetFileUploads")
+		panic("no return value specified for DeleteDataset")
 	}
 
-	var r0 []datasetsmodels.DatasetFileUpload
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetFileUpload, error)); ok {
-		return rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (string, error)); ok {
+		return rf(ctx, merchantId, datasetId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []datasetsmodels.DatasetFileUpload); ok {
-		r0 = rf(ctx, datasetId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]datasetsmodels.DatasetFileUpload)
-		}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) string); ok {
+		r0 = rf(ctx, merchantId, datasetId)
+	} else {
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, merchantId, datasetId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -837,118 +1128,106 @@ func (_m *MockDatasetService) GetDatasetFileUploads(ctx context.Context, dataset
 	return r0, r1
 }
 
-// MockDatasetService_GetDatasetFileUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploads'
-type MockDatasetService_GetDatasetFileUploads_Call struct {
+// MockDatasetService_DeleteDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDataset'
+type MockDatasetService_DeleteDataset_Call struct {
 	*mock.Call
 }
 
-// GetDatasetFileUploads is a helper method to define mock.On call
+// DeleteDataset is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-func (_e *MockDatasetService_Expecter) GetDatasetFileUploads(ctx interface{}, datasetId interface{}) *MockDatasetService_GetDatasetFileUploads_Call {
-	return &MockDatasetService_GetDatasetFileUploads_Call{Call: _e.mock.On("GetDatasetFileUploads", ctx, datasetId)}
+//   - merchantId uuid.UUID
+//   - datasetId string
+func (_e *MockDatasetService_Expecter) DeleteDataset(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_DeleteDataset_Call {
+	return &MockDatasetService_DeleteDataset_Call{Call: _e.mock.On("DeleteDataset", ctx, merchantId, datasetId)}
 }
 
-func (_c *MockDatasetService_GetDatasetFileUploads_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetService_GetDatasetFileUploads_Call {
+func (_c *MockDatasetService_DeleteDataset_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string)) *MockDatasetService_DeleteDataset_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetFileUploads_Call) Return(_a0 []datasetsmodels.DatasetFileUpload, _a1 error) *MockDatasetService_GetDatasetFileUploads_Call {
+func (_c *MockDatasetService_DeleteDataset_Call) Return(_a0 string, _a1 error) *MockDatasetService_DeleteDataset_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetFileUploads_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetFileUpload, error)) *MockDatasetService_GetDatasetFileUploads_Call {
+func (_c *MockDatasetService_DeleteDataset_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (string, error)) *MockDatasetService_DeleteDataset_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetImportPath provides a mock function with given fields: ctx, merchantId, datasetId
-func (_m *MockDatasetService) GetDatasetImportPath(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID) (*datasetsmodels.FileImportConfig, error) {
-	ret := _m.Called(ctx, merchantId, datasetId)
+// DeleteDatasetAlert provides a mock function with given fields: ctx, userId, datasetId, alertId
+func (_m *MockDatasetService) DeleteDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId, alertId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetImportPath")
-	}
-
-	var r0 *datasetsmodels.FileImportConfig
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*datasetsmodels.FileImportConfig, error)); ok {
-		return rf(ctx, merchantId, datasetId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *datasetsmodels.FileImportConfig); ok {
-		r0 = rf(ctx, merchantId, datasetId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datasetsmodels.FileImportConfig)
-		}
+		panic("no return value specified for DeleteDatasetAlert")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r1 = rf(ctx, merchantId, datasetId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId, alertId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDatasetService_GetDatasetImportPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetImportPath'
-type MockDatasetService_GetDatasetImportPath_Call struct {
+// MockDatasetService_DeleteDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetAlert'
+type MockDatasetService_DeleteDatasetAlert_Call struct {
 	*mock.Call
 }
 
-// GetDatasetImportPath is a helper method to define mock.On call
+// DeleteDatasetAlert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - merchantId uuid.UUID
+//   - userId uuid.UUID
 //   - datasetId uuid.UUID
-func (_e *MockDatasetService_Expecter) GetDatasetImportPath(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_GetDatasetImportPath_Call {
-	return &MockDatasetService_GetDatasetImportPath_Call{Call: _e.mock.On("GetDatasetImportPath", ctx, merchantId, datasetId)}
+//   - alertId uuid.UUID
+func (_e *MockDatasetService_Expecter) DeleteDatasetAlert(ctx interface{}, userId interface{}, datasetId interface{}, alertId interface{}) *MockDatasetService_DeleteDatasetAlert_Call {
+	return &MockDatasetService_DeleteDatasetAlert_Call{Call: _e.mock.On("DeleteDatasetAlert", ctx, userId, datasetId, alertId)}
 }
 
-func (_c *MockDatasetService_GetDatasetImportPath_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID)) *MockDatasetService_GetDatasetImportPath_Call {
+func (_c *MockDatasetService_DeleteDatasetAlert_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID)) *MockDatasetService_DeleteDatasetAlert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetImportPath_Call) Return(_a0 *datasetsmodels.FileImportConfig, _a1 error) *MockDatasetService_GetDatasetImportPath_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDatasetService_DeleteDatasetAlert_Call) Return(_a0 error) *MockDatasetService_DeleteDatasetAlert_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetImportPath_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*datasetsmodels.FileImportConfig, error)) *MockDatasetService_GetDatasetImportPath_Call {
+func (_c *MockDatasetService_DeleteDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockDatasetService_DeleteDatasetAlert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetListing provides a mock function with given fields: ctx, merchantId, params
-func (_m *MockDatasetService) GetDatasetListing(ctx context.Context, merchantId uuid.UUID, params datasetsmodels.DatsetListingParams) ([]datasetsmodels.Dataset, error) {
-	ret := _m.Called(ctx, merchantId, params)
+// DeleteDatasetColumnPolicy provides a mock function with given fields: ctx, userId, datasetId, policyId
+func (_m *MockDatasetService) DeleteDatasetColumnPolicy(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID) (datasetsmodels.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, userId, datasetId, policyId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetListing")
+		panic("no return value specified for DeleteDatasetColumnPolicy")
 	}
 
-	var r0 []datasetsmodels.Dataset
+	var r0 datasetsmodels.DatasetColumnPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) ([]datasetsmodels.Dataset, error)); ok {
-		return rf(ctx, merchantId, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, userId, datasetId, policyId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) []datasetsmodels.Dataset); ok {
-		r0 = rf(ctx, merchantId, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) datasetsmodels.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, userId, datasetId, policyId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]datasetsmodels.Dataset)
-		}
+		r0 = ret.Get(0).(datasetsmodels.DatasetColumnPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) error); ok {
-		r1 = rf(ctx, merchantId, params)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId, policyId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -956,364 +1235,314 @@ func (_m *MockDatasetService) GetDatasetListing(ctx context.Context, merchantId
 	return r0, r1
 }
 
-// MockDatasetService_GetDatasetListing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetListing'
-type MockDatasetService_GetDatasetListing_Call struct {
+// MockDatasetService_DeleteDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetColumnPolicy'
+type MockDatasetService_DeleteDatasetColumnPolicy_Call struct {
 	*mock.Call
 }
 
-// GetDatasetListing is a helper method to define mock.On call
+// DeleteDatasetColumnPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - merchantId uuid.UUID
-//   - params datasetsmodels.DatsetListingParams
-func (_e *MockDatasetService_Expecter) GetDatasetListing(ctx interface{}, merchantId interface{}, params interface{}) *MockDatasetService_GetDatasetListing_Call {
-	return &MockDatasetService_GetDatasetListing_Call{Call: _e.mock.On("GetDatasetListing", ctx, merchantId, params)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - policyId uuid.UUID
+func (_e *MockDatasetService_Expecter) DeleteDatasetColumnPolicy(ctx interface{}, userId interface{}, datasetId interface{}, policyId interface{}) *MockDatasetService_DeleteDatasetColumnPolicy_Call {
+	return &MockDatasetService_DeleteDatasetColumnPolicy_Call{Call: _e.mock.On("DeleteDatasetColumnPolicy", ctx, userId, datasetId, policyId)}
 }
 
-func (_c *MockDatasetService_GetDatasetListing_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, params datasetsmodels.DatsetListingParams)) *MockDatasetService_GetDatasetListing_Call {
+func (_c *MockDatasetService_DeleteDatasetColumnPolicy_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID)) *MockDatasetService_DeleteDatasetColumnPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(datasetsmodels.DatsetListingParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetListing_Call) Return(_a0 []datasetsmodels.Dataset, _a1 error) *MockDatasetService_GetDatasetListing_Call {
+func (_c *MockDatasetService_DeleteDatasetColumnPolicy_Call) Return(_a0 datasetsmodels.DatasetColumnPolicy, _a1 error) *MockDatasetService_DeleteDatasetColumnPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetDatasetListing_Call) RunAndReturn(run func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) ([]datasetsmodels.Dataset, error)) *MockDatasetService_GetDatasetListing_Call {
+func (_c *MockDatasetService_DeleteDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetColumnPolicy, error)) *MockDatasetService_DeleteDatasetColumnPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDownloadableDataExportUrl provides a mock function with given fields: ctx, workflowId
-func (_m *MockDatasetService) GetDownloadableDataExportUrl(ctx context.Context, workflowId string) (string, error) {
-	ret := _m.Called(ctx, workflowId)
+// DeleteDatasetExportSchedule provides a mock function with given fields: ctx, userId, datasetId, scheduleId
+func (_m *MockDatasetService) DeleteDatasetExportSchedule(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, scheduleId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId, scheduleId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDownloadableDataExportUrl")
-	}
-
-	var r0 string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, workflowId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, workflowId)
-	} else {
-		r0 = ret.Get(0).(string)
+		panic("no return value specified for DeleteDatasetExportSchedule")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, workflowId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId, scheduleId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDatasetService_GetDownloadableDataExportUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDownloadableDataExportUrl'
-type MockDatasetService_GetDownloadableDataExportUrl_Call struct {
+// MockDatasetService_DeleteDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetExportSchedule'
+type MockDatasetService_DeleteDatasetExportSchedule_Call struct {
 	*mock.Call
 }
 
-// GetDownloadableDataExportUrl is a helper method to define mock.On call
+// DeleteDatasetExportSchedule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - workflowId string
-func (_e *MockDatasetService_Expecter) GetDownloadableDataExportUrl(ctx interface{}, workflowId interface{}) *MockDatasetService_GetDownloadableDataExportUrl_Call {
-	return &MockDatasetService_GetDownloadableDataExportUrl_Call{Call: _e.mock.On("GetDownloadableDataExportUrl", ctx, workflowId)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - scheduleId uuid.UUID
+func (_e *MockDatasetService_Expecter) DeleteDatasetExportSchedule(ctx interface{}, userId interface{}, datasetId interface{}, scheduleId interface{}) *MockDatasetService_DeleteDatasetExportSchedule_Call {
+	return &MockDatasetService_DeleteDatasetExportSchedule_Call{Call: _e.mock.On("DeleteDatasetExportSchedule", ctx, userId, datasetId, scheduleId)}
 }
 
-func (_c *MockDatasetService_GetDownloadableDataExportUrl_Call) Run(run func(ctx context.Context, workflowId string)) *MockDatasetService_GetDownloadableDataExportUrl_Call {
+func (_c *MockDatasetService_DeleteDatasetExportSchedule_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, scheduleId uuid.UUID)) *MockDatasetService_DeleteDatasetExportSchedule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetDownloadableDataExportUrl_Call) Return(_a0 string, _a1 error) *MockDatasetService_GetDownloadableDataExportUrl_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDatasetService_DeleteDatasetExportSchedule_Call) Return(_a0 error) *MockDatasetService_DeleteDatasetExportSchedule_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDatasetService_GetDownloadableDataExportUrl_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockDatasetService_GetDownloadableDataExportUrl_Call {
+func (_c *MockDatasetService_DeleteDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockDatasetService_DeleteDatasetExportSchedule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFileUploadPreview provides a mock function with given fields: ctx, fileUploadId
-func (_m *MockDatasetService) GetFileUploadPreview(ctx context.Context, fileUploadId uuid.UUID) (models.DatasetPreview, error) {
-	ret := _m.Called(ctx, fileUploadId)
+// DeleteDatasetRowPolicy provides a mock function with given fields: ctx, userId, datasetId, policyId
+func (_m *MockDatasetService) DeleteDatasetRowPolicy(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId, policyId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFileUploadPreview")
-	}
-
-	var r0 models.DatasetPreview
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetPreview, error)); ok {
-		return rf(ctx, fileUploadId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetPreview); ok {
-		r0 = rf(ctx, fileUploadId)
-	} else {
-		r0 = ret.Get(0).(models.DatasetPreview)
+		panic("no return value specified for DeleteDatasetRowPolicy")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, fileUploadId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId, policyId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDatasetService_GetFileUploadPreview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFileUploadPreview'
-type MockDatasetService_GetFileUploadPreview_Call struct {
+// MockDatasetService_DeleteDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetRowPolicy'
+type MockDatasetService_DeleteDatasetRowPolicy_Call struct {
 	*mock.Call
 }
 
-// GetFileUploadPreview is a helper method to define mock.On call
+// DeleteDatasetRowPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fileUploadId uuid.UUID
-func (_e *MockDatasetService_Expecter) GetFileUploadPreview(ctx interface{}, fileUploadId interface{}) *MockDatasetService_GetFileUploadPreview_Call {
-	return &MockDatasetService_GetFileUploadPreview_Call{Call: _e.mock.On("GetFileUploadPreview", ctx, fileUploadId)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - policyId uuid.UUID
+func (_e *MockDatasetService_Expecter) DeleteDatasetRowPolicy(ctx interface{}, userId interface{}, datasetId interface{}, policyId interface{}) *MockDatasetService_DeleteDatasetRowPolicy_Call {
+	return &MockDatasetService_DeleteDatasetRowPolicy_Call{Call: _e.mock.On("DeleteDatasetRowPolicy", ctx, userId, datasetId, policyId)}
 }
 
-func (_c *MockDatasetService_GetFileUploadPreview_Call) Run(run func(ctx context.Context, fileUploadId uuid.UUID)) *MockDatasetService_GetFileUploadPreview_Call {
+func (_c *MockDatasetService_DeleteDatasetRowPolicy_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID)) *MockDatasetService_DeleteDatasetRowPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetFileUploadPreview_Call) Return(_a0 models.DatasetPreview, _a1 error) *MockDatasetService_GetFileUploadPreview_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDatasetService_DeleteDatasetRowPolicy_Call) Return(_a0 error) *MockDatasetService_DeleteDatasetRowPolicy_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDatasetService_GetFileUploadPreview_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetPreview, error)) *MockDatasetService_GetFileUploadPreview_Call {
+func (_c *MockDatasetService_DeleteDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockDatasetService_DeleteDatasetRowPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFilterConfigByDatasetId provides a mock function with given fields: ctx, merchantId, datasetId
-func (_m *MockDatasetService) GetFilterConfigByDatasetId(ctx context.Context, merchantId uuid.UUID, datasetId string) ([]datasetsmodels.FilterConfig, map[string]interface{}, error) {
-	ret := _m.Called(ctx, merchantId, datasetId)
+// DeleteDatasetRule provides a mock function with given fields: ctx, merchantId, userId, datasetId, ruleId
+func (_m *MockDatasetService) DeleteDatasetRule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID) (datasetsmodels.DatasetRuleChange, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, ruleId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFilterConfigByDatasetId")
+		panic("no return value specified for DeleteDatasetRule")
 	}
 
-	var r0 []datasetsmodels.FilterConfig
-	var r1 map[string]interface{}
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]datasetsmodels.FilterConfig, map[string]interface{}, error)); ok {
-		return rf(ctx, merchantId, datasetId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []datasetsmodels.FilterConfig); ok {
-		r0 = rf(ctx, merchantId, datasetId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]datasetsmodels.FilterConfig)
-		}
+	var r0 datasetsmodels.DatasetRuleChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetRuleChange, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, ruleId)
 	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) map[string]interface{}); ok {
-		r1 = rf(ctx, merchantId, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) datasetsmodels.DatasetRuleChange); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, ruleId)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(map[string]interface{})
-		}
+		r0 = ret.Get(0).(datasetsmodels.DatasetRuleChange)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string) error); ok {
-		r2 = rf(ctx, merchantId, datasetId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, ruleId)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockDatasetService_GetFilterConfigByDatasetId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFilterConfigByDatasetId'
-type MockDatasetService_GetFilterConfigByDatasetId_Call struct {
+// MockDatasetService_DeleteDatasetRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetRule'
+type MockDatasetService_DeleteDatasetRule_Call struct {
 	*mock.Call
 }
 
-// GetFilterConfigByDatasetId is a helper method to define mock.On call
+// DeleteDatasetRule is a helper method to define mock.On call
 //   - ctx context.Context
 //   - merchantId uuid.UUID
-//   - datasetId string
-func (_e *MockDatasetService_Expecter) GetFilterConfigByDatasetId(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_GetFilterConfigByDatasetId_Call {
-	return &MockDatasetService_GetFilterConfigByDatasetId_Call{Call: _e.mock.On("GetFilterConfigByDatasetId", ctx, merchantId, datasetId)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - ruleId uuid.UUID
+func (_e *MockDatasetService_Expecter) DeleteDatasetRule(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, ruleId interface{}) *MockDatasetService_DeleteDatasetRule_Call {
+	return &MockDatasetService_DeleteDatasetRule_Call{Call: _e.mock.On("DeleteDatasetRule", ctx, merchantId, userId, datasetId, ruleId)}
 }
 
-func (_c *MockDatasetService_GetFilterConfigByDatasetId_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string)) *MockDatasetService_GetFilterConfigByDatasetId_Call {
+func (_c *MockDatasetService_DeleteDatasetRule_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID)) *MockDatasetService_DeleteDatasetRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetFilterConfigByDatasetId_Call) Return(_a0 []datasetsmodels.FilterConfig, _a1 map[string]interface{}, _a2 error) *MockDatasetService_GetFilterConfigByDatasetId_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *MockDatasetService_DeleteDatasetRule_Call) Return(_a0 datasetsmodels.DatasetRuleChange, _a1 error) *MockDatasetService_DeleteDatasetRule_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetFilterConfigByDatasetId_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]datasetsmodels.FilterConfig, map[string]interface{}, error)) *MockDatasetService_GetFilterConfigByDatasetId_Call {
+func (_c *MockDatasetService_DeleteDatasetRule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetRuleChange, error)) *MockDatasetService_DeleteDatasetRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOptionsForColumn provides a mock function with given fields: ctx, merchantId, datasetId, column, filterType, respectThreshold
-func (_m *MockDatasetService) GetOptionsForColumn(ctx context.Context, merchantId uuid.UUID, datasetId string, column string, filterType string, respectThreshold bool) ([]interface{}, error) {
-	ret := _m.Called(ctx, merchantId, datasetId, column, filterType, respectThreshold)
+// DeleteDatasetStatusWorkflow provides a mock function with given fields: ctx, userId, datasetId, workflowId
+func (_m *MockDatasetService) DeleteDatasetStatusWorkflow(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, workflowId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId, workflowId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOptionsForColumn")
-	}
-
-	var r0 []interface{}
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, bool) ([]interface{}, error)); ok {
-		return rf(ctx, merchantId, datasetId, column, filterType, respectThreshold)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, bool) []interface{}); ok {
-		r0 = rf(ctx, merchantId, datasetId, column, filterType, respectThreshold)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]interface{})
-		}
+		panic("no return value specified for DeleteDatasetStatusWorkflow")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, string, bool) error); ok {
-		r1 = rf(ctx, merchantId, datasetId, column, filterType, respectThreshold)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId, workflowId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDatasetService_GetOptionsForColumn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOptionsForColumn'
-type MockDatasetService_GetOptionsForColumn_Call struct {
+// MockDatasetService_DeleteDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetStatusWorkflow'
+type MockDatasetService_DeleteDatasetStatusWorkflow_Call struct {
 	*mock.Call
 }
 
-// GetOptionsForColumn is a helper method to define mock.On call
+// DeleteDatasetStatusWorkflow is a helper method to define mock.On call
 //   - ctx context.Context
-//   - merchantId uuid.UUID
-//   - datasetId string
-//   - column string
-//   - filterType string
-//   - respectThreshold bool
-func (_e *MockDatasetService_Expecter) GetOptionsForColumn(ctx interface{}, merchantId interface{}, datasetId interface{}, column interface{}, filterType interface{}, respectThreshold interface{}) *MockDatasetService_GetOptionsForColumn_Call {
-	return &MockDatasetService_GetOptionsForColumn_Call{Call: _e.mock.On("GetOptionsForColumn", ctx, merchantId, datasetId, column, filterType, respectThreshold)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - workflowId uuid.UUID
+func (_e *MockDatasetService_Expecter) DeleteDatasetStatusWorkflow(ctx interface{}, userId interface{}, datasetId interface{}, workflowId interface{}) *MockDatasetService_DeleteDatasetStatusWorkflow_Call {
+	return &MockDatasetService_DeleteDatasetStatusWorkflow_Call{Call: _e.mock.On("DeleteDatasetStatusWorkflow", ctx, userId, datasetId, workflowId)}
 }
 
-func (_c *MockDatasetService_GetOptionsForColumn_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, column string, filterType string, respectThreshold bool)) *MockDatasetService_GetOptionsForColumn_Call {
+func (_c *MockDatasetService_DeleteDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, workflowId uuid.UUID)) *MockDatasetService_DeleteDatasetStatusWorkflow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(string), args[5].(bool))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetOptionsForColumn_Call) Return(_a0 []interface{}, _a1 error) *MockDatasetService_GetOptionsForColumn_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDatasetService_DeleteDatasetStatusWorkflow_Call) Return(_a0 error) *MockDatasetService_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDatasetService_GetOptionsForColumn_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, string, bool) ([]interface{}, error)) *MockDatasetService_GetOptionsForColumn_Call {
+func (_c *MockDatasetService_DeleteDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockDatasetService_DeleteDatasetStatusWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRowDetailsByUUID provides a mock function with given fields: ctx, merchantId, datasetId, rowUUID
-func (_m *MockDatasetService) GetRowDetailsByUUID(ctx context.Context, merchantId uuid.UUID, datasetId string, rowUUID string) (datasetsmodels.ParentDatasetInfo, error) {
-	ret := _m.Called(ctx, merchantId, datasetId, rowUUID)
+// DeleteDatasetView provides a mock function with given fields: ctx, userId, datasetId, viewId
+func (_m *MockDatasetService) DeleteDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId, viewId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRowDetailsByUUID")
-	}
-
-	var r0 datasetsmodels.ParentDatasetInfo
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (datasetsmodels.ParentDatasetInfo, error)); ok {
-		return rf(ctx, merchantId, datasetId, rowUUID)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) datasetsmodels.ParentDatasetInfo); ok {
-		r0 = rf(ctx, merchantId, datasetId, rowUUID)
-	} else {
-		r0 = ret.Get(0).(datasetsmodels.ParentDatasetInfo)
+		panic("no return value specified for DeleteDatasetView")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
-		r1 = rf(ctx, merchantId, datasetId, rowUUID)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId, viewId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDatasetService_GetRowDetailsByUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRowDetailsByUUID'
-type MockDatasetService_GetRowDetailsByUUID_Call struct {
+// MockDatasetService_DeleteDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetView'
+type MockDatasetService_DeleteDatasetView_Call struct {
 	*mock.Call
 }
 
-// GetRowDetailsByUUID is a helper method to define mock.On call
+// DeleteDatasetView is a helper method to define mock.On call
 //   - ctx context.Context
-//   - merchantId uuid.UUID
-//   - datasetId string
-//   - rowUUID string
-func (_e *MockDatasetService_Expecter) GetRowDetailsByUUID(ctx interface{}, merchantId interface{}, datasetId interface{}, rowUUID interface{}) *MockDatasetService_GetRowDetailsByUUID_Call {
-	return &MockDatasetService_GetRowDetailsByUUID_Call{Call: _e.mock.On("GetRowDetailsByUUID", ctx, merchantId, datasetId, rowUUID)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+func (_e *MockDatasetService_Expecter) DeleteDatasetView(ctx interface{}, userId interface{}, datasetId interface{}, viewId interface{}) *MockDatasetService_DeleteDatasetView_Call {
+	return &MockDatasetService_DeleteDatasetView_Call{Call: _e.mock.On("DeleteDatasetView", ctx, userId, datasetId, viewId)}
 }
 
-func (_c *MockDatasetService_GetRowDetailsByUUID_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, rowUUID string)) *MockDatasetService_GetRowDetailsByUUID_Call {
+func (_c *MockDatasetService_DeleteDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID)) *MockDatasetService_DeleteDatasetView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetRowDetailsByUUID_Call) Return(_a0 datasetsmodels.ParentDatasetInfo, _a1 error) *MockDatasetService_GetRowDetailsByUUID_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDatasetService_DeleteDatasetView_Call) Return(_a0 error) *MockDatasetService_DeleteDatasetView_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDatasetService_GetRowDetailsByUUID_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) (datasetsmodels.ParentDatasetInfo, error)) *MockDatasetService_GetRowDetailsByUUID_Call {
+func (_c *MockDatasetService_DeleteDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockDatasetService_DeleteDatasetView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRulesByDatasetColumns provides a mock function with given fields: ctx, orgId, datasetColumns
-func (_m *MockDatasetService) GetRulesByDatasetColumns(ctx context.Context, orgId uuid.UUID, datasetColumns []models.DatasetColumn) (map[string]map[string][]rulesmodels.Rule, error) {
-	ret := _m.Called(ctx, orgId, datasetColumns)
+// EvaluateDatasetAlertActivity provides a mock function with given fields: ctx, params
+func (_m *MockDatasetService) EvaluateDatasetAlertActivity(ctx context.Context, params datasetsmodels.DatasetAlertWorkflowParams) (datasetsmodels.DatasetAlertEvaluation, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRulesByDatasetColumns")
+		panic("no return value specified for EvaluateDatasetAlertActivity")
 	}
 
-	var r0 map[string]map[string][]rulesmodels.Rule
+	var r0 datasetsmodels.DatasetAlertEvaluation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.DatasetColumn) (map[string]map[string][]rulesmodels.Rule, error)); ok {
-		return rf(ctx, orgId, datasetColumns)
+	if rf, ok := ret.Get(0).(func(context.Context, datasetsmodels.DatasetAlertWorkflowParams) (datasetsmodels.DatasetAlertEvaluation, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.DatasetColumn) map[string]map[string][]rulesmodels.Rule); ok {
-		r0 = rf(ctx, orgId, datasetColumns)
+	if rf, ok := ret.Get(0).(func(context.Context, datasetsmodels.DatasetAlertWorkflowParams) datasetsmodels.DatasetAlertEvaluation); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]map[string][]rulesmodels.Rule)
-		}
+		r0 = ret.Get(0).(datasetsmodels.DatasetAlertEvaluation)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []models.DatasetColumn) error); ok {
-		r1 = rf(ctx, orgId, datasetColumns)
+	if rf, ok := ret.Get(1).(func(context.Context, datasetsmodels.DatasetAlertWorkflowParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1321,59 +1550,56 @@ func (_m *MockDatasetService) GetRulesByDatasetColumns(ctx context.Context, orgI
 	return r0, r1
 }
 
-// MockDatasetService_GetRulesByDatasetColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRulesByDatasetColumns'
-type MockDatasetService_GetRulesByDatasetColumns_Call struct {
+// MockDatasetService_EvaluateDatasetAlertActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluateDatasetAlertActivity'
+type MockDatasetService_EvaluateDatasetAlertActivity_Call struct {
 	*mock.Call
 }
 
-// GetRulesByDatasetColumns is a helper method to define mock.On call
+// EvaluateDatasetAlertActivity is a helper method to define mock.On call
 //   - ctx context.Context
-//   - orgId uuid.UUID
-//   - datasetColumns []models.DatasetColumn
-func (_e *MockDatasetService_Expecter) GetRulesByDatasetColumns(ctx interface{}, orgId interface{}, datasetColumns interface{}) *MockDatasetService_GetRulesByDatasetColumns_Call {
-	return &MockDatasetService_GetRulesByDatasetColumns_Call{Call: _e.mock.On("GetRulesByDatasetColumns", ctx, orgId, datasetColumns)}
+//   - params datasetsmodels.DatasetAlertWorkflowParams
+func (_e *MockDatasetService_Expecter) EvaluateDatasetAlertActivity(ctx interface{}, params interface{}) *MockDatasetService_EvaluateDatasetAlertActivity_Call {
+	return &MockDatasetService_EvaluateDatasetAlertActivity_Call{Call: _e.mock.On("EvaluateDatasetAlertActivity", ctx, params)}
 }
 
-func (_c *MockDatasetService_GetRulesByDatasetColumns_Call) Run(run func(ctx context.Context, orgId uuid.UUID, datasetColumns []models.DatasetColumn)) *MockDatasetService_GetRulesByDatasetColumns_Call {
+func (_c *MockDatasetService_EvaluateDatasetAlertActivity_Call) Run(run func(ctx context.Context, params datasetsmodels.DatasetAlertWorkflowParams)) *MockDatasetService_EvaluateDatasetAlertActivity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.DatasetColumn))
+		run(args[0].(context.Context), args[1].(datasetsmodels.DatasetAlertWorkflowParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetRulesByDatasetColumns_Call) Return(_a0 map[string]map[string][]rulesmodels.Rule, _a1 error) *MockDatasetService_GetRulesByDatasetColumns_Call {
+func (_c *MockDatasetService_EvaluateDatasetAlertActivity_Call) Return(_a0 datasetsmodels.DatasetAlertEvaluation, _a1 error) *MockDatasetService_EvaluateDatasetAlertActivity_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetRulesByDatasetColumns_Call) RunAndReturn(run func(context.Context, uuid.UUID, []models.DatasetColumn) (map[string]map[string][]rulesmodels.Rule, error)) *MockDatasetService_GetRulesByDatasetColumns_Call {
+func (_c *MockDatasetService_EvaluateDatasetAlertActivity_Call) RunAndReturn(run func(context.Context, datasetsmodels.DatasetAlertWorkflowParams) (datasetsmodels.DatasetAlertEvaluation, error)) *MockDatasetService_EvaluateDatasetAlertActivity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRulesByIds provides a mock function with given fields: ctx, ruleIds
-func (_m *MockDatasetService) GetRulesByIds(ctx context.Context, ruleIds []string) ([]rulesmodels.Rule, error) {
-	ret := _m.Called(ctx, ruleIds)
+// ExecuteRawQuery provides a mock function with given fields: ctx, merchantId, datasetId, query, queryParams
+func (_m *MockDatasetService) ExecuteRawQuery(ctx context.Context, merchantId uuid.UUID, datasetId string, query string, queryParams map[string]interface{}) (datasetsmodels.DatasetData, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, query, queryParams)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRulesByIds")
+		panic("no return value specified for ExecuteRawQuery")
 	}
 
-	var r0 []rulesmodels.Rule
+	var r0 datasetsmodels.DatasetData
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]rulesmodels.Rule, error)); ok {
-		return rf(ctx, ruleIds)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, map[string]interface{}) (datasetsmodels.DatasetData, error)); ok {
+		return rf(ctx, merchantId, datasetId, query, queryParams)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string) []rulesmodels.Rule); ok {
-		r0 = rf(ctx, ruleIds)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, map[string]interface{}) datasetsmodels.DatasetData); ok {
+		r0 = rf(ctx, merchantId, datasetId, query, queryParams)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]rulesmodels.Rule)
-		}
+		r0 = ret.Get(0).(datasetsmodels.DatasetData)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = rf(ctx, ruleIds)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, map[string]interface{}) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, query, queryParams)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1381,107 +1607,118 @@ func (_m *MockDatasetService) GetRulesByIds(ctx context.Context, ruleIds []strin
 	return r0, r1
 }
 
-// MockDatasetService_GetRulesByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRulesByIds'
-type MockDatasetService_GetRulesByIds_Call struct {
+// MockDatasetService_ExecuteRawQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteRawQuery'
+type MockDatasetService_ExecuteRawQuery_Call struct {
 	*mock.Call
 }
 
-// GetRulesByIds is a helper method to define mock.On call
+// ExecuteRawQuery is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ruleIds []string
-func (_e *MockDatasetService_Expecter) GetRulesByIds(ctx interface{}, ruleIds interface{}) *MockDatasetService_GetRulesByIds_Call {
-	return &MockDatasetService_GetRulesByIds_Call{Call: _e.mock.On("GetRulesByIds", ctx, ruleIds)}
+//   - merchantId uuid.UUID
+//   - datasetId string
+//   - query string
+//   - queryParams map[string]interface{}
+func (_e *MockDatasetService_Expecter) ExecuteRawQuery(ctx interface{}, merchantId interface{}, datasetId interface{}, query interface{}, queryParams interface{}) *MockDatasetService_ExecuteRawQuery_Call {
+	return &MockDatasetService_ExecuteRawQuery_Call{Call: _e.mock.On("ExecuteRawQuery", ctx, merchantId, datasetId, query, queryParams)}
 }
 
-func (_c *MockDatasetService_GetRulesByIds_Call) Run(run func(ctx context.Context, ruleIds []string)) *MockDatasetService_GetRulesByIds_Call {
+func (_c *MockDatasetService_ExecuteRawQuery_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, query string, queryParams map[string]interface{})) *MockDatasetService_ExecuteRawQuery_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(map[string]interface{}))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_GetRulesByIds_Call) Return(_a0 []rulesmodels.Rule, _a1 error) *MockDatasetService_GetRulesByIds_Call {
+func (_c *MockDatasetService_ExecuteRawQuery_Call) Return(_a0 datasetsmodels.DatasetData, _a1 error) *MockDatasetService_ExecuteRawQuery_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_GetRulesByIds_Call) RunAndReturn(run func(context.Context, []string) ([]rulesmodels.Rule, error)) *MockDatasetService_GetRulesByIds_Call {
+func (_c *MockDatasetService_ExecuteRawQuery_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, map[string]interface{}) (datasetsmodels.DatasetData, error)) *MockDatasetService_ExecuteRawQuery_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ImportDataFromFile provides a mock function with given fields: ctx, merchantId, datasetId, fileUploadId
-func (_m *MockDatasetService) ImportDataFromFile(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, fileUploadId uuid.UUID) error {
-	ret := _m.Called(ctx, merchantId, datasetId, fileUploadId)
+// ExportDatasetRuleBundle provides a mock function with given fields: ctx, merchantId, datasetId, columns
+func (_m *MockDatasetService) ExportDatasetRuleBundle(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, columns []string) (datasetsmodels.DatasetRuleBundle, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, columns)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ImportDataFromFile")
+		panic("no return value specified for ExportDatasetRuleBundle")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, merchantId, datasetId, fileUploadId)
+	var r0 datasetsmodels.DatasetRuleBundle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []string) (datasetsmodels.DatasetRuleBundle, error)); ok {
+		return rf(ctx, merchantId, datasetId, columns)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []string) datasetsmodels.DatasetRuleBundle); ok {
+		r0 = rf(ctx, merchantId, datasetId, columns)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(datasetsmodels.DatasetRuleBundle)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, []string) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, columns)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetService_ImportDataFromFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportDataFromFile'
-type MockDatasetService_ImportDataFromFile_Call struct {
+// MockDatasetService_ExportDatasetRuleBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportDatasetRuleBundle'
+type MockDatasetService_ExportDatasetRuleBundle_Call struct {
 	*mock.Call
 }
 
-// ImportDataFromFile is a helper method to define mock.On call
+// ExportDatasetRuleBundle is a helper method to define mock.On call
 //   - ctx context.Context
 //   - merchantId uuid.UUID
 //   - datasetId uuid.UUID
-//   - fileUploadId uuid.UUID
-func (_e *MockDatasetService_Expecter) ImportDataFromFile(ctx interface{}, merchantId interface{}, datasetId interface{}, fileUploadId interface{}) *MockDatasetService_ImportDataFromFile_Call {
-	return &MockDatasetService_ImportDataFromFile_Call{Call: _e.mock.On("ImportDataFromFile", ctx, merchantId, datasetId, fileUploadId)}
+//   - columns []string
+func (_e *MockDatasetService_Expecter) ExportDatasetRuleBundle(ctx interface{}, merchantId interface{}, datasetId interface{}, columns interface{}) *MockDatasetService_ExportDatasetRuleBundle_Call {
+	return &MockDatasetService_ExportDatasetRuleBundle_Call{Call: _e.mock.On("ExportDatasetRuleBundle", ctx, merchantId, datasetId, columns)}
 }
 
-func (_c *MockDatasetService_ImportDataFromFile_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, fileUploadId uuid.UUID)) *MockDatasetService_ImportDataFromFile_Call {
+func (_c *MockDatasetService_ExportDatasetRuleBundle_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, columns []string)) *MockDatasetService_ExportDatasetRuleBundle_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]string))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_ImportDataFromFile_Call) Return(err error) *MockDatasetService_ImportDataFromFile_Call {
-	_c.Call.Return(err)
+func (_c *MockDatasetService_ExportDatasetRuleBundle_Call) Return(_a0 datasetsmodels.DatasetRuleBundle, _a1 error) *MockDatasetService_ExportDatasetRuleBundle_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_ImportDataFromFile_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockDatasetService_ImportDataFromFile_Call {
+func (_c *MockDatasetService_ExportDatasetRuleBundle_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []string) (datasetsmodels.DatasetRuleBundle, error)) *MockDatasetService_ExportDatasetRuleBundle_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InitiateFilePreparationForDatasetImport provides a mock function with given fields: ctx, datasetId, fileId
-func (_m *MockDatasetService) InitiateFilePreparationForDatasetImport(ctx context.Context, datasetId uuid.UUID, fileId uuid.UUID) (*uuid.UUID, error) {
-	ret := _m.Called(ctx, datasetId, fileId)
+// GetDataByDatasetId provides a mock function with given fields: ctx, merchantId, datasetId, params
+func (_m *MockDatasetService) GetDataByDatasetId(ctx context.Context, merchantId uuid.UUID, datasetId string, params datasetsmodels.DatasetParams) (datasetsmodels.DatasetData, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InitiateFilePreparationForDatasetImport")
+		panic("no return value specified for GetDataByDatasetId")
 	}
 
-	var r0 *uuid.UUID
+	var r0 datasetsmodels.DatasetData
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*uuid.UUID, error)); ok {
-		return rf(ctx, datasetId, fileId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams) (datasetsmodels.DatasetData, error)); ok {
+		return rf(ctx, merchantId, datasetId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *uuid.UUID); ok {
-		r0 = rf(ctx, datasetId, fileId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams) datasetsmodels.DatasetData); ok {
+		r0 = rf(ctx, merchantId, datasetId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*uuid.UUID)
-		}
+		r0 = ret.Get(0).(datasetsmodels.DatasetData)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r1 = rf(ctx, datasetId, fileId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1489,125 +1726,3173 @@ func (_m *MockDatasetService) InitiateFilePreparationForDatasetImport(ctx contex
 	return r0, r1
 }
 
-// MockDatasetService_InitiateFilePreparationForDatasetImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitiateFilePreparationForDatasetImport'
-type MockDatasetService_InitiateFilePreparationForDatasetImport_Call struct {
+// MockDatasetService_GetDataByDatasetId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDataByDatasetId'
+type MockDatasetService_GetDataByDatasetId_Call struct {
 	*mock.Call
 }
 
-// InitiateFilePreparationForDatasetImport is a helper method to define mock.On call
+// GetDataByDatasetId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-//   - fileId uuid.UUID
-func (_e *MockDatasetService_Expecter) InitiateFilePreparationForDatasetImport(ctx interface{}, datasetId interface{}, fileId interface{}) *MockDatasetService_InitiateFilePreparationForDatasetImport_Call {
-	return &MockDatasetService_InitiateFilePreparationForDatasetImport_Call{Call: _e.mock.On("InitiateFilePreparationForDatasetImport", ctx, datasetId, fileId)}
+//   - merchantId uuid.UUID
+//   - datasetId string
+//   - params datasetsmodels.DatasetParams
+func (_e *MockDatasetService_Expecter) GetDataByDatasetId(ctx interface{}, merchantId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_GetDataByDatasetId_Call {
+	return &MockDatasetService_GetDataByDatasetId_Call{Call: _e.mock.On("GetDataByDatasetId", ctx, merchantId, datasetId, params)}
 }
 
-func (_c *MockDatasetService_InitiateFilePreparationForDatasetImport_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, fileId uuid.UUID)) *MockDatasetService_InitiateFilePreparationForDatasetImport_Call {
+func (_c *MockDatasetService_GetDataByDatasetId_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, params datasetsmodels.DatasetParams)) *MockDatasetService_GetDataByDatasetId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(datasetsmodels.DatasetParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_InitiateFilePreparationForDatasetImport_Call) Return(datasetActionId *uuid.UUID, err error) *MockDatasetService_InitiateFilePreparationForDatasetImport_Call {
-	_c.Call.Return(datasetActionId, err)
+func (_c *MockDatasetService_GetDataByDatasetId_Call) Return(_a0 datasetsmodels.DatasetData, _a1 error) *MockDatasetService_GetDataByDatasetId_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_InitiateFilePreparationForDatasetImport_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*uuid.UUID, error)) *MockDatasetService_InitiateFilePreparationForDatasetImport_Call {
+func (_c *MockDatasetService_GetDataByDatasetId_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, datasetsmodels.DatasetParams) (datasetsmodels.DatasetData, error)) *MockDatasetService_GetDataByDatasetId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterDataset provides a mock function with given fields: ctx, merchantId, userId, datasetCreationInfo
-func (_m *MockDatasetService) RegisterDataset(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetCreationInfo datasetsmodels.DatasetCreationInfo) (string, uuid.UUID, error) {
-	ret := _m.Called(ctx, merchantId, userId, datasetCreationInfo)
+// GetDatasetActions provides a mock function with given fields: ctx, merchantId, filters
+func (_m *MockDatasetService) GetDatasetActions(ctx context.Context, merchantId uuid.UUID, filters models.DatasetActionFilters) ([]datasetsmodels.DatasetAction, error) {
+	ret := _m.Called(ctx, merchantId, filters)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegisterDataset")
+		panic("no return value specified for GetDatasetActions")
 	}
 
-	var r0 string
-	var r1 uuid.UUID
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) (string, uuid.UUID, error)); ok {
-		return rf(ctx, merchantId, userId, datasetCreationInfo)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) string); ok {
-		r0 = rf(ctx, merchantId, userId, datasetCreationInfo)
-	} else {
-		r0 = ret.Get(0).(string)
+	var r0 []datasetsmodels.DatasetAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]datasetsmodels.DatasetAction, error)); ok {
+		return rf(ctx, merchantId, filters)
 	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) uuid.UUID); ok {
-		r1 = rf(ctx, merchantId, userId, datasetCreationInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) []datasetsmodels.DatasetAction); ok {
+		r0 = rf(ctx, merchantId, filters)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(uuid.UUID)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetAction)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) error); ok {
-		r2 = rf(ctx, merchantId, userId, datasetCreationInfo)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetActionFilters) error); ok {
+		r1 = rf(ctx, merchantId, filters)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockDatasetService_RegisterDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterDataset'
-type MockDatasetService_RegisterDataset_Call struct {
+// MockDatasetService_GetDatasetActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActions'
+type MockDatasetService_GetDatasetActions_Call struct {
 	*mock.Call
 }
 
-// RegisterDataset is a helper method to define mock.On call
+// GetDatasetActions is a helper method to define mock.On call
 //   - ctx context.Context
 //   - merchantId uuid.UUID
-//   - userId uuid.UUID
-//   - datasetCreationInfo datasetsmodels.DatasetCreationInfo
-func (_e *MockDatasetService_Expecter) RegisterDataset(ctx interface{}, merchantId interface{}, userId interface{}, datasetCreationInfo interface{}) *MockDatasetService_RegisterDataset_Call {
-	return &MockDatasetService_RegisterDataset_Call{Call: _e.mock.On("RegisterDataset", ctx, merchantId, userId, datasetCreationInfo)}
+//   - filters models.DatasetActionFilters
+func (_e *MockDatasetService_Expecter) GetDatasetActions(ctx interface{}, merchantId interface{}, filters interface{}) *MockDatasetService_GetDatasetActions_Call {
+	return &MockDatasetService_GetDatasetActions_Call{Call: _e.mock.On("GetDatasetActions", ctx, merchantId, filters)}
 }
 
-func (_c *MockDatasetService_RegisterDataset_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetCreationInfo datasetsmodels.DatasetCreationInfo)) *MockDatasetService_RegisterDataset_Call {
+func (_c *MockDatasetService_GetDatasetActions_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, filters models.DatasetActionFilters)) *MockDatasetService_GetDatasetActions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(datasetsmodels.DatasetCreationInfo))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetActionFilters))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_RegisterDataset_Call) Return(_a0 string, _a1 uuid.UUID, _a2 error) *MockDatasetService_RegisterDataset_Call {
+func (_c *MockDatasetService_GetDatasetActions_Call) Return(_a0 []datasetsmodels.DatasetAction, _a1 error) *MockDatasetService_GetDatasetActions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetActions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]datasetsmodels.DatasetAction, error)) *MockDatasetService_GetDatasetActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlert provides a mock function with given fields: ctx, datasetId, alertId
+func (_m *MockDatasetService) GetDatasetAlert(ctx context.Context, datasetId uuid.UUID, alertId uuid.UUID) (datasetsmodels.DatasetAlert, error) {
+	ret := _m.Called(ctx, datasetId, alertId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlert")
+	}
+
+	var r0 datasetsmodels.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetAlert, error)); ok {
+		return rf(ctx, datasetId, alertId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) datasetsmodels.DatasetAlert); ok {
+		r0 = rf(ctx, datasetId, alertId)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, alertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlert'
+type MockDatasetService_GetDatasetAlert_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - alertId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetAlert(ctx interface{}, datasetId interface{}, alertId interface{}) *MockDatasetService_GetDatasetAlert_Call {
+	return &MockDatasetService_GetDatasetAlert_Call{Call: _e.mock.On("GetDatasetAlert", ctx, datasetId, alertId)}
+}
+
+func (_c *MockDatasetService_GetDatasetAlert_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, alertId uuid.UUID)) *MockDatasetService_GetDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetAlert_Call) Return(_a0 datasetsmodels.DatasetAlert, _a1 error) *MockDatasetService_GetDatasetAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetAlert, error)) *MockDatasetService_GetDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlertEvents provides a mock function with given fields: ctx, datasetId, alertId
+func (_m *MockDatasetService) GetDatasetAlertEvents(ctx context.Context, datasetId uuid.UUID, alertId uuid.UUID) ([]datasetsmodels.DatasetAlertEvent, error) {
+	ret := _m.Called(ctx, datasetId, alertId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlertEvents")
+	}
+
+	var r0 []datasetsmodels.DatasetAlertEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetAlertEvent, error)); ok {
+		return rf(ctx, datasetId, alertId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []datasetsmodels.DatasetAlertEvent); ok {
+		r0 = rf(ctx, datasetId, alertId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetAlertEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, alertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetAlertEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlertEvents'
+type MockDatasetService_GetDatasetAlertEvents_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlertEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - alertId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetAlertEvents(ctx interface{}, datasetId interface{}, alertId interface{}) *MockDatasetService_GetDatasetAlertEvents_Call {
+	return &MockDatasetService_GetDatasetAlertEvents_Call{Call: _e.mock.On("GetDatasetAlertEvents", ctx, datasetId, alertId)}
+}
+
+func (_c *MockDatasetService_GetDatasetAlertEvents_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, alertId uuid.UUID)) *MockDatasetService_GetDatasetAlertEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetAlertEvents_Call) Return(_a0 []datasetsmodels.DatasetAlertEvent, _a1 error) *MockDatasetService_GetDatasetAlertEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetAlertEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetAlertEvent, error)) *MockDatasetService_GetDatasetAlertEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlerts provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetService) GetDatasetAlerts(ctx context.Context, datasetId uuid.UUID) ([]datasetsmodels.DatasetAlert, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlerts")
+	}
+
+	var r0 []datasetsmodels.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetAlert, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []datasetsmodels.DatasetAlert); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlerts'
+type MockDatasetService_GetDatasetAlerts_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetAlerts(ctx interface{}, datasetId interface{}) *MockDatasetService_GetDatasetAlerts_Call {
+	return &MockDatasetService_GetDatasetAlerts_Call{Call: _e.mock.On("GetDatasetAlerts", ctx, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetAlerts_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetService_GetDatasetAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetAlerts_Call) Return(_a0 []datasetsmodels.DatasetAlert, _a1 error) *MockDatasetService_GetDatasetAlerts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetAlerts_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetAlert, error)) *MockDatasetService_GetDatasetAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAudiences provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetService) GetDatasetAudiences(ctx context.Context, datasetId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAudiences")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetAudiences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAudiences'
+type MockDatasetService_GetDatasetAudiences_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAudiences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetAudiences(ctx interface{}, datasetId interface{}) *MockDatasetService_GetDatasetAudiences_Call {
+	return &MockDatasetService_GetDatasetAudiences_Call{Call: _e.mock.On("GetDatasetAudiences", ctx, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetAudiences_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetService_GetDatasetAudiences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetAudiences_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetService_GetDatasetAudiences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetAudiences_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockDatasetService_GetDatasetAudiences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetService) GetDatasetColumnPolicies(ctx context.Context, datasetId uuid.UUID) ([]datasetsmodels.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPolicies")
+	}
+
+	var r0 []datasetsmodels.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []datasetsmodels.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetColumnPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetColumnPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPolicies'
+type MockDatasetService_GetDatasetColumnPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetColumnPolicies(ctx interface{}, datasetId interface{}) *MockDatasetService_GetDatasetColumnPolicies_Call {
+	return &MockDatasetService_GetDatasetColumnPolicies_Call{Call: _e.mock.On("GetDatasetColumnPolicies", ctx, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetColumnPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetService_GetDatasetColumnPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetColumnPolicies_Call) Return(_a0 []datasetsmodels.DatasetColumnPolicy, _a1 error) *MockDatasetService_GetDatasetColumnPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetColumnPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetColumnPolicy, error)) *MockDatasetService_GetDatasetColumnPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetCount provides a mock function with given fields: ctx, merchantId, params
+func (_m *MockDatasetService) GetDatasetCount(ctx context.Context, merchantId uuid.UUID, params datasetsmodels.DatsetListingParams) (int64, error) {
+	ret := _m.Called(ctx, merchantId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) (int64, error)); ok {
+		return rf(ctx, merchantId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) int64); ok {
+		r0 = rf(ctx, merchantId, params)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) error); ok {
+		r1 = rf(ctx, merchantId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetCount'
+type MockDatasetService_GetDatasetCount_Call struct {
+	*mock.Call
+}
+
+// GetDatasetCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - params datasetsmodels.DatsetListingParams
+func (_e *MockDatasetService_Expecter) GetDatasetCount(ctx interface{}, merchantId interface{}, params interface{}) *MockDatasetService_GetDatasetCount_Call {
+	return &MockDatasetService_GetDatasetCount_Call{Call: _e.mock.On("GetDatasetCount", ctx, merchantId, params)}
+}
+
+func (_c *MockDatasetService_GetDatasetCount_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, params datasetsmodels.DatsetListingParams)) *MockDatasetService_GetDatasetCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(datasetsmodels.DatsetListingParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetCount_Call) Return(_a0 int64, _a1 error) *MockDatasetService_GetDatasetCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetCount_Call) RunAndReturn(run func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) (int64, error)) *MockDatasetService_GetDatasetCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetDisplayConfig provides a mock function with given fields: ctx, merchantId, datasetId
+func (_m *MockDatasetService) GetDatasetDisplayConfig(ctx context.Context, merchantId uuid.UUID, datasetId string) ([]datasetsmodels.DisplayConfig, error) {
+	ret := _m.Called(ctx, merchantId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetDisplayConfig")
+	}
+
+	var r0 []datasetsmodels.DisplayConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]datasetsmodels.DisplayConfig, error)); ok {
+		return rf(ctx, merchantId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []datasetsmodels.DisplayConfig); ok {
+		r0 = rf(ctx, merchantId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DisplayConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, merchantId, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetDisplayConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetDisplayConfig'
+type MockDatasetService_GetDatasetDisplayConfig_Call struct {
+	*mock.Call
+}
+
+// GetDatasetDisplayConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId string
+func (_e *MockDatasetService_Expecter) GetDatasetDisplayConfig(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_GetDatasetDisplayConfig_Call {
+	return &MockDatasetService_GetDatasetDisplayConfig_Call{Call: _e.mock.On("GetDatasetDisplayConfig", ctx, merchantId, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetDisplayConfig_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string)) *MockDatasetService_GetDatasetDisplayConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetDisplayConfig_Call) Return(_a0 []datasetsmodels.DisplayConfig, _a1 error) *MockDatasetService_GetDatasetDisplayConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetDisplayConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]datasetsmodels.DisplayConfig, error)) *MockDatasetService_GetDatasetDisplayConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportSchedule provides a mock function with given fields: ctx, datasetId, scheduleId
+func (_m *MockDatasetService) GetDatasetExportSchedule(ctx context.Context, datasetId uuid.UUID, scheduleId uuid.UUID) (datasetsmodels.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, datasetId, scheduleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportSchedule")
+	}
+
+	var r0 datasetsmodels.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetExportSchedule, error)); ok {
+		return rf(ctx, datasetId, scheduleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) datasetsmodels.DatasetExportSchedule); ok {
+		r0 = rf(ctx, datasetId, scheduleId)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetExportSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, scheduleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportSchedule'
+type MockDatasetService_GetDatasetExportSchedule_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - scheduleId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetExportSchedule(ctx interface{}, datasetId interface{}, scheduleId interface{}) *MockDatasetService_GetDatasetExportSchedule_Call {
+	return &MockDatasetService_GetDatasetExportSchedule_Call{Call: _e.mock.On("GetDatasetExportSchedule", ctx, datasetId, scheduleId)}
+}
+
+func (_c *MockDatasetService_GetDatasetExportSchedule_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, scheduleId uuid.UUID)) *MockDatasetService_GetDatasetExportSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetExportSchedule_Call) Return(_a0 datasetsmodels.DatasetExportSchedule, _a1 error) *MockDatasetService_GetDatasetExportSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetExportSchedule, error)) *MockDatasetService_GetDatasetExportSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportScheduleRuns provides a mock function with given fields: ctx, datasetId, scheduleId
+func (_m *MockDatasetService) GetDatasetExportScheduleRuns(ctx context.Context, datasetId uuid.UUID, scheduleId uuid.UUID) ([]datasetsmodels.DatasetExportScheduleRun, error) {
+	ret := _m.Called(ctx, datasetId, scheduleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportScheduleRuns")
+	}
+
+	var r0 []datasetsmodels.DatasetExportScheduleRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetExportScheduleRun, error)); ok {
+		return rf(ctx, datasetId, scheduleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []datasetsmodels.DatasetExportScheduleRun); ok {
+		r0 = rf(ctx, datasetId, scheduleId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetExportScheduleRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, scheduleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetExportScheduleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportScheduleRuns'
+type MockDatasetService_GetDatasetExportScheduleRuns_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportScheduleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - scheduleId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetExportScheduleRuns(ctx interface{}, datasetId interface{}, scheduleId interface{}) *MockDatasetService_GetDatasetExportScheduleRuns_Call {
+	return &MockDatasetService_GetDatasetExportScheduleRuns_Call{Call: _e.mock.On("GetDatasetExportScheduleRuns", ctx, datasetId, scheduleId)}
+}
+
+func (_c *MockDatasetService_GetDatasetExportScheduleRuns_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, scheduleId uuid.UUID)) *MockDatasetService_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetExportScheduleRuns_Call) Return(_a0 []datasetsmodels.DatasetExportScheduleRun, _a1 error) *MockDatasetService_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetExportScheduleRuns_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetExportScheduleRun, error)) *MockDatasetService_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportSchedules provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetService) GetDatasetExportSchedules(ctx context.Context, datasetId uuid.UUID) ([]datasetsmodels.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportSchedules")
+	}
+
+	var r0 []datasetsmodels.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetExportSchedule, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []datasetsmodels.DatasetExportSchedule); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetExportSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetExportSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportSchedules'
+type MockDatasetService_GetDatasetExportSchedules_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetExportSchedules(ctx interface{}, datasetId interface{}) *MockDatasetService_GetDatasetExportSchedules_Call {
+	return &MockDatasetService_GetDatasetExportSchedules_Call{Call: _e.mock.On("GetDatasetExportSchedules", ctx, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetExportSchedules_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetService_GetDatasetExportSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetExportSchedules_Call) Return(_a0 []datasetsmodels.DatasetExportSchedule, _a1 error) *MockDatasetService_GetDatasetExportSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetExportSchedules_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetExportSchedule, error)) *MockDatasetService_GetDatasetExportSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetFileUploads provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetService) GetDatasetFileUploads(ctx context.Context, datasetId uuid.UUID) ([]datasetsmodels.DatasetFileUpload, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetFileUploads")
+	}
+
+	var r0 []datasetsmodels.DatasetFileUpload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetFileUpload, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []datasetsmodels.DatasetFileUpload); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetFileUpload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetFileUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploads'
+type MockDatasetService_GetDatasetFileUploads_Call struct {
+	*mock.Call
+}
+
+// GetDatasetFileUploads is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetFileUploads(ctx interface{}, datasetId interface{}) *MockDatasetService_GetDatasetFileUploads_Call {
+	return &MockDatasetService_GetDatasetFileUploads_Call{Call: _e.mock.On("GetDatasetFileUploads", ctx, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetFileUploads_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetService_GetDatasetFileUploads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetFileUploads_Call) Return(_a0 []datasetsmodels.DatasetFileUpload, _a1 error) *MockDatasetService_GetDatasetFileUploads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetFileUploads_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetFileUpload, error)) *MockDatasetService_GetDatasetFileUploads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetImportPath provides a mock function with given fields: ctx, merchantId, datasetId
+func (_m *MockDatasetService) GetDatasetImportPath(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID) (*datasetsmodels.FileImportConfig, error) {
+	ret := _m.Called(ctx, merchantId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetImportPath")
+	}
+
+	var r0 *datasetsmodels.FileImportConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*datasetsmodels.FileImportConfig, error)); ok {
+		return rf(ctx, merchantId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *datasetsmodels.FileImportConfig); ok {
+		r0 = rf(ctx, merchantId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datasetsmodels.FileImportConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, merchantId, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetImportPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetImportPath'
+type MockDatasetService_GetDatasetImportPath_Call struct {
+	*mock.Call
+}
+
+// GetDatasetImportPath is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetImportPath(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_GetDatasetImportPath_Call {
+	return &MockDatasetService_GetDatasetImportPath_Call{Call: _e.mock.On("GetDatasetImportPath", ctx, merchantId, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetImportPath_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID)) *MockDatasetService_GetDatasetImportPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetImportPath_Call) Return(_a0 *datasetsmodels.FileImportConfig, _a1 error) *MockDatasetService_GetDatasetImportPath_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetImportPath_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*datasetsmodels.FileImportConfig, error)) *MockDatasetService_GetDatasetImportPath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetListing provides a mock function with given fields: ctx, merchantId, params
+func (_m *MockDatasetService) GetDatasetListing(ctx context.Context, merchantId uuid.UUID, params datasetsmodels.DatsetListingParams) ([]datasetsmodels.Dataset, error) {
+	ret := _m.Called(ctx, merchantId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetListing")
+	}
+
+	var r0 []datasetsmodels.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) ([]datasetsmodels.Dataset, error)); ok {
+		return rf(ctx, merchantId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) []datasetsmodels.Dataset); ok {
+		r0 = rf(ctx, merchantId, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) error); ok {
+		r1 = rf(ctx, merchantId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetListing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetListing'
+type MockDatasetService_GetDatasetListing_Call struct {
+	*mock.Call
+}
+
+// GetDatasetListing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - params datasetsmodels.DatsetListingParams
+func (_e *MockDatasetService_Expecter) GetDatasetListing(ctx interface{}, merchantId interface{}, params interface{}) *MockDatasetService_GetDatasetListing_Call {
+	return &MockDatasetService_GetDatasetListing_Call{Call: _e.mock.On("GetDatasetListing", ctx, merchantId, params)}
+}
+
+func (_c *MockDatasetService_GetDatasetListing_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, params datasetsmodels.DatsetListingParams)) *MockDatasetService_GetDatasetListing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(datasetsmodels.DatsetListingParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetListing_Call) Return(_a0 []datasetsmodels.Dataset, _a1 error) *MockDatasetService_GetDatasetListing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetListing_Call) RunAndReturn(run func(context.Context, uuid.UUID, datasetsmodels.DatsetListingParams) ([]datasetsmodels.Dataset, error)) *MockDatasetService_GetDatasetListing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowAssignment provides a mock function with given fields: ctx, datasetId, rowId
+func (_m *MockDatasetService) GetDatasetRowAssignment(ctx context.Context, datasetId uuid.UUID, rowId string) (*datasetsmodels.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, datasetId, rowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowAssignment")
+	}
+
+	var r0 *datasetsmodels.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*datasetsmodels.DatasetRowAssignment, error)); ok {
+		return rf(ctx, datasetId, rowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *datasetsmodels.DatasetRowAssignment); ok {
+		r0 = rf(ctx, datasetId, rowId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datasetsmodels.DatasetRowAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, datasetId, rowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetRowAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowAssignment'
+type MockDatasetService_GetDatasetRowAssignment_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - rowId string
+func (_e *MockDatasetService_Expecter) GetDatasetRowAssignment(ctx interface{}, datasetId interface{}, rowId interface{}) *MockDatasetService_GetDatasetRowAssignment_Call {
+	return &MockDatasetService_GetDatasetRowAssignment_Call{Call: _e.mock.On("GetDatasetRowAssignment", ctx, datasetId, rowId)}
+}
+
+func (_c *MockDatasetService_GetDatasetRowAssignment_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, rowId string)) *MockDatasetService_GetDatasetRowAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRowAssignment_Call) Return(_a0 *datasetsmodels.DatasetRowAssignment, _a1 error) *MockDatasetService_GetDatasetRowAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRowAssignment_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*datasetsmodels.DatasetRowAssignment, error)) *MockDatasetService_GetDatasetRowAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetService) GetDatasetRowPolicies(ctx context.Context, datasetId uuid.UUID) ([]datasetsmodels.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPolicies")
+	}
+
+	var r0 []datasetsmodels.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetRowPolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []datasetsmodels.DatasetRowPolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetRowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPolicies'
+type MockDatasetService_GetDatasetRowPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetRowPolicies(ctx interface{}, datasetId interface{}) *MockDatasetService_GetDatasetRowPolicies_Call {
+	return &MockDatasetService_GetDatasetRowPolicies_Call{Call: _e.mock.On("GetDatasetRowPolicies", ctx, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetRowPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetService_GetDatasetRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRowPolicies_Call) Return(_a0 []datasetsmodels.DatasetRowPolicy, _a1 error) *MockDatasetService_GetDatasetRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRowPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetRowPolicy, error)) *MockDatasetService_GetDatasetRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRule provides a mock function with given fields: ctx, datasetId, ruleId
+func (_m *MockDatasetService) GetDatasetRule(ctx context.Context, datasetId uuid.UUID, ruleId uuid.UUID) (rulesmodels.Rule, error) {
+	ret := _m.Called(ctx, datasetId, ruleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRule")
+	}
+
+	var r0 rulesmodels.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (rulesmodels.Rule, error)); ok {
+		return rf(ctx, datasetId, ruleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) rulesmodels.Rule); ok {
+		r0 = rf(ctx, datasetId, ruleId)
+	} else {
+		r0 = ret.Get(0).(rulesmodels.Rule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, ruleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRule'
+type MockDatasetService_GetDatasetRule_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - ruleId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetRule(ctx interface{}, datasetId interface{}, ruleId interface{}) *MockDatasetService_GetDatasetRule_Call {
+	return &MockDatasetService_GetDatasetRule_Call{Call: _e.mock.On("GetDatasetRule", ctx, datasetId, ruleId)}
+}
+
+func (_c *MockDatasetService_GetDatasetRule_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, ruleId uuid.UUID)) *MockDatasetService_GetDatasetRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRule_Call) Return(_a0 rulesmodels.Rule, _a1 error) *MockDatasetService_GetDatasetRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (rulesmodels.Rule, error)) *MockDatasetService_GetDatasetRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRuleStats provides a mock function with given fields: ctx, merchantId, datasetId, ruleId
+func (_m *MockDatasetService) GetDatasetRuleStats(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID) (datasetsmodels.DatasetRuleStats, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, ruleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRuleStats")
+	}
+
+	var r0 datasetsmodels.DatasetRuleStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetRuleStats, error)); ok {
+		return rf(ctx, merchantId, datasetId, ruleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) datasetsmodels.DatasetRuleStats); ok {
+		r0 = rf(ctx, merchantId, datasetId, ruleId)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetRuleStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, ruleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetRuleStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRuleStats'
+type MockDatasetService_GetDatasetRuleStats_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRuleStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId uuid.UUID
+//   - ruleId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetRuleStats(ctx interface{}, merchantId interface{}, datasetId interface{}, ruleId interface{}) *MockDatasetService_GetDatasetRuleStats_Call {
+	return &MockDatasetService_GetDatasetRuleStats_Call{Call: _e.mock.On("GetDatasetRuleStats", ctx, merchantId, datasetId, ruleId)}
+}
+
+func (_c *MockDatasetService_GetDatasetRuleStats_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID)) *MockDatasetService_GetDatasetRuleStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRuleStats_Call) Return(_a0 datasetsmodels.DatasetRuleStats, _a1 error) *MockDatasetService_GetDatasetRuleStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRuleStats_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetRuleStats, error)) *MockDatasetService_GetDatasetRuleStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRuleVersions provides a mock function with given fields: ctx, merchantId, datasetId, ruleId
+func (_m *MockDatasetService) GetDatasetRuleVersions(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID) ([]datasetsmodels.DatasetRuleVersion, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, ruleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRuleVersions")
+	}
+
+	var r0 []datasetsmodels.DatasetRuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetRuleVersion, error)); ok {
+		return rf(ctx, merchantId, datasetId, ruleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) []datasetsmodels.DatasetRuleVersion); ok {
+		r0 = rf(ctx, merchantId, datasetId, ruleId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetRuleVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, ruleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetRuleVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRuleVersions'
+type MockDatasetService_GetDatasetRuleVersions_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRuleVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId uuid.UUID
+//   - ruleId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetRuleVersions(ctx interface{}, merchantId interface{}, datasetId interface{}, ruleId interface{}) *MockDatasetService_GetDatasetRuleVersions_Call {
+	return &MockDatasetService_GetDatasetRuleVersions_Call{Call: _e.mock.On("GetDatasetRuleVersions", ctx, merchantId, datasetId, ruleId)}
+}
+
+func (_c *MockDatasetService_GetDatasetRuleVersions_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID)) *MockDatasetService_GetDatasetRuleVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRuleVersions_Call) Return(_a0 []datasetsmodels.DatasetRuleVersion, _a1 error) *MockDatasetService_GetDatasetRuleVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRuleVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetRuleVersion, error)) *MockDatasetService_GetDatasetRuleVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRules provides a mock function with given fields: ctx, merchantId, datasetId
+func (_m *MockDatasetService) GetDatasetRules(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID) ([]rulesmodels.Rule, error) {
+	ret := _m.Called(ctx, merchantId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRules")
+	}
+
+	var r0 []rulesmodels.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]rulesmodels.Rule, error)); ok {
+		return rf(ctx, merchantId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []rulesmodels.Rule); ok {
+		r0 = rf(ctx, merchantId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rulesmodels.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, merchantId, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRules'
+type MockDatasetService_GetDatasetRules_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetRules(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_GetDatasetRules_Call {
+	return &MockDatasetService_GetDatasetRules_Call{Call: _e.mock.On("GetDatasetRules", ctx, merchantId, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetRules_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID)) *MockDatasetService_GetDatasetRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRules_Call) Return(_a0 []rulesmodels.Rule, _a1 error) *MockDatasetService_GetDatasetRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRules_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]rulesmodels.Rule, error)) *MockDatasetService_GetDatasetRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRulesStats provides a mock function with given fields: ctx, merchantId, datasetId
+func (_m *MockDatasetService) GetDatasetRulesStats(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID) ([]datasetsmodels.DatasetRuleStats, error) {
+	ret := _m.Called(ctx, merchantId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRulesStats")
+	}
+
+	var r0 []datasetsmodels.DatasetRuleStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetRuleStats, error)); ok {
+		return rf(ctx, merchantId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []datasetsmodels.DatasetRuleStats); ok {
+		r0 = rf(ctx, merchantId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetRuleStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, merchantId, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetRulesStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRulesStats'
+type MockDatasetService_GetDatasetRulesStats_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRulesStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetRulesStats(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_GetDatasetRulesStats_Call {
+	return &MockDatasetService_GetDatasetRulesStats_Call{Call: _e.mock.On("GetDatasetRulesStats", ctx, merchantId, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetRulesStats_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID)) *MockDatasetService_GetDatasetRulesStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRulesStats_Call) Return(_a0 []datasetsmodels.DatasetRuleStats, _a1 error) *MockDatasetService_GetDatasetRulesStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetRulesStats_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetRuleStats, error)) *MockDatasetService_GetDatasetRulesStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetStatusWorkflows provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetService) GetDatasetStatusWorkflows(ctx context.Context, datasetId uuid.UUID) ([]datasetsmodels.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetStatusWorkflows")
+	}
+
+	var r0 []datasetsmodels.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []datasetsmodels.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetStatusWorkflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetStatusWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetStatusWorkflows'
+type MockDatasetService_GetDatasetStatusWorkflows_Call struct {
+	*mock.Call
+}
+
+// GetDatasetStatusWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetStatusWorkflows(ctx interface{}, datasetId interface{}) *MockDatasetService_GetDatasetStatusWorkflows_Call {
+	return &MockDatasetService_GetDatasetStatusWorkflows_Call{Call: _e.mock.On("GetDatasetStatusWorkflows", ctx, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetStatusWorkflows_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetService_GetDatasetStatusWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetStatusWorkflows_Call) Return(_a0 []datasetsmodels.DatasetStatusWorkflow, _a1 error) *MockDatasetService_GetDatasetStatusWorkflows_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetStatusWorkflows_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]datasetsmodels.DatasetStatusWorkflow, error)) *MockDatasetService_GetDatasetStatusWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetView provides a mock function with given fields: ctx, datasetId, viewId
+func (_m *MockDatasetService) GetDatasetView(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID) (datasetsmodels.DatasetView, error) {
+	ret := _m.Called(ctx, datasetId, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetView")
+	}
+
+	var r0 datasetsmodels.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetView, error)); ok {
+		return rf(ctx, datasetId, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) datasetsmodels.DatasetView); ok {
+		r0 = rf(ctx, datasetId, viewId)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetView)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetView'
+type MockDatasetService_GetDatasetView_Call struct {
+	*mock.Call
+}
+
+// GetDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetView(ctx interface{}, datasetId interface{}, viewId interface{}) *MockDatasetService_GetDatasetView_Call {
+	return &MockDatasetService_GetDatasetView_Call{Call: _e.mock.On("GetDatasetView", ctx, datasetId, viewId)}
+}
+
+func (_c *MockDatasetService_GetDatasetView_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID)) *MockDatasetService_GetDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetView_Call) Return(_a0 datasetsmodels.DatasetView, _a1 error) *MockDatasetService_GetDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetView, error)) *MockDatasetService_GetDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViewAudiences provides a mock function with given fields: ctx, datasetId, viewId
+func (_m *MockDatasetService) GetDatasetViewAudiences(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViewAudiences")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, viewId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetViewAudiences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViewAudiences'
+type MockDatasetService_GetDatasetViewAudiences_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViewAudiences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetViewAudiences(ctx interface{}, datasetId interface{}, viewId interface{}) *MockDatasetService_GetDatasetViewAudiences_Call {
+	return &MockDatasetService_GetDatasetViewAudiences_Call{Call: _e.mock.On("GetDatasetViewAudiences", ctx, datasetId, viewId)}
+}
+
+func (_c *MockDatasetService_GetDatasetViewAudiences_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, viewId uuid.UUID)) *MockDatasetService_GetDatasetViewAudiences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetViewAudiences_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetService_GetDatasetViewAudiences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetViewAudiences_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockDatasetService_GetDatasetViewAudiences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViews provides a mock function with given fields: ctx, userId, datasetId
+func (_m *MockDatasetService) GetDatasetViews(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) ([]datasetsmodels.DatasetView, error) {
+	ret := _m.Called(ctx, userId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViews")
+	}
+
+	var r0 []datasetsmodels.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetView, error)); ok {
+		return rf(ctx, userId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []datasetsmodels.DatasetView); ok {
+		r0 = rf(ctx, userId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDatasetViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViews'
+type MockDatasetService_GetDatasetViews_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDatasetViews(ctx interface{}, userId interface{}, datasetId interface{}) *MockDatasetService_GetDatasetViews_Call {
+	return &MockDatasetService_GetDatasetViews_Call{Call: _e.mock.On("GetDatasetViews", ctx, userId, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDatasetViews_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID)) *MockDatasetService_GetDatasetViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetViews_Call) Return(_a0 []datasetsmodels.DatasetView, _a1 error) *MockDatasetService_GetDatasetViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDatasetViews_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetView, error)) *MockDatasetService_GetDatasetViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId
+func (_m *MockDatasetService) GetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) (*datasetsmodels.DatasetView, error) {
+	ret := _m.Called(ctx, userId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultDatasetView")
+	}
+
+	var r0 *datasetsmodels.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*datasetsmodels.DatasetView, error)); ok {
+		return rf(ctx, userId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *datasetsmodels.DatasetView); ok {
+		r0 = rf(ctx, userId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datasetsmodels.DatasetView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultDatasetView'
+type MockDatasetService_GetDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// GetDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}) *MockDatasetService_GetDefaultDatasetView_Call {
+	return &MockDatasetService_GetDefaultDatasetView_Call{Call: _e.mock.On("GetDefaultDatasetView", ctx, userId, datasetId)}
+}
+
+func (_c *MockDatasetService_GetDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID)) *MockDatasetService_GetDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDefaultDatasetView_Call) Return(_a0 *datasetsmodels.DatasetView, _a1 error) *MockDatasetService_GetDefaultDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*datasetsmodels.DatasetView, error)) *MockDatasetService_GetDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDownloadableDataExportUrl provides a mock function with given fields: ctx, workflowId
+func (_m *MockDatasetService) GetDownloadableDataExportUrl(ctx context.Context, workflowId string) (string, error) {
+	ret := _m.Called(ctx, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDownloadableDataExportUrl")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, workflowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, workflowId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, workflowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetDownloadableDataExportUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDownloadableDataExportUrl'
+type MockDatasetService_GetDownloadableDataExportUrl_Call struct {
+	*mock.Call
+}
+
+// GetDownloadableDataExportUrl is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId string
+func (_e *MockDatasetService_Expecter) GetDownloadableDataExportUrl(ctx interface{}, workflowId interface{}) *MockDatasetService_GetDownloadableDataExportUrl_Call {
+	return &MockDatasetService_GetDownloadableDataExportUrl_Call{Call: _e.mock.On("GetDownloadableDataExportUrl", ctx, workflowId)}
+}
+
+func (_c *MockDatasetService_GetDownloadableDataExportUrl_Call) Run(run func(ctx context.Context, workflowId string)) *MockDatasetService_GetDownloadableDataExportUrl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetDownloadableDataExportUrl_Call) Return(_a0 string, _a1 error) *MockDatasetService_GetDownloadableDataExportUrl_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetDownloadableDataExportUrl_Call) RunAndReturn(run func(context.Context, string) (string, error)) *MockDatasetService_GetDownloadableDataExportUrl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFileUploadPreview provides a mock function with given fields: ctx, fileUploadId
+func (_m *MockDatasetService) GetFileUploadPreview(ctx context.Context, fileUploadId uuid.UUID) (models.DatasetPreview, error) {
+	ret := _m.Called(ctx, fileUploadId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFileUploadPreview")
+	}
+
+	var r0 models.DatasetPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetPreview, error)); ok {
+		return rf(ctx, fileUploadId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetPreview); ok {
+		r0 = rf(ctx, fileUploadId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetPreview)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, fileUploadId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetFileUploadPreview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFileUploadPreview'
+type MockDatasetService_GetFileUploadPreview_Call struct {
+	*mock.Call
+}
+
+// GetFileUploadPreview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fileUploadId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetFileUploadPreview(ctx interface{}, fileUploadId interface{}) *MockDatasetService_GetFileUploadPreview_Call {
+	return &MockDatasetService_GetFileUploadPreview_Call{Call: _e.mock.On("GetFileUploadPreview", ctx, fileUploadId)}
+}
+
+func (_c *MockDatasetService_GetFileUploadPreview_Call) Run(run func(ctx context.Context, fileUploadId uuid.UUID)) *MockDatasetService_GetFileUploadPreview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetFileUploadPreview_Call) Return(_a0 models.DatasetPreview, _a1 error) *MockDatasetService_GetFileUploadPreview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetFileUploadPreview_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetPreview, error)) *MockDatasetService_GetFileUploadPreview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFilterConfigByDatasetId provides a mock function with given fields: ctx, merchantId, datasetId
+func (_m *MockDatasetService) GetFilterConfigByDatasetId(ctx context.Context, merchantId uuid.UUID, datasetId string) ([]datasetsmodels.FilterConfig, map[string]interface{}, error) {
+	ret := _m.Called(ctx, merchantId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFilterConfigByDatasetId")
+	}
+
+	var r0 []datasetsmodels.FilterConfig
+	var r1 map[string]interface{}
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]datasetsmodels.FilterConfig, map[string]interface{}, error)); ok {
+		return rf(ctx, merchantId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []datasetsmodels.FilterConfig); ok {
+		r0 = rf(ctx, merchantId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.FilterConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) map[string]interface{}); ok {
+		r1 = rf(ctx, merchantId, datasetId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string) error); ok {
+		r2 = rf(ctx, merchantId, datasetId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDatasetService_GetFilterConfigByDatasetId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFilterConfigByDatasetId'
+type MockDatasetService_GetFilterConfigByDatasetId_Call struct {
+	*mock.Call
+}
+
+// GetFilterConfigByDatasetId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId string
+func (_e *MockDatasetService_Expecter) GetFilterConfigByDatasetId(ctx interface{}, merchantId interface{}, datasetId interface{}) *MockDatasetService_GetFilterConfigByDatasetId_Call {
+	return &MockDatasetService_GetFilterConfigByDatasetId_Call{Call: _e.mock.On("GetFilterConfigByDatasetId", ctx, merchantId, datasetId)}
+}
+
+func (_c *MockDatasetService_GetFilterConfigByDatasetId_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string)) *MockDatasetService_GetFilterConfigByDatasetId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetFilterConfigByDatasetId_Call) Return(_a0 []datasetsmodels.FilterConfig, _a1 map[string]interface{}, _a2 error) *MockDatasetService_GetFilterConfigByDatasetId_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockDatasetService_GetFilterConfigByDatasetId_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]datasetsmodels.FilterConfig, map[string]interface{}, error)) *MockDatasetService_GetFilterConfigByDatasetId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMyQueue provides a mock function with given fields: ctx, merchantId, userId
+func (_m *MockDatasetService) GetMyQueue(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID) ([]datasetsmodels.DatasetQueueItem, error) {
+	ret := _m.Called(ctx, merchantId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMyQueue")
+	}
+
+	var r0 []datasetsmodels.DatasetQueueItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetQueueItem, error)); ok {
+		return rf(ctx, merchantId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []datasetsmodels.DatasetQueueItem); ok {
+		r0 = rf(ctx, merchantId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetQueueItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, merchantId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetMyQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMyQueue'
+type MockDatasetService_GetMyQueue_Call struct {
+	*mock.Call
+}
+
+// GetMyQueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockDatasetService_Expecter) GetMyQueue(ctx interface{}, merchantId interface{}, userId interface{}) *MockDatasetService_GetMyQueue_Call {
+	return &MockDatasetService_GetMyQueue_Call{Call: _e.mock.On("GetMyQueue", ctx, merchantId, userId)}
+}
+
+func (_c *MockDatasetService_GetMyQueue_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID)) *MockDatasetService_GetMyQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetMyQueue_Call) Return(_a0 []datasetsmodels.DatasetQueueItem, _a1 error) *MockDatasetService_GetMyQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetMyQueue_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]datasetsmodels.DatasetQueueItem, error)) *MockDatasetService_GetMyQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOptionsForColumn provides a mock function with given fields: ctx, merchantId, datasetId, column, filterType, respectThreshold
+func (_m *MockDatasetService) GetOptionsForColumn(ctx context.Context, merchantId uuid.UUID, datasetId string, column string, filterType string, respectThreshold bool) ([]interface{}, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, column, filterType, respectThreshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOptionsForColumn")
+	}
+
+	var r0 []interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, bool) ([]interface{}, error)); ok {
+		return rf(ctx, merchantId, datasetId, column, filterType, respectThreshold)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, bool) []interface{}); ok {
+		r0 = rf(ctx, merchantId, datasetId, column, filterType, respectThreshold)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, string, bool) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, column, filterType, respectThreshold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetOptionsForColumn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOptionsForColumn'
+type MockDatasetService_GetOptionsForColumn_Call struct {
+	*mock.Call
+}
+
+// GetOptionsForColumn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId string
+//   - column string
+//   - filterType string
+//   - respectThreshold bool
+func (_e *MockDatasetService_Expecter) GetOptionsForColumn(ctx interface{}, merchantId interface{}, datasetId interface{}, column interface{}, filterType interface{}, respectThreshold interface{}) *MockDatasetService_GetOptionsForColumn_Call {
+	return &MockDatasetService_GetOptionsForColumn_Call{Call: _e.mock.On("GetOptionsForColumn", ctx, merchantId, datasetId, column, filterType, respectThreshold)}
+}
+
+func (_c *MockDatasetService_GetOptionsForColumn_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, column string, filterType string, respectThreshold bool)) *MockDatasetService_GetOptionsForColumn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(string), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetOptionsForColumn_Call) Return(_a0 []interface{}, _a1 error) *MockDatasetService_GetOptionsForColumn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetOptionsForColumn_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, string, bool) ([]interface{}, error)) *MockDatasetService_GetOptionsForColumn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRowDetailsByUUID provides a mock function with given fields: ctx, merchantId, datasetId, rowUUID
+func (_m *MockDatasetService) GetRowDetailsByUUID(ctx context.Context, merchantId uuid.UUID, datasetId string, rowUUID string) (datasetsmodels.ParentDatasetInfo, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, rowUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRowDetailsByUUID")
+	}
+
+	var r0 datasetsmodels.ParentDatasetInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) (datasetsmodels.ParentDatasetInfo, error)); ok {
+		return rf(ctx, merchantId, datasetId, rowUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) datasetsmodels.ParentDatasetInfo); ok {
+		r0 = rf(ctx, merchantId, datasetId, rowUUID)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.ParentDatasetInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, rowUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetRowDetailsByUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRowDetailsByUUID'
+type MockDatasetService_GetRowDetailsByUUID_Call struct {
+	*mock.Call
+}
+
+// GetRowDetailsByUUID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId string
+//   - rowUUID string
+func (_e *MockDatasetService_Expecter) GetRowDetailsByUUID(ctx interface{}, merchantId interface{}, datasetId interface{}, rowUUID interface{}) *MockDatasetService_GetRowDetailsByUUID_Call {
+	return &MockDatasetService_GetRowDetailsByUUID_Call{Call: _e.mock.On("GetRowDetailsByUUID", ctx, merchantId, datasetId, rowUUID)}
+}
+
+func (_c *MockDatasetService_GetRowDetailsByUUID_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, rowUUID string)) *MockDatasetService_GetRowDetailsByUUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetRowDetailsByUUID_Call) Return(_a0 datasetsmodels.ParentDatasetInfo, _a1 error) *MockDatasetService_GetRowDetailsByUUID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetRowDetailsByUUID_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) (datasetsmodels.ParentDatasetInfo, error)) *MockDatasetService_GetRowDetailsByUUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRulesByDatasetColumns provides a mock function with given fields: ctx, orgId, datasetColumns
+func (_m *MockDatasetService) GetRulesByDatasetColumns(ctx context.Context, orgId uuid.UUID, datasetColumns []models.DatasetColumn) (map[string]map[string][]rulesmodels.Rule, error) {
+	ret := _m.Called(ctx, orgId, datasetColumns)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRulesByDatasetColumns")
+	}
+
+	var r0 map[string]map[string][]rulesmodels.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.DatasetColumn) (map[string]map[string][]rulesmodels.Rule, error)); ok {
+		return rf(ctx, orgId, datasetColumns)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.DatasetColumn) map[string]map[string][]rulesmodels.Rule); ok {
+		r0 = rf(ctx, orgId, datasetColumns)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string][]rulesmodels.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []models.DatasetColumn) error); ok {
+		r1 = rf(ctx, orgId, datasetColumns)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetRulesByDatasetColumns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRulesByDatasetColumns'
+type MockDatasetService_GetRulesByDatasetColumns_Call struct {
+	*mock.Call
+}
+
+// GetRulesByDatasetColumns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - datasetColumns []models.DatasetColumn
+func (_e *MockDatasetService_Expecter) GetRulesByDatasetColumns(ctx interface{}, orgId interface{}, datasetColumns interface{}) *MockDatasetService_GetRulesByDatasetColumns_Call {
+	return &MockDatasetService_GetRulesByDatasetColumns_Call{Call: _e.mock.On("GetRulesByDatasetColumns", ctx, orgId, datasetColumns)}
+}
+
+func (_c *MockDatasetService_GetRulesByDatasetColumns_Call) Run(run func(ctx context.Context, orgId uuid.UUID, datasetColumns []models.DatasetColumn)) *MockDatasetService_GetRulesByDatasetColumns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.DatasetColumn))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetRulesByDatasetColumns_Call) Return(_a0 map[string]map[string][]rulesmodels.Rule, _a1 error) *MockDatasetService_GetRulesByDatasetColumns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetRulesByDatasetColumns_Call) RunAndReturn(run func(context.Context, uuid.UUID, []models.DatasetColumn) (map[string]map[string][]rulesmodels.Rule, error)) *MockDatasetService_GetRulesByDatasetColumns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRulesByIds provides a mock function with given fields: ctx, ruleIds
+func (_m *MockDatasetService) GetRulesByIds(ctx context.Context, ruleIds []string) ([]rulesmodels.Rule, error) {
+	ret := _m.Called(ctx, ruleIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRulesByIds")
+	}
+
+	var r0 []rulesmodels.Rule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]rulesmodels.Rule, error)); ok {
+		return rf(ctx, ruleIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []rulesmodels.Rule); ok {
+		r0 = rf(ctx, ruleIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rulesmodels.Rule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ruleIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_GetRulesByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRulesByIds'
+type MockDatasetService_GetRulesByIds_Call struct {
+	*mock.Call
+}
+
+// GetRulesByIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleIds []string
+func (_e *MockDatasetService_Expecter) GetRulesByIds(ctx interface{}, ruleIds interface{}) *MockDatasetService_GetRulesByIds_Call {
+	return &MockDatasetService_GetRulesByIds_Call{Call: _e.mock.On("GetRulesByIds", ctx, ruleIds)}
+}
+
+func (_c *MockDatasetService_GetRulesByIds_Call) Run(run func(ctx context.Context, ruleIds []string)) *MockDatasetService_GetRulesByIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_GetRulesByIds_Call) Return(_a0 []rulesmodels.Rule, _a1 error) *MockDatasetService_GetRulesByIds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_GetRulesByIds_Call) RunAndReturn(run func(context.Context, []string) ([]rulesmodels.Rule, error)) *MockDatasetService_GetRulesByIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportDataFromFile provides a mock function with given fields: ctx, merchantId, datasetId, fileUploadId
+func (_m *MockDatasetService) ImportDataFromFile(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, fileUploadId uuid.UUID) error {
+	ret := _m.Called(ctx, merchantId, datasetId, fileUploadId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportDataFromFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, merchantId, datasetId, fileUploadId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetService_ImportDataFromFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportDataFromFile'
+type MockDatasetService_ImportDataFromFile_Call struct {
+	*mock.Call
+}
+
+// ImportDataFromFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId uuid.UUID
+//   - fileUploadId uuid.UUID
+func (_e *MockDatasetService_Expecter) ImportDataFromFile(ctx interface{}, merchantId interface{}, datasetId interface{}, fileUploadId interface{}) *MockDatasetService_ImportDataFromFile_Call {
+	return &MockDatasetService_ImportDataFromFile_Call{Call: _e.mock.On("ImportDataFromFile", ctx, merchantId, datasetId, fileUploadId)}
+}
+
+func (_c *MockDatasetService_ImportDataFromFile_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, fileUploadId uuid.UUID)) *MockDatasetService_ImportDataFromFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_ImportDataFromFile_Call) Return(err error) *MockDatasetService_ImportDataFromFile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatasetService_ImportDataFromFile_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockDatasetService_ImportDataFromFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportDatasetRuleBundle provides a mock function with given fields: ctx, merchantId, userId, datasetId, params
+func (_m *MockDatasetService) ImportDatasetRuleBundle(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.ImportDatasetRuleBundleParams) (datasetsmodels.DatasetRuleBundleImport, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportDatasetRuleBundle")
+	}
+
+	var r0 datasetsmodels.DatasetRuleBundleImport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.ImportDatasetRuleBundleParams) (datasetsmodels.DatasetRuleBundleImport, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.ImportDatasetRuleBundleParams) datasetsmodels.DatasetRuleBundleImport); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, params)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetRuleBundleImport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.ImportDatasetRuleBundleParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_ImportDatasetRuleBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportDatasetRuleBundle'
+type MockDatasetService_ImportDatasetRuleBundle_Call struct {
+	*mock.Call
+}
+
+// ImportDatasetRuleBundle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - params datasetsmodels.ImportDatasetRuleBundleParams
+func (_e *MockDatasetService_Expecter) ImportDatasetRuleBundle(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_ImportDatasetRuleBundle_Call {
+	return &MockDatasetService_ImportDatasetRuleBundle_Call{Call: _e.mock.On("ImportDatasetRuleBundle", ctx, merchantId, userId, datasetId, params)}
+}
+
+func (_c *MockDatasetService_ImportDatasetRuleBundle_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.ImportDatasetRuleBundleParams)) *MockDatasetService_ImportDatasetRuleBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.ImportDatasetRuleBundleParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_ImportDatasetRuleBundle_Call) Return(_a0 datasetsmodels.DatasetRuleBundleImport, _a1 error) *MockDatasetService_ImportDatasetRuleBundle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_ImportDatasetRuleBundle_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.ImportDatasetRuleBundleParams) (datasetsmodels.DatasetRuleBundleImport, error)) *MockDatasetService_ImportDatasetRuleBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitiateFilePreparationForDatasetImport provides a mock function with given fields: ctx, datasetId, fileId
+func (_m *MockDatasetService) InitiateFilePreparationForDatasetImport(ctx context.Context, datasetId uuid.UUID, fileId uuid.UUID) (*uuid.UUID, error) {
+	ret := _m.Called(ctx, datasetId, fileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitiateFilePreparationForDatasetImport")
+	}
+
+	var r0 *uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*uuid.UUID, error)); ok {
+		return rf(ctx, datasetId, fileId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *uuid.UUID); ok {
+		r0 = rf(ctx, datasetId, fileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, fileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_InitiateFilePreparationForDatasetImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitiateFilePreparationForDatasetImport'
+type MockDatasetService_InitiateFilePreparationForDatasetImport_Call struct {
+	*mock.Call
+}
+
+// InitiateFilePreparationForDatasetImport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - fileId uuid.UUID
+func (_e *MockDatasetService_Expecter) InitiateFilePreparationForDatasetImport(ctx interface{}, datasetId interface{}, fileId interface{}) *MockDatasetService_InitiateFilePreparationForDatasetImport_Call {
+	return &MockDatasetService_InitiateFilePreparationForDatasetImport_Call{Call: _e.mock.On("InitiateFilePreparationForDatasetImport", ctx, datasetId, fileId)}
+}
+
+func (_c *MockDatasetService_InitiateFilePreparationForDatasetImport_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, fileId uuid.UUID)) *MockDatasetService_InitiateFilePreparationForDatasetImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_InitiateFilePreparationForDatasetImport_Call) Return(datasetActionId *uuid.UUID, err error) *MockDatasetService_InitiateFilePreparationForDatasetImport_Call {
+	_c.Call.Return(datasetActionId, err)
+	return _c
+}
+
+func (_c *MockDatasetService_InitiateFilePreparationForDatasetImport_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*uuid.UUID, error)) *MockDatasetService_InitiateFilePreparationForDatasetImport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewDatasetDataForUser provides a mock function with given fields: ctx, merchantId, datasetId, previewUserId, params
+func (_m *MockDatasetService) PreviewDatasetDataForUser(ctx context.Context, merchantId uuid.UUID, datasetId string, previewUserId uuid.UUID, params datasetsmodels.DatasetParams) (datasetsmodels.DatasetData, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, previewUserId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewDatasetDataForUser")
+	}
+
+	var r0 datasetsmodels.DatasetData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, datasetsmodels.DatasetParams) (datasetsmodels.DatasetData, error)); ok {
+		return rf(ctx, merchantId, datasetId, previewUserId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, uuid.UUID, datasetsmodels.DatasetParams) datasetsmodels.DatasetData); ok {
+		r0 = rf(ctx, merchantId, datasetId, previewUserId, params)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, uuid.UUID, datasetsmodels.DatasetParams) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, previewUserId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_PreviewDatasetDataForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewDatasetDataForUser'
+type MockDatasetService_PreviewDatasetDataForUser_Call struct {
+	*mock.Call
+}
+
+// PreviewDatasetDataForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId string
+//   - previewUserId uuid.UUID
+//   - params datasetsmodels.DatasetParams
+func (_e *MockDatasetService_Expecter) PreviewDatasetDataForUser(ctx interface{}, merchantId interface{}, datasetId interface{}, previewUserId interface{}, params interface{}) *MockDatasetService_PreviewDatasetDataForUser_Call {
+	return &MockDatasetService_PreviewDatasetDataForUser_Call{Call: _e.mock.On("PreviewDatasetDataForUser", ctx, merchantId, datasetId, previewUserId, params)}
+}
+
+func (_c *MockDatasetService_PreviewDatasetDataForUser_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, previewUserId uuid.UUID, params datasetsmodels.DatasetParams)) *MockDatasetService_PreviewDatasetDataForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_PreviewDatasetDataForUser_Call) Return(_a0 datasetsmodels.DatasetData, _a1 error) *MockDatasetService_PreviewDatasetDataForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_PreviewDatasetDataForUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, uuid.UUID, datasetsmodels.DatasetParams) (datasetsmodels.DatasetData, error)) *MockDatasetService_PreviewDatasetDataForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewDatasetRule provides a mock function with given fields: ctx, merchantId, datasetId, params
+func (_m *MockDatasetService) PreviewDatasetRule(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetRulePreviewParams) (datasetsmodels.DatasetRulePreview, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewDatasetRule")
+	}
+
+	var r0 datasetsmodels.DatasetRulePreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRulePreviewParams) (datasetsmodels.DatasetRulePreview, error)); ok {
+		return rf(ctx, merchantId, datasetId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRulePreviewParams) datasetsmodels.DatasetRulePreview); ok {
+		r0 = rf(ctx, merchantId, datasetId, params)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetRulePreview)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRulePreviewParams) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_PreviewDatasetRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewDatasetRule'
+type MockDatasetService_PreviewDatasetRule_Call struct {
+	*mock.Call
+}
+
+// PreviewDatasetRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId uuid.UUID
+//   - params datasetsmodels.DatasetRulePreviewParams
+func (_e *MockDatasetService_Expecter) PreviewDatasetRule(ctx interface{}, merchantId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_PreviewDatasetRule_Call {
+	return &MockDatasetService_PreviewDatasetRule_Call{Call: _e.mock.On("PreviewDatasetRule", ctx, merchantId, datasetId, params)}
+}
+
+func (_c *MockDatasetService_PreviewDatasetRule_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.DatasetRulePreviewParams)) *MockDatasetService_PreviewDatasetRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(datasetsmodels.DatasetRulePreviewParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_PreviewDatasetRule_Call) Return(_a0 datasetsmodels.DatasetRulePreview, _a1 error) *MockDatasetService_PreviewDatasetRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_PreviewDatasetRule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRulePreviewParams) (datasetsmodels.DatasetRulePreview, error)) *MockDatasetService_PreviewDatasetRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterDataset provides a mock function with given fields: ctx, merchantId, userId, datasetCreationInfo
+func (_m *MockDatasetService) RegisterDataset(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetCreationInfo datasetsmodels.DatasetCreationInfo) (string, uuid.UUID, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetCreationInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterDataset")
+	}
+
+	var r0 string
+	var r1 uuid.UUID
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) (string, uuid.UUID, error)); ok {
+		return rf(ctx, merchantId, userId, datasetCreationInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) string); ok {
+		r0 = rf(ctx, merchantId, userId, datasetCreationInfo)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) uuid.UUID); ok {
+		r1 = rf(ctx, merchantId, userId, datasetCreationInfo)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) error); ok {
+		r2 = rf(ctx, merchantId, userId, datasetCreationInfo)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockDatasetService_RegisterDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterDataset'
+type MockDatasetService_RegisterDataset_Call struct {
+	*mock.Call
+}
+
+// RegisterDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
+//   - datasetCreationInfo datasetsmodels.DatasetCreationInfo
+func (_e *MockDatasetService_Expecter) RegisterDataset(ctx interface{}, merchantId interface{}, userId interface{}, datasetCreationInfo interface{}) *MockDatasetService_RegisterDataset_Call {
+	return &MockDatasetService_RegisterDataset_Call{Call: _e.mock.On("RegisterDataset", ctx, merchantId, userId, datasetCreationInfo)}
+}
+
+func (_c *MockDatasetService_RegisterDataset_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetCreationInfo datasetsmodels.DatasetCreationInfo)) *MockDatasetService_RegisterDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(datasetsmodels.DatasetCreationInfo))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_RegisterDataset_Call) Return(_a0 string, _a1 uuid.UUID, _a2 error) *MockDatasetService_RegisterDataset_Call {
 	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockDatasetService_RegisterDataset_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) (string, uuid.UUID, error)) *MockDatasetService_RegisterDataset_Call {
+func (_c *MockDatasetService_RegisterDataset_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.DatasetCreationInfo) (string, uuid.UUID, error)) *MockDatasetService_RegisterDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterDatasetJob provides a mock function with given fields: ctx, merchantId, jobInfo
+func (_m *MockDatasetService) RegisterDatasetJob(ctx context.Context, merchantId uuid.UUID, jobInfo actionsmodels.RegisterJobActionPayload) (string, error) {
+	ret := _m.Called(ctx, merchantId, jobInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterDatasetJob")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, actionsmodels.RegisterJobActionPayload) (string, error)); ok {
+		return rf(ctx, merchantId, jobInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, actionsmodels.RegisterJobActionPayload) string); ok {
+		r0 = rf(ctx, merchantId, jobInfo)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, actionsmodels.RegisterJobActionPayload) error); ok {
+		r1 = rf(ctx, merchantId, jobInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_RegisterDatasetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterDatasetJob'
+type MockDatasetService_RegisterDatasetJob_Call struct {
+	*mock.Call
+}
+
+// RegisterDatasetJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - jobInfo actionsmodels.RegisterJobActionPayload
+func (_e *MockDatasetService_Expecter) RegisterDatasetJob(ctx interface{}, merchantId interface{}, jobInfo interface{}) *MockDatasetService_RegisterDatasetJob_Call {
+	return &MockDatasetService_RegisterDatasetJob_Call{Call: _e.mock.On("RegisterDatasetJob", ctx, merchantId, jobInfo)}
+}
+
+func (_c *MockDatasetService_RegisterDatasetJob_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, jobInfo actionsmodels.RegisterJobActionPayload)) *MockDatasetService_RegisterDatasetJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(actionsmodels.RegisterJobActionPayload))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_RegisterDatasetJob_Call) Return(_a0 string, _a1 error) *MockDatasetService_RegisterDatasetJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_RegisterDatasetJob_Call) RunAndReturn(run func(context.Context, uuid.UUID, actionsmodels.RegisterJobActionPayload) (string, error)) *MockDatasetService_RegisterDatasetJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAudienceFromDataset provides a mock function with given fields: ctx, datasetId, audienceId
+func (_m *MockDatasetService) RemoveAudienceFromDataset(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, datasetId, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAudienceFromDataset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, datasetId, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetService_RemoveAudienceFromDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAudienceFromDataset'
+type MockDatasetService_RemoveAudienceFromDataset_Call struct {
+	*mock.Call
+}
+
+// RemoveAudienceFromDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceId uuid.UUID
+func (_e *MockDatasetService_Expecter) RemoveAudienceFromDataset(ctx interface{}, datasetId interface{}, audienceId interface{}) *MockDatasetService_RemoveAudienceFromDataset_Call {
+	return &MockDatasetService_RemoveAudienceFromDataset_Call{Call: _e.mock.On("RemoveAudienceFromDataset", ctx, datasetId, audienceId)}
+}
+
+func (_c *MockDatasetService_RemoveAudienceFromDataset_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID)) *MockDatasetService_RemoveAudienceFromDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_RemoveAudienceFromDataset_Call) Return(_a0 error) *MockDatasetService_RemoveAudienceFromDataset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetService_RemoveAudienceFromDataset_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetService_RemoveAudienceFromDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAudienceFromDatasetView provides a mock function with given fields: ctx, userId, datasetId, viewId, audienceType, audienceId
+func (_m *MockDatasetService) RemoveAudienceFromDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId, viewId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAudienceFromDatasetView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId, viewId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetService_RemoveAudienceFromDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAudienceFromDatasetView'
+type MockDatasetService_RemoveAudienceFromDatasetView_Call struct {
+	*mock.Call
+}
+
+// RemoveAudienceFromDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockDatasetService_Expecter) RemoveAudienceFromDatasetView(ctx interface{}, userId interface{}, datasetId interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}) *MockDatasetService_RemoveAudienceFromDatasetView_Call {
+	return &MockDatasetService_RemoveAudienceFromDatasetView_Call{Call: _e.mock.On("RemoveAudienceFromDatasetView", ctx, userId, datasetId, viewId, audienceType, audienceId)}
+}
+
+func (_c *MockDatasetService_RemoveAudienceFromDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockDatasetService_RemoveAudienceFromDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(models.AudienceType), args[5].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_RemoveAudienceFromDatasetView_Call) Return(_a0 error) *MockDatasetService_RemoveAudienceFromDatasetView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetService_RemoveAudienceFromDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockDatasetService_RemoveAudienceFromDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreDatasetRuleVersion provides a mock function with given fields: ctx, merchantId, userId, datasetId, ruleId, version
+func (_m *MockDatasetService) RestoreDatasetRuleVersion(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, version int) (datasetsmodels.DatasetRuleChange, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, ruleId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreDatasetRuleVersion")
+	}
+
+	var r0 datasetsmodels.DatasetRuleChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, int) (datasetsmodels.DatasetRuleChange, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, ruleId, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, int) datasetsmodels.DatasetRuleChange); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, ruleId, version)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetRuleChange)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, ruleId, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_RestoreDatasetRuleVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreDatasetRuleVersion'
+type MockDatasetService_RestoreDatasetRuleVersion_Call struct {
+	*mock.Call
+}
+
+// RestoreDatasetRuleVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - ruleId uuid.UUID
+//   - version int
+func (_e *MockDatasetService_Expecter) RestoreDatasetRuleVersion(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, ruleId interface{}, version interface{}) *MockDatasetService_RestoreDatasetRuleVersion_Call {
+	return &MockDatasetService_RestoreDatasetRuleVersion_Call{Call: _e.mock.On("RestoreDatasetRuleVersion", ctx, merchantId, userId, datasetId, ruleId, version)}
+}
+
+func (_c *MockDatasetService_RestoreDatasetRuleVersion_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, version int)) *MockDatasetService_RestoreDatasetRuleVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_RestoreDatasetRuleVersion_Call) Return(_a0 datasetsmodels.DatasetRuleChange, _a1 error) *MockDatasetService_RestoreDatasetRuleVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_RestoreDatasetRuleVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, int) (datasetsmodels.DatasetRuleChange, error)) *MockDatasetService_RestoreDatasetRuleVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDatasetRuleEnabled provides a mock function with given fields: ctx, merchantId, userId, datasetId, ruleId, isEnabled
+func (_m *MockDatasetService) SetDatasetRuleEnabled(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, isEnabled bool) (datasetsmodels.DatasetRuleChange, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, ruleId, isEnabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDatasetRuleEnabled")
+	}
+
+	var r0 datasetsmodels.DatasetRuleChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, bool) (datasetsmodels.DatasetRuleChange, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, ruleId, isEnabled)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, bool) datasetsmodels.DatasetRuleChange); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, ruleId, isEnabled)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetRuleChange)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, bool) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, ruleId, isEnabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_SetDatasetRuleEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDatasetRuleEnabled'
+type MockDatasetService_SetDatasetRuleEnabled_Call struct {
+	*mock.Call
+}
+
+// SetDatasetRuleEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - ruleId uuid.UUID
+//   - isEnabled bool
+func (_e *MockDatasetService_Expecter) SetDatasetRuleEnabled(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, ruleId interface{}, isEnabled interface{}) *MockDatasetService_SetDatasetRuleEnabled_Call {
+	return &MockDatasetService_SetDatasetRuleEnabled_Call{Call: _e.mock.On("SetDatasetRuleEnabled", ctx, merchantId, userId, datasetId, ruleId, isEnabled)}
+}
+
+func (_c *MockDatasetService_SetDatasetRuleEnabled_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, isEnabled bool)) *MockDatasetService_SetDatasetRuleEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(bool))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_SetDatasetRuleEnabled_Call) Return(_a0 datasetsmodels.DatasetRuleChange, _a1 error) *MockDatasetService_SetDatasetRuleEnabled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_SetDatasetRuleEnabled_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, bool) (datasetsmodels.DatasetRuleChange, error)) *MockDatasetService_SetDatasetRuleEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId, viewId
+func (_m *MockDatasetService) SetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) (datasetsmodels.DatasetView, error) {
+	ret := _m.Called(ctx, userId, datasetId, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDefaultDatasetView")
+	}
+
+	var r0 datasetsmodels.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetView, error)); ok {
+		return rf(ctx, userId, datasetId, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) datasetsmodels.DatasetView); ok {
+		r0 = rf(ctx, userId, datasetId, viewId)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetView)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_SetDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDefaultDatasetView'
+type MockDatasetService_SetDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// SetDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+func (_e *MockDatasetService_Expecter) SetDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}, viewId interface{}) *MockDatasetService_SetDefaultDatasetView_Call {
+	return &MockDatasetService_SetDefaultDatasetView_Call{Call: _e.mock.On("SetDefaultDatasetView", ctx, userId, datasetId, viewId)}
+}
+
+func (_c *MockDatasetService_SetDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID)) *MockDatasetService_SetDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_SetDefaultDatasetView_Call) Return(_a0 datasetsmodels.DatasetView, _a1 error) *MockDatasetService_SetDefaultDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_SetDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetView, error)) *MockDatasetService_SetDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SnoozeDatasetAlert provides a mock function with given fields: ctx, userId, datasetId, alertId, until
+func (_m *MockDatasetService) SnoozeDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID, until time.Time) (datasetsmodels.DatasetAlert, error) {
+	ret := _m.Called(ctx, userId, datasetId, alertId, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SnoozeDatasetAlert")
+	}
+
+	var r0 datasetsmodels.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (datasetsmodels.DatasetAlert, error)); ok {
+		return rf(ctx, userId, datasetId, alertId, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) datasetsmodels.DatasetAlert); ok {
+		r0 = rf(ctx, userId, datasetId, alertId, until)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, userId, datasetId, alertId, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_SnoozeDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnoozeDatasetAlert'
+type MockDatasetService_SnoozeDatasetAlert_Call struct {
+	*mock.Call
+}
+
+// SnoozeDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - alertId uuid.UUID
+//   - until time.Time
+func (_e *MockDatasetService_Expecter) SnoozeDatasetAlert(ctx interface{}, userId interface{}, datasetId interface{}, alertId interface{}, until interface{}) *MockDatasetService_SnoozeDatasetAlert_Call {
+	return &MockDatasetService_SnoozeDatasetAlert_Call{Call: _e.mock.On("SnoozeDatasetAlert", ctx, userId, datasetId, alertId, until)}
+}
+
+func (_c *MockDatasetService_SnoozeDatasetAlert_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID, until time.Time)) *MockDatasetService_SnoozeDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_SnoozeDatasetAlert_Call) Return(_a0 datasetsmodels.DatasetAlert, _a1 error) *MockDatasetService_SnoozeDatasetAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_SnoozeDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, time.Time) (datasetsmodels.DatasetAlert, error)) *MockDatasetService_SnoozeDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartDatasetExportScheduleRunActivity provides a mock function with given fields: ctx, params, workflowId
+func (_m *MockDatasetService) StartDatasetExportScheduleRunActivity(ctx context.Context, params datasetsmodels.DatasetExportScheduleWorkflowParams, workflowId string) (datasetsmodels.DatasetExportScheduleRunPlan, error) {
+	ret := _m.Called(ctx, params, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartDatasetExportScheduleRunActivity")
+	}
+
+	var r0 datasetsmodels.DatasetExportScheduleRunPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, datasetsmodels.DatasetExportScheduleWorkflowParams, string) (datasetsmodels.DatasetExportScheduleRunPlan, error)); ok {
+		return rf(ctx, params, workflowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, datasetsmodels.DatasetExportScheduleWorkflowParams, string) datasetsmodels.DatasetExportScheduleRunPlan); ok {
+		r0 = rf(ctx, params, workflowId)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetExportScheduleRunPlan)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, datasetsmodels.DatasetExportScheduleWorkflowParams, string) error); ok {
+		r1 = rf(ctx, params, workflowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_StartDatasetExportScheduleRunActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartDatasetExportScheduleRunActivity'
+type MockDatasetService_StartDatasetExportScheduleRunActivity_Call struct {
+	*mock.Call
+}
+
+// StartDatasetExportScheduleRunActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params datasetsmodels.DatasetExportScheduleWorkflowParams
+//   - workflowId string
+func (_e *MockDatasetService_Expecter) StartDatasetExportScheduleRunActivity(ctx interface{}, params interface{}, workflowId interface{}) *MockDatasetService_StartDatasetExportScheduleRunActivity_Call {
+	return &MockDatasetService_StartDatasetExportScheduleRunActivity_Call{Call: _e.mock.On("StartDatasetExportScheduleRunActivity", ctx, params, workflowId)}
+}
+
+func (_c *MockDatasetService_StartDatasetExportScheduleRunActivity_Call) Run(run func(ctx context.Context, params datasetsmodels.DatasetExportScheduleWorkflowParams, workflowId string)) *MockDatasetService_StartDatasetExportScheduleRunActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(datasetsmodels.DatasetExportScheduleWorkflowParams), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_StartDatasetExportScheduleRunActivity_Call) Return(_a0 datasetsmodels.DatasetExportScheduleRunPlan, _a1 error) *MockDatasetService_StartDatasetExportScheduleRunActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_StartDatasetExportScheduleRunActivity_Call) RunAndReturn(run func(context.Context, datasetsmodels.DatasetExportScheduleWorkflowParams, string) (datasetsmodels.DatasetExportScheduleRunPlan, error)) *MockDatasetService_StartDatasetExportScheduleRunActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionDatasetRowStatus provides a mock function with given fields: ctx, merchantId, userId, datasetId, rowId, column, state
+func (_m *MockDatasetService) TransitionDatasetRowStatus(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, rowId string, column string, state string) (datasetsmodels.DatasetAction, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, rowId, column, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionDatasetRowStatus")
+	}
+
+	var r0 datasetsmodels.DatasetAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, string, string) (datasetsmodels.DatasetAction, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, rowId, column, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, string, string) datasetsmodels.DatasetAction); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, rowId, column, state)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetAction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, string, string) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, rowId, column, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_TransitionDatasetRowStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionDatasetRowStatus'
+type MockDatasetService_TransitionDatasetRowStatus_Call struct {
+	*mock.Call
+}
+
+// TransitionDatasetRowStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - rowId string
+//   - column string
+//   - state string
+func (_e *MockDatasetService_Expecter) TransitionDatasetRowStatus(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, rowId interface{}, column interface{}, state interface{}) *MockDatasetService_TransitionDatasetRowStatus_Call {
+	return &MockDatasetService_TransitionDatasetRowStatus_Call{Call: _e.mock.On("TransitionDatasetRowStatus", ctx, merchantId, userId, datasetId, rowId, column, state)}
+}
+
+func (_c *MockDatasetService_TransitionDatasetRowStatus_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, rowId string, column string, state string)) *MockDatasetService_TransitionDatasetRowStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string), args[5].(string), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_TransitionDatasetRowStatus_Call) Return(_a0 datasetsmodels.DatasetAction, _a1 error) *MockDatasetService_TransitionDatasetRowStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_TransitionDatasetRowStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string, string, string) (datasetsmodels.DatasetAction, error)) *MockDatasetService_TransitionDatasetRowStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsnoozeDatasetAlert provides a mock function with given fields: ctx, userId, datasetId, alertId
+func (_m *MockDatasetService) UnsnoozeDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID) (datasetsmodels.DatasetAlert, error) {
+	ret := _m.Called(ctx, userId, datasetId, alertId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsnoozeDatasetAlert")
+	}
+
+	var r0 datasetsmodels.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetAlert, error)); ok {
+		return rf(ctx, userId, datasetId, alertId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) datasetsmodels.DatasetAlert); ok {
+		r0 = rf(ctx, userId, datasetId, alertId)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId, alertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_UnsnoozeDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsnoozeDatasetAlert'
+type MockDatasetService_UnsnoozeDatasetAlert_Call struct {
+	*mock.Call
+}
+
+// UnsnoozeDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - alertId uuid.UUID
+func (_e *MockDatasetService_Expecter) UnsnoozeDatasetAlert(ctx interface{}, userId interface{}, datasetId interface{}, alertId interface{}) *MockDatasetService_UnsnoozeDatasetAlert_Call {
+	return &MockDatasetService_UnsnoozeDatasetAlert_Call{Call: _e.mock.On("UnsnoozeDatasetAlert", ctx, userId, datasetId, alertId)}
+}
+
+func (_c *MockDatasetService_UnsnoozeDatasetAlert_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID)) *MockDatasetService_UnsnoozeDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_UnsnoozeDatasetAlert_Call) Return(_a0 datasetsmodels.DatasetAlert, _a1 error) *MockDatasetService_UnsnoozeDatasetAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_UnsnoozeDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (datasetsmodels.DatasetAlert, error)) *MockDatasetService_UnsnoozeDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDataset provides a mock function with given fields: ctx, merchantId, datasetId, params
+func (_m *MockDatasetService) UpdateDataset(ctx context.Context, merchantId uuid.UUID, datasetId string, params datasetsmodels.UpdateDatasetParams) (string, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDataset")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.UpdateDatasetParams) (string, error)); ok {
+		return rf(ctx, merchantId, datasetId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.UpdateDatasetParams) string); ok {
+		r0 = rf(ctx, merchantId, datasetId, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, datasetsmodels.UpdateDatasetParams) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_UpdateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataset'
+type MockDatasetService_UpdateDataset_Call struct {
+	*mock.Call
+}
+
+// UpdateDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId string
+//   - params datasetsmodels.UpdateDatasetParams
+func (_e *MockDatasetService_Expecter) UpdateDataset(ctx interface{}, merchantId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_UpdateDataset_Call {
+	return &MockDatasetService_UpdateDataset_Call{Call: _e.mock.On("UpdateDataset", ctx, merchantId, datasetId, params)}
+}
+
+func (_c *MockDatasetService_UpdateDataset_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, params datasetsmodels.UpdateDatasetParams)) *MockDatasetService_UpdateDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(datasetsmodels.UpdateDatasetParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDataset_Call) Return(_a0 string, _a1 error) *MockDatasetService_UpdateDataset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDataset_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, datasetsmodels.UpdateDatasetParams) (string, error)) *MockDatasetService_UpdateDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetActionConfig provides a mock function with given fields: ctx, actionId, config
+func (_m *MockDatasetService) UpdateDatasetActionConfig(ctx context.Context, actionId string, config map[string]interface{}) error {
+	ret := _m.Called(ctx, actionId, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetActionConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) error); ok {
+		r0 = rf(ctx, actionId, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetService_UpdateDatasetActionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionConfig'
+type MockDatasetService_UpdateDatasetActionConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetActionConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actionId string
+//   - config map[string]interface{}
+func (_e *MockDatasetService_Expecter) UpdateDatasetActionConfig(ctx interface{}, actionId interface{}, config interface{}) *MockDatasetService_UpdateDatasetActionConfig_Call {
+	return &MockDatasetService_UpdateDatasetActionConfig_Call{Call: _e.mock.On("UpdateDatasetActionConfig", ctx, actionId, config)}
+}
+
+func (_c *MockDatasetService_UpdateDatasetActionConfig_Call) Run(run func(ctx context.Context, actionId string, config map[string]interface{})) *MockDatasetService_UpdateDatasetActionConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetActionConfig_Call) Return(_a0 error) *MockDatasetService_UpdateDatasetActionConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetActionConfig_Call) RunAndReturn(run func(context.Context, string, map[string]interface{}) error) *MockDatasetService_UpdateDatasetActionConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterDatasetJob provides a mock function with given fields: ctx, merchantId, jobInfo
-func (_m *MockDatasetService) RegisterDatasetJob(ctx context.Context, merchantId uuid.UUID, jobInfo actionsmodels.RegisterJobActionPayload) (string, error) {
-	ret := _m.Called(ctx, merchantId, jobInfo)
+// UpdateDatasetActionStatus provides a mock function with given fields: ctx, actionId, status
+func (_m *MockDatasetService) UpdateDatasetActionStatus(ctx context.Context, actionId string, status string) error {
+	ret := _m.Called(ctx, actionId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegisterDatasetJob")
+		panic("no return value specified for UpdateDatasetActionStatus")
 	}
 
-	var r0 string
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, actionId, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetService_UpdateDatasetActionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionStatus'
+type MockDatasetService_UpdateDatasetActionStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetActionStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actionId string
+//   - status string
+func (_e *MockDatasetService_Expecter) UpdateDatasetActionStatus(ctx interface{}, actionId interface{}, status interface{}) *MockDatasetService_UpdateDatasetActionStatus_Call {
+	return &MockDatasetService_UpdateDatasetActionStatus_Call{Call: _e.mock.On("UpdateDatasetActionStatus", ctx, actionId, status)}
+}
+
+func (_c *MockDatasetService_UpdateDatasetActionStatus_Call) Run(run func(ctx context.Context, actionId string, status string)) *MockDatasetService_UpdateDatasetActionStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetActionStatus_Call) Return(_a0 error) *MockDatasetService_UpdateDatasetActionStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetActionStatus_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDatasetService_UpdateDatasetActionStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetAlert provides a mock function with given fields: ctx, userId, datasetId, alertId, params
+func (_m *MockDatasetService) UpdateDatasetAlert(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID, params datasetsmodels.DatasetAlertParams) (datasetsmodels.DatasetAlert, error) {
+	ret := _m.Called(ctx, userId, datasetId, alertId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetAlert")
+	}
+
+	var r0 datasetsmodels.DatasetAlert
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, actionsmodels.RegisterJobActionPayload) (string, error)); ok {
-		return rf(ctx, merchantId, jobInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetAlertParams) (datasetsmodels.DatasetAlert, error)); ok {
+		return rf(ctx, userId, datasetId, alertId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, actionsmodels.RegisterJobActionPayload) string); ok {
-		r0 = rf(ctx, merchantId, jobInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetAlertParams) datasetsmodels.DatasetAlert); ok {
+		r0 = rf(ctx, userId, datasetId, alertId, params)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(datasetsmodels.DatasetAlert)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, actionsmodels.RegisterJobActionPayload) error); ok {
-		r1 = rf(ctx, merchantId, jobInfo)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetAlertParams) error); ok {
+		r1 = rf(ctx, userId, datasetId, alertId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1615,262 +4900,470 @@ func (_m *MockDatasetService) RegisterDatasetJob(ctx context.Context, merchantId
 	return r0, r1
 }
 
-// MockDatasetService_RegisterDatasetJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterDatasetJob'
-type MockDatasetService_RegisterDatasetJob_Call struct {
+// MockDatasetService_UpdateDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlert'
+type MockDatasetService_UpdateDatasetAlert_Call struct {
 	*mock.Call
 }
 
-// RegisterDatasetJob is a helper method to define mock.On call
+// UpdateDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - alertId uuid.UUID
+//   - params datasetsmodels.DatasetAlertParams
+func (_e *MockDatasetService_Expecter) UpdateDatasetAlert(ctx interface{}, userId interface{}, datasetId interface{}, alertId interface{}, params interface{}) *MockDatasetService_UpdateDatasetAlert_Call {
+	return &MockDatasetService_UpdateDatasetAlert_Call{Call: _e.mock.On("UpdateDatasetAlert", ctx, userId, datasetId, alertId, params)}
+}
+
+func (_c *MockDatasetService_UpdateDatasetAlert_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, alertId uuid.UUID, params datasetsmodels.DatasetAlertParams)) *MockDatasetService_UpdateDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetAlertParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetAlert_Call) Return(_a0 datasetsmodels.DatasetAlert, _a1 error) *MockDatasetService_UpdateDatasetAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetAlertParams) (datasetsmodels.DatasetAlert, error)) *MockDatasetService_UpdateDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetAudiencePrivilege provides a mock function with given fields: ctx, datasetId, audienceId, privilege
+func (_m *MockDatasetService) UpdateDatasetAudiencePrivilege(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetAudiencePrivilege")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, datasetId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_UpdateDatasetAudiencePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAudiencePrivilege'
+type MockDatasetService_UpdateDatasetAudiencePrivilege_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetAudiencePrivilege is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetService_Expecter) UpdateDatasetAudiencePrivilege(ctx interface{}, datasetId interface{}, audienceId interface{}, privilege interface{}) *MockDatasetService_UpdateDatasetAudiencePrivilege_Call {
+	return &MockDatasetService_UpdateDatasetAudiencePrivilege_Call{Call: _e.mock.On("UpdateDatasetAudiencePrivilege", ctx, datasetId, audienceId, privilege)}
+}
+
+func (_c *MockDatasetService_UpdateDatasetAudiencePrivilege_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetService_UpdateDatasetAudiencePrivilege_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetAudiencePrivilege_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetService_UpdateDatasetAudiencePrivilege_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetAudiencePrivilege_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetService_UpdateDatasetAudiencePrivilege_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetColumnPolicy provides a mock function with given fields: ctx, merchantId, userId, datasetId, policyId, params
+func (_m *MockDatasetService) UpdateDatasetColumnPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID, params datasetsmodels.DatasetColumnPolicyParams) (datasetsmodels.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, policyId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetColumnPolicy")
+	}
+
+	var r0 datasetsmodels.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetColumnPolicyParams) (datasetsmodels.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, policyId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetColumnPolicyParams) datasetsmodels.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, policyId, params)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetColumnPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetColumnPolicyParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, policyId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_UpdateDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetColumnPolicy'
+type MockDatasetService_UpdateDatasetColumnPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetColumnPolicy is a helper method to define mock.On call
 //   - ctx context.Context
 //   - merchantId uuid.UUID
-//   - jobInfo actionsmodels.RegisterJobActionPayload
-func (_e *MockDatasetService_Expecter) RegisterDatasetJob(ctx interface{}, merchantId interface{}, jobInfo interface{}) *MockDatasetService_RegisterDatasetJob_Call {
-	return &MockDatasetService_RegisterDatasetJob_Call{Call: _e.mock.On("RegisterDatasetJob", ctx, merchantId, jobInfo)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - policyId uuid.UUID
+//   - params datasetsmodels.DatasetColumnPolicyParams
+func (_e *MockDatasetService_Expecter) UpdateDatasetColumnPolicy(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, policyId interface{}, params interface{}) *MockDatasetService_UpdateDatasetColumnPolicy_Call {
+	return &MockDatasetService_UpdateDatasetColumnPolicy_Call{Call: _e.mock.On("UpdateDatasetColumnPolicy", ctx, merchantId, userId, datasetId, policyId, params)}
 }
 
-func (_c *MockDatasetService_RegisterDatasetJob_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, jobInfo actionsmodels.RegisterJobActionPayload)) *MockDatasetService_RegisterDatasetJob_Call {
+func (_c *MockDatasetService_UpdateDatasetColumnPolicy_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID, params datasetsmodels.DatasetColumnPolicyParams)) *MockDatasetService_UpdateDatasetColumnPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(actionsmodels.RegisterJobActionPayload))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(datasetsmodels.DatasetColumnPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_RegisterDatasetJob_Call) Return(_a0 string, _a1 error) *MockDatasetService_RegisterDatasetJob_Call {
+func (_c *MockDatasetService_UpdateDatasetColumnPolicy_Call) Return(_a0 datasetsmodels.DatasetColumnPolicy, _a1 error) *MockDatasetService_UpdateDatasetColumnPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_RegisterDatasetJob_Call) RunAndReturn(run func(context.Context, uuid.UUID, actionsmodels.RegisterJobActionPayload) (string, error)) *MockDatasetService_RegisterDatasetJob_Call {
+func (_c *MockDatasetService_UpdateDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetColumnPolicyParams) (datasetsmodels.DatasetColumnPolicy, error)) *MockDatasetService_UpdateDatasetColumnPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveAudienceFromDataset provides a mock function with given fields: ctx, datasetId, audienceId
-func (_m *MockDatasetService) RemoveAudienceFromDataset(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID) error {
-	ret := _m.Called(ctx, datasetId, audienceId)
+// UpdateDatasetData provides a mock function with given fields: ctx, merchantId, datasetId, params
+func (_m *MockDatasetService) UpdateDatasetData(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.UpdateDatasetDataParams) (datasetsmodels.DatasetAction, error) {
+	ret := _m.Called(ctx, merchantId, datasetId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveAudienceFromDataset")
+		panic("no return value specified for UpdateDatasetData")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, datasetId, audienceId)
+	var r0 datasetsmodels.DatasetAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.UpdateDatasetDataParams) (datasetsmodels.DatasetAction, error)); ok {
+		return rf(ctx, merchantId, datasetId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.UpdateDatasetDataParams) datasetsmodels.DatasetAction); ok {
+		r0 = rf(ctx, merchantId, datasetId, params)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(datasetsmodels.DatasetAction)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.UpdateDatasetDataParams) error); ok {
+		r1 = rf(ctx, merchantId, datasetId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_UpdateDatasetData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetData'
+type MockDatasetService_UpdateDatasetData_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - datasetId uuid.UUID
+//   - params datasetsmodels.UpdateDatasetDataParams
+func (_e *MockDatasetService_Expecter) UpdateDatasetData(ctx interface{}, merchantId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_UpdateDatasetData_Call {
+	return &MockDatasetService_UpdateDatasetData_Call{Call: _e.mock.On("UpdateDatasetData", ctx, merchantId, datasetId, params)}
+}
+
+func (_c *MockDatasetService_UpdateDatasetData_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.UpdateDatasetDataParams)) *MockDatasetService_UpdateDatasetData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(datasetsmodels.UpdateDatasetDataParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetData_Call) Return(_a0 datasetsmodels.DatasetAction, _a1 error) *MockDatasetService_UpdateDatasetData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_UpdateDatasetData_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.UpdateDatasetDataParams) (datasetsmodels.DatasetAction, error)) *MockDatasetService_UpdateDatasetData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetExportSchedule provides a mock function with given fields: ctx, userId, datasetId, scheduleId, params
+func (_m *MockDatasetService) UpdateDatasetExportSchedule(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, scheduleId uuid.UUID, params datasetsmodels.DatasetExportScheduleParams) (datasetsmodels.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, userId, datasetId, scheduleId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetExportSchedule")
+	}
+
+	var r0 datasetsmodels.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetExportScheduleParams) (datasetsmodels.DatasetExportSchedule, error)); ok {
+		return rf(ctx, userId, datasetId, scheduleId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetExportScheduleParams) datasetsmodels.DatasetExportSchedule); ok {
+		r0 = rf(ctx, userId, datasetId, scheduleId, params)
+	} else {
+		r0 = ret.Get(0).(datasetsmodels.DatasetExportSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetExportScheduleParams) error); ok {
+		r1 = rf(ctx, userId, datasetId, scheduleId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetService_RemoveAudienceFromDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAudienceFromDataset'
-type MockDatasetService_RemoveAudienceFromDataset_Call struct {
+// MockDatasetService_UpdateDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetExportSchedule'
+type MockDatasetService_UpdateDatasetExportSchedule_Call struct {
 	*mock.Call
 }
 
-// RemoveAudienceFromDataset is a helper method to define mock.On call
+// UpdateDatasetExportSchedule is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userId uuid.UUID
 //   - datasetId uuid.UUID
-//   - audienceId uuid.UUID
-func (_e *MockDatasetService_Expecter) RemoveAudienceFromDataset(ctx interface{}, datasetId interface{}, audienceId interface{}) *MockDatasetService_RemoveAudienceFromDataset_Call {
-	return &MockDatasetService_RemoveAudienceFromDataset_Call{Call: _e.mock.On("RemoveAudienceFromDataset", ctx, datasetId, audienceId)}
+//   - scheduleId uuid.UUID
+//   - params datasetsmodels.DatasetExportScheduleParams
+func (_e *MockDatasetService_Expecter) UpdateDatasetExportSchedule(ctx interface{}, userId interface{}, datasetId interface{}, scheduleId interface{}, params interface{}) *MockDatasetService_UpdateDatasetExportSchedule_Call {
+	return &MockDatasetService_UpdateDatasetExportSchedule_Call{Call: _e.mock.On("UpdateDatasetExportSchedule", ctx, userId, datasetId, scheduleId, params)}
 }
 
-func (_c *MockDatasetService_RemoveAudienceFromDataset_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID)) *MockDatasetService_RemoveAudienceFromDataset_Call {
+func (_c *MockDatasetService_UpdateDatasetExportSchedule_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, scheduleId uuid.UUID, params datasetsmodels.DatasetExportScheduleParams)) *MockDatasetService_UpdateDatasetExportSchedule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetExportScheduleParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_RemoveAudienceFromDataset_Call) Return(_a0 error) *MockDatasetService_RemoveAudienceFromDataset_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatasetService_UpdateDatasetExportSchedule_Call) Return(_a0 datasetsmodels.DatasetExportSchedule, _a1 error) *MockDatasetService_UpdateDatasetExportSchedule_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_RemoveAudienceFromDataset_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetService_RemoveAudienceFromDataset_Call {
+func (_c *MockDatasetService_UpdateDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetExportScheduleParams) (datasetsmodels.DatasetExportSchedule, error)) *MockDatasetService_UpdateDatasetExportSchedule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDataset provides a mock function with given fields: ctx, merchantId, datasetId, params
-func (_m *MockDatasetService) UpdateDataset(ctx context.Context, merchantId uuid.UUID, datasetId string, params datasetsmodels.UpdateDatasetParams) (string, error) {
-	ret := _m.Called(ctx, merchantId, datasetId, params)
+// UpdateDatasetFileUploadStatus provides a mock function with given fields: ctx, datasetFileUploadId, params
+func (_m *MockDatasetService) UpdateDatasetFileUploadStatus(ctx context.Context, datasetFileUploadId uuid.UUID, params datasetsmodels.UpdateDatasetFileUploadParams) error {
+	ret := _m.Called(ctx, datasetFileUploadId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDataset")
-	}
-
-	var r0 string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.UpdateDatasetParams) (string, error)); ok {
-		return rf(ctx, merchantId, datasetId, params)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, datasetsmodels.UpdateDatasetParams) string); ok {
-		r0 = rf(ctx, merchantId, datasetId, params)
-	} else {
-		r0 = ret.Get(0).(string)
+		panic("no return value specified for UpdateDatasetFileUploadStatus")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, datasetsmodels.UpdateDatasetParams) error); ok {
-		r1 = rf(ctx, merchantId, datasetId, params)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.UpdateDatasetFileUploadParams) error); ok {
+		r0 = rf(ctx, datasetFileUploadId, params)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDatasetService_UpdateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataset'
-type MockDatasetService_UpdateDataset_Call struct {
+// MockDatasetService_UpdateDatasetFileUploadStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetFileUploadStatus'
+type MockDatasetService_UpdateDatasetFileUploadStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateDataset is a helper method to define mock.On call
+// UpdateDatasetFileUploadStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - merchantId uuid.UUID
-//   - datasetId string
-//   - params datasetsmodels.UpdateDatasetParams
-func (_e *MockDatasetService_Expecter) UpdateDataset(ctx interface{}, merchantId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_UpdateDataset_Call {
-	return &MockDatasetService_UpdateDataset_Call{Call: _e.mock.On("UpdateDataset", ctx, merchantId, datasetId, params)}
+//   - datasetFileUploadId uuid.UUID
+//   - params datasetsmodels.UpdateDatasetFileUploadParams
+func (_e *MockDatasetService_Expecter) UpdateDatasetFileUploadStatus(ctx interface{}, datasetFileUploadId interface{}, params interface{}) *MockDatasetService_UpdateDatasetFileUploadStatus_Call {
+	return &MockDatasetService_UpdateDatasetFileUploadStatus_Call{Call: _e.mock.On("UpdateDatasetFileUploadStatus", ctx, datasetFileUploadId, params)}
 }
 
-func (_c *MockDatasetService_UpdateDataset_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId string, params datasetsmodels.UpdateDatasetParams)) *MockDatasetService_UpdateDataset_Call {
+func (_c *MockDatasetService_UpdateDatasetFileUploadStatus_Call) Run(run func(ctx context.Context, datasetFileUploadId uuid.UUID, params datasetsmodels.UpdateDatasetFileUploadParams)) *MockDatasetService_UpdateDatasetFileUploadStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(datasetsmodels.UpdateDatasetParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(datasetsmodels.UpdateDatasetFileUploadParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDataset_Call) Return(_a0 string, _a1 error) *MockDatasetService_UpdateDataset_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDatasetService_UpdateDatasetFileUploadStatus_Call) Return(_a0 error) *MockDatasetService_UpdateDatasetFileUploadStatus_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDataset_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, datasetsmodels.UpdateDatasetParams) (string, error)) *MockDatasetService_UpdateDataset_Call {
+func (_c *MockDatasetService_UpdateDatasetFileUploadStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, datasetsmodels.UpdateDatasetFileUploadParams) error) *MockDatasetService_UpdateDatasetFileUploadStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetActionConfig provides a mock function with given fields: ctx, actionId, config
-func (_m *MockDatasetService) UpdateDatasetActionConfig(ctx context.Context, actionId string, config map[string]interface{}) error {
-	ret := _m.Called(ctx, actionId, config)
+// UpdateDatasetRowPolicy provides a mock function with given fields: ctx, merchantId, userId, datasetId, policyId, params
+func (_m *MockDatasetService) UpdateDatasetRowPolicy(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID, params datasetsmodels.DatasetRowPolicyParams) (datasetsmodels.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, policyId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetActionConfig")
+		panic("no return value specified for UpdateDatasetRowPolicy")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) error); ok {
-		r0 = rf(ctx, actionId, config)
+	var r0 datasetsmodels.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRowPolicyParams) (datasetsmodels.DatasetRowPolicy, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, policyId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRowPolicyParams) datasetsmodels.DatasetRowPolicy); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, policyId, params)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(datasetsmodels.DatasetRowPolicy)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRowPolicyParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, policyId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetService_UpdateDatasetActionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionConfig'
-type MockDatasetService_UpdateDatasetActionConfig_Call struct {
+// MockDatasetService_UpdateDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetRowPolicy'
+type MockDatasetService_UpdateDatasetRowPolicy_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetActionConfig is a helper method to define mock.On call
+// UpdateDatasetRowPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - actionId string
-//   - config map[string]interface{}
-func (_e *MockDatasetService_Expecter) UpdateDatasetActionConfig(ctx interface{}, actionId interface{}, config interface{}) *MockDatasetService_UpdateDatasetActionConfig_Call {
-	return &MockDatasetService_UpdateDatasetActionConfig_Call{Call: _e.mock.On("UpdateDatasetActionConfig", ctx, actionId, config)}
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - policyId uuid.UUID
+//   - params datasetsmodels.DatasetRowPolicyParams
+func (_e *MockDatasetService_Expecter) UpdateDatasetRowPolicy(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, policyId interface{}, params interface{}) *MockDatasetService_UpdateDatasetRowPolicy_Call {
+	return &MockDatasetService_UpdateDatasetRowPolicy_Call{Call: _e.mock.On("UpdateDatasetRowPolicy", ctx, merchantId, userId, datasetId, policyId, params)}
 }
 
-func (_c *MockDatasetService_UpdateDatasetActionConfig_Call) Run(run func(ctx context.Context, actionId string, config map[string]interface{})) *MockDatasetService_UpdateDatasetActionConfig_Call {
+func (_c *MockDatasetService_UpdateDatasetRowPolicy_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, policyId uuid.UUID, params datasetsmodels.DatasetRowPolicyParams)) *MockDatasetService_UpdateDatasetRowPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(map[string]interface{}))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(datasetsmodels.DatasetRowPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetActionConfig_Call) Return(_a0 error) *MockDatasetService_UpdateDatasetActionConfig_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatasetService_UpdateDatasetRowPolicy_Call) Return(_a0 datasetsmodels.DatasetRowPolicy, _a1 error) *MockDatasetService_UpdateDatasetRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetActionConfig_Call) RunAndReturn(run func(context.Context, string, map[string]interface{}) error) *MockDatasetService_UpdateDatasetActionConfig_Call {
+func (_c *MockDatasetService_UpdateDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRowPolicyParams) (datasetsmodels.DatasetRowPolicy, error)) *MockDatasetService_UpdateDatasetRowPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetActionStatus provides a mock function with given fields: ctx, actionId, status
-func (_m *MockDatasetService) UpdateDatasetActionStatus(ctx context.Context, actionId string, status string) error {
-	ret := _m.Called(ctx, actionId, status)
+// UpdateDatasetRule provides a mock function with given fields: ctx, merchantId, userId, datasetId, ruleId, params
+func (_m *MockDatasetService) UpdateDatasetRule(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, params datasetsmodels.DatasetRuleParams) (datasetsmodels.DatasetRuleChange, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, ruleId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetActionStatus")
+		panic("no return value specified for UpdateDatasetRule")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, actionId, status)
+	var r0 datasetsmodels.DatasetRuleChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRuleParams) (datasetsmodels.DatasetRuleChange, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, ruleId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRuleParams) datasetsmodels.DatasetRuleChange); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, ruleId, params)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(datasetsmodels.DatasetRuleChange)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRuleParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, ruleId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetService_UpdateDatasetActionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionStatus'
-type MockDatasetService_UpdateDatasetActionStatus_Call struct {
+// MockDatasetService_UpdateDatasetRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetRule'
+type MockDatasetService_UpdateDatasetRule_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetActionStatus is a helper method to define mock.On call
+// UpdateDatasetRule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - actionId string
-//   - status string
-func (_e *MockDatasetService_Expecter) UpdateDatasetActionStatus(ctx interface{}, actionId interface{}, status interface{}) *MockDatasetService_UpdateDatasetActionStatus_Call {
-	return &MockDatasetService_UpdateDatasetActionStatus_Call{Call: _e.mock.On("UpdateDatasetActionStatus", ctx, actionId, status)}
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - ruleId uuid.UUID
+//   - params datasetsmodels.DatasetRuleParams
+func (_e *MockDatasetService_Expecter) UpdateDatasetRule(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, ruleId interface{}, params interface{}) *MockDatasetService_UpdateDatasetRule_Call {
+	return &MockDatasetService_UpdateDatasetRule_Call{Call: _e.mock.On("UpdateDatasetRule", ctx, merchantId, userId, datasetId, ruleId, params)}
 }
 
-func (_c *MockDatasetService_UpdateDatasetActionStatus_Call) Run(run func(ctx context.Context, actionId string, status string)) *MockDatasetService_UpdateDatasetActionStatus_Call {
+func (_c *MockDatasetService_UpdateDatasetRule_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, ruleId uuid.UUID, params datasetsmodels.DatasetRuleParams)) *MockDatasetService_UpdateDatasetRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(datasetsmodels.DatasetRuleParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetActionStatus_Call) Return(_a0 error) *MockDatasetService_UpdateDatasetActionStatus_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatasetService_UpdateDatasetRule_Call) Return(_a0 datasetsmodels.DatasetRuleChange, _a1 error) *MockDatasetService_UpdateDatasetRule_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetActionStatus_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDatasetService_UpdateDatasetActionStatus_Call {
+func (_c *MockDatasetService_UpdateDatasetRule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetRuleParams) (datasetsmodels.DatasetRuleChange, error)) *MockDatasetService_UpdateDatasetRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetAudiencePrivilege provides a mock function with given fields: ctx, datasetId, audienceId, privilege
-func (_m *MockDatasetService) UpdateDatasetAudiencePrivilege(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId, audienceId, privilege)
+// UpdateDatasetStatusWorkflow provides a mock function with given fields: ctx, merchantId, userId, datasetId, workflowId, params
+func (_m *MockDatasetService) UpdateDatasetStatusWorkflow(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, workflowId uuid.UUID, params datasetsmodels.DatasetStatusWorkflowParams) (datasetsmodels.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, merchantId, userId, datasetId, workflowId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetAudiencePrivilege")
+		panic("no return value specified for UpdateDatasetStatusWorkflow")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
+	var r0 datasetsmodels.DatasetStatusWorkflow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetStatusWorkflowParams) (datasetsmodels.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, merchantId, userId, datasetId, workflowId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetStatusWorkflowParams) datasetsmodels.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, merchantId, userId, datasetId, workflowId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Get(0).(datasetsmodels.DatasetStatusWorkflow)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, datasetId, audienceId, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetStatusWorkflowParams) error); ok {
+		r1 = rf(ctx, merchantId, userId, datasetId, workflowId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1878,58 +5371,60 @@ func (_m *MockDatasetService) UpdateDatasetAudiencePrivilege(ctx context.Context
 	return r0, r1
 }
 
-// MockDatasetService_UpdateDatasetAudiencePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAudiencePrivilege'
-type MockDatasetService_UpdateDatasetAudiencePrivilege_Call struct {
+// MockDatasetService_UpdateDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetStatusWorkflow'
+type MockDatasetService_UpdateDatasetStatusWorkflow_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetAudiencePrivilege is a helper method to define mock.On call
+// UpdateDatasetStatusWorkflow is a helper method to define mock.On call
 //   - ctx context.Context
+//   - merchantId uuid.UUID
+//   - userId uuid.UUID
 //   - datasetId uuid.UUID
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockDatasetService_Expecter) UpdateDatasetAudiencePrivilege(ctx interface{}, datasetId interface{}, audienceId interface{}, privilege interface{}) *MockDatasetService_UpdateDatasetAudiencePrivilege_Call {
-	return &MockDatasetService_UpdateDatasetAudiencePrivilege_Call{Call: _e.mock.On("UpdateDatasetAudiencePrivilege", ctx, datasetId, audienceId, privilege)}
+//   - workflowId uuid.UUID
+//   - params datasetsmodels.DatasetStatusWorkflowParams
+func (_e *MockDatasetService_Expecter) UpdateDatasetStatusWorkflow(ctx interface{}, merchantId interface{}, userId interface{}, datasetId interface{}, workflowId interface{}, params interface{}) *MockDatasetService_UpdateDatasetStatusWorkflow_Call {
+	return &MockDatasetService_UpdateDatasetStatusWorkflow_Call{Call: _e.mock.On("UpdateDatasetStatusWorkflow", ctx, merchantId, userId, datasetId, workflowId, params)}
 }
 
-func (_c *MockDatasetService_UpdateDatasetAudiencePrivilege_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetService_UpdateDatasetAudiencePrivilege_Call {
+func (_c *MockDatasetService_UpdateDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, userId uuid.UUID, datasetId uuid.UUID, workflowId uuid.UUID, params datasetsmodels.DatasetStatusWorkflowParams)) *MockDatasetService_UpdateDatasetStatusWorkflow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(datasetsmodels.DatasetStatusWorkflowParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetAudiencePrivilege_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetService_UpdateDatasetAudiencePrivilege_Call {
+func (_c *MockDatasetService_UpdateDatasetStatusWorkflow_Call) Return(_a0 datasetsmodels.DatasetStatusWorkflow, _a1 error) *MockDatasetService_UpdateDatasetStatusWorkflow_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetAudiencePrivilege_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetService_UpdateDatasetAudiencePrivilege_Call {
+func (_c *MockDatasetService_UpdateDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetStatusWorkflowParams) (datasetsmodels.DatasetStatusWorkflow, error)) *MockDatasetService_UpdateDatasetStatusWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetData provides a mock function with given fields: ctx, merchantId, datasetId, params
-func (_m *MockDatasetService) UpdateDatasetData(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.UpdateDatasetDataParams) (datasetsmodels.DatasetAction, error) {
-	ret := _m.Called(ctx, merchantId, datasetId, params)
+// UpdateDatasetView provides a mock function with given fields: ctx, userId, datasetId, viewId, params
+func (_m *MockDatasetService) UpdateDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, params datasetsmodels.DatasetViewParams) (datasetsmodels.DatasetView, error) {
+	ret := _m.Called(ctx, userId, datasetId, viewId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetData")
+		panic("no return value specified for UpdateDatasetView")
 	}
 
-	var r0 datasetsmodels.DatasetAction
+	var r0 datasetsmodels.DatasetView
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.UpdateDatasetDataParams) (datasetsmodels.DatasetAction, error)); ok {
-		return rf(ctx, merchantId, datasetId, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetViewParams) (datasetsmodels.DatasetView, error)); ok {
+		return rf(ctx, userId, datasetId, viewId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.UpdateDatasetDataParams) datasetsmodels.DatasetAction); ok {
-		r0 = rf(ctx, merchantId, datasetId, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetViewParams) datasetsmodels.DatasetView); ok {
+		r0 = rf(ctx, userId, datasetId, viewId, params)
 	} else {
-		r0 = ret.Get(0).(datasetsmodels.DatasetAction)
+		r0 = ret.Get(0).(datasetsmodels.DatasetView)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.UpdateDatasetDataParams) error); ok {
-		r1 = rf(ctx, merchantId, datasetId, params)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetViewParams) error); ok {
+		r1 = rf(ctx, userId, datasetId, viewId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1937,81 +5432,97 @@ func (_m *MockDatasetService) UpdateDatasetData(ctx context.Context, merchantId
 	return r0, r1
 }
 
-// MockDatasetService_UpdateDatasetData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetData'
-type MockDatasetService_UpdateDatasetData_Call struct {
+// MockDatasetService_UpdateDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetView'
+type MockDatasetService_UpdateDatasetView_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetData is a helper method to define mock.On call
+// UpdateDatasetView is a helper method to define mock.On call
 //   - ctx context.Context
-//   - merchantId uuid.UUID
+//   - userId uuid.UUID
 //   - datasetId uuid.UUID
-//   - params datasetsmodels.UpdateDatasetDataParams
-func (_e *MockDatasetService_Expecter) UpdateDatasetData(ctx interface{}, merchantId interface{}, datasetId interface{}, params interface{}) *MockDatasetService_UpdateDatasetData_Call {
-	return &MockDatasetService_UpdateDatasetData_Call{Call: _e.mock.On("UpdateDatasetData", ctx, merchantId, datasetId, params)}
+//   - viewId uuid.UUID
+//   - params datasetsmodels.DatasetViewParams
+func (_e *MockDatasetService_Expecter) UpdateDatasetView(ctx interface{}, userId interface{}, datasetId interface{}, viewId interface{}, params interface{}) *MockDatasetService_UpdateDatasetView_Call {
+	return &MockDatasetService_UpdateDatasetView_Call{Call: _e.mock.On("UpdateDatasetView", ctx, userId, datasetId, viewId, params)}
 }
 
-func (_c *MockDatasetService_UpdateDatasetData_Call) Run(run func(ctx context.Context, merchantId uuid.UUID, datasetId uuid.UUID, params datasetsmodels.UpdateDatasetDataParams)) *MockDatasetService_UpdateDatasetData_Call {
+func (_c *MockDatasetService_UpdateDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, params datasetsmodels.DatasetViewParams)) *MockDatasetService_UpdateDatasetView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(datasetsmodels.UpdateDatasetDataParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(datasetsmodels.DatasetViewParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetData_Call) Return(_a0 datasetsmodels.DatasetAction, _a1 error) *MockDatasetService_UpdateDatasetData_Call {
+func (_c *MockDatasetService_UpdateDatasetView_Call) Return(_a0 datasetsmodels.DatasetView, _a1 error) *MockDatasetService_UpdateDatasetView_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetData_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, datasetsmodels.UpdateDatasetDataParams) (datasetsmodels.DatasetAction, error)) *MockDatasetService_UpdateDatasetData_Call {
+func (_c *MockDatasetService_UpdateDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, datasetsmodels.DatasetViewParams) (datasetsmodels.DatasetView, error)) *MockDatasetService_UpdateDatasetView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetFileUploadStatus provides a mock function with given fields: ctx, datasetFileUploadId, params
-func (_m *MockDatasetService) UpdateDatasetFileUploadStatus(ctx context.Context, datasetFileUploadId uuid.UUID, params datasetsmodels.UpdateDatasetFileUploadParams) error {
-	ret := _m.Called(ctx, datasetFileUploadId, params)
+// UpdateDatasetViewAudiencePrivilege provides a mock function with given fields: ctx, userId, datasetId, viewId, audienceId, privilege
+func (_m *MockDatasetService) UpdateDatasetViewAudiencePrivilege(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, userId, datasetId, viewId, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetFileUploadStatus")
+		panic("no return value specified for UpdateDatasetViewAudiencePrivilege")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, datasetsmodels.UpdateDatasetFileUploadParams) error); ok {
-		r0 = rf(ctx, datasetFileUploadId, params)
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, userId, datasetId, viewId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, userId, datasetId, viewId, audienceId, privilege)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, userId, datasetId, viewId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetService_UpdateDatasetFileUploadStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetFileUploadStatus'
-type MockDatasetService_UpdateDatasetFileUploadStatus_Call struct {
+// MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetViewAudiencePrivilege'
+type MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetFileUploadStatus is a helper method to define mock.On call
+// UpdateDatasetViewAudiencePrivilege is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetFileUploadId uuid.UUID
-//   - params datasetsmodels.UpdateDatasetFileUploadParams
-func (_e *MockDatasetService_Expecter) UpdateDatasetFileUploadStatus(ctx interface{}, datasetFileUploadId interface{}, params interface{}) *MockDatasetService_UpdateDatasetFileUploadStatus_Call {
-	return &MockDatasetService_UpdateDatasetFileUploadStatus_Call{Call: _e.mock.On("UpdateDatasetFileUploadStatus", ctx, datasetFileUploadId, params)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetService_Expecter) UpdateDatasetViewAudiencePrivilege(ctx interface{}, userId interface{}, datasetId interface{}, viewId interface{}, audienceId interface{}, privilege interface{}) *MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call {
+	return &MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call{Call: _e.mock.On("UpdateDatasetViewAudiencePrivilege", ctx, userId, datasetId, viewId, audienceId, privilege)}
 }
 
-func (_c *MockDatasetService_UpdateDatasetFileUploadStatus_Call) Run(run func(ctx context.Context, datasetFileUploadId uuid.UUID, params datasetsmodels.UpdateDatasetFileUploadParams)) *MockDatasetService_UpdateDatasetFileUploadStatus_Call {
+func (_c *MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(datasetsmodels.UpdateDatasetFileUploadParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetFileUploadStatus_Call) Return(_a0 error) *MockDatasetService_UpdateDatasetFileUploadStatus_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetService_UpdateDatasetFileUploadStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, datasetsmodels.UpdateDatasetFileUploadParams) error) *MockDatasetService_UpdateDatasetFileUploadStatus_Call {
+func (_c *MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetService_UpdateDatasetViewAudiencePrivilege_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2133,6 +5644,66 @@ func (_c *MockDatasetService_UpsertTemplate_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ValidateFileImportPreview provides a mock function with given fields: ctx, datasetId, preview
+func (_m *MockDatasetService) ValidateFileImportPreview(ctx context.Context, datasetId uuid.UUID, preview []map[string]interface{}) ([]string, error) {
+	ret := _m.Called(ctx, datasetId, preview)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateFileImportPreview")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []map[string]interface{}) ([]string, error)); ok {
+		return rf(ctx, datasetId, preview)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []map[string]interface{}) []string); ok {
+		r0 = rf(ctx, datasetId, preview)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []map[string]interface{}) error); ok {
+		r1 = rf(ctx, datasetId, preview)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetService_ValidateFileImportPreview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateFileImportPreview'
+type MockDatasetService_ValidateFileImportPreview_Call struct {
+	*mock.Call
+}
+
+// ValidateFileImportPreview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - preview []map[string]interface{}
+func (_e *MockDatasetService_Expecter) ValidateFileImportPreview(ctx interface{}, datasetId interface{}, preview interface{}) *MockDatasetService_ValidateFileImportPreview_Call {
+	return &MockDatasetService_ValidateFileImportPreview_Call{Call: _e.mock.On("ValidateFileImportPreview", ctx, datasetId, preview)}
+}
+
+func (_c *MockDatasetService_ValidateFileImportPreview_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, preview []map[string]interface{})) *MockDatasetService_ValidateFileImportPreview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockDatasetService_ValidateFileImportPreview_Call) Return(_a0 []string, _a1 error) *MockDatasetService_ValidateFileImportPreview_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetService_ValidateFileImportPreview_Call) RunAndReturn(run func(context.Context, uuid.UUID, []map[string]interface{}) ([]string, error)) *MockDatasetService_ValidateFileImportPreview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockDatasetService creates a new instance of MockDatasetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockDatasetService(t interface {
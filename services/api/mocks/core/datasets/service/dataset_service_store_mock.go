@@ -4,12 +4,16 @@ package mock_service
 
 import (
 	context "context"
+	json "encoding/json"
 
-	models "github.com/Zampfi/application-platform/services/api/db/models"
 	mock "github.com/stretchr/testify/mock"
 
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+
 	store "github.com/Zampfi/application-platform/services/api/db/store"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -133,29 +137,27 @@ func (_c *MockDatasetServiceStore_CreateDatasetAction_Call) RunAndReturn(run fun
 	return _c
 }
 
-// CreateDatasetFileUpload provides a mock function with given fields: ctx, datasetFileUpload
-func (_m *MockDatasetServiceStore) CreateDatasetFileUpload(ctx context.Context, datasetFileUpload *models.DatasetFileUpload) (*models.DatasetFileUpload, error) {
-	ret := _m.Called(ctx, datasetFileUpload)
+// CreateDatasetAlert provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateDatasetAlert(ctx context.Context, params models.CreateDatasetAlertParams) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDatasetFileUpload")
+		panic("no return value specified for CreateDatasetAlert")
 	}
 
-	var r0 *models.DatasetFileUpload
+	var r0 models.DatasetAlert
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.DatasetFileUpload) (*models.DatasetFileUpload, error)); ok {
-		return rf(ctx, datasetFileUpload)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertParams) (models.DatasetAlert, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.DatasetFileUpload) *models.DatasetFileUpload); ok {
-		r0 = rf(ctx, datasetFileUpload)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertParams) models.DatasetAlert); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.DatasetFileUpload)
-		}
+		r0 = ret.Get(0).(models.DatasetAlert)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.DatasetFileUpload) error); ok {
-		r1 = rf(ctx, datasetFileUpload)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetAlertParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -163,58 +165,56 @@ func (_m *MockDatasetServiceStore) CreateDatasetFileUpload(ctx context.Context,
 	return r0, r1
 }
 
-// MockDatasetServiceStore_CreateDatasetFileUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetFileUpload'
-type MockDatasetServiceStore_CreateDatasetFileUpload_Call struct {
+// MockDatasetServiceStore_CreateDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetAlert'
+type MockDatasetServiceStore_CreateDatasetAlert_Call struct {
 	*mock.Call
 }
 
-// CreateDatasetFileUpload is a helper method to define mock.On call
+// CreateDatasetAlert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetFileUpload *models.DatasetFileUpload
-func (_e *MockDatasetServiceStore_Expecter) CreateDatasetFileUpload(ctx interface{}, datasetFileUpload interface{}) *MockDatasetServiceStore_CreateDatasetFileUpload_Call {
-	return &MockDatasetServiceStore_CreateDatasetFileUpload_Call{Call: _e.mock.On("CreateDatasetFileUpload", ctx, datasetFileUpload)}
+//   - params models.CreateDatasetAlertParams
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetAlert(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateDatasetAlert_Call {
+	return &MockDatasetServiceStore_CreateDatasetAlert_Call{Call: _e.mock.On("CreateDatasetAlert", ctx, params)}
 }
 
-func (_c *MockDatasetServiceStore_CreateDatasetFileUpload_Call) Run(run func(ctx context.Context, datasetFileUpload *models.DatasetFileUpload)) *MockDatasetServiceStore_CreateDatasetFileUpload_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetAlert_Call) Run(run func(ctx context.Context, params models.CreateDatasetAlertParams)) *MockDatasetServiceStore_CreateDatasetAlert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.DatasetFileUpload))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetAlertParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_CreateDatasetFileUpload_Call) Return(_a0 *models.DatasetFileUpload, _a1 error) *MockDatasetServiceStore_CreateDatasetFileUpload_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetAlert_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockDatasetServiceStore_CreateDatasetAlert_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_CreateDatasetFileUpload_Call) RunAndReturn(run func(context.Context, *models.DatasetFileUpload) (*models.DatasetFileUpload, error)) *MockDatasetServiceStore_CreateDatasetFileUpload_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetAlert_Call) RunAndReturn(run func(context.Context, models.CreateDatasetAlertParams) (models.DatasetAlert, error)) *MockDatasetServiceStore_CreateDatasetAlert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId, privilege
-func (_m *MockDatasetServiceStore) CreateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId, audienceType, audienceId, privilege)
+// CreateDatasetAlertEvent provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateDatasetAlertEvent(ctx context.Context, params models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDatasetPolicy")
+		panic("no return value specified for CreateDatasetAlertEvent")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
+	var r0 models.DatasetAlertEvent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId, audienceType, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId, audienceType, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertEventParams) models.DatasetAlertEvent); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Get(0).(models.DatasetAlertEvent)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, datasetId, audienceType, audienceId, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetAlertEventParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -222,157 +222,170 @@ func (_m *MockDatasetServiceStore) CreateDatasetPolicy(ctx context.Context, data
 	return r0, r1
 }
 
-// MockDatasetServiceStore_CreateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetPolicy'
-type MockDatasetServiceStore_CreateDatasetPolicy_Call struct {
+// MockDatasetServiceStore_CreateDatasetAlertEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetAlertEvent'
+type MockDatasetServiceStore_CreateDatasetAlertEvent_Call struct {
 	*mock.Call
 }
 
-// CreateDatasetPolicy is a helper method to define mock.On call
+// CreateDatasetAlertEvent is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-//   - audienceType models.AudienceType
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockDatasetServiceStore_Expecter) CreateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockDatasetServiceStore_CreateDatasetPolicy_Call {
-	return &MockDatasetServiceStore_CreateDatasetPolicy_Call{Call: _e.mock.On("CreateDatasetPolicy", ctx, datasetId, audienceType, audienceId, privilege)}
+//   - params models.CreateDatasetAlertEventParams
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetAlertEvent(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateDatasetAlertEvent_Call {
+	return &MockDatasetServiceStore_CreateDatasetAlertEvent_Call{Call: _e.mock.On("CreateDatasetAlertEvent", ctx, params)}
 }
 
-func (_c *MockDatasetServiceStore_CreateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetServiceStore_CreateDatasetPolicy_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetAlertEvent_Call) Run(run func(ctx context.Context, params models.CreateDatasetAlertEventParams)) *MockDatasetServiceStore_CreateDatasetAlertEvent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetAlertEventParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_CreateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_CreateDatasetPolicy_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetAlertEvent_Call) Return(_a0 models.DatasetAlertEvent, _a1 error) *MockDatasetServiceStore_CreateDatasetAlertEvent_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_CreateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_CreateDatasetPolicy_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetAlertEvent_Call) RunAndReturn(run func(context.Context, models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error)) *MockDatasetServiceStore_CreateDatasetAlertEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteDataset provides a mock function with given fields: ctx, dataset
-func (_m *MockDatasetServiceStore) DeleteDataset(ctx context.Context, dataset models.Dataset) error {
-	ret := _m.Called(ctx, dataset)
+// CreateDatasetColumnPolicy provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateDatasetColumnPolicy(ctx context.Context, params models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteDataset")
+		panic("no return value specified for CreateDatasetColumnPolicy")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) error); ok {
-		r0 = rf(ctx, dataset)
+	var r0 models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetColumnPolicyParams) models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, params)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.DatasetColumnPolicy)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetColumnPolicyParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetServiceStore_DeleteDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDataset'
-type MockDatasetServiceStore_DeleteDataset_Call struct {
+// MockDatasetServiceStore_CreateDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetColumnPolicy'
+type MockDatasetServiceStore_CreateDatasetColumnPolicy_Call struct {
 	*mock.Call
 }
 
-// DeleteDataset is a helper method to define mock.On call
+// CreateDatasetColumnPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dataset models.Dataset
-func (_e *MockDatasetServiceStore_Expecter) DeleteDataset(ctx interface{}, dataset interface{}) *MockDatasetServiceStore_DeleteDataset_Call {
-	return &MockDatasetServiceStore_DeleteDataset_Call{Call: _e.mock.On("DeleteDataset", ctx, dataset)}
+//   - params models.CreateDatasetColumnPolicyParams
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetColumnPolicy(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateDatasetColumnPolicy_Call {
+	return &MockDatasetServiceStore_CreateDatasetColumnPolicy_Call{Call: _e.mock.On("CreateDatasetColumnPolicy", ctx, params)}
 }
 
-func (_c *MockDatasetServiceStore_DeleteDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockDatasetServiceStore_DeleteDataset_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetColumnPolicy_Call) Run(run func(ctx context.Context, params models.CreateDatasetColumnPolicyParams)) *MockDatasetServiceStore_CreateDatasetColumnPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.Dataset))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetColumnPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_DeleteDataset_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDataset_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatasetServiceStore_CreateDatasetColumnPolicy_Call) Return(_a0 models.DatasetColumnPolicy, _a1 error) *MockDatasetServiceStore_CreateDatasetColumnPolicy_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_DeleteDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) error) *MockDatasetServiceStore_DeleteDataset_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)) *MockDatasetServiceStore_CreateDatasetColumnPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId
-func (_m *MockDatasetServiceStore) DeleteDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
-	ret := _m.Called(ctx, datasetId, audienceType, audienceId)
+// CreateDatasetExportSchedule provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateDatasetExportSchedule(ctx context.Context, params models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteDatasetPolicy")
+		panic("no return value specified for CreateDatasetExportSchedule")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
-		r0 = rf(ctx, datasetId, audienceType, audienceId)
+	var r0 models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleParams) models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, params)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.DatasetExportSchedule)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetExportScheduleParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetServiceStore_DeleteDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetPolicy'
-type MockDatasetServiceStore_DeleteDatasetPolicy_Call struct {
+// MockDatasetServiceStore_CreateDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetExportSchedule'
+type MockDatasetServiceStore_CreateDatasetExportSchedule_Call struct {
 	*mock.Call
 }
 
-// DeleteDatasetPolicy is a helper method to define mock.On call
+// CreateDatasetExportSchedule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-//   - audienceType models.AudienceType
-//   - audienceId uuid.UUID
-func (_e *MockDatasetServiceStore_Expecter) DeleteDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}) *MockDatasetServiceStore_DeleteDatasetPolicy_Call {
-	return &MockDatasetServiceStore_DeleteDatasetPolicy_Call{Call: _e.mock.On("DeleteDatasetPolicy", ctx, datasetId, audienceType, audienceId)}
+//   - params models.CreateDatasetExportScheduleParams
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetExportSchedule(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateDatasetExportSchedule_Call {
+	return &MockDatasetServiceStore_CreateDatasetExportSchedule_Call{Call: _e.mock.On("CreateDatasetExportSchedule", ctx, params)}
 }
 
-func (_c *MockDatasetServiceStore_DeleteDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockDatasetServiceStore_DeleteDatasetPolicy_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetExportSchedule_Call) Run(run func(ctx context.Context, params models.CreateDatasetExportScheduleParams)) *MockDatasetServiceStore_CreateDatasetExportSchedule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetExportScheduleParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_DeleteDatasetPolicy_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDatasetPolicy_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatasetServiceStore_CreateDatasetExportSchedule_Call) Return(_a0 models.DatasetExportSchedule, _a1 error) *MockDatasetServiceStore_CreateDatasetExportSchedule_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_DeleteDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockDatasetServiceStore_DeleteDatasetPolicy_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)) *MockDatasetServiceStore_CreateDatasetExportSchedule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetActionFromActionId provides a mock function with given fields: ctx, actionId
-func (_m *MockDatasetServiceStore) GetDatasetActionFromActionId(ctx context.Context, actionId string) (*models.DatasetAction, error) {
-	ret := _m.Called(ctx, actionId)
+// CreateDatasetExportScheduleRun provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateDatasetExportScheduleRun(ctx context.Context, params models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetActionFromActionId")
+		panic("no return value specified for CreateDatasetExportScheduleRun")
 	}
 
-	var r0 *models.DatasetAction
+	var r0 models.DatasetExportScheduleRun
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.DatasetAction, error)); ok {
-		return rf(ctx, actionId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.DatasetAction); ok {
-		r0 = rf(ctx, actionId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleRunParams) models.DatasetExportScheduleRun); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.DatasetAction)
-		}
+		r0 = ret.Get(0).(models.DatasetExportScheduleRun)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, actionId)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetExportScheduleRunParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -380,58 +393,58 @@ func (_m *MockDatasetServiceStore) GetDatasetActionFromActionId(ctx context.Cont
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetDatasetActionFromActionId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActionFromActionId'
-type MockDatasetServiceStore_GetDatasetActionFromActionId_Call struct {
+// MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetExportScheduleRun'
+type MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call struct {
 	*mock.Call
 }
 
-// GetDatasetActionFromActionId is a helper method to define mock.On call
+// CreateDatasetExportScheduleRun is a helper method to define mock.On call
 //   - ctx context.Context
-//   - actionId string
-func (_e *MockDatasetServiceStore_Expecter) GetDatasetActionFromActionId(ctx interface{}, actionId interface{}) *MockDatasetServiceStore_GetDatasetActionFromActionId_Call {
-	return &MockDatasetServiceStore_GetDatasetActionFromActionId_Call{Call: _e.mock.On("GetDatasetActionFromActionId", ctx, actionId)}
+//   - params models.CreateDatasetExportScheduleRunParams
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetExportScheduleRun(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call {
+	return &MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call{Call: _e.mock.On("CreateDatasetExportScheduleRun", ctx, params)}
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetActionFromActionId_Call) Run(run func(ctx context.Context, actionId string)) *MockDatasetServiceStore_GetDatasetActionFromActionId_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call) Run(run func(ctx context.Context, params models.CreateDatasetExportScheduleRunParams)) *MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetExportScheduleRunParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetActionFromActionId_Call) Return(_a0 *models.DatasetAction, _a1 error) *MockDatasetServiceStore_GetDatasetActionFromActionId_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call) Return(_a0 models.DatasetExportScheduleRun, _a1 error) *MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetActionFromActionId_Call) RunAndReturn(run func(context.Context, string) (*models.DatasetAction, error)) *MockDatasetServiceStore_GetDatasetActionFromActionId_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call) RunAndReturn(run func(context.Context, models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error)) *MockDatasetServiceStore_CreateDatasetExportScheduleRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetActions provides a mock function with given fields: ctx, organizationId, filters
-func (_m *MockDatasetServiceStore) GetDatasetActions(ctx context.Context, organizationId uuid.UUID, filters models.DatasetActionFilters) ([]models.DatasetAction, error) {
-	ret := _m.Called(ctx, organizationId, filters)
+// CreateDatasetFileUpload provides a mock function with given fields: ctx, datasetFileUpload
+func (_m *MockDatasetServiceStore) CreateDatasetFileUpload(ctx context.Context, datasetFileUpload *models.DatasetFileUpload) (*models.DatasetFileUpload, error) {
+	ret := _m.Called(ctx, datasetFileUpload)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetActions")
+		panic("no return value specified for CreateDatasetFileUpload")
 	}
 
-	var r0 []models.DatasetAction
+	var r0 *models.DatasetFileUpload
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]models.DatasetAction, error)); ok {
-		return rf(ctx, organizationId, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.DatasetFileUpload) (*models.DatasetFileUpload, error)); ok {
+		return rf(ctx, datasetFileUpload)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) []models.DatasetAction); ok {
-		r0 = rf(ctx, organizationId, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.DatasetFileUpload) *models.DatasetFileUpload); ok {
+		r0 = rf(ctx, datasetFileUpload)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DatasetAction)
+			r0 = ret.Get(0).(*models.DatasetFileUpload)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetActionFilters) error); ok {
-		r1 = rf(ctx, organizationId, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.DatasetFileUpload) error); ok {
+		r1 = rf(ctx, datasetFileUpload)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -439,59 +452,58 @@ func (_m *MockDatasetServiceStore) GetDatasetActions(ctx context.Context, organi
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetDatasetActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActions'
-type MockDatasetServiceStore_GetDatasetActions_Call struct {
+// MockDatasetServiceStore_CreateDatasetFileUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetFileUpload'
+type MockDatasetServiceStore_CreateDatasetFileUpload_Call struct {
 	*mock.Call
 }
 
-// GetDatasetActions is a helper method to define mock.On call
+// CreateDatasetFileUpload is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - filters models.DatasetActionFilters
-func (_e *MockDatasetServiceStore_Expecter) GetDatasetActions(ctx interface{}, organizationId interface{}, filters interface{}) *MockDatasetServiceStore_GetDatasetActions_Call {
-	return &MockDatasetServiceStore_GetDatasetActions_Call{Call: _e.mock.On("GetDatasetActions", ctx, organizationId, filters)}
+//   - datasetFileUpload *models.DatasetFileUpload
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetFileUpload(ctx interface{}, datasetFileUpload interface{}) *MockDatasetServiceStore_CreateDatasetFileUpload_Call {
+	return &MockDatasetServiceStore_CreateDatasetFileUpload_Call{Call: _e.mock.On("CreateDatasetFileUpload", ctx, datasetFileUpload)}
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetActions_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, filters models.DatasetActionFilters)) *MockDatasetServiceStore_GetDatasetActions_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetFileUpload_Call) Run(run func(ctx context.Context, datasetFileUpload *models.DatasetFileUpload)) *MockDatasetServiceStore_CreateDatasetFileUpload_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetActionFilters))
+		run(args[0].(context.Context), args[1].(*models.DatasetFileUpload))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetActions_Call) Return(_a0 []models.DatasetAction, _a1 error) *MockDatasetServiceStore_GetDatasetActions_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetFileUpload_Call) Return(_a0 *models.DatasetFileUpload, _a1 error) *MockDatasetServiceStore_CreateDatasetFileUpload_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetActions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]models.DatasetAction, error)) *MockDatasetServiceStore_GetDatasetActions_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetFileUpload_Call) RunAndReturn(run func(context.Context, *models.DatasetFileUpload) (*models.DatasetFileUpload, error)) *MockDatasetServiceStore_CreateDatasetFileUpload_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetById provides a mock function with given fields: ctx, datasetId
-func (_m *MockDatasetServiceStore) GetDatasetById(ctx context.Context, datasetId string) (*models.Dataset, error) {
-	ret := _m.Called(ctx, datasetId)
+// CreateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId, privilege
+func (_m *MockDatasetServiceStore) CreateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, audienceType, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetById")
+		panic("no return value specified for CreateDatasetPolicy")
 	}
 
-	var r0 *models.Dataset
+	var r0 *models.ResourceAudiencePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Dataset, error)); ok {
-		return rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, audienceType, audienceId, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Dataset); ok {
-		r0 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, audienceType, audienceId, privilege)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Dataset)
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, datasetId, audienceType, audienceId, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -499,56 +511,59 @@ func (_m *MockDatasetServiceStore) GetDatasetById(ctx context.Context, datasetId
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetDatasetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetById'
-type MockDatasetServiceStore_GetDatasetById_Call struct {
+// MockDatasetServiceStore_CreateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetPolicy'
+type MockDatasetServiceStore_CreateDatasetPolicy_Call struct {
 	*mock.Call
 }
 
-// GetDatasetById is a helper method to define mock.On call
+// CreateDatasetPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId string
-func (_e *MockDatasetServiceStore_Expecter) GetDatasetById(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetById_Call {
-	return &MockDatasetServiceStore_GetDatasetById_Call{Call: _e.mock.On("GetDatasetById", ctx, datasetId)}
+//   - datasetId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockDatasetServiceStore_CreateDatasetPolicy_Call {
+	return &MockDatasetServiceStore_CreateDatasetPolicy_Call{Call: _e.mock.On("CreateDatasetPolicy", ctx, datasetId, audienceType, audienceId, privilege)}
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetById_Call) Run(run func(ctx context.Context, datasetId string)) *MockDatasetServiceStore_GetDatasetById_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetServiceStore_CreateDatasetPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetById_Call) Return(_a0 *models.Dataset, _a1 error) *MockDatasetServiceStore_GetDatasetById_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_CreateDatasetPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetById_Call) RunAndReturn(run func(context.Context, string) (*models.Dataset, error)) *MockDatasetServiceStore_GetDatasetById_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_CreateDatasetPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetCount provides a mock function with given fields: ctx, filters
-func (_m *MockDatasetServiceStore) GetDatasetCount(ctx context.Context, filters models.DatasetFilters) (int64, error) {
-	ret := _m.Called(ctx, filters)
+// CreateDatasetRowPolicy provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateDatasetRowPolicy(ctx context.Context, params models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetCount")
+		panic("no return value specified for CreateDatasetRowPolicy")
 	}
 
-	var r0 int64
+	var r0 models.DatasetRowPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) (int64, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) int64); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetRowPolicyParams) models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, params)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(models.DatasetRowPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
-		r1 = rf(ctx, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetRowPolicyParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -556,58 +571,56 @@ func (_m *MockDatasetServiceStore) GetDatasetCount(ctx context.Context, filters
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetDatasetCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetCount'
-type MockDatasetServiceStore_GetDatasetCount_Call struct {
+// MockDatasetServiceStore_CreateDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetRowPolicy'
+type MockDatasetServiceStore_CreateDatasetRowPolicy_Call struct {
 	*mock.Call
 }
 
-// GetDatasetCount is a helper method to define mock.On call
+// CreateDatasetRowPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters models.DatasetFilters
-func (_e *MockDatasetServiceStore_Expecter) GetDatasetCount(ctx interface{}, filters interface{}) *MockDatasetServiceStore_GetDatasetCount_Call {
-	return &MockDatasetServiceStore_GetDatasetCount_Call{Call: _e.mock.On("GetDatasetCount", ctx, filters)}
+//   - params models.CreateDatasetRowPolicyParams
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetRowPolicy(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateDatasetRowPolicy_Call {
+	return &MockDatasetServiceStore_CreateDatasetRowPolicy_Call{Call: _e.mock.On("CreateDatasetRowPolicy", ctx, params)}
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetCount_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockDatasetServiceStore_GetDatasetCount_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetRowPolicy_Call) Run(run func(ctx context.Context, params models.CreateDatasetRowPolicyParams)) *MockDatasetServiceStore_CreateDatasetRowPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetRowPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetCount_Call) Return(_a0 int64, _a1 error) *MockDatasetServiceStore_GetDatasetCount_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetRowPolicy_Call) Return(_a0 models.DatasetRowPolicy, _a1 error) *MockDatasetServiceStore_CreateDatasetRowPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetCount_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) (int64, error)) *MockDatasetServiceStore_GetDatasetCount_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)) *MockDatasetServiceStore_CreateDatasetRowPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetFileUploadByDatasetId provides a mock function with given fields: ctx, datasetId
-func (_m *MockDatasetServiceStore) GetDatasetFileUploadByDatasetId(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetFileUpload, error) {
-	ret := _m.Called(ctx, datasetId)
+// CreateDatasetStatusWorkflow provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateDatasetStatusWorkflow(ctx context.Context, params models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetFileUploadByDatasetId")
+		panic("no return value specified for CreateDatasetStatusWorkflow")
 	}
 
-	var r0 []models.DatasetFileUpload
+	var r0 models.DatasetStatusWorkflow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetFileUpload, error)); ok {
-		return rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetFileUpload); ok {
-		r0 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetStatusWorkflowParams) models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DatasetFileUpload)
-		}
+		r0 = ret.Get(0).(models.DatasetStatusWorkflow)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetStatusWorkflowParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -615,56 +628,56 @@ func (_m *MockDatasetServiceStore) GetDatasetFileUploadByDatasetId(ctx context.C
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploadByDatasetId'
-type MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call struct {
+// MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetStatusWorkflow'
+type MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call struct {
 	*mock.Call
 }
 
-// GetDatasetFileUploadByDatasetId is a helper method to define mock.On call
+// CreateDatasetStatusWorkflow is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-func (_e *MockDatasetServiceStore_Expecter) GetDatasetFileUploadByDatasetId(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call {
-	return &MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call{Call: _e.mock.On("GetDatasetFileUploadByDatasetId", ctx, datasetId)}
+//   - params models.CreateDatasetStatusWorkflowParams
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetStatusWorkflow(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call {
+	return &MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call{Call: _e.mock.On("CreateDatasetStatusWorkflow", ctx, params)}
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, params models.CreateDatasetStatusWorkflowParams)) *MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetStatusWorkflowParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call) Return(_a0 []models.DatasetFileUpload, _a1 error) *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call) Return(_a0 models.DatasetStatusWorkflow, _a1 error) *MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetFileUpload, error)) *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)) *MockDatasetServiceStore_CreateDatasetStatusWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetFileUploadById provides a mock function with given fields: ctx, fileUploadId
-func (_m *MockDatasetServiceStore) GetDatasetFileUploadById(ctx context.Context, fileUploadId uuid.UUID) (models.DatasetFileUpload, error) {
-	ret := _m.Called(ctx, fileUploadId)
+// CreateDatasetView provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateDatasetView(ctx context.Context, params models.CreateDatasetViewParams) (models.DatasetView, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetFileUploadById")
+		panic("no return value specified for CreateDatasetView")
 	}
 
-	var r0 models.DatasetFileUpload
+	var r0 models.DatasetView
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetFileUpload, error)); ok {
-		return rf(ctx, fileUploadId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetViewParams) (models.DatasetView, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetFileUpload); ok {
-		r0 = rf(ctx, fileUploadId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetViewParams) models.DatasetView); ok {
+		r0 = rf(ctx, params)
 	} else {
-		r0 = ret.Get(0).(models.DatasetFileUpload)
+		r0 = ret.Get(0).(models.DatasetView)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, fileUploadId)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetViewParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -672,58 +685,58 @@ func (_m *MockDatasetServiceStore) GetDatasetFileUploadById(ctx context.Context,
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetDatasetFileUploadById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploadById'
-type MockDatasetServiceStore_GetDatasetFileUploadById_Call struct {
+// MockDatasetServiceStore_CreateDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetView'
+type MockDatasetServiceStore_CreateDatasetView_Call struct {
 	*mock.Call
 }
 
-// GetDatasetFileUploadById is a helper method to define mock.On call
+// CreateDatasetView is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fileUploadId uuid.UUID
-func (_e *MockDatasetServiceStore_Expecter) GetDatasetFileUploadById(ctx interface{}, fileUploadId interface{}) *MockDatasetServiceStore_GetDatasetFileUploadById_Call {
-	return &MockDatasetServiceStore_GetDatasetFileUploadById_Call{Call: _e.mock.On("GetDatasetFileUploadById", ctx, fileUploadId)}
+//   - params models.CreateDatasetViewParams
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetView(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateDatasetView_Call {
+	return &MockDatasetServiceStore_CreateDatasetView_Call{Call: _e.mock.On("CreateDatasetView", ctx, params)}
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetFileUploadById_Call) Run(run func(ctx context.Context, fileUploadId uuid.UUID)) *MockDatasetServiceStore_GetDatasetFileUploadById_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetView_Call) Run(run func(ctx context.Context, params models.CreateDatasetViewParams)) *MockDatasetServiceStore_CreateDatasetView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetViewParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetFileUploadById_Call) Return(_a0 models.DatasetFileUpload, _a1 error) *MockDatasetServiceStore_GetDatasetFileUploadById_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetView_Call) Return(_a0 models.DatasetView, _a1 error) *MockDatasetServiceStore_CreateDatasetView_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetFileUploadById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetFileUpload, error)) *MockDatasetServiceStore_GetDatasetFileUploadById_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetView_Call) RunAndReturn(run func(context.Context, models.CreateDatasetViewParams) (models.DatasetView, error)) *MockDatasetServiceStore_CreateDatasetView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetPolicies provides a mock function with given fields: ctx, datasetId
-func (_m *MockDatasetServiceStore) GetDatasetPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId)
+// CreateDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceType, audienceId, privilege
+func (_m *MockDatasetServiceStore) CreateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId, audienceType, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetPolicies")
+		panic("no return value specified for CreateDatasetViewPolicy")
 	}
 
-	var r0 []models.ResourceAudiencePolicy
+	var r0 *models.ResourceAudiencePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId, audienceType, audienceId, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId, audienceType, audienceId, privilege)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, viewId, audienceType, audienceId, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -731,58 +744,61 @@ func (_m *MockDatasetServiceStore) GetDatasetPolicies(ctx context.Context, datas
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetDatasetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPolicies'
-type MockDatasetServiceStore_GetDatasetPolicies_Call struct {
+// MockDatasetServiceStore_CreateDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetViewPolicy'
+type MockDatasetServiceStore_CreateDatasetViewPolicy_Call struct {
 	*mock.Call
 }
 
-// GetDatasetPolicies is a helper method to define mock.On call
+// CreateDatasetViewPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-func (_e *MockDatasetServiceStore_Expecter) GetDatasetPolicies(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetPolicies_Call {
-	return &MockDatasetServiceStore_GetDatasetPolicies_Call{Call: _e.mock.On("GetDatasetPolicies", ctx, datasetId)}
+//   - viewId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetServiceStore_Expecter) CreateDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockDatasetServiceStore_CreateDatasetViewPolicy_Call {
+	return &MockDatasetServiceStore_CreateDatasetViewPolicy_Call{Call: _e.mock.On("CreateDatasetViewPolicy", ctx, viewId, audienceType, audienceId, privilege)}
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetPolicies_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetServiceStore_CreateDatasetViewPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetDatasetPolicies_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetViewPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_CreateDatasetViewPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetDatasetPolicies_Call {
+func (_c *MockDatasetServiceStore_CreateDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_CreateDatasetViewPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetPoliciesByEmail provides a mock function with given fields: ctx, datasetId, email
-func (_m *MockDatasetServiceStore) GetDatasetPoliciesByEmail(ctx context.Context, datasetId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId, email)
+// CreateOrganization provides a mock function with given fields: ctx, name, description, ownerId
+func (_m *MockDatasetServiceStore) CreateOrganization(ctx context.Context, name string, description *string, ownerId uuid.UUID) (*models.Organization, error) {
+	ret := _m.Called(ctx, name, description, ownerId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetPoliciesByEmail")
+		panic("no return value specified for CreateOrganization")
 	}
 
-	var r0 []models.ResourceAudiencePolicy
+	var r0 *models.Organization
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId, email)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)); ok {
+		return rf(ctx, name, description, ownerId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId, email)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) *models.Organization); ok {
+		r0 = rf(ctx, name, description, ownerId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+			r0 = ret.Get(0).(*models.Organization)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, datasetId, email)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, uuid.UUID) error); ok {
+		r1 = rf(ctx, name, description, ownerId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -790,59 +806,60 @@ func (_m *MockDatasetServiceStore) GetDatasetPoliciesByEmail(ctx context.Context
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPoliciesByEmail'
-type MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call struct {
+// MockDatasetServiceStore_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type MockDatasetServiceStore_CreateOrganization_Call struct {
 	*mock.Call
 }
 
-// GetDatasetPoliciesByEmail is a helper method to define mock.On call
+// CreateOrganization is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-//   - email string
-func (_e *MockDatasetServiceStore_Expecter) GetDatasetPoliciesByEmail(ctx interface{}, datasetId interface{}, email interface{}) *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call {
-	return &MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call{Call: _e.mock.On("GetDatasetPoliciesByEmail", ctx, datasetId, email)}
+//   - name string
+//   - description *string
+//   - ownerId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) CreateOrganization(ctx interface{}, name interface{}, description interface{}, ownerId interface{}) *MockDatasetServiceStore_CreateOrganization_Call {
+	return &MockDatasetServiceStore_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, name, description, ownerId)}
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, email string)) *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call {
+func (_c *MockDatasetServiceStore_CreateOrganization_Call) Run(run func(ctx context.Context, name string, description *string, ownerId uuid.UUID)) *MockDatasetServiceStore_CreateOrganization_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(*string), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call {
+func (_c *MockDatasetServiceStore_CreateOrganization_Call) Return(_a0 *models.Organization, _a1 error) *MockDatasetServiceStore_CreateOrganization_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call {
+func (_c *MockDatasetServiceStore_CreateOrganization_Call) RunAndReturn(run func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)) *MockDatasetServiceStore_CreateOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetsAll provides a mock function with given fields: ctx, filters
-func (_m *MockDatasetServiceStore) GetDatasetsAll(ctx context.Context, filters models.DatasetFilters) ([]models.Dataset, error) {
-	ret := _m.Called(ctx, filters)
+// CreateOrganizationInvitation provides a mock function with given fields: ctx, organizationId, targetEmail, privilege
+func (_m *MockDatasetServiceStore) CreateOrganizationInvitation(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege) (*models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationId, targetEmail, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetsAll")
+		panic("no return value specified for CreateOrganizationInvitation")
 	}
 
-	var r0 []models.Dataset
+	var r0 *models.OrganizationInvitation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) ([]models.Dataset, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationId, targetEmail, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) []models.Dataset); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) *models.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationId, targetEmail, privilege)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Dataset)
+			r0 = ret.Get(0).(*models.OrganizationInvitation)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
-		r1 = rf(ctx, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, organizationId, targetEmail, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -850,58 +867,60 @@ func (_m *MockDatasetServiceStore) GetDatasetsAll(ctx context.Context, filters m
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetDatasetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetsAll'
-type MockDatasetServiceStore_GetDatasetsAll_Call struct {
+// MockDatasetServiceStore_CreateOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitation'
+type MockDatasetServiceStore_CreateOrganizationInvitation_Call struct {
 	*mock.Call
 }
 
-// GetDatasetsAll is a helper method to define mock.On call
+// CreateOrganizationInvitation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters models.DatasetFilters
-func (_e *MockDatasetServiceStore_Expecter) GetDatasetsAll(ctx interface{}, filters interface{}) *MockDatasetServiceStore_GetDatasetsAll_Call {
-	return &MockDatasetServiceStore_GetDatasetsAll_Call{Call: _e.mock.On("GetDatasetsAll", ctx, filters)}
+//   - organizationId uuid.UUID
+//   - targetEmail string
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetServiceStore_Expecter) CreateOrganizationInvitation(ctx interface{}, organizationId interface{}, targetEmail interface{}, privilege interface{}) *MockDatasetServiceStore_CreateOrganizationInvitation_Call {
+	return &MockDatasetServiceStore_CreateOrganizationInvitation_Call{Call: _e.mock.On("CreateOrganizationInvitation", ctx, organizationId, targetEmail, privilege)}
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetsAll_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockDatasetServiceStore_GetDatasetsAll_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationInvitation_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege)) *MockDatasetServiceStore_CreateOrganizationInvitation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetsAll_Call) Return(_a0 []models.Dataset, _a1 error) *MockDatasetServiceStore_GetDatasetsAll_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationInvitation_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockDatasetServiceStore_CreateOrganizationInvitation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetDatasetsAll_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) ([]models.Dataset, error)) *MockDatasetServiceStore_GetDatasetsAll_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)) *MockDatasetServiceStore_CreateOrganizationInvitation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlattenedResourceAudiencePolicies provides a mock function with given fields: ctx, filters
-func (_m *MockDatasetServiceStore) GetFlattenedResourceAudiencePolicies(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, filters)
+// CreateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
+func (_m *MockDatasetServiceStore) CreateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
+	ret := _m.Called(ctx, invitationId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFlattenedResourceAudiencePolicies")
+		panic("no return value specified for CreateOrganizationInvitationStatus")
 	}
 
-	var r0 []models.FlattenedResourceAudiencePolicy
+	var r0 *models.OrganizationInvitationStatus
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
+		return rf(ctx, invitationId, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) []models.FlattenedResourceAudiencePolicy); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
+		r0 = rf(ctx, invitationId, status)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.FlattenedResourceAudiencePolicy)
+			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) error); ok {
-		r1 = rf(ctx, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
+		r1 = rf(ctx, invitationId, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -909,58 +928,59 @@ func (_m *MockDatasetServiceStore) GetFlattenedResourceAudiencePolicies(ctx cont
 	return r0, r1
 }
 
-// MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlattenedResourceAudiencePolicies'
-type MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call struct {
+// MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitationStatus'
+type MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call struct {
 	*mock.Call
 }
 
-// GetFlattenedResourceAudiencePolicies is a helper method to define mock.On call
+// CreateOrganizationInvitationStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters models.FlattenedResourceAudiencePoliciesFilters
-func (_e *MockDatasetServiceStore_Expecter) GetFlattenedResourceAudiencePolicies(ctx interface{}, filters interface{}) *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call {
-	return &MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call{Call: _e.mock.On("GetFlattenedResourceAudiencePolicies", ctx, filters)}
+//   - invitationId uuid.UUID
+//   - status models.InvitationStatus
+func (_e *MockDatasetServiceStore_Expecter) CreateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call {
+	return &MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call{Call: _e.mock.On("CreateOrganizationInvitationStatus", ctx, invitationId, status)}
 }
 
-func (_c *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call) Run(run func(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters)) *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.FlattenedResourceAudiencePoliciesFilters))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call) Return(_a0 []models.FlattenedResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call) RunAndReturn(run func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockDatasetServiceStore_CreateOrganizationInvitationStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDataset provides a mock function with given fields: ctx, dataset
-func (_m *MockDatasetServiceStore) UpdateDataset(ctx context.Context, dataset models.Dataset) (uuid.UUID, error) {
-	ret := _m.Called(ctx, dataset)
+// CreateOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
+func (_m *MockDatasetServiceStore) CreateOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId, userId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDataset")
+		panic("no return value specified for CreateOrganizationMembershipRequest")
 	}
 
-	var r0 uuid.UUID
+	var r0 *models.OrganizationMembershipRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) (uuid.UUID, error)); ok {
-		return rf(ctx, dataset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId, userId, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) uuid.UUID); ok {
-		r0 = rf(ctx, dataset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId, userId, status)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
+			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.Dataset) error); ok {
-		r1 = rf(ctx, dataset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
+		r1 = rf(ctx, organizationId, userId, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -968,154 +988,180 @@ func (_m *MockDatasetServiceStore) UpdateDataset(ctx context.Context, dataset mo
 	return r0, r1
 }
 
-// MockDatasetServiceStore_UpdateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataset'
-type MockDatasetServiceStore_UpdateDataset_Call struct {
+// MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationMembershipRequest'
+type MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call struct {
 	*mock.Call
 }
 
-// UpdateDataset is a helper method to define mock.On call
+// CreateOrganizationMembershipRequest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dataset models.Dataset
-func (_e *MockDatasetServiceStore_Expecter) UpdateDataset(ctx interface{}, dataset interface{}) *MockDatasetServiceStore_UpdateDataset_Call {
-	return &MockDatasetServiceStore_UpdateDataset_Call{Call: _e.mock.On("UpdateDataset", ctx, dataset)}
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+//   - status models.OrgMembershipStatus
+func (_e *MockDatasetServiceStore_Expecter) CreateOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call {
+	return &MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call{Call: _e.mock.On("CreateOrganizationMembershipRequest", ctx, organizationId, userId, status)}
 }
 
-func (_c *MockDatasetServiceStore_UpdateDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockDatasetServiceStore_UpdateDataset_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.Dataset))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDataset_Call) Return(_a0 uuid.UUID, _a1 error) *MockDatasetServiceStore_UpdateDataset_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) (uuid.UUID, error)) *MockDatasetServiceStore_UpdateDataset_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockDatasetServiceStore_CreateOrganizationMembershipRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetActionConfig provides a mock function with given fields: ctx, actionId, config
-func (_m *MockDatasetServiceStore) UpdateDatasetActionConfig(ctx context.Context, actionId string, config map[string]interface{}) error {
-	ret := _m.Called(ctx, actionId, config)
+// CreateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceType, audienceId, privilege
+func (_m *MockDatasetServiceStore) CreateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId, audienceType, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetActionConfig")
+		panic("no return value specified for CreateOrganizationPolicy")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) error); ok {
-		r0 = rf(ctx, actionId, config)
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId, audienceType, audienceId, privilege)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, orgId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetServiceStore_UpdateDatasetActionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionConfig'
-type MockDatasetServiceStore_UpdateDatasetActionConfig_Call struct {
+// MockDatasetServiceStore_CreateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationPolicy'
+type MockDatasetServiceStore_CreateOrganizationPolicy_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetActionConfig is a helper method to define mock.On call
+// CreateOrganizationPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - actionId string
-//   - config map[string]interface{}
-func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetActionConfig(ctx interface{}, actionId interface{}, config interface{}) *MockDatasetServiceStore_UpdateDatasetActionConfig_Call {
-	return &MockDatasetServiceStore_UpdateDatasetActionConfig_Call{Call: _e.mock.On("UpdateDatasetActionConfig", ctx, actionId, config)}
+//   - orgId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetServiceStore_Expecter) CreateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockDatasetServiceStore_CreateOrganizationPolicy_Call {
+	return &MockDatasetServiceStore_CreateOrganizationPolicy_Call{Call: _e.mock.On("CreateOrganizationPolicy", ctx, orgId, audienceType, audienceId, privilege)}
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetActionConfig_Call) Run(run func(ctx context.Context, actionId string, config map[string]interface{})) *MockDatasetServiceStore_UpdateDatasetActionConfig_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetServiceStore_CreateOrganizationPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(map[string]interface{}))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetActionConfig_Call) Return(_a0 error) *MockDatasetServiceStore_UpdateDatasetActionConfig_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatasetServiceStore_CreateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_CreateOrganizationPolicy_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetActionConfig_Call) RunAndReturn(run func(context.Context, string, map[string]interface{}) error) *MockDatasetServiceStore_UpdateDatasetActionConfig_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_CreateOrganizationPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetActionStatus provides a mock function with given fields: ctx, actionId, status
-func (_m *MockDatasetServiceStore) UpdateDatasetActionStatus(ctx context.Context, actionId string, status string) error {
-	ret := _m.Called(ctx, actionId, status)
+// CreateOrganizationTeam provides a mock function with given fields: ctx, organizationId, team
+func (_m *MockDatasetServiceStore) CreateOrganizationTeam(ctx context.Context, organizationId uuid.UUID, team models.Team) (*models.Team, error) {
+	ret := _m.Called(ctx, organizationId, team)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetActionStatus")
+		panic("no return value specified for CreateOrganizationTeam")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, actionId, status)
+	var r0 *models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) (*models.Team, error)); ok {
+		return rf(ctx, organizationId, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) *models.Team); ok {
+		r0 = rf(ctx, organizationId, team)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.Team) error); ok {
+		r1 = rf(ctx, organizationId, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDatasetServiceStore_UpdateDatasetActionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionStatus'
-type MockDatasetServiceStore_UpdateDatasetActionStatus_Call struct {
+// MockDatasetServiceStore_CreateOrganizationTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationTeam'
+type MockDatasetServiceStore_CreateOrganizationTeam_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetActionStatus is a helper method to define mock.On call
+// CreateOrganizationTeam is a helper method to define mock.On call
 //   - ctx context.Context
-//   - actionId string
-//   - status string
-func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetActionStatus(ctx interface{}, actionId interface{}, status interface{}) *MockDatasetServiceStore_UpdateDatasetActionStatus_Call {
-	return &MockDatasetServiceStore_UpdateDatasetActionStatus_Call{Call: _e.mock.On("UpdateDatasetActionStatus", ctx, actionId, status)}
+//   - organizationId uuid.UUID
+//   - team models.Team
+func (_e *MockDatasetServiceStore_Expecter) CreateOrganizationTeam(ctx interface{}, organizationId interface{}, team interface{}) *MockDatasetServiceStore_CreateOrganizationTeam_Call {
+	return &MockDatasetServiceStore_CreateOrganizationTeam_Call{Call: _e.mock.On("CreateOrganizationTeam", ctx, organizationId, team)}
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetActionStatus_Call) Run(run func(ctx context.Context, actionId string, status string)) *MockDatasetServiceStore_UpdateDatasetActionStatus_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, team models.Team)) *MockDatasetServiceStore_CreateOrganizationTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.Team))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetActionStatus_Call) Return(_a0 error) *MockDatasetServiceStore_UpdateDatasetActionStatus_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDatasetServiceStore_CreateOrganizationTeam_Call) Return(_a0 *models.Team, _a1 error) *MockDatasetServiceStore_CreateOrganizationTeam_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetActionStatus_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDatasetServiceStore_UpdateDatasetActionStatus_Call {
+func (_c *MockDatasetServiceStore_CreateOrganizationTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.Team) (*models.Team, error)) *MockDatasetServiceStore_CreateOrganizationTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetFileUploadStatus provides a mock function with given fields: ctx, id, fileAllignmentStatus, metadata
-func (_m *MockDatasetServiceStore) UpdateDatasetFileUploadStatus(ctx context.Context, id uuid.UUID, fileAllignmentStatus models.DatasetFileAllignmentStatus, metadata models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error) {
-	ret := _m.Called(ctx, id, fileAllignmentStatus, metadata)
+// CreateReferenceBank provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateReferenceBank(ctx context.Context, params models.CreateReferenceBankParams) (models.ReferenceBank, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetFileUploadStatus")
+		panic("no return value specified for CreateReferenceBank")
 	}
 
-	var r0 *models.DatasetFileUpload
+	var r0 models.ReferenceBank
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error)); ok {
-		return rf(ctx, id, fileAllignmentStatus, metadata)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) *models.DatasetFileUpload); ok {
-		r0 = rf(ctx, id, fileAllignmentStatus, metadata)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) models.ReferenceBank); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.DatasetFileUpload)
-		}
+		r0 = ret.Get(0).(models.ReferenceBank)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) error); ok {
-		r1 = rf(ctx, id, fileAllignmentStatus, metadata)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateReferenceBankParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1123,60 +1169,5551 @@ func (_m *MockDatasetServiceStore) UpdateDatasetFileUploadStatus(ctx context.Con
 	return r0, r1
 }
 
-// MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetFileUploadStatus'
-type MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call struct {
+// MockDatasetServiceStore_CreateReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReferenceBank'
+type MockDatasetServiceStore_CreateReferenceBank_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetFileUploadStatus is a helper method to define mock.On call
+// CreateReferenceBank is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-//   - fileAllignmentStatus models.DatasetFileAllignmentStatus
-//   - metadata models.DatasetFileUploadMetadata
+//   - params models.CreateReferenceBankParams
+func (_e *MockDatasetServiceStore_Expecter) CreateReferenceBank(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateReferenceBank_Call {
+	return &MockDatasetServiceStore_CreateReferenceBank_Call{Call: _e.mock.On("CreateReferenceBank", ctx, params)}
+}
+
+func (_c *MockDatasetServiceStore_CreateReferenceBank_Call) Run(run func(ctx context.Context, params models.CreateReferenceBankParams)) *MockDatasetServiceStore_CreateReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateReferenceBankParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_CreateReferenceBank_Call) Return(_a0 models.ReferenceBank, _a1 error) *MockDatasetServiceStore_CreateReferenceBank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_CreateReferenceBank_Call) RunAndReturn(run func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)) *MockDatasetServiceStore_CreateReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSSOConfig provides a mock function with given fields: ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain
+func (_m *MockDatasetServiceStore) CreateSSOConfig(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSSOConfig")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) error); ok {
+		r1 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_CreateSSOConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSSOConfig'
+type MockDatasetServiceStore_CreateSSOConfig_Call struct {
+	*mock.Call
+}
+
+// CreateSSOConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - ssoProviderID string
+//   - ssoProviderName string
+//   - ssoConfig json.RawMessage
+//   - emailDomain string
+func (_e *MockDatasetServiceStore_Expecter) CreateSSOConfig(ctx interface{}, organizationId interface{}, ssoProviderID interface{}, ssoProviderName interface{}, ssoConfig interface{}, emailDomain interface{}) *MockDatasetServiceStore_CreateSSOConfig_Call {
+	return &MockDatasetServiceStore_CreateSSOConfig_Call{Call: _e.mock.On("CreateSSOConfig", ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)}
+}
+
+func (_c *MockDatasetServiceStore_CreateSSOConfig_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string)) *MockDatasetServiceStore_CreateSSOConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(json.RawMessage), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_CreateSSOConfig_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockDatasetServiceStore_CreateSSOConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_CreateSSOConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)) *MockDatasetServiceStore_CreateSSOConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTag provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) CreateTag(ctx context.Context, params models.CreateTagParams) (models.Tag, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateTagParams) (models.Tag, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateTagParams) models.Tag); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateTagParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type MockDatasetServiceStore_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateTagParams
+func (_e *MockDatasetServiceStore_Expecter) CreateTag(ctx interface{}, params interface{}) *MockDatasetServiceStore_CreateTag_Call {
+	return &MockDatasetServiceStore_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, params)}
+}
+
+func (_c *MockDatasetServiceStore_CreateTag_Call) Run(run func(ctx context.Context, params models.CreateTagParams)) *MockDatasetServiceStore_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateTagParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_CreateTag_Call) Return(_a0 models.Tag, _a1 error) *MockDatasetServiceStore_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_CreateTag_Call) RunAndReturn(run func(context.Context, models.CreateTagParams) (models.Tag, error)) *MockDatasetServiceStore_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTeamMembership provides a mock function with given fields: ctx, teamId, userId
+func (_m *MockDatasetServiceStore) CreateTeamMembership(ctx context.Context, teamId uuid.UUID, userId uuid.UUID) (*models.TeamMembership, error) {
+	ret := _m.Called(ctx, teamId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeamMembership")
+	}
+
+	var r0 *models.TeamMembership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)); ok {
+		return rf(ctx, teamId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.TeamMembership); ok {
+		r0 = rf(ctx, teamId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TeamMembership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_CreateTeamMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeamMembership'
+type MockDatasetServiceStore_CreateTeamMembership_Call struct {
+	*mock.Call
+}
+
+// CreateTeamMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) CreateTeamMembership(ctx interface{}, teamId interface{}, userId interface{}) *MockDatasetServiceStore_CreateTeamMembership_Call {
+	return &MockDatasetServiceStore_CreateTeamMembership_Call{Call: _e.mock.On("CreateTeamMembership", ctx, teamId, userId)}
+}
+
+func (_c *MockDatasetServiceStore_CreateTeamMembership_Call) Run(run func(ctx context.Context, teamId uuid.UUID, userId uuid.UUID)) *MockDatasetServiceStore_CreateTeamMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_CreateTeamMembership_Call) Return(_a0 *models.TeamMembership, _a1 error) *MockDatasetServiceStore_CreateTeamMembership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_CreateTeamMembership_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)) *MockDatasetServiceStore_CreateTeamMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockDatasetServiceStore) DeleteDataset(ctx context.Context, dataset models.Dataset) error {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDataset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) error); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDataset'
+type MockDatasetServiceStore_DeleteDataset_Call struct {
+	*mock.Call
+}
+
+// DeleteDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset models.Dataset
+func (_e *MockDatasetServiceStore_Expecter) DeleteDataset(ctx interface{}, dataset interface{}) *MockDatasetServiceStore_DeleteDataset_Call {
+	return &MockDatasetServiceStore_DeleteDataset_Call{Call: _e.mock.On("DeleteDataset", ctx, dataset)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockDatasetServiceStore_DeleteDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Dataset))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDataset_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDataset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) error) *MockDatasetServiceStore_DeleteDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetAlert provides a mock function with given fields: ctx, alertId, deletedBy
+func (_m *MockDatasetServiceStore) DeleteDatasetAlert(ctx context.Context, alertId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, alertId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, alertId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetAlert'
+type MockDatasetServiceStore_DeleteDatasetAlert_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteDatasetAlert(ctx interface{}, alertId interface{}, deletedBy interface{}) *MockDatasetServiceStore_DeleteDatasetAlert_Call {
+	return &MockDatasetServiceStore_DeleteDatasetAlert_Call{Call: _e.mock.On("DeleteDatasetAlert", ctx, alertId, deletedBy)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetAlert_Call) Run(run func(ctx context.Context, alertId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetServiceStore_DeleteDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetAlert_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDatasetAlert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetColumnPolicy provides a mock function with given fields: ctx, policyId, deletedBy
+func (_m *MockDatasetServiceStore) DeleteDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, policyId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetColumnPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, policyId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetColumnPolicy'
+type MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetColumnPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteDatasetColumnPolicy(ctx interface{}, policyId interface{}, deletedBy interface{}) *MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call {
+	return &MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call{Call: _e.mock.On("DeleteDatasetColumnPolicy", ctx, policyId, deletedBy)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteDatasetColumnPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetExportSchedule provides a mock function with given fields: ctx, scheduleId, deletedBy
+func (_m *MockDatasetServiceStore) DeleteDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, scheduleId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetExportSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, scheduleId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetExportSchedule'
+type MockDatasetServiceStore_DeleteDatasetExportSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetExportSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteDatasetExportSchedule(ctx interface{}, scheduleId interface{}, deletedBy interface{}) *MockDatasetServiceStore_DeleteDatasetExportSchedule_Call {
+	return &MockDatasetServiceStore_DeleteDatasetExportSchedule_Call{Call: _e.mock.On("DeleteDatasetExportSchedule", ctx, scheduleId, deletedBy)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetExportSchedule_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetServiceStore_DeleteDatasetExportSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetExportSchedule_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDatasetExportSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteDatasetExportSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId
+func (_m *MockDatasetServiceStore) DeleteDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, datasetId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, datasetId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetPolicy'
+type MockDatasetServiceStore_DeleteDatasetPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}) *MockDatasetServiceStore_DeleteDatasetPolicy_Call {
+	return &MockDatasetServiceStore_DeleteDatasetPolicy_Call{Call: _e.mock.On("DeleteDatasetPolicy", ctx, datasetId, audienceType, audienceId)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockDatasetServiceStore_DeleteDatasetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetPolicy_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDatasetPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockDatasetServiceStore_DeleteDatasetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetRowPolicy provides a mock function with given fields: ctx, policyId, deletedBy
+func (_m *MockDatasetServiceStore) DeleteDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, policyId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetRowPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, policyId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetRowPolicy'
+type MockDatasetServiceStore_DeleteDatasetRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteDatasetRowPolicy(ctx interface{}, policyId interface{}, deletedBy interface{}) *MockDatasetServiceStore_DeleteDatasetRowPolicy_Call {
+	return &MockDatasetServiceStore_DeleteDatasetRowPolicy_Call{Call: _e.mock.On("DeleteDatasetRowPolicy", ctx, policyId, deletedBy)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetRowPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetServiceStore_DeleteDatasetRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetRowPolicy_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDatasetRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteDatasetRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetStatusWorkflow provides a mock function with given fields: ctx, workflowId, deletedBy
+func (_m *MockDatasetServiceStore) DeleteDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, workflowId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetStatusWorkflow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, workflowId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetStatusWorkflow'
+type MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetStatusWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteDatasetStatusWorkflow(ctx interface{}, workflowId interface{}, deletedBy interface{}) *MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call {
+	return &MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call{Call: _e.mock.On("DeleteDatasetStatusWorkflow", ctx, workflowId, deletedBy)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, workflowId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetView provides a mock function with given fields: ctx, viewId, deletedBy
+func (_m *MockDatasetServiceStore) DeleteDatasetView(ctx context.Context, viewId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, viewId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, viewId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetView'
+type MockDatasetServiceStore_DeleteDatasetView_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteDatasetView(ctx interface{}, viewId interface{}, deletedBy interface{}) *MockDatasetServiceStore_DeleteDatasetView_Call {
+	return &MockDatasetServiceStore_DeleteDatasetView_Call{Call: _e.mock.On("DeleteDatasetView", ctx, viewId, deletedBy)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetView_Call) Run(run func(ctx context.Context, viewId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetServiceStore_DeleteDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetView_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDatasetView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceType, audienceId
+func (_m *MockDatasetServiceStore) DeleteDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, viewId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetViewPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, viewId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetViewPolicy'
+type MockDatasetServiceStore_DeleteDatasetViewPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetViewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}) *MockDatasetServiceStore_DeleteDatasetViewPolicy_Call {
+	return &MockDatasetServiceStore_DeleteDatasetViewPolicy_Call{Call: _e.mock.On("DeleteDatasetViewPolicy", ctx, viewId, audienceType, audienceId)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockDatasetServiceStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetViewPolicy_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockDatasetServiceStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId
+func (_m *MockDatasetServiceStore) DeleteDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDefaultDatasetView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDefaultDatasetView'
+type MockDatasetServiceStore_DeleteDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// DeleteDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}) *MockDatasetServiceStore_DeleteDefaultDatasetView_Call {
+	return &MockDatasetServiceStore_DeleteDefaultDatasetView_Call{Call: _e.mock.On("DeleteDefaultDatasetView", ctx, userId, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID)) *MockDatasetServiceStore_DeleteDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDefaultDatasetView_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteDefaultDatasetView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId
+func (_m *MockDatasetServiceStore) DeleteOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, orgId, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, orgId, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationPolicy'
+type MockDatasetServiceStore_DeleteOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}) *MockDatasetServiceStore_DeleteOrganizationPolicy_Call {
+	return &MockDatasetServiceStore_DeleteOrganizationPolicy_Call{Call: _e.mock.On("DeleteOrganizationPolicy", ctx, orgId, audienceId)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID)) *MockDatasetServiceStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteOrganizationPolicy_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReferenceBank provides a mock function with given fields: ctx, bankId, deletedBy
+func (_m *MockDatasetServiceStore) DeleteReferenceBank(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, bankId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReferenceBank")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, bankId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReferenceBank'
+type MockDatasetServiceStore_DeleteReferenceBank_Call struct {
+	*mock.Call
+}
+
+// DeleteReferenceBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bankId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteReferenceBank(ctx interface{}, bankId interface{}, deletedBy interface{}) *MockDatasetServiceStore_DeleteReferenceBank_Call {
+	return &MockDatasetServiceStore_DeleteReferenceBank_Call{Call: _e.mock.On("DeleteReferenceBank", ctx, bankId, deletedBy)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteReferenceBank_Call) Run(run func(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetServiceStore_DeleteReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteReferenceBank_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteReferenceBank_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteReferenceBank_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, tagId, deletedBy
+func (_m *MockDatasetServiceStore) DeleteTag(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, tagId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, tagId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockDatasetServiceStore_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteTag(ctx interface{}, tagId interface{}, deletedBy interface{}) *MockDatasetServiceStore_DeleteTag_Call {
+	return &MockDatasetServiceStore_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, tagId, deletedBy)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetServiceStore_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteTag_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeam provides a mock function with given fields: ctx, organizationId, teamId
+func (_m *MockDatasetServiceStore) DeleteTeam(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID) error {
+	ret := _m.Called(ctx, organizationId, teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, organizationId, teamId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type MockDatasetServiceStore_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - teamId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteTeam(ctx interface{}, organizationId interface{}, teamId interface{}) *MockDatasetServiceStore_DeleteTeam_Call {
+	return &MockDatasetServiceStore_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, organizationId, teamId)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID)) *MockDatasetServiceStore_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteTeam_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeamMembership provides a mock function with given fields: ctx, teamId, teamMembershipId
+func (_m *MockDatasetServiceStore) DeleteTeamMembership(ctx context.Context, teamId uuid.UUID, teamMembershipId uuid.UUID) error {
+	ret := _m.Called(ctx, teamId, teamMembershipId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeamMembership")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, teamId, teamMembershipId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_DeleteTeamMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeamMembership'
+type MockDatasetServiceStore_DeleteTeamMembership_Call struct {
+	*mock.Call
+}
+
+// DeleteTeamMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamId uuid.UUID
+//   - teamMembershipId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) DeleteTeamMembership(ctx interface{}, teamId interface{}, teamMembershipId interface{}) *MockDatasetServiceStore_DeleteTeamMembership_Call {
+	return &MockDatasetServiceStore_DeleteTeamMembership_Call{Call: _e.mock.On("DeleteTeamMembership", ctx, teamId, teamMembershipId)}
+}
+
+func (_c *MockDatasetServiceStore_DeleteTeamMembership_Call) Run(run func(ctx context.Context, teamId uuid.UUID, teamMembershipId uuid.UUID)) *MockDatasetServiceStore_DeleteTeamMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteTeamMembership_Call) Return(_a0 error) *MockDatasetServiceStore_DeleteTeamMembership_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_DeleteTeamMembership_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetServiceStore_DeleteTeamMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetActionFromActionId provides a mock function with given fields: ctx, actionId
+func (_m *MockDatasetServiceStore) GetDatasetActionFromActionId(ctx context.Context, actionId string) (*models.DatasetAction, error) {
+	ret := _m.Called(ctx, actionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetActionFromActionId")
+	}
+
+	var r0 *models.DatasetAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.DatasetAction, error)); ok {
+		return rf(ctx, actionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.DatasetAction); ok {
+		r0 = rf(ctx, actionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DatasetAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, actionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetActionFromActionId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActionFromActionId'
+type MockDatasetServiceStore_GetDatasetActionFromActionId_Call struct {
+	*mock.Call
+}
+
+// GetDatasetActionFromActionId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actionId string
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetActionFromActionId(ctx interface{}, actionId interface{}) *MockDatasetServiceStore_GetDatasetActionFromActionId_Call {
+	return &MockDatasetServiceStore_GetDatasetActionFromActionId_Call{Call: _e.mock.On("GetDatasetActionFromActionId", ctx, actionId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetActionFromActionId_Call) Run(run func(ctx context.Context, actionId string)) *MockDatasetServiceStore_GetDatasetActionFromActionId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetActionFromActionId_Call) Return(_a0 *models.DatasetAction, _a1 error) *MockDatasetServiceStore_GetDatasetActionFromActionId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetActionFromActionId_Call) RunAndReturn(run func(context.Context, string) (*models.DatasetAction, error)) *MockDatasetServiceStore_GetDatasetActionFromActionId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetActions provides a mock function with given fields: ctx, organizationId, filters
+func (_m *MockDatasetServiceStore) GetDatasetActions(ctx context.Context, organizationId uuid.UUID, filters models.DatasetActionFilters) ([]models.DatasetAction, error) {
+	ret := _m.Called(ctx, organizationId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetActions")
+	}
+
+	var r0 []models.DatasetAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]models.DatasetAction, error)); ok {
+		return rf(ctx, organizationId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) []models.DatasetAction); ok {
+		r0 = rf(ctx, organizationId, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetActionFilters) error); ok {
+		r1 = rf(ctx, organizationId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActions'
+type MockDatasetServiceStore_GetDatasetActions_Call struct {
+	*mock.Call
+}
+
+// GetDatasetActions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - filters models.DatasetActionFilters
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetActions(ctx interface{}, organizationId interface{}, filters interface{}) *MockDatasetServiceStore_GetDatasetActions_Call {
+	return &MockDatasetServiceStore_GetDatasetActions_Call{Call: _e.mock.On("GetDatasetActions", ctx, organizationId, filters)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetActions_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, filters models.DatasetActionFilters)) *MockDatasetServiceStore_GetDatasetActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetActionFilters))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetActions_Call) Return(_a0 []models.DatasetAction, _a1 error) *MockDatasetServiceStore_GetDatasetActions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetActions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]models.DatasetAction, error)) *MockDatasetServiceStore_GetDatasetActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlertById provides a mock function with given fields: ctx, alertId
+func (_m *MockDatasetServiceStore) GetDatasetAlertById(ctx context.Context, alertId uuid.UUID) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, alertId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlertById")
+	}
+
+	var r0 models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetAlert, error)); ok {
+		return rf(ctx, alertId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetAlert); ok {
+		r0 = rf(ctx, alertId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, alertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetAlertById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlertById'
+type MockDatasetServiceStore_GetDatasetAlertById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlertById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetAlertById(ctx interface{}, alertId interface{}) *MockDatasetServiceStore_GetDatasetAlertById_Call {
+	return &MockDatasetServiceStore_GetDatasetAlertById_Call{Call: _e.mock.On("GetDatasetAlertById", ctx, alertId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetAlertById_Call) Run(run func(ctx context.Context, alertId uuid.UUID)) *MockDatasetServiceStore_GetDatasetAlertById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetAlertById_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockDatasetServiceStore_GetDatasetAlertById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetAlertById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetAlert, error)) *MockDatasetServiceStore_GetDatasetAlertById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlertEvents provides a mock function with given fields: ctx, alertId, limit
+func (_m *MockDatasetServiceStore) GetDatasetAlertEvents(ctx context.Context, alertId uuid.UUID, limit int) ([]models.DatasetAlertEvent, error) {
+	ret := _m.Called(ctx, alertId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlertEvents")
+	}
+
+	var r0 []models.DatasetAlertEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.DatasetAlertEvent, error)); ok {
+		return rf(ctx, alertId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.DatasetAlertEvent); ok {
+		r0 = rf(ctx, alertId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetAlertEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, alertId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetAlertEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlertEvents'
+type MockDatasetServiceStore_GetDatasetAlertEvents_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlertEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - limit int
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetAlertEvents(ctx interface{}, alertId interface{}, limit interface{}) *MockDatasetServiceStore_GetDatasetAlertEvents_Call {
+	return &MockDatasetServiceStore_GetDatasetAlertEvents_Call{Call: _e.mock.On("GetDatasetAlertEvents", ctx, alertId, limit)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetAlertEvents_Call) Run(run func(ctx context.Context, alertId uuid.UUID, limit int)) *MockDatasetServiceStore_GetDatasetAlertEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetAlertEvents_Call) Return(_a0 []models.DatasetAlertEvent, _a1 error) *MockDatasetServiceStore_GetDatasetAlertEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetAlertEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]models.DatasetAlertEvent, error)) *MockDatasetServiceStore_GetDatasetAlertEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlerts provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetServiceStore) GetDatasetAlerts(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetAlert, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlerts")
+	}
+
+	var r0 []models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetAlert, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetAlert); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlerts'
+type MockDatasetServiceStore_GetDatasetAlerts_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetAlerts(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetAlerts_Call {
+	return &MockDatasetServiceStore_GetDatasetAlerts_Call{Call: _e.mock.On("GetDatasetAlerts", ctx, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetAlerts_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetAlerts_Call) Return(_a0 []models.DatasetAlert, _a1 error) *MockDatasetServiceStore_GetDatasetAlerts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetAlerts_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetAlert, error)) *MockDatasetServiceStore_GetDatasetAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetById provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetServiceStore) GetDatasetById(ctx context.Context, datasetId string) (*models.Dataset, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetById")
+	}
+
+	var r0 *models.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Dataset, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Dataset); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetById'
+type MockDatasetServiceStore_GetDatasetById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId string
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetById(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetById_Call {
+	return &MockDatasetServiceStore_GetDatasetById_Call{Call: _e.mock.On("GetDatasetById", ctx, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetById_Call) Run(run func(ctx context.Context, datasetId string)) *MockDatasetServiceStore_GetDatasetById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetById_Call) Return(_a0 *models.Dataset, _a1 error) *MockDatasetServiceStore_GetDatasetById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetById_Call) RunAndReturn(run func(context.Context, string) (*models.Dataset, error)) *MockDatasetServiceStore_GetDatasetById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetServiceStore) GetDatasetColumnPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPolicies")
+	}
+
+	var r0 []models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetColumnPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetColumnPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPolicies'
+type MockDatasetServiceStore_GetDatasetColumnPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetColumnPolicies(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetColumnPolicies_Call {
+	return &MockDatasetServiceStore_GetDatasetColumnPolicies_Call{Call: _e.mock.On("GetDatasetColumnPolicies", ctx, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetColumnPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetColumnPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetColumnPolicies_Call) Return(_a0 []models.DatasetColumnPolicy, _a1 error) *MockDatasetServiceStore_GetDatasetColumnPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetColumnPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetColumnPolicy, error)) *MockDatasetServiceStore_GetDatasetColumnPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPoliciesForUser provides a mock function with given fields: ctx, datasetId, userId
+func (_m *MockDatasetServiceStore) GetDatasetColumnPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, datasetId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPoliciesForUser")
+	}
+
+	var r0 []models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, datasetId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, datasetId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetColumnPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPoliciesForUser'
+type MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPoliciesForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetColumnPoliciesForUser(ctx interface{}, datasetId interface{}, userId interface{}) *MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call {
+	return &MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call{Call: _e.mock.On("GetDatasetColumnPoliciesForUser", ctx, datasetId, userId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID)) *MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call) Return(_a0 []models.DatasetColumnPolicy, _a1 error) *MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetColumnPolicy, error)) *MockDatasetServiceStore_GetDatasetColumnPoliciesForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPolicyById provides a mock function with given fields: ctx, policyId
+func (_m *MockDatasetServiceStore) GetDatasetColumnPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, policyId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPolicyById")
+	}
+
+	var r0 models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, policyId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, policyId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetColumnPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, policyId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetColumnPolicyById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPolicyById'
+type MockDatasetServiceStore_GetDatasetColumnPolicyById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPolicyById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetColumnPolicyById(ctx interface{}, policyId interface{}) *MockDatasetServiceStore_GetDatasetColumnPolicyById_Call {
+	return &MockDatasetServiceStore_GetDatasetColumnPolicyById_Call{Call: _e.mock.On("GetDatasetColumnPolicyById", ctx, policyId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetColumnPolicyById_Call) Run(run func(ctx context.Context, policyId uuid.UUID)) *MockDatasetServiceStore_GetDatasetColumnPolicyById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetColumnPolicyById_Call) Return(_a0 models.DatasetColumnPolicy, _a1 error) *MockDatasetServiceStore_GetDatasetColumnPolicyById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetColumnPolicyById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetColumnPolicy, error)) *MockDatasetServiceStore_GetDatasetColumnPolicyById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetCount provides a mock function with given fields: ctx, filters
+func (_m *MockDatasetServiceStore) GetDatasetCount(ctx context.Context, filters models.DatasetFilters) (int64, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) (int64, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) int64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetCount'
+type MockDatasetServiceStore_GetDatasetCount_Call struct {
+	*mock.Call
+}
+
+// GetDatasetCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.DatasetFilters
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetCount(ctx interface{}, filters interface{}) *MockDatasetServiceStore_GetDatasetCount_Call {
+	return &MockDatasetServiceStore_GetDatasetCount_Call{Call: _e.mock.On("GetDatasetCount", ctx, filters)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetCount_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockDatasetServiceStore_GetDatasetCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetCount_Call) Return(_a0 int64, _a1 error) *MockDatasetServiceStore_GetDatasetCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetCount_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) (int64, error)) *MockDatasetServiceStore_GetDatasetCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportScheduleById provides a mock function with given fields: ctx, scheduleId
+func (_m *MockDatasetServiceStore) GetDatasetExportScheduleById(ctx context.Context, scheduleId uuid.UUID) (models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, scheduleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportScheduleById")
+	}
+
+	var r0 models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, scheduleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, scheduleId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetExportSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, scheduleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetExportScheduleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportScheduleById'
+type MockDatasetServiceStore_GetDatasetExportScheduleById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportScheduleById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetExportScheduleById(ctx interface{}, scheduleId interface{}) *MockDatasetServiceStore_GetDatasetExportScheduleById_Call {
+	return &MockDatasetServiceStore_GetDatasetExportScheduleById_Call{Call: _e.mock.On("GetDatasetExportScheduleById", ctx, scheduleId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetExportScheduleById_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID)) *MockDatasetServiceStore_GetDatasetExportScheduleById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetExportScheduleById_Call) Return(_a0 models.DatasetExportSchedule, _a1 error) *MockDatasetServiceStore_GetDatasetExportScheduleById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetExportScheduleById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetExportSchedule, error)) *MockDatasetServiceStore_GetDatasetExportScheduleById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportScheduleRuns provides a mock function with given fields: ctx, scheduleId, limit
+func (_m *MockDatasetServiceStore) GetDatasetExportScheduleRuns(ctx context.Context, scheduleId uuid.UUID, limit int) ([]models.DatasetExportScheduleRun, error) {
+	ret := _m.Called(ctx, scheduleId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportScheduleRuns")
+	}
+
+	var r0 []models.DatasetExportScheduleRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.DatasetExportScheduleRun, error)); ok {
+		return rf(ctx, scheduleId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.DatasetExportScheduleRun); ok {
+		r0 = rf(ctx, scheduleId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetExportScheduleRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, scheduleId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportScheduleRuns'
+type MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportScheduleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+//   - limit int
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetExportScheduleRuns(ctx interface{}, scheduleId interface{}, limit interface{}) *MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call {
+	return &MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call{Call: _e.mock.On("GetDatasetExportScheduleRuns", ctx, scheduleId, limit)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID, limit int)) *MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call) Return(_a0 []models.DatasetExportScheduleRun, _a1 error) *MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]models.DatasetExportScheduleRun, error)) *MockDatasetServiceStore_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportSchedules provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetServiceStore) GetDatasetExportSchedules(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportSchedules")
+	}
+
+	var r0 []models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetExportSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetExportSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportSchedules'
+type MockDatasetServiceStore_GetDatasetExportSchedules_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetExportSchedules(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetExportSchedules_Call {
+	return &MockDatasetServiceStore_GetDatasetExportSchedules_Call{Call: _e.mock.On("GetDatasetExportSchedules", ctx, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetExportSchedules_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetExportSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetExportSchedules_Call) Return(_a0 []models.DatasetExportSchedule, _a1 error) *MockDatasetServiceStore_GetDatasetExportSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetExportSchedules_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetExportSchedule, error)) *MockDatasetServiceStore_GetDatasetExportSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetFileUploadByDatasetId provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetServiceStore) GetDatasetFileUploadByDatasetId(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetFileUpload, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetFileUploadByDatasetId")
+	}
+
+	var r0 []models.DatasetFileUpload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetFileUpload, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetFileUpload); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetFileUpload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploadByDatasetId'
+type MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call struct {
+	*mock.Call
+}
+
+// GetDatasetFileUploadByDatasetId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetFileUploadByDatasetId(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call {
+	return &MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call{Call: _e.mock.On("GetDatasetFileUploadByDatasetId", ctx, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call) Return(_a0 []models.DatasetFileUpload, _a1 error) *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetFileUpload, error)) *MockDatasetServiceStore_GetDatasetFileUploadByDatasetId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetFileUploadById provides a mock function with given fields: ctx, fileUploadId
+func (_m *MockDatasetServiceStore) GetDatasetFileUploadById(ctx context.Context, fileUploadId uuid.UUID) (models.DatasetFileUpload, error) {
+	ret := _m.Called(ctx, fileUploadId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetFileUploadById")
+	}
+
+	var r0 models.DatasetFileUpload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetFileUpload, error)); ok {
+		return rf(ctx, fileUploadId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetFileUpload); ok {
+		r0 = rf(ctx, fileUploadId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetFileUpload)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, fileUploadId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetFileUploadById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploadById'
+type MockDatasetServiceStore_GetDatasetFileUploadById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetFileUploadById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fileUploadId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetFileUploadById(ctx interface{}, fileUploadId interface{}) *MockDatasetServiceStore_GetDatasetFileUploadById_Call {
+	return &MockDatasetServiceStore_GetDatasetFileUploadById_Call{Call: _e.mock.On("GetDatasetFileUploadById", ctx, fileUploadId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetFileUploadById_Call) Run(run func(ctx context.Context, fileUploadId uuid.UUID)) *MockDatasetServiceStore_GetDatasetFileUploadById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetFileUploadById_Call) Return(_a0 models.DatasetFileUpload, _a1 error) *MockDatasetServiceStore_GetDatasetFileUploadById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetFileUploadById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetFileUpload, error)) *MockDatasetServiceStore_GetDatasetFileUploadById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetServiceStore) GetDatasetPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPolicies'
+type MockDatasetServiceStore_GetDatasetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetPolicies(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetPolicies_Call {
+	return &MockDatasetServiceStore_GetDatasetPolicies_Call{Call: _e.mock.On("GetDatasetPolicies", ctx, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetDatasetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetDatasetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetPoliciesByEmail provides a mock function with given fields: ctx, datasetId, email
+func (_m *MockDatasetServiceStore) GetDatasetPoliciesByEmail(ctx context.Context, datasetId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetPoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, datasetId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPoliciesByEmail'
+type MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetDatasetPoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - email string
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetPoliciesByEmail(ctx interface{}, datasetId interface{}, email interface{}) *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call {
+	return &MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call{Call: _e.mock.On("GetDatasetPoliciesByEmail", ctx, datasetId, email)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, email string)) *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowAssignment provides a mock function with given fields: ctx, datasetId, rowId
+func (_m *MockDatasetServiceStore) GetDatasetRowAssignment(ctx context.Context, datasetId uuid.UUID, rowId string) (models.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, datasetId, rowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowAssignment")
+	}
+
+	var r0 models.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (models.DatasetRowAssignment, error)); ok {
+		return rf(ctx, datasetId, rowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) models.DatasetRowAssignment); ok {
+		r0 = rf(ctx, datasetId, rowId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowAssignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, datasetId, rowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetRowAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowAssignment'
+type MockDatasetServiceStore_GetDatasetRowAssignment_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - rowId string
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetRowAssignment(ctx interface{}, datasetId interface{}, rowId interface{}) *MockDatasetServiceStore_GetDatasetRowAssignment_Call {
+	return &MockDatasetServiceStore_GetDatasetRowAssignment_Call{Call: _e.mock.On("GetDatasetRowAssignment", ctx, datasetId, rowId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowAssignment_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, rowId string)) *MockDatasetServiceStore_GetDatasetRowAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowAssignment_Call) Return(_a0 models.DatasetRowAssignment, _a1 error) *MockDatasetServiceStore_GetDatasetRowAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowAssignment_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (models.DatasetRowAssignment, error)) *MockDatasetServiceStore_GetDatasetRowAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowAssignmentsForAssignee provides a mock function with given fields: ctx, assigneeId
+func (_m *MockDatasetServiceStore) GetDatasetRowAssignmentsForAssignee(ctx context.Context, assigneeId uuid.UUID) ([]models.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, assigneeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowAssignmentsForAssignee")
+	}
+
+	var r0 []models.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetRowAssignment, error)); ok {
+		return rf(ctx, assigneeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetRowAssignment); ok {
+		r0 = rf(ctx, assigneeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetRowAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, assigneeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowAssignmentsForAssignee'
+type MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowAssignmentsForAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assigneeId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetRowAssignmentsForAssignee(ctx interface{}, assigneeId interface{}) *MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call {
+	return &MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call{Call: _e.mock.On("GetDatasetRowAssignmentsForAssignee", ctx, assigneeId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call) Run(run func(ctx context.Context, assigneeId uuid.UUID)) *MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call) Return(_a0 []models.DatasetRowAssignment, _a1 error) *MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetRowAssignment, error)) *MockDatasetServiceStore_GetDatasetRowAssignmentsForAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetServiceStore) GetDatasetRowPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPolicies")
+	}
+
+	var r0 []models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetRowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPolicies'
+type MockDatasetServiceStore_GetDatasetRowPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetRowPolicies(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetRowPolicies_Call {
+	return &MockDatasetServiceStore_GetDatasetRowPolicies_Call{Call: _e.mock.On("GetDatasetRowPolicies", ctx, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowPolicies_Call) Return(_a0 []models.DatasetRowPolicy, _a1 error) *MockDatasetServiceStore_GetDatasetRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetRowPolicy, error)) *MockDatasetServiceStore_GetDatasetRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPoliciesForUser provides a mock function with given fields: ctx, datasetId, userId
+func (_m *MockDatasetServiceStore) GetDatasetRowPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, datasetId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPoliciesForUser")
+	}
+
+	var r0 []models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, datasetId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, datasetId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetRowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPoliciesForUser'
+type MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPoliciesForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetRowPoliciesForUser(ctx interface{}, datasetId interface{}, userId interface{}) *MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call {
+	return &MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call{Call: _e.mock.On("GetDatasetRowPoliciesForUser", ctx, datasetId, userId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID)) *MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call) Return(_a0 []models.DatasetRowPolicy, _a1 error) *MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetRowPolicy, error)) *MockDatasetServiceStore_GetDatasetRowPoliciesForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPolicyById provides a mock function with given fields: ctx, policyId
+func (_m *MockDatasetServiceStore) GetDatasetRowPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, policyId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPolicyById")
+	}
+
+	var r0 models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, policyId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, policyId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, policyId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetRowPolicyById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPolicyById'
+type MockDatasetServiceStore_GetDatasetRowPolicyById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPolicyById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetRowPolicyById(ctx interface{}, policyId interface{}) *MockDatasetServiceStore_GetDatasetRowPolicyById_Call {
+	return &MockDatasetServiceStore_GetDatasetRowPolicyById_Call{Call: _e.mock.On("GetDatasetRowPolicyById", ctx, policyId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowPolicyById_Call) Run(run func(ctx context.Context, policyId uuid.UUID)) *MockDatasetServiceStore_GetDatasetRowPolicyById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowPolicyById_Call) Return(_a0 models.DatasetRowPolicy, _a1 error) *MockDatasetServiceStore_GetDatasetRowPolicyById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetRowPolicyById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetRowPolicy, error)) *MockDatasetServiceStore_GetDatasetRowPolicyById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetStatusWorkflowById provides a mock function with given fields: ctx, workflowId
+func (_m *MockDatasetServiceStore) GetDatasetStatusWorkflowById(ctx context.Context, workflowId uuid.UUID) (models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetStatusWorkflowById")
+	}
+
+	var r0 models.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, workflowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, workflowId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetStatusWorkflow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, workflowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetStatusWorkflowById'
+type MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetStatusWorkflowById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetStatusWorkflowById(ctx interface{}, workflowId interface{}) *MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call {
+	return &MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call{Call: _e.mock.On("GetDatasetStatusWorkflowById", ctx, workflowId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call) Run(run func(ctx context.Context, workflowId uuid.UUID)) *MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call) Return(_a0 models.DatasetStatusWorkflow, _a1 error) *MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetStatusWorkflow, error)) *MockDatasetServiceStore_GetDatasetStatusWorkflowById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetStatusWorkflows provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetServiceStore) GetDatasetStatusWorkflows(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetStatusWorkflows")
+	}
+
+	var r0 []models.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetStatusWorkflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetStatusWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetStatusWorkflows'
+type MockDatasetServiceStore_GetDatasetStatusWorkflows_Call struct {
+	*mock.Call
+}
+
+// GetDatasetStatusWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetStatusWorkflows(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetStatusWorkflows_Call {
+	return &MockDatasetServiceStore_GetDatasetStatusWorkflows_Call{Call: _e.mock.On("GetDatasetStatusWorkflows", ctx, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetStatusWorkflows_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetStatusWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetStatusWorkflows_Call) Return(_a0 []models.DatasetStatusWorkflow, _a1 error) *MockDatasetServiceStore_GetDatasetStatusWorkflows_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetStatusWorkflows_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetStatusWorkflow, error)) *MockDatasetServiceStore_GetDatasetStatusWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViewById provides a mock function with given fields: ctx, viewId
+func (_m *MockDatasetServiceStore) GetDatasetViewById(ctx context.Context, viewId uuid.UUID) (models.DatasetView, error) {
+	ret := _m.Called(ctx, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViewById")
+	}
+
+	var r0 models.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetView, error)); ok {
+		return rf(ctx, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetView); ok {
+		r0 = rf(ctx, viewId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetView)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetViewById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViewById'
+type MockDatasetServiceStore_GetDatasetViewById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViewById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetViewById(ctx interface{}, viewId interface{}) *MockDatasetServiceStore_GetDatasetViewById_Call {
+	return &MockDatasetServiceStore_GetDatasetViewById_Call{Call: _e.mock.On("GetDatasetViewById", ctx, viewId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetViewById_Call) Run(run func(ctx context.Context, viewId uuid.UUID)) *MockDatasetServiceStore_GetDatasetViewById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetViewById_Call) Return(_a0 models.DatasetView, _a1 error) *MockDatasetServiceStore_GetDatasetViewById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetViewById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetView, error)) *MockDatasetServiceStore_GetDatasetViewById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViewPolicies provides a mock function with given fields: ctx, viewId
+func (_m *MockDatasetServiceStore) GetDatasetViewPolicies(ctx context.Context, viewId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViewPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetViewPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViewPolicies'
+type MockDatasetServiceStore_GetDatasetViewPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViewPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetViewPolicies(ctx interface{}, viewId interface{}) *MockDatasetServiceStore_GetDatasetViewPolicies_Call {
+	return &MockDatasetServiceStore_GetDatasetViewPolicies_Call{Call: _e.mock.On("GetDatasetViewPolicies", ctx, viewId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetViewPolicies_Call) Run(run func(ctx context.Context, viewId uuid.UUID)) *MockDatasetServiceStore_GetDatasetViewPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetViewPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetDatasetViewPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetViewPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetDatasetViewPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViews provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetServiceStore) GetDatasetViews(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetView, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViews")
+	}
+
+	var r0 []models.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetView, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetView); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViews'
+type MockDatasetServiceStore_GetDatasetViews_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetViews(ctx interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDatasetViews_Call {
+	return &MockDatasetServiceStore_GetDatasetViews_Call{Call: _e.mock.On("GetDatasetViews", ctx, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetViews_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDatasetViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetViews_Call) Return(_a0 []models.DatasetView, _a1 error) *MockDatasetServiceStore_GetDatasetViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetViews_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetView, error)) *MockDatasetServiceStore_GetDatasetViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetsAll provides a mock function with given fields: ctx, filters
+func (_m *MockDatasetServiceStore) GetDatasetsAll(ctx context.Context, filters models.DatasetFilters) ([]models.Dataset, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetsAll")
+	}
+
+	var r0 []models.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) ([]models.Dataset, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) []models.Dataset); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDatasetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetsAll'
+type MockDatasetServiceStore_GetDatasetsAll_Call struct {
+	*mock.Call
+}
+
+// GetDatasetsAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.DatasetFilters
+func (_e *MockDatasetServiceStore_Expecter) GetDatasetsAll(ctx interface{}, filters interface{}) *MockDatasetServiceStore_GetDatasetsAll_Call {
+	return &MockDatasetServiceStore_GetDatasetsAll_Call{Call: _e.mock.On("GetDatasetsAll", ctx, filters)}
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetsAll_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockDatasetServiceStore_GetDatasetsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetsAll_Call) Return(_a0 []models.Dataset, _a1 error) *MockDatasetServiceStore_GetDatasetsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDatasetsAll_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) ([]models.Dataset, error)) *MockDatasetServiceStore_GetDatasetsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId
+func (_m *MockDatasetServiceStore) GetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) (*models.DatasetViewDefault, error) {
+	ret := _m.Called(ctx, userId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultDatasetView")
+	}
+
+	var r0 *models.DatasetViewDefault
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.DatasetViewDefault, error)); ok {
+		return rf(ctx, userId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.DatasetViewDefault); ok {
+		r0 = rf(ctx, userId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DatasetViewDefault)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultDatasetView'
+type MockDatasetServiceStore_GetDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// GetDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}) *MockDatasetServiceStore_GetDefaultDatasetView_Call {
+	return &MockDatasetServiceStore_GetDefaultDatasetView_Call{Call: _e.mock.On("GetDefaultDatasetView", ctx, userId, datasetId)}
+}
+
+func (_c *MockDatasetServiceStore_GetDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID)) *MockDatasetServiceStore_GetDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDefaultDatasetView_Call) Return(_a0 *models.DatasetViewDefault, _a1 error) *MockDatasetServiceStore_GetDefaultDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.DatasetViewDefault, error)) *MockDatasetServiceStore_GetDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlattenedResourceAudiencePolicies provides a mock function with given fields: ctx, filters
+func (_m *MockDatasetServiceStore) GetFlattenedResourceAudiencePolicies(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlattenedResourceAudiencePolicies")
+	}
+
+	var r0 []models.FlattenedResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) []models.FlattenedResourceAudiencePolicy); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FlattenedResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlattenedResourceAudiencePolicies'
+type MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call struct {
+	*mock.Call
+}
+
+// GetFlattenedResourceAudiencePolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FlattenedResourceAudiencePoliciesFilters
+func (_e *MockDatasetServiceStore_Expecter) GetFlattenedResourceAudiencePolicies(ctx interface{}, filters interface{}) *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	return &MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call{Call: _e.mock.On("GetFlattenedResourceAudiencePolicies", ctx, filters)}
+}
+
+func (_c *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call) Run(run func(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters)) *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FlattenedResourceAudiencePoliciesFilters))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call) Return(_a0 []models.FlattenedResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call) RunAndReturn(run func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationById provides a mock function with given fields: ctx, organizationId
+func (_m *MockDatasetServiceStore) GetOrganizationById(ctx context.Context, organizationId string) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationById")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationById'
+type MockDatasetServiceStore_GetOrganizationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationById(ctx interface{}, organizationId interface{}) *MockDatasetServiceStore_GetOrganizationById_Call {
+	return &MockDatasetServiceStore_GetOrganizationById_Call{Call: _e.mock.On("GetOrganizationById", ctx, organizationId)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationById_Call) Run(run func(ctx context.Context, organizationId string)) *MockDatasetServiceStore_GetOrganizationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationById_Call) Return(_a0 *models.Organization, _a1 error) *MockDatasetServiceStore_GetOrganizationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationById_Call) RunAndReturn(run func(context.Context, string) (*models.Organization, error)) *MockDatasetServiceStore_GetOrganizationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationById provides a mock function with given fields: ctx, invitationId
+func (_m *MockDatasetServiceStore) GetOrganizationInvitationById(ctx context.Context, invitationId uuid.UUID) (*models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, invitationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationById")
+	}
+
+	var r0 *models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)); ok {
+		return rf(ctx, invitationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.OrganizationInvitation); ok {
+		r0 = rf(ctx, invitationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationInvitationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationById'
+type MockDatasetServiceStore_GetOrganizationInvitationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationInvitationById(ctx interface{}, invitationId interface{}) *MockDatasetServiceStore_GetOrganizationInvitationById_Call {
+	return &MockDatasetServiceStore_GetOrganizationInvitationById_Call{Call: _e.mock.On("GetOrganizationInvitationById", ctx, invitationId)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationById_Call) Run(run func(ctx context.Context, invitationId uuid.UUID)) *MockDatasetServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationById_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockDatasetServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)) *MockDatasetServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAll provides a mock function with given fields: ctx
+func (_m *MockDatasetServiceStore) GetOrganizationInvitationsAll(ctx context.Context) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAll")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationInvitationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAll'
+type MockDatasetServiceStore_GetOrganizationInvitationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationInvitationsAll(ctx interface{}) *MockDatasetServiceStore_GetOrganizationInvitationsAll_Call {
+	return &MockDatasetServiceStore_GetOrganizationInvitationsAll_Call{Call: _e.mock.On("GetOrganizationInvitationsAll", ctx)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationsAll_Call) Run(run func(ctx context.Context)) *MockDatasetServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationsAll_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockDatasetServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationInvitation, error)) *MockDatasetServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAndMembershipRequests provides a mock function with given fields: ctx, organizationId
+func (_m *MockDatasetServiceStore) GetOrganizationInvitationsAndMembershipRequests(ctx context.Context, organizationId uuid.UUID) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAndMembershipRequests")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAndMembershipRequests'
+type MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAndMembershipRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationInvitationsAndMembershipRequests(ctx interface{}, organizationId interface{}) *MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	return &MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call{Call: _e.mock.On("GetOrganizationInvitationsAndMembershipRequests", ctx, organizationId)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) Return(_a0 *models.Organization, _a1 error) *MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Organization, error)) *MockDatasetServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockDatasetServiceStore) GetOrganizationInvitationsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsByOrganizationId'
+type MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationInvitationsByOrganizationId(ctx interface{}, organizationId interface{}) *MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	return &MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationInvitationsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)) *MockDatasetServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsAll provides a mock function with given fields: ctx
+func (_m *MockDatasetServiceStore) GetOrganizationMembershipRequestsAll(ctx context.Context) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsAll")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsAll'
+type MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationMembershipRequestsAll(ctx interface{}) *MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	return &MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsAll", ctx)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call) Run(run func(ctx context.Context)) *MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationMembershipRequest, error)) *MockDatasetServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockDatasetServiceStore) GetOrganizationMembershipRequestsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsByOrganizationId'
+type MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationMembershipRequestsByOrganizationId(ctx interface{}, organizationId interface{}) *MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	return &MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)) *MockDatasetServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicies provides a mock function with given fields: ctx, orgId
+func (_m *MockDatasetServiceStore) GetOrganizationPolicies(ctx context.Context, orgId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicies'
+type MockDatasetServiceStore_GetOrganizationPolicies_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationPolicies(ctx interface{}, orgId interface{}) *MockDatasetServiceStore_GetOrganizationPolicies_Call {
+	return &MockDatasetServiceStore_GetOrganizationPolicies_Call{Call: _e.mock.On("GetOrganizationPolicies", ctx, orgId)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationPolicies_Call) Run(run func(ctx context.Context, orgId uuid.UUID)) *MockDatasetServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPoliciesByEmail provides a mock function with given fields: ctx, organizationId, email
+func (_m *MockDatasetServiceStore) GetOrganizationPoliciesByEmail(ctx context.Context, organizationId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, organizationId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPoliciesByEmail'
+type MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - email string
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationPoliciesByEmail(ctx interface{}, organizationId interface{}, email interface{}) *MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call {
+	return &MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call{Call: _e.mock.On("GetOrganizationPoliciesByEmail", ctx, organizationId, email)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, email string)) *MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicyByUser provides a mock function with given fields: ctx, organizationId, userId
+func (_m *MockDatasetServiceStore) GetOrganizationPolicyByUser(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicyByUser")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationPolicyByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicyByUser'
+type MockDatasetServiceStore_GetOrganizationPolicyByUser_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicyByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationPolicyByUser(ctx interface{}, organizationId interface{}, userId interface{}) *MockDatasetServiceStore_GetOrganizationPolicyByUser_Call {
+	return &MockDatasetServiceStore_GetOrganizationPolicyByUser_Call{Call: _e.mock.On("GetOrganizationPolicyByUser", ctx, organizationId, userId)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationPolicyByUser_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID)) *MockDatasetServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationPolicyByUser_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationPolicyByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationSSOConfigsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockDatasetServiceStore) GetOrganizationSSOConfigsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationSSOConfigsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationSSOConfigsByOrganizationId'
+type MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationSSOConfigsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationSSOConfigsByOrganizationId(ctx interface{}, organizationId interface{}) *MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	return &MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationSSOConfigsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) Return(_a0 []models.OrganizationSSOConfig, _a1 error) *MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)) *MockDatasetServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationsAll provides a mock function with given fields: ctx
+func (_m *MockDatasetServiceStore) GetOrganizationsAll(ctx context.Context) ([]models.Organization, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationsAll")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Organization, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Organization); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsAll'
+type MockDatasetServiceStore_GetOrganizationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationsAll(ctx interface{}) *MockDatasetServiceStore_GetOrganizationsAll_Call {
+	return &MockDatasetServiceStore_GetOrganizationsAll_Call{Call: _e.mock.On("GetOrganizationsAll", ctx)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationsAll_Call) Run(run func(ctx context.Context)) *MockDatasetServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationsAll_Call) Return(_a0 []models.Organization, _a1 error) *MockDatasetServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationsAll_Call) RunAndReturn(run func(context.Context) ([]models.Organization, error)) *MockDatasetServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationsByMemberId provides a mock function with given fields: ctx, memberId
+func (_m *MockDatasetServiceStore) GetOrganizationsByMemberId(ctx context.Context, memberId uuid.UUID) ([]models.Organization, error) {
+	ret := _m.Called(ctx, memberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationsByMemberId")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Organization, error)); ok {
+		return rf(ctx, memberId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Organization); ok {
+		r0 = rf(ctx, memberId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, memberId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetOrganizationsByMemberId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsByMemberId'
+type MockDatasetServiceStore_GetOrganizationsByMemberId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationsByMemberId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - memberId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetOrganizationsByMemberId(ctx interface{}, memberId interface{}) *MockDatasetServiceStore_GetOrganizationsByMemberId_Call {
+	return &MockDatasetServiceStore_GetOrganizationsByMemberId_Call{Call: _e.mock.On("GetOrganizationsByMemberId", ctx, memberId)}
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationsByMemberId_Call) Run(run func(ctx context.Context, memberId uuid.UUID)) *MockDatasetServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationsByMemberId_Call) Return(_a0 []models.Organization, _a1 error) *MockDatasetServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetOrganizationsByMemberId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Organization, error)) *MockDatasetServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrimarySSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockDatasetServiceStore) GetPrimarySSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrimarySSOConfigByDomain")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrimarySSOConfigByDomain'
+type MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call struct {
+	*mock.Call
+}
+
+// GetPrimarySSOConfigByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domain string
+func (_e *MockDatasetServiceStore_Expecter) GetPrimarySSOConfigByDomain(ctx interface{}, domain interface{}) *MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call {
+	return &MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call{Call: _e.mock.On("GetPrimarySSOConfigByDomain", ctx, domain)}
+}
+
+func (_c *MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockDatasetServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferenceBanks provides a mock function with given fields: ctx
+func (_m *MockDatasetServiceStore) GetReferenceBanks(ctx context.Context) ([]models.ReferenceBank, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferenceBanks")
+	}
+
+	var r0 []models.ReferenceBank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ReferenceBank, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ReferenceBank); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReferenceBank)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetReferenceBanks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferenceBanks'
+type MockDatasetServiceStore_GetReferenceBanks_Call struct {
+	*mock.Call
+}
+
+// GetReferenceBanks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatasetServiceStore_Expecter) GetReferenceBanks(ctx interface{}) *MockDatasetServiceStore_GetReferenceBanks_Call {
+	return &MockDatasetServiceStore_GetReferenceBanks_Call{Call: _e.mock.On("GetReferenceBanks", ctx)}
+}
+
+func (_c *MockDatasetServiceStore_GetReferenceBanks_Call) Run(run func(ctx context.Context)) *MockDatasetServiceStore_GetReferenceBanks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetReferenceBanks_Call) Return(_a0 []models.ReferenceBank, _a1 error) *MockDatasetServiceStore_GetReferenceBanks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetReferenceBanks_Call) RunAndReturn(run func(context.Context) ([]models.ReferenceBank, error)) *MockDatasetServiceStore_GetReferenceBanks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockDatasetServiceStore) GetSSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSSOConfigByDomain")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetSSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSSOConfigByDomain'
+type MockDatasetServiceStore_GetSSOConfigByDomain_Call struct {
+	*mock.Call
+}
+
+// GetSSOConfigByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domain string
+func (_e *MockDatasetServiceStore_Expecter) GetSSOConfigByDomain(ctx interface{}, domain interface{}) *MockDatasetServiceStore_GetSSOConfigByDomain_Call {
+	return &MockDatasetServiceStore_GetSSOConfigByDomain_Call{Call: _e.mock.On("GetSSOConfigByDomain", ctx, domain)}
+}
+
+func (_c *MockDatasetServiceStore_GetSSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockDatasetServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetSSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockDatasetServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetSSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockDatasetServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagById provides a mock function with given fields: ctx, tagId
+func (_m *MockDatasetServiceStore) GetTagById(ctx context.Context, tagId uuid.UUID) (models.Tag, error) {
+	ret := _m.Called(ctx, tagId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagById")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Tag, error)); ok {
+		return rf(ctx, tagId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Tag); ok {
+		r0 = rf(ctx, tagId)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, tagId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetTagById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagById'
+type MockDatasetServiceStore_GetTagById_Call struct {
+	*mock.Call
+}
+
+// GetTagById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetTagById(ctx interface{}, tagId interface{}) *MockDatasetServiceStore_GetTagById_Call {
+	return &MockDatasetServiceStore_GetTagById_Call{Call: _e.mock.On("GetTagById", ctx, tagId)}
+}
+
+func (_c *MockDatasetServiceStore_GetTagById_Call) Run(run func(ctx context.Context, tagId uuid.UUID)) *MockDatasetServiceStore_GetTagById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTagById_Call) Return(_a0 models.Tag, _a1 error) *MockDatasetServiceStore_GetTagById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTagById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Tag, error)) *MockDatasetServiceStore_GetTagById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: ctx
+func (_m *MockDatasetServiceStore) GetTags(ctx context.Context) ([]models.Tag, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Tag, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Tag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockDatasetServiceStore_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatasetServiceStore_Expecter) GetTags(ctx interface{}) *MockDatasetServiceStore_GetTags_Call {
+	return &MockDatasetServiceStore_GetTags_Call{Call: _e.mock.On("GetTags", ctx)}
+}
+
+func (_c *MockDatasetServiceStore_GetTags_Call) Run(run func(ctx context.Context)) *MockDatasetServiceStore_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTags_Call) Return(_a0 []models.Tag, _a1 error) *MockDatasetServiceStore_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTags_Call) RunAndReturn(run func(context.Context) ([]models.Tag, error)) *MockDatasetServiceStore_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeam provides a mock function with given fields: ctx, organizationId, teamId
+func (_m *MockDatasetServiceStore) GetTeam(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID) (*models.Team, error) {
+	ret := _m.Called(ctx, organizationId, teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeam")
+	}
+
+	var r0 *models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.Team, error)); ok {
+		return rf(ctx, organizationId, teamId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.Team); ok {
+		r0 = rf(ctx, organizationId, teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId, teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeam'
+type MockDatasetServiceStore_GetTeam_Call struct {
+	*mock.Call
+}
+
+// GetTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - teamId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetTeam(ctx interface{}, organizationId interface{}, teamId interface{}) *MockDatasetServiceStore_GetTeam_Call {
+	return &MockDatasetServiceStore_GetTeam_Call{Call: _e.mock.On("GetTeam", ctx, organizationId, teamId)}
+}
+
+func (_c *MockDatasetServiceStore_GetTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID)) *MockDatasetServiceStore_GetTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeam_Call) Return(_a0 *models.Team, _a1 error) *MockDatasetServiceStore_GetTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.Team, error)) *MockDatasetServiceStore_GetTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamByName provides a mock function with given fields: ctx, organizationId, name
+func (_m *MockDatasetServiceStore) GetTeamByName(ctx context.Context, organizationId uuid.UUID, name string) ([]models.Team, error) {
+	ret := _m.Called(ctx, organizationId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamByName")
+	}
+
+	var r0 []models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.Team, error)); ok {
+		return rf(ctx, organizationId, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.Team); ok {
+		r0 = rf(ctx, organizationId, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, organizationId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetTeamByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamByName'
+type MockDatasetServiceStore_GetTeamByName_Call struct {
+	*mock.Call
+}
+
+// GetTeamByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - name string
+func (_e *MockDatasetServiceStore_Expecter) GetTeamByName(ctx interface{}, organizationId interface{}, name interface{}) *MockDatasetServiceStore_GetTeamByName_Call {
+	return &MockDatasetServiceStore_GetTeamByName_Call{Call: _e.mock.On("GetTeamByName", ctx, organizationId, name)}
+}
+
+func (_c *MockDatasetServiceStore_GetTeamByName_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, name string)) *MockDatasetServiceStore_GetTeamByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeamByName_Call) Return(_a0 []models.Team, _a1 error) *MockDatasetServiceStore_GetTeamByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeamByName_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.Team, error)) *MockDatasetServiceStore_GetTeamByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamMembershipById provides a mock function with given fields: ctx, teamMembershipId
+func (_m *MockDatasetServiceStore) GetTeamMembershipById(ctx context.Context, teamMembershipId uuid.UUID) (*models.TeamMembership, error) {
+	ret := _m.Called(ctx, teamMembershipId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamMembershipById")
+	}
+
+	var r0 *models.TeamMembership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.TeamMembership, error)); ok {
+		return rf(ctx, teamMembershipId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.TeamMembership); ok {
+		r0 = rf(ctx, teamMembershipId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TeamMembership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamMembershipId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetTeamMembershipById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMembershipById'
+type MockDatasetServiceStore_GetTeamMembershipById_Call struct {
+	*mock.Call
+}
+
+// GetTeamMembershipById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamMembershipId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetTeamMembershipById(ctx interface{}, teamMembershipId interface{}) *MockDatasetServiceStore_GetTeamMembershipById_Call {
+	return &MockDatasetServiceStore_GetTeamMembershipById_Call{Call: _e.mock.On("GetTeamMembershipById", ctx, teamMembershipId)}
+}
+
+func (_c *MockDatasetServiceStore_GetTeamMembershipById_Call) Run(run func(ctx context.Context, teamMembershipId uuid.UUID)) *MockDatasetServiceStore_GetTeamMembershipById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeamMembershipById_Call) Return(_a0 *models.TeamMembership, _a1 error) *MockDatasetServiceStore_GetTeamMembershipById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeamMembershipById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.TeamMembership, error)) *MockDatasetServiceStore_GetTeamMembershipById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamMembershipByUserIdTeamId provides a mock function with given fields: ctx, userId, teamId
+func (_m *MockDatasetServiceStore) GetTeamMembershipByUserIdTeamId(ctx context.Context, userId uuid.UUID, teamId uuid.UUID) (*models.TeamMembership, error) {
+	ret := _m.Called(ctx, userId, teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamMembershipByUserIdTeamId")
+	}
+
+	var r0 *models.TeamMembership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)); ok {
+		return rf(ctx, userId, teamId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.TeamMembership); ok {
+		r0 = rf(ctx, userId, teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TeamMembership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMembershipByUserIdTeamId'
+type MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call struct {
+	*mock.Call
+}
+
+// GetTeamMembershipByUserIdTeamId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - teamId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetTeamMembershipByUserIdTeamId(ctx interface{}, userId interface{}, teamId interface{}) *MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call {
+	return &MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call{Call: _e.mock.On("GetTeamMembershipByUserIdTeamId", ctx, userId, teamId)}
+}
+
+func (_c *MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call) Run(run func(ctx context.Context, userId uuid.UUID, teamId uuid.UUID)) *MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call) Return(_a0 *models.TeamMembership, _a1 error) *MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)) *MockDatasetServiceStore_GetTeamMembershipByUserIdTeamId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamMemberships provides a mock function with given fields: ctx, teamId
+func (_m *MockDatasetServiceStore) GetTeamMemberships(ctx context.Context, teamId uuid.UUID) ([]models.TeamMembership, error) {
+	ret := _m.Called(ctx, teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamMemberships")
+	}
+
+	var r0 []models.TeamMembership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.TeamMembership, error)); ok {
+		return rf(ctx, teamId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.TeamMembership); ok {
+		r0 = rf(ctx, teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TeamMembership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetTeamMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMemberships'
+type MockDatasetServiceStore_GetTeamMemberships_Call struct {
+	*mock.Call
+}
+
+// GetTeamMemberships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetTeamMemberships(ctx interface{}, teamId interface{}) *MockDatasetServiceStore_GetTeamMemberships_Call {
+	return &MockDatasetServiceStore_GetTeamMemberships_Call{Call: _e.mock.On("GetTeamMemberships", ctx, teamId)}
+}
+
+func (_c *MockDatasetServiceStore_GetTeamMemberships_Call) Run(run func(ctx context.Context, teamId uuid.UUID)) *MockDatasetServiceStore_GetTeamMemberships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeamMemberships_Call) Return(_a0 []models.TeamMembership, _a1 error) *MockDatasetServiceStore_GetTeamMemberships_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeamMemberships_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.TeamMembership, error)) *MockDatasetServiceStore_GetTeamMemberships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeams provides a mock function with given fields: ctx, organizationId
+func (_m *MockDatasetServiceStore) GetTeams(ctx context.Context, organizationId uuid.UUID) ([]models.Team, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeams")
+	}
+
+	var r0 []models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Team, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Team); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_GetTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeams'
+type MockDatasetServiceStore_GetTeams_Call struct {
+	*mock.Call
+}
+
+// GetTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) GetTeams(ctx interface{}, organizationId interface{}) *MockDatasetServiceStore_GetTeams_Call {
+	return &MockDatasetServiceStore_GetTeams_Call{Call: _e.mock.On("GetTeams", ctx, organizationId)}
+}
+
+func (_c *MockDatasetServiceStore_GetTeams_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockDatasetServiceStore_GetTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeams_Call) Return(_a0 []models.Team, _a1 error) *MockDatasetServiceStore_GetTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_GetTeams_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Team, error)) *MockDatasetServiceStore_GetTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDatasetAlertEventNotified provides a mock function with given fields: ctx, eventId
+func (_m *MockDatasetServiceStore) MarkDatasetAlertEventNotified(ctx context.Context, eventId uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, eventId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDatasetAlertEventNotified")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, eventId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, eventId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, eventId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDatasetAlertEventNotified'
+type MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call struct {
+	*mock.Call
+}
+
+// MarkDatasetAlertEventNotified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) MarkDatasetAlertEventNotified(ctx interface{}, eventId interface{}) *MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call {
+	return &MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call{Call: _e.mock.On("MarkDatasetAlertEventNotified", ctx, eventId)}
+}
+
+func (_c *MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call) Run(run func(ctx context.Context, eventId uuid.UUID)) *MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call) Return(_a0 bool, _a1 error) *MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockDatasetServiceStore_MarkDatasetAlertEventNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeTags provides a mock function with given fields: ctx, sourceTagId, targetTagId, targetAliases, updatedBy
+func (_m *MockDatasetServiceStore) MergeTags(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, sourceTagId, targetTagId, targetAliases, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []string, uuid.UUID) error); ok {
+		r0 = rf(ctx, sourceTagId, targetTagId, targetAliases, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_MergeTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTags'
+type MockDatasetServiceStore_MergeTags_Call struct {
+	*mock.Call
+}
+
+// MergeTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceTagId uuid.UUID
+//   - targetTagId uuid.UUID
+//   - targetAliases []string
+//   - updatedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) MergeTags(ctx interface{}, sourceTagId interface{}, targetTagId interface{}, targetAliases interface{}, updatedBy interface{}) *MockDatasetServiceStore_MergeTags_Call {
+	return &MockDatasetServiceStore_MergeTags_Call{Call: _e.mock.On("MergeTags", ctx, sourceTagId, targetTagId, targetAliases, updatedBy)}
+}
+
+func (_c *MockDatasetServiceStore_MergeTags_Call) Run(run func(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID)) *MockDatasetServiceStore_MergeTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]string), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_MergeTags_Call) Return(_a0 error) *MockDatasetServiceStore_MergeTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_MergeTags_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []string, uuid.UUID) error) *MockDatasetServiceStore_MergeTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTag provides a mock function with given fields: ctx, tagId, name, path, aliases, updatedBy
+func (_m *MockDatasetServiceStore) RenameTag(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, tagId, name, path, aliases, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, []string, uuid.UUID) error); ok {
+		r0 = rf(ctx, tagId, name, path, aliases, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_RenameTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTag'
+type MockDatasetServiceStore_RenameTag_Call struct {
+	*mock.Call
+}
+
+// RenameTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - name string
+//   - path string
+//   - aliases []string
+//   - updatedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) RenameTag(ctx interface{}, tagId interface{}, name interface{}, path interface{}, aliases interface{}, updatedBy interface{}) *MockDatasetServiceStore_RenameTag_Call {
+	return &MockDatasetServiceStore_RenameTag_Call{Call: _e.mock.On("RenameTag", ctx, tagId, name, path, aliases, updatedBy)}
+}
+
+func (_c *MockDatasetServiceStore_RenameTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID)) *MockDatasetServiceStore_RenameTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].([]string), args[5].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_RenameTag_Call) Return(_a0 error) *MockDatasetServiceStore_RenameTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_RenameTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, []string, uuid.UUID) error) *MockDatasetServiceStore_RenameTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId, viewId
+func (_m *MockDatasetServiceStore) SetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) (models.DatasetViewDefault, error) {
+	ret := _m.Called(ctx, userId, datasetId, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDefaultDatasetView")
+	}
+
+	var r0 models.DatasetViewDefault
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (models.DatasetViewDefault, error)); ok {
+		return rf(ctx, userId, datasetId, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) models.DatasetViewDefault); ok {
+		r0 = rf(ctx, userId, datasetId, viewId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetViewDefault)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_SetDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDefaultDatasetView'
+type MockDatasetServiceStore_SetDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// SetDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) SetDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}, viewId interface{}) *MockDatasetServiceStore_SetDefaultDatasetView_Call {
+	return &MockDatasetServiceStore_SetDefaultDatasetView_Call{Call: _e.mock.On("SetDefaultDatasetView", ctx, userId, datasetId, viewId)}
+}
+
+func (_c *MockDatasetServiceStore_SetDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID)) *MockDatasetServiceStore_SetDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_SetDefaultDatasetView_Call) Return(_a0 models.DatasetViewDefault, _a1 error) *MockDatasetServiceStore_SetDefaultDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_SetDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (models.DatasetViewDefault, error)) *MockDatasetServiceStore_SetDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockDatasetServiceStore) UpdateDataset(ctx context.Context, dataset models.Dataset) (uuid.UUID, error) {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDataset")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) (uuid.UUID, error)); ok {
+		return rf(ctx, dataset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) uuid.UUID); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Dataset) error); ok {
+		r1 = rf(ctx, dataset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataset'
+type MockDatasetServiceStore_UpdateDataset_Call struct {
+	*mock.Call
+}
+
+// UpdateDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset models.Dataset
+func (_e *MockDatasetServiceStore_Expecter) UpdateDataset(ctx interface{}, dataset interface{}) *MockDatasetServiceStore_UpdateDataset_Call {
+	return &MockDatasetServiceStore_UpdateDataset_Call{Call: _e.mock.On("UpdateDataset", ctx, dataset)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockDatasetServiceStore_UpdateDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Dataset))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDataset_Call) Return(_a0 uuid.UUID, _a1 error) *MockDatasetServiceStore_UpdateDataset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) (uuid.UUID, error)) *MockDatasetServiceStore_UpdateDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetActionConfig provides a mock function with given fields: ctx, actionId, config
+func (_m *MockDatasetServiceStore) UpdateDatasetActionConfig(ctx context.Context, actionId string, config map[string]interface{}) error {
+	ret := _m.Called(ctx, actionId, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetActionConfig")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) error); ok {
+		r0 = rf(ctx, actionId, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_UpdateDatasetActionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionConfig'
+type MockDatasetServiceStore_UpdateDatasetActionConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetActionConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actionId string
+//   - config map[string]interface{}
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetActionConfig(ctx interface{}, actionId interface{}, config interface{}) *MockDatasetServiceStore_UpdateDatasetActionConfig_Call {
+	return &MockDatasetServiceStore_UpdateDatasetActionConfig_Call{Call: _e.mock.On("UpdateDatasetActionConfig", ctx, actionId, config)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetActionConfig_Call) Run(run func(ctx context.Context, actionId string, config map[string]interface{})) *MockDatasetServiceStore_UpdateDatasetActionConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetActionConfig_Call) Return(_a0 error) *MockDatasetServiceStore_UpdateDatasetActionConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetActionConfig_Call) RunAndReturn(run func(context.Context, string, map[string]interface{}) error) *MockDatasetServiceStore_UpdateDatasetActionConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetActionStatus provides a mock function with given fields: ctx, actionId, status
+func (_m *MockDatasetServiceStore) UpdateDatasetActionStatus(ctx context.Context, actionId string, status string) error {
+	ret := _m.Called(ctx, actionId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetActionStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, actionId, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_UpdateDatasetActionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionStatus'
+type MockDatasetServiceStore_UpdateDatasetActionStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetActionStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actionId string
+//   - status string
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetActionStatus(ctx interface{}, actionId interface{}, status interface{}) *MockDatasetServiceStore_UpdateDatasetActionStatus_Call {
+	return &MockDatasetServiceStore_UpdateDatasetActionStatus_Call{Call: _e.mock.On("UpdateDatasetActionStatus", ctx, actionId, status)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetActionStatus_Call) Run(run func(ctx context.Context, actionId string, status string)) *MockDatasetServiceStore_UpdateDatasetActionStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetActionStatus_Call) Return(_a0 error) *MockDatasetServiceStore_UpdateDatasetActionStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetActionStatus_Call) RunAndReturn(run func(context.Context, string, string) error) *MockDatasetServiceStore_UpdateDatasetActionStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetAlert provides a mock function with given fields: ctx, alertId, params
+func (_m *MockDatasetServiceStore) UpdateDatasetAlert(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertParams) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, alertId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetAlert")
+	}
+
+	var r0 models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) (models.DatasetAlert, error)); ok {
+		return rf(ctx, alertId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) models.DatasetAlert); ok {
+		r0 = rf(ctx, alertId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) error); ok {
+		r1 = rf(ctx, alertId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlert'
+type MockDatasetServiceStore_UpdateDatasetAlert_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - params models.UpdateDatasetAlertParams
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetAlert(ctx interface{}, alertId interface{}, params interface{}) *MockDatasetServiceStore_UpdateDatasetAlert_Call {
+	return &MockDatasetServiceStore_UpdateDatasetAlert_Call{Call: _e.mock.On("UpdateDatasetAlert", ctx, alertId, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetAlert_Call) Run(run func(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertParams)) *MockDatasetServiceStore_UpdateDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetAlertParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetAlert_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockDatasetServiceStore_UpdateDatasetAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) (models.DatasetAlert, error)) *MockDatasetServiceStore_UpdateDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetAlertSnooze provides a mock function with given fields: ctx, alertId, snoozedUntil, updatedBy
+func (_m *MockDatasetServiceStore) UpdateDatasetAlertSnooze(ctx context.Context, alertId uuid.UUID, snoozedUntil *time.Time, updatedBy uuid.UUID) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, alertId, snoozedUntil, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetAlertSnooze")
+	}
+
+	var r0 models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, uuid.UUID) (models.DatasetAlert, error)); ok {
+		return rf(ctx, alertId, snoozedUntil, updatedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, uuid.UUID) models.DatasetAlert); ok {
+		r0 = rf(ctx, alertId, snoozedUntil, updatedBy)
+	} else {
+		r0 = ret.Get(0).(models.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time, uuid.UUID) error); ok {
+		r1 = rf(ctx, alertId, snoozedUntil, updatedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlertSnooze'
+type MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetAlertSnooze is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - snoozedUntil *time.Time
+//   - updatedBy uuid.UUID
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetAlertSnooze(ctx interface{}, alertId interface{}, snoozedUntil interface{}, updatedBy interface{}) *MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call {
+	return &MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call{Call: _e.mock.On("UpdateDatasetAlertSnooze", ctx, alertId, snoozedUntil, updatedBy)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call) Run(run func(ctx context.Context, alertId uuid.UUID, snoozedUntil *time.Time, updatedBy uuid.UUID)) *MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*time.Time), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call) RunAndReturn(run func(context.Context, uuid.UUID, *time.Time, uuid.UUID) (models.DatasetAlert, error)) *MockDatasetServiceStore_UpdateDatasetAlertSnooze_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetAlertState provides a mock function with given fields: ctx, alertId, params
+func (_m *MockDatasetServiceStore) UpdateDatasetAlertState(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertStateParams) error {
+	ret := _m.Called(ctx, alertId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetAlertState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertStateParams) error); ok {
+		r0 = rf(ctx, alertId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_UpdateDatasetAlertState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlertState'
+type MockDatasetServiceStore_UpdateDatasetAlertState_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetAlertState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - params models.UpdateDatasetAlertStateParams
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetAlertState(ctx interface{}, alertId interface{}, params interface{}) *MockDatasetServiceStore_UpdateDatasetAlertState_Call {
+	return &MockDatasetServiceStore_UpdateDatasetAlertState_Call{Call: _e.mock.On("UpdateDatasetAlertState", ctx, alertId, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetAlertState_Call) Run(run func(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertStateParams)) *MockDatasetServiceStore_UpdateDatasetAlertState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetAlertStateParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetAlertState_Call) Return(_a0 error) *MockDatasetServiceStore_UpdateDatasetAlertState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetAlertState_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetAlertStateParams) error) *MockDatasetServiceStore_UpdateDatasetAlertState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetColumnPolicy provides a mock function with given fields: ctx, policyId, params
+func (_m *MockDatasetServiceStore) UpdateDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, policyId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetColumnPolicy")
+	}
+
+	var r0 models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, policyId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, policyId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetColumnPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) error); ok {
+		r1 = rf(ctx, policyId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetColumnPolicy'
+type MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetColumnPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - params models.UpdateDatasetColumnPolicyParams
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetColumnPolicy(ctx interface{}, policyId interface{}, params interface{}) *MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call {
+	return &MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call{Call: _e.mock.On("UpdateDatasetColumnPolicy", ctx, policyId, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetColumnPolicyParams)) *MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetColumnPolicyParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call) Return(_a0 models.DatasetColumnPolicy, _a1 error) *MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)) *MockDatasetServiceStore_UpdateDatasetColumnPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetExportSchedule provides a mock function with given fields: ctx, scheduleId, params
+func (_m *MockDatasetServiceStore) UpdateDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, params models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, scheduleId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetExportSchedule")
+	}
+
+	var r0 models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, scheduleId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, scheduleId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetExportSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) error); ok {
+		r1 = rf(ctx, scheduleId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetExportSchedule'
+type MockDatasetServiceStore_UpdateDatasetExportSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetExportSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+//   - params models.UpdateDatasetExportScheduleParams
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetExportSchedule(ctx interface{}, scheduleId interface{}, params interface{}) *MockDatasetServiceStore_UpdateDatasetExportSchedule_Call {
+	return &MockDatasetServiceStore_UpdateDatasetExportSchedule_Call{Call: _e.mock.On("UpdateDatasetExportSchedule", ctx, scheduleId, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetExportSchedule_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID, params models.UpdateDatasetExportScheduleParams)) *MockDatasetServiceStore_UpdateDatasetExportSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetExportScheduleParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetExportSchedule_Call) Return(_a0 models.DatasetExportSchedule, _a1 error) *MockDatasetServiceStore_UpdateDatasetExportSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)) *MockDatasetServiceStore_UpdateDatasetExportSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetExportScheduleRun provides a mock function with given fields: ctx, runId, params
+func (_m *MockDatasetServiceStore) UpdateDatasetExportScheduleRun(ctx context.Context, runId uuid.UUID, params models.UpdateDatasetExportScheduleRunParams) error {
+	ret := _m.Called(ctx, runId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetExportScheduleRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleRunParams) error); ok {
+		r0 = rf(ctx, runId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetExportScheduleRun'
+type MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetExportScheduleRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - params models.UpdateDatasetExportScheduleRunParams
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetExportScheduleRun(ctx interface{}, runId interface{}, params interface{}) *MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call {
+	return &MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call{Call: _e.mock.On("UpdateDatasetExportScheduleRun", ctx, runId, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call) Run(run func(ctx context.Context, runId uuid.UUID, params models.UpdateDatasetExportScheduleRunParams)) *MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetExportScheduleRunParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call) Return(_a0 error) *MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleRunParams) error) *MockDatasetServiceStore_UpdateDatasetExportScheduleRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetFileUploadStatus provides a mock function with given fields: ctx, id, fileAllignmentStatus, metadata
+func (_m *MockDatasetServiceStore) UpdateDatasetFileUploadStatus(ctx context.Context, id uuid.UUID, fileAllignmentStatus models.DatasetFileAllignmentStatus, metadata models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error) {
+	ret := _m.Called(ctx, id, fileAllignmentStatus, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetFileUploadStatus")
+	}
+
+	var r0 *models.DatasetFileUpload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error)); ok {
+		return rf(ctx, id, fileAllignmentStatus, metadata)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) *models.DatasetFileUpload); ok {
+		r0 = rf(ctx, id, fileAllignmentStatus, metadata)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DatasetFileUpload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) error); ok {
+		r1 = rf(ctx, id, fileAllignmentStatus, metadata)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetFileUploadStatus'
+type MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetFileUploadStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - fileAllignmentStatus models.DatasetFileAllignmentStatus
+//   - metadata models.DatasetFileUploadMetadata
 func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetFileUploadStatus(ctx interface{}, id interface{}, fileAllignmentStatus interface{}, metadata interface{}) *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call {
 	return &MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call{Call: _e.mock.On("UpdateDatasetFileUploadStatus", ctx, id, fileAllignmentStatus, metadata)}
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, fileAllignmentStatus models.DatasetFileAllignmentStatus, metadata models.DatasetFileUploadMetadata)) *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call {
+func (_c *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, fileAllignmentStatus models.DatasetFileAllignmentStatus, metadata models.DatasetFileUploadMetadata)) *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetFileAllignmentStatus), args[3].(models.DatasetFileUploadMetadata))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call) Return(_a0 *models.DatasetFileUpload, _a1 error) *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error)) *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceId, privilege
+func (_m *MockDatasetServiceStore) UpdateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, datasetId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetPolicy'
+type MockDatasetServiceStore_UpdateDatasetPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceId interface{}, privilege interface{}) *MockDatasetServiceStore_UpdateDatasetPolicy_Call {
+	return &MockDatasetServiceStore_UpdateDatasetPolicy_Call{Call: _e.mock.On("UpdateDatasetPolicy", ctx, datasetId, audienceId, privilege)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetServiceStore_UpdateDatasetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_UpdateDatasetPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_UpdateDatasetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetRowPolicy provides a mock function with given fields: ctx, policyId, params
+func (_m *MockDatasetServiceStore) UpdateDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, policyId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetRowPolicy")
+	}
+
+	var r0 models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, policyId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, policyId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) error); ok {
+		r1 = rf(ctx, policyId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetRowPolicy'
+type MockDatasetServiceStore_UpdateDatasetRowPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - params models.UpdateDatasetRowPolicyParams
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetRowPolicy(ctx interface{}, policyId interface{}, params interface{}) *MockDatasetServiceStore_UpdateDatasetRowPolicy_Call {
+	return &MockDatasetServiceStore_UpdateDatasetRowPolicy_Call{Call: _e.mock.On("UpdateDatasetRowPolicy", ctx, policyId, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetRowPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetRowPolicyParams)) *MockDatasetServiceStore_UpdateDatasetRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetRowPolicyParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetRowPolicy_Call) Return(_a0 models.DatasetRowPolicy, _a1 error) *MockDatasetServiceStore_UpdateDatasetRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)) *MockDatasetServiceStore_UpdateDatasetRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetStatusWorkflow provides a mock function with given fields: ctx, workflowId, params
+func (_m *MockDatasetServiceStore) UpdateDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, params models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, workflowId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetStatusWorkflow")
+	}
+
+	var r0 models.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, workflowId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, workflowId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetStatusWorkflow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) error); ok {
+		r1 = rf(ctx, workflowId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetStatusWorkflow'
+type MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetStatusWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId uuid.UUID
+//   - params models.UpdateDatasetStatusWorkflowParams
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetStatusWorkflow(ctx interface{}, workflowId interface{}, params interface{}) *MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call {
+	return &MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call{Call: _e.mock.On("UpdateDatasetStatusWorkflow", ctx, workflowId, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, workflowId uuid.UUID, params models.UpdateDatasetStatusWorkflowParams)) *MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetStatusWorkflowParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call) Return(_a0 models.DatasetStatusWorkflow, _a1 error) *MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)) *MockDatasetServiceStore_UpdateDatasetStatusWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetView provides a mock function with given fields: ctx, viewId, params
+func (_m *MockDatasetServiceStore) UpdateDatasetView(ctx context.Context, viewId uuid.UUID, params models.UpdateDatasetViewParams) (models.DatasetView, error) {
+	ret := _m.Called(ctx, viewId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetView")
+	}
+
+	var r0 models.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) (models.DatasetView, error)); ok {
+		return rf(ctx, viewId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) models.DatasetView); ok {
+		r0 = rf(ctx, viewId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetView)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) error); ok {
+		r1 = rf(ctx, viewId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetView'
+type MockDatasetServiceStore_UpdateDatasetView_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - params models.UpdateDatasetViewParams
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetView(ctx interface{}, viewId interface{}, params interface{}) *MockDatasetServiceStore_UpdateDatasetView_Call {
+	return &MockDatasetServiceStore_UpdateDatasetView_Call{Call: _e.mock.On("UpdateDatasetView", ctx, viewId, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetView_Call) Run(run func(ctx context.Context, viewId uuid.UUID, params models.UpdateDatasetViewParams)) *MockDatasetServiceStore_UpdateDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetViewParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetView_Call) Return(_a0 models.DatasetView, _a1 error) *MockDatasetServiceStore_UpdateDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) (models.DatasetView, error)) *MockDatasetServiceStore_UpdateDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceId, privilege
+func (_m *MockDatasetServiceStore) UpdateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetViewPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, viewId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetViewPolicy'
+type MockDatasetServiceStore_UpdateDatasetViewPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetViewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceId interface{}, privilege interface{}) *MockDatasetServiceStore_UpdateDatasetViewPolicy_Call {
+	return &MockDatasetServiceStore_UpdateDatasetViewPolicy_Call{Call: _e.mock.On("UpdateDatasetViewPolicy", ctx, viewId, audienceId, privilege)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetServiceStore_UpdateDatasetViewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetViewPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_UpdateDatasetViewPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_UpdateDatasetViewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationCalendarSettings provides a mock function with given fields: ctx, organizationId, calendarSettings
+func (_m *MockDatasetServiceStore) UpdateOrganizationCalendarSettings(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, calendarSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationCalendarSettings")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, calendarSettings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, calendarSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, calendarSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationCalendarSettings'
+type MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationCalendarSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - calendarSettings json.RawMessage
+func (_e *MockDatasetServiceStore_Expecter) UpdateOrganizationCalendarSettings(ctx interface{}, organizationId interface{}, calendarSettings interface{}) *MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call {
+	return &MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call{Call: _e.mock.On("UpdateOrganizationCalendarSettings", ctx, organizationId, calendarSettings)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage)) *MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockDatasetServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationExportSettings provides a mock function with given fields: ctx, organizationId, exportSettings
+func (_m *MockDatasetServiceStore) UpdateOrganizationExportSettings(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, exportSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationExportSettings")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, exportSettings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, exportSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, exportSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateOrganizationExportSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationExportSettings'
+type MockDatasetServiceStore_UpdateOrganizationExportSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationExportSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - exportSettings json.RawMessage
+func (_e *MockDatasetServiceStore_Expecter) UpdateOrganizationExportSettings(ctx interface{}, organizationId interface{}, exportSettings interface{}) *MockDatasetServiceStore_UpdateOrganizationExportSettings_Call {
+	return &MockDatasetServiceStore_UpdateOrganizationExportSettings_Call{Call: _e.mock.On("UpdateOrganizationExportSettings", ctx, organizationId, exportSettings)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationExportSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage)) *MockDatasetServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationExportSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockDatasetServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationExportSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockDatasetServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
+func (_m *MockDatasetServiceStore) UpdateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
+	ret := _m.Called(ctx, invitationId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationInvitationStatus")
+	}
+
+	var r0 *models.OrganizationInvitationStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
+		return rf(ctx, invitationId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
+		r0 = rf(ctx, invitationId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
+		r1 = rf(ctx, invitationId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationStatus'
+type MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationInvitationStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+//   - status models.InvitationStatus
+func (_e *MockDatasetServiceStore_Expecter) UpdateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call {
+	return &MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call{Call: _e.mock.On("UpdateOrganizationInvitationStatus", ctx, invitationId, status)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockDatasetServiceStore_UpdateOrganizationInvitationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId, privilege
+func (_m *MockDatasetServiceStore) UpdateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, orgId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationPolicy'
+type MockDatasetServiceStore_UpdateOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetServiceStore_Expecter) UpdateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}, privilege interface{}) *MockDatasetServiceStore_UpdateOrganizationPolicy_Call {
+	return &MockDatasetServiceStore_UpdateOrganizationPolicy_Call{Call: _e.mock.On("UpdateOrganizationPolicy", ctx, orgId, audienceId, privilege)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetServiceStore_UpdateOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_UpdateOrganizationPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_UpdateOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePendingOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
+func (_m *MockDatasetServiceStore) UpdatePendingOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId, userId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePendingOrganizationMembershipRequest")
+	}
+
+	var r0 *models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId, userId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId, userId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
+		r1 = rf(ctx, organizationId, userId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePendingOrganizationMembershipRequest'
+type MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call struct {
+	*mock.Call
+}
+
+// UpdatePendingOrganizationMembershipRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+//   - status models.OrgMembershipStatus
+func (_e *MockDatasetServiceStore_Expecter) UpdatePendingOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	return &MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call{Call: _e.mock.On("UpdatePendingOrganizationMembershipRequest", ctx, organizationId, userId, status)}
+}
+
+func (_c *MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockDatasetServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, tagId, params
+func (_m *MockDatasetServiceStore) UpdateTag(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams) (models.Tag, error) {
+	ret := _m.Called(ctx, tagId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateTagParams) (models.Tag, error)); ok {
+		return rf(ctx, tagId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateTagParams) models.Tag); ok {
+		r0 = rf(ctx, tagId, params)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateTagParams) error); ok {
+		r1 = rf(ctx, tagId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type MockDatasetServiceStore_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - params models.UpdateTagParams
+func (_e *MockDatasetServiceStore_Expecter) UpdateTag(ctx interface{}, tagId interface{}, params interface{}) *MockDatasetServiceStore_UpdateTag_Call {
+	return &MockDatasetServiceStore_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, tagId, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpdateTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams)) *MockDatasetServiceStore_UpdateTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetFileAllignmentStatus), args[3].(models.DatasetFileUploadMetadata))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateTagParams))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call) Return(_a0 *models.DatasetFileUpload, _a1 error) *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call {
+func (_c *MockDatasetServiceStore_UpdateTag_Call) Return(_a0 models.Tag, _a1 error) *MockDatasetServiceStore_UpdateTag_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error)) *MockDatasetServiceStore_UpdateDatasetFileUploadStatus_Call {
+func (_c *MockDatasetServiceStore_UpdateTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateTagParams) (models.Tag, error)) *MockDatasetServiceStore_UpdateTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceId, privilege
-func (_m *MockDatasetServiceStore) UpdateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId, audienceId, privilege)
+// UpdateTeam provides a mock function with given fields: ctx, organizationId, team
+func (_m *MockDatasetServiceStore) UpdateTeam(ctx context.Context, organizationId uuid.UUID, team models.Team) (*models.Team, error) {
+	ret := _m.Called(ctx, organizationId, team)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetPolicy")
+		panic("no return value specified for UpdateTeam")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
+	var r0 *models.Team
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) (*models.Team, error)); ok {
+		return rf(ctx, organizationId, team)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) *models.Team); ok {
+		r0 = rf(ctx, organizationId, team)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+			r0 = ret.Get(0).(*models.Team)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, datasetId, audienceId, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.Team) error); ok {
+		r1 = rf(ctx, organizationId, team)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1184,33 +6721,183 @@ func (_m *MockDatasetServiceStore) UpdateDatasetPolicy(ctx context.Context, data
 	return r0, r1
 }
 
-// MockDatasetServiceStore_UpdateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetPolicy'
-type MockDatasetServiceStore_UpdateDatasetPolicy_Call struct {
+// MockDatasetServiceStore_UpdateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeam'
+type MockDatasetServiceStore_UpdateTeam_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetPolicy is a helper method to define mock.On call
+// UpdateTeam is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockDatasetServiceStore_Expecter) UpdateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceId interface{}, privilege interface{}) *MockDatasetServiceStore_UpdateDatasetPolicy_Call {
-	return &MockDatasetServiceStore_UpdateDatasetPolicy_Call{Call: _e.mock.On("UpdateDatasetPolicy", ctx, datasetId, audienceId, privilege)}
+//   - organizationId uuid.UUID
+//   - team models.Team
+func (_e *MockDatasetServiceStore_Expecter) UpdateTeam(ctx interface{}, organizationId interface{}, team interface{}) *MockDatasetServiceStore_UpdateTeam_Call {
+	return &MockDatasetServiceStore_UpdateTeam_Call{Call: _e.mock.On("UpdateTeam", ctx, organizationId, team)}
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetServiceStore_UpdateDatasetPolicy_Call {
+func (_c *MockDatasetServiceStore_UpdateTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, team models.Team)) *MockDatasetServiceStore_UpdateTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.Team))
 	})
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetServiceStore_UpdateDatasetPolicy_Call {
+func (_c *MockDatasetServiceStore_UpdateTeam_Call) Return(_a0 *models.Team, _a1 error) *MockDatasetServiceStore_UpdateTeam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDatasetServiceStore_UpdateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetServiceStore_UpdateDatasetPolicy_Call {
+func (_c *MockDatasetServiceStore_UpdateTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.Team) (*models.Team, error)) *MockDatasetServiceStore_UpdateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertDatasetRowAssignment provides a mock function with given fields: ctx, params
+func (_m *MockDatasetServiceStore) UpsertDatasetRowAssignment(ctx context.Context, params models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertDatasetRowAssignment")
+	}
+
+	var r0 models.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.UpsertDatasetRowAssignmentParams) models.DatasetRowAssignment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowAssignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.UpsertDatasetRowAssignmentParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetServiceStore_UpsertDatasetRowAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertDatasetRowAssignment'
+type MockDatasetServiceStore_UpsertDatasetRowAssignment_Call struct {
+	*mock.Call
+}
+
+// UpsertDatasetRowAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.UpsertDatasetRowAssignmentParams
+func (_e *MockDatasetServiceStore_Expecter) UpsertDatasetRowAssignment(ctx interface{}, params interface{}) *MockDatasetServiceStore_UpsertDatasetRowAssignment_Call {
+	return &MockDatasetServiceStore_UpsertDatasetRowAssignment_Call{Call: _e.mock.On("UpsertDatasetRowAssignment", ctx, params)}
+}
+
+func (_c *MockDatasetServiceStore_UpsertDatasetRowAssignment_Call) Run(run func(ctx context.Context, params models.UpsertDatasetRowAssignmentParams)) *MockDatasetServiceStore_UpsertDatasetRowAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.UpsertDatasetRowAssignmentParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpsertDatasetRowAssignment_Call) Return(_a0 models.DatasetRowAssignment, _a1 error) *MockDatasetServiceStore_UpsertDatasetRowAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_UpsertDatasetRowAssignment_Call) RunAndReturn(run func(context.Context, models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error)) *MockDatasetServiceStore_UpsertDatasetRowAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithDatasetAlertTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDatasetServiceStore) WithDatasetAlertTransaction(ctx context.Context, fn func(store.DatasetAlertStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDatasetAlertTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetAlertStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_WithDatasetAlertTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetAlertTransaction'
+type MockDatasetServiceStore_WithDatasetAlertTransaction_Call struct {
+	*mock.Call
+}
+
+// WithDatasetAlertTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.DatasetAlertStore) error
+func (_e *MockDatasetServiceStore_Expecter) WithDatasetAlertTransaction(ctx interface{}, fn interface{}) *MockDatasetServiceStore_WithDatasetAlertTransaction_Call {
+	return &MockDatasetServiceStore_WithDatasetAlertTransaction_Call{Call: _e.mock.On("WithDatasetAlertTransaction", ctx, fn)}
+}
+
+func (_c *MockDatasetServiceStore_WithDatasetAlertTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetAlertStore) error)) *MockDatasetServiceStore_WithDatasetAlertTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.DatasetAlertStore) error))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithDatasetAlertTransaction_Call) Return(_a0 error) *MockDatasetServiceStore_WithDatasetAlertTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithDatasetAlertTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetAlertStore) error) error) *MockDatasetServiceStore_WithDatasetAlertTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithDatasetExportScheduleTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDatasetServiceStore) WithDatasetExportScheduleTransaction(ctx context.Context, fn func(store.DatasetExportScheduleStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDatasetExportScheduleTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetExportScheduleStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetExportScheduleTransaction'
+type MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call struct {
+	*mock.Call
+}
+
+// WithDatasetExportScheduleTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.DatasetExportScheduleStore) error
+func (_e *MockDatasetServiceStore_Expecter) WithDatasetExportScheduleTransaction(ctx interface{}, fn interface{}) *MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call {
+	return &MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call{Call: _e.mock.On("WithDatasetExportScheduleTransaction", ctx, fn)}
+}
+
+func (_c *MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetExportScheduleStore) error)) *MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.DatasetExportScheduleStore) error))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call) Return(_a0 error) *MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetExportScheduleStore) error) error) *MockDatasetServiceStore_WithDatasetExportScheduleTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1262,6 +6949,147 @@ func (_c *MockDatasetServiceStore_WithDatasetTransaction_Call) RunAndReturn(run
 	return _c
 }
 
+// WithDatasetViewTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDatasetServiceStore) WithDatasetViewTransaction(ctx context.Context, fn func(store.DatasetViewStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDatasetViewTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetViewStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_WithDatasetViewTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetViewTransaction'
+type MockDatasetServiceStore_WithDatasetViewTransaction_Call struct {
+	*mock.Call
+}
+
+// WithDatasetViewTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.DatasetViewStore) error
+func (_e *MockDatasetServiceStore_Expecter) WithDatasetViewTransaction(ctx interface{}, fn interface{}) *MockDatasetServiceStore_WithDatasetViewTransaction_Call {
+	return &MockDatasetServiceStore_WithDatasetViewTransaction_Call{Call: _e.mock.On("WithDatasetViewTransaction", ctx, fn)}
+}
+
+func (_c *MockDatasetServiceStore_WithDatasetViewTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetViewStore) error)) *MockDatasetServiceStore_WithDatasetViewTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.DatasetViewStore) error))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithDatasetViewTransaction_Call) Return(_a0 error) *MockDatasetServiceStore_WithDatasetViewTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithDatasetViewTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetViewStore) error) error) *MockDatasetServiceStore_WithDatasetViewTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithOrganizationTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDatasetServiceStore) WithOrganizationTransaction(ctx context.Context, fn func(store.OrganizationStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithOrganizationTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.OrganizationStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_WithOrganizationTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithOrganizationTransaction'
+type MockDatasetServiceStore_WithOrganizationTransaction_Call struct {
+	*mock.Call
+}
+
+// WithOrganizationTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.OrganizationStore) error
+func (_e *MockDatasetServiceStore_Expecter) WithOrganizationTransaction(ctx interface{}, fn interface{}) *MockDatasetServiceStore_WithOrganizationTransaction_Call {
+	return &MockDatasetServiceStore_WithOrganizationTransaction_Call{Call: _e.mock.On("WithOrganizationTransaction", ctx, fn)}
+}
+
+func (_c *MockDatasetServiceStore_WithOrganizationTransaction_Call) Run(run func(ctx context.Context, fn func(store.OrganizationStore) error)) *MockDatasetServiceStore_WithOrganizationTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.OrganizationStore) error))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithOrganizationTransaction_Call) Return(_a0 error) *MockDatasetServiceStore_WithOrganizationTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithOrganizationTransaction_Call) RunAndReturn(run func(context.Context, func(store.OrganizationStore) error) error) *MockDatasetServiceStore_WithOrganizationTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTeamTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDatasetServiceStore) WithTeamTransaction(ctx context.Context, fn func(store.TeamStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTeamTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.TeamStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetServiceStore_WithTeamTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTeamTransaction'
+type MockDatasetServiceStore_WithTeamTransaction_Call struct {
+	*mock.Call
+}
+
+// WithTeamTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.TeamStore) error
+func (_e *MockDatasetServiceStore_Expecter) WithTeamTransaction(ctx interface{}, fn interface{}) *MockDatasetServiceStore_WithTeamTransaction_Call {
+	return &MockDatasetServiceStore_WithTeamTransaction_Call{Call: _e.mock.On("WithTeamTransaction", ctx, fn)}
+}
+
+func (_c *MockDatasetServiceStore_WithTeamTransaction_Call) Run(run func(ctx context.Context, fn func(store.TeamStore) error)) *MockDatasetServiceStore_WithTeamTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.TeamStore) error))
+	})
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithTeamTransaction_Call) Return(_a0 error) *MockDatasetServiceStore_WithTeamTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetServiceStore_WithTeamTransaction_Call) RunAndReturn(run func(context.Context, func(store.TeamStore) error) error) *MockDatasetServiceStore_WithTeamTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTx provides a mock function with given fields: ctx, fn
 func (_m *MockDatasetServiceStore) WithTx(ctx context.Context, fn func(store.Store) error) error {
 	ret := _m.Called(ctx, fn)
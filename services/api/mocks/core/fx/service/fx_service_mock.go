@@ -0,0 +1,500 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_service
+
+import (
+	context "context"
+	io "io"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Zampfi/application-platform/services/api/core/fx/models"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockFxService is an autogenerated mock type for the FxService type
+type MockFxService struct {
+	mock.Mock
+}
+
+type MockFxService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFxService) EXPECT() *MockFxService_Expecter {
+	return &MockFxService_Expecter{mock: &_m.Mock}
+}
+
+// CreateFxRateSource provides a mock function with given fields: ctx, orgId, userId, params
+func (_m *MockFxService) CreateFxRateSource(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.FxRateSourceParams) (models.FxRateSource, error) {
+	ret := _m.Called(ctx, orgId, userId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFxRateSource")
+	}
+
+	var r0 models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.FxRateSourceParams) (models.FxRateSource, error)); ok {
+		return rf(ctx, orgId, userId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.FxRateSourceParams) models.FxRateSource); ok {
+		r0 = rf(ctx, orgId, userId, params)
+	} else {
+		r0 = ret.Get(0).(models.FxRateSource)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.FxRateSourceParams) error); ok {
+		r1 = rf(ctx, orgId, userId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxService_CreateFxRateSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFxRateSource'
+type MockFxService_CreateFxRateSource_Call struct {
+	*mock.Call
+}
+
+// CreateFxRateSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - userId uuid.UUID
+//   - params models.FxRateSourceParams
+func (_e *MockFxService_Expecter) CreateFxRateSource(ctx interface{}, orgId interface{}, userId interface{}, params interface{}) *MockFxService_CreateFxRateSource_Call {
+	return &MockFxService_CreateFxRateSource_Call{Call: _e.mock.On("CreateFxRateSource", ctx, orgId, userId, params)}
+}
+
+func (_c *MockFxService_CreateFxRateSource_Call) Run(run func(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.FxRateSourceParams)) *MockFxService_CreateFxRateSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.FxRateSourceParams))
+	})
+	return _c
+}
+
+func (_c *MockFxService_CreateFxRateSource_Call) Return(_a0 models.FxRateSource, _a1 error) *MockFxService_CreateFxRateSource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxService_CreateFxRateSource_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.FxRateSourceParams) (models.FxRateSource, error)) *MockFxService_CreateFxRateSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFxRateSource provides a mock function with given fields: ctx, userId, sourceId
+func (_m *MockFxService) DeleteFxRateSource(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, sourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFxRateSource")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, sourceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxService_DeleteFxRateSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFxRateSource'
+type MockFxService_DeleteFxRateSource_Call struct {
+	*mock.Call
+}
+
+// DeleteFxRateSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - sourceId uuid.UUID
+func (_e *MockFxService_Expecter) DeleteFxRateSource(ctx interface{}, userId interface{}, sourceId interface{}) *MockFxService_DeleteFxRateSource_Call {
+	return &MockFxService_DeleteFxRateSource_Call{Call: _e.mock.On("DeleteFxRateSource", ctx, userId, sourceId)}
+}
+
+func (_c *MockFxService_DeleteFxRateSource_Call) Run(run func(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID)) *MockFxService_DeleteFxRateSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxService_DeleteFxRateSource_Call) Return(_a0 error) *MockFxService_DeleteFxRateSource_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxService_DeleteFxRateSource_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockFxService_DeleteFxRateSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRate provides a mock function with given fields: ctx, baseCurrency, quoteCurrency, date
+func (_m *MockFxService) GetFxRate(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time) (models.EffectiveFxRate, error) {
+	ret := _m.Called(ctx, baseCurrency, quoteCurrency, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRate")
+	}
+
+	var r0 models.EffectiveFxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (models.EffectiveFxRate, error)); ok {
+		return rf(ctx, baseCurrency, quoteCurrency, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) models.EffectiveFxRate); ok {
+		r0 = rf(ctx, baseCurrency, quoteCurrency, date)
+	} else {
+		r0 = ret.Get(0).(models.EffectiveFxRate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, baseCurrency, quoteCurrency, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxService_GetFxRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRate'
+type MockFxService_GetFxRate_Call struct {
+	*mock.Call
+}
+
+// GetFxRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - baseCurrency string
+//   - quoteCurrency string
+//   - date time.Time
+func (_e *MockFxService_Expecter) GetFxRate(ctx interface{}, baseCurrency interface{}, quoteCurrency interface{}, date interface{}) *MockFxService_GetFxRate_Call {
+	return &MockFxService_GetFxRate_Call{Call: _e.mock.On("GetFxRate", ctx, baseCurrency, quoteCurrency, date)}
+}
+
+func (_c *MockFxService_GetFxRate_Call) Run(run func(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time)) *MockFxService_GetFxRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockFxService_GetFxRate_Call) Return(_a0 models.EffectiveFxRate, _a1 error) *MockFxService_GetFxRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxService_GetFxRate_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (models.EffectiveFxRate, error)) *MockFxService_GetFxRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateSource provides a mock function with given fields: ctx, sourceId
+func (_m *MockFxService) GetFxRateSource(ctx context.Context, sourceId uuid.UUID) (models.FxRateSource, error) {
+	ret := _m.Called(ctx, sourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateSource")
+	}
+
+	var r0 models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.FxRateSource, error)); ok {
+		return rf(ctx, sourceId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.FxRateSource); ok {
+		r0 = rf(ctx, sourceId)
+	} else {
+		r0 = ret.Get(0).(models.FxRateSource)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sourceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxService_GetFxRateSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateSource'
+type MockFxService_GetFxRateSource_Call struct {
+	*mock.Call
+}
+
+// GetFxRateSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+func (_e *MockFxService_Expecter) GetFxRateSource(ctx interface{}, sourceId interface{}) *MockFxService_GetFxRateSource_Call {
+	return &MockFxService_GetFxRateSource_Call{Call: _e.mock.On("GetFxRateSource", ctx, sourceId)}
+}
+
+func (_c *MockFxService_GetFxRateSource_Call) Run(run func(ctx context.Context, sourceId uuid.UUID)) *MockFxService_GetFxRateSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxService_GetFxRateSource_Call) Return(_a0 models.FxRateSource, _a1 error) *MockFxService_GetFxRateSource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxService_GetFxRateSource_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.FxRateSource, error)) *MockFxService_GetFxRateSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateSources provides a mock function with given fields: ctx
+func (_m *MockFxService) GetFxRateSources(ctx context.Context) ([]models.FxRateSource, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateSources")
+	}
+
+	var r0 []models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.FxRateSource, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.FxRateSource); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FxRateSource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxService_GetFxRateSources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateSources'
+type MockFxService_GetFxRateSources_Call struct {
+	*mock.Call
+}
+
+// GetFxRateSources is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFxService_Expecter) GetFxRateSources(ctx interface{}) *MockFxService_GetFxRateSources_Call {
+	return &MockFxService_GetFxRateSources_Call{Call: _e.mock.On("GetFxRateSources", ctx)}
+}
+
+func (_c *MockFxService_GetFxRateSources_Call) Run(run func(ctx context.Context)) *MockFxService_GetFxRateSources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockFxService_GetFxRateSources_Call) Return(_a0 []models.FxRateSource, _a1 error) *MockFxService_GetFxRateSources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxService_GetFxRateSources_Call) RunAndReturn(run func(context.Context) ([]models.FxRateSource, error)) *MockFxService_GetFxRateSources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRates provides a mock function with given fields: ctx, filters
+func (_m *MockFxService) GetFxRates(ctx context.Context, filters dbmodels.FxRateFilters) (models.FxRatesPage, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRates")
+	}
+
+	var r0 models.FxRatesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dbmodels.FxRateFilters) (models.FxRatesPage, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dbmodels.FxRateFilters) models.FxRatesPage); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(models.FxRatesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dbmodels.FxRateFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxService_GetFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRates'
+type MockFxService_GetFxRates_Call struct {
+	*mock.Call
+}
+
+// GetFxRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters dbmodels.FxRateFilters
+func (_e *MockFxService_Expecter) GetFxRates(ctx interface{}, filters interface{}) *MockFxService_GetFxRates_Call {
+	return &MockFxService_GetFxRates_Call{Call: _e.mock.On("GetFxRates", ctx, filters)}
+}
+
+func (_c *MockFxService_GetFxRates_Call) Run(run func(ctx context.Context, filters dbmodels.FxRateFilters)) *MockFxService_GetFxRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dbmodels.FxRateFilters))
+	})
+	return _c
+}
+
+func (_c *MockFxService_GetFxRates_Call) Return(_a0 models.FxRatesPage, _a1 error) *MockFxService_GetFxRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxService_GetFxRates_Call) RunAndReturn(run func(context.Context, dbmodels.FxRateFilters) (models.FxRatesPage, error)) *MockFxService_GetFxRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportFxRatesFromConnector provides a mock function with given fields: ctx, userId, sourceId
+func (_m *MockFxService) ImportFxRatesFromConnector(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID) (models.FxRateImportResult, error) {
+	ret := _m.Called(ctx, userId, sourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportFxRatesFromConnector")
+	}
+
+	var r0 models.FxRateImportResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.FxRateImportResult, error)); ok {
+		return rf(ctx, userId, sourceId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.FxRateImportResult); ok {
+		r0 = rf(ctx, userId, sourceId)
+	} else {
+		r0 = ret.Get(0).(models.FxRateImportResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, sourceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxService_ImportFxRatesFromConnector_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportFxRatesFromConnector'
+type MockFxService_ImportFxRatesFromConnector_Call struct {
+	*mock.Call
+}
+
+// ImportFxRatesFromConnector is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - sourceId uuid.UUID
+func (_e *MockFxService_Expecter) ImportFxRatesFromConnector(ctx interface{}, userId interface{}, sourceId interface{}) *MockFxService_ImportFxRatesFromConnector_Call {
+	return &MockFxService_ImportFxRatesFromConnector_Call{Call: _e.mock.On("ImportFxRatesFromConnector", ctx, userId, sourceId)}
+}
+
+func (_c *MockFxService_ImportFxRatesFromConnector_Call) Run(run func(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID)) *MockFxService_ImportFxRatesFromConnector_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxService_ImportFxRatesFromConnector_Call) Return(_a0 models.FxRateImportResult, _a1 error) *MockFxService_ImportFxRatesFromConnector_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxService_ImportFxRatesFromConnector_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (models.FxRateImportResult, error)) *MockFxService_ImportFxRatesFromConnector_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportFxRatesFromFile provides a mock function with given fields: ctx, userId, sourceId, file
+func (_m *MockFxService) ImportFxRatesFromFile(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID, file io.Reader) (models.FxRateImportResult, error) {
+	ret := _m.Called(ctx, userId, sourceId, file)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportFxRatesFromFile")
+	}
+
+	var r0 models.FxRateImportResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, io.Reader) (models.FxRateImportResult, error)); ok {
+		return rf(ctx, userId, sourceId, file)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, io.Reader) models.FxRateImportResult); ok {
+		r0 = rf(ctx, userId, sourceId, file)
+	} else {
+		r0 = ret.Get(0).(models.FxRateImportResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, io.Reader) error); ok {
+		r1 = rf(ctx, userId, sourceId, file)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxService_ImportFxRatesFromFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportFxRatesFromFile'
+type MockFxService_ImportFxRatesFromFile_Call struct {
+	*mock.Call
+}
+
+// ImportFxRatesFromFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - sourceId uuid.UUID
+//   - file io.Reader
+func (_e *MockFxService_Expecter) ImportFxRatesFromFile(ctx interface{}, userId interface{}, sourceId interface{}, file interface{}) *MockFxService_ImportFxRatesFromFile_Call {
+	return &MockFxService_ImportFxRatesFromFile_Call{Call: _e.mock.On("ImportFxRatesFromFile", ctx, userId, sourceId, file)}
+}
+
+func (_c *MockFxService_ImportFxRatesFromFile_Call) Run(run func(ctx context.Context, userId uuid.UUID, sourceId uuid.UUID, file io.Reader)) *MockFxService_ImportFxRatesFromFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockFxService_ImportFxRatesFromFile_Call) Return(_a0 models.FxRateImportResult, _a1 error) *MockFxService_ImportFxRatesFromFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxService_ImportFxRatesFromFile_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, io.Reader) (models.FxRateImportResult, error)) *MockFxService_ImportFxRatesFromFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFxService creates a new instance of MockFxService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFxService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFxService {
+	mock := &MockFxService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
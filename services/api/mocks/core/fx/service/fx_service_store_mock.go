@@ -0,0 +1,3099 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_service
+
+import (
+	context "context"
+	json "encoding/json"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+
+	store "github.com/Zampfi/application-platform/services/api/db/store"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockFxServiceStore is an autogenerated mock type for the FxServiceStore type
+type MockFxServiceStore struct {
+	mock.Mock
+}
+
+type MockFxServiceStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFxServiceStore) EXPECT() *MockFxServiceStore_Expecter {
+	return &MockFxServiceStore_Expecter{mock: &_m.Mock}
+}
+
+// CountFxRates provides a mock function with given fields: ctx, filters
+func (_m *MockFxServiceStore) CountFxRates(ctx context.Context, filters models.FxRateFilters) (int64, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFxRates")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) (int64, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) int64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FxRateFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CountFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFxRates'
+type MockFxServiceStore_CountFxRates_Call struct {
+	*mock.Call
+}
+
+// CountFxRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FxRateFilters
+func (_e *MockFxServiceStore_Expecter) CountFxRates(ctx interface{}, filters interface{}) *MockFxServiceStore_CountFxRates_Call {
+	return &MockFxServiceStore_CountFxRates_Call{Call: _e.mock.On("CountFxRates", ctx, filters)}
+}
+
+func (_c *MockFxServiceStore_CountFxRates_Call) Run(run func(ctx context.Context, filters models.FxRateFilters)) *MockFxServiceStore_CountFxRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FxRateFilters))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CountFxRates_Call) Return(_a0 int64, _a1 error) *MockFxServiceStore_CountFxRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CountFxRates_Call) RunAndReturn(run func(context.Context, models.FxRateFilters) (int64, error)) *MockFxServiceStore_CountFxRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateConnection provides a mock function with given fields: ctx, connection
+func (_m *MockFxServiceStore) CreateConnection(ctx context.Context, connection *models.CreateConnectionParams) (uuid.UUID, error) {
+	ret := _m.Called(ctx, connection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConnection")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CreateConnectionParams) (uuid.UUID, error)); ok {
+		return rf(ctx, connection)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CreateConnectionParams) uuid.UUID); ok {
+		r0 = rf(ctx, connection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.CreateConnectionParams) error); ok {
+		r1 = rf(ctx, connection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConnection'
+type MockFxServiceStore_CreateConnection_Call struct {
+	*mock.Call
+}
+
+// CreateConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection *models.CreateConnectionParams
+func (_e *MockFxServiceStore_Expecter) CreateConnection(ctx interface{}, connection interface{}) *MockFxServiceStore_CreateConnection_Call {
+	return &MockFxServiceStore_CreateConnection_Call{Call: _e.mock.On("CreateConnection", ctx, connection)}
+}
+
+func (_c *MockFxServiceStore_CreateConnection_Call) Run(run func(ctx context.Context, connection *models.CreateConnectionParams)) *MockFxServiceStore_CreateConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CreateConnectionParams))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateConnection_Call) Return(_a0 uuid.UUID, _a1 error) *MockFxServiceStore_CreateConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateConnection_Call) RunAndReturn(run func(context.Context, *models.CreateConnectionParams) (uuid.UUID, error)) *MockFxServiceStore_CreateConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockFxServiceStore) CreateDataset(ctx context.Context, dataset models.Dataset) (uuid.UUID, error) {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDataset")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) (uuid.UUID, error)); ok {
+		return rf(ctx, dataset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) uuid.UUID); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Dataset) error); ok {
+		r1 = rf(ctx, dataset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDataset'
+type MockFxServiceStore_CreateDataset_Call struct {
+	*mock.Call
+}
+
+// CreateDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset models.Dataset
+func (_e *MockFxServiceStore_Expecter) CreateDataset(ctx interface{}, dataset interface{}) *MockFxServiceStore_CreateDataset_Call {
+	return &MockFxServiceStore_CreateDataset_Call{Call: _e.mock.On("CreateDataset", ctx, dataset)}
+}
+
+func (_c *MockFxServiceStore_CreateDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockFxServiceStore_CreateDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Dataset))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateDataset_Call) Return(_a0 uuid.UUID, _a1 error) *MockFxServiceStore_CreateDataset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) (uuid.UUID, error)) *MockFxServiceStore_CreateDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId, privilege
+func (_m *MockFxServiceStore) CreateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, audienceType, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, audienceType, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, datasetId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetPolicy'
+type MockFxServiceStore_CreateDatasetPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockFxServiceStore_Expecter) CreateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockFxServiceStore_CreateDatasetPolicy_Call {
+	return &MockFxServiceStore_CreateDatasetPolicy_Call{Call: _e.mock.On("CreateDatasetPolicy", ctx, datasetId, audienceType, audienceId, privilege)}
+}
+
+func (_c *MockFxServiceStore_CreateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockFxServiceStore_CreateDatasetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockFxServiceStore_CreateDatasetPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockFxServiceStore_CreateDatasetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFxRateSource provides a mock function with given fields: ctx, params
+func (_m *MockFxServiceStore) CreateFxRateSource(ctx context.Context, params models.CreateFxRateSourceParams) (models.FxRateSource, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFxRateSource")
+	}
+
+	var r0 models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateFxRateSourceParams) (models.FxRateSource, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateFxRateSourceParams) models.FxRateSource); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.FxRateSource)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateFxRateSourceParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateFxRateSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFxRateSource'
+type MockFxServiceStore_CreateFxRateSource_Call struct {
+	*mock.Call
+}
+
+// CreateFxRateSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateFxRateSourceParams
+func (_e *MockFxServiceStore_Expecter) CreateFxRateSource(ctx interface{}, params interface{}) *MockFxServiceStore_CreateFxRateSource_Call {
+	return &MockFxServiceStore_CreateFxRateSource_Call{Call: _e.mock.On("CreateFxRateSource", ctx, params)}
+}
+
+func (_c *MockFxServiceStore_CreateFxRateSource_Call) Run(run func(ctx context.Context, params models.CreateFxRateSourceParams)) *MockFxServiceStore_CreateFxRateSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateFxRateSourceParams))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateFxRateSource_Call) Return(_a0 models.FxRateSource, _a1 error) *MockFxServiceStore_CreateFxRateSource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateFxRateSource_Call) RunAndReturn(run func(context.Context, models.CreateFxRateSourceParams) (models.FxRateSource, error)) *MockFxServiceStore_CreateFxRateSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function with given fields: ctx, name, description, ownerId
+func (_m *MockFxServiceStore) CreateOrganization(ctx context.Context, name string, description *string, ownerId uuid.UUID) (*models.Organization, error) {
+	ret := _m.Called(ctx, name, description, ownerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)); ok {
+		return rf(ctx, name, description, ownerId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) *models.Organization); ok {
+		r0 = rf(ctx, name, description, ownerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, uuid.UUID) error); ok {
+		r1 = rf(ctx, name, description, ownerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type MockFxServiceStore_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - description *string
+//   - ownerId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) CreateOrganization(ctx interface{}, name interface{}, description interface{}, ownerId interface{}) *MockFxServiceStore_CreateOrganization_Call {
+	return &MockFxServiceStore_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, name, description, ownerId)}
+}
+
+func (_c *MockFxServiceStore_CreateOrganization_Call) Run(run func(ctx context.Context, name string, description *string, ownerId uuid.UUID)) *MockFxServiceStore_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*string), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganization_Call) Return(_a0 *models.Organization, _a1 error) *MockFxServiceStore_CreateOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganization_Call) RunAndReturn(run func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)) *MockFxServiceStore_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationInvitation provides a mock function with given fields: ctx, organizationId, targetEmail, privilege
+func (_m *MockFxServiceStore) CreateOrganizationInvitation(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege) (*models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationId, targetEmail, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationInvitation")
+	}
+
+	var r0 *models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationId, targetEmail, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) *models.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationId, targetEmail, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, organizationId, targetEmail, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitation'
+type MockFxServiceStore_CreateOrganizationInvitation_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - targetEmail string
+//   - privilege models.ResourcePrivilege
+func (_e *MockFxServiceStore_Expecter) CreateOrganizationInvitation(ctx interface{}, organizationId interface{}, targetEmail interface{}, privilege interface{}) *MockFxServiceStore_CreateOrganizationInvitation_Call {
+	return &MockFxServiceStore_CreateOrganizationInvitation_Call{Call: _e.mock.On("CreateOrganizationInvitation", ctx, organizationId, targetEmail, privilege)}
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationInvitation_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege)) *MockFxServiceStore_CreateOrganizationInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationInvitation_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockFxServiceStore_CreateOrganizationInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)) *MockFxServiceStore_CreateOrganizationInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
+func (_m *MockFxServiceStore) CreateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
+	ret := _m.Called(ctx, invitationId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationInvitationStatus")
+	}
+
+	var r0 *models.OrganizationInvitationStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
+		return rf(ctx, invitationId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
+		r0 = rf(ctx, invitationId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
+		r1 = rf(ctx, invitationId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitationStatus'
+type MockFxServiceStore_CreateOrganizationInvitationStatus_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationInvitationStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+//   - status models.InvitationStatus
+func (_e *MockFxServiceStore_Expecter) CreateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockFxServiceStore_CreateOrganizationInvitationStatus_Call {
+	return &MockFxServiceStore_CreateOrganizationInvitationStatus_Call{Call: _e.mock.On("CreateOrganizationInvitationStatus", ctx, invitationId, status)}
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockFxServiceStore_CreateOrganizationInvitationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockFxServiceStore_CreateOrganizationInvitationStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockFxServiceStore_CreateOrganizationInvitationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
+func (_m *MockFxServiceStore) CreateOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId, userId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationMembershipRequest")
+	}
+
+	var r0 *models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId, userId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId, userId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
+		r1 = rf(ctx, organizationId, userId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationMembershipRequest'
+type MockFxServiceStore_CreateOrganizationMembershipRequest_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationMembershipRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+//   - status models.OrgMembershipStatus
+func (_e *MockFxServiceStore_Expecter) CreateOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockFxServiceStore_CreateOrganizationMembershipRequest_Call {
+	return &MockFxServiceStore_CreateOrganizationMembershipRequest_Call{Call: _e.mock.On("CreateOrganizationMembershipRequest", ctx, organizationId, userId, status)}
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockFxServiceStore_CreateOrganizationMembershipRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockFxServiceStore_CreateOrganizationMembershipRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockFxServiceStore_CreateOrganizationMembershipRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceType, audienceId, privilege
+func (_m *MockFxServiceStore) CreateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId, audienceType, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId, audienceType, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, orgId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationPolicy'
+type MockFxServiceStore_CreateOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockFxServiceStore_Expecter) CreateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockFxServiceStore_CreateOrganizationPolicy_Call {
+	return &MockFxServiceStore_CreateOrganizationPolicy_Call{Call: _e.mock.On("CreateOrganizationPolicy", ctx, orgId, audienceType, audienceId, privilege)}
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockFxServiceStore_CreateOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockFxServiceStore_CreateOrganizationPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockFxServiceStore_CreateOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSSOConfig provides a mock function with given fields: ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain
+func (_m *MockFxServiceStore) CreateSSOConfig(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSSOConfig")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) error); ok {
+		r1 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_CreateSSOConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSSOConfig'
+type MockFxServiceStore_CreateSSOConfig_Call struct {
+	*mock.Call
+}
+
+// CreateSSOConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - ssoProviderID string
+//   - ssoProviderName string
+//   - ssoConfig json.RawMessage
+//   - emailDomain string
+func (_e *MockFxServiceStore_Expecter) CreateSSOConfig(ctx interface{}, organizationId interface{}, ssoProviderID interface{}, ssoProviderName interface{}, ssoConfig interface{}, emailDomain interface{}) *MockFxServiceStore_CreateSSOConfig_Call {
+	return &MockFxServiceStore_CreateSSOConfig_Call{Call: _e.mock.On("CreateSSOConfig", ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)}
+}
+
+func (_c *MockFxServiceStore_CreateSSOConfig_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string)) *MockFxServiceStore_CreateSSOConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(json.RawMessage), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateSSOConfig_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockFxServiceStore_CreateSSOConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_CreateSSOConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)) *MockFxServiceStore_CreateSSOConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockFxServiceStore) DeleteDataset(ctx context.Context, dataset models.Dataset) error {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDataset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) error); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxServiceStore_DeleteDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDataset'
+type MockFxServiceStore_DeleteDataset_Call struct {
+	*mock.Call
+}
+
+// DeleteDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset models.Dataset
+func (_e *MockFxServiceStore_Expecter) DeleteDataset(ctx interface{}, dataset interface{}) *MockFxServiceStore_DeleteDataset_Call {
+	return &MockFxServiceStore_DeleteDataset_Call{Call: _e.mock.On("DeleteDataset", ctx, dataset)}
+}
+
+func (_c *MockFxServiceStore_DeleteDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockFxServiceStore_DeleteDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Dataset))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_DeleteDataset_Call) Return(_a0 error) *MockFxServiceStore_DeleteDataset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxServiceStore_DeleteDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) error) *MockFxServiceStore_DeleteDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId
+func (_m *MockFxServiceStore) DeleteDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, datasetId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, datasetId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxServiceStore_DeleteDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetPolicy'
+type MockFxServiceStore_DeleteDatasetPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) DeleteDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}) *MockFxServiceStore_DeleteDatasetPolicy_Call {
+	return &MockFxServiceStore_DeleteDatasetPolicy_Call{Call: _e.mock.On("DeleteDatasetPolicy", ctx, datasetId, audienceType, audienceId)}
+}
+
+func (_c *MockFxServiceStore_DeleteDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockFxServiceStore_DeleteDatasetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_DeleteDatasetPolicy_Call) Return(_a0 error) *MockFxServiceStore_DeleteDatasetPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxServiceStore_DeleteDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockFxServiceStore_DeleteDatasetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFxRateSource provides a mock function with given fields: ctx, sourceId, deletedBy
+func (_m *MockFxServiceStore) DeleteFxRateSource(ctx context.Context, sourceId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, sourceId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFxRateSource")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, sourceId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxServiceStore_DeleteFxRateSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFxRateSource'
+type MockFxServiceStore_DeleteFxRateSource_Call struct {
+	*mock.Call
+}
+
+// DeleteFxRateSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockFxServiceStore_Expecter) DeleteFxRateSource(ctx interface{}, sourceId interface{}, deletedBy interface{}) *MockFxServiceStore_DeleteFxRateSource_Call {
+	return &MockFxServiceStore_DeleteFxRateSource_Call{Call: _e.mock.On("DeleteFxRateSource", ctx, sourceId, deletedBy)}
+}
+
+func (_c *MockFxServiceStore_DeleteFxRateSource_Call) Run(run func(ctx context.Context, sourceId uuid.UUID, deletedBy uuid.UUID)) *MockFxServiceStore_DeleteFxRateSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_DeleteFxRateSource_Call) Return(_a0 error) *MockFxServiceStore_DeleteFxRateSource_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxServiceStore_DeleteFxRateSource_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockFxServiceStore_DeleteFxRateSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId
+func (_m *MockFxServiceStore) DeleteOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, orgId, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, orgId, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxServiceStore_DeleteOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationPolicy'
+type MockFxServiceStore_DeleteOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) DeleteOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}) *MockFxServiceStore_DeleteOrganizationPolicy_Call {
+	return &MockFxServiceStore_DeleteOrganizationPolicy_Call{Call: _e.mock.On("DeleteOrganizationPolicy", ctx, orgId, audienceId)}
+}
+
+func (_c *MockFxServiceStore_DeleteOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID)) *MockFxServiceStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_DeleteOrganizationPolicy_Call) Return(_a0 error) *MockFxServiceStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxServiceStore_DeleteOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockFxServiceStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionByID provides a mock function with given fields: ctx, id
+func (_m *MockFxServiceStore) GetConnectionByID(ctx context.Context, id uuid.UUID) (*models.Connection, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionByID")
+	}
+
+	var r0 *models.Connection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Connection, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Connection); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Connection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetConnectionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionByID'
+type MockFxServiceStore_GetConnectionByID_Call struct {
+	*mock.Call
+}
+
+// GetConnectionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetConnectionByID(ctx interface{}, id interface{}) *MockFxServiceStore_GetConnectionByID_Call {
+	return &MockFxServiceStore_GetConnectionByID_Call{Call: _e.mock.On("GetConnectionByID", ctx, id)}
+}
+
+func (_c *MockFxServiceStore_GetConnectionByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockFxServiceStore_GetConnectionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetConnectionByID_Call) Return(_a0 *models.Connection, _a1 error) *MockFxServiceStore_GetConnectionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetConnectionByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Connection, error)) *MockFxServiceStore_GetConnectionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnections provides a mock function with given fields: ctx
+func (_m *MockFxServiceStore) GetConnections(ctx context.Context) ([]models.Connection, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnections")
+	}
+
+	var r0 []models.Connection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Connection, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Connection); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Connection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnections'
+type MockFxServiceStore_GetConnections_Call struct {
+	*mock.Call
+}
+
+// GetConnections is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFxServiceStore_Expecter) GetConnections(ctx interface{}) *MockFxServiceStore_GetConnections_Call {
+	return &MockFxServiceStore_GetConnections_Call{Call: _e.mock.On("GetConnections", ctx)}
+}
+
+func (_c *MockFxServiceStore_GetConnections_Call) Run(run func(ctx context.Context)) *MockFxServiceStore_GetConnections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetConnections_Call) Return(_a0 []models.Connection, _a1 error) *MockFxServiceStore_GetConnections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetConnections_Call) RunAndReturn(run func(context.Context) ([]models.Connection, error)) *MockFxServiceStore_GetConnections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetById provides a mock function with given fields: ctx, datasetId
+func (_m *MockFxServiceStore) GetDatasetById(ctx context.Context, datasetId string) (*models.Dataset, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetById")
+	}
+
+	var r0 *models.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Dataset, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Dataset); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetDatasetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetById'
+type MockFxServiceStore_GetDatasetById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId string
+func (_e *MockFxServiceStore_Expecter) GetDatasetById(ctx interface{}, datasetId interface{}) *MockFxServiceStore_GetDatasetById_Call {
+	return &MockFxServiceStore_GetDatasetById_Call{Call: _e.mock.On("GetDatasetById", ctx, datasetId)}
+}
+
+func (_c *MockFxServiceStore_GetDatasetById_Call) Run(run func(ctx context.Context, datasetId string)) *MockFxServiceStore_GetDatasetById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetById_Call) Return(_a0 *models.Dataset, _a1 error) *MockFxServiceStore_GetDatasetById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetById_Call) RunAndReturn(run func(context.Context, string) (*models.Dataset, error)) *MockFxServiceStore_GetDatasetById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetCount provides a mock function with given fields: ctx, filters
+func (_m *MockFxServiceStore) GetDatasetCount(ctx context.Context, filters models.DatasetFilters) (int64, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) (int64, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) int64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetDatasetCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetCount'
+type MockFxServiceStore_GetDatasetCount_Call struct {
+	*mock.Call
+}
+
+// GetDatasetCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.DatasetFilters
+func (_e *MockFxServiceStore_Expecter) GetDatasetCount(ctx interface{}, filters interface{}) *MockFxServiceStore_GetDatasetCount_Call {
+	return &MockFxServiceStore_GetDatasetCount_Call{Call: _e.mock.On("GetDatasetCount", ctx, filters)}
+}
+
+func (_c *MockFxServiceStore_GetDatasetCount_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockFxServiceStore_GetDatasetCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetCount_Call) Return(_a0 int64, _a1 error) *MockFxServiceStore_GetDatasetCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetCount_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) (int64, error)) *MockFxServiceStore_GetDatasetCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockFxServiceStore) GetDatasetPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetDatasetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPolicies'
+type MockFxServiceStore_GetDatasetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetDatasetPolicies(ctx interface{}, datasetId interface{}) *MockFxServiceStore_GetDatasetPolicies_Call {
+	return &MockFxServiceStore_GetDatasetPolicies_Call{Call: _e.mock.On("GetDatasetPolicies", ctx, datasetId)}
+}
+
+func (_c *MockFxServiceStore_GetDatasetPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockFxServiceStore_GetDatasetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockFxServiceStore_GetDatasetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockFxServiceStore_GetDatasetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetPoliciesByEmail provides a mock function with given fields: ctx, datasetId, email
+func (_m *MockFxServiceStore) GetDatasetPoliciesByEmail(ctx context.Context, datasetId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetPoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, datasetId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetDatasetPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPoliciesByEmail'
+type MockFxServiceStore_GetDatasetPoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetDatasetPoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - email string
+func (_e *MockFxServiceStore_Expecter) GetDatasetPoliciesByEmail(ctx interface{}, datasetId interface{}, email interface{}) *MockFxServiceStore_GetDatasetPoliciesByEmail_Call {
+	return &MockFxServiceStore_GetDatasetPoliciesByEmail_Call{Call: _e.mock.On("GetDatasetPoliciesByEmail", ctx, datasetId, email)}
+}
+
+func (_c *MockFxServiceStore_GetDatasetPoliciesByEmail_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, email string)) *MockFxServiceStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockFxServiceStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockFxServiceStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetsAll provides a mock function with given fields: ctx, filters
+func (_m *MockFxServiceStore) GetDatasetsAll(ctx context.Context, filters models.DatasetFilters) ([]models.Dataset, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetsAll")
+	}
+
+	var r0 []models.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) ([]models.Dataset, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) []models.Dataset); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetDatasetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetsAll'
+type MockFxServiceStore_GetDatasetsAll_Call struct {
+	*mock.Call
+}
+
+// GetDatasetsAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.DatasetFilters
+func (_e *MockFxServiceStore_Expecter) GetDatasetsAll(ctx interface{}, filters interface{}) *MockFxServiceStore_GetDatasetsAll_Call {
+	return &MockFxServiceStore_GetDatasetsAll_Call{Call: _e.mock.On("GetDatasetsAll", ctx, filters)}
+}
+
+func (_c *MockFxServiceStore_GetDatasetsAll_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockFxServiceStore_GetDatasetsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetsAll_Call) Return(_a0 []models.Dataset, _a1 error) *MockFxServiceStore_GetDatasetsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetDatasetsAll_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) ([]models.Dataset, error)) *MockFxServiceStore_GetDatasetsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateOnDate provides a mock function with given fields: ctx, baseCurrency, quoteCurrency, date
+func (_m *MockFxServiceStore) GetFxRateOnDate(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time) (models.FxRate, error) {
+	ret := _m.Called(ctx, baseCurrency, quoteCurrency, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateOnDate")
+	}
+
+	var r0 models.FxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (models.FxRate, error)); ok {
+		return rf(ctx, baseCurrency, quoteCurrency, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) models.FxRate); ok {
+		r0 = rf(ctx, baseCurrency, quoteCurrency, date)
+	} else {
+		r0 = ret.Get(0).(models.FxRate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, baseCurrency, quoteCurrency, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetFxRateOnDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateOnDate'
+type MockFxServiceStore_GetFxRateOnDate_Call struct {
+	*mock.Call
+}
+
+// GetFxRateOnDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - baseCurrency string
+//   - quoteCurrency string
+//   - date time.Time
+func (_e *MockFxServiceStore_Expecter) GetFxRateOnDate(ctx interface{}, baseCurrency interface{}, quoteCurrency interface{}, date interface{}) *MockFxServiceStore_GetFxRateOnDate_Call {
+	return &MockFxServiceStore_GetFxRateOnDate_Call{Call: _e.mock.On("GetFxRateOnDate", ctx, baseCurrency, quoteCurrency, date)}
+}
+
+func (_c *MockFxServiceStore_GetFxRateOnDate_Call) Run(run func(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time)) *MockFxServiceStore_GetFxRateOnDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetFxRateOnDate_Call) Return(_a0 models.FxRate, _a1 error) *MockFxServiceStore_GetFxRateOnDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetFxRateOnDate_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (models.FxRate, error)) *MockFxServiceStore_GetFxRateOnDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateSourceById provides a mock function with given fields: ctx, sourceId
+func (_m *MockFxServiceStore) GetFxRateSourceById(ctx context.Context, sourceId uuid.UUID) (models.FxRateSource, error) {
+	ret := _m.Called(ctx, sourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateSourceById")
+	}
+
+	var r0 models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.FxRateSource, error)); ok {
+		return rf(ctx, sourceId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.FxRateSource); ok {
+		r0 = rf(ctx, sourceId)
+	} else {
+		r0 = ret.Get(0).(models.FxRateSource)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sourceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetFxRateSourceById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateSourceById'
+type MockFxServiceStore_GetFxRateSourceById_Call struct {
+	*mock.Call
+}
+
+// GetFxRateSourceById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetFxRateSourceById(ctx interface{}, sourceId interface{}) *MockFxServiceStore_GetFxRateSourceById_Call {
+	return &MockFxServiceStore_GetFxRateSourceById_Call{Call: _e.mock.On("GetFxRateSourceById", ctx, sourceId)}
+}
+
+func (_c *MockFxServiceStore_GetFxRateSourceById_Call) Run(run func(ctx context.Context, sourceId uuid.UUID)) *MockFxServiceStore_GetFxRateSourceById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetFxRateSourceById_Call) Return(_a0 models.FxRateSource, _a1 error) *MockFxServiceStore_GetFxRateSourceById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetFxRateSourceById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.FxRateSource, error)) *MockFxServiceStore_GetFxRateSourceById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateSources provides a mock function with given fields: ctx
+func (_m *MockFxServiceStore) GetFxRateSources(ctx context.Context) ([]models.FxRateSource, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateSources")
+	}
+
+	var r0 []models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.FxRateSource, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.FxRateSource); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FxRateSource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetFxRateSources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateSources'
+type MockFxServiceStore_GetFxRateSources_Call struct {
+	*mock.Call
+}
+
+// GetFxRateSources is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFxServiceStore_Expecter) GetFxRateSources(ctx interface{}) *MockFxServiceStore_GetFxRateSources_Call {
+	return &MockFxServiceStore_GetFxRateSources_Call{Call: _e.mock.On("GetFxRateSources", ctx)}
+}
+
+func (_c *MockFxServiceStore_GetFxRateSources_Call) Run(run func(ctx context.Context)) *MockFxServiceStore_GetFxRateSources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetFxRateSources_Call) Return(_a0 []models.FxRateSource, _a1 error) *MockFxServiceStore_GetFxRateSources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetFxRateSources_Call) RunAndReturn(run func(context.Context) ([]models.FxRateSource, error)) *MockFxServiceStore_GetFxRateSources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRates provides a mock function with given fields: ctx, filters
+func (_m *MockFxServiceStore) GetFxRates(ctx context.Context, filters models.FxRateFilters) ([]models.FxRate, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRates")
+	}
+
+	var r0 []models.FxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) ([]models.FxRate, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) []models.FxRate); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FxRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FxRateFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRates'
+type MockFxServiceStore_GetFxRates_Call struct {
+	*mock.Call
+}
+
+// GetFxRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FxRateFilters
+func (_e *MockFxServiceStore_Expecter) GetFxRates(ctx interface{}, filters interface{}) *MockFxServiceStore_GetFxRates_Call {
+	return &MockFxServiceStore_GetFxRates_Call{Call: _e.mock.On("GetFxRates", ctx, filters)}
+}
+
+func (_c *MockFxServiceStore_GetFxRates_Call) Run(run func(ctx context.Context, filters models.FxRateFilters)) *MockFxServiceStore_GetFxRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FxRateFilters))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetFxRates_Call) Return(_a0 []models.FxRate, _a1 error) *MockFxServiceStore_GetFxRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetFxRates_Call) RunAndReturn(run func(context.Context, models.FxRateFilters) ([]models.FxRate, error)) *MockFxServiceStore_GetFxRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestFxRateDate provides a mock function with given fields: ctx, sourceId
+func (_m *MockFxServiceStore) GetLatestFxRateDate(ctx context.Context, sourceId uuid.UUID) (*time.Time, error) {
+	ret := _m.Called(ctx, sourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestFxRateDate")
+	}
+
+	var r0 *time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*time.Time, error)); ok {
+		return rf(ctx, sourceId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *time.Time); ok {
+		r0 = rf(ctx, sourceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sourceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetLatestFxRateDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestFxRateDate'
+type MockFxServiceStore_GetLatestFxRateDate_Call struct {
+	*mock.Call
+}
+
+// GetLatestFxRateDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetLatestFxRateDate(ctx interface{}, sourceId interface{}) *MockFxServiceStore_GetLatestFxRateDate_Call {
+	return &MockFxServiceStore_GetLatestFxRateDate_Call{Call: _e.mock.On("GetLatestFxRateDate", ctx, sourceId)}
+}
+
+func (_c *MockFxServiceStore_GetLatestFxRateDate_Call) Run(run func(ctx context.Context, sourceId uuid.UUID)) *MockFxServiceStore_GetLatestFxRateDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetLatestFxRateDate_Call) Return(_a0 *time.Time, _a1 error) *MockFxServiceStore_GetLatestFxRateDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetLatestFxRateDate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*time.Time, error)) *MockFxServiceStore_GetLatestFxRateDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationById provides a mock function with given fields: ctx, organizationId
+func (_m *MockFxServiceStore) GetOrganizationById(ctx context.Context, organizationId string) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationById")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationById'
+type MockFxServiceStore_GetOrganizationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+func (_e *MockFxServiceStore_Expecter) GetOrganizationById(ctx interface{}, organizationId interface{}) *MockFxServiceStore_GetOrganizationById_Call {
+	return &MockFxServiceStore_GetOrganizationById_Call{Call: _e.mock.On("GetOrganizationById", ctx, organizationId)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationById_Call) Run(run func(ctx context.Context, organizationId string)) *MockFxServiceStore_GetOrganizationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationById_Call) Return(_a0 *models.Organization, _a1 error) *MockFxServiceStore_GetOrganizationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationById_Call) RunAndReturn(run func(context.Context, string) (*models.Organization, error)) *MockFxServiceStore_GetOrganizationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationById provides a mock function with given fields: ctx, invitationId
+func (_m *MockFxServiceStore) GetOrganizationInvitationById(ctx context.Context, invitationId uuid.UUID) (*models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, invitationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationById")
+	}
+
+	var r0 *models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)); ok {
+		return rf(ctx, invitationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.OrganizationInvitation); ok {
+		r0 = rf(ctx, invitationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationInvitationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationById'
+type MockFxServiceStore_GetOrganizationInvitationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetOrganizationInvitationById(ctx interface{}, invitationId interface{}) *MockFxServiceStore_GetOrganizationInvitationById_Call {
+	return &MockFxServiceStore_GetOrganizationInvitationById_Call{Call: _e.mock.On("GetOrganizationInvitationById", ctx, invitationId)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationById_Call) Run(run func(ctx context.Context, invitationId uuid.UUID)) *MockFxServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationById_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockFxServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)) *MockFxServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAll provides a mock function with given fields: ctx
+func (_m *MockFxServiceStore) GetOrganizationInvitationsAll(ctx context.Context) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAll")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationInvitationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAll'
+type MockFxServiceStore_GetOrganizationInvitationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFxServiceStore_Expecter) GetOrganizationInvitationsAll(ctx interface{}) *MockFxServiceStore_GetOrganizationInvitationsAll_Call {
+	return &MockFxServiceStore_GetOrganizationInvitationsAll_Call{Call: _e.mock.On("GetOrganizationInvitationsAll", ctx)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationsAll_Call) Run(run func(ctx context.Context)) *MockFxServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationsAll_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockFxServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationInvitation, error)) *MockFxServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAndMembershipRequests provides a mock function with given fields: ctx, organizationId
+func (_m *MockFxServiceStore) GetOrganizationInvitationsAndMembershipRequests(ctx context.Context, organizationId uuid.UUID) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAndMembershipRequests")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAndMembershipRequests'
+type MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAndMembershipRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetOrganizationInvitationsAndMembershipRequests(ctx interface{}, organizationId interface{}) *MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	return &MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call{Call: _e.mock.On("GetOrganizationInvitationsAndMembershipRequests", ctx, organizationId)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) Return(_a0 *models.Organization, _a1 error) *MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Organization, error)) *MockFxServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockFxServiceStore) GetOrganizationInvitationsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsByOrganizationId'
+type MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetOrganizationInvitationsByOrganizationId(ctx interface{}, organizationId interface{}) *MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	return &MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationInvitationsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)) *MockFxServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsAll provides a mock function with given fields: ctx
+func (_m *MockFxServiceStore) GetOrganizationMembershipRequestsAll(ctx context.Context) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsAll")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsAll'
+type MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFxServiceStore_Expecter) GetOrganizationMembershipRequestsAll(ctx interface{}) *MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	return &MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsAll", ctx)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call) Run(run func(ctx context.Context)) *MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationMembershipRequest, error)) *MockFxServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockFxServiceStore) GetOrganizationMembershipRequestsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsByOrganizationId'
+type MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetOrganizationMembershipRequestsByOrganizationId(ctx interface{}, organizationId interface{}) *MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	return &MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)) *MockFxServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicies provides a mock function with given fields: ctx, orgId
+func (_m *MockFxServiceStore) GetOrganizationPolicies(ctx context.Context, orgId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicies'
+type MockFxServiceStore_GetOrganizationPolicies_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetOrganizationPolicies(ctx interface{}, orgId interface{}) *MockFxServiceStore_GetOrganizationPolicies_Call {
+	return &MockFxServiceStore_GetOrganizationPolicies_Call{Call: _e.mock.On("GetOrganizationPolicies", ctx, orgId)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationPolicies_Call) Run(run func(ctx context.Context, orgId uuid.UUID)) *MockFxServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockFxServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockFxServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPoliciesByEmail provides a mock function with given fields: ctx, organizationId, email
+func (_m *MockFxServiceStore) GetOrganizationPoliciesByEmail(ctx context.Context, organizationId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, organizationId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPoliciesByEmail'
+type MockFxServiceStore_GetOrganizationPoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - email string
+func (_e *MockFxServiceStore_Expecter) GetOrganizationPoliciesByEmail(ctx interface{}, organizationId interface{}, email interface{}) *MockFxServiceStore_GetOrganizationPoliciesByEmail_Call {
+	return &MockFxServiceStore_GetOrganizationPoliciesByEmail_Call{Call: _e.mock.On("GetOrganizationPoliciesByEmail", ctx, organizationId, email)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationPoliciesByEmail_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, email string)) *MockFxServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockFxServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockFxServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicyByUser provides a mock function with given fields: ctx, organizationId, userId
+func (_m *MockFxServiceStore) GetOrganizationPolicyByUser(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicyByUser")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationPolicyByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicyByUser'
+type MockFxServiceStore_GetOrganizationPolicyByUser_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicyByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetOrganizationPolicyByUser(ctx interface{}, organizationId interface{}, userId interface{}) *MockFxServiceStore_GetOrganizationPolicyByUser_Call {
+	return &MockFxServiceStore_GetOrganizationPolicyByUser_Call{Call: _e.mock.On("GetOrganizationPolicyByUser", ctx, organizationId, userId)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationPolicyByUser_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID)) *MockFxServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationPolicyByUser_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockFxServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationPolicyByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)) *MockFxServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationSSOConfigsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockFxServiceStore) GetOrganizationSSOConfigsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationSSOConfigsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationSSOConfigsByOrganizationId'
+type MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationSSOConfigsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetOrganizationSSOConfigsByOrganizationId(ctx interface{}, organizationId interface{}) *MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	return &MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationSSOConfigsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) Return(_a0 []models.OrganizationSSOConfig, _a1 error) *MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)) *MockFxServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationsAll provides a mock function with given fields: ctx
+func (_m *MockFxServiceStore) GetOrganizationsAll(ctx context.Context) ([]models.Organization, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationsAll")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Organization, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Organization); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsAll'
+type MockFxServiceStore_GetOrganizationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFxServiceStore_Expecter) GetOrganizationsAll(ctx interface{}) *MockFxServiceStore_GetOrganizationsAll_Call {
+	return &MockFxServiceStore_GetOrganizationsAll_Call{Call: _e.mock.On("GetOrganizationsAll", ctx)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationsAll_Call) Run(run func(ctx context.Context)) *MockFxServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationsAll_Call) Return(_a0 []models.Organization, _a1 error) *MockFxServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationsAll_Call) RunAndReturn(run func(context.Context) ([]models.Organization, error)) *MockFxServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationsByMemberId provides a mock function with given fields: ctx, memberId
+func (_m *MockFxServiceStore) GetOrganizationsByMemberId(ctx context.Context, memberId uuid.UUID) ([]models.Organization, error) {
+	ret := _m.Called(ctx, memberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationsByMemberId")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Organization, error)); ok {
+		return rf(ctx, memberId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Organization); ok {
+		r0 = rf(ctx, memberId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, memberId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetOrganizationsByMemberId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsByMemberId'
+type MockFxServiceStore_GetOrganizationsByMemberId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationsByMemberId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - memberId uuid.UUID
+func (_e *MockFxServiceStore_Expecter) GetOrganizationsByMemberId(ctx interface{}, memberId interface{}) *MockFxServiceStore_GetOrganizationsByMemberId_Call {
+	return &MockFxServiceStore_GetOrganizationsByMemberId_Call{Call: _e.mock.On("GetOrganizationsByMemberId", ctx, memberId)}
+}
+
+func (_c *MockFxServiceStore_GetOrganizationsByMemberId_Call) Run(run func(ctx context.Context, memberId uuid.UUID)) *MockFxServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationsByMemberId_Call) Return(_a0 []models.Organization, _a1 error) *MockFxServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetOrganizationsByMemberId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Organization, error)) *MockFxServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrimarySSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockFxServiceStore) GetPrimarySSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrimarySSOConfigByDomain")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetPrimarySSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrimarySSOConfigByDomain'
+type MockFxServiceStore_GetPrimarySSOConfigByDomain_Call struct {
+	*mock.Call
+}
+
+// GetPrimarySSOConfigByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domain string
+func (_e *MockFxServiceStore_Expecter) GetPrimarySSOConfigByDomain(ctx interface{}, domain interface{}) *MockFxServiceStore_GetPrimarySSOConfigByDomain_Call {
+	return &MockFxServiceStore_GetPrimarySSOConfigByDomain_Call{Call: _e.mock.On("GetPrimarySSOConfigByDomain", ctx, domain)}
+}
+
+func (_c *MockFxServiceStore_GetPrimarySSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockFxServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetPrimarySSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockFxServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetPrimarySSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockFxServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockFxServiceStore) GetSSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSSOConfigByDomain")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_GetSSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSSOConfigByDomain'
+type MockFxServiceStore_GetSSOConfigByDomain_Call struct {
+	*mock.Call
+}
+
+// GetSSOConfigByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domain string
+func (_e *MockFxServiceStore_Expecter) GetSSOConfigByDomain(ctx interface{}, domain interface{}) *MockFxServiceStore_GetSSOConfigByDomain_Call {
+	return &MockFxServiceStore_GetSSOConfigByDomain_Call{Call: _e.mock.On("GetSSOConfigByDomain", ctx, domain)}
+}
+
+func (_c *MockFxServiceStore_GetSSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockFxServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetSSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockFxServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_GetSSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockFxServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFxRateSourceImported provides a mock function with given fields: ctx, sourceId, importedAt
+func (_m *MockFxServiceStore) MarkFxRateSourceImported(ctx context.Context, sourceId uuid.UUID, importedAt time.Time) error {
+	ret := _m.Called(ctx, sourceId, importedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFxRateSourceImported")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, sourceId, importedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxServiceStore_MarkFxRateSourceImported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFxRateSourceImported'
+type MockFxServiceStore_MarkFxRateSourceImported_Call struct {
+	*mock.Call
+}
+
+// MarkFxRateSourceImported is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+//   - importedAt time.Time
+func (_e *MockFxServiceStore_Expecter) MarkFxRateSourceImported(ctx interface{}, sourceId interface{}, importedAt interface{}) *MockFxServiceStore_MarkFxRateSourceImported_Call {
+	return &MockFxServiceStore_MarkFxRateSourceImported_Call{Call: _e.mock.On("MarkFxRateSourceImported", ctx, sourceId, importedAt)}
+}
+
+func (_c *MockFxServiceStore_MarkFxRateSourceImported_Call) Run(run func(ctx context.Context, sourceId uuid.UUID, importedAt time.Time)) *MockFxServiceStore_MarkFxRateSourceImported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_MarkFxRateSourceImported_Call) Return(_a0 error) *MockFxServiceStore_MarkFxRateSourceImported_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxServiceStore_MarkFxRateSourceImported_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockFxServiceStore_MarkFxRateSourceImported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockFxServiceStore) UpdateDataset(ctx context.Context, dataset models.Dataset) (uuid.UUID, error) {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDataset")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) (uuid.UUID, error)); ok {
+		return rf(ctx, dataset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) uuid.UUID); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Dataset) error); ok {
+		r1 = rf(ctx, dataset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_UpdateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataset'
+type MockFxServiceStore_UpdateDataset_Call struct {
+	*mock.Call
+}
+
+// UpdateDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset models.Dataset
+func (_e *MockFxServiceStore_Expecter) UpdateDataset(ctx interface{}, dataset interface{}) *MockFxServiceStore_UpdateDataset_Call {
+	return &MockFxServiceStore_UpdateDataset_Call{Call: _e.mock.On("UpdateDataset", ctx, dataset)}
+}
+
+func (_c *MockFxServiceStore_UpdateDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockFxServiceStore_UpdateDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Dataset))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateDataset_Call) Return(_a0 uuid.UUID, _a1 error) *MockFxServiceStore_UpdateDataset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) (uuid.UUID, error)) *MockFxServiceStore_UpdateDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceId, privilege
+func (_m *MockFxServiceStore) UpdateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, datasetId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_UpdateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetPolicy'
+type MockFxServiceStore_UpdateDatasetPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockFxServiceStore_Expecter) UpdateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceId interface{}, privilege interface{}) *MockFxServiceStore_UpdateDatasetPolicy_Call {
+	return &MockFxServiceStore_UpdateDatasetPolicy_Call{Call: _e.mock.On("UpdateDatasetPolicy", ctx, datasetId, audienceId, privilege)}
+}
+
+func (_c *MockFxServiceStore_UpdateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockFxServiceStore_UpdateDatasetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockFxServiceStore_UpdateDatasetPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockFxServiceStore_UpdateDatasetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationCalendarSettings provides a mock function with given fields: ctx, organizationId, calendarSettings
+func (_m *MockFxServiceStore) UpdateOrganizationCalendarSettings(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, calendarSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationCalendarSettings")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, calendarSettings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, calendarSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, calendarSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_UpdateOrganizationCalendarSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationCalendarSettings'
+type MockFxServiceStore_UpdateOrganizationCalendarSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationCalendarSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - calendarSettings json.RawMessage
+func (_e *MockFxServiceStore_Expecter) UpdateOrganizationCalendarSettings(ctx interface{}, organizationId interface{}, calendarSettings interface{}) *MockFxServiceStore_UpdateOrganizationCalendarSettings_Call {
+	return &MockFxServiceStore_UpdateOrganizationCalendarSettings_Call{Call: _e.mock.On("UpdateOrganizationCalendarSettings", ctx, organizationId, calendarSettings)}
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationCalendarSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage)) *MockFxServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationCalendarSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockFxServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationCalendarSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockFxServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationExportSettings provides a mock function with given fields: ctx, organizationId, exportSettings
+func (_m *MockFxServiceStore) UpdateOrganizationExportSettings(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, exportSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationExportSettings")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, exportSettings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, exportSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, exportSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_UpdateOrganizationExportSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationExportSettings'
+type MockFxServiceStore_UpdateOrganizationExportSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationExportSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - exportSettings json.RawMessage
+func (_e *MockFxServiceStore_Expecter) UpdateOrganizationExportSettings(ctx interface{}, organizationId interface{}, exportSettings interface{}) *MockFxServiceStore_UpdateOrganizationExportSettings_Call {
+	return &MockFxServiceStore_UpdateOrganizationExportSettings_Call{Call: _e.mock.On("UpdateOrganizationExportSettings", ctx, organizationId, exportSettings)}
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationExportSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage)) *MockFxServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationExportSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockFxServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationExportSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockFxServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
+func (_m *MockFxServiceStore) UpdateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
+	ret := _m.Called(ctx, invitationId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationInvitationStatus")
+	}
+
+	var r0 *models.OrganizationInvitationStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
+		return rf(ctx, invitationId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
+		r0 = rf(ctx, invitationId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
+		r1 = rf(ctx, invitationId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_UpdateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationStatus'
+type MockFxServiceStore_UpdateOrganizationInvitationStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationInvitationStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+//   - status models.InvitationStatus
+func (_e *MockFxServiceStore_Expecter) UpdateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockFxServiceStore_UpdateOrganizationInvitationStatus_Call {
+	return &MockFxServiceStore_UpdateOrganizationInvitationStatus_Call{Call: _e.mock.On("UpdateOrganizationInvitationStatus", ctx, invitationId, status)}
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockFxServiceStore_UpdateOrganizationInvitationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockFxServiceStore_UpdateOrganizationInvitationStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockFxServiceStore_UpdateOrganizationInvitationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId, privilege
+func (_m *MockFxServiceStore) UpdateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, orgId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_UpdateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationPolicy'
+type MockFxServiceStore_UpdateOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockFxServiceStore_Expecter) UpdateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}, privilege interface{}) *MockFxServiceStore_UpdateOrganizationPolicy_Call {
+	return &MockFxServiceStore_UpdateOrganizationPolicy_Call{Call: _e.mock.On("UpdateOrganizationPolicy", ctx, orgId, audienceId, privilege)}
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockFxServiceStore_UpdateOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockFxServiceStore_UpdateOrganizationPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockFxServiceStore_UpdateOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePendingOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
+func (_m *MockFxServiceStore) UpdatePendingOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId, userId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePendingOrganizationMembershipRequest")
+	}
+
+	var r0 *models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId, userId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId, userId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
+		r1 = rf(ctx, organizationId, userId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePendingOrganizationMembershipRequest'
+type MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call struct {
+	*mock.Call
+}
+
+// UpdatePendingOrganizationMembershipRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+//   - status models.OrgMembershipStatus
+func (_e *MockFxServiceStore_Expecter) UpdatePendingOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	return &MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call{Call: _e.mock.On("UpdatePendingOrganizationMembershipRequest", ctx, organizationId, userId, status)}
+}
+
+func (_c *MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockFxServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertFxRates provides a mock function with given fields: ctx, orgId, sourceId, rates
+func (_m *MockFxServiceStore) UpsertFxRates(ctx context.Context, orgId uuid.UUID, sourceId uuid.UUID, rates []models.FxRateParams) error {
+	ret := _m.Called(ctx, orgId, sourceId, rates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertFxRates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []models.FxRateParams) error); ok {
+		r0 = rf(ctx, orgId, sourceId, rates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxServiceStore_UpsertFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertFxRates'
+type MockFxServiceStore_UpsertFxRates_Call struct {
+	*mock.Call
+}
+
+// UpsertFxRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - sourceId uuid.UUID
+//   - rates []models.FxRateParams
+func (_e *MockFxServiceStore_Expecter) UpsertFxRates(ctx interface{}, orgId interface{}, sourceId interface{}, rates interface{}) *MockFxServiceStore_UpsertFxRates_Call {
+	return &MockFxServiceStore_UpsertFxRates_Call{Call: _e.mock.On("UpsertFxRates", ctx, orgId, sourceId, rates)}
+}
+
+func (_c *MockFxServiceStore_UpsertFxRates_Call) Run(run func(ctx context.Context, orgId uuid.UUID, sourceId uuid.UUID, rates []models.FxRateParams)) *MockFxServiceStore_UpsertFxRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]models.FxRateParams))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpsertFxRates_Call) Return(_a0 error) *MockFxServiceStore_UpsertFxRates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxServiceStore_UpsertFxRates_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []models.FxRateParams) error) *MockFxServiceStore_UpsertFxRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithDatasetTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockFxServiceStore) WithDatasetTransaction(ctx context.Context, fn func(store.DatasetStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDatasetTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxServiceStore_WithDatasetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetTransaction'
+type MockFxServiceStore_WithDatasetTransaction_Call struct {
+	*mock.Call
+}
+
+// WithDatasetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.DatasetStore) error
+func (_e *MockFxServiceStore_Expecter) WithDatasetTransaction(ctx interface{}, fn interface{}) *MockFxServiceStore_WithDatasetTransaction_Call {
+	return &MockFxServiceStore_WithDatasetTransaction_Call{Call: _e.mock.On("WithDatasetTransaction", ctx, fn)}
+}
+
+func (_c *MockFxServiceStore_WithDatasetTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetStore) error)) *MockFxServiceStore_WithDatasetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.DatasetStore) error))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_WithDatasetTransaction_Call) Return(_a0 error) *MockFxServiceStore_WithDatasetTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxServiceStore_WithDatasetTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetStore) error) error) *MockFxServiceStore_WithDatasetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithOrganizationTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockFxServiceStore) WithOrganizationTransaction(ctx context.Context, fn func(store.OrganizationStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithOrganizationTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.OrganizationStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxServiceStore_WithOrganizationTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithOrganizationTransaction'
+type MockFxServiceStore_WithOrganizationTransaction_Call struct {
+	*mock.Call
+}
+
+// WithOrganizationTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.OrganizationStore) error
+func (_e *MockFxServiceStore_Expecter) WithOrganizationTransaction(ctx interface{}, fn interface{}) *MockFxServiceStore_WithOrganizationTransaction_Call {
+	return &MockFxServiceStore_WithOrganizationTransaction_Call{Call: _e.mock.On("WithOrganizationTransaction", ctx, fn)}
+}
+
+func (_c *MockFxServiceStore_WithOrganizationTransaction_Call) Run(run func(ctx context.Context, fn func(store.OrganizationStore) error)) *MockFxServiceStore_WithOrganizationTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.OrganizationStore) error))
+	})
+	return _c
+}
+
+func (_c *MockFxServiceStore_WithOrganizationTransaction_Call) Return(_a0 error) *MockFxServiceStore_WithOrganizationTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxServiceStore_WithOrganizationTransaction_Call) RunAndReturn(run func(context.Context, func(store.OrganizationStore) error) error) *MockFxServiceStore_WithOrganizationTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFxServiceStore creates a new instance of MockFxServiceStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFxServiceStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFxServiceStore {
+	mock := &MockFxServiceStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,6 +22,147 @@ func (_m *MockMailerService) EXPECT() *MockMailerService_Expecter {
 	return &MockMailerService_Expecter{mock: &_m.Mock}
 }
 
+// SendDatasetAlertEmail provides a mock function with given fields: ctx, data
+func (_m *MockMailerService) SendDatasetAlertEmail(ctx context.Context, data mailer.DatasetAlertEmailData) error {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDatasetAlertEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, mailer.DatasetAlertEmailData) error); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMailerService_SendDatasetAlertEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDatasetAlertEmail'
+type MockMailerService_SendDatasetAlertEmail_Call struct {
+	*mock.Call
+}
+
+// SendDatasetAlertEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data mailer.DatasetAlertEmailData
+func (_e *MockMailerService_Expecter) SendDatasetAlertEmail(ctx interface{}, data interface{}) *MockMailerService_SendDatasetAlertEmail_Call {
+	return &MockMailerService_SendDatasetAlertEmail_Call{Call: _e.mock.On("SendDatasetAlertEmail", ctx, data)}
+}
+
+func (_c *MockMailerService_SendDatasetAlertEmail_Call) Run(run func(ctx context.Context, data mailer.DatasetAlertEmailData)) *MockMailerService_SendDatasetAlertEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(mailer.DatasetAlertEmailData))
+	})
+	return _c
+}
+
+func (_c *MockMailerService_SendDatasetAlertEmail_Call) Return(_a0 error) *MockMailerService_SendDatasetAlertEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMailerService_SendDatasetAlertEmail_Call) RunAndReturn(run func(context.Context, mailer.DatasetAlertEmailData) error) *MockMailerService_SendDatasetAlertEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendDatasetExportEmail provides a mock function with given fields: ctx, data
+func (_m *MockMailerService) SendDatasetExportEmail(ctx context.Context, data mailer.DatasetExportEmailData) error {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDatasetExportEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, mailer.DatasetExportEmailData) error); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMailerService_SendDatasetExportEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDatasetExportEmail'
+type MockMailerService_SendDatasetExportEmail_Call struct {
+	*mock.Call
+}
+
+// SendDatasetExportEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data mailer.DatasetExportEmailData
+func (_e *MockMailerService_Expecter) SendDatasetExportEmail(ctx interface{}, data interface{}) *MockMailerService_SendDatasetExportEmail_Call {
+	return &MockMailerService_SendDatasetExportEmail_Call{Call: _e.mock.On("SendDatasetExportEmail", ctx, data)}
+}
+
+func (_c *MockMailerService_SendDatasetExportEmail_Call) Run(run func(ctx context.Context, data mailer.DatasetExportEmailData)) *MockMailerService_SendDatasetExportEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(mailer.DatasetExportEmailData))
+	})
+	return _c
+}
+
+func (_c *MockMailerService_SendDatasetExportEmail_Call) Return(_a0 error) *MockMailerService_SendDatasetExportEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMailerService_SendDatasetExportEmail_Call) RunAndReturn(run func(context.Context, mailer.DatasetExportEmailData) error) *MockMailerService_SendDatasetExportEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendDatasetExportFailureEmail provides a mock function with given fields: ctx, data
+func (_m *MockMailerService) SendDatasetExportFailureEmail(ctx context.Context, data mailer.DatasetExportFailureEmailData) error {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDatasetExportFailureEmail")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, mailer.DatasetExportFailureEmailData) error); ok {
+		r0 = rf(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMailerService_SendDatasetExportFailureEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDatasetExportFailureEmail'
+type MockMailerService_SendDatasetExportFailureEmail_Call struct {
+	*mock.Call
+}
+
+// SendDatasetExportFailureEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data mailer.DatasetExportFailureEmailData
+func (_e *MockMailerService_Expecter) SendDatasetExportFailureEmail(ctx interface{}, data interface{}) *MockMailerService_SendDatasetExportFailureEmail_Call {
+	return &MockMailerService_SendDatasetExportFailureEmail_Call{Call: _e.mock.On("SendDatasetExportFailureEmail", ctx, data)}
+}
+
+func (_c *MockMailerService_SendDatasetExportFailureEmail_Call) Run(run func(ctx context.Context, data mailer.DatasetExportFailureEmailData)) *MockMailerService_SendDatasetExportFailureEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(mailer.DatasetExportFailureEmailData))
+	})
+	return _c
+}
+
+func (_c *MockMailerService_SendDatasetExportFailureEmail_Call) Return(_a0 error) *MockMailerService_SendDatasetExportFailureEmail_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMailerService_SendDatasetExportFailureEmail_Call) RunAndReturn(run func(context.Context, mailer.DatasetExportFailureEmailData) error) *MockMailerService_SendDatasetExportFailureEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendInvitationEmail provides a mock function with given fields: ctx, data
 func (_m *MockMailerService) SendInvitationEmail(ctx context.Context, data mailer.InvitationEmailData) error {
 	ret := _m.Called(ctx, data)
@@ -5,9 +5,14 @@ package mock_organizations
 import (
 	context "context"
 
-	models "github.com/Zampfi/application-platform/services/api/db/models"
+	calendar "github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+
+	exports "github.com/Zampfi/application-platform/services/api/core/organizations/exports"
+
 	mock "github.com/stretchr/testify/mock"
 
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+
 	organizations "github.com/Zampfi/application-platform/services/api/core/organizations"
 
 	teams "github.com/Zampfi/application-platform/services/api/core/organizations/teams"
@@ -268,6 +273,120 @@ func (_c *MockOrganizationService_GetAllOrganizationInvitations_Call) RunAndRetu
 	return _c
 }
 
+// GetCalendarSettings provides a mock function with given fields: ctx, organizationId
+func (_m *MockOrganizationService) GetCalendarSettings(ctx context.Context, organizationId uuid.UUID) (calendar.Settings, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCalendarSettings")
+	}
+
+	var r0 calendar.Settings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (calendar.Settings, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) calendar.Settings); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		r0 = ret.Get(0).(calendar.Settings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationService_GetCalendarSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCalendarSettings'
+type MockOrganizationService_GetCalendarSettings_Call struct {
+	*mock.Call
+}
+
+// GetCalendarSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockOrganizationService_Expecter) GetCalendarSettings(ctx interface{}, organizationId interface{}) *MockOrganizationService_GetCalendarSettings_Call {
+	return &MockOrganizationService_GetCalendarSettings_Call{Call: _e.mock.On("GetCalendarSettings", ctx, organizationId)}
+}
+
+func (_c *MockOrganizationService_GetCalendarSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockOrganizationService_GetCalendarSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationService_GetCalendarSettings_Call) Return(_a0 calendar.Settings, _a1 error) *MockOrganizationService_GetCalendarSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationService_GetCalendarSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID) (calendar.Settings, error)) *MockOrganizationService_GetCalendarSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExportSettings provides a mock function with given fields: ctx, organizationId
+func (_m *MockOrganizationService) GetExportSettings(ctx context.Context, organizationId uuid.UUID) (exports.Settings, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExportSettings")
+	}
+
+	var r0 exports.Settings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (exports.Settings, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) exports.Settings); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		r0 = ret.Get(0).(exports.Settings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationService_GetExportSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportSettings'
+type MockOrganizationService_GetExportSettings_Call struct {
+	*mock.Call
+}
+
+// GetExportSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockOrganizationService_Expecter) GetExportSettings(ctx interface{}, organizationId interface{}) *MockOrganizationService_GetExportSettings_Call {
+	return &MockOrganizationService_GetExportSettings_Call{Call: _e.mock.On("GetExportSettings", ctx, organizationId)}
+}
+
+func (_c *MockOrganizationService_GetExportSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockOrganizationService_GetExportSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationService_GetExportSettings_Call) Return(_a0 exports.Settings, _a1 error) *MockOrganizationService_GetExportSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationService_GetExportSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID) (exports.Settings, error)) *MockOrganizationService_GetExportSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrganizationAudiences provides a mock function with given fields: ctx, organizationId
 func (_m *MockOrganizationService) GetOrganizationAudiences(ctx context.Context, organizationId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
 	ret := _m.Called(ctx, organizationId)
@@ -597,6 +716,122 @@ func (_c *MockOrganizationService_TeamService_Call) RunAndReturn(run func() team
 	return _c
 }
 
+// UpdateCalendarSettings provides a mock function with given fields: ctx, organizationId, settings
+func (_m *MockOrganizationService) UpdateCalendarSettings(ctx context.Context, organizationId uuid.UUID, settings calendar.Settings) (calendar.Settings, error) {
+	ret := _m.Called(ctx, organizationId, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCalendarSettings")
+	}
+
+	var r0 calendar.Settings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, calendar.Settings) (calendar.Settings, error)); ok {
+		return rf(ctx, organizationId, settings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, calendar.Settings) calendar.Settings); ok {
+		r0 = rf(ctx, organizationId, settings)
+	} else {
+		r0 = ret.Get(0).(calendar.Settings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, calendar.Settings) error); ok {
+		r1 = rf(ctx, organizationId, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationService_UpdateCalendarSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCalendarSettings'
+type MockOrganizationService_UpdateCalendarSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateCalendarSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - settings calendar.Settings
+func (_e *MockOrganizationService_Expecter) UpdateCalendarSettings(ctx interface{}, organizationId interface{}, settings interface{}) *MockOrganizationService_UpdateCalendarSettings_Call {
+	return &MockOrganizationService_UpdateCalendarSettings_Call{Call: _e.mock.On("UpdateCalendarSettings", ctx, organizationId, settings)}
+}
+
+func (_c *MockOrganizationService_UpdateCalendarSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, settings calendar.Settings)) *MockOrganizationService_UpdateCalendarSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(calendar.Settings))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationService_UpdateCalendarSettings_Call) Return(_a0 calendar.Settings, _a1 error) *MockOrganizationService_UpdateCalendarSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationService_UpdateCalendarSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, calendar.Settings) (calendar.Settings, error)) *MockOrganizationService_UpdateCalendarSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateExportSettings provides a mock function with given fields: ctx, organizationId, settings
+func (_m *MockOrganizationService) UpdateExportSettings(ctx context.Context, organizationId uuid.UUID, settings exports.Settings) (exports.Settings, error) {
+	ret := _m.Called(ctx, organizationId, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExportSettings")
+	}
+
+	var r0 exports.Settings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, exports.Settings) (exports.Settings, error)); ok {
+		return rf(ctx, organizationId, settings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, exports.Settings) exports.Settings); ok {
+		r0 = rf(ctx, organizationId, settings)
+	} else {
+		r0 = ret.Get(0).(exports.Settings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, exports.Settings) error); ok {
+		r1 = rf(ctx, organizationId, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrganizationService_UpdateExportSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateExportSettings'
+type MockOrganizationService_UpdateExportSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateExportSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - settings exports.Settings
+func (_e *MockOrganizationService_Expecter) UpdateExportSettings(ctx interface{}, organizationId interface{}, settings interface{}) *MockOrganizationService_UpdateExportSettings_Call {
+	return &MockOrganizationService_UpdateExportSettings_Call{Call: _e.mock.On("UpdateExportSettings", ctx, organizationId, settings)}
+}
+
+func (_c *MockOrganizationService_UpdateExportSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, settings exports.Settings)) *MockOrganizationService_UpdateExportSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(exports.Settings))
+	})
+	return _c
+}
+
+func (_c *MockOrganizationService_UpdateExportSettings_Call) Return(_a0 exports.Settings, _a1 error) *MockOrganizationService_UpdateExportSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrganizationService_UpdateExportSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, exports.Settings) (exports.Settings, error)) *MockOrganizationService_UpdateExportSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateMemberRole provides a mock function with given fields: ctx, organizationId, userId, privilege
 func (_m *MockOrganizationService) UpdateMemberRole(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
 	ret := _m.Called(ctx, organizationId, userId, privilege)
@@ -148,6 +148,172 @@ func (_c *MockPagesService_BulkAddAudienceToPage_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// CreatePage provides a mock function with given fields: ctx, payload
+func (_m *MockPagesService) CreatePage(ctx context.Context, payload pages.CreatePagePayload) (*models.Page, error) {
+	ret := _m.Called(ctx, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePage")
+	}
+
+	var r0 *models.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pages.CreatePagePayload) (*models.Page, error)); ok {
+		return rf(ctx, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pages.CreatePagePayload) *models.Page); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pages.CreatePagePayload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesService_CreatePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePage'
+type MockPagesService_CreatePage_Call struct {
+	*mock.Call
+}
+
+// CreatePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payload pages.CreatePagePayload
+func (_e *MockPagesService_Expecter) CreatePage(ctx interface{}, payload interface{}) *MockPagesService_CreatePage_Call {
+	return &MockPagesService_CreatePage_Call{Call: _e.mock.On("CreatePage", ctx, payload)}
+}
+
+func (_c *MockPagesService_CreatePage_Call) Run(run func(ctx context.Context, payload pages.CreatePagePayload)) *MockPagesService_CreatePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pages.CreatePagePayload))
+	})
+	return _c
+}
+
+func (_c *MockPagesService_CreatePage_Call) Return(_a0 *models.Page, _a1 error) *MockPagesService_CreatePage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesService_CreatePage_Call) RunAndReturn(run func(context.Context, pages.CreatePagePayload) (*models.Page, error)) *MockPagesService_CreatePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePage provides a mock function with given fields: ctx, pageId
+func (_m *MockPagesService) DeletePage(ctx context.Context, pageId uuid.UUID) error {
+	ret := _m.Called(ctx, pageId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, pageId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPagesService_DeletePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePage'
+type MockPagesService_DeletePage_Call struct {
+	*mock.Call
+}
+
+// DeletePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+func (_e *MockPagesService_Expecter) DeletePage(ctx interface{}, pageId interface{}) *MockPagesService_DeletePage_Call {
+	return &MockPagesService_DeletePage_Call{Call: _e.mock.On("DeletePage", ctx, pageId)}
+}
+
+func (_c *MockPagesService_DeletePage_Call) Run(run func(ctx context.Context, pageId uuid.UUID)) *MockPagesService_DeletePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPagesService_DeletePage_Call) Return(_a0 error) *MockPagesService_DeletePage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPagesService_DeletePage_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockPagesService_DeletePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DuplicatePage provides a mock function with given fields: ctx, pageId, name
+func (_m *MockPagesService) DuplicatePage(ctx context.Context, pageId uuid.UUID, name string) (*models.Page, error) {
+	ret := _m.Called(ctx, pageId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DuplicatePage")
+	}
+
+	var r0 *models.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*models.Page, error)); ok {
+		return rf(ctx, pageId, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *models.Page); ok {
+		r0 = rf(ctx, pageId, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, pageId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesService_DuplicatePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicatePage'
+type MockPagesService_DuplicatePage_Call struct {
+	*mock.Call
+}
+
+// DuplicatePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+//   - name string
+func (_e *MockPagesService_Expecter) DuplicatePage(ctx interface{}, pageId interface{}, name interface{}) *MockPagesService_DuplicatePage_Call {
+	return &MockPagesService_DuplicatePage_Call{Call: _e.mock.On("DuplicatePage", ctx, pageId, name)}
+}
+
+func (_c *MockPagesService_DuplicatePage_Call) Run(run func(ctx context.Context, pageId uuid.UUID, name string)) *MockPagesService_DuplicatePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPagesService_DuplicatePage_Call) Return(_a0 *models.Page, _a1 error) *MockPagesService_DuplicatePage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesService_DuplicatePage_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*models.Page, error)) *MockPagesService_DuplicatePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPageAudiences provides a mock function with given fields: ctx, pageId
 func (_m *MockPagesService) GetPageAudiences(ctx context.Context, pageId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
 	ret := _m.Called(ctx, pageId)
@@ -431,6 +597,66 @@ func (_c *MockPagesService_RemoveAudienceFromPage_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// UpdatePage provides a mock function with given fields: ctx, pageId, params
+func (_m *MockPagesService) UpdatePage(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams) (*models.Page, error) {
+	ret := _m.Called(ctx, pageId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePage")
+	}
+
+	var r0 *models.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePageParams) (*models.Page, error)); ok {
+		return rf(ctx, pageId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePageParams) *models.Page); ok {
+		r0 = rf(ctx, pageId, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdatePageParams) error); ok {
+		r1 = rf(ctx, pageId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesService_UpdatePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePage'
+type MockPagesService_UpdatePage_Call struct {
+	*mock.Call
+}
+
+// UpdatePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+//   - params models.UpdatePageParams
+func (_e *MockPagesService_Expecter) UpdatePage(ctx interface{}, pageId interface{}, params interface{}) *MockPagesService_UpdatePage_Call {
+	return &MockPagesService_UpdatePage_Call{Call: _e.mock.On("UpdatePage", ctx, pageId, params)}
+}
+
+func (_c *MockPagesService_UpdatePage_Call) Run(run func(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams)) *MockPagesService_UpdatePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdatePageParams))
+	})
+	return _c
+}
+
+func (_c *MockPagesService_UpdatePage_Call) Return(_a0 *models.Page, _a1 error) *MockPagesService_UpdatePage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesService_UpdatePage_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdatePageParams) (*models.Page, error)) *MockPagesService_UpdatePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePageAudiencePrivilege provides a mock function with given fields: ctx, pageId, audienceId, privilege
 func (_m *MockPagesService) UpdatePageAudiencePrivilege(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
 	ret := _m.Called(ctx, pageId, audienceId, privilege)
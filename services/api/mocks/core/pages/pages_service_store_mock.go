@@ -148,6 +148,171 @@ func (_c *MockPagesServiceStore_CreatePagePolicy_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// CreateSheet provides a mock function with given fields: ctx, sheet
+func (_m *MockPagesServiceStore) CreateSheet(ctx context.Context, sheet models.Sheet) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSheet")
+	}
+
+	var r0 *models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) (*models.Sheet, error)); ok {
+		return rf(ctx, sheet)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) *models.Sheet); ok {
+		r0 = rf(ctx, sheet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Sheet) error); ok {
+		r1 = rf(ctx, sheet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_CreateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSheet'
+type MockPagesServiceStore_CreateSheet_Call struct {
+	*mock.Call
+}
+
+// CreateSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheet models.Sheet
+func (_e *MockPagesServiceStore_Expecter) CreateSheet(ctx interface{}, sheet interface{}) *MockPagesServiceStore_CreateSheet_Call {
+	return &MockPagesServiceStore_CreateSheet_Call{Call: _e.mock.On("CreateSheet", ctx, sheet)}
+}
+
+func (_c *MockPagesServiceStore_CreateSheet_Call) Run(run func(ctx context.Context, sheet models.Sheet)) *MockPagesServiceStore_CreateSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Sheet))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_CreateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockPagesServiceStore_CreateSheet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_CreateSheet_Call) RunAndReturn(run func(context.Context, models.Sheet) (*models.Sheet, error)) *MockPagesServiceStore_CreateSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
+func (_m *MockPagesServiceStore) CreateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWidgetInstance")
+	}
+
+	var r0 *models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstance)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
+		r1 = rf(ctx, widgetInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_CreateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWidgetInstance'
+type MockPagesServiceStore_CreateWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// CreateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstance *models.WidgetInstance
+func (_e *MockPagesServiceStore_Expecter) CreateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockPagesServiceStore_CreateWidgetInstance_Call {
+	return &MockPagesServiceStore_CreateWidgetInstance_Call{Call: _e.mock.On("CreateWidgetInstance", ctx, widgetInstance)}
+}
+
+func (_c *MockPagesServiceStore_CreateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockPagesServiceStore_CreateWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_CreateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockPagesServiceStore_CreateWidgetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_CreateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockPagesServiceStore_CreateWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePage provides a mock function with given fields: ctx, pageId
+func (_m *MockPagesServiceStore) DeletePage(ctx context.Context, pageId uuid.UUID) error {
+	ret := _m.Called(ctx, pageId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, pageId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPagesServiceStore_DeletePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePage'
+type MockPagesServiceStore_DeletePage_Call struct {
+	*mock.Call
+}
+
+// DeletePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+func (_e *MockPagesServiceStore_Expecter) DeletePage(ctx interface{}, pageId interface{}) *MockPagesServiceStore_DeletePage_Call {
+	return &MockPagesServiceStore_DeletePage_Call{Call: _e.mock.On("DeletePage", ctx, pageId)}
+}
+
+func (_c *MockPagesServiceStore_DeletePage_Call) Run(run func(ctx context.Context, pageId uuid.UUID)) *MockPagesServiceStore_DeletePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_DeletePage_Call) Return(_a0 error) *MockPagesServiceStore_DeletePage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_DeletePage_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockPagesServiceStore_DeletePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeletePagePolicy provides a mock function with given fields: ctx, pageId, audienceType, audienceId
 func (_m *MockPagesServiceStore) DeletePagePolicy(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
 	ret := _m.Called(ctx, pageId, audienceType, audienceId)
@@ -197,6 +362,159 @@ func (_c *MockPagesServiceStore_DeletePagePolicy_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// DeleteSheet provides a mock function with given fields: ctx, sheetId
+func (_m *MockPagesServiceStore) DeleteSheet(ctx context.Context, sheetId uuid.UUID) error {
+	ret := _m.Called(ctx, sheetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSheet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sheetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPagesServiceStore_DeleteSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSheet'
+type MockPagesServiceStore_DeleteSheet_Call struct {
+	*mock.Call
+}
+
+// DeleteSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+func (_e *MockPagesServiceStore_Expecter) DeleteSheet(ctx interface{}, sheetId interface{}) *MockPagesServiceStore_DeleteSheet_Call {
+	return &MockPagesServiceStore_DeleteSheet_Call{Call: _e.mock.On("DeleteSheet", ctx, sheetId)}
+}
+
+func (_c *MockPagesServiceStore_DeleteSheet_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockPagesServiceStore_DeleteSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_DeleteSheet_Call) Return(_a0 error) *MockPagesServiceStore_DeleteSheet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_DeleteSheet_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockPagesServiceStore_DeleteSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWidgetInstance provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockPagesServiceStore) DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidgetInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPagesServiceStore_DeleteWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWidgetInstance'
+type MockPagesServiceStore_DeleteWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// DeleteWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockPagesServiceStore_Expecter) DeleteWidgetInstance(ctx interface{}, widgetInstanceID interface{}) *MockPagesServiceStore_DeleteWidgetInstance_Call {
+	return &MockPagesServiceStore_DeleteWidgetInstance_Call{Call: _e.mock.On("DeleteWidgetInstance", ctx, widgetInstanceID)}
+}
+
+func (_c *MockPagesServiceStore_DeleteWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockPagesServiceStore_DeleteWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_DeleteWidgetInstance_Call) Return(_a0 error) *MockPagesServiceStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_DeleteWidgetInstance_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockPagesServiceStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlattenedResourceAudiencePolicies provides a mock function with given fields: ctx, filters
+func (_m *MockPagesServiceStore) GetFlattenedResourceAudiencePolicies(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlattenedResourceAudiencePolicies")
+	}
+
+	var r0 []models.FlattenedResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) []models.FlattenedResourceAudiencePolicy); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FlattenedResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlattenedResourceAudiencePolicies'
+type MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call struct {
+	*mock.Call
+}
+
+// GetFlattenedResourceAudiencePolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FlattenedResourceAudiencePoliciesFilters
+func (_e *MockPagesServiceStore_Expecter) GetFlattenedResourceAudiencePolicies(ctx interface{}, filters interface{}) *MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	return &MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call{Call: _e.mock.On("GetFlattenedResourceAudiencePolicies", ctx, filters)}
+}
+
+func (_c *MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call) Run(run func(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters)) *MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FlattenedResourceAudiencePoliciesFilters))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call) Return(_a0 []models.FlattenedResourceAudiencePolicy, _a1 error) *MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call) RunAndReturn(run func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)) *MockPagesServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPageById provides a mock function with given fields: ctx, pageId
 func (_m *MockPagesServiceStore) GetPageById(ctx context.Context, pageId uuid.UUID) (*models.Page, error) {
 	ret := _m.Called(ctx, pageId)
@@ -493,29 +811,29 @@ func (_c *MockPagesServiceStore_GetPagesPolicies_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// UpdatePagePolicy provides a mock function with given fields: ctx, pageId, audienceId, privilege
-func (_m *MockPagesServiceStore) UpdatePagePolicy(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, pageId, audienceId, privilege)
+// GetSheetById provides a mock function with given fields: ctx, sheetId
+func (_m *MockPagesServiceStore) GetSheetById(ctx context.Context, sheetId uuid.UUID) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheetId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePagePolicy")
+		panic("no return value specified for GetSheetById")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
+	var r0 *models.Sheet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, pageId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Sheet, error)); ok {
+		return rf(ctx, sheetId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, pageId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Sheet); ok {
+		r0 = rf(ctx, sheetId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+			r0 = ret.Get(0).(*models.Sheet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, pageId, audienceId, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sheetId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -523,53 +841,463 @@ func (_m *MockPagesServiceStore) UpdatePagePolicy(ctx context.Context, pageId uu
 	return r0, r1
 }
 
-// MockPagesServiceStore_UpdatePagePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePagePolicy'
-type MockPagesServiceStore_UpdatePagePolicy_Call struct {
+// MockPagesServiceStore_GetSheetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetById'
+type MockPagesServiceStore_GetSheetById_Call struct {
 	*mock.Call
 }
 
-// UpdatePagePolicy is a helper method to define mock.On call
+// GetSheetById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pageId uuid.UUID
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockPagesServiceStore_Expecter) UpdatePagePolicy(ctx interface{}, pageId interface{}, audienceId interface{}, privilege interface{}) *MockPagesServiceStore_UpdatePagePolicy_Call {
-	return &MockPagesServiceStore_UpdatePagePolicy_Call{Call: _e.mock.On("UpdatePagePolicy", ctx, pageId, audienceId, privilege)}
+//   - sheetId uuid.UUID
+func (_e *MockPagesServiceStore_Expecter) GetSheetById(ctx interface{}, sheetId interface{}) *MockPagesServiceStore_GetSheetById_Call {
+	return &MockPagesServiceStore_GetSheetById_Call{Call: _e.mock.On("GetSheetById", ctx, sheetId)}
 }
 
-func (_c *MockPagesServiceStore_UpdatePagePolicy_Call) Run(run func(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockPagesServiceStore_UpdatePagePolicy_Call {
+func (_c *MockPagesServiceStore_GetSheetById_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockPagesServiceStore_GetSheetById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockPagesServiceStore_UpdatePagePolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockPagesServiceStore_UpdatePagePolicy_Call {
+func (_c *MockPagesServiceStore_GetSheetById_Call) Return(_a0 *models.Sheet, _a1 error) *MockPagesServiceStore_GetSheetById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockPagesServiceStore_UpdatePagePolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockPagesServiceStore_UpdatePagePolicy_Call {
+func (_c *MockPagesServiceStore_GetSheetById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Sheet, error)) *MockPagesServiceStore_GetSheetById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithPageTransaction provides a mock function with given fields: ctx, fn
-func (_m *MockPagesServiceStore) WithPageTransaction(ctx context.Context, fn func(store.PageStore) error) error {
-	ret := _m.Called(ctx, fn)
+// GetSheetsAll provides a mock function with given fields: ctx, filters
+func (_m *MockPagesServiceStore) GetSheetsAll(ctx context.Context, filters models.SheetFilters) ([]models.Sheet, error) {
+	ret := _m.Called(ctx, filters)
 
 	if len(ret) == 0 {
-		panic("no return value specified for WithPageTransaction")
+		panic("no return value specified for GetSheetsAll")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, func(store.PageStore) error) error); ok {
-		r0 = rf(ctx, fn)
+	var r0 []models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) ([]models.Sheet, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) []models.Sheet); ok {
+		r0 = rf(ctx, filters)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Sheet)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, models.SheetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_GetSheetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetsAll'
+type MockPagesServiceStore_GetSheetsAll_Call struct {
+	*mock.Call
+}
+
+// GetSheetsAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.SheetFilters
+func (_e *MockPagesServiceStore_Expecter) GetSheetsAll(ctx interface{}, filters interface{}) *MockPagesServiceStore_GetSheetsAll_Call {
+	return &MockPagesServiceStore_GetSheetsAll_Call{Call: _e.mock.On("GetSheetsAll", ctx, filters)}
+}
+
+func (_c *MockPagesServiceStore_GetSheetsAll_Call) Run(run func(ctx context.Context, filters models.SheetFilters)) *MockPagesServiceStore_GetSheetsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SheetFilters))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_GetSheetsAll_Call) Return(_a0 []models.Sheet, _a1 error) *MockPagesServiceStore_GetSheetsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_GetSheetsAll_Call) RunAndReturn(run func(context.Context, models.SheetFilters) ([]models.Sheet, error)) *MockPagesServiceStore_GetSheetsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWidgetInstanceByID provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockPagesServiceStore) GetWidgetInstanceByID(ctx context.Context, widgetInstanceID uuid.UUID) (models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWidgetInstanceByID")
+	}
+
+	var r0 models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstanceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		r0 = ret.Get(0).(models.WidgetInstance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, widgetInstanceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_GetWidgetInstanceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetInstanceByID'
+type MockPagesServiceStore_GetWidgetInstanceByID_Call struct {
+	*mock.Call
+}
+
+// GetWidgetInstanceByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockPagesServiceStore_Expecter) GetWidgetInstanceByID(ctx interface{}, widgetInstanceID interface{}) *MockPagesServiceStore_GetWidgetInstanceByID_Call {
+	return &MockPagesServiceStore_GetWidgetInstanceByID_Call{Call: _e.mock.On("GetWidgetInstanceByID", ctx, widgetInstanceID)}
+}
+
+func (_c *MockPagesServiceStore_GetWidgetInstanceByID_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockPagesServiceStore_GetWidgetInstanceByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_GetWidgetInstanceByID_Call) Return(_a0 models.WidgetInstance, _a1 error) *MockPagesServiceStore_GetWidgetInstanceByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_GetWidgetInstanceByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.WidgetInstance, error)) *MockPagesServiceStore_GetWidgetInstanceByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWidgetTemplate provides a mock function with given fields: ctx, widgetType
+func (_m *MockPagesServiceStore) GetWidgetTemplate(ctx context.Context, widgetType string) (models.Widget, error) {
+	ret := _m.Called(ctx, widgetType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWidgetTemplate")
+	}
+
+	var r0 models.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Widget, error)); ok {
+		return rf(ctx, widgetType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Widget); ok {
+		r0 = rf(ctx, widgetType)
+	} else {
+		r0 = ret.Get(0).(models.Widget)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, widgetType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_GetWidgetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetTemplate'
+type MockPagesServiceStore_GetWidgetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetWidgetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetType string
+func (_e *MockPagesServiceStore_Expecter) GetWidgetTemplate(ctx interface{}, widgetType interface{}) *MockPagesServiceStore_GetWidgetTemplate_Call {
+	return &MockPagesServiceStore_GetWidgetTemplate_Call{Call: _e.mock.On("GetWidgetTemplate", ctx, widgetType)}
+}
+
+func (_c *MockPagesServiceStore_GetWidgetTemplate_Call) Run(run func(ctx context.Context, widgetType string)) *MockPagesServiceStore_GetWidgetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_GetWidgetTemplate_Call) Return(_a0 models.Widget, _a1 error) *MockPagesServiceStore_GetWidgetTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_GetWidgetTemplate_Call) RunAndReturn(run func(context.Context, string) (models.Widget, error)) *MockPagesServiceStore_GetWidgetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePage provides a mock function with given fields: ctx, pageId, params
+func (_m *MockPagesServiceStore) UpdatePage(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams) (*models.Page, error) {
+	ret := _m.Called(ctx, pageId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePage")
+	}
+
+	var r0 *models.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePageParams) (*models.Page, error)); ok {
+		return rf(ctx, pageId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePageParams) *models.Page); ok {
+		r0 = rf(ctx, pageId, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdatePageParams) error); ok {
+		r1 = rf(ctx, pageId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_UpdatePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePage'
+type MockPagesServiceStore_UpdatePage_Call struct {
+	*mock.Call
+}
+
+// UpdatePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+//   - params models.UpdatePageParams
+func (_e *MockPagesServiceStore_Expecter) UpdatePage(ctx interface{}, pageId interface{}, params interface{}) *MockPagesServiceStore_UpdatePage_Call {
+	return &MockPagesServiceStore_UpdatePage_Call{Call: _e.mock.On("UpdatePage", ctx, pageId, params)}
+}
+
+func (_c *MockPagesServiceStore_UpdatePage_Call) Run(run func(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams)) *MockPagesServiceStore_UpdatePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdatePageParams))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_UpdatePage_Call) Return(_a0 *models.Page, _a1 error) *MockPagesServiceStore_UpdatePage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_UpdatePage_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdatePageParams) (*models.Page, error)) *MockPagesServiceStore_UpdatePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePagePolicy provides a mock function with given fields: ctx, pageId, audienceId, privilege
+func (_m *MockPagesServiceStore) UpdatePagePolicy(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, pageId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePagePolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, pageId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, pageId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, pageId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_UpdatePagePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePagePolicy'
+type MockPagesServiceStore_UpdatePagePolicy_Call struct {
+	*mock.Call
+}
+
+// UpdatePagePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockPagesServiceStore_Expecter) UpdatePagePolicy(ctx interface{}, pageId interface{}, audienceId interface{}, privilege interface{}) *MockPagesServiceStore_UpdatePagePolicy_Call {
+	return &MockPagesServiceStore_UpdatePagePolicy_Call{Call: _e.mock.On("UpdatePagePolicy", ctx, pageId, audienceId, privilege)}
+}
+
+func (_c *MockPagesServiceStore_UpdatePagePolicy_Call) Run(run func(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockPagesServiceStore_UpdatePagePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_UpdatePagePolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockPagesServiceStore_UpdatePagePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_UpdatePagePolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockPagesServiceStore_UpdatePagePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSheet provides a mock function with given fields: ctx, sheet
+func (_m *MockPagesServiceStore) UpdateSheet(ctx context.Context, sheet *models.Sheet) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSheet")
+	}
+
+	var r0 *models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) (*models.Sheet, error)); ok {
+		return rf(ctx, sheet)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) *models.Sheet); ok {
+		r0 = rf(ctx, sheet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Sheet) error); ok {
+		r1 = rf(ctx, sheet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_UpdateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSheet'
+type MockPagesServiceStore_UpdateSheet_Call struct {
+	*mock.Call
+}
+
+// UpdateSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheet *models.Sheet
+func (_e *MockPagesServiceStore_Expecter) UpdateSheet(ctx interface{}, sheet interface{}) *MockPagesServiceStore_UpdateSheet_Call {
+	return &MockPagesServiceStore_UpdateSheet_Call{Call: _e.mock.On("UpdateSheet", ctx, sheet)}
+}
+
+func (_c *MockPagesServiceStore_UpdateSheet_Call) Run(run func(ctx context.Context, sheet *models.Sheet)) *MockPagesServiceStore_UpdateSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Sheet))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_UpdateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockPagesServiceStore_UpdateSheet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_UpdateSheet_Call) RunAndReturn(run func(context.Context, *models.Sheet) (*models.Sheet, error)) *MockPagesServiceStore_UpdateSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
+func (_m *MockPagesServiceStore) UpdateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWidgetInstance")
+	}
+
+	var r0 *models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstance)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
+		r1 = rf(ctx, widgetInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPagesServiceStore_UpdateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWidgetInstance'
+type MockPagesServiceStore_UpdateWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// UpdateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstance *models.WidgetInstance
+func (_e *MockPagesServiceStore_Expecter) UpdateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockPagesServiceStore_UpdateWidgetInstance_Call {
+	return &MockPagesServiceStore_UpdateWidgetInstance_Call{Call: _e.mock.On("UpdateWidgetInstance", ctx, widgetInstance)}
+}
+
+func (_c *MockPagesServiceStore_UpdateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockPagesServiceStore_UpdateWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_UpdateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockPagesServiceStore_UpdateWidgetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_UpdateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockPagesServiceStore_UpdateWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithPageTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockPagesServiceStore) WithPageTransaction(ctx context.Context, fn func(store.PageStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithPageTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.PageStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // MockPagesServiceStore_WithPageTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithPageTransaction'
@@ -601,6 +1329,53 @@ func (_c *MockPagesServiceStore_WithPageTransaction_Call) RunAndReturn(run func(
 	return _c
 }
 
+// WithTx provides a mock function with given fields: ctx, fn
+func (_m *MockPagesServiceStore) WithTx(ctx context.Context, fn func(store.Store) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.Store) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPagesServiceStore_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockPagesServiceStore_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.Store) error
+func (_e *MockPagesServiceStore_Expecter) WithTx(ctx interface{}, fn interface{}) *MockPagesServiceStore_WithTx_Call {
+	return &MockPagesServiceStore_WithTx_Call{Call: _e.mock.On("WithTx", ctx, fn)}
+}
+
+func (_c *MockPagesServiceStore_WithTx_Call) Run(run func(ctx context.Context, fn func(store.Store) error)) *MockPagesServiceStore_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.Store) error))
+	})
+	return _c
+}
+
+func (_c *MockPagesServiceStore_WithTx_Call) Return(_a0 error) *MockPagesServiceStore_WithTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPagesServiceStore_WithTx_Call) RunAndReturn(run func(context.Context, func(store.Store) error) error) *MockPagesServiceStore_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockPagesServiceStore creates a new instance of MockPagesServiceStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockPagesServiceStore(t interface {
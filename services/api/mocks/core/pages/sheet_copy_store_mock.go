@@ -0,0 +1,601 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_pages
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockSheetCopyStore is an autogenerated mock type for the SheetCopyStore type
+type MockSheetCopyStore struct {
+	mock.Mock
+}
+
+type MockSheetCopyStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSheetCopyStore) EXPECT() *MockSheetCopyStore_Expecter {
+	return &MockSheetCopyStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateSheet provides a mock function with given fields: ctx, sheet
+func (_m *MockSheetCopyStore) CreateSheet(ctx context.Context, sheet models.Sheet) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSheet")
+	}
+
+	var r0 *models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) (*models.Sheet, error)); ok {
+		return rf(ctx, sheet)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) *models.Sheet); ok {
+		r0 = rf(ctx, sheet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Sheet) error); ok {
+		r1 = rf(ctx, sheet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetCopyStore_CreateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSheet'
+type MockSheetCopyStore_CreateSheet_Call struct {
+	*mock.Call
+}
+
+// CreateSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheet models.Sheet
+func (_e *MockSheetCopyStore_Expecter) CreateSheet(ctx interface{}, sheet interface{}) *MockSheetCopyStore_CreateSheet_Call {
+	return &MockSheetCopyStore_CreateSheet_Call{Call: _e.mock.On("CreateSheet", ctx, sheet)}
+}
+
+func (_c *MockSheetCopyStore_CreateSheet_Call) Run(run func(ctx context.Context, sheet models.Sheet)) *MockSheetCopyStore_CreateSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Sheet))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_CreateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockSheetCopyStore_CreateSheet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_CreateSheet_Call) RunAndReturn(run func(context.Context, models.Sheet) (*models.Sheet, error)) *MockSheetCopyStore_CreateSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
+func (_m *MockSheetCopyStore) CreateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWidgetInstance")
+	}
+
+	var r0 *models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstance)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
+		r1 = rf(ctx, widgetInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetCopyStore_CreateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWidgetInstance'
+type MockSheetCopyStore_CreateWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// CreateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstance *models.WidgetInstance
+func (_e *MockSheetCopyStore_Expecter) CreateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockSheetCopyStore_CreateWidgetInstance_Call {
+	return &MockSheetCopyStore_CreateWidgetInstance_Call{Call: _e.mock.On("CreateWidgetInstance", ctx, widgetInstance)}
+}
+
+func (_c *MockSheetCopyStore_CreateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockSheetCopyStore_CreateWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_CreateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockSheetCopyStore_CreateWidgetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_CreateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockSheetCopyStore_CreateWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSheet provides a mock function with given fields: ctx, sheetId
+func (_m *MockSheetCopyStore) DeleteSheet(ctx context.Context, sheetId uuid.UUID) error {
+	ret := _m.Called(ctx, sheetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSheet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sheetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSheetCopyStore_DeleteSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSheet'
+type MockSheetCopyStore_DeleteSheet_Call struct {
+	*mock.Call
+}
+
+// DeleteSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+func (_e *MockSheetCopyStore_Expecter) DeleteSheet(ctx interface{}, sheetId interface{}) *MockSheetCopyStore_DeleteSheet_Call {
+	return &MockSheetCopyStore_DeleteSheet_Call{Call: _e.mock.On("DeleteSheet", ctx, sheetId)}
+}
+
+func (_c *MockSheetCopyStore_DeleteSheet_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockSheetCopyStore_DeleteSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_DeleteSheet_Call) Return(_a0 error) *MockSheetCopyStore_DeleteSheet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_DeleteSheet_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockSheetCopyStore_DeleteSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWidgetInstance provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockSheetCopyStore) DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidgetInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSheetCopyStore_DeleteWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWidgetInstance'
+type MockSheetCopyStore_DeleteWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// DeleteWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockSheetCopyStore_Expecter) DeleteWidgetInstance(ctx interface{}, widgetInstanceID interface{}) *MockSheetCopyStore_DeleteWidgetInstance_Call {
+	return &MockSheetCopyStore_DeleteWidgetInstance_Call{Call: _e.mock.On("DeleteWidgetInstance", ctx, widgetInstanceID)}
+}
+
+func (_c *MockSheetCopyStore_DeleteWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockSheetCopyStore_DeleteWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_DeleteWidgetInstance_Call) Return(_a0 error) *MockSheetCopyStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_DeleteWidgetInstance_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockSheetCopyStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSheetById provides a mock function with given fields: ctx, sheetId
+func (_m *MockSheetCopyStore) GetSheetById(ctx context.Context, sheetId uuid.UUID) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSheetById")
+	}
+
+	var r0 *models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Sheet, error)); ok {
+		return rf(ctx, sheetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Sheet); ok {
+		r0 = rf(ctx, sheetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sheetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetCopyStore_GetSheetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetById'
+type MockSheetCopyStore_GetSheetById_Call struct {
+	*mock.Call
+}
+
+// GetSheetById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+func (_e *MockSheetCopyStore_Expecter) GetSheetById(ctx interface{}, sheetId interface{}) *MockSheetCopyStore_GetSheetById_Call {
+	return &MockSheetCopyStore_GetSheetById_Call{Call: _e.mock.On("GetSheetById", ctx, sheetId)}
+}
+
+func (_c *MockSheetCopyStore_GetSheetById_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockSheetCopyStore_GetSheetById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_GetSheetById_Call) Return(_a0 *models.Sheet, _a1 error) *MockSheetCopyStore_GetSheetById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_GetSheetById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Sheet, error)) *MockSheetCopyStore_GetSheetById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSheetsAll provides a mock function with given fields: ctx, filters
+func (_m *MockSheetCopyStore) GetSheetsAll(ctx context.Context, filters models.SheetFilters) ([]models.Sheet, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSheetsAll")
+	}
+
+	var r0 []models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) ([]models.Sheet, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) []models.Sheet); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SheetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetCopyStore_GetSheetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetsAll'
+type MockSheetCopyStore_GetSheetsAll_Call struct {
+	*mock.Call
+}
+
+// GetSheetsAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.SheetFilters
+func (_e *MockSheetCopyStore_Expecter) GetSheetsAll(ctx interface{}, filters interface{}) *MockSheetCopyStore_GetSheetsAll_Call {
+	return &MockSheetCopyStore_GetSheetsAll_Call{Call: _e.mock.On("GetSheetsAll", ctx, filters)}
+}
+
+func (_c *MockSheetCopyStore_GetSheetsAll_Call) Run(run func(ctx context.Context, filters models.SheetFilters)) *MockSheetCopyStore_GetSheetsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SheetFilters))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_GetSheetsAll_Call) Return(_a0 []models.Sheet, _a1 error) *MockSheetCopyStore_GetSheetsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_GetSheetsAll_Call) RunAndReturn(run func(context.Context, models.SheetFilters) ([]models.Sheet, error)) *MockSheetCopyStore_GetSheetsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWidgetInstanceByID provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockSheetCopyStore) GetWidgetInstanceByID(ctx context.Context, widgetInstanceID uuid.UUID) (models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWidgetInstanceByID")
+	}
+
+	var r0 models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstanceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		r0 = ret.Get(0).(models.WidgetInstance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, widgetInstanceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetCopyStore_GetWidgetInstanceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetInstanceByID'
+type MockSheetCopyStore_GetWidgetInstanceByID_Call struct {
+	*mock.Call
+}
+
+// GetWidgetInstanceByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockSheetCopyStore_Expecter) GetWidgetInstanceByID(ctx interface{}, widgetInstanceID interface{}) *MockSheetCopyStore_GetWidgetInstanceByID_Call {
+	return &MockSheetCopyStore_GetWidgetInstanceByID_Call{Call: _e.mock.On("GetWidgetInstanceByID", ctx, widgetInstanceID)}
+}
+
+func (_c *MockSheetCopyStore_GetWidgetInstanceByID_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockSheetCopyStore_GetWidgetInstanceByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_GetWidgetInstanceByID_Call) Return(_a0 models.WidgetInstance, _a1 error) *MockSheetCopyStore_GetWidgetInstanceByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_GetWidgetInstanceByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.WidgetInstance, error)) *MockSheetCopyStore_GetWidgetInstanceByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWidgetTemplate provides a mock function with given fields: ctx, widgetType
+func (_m *MockSheetCopyStore) GetWidgetTemplate(ctx context.Context, widgetType string) (models.Widget, error) {
+	ret := _m.Called(ctx, widgetType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWidgetTemplate")
+	}
+
+	var r0 models.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Widget, error)); ok {
+		return rf(ctx, widgetType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Widget); ok {
+		r0 = rf(ctx, widgetType)
+	} else {
+		r0 = ret.Get(0).(models.Widget)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, widgetType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetCopyStore_GetWidgetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetTemplate'
+type MockSheetCopyStore_GetWidgetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetWidgetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetType string
+func (_e *MockSheetCopyStore_Expecter) GetWidgetTemplate(ctx interface{}, widgetType interface{}) *MockSheetCopyStore_GetWidgetTemplate_Call {
+	return &MockSheetCopyStore_GetWidgetTemplate_Call{Call: _e.mock.On("GetWidgetTemplate", ctx, widgetType)}
+}
+
+func (_c *MockSheetCopyStore_GetWidgetTemplate_Call) Run(run func(ctx context.Context, widgetType string)) *MockSheetCopyStore_GetWidgetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_GetWidgetTemplate_Call) Return(_a0 models.Widget, _a1 error) *MockSheetCopyStore_GetWidgetTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_GetWidgetTemplate_Call) RunAndReturn(run func(context.Context, string) (models.Widget, error)) *MockSheetCopyStore_GetWidgetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSheet provides a mock function with given fields: ctx, sheet
+func (_m *MockSheetCopyStore) UpdateSheet(ctx context.Context, sheet *models.Sheet) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSheet")
+	}
+
+	var r0 *models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) (*models.Sheet, error)); ok {
+		return rf(ctx, sheet)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) *models.Sheet); ok {
+		r0 = rf(ctx, sheet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Sheet) error); ok {
+		r1 = rf(ctx, sheet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetCopyStore_UpdateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSheet'
+type MockSheetCopyStore_UpdateSheet_Call struct {
+	*mock.Call
+}
+
+// UpdateSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheet *models.Sheet
+func (_e *MockSheetCopyStore_Expecter) UpdateSheet(ctx interface{}, sheet interface{}) *MockSheetCopyStore_UpdateSheet_Call {
+	return &MockSheetCopyStore_UpdateSheet_Call{Call: _e.mock.On("UpdateSheet", ctx, sheet)}
+}
+
+func (_c *MockSheetCopyStore_UpdateSheet_Call) Run(run func(ctx context.Context, sheet *models.Sheet)) *MockSheetCopyStore_UpdateSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Sheet))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_UpdateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockSheetCopyStore_UpdateSheet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_UpdateSheet_Call) RunAndReturn(run func(context.Context, *models.Sheet) (*models.Sheet, error)) *MockSheetCopyStore_UpdateSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
+func (_m *MockSheetCopyStore) UpdateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWidgetInstance")
+	}
+
+	var r0 *models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstance)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
+		r1 = rf(ctx, widgetInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetCopyStore_UpdateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWidgetInstance'
+type MockSheetCopyStore_UpdateWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// UpdateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstance *models.WidgetInstance
+func (_e *MockSheetCopyStore_Expecter) UpdateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockSheetCopyStore_UpdateWidgetInstance_Call {
+	return &MockSheetCopyStore_UpdateWidgetInstance_Call{Call: _e.mock.On("UpdateWidgetInstance", ctx, widgetInstance)}
+}
+
+func (_c *MockSheetCopyStore_UpdateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockSheetCopyStore_UpdateWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+	})
+	return _c
+}
+
+func (_c *MockSheetCopyStore_UpdateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockSheetCopyStore_UpdateWidgetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetCopyStore_UpdateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockSheetCopyStore_UpdateWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSheetCopyStore creates a new instance of MockSheetCopyStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSheetCopyStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSheetCopyStore {
+	mock := &MockSheetCopyStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,833 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mock_service
+
+import (
+	context "context"
+
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockReconciliationService is an autogenerated mock type for the ReconciliationService type
+type MockReconciliationService struct {
+	mock.Mock
+}
+
+type MockReconciliationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReconciliationService) EXPECT() *MockReconciliationService_Expecter {
+	return &MockReconciliationService_Expecter{mock: &_m.Mock}
+}
+
+// CreateManualMatch provides a mock function with given fields: ctx, orgId, userId, reconciliationId, runId, params
+func (_m *MockReconciliationService) CreateManualMatch(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID, runId uuid.UUID, params models.ManualMatchParams) (models.ReconciliationMatch, error) {
+	ret := _m.Called(ctx, orgId, userId, reconciliationId, runId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateManualMatch")
+	}
+
+	var r0 models.ReconciliationMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, models.ManualMatchParams) (models.ReconciliationMatch, error)); ok {
+		return rf(ctx, orgId, userId, reconciliationId, runId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, models.ManualMatchParams) models.ReconciliationMatch); ok {
+		r0 = rf(ctx, orgId, userId, reconciliationId, runId, params)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationMatch)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, models.ManualMatchParams) error); ok {
+		r1 = rf(ctx, orgId, userId, reconciliationId, runId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_CreateManualMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateManualMatch'
+type MockReconciliationService_CreateManualMatch_Call struct {
+	*mock.Call
+}
+
+// CreateManualMatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - userId uuid.UUID
+//   - reconciliationId uuid.UUID
+//   - runId uuid.UUID
+//   - params models.ManualMatchParams
+func (_e *MockReconciliationService_Expecter) CreateManualMatch(ctx interface{}, orgId interface{}, userId interface{}, reconciliationId interface{}, runId interface{}, params interface{}) *MockReconciliationService_CreateManualMatch_Call {
+	return &MockReconciliationService_CreateManualMatch_Call{Call: _e.mock.On("CreateManualMatch", ctx, orgId, userId, reconciliationId, runId, params)}
+}
+
+func (_c *MockReconciliationService_CreateManualMatch_Call) Run(run func(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID, runId uuid.UUID, params models.ManualMatchParams)) *MockReconciliationService_CreateManualMatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(models.ManualMatchParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_CreateManualMatch_Call) Return(_a0 models.ReconciliationMatch, _a1 error) *MockReconciliationService_CreateManualMatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_CreateManualMatch_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, models.ManualMatchParams) (models.ReconciliationMatch, error)) *MockReconciliationService_CreateManualMatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliation provides a mock function with given fields: ctx, orgId, userId, params
+func (_m *MockReconciliationService) CreateReconciliation(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.ReconciliationParams) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, orgId, userId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliation")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ReconciliationParams) (models.Reconciliation, error)); ok {
+		return rf(ctx, orgId, userId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ReconciliationParams) models.Reconciliation); ok {
+		r0 = rf(ctx, orgId, userId, params)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ReconciliationParams) error); ok {
+		r1 = rf(ctx, orgId, userId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_CreateReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliation'
+type MockReconciliationService_CreateReconciliation_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - userId uuid.UUID
+//   - params models.ReconciliationParams
+func (_e *MockReconciliationService_Expecter) CreateReconciliation(ctx interface{}, orgId interface{}, userId interface{}, params interface{}) *MockReconciliationService_CreateReconciliation_Call {
+	return &MockReconciliationService_CreateReconciliation_Call{Call: _e.mock.On("CreateReconciliation", ctx, orgId, userId, params)}
+}
+
+func (_c *MockReconciliationService_CreateReconciliation_Call) Run(run func(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.ReconciliationParams)) *MockReconciliationService_CreateReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ReconciliationParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_CreateReconciliation_Call) Return(_a0 models.Reconciliation, _a1 error) *MockReconciliationService_CreateReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_CreateReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ReconciliationParams) (models.Reconciliation, error)) *MockReconciliationService_CreateReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReconciliation provides a mock function with given fields: ctx, userId, reconciliationId
+func (_m *MockReconciliationService) DeleteReconciliation(ctx context.Context, userId uuid.UUID, reconciliationId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, reconciliationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReconciliation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, reconciliationId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationService_DeleteReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReconciliation'
+type MockReconciliationService_DeleteReconciliation_Call struct {
+	*mock.Call
+}
+
+// DeleteReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - reconciliationId uuid.UUID
+func (_e *MockReconciliationService_Expecter) DeleteReconciliation(ctx interface{}, userId interface{}, reconciliationId interface{}) *MockReconciliationService_DeleteReconciliation_Call {
+	return &MockReconciliationService_DeleteReconciliation_Call{Call: _e.mock.On("DeleteReconciliation", ctx, userId, reconciliationId)}
+}
+
+func (_c *MockReconciliationService_DeleteReconciliation_Call) Run(run func(ctx context.Context, userId uuid.UUID, reconciliationId uuid.UUID)) *MockReconciliationService_DeleteReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_DeleteReconciliation_Call) Return(_a0 error) *MockReconciliationService_DeleteReconciliation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationService_DeleteReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockReconciliationService_DeleteReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailReconciliationRunActivity provides a mock function with given fields: ctx, params, message
+func (_m *MockReconciliationService) FailReconciliationRunActivity(ctx context.Context, params models.ReconciliationRunWorkflowParams, message string) error {
+	ret := _m.Called(ctx, params, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailReconciliationRunActivity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReconciliationRunWorkflowParams, string) error); ok {
+		r0 = rf(ctx, params, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationService_FailReconciliationRunActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailReconciliationRunActivity'
+type MockReconciliationService_FailReconciliationRunActivity_Call struct {
+	*mock.Call
+}
+
+// FailReconciliationRunActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.ReconciliationRunWorkflowParams
+//   - message string
+func (_e *MockReconciliationService_Expecter) FailReconciliationRunActivity(ctx interface{}, params interface{}, message interface{}) *MockReconciliationService_FailReconciliationRunActivity_Call {
+	return &MockReconciliationService_FailReconciliationRunActivity_Call{Call: _e.mock.On("FailReconciliationRunActivity", ctx, params, message)}
+}
+
+func (_c *MockReconciliationService_FailReconciliationRunActivity_Call) Run(run func(ctx context.Context, params models.ReconciliationRunWorkflowParams, message string)) *MockReconciliationService_FailReconciliationRunActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReconciliationRunWorkflowParams), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_FailReconciliationRunActivity_Call) Return(_a0 error) *MockReconciliationService_FailReconciliationRunActivity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationService_FailReconciliationRunActivity_Call) RunAndReturn(run func(context.Context, models.ReconciliationRunWorkflowParams, string) error) *MockReconciliationService_FailReconciliationRunActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliation provides a mock function with given fields: ctx, reconciliationId
+func (_m *MockReconciliationService) GetReconciliation(ctx context.Context, reconciliationId uuid.UUID) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, reconciliationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliation")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Reconciliation, error)); ok {
+		return rf(ctx, reconciliationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Reconciliation); ok {
+		r0 = rf(ctx, reconciliationId)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, reconciliationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_GetReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliation'
+type MockReconciliationService_GetReconciliation_Call struct {
+	*mock.Call
+}
+
+// GetReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+func (_e *MockReconciliationService_Expecter) GetReconciliation(ctx interface{}, reconciliationId interface{}) *MockReconciliationService_GetReconciliation_Call {
+	return &MockReconciliationService_GetReconciliation_Call{Call: _e.mock.On("GetReconciliation", ctx, reconciliationId)}
+}
+
+func (_c *MockReconciliationService_GetReconciliation_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID)) *MockReconciliationService_GetReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliation_Call) Return(_a0 models.Reconciliation, _a1 error) *MockReconciliationService_GetReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Reconciliation, error)) *MockReconciliationService_GetReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationExceptions provides a mock function with given fields: ctx, reconciliationId, runId, filters
+func (_m *MockReconciliationService) GetReconciliationExceptions(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID, filters dbmodels.ReconciliationExceptionFilters) (models.ReconciliationExceptionsPage, error) {
+	ret := _m.Called(ctx, reconciliationId, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationExceptions")
+	}
+
+	var r0 models.ReconciliationExceptionsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dbmodels.ReconciliationExceptionFilters) (models.ReconciliationExceptionsPage, error)); ok {
+		return rf(ctx, reconciliationId, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dbmodels.ReconciliationExceptionFilters) models.ReconciliationExceptionsPage); ok {
+		r0 = rf(ctx, reconciliationId, runId, filters)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationExceptionsPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, dbmodels.ReconciliationExceptionFilters) error); ok {
+		r1 = rf(ctx, reconciliationId, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_GetReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationExceptions'
+type MockReconciliationService_GetReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - runId uuid.UUID
+//   - filters dbmodels.ReconciliationExceptionFilters
+func (_e *MockReconciliationService_Expecter) GetReconciliationExceptions(ctx interface{}, reconciliationId interface{}, runId interface{}, filters interface{}) *MockReconciliationService_GetReconciliationExceptions_Call {
+	return &MockReconciliationService_GetReconciliationExceptions_Call{Call: _e.mock.On("GetReconciliationExceptions", ctx, reconciliationId, runId, filters)}
+}
+
+func (_c *MockReconciliationService_GetReconciliationExceptions_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID, filters dbmodels.ReconciliationExceptionFilters)) *MockReconciliationService_GetReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(dbmodels.ReconciliationExceptionFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliationExceptions_Call) Return(_a0 models.ReconciliationExceptionsPage, _a1 error) *MockReconciliationService_GetReconciliationExceptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, dbmodels.ReconciliationExceptionFilters) (models.ReconciliationExceptionsPage, error)) *MockReconciliationService_GetReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationMatches provides a mock function with given fields: ctx, reconciliationId, runId, filters
+func (_m *MockReconciliationService) GetReconciliationMatches(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID, filters dbmodels.ReconciliationMatchFilters) (models.ReconciliationMatchesPage, error) {
+	ret := _m.Called(ctx, reconciliationId, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationMatches")
+	}
+
+	var r0 models.ReconciliationMatchesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dbmodels.ReconciliationMatchFilters) (models.ReconciliationMatchesPage, error)); ok {
+		return rf(ctx, reconciliationId, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, dbmodels.ReconciliationMatchFilters) models.ReconciliationMatchesPage); ok {
+		r0 = rf(ctx, reconciliationId, runId, filters)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationMatchesPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, dbmodels.ReconciliationMatchFilters) error); ok {
+		r1 = rf(ctx, reconciliationId, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_GetReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationMatches'
+type MockReconciliationService_GetReconciliationMatches_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - runId uuid.UUID
+//   - filters dbmodels.ReconciliationMatchFilters
+func (_e *MockReconciliationService_Expecter) GetReconciliationMatches(ctx interface{}, reconciliationId interface{}, runId interface{}, filters interface{}) *MockReconciliationService_GetReconciliationMatches_Call {
+	return &MockReconciliationService_GetReconciliationMatches_Call{Call: _e.mock.On("GetReconciliationMatches", ctx, reconciliationId, runId, filters)}
+}
+
+func (_c *MockReconciliationService_GetReconciliationMatches_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID, filters dbmodels.ReconciliationMatchFilters)) *MockReconciliationService_GetReconciliationMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(dbmodels.ReconciliationMatchFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliationMatches_Call) Return(_a0 models.ReconciliationMatchesPage, _a1 error) *MockReconciliationService_GetReconciliationMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, dbmodels.ReconciliationMatchFilters) (models.ReconciliationMatchesPage, error)) *MockReconciliationService_GetReconciliationMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationRun provides a mock function with given fields: ctx, reconciliationId, runId
+func (_m *MockReconciliationService) GetReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID) (dbmodels.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, runId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationRun")
+	}
+
+	var r0 dbmodels.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dbmodels.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, runId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dbmodels.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, runId)
+	} else {
+		r0 = ret.Get(0).(dbmodels.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, reconciliationId, runId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_GetReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationRun'
+type MockReconciliationService_GetReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - runId uuid.UUID
+func (_e *MockReconciliationService_Expecter) GetReconciliationRun(ctx interface{}, reconciliationId interface{}, runId interface{}) *MockReconciliationService_GetReconciliationRun_Call {
+	return &MockReconciliationService_GetReconciliationRun_Call{Call: _e.mock.On("GetReconciliationRun", ctx, reconciliationId, runId)}
+}
+
+func (_c *MockReconciliationService_GetReconciliationRun_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, runId uuid.UUID)) *MockReconciliationService_GetReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliationRun_Call) Return(_a0 dbmodels.ReconciliationRun, _a1 error) *MockReconciliationService_GetReconciliationRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (dbmodels.ReconciliationRun, error)) *MockReconciliationService_GetReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationRuns provides a mock function with given fields: ctx, reconciliationId
+func (_m *MockReconciliationService) GetReconciliationRuns(ctx context.Context, reconciliationId uuid.UUID) ([]dbmodels.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationRuns")
+	}
+
+	var r0 []dbmodels.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dbmodels.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dbmodels.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dbmodels.ReconciliationRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, reconciliationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_GetReconciliationRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationRuns'
+type MockReconciliationService_GetReconciliationRuns_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+func (_e *MockReconciliationService_Expecter) GetReconciliationRuns(ctx interface{}, reconciliationId interface{}) *MockReconciliationService_GetReconciliationRuns_Call {
+	return &MockReconciliationService_GetReconciliationRuns_Call{Call: _e.mock.On("GetReconciliationRuns", ctx, reconciliationId)}
+}
+
+func (_c *MockReconciliationService_GetReconciliationRuns_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID)) *MockReconciliationService_GetReconciliationRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliationRuns_Call) Return(_a0 []dbmodels.ReconciliationRun, _a1 error) *MockReconciliationService_GetReconciliationRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliationRuns_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dbmodels.ReconciliationRun, error)) *MockReconciliationService_GetReconciliationRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliations provides a mock function with given fields: ctx
+func (_m *MockReconciliationService) GetReconciliations(ctx context.Context) ([]models.Reconciliation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliations")
+	}
+
+	var r0 []models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Reconciliation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Reconciliation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reconciliation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_GetReconciliations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliations'
+type MockReconciliationService_GetReconciliations_Call struct {
+	*mock.Call
+}
+
+// GetReconciliations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReconciliationService_Expecter) GetReconciliations(ctx interface{}) *MockReconciliationService_GetReconciliations_Call {
+	return &MockReconciliationService_GetReconciliations_Call{Call: _e.mock.On("GetReconciliations", ctx)}
+}
+
+func (_c *MockReconciliationService_GetReconciliations_Call) Run(run func(ctx context.Context)) *MockReconciliationService_GetReconciliations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliations_Call) Return(_a0 []models.Reconciliation, _a1 error) *MockReconciliationService_GetReconciliations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_GetReconciliations_Call) RunAndReturn(run func(context.Context) ([]models.Reconciliation, error)) *MockReconciliationService_GetReconciliations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunReconciliationActivity provides a mock function with given fields: ctx, params
+func (_m *MockReconciliationService) RunReconciliationActivity(ctx context.Context, params models.ReconciliationRunWorkflowParams) (models.ReconciliationRunResult, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunReconciliationActivity")
+	}
+
+	var r0 models.ReconciliationRunResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReconciliationRunWorkflowParams) (models.ReconciliationRunResult, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.ReconciliationRunWorkflowParams) models.ReconciliationRunResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationRunResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.ReconciliationRunWorkflowParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_RunReconciliationActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunReconciliationActivity'
+type MockReconciliationService_RunReconciliationActivity_Call struct {
+	*mock.Call
+}
+
+// RunReconciliationActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.ReconciliationRunWorkflowParams
+func (_e *MockReconciliationService_Expecter) RunReconciliationActivity(ctx interface{}, params interface{}) *MockReconciliationService_RunReconciliationActivity_Call {
+	return &MockReconciliationService_RunReconciliationActivity_Call{Call: _e.mock.On("RunReconciliationActivity", ctx, params)}
+}
+
+func (_c *MockReconciliationService_RunReconciliationActivity_Call) Run(run func(ctx context.Context, params models.ReconciliationRunWorkflowParams)) *MockReconciliationService_RunReconciliationActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.ReconciliationRunWorkflowParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_RunReconciliationActivity_Call) Return(_a0 models.ReconciliationRunResult, _a1 error) *MockReconciliationService_RunReconciliationActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_RunReconciliationActivity_Call) RunAndReturn(run func(context.Context, models.ReconciliationRunWorkflowParams) (models.ReconciliationRunResult, error)) *MockReconciliationService_RunReconciliationActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartReconciliationRun provides a mock function with given fields: ctx, orgId, userId, reconciliationId
+func (_m *MockReconciliationService) StartReconciliationRun(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID) (dbmodels.ReconciliationRun, error) {
+	ret := _m.Called(ctx, orgId, userId, reconciliationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartReconciliationRun")
+	}
+
+	var r0 dbmodels.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (dbmodels.ReconciliationRun, error)); ok {
+		return rf(ctx, orgId, userId, reconciliationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) dbmodels.ReconciliationRun); ok {
+		r0 = rf(ctx, orgId, userId, reconciliationId)
+	} else {
+		r0 = ret.Get(0).(dbmodels.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgId, userId, reconciliationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_StartReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartReconciliationRun'
+type MockReconciliationService_StartReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// StartReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - userId uuid.UUID
+//   - reconciliationId uuid.UUID
+func (_e *MockReconciliationService_Expecter) StartReconciliationRun(ctx interface{}, orgId interface{}, userId interface{}, reconciliationId interface{}) *MockReconciliationService_StartReconciliationRun_Call {
+	return &MockReconciliationService_StartReconciliationRun_Call{Call: _e.mock.On("StartReconciliationRun", ctx, orgId, userId, reconciliationId)}
+}
+
+func (_c *MockReconciliationService_StartReconciliationRun_Call) Run(run func(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID)) *MockReconciliationService_StartReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_StartReconciliationRun_Call) Return(_a0 dbmodels.ReconciliationRun, _a1 error) *MockReconciliationService_StartReconciliationRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_StartReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (dbmodels.ReconciliationRun, error)) *MockReconciliationService_StartReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnmatchReconciliationMatch provides a mock function with given fields: ctx, orgId, userId, reconciliationId, runId, matchId
+func (_m *MockReconciliationService) UnmatchReconciliationMatch(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID, runId uuid.UUID, matchId uuid.UUID) error {
+	ret := _m.Called(ctx, orgId, userId, reconciliationId, runId, matchId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmatchReconciliationMatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, orgId, userId, reconciliationId, runId, matchId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationService_UnmatchReconciliationMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnmatchReconciliationMatch'
+type MockReconciliationService_UnmatchReconciliationMatch_Call struct {
+	*mock.Call
+}
+
+// UnmatchReconciliationMatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - userId uuid.UUID
+//   - reconciliationId uuid.UUID
+//   - runId uuid.UUID
+//   - matchId uuid.UUID
+func (_e *MockReconciliationService_Expecter) UnmatchReconciliationMatch(ctx interface{}, orgId interface{}, userId interface{}, reconciliationId interface{}, runId interface{}, matchId interface{}) *MockReconciliationService_UnmatchReconciliationMatch_Call {
+	return &MockReconciliationService_UnmatchReconciliationMatch_Call{Call: _e.mock.On("UnmatchReconciliationMatch", ctx, orgId, userId, reconciliationId, runId, matchId)}
+}
+
+func (_c *MockReconciliationService_UnmatchReconciliationMatch_Call) Run(run func(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, reconciliationId uuid.UUID, runId uuid.UUID, matchId uuid.UUID)) *MockReconciliationService_UnmatchReconciliationMatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID), args[5].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_UnmatchReconciliationMatch_Call) Return(_a0 error) *MockReconciliationService_UnmatchReconciliationMatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationService_UnmatchReconciliationMatch_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) error) *MockReconciliationService_UnmatchReconciliationMatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReconciliation provides a mock function with given fields: ctx, userId, reconciliationId, params
+func (_m *MockReconciliationService) UpdateReconciliation(ctx context.Context, userId uuid.UUID, reconciliationId uuid.UUID, params models.ReconciliationParams) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, userId, reconciliationId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReconciliation")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ReconciliationParams) (models.Reconciliation, error)); ok {
+		return rf(ctx, userId, reconciliationId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ReconciliationParams) models.Reconciliation); ok {
+		r0 = rf(ctx, userId, reconciliationId, params)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ReconciliationParams) error); ok {
+		r1 = rf(ctx, userId, reconciliationId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationService_UpdateReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliation'
+type MockReconciliationService_UpdateReconciliation_Call struct {
+	*mock.Call
+}
+
+// UpdateReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - reconciliationId uuid.UUID
+//   - params models.ReconciliationParams
+func (_e *MockReconciliationService_Expecter) UpdateReconciliation(ctx interface{}, userId interface{}, reconciliationId interface{}, params interface{}) *MockReconciliationService_UpdateReconciliation_Call {
+	return &MockReconciliationService_UpdateReconciliation_Call{Call: _e.mock.On("UpdateReconciliation", ctx, userId, reconciliationId, params)}
+}
+
+func (_c *MockReconciliationService_UpdateReconciliation_Call) Run(run func(ctx context.Context, userId uuid.UUID, reconciliationId uuid.UUID, params models.ReconciliationParams)) *MockReconciliationService_UpdateReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ReconciliationParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationService_UpdateReconciliation_Call) Return(_a0 models.Reconciliation, _a1 error) *MockReconciliationService_UpdateReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationService_UpdateReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ReconciliationParams) (models.Reconciliation, error)) *MockReconciliationService_UpdateReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReconciliationService creates a new instance of MockReconciliationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReconciliationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReconciliationService {
+	mock := &MockReconciliationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
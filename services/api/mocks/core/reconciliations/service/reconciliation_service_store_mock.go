@@ -0,0 +1,1400 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_service
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	store "github.com/Zampfi/application-platform/services/api/db/store"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockReconciliationServiceStore is an autogenerated mock type for the ReconciliationServiceStore type
+type MockReconciliationServiceStore struct {
+	mock.Mock
+}
+
+type MockReconciliationServiceStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReconciliationServiceStore) EXPECT() *MockReconciliationServiceStore_Expecter {
+	return &MockReconciliationServiceStore_Expecter{mock: &_m.Mock}
+}
+
+// AdjustReconciliationRunCounts provides a mock function with given fields: ctx, runId, deltas
+func (_m *MockReconciliationServiceStore) AdjustReconciliationRunCounts(ctx context.Context, runId uuid.UUID, deltas models.ReconciliationRunCountDeltas) error {
+	ret := _m.Called(ctx, runId, deltas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustReconciliationRunCounts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationRunCountDeltas) error); ok {
+		r0 = rf(ctx, runId, deltas)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustReconciliationRunCounts'
+type MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call struct {
+	*mock.Call
+}
+
+// AdjustReconciliationRunCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - deltas models.ReconciliationRunCountDeltas
+func (_e *MockReconciliationServiceStore_Expecter) AdjustReconciliationRunCounts(ctx interface{}, runId interface{}, deltas interface{}) *MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call {
+	return &MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call{Call: _e.mock.On("AdjustReconciliationRunCounts", ctx, runId, deltas)}
+}
+
+func (_c *MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call) Run(run func(ctx context.Context, runId uuid.UUID, deltas models.ReconciliationRunCountDeltas)) *MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationRunCountDeltas))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call) Return(_a0 error) *MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationRunCountDeltas) error) *MockReconciliationServiceStore_AdjustReconciliationRunCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteReconciliationRun provides a mock function with given fields: ctx, runId, params
+func (_m *MockReconciliationServiceStore) CompleteReconciliationRun(ctx context.Context, runId uuid.UUID, params models.CompleteReconciliationRunParams) error {
+	ret := _m.Called(ctx, runId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteReconciliationRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.CompleteReconciliationRunParams) error); ok {
+		r0 = rf(ctx, runId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_CompleteReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteReconciliationRun'
+type MockReconciliationServiceStore_CompleteReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// CompleteReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - params models.CompleteReconciliationRunParams
+func (_e *MockReconciliationServiceStore_Expecter) CompleteReconciliationRun(ctx interface{}, runId interface{}, params interface{}) *MockReconciliationServiceStore_CompleteReconciliationRun_Call {
+	return &MockReconciliationServiceStore_CompleteReconciliationRun_Call{Call: _e.mock.On("CompleteReconciliationRun", ctx, runId, params)}
+}
+
+func (_c *MockReconciliationServiceStore_CompleteReconciliationRun_Call) Run(run func(ctx context.Context, runId uuid.UUID, params models.CompleteReconciliationRunParams)) *MockReconciliationServiceStore_CompleteReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.CompleteReconciliationRunParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CompleteReconciliationRun_Call) Return(_a0 error) *MockReconciliationServiceStore_CompleteReconciliationRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CompleteReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.CompleteReconciliationRunParams) error) *MockReconciliationServiceStore_CompleteReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReconciliationExceptions provides a mock function with given fields: ctx, runId, filters
+func (_m *MockReconciliationServiceStore) CountReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) (int64, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReconciliationExceptions")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) (int64, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) int64); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_CountReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReconciliationExceptions'
+type MockReconciliationServiceStore_CountReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// CountReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationExceptionFilters
+func (_e *MockReconciliationServiceStore_Expecter) CountReconciliationExceptions(ctx interface{}, runId interface{}, filters interface{}) *MockReconciliationServiceStore_CountReconciliationExceptions_Call {
+	return &MockReconciliationServiceStore_CountReconciliationExceptions_Call{Call: _e.mock.On("CountReconciliationExceptions", ctx, runId, filters)}
+}
+
+func (_c *MockReconciliationServiceStore_CountReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters)) *MockReconciliationServiceStore_CountReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationExceptionFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CountReconciliationExceptions_Call) Return(_a0 int64, _a1 error) *MockReconciliationServiceStore_CountReconciliationExceptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CountReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) (int64, error)) *MockReconciliationServiceStore_CountReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReconciliationMatches provides a mock function with given fields: ctx, runId, filters
+func (_m *MockReconciliationServiceStore) CountReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) (int64, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReconciliationMatches")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) (int64, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) int64); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_CountReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReconciliationMatches'
+type MockReconciliationServiceStore_CountReconciliationMatches_Call struct {
+	*mock.Call
+}
+
+// CountReconciliationMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationMatchFilters
+func (_e *MockReconciliationServiceStore_Expecter) CountReconciliationMatches(ctx interface{}, runId interface{}, filters interface{}) *MockReconciliationServiceStore_CountReconciliationMatches_Call {
+	return &MockReconciliationServiceStore_CountReconciliationMatches_Call{Call: _e.mock.On("CountReconciliationMatches", ctx, runId, filters)}
+}
+
+func (_c *MockReconciliationServiceStore_CountReconciliationMatches_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters)) *MockReconciliationServiceStore_CountReconciliationMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationMatchFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CountReconciliationMatches_Call) Return(_a0 int64, _a1 error) *MockReconciliationServiceStore_CountReconciliationMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CountReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) (int64, error)) *MockReconciliationServiceStore_CountReconciliationMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliation provides a mock function with given fields: ctx, params
+func (_m *MockReconciliationServiceStore) CreateReconciliation(ctx context.Context, params models.CreateReconciliationParams) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliation")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReconciliationParams) (models.Reconciliation, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReconciliationParams) models.Reconciliation); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateReconciliationParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_CreateReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliation'
+type MockReconciliationServiceStore_CreateReconciliation_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateReconciliationParams
+func (_e *MockReconciliationServiceStore_Expecter) CreateReconciliation(ctx interface{}, params interface{}) *MockReconciliationServiceStore_CreateReconciliation_Call {
+	return &MockReconciliationServiceStore_CreateReconciliation_Call{Call: _e.mock.On("CreateReconciliation", ctx, params)}
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliation_Call) Run(run func(ctx context.Context, params models.CreateReconciliationParams)) *MockReconciliationServiceStore_CreateReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateReconciliationParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliation_Call) Return(_a0 models.Reconciliation, _a1 error) *MockReconciliationServiceStore_CreateReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliation_Call) RunAndReturn(run func(context.Context, models.CreateReconciliationParams) (models.Reconciliation, error)) *MockReconciliationServiceStore_CreateReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationExceptions provides a mock function with given fields: ctx, runId, side, rows, skipped
+func (_m *MockReconciliationServiceStore) CreateReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow, skipped bool) error {
+	ret := _m.Called(ctx, runId, side, rows, skipped)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliationExceptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []models.ReconciliationRow, bool) error); ok {
+		r0 = rf(ctx, runId, side, rows, skipped)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_CreateReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationExceptions'
+type MockReconciliationServiceStore_CreateReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - side string
+//   - rows []models.ReconciliationRow
+//   - skipped bool
+func (_e *MockReconciliationServiceStore_Expecter) CreateReconciliationExceptions(ctx interface{}, runId interface{}, side interface{}, rows interface{}, skipped interface{}) *MockReconciliationServiceStore_CreateReconciliationExceptions_Call {
+	return &MockReconciliationServiceStore_CreateReconciliationExceptions_Call{Call: _e.mock.On("CreateReconciliationExceptions", ctx, runId, side, rows, skipped)}
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow, skipped bool)) *MockReconciliationServiceStore_CreateReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]models.ReconciliationRow), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliationExceptions_Call) Return(_a0 error) *MockReconciliationServiceStore_CreateReconciliationExceptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []models.ReconciliationRow, bool) error) *MockReconciliationServiceStore_CreateReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationMatches provides a mock function with given fields: ctx, runId, params
+func (_m *MockReconciliationServiceStore) CreateReconciliationMatches(ctx context.Context, runId uuid.UUID, params []models.CreateReconciliationMatchParams) error {
+	ret := _m.Called(ctx, runId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliationMatches")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.CreateReconciliationMatchParams) error); ok {
+		r0 = rf(ctx, runId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_CreateReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationMatches'
+type MockReconciliationServiceStore_CreateReconciliationMatches_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - params []models.CreateReconciliationMatchParams
+func (_e *MockReconciliationServiceStore_Expecter) CreateReconciliationMatches(ctx interface{}, runId interface{}, params interface{}) *MockReconciliationServiceStore_CreateReconciliationMatches_Call {
+	return &MockReconciliationServiceStore_CreateReconciliationMatches_Call{Call: _e.mock.On("CreateReconciliationMatches", ctx, runId, params)}
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliationMatches_Call) Run(run func(ctx context.Context, runId uuid.UUID, params []models.CreateReconciliationMatchParams)) *MockReconciliationServiceStore_CreateReconciliationMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.CreateReconciliationMatchParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliationMatches_Call) Return(_a0 error) *MockReconciliationServiceStore_CreateReconciliationMatches_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, []models.CreateReconciliationMatchParams) error) *MockReconciliationServiceStore_CreateReconciliationMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationRun provides a mock function with given fields: ctx, reconciliationId, createdBy
+func (_m *MockReconciliationServiceStore) CreateReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, createdBy uuid.UUID) (models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, createdBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliationRun")
+	}
+
+	var r0 models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, createdBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, createdBy)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, reconciliationId, createdBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_CreateReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationRun'
+type MockReconciliationServiceStore_CreateReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - createdBy uuid.UUID
+func (_e *MockReconciliationServiceStore_Expecter) CreateReconciliationRun(ctx interface{}, reconciliationId interface{}, createdBy interface{}) *MockReconciliationServiceStore_CreateReconciliationRun_Call {
+	return &MockReconciliationServiceStore_CreateReconciliationRun_Call{Call: _e.mock.On("CreateReconciliationRun", ctx, reconciliationId, createdBy)}
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliationRun_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, createdBy uuid.UUID)) *MockReconciliationServiceStore_CreateReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliationRun_Call) Return(_a0 models.ReconciliationRun, _a1 error) *MockReconciliationServiceStore_CreateReconciliationRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_CreateReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationRun, error)) *MockReconciliationServiceStore_CreateReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReconciliation provides a mock function with given fields: ctx, reconciliationId, deletedBy
+func (_m *MockReconciliationServiceStore) DeleteReconciliation(ctx context.Context, reconciliationId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, reconciliationId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReconciliation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, reconciliationId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_DeleteReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReconciliation'
+type MockReconciliationServiceStore_DeleteReconciliation_Call struct {
+	*mock.Call
+}
+
+// DeleteReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockReconciliationServiceStore_Expecter) DeleteReconciliation(ctx interface{}, reconciliationId interface{}, deletedBy interface{}) *MockReconciliationServiceStore_DeleteReconciliation_Call {
+	return &MockReconciliationServiceStore_DeleteReconciliation_Call{Call: _e.mock.On("DeleteReconciliation", ctx, reconciliationId, deletedBy)}
+}
+
+func (_c *MockReconciliationServiceStore_DeleteReconciliation_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, deletedBy uuid.UUID)) *MockReconciliationServiceStore_DeleteReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_DeleteReconciliation_Call) Return(_a0 error) *MockReconciliationServiceStore_DeleteReconciliation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_DeleteReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockReconciliationServiceStore_DeleteReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlattenedResourceAudiencePolicies provides a mock function with given fields: ctx, filters
+func (_m *MockReconciliationServiceStore) GetFlattenedResourceAudiencePolicies(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlattenedResourceAudiencePolicies")
+	}
+
+	var r0 []models.FlattenedResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) []models.FlattenedResourceAudiencePolicy); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FlattenedResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlattenedResourceAudiencePolicies'
+type MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call struct {
+	*mock.Call
+}
+
+// GetFlattenedResourceAudiencePolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FlattenedResourceAudiencePoliciesFilters
+func (_e *MockReconciliationServiceStore_Expecter) GetFlattenedResourceAudiencePolicies(ctx interface{}, filters interface{}) *MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	return &MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call{Call: _e.mock.On("GetFlattenedResourceAudiencePolicies", ctx, filters)}
+}
+
+func (_c *MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call) Run(run func(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters)) *MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FlattenedResourceAudiencePoliciesFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call) Return(_a0 []models.FlattenedResourceAudiencePolicy, _a1 error) *MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call) RunAndReturn(run func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)) *MockReconciliationServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestReconciliationRun provides a mock function with given fields: ctx, reconciliationId, status
+func (_m *MockReconciliationServiceStore) GetLatestReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, status string) (models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestReconciliationRun")
+	}
+
+	var r0 models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (models.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) models.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, status)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, reconciliationId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_GetLatestReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestReconciliationRun'
+type MockReconciliationServiceStore_GetLatestReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// GetLatestReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - status string
+func (_e *MockReconciliationServiceStore_Expecter) GetLatestReconciliationRun(ctx interface{}, reconciliationId interface{}, status interface{}) *MockReconciliationServiceStore_GetLatestReconciliationRun_Call {
+	return &MockReconciliationServiceStore_GetLatestReconciliationRun_Call{Call: _e.mock.On("GetLatestReconciliationRun", ctx, reconciliationId, status)}
+}
+
+func (_c *MockReconciliationServiceStore_GetLatestReconciliationRun_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, status string)) *MockReconciliationServiceStore_GetLatestReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetLatestReconciliationRun_Call) Return(_a0 models.ReconciliationRun, _a1 error) *MockReconciliationServiceStore_GetLatestReconciliationRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetLatestReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (models.ReconciliationRun, error)) *MockReconciliationServiceStore_GetLatestReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationById provides a mock function with given fields: ctx, reconciliationId
+func (_m *MockReconciliationServiceStore) GetReconciliationById(ctx context.Context, reconciliationId uuid.UUID) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, reconciliationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationById")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Reconciliation, error)); ok {
+		return rf(ctx, reconciliationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Reconciliation); ok {
+		r0 = rf(ctx, reconciliationId)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, reconciliationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_GetReconciliationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationById'
+type MockReconciliationServiceStore_GetReconciliationById_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+func (_e *MockReconciliationServiceStore_Expecter) GetReconciliationById(ctx interface{}, reconciliationId interface{}) *MockReconciliationServiceStore_GetReconciliationById_Call {
+	return &MockReconciliationServiceStore_GetReconciliationById_Call{Call: _e.mock.On("GetReconciliationById", ctx, reconciliationId)}
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationById_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID)) *MockReconciliationServiceStore_GetReconciliationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationById_Call) Return(_a0 models.Reconciliation, _a1 error) *MockReconciliationServiceStore_GetReconciliationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Reconciliation, error)) *MockReconciliationServiceStore_GetReconciliationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationExceptions provides a mock function with given fields: ctx, runId, filters
+func (_m *MockReconciliationServiceStore) GetReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationExceptions")
+	}
+
+	var r0 []models.ReconciliationException
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) []models.ReconciliationException); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReconciliationException)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_GetReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationExceptions'
+type MockReconciliationServiceStore_GetReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationExceptionFilters
+func (_e *MockReconciliationServiceStore_Expecter) GetReconciliationExceptions(ctx interface{}, runId interface{}, filters interface{}) *MockReconciliationServiceStore_GetReconciliationExceptions_Call {
+	return &MockReconciliationServiceStore_GetReconciliationExceptions_Call{Call: _e.mock.On("GetReconciliationExceptions", ctx, runId, filters)}
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters)) *MockReconciliationServiceStore_GetReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationExceptionFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationExceptions_Call) Return(_a0 []models.ReconciliationException, _a1 error) *MockReconciliationServiceStore_GetReconciliationExceptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error)) *MockReconciliationServiceStore_GetReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationMatch provides a mock function with given fields: ctx, runId, matchId
+func (_m *MockReconciliationServiceStore) GetReconciliationMatch(ctx context.Context, runId uuid.UUID, matchId uuid.UUID) (models.ReconciliationMatch, error) {
+	ret := _m.Called(ctx, runId, matchId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationMatch")
+	}
+
+	var r0 models.ReconciliationMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationMatch, error)); ok {
+		return rf(ctx, runId, matchId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.ReconciliationMatch); ok {
+		r0 = rf(ctx, runId, matchId)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationMatch)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, runId, matchId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_GetReconciliationMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationMatch'
+type MockReconciliationServiceStore_GetReconciliationMatch_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationMatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - matchId uuid.UUID
+func (_e *MockReconciliationServiceStore_Expecter) GetReconciliationMatch(ctx interface{}, runId interface{}, matchId interface{}) *MockReconciliationServiceStore_GetReconciliationMatch_Call {
+	return &MockReconciliationServiceStore_GetReconciliationMatch_Call{Call: _e.mock.On("GetReconciliationMatch", ctx, runId, matchId)}
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationMatch_Call) Run(run func(ctx context.Context, runId uuid.UUID, matchId uuid.UUID)) *MockReconciliationServiceStore_GetReconciliationMatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationMatch_Call) Return(_a0 models.ReconciliationMatch, _a1 error) *MockReconciliationServiceStore_GetReconciliationMatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationMatch_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationMatch, error)) *MockReconciliationServiceStore_GetReconciliationMatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationMatches provides a mock function with given fields: ctx, runId, filters
+func (_m *MockReconciliationServiceStore) GetReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationMatches")
+	}
+
+	var r0 []models.ReconciliationMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) []models.ReconciliationMatch); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReconciliationMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_GetReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationMatches'
+type MockReconciliationServiceStore_GetReconciliationMatches_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationMatchFilters
+func (_e *MockReconciliationServiceStore_Expecter) GetReconciliationMatches(ctx interface{}, runId interface{}, filters interface{}) *MockReconciliationServiceStore_GetReconciliationMatches_Call {
+	return &MockReconciliationServiceStore_GetReconciliationMatches_Call{Call: _e.mock.On("GetReconciliationMatches", ctx, runId, filters)}
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationMatches_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters)) *MockReconciliationServiceStore_GetReconciliationMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationMatchFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationMatches_Call) Return(_a0 []models.ReconciliationMatch, _a1 error) *MockReconciliationServiceStore_GetReconciliationMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error)) *MockReconciliationServiceStore_GetReconciliationMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationRunById provides a mock function with given fields: ctx, runId
+func (_m *MockReconciliationServiceStore) GetReconciliationRunById(ctx context.Context, runId uuid.UUID) (models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, runId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationRunById")
+	}
+
+	var r0 models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.ReconciliationRun, error)); ok {
+		return rf(ctx, runId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.ReconciliationRun); ok {
+		r0 = rf(ctx, runId)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, runId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_GetReconciliationRunById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationRunById'
+type MockReconciliationServiceStore_GetReconciliationRunById_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationRunById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+func (_e *MockReconciliationServiceStore_Expecter) GetReconciliationRunById(ctx interface{}, runId interface{}) *MockReconciliationServiceStore_GetReconciliationRunById_Call {
+	return &MockReconciliationServiceStore_GetReconciliationRunById_Call{Call: _e.mock.On("GetReconciliationRunById", ctx, runId)}
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationRunById_Call) Run(run func(ctx context.Context, runId uuid.UUID)) *MockReconciliationServiceStore_GetReconciliationRunById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationRunById_Call) Return(_a0 models.ReconciliationRun, _a1 error) *MockReconciliationServiceStore_GetReconciliationRunById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationRunById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.ReconciliationRun, error)) *MockReconciliationServiceStore_GetReconciliationRunById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationRuns provides a mock function with given fields: ctx, reconciliationId, limit
+func (_m *MockReconciliationServiceStore) GetReconciliationRuns(ctx context.Context, reconciliationId uuid.UUID, limit int) ([]models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationRuns")
+	}
+
+	var r0 []models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReconciliationRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, reconciliationId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_GetReconciliationRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationRuns'
+type MockReconciliationServiceStore_GetReconciliationRuns_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - limit int
+func (_e *MockReconciliationServiceStore_Expecter) GetReconciliationRuns(ctx interface{}, reconciliationId interface{}, limit interface{}) *MockReconciliationServiceStore_GetReconciliationRuns_Call {
+	return &MockReconciliationServiceStore_GetReconciliationRuns_Call{Call: _e.mock.On("GetReconciliationRuns", ctx, reconciliationId, limit)}
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationRuns_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, limit int)) *MockReconciliationServiceStore_GetReconciliationRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationRuns_Call) Return(_a0 []models.ReconciliationRun, _a1 error) *MockReconciliationServiceStore_GetReconciliationRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliationRuns_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]models.ReconciliationRun, error)) *MockReconciliationServiceStore_GetReconciliationRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliations provides a mock function with given fields: ctx
+func (_m *MockReconciliationServiceStore) GetReconciliations(ctx context.Context) ([]models.Reconciliation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliations")
+	}
+
+	var r0 []models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Reconciliation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Reconciliation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reconciliation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_GetReconciliations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliations'
+type MockReconciliationServiceStore_GetReconciliations_Call struct {
+	*mock.Call
+}
+
+// GetReconciliations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReconciliationServiceStore_Expecter) GetReconciliations(ctx interface{}) *MockReconciliationServiceStore_GetReconciliations_Call {
+	return &MockReconciliationServiceStore_GetReconciliations_Call{Call: _e.mock.On("GetReconciliations", ctx)}
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliations_Call) Run(run func(ctx context.Context)) *MockReconciliationServiceStore_GetReconciliations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliations_Call) Return(_a0 []models.Reconciliation, _a1 error) *MockReconciliationServiceStore_GetReconciliations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_GetReconciliations_Call) RunAndReturn(run func(context.Context) ([]models.Reconciliation, error)) *MockReconciliationServiceStore_GetReconciliations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenReconciliationExceptions provides a mock function with given fields: ctx, runId, side, rows
+func (_m *MockReconciliationServiceStore) ReopenReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow) error {
+	ret := _m.Called(ctx, runId, side, rows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenReconciliationExceptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []models.ReconciliationRow) error); ok {
+		r0 = rf(ctx, runId, side, rows)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_ReopenReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenReconciliationExceptions'
+type MockReconciliationServiceStore_ReopenReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// ReopenReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - side string
+//   - rows []models.ReconciliationRow
+func (_e *MockReconciliationServiceStore_Expecter) ReopenReconciliationExceptions(ctx interface{}, runId interface{}, side interface{}, rows interface{}) *MockReconciliationServiceStore_ReopenReconciliationExceptions_Call {
+	return &MockReconciliationServiceStore_ReopenReconciliationExceptions_Call{Call: _e.mock.On("ReopenReconciliationExceptions", ctx, runId, side, rows)}
+}
+
+func (_c *MockReconciliationServiceStore_ReopenReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow)) *MockReconciliationServiceStore_ReopenReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]models.ReconciliationRow))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_ReopenReconciliationExceptions_Call) Return(_a0 error) *MockReconciliationServiceStore_ReopenReconciliationExceptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_ReopenReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []models.ReconciliationRow) error) *MockReconciliationServiceStore_ReopenReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveReconciliationExceptions provides a mock function with given fields: ctx, runId, side, rowIds, matchId
+func (_m *MockReconciliationServiceStore) ResolveReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rowIds []string, matchId uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, runId, side, rowIds, matchId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveReconciliationExceptions")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, runId, side, rowIds, matchId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, uuid.UUID) int64); ok {
+		r0 = rf(ctx, runId, side, rowIds, matchId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []string, uuid.UUID) error); ok {
+		r1 = rf(ctx, runId, side, rowIds, matchId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_ResolveReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveReconciliationExceptions'
+type MockReconciliationServiceStore_ResolveReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// ResolveReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - side string
+//   - rowIds []string
+//   - matchId uuid.UUID
+func (_e *MockReconciliationServiceStore_Expecter) ResolveReconciliationExceptions(ctx interface{}, runId interface{}, side interface{}, rowIds interface{}, matchId interface{}) *MockReconciliationServiceStore_ResolveReconciliationExceptions_Call {
+	return &MockReconciliationServiceStore_ResolveReconciliationExceptions_Call{Call: _e.mock.On("ResolveReconciliationExceptions", ctx, runId, side, rowIds, matchId)}
+}
+
+func (_c *MockReconciliationServiceStore_ResolveReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, side string, rowIds []string, matchId uuid.UUID)) *MockReconciliationServiceStore_ResolveReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]string), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_ResolveReconciliationExceptions_Call) Return(_a0 int64, _a1 error) *MockReconciliationServiceStore_ResolveReconciliationExceptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_ResolveReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []string, uuid.UUID) (int64, error)) *MockReconciliationServiceStore_ResolveReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReconciliation provides a mock function with given fields: ctx, reconciliationId, params
+func (_m *MockReconciliationServiceStore) UpdateReconciliation(ctx context.Context, reconciliationId uuid.UUID, params models.UpdateReconciliationParams) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, reconciliationId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReconciliation")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateReconciliationParams) (models.Reconciliation, error)); ok {
+		return rf(ctx, reconciliationId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateReconciliationParams) models.Reconciliation); ok {
+		r0 = rf(ctx, reconciliationId, params)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateReconciliationParams) error); ok {
+		r1 = rf(ctx, reconciliationId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationServiceStore_UpdateReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliation'
+type MockReconciliationServiceStore_UpdateReconciliation_Call struct {
+	*mock.Call
+}
+
+// UpdateReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - params models.UpdateReconciliationParams
+func (_e *MockReconciliationServiceStore_Expecter) UpdateReconciliation(ctx interface{}, reconciliationId interface{}, params interface{}) *MockReconciliationServiceStore_UpdateReconciliation_Call {
+	return &MockReconciliationServiceStore_UpdateReconciliation_Call{Call: _e.mock.On("UpdateReconciliation", ctx, reconciliationId, params)}
+}
+
+func (_c *MockReconciliationServiceStore_UpdateReconciliation_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, params models.UpdateReconciliationParams)) *MockReconciliationServiceStore_UpdateReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateReconciliationParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_UpdateReconciliation_Call) Return(_a0 models.Reconciliation, _a1 error) *MockReconciliationServiceStore_UpdateReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_UpdateReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateReconciliationParams) (models.Reconciliation, error)) *MockReconciliationServiceStore_UpdateReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReconciliationMatchStatus provides a mock function with given fields: ctx, runId, matchId, status, updatedBy
+func (_m *MockReconciliationServiceStore) UpdateReconciliationMatchStatus(ctx context.Context, runId uuid.UUID, matchId uuid.UUID, status string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, runId, matchId, status, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReconciliationMatchStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, runId, matchId, status, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliationMatchStatus'
+type MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateReconciliationMatchStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - matchId uuid.UUID
+//   - status string
+//   - updatedBy uuid.UUID
+func (_e *MockReconciliationServiceStore_Expecter) UpdateReconciliationMatchStatus(ctx interface{}, runId interface{}, matchId interface{}, status interface{}, updatedBy interface{}) *MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call {
+	return &MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call{Call: _e.mock.On("UpdateReconciliationMatchStatus", ctx, runId, matchId, status, updatedBy)}
+}
+
+func (_c *MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call) Run(run func(ctx context.Context, runId uuid.UUID, matchId uuid.UUID, status string, updatedBy uuid.UUID)) *MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call) Return(_a0 error) *MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) error) *MockReconciliationServiceStore_UpdateReconciliationMatchStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReconciliationRunWorkflowId provides a mock function with given fields: ctx, runId, workflowId
+func (_m *MockReconciliationServiceStore) UpdateReconciliationRunWorkflowId(ctx context.Context, runId uuid.UUID, workflowId string) error {
+	ret := _m.Called(ctx, runId, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReconciliationRunWorkflowId")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, runId, workflowId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliationRunWorkflowId'
+type MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call struct {
+	*mock.Call
+}
+
+// UpdateReconciliationRunWorkflowId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - workflowId string
+func (_e *MockReconciliationServiceStore_Expecter) UpdateReconciliationRunWorkflowId(ctx interface{}, runId interface{}, workflowId interface{}) *MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call {
+	return &MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call{Call: _e.mock.On("UpdateReconciliationRunWorkflowId", ctx, runId, workflowId)}
+}
+
+func (_c *MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call) Run(run func(ctx context.Context, runId uuid.UUID, workflowId string)) *MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call) Return(_a0 error) *MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *MockReconciliationServiceStore_UpdateReconciliationRunWorkflowId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithReconciliationTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockReconciliationServiceStore) WithReconciliationTransaction(ctx context.Context, fn func(store.ReconciliationStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithReconciliationTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.ReconciliationStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_WithReconciliationTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithReconciliationTransaction'
+type MockReconciliationServiceStore_WithReconciliationTransaction_Call struct {
+	*mock.Call
+}
+
+// WithReconciliationTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.ReconciliationStore) error
+func (_e *MockReconciliationServiceStore_Expecter) WithReconciliationTransaction(ctx interface{}, fn interface{}) *MockReconciliationServiceStore_WithReconciliationTransaction_Call {
+	return &MockReconciliationServiceStore_WithReconciliationTransaction_Call{Call: _e.mock.On("WithReconciliationTransaction", ctx, fn)}
+}
+
+func (_c *MockReconciliationServiceStore_WithReconciliationTransaction_Call) Run(run func(ctx context.Context, fn func(store.ReconciliationStore) error)) *MockReconciliationServiceStore_WithReconciliationTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.ReconciliationStore) error))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_WithReconciliationTransaction_Call) Return(_a0 error) *MockReconciliationServiceStore_WithReconciliationTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_WithReconciliationTransaction_Call) RunAndReturn(run func(context.Context, func(store.ReconciliationStore) error) error) *MockReconciliationServiceStore_WithReconciliationTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function with given fields: ctx, fn
+func (_m *MockReconciliationServiceStore) WithTx(ctx context.Context, fn func(store.Store) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.Store) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationServiceStore_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockReconciliationServiceStore_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.Store) error
+func (_e *MockReconciliationServiceStore_Expecter) WithTx(ctx interface{}, fn interface{}) *MockReconciliationServiceStore_WithTx_Call {
+	return &MockReconciliationServiceStore_WithTx_Call{Call: _e.mock.On("WithTx", ctx, fn)}
+}
+
+func (_c *MockReconciliationServiceStore_WithTx_Call) Run(run func(ctx context.Context, fn func(store.Store) error)) *MockReconciliationServiceStore_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.Store) error))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_WithTx_Call) Return(_a0 error) *MockReconciliationServiceStore_WithTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationServiceStore_WithTx_Call) RunAndReturn(run func(context.Context, func(store.Store) error) error) *MockReconciliationServiceStore_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReconciliationServiceStore creates a new instance of MockReconciliationServiceStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReconciliationServiceStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReconciliationServiceStore {
+	mock := &MockReconciliationServiceStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
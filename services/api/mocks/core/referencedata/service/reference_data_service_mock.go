@@ -0,0 +1,357 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mock_service
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/core/referencedata/models"
+	referencedata "github.com/Zampfi/application-platform/services/api/pkg/referencedata"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockReferenceDataService is an autogenerated mock type for the ReferenceDataService type
+type MockReferenceDataService struct {
+	mock.Mock
+}
+
+type MockReferenceDataService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReferenceDataService) EXPECT() *MockReferenceDataService_Expecter {
+	return &MockReferenceDataService_Expecter{mock: &_m.Mock}
+}
+
+// CreateBank provides a mock function with given fields: ctx, orgId, userId, params
+func (_m *MockReferenceDataService) CreateBank(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.BankParams) (models.Bank, error) {
+	ret := _m.Called(ctx, orgId, userId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBank")
+	}
+
+	var r0 models.Bank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.BankParams) (models.Bank, error)); ok {
+		return rf(ctx, orgId, userId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.BankParams) models.Bank); ok {
+		r0 = rf(ctx, orgId, userId, params)
+	} else {
+		r0 = ret.Get(0).(models.Bank)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.BankParams) error); ok {
+		r1 = rf(ctx, orgId, userId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferenceDataService_CreateBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBank'
+type MockReferenceDataService_CreateBank_Call struct {
+	*mock.Call
+}
+
+// CreateBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - userId uuid.UUID
+//   - params models.BankParams
+func (_e *MockReferenceDataService_Expecter) CreateBank(ctx interface{}, orgId interface{}, userId interface{}, params interface{}) *MockReferenceDataService_CreateBank_Call {
+	return &MockReferenceDataService_CreateBank_Call{Call: _e.mock.On("CreateBank", ctx, orgId, userId, params)}
+}
+
+func (_c *MockReferenceDataService_CreateBank_Call) Run(run func(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.BankParams)) *MockReferenceDataService_CreateBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.BankParams))
+	})
+	return _c
+}
+
+func (_c *MockReferenceDataService_CreateBank_Call) Return(_a0 models.Bank, _a1 error) *MockReferenceDataService_CreateBank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferenceDataService_CreateBank_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.BankParams) (models.Bank, error)) *MockReferenceDataService_CreateBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBank provides a mock function with given fields: ctx, userId, bankId
+func (_m *MockReferenceDataService) DeleteBank(ctx context.Context, userId uuid.UUID, bankId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, bankId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBank")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, bankId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReferenceDataService_DeleteBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBank'
+type MockReferenceDataService_DeleteBank_Call struct {
+	*mock.Call
+}
+
+// DeleteBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - bankId uuid.UUID
+func (_e *MockReferenceDataService_Expecter) DeleteBank(ctx interface{}, userId interface{}, bankId interface{}) *MockReferenceDataService_DeleteBank_Call {
+	return &MockReferenceDataService_DeleteBank_Call{Call: _e.mock.On("DeleteBank", ctx, userId, bankId)}
+}
+
+func (_c *MockReferenceDataService_DeleteBank_Call) Run(run func(ctx context.Context, userId uuid.UUID, bankId uuid.UUID)) *MockReferenceDataService_DeleteBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReferenceDataService_DeleteBank_Call) Return(_a0 error) *MockReferenceDataService_DeleteBank_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferenceDataService_DeleteBank_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockReferenceDataService_DeleteBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBanks provides a mock function with given fields: ctx
+func (_m *MockReferenceDataService) GetBanks(ctx context.Context) ([]models.Bank, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBanks")
+	}
+
+	var r0 []models.Bank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Bank, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Bank); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Bank)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferenceDataService_GetBanks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBanks'
+type MockReferenceDataService_GetBanks_Call struct {
+	*mock.Call
+}
+
+// GetBanks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReferenceDataService_Expecter) GetBanks(ctx interface{}) *MockReferenceDataService_GetBanks_Call {
+	return &MockReferenceDataService_GetBanks_Call{Call: _e.mock.On("GetBanks", ctx)}
+}
+
+func (_c *MockReferenceDataService_GetBanks_Call) Run(run func(ctx context.Context)) *MockReferenceDataService_GetBanks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReferenceDataService_GetBanks_Call) Return(_a0 []models.Bank, _a1 error) *MockReferenceDataService_GetBanks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferenceDataService_GetBanks_Call) RunAndReturn(run func(context.Context) ([]models.Bank, error)) *MockReferenceDataService_GetBanks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCountries provides a mock function with no fields
+func (_m *MockReferenceDataService) GetCountries() []referencedata.Country {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCountries")
+	}
+
+	var r0 []referencedata.Country
+	if rf, ok := ret.Get(0).(func() []referencedata.Country); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]referencedata.Country)
+		}
+	}
+
+	return r0
+}
+
+// MockReferenceDataService_GetCountries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCountries'
+type MockReferenceDataService_GetCountries_Call struct {
+	*mock.Call
+}
+
+// GetCountries is a helper method to define mock.On call
+func (_e *MockReferenceDataService_Expecter) GetCountries() *MockReferenceDataService_GetCountries_Call {
+	return &MockReferenceDataService_GetCountries_Call{Call: _e.mock.On("GetCountries")}
+}
+
+func (_c *MockReferenceDataService_GetCountries_Call) Run(run func()) *MockReferenceDataService_GetCountries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReferenceDataService_GetCountries_Call) Return(_a0 []referencedata.Country) *MockReferenceDataService_GetCountries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferenceDataService_GetCountries_Call) RunAndReturn(run func() []referencedata.Country) *MockReferenceDataService_GetCountries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrencies provides a mock function with no fields
+func (_m *MockReferenceDataService) GetCurrencies() []referencedata.Currency {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrencies")
+	}
+
+	var r0 []referencedata.Currency
+	if rf, ok := ret.Get(0).(func() []referencedata.Currency); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]referencedata.Currency)
+		}
+	}
+
+	return r0
+}
+
+// MockReferenceDataService_GetCurrencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrencies'
+type MockReferenceDataService_GetCurrencies_Call struct {
+	*mock.Call
+}
+
+// GetCurrencies is a helper method to define mock.On call
+func (_e *MockReferenceDataService_Expecter) GetCurrencies() *MockReferenceDataService_GetCurrencies_Call {
+	return &MockReferenceDataService_GetCurrencies_Call{Call: _e.mock.On("GetCurrencies")}
+}
+
+func (_c *MockReferenceDataService_GetCurrencies_Call) Run(run func()) *MockReferenceDataService_GetCurrencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReferenceDataService_GetCurrencies_Call) Return(_a0 []referencedata.Currency) *MockReferenceDataService_GetCurrencies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferenceDataService_GetCurrencies_Call) RunAndReturn(run func() []referencedata.Currency) *MockReferenceDataService_GetCurrencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lookup provides a mock function with given fields: ctx, kind, value
+func (_m *MockReferenceDataService) Lookup(ctx context.Context, kind referencedata.Kind, value string) (models.LookupResult, error) {
+	ret := _m.Called(ctx, kind, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lookup")
+	}
+
+	var r0 models.LookupResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, referencedata.Kind, string) (models.LookupResult, error)); ok {
+		return rf(ctx, kind, value)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, referencedata.Kind, string) models.LookupResult); ok {
+		r0 = rf(ctx, kind, value)
+	} else {
+		r0 = ret.Get(0).(models.LookupResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, referencedata.Kind, string) error); ok {
+		r1 = rf(ctx, kind, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferenceDataService_Lookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lookup'
+type MockReferenceDataService_Lookup_Call struct {
+	*mock.Call
+}
+
+// Lookup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kind referencedata.Kind
+//   - value string
+func (_e *MockReferenceDataService_Expecter) Lookup(ctx interface{}, kind interface{}, value interface{}) *MockReferenceDataService_Lookup_Call {
+	return &MockReferenceDataService_Lookup_Call{Call: _e.mock.On("Lookup", ctx, kind, value)}
+}
+
+func (_c *MockReferenceDataService_Lookup_Call) Run(run func(ctx context.Context, kind referencedata.Kind, value string)) *MockReferenceDataService_Lookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(referencedata.Kind), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferenceDataService_Lookup_Call) Return(_a0 models.LookupResult, _a1 error) *MockReferenceDataService_Lookup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferenceDataService_Lookup_Call) RunAndReturn(run func(context.Context, referencedata.Kind, string) (models.LookupResult, error)) *MockReferenceDataService_Lookup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReferenceDataService creates a new instance of MockReferenceDataService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReferenceDataService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReferenceDataService {
+	mock := &MockReferenceDataService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
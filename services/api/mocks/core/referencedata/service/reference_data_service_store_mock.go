@@ -0,0 +1,202 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mock_service
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockReferenceDataServiceStore is an autogenerated mock type for the ReferenceDataServiceStore type
+type MockReferenceDataServiceStore struct {
+	mock.Mock
+}
+
+type MockReferenceDataServiceStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReferenceDataServiceStore) EXPECT() *MockReferenceDataServiceStore_Expecter {
+	return &MockReferenceDataServiceStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateReferenceBank provides a mock function with given fields: ctx, params
+func (_m *MockReferenceDataServiceStore) CreateReferenceBank(ctx context.Context, params models.CreateReferenceBankParams) (models.ReferenceBank, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReferenceBank")
+	}
+
+	var r0 models.ReferenceBank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) models.ReferenceBank); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.ReferenceBank)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateReferenceBankParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferenceDataServiceStore_CreateReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReferenceBank'
+type MockReferenceDataServiceStore_CreateReferenceBank_Call struct {
+	*mock.Call
+}
+
+// CreateReferenceBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateReferenceBankParams
+func (_e *MockReferenceDataServiceStore_Expecter) CreateReferenceBank(ctx interface{}, params interface{}) *MockReferenceDataServiceStore_CreateReferenceBank_Call {
+	return &MockReferenceDataServiceStore_CreateReferenceBank_Call{Call: _e.mock.On("CreateReferenceBank", ctx, params)}
+}
+
+func (_c *MockReferenceDataServiceStore_CreateReferenceBank_Call) Run(run func(ctx context.Context, params models.CreateReferenceBankParams)) *MockReferenceDataServiceStore_CreateReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateReferenceBankParams))
+	})
+	return _c
+}
+
+func (_c *MockReferenceDataServiceStore_CreateReferenceBank_Call) Return(_a0 models.ReferenceBank, _a1 error) *MockReferenceDataServiceStore_CreateReferenceBank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferenceDataServiceStore_CreateReferenceBank_Call) RunAndReturn(run func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)) *MockReferenceDataServiceStore_CreateReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReferenceBank provides a mock function with given fields: ctx, bankId, deletedBy
+func (_m *MockReferenceDataServiceStore) DeleteReferenceBank(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, bankId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReferenceBank")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, bankId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReferenceDataServiceStore_DeleteReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReferenceBank'
+type MockReferenceDataServiceStore_DeleteReferenceBank_Call struct {
+	*mock.Call
+}
+
+// DeleteReferenceBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bankId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockReferenceDataServiceStore_Expecter) DeleteReferenceBank(ctx interface{}, bankId interface{}, deletedBy interface{}) *MockReferenceDataServiceStore_DeleteReferenceBank_Call {
+	return &MockReferenceDataServiceStore_DeleteReferenceBank_Call{Call: _e.mock.On("DeleteReferenceBank", ctx, bankId, deletedBy)}
+}
+
+func (_c *MockReferenceDataServiceStore_DeleteReferenceBank_Call) Run(run func(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID)) *MockReferenceDataServiceStore_DeleteReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReferenceDataServiceStore_DeleteReferenceBank_Call) Return(_a0 error) *MockReferenceDataServiceStore_DeleteReferenceBank_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferenceDataServiceStore_DeleteReferenceBank_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockReferenceDataServiceStore_DeleteReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferenceBanks provides a mock function with given fields: ctx
+func (_m *MockReferenceDataServiceStore) GetReferenceBanks(ctx context.Context) ([]models.ReferenceBank, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferenceBanks")
+	}
+
+	var r0 []models.ReferenceBank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ReferenceBank, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ReferenceBank); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReferenceBank)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferenceDataServiceStore_GetReferenceBanks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferenceBanks'
+type MockReferenceDataServiceStore_GetReferenceBanks_Call struct {
+	*mock.Call
+}
+
+// GetReferenceBanks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReferenceDataServiceStore_Expecter) GetReferenceBanks(ctx interface{}) *MockReferenceDataServiceStore_GetReferenceBanks_Call {
+	return &MockReferenceDataServiceStore_GetReferenceBanks_Call{Call: _e.mock.On("GetReferenceBanks", ctx)}
+}
+
+func (_c *MockReferenceDataServiceStore_GetReferenceBanks_Call) Run(run func(ctx context.Context)) *MockReferenceDataServiceStore_GetReferenceBanks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReferenceDataServiceStore_GetReferenceBanks_Call) Return(_a0 []models.ReferenceBank, _a1 error) *MockReferenceDataServiceStore_GetReferenceBanks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferenceDataServiceStore_GetReferenceBanks_Call) RunAndReturn(run func(context.Context) ([]models.ReferenceBank, error)) *MockReferenceDataServiceStore_GetReferenceBanks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReferenceDataServiceStore creates a new instance of MockReferenceDataServiceStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReferenceDataServiceStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReferenceDataServiceStore {
+	mock := &MockReferenceDataServiceStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
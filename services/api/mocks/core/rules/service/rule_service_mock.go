@@ -73,6 +73,53 @@ func (_c *MockRuleService_CreateRule_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// CreateRuleRuns provides a mock function with given fields: ctx, params
+func (_m *MockRuleService) CreateRuleRuns(ctx context.Context, params []models.CreateRuleRunParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRuleRuns")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.CreateRuleRunParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRuleService_CreateRuleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRuleRuns'
+type MockRuleService_CreateRuleRuns_Call struct {
+	*mock.Call
+}
+
+// CreateRuleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params []models.CreateRuleRunParams
+func (_e *MockRuleService_Expecter) CreateRuleRuns(ctx interface{}, params interface{}) *MockRuleService_CreateRuleRuns_Call {
+	return &MockRuleService_CreateRuleRuns_Call{Call: _e.mock.On("CreateRuleRuns", ctx, params)}
+}
+
+func (_c *MockRuleService_CreateRuleRuns_Call) Run(run func(ctx context.Context, params []models.CreateRuleRunParams)) *MockRuleService_CreateRuleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.CreateRuleRunParams))
+	})
+	return _c
+}
+
+func (_c *MockRuleService_CreateRuleRuns_Call) Return(_a0 error) *MockRuleService_CreateRuleRuns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRuleService_CreateRuleRuns_Call) RunAndReturn(run func(context.Context, []models.CreateRuleRunParams) error) *MockRuleService_CreateRuleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteRule provides a mock function with given fields: ctx, params
 func (_m *MockRuleService) DeleteRule(ctx context.Context, params models.DeleteRuleParams) error {
 	ret := _m.Called(ctx, params)
@@ -179,6 +226,125 @@ func (_c *MockRuleService_GetRuleByIds_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetRuleRuns provides a mock function with given fields: ctx, ruleIds, limit
+func (_m *MockRuleService) GetRuleRuns(ctx context.Context, ruleIds []uuid.UUID, limit int) (map[uuid.UUID][]rulesmodels.RuleRun, error) {
+	ret := _m.Called(ctx, ruleIds, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleRuns")
+	}
+
+	var r0 map[uuid.UUID][]rulesmodels.RuleRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) (map[uuid.UUID][]rulesmodels.RuleRun, error)); ok {
+		return rf(ctx, ruleIds, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) map[uuid.UUID][]rulesmodels.RuleRun); ok {
+		r0 = rf(ctx, ruleIds, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID][]rulesmodels.RuleRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, int) error); ok {
+		r1 = rf(ctx, ruleIds, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleService_GetRuleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleRuns'
+type MockRuleService_GetRuleRuns_Call struct {
+	*mock.Call
+}
+
+// GetRuleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleIds []uuid.UUID
+//   - limit int
+func (_e *MockRuleService_Expecter) GetRuleRuns(ctx interface{}, ruleIds interface{}, limit interface{}) *MockRuleService_GetRuleRuns_Call {
+	return &MockRuleService_GetRuleRuns_Call{Call: _e.mock.On("GetRuleRuns", ctx, ruleIds, limit)}
+}
+
+func (_c *MockRuleService_GetRuleRuns_Call) Run(run func(ctx context.Context, ruleIds []uuid.UUID, limit int)) *MockRuleService_GetRuleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRuleService_GetRuleRuns_Call) Return(_a0 map[uuid.UUID][]rulesmodels.RuleRun, _a1 error) *MockRuleService_GetRuleRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleService_GetRuleRuns_Call) RunAndReturn(run func(context.Context, []uuid.UUID, int) (map[uuid.UUID][]rulesmodels.RuleRun, error)) *MockRuleService_GetRuleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuleVersions provides a mock function with given fields: ctx, ruleId
+func (_m *MockRuleService) GetRuleVersions(ctx context.Context, ruleId uuid.UUID) ([]rulesmodels.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleVersions")
+	}
+
+	var r0 []rulesmodels.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]rulesmodels.RuleVersion, error)); ok {
+		return rf(ctx, ruleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []rulesmodels.RuleVersion); ok {
+		r0 = rf(ctx, ruleId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rulesmodels.RuleVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ruleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleService_GetRuleVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleVersions'
+type MockRuleService_GetRuleVersions_Call struct {
+	*mock.Call
+}
+
+// GetRuleVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+func (_e *MockRuleService_Expecter) GetRuleVersions(ctx interface{}, ruleId interface{}) *MockRuleService_GetRuleVersions_Call {
+	return &MockRuleService_GetRuleVersions_Call{Call: _e.mock.On("GetRuleVersions", ctx, ruleId)}
+}
+
+func (_c *MockRuleService_GetRuleVersions_Call) Run(run func(ctx context.Context, ruleId uuid.UUID)) *MockRuleService_GetRuleVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRuleService_GetRuleVersions_Call) Return(_a0 []rulesmodels.RuleVersion, _a1 error) *MockRuleService_GetRuleVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleService_GetRuleVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]rulesmodels.RuleVersion, error)) *MockRuleService_GetRuleVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRules provides a mock function with given fields: ctx, params
 func (_m *MockRuleService) GetRules(ctx context.Context, params models.FilterRuleParams) (map[string]map[string][]rulesmodels.Rule, error) {
 	ret := _m.Called(ctx, params)
@@ -238,6 +404,114 @@ func (_c *MockRuleService_GetRules_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// RestoreRuleVersion provides a mock function with given fields: ctx, ruleId, version, updatedBy
+func (_m *MockRuleService) RestoreRuleVersion(ctx context.Context, ruleId uuid.UUID, version int, updatedBy uuid.UUID) (rulesmodels.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId, version, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreRuleVersion")
+	}
+
+	var r0 rulesmodels.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, uuid.UUID) (rulesmodels.RuleVersion, error)); ok {
+		return rf(ctx, ruleId, version, updatedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, uuid.UUID) rulesmodels.RuleVersion); ok {
+		r0 = rf(ctx, ruleId, version, updatedBy)
+	} else {
+		r0 = ret.Get(0).(rulesmodels.RuleVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, uuid.UUID) error); ok {
+		r1 = rf(ctx, ruleId, version, updatedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleService_RestoreRuleVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRuleVersion'
+type MockRuleService_RestoreRuleVersion_Call struct {
+	*mock.Call
+}
+
+// RestoreRuleVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+//   - version int
+//   - updatedBy uuid.UUID
+func (_e *MockRuleService_Expecter) RestoreRuleVersion(ctx interface{}, ruleId interface{}, version interface{}, updatedBy interface{}) *MockRuleService_RestoreRuleVersion_Call {
+	return &MockRuleService_RestoreRuleVersion_Call{Call: _e.mock.On("RestoreRuleVersion", ctx, ruleId, version, updatedBy)}
+}
+
+func (_c *MockRuleService_RestoreRuleVersion_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, version int, updatedBy uuid.UUID)) *MockRuleService_RestoreRuleVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRuleService_RestoreRuleVersion_Call) Return(_a0 rulesmodels.RuleVersion, _a1 error) *MockRuleService_RestoreRuleVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleService_RestoreRuleVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, int, uuid.UUID) (rulesmodels.RuleVersion, error)) *MockRuleService_RestoreRuleVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRuleEnabled provides a mock function with given fields: ctx, ruleId, isEnabled, updatedBy
+func (_m *MockRuleService) SetRuleEnabled(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, ruleId, isEnabled, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRuleEnabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, uuid.UUID) error); ok {
+		r0 = rf(ctx, ruleId, isEnabled, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRuleService_SetRuleEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRuleEnabled'
+type MockRuleService_SetRuleEnabled_Call struct {
+	*mock.Call
+}
+
+// SetRuleEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+//   - isEnabled bool
+//   - updatedBy uuid.UUID
+func (_e *MockRuleService_Expecter) SetRuleEnabled(ctx interface{}, ruleId interface{}, isEnabled interface{}, updatedBy interface{}) *MockRuleService_SetRuleEnabled_Call {
+	return &MockRuleService_SetRuleEnabled_Call{Call: _e.mock.On("SetRuleEnabled", ctx, ruleId, isEnabled, updatedBy)}
+}
+
+func (_c *MockRuleService_SetRuleEnabled_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID)) *MockRuleService_SetRuleEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRuleService_SetRuleEnabled_Call) Return(_a0 error) *MockRuleService_SetRuleEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRuleService_SetRuleEnabled_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, uuid.UUID) error) *MockRuleService_SetRuleEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRule provides a mock function with given fields: ctx, ruleId, params
 func (_m *MockRuleService) UpdateRule(ctx context.Context, ruleId uuid.UUID, params models.UpdateRuleParams) error {
 	ret := _m.Called(ctx, ruleId, params)
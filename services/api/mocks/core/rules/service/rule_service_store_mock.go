@@ -71,6 +71,112 @@ func (_c *MockRuleServiceStore_CreateRule_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// CreateRuleRuns provides a mock function with given fields: ctx, params
+func (_m *MockRuleServiceStore) CreateRuleRuns(ctx context.Context, params []models.CreateRuleRunParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRuleRuns")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.CreateRuleRunParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRuleServiceStore_CreateRuleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRuleRuns'
+type MockRuleServiceStore_CreateRuleRuns_Call struct {
+	*mock.Call
+}
+
+// CreateRuleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params []models.CreateRuleRunParams
+func (_e *MockRuleServiceStore_Expecter) CreateRuleRuns(ctx interface{}, params interface{}) *MockRuleServiceStore_CreateRuleRuns_Call {
+	return &MockRuleServiceStore_CreateRuleRuns_Call{Call: _e.mock.On("CreateRuleRuns", ctx, params)}
+}
+
+func (_c *MockRuleServiceStore_CreateRuleRuns_Call) Run(run func(ctx context.Context, params []models.CreateRuleRunParams)) *MockRuleServiceStore_CreateRuleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.CreateRuleRunParams))
+	})
+	return _c
+}
+
+func (_c *MockRuleServiceStore_CreateRuleRuns_Call) Return(_a0 error) *MockRuleServiceStore_CreateRuleRuns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRuleServiceStore_CreateRuleRuns_Call) RunAndReturn(run func(context.Context, []models.CreateRuleRunParams) error) *MockRuleServiceStore_CreateRuleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRuleVersion provides a mock function with given fields: ctx, ruleId, changeType, createdBy
+func (_m *MockRuleServiceStore) CreateRuleVersion(ctx context.Context, ruleId uuid.UUID, changeType models.RuleChangeType, createdBy uuid.UUID) (models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId, changeType, createdBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRuleVersion")
+	}
+
+	var r0 models.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) (models.RuleVersion, error)); ok {
+		return rf(ctx, ruleId, changeType, createdBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) models.RuleVersion); ok {
+		r0 = rf(ctx, ruleId, changeType, createdBy)
+	} else {
+		r0 = ret.Get(0).(models.RuleVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) error); ok {
+		r1 = rf(ctx, ruleId, changeType, createdBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleServiceStore_CreateRuleVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRuleVersion'
+type MockRuleServiceStore_CreateRuleVersion_Call struct {
+	*mock.Call
+}
+
+// CreateRuleVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+//   - changeType models.RuleChangeType
+//   - createdBy uuid.UUID
+func (_e *MockRuleServiceStore_Expecter) CreateRuleVersion(ctx interface{}, ruleId interface{}, changeType interface{}, createdBy interface{}) *MockRuleServiceStore_CreateRuleVersion_Call {
+	return &MockRuleServiceStore_CreateRuleVersion_Call{Call: _e.mock.On("CreateRuleVersion", ctx, ruleId, changeType, createdBy)}
+}
+
+func (_c *MockRuleServiceStore_CreateRuleVersion_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, changeType models.RuleChangeType, createdBy uuid.UUID)) *MockRuleServiceStore_CreateRuleVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.RuleChangeType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRuleServiceStore_CreateRuleVersion_Call) Return(_a0 models.RuleVersion, _a1 error) *MockRuleServiceStore_CreateRuleVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleServiceStore_CreateRuleVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) (models.RuleVersion, error)) *MockRuleServiceStore_CreateRuleVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteRule provides a mock function with given fields: ctx, params
 func (_m *MockRuleServiceStore) DeleteRule(ctx context.Context, params models.DeleteRuleParams) error {
 	ret := _m.Called(ctx, params)
@@ -234,6 +340,183 @@ func (_c *MockRuleServiceStore_GetRuleByIds_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetRuleRuns provides a mock function with given fields: ctx, ruleIds, limit
+func (_m *MockRuleServiceStore) GetRuleRuns(ctx context.Context, ruleIds []uuid.UUID, limit int) ([]models.RuleRun, error) {
+	ret := _m.Called(ctx, ruleIds, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleRuns")
+	}
+
+	var r0 []models.RuleRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) ([]models.RuleRun, error)); ok {
+		return rf(ctx, ruleIds, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) []models.RuleRun); ok {
+		r0 = rf(ctx, ruleIds, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RuleRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, int) error); ok {
+		r1 = rf(ctx, ruleIds, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleServiceStore_GetRuleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleRuns'
+type MockRuleServiceStore_GetRuleRuns_Call struct {
+	*mock.Call
+}
+
+// GetRuleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleIds []uuid.UUID
+//   - limit int
+func (_e *MockRuleServiceStore_Expecter) GetRuleRuns(ctx interface{}, ruleIds interface{}, limit interface{}) *MockRuleServiceStore_GetRuleRuns_Call {
+	return &MockRuleServiceStore_GetRuleRuns_Call{Call: _e.mock.On("GetRuleRuns", ctx, ruleIds, limit)}
+}
+
+func (_c *MockRuleServiceStore_GetRuleRuns_Call) Run(run func(ctx context.Context, ruleIds []uuid.UUID, limit int)) *MockRuleServiceStore_GetRuleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRuleServiceStore_GetRuleRuns_Call) Return(_a0 []models.RuleRun, _a1 error) *MockRuleServiceStore_GetRuleRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleServiceStore_GetRuleRuns_Call) RunAndReturn(run func(context.Context, []uuid.UUID, int) ([]models.RuleRun, error)) *MockRuleServiceStore_GetRuleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuleVersion provides a mock function with given fields: ctx, ruleId, version
+func (_m *MockRuleServiceStore) GetRuleVersion(ctx context.Context, ruleId uuid.UUID, version int) (models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleVersion")
+	}
+
+	var r0 models.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (models.RuleVersion, error)); ok {
+		return rf(ctx, ruleId, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) models.RuleVersion); ok {
+		r0 = rf(ctx, ruleId, version)
+	} else {
+		r0 = ret.Get(0).(models.RuleVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, ruleId, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleServiceStore_GetRuleVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleVersion'
+type MockRuleServiceStore_GetRuleVersion_Call struct {
+	*mock.Call
+}
+
+// GetRuleVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+//   - version int
+func (_e *MockRuleServiceStore_Expecter) GetRuleVersion(ctx interface{}, ruleId interface{}, version interface{}) *MockRuleServiceStore_GetRuleVersion_Call {
+	return &MockRuleServiceStore_GetRuleVersion_Call{Call: _e.mock.On("GetRuleVersion", ctx, ruleId, version)}
+}
+
+func (_c *MockRuleServiceStore_GetRuleVersion_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, version int)) *MockRuleServiceStore_GetRuleVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRuleServiceStore_GetRuleVersion_Call) Return(_a0 models.RuleVersion, _a1 error) *MockRuleServiceStore_GetRuleVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleServiceStore_GetRuleVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) (models.RuleVersion, error)) *MockRuleServiceStore_GetRuleVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuleVersions provides a mock function with given fields: ctx, ruleId
+func (_m *MockRuleServiceStore) GetRuleVersions(ctx context.Context, ruleId uuid.UUID) ([]models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleVersions")
+	}
+
+	var r0 []models.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.RuleVersion, error)); ok {
+		return rf(ctx, ruleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.RuleVersion); ok {
+		r0 = rf(ctx, ruleId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RuleVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ruleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleServiceStore_GetRuleVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleVersions'
+type MockRuleServiceStore_GetRuleVersions_Call struct {
+	*mock.Call
+}
+
+// GetRuleVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+func (_e *MockRuleServiceStore_Expecter) GetRuleVersions(ctx interface{}, ruleId interface{}) *MockRuleServiceStore_GetRuleVersions_Call {
+	return &MockRuleServiceStore_GetRuleVersions_Call{Call: _e.mock.On("GetRuleVersions", ctx, ruleId)}
+}
+
+func (_c *MockRuleServiceStore_GetRuleVersions_Call) Run(run func(ctx context.Context, ruleId uuid.UUID)) *MockRuleServiceStore_GetRuleVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRuleServiceStore_GetRuleVersions_Call) Return(_a0 []models.RuleVersion, _a1 error) *MockRuleServiceStore_GetRuleVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleServiceStore_GetRuleVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.RuleVersion, error)) *MockRuleServiceStore_GetRuleVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRules provides a mock function with given fields: ctx, params
 func (_m *MockRuleServiceStore) GetRules(ctx context.Context, params models.FilterRuleParams) (map[string]map[string][]models.Rule, error) {
 	ret := _m.Called(ctx, params)
@@ -293,6 +576,55 @@ func (_c *MockRuleServiceStore_GetRules_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// SetRuleEnabled provides a mock function with given fields: ctx, ruleId, isEnabled, updatedBy
+func (_m *MockRuleServiceStore) SetRuleEnabled(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, ruleId, isEnabled, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRuleEnabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, uuid.UUID) error); ok {
+		r0 = rf(ctx, ruleId, isEnabled, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRuleServiceStore_SetRuleEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRuleEnabled'
+type MockRuleServiceStore_SetRuleEnabled_Call struct {
+	*mock.Call
+}
+
+// SetRuleEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+//   - isEnabled bool
+//   - updatedBy uuid.UUID
+func (_e *MockRuleServiceStore_Expecter) SetRuleEnabled(ctx interface{}, ruleId interface{}, isEnabled interface{}, updatedBy interface{}) *MockRuleServiceStore_SetRuleEnabled_Call {
+	return &MockRuleServiceStore_SetRuleEnabled_Call{Call: _e.mock.On("SetRuleEnabled", ctx, ruleId, isEnabled, updatedBy)}
+}
+
+func (_c *MockRuleServiceStore_SetRuleEnabled_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID)) *MockRuleServiceStore_SetRuleEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRuleServiceStore_SetRuleEnabled_Call) Return(_a0 error) *MockRuleServiceStore_SetRuleEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRuleServiceStore_SetRuleEnabled_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, uuid.UUID) error) *MockRuleServiceStore_SetRuleEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRule provides a mock function with given fields: ctx, ruleId, params
 func (_m *MockRuleServiceStore) UpdateRule(ctx context.Context, ruleId uuid.UUID, params models.UpdateRuleParams) error {
 	ret := _m.Called(ctx, ruleId, params)
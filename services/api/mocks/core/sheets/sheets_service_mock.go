@@ -85,6 +85,113 @@ func (_c *MockSheetsService_CreateSheet_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// DeleteSheet provides a mock function with given fields: ctx, sheetId
+func (_m *MockSheetsService) DeleteSheet(ctx context.Context, sheetId uuid.UUID) error {
+	ret := _m.Called(ctx, sheetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSheet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sheetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSheetsService_DeleteSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSheet'
+type MockSheetsService_DeleteSheet_Call struct {
+	*mock.Call
+}
+
+// DeleteSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+func (_e *MockSheetsService_Expecter) DeleteSheet(ctx interface{}, sheetId interface{}) *MockSheetsService_DeleteSheet_Call {
+	return &MockSheetsService_DeleteSheet_Call{Call: _e.mock.On("DeleteSheet", ctx, sheetId)}
+}
+
+func (_c *MockSheetsService_DeleteSheet_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockSheetsService_DeleteSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetsService_DeleteSheet_Call) Return(_a0 error) *MockSheetsService_DeleteSheet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSheetsService_DeleteSheet_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockSheetsService_DeleteSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DuplicateSheet provides a mock function with given fields: ctx, sheetId, name
+func (_m *MockSheetsService) DuplicateSheet(ctx context.Context, sheetId uuid.UUID, name string) (*dbmodels.Sheet, error) {
+	ret := _m.Called(ctx, sheetId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DuplicateSheet")
+	}
+
+	var r0 *dbmodels.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (*dbmodels.Sheet, error)); ok {
+		return rf(ctx, sheetId, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) *dbmodels.Sheet); ok {
+		r0 = rf(ctx, sheetId, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodels.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, sheetId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetsService_DuplicateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateSheet'
+type MockSheetsService_DuplicateSheet_Call struct {
+	*mock.Call
+}
+
+// DuplicateSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+//   - name string
+func (_e *MockSheetsService_Expecter) DuplicateSheet(ctx interface{}, sheetId interface{}, name interface{}) *MockSheetsService_DuplicateSheet_Call {
+	return &MockSheetsService_DuplicateSheet_Call{Call: _e.mock.On("DuplicateSheet", ctx, sheetId, name)}
+}
+
+func (_c *MockSheetsService_DuplicateSheet_Call) Run(run func(ctx context.Context, sheetId uuid.UUID, name string)) *MockSheetsService_DuplicateSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSheetsService_DuplicateSheet_Call) Return(_a0 *dbmodels.Sheet, _a1 error) *MockSheetsService_DuplicateSheet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetsService_DuplicateSheet_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (*dbmodels.Sheet, error)) *MockSheetsService_DuplicateSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSheetById provides a mock function with given fields: ctx, pageId
 func (_m *MockSheetsService) GetSheetById(ctx context.Context, pageId uuid.UUID) (*dbmodels.Sheet, error) {
 	ret := _m.Called(ctx, pageId)
@@ -8,6 +8,8 @@ import (
 	models "github.com/Zampfi/application-platform/services/api/db/models"
 	mock "github.com/stretchr/testify/mock"
 
+	store "github.com/Zampfi/application-platform/services/api/db/store"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -24,6 +26,63 @@ func (_m *MockSheetsServiceStore) EXPECT() *MockSheetsServiceStore_Expecter {
 	return &MockSheetsServiceStore_Expecter{mock: &_m.Mock}
 }
 
+// CreateReferenceBank provides a mock function with given fields: ctx, params
+func (_m *MockSheetsServiceStore) CreateReferenceBank(ctx context.Context, params models.CreateReferenceBankParams) (models.ReferenceBank, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReferenceBank")
+	}
+
+	var r0 models.ReferenceBank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) models.ReferenceBank); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.ReferenceBank)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateReferenceBankParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetsServiceStore_CreateReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReferenceBank'
+type MockSheetsServiceStore_CreateReferenceBank_Call struct {
+	*mock.Call
+}
+
+// CreateReferenceBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateReferenceBankParams
+func (_e *MockSheetsServiceStore_Expecter) CreateReferenceBank(ctx interface{}, params interface{}) *MockSheetsServiceStore_CreateReferenceBank_Call {
+	return &MockSheetsServiceStore_CreateReferenceBank_Call{Call: _e.mock.On("CreateReferenceBank", ctx, params)}
+}
+
+func (_c *MockSheetsServiceStore_CreateReferenceBank_Call) Run(run func(ctx context.Context, params models.CreateReferenceBankParams)) *MockSheetsServiceStore_CreateReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateReferenceBankParams))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_CreateReferenceBank_Call) Return(_a0 models.ReferenceBank, _a1 error) *MockSheetsServiceStore_CreateReferenceBank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_CreateReferenceBank_Call) RunAndReturn(run func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)) *MockSheetsServiceStore_CreateReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSheet provides a mock function with given fields: ctx, sheet
 func (_m *MockSheetsServiceStore) CreateSheet(ctx context.Context, sheet models.Sheet) (*models.Sheet, error) {
 	ret := _m.Called(ctx, sheet)
@@ -83,6 +142,324 @@ func (_c *MockSheetsServiceStore_CreateSheet_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// CreateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
+func (_m *MockSheetsServiceStore) CreateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWidgetInstance")
+	}
+
+	var r0 *models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstance)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
+		r1 = rf(ctx, widgetInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetsServiceStore_CreateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWidgetInstance'
+type MockSheetsServiceStore_CreateWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// CreateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstance *models.WidgetInstance
+func (_e *MockSheetsServiceStore_Expecter) CreateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockSheetsServiceStore_CreateWidgetInstance_Call {
+	return &MockSheetsServiceStore_CreateWidgetInstance_Call{Call: _e.mock.On("CreateWidgetInstance", ctx, widgetInstance)}
+}
+
+func (_c *MockSheetsServiceStore_CreateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockSheetsServiceStore_CreateWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_CreateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockSheetsServiceStore_CreateWidgetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_CreateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockSheetsServiceStore_CreateWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReferenceBank provides a mock function with given fields: ctx, bankId, deletedBy
+func (_m *MockSheetsServiceStore) DeleteReferenceBank(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, bankId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReferenceBank")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, bankId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSheetsServiceStore_DeleteReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReferenceBank'
+type MockSheetsServiceStore_DeleteReferenceBank_Call struct {
+	*mock.Call
+}
+
+// DeleteReferenceBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bankId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockSheetsServiceStore_Expecter) DeleteReferenceBank(ctx interface{}, bankId interface{}, deletedBy interface{}) *MockSheetsServiceStore_DeleteReferenceBank_Call {
+	return &MockSheetsServiceStore_DeleteReferenceBank_Call{Call: _e.mock.On("DeleteReferenceBank", ctx, bankId, deletedBy)}
+}
+
+func (_c *MockSheetsServiceStore_DeleteReferenceBank_Call) Run(run func(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID)) *MockSheetsServiceStore_DeleteReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_DeleteReferenceBank_Call) Return(_a0 error) *MockSheetsServiceStore_DeleteReferenceBank_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_DeleteReferenceBank_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockSheetsServiceStore_DeleteReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSheet provides a mock function with given fields: ctx, sheetId
+func (_m *MockSheetsServiceStore) DeleteSheet(ctx context.Context, sheetId uuid.UUID) error {
+	ret := _m.Called(ctx, sheetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSheet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sheetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSheetsServiceStore_DeleteSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSheet'
+type MockSheetsServiceStore_DeleteSheet_Call struct {
+	*mock.Call
+}
+
+// DeleteSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+func (_e *MockSheetsServiceStore_Expecter) DeleteSheet(ctx interface{}, sheetId interface{}) *MockSheetsServiceStore_DeleteSheet_Call {
+	return &MockSheetsServiceStore_DeleteSheet_Call{Call: _e.mock.On("DeleteSheet", ctx, sheetId)}
+}
+
+func (_c *MockSheetsServiceStore_DeleteSheet_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockSheetsServiceStore_DeleteSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_DeleteSheet_Call) Return(_a0 error) *MockSheetsServiceStore_DeleteSheet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_DeleteSheet_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockSheetsServiceStore_DeleteSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWidgetInstance provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockSheetsServiceStore) DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidgetInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSheetsServiceStore_DeleteWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWidgetInstance'
+type MockSheetsServiceStore_DeleteWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// DeleteWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockSheetsServiceStore_Expecter) DeleteWidgetInstance(ctx interface{}, widgetInstanceID interface{}) *MockSheetsServiceStore_DeleteWidgetInstance_Call {
+	return &MockSheetsServiceStore_DeleteWidgetInstance_Call{Call: _e.mock.On("DeleteWidgetInstance", ctx, widgetInstanceID)}
+}
+
+func (_c *MockSheetsServiceStore_DeleteWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockSheetsServiceStore_DeleteWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_DeleteWidgetInstance_Call) Return(_a0 error) *MockSheetsServiceStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_DeleteWidgetInstance_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockSheetsServiceStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlattenedResourceAudiencePolicies provides a mock function with given fields: ctx, filters
+func (_m *MockSheetsServiceStore) GetFlattenedResourceAudiencePolicies(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlattenedResourceAudiencePolicies")
+	}
+
+	var r0 []models.FlattenedResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) []models.FlattenedResourceAudiencePolicy); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FlattenedResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlattenedResourceAudiencePolicies'
+type MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call struct {
+	*mock.Call
+}
+
+// GetFlattenedResourceAudiencePolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FlattenedResourceAudiencePoliciesFilters
+func (_e *MockSheetsServiceStore_Expecter) GetFlattenedResourceAudiencePolicies(ctx interface{}, filters interface{}) *MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	return &MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call{Call: _e.mock.On("GetFlattenedResourceAudiencePolicies", ctx, filters)}
+}
+
+func (_c *MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call) Run(run func(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters)) *MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FlattenedResourceAudiencePoliciesFilters))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call) Return(_a0 []models.FlattenedResourceAudiencePolicy, _a1 error) *MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call) RunAndReturn(run func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)) *MockSheetsServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferenceBanks provides a mock function with given fields: ctx
+func (_m *MockSheetsServiceStore) GetReferenceBanks(ctx context.Context) ([]models.ReferenceBank, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferenceBanks")
+	}
+
+	var r0 []models.ReferenceBank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ReferenceBank, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ReferenceBank); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReferenceBank)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetsServiceStore_GetReferenceBanks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferenceBanks'
+type MockSheetsServiceStore_GetReferenceBanks_Call struct {
+	*mock.Call
+}
+
+// GetReferenceBanks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSheetsServiceStore_Expecter) GetReferenceBanks(ctx interface{}) *MockSheetsServiceStore_GetReferenceBanks_Call {
+	return &MockSheetsServiceStore_GetReferenceBanks_Call{Call: _e.mock.On("GetReferenceBanks", ctx)}
+}
+
+func (_c *MockSheetsServiceStore_GetReferenceBanks_Call) Run(run func(ctx context.Context)) *MockSheetsServiceStore_GetReferenceBanks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_GetReferenceBanks_Call) Return(_a0 []models.ReferenceBank, _a1 error) *MockSheetsServiceStore_GetReferenceBanks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_GetReferenceBanks_Call) RunAndReturn(run func(context.Context) ([]models.ReferenceBank, error)) *MockSheetsServiceStore_GetReferenceBanks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSheetById provides a mock function with given fields: ctx, sheetId
 func (_m *MockSheetsServiceStore) GetSheetById(ctx context.Context, sheetId uuid.UUID) (*models.Sheet, error) {
 	ret := _m.Called(ctx, sheetId)
@@ -201,6 +578,120 @@ func (_c *MockSheetsServiceStore_GetSheetsAll_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetWidgetInstanceByID provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockSheetsServiceStore) GetWidgetInstanceByID(ctx context.Context, widgetInstanceID uuid.UUID) (models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWidgetInstanceByID")
+	}
+
+	var r0 models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstanceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		r0 = ret.Get(0).(models.WidgetInstance)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, widgetInstanceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetsServiceStore_GetWidgetInstanceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetInstanceByID'
+type MockSheetsServiceStore_GetWidgetInstanceByID_Call struct {
+	*mock.Call
+}
+
+// GetWidgetInstanceByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockSheetsServiceStore_Expecter) GetWidgetInstanceByID(ctx interface{}, widgetInstanceID interface{}) *MockSheetsServiceStore_GetWidgetInstanceByID_Call {
+	return &MockSheetsServiceStore_GetWidgetInstanceByID_Call{Call: _e.mock.On("GetWidgetInstanceByID", ctx, widgetInstanceID)}
+}
+
+func (_c *MockSheetsServiceStore_GetWidgetInstanceByID_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockSheetsServiceStore_GetWidgetInstanceByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_GetWidgetInstanceByID_Call) Return(_a0 models.WidgetInstance, _a1 error) *MockSheetsServiceStore_GetWidgetInstanceByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_GetWidgetInstanceByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.WidgetInstance, error)) *MockSheetsServiceStore_GetWidgetInstanceByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWidgetTemplate provides a mock function with given fields: ctx, widgetType
+func (_m *MockSheetsServiceStore) GetWidgetTemplate(ctx context.Context, widgetType string) (models.Widget, error) {
+	ret := _m.Called(ctx, widgetType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWidgetTemplate")
+	}
+
+	var r0 models.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Widget, error)); ok {
+		return rf(ctx, widgetType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Widget); ok {
+		r0 = rf(ctx, widgetType)
+	} else {
+		r0 = ret.Get(0).(models.Widget)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, widgetType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetsServiceStore_GetWidgetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetTemplate'
+type MockSheetsServiceStore_GetWidgetTemplate_Call struct {
+	*mock.Call
+}
+
+// GetWidgetTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetType string
+func (_e *MockSheetsServiceStore_Expecter) GetWidgetTemplate(ctx interface{}, widgetType interface{}) *MockSheetsServiceStore_GetWidgetTemplate_Call {
+	return &MockSheetsServiceStore_GetWidgetTemplate_Call{Call: _e.mock.On("GetWidgetTemplate", ctx, widgetType)}
+}
+
+func (_c *MockSheetsServiceStore_GetWidgetTemplate_Call) Run(run func(ctx context.Context, widgetType string)) *MockSheetsServiceStore_GetWidgetTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_GetWidgetTemplate_Call) Return(_a0 models.Widget, _a1 error) *MockSheetsServiceStore_GetWidgetTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_GetWidgetTemplate_Call) RunAndReturn(run func(context.Context, string) (models.Widget, error)) *MockSheetsServiceStore_GetWidgetTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSheet provides a mock function with given fields: ctx, sheet
 func (_m *MockSheetsServiceStore) UpdateSheet(ctx context.Context, sheet *models.Sheet) (*models.Sheet, error) {
 	ret := _m.Called(ctx, sheet)
@@ -260,6 +751,112 @@ func (_c *MockSheetsServiceStore_UpdateSheet_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UpdateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
+func (_m *MockSheetsServiceStore) UpdateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWidgetInstance")
+	}
+
+	var r0 *models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstance)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
+		r1 = rf(ctx, widgetInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSheetsServiceStore_UpdateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWidgetInstance'
+type MockSheetsServiceStore_UpdateWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// UpdateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstance *models.WidgetInstance
+func (_e *MockSheetsServiceStore_Expecter) UpdateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockSheetsServiceStore_UpdateWidgetInstance_Call {
+	return &MockSheetsServiceStore_UpdateWidgetInstance_Call{Call: _e.mock.On("UpdateWidgetInstance", ctx, widgetInstance)}
+}
+
+func (_c *MockSheetsServiceStore_UpdateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockSheetsServiceStore_UpdateWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_UpdateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockSheetsServiceStore_UpdateWidgetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_UpdateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockSheetsServiceStore_UpdateWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function with given fields: ctx, fn
+func (_m *MockSheetsServiceStore) WithTx(ctx context.Context, fn func(store.Store) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.Store) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSheetsServiceStore_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockSheetsServiceStore_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.Store) error
+func (_e *MockSheetsServiceStore_Expecter) WithTx(ctx interface{}, fn interface{}) *MockSheetsServiceStore_WithTx_Call {
+	return &MockSheetsServiceStore_WithTx_Call{Call: _e.mock.On("WithTx", ctx, fn)}
+}
+
+func (_c *MockSheetsServiceStore_WithTx_Call) Run(run func(ctx context.Context, fn func(store.Store) error)) *MockSheetsServiceStore_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.Store) error))
+	})
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_WithTx_Call) Return(_a0 error) *MockSheetsServiceStore_WithTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSheetsServiceStore_WithTx_Call) RunAndReturn(run func(context.Context, func(store.Store) error) error) *MockSheetsServiceStore_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockSheetsServiceStore creates a new instance of MockSheetsServiceStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSheetsServiceStore(t interface {
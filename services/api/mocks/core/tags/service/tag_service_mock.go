@@ -0,0 +1,383 @@
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mock_service
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/core/tags/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockTagService is an autogenerated mock type for the TagService type
+type MockTagService struct {
+	mock.Mock
+}
+
+type MockTagService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTagService) EXPECT() *MockTagService_Expecter {
+	return &MockTagService_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: ctx, orgId, userId, params
+func (_m *MockTagService) CreateTag(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.TagParams) (models.Tag, error) {
+	ret := _m.Called(ctx, orgId, userId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.TagParams) (models.Tag, error)); ok {
+		return rf(ctx, orgId, userId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.TagParams) models.Tag); ok {
+		r0 = rf(ctx, orgId, userId, params)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.TagParams) error); ok {
+		r1 = rf(ctx, orgId, userId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagService_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type MockTagService_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - userId uuid.UUID
+//   - params models.TagParams
+func (_e *MockTagService_Expecter) CreateTag(ctx interface{}, orgId interface{}, userId interface{}, params interface{}) *MockTagService_CreateTag_Call {
+	return &MockTagService_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, orgId, userId, params)}
+}
+
+func (_c *MockTagService_CreateTag_Call) Run(run func(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, params models.TagParams)) *MockTagService_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.TagParams))
+	})
+	return _c
+}
+
+func (_c *MockTagService_CreateTag_Call) Return(_a0 models.Tag, _a1 error) *MockTagService_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagService_CreateTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.TagParams) (models.Tag, error)) *MockTagService_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, userId, tagId
+func (_m *MockTagService) DeleteTag(ctx context.Context, userId uuid.UUID, tagId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, tagId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, tagId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagService_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockTagService_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - tagId uuid.UUID
+func (_e *MockTagService_Expecter) DeleteTag(ctx interface{}, userId interface{}, tagId interface{}) *MockTagService_DeleteTag_Call {
+	return &MockTagService_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, userId, tagId)}
+}
+
+func (_c *MockTagService_DeleteTag_Call) Run(run func(ctx context.Context, userId uuid.UUID, tagId uuid.UUID)) *MockTagService_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagService_DeleteTag_Call) Return(_a0 error) *MockTagService_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagService_DeleteTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockTagService_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: ctx
+func (_m *MockTagService) GetTags(ctx context.Context) ([]models.Tag, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Tag, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Tag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagService_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockTagService_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTagService_Expecter) GetTags(ctx interface{}) *MockTagService_GetTags_Call {
+	return &MockTagService_GetTags_Call{Call: _e.mock.On("GetTags", ctx)}
+}
+
+func (_c *MockTagService_GetTags_Call) Run(run func(ctx context.Context)) *MockTagService_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTagService_GetTags_Call) Return(_a0 []models.Tag, _a1 error) *MockTagService_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagService_GetTags_Call) RunAndReturn(run func(context.Context) ([]models.Tag, error)) *MockTagService_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeTags provides a mock function with given fields: ctx, orgId, userId, sourceTagId, targetTagId
+func (_m *MockTagService) MergeTags(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, sourceTagId uuid.UUID, targetTagId uuid.UUID) (models.TagRewriteResult, error) {
+	ret := _m.Called(ctx, orgId, userId, sourceTagId, targetTagId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTags")
+	}
+
+	var r0 models.TagRewriteResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) (models.TagRewriteResult, error)); ok {
+		return rf(ctx, orgId, userId, sourceTagId, targetTagId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) models.TagRewriteResult); ok {
+		r0 = rf(ctx, orgId, userId, sourceTagId, targetTagId)
+	} else {
+		r0 = ret.Get(0).(models.TagRewriteResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgId, userId, sourceTagId, targetTagId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagService_MergeTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTags'
+type MockTagService_MergeTags_Call struct {
+	*mock.Call
+}
+
+// MergeTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - userId uuid.UUID
+//   - sourceTagId uuid.UUID
+//   - targetTagId uuid.UUID
+func (_e *MockTagService_Expecter) MergeTags(ctx interface{}, orgId interface{}, userId interface{}, sourceTagId interface{}, targetTagId interface{}) *MockTagService_MergeTags_Call {
+	return &MockTagService_MergeTags_Call{Call: _e.mock.On("MergeTags", ctx, orgId, userId, sourceTagId, targetTagId)}
+}
+
+func (_c *MockTagService_MergeTags_Call) Run(run func(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, sourceTagId uuid.UUID, targetTagId uuid.UUID)) *MockTagService_MergeTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagService_MergeTags_Call) Return(_a0 models.TagRewriteResult, _a1 error) *MockTagService_MergeTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagService_MergeTags_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) (models.TagRewriteResult, error)) *MockTagService_MergeTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTag provides a mock function with given fields: ctx, orgId, userId, tagId, name
+func (_m *MockTagService) RenameTag(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, tagId uuid.UUID, name string) (models.TagRewriteResult, error) {
+	ret := _m.Called(ctx, orgId, userId, tagId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 models.TagRewriteResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (models.TagRewriteResult, error)); ok {
+		return rf(ctx, orgId, userId, tagId, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) models.TagRewriteResult); ok {
+		r0 = rf(ctx, orgId, userId, tagId, name)
+	} else {
+		r0 = ret.Get(0).(models.TagRewriteResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, orgId, userId, tagId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagService_RenameTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTag'
+type MockTagService_RenameTag_Call struct {
+	*mock.Call
+}
+
+// RenameTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - userId uuid.UUID
+//   - tagId uuid.UUID
+//   - name string
+func (_e *MockTagService_Expecter) RenameTag(ctx interface{}, orgId interface{}, userId interface{}, tagId interface{}, name interface{}) *MockTagService_RenameTag_Call {
+	return &MockTagService_RenameTag_Call{Call: _e.mock.On("RenameTag", ctx, orgId, userId, tagId, name)}
+}
+
+func (_c *MockTagService_RenameTag_Call) Run(run func(ctx context.Context, orgId uuid.UUID, userId uuid.UUID, tagId uuid.UUID, name string)) *MockTagService_RenameTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockTagService_RenameTag_Call) Return(_a0 models.TagRewriteResult, _a1 error) *MockTagService_RenameTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagService_RenameTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (models.TagRewriteResult, error)) *MockTagService_RenameTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, userId, tagId, params
+func (_m *MockTagService) UpdateTag(ctx context.Context, userId uuid.UUID, tagId uuid.UUID, params models.TagUpdateParams) (models.Tag, error) {
+	ret := _m.Called(ctx, userId, tagId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.TagUpdateParams) (models.Tag, error)); ok {
+		return rf(ctx, userId, tagId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.TagUpdateParams) models.Tag); ok {
+		r0 = rf(ctx, userId, tagId, params)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.TagUpdateParams) error); ok {
+		r1 = rf(ctx, userId, tagId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagService_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type MockTagService_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - tagId uuid.UUID
+//   - params models.TagUpdateParams
+func (_e *MockTagService_Expecter) UpdateTag(ctx interface{}, userId interface{}, tagId interface{}, params interface{}) *MockTagService_UpdateTag_Call {
+	return &MockTagService_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, userId, tagId, params)}
+}
+
+func (_c *MockTagService_UpdateTag_Call) Run(run func(ctx context.Context, userId uuid.UUID, tagId uuid.UUID, params models.TagUpdateParams)) *MockTagService_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.TagUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockTagService_UpdateTag_Call) Return(_a0 models.Tag, _a1 error) *MockTagService_UpdateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagService_UpdateTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.TagUpdateParams) (models.Tag, error)) *MockTagService_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTagService creates a new instance of MockTagService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTagService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTagService {
+	mock := &MockTagService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
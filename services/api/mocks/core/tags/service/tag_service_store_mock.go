@@ -0,0 +1,2749 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_service
+
+import (
+	context "context"
+	json "encoding/json"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+
+	store "github.com/Zampfi/application-platform/services/api/db/store"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockTagServiceStore is an autogenerated mock type for the TagServiceStore type
+type MockTagServiceStore struct {
+	mock.Mock
+}
+
+type MockTagServiceStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTagServiceStore) EXPECT() *MockTagServiceStore_Expecter {
+	return &MockTagServiceStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockTagServiceStore) CreateDataset(ctx context.Context, dataset models.Dataset) (uuid.UUID, error) {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDataset")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) (uuid.UUID, error)); ok {
+		return rf(ctx, dataset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) uuid.UUID); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Dataset) error); ok {
+		r1 = rf(ctx, dataset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_CreateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDataset'
+type MockTagServiceStore_CreateDataset_Call struct {
+	*mock.Call
+}
+
+// CreateDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset models.Dataset
+func (_e *MockTagServiceStore_Expecter) CreateDataset(ctx interface{}, dataset interface{}) *MockTagServiceStore_CreateDataset_Call {
+	return &MockTagServiceStore_CreateDataset_Call{Call: _e.mock.On("CreateDataset", ctx, dataset)}
+}
+
+func (_c *MockTagServiceStore_CreateDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockTagServiceStore_CreateDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Dataset))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateDataset_Call) Return(_a0 uuid.UUID, _a1 error) *MockTagServiceStore_CreateDataset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) (uuid.UUID, error)) *MockTagServiceStore_CreateDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId, privilege
+func (_m *MockTagServiceStore) CreateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, audienceType, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, audienceType, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, datasetId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_CreateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetPolicy'
+type MockTagServiceStore_CreateDatasetPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockTagServiceStore_Expecter) CreateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockTagServiceStore_CreateDatasetPolicy_Call {
+	return &MockTagServiceStore_CreateDatasetPolicy_Call{Call: _e.mock.On("CreateDatasetPolicy", ctx, datasetId, audienceType, audienceId, privilege)}
+}
+
+func (_c *MockTagServiceStore_CreateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockTagServiceStore_CreateDatasetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockTagServiceStore_CreateDatasetPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockTagServiceStore_CreateDatasetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganization provides a mock function with given fields: ctx, name, description, ownerId
+func (_m *MockTagServiceStore) CreateOrganization(ctx context.Context, name string, description *string, ownerId uuid.UUID) (*models.Organization, error) {
+	ret := _m.Called(ctx, name, description, ownerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganization")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)); ok {
+		return rf(ctx, name, description, ownerId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) *models.Organization); ok {
+		r0 = rf(ctx, name, description, ownerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, uuid.UUID) error); ok {
+		r1 = rf(ctx, name, description, ownerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type MockTagServiceStore_CreateOrganization_Call struct {
+	*mock.Call
+}
+
+// CreateOrganization is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - description *string
+//   - ownerId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) CreateOrganization(ctx interface{}, name interface{}, description interface{}, ownerId interface{}) *MockTagServiceStore_CreateOrganization_Call {
+	return &MockTagServiceStore_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, name, description, ownerId)}
+}
+
+func (_c *MockTagServiceStore_CreateOrganization_Call) Run(run func(ctx context.Context, name string, description *string, ownerId uuid.UUID)) *MockTagServiceStore_CreateOrganization_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*string), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganization_Call) Return(_a0 *models.Organization, _a1 error) *MockTagServiceStore_CreateOrganization_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganization_Call) RunAndReturn(run func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)) *MockTagServiceStore_CreateOrganization_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationInvitation provides a mock function with given fields: ctx, organizationId, targetEmail, privilege
+func (_m *MockTagServiceStore) CreateOrganizationInvitation(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege) (*models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationId, targetEmail, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationInvitation")
+	}
+
+	var r0 *models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationId, targetEmail, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) *models.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationId, targetEmail, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, organizationId, targetEmail, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_CreateOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitation'
+type MockTagServiceStore_CreateOrganizationInvitation_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - targetEmail string
+//   - privilege models.ResourcePrivilege
+func (_e *MockTagServiceStore_Expecter) CreateOrganizationInvitation(ctx interface{}, organizationId interface{}, targetEmail interface{}, privilege interface{}) *MockTagServiceStore_CreateOrganizationInvitation_Call {
+	return &MockTagServiceStore_CreateOrganizationInvitation_Call{Call: _e.mock.On("CreateOrganizationInvitation", ctx, organizationId, targetEmail, privilege)}
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationInvitation_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege)) *MockTagServiceStore_CreateOrganizationInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationInvitation_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockTagServiceStore_CreateOrganizationInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)) *MockTagServiceStore_CreateOrganizationInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
+func (_m *MockTagServiceStore) CreateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
+	ret := _m.Called(ctx, invitationId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationInvitationStatus")
+	}
+
+	var r0 *models.OrganizationInvitationStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
+		return rf(ctx, invitationId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
+		r0 = rf(ctx, invitationId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
+		r1 = rf(ctx, invitationId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_CreateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitationStatus'
+type MockTagServiceStore_CreateOrganizationInvitationStatus_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationInvitationStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+//   - status models.InvitationStatus
+func (_e *MockTagServiceStore_Expecter) CreateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockTagServiceStore_CreateOrganizationInvitationStatus_Call {
+	return &MockTagServiceStore_CreateOrganizationInvitationStatus_Call{Call: _e.mock.On("CreateOrganizationInvitationStatus", ctx, invitationId, status)}
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockTagServiceStore_CreateOrganizationInvitationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockTagServiceStore_CreateOrganizationInvitationStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockTagServiceStore_CreateOrganizationInvitationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
+func (_m *MockTagServiceStore) CreateOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId, userId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationMembershipRequest")
+	}
+
+	var r0 *models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId, userId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId, userId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
+		r1 = rf(ctx, organizationId, userId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_CreateOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationMembershipRequest'
+type MockTagServiceStore_CreateOrganizationMembershipRequest_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationMembershipRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+//   - status models.OrgMembershipStatus
+func (_e *MockTagServiceStore_Expecter) CreateOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockTagServiceStore_CreateOrganizationMembershipRequest_Call {
+	return &MockTagServiceStore_CreateOrganizationMembershipRequest_Call{Call: _e.mock.On("CreateOrganizationMembershipRequest", ctx, organizationId, userId, status)}
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockTagServiceStore_CreateOrganizationMembershipRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockTagServiceStore_CreateOrganizationMembershipRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockTagServiceStore_CreateOrganizationMembershipRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceType, audienceId, privilege
+func (_m *MockTagServiceStore) CreateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId, audienceType, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId, audienceType, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, orgId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_CreateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationPolicy'
+type MockTagServiceStore_CreateOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockTagServiceStore_Expecter) CreateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockTagServiceStore_CreateOrganizationPolicy_Call {
+	return &MockTagServiceStore_CreateOrganizationPolicy_Call{Call: _e.mock.On("CreateOrganizationPolicy", ctx, orgId, audienceType, audienceId, privilege)}
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockTagServiceStore_CreateOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockTagServiceStore_CreateOrganizationPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockTagServiceStore_CreateOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSSOConfig provides a mock function with given fields: ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain
+func (_m *MockTagServiceStore) CreateSSOConfig(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSSOConfig")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) error); ok {
+		r1 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_CreateSSOConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSSOConfig'
+type MockTagServiceStore_CreateSSOConfig_Call struct {
+	*mock.Call
+}
+
+// CreateSSOConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - ssoProviderID string
+//   - ssoProviderName string
+//   - ssoConfig json.RawMessage
+//   - emailDomain string
+func (_e *MockTagServiceStore_Expecter) CreateSSOConfig(ctx interface{}, organizationId interface{}, ssoProviderID interface{}, ssoProviderName interface{}, ssoConfig interface{}, emailDomain interface{}) *MockTagServiceStore_CreateSSOConfig_Call {
+	return &MockTagServiceStore_CreateSSOConfig_Call{Call: _e.mock.On("CreateSSOConfig", ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)}
+}
+
+func (_c *MockTagServiceStore_CreateSSOConfig_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string)) *MockTagServiceStore_CreateSSOConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(json.RawMessage), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateSSOConfig_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockTagServiceStore_CreateSSOConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateSSOConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)) *MockTagServiceStore_CreateSSOConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTag provides a mock function with given fields: ctx, params
+func (_m *MockTagServiceStore) CreateTag(ctx context.Context, params models.CreateTagParams) (models.Tag, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateTagParams) (models.Tag, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateTagParams) models.Tag); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateTagParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type MockTagServiceStore_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateTagParams
+func (_e *MockTagServiceStore_Expecter) CreateTag(ctx interface{}, params interface{}) *MockTagServiceStore_CreateTag_Call {
+	return &MockTagServiceStore_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, params)}
+}
+
+func (_c *MockTagServiceStore_CreateTag_Call) Run(run func(ctx context.Context, params models.CreateTagParams)) *MockTagServiceStore_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateTagParams))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateTag_Call) Return(_a0 models.Tag, _a1 error) *MockTagServiceStore_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_CreateTag_Call) RunAndReturn(run func(context.Context, models.CreateTagParams) (models.Tag, error)) *MockTagServiceStore_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockTagServiceStore) DeleteDataset(ctx context.Context, dataset models.Dataset) error {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDataset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) error); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagServiceStore_DeleteDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDataset'
+type MockTagServiceStore_DeleteDataset_Call struct {
+	*mock.Call
+}
+
+// DeleteDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset models.Dataset
+func (_e *MockTagServiceStore_Expecter) DeleteDataset(ctx interface{}, dataset interface{}) *MockTagServiceStore_DeleteDataset_Call {
+	return &MockTagServiceStore_DeleteDataset_Call{Call: _e.mock.On("DeleteDataset", ctx, dataset)}
+}
+
+func (_c *MockTagServiceStore_DeleteDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockTagServiceStore_DeleteDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Dataset))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_DeleteDataset_Call) Return(_a0 error) *MockTagServiceStore_DeleteDataset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagServiceStore_DeleteDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) error) *MockTagServiceStore_DeleteDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId
+func (_m *MockTagServiceStore) DeleteDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, datasetId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, datasetId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagServiceStore_DeleteDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetPolicy'
+type MockTagServiceStore_DeleteDatasetPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) DeleteDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}) *MockTagServiceStore_DeleteDatasetPolicy_Call {
+	return &MockTagServiceStore_DeleteDatasetPolicy_Call{Call: _e.mock.On("DeleteDatasetPolicy", ctx, datasetId, audienceType, audienceId)}
+}
+
+func (_c *MockTagServiceStore_DeleteDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockTagServiceStore_DeleteDatasetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_DeleteDatasetPolicy_Call) Return(_a0 error) *MockTagServiceStore_DeleteDatasetPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagServiceStore_DeleteDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockTagServiceStore_DeleteDatasetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId
+func (_m *MockTagServiceStore) DeleteOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, orgId, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, orgId, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagServiceStore_DeleteOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationPolicy'
+type MockTagServiceStore_DeleteOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) DeleteOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}) *MockTagServiceStore_DeleteOrganizationPolicy_Call {
+	return &MockTagServiceStore_DeleteOrganizationPolicy_Call{Call: _e.mock.On("DeleteOrganizationPolicy", ctx, orgId, audienceId)}
+}
+
+func (_c *MockTagServiceStore_DeleteOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID)) *MockTagServiceStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_DeleteOrganizationPolicy_Call) Return(_a0 error) *MockTagServiceStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagServiceStore_DeleteOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockTagServiceStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, tagId, deletedBy
+func (_m *MockTagServiceStore) DeleteTag(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, tagId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, tagId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagServiceStore_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockTagServiceStore_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockTagServiceStore_Expecter) DeleteTag(ctx interface{}, tagId interface{}, deletedBy interface{}) *MockTagServiceStore_DeleteTag_Call {
+	return &MockTagServiceStore_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, tagId, deletedBy)}
+}
+
+func (_c *MockTagServiceStore_DeleteTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID)) *MockTagServiceStore_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_DeleteTag_Call) Return(_a0 error) *MockTagServiceStore_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagServiceStore_DeleteTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockTagServiceStore_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetById provides a mock function with given fields: ctx, datasetId
+func (_m *MockTagServiceStore) GetDatasetById(ctx context.Context, datasetId string) (*models.Dataset, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetById")
+	}
+
+	var r0 *models.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Dataset, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Dataset); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetDatasetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetById'
+type MockTagServiceStore_GetDatasetById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId string
+func (_e *MockTagServiceStore_Expecter) GetDatasetById(ctx interface{}, datasetId interface{}) *MockTagServiceStore_GetDatasetById_Call {
+	return &MockTagServiceStore_GetDatasetById_Call{Call: _e.mock.On("GetDatasetById", ctx, datasetId)}
+}
+
+func (_c *MockTagServiceStore_GetDatasetById_Call) Run(run func(ctx context.Context, datasetId string)) *MockTagServiceStore_GetDatasetById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetById_Call) Return(_a0 *models.Dataset, _a1 error) *MockTagServiceStore_GetDatasetById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetById_Call) RunAndReturn(run func(context.Context, string) (*models.Dataset, error)) *MockTagServiceStore_GetDatasetById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetCount provides a mock function with given fields: ctx, filters
+func (_m *MockTagServiceStore) GetDatasetCount(ctx context.Context, filters models.DatasetFilters) (int64, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) (int64, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) int64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetDatasetCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetCount'
+type MockTagServiceStore_GetDatasetCount_Call struct {
+	*mock.Call
+}
+
+// GetDatasetCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.DatasetFilters
+func (_e *MockTagServiceStore_Expecter) GetDatasetCount(ctx interface{}, filters interface{}) *MockTagServiceStore_GetDatasetCount_Call {
+	return &MockTagServiceStore_GetDatasetCount_Call{Call: _e.mock.On("GetDatasetCount", ctx, filters)}
+}
+
+func (_c *MockTagServiceStore_GetDatasetCount_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockTagServiceStore_GetDatasetCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetCount_Call) Return(_a0 int64, _a1 error) *MockTagServiceStore_GetDatasetCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetCount_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) (int64, error)) *MockTagServiceStore_GetDatasetCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockTagServiceStore) GetDatasetPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetDatasetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPolicies'
+type MockTagServiceStore_GetDatasetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetDatasetPolicies(ctx interface{}, datasetId interface{}) *MockTagServiceStore_GetDatasetPolicies_Call {
+	return &MockTagServiceStore_GetDatasetPolicies_Call{Call: _e.mock.On("GetDatasetPolicies", ctx, datasetId)}
+}
+
+func (_c *MockTagServiceStore_GetDatasetPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockTagServiceStore_GetDatasetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockTagServiceStore_GetDatasetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockTagServiceStore_GetDatasetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetPoliciesByEmail provides a mock function with given fields: ctx, datasetId, email
+func (_m *MockTagServiceStore) GetDatasetPoliciesByEmail(ctx context.Context, datasetId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetPoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, datasetId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetDatasetPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPoliciesByEmail'
+type MockTagServiceStore_GetDatasetPoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetDatasetPoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - email string
+func (_e *MockTagServiceStore_Expecter) GetDatasetPoliciesByEmail(ctx interface{}, datasetId interface{}, email interface{}) *MockTagServiceStore_GetDatasetPoliciesByEmail_Call {
+	return &MockTagServiceStore_GetDatasetPoliciesByEmail_Call{Call: _e.mock.On("GetDatasetPoliciesByEmail", ctx, datasetId, email)}
+}
+
+func (_c *MockTagServiceStore_GetDatasetPoliciesByEmail_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, email string)) *MockTagServiceStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockTagServiceStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockTagServiceStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetsAll provides a mock function with given fields: ctx, filters
+func (_m *MockTagServiceStore) GetDatasetsAll(ctx context.Context, filters models.DatasetFilters) ([]models.Dataset, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetsAll")
+	}
+
+	var r0 []models.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) ([]models.Dataset, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) []models.Dataset); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetDatasetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetsAll'
+type MockTagServiceStore_GetDatasetsAll_Call struct {
+	*mock.Call
+}
+
+// GetDatasetsAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.DatasetFilters
+func (_e *MockTagServiceStore_Expecter) GetDatasetsAll(ctx interface{}, filters interface{}) *MockTagServiceStore_GetDatasetsAll_Call {
+	return &MockTagServiceStore_GetDatasetsAll_Call{Call: _e.mock.On("GetDatasetsAll", ctx, filters)}
+}
+
+func (_c *MockTagServiceStore_GetDatasetsAll_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockTagServiceStore_GetDatasetsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetsAll_Call) Return(_a0 []models.Dataset, _a1 error) *MockTagServiceStore_GetDatasetsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetDatasetsAll_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) ([]models.Dataset, error)) *MockTagServiceStore_GetDatasetsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationById provides a mock function with given fields: ctx, organizationId
+func (_m *MockTagServiceStore) GetOrganizationById(ctx context.Context, organizationId string) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationById")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationById'
+type MockTagServiceStore_GetOrganizationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+func (_e *MockTagServiceStore_Expecter) GetOrganizationById(ctx interface{}, organizationId interface{}) *MockTagServiceStore_GetOrganizationById_Call {
+	return &MockTagServiceStore_GetOrganizationById_Call{Call: _e.mock.On("GetOrganizationById", ctx, organizationId)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationById_Call) Run(run func(ctx context.Context, organizationId string)) *MockTagServiceStore_GetOrganizationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationById_Call) Return(_a0 *models.Organization, _a1 error) *MockTagServiceStore_GetOrganizationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationById_Call) RunAndReturn(run func(context.Context, string) (*models.Organization, error)) *MockTagServiceStore_GetOrganizationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationById provides a mock function with given fields: ctx, invitationId
+func (_m *MockTagServiceStore) GetOrganizationInvitationById(ctx context.Context, invitationId uuid.UUID) (*models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, invitationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationById")
+	}
+
+	var r0 *models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)); ok {
+		return rf(ctx, invitationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.OrganizationInvitation); ok {
+		r0 = rf(ctx, invitationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationInvitationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationById'
+type MockTagServiceStore_GetOrganizationInvitationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetOrganizationInvitationById(ctx interface{}, invitationId interface{}) *MockTagServiceStore_GetOrganizationInvitationById_Call {
+	return &MockTagServiceStore_GetOrganizationInvitationById_Call{Call: _e.mock.On("GetOrganizationInvitationById", ctx, invitationId)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationById_Call) Run(run func(ctx context.Context, invitationId uuid.UUID)) *MockTagServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationById_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockTagServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)) *MockTagServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAll provides a mock function with given fields: ctx
+func (_m *MockTagServiceStore) GetOrganizationInvitationsAll(ctx context.Context) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAll")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationInvitationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAll'
+type MockTagServiceStore_GetOrganizationInvitationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTagServiceStore_Expecter) GetOrganizationInvitationsAll(ctx interface{}) *MockTagServiceStore_GetOrganizationInvitationsAll_Call {
+	return &MockTagServiceStore_GetOrganizationInvitationsAll_Call{Call: _e.mock.On("GetOrganizationInvitationsAll", ctx)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationsAll_Call) Run(run func(ctx context.Context)) *MockTagServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationsAll_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockTagServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationInvitation, error)) *MockTagServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAndMembershipRequests provides a mock function with given fields: ctx, organizationId
+func (_m *MockTagServiceStore) GetOrganizationInvitationsAndMembershipRequests(ctx context.Context, organizationId uuid.UUID) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAndMembershipRequests")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAndMembershipRequests'
+type MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAndMembershipRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetOrganizationInvitationsAndMembershipRequests(ctx interface{}, organizationId interface{}) *MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	return &MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call{Call: _e.mock.On("GetOrganizationInvitationsAndMembershipRequests", ctx, organizationId)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) Return(_a0 *models.Organization, _a1 error) *MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Organization, error)) *MockTagServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockTagServiceStore) GetOrganizationInvitationsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsByOrganizationId'
+type MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetOrganizationInvitationsByOrganizationId(ctx interface{}, organizationId interface{}) *MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	return &MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationInvitationsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)) *MockTagServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsAll provides a mock function with given fields: ctx
+func (_m *MockTagServiceStore) GetOrganizationMembershipRequestsAll(ctx context.Context) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsAll")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsAll'
+type MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTagServiceStore_Expecter) GetOrganizationMembershipRequestsAll(ctx interface{}) *MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	return &MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsAll", ctx)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call) Run(run func(ctx context.Context)) *MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationMembershipRequest, error)) *MockTagServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockTagServiceStore) GetOrganizationMembershipRequestsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsByOrganizationId'
+type MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetOrganizationMembershipRequestsByOrganizationId(ctx interface{}, organizationId interface{}) *MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	return &MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)) *MockTagServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicies provides a mock function with given fields: ctx, orgId
+func (_m *MockTagServiceStore) GetOrganizationPolicies(ctx context.Context, orgId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicies'
+type MockTagServiceStore_GetOrganizationPolicies_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetOrganizationPolicies(ctx interface{}, orgId interface{}) *MockTagServiceStore_GetOrganizationPolicies_Call {
+	return &MockTagServiceStore_GetOrganizationPolicies_Call{Call: _e.mock.On("GetOrganizationPolicies", ctx, orgId)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationPolicies_Call) Run(run func(ctx context.Context, orgId uuid.UUID)) *MockTagServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockTagServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockTagServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPoliciesByEmail provides a mock function with given fields: ctx, organizationId, email
+func (_m *MockTagServiceStore) GetOrganizationPoliciesByEmail(ctx context.Context, organizationId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, organizationId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPoliciesByEmail'
+type MockTagServiceStore_GetOrganizationPoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - email string
+func (_e *MockTagServiceStore_Expecter) GetOrganizationPoliciesByEmail(ctx interface{}, organizationId interface{}, email interface{}) *MockTagServiceStore_GetOrganizationPoliciesByEmail_Call {
+	return &MockTagServiceStore_GetOrganizationPoliciesByEmail_Call{Call: _e.mock.On("GetOrganizationPoliciesByEmail", ctx, organizationId, email)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationPoliciesByEmail_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, email string)) *MockTagServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockTagServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockTagServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicyByUser provides a mock function with given fields: ctx, organizationId, userId
+func (_m *MockTagServiceStore) GetOrganizationPolicyByUser(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicyByUser")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationPolicyByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicyByUser'
+type MockTagServiceStore_GetOrganizationPolicyByUser_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicyByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetOrganizationPolicyByUser(ctx interface{}, organizationId interface{}, userId interface{}) *MockTagServiceStore_GetOrganizationPolicyByUser_Call {
+	return &MockTagServiceStore_GetOrganizationPolicyByUser_Call{Call: _e.mock.On("GetOrganizationPolicyByUser", ctx, organizationId, userId)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationPolicyByUser_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID)) *MockTagServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationPolicyByUser_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockTagServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationPolicyByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)) *MockTagServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationSSOConfigsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockTagServiceStore) GetOrganizationSSOConfigsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationSSOConfigsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationSSOConfigsByOrganizationId'
+type MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationSSOConfigsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetOrganizationSSOConfigsByOrganizationId(ctx interface{}, organizationId interface{}) *MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	return &MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationSSOConfigsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) Return(_a0 []models.OrganizationSSOConfig, _a1 error) *MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)) *MockTagServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationsAll provides a mock function with given fields: ctx
+func (_m *MockTagServiceStore) GetOrganizationsAll(ctx context.Context) ([]models.Organization, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationsAll")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Organization, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Organization); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsAll'
+type MockTagServiceStore_GetOrganizationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTagServiceStore_Expecter) GetOrganizationsAll(ctx interface{}) *MockTagServiceStore_GetOrganizationsAll_Call {
+	return &MockTagServiceStore_GetOrganizationsAll_Call{Call: _e.mock.On("GetOrganizationsAll", ctx)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationsAll_Call) Run(run func(ctx context.Context)) *MockTagServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationsAll_Call) Return(_a0 []models.Organization, _a1 error) *MockTagServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationsAll_Call) RunAndReturn(run func(context.Context) ([]models.Organization, error)) *MockTagServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationsByMemberId provides a mock function with given fields: ctx, memberId
+func (_m *MockTagServiceStore) GetOrganizationsByMemberId(ctx context.Context, memberId uuid.UUID) ([]models.Organization, error) {
+	ret := _m.Called(ctx, memberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationsByMemberId")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Organization, error)); ok {
+		return rf(ctx, memberId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Organization); ok {
+		r0 = rf(ctx, memberId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, memberId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetOrganizationsByMemberId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsByMemberId'
+type MockTagServiceStore_GetOrganizationsByMemberId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationsByMemberId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - memberId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetOrganizationsByMemberId(ctx interface{}, memberId interface{}) *MockTagServiceStore_GetOrganizationsByMemberId_Call {
+	return &MockTagServiceStore_GetOrganizationsByMemberId_Call{Call: _e.mock.On("GetOrganizationsByMemberId", ctx, memberId)}
+}
+
+func (_c *MockTagServiceStore_GetOrganizationsByMemberId_Call) Run(run func(ctx context.Context, memberId uuid.UUID)) *MockTagServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationsByMemberId_Call) Return(_a0 []models.Organization, _a1 error) *MockTagServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetOrganizationsByMemberId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Organization, error)) *MockTagServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrimarySSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockTagServiceStore) GetPrimarySSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrimarySSOConfigByDomain")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetPrimarySSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrimarySSOConfigByDomain'
+type MockTagServiceStore_GetPrimarySSOConfigByDomain_Call struct {
+	*mock.Call
+}
+
+// GetPrimarySSOConfigByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domain string
+func (_e *MockTagServiceStore_Expecter) GetPrimarySSOConfigByDomain(ctx interface{}, domain interface{}) *MockTagServiceStore_GetPrimarySSOConfigByDomain_Call {
+	return &MockTagServiceStore_GetPrimarySSOConfigByDomain_Call{Call: _e.mock.On("GetPrimarySSOConfigByDomain", ctx, domain)}
+}
+
+func (_c *MockTagServiceStore_GetPrimarySSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockTagServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetPrimarySSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockTagServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetPrimarySSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockTagServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockTagServiceStore) GetSSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSSOConfigByDomain")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetSSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSSOConfigByDomain'
+type MockTagServiceStore_GetSSOConfigByDomain_Call struct {
+	*mock.Call
+}
+
+// GetSSOConfigByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domain string
+func (_e *MockTagServiceStore_Expecter) GetSSOConfigByDomain(ctx interface{}, domain interface{}) *MockTagServiceStore_GetSSOConfigByDomain_Call {
+	return &MockTagServiceStore_GetSSOConfigByDomain_Call{Call: _e.mock.On("GetSSOConfigByDomain", ctx, domain)}
+}
+
+func (_c *MockTagServiceStore_GetSSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockTagServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetSSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockTagServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetSSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockTagServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagById provides a mock function with given fields: ctx, tagId
+func (_m *MockTagServiceStore) GetTagById(ctx context.Context, tagId uuid.UUID) (models.Tag, error) {
+	ret := _m.Called(ctx, tagId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagById")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Tag, error)); ok {
+		return rf(ctx, tagId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Tag); ok {
+		r0 = rf(ctx, tagId)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, tagId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetTagById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagById'
+type MockTagServiceStore_GetTagById_Call struct {
+	*mock.Call
+}
+
+// GetTagById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+func (_e *MockTagServiceStore_Expecter) GetTagById(ctx interface{}, tagId interface{}) *MockTagServiceStore_GetTagById_Call {
+	return &MockTagServiceStore_GetTagById_Call{Call: _e.mock.On("GetTagById", ctx, tagId)}
+}
+
+func (_c *MockTagServiceStore_GetTagById_Call) Run(run func(ctx context.Context, tagId uuid.UUID)) *MockTagServiceStore_GetTagById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetTagById_Call) Return(_a0 models.Tag, _a1 error) *MockTagServiceStore_GetTagById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetTagById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Tag, error)) *MockTagServiceStore_GetTagById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: ctx
+func (_m *MockTagServiceStore) GetTags(ctx context.Context) ([]models.Tag, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Tag, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Tag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockTagServiceStore_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTagServiceStore_Expecter) GetTags(ctx interface{}) *MockTagServiceStore_GetTags_Call {
+	return &MockTagServiceStore_GetTags_Call{Call: _e.mock.On("GetTags", ctx)}
+}
+
+func (_c *MockTagServiceStore_GetTags_Call) Run(run func(ctx context.Context)) *MockTagServiceStore_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetTags_Call) Return(_a0 []models.Tag, _a1 error) *MockTagServiceStore_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_GetTags_Call) RunAndReturn(run func(context.Context) ([]models.Tag, error)) *MockTagServiceStore_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeTags provides a mock function with given fields: ctx, sourceTagId, targetTagId, targetAliases, updatedBy
+func (_m *MockTagServiceStore) MergeTags(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, sourceTagId, targetTagId, targetAliases, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []string, uuid.UUID) error); ok {
+		r0 = rf(ctx, sourceTagId, targetTagId, targetAliases, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagServiceStore_MergeTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTags'
+type MockTagServiceStore_MergeTags_Call struct {
+	*mock.Call
+}
+
+// MergeTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceTagId uuid.UUID
+//   - targetTagId uuid.UUID
+//   - targetAliases []string
+//   - updatedBy uuid.UUID
+func (_e *MockTagServiceStore_Expecter) MergeTags(ctx interface{}, sourceTagId interface{}, targetTagId interface{}, targetAliases interface{}, updatedBy interface{}) *MockTagServiceStore_MergeTags_Call {
+	return &MockTagServiceStore_MergeTags_Call{Call: _e.mock.On("MergeTags", ctx, sourceTagId, targetTagId, targetAliases, updatedBy)}
+}
+
+func (_c *MockTagServiceStore_MergeTags_Call) Run(run func(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID)) *MockTagServiceStore_MergeTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]string), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_MergeTags_Call) Return(_a0 error) *MockTagServiceStore_MergeTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagServiceStore_MergeTags_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []string, uuid.UUID) error) *MockTagServiceStore_MergeTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTag provides a mock function with given fields: ctx, tagId, name, path, aliases, updatedBy
+func (_m *MockTagServiceStore) RenameTag(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, tagId, name, path, aliases, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, []string, uuid.UUID) error); ok {
+		r0 = rf(ctx, tagId, name, path, aliases, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagServiceStore_RenameTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTag'
+type MockTagServiceStore_RenameTag_Call struct {
+	*mock.Call
+}
+
+// RenameTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - name string
+//   - path string
+//   - aliases []string
+//   - updatedBy uuid.UUID
+func (_e *MockTagServiceStore_Expecter) RenameTag(ctx interface{}, tagId interface{}, name interface{}, path interface{}, aliases interface{}, updatedBy interface{}) *MockTagServiceStore_RenameTag_Call {
+	return &MockTagServiceStore_RenameTag_Call{Call: _e.mock.On("RenameTag", ctx, tagId, name, path, aliases, updatedBy)}
+}
+
+func (_c *MockTagServiceStore_RenameTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID)) *MockTagServiceStore_RenameTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].([]string), args[5].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_RenameTag_Call) Return(_a0 error) *MockTagServiceStore_RenameTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagServiceStore_RenameTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, []string, uuid.UUID) error) *MockTagServiceStore_RenameTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockTagServiceStore) UpdateDataset(ctx context.Context, dataset models.Dataset) (uuid.UUID, error) {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDataset")
+	}
+
+	var r0 uuid.UUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) (uuid.UUID, error)); ok {
+		return rf(ctx, dataset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) uuid.UUID); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(uuid.UUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Dataset) error); ok {
+		r1 = rf(ctx, dataset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_UpdateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataset'
+type MockTagServiceStore_UpdateDataset_Call struct {
+	*mock.Call
+}
+
+// UpdateDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset models.Dataset
+func (_e *MockTagServiceStore_Expecter) UpdateDataset(ctx interface{}, dataset interface{}) *MockTagServiceStore_UpdateDataset_Call {
+	return &MockTagServiceStore_UpdateDataset_Call{Call: _e.mock.On("UpdateDataset", ctx, dataset)}
+}
+
+func (_c *MockTagServiceStore_UpdateDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockTagServiceStore_UpdateDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Dataset))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateDataset_Call) Return(_a0 uuid.UUID, _a1 error) *MockTagServiceStore_UpdateDataset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) (uuid.UUID, error)) *MockTagServiceStore_UpdateDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceId, privilege
+func (_m *MockTagServiceStore) UpdateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, datasetId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_UpdateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetPolicy'
+type MockTagServiceStore_UpdateDatasetPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockTagServiceStore_Expecter) UpdateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceId interface{}, privilege interface{}) *MockTagServiceStore_UpdateDatasetPolicy_Call {
+	return &MockTagServiceStore_UpdateDatasetPolicy_Call{Call: _e.mock.On("UpdateDatasetPolicy", ctx, datasetId, audienceId, privilege)}
+}
+
+func (_c *MockTagServiceStore_UpdateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockTagServiceStore_UpdateDatasetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockTagServiceStore_UpdateDatasetPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockTagServiceStore_UpdateDatasetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationCalendarSettings provides a mock function with given fields: ctx, organizationId, calendarSettings
+func (_m *MockTagServiceStore) UpdateOrganizationCalendarSettings(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, calendarSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationCalendarSettings")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, calendarSettings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, calendarSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, calendarSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_UpdateOrganizationCalendarSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationCalendarSettings'
+type MockTagServiceStore_UpdateOrganizationCalendarSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationCalendarSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - calendarSettings json.RawMessage
+func (_e *MockTagServiceStore_Expecter) UpdateOrganizationCalendarSettings(ctx interface{}, organizationId interface{}, calendarSettings interface{}) *MockTagServiceStore_UpdateOrganizationCalendarSettings_Call {
+	return &MockTagServiceStore_UpdateOrganizationCalendarSettings_Call{Call: _e.mock.On("UpdateOrganizationCalendarSettings", ctx, organizationId, calendarSettings)}
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationCalendarSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage)) *MockTagServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationCalendarSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockTagServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationCalendarSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockTagServiceStore_UpdateOrganizationCalendarSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationExportSettings provides a mock function with given fields: ctx, organizationId, exportSettings
+func (_m *MockTagServiceStore) UpdateOrganizationExportSettings(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, exportSettings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationExportSettings")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, exportSettings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, exportSettings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, exportSettings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_UpdateOrganizationExportSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationExportSettings'
+type MockTagServiceStore_UpdateOrganizationExportSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationExportSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - exportSettings json.RawMessage
+func (_e *MockTagServiceStore_Expecter) UpdateOrganizationExportSettings(ctx interface{}, organizationId interface{}, exportSettings interface{}) *MockTagServiceStore_UpdateOrganizationExportSettings_Call {
+	return &MockTagServiceStore_UpdateOrganizationExportSettings_Call{Call: _e.mock.On("UpdateOrganizationExportSettings", ctx, organizationId, exportSettings)}
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationExportSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage)) *MockTagServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationExportSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockTagServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationExportSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockTagServiceStore_UpdateOrganizationExportSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
+func (_m *MockTagServiceStore) UpdateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
+	ret := _m.Called(ctx, invitationId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationInvitationStatus")
+	}
+
+	var r0 *models.OrganizationInvitationStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
+		return rf(ctx, invitationId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
+		r0 = rf(ctx, invitationId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
+		r1 = rf(ctx, invitationId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_UpdateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationStatus'
+type MockTagServiceStore_UpdateOrganizationInvitationStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationInvitationStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+//   - status models.InvitationStatus
+func (_e *MockTagServiceStore_Expecter) UpdateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockTagServiceStore_UpdateOrganizationInvitationStatus_Call {
+	return &MockTagServiceStore_UpdateOrganizationInvitationStatus_Call{Call: _e.mock.On("UpdateOrganizationInvitationStatus", ctx, invitationId, status)}
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockTagServiceStore_UpdateOrganizationInvitationStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockTagServiceStore_UpdateOrganizationInvitationStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockTagServiceStore_UpdateOrganizationInvitationStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId, privilege
+func (_m *MockTagServiceStore) UpdateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOrganizationPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, orgId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_UpdateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationPolicy'
+type MockTagServiceStore_UpdateOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockTagServiceStore_Expecter) UpdateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}, privilege interface{}) *MockTagServiceStore_UpdateOrganizationPolicy_Call {
+	return &MockTagServiceStore_UpdateOrganizationPolicy_Call{Call: _e.mock.On("UpdateOrganizationPolicy", ctx, orgId, audienceId, privilege)}
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockTagServiceStore_UpdateOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockTagServiceStore_UpdateOrganizationPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockTagServiceStore_UpdateOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePendingOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
+func (_m *MockTagServiceStore) UpdatePendingOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId, userId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePendingOrganizationMembershipRequest")
+	}
+
+	var r0 *models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId, userId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId, userId, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
+		r1 = rf(ctx, organizationId, userId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePendingOrganizationMembershipRequest'
+type MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call struct {
+	*mock.Call
+}
+
+// UpdatePendingOrganizationMembershipRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+//   - status models.OrgMembershipStatus
+func (_e *MockTagServiceStore_Expecter) UpdatePendingOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	return &MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call{Call: _e.mock.On("UpdatePendingOrganizationMembershipRequest", ctx, organizationId, userId, status)}
+}
+
+func (_c *MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockTagServiceStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, tagId, params
+func (_m *MockTagServiceStore) UpdateTag(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams) (models.Tag, error) {
+	ret := _m.Called(ctx, tagId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateTagParams) (models.Tag, error)); ok {
+		return rf(ctx, tagId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateTagParams) models.Tag); ok {
+		r0 = rf(ctx, tagId, params)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateTagParams) error); ok {
+		r1 = rf(ctx, tagId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagServiceStore_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type MockTagServiceStore_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - params models.UpdateTagParams
+func (_e *MockTagServiceStore_Expecter) UpdateTag(ctx interface{}, tagId interface{}, params interface{}) *MockTagServiceStore_UpdateTag_Call {
+	return &MockTagServiceStore_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, tagId, params)}
+}
+
+func (_c *MockTagServiceStore_UpdateTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams)) *MockTagServiceStore_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateTagParams))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateTag_Call) Return(_a0 models.Tag, _a1 error) *MockTagServiceStore_UpdateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagServiceStore_UpdateTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateTagParams) (models.Tag, error)) *MockTagServiceStore_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithDatasetTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockTagServiceStore) WithDatasetTransaction(ctx context.Context, fn func(store.DatasetStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDatasetTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagServiceStore_WithDatasetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetTransaction'
+type MockTagServiceStore_WithDatasetTransaction_Call struct {
+	*mock.Call
+}
+
+// WithDatasetTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.DatasetStore) error
+func (_e *MockTagServiceStore_Expecter) WithDatasetTransaction(ctx interface{}, fn interface{}) *MockTagServiceStore_WithDatasetTransaction_Call {
+	return &MockTagServiceStore_WithDatasetTransaction_Call{Call: _e.mock.On("WithDatasetTransaction", ctx, fn)}
+}
+
+func (_c *MockTagServiceStore_WithDatasetTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetStore) error)) *MockTagServiceStore_WithDatasetTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.DatasetStore) error))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_WithDatasetTransaction_Call) Return(_a0 error) *MockTagServiceStore_WithDatasetTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagServiceStore_WithDatasetTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetStore) error) error) *MockTagServiceStore_WithDatasetTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithOrganizationTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockTagServiceStore) WithOrganizationTransaction(ctx context.Context, fn func(store.OrganizationStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithOrganizationTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.OrganizationStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagServiceStore_WithOrganizationTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithOrganizationTransaction'
+type MockTagServiceStore_WithOrganizationTransaction_Call struct {
+	*mock.Call
+}
+
+// WithOrganizationTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.OrganizationStore) error
+func (_e *MockTagServiceStore_Expecter) WithOrganizationTransaction(ctx interface{}, fn interface{}) *MockTagServiceStore_WithOrganizationTransaction_Call {
+	return &MockTagServiceStore_WithOrganizationTransaction_Call{Call: _e.mock.On("WithOrganizationTransaction", ctx, fn)}
+}
+
+func (_c *MockTagServiceStore_WithOrganizationTransaction_Call) Run(run func(ctx context.Context, fn func(store.OrganizationStore) error)) *MockTagServiceStore_WithOrganizationTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.OrganizationStore) error))
+	})
+	return _c
+}
+
+func (_c *MockTagServiceStore_WithOrganizationTransaction_Call) Return(_a0 error) *MockTagServiceStore_WithOrganizationTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagServiceStore_WithOrganizationTransaction_Call) RunAndReturn(run func(context.Context, func(store.OrganizationStore) error) error) *MockTagServiceStore_WithOrganizationTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTagServiceStore creates a new instance of MockTagServiceStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTagServiceStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTagServiceStore {
+	mock := &MockTagServiceStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_widgets
+
+import (
+	datasetsmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+)
+
+// MockDatasetDataTransformer is an autogenerated mock type for the DatasetDataTransformer type
+type MockDatasetDataTransformer struct {
+	mock.Mock
+}
+
+type MockDatasetDataTransformer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetDataTransformer) EXPECT() *MockDatasetDataTransformer_Expecter {
+	return &MockDatasetDataTransformer_Expecter{mock: &_m.Mock}
+}
+
+// TransformData provides a mock function with given fields: instance, data
+func (_m *MockDatasetDataTransformer) TransformData(instance *models.WidgetInstance, data []datasetsmodels.DatasetData) ([]datasetsmodels.DatasetData, error) {
+	ret := _m.Called(instance, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformData")
+	}
+
+	var r0 []datasetsmodels.DatasetData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.WidgetInstance, []datasetsmodels.DatasetData) ([]datasetsmodels.DatasetData, error)); ok {
+		return rf(instance, data)
+	}
+	if rf, ok := ret.Get(0).(func(*models.WidgetInstance, []datasetsmodels.DatasetData) []datasetsmodels.DatasetData); ok {
+		r0 = rf(instance, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datasetsmodels.DatasetData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.WidgetInstance, []datasetsmodels.DatasetData) error); ok {
+		r1 = rf(instance, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetDataTransformer_TransformData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformData'
+type MockDatasetDataTransformer_TransformData_Call struct {
+	*mock.Call
+}
+
+// TransformData is a helper method to define mock.On call
+//   - instance *models.WidgetInstance
+//   - data []datasetsmodels.DatasetData
+func (_e *MockDatasetDataTransformer_Expecter) TransformData(instance interface{}, data interface{}) *MockDatasetDataTransformer_TransformData_Call {
+	return &MockDatasetDataTransformer_TransformData_Call{Call: _e.mock.On("TransformData", instance, data)}
+}
+
+func (_c *MockDatasetDataTransformer_TransformData_Call) Run(run func(instance *models.WidgetInstance, data []datasetsmodels.DatasetData)) *MockDatasetDataTransformer_TransformData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.WidgetInstance), args[1].([]datasetsmodels.DatasetData))
+	})
+	return _c
+}
+
+func (_c *MockDatasetDataTransformer_TransformData_Call) Return(_a0 []datasetsmodels.DatasetData, _a1 error) *MockDatasetDataTransformer_TransformData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetDataTransformer_TransformData_Call) RunAndReturn(run func(*models.WidgetInstance, []datasetsmodels.DatasetData) ([]datasetsmodels.DatasetData, error)) *MockDatasetDataTransformer_TransformData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetDataTransformer creates a new instance of MockDatasetDataTransformer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetDataTransformer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetDataTransformer {
+	mock := &MockDatasetDataTransformer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
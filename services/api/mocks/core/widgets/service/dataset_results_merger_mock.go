@@ -0,0 +1,97 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_widgets
+
+import (
+	datasetsmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+)
+
+// MockDatasetResultsMerger is an autogenerated mock type for the DatasetResultsMerger type
+type MockDatasetResultsMerger struct {
+	mock.Mock
+}
+
+type MockDatasetResultsMerger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetResultsMerger) EXPECT() *MockDatasetResultsMerger_Expecter {
+	return &MockDatasetResultsMerger_Expecter{mock: &_m.Mock}
+}
+
+// MergeResults provides a mock function with given fields: instance, datasetbuilderparams, results
+func (_m *MockDatasetResultsMerger) MergeResults(instance *models.WidgetInstance, datasetbuilderparams models.DatasetBuilderParams, results map[string]datasetsmodels.DatasetData) (map[string]datasetsmodels.DatasetData, error) {
+	ret := _m.Called(instance, datasetbuilderparams, results)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeResults")
+	}
+
+	var r0 map[string]datasetsmodels.DatasetData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.WidgetInstance, models.DatasetBuilderParams, map[string]datasetsmodels.DatasetData) (map[string]datasetsmodels.DatasetData, error)); ok {
+		return rf(instance, datasetbuilderparams, results)
+	}
+	if rf, ok := ret.Get(0).(func(*models.WidgetInstance, models.DatasetBuilderParams, map[string]datasetsmodels.DatasetData) map[string]datasetsmodels.DatasetData); ok {
+		r0 = rf(instance, datasetbuilderparams, results)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]datasetsmodels.DatasetData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.WidgetInstance, models.DatasetBuilderParams, map[string]datasetsmodels.DatasetData) error); ok {
+		r1 = rf(instance, datasetbuilderparams, results)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetResultsMerger_MergeResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeResults'
+type MockDatasetResultsMerger_MergeResults_Call struct {
+	*mock.Call
+}
+
+// MergeResults is a helper method to define mock.On call
+//   - instance *models.WidgetInstance
+//   - datasetbuilderparams models.DatasetBuilderParams
+//   - results map[string]datasetsmodels.DatasetData
+func (_e *MockDatasetResultsMerger_Expecter) MergeResults(instance interface{}, datasetbuilderparams interface{}, results interface{}) *MockDatasetResultsMerger_MergeResults_Call {
+	return &MockDatasetResultsMerger_MergeResults_Call{Call: _e.mock.On("MergeResults", instance, datasetbuilderparams, results)}
+}
+
+func (_c *MockDatasetResultsMerger_MergeResults_Call) Run(run func(instance *models.WidgetInstance, datasetbuilderparams models.DatasetBuilderParams, results map[string]datasetsmodels.DatasetData)) *MockDatasetResultsMerger_MergeResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*models.WidgetInstance), args[1].(models.DatasetBuilderParams), args[2].(map[string]datasetsmodels.DatasetData))
+	})
+	return _c
+}
+
+func (_c *MockDatasetResultsMerger_MergeResults_Call) Return(_a0 map[string]datasetsmodels.DatasetData, _a1 error) *MockDatasetResultsMerger_MergeResults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetResultsMerger_MergeResults_Call) RunAndReturn(run func(*models.WidgetInstance, models.DatasetBuilderParams, map[string]datasetsmodels.DatasetData) (map[string]datasetsmodels.DatasetData, error)) *MockDatasetResultsMerger_MergeResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetResultsMerger creates a new instance of MockDatasetResultsMerger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetResultsMerger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetResultsMerger {
+	mock := &MockDatasetResultsMerger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -85,6 +85,233 @@ func (_c *MockWidgetsService_CreateWidgetInstance_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// DeleteWidgetInstance provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockWidgetsService) DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidgetInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWidgetsService_DeleteWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWidgetInstance'
+type MockWidgetsService_DeleteWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// DeleteWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockWidgetsService_Expecter) DeleteWidgetInstance(ctx interface{}, widgetInstanceID interface{}) *MockWidgetsService_DeleteWidgetInstance_Call {
+	return &MockWidgetsService_DeleteWidgetInstance_Call{Call: _e.mock.On("DeleteWidgetInstance", ctx, widgetInstanceID)}
+}
+
+func (_c *MockWidgetsService_DeleteWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockWidgetsService_DeleteWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsService_DeleteWidgetInstance_Call) Return(_a0 error) *MockWidgetsService_DeleteWidgetInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWidgetsService_DeleteWidgetInstance_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockWidgetsService_DeleteWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DuplicateWidgetInstance provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockWidgetsService) DuplicateWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DuplicateWidgetInstance")
+	}
+
+	var r0 *models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstanceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, widgetInstanceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsService_DuplicateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateWidgetInstance'
+type MockWidgetsService_DuplicateWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// DuplicateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockWidgetsService_Expecter) DuplicateWidgetInstance(ctx interface{}, widgetInstanceID interface{}) *MockWidgetsService_DuplicateWidgetInstance_Call {
+	return &MockWidgetsService_DuplicateWidgetInstance_Call{Call: _e.mock.On("DuplicateWidgetInstance", ctx, widgetInstanceID)}
+}
+
+func (_c *MockWidgetsService_DuplicateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockWidgetsService_DuplicateWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsService_DuplicateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockWidgetsService_DuplicateWidgetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsService_DuplicateWidgetInstance_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.WidgetInstance, error)) *MockWidgetsService_DuplicateWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDrillThroughTarget provides a mock function with given fields: ctx, orgId, widgetInstanceID, selection, params
+func (_m *MockWidgetsService) GetDrillThroughTarget(ctx context.Context, orgId uuid.UUID, widgetInstanceID uuid.UUID, selection models.WidgetSelection, params models.GetWidgetInstanceDataQueryParams) (models.DrillThroughTarget, error) {
+	ret := _m.Called(ctx, orgId, widgetInstanceID, selection, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDrillThroughTarget")
+	}
+
+	var r0 models.DrillThroughTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.WidgetSelection, models.GetWidgetInstanceDataQueryParams) (models.DrillThroughTarget, error)); ok {
+		return rf(ctx, orgId, widgetInstanceID, selection, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.WidgetSelection, models.GetWidgetInstanceDataQueryParams) models.DrillThroughTarget); ok {
+		r0 = rf(ctx, orgId, widgetInstanceID, selection, params)
+	} else {
+		r0 = ret.Get(0).(models.DrillThroughTarget)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.WidgetSelection, models.GetWidgetInstanceDataQueryParams) error); ok {
+		r1 = rf(ctx, orgId, widgetInstanceID, selection, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsService_GetDrillThroughTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDrillThroughTarget'
+type MockWidgetsService_GetDrillThroughTarget_Call struct {
+	*mock.Call
+}
+
+// GetDrillThroughTarget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - widgetInstanceID uuid.UUID
+//   - selection models.WidgetSelection
+//   - params models.GetWidgetInstanceDataQueryParams
+func (_e *MockWidgetsService_Expecter) GetDrillThroughTarget(ctx interface{}, orgId interface{}, widgetInstanceID interface{}, selection interface{}, params interface{}) *MockWidgetsService_GetDrillThroughTarget_Call {
+	return &MockWidgetsService_GetDrillThroughTarget_Call{Call: _e.mock.On("GetDrillThroughTarget", ctx, orgId, widgetInstanceID, selection, params)}
+}
+
+func (_c *MockWidgetsService_GetDrillThroughTarget_Call) Run(run func(ctx context.Context, orgId uuid.UUID, widgetInstanceID uuid.UUID, selection models.WidgetSelection, params models.GetWidgetInstanceDataQueryParams)) *MockWidgetsService_GetDrillThroughTarget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.WidgetSelection), args[4].(models.GetWidgetInstanceDataQueryParams))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsService_GetDrillThroughTarget_Call) Return(_a0 models.DrillThroughTarget, _a1 error) *MockWidgetsService_GetDrillThroughTarget_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsService_GetDrillThroughTarget_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.WidgetSelection, models.GetWidgetInstanceDataQueryParams) (models.DrillThroughTarget, error)) *MockWidgetsService_GetDrillThroughTarget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSheetData provides a mock function with given fields: ctx, orgId, sheetId, params
+func (_m *MockWidgetsService) GetSheetData(ctx context.Context, orgId uuid.UUID, sheetId uuid.UUID, params models.GetWidgetInstanceDataQueryParams) (map[uuid.UUID]models.WidgetInstanceData, error) {
+	ret := _m.Called(ctx, orgId, sheetId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSheetData")
+	}
+
+	var r0 map[uuid.UUID]models.WidgetInstanceData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.GetWidgetInstanceDataQueryParams) (map[uuid.UUID]models.WidgetInstanceData, error)); ok {
+		return rf(ctx, orgId, sheetId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.GetWidgetInstanceDataQueryParams) map[uuid.UUID]models.WidgetInstanceData); ok {
+		r0 = rf(ctx, orgId, sheetId, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uuid.UUID]models.WidgetInstanceData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.GetWidgetInstanceDataQueryParams) error); ok {
+		r1 = rf(ctx, orgId, sheetId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsService_GetSheetData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetData'
+type MockWidgetsService_GetSheetData_Call struct {
+	*mock.Call
+}
+
+// GetSheetData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - sheetId uuid.UUID
+//   - params models.GetWidgetInstanceDataQueryParams
+func (_e *MockWidgetsService_Expecter) GetSheetData(ctx interface{}, orgId interface{}, sheetId interface{}, params interface{}) *MockWidgetsService_GetSheetData_Call {
+	return &MockWidgetsService_GetSheetData_Call{Call: _e.mock.On("GetSheetData", ctx, orgId, sheetId, params)}
+}
+
+func (_c *MockWidgetsService_GetSheetData_Call) Run(run func(ctx context.Context, orgId uuid.UUID, sheetId uuid.UUID, params models.GetWidgetInstanceDataQueryParams)) *MockWidgetsService_GetSheetData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.GetWidgetInstanceDataQueryParams))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsService_GetSheetData_Call) Return(_a0 map[uuid.UUID]models.WidgetInstanceData, _a1 error) *MockWidgetsService_GetSheetData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsService_GetSheetData_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.GetWidgetInstanceDataQueryParams) (map[uuid.UUID]models.WidgetInstanceData, error)) *MockWidgetsService_GetSheetData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWidgetInstance provides a mock function with given fields: ctx, widgetInstanceID
 func (_m *MockWidgetsService) GetWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) (models.WidgetInstance, error) {
 	ret := _m.Called(ctx, widgetInstanceID)
@@ -8,45 +8,1025 @@ import (
 	models "github.com/Zampfi/application-platform/services/api/db/models"
 	mock "github.com/stretchr/testify/mock"
 
+	store "github.com/Zampfi/application-platform/services/api/db/store"
+
 	uuid "github.com/google/uuid"
 )
 
-// MockWidgetsServiceStore is an autogenerated mock type for the WidgetsServiceStore type
-type MockWidgetsServiceStore struct {
-	mock.Mock
+// MockWidgetsServiceStore is an autogenerated mock type for the WidgetsServiceStore type
+type MockWidgetsServiceStore struct {
+	mock.Mock
+}
+
+type MockWidgetsServiceStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWidgetsServiceStore) EXPECT() *MockWidgetsServiceStore_Expecter {
+	return &MockWidgetsServiceStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateSheet provides a mock function with given fields: ctx, sheet
+func (_m *MockWidgetsServiceStore) CreateSheet(ctx context.Context, sheet models.Sheet) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSheet")
+	}
+
+	var r0 *models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) (*models.Sheet, error)); ok {
+		return rf(ctx, sheet)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) *models.Sheet); ok {
+		r0 = rf(ctx, sheet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Sheet) error); ok {
+		r1 = rf(ctx, sheet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_CreateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSheet'
+type MockWidgetsServiceStore_CreateSheet_Call struct {
+	*mock.Call
+}
+
+// CreateSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheet models.Sheet
+func (_e *MockWidgetsServiceStore_Expecter) CreateSheet(ctx interface{}, sheet interface{}) *MockWidgetsServiceStore_CreateSheet_Call {
+	return &MockWidgetsServiceStore_CreateSheet_Call{Call: _e.mock.On("CreateSheet", ctx, sheet)}
+}
+
+func (_c *MockWidgetsServiceStore_CreateSheet_Call) Run(run func(ctx context.Context, sheet models.Sheet)) *MockWidgetsServiceStore_CreateSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Sheet))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_CreateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockWidgetsServiceStore_CreateSheet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_CreateSheet_Call) RunAndReturn(run func(context.Context, models.Sheet) (*models.Sheet, error)) *MockWidgetsServiceStore_CreateSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
+func (_m *MockWidgetsServiceStore) CreateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWidgetInstance")
+	}
+
+	var r0 *models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstance)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
+		r1 = rf(ctx, widgetInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_CreateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWidgetInstance'
+type MockWidgetsServiceStore_CreateWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// CreateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstance *models.WidgetInstance
+func (_e *MockWidgetsServiceStore_Expecter) CreateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockWidgetsServiceStore_CreateWidgetInstance_Call {
+	return &MockWidgetsServiceStore_CreateWidgetInstance_Call{Call: _e.mock.On("CreateWidgetInstance", ctx, widgetInstance)}
+}
+
+func (_c *MockWidgetsServiceStore_CreateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockWidgetsServiceStore_CreateWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_CreateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockWidgetsServiceStore_CreateWidgetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_CreateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockWidgetsServiceStore_CreateWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSheet provides a mock function with given fields: ctx, sheetId
+func (_m *MockWidgetsServiceStore) DeleteSheet(ctx context.Context, sheetId uuid.UUID) error {
+	ret := _m.Called(ctx, sheetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSheet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sheetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWidgetsServiceStore_DeleteSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSheet'
+type MockWidgetsServiceStore_DeleteSheet_Call struct {
+	*mock.Call
+}
+
+// DeleteSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) DeleteSheet(ctx interface{}, sheetId interface{}) *MockWidgetsServiceStore_DeleteSheet_Call {
+	return &MockWidgetsServiceStore_DeleteSheet_Call{Call: _e.mock.On("DeleteSheet", ctx, sheetId)}
+}
+
+func (_c *MockWidgetsServiceStore_DeleteSheet_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockWidgetsServiceStore_DeleteSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_DeleteSheet_Call) Return(_a0 error) *MockWidgetsServiceStore_DeleteSheet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_DeleteSheet_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockWidgetsServiceStore_DeleteSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWidgetInstance provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockWidgetsServiceStore) DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidgetInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWidgetsServiceStore_DeleteWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWidgetInstance'
+type MockWidgetsServiceStore_DeleteWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// DeleteWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) DeleteWidgetInstance(ctx interface{}, widgetInstanceID interface{}) *MockWidgetsServiceStore_DeleteWidgetInstance_Call {
+	return &MockWidgetsServiceStore_DeleteWidgetInstance_Call{Call: _e.mock.On("DeleteWidgetInstance", ctx, widgetInstanceID)}
+}
+
+func (_c *MockWidgetsServiceStore_DeleteWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockWidgetsServiceStore_DeleteWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_DeleteWidgetInstance_Call) Return(_a0 error) *MockWidgetsServiceStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_DeleteWidgetInstance_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockWidgetsServiceStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlattenedResourceAudiencePolicies provides a mock function with given fields: ctx, filters
+func (_m *MockWidgetsServiceStore) GetFlattenedResourceAudiencePolicies(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlattenedResourceAudiencePolicies")
+	}
+
+	var r0 []models.FlattenedResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) []models.FlattenedResourceAudiencePolicy); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FlattenedResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlattenedResourceAudiencePolicies'
+type MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call struct {
+	*mock.Call
+}
+
+// GetFlattenedResourceAudiencePolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FlattenedResourceAudiencePoliciesFilters
+func (_e *MockWidgetsServiceStore_Expecter) GetFlattenedResourceAudiencePolicies(ctx interface{}, filters interface{}) *MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	return &MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call{Call: _e.mock.On("GetFlattenedResourceAudiencePolicies", ctx, filters)}
+}
+
+func (_c *MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call) Run(run func(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters)) *MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FlattenedResourceAudiencePoliciesFilters))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call) Return(_a0 []models.FlattenedResourceAudiencePolicy, _a1 error) *MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call) RunAndReturn(run func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)) *MockWidgetsServiceStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationById provides a mock function with given fields: ctx, organizationId
+func (_m *MockWidgetsServiceStore) GetOrganizationById(ctx context.Context, organizationId string) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationById")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationById'
+type MockWidgetsServiceStore_GetOrganizationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationById(ctx interface{}, organizationId interface{}) *MockWidgetsServiceStore_GetOrganizationById_Call {
+	return &MockWidgetsServiceStore_GetOrganizationById_Call{Call: _e.mock.On("GetOrganizationById", ctx, organizationId)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationById_Call) Run(run func(ctx context.Context, organizationId string)) *MockWidgetsServiceStore_GetOrganizationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationById_Call) Return(_a0 *models.Organization, _a1 error) *MockWidgetsServiceStore_GetOrganizationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationById_Call) RunAndReturn(run func(context.Context, string) (*models.Organization, error)) *MockWidgetsServiceStore_GetOrganizationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationById provides a mock function with given fields: ctx, invitationId
+func (_m *MockWidgetsServiceStore) GetOrganizationInvitationById(ctx context.Context, invitationId uuid.UUID) (*models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, invitationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationById")
+	}
+
+	var r0 *models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)); ok {
+		return rf(ctx, invitationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.OrganizationInvitation); ok {
+		r0 = rf(ctx, invitationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationInvitationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationById'
+type MockWidgetsServiceStore_GetOrganizationInvitationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationInvitationById(ctx interface{}, invitationId interface{}) *MockWidgetsServiceStore_GetOrganizationInvitationById_Call {
+	return &MockWidgetsServiceStore_GetOrganizationInvitationById_Call{Call: _e.mock.On("GetOrganizationInvitationById", ctx, invitationId)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationById_Call) Run(run func(ctx context.Context, invitationId uuid.UUID)) *MockWidgetsServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationById_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockWidgetsServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)) *MockWidgetsServiceStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAll provides a mock function with given fields: ctx
+func (_m *MockWidgetsServiceStore) GetOrganizationInvitationsAll(ctx context.Context) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAll")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAll'
+type MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationInvitationsAll(ctx interface{}) *MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call {
+	return &MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call{Call: _e.mock.On("GetOrganizationInvitationsAll", ctx)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call) Run(run func(ctx context.Context)) *MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationInvitation, error)) *MockWidgetsServiceStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAndMembershipRequests provides a mock function with given fields: ctx, organizationId
+func (_m *MockWidgetsServiceStore) GetOrganizationInvitationsAndMembershipRequests(ctx context.Context, organizationId uuid.UUID) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAndMembershipRequests")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAndMembershipRequests'
+type MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAndMembershipRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationInvitationsAndMembershipRequests(ctx interface{}, organizationId interface{}) *MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	return &MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call{Call: _e.mock.On("GetOrganizationInvitationsAndMembershipRequests", ctx, organizationId)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) Return(_a0 *models.Organization, _a1 error) *MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Organization, error)) *MockWidgetsServiceStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockWidgetsServiceStore) GetOrganizationInvitationsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsByOrganizationId'
+type MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationInvitationsByOrganizationId(ctx interface{}, organizationId interface{}) *MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	return &MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationInvitationsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)) *MockWidgetsServiceStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsAll provides a mock function with given fields: ctx
+func (_m *MockWidgetsServiceStore) GetOrganizationMembershipRequestsAll(ctx context.Context) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsAll")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsAll'
+type MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationMembershipRequestsAll(ctx interface{}) *MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	return &MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsAll", ctx)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call) Run(run func(ctx context.Context)) *MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationMembershipRequest, error)) *MockWidgetsServiceStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockWidgetsServiceStore) GetOrganizationMembershipRequestsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsByOrganizationId'
+type MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationMembershipRequestsByOrganizationId(ctx interface{}, organizationId interface{}) *MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	return &MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)) *MockWidgetsServiceStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicies provides a mock function with given fields: ctx, orgId
+func (_m *MockWidgetsServiceStore) GetOrganizationPolicies(ctx context.Context, orgId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicies'
+type MockWidgetsServiceStore_GetOrganizationPolicies_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationPolicies(ctx interface{}, orgId interface{}) *MockWidgetsServiceStore_GetOrganizationPolicies_Call {
+	return &MockWidgetsServiceStore_GetOrganizationPolicies_Call{Call: _e.mock.On("GetOrganizationPolicies", ctx, orgId)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationPolicies_Call) Run(run func(ctx context.Context, orgId uuid.UUID)) *MockWidgetsServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockWidgetsServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockWidgetsServiceStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPoliciesByEmail provides a mock function with given fields: ctx, organizationId, email
+func (_m *MockWidgetsServiceStore) GetOrganizationPoliciesByEmail(ctx context.Context, organizationId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, organizationId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPoliciesByEmail'
+type MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - email string
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationPoliciesByEmail(ctx interface{}, organizationId interface{}, email interface{}) *MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call {
+	return &MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call{Call: _e.mock.On("GetOrganizationPoliciesByEmail", ctx, organizationId, email)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, email string)) *MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockWidgetsServiceStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicyByUser provides a mock function with given fields: ctx, organizationId, userId
+func (_m *MockWidgetsServiceStore) GetOrganizationPolicyByUser(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicyByUser")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicyByUser'
+type MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicyByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationPolicyByUser(ctx interface{}, organizationId interface{}, userId interface{}) *MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call {
+	return &MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call{Call: _e.mock.On("GetOrganizationPolicyByUser", ctx, organizationId, userId)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID)) *MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)) *MockWidgetsServiceStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationSSOConfigsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockWidgetsServiceStore) GetOrganizationSSOConfigsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationSSOConfigsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationSSOConfigsByOrganizationId'
+type MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationSSOConfigsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationSSOConfigsByOrganizationId(ctx interface{}, organizationId interface{}) *MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	return &MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationSSOConfigsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) Return(_a0 []models.OrganizationSSOConfig, _a1 error) *MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)) *MockWidgetsServiceStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationsAll provides a mock function with given fields: ctx
+func (_m *MockWidgetsServiceStore) GetOrganizationsAll(ctx context.Context) ([]models.Organization, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationsAll")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Organization, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Organization); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetOrganizationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsAll'
+type MockWidgetsServiceStore_GetOrganizationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationsAll(ctx interface{}) *MockWidgetsServiceStore_GetOrganizationsAll_Call {
+	return &MockWidgetsServiceStore_GetOrganizationsAll_Call{Call: _e.mock.On("GetOrganizationsAll", ctx)}
+}
+
+func (_c *MockWidgetsServiceStore_GetOrganizationsAll_Call) Run(run func(ctx context.Context)) *MockWidgetsServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
 }
 
-type MockWidgetsServiceStore_Expecter struct {
-	mock *mock.Mock
+func (_c *MockWidgetsServiceStore_GetOrganizationsAll_Call) Return(_a0 []models.Organization, _a1 error) *MockWidgetsServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
 }
 
-func (_m *MockWidgetsServiceStore) EXPECT() *MockWidgetsServiceStore_Expecter {
-	return &MockWidgetsServiceStore_Expecter{mock: &_m.Mock}
+func (_c *MockWidgetsServiceStore_GetOrganizationsAll_Call) RunAndReturn(run func(context.Context) ([]models.Organization, error)) *MockWidgetsServiceStore_GetOrganizationsAll_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// CreateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
-func (_m *MockWidgetsServiceStore) CreateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
-	ret := _m.Called(ctx, widgetInstance)
+// GetOrganizationsByMemberId provides a mock function with given fields: ctx, memberId
+func (_m *MockWidgetsServiceStore) GetOrganizationsByMemberId(ctx context.Context, memberId uuid.UUID) ([]models.Organization, error) {
+	ret := _m.Called(ctx, memberId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateWidgetInstance")
+		panic("no return value specified for GetOrganizationsByMemberId")
 	}
 
-	var r0 *models.WidgetInstance
+	var r0 []models.Organization
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
-		return rf(ctx, widgetInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Organization, error)); ok {
+		return rf(ctx, memberId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
-		r0 = rf(ctx, widgetInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Organization); ok {
+		r0 = rf(ctx, memberId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.WidgetInstance)
+			r0 = ret.Get(0).([]models.Organization)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
-		r1 = rf(ctx, widgetInstance)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, memberId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -54,31 +1034,267 @@ func (_m *MockWidgetsServiceStore) CreateWidgetInstance(ctx context.Context, wid
 	return r0, r1
 }
 
-// MockWidgetsServiceStore_CreateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWidgetInstance'
-type MockWidgetsServiceStore_CreateWidgetInstance_Call struct {
+// MockWidgetsServiceStore_GetOrganizationsByMemberId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsByMemberId'
+type MockWidgetsServiceStore_GetOrganizationsByMemberId_Call struct {
 	*mock.Call
 }
 
-// CreateWidgetInstance is a helper method to define mock.On call
+// GetOrganizationsByMemberId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - widgetInstance *models.WidgetInstance
-func (_e *MockWidgetsServiceStore_Expecter) CreateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockWidgetsServiceStore_CreateWidgetInstance_Call {
-	return &MockWidgetsServiceStore_CreateWidgetInstance_Call{Call: _e.mock.On("CreateWidgetInstance", ctx, widgetInstance)}
+//   - memberId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) GetOrganizationsByMemberId(ctx interface{}, memberId interface{}) *MockWidgetsServiceStore_GetOrganizationsByMemberId_Call {
+	return &MockWidgetsServiceStore_GetOrganizationsByMemberId_Call{Call: _e.mock.On("GetOrganizationsByMemberId", ctx, memberId)}
 }
 
-func (_c *MockWidgetsServiceStore_CreateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockWidgetsServiceStore_CreateWidgetInstance_Call {
+func (_c *MockWidgetsServiceStore_GetOrganizationsByMemberId_Call) Run(run func(ctx context.Context, memberId uuid.UUID)) *MockWidgetsServiceStore_GetOrganizationsByMemberId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockWidgetsServiceStore_CreateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockWidgetsServiceStore_CreateWidgetInstance_Call {
+func (_c *MockWidgetsServiceStore_GetOrganizationsByMemberId_Call) Return(_a0 []models.Organization, _a1 error) *MockWidgetsServiceStore_GetOrganizationsByMemberId_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockWidgetsServiceStore_CreateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockWidgetsServiceStore_CreateWidgetInstance_Call {
+func (_c *MockWidgetsServiceStore_GetOrganizationsByMemberId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Organization, error)) *MockWidgetsServiceStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrimarySSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockWidgetsServiceStore) GetPrimarySSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrimarySSOConfigByDomain")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrimarySSOConfigByDomain'
+type MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call struct {
+	*mock.Call
+}
+
+// GetPrimarySSOConfigByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domain string
+func (_e *MockWidgetsServiceStore_Expecter) GetPrimarySSOConfigByDomain(ctx interface{}, domain interface{}) *MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call {
+	return &MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call{Call: _e.mock.On("GetPrimarySSOConfigByDomain", ctx, domain)}
+}
+
+func (_c *MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockWidgetsServiceStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockWidgetsServiceStore) GetSSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSSOConfigByDomain")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetSSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSSOConfigByDomain'
+type MockWidgetsServiceStore_GetSSOConfigByDomain_Call struct {
+	*mock.Call
+}
+
+// GetSSOConfigByDomain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domain string
+func (_e *MockWidgetsServiceStore_Expecter) GetSSOConfigByDomain(ctx interface{}, domain interface{}) *MockWidgetsServiceStore_GetSSOConfigByDomain_Call {
+	return &MockWidgetsServiceStore_GetSSOConfigByDomain_Call{Call: _e.mock.On("GetSSOConfigByDomain", ctx, domain)}
+}
+
+func (_c *MockWidgetsServiceStore_GetSSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockWidgetsServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetSSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockWidgetsServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetSSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockWidgetsServiceStore_GetSSOConfigByDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSheetById provides a mock function with given fields: ctx, sheetId
+func (_m *MockWidgetsServiceStore) GetSheetById(ctx context.Context, sheetId uuid.UUID) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSheetById")
+	}
+
+	var r0 *models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Sheet, error)); ok {
+		return rf(ctx, sheetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Sheet); ok {
+		r0 = rf(ctx, sheetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sheetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetSheetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetById'
+type MockWidgetsServiceStore_GetSheetById_Call struct {
+	*mock.Call
+}
+
+// GetSheetById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+func (_e *MockWidgetsServiceStore_Expecter) GetSheetById(ctx interface{}, sheetId interface{}) *MockWidgetsServiceStore_GetSheetById_Call {
+	return &MockWidgetsServiceStore_GetSheetById_Call{Call: _e.mock.On("GetSheetById", ctx, sheetId)}
+}
+
+func (_c *MockWidgetsServiceStore_GetSheetById_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockWidgetsServiceStore_GetSheetById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetSheetById_Call) Return(_a0 *models.Sheet, _a1 error) *MockWidgetsServiceStore_GetSheetById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetSheetById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Sheet, error)) *MockWidgetsServiceStore_GetSheetById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSheetsAll provides a mock function with given fields: ctx, filters
+func (_m *MockWidgetsServiceStore) GetSheetsAll(ctx context.Context, filters models.SheetFilters) ([]models.Sheet, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSheetsAll")
+	}
+
+	var r0 []models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) ([]models.Sheet, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) []models.Sheet); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.SheetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_GetSheetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetsAll'
+type MockWidgetsServiceStore_GetSheetsAll_Call struct {
+	*mock.Call
+}
+
+// GetSheetsAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.SheetFilters
+func (_e *MockWidgetsServiceStore_Expecter) GetSheetsAll(ctx interface{}, filters interface{}) *MockWidgetsServiceStore_GetSheetsAll_Call {
+	return &MockWidgetsServiceStore_GetSheetsAll_Call{Call: _e.mock.On("GetSheetsAll", ctx, filters)}
+}
+
+func (_c *MockWidgetsServiceStore_GetSheetsAll_Call) Run(run func(ctx context.Context, filters models.SheetFilters)) *MockWidgetsServiceStore_GetSheetsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.SheetFilters))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetSheetsAll_Call) Return(_a0 []models.Sheet, _a1 error) *MockWidgetsServiceStore_GetSheetsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_GetSheetsAll_Call) RunAndReturn(run func(context.Context, models.SheetFilters) ([]models.Sheet, error)) *MockWidgetsServiceStore_GetSheetsAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -197,6 +1413,65 @@ func (_c *MockWidgetsServiceStore_GetWidgetTemplate_Call) RunAndReturn(run func(
 	return _c
 }
 
+// UpdateSheet provides a mock function with given fields: ctx, sheet
+func (_m *MockWidgetsServiceStore) UpdateSheet(ctx context.Context, sheet *models.Sheet) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSheet")
+	}
+
+	var r0 *models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) (*models.Sheet, error)); ok {
+		return rf(ctx, sheet)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) *models.Sheet); ok {
+		r0 = rf(ctx, sheet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Sheet) error); ok {
+		r1 = rf(ctx, sheet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWidgetsServiceStore_UpdateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSheet'
+type MockWidgetsServiceStore_UpdateSheet_Call struct {
+	*mock.Call
+}
+
+// UpdateSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheet *models.Sheet
+func (_e *MockWidgetsServiceStore_Expecter) UpdateSheet(ctx interface{}, sheet interface{}) *MockWidgetsServiceStore_UpdateSheet_Call {
+	return &MockWidgetsServiceStore_UpdateSheet_Call{Call: _e.mock.On("UpdateSheet", ctx, sheet)}
+}
+
+func (_c *MockWidgetsServiceStore_UpdateSheet_Call) Run(run func(ctx context.Context, sheet *models.Sheet)) *MockWidgetsServiceStore_UpdateSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Sheet))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_UpdateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockWidgetsServiceStore_UpdateSheet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_UpdateSheet_Call) RunAndReturn(run func(context.Context, *models.Sheet) (*models.Sheet, error)) *MockWidgetsServiceStore_UpdateSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
 func (_m *MockWidgetsServiceStore) UpdateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
 	ret := _m.Called(ctx, widgetInstance)
@@ -256,6 +1531,53 @@ func (_c *MockWidgetsServiceStore_UpdateWidgetInstance_Call) RunAndReturn(run fu
 	return _c
 }
 
+// WithTx provides a mock function with given fields: ctx, fn
+func (_m *MockWidgetsServiceStore) WithTx(ctx context.Context, fn func(store.Store) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.Store) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWidgetsServiceStore_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockWidgetsServiceStore_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.Store) error
+func (_e *MockWidgetsServiceStore_Expecter) WithTx(ctx interface{}, fn interface{}) *MockWidgetsServiceStore_WithTx_Call {
+	return &MockWidgetsServiceStore_WithTx_Call{Call: _e.mock.On("WithTx", ctx, fn)}
+}
+
+func (_c *MockWidgetsServiceStore_WithTx_Call) Run(run func(ctx context.Context, fn func(store.Store) error)) *MockWidgetsServiceStore_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.Store) error))
+	})
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_WithTx_Call) Return(_a0 error) *MockWidgetsServiceStore_WithTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWidgetsServiceStore_WithTx_Call) RunAndReturn(run func(context.Context, func(store.Store) error) error) *MockWidgetsServiceStore_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWidgetsServiceStore creates a new instance of MockWidgetsServiceStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWidgetsServiceStore(t interface {
@@ -0,0 +1,650 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	store "github.com/Zampfi/application-platform/services/api/db/store"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDatasetAlertStore is an autogenerated mock type for the DatasetAlertStore type
+type MockDatasetAlertStore struct {
+	mock.Mock
+}
+
+type MockDatasetAlertStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetAlertStore) EXPECT() *MockDatasetAlertStore_Expecter {
+	return &MockDatasetAlertStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateDatasetAlert provides a mock function with given fields: ctx, params
+func (_m *MockDatasetAlertStore) CreateDatasetAlert(ctx context.Context, params models.CreateDatasetAlertParams) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetAlert")
+	}
+
+	var r0 models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertParams) (models.DatasetAlert, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertParams) models.DatasetAlert); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetAlertParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetAlertStore_CreateDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetAlert'
+type MockDatasetAlertStore_CreateDatasetAlert_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateDatasetAlertParams
+func (_e *MockDatasetAlertStore_Expecter) CreateDatasetAlert(ctx interface{}, params interface{}) *MockDatasetAlertStore_CreateDatasetAlert_Call {
+	return &MockDatasetAlertStore_CreateDatasetAlert_Call{Call: _e.mock.On("CreateDatasetAlert", ctx, params)}
+}
+
+func (_c *MockDatasetAlertStore_CreateDatasetAlert_Call) Run(run func(ctx context.Context, params models.CreateDatasetAlertParams)) *MockDatasetAlertStore_CreateDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateDatasetAlertParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_CreateDatasetAlert_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockDatasetAlertStore_CreateDatasetAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_CreateDatasetAlert_Call) RunAndReturn(run func(context.Context, models.CreateDatasetAlertParams) (models.DatasetAlert, error)) *MockDatasetAlertStore_CreateDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDatasetAlertEvent provides a mock function with given fields: ctx, params
+func (_m *MockDatasetAlertStore) CreateDatasetAlertEvent(ctx context.Context, params models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetAlertEvent")
+	}
+
+	var r0 models.DatasetAlertEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertEventParams) models.DatasetAlertEvent); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetAlertEvent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetAlertEventParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetAlertStore_CreateDatasetAlertEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetAlertEvent'
+type MockDatasetAlertStore_CreateDatasetAlertEvent_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetAlertEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateDatasetAlertEventParams
+func (_e *MockDatasetAlertStore_Expecter) CreateDatasetAlertEvent(ctx interface{}, params interface{}) *MockDatasetAlertStore_CreateDatasetAlertEvent_Call {
+	return &MockDatasetAlertStore_CreateDatasetAlertEvent_Call{Call: _e.mock.On("CreateDatasetAlertEvent", ctx, params)}
+}
+
+func (_c *MockDatasetAlertStore_CreateDatasetAlertEvent_Call) Run(run func(ctx context.Context, params models.CreateDatasetAlertEventParams)) *MockDatasetAlertStore_CreateDatasetAlertEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateDatasetAlertEventParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_CreateDatasetAlertEvent_Call) Return(_a0 models.DatasetAlertEvent, _a1 error) *MockDatasetAlertStore_CreateDatasetAlertEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_CreateDatasetAlertEvent_Call) RunAndReturn(run func(context.Context, models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error)) *MockDatasetAlertStore_CreateDatasetAlertEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetAlert provides a mock function with given fields: ctx, alertId, deletedBy
+func (_m *MockDatasetAlertStore) DeleteDatasetAlert(ctx context.Context, alertId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, alertId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, alertId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetAlertStore_DeleteDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetAlert'
+type MockDatasetAlertStore_DeleteDatasetAlert_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetAlertStore_Expecter) DeleteDatasetAlert(ctx interface{}, alertId interface{}, deletedBy interface{}) *MockDatasetAlertStore_DeleteDatasetAlert_Call {
+	return &MockDatasetAlertStore_DeleteDatasetAlert_Call{Call: _e.mock.On("DeleteDatasetAlert", ctx, alertId, deletedBy)}
+}
+
+func (_c *MockDatasetAlertStore_DeleteDatasetAlert_Call) Run(run func(ctx context.Context, alertId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetAlertStore_DeleteDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_DeleteDatasetAlert_Call) Return(_a0 error) *MockDatasetAlertStore_DeleteDatasetAlert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_DeleteDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetAlertStore_DeleteDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlertById provides a mock function with given fields: ctx, alertId
+func (_m *MockDatasetAlertStore) GetDatasetAlertById(ctx context.Context, alertId uuid.UUID) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, alertId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlertById")
+	}
+
+	var r0 models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetAlert, error)); ok {
+		return rf(ctx, alertId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetAlert); ok {
+		r0 = rf(ctx, alertId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, alertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetAlertStore_GetDatasetAlertById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlertById'
+type MockDatasetAlertStore_GetDatasetAlertById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlertById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+func (_e *MockDatasetAlertStore_Expecter) GetDatasetAlertById(ctx interface{}, alertId interface{}) *MockDatasetAlertStore_GetDatasetAlertById_Call {
+	return &MockDatasetAlertStore_GetDatasetAlertById_Call{Call: _e.mock.On("GetDatasetAlertById", ctx, alertId)}
+}
+
+func (_c *MockDatasetAlertStore_GetDatasetAlertById_Call) Run(run func(ctx context.Context, alertId uuid.UUID)) *MockDatasetAlertStore_GetDatasetAlertById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_GetDatasetAlertById_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockDatasetAlertStore_GetDatasetAlertById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_GetDatasetAlertById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetAlert, error)) *MockDatasetAlertStore_GetDatasetAlertById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlertEvents provides a mock function with given fields: ctx, alertId, limit
+func (_m *MockDatasetAlertStore) GetDatasetAlertEvents(ctx context.Context, alertId uuid.UUID, limit int) ([]models.DatasetAlertEvent, error) {
+	ret := _m.Called(ctx, alertId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlertEvents")
+	}
+
+	var r0 []models.DatasetAlertEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.DatasetAlertEvent, error)); ok {
+		return rf(ctx, alertId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.DatasetAlertEvent); ok {
+		r0 = rf(ctx, alertId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetAlertEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, alertId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetAlertStore_GetDatasetAlertEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlertEvents'
+type MockDatasetAlertStore_GetDatasetAlertEvents_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlertEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - limit int
+func (_e *MockDatasetAlertStore_Expecter) GetDatasetAlertEvents(ctx interface{}, alertId interface{}, limit interface{}) *MockDatasetAlertStore_GetDatasetAlertEvents_Call {
+	return &MockDatasetAlertStore_GetDatasetAlertEvents_Call{Call: _e.mock.On("GetDatasetAlertEvents", ctx, alertId, limit)}
+}
+
+func (_c *MockDatasetAlertStore_GetDatasetAlertEvents_Call) Run(run func(ctx context.Context, alertId uuid.UUID, limit int)) *MockDatasetAlertStore_GetDatasetAlertEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_GetDatasetAlertEvents_Call) Return(_a0 []models.DatasetAlertEvent, _a1 error) *MockDatasetAlertStore_GetDatasetAlertEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_GetDatasetAlertEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]models.DatasetAlertEvent, error)) *MockDatasetAlertStore_GetDatasetAlertEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlerts provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetAlertStore) GetDatasetAlerts(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetAlert, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlerts")
+	}
+
+	var r0 []models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetAlert, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetAlert); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetAlertStore_GetDatasetAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlerts'
+type MockDatasetAlertStore_GetDatasetAlerts_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetAlertStore_Expecter) GetDatasetAlerts(ctx interface{}, datasetId interface{}) *MockDatasetAlertStore_GetDatasetAlerts_Call {
+	return &MockDatasetAlertStore_GetDatasetAlerts_Call{Call: _e.mock.On("GetDatasetAlerts", ctx, datasetId)}
+}
+
+func (_c *MockDatasetAlertStore_GetDatasetAlerts_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetAlertStore_GetDatasetAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_GetDatasetAlerts_Call) Return(_a0 []models.DatasetAlert, _a1 error) *MockDatasetAlertStore_GetDatasetAlerts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_GetDatasetAlerts_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetAlert, error)) *MockDatasetAlertStore_GetDatasetAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkDatasetAlertEventNotified provides a mock function with given fields: ctx, eventId
+func (_m *MockDatasetAlertStore) MarkDatasetAlertEventNotified(ctx context.Context, eventId uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, eventId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDatasetAlertEventNotified")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, eventId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, eventId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, eventId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDatasetAlertEventNotified'
+type MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call struct {
+	*mock.Call
+}
+
+// MarkDatasetAlertEventNotified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventId uuid.UUID
+func (_e *MockDatasetAlertStore_Expecter) MarkDatasetAlertEventNotified(ctx interface{}, eventId interface{}) *MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call {
+	return &MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call{Call: _e.mock.On("MarkDatasetAlertEventNotified", ctx, eventId)}
+}
+
+func (_c *MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call) Run(run func(ctx context.Context, eventId uuid.UUID)) *MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call) Return(_a0 bool, _a1 error) *MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockDatasetAlertStore_MarkDatasetAlertEventNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetAlert provides a mock function with given fields: ctx, alertId, params
+func (_m *MockDatasetAlertStore) UpdateDatasetAlert(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertParams) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, alertId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetAlert")
+	}
+
+	var r0 models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) (models.DatasetAlert, error)); ok {
+		return rf(ctx, alertId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) models.DatasetAlert); ok {
+		r0 = rf(ctx, alertId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) error); ok {
+		r1 = rf(ctx, alertId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetAlertStore_UpdateDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlert'
+type MockDatasetAlertStore_UpdateDatasetAlert_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - params models.UpdateDatasetAlertParams
+func (_e *MockDatasetAlertStore_Expecter) UpdateDatasetAlert(ctx interface{}, alertId interface{}, params interface{}) *MockDatasetAlertStore_UpdateDatasetAlert_Call {
+	return &MockDatasetAlertStore_UpdateDatasetAlert_Call{Call: _e.mock.On("UpdateDatasetAlert", ctx, alertId, params)}
+}
+
+func (_c *MockDatasetAlertStore_UpdateDatasetAlert_Call) Run(run func(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertParams)) *MockDatasetAlertStore_UpdateDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetAlertParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_UpdateDatasetAlert_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockDatasetAlertStore_UpdateDatasetAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_UpdateDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) (models.DatasetAlert, error)) *MockDatasetAlertStore_UpdateDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetAlertSnooze provides a mock function with given fields: ctx, alertId, snoozedUntil, updatedBy
+func (_m *MockDatasetAlertStore) UpdateDatasetAlertSnooze(ctx context.Context, alertId uuid.UUID, snoozedUntil *time.Time, updatedBy uuid.UUID) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, alertId, snoozedUntil, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetAlertSnooze")
+	}
+
+	var r0 models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, uuid.UUID) (models.DatasetAlert, error)); ok {
+		return rf(ctx, alertId, snoozedUntil, updatedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, uuid.UUID) models.DatasetAlert); ok {
+		r0 = rf(ctx, alertId, snoozedUntil, updatedBy)
+	} else {
+		r0 = ret.Get(0).(models.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time, uuid.UUID) error); ok {
+		r1 = rf(ctx, alertId, snoozedUntil, updatedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlertSnooze'
+type MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetAlertSnooze is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - snoozedUntil *time.Time
+//   - updatedBy uuid.UUID
+func (_e *MockDatasetAlertStore_Expecter) UpdateDatasetAlertSnooze(ctx interface{}, alertId interface{}, snoozedUntil interface{}, updatedBy interface{}) *MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call {
+	return &MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call{Call: _e.mock.On("UpdateDatasetAlertSnooze", ctx, alertId, snoozedUntil, updatedBy)}
+}
+
+func (_c *MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call) Run(run func(ctx context.Context, alertId uuid.UUID, snoozedUntil *time.Time, updatedBy uuid.UUID)) *MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*time.Time), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call) RunAndReturn(run func(context.Context, uuid.UUID, *time.Time, uuid.UUID) (models.DatasetAlert, error)) *MockDatasetAlertStore_UpdateDatasetAlertSnooze_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetAlertState provides a mock function with given fields: ctx, alertId, params
+func (_m *MockDatasetAlertStore) UpdateDatasetAlertState(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertStateParams) error {
+	ret := _m.Called(ctx, alertId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetAlertState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertStateParams) error); ok {
+		r0 = rf(ctx, alertId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetAlertStore_UpdateDatasetAlertState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlertState'
+type MockDatasetAlertStore_UpdateDatasetAlertState_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetAlertState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - params models.UpdateDatasetAlertStateParams
+func (_e *MockDatasetAlertStore_Expecter) UpdateDatasetAlertState(ctx interface{}, alertId interface{}, params interface{}) *MockDatasetAlertStore_UpdateDatasetAlertState_Call {
+	return &MockDatasetAlertStore_UpdateDatasetAlertState_Call{Call: _e.mock.On("UpdateDatasetAlertState", ctx, alertId, params)}
+}
+
+func (_c *MockDatasetAlertStore_UpdateDatasetAlertState_Call) Run(run func(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertStateParams)) *MockDatasetAlertStore_UpdateDatasetAlertState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetAlertStateParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_UpdateDatasetAlertState_Call) Return(_a0 error) *MockDatasetAlertStore_UpdateDatasetAlertState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_UpdateDatasetAlertState_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetAlertStateParams) error) *MockDatasetAlertStore_UpdateDatasetAlertState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithDatasetAlertTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDatasetAlertStore) WithDatasetAlertTransaction(ctx context.Context, fn func(store.DatasetAlertStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDatasetAlertTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetAlertStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetAlertStore_WithDatasetAlertTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetAlertTransaction'
+type MockDatasetAlertStore_WithDatasetAlertTransaction_Call struct {
+	*mock.Call
+}
+
+// WithDatasetAlertTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.DatasetAlertStore) error
+func (_e *MockDatasetAlertStore_Expecter) WithDatasetAlertTransaction(ctx interface{}, fn interface{}) *MockDatasetAlertStore_WithDatasetAlertTransaction_Call {
+	return &MockDatasetAlertStore_WithDatasetAlertTransaction_Call{Call: _e.mock.On("WithDatasetAlertTransaction", ctx, fn)}
+}
+
+func (_c *MockDatasetAlertStore_WithDatasetAlertTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetAlertStore) error)) *MockDatasetAlertStore_WithDatasetAlertTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.DatasetAlertStore) error))
+	})
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_WithDatasetAlertTransaction_Call) Return(_a0 error) *MockDatasetAlertStore_WithDatasetAlertTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetAlertStore_WithDatasetAlertTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetAlertStore) error) error) *MockDatasetAlertStore_WithDatasetAlertTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetAlertStore creates a new instance of MockDatasetAlertStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetAlertStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetAlertStore {
+	mock := &MockDatasetAlertStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,378 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDatasetColumnPolicyStore is an autogenerated mock type for the DatasetColumnPolicyStore type
+type MockDatasetColumnPolicyStore struct {
+	mock.Mock
+}
+
+type MockDatasetColumnPolicyStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetColumnPolicyStore) EXPECT() *MockDatasetColumnPolicyStore_Expecter {
+	return &MockDatasetColumnPolicyStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateDatasetColumnPolicy provides a mock function with given fields: ctx, params
+func (_m *MockDatasetColumnPolicyStore) CreateDatasetColumnPolicy(ctx context.Context, params models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetColumnPolicy")
+	}
+
+	var r0 models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetColumnPolicyParams) models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetColumnPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetColumnPolicyParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetColumnPolicy'
+type MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetColumnPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateDatasetColumnPolicyParams
+func (_e *MockDatasetColumnPolicyStore_Expecter) CreateDatasetColumnPolicy(ctx interface{}, params interface{}) *MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call {
+	return &MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call{Call: _e.mock.On("CreateDatasetColumnPolicy", ctx, params)}
+}
+
+func (_c *MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call) Run(run func(ctx context.Context, params models.CreateDatasetColumnPolicyParams)) *MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateDatasetColumnPolicyParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call) Return(_a0 models.DatasetColumnPolicy, _a1 error) *MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)) *MockDatasetColumnPolicyStore_CreateDatasetColumnPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetColumnPolicy provides a mock function with given fields: ctx, policyId, deletedBy
+func (_m *MockDatasetColumnPolicyStore) DeleteDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, policyId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetColumnPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, policyId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetColumnPolicy'
+type MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetColumnPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetColumnPolicyStore_Expecter) DeleteDatasetColumnPolicy(ctx interface{}, policyId interface{}, deletedBy interface{}) *MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call {
+	return &MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call{Call: _e.mock.On("DeleteDatasetColumnPolicy", ctx, policyId, deletedBy)}
+}
+
+func (_c *MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call) Return(_a0 error) *MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetColumnPolicyStore_DeleteDatasetColumnPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetColumnPolicyStore) GetDatasetColumnPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPolicies")
+	}
+
+	var r0 []models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetColumnPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPolicies'
+type MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetColumnPolicyStore_Expecter) GetDatasetColumnPolicies(ctx interface{}, datasetId interface{}) *MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call {
+	return &MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call{Call: _e.mock.On("GetDatasetColumnPolicies", ctx, datasetId)}
+}
+
+func (_c *MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call) Return(_a0 []models.DatasetColumnPolicy, _a1 error) *MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetColumnPolicy, error)) *MockDatasetColumnPolicyStore_GetDatasetColumnPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPoliciesForUser provides a mock function with given fields: ctx, datasetId, userId
+func (_m *MockDatasetColumnPolicyStore) GetDatasetColumnPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, datasetId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPoliciesForUser")
+	}
+
+	var r0 []models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, datasetId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, datasetId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetColumnPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPoliciesForUser'
+type MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPoliciesForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockDatasetColumnPolicyStore_Expecter) GetDatasetColumnPoliciesForUser(ctx interface{}, datasetId interface{}, userId interface{}) *MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call {
+	return &MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call{Call: _e.mock.On("GetDatasetColumnPoliciesForUser", ctx, datasetId, userId)}
+}
+
+func (_c *MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID)) *MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call) Return(_a0 []models.DatasetColumnPolicy, _a1 error) *MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetColumnPolicy, error)) *MockDatasetColumnPolicyStore_GetDatasetColumnPoliciesForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPolicyById provides a mock function with given fields: ctx, policyId
+func (_m *MockDatasetColumnPolicyStore) GetDatasetColumnPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, policyId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPolicyById")
+	}
+
+	var r0 models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, policyId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, policyId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetColumnPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, policyId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPolicyById'
+type MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPolicyById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+func (_e *MockDatasetColumnPolicyStore_Expecter) GetDatasetColumnPolicyById(ctx interface{}, policyId interface{}) *MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call {
+	return &MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call{Call: _e.mock.On("GetDatasetColumnPolicyById", ctx, policyId)}
+}
+
+func (_c *MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call) Run(run func(ctx context.Context, policyId uuid.UUID)) *MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call) Return(_a0 models.DatasetColumnPolicy, _a1 error) *MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetColumnPolicy, error)) *MockDatasetColumnPolicyStore_GetDatasetColumnPolicyById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetColumnPolicy provides a mock function with given fields: ctx, policyId, params
+func (_m *MockDatasetColumnPolicyStore) UpdateDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, policyId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetColumnPolicy")
+	}
+
+	var r0 models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, policyId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, policyId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetColumnPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) error); ok {
+		r1 = rf(ctx, policyId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetColumnPolicy'
+type MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetColumnPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - params models.UpdateDatasetColumnPolicyParams
+func (_e *MockDatasetColumnPolicyStore_Expecter) UpdateDatasetColumnPolicy(ctx interface{}, policyId interface{}, params interface{}) *MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call {
+	return &MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call{Call: _e.mock.On("UpdateDatasetColumnPolicy", ctx, policyId, params)}
+}
+
+func (_c *MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetColumnPolicyParams)) *MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetColumnPolicyParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call) Return(_a0 models.DatasetColumnPolicy, _a1 error) *MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)) *MockDatasetColumnPolicyStore_UpdateDatasetColumnPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetColumnPolicyStore creates a new instance of MockDatasetColumnPolicyStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetColumnPolicyStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetColumnPolicyStore {
+	mock := &MockDatasetColumnPolicyStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,532 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	store "github.com/Zampfi/application-platform/services/api/db/store"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDatasetExportScheduleStore is an autogenerated mock type for the DatasetExportScheduleStore type
+type MockDatasetExportScheduleStore struct {
+	mock.Mock
+}
+
+type MockDatasetExportScheduleStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetExportScheduleStore) EXPECT() *MockDatasetExportScheduleStore_Expecter {
+	return &MockDatasetExportScheduleStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateDatasetExportSchedule provides a mock function with given fields: ctx, params
+func (_m *MockDatasetExportScheduleStore) CreateDatasetExportSchedule(ctx context.Context, params models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetExportSchedule")
+	}
+
+	var r0 models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleParams) models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetExportSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetExportScheduleParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetExportSchedule'
+type MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetExportSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateDatasetExportScheduleParams
+func (_e *MockDatasetExportScheduleStore_Expecter) CreateDatasetExportSchedule(ctx interface{}, params interface{}) *MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call {
+	return &MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call{Call: _e.mock.On("CreateDatasetExportSchedule", ctx, params)}
+}
+
+func (_c *MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call) Run(run func(ctx context.Context, params models.CreateDatasetExportScheduleParams)) *MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateDatasetExportScheduleParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call) Return(_a0 models.DatasetExportSchedule, _a1 error) *MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)) *MockDatasetExportScheduleStore_CreateDatasetExportSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDatasetExportScheduleRun provides a mock function with given fields: ctx, params
+func (_m *MockDatasetExportScheduleStore) CreateDatasetExportScheduleRun(ctx context.Context, params models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetExportScheduleRun")
+	}
+
+	var r0 models.DatasetExportScheduleRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleRunParams) models.DatasetExportScheduleRun); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetExportScheduleRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetExportScheduleRunParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetExportScheduleRun'
+type MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetExportScheduleRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateDatasetExportScheduleRunParams
+func (_e *MockDatasetExportScheduleStore_Expecter) CreateDatasetExportScheduleRun(ctx interface{}, params interface{}) *MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call {
+	return &MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call{Call: _e.mock.On("CreateDatasetExportScheduleRun", ctx, params)}
+}
+
+func (_c *MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call) Run(run func(ctx context.Context, params models.CreateDatasetExportScheduleRunParams)) *MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateDatasetExportScheduleRunParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call) Return(_a0 models.DatasetExportScheduleRun, _a1 error) *MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call) RunAndReturn(run func(context.Context, models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error)) *MockDatasetExportScheduleStore_CreateDatasetExportScheduleRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetExportSchedule provides a mock function with given fields: ctx, scheduleId, deletedBy
+func (_m *MockDatasetExportScheduleStore) DeleteDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, scheduleId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetExportSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, scheduleId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetExportSchedule'
+type MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetExportSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetExportScheduleStore_Expecter) DeleteDatasetExportSchedule(ctx interface{}, scheduleId interface{}, deletedBy interface{}) *MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call {
+	return &MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call{Call: _e.mock.On("DeleteDatasetExportSchedule", ctx, scheduleId, deletedBy)}
+}
+
+func (_c *MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call) Return(_a0 error) *MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetExportScheduleStore_DeleteDatasetExportSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportScheduleById provides a mock function with given fields: ctx, scheduleId
+func (_m *MockDatasetExportScheduleStore) GetDatasetExportScheduleById(ctx context.Context, scheduleId uuid.UUID) (models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, scheduleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportScheduleById")
+	}
+
+	var r0 models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, scheduleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, scheduleId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetExportSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, scheduleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportScheduleById'
+type MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportScheduleById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+func (_e *MockDatasetExportScheduleStore_Expecter) GetDatasetExportScheduleById(ctx interface{}, scheduleId interface{}) *MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call {
+	return &MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call{Call: _e.mock.On("GetDatasetExportScheduleById", ctx, scheduleId)}
+}
+
+func (_c *MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID)) *MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call) Return(_a0 models.DatasetExportSchedule, _a1 error) *MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetExportSchedule, error)) *MockDatasetExportScheduleStore_GetDatasetExportScheduleById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportScheduleRuns provides a mock function with given fields: ctx, scheduleId, limit
+func (_m *MockDatasetExportScheduleStore) GetDatasetExportScheduleRuns(ctx context.Context, scheduleId uuid.UUID, limit int) ([]models.DatasetExportScheduleRun, error) {
+	ret := _m.Called(ctx, scheduleId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportScheduleRuns")
+	}
+
+	var r0 []models.DatasetExportScheduleRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.DatasetExportScheduleRun, error)); ok {
+		return rf(ctx, scheduleId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.DatasetExportScheduleRun); ok {
+		r0 = rf(ctx, scheduleId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetExportScheduleRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, scheduleId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportScheduleRuns'
+type MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportScheduleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+//   - limit int
+func (_e *MockDatasetExportScheduleStore_Expecter) GetDatasetExportScheduleRuns(ctx interface{}, scheduleId interface{}, limit interface{}) *MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call {
+	return &MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call{Call: _e.mock.On("GetDatasetExportScheduleRuns", ctx, scheduleId, limit)}
+}
+
+func (_c *MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID, limit int)) *MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call) Return(_a0 []models.DatasetExportScheduleRun, _a1 error) *MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]models.DatasetExportScheduleRun, error)) *MockDatasetExportScheduleStore_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportSchedules provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetExportScheduleStore) GetDatasetExportSchedules(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportSchedules")
+	}
+
+	var r0 []models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetExportSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportSchedules'
+type MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetExportScheduleStore_Expecter) GetDatasetExportSchedules(ctx interface{}, datasetId interface{}) *MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call {
+	return &MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call{Call: _e.mock.On("GetDatasetExportSchedules", ctx, datasetId)}
+}
+
+func (_c *MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call) Return(_a0 []models.DatasetExportSchedule, _a1 error) *MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetExportSchedule, error)) *MockDatasetExportScheduleStore_GetDatasetExportSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetExportSchedule provides a mock function with given fields: ctx, scheduleId, params
+func (_m *MockDatasetExportScheduleStore) UpdateDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, params models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, scheduleId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetExportSchedule")
+	}
+
+	var r0 models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, scheduleId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, scheduleId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetExportSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) error); ok {
+		r1 = rf(ctx, scheduleId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetExportSchedule'
+type MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetExportSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+//   - params models.UpdateDatasetExportScheduleParams
+func (_e *MockDatasetExportScheduleStore_Expecter) UpdateDatasetExportSchedule(ctx interface{}, scheduleId interface{}, params interface{}) *MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call {
+	return &MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call{Call: _e.mock.On("UpdateDatasetExportSchedule", ctx, scheduleId, params)}
+}
+
+func (_c *MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID, params models.UpdateDatasetExportScheduleParams)) *MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetExportScheduleParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call) Return(_a0 models.DatasetExportSchedule, _a1 error) *MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)) *MockDatasetExportScheduleStore_UpdateDatasetExportSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetExportScheduleRun provides a mock function with given fields: ctx, runId, params
+func (_m *MockDatasetExportScheduleStore) UpdateDatasetExportScheduleRun(ctx context.Context, runId uuid.UUID, params models.UpdateDatasetExportScheduleRunParams) error {
+	ret := _m.Called(ctx, runId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetExportScheduleRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleRunParams) error); ok {
+		r0 = rf(ctx, runId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetExportScheduleRun'
+type MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetExportScheduleRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - params models.UpdateDatasetExportScheduleRunParams
+func (_e *MockDatasetExportScheduleStore_Expecter) UpdateDatasetExportScheduleRun(ctx interface{}, runId interface{}, params interface{}) *MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call {
+	return &MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call{Call: _e.mock.On("UpdateDatasetExportScheduleRun", ctx, runId, params)}
+}
+
+func (_c *MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call) Run(run func(ctx context.Context, runId uuid.UUID, params models.UpdateDatasetExportScheduleRunParams)) *MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetExportScheduleRunParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call) Return(_a0 error) *MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleRunParams) error) *MockDatasetExportScheduleStore_UpdateDatasetExportScheduleRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithDatasetExportScheduleTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDatasetExportScheduleStore) WithDatasetExportScheduleTransaction(ctx context.Context, fn func(store.DatasetExportScheduleStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDatasetExportScheduleTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetExportScheduleStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetExportScheduleTransaction'
+type MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call struct {
+	*mock.Call
+}
+
+// WithDatasetExportScheduleTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.DatasetExportScheduleStore) error
+func (_e *MockDatasetExportScheduleStore_Expecter) WithDatasetExportScheduleTransaction(ctx interface{}, fn interface{}) *MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call {
+	return &MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call{Call: _e.mock.On("WithDatasetExportScheduleTransaction", ctx, fn)}
+}
+
+func (_c *MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetExportScheduleStore) error)) *MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.DatasetExportScheduleStore) error))
+	})
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call) Return(_a0 error) *MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetExportScheduleStore) error) error) *MockDatasetExportScheduleStore_WithDatasetExportScheduleTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetExportScheduleStore creates a new instance of MockDatasetExportScheduleStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetExportScheduleStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetExportScheduleStore {
+	mock := &MockDatasetExportScheduleStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
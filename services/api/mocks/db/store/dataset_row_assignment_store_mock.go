@@ -0,0 +1,213 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDatasetRowAssignmentStore is an autogenerated mock type for the DatasetRowAssignmentStore type
+type MockDatasetRowAssignmentStore struct {
+	mock.Mock
+}
+
+type MockDatasetRowAssignmentStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetRowAssignmentStore) EXPECT() *MockDatasetRowAssignmentStore_Expecter {
+	return &MockDatasetRowAssignmentStore_Expecter{mock: &_m.Mock}
+}
+
+// GetDatasetRowAssignment provides a mock function with given fields: ctx, datasetId, rowId
+func (_m *MockDatasetRowAssignmentStore) GetDatasetRowAssignment(ctx context.Context, datasetId uuid.UUID, rowId string) (models.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, datasetId, rowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowAssignment")
+	}
+
+	var r0 models.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (models.DatasetRowAssignment, error)); ok {
+		return rf(ctx, datasetId, rowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) models.DatasetRowAssignment); ok {
+		r0 = rf(ctx, datasetId, rowId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowAssignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, datasetId, rowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowAssignment'
+type MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - rowId string
+func (_e *MockDatasetRowAssignmentStore_Expecter) GetDatasetRowAssignment(ctx interface{}, datasetId interface{}, rowId interface{}) *MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call {
+	return &MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call{Call: _e.mock.On("GetDatasetRowAssignment", ctx, datasetId, rowId)}
+}
+
+func (_c *MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, rowId string)) *MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call) Return(_a0 models.DatasetRowAssignment, _a1 error) *MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (models.DatasetRowAssignment, error)) *MockDatasetRowAssignmentStore_GetDatasetRowAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowAssignmentsForAssignee provides a mock function with given fields: ctx, assigneeId
+func (_m *MockDatasetRowAssignmentStore) GetDatasetRowAssignmentsForAssignee(ctx context.Context, assigneeId uuid.UUID) ([]models.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, assigneeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowAssignmentsForAssignee")
+	}
+
+	var r0 []models.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetRowAssignment, error)); ok {
+		return rf(ctx, assigneeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetRowAssignment); ok {
+		r0 = rf(ctx, assigneeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetRowAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, assigneeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowAssignmentsForAssignee'
+type MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowAssignmentsForAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assigneeId uuid.UUID
+func (_e *MockDatasetRowAssignmentStore_Expecter) GetDatasetRowAssignmentsForAssignee(ctx interface{}, assigneeId interface{}) *MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call {
+	return &MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call{Call: _e.mock.On("GetDatasetRowAssignmentsForAssignee", ctx, assigneeId)}
+}
+
+func (_c *MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call) Run(run func(ctx context.Context, assigneeId uuid.UUID)) *MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call) Return(_a0 []models.DatasetRowAssignment, _a1 error) *MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetRowAssignment, error)) *MockDatasetRowAssignmentStore_GetDatasetRowAssignmentsForAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertDatasetRowAssignment provides a mock function with given fields: ctx, params
+func (_m *MockDatasetRowAssignmentStore) UpsertDatasetRowAssignment(ctx context.Context, params models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertDatasetRowAssignment")
+	}
+
+	var r0 models.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.UpsertDatasetRowAssignmentParams) models.DatasetRowAssignment); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowAssignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.UpsertDatasetRowAssignmentParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertDatasetRowAssignment'
+type MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call struct {
+	*mock.Call
+}
+
+// UpsertDatasetRowAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.UpsertDatasetRowAssignmentParams
+func (_e *MockDatasetRowAssignmentStore_Expecter) UpsertDatasetRowAssignment(ctx interface{}, params interface{}) *MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call {
+	return &MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call{Call: _e.mock.On("UpsertDatasetRowAssignment", ctx, params)}
+}
+
+func (_c *MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call) Run(run func(ctx context.Context, params models.UpsertDatasetRowAssignmentParams)) *MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.UpsertDatasetRowAssignmentParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call) Return(_a0 models.DatasetRowAssignment, _a1 error) *MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call) RunAndReturn(run func(context.Context, models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error)) *MockDatasetRowAssignmentStore_UpsertDatasetRowAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetRowAssignmentStore creates a new instance of MockDatasetRowAssignmentStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetRowAssignmentStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetRowAssignmentStore {
+	mock := &MockDatasetRowAssignmentStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
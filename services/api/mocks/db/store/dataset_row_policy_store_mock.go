@@ -0,0 +1,378 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDatasetRowPolicyStore is an autogenerated mock type for the DatasetRowPolicyStore type
+type MockDatasetRowPolicyStore struct {
+	mock.Mock
+}
+
+type MockDatasetRowPolicyStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetRowPolicyStore) EXPECT() *MockDatasetRowPolicyStore_Expecter {
+	return &MockDatasetRowPolicyStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateDatasetRowPolicy provides a mock function with given fields: ctx, params
+func (_m *MockDatasetRowPolicyStore) CreateDatasetRowPolicy(ctx context.Context, params models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetRowPolicy")
+	}
+
+	var r0 models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetRowPolicyParams) models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetRowPolicyParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetRowPolicy'
+type MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateDatasetRowPolicyParams
+func (_e *MockDatasetRowPolicyStore_Expecter) CreateDatasetRowPolicy(ctx interface{}, params interface{}) *MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call {
+	return &MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call{Call: _e.mock.On("CreateDatasetRowPolicy", ctx, params)}
+}
+
+func (_c *MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call) Run(run func(ctx context.Context, params models.CreateDatasetRowPolicyParams)) *MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateDatasetRowPolicyParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call) Return(_a0 models.DatasetRowPolicy, _a1 error) *MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)) *MockDatasetRowPolicyStore_CreateDatasetRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetRowPolicy provides a mock function with given fields: ctx, policyId, deletedBy
+func (_m *MockDatasetRowPolicyStore) DeleteDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, policyId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetRowPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, policyId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetRowPolicy'
+type MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetRowPolicyStore_Expecter) DeleteDatasetRowPolicy(ctx interface{}, policyId interface{}, deletedBy interface{}) *MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call {
+	return &MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call{Call: _e.mock.On("DeleteDatasetRowPolicy", ctx, policyId, deletedBy)}
+}
+
+func (_c *MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call) Return(_a0 error) *MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetRowPolicyStore_DeleteDatasetRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetRowPolicyStore) GetDatasetRowPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPolicies")
+	}
+
+	var r0 []models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetRowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPolicies'
+type MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetRowPolicyStore_Expecter) GetDatasetRowPolicies(ctx interface{}, datasetId interface{}) *MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call {
+	return &MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call{Call: _e.mock.On("GetDatasetRowPolicies", ctx, datasetId)}
+}
+
+func (_c *MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call) Return(_a0 []models.DatasetRowPolicy, _a1 error) *MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetRowPolicy, error)) *MockDatasetRowPolicyStore_GetDatasetRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPoliciesForUser provides a mock function with given fields: ctx, datasetId, userId
+func (_m *MockDatasetRowPolicyStore) GetDatasetRowPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, datasetId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPoliciesForUser")
+	}
+
+	var r0 []models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, datasetId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, datasetId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetRowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPoliciesForUser'
+type MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPoliciesForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockDatasetRowPolicyStore_Expecter) GetDatasetRowPoliciesForUser(ctx interface{}, datasetId interface{}, userId interface{}) *MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call {
+	return &MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call{Call: _e.mock.On("GetDatasetRowPoliciesForUser", ctx, datasetId, userId)}
+}
+
+func (_c *MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID)) *MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call) Return(_a0 []models.DatasetRowPolicy, _a1 error) *MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetRowPolicy, error)) *MockDatasetRowPolicyStore_GetDatasetRowPoliciesForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPolicyById provides a mock function with given fields: ctx, policyId
+func (_m *MockDatasetRowPolicyStore) GetDatasetRowPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, policyId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPolicyById")
+	}
+
+	var r0 models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, policyId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, policyId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, policyId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPolicyById'
+type MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPolicyById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+func (_e *MockDatasetRowPolicyStore_Expecter) GetDatasetRowPolicyById(ctx interface{}, policyId interface{}) *MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call {
+	return &MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call{Call: _e.mock.On("GetDatasetRowPolicyById", ctx, policyId)}
+}
+
+func (_c *MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call) Run(run func(ctx context.Context, policyId uuid.UUID)) *MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call) Return(_a0 models.DatasetRowPolicy, _a1 error) *MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetRowPolicy, error)) *MockDatasetRowPolicyStore_GetDatasetRowPolicyById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetRowPolicy provides a mock function with given fields: ctx, policyId, params
+func (_m *MockDatasetRowPolicyStore) UpdateDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, policyId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetRowPolicy")
+	}
+
+	var r0 models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, policyId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, policyId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) error); ok {
+		r1 = rf(ctx, policyId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetRowPolicy'
+type MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - params models.UpdateDatasetRowPolicyParams
+func (_e *MockDatasetRowPolicyStore_Expecter) UpdateDatasetRowPolicy(ctx interface{}, policyId interface{}, params interface{}) *MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call {
+	return &MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call{Call: _e.mock.On("UpdateDatasetRowPolicy", ctx, policyId, params)}
+}
+
+func (_c *MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetRowPolicyParams)) *MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetRowPolicyParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call) Return(_a0 models.DatasetRowPolicy, _a1 error) *MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)) *MockDatasetRowPolicyStore_UpdateDatasetRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetRowPolicyStore creates a new instance of MockDatasetRowPolicyStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetRowPolicyStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetRowPolicyStore {
+	mock := &MockDatasetRowPolicyStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
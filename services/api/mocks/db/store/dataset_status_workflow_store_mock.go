@@ -0,0 +1,318 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDatasetStatusWorkflowStore is an autogenerated mock type for the DatasetStatusWorkflowStore type
+type MockDatasetStatusWorkflowStore struct {
+	mock.Mock
+}
+
+type MockDatasetStatusWorkflowStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetStatusWorkflowStore) EXPECT() *MockDatasetStatusWorkflowStore_Expecter {
+	return &MockDatasetStatusWorkflowStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateDatasetStatusWorkflow provides a mock function with given fields: ctx, params
+func (_m *MockDatasetStatusWorkflowStore) CreateDatasetStatusWorkflow(ctx context.Context, params models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetStatusWorkflow")
+	}
+
+	var r0 models.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetStatusWorkflowParams) models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetStatusWorkflow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetStatusWorkflowParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetStatusWorkflow'
+type MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetStatusWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateDatasetStatusWorkflowParams
+func (_e *MockDatasetStatusWorkflowStore_Expecter) CreateDatasetStatusWorkflow(ctx interface{}, params interface{}) *MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call {
+	return &MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call{Call: _e.mock.On("CreateDatasetStatusWorkflow", ctx, params)}
+}
+
+func (_c *MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, params models.CreateDatasetStatusWorkflowParams)) *MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateDatasetStatusWorkflowParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call) Return(_a0 models.DatasetStatusWorkflow, _a1 error) *MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)) *MockDatasetStatusWorkflowStore_CreateDatasetStatusWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetStatusWorkflow provides a mock function with given fields: ctx, workflowId, deletedBy
+func (_m *MockDatasetStatusWorkflowStore) DeleteDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, workflowId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetStatusWorkflow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, workflowId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetStatusWorkflow'
+type MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetStatusWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetStatusWorkflowStore_Expecter) DeleteDatasetStatusWorkflow(ctx interface{}, workflowId interface{}, deletedBy interface{}) *MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call {
+	return &MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call{Call: _e.mock.On("DeleteDatasetStatusWorkflow", ctx, workflowId, deletedBy)}
+}
+
+func (_c *MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, workflowId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call) Return(_a0 error) *MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetStatusWorkflowStore_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetStatusWorkflowById provides a mock function with given fields: ctx, workflowId
+func (_m *MockDatasetStatusWorkflowStore) GetDatasetStatusWorkflowById(ctx context.Context, workflowId uuid.UUID) (models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetStatusWorkflowById")
+	}
+
+	var r0 models.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, workflowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, workflowId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetStatusWorkflow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, workflowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetStatusWorkflowById'
+type MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetStatusWorkflowById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId uuid.UUID
+func (_e *MockDatasetStatusWorkflowStore_Expecter) GetDatasetStatusWorkflowById(ctx interface{}, workflowId interface{}) *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call {
+	return &MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call{Call: _e.mock.On("GetDatasetStatusWorkflowById", ctx, workflowId)}
+}
+
+func (_c *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call) Run(run func(ctx context.Context, workflowId uuid.UUID)) *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call) Return(_a0 models.DatasetStatusWorkflow, _a1 error) *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetStatusWorkflow, error)) *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflowById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetStatusWorkflows provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetStatusWorkflowStore) GetDatasetStatusWorkflows(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetStatusWorkflows")
+	}
+
+	var r0 []models.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetStatusWorkflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetStatusWorkflows'
+type MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call struct {
+	*mock.Call
+}
+
+// GetDatasetStatusWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetStatusWorkflowStore_Expecter) GetDatasetStatusWorkflows(ctx interface{}, datasetId interface{}) *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call {
+	return &MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call{Call: _e.mock.On("GetDatasetStatusWorkflows", ctx, datasetId)}
+}
+
+func (_c *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call) Return(_a0 []models.DatasetStatusWorkflow, _a1 error) *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetStatusWorkflow, error)) *MockDatasetStatusWorkflowStore_GetDatasetStatusWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetStatusWorkflow provides a mock function with given fields: ctx, workflowId, params
+func (_m *MockDatasetStatusWorkflowStore) UpdateDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, params models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, workflowId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetStatusWorkflow")
+	}
+
+	var r0 models.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, workflowId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, workflowId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetStatusWorkflow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) error); ok {
+		r1 = rf(ctx, workflowId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetStatusWorkflow'
+type MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetStatusWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId uuid.UUID
+//   - params models.UpdateDatasetStatusWorkflowParams
+func (_e *MockDatasetStatusWorkflowStore_Expecter) UpdateDatasetStatusWorkflow(ctx interface{}, workflowId interface{}, params interface{}) *MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call {
+	return &MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call{Call: _e.mock.On("UpdateDatasetStatusWorkflow", ctx, workflowId, params)}
+}
+
+func (_c *MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, workflowId uuid.UUID, params models.UpdateDatasetStatusWorkflowParams)) *MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetStatusWorkflowParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call) Return(_a0 models.DatasetStatusWorkflow, _a1 error) *MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)) *MockDatasetStatusWorkflowStore_UpdateDatasetStatusWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetStatusWorkflowStore creates a new instance of MockDatasetStatusWorkflowStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetStatusWorkflowStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetStatusWorkflowStore {
+	mock := &MockDatasetStatusWorkflowStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
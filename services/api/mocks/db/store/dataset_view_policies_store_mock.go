@@ -0,0 +1,270 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockdatasetViewPoliciesStore is an autogenerated mock type for the datasetViewPoliciesStore type
+type MockdatasetViewPoliciesStore struct {
+	mock.Mock
+}
+
+type MockdatasetViewPoliciesStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockdatasetViewPoliciesStore) EXPECT() *MockdatasetViewPoliciesStore_Expecter {
+	return &MockdatasetViewPoliciesStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceType, audienceId, privilege
+func (_m *MockdatasetViewPoliciesStore) CreateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId, audienceType, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetViewPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId, audienceType, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, viewId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetViewPolicy'
+type MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetViewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockdatasetViewPoliciesStore_Expecter) CreateDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call {
+	return &MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call{Call: _e.mock.On("CreateDatasetViewPolicy", ctx, viewId, audienceType, audienceId, privilege)}
+}
+
+func (_c *MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockdatasetViewPoliciesStore_CreateDatasetViewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceType, audienceId
+func (_m *MockdatasetViewPoliciesStore) DeleteDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, viewId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetViewPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, viewId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetViewPolicy'
+type MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetViewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockdatasetViewPoliciesStore_Expecter) DeleteDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}) *MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call {
+	return &MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call{Call: _e.mock.On("DeleteDatasetViewPolicy", ctx, viewId, audienceType, audienceId)}
+}
+
+func (_c *MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call) Return(_a0 error) *MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockdatasetViewPoliciesStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViewPolicies provides a mock function with given fields: ctx, viewId
+func (_m *MockdatasetViewPoliciesStore) GetDatasetViewPolicies(ctx context.Context, viewId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViewPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViewPolicies'
+type MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViewPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+func (_e *MockdatasetViewPoliciesStore_Expecter) GetDatasetViewPolicies(ctx interface{}, viewId interface{}) *MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call {
+	return &MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call{Call: _e.mock.On("GetDatasetViewPolicies", ctx, viewId)}
+}
+
+func (_c *MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call) Run(run func(ctx context.Context, viewId uuid.UUID)) *MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockdatasetViewPoliciesStore_GetDatasetViewPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceId, privilege
+func (_m *MockdatasetViewPoliciesStore) UpdateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetViewPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, viewId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetViewPolicy'
+type MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetViewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockdatasetViewPoliciesStore_Expecter) UpdateDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceId interface{}, privilege interface{}) *MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call {
+	return &MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call{Call: _e.mock.On("UpdateDatasetViewPolicy", ctx, viewId, audienceId, privilege)}
+}
+
+func (_c *MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockdatasetViewPoliciesStore_UpdateDatasetViewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockdatasetViewPoliciesStore creates a new instance of MockdatasetViewPoliciesStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockdatasetViewPoliciesStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockdatasetViewPoliciesStore {
+	mock := &MockdatasetViewPoliciesStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,765 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	store "github.com/Zampfi/application-platform/services/api/db/store"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockDatasetViewStore is an autogenerated mock type for the DatasetViewStore type
+type MockDatasetViewStore struct {
+	mock.Mock
+}
+
+type MockDatasetViewStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDatasetViewStore) EXPECT() *MockDatasetViewStore_Expecter {
+	return &MockDatasetViewStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateDatasetView provides a mock function with given fields: ctx, params
+func (_m *MockDatasetViewStore) CreateDatasetView(ctx context.Context, params models.CreateDatasetViewParams) (models.DatasetView, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetView")
+	}
+
+	var r0 models.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetViewParams) (models.DatasetView, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetViewParams) models.DatasetView); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetView)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetViewParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetViewStore_CreateDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetView'
+type MockDatasetViewStore_CreateDatasetView_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateDatasetViewParams
+func (_e *MockDatasetViewStore_Expecter) CreateDatasetView(ctx interface{}, params interface{}) *MockDatasetViewStore_CreateDatasetView_Call {
+	return &MockDatasetViewStore_CreateDatasetView_Call{Call: _e.mock.On("CreateDatasetView", ctx, params)}
+}
+
+func (_c *MockDatasetViewStore_CreateDatasetView_Call) Run(run func(ctx context.Context, params models.CreateDatasetViewParams)) *MockDatasetViewStore_CreateDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateDatasetViewParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_CreateDatasetView_Call) Return(_a0 models.DatasetView, _a1 error) *MockDatasetViewStore_CreateDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_CreateDatasetView_Call) RunAndReturn(run func(context.Context, models.CreateDatasetViewParams) (models.DatasetView, error)) *MockDatasetViewStore_CreateDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceType, audienceId, privilege
+func (_m *MockDatasetViewStore) CreateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId, audienceType, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDatasetViewPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId, audienceType, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, viewId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetViewStore_CreateDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetViewPolicy'
+type MockDatasetViewStore_CreateDatasetViewPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateDatasetViewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetViewStore_Expecter) CreateDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockDatasetViewStore_CreateDatasetViewPolicy_Call {
+	return &MockDatasetViewStore_CreateDatasetViewPolicy_Call{Call: _e.mock.On("CreateDatasetViewPolicy", ctx, viewId, audienceType, audienceId, privilege)}
+}
+
+func (_c *MockDatasetViewStore_CreateDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetViewStore_CreateDatasetViewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_CreateDatasetViewPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetViewStore_CreateDatasetViewPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_CreateDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetViewStore_CreateDatasetViewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetView provides a mock function with given fields: ctx, viewId, deletedBy
+func (_m *MockDatasetViewStore) DeleteDatasetView(ctx context.Context, viewId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, viewId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, viewId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetViewStore_DeleteDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetView'
+type MockDatasetViewStore_DeleteDatasetView_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockDatasetViewStore_Expecter) DeleteDatasetView(ctx interface{}, viewId interface{}, deletedBy interface{}) *MockDatasetViewStore_DeleteDatasetView_Call {
+	return &MockDatasetViewStore_DeleteDatasetView_Call{Call: _e.mock.On("DeleteDatasetView", ctx, viewId, deletedBy)}
+}
+
+func (_c *MockDatasetViewStore_DeleteDatasetView_Call) Run(run func(ctx context.Context, viewId uuid.UUID, deletedBy uuid.UUID)) *MockDatasetViewStore_DeleteDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_DeleteDatasetView_Call) Return(_a0 error) *MockDatasetViewStore_DeleteDatasetView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_DeleteDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetViewStore_DeleteDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceType, audienceId
+func (_m *MockDatasetViewStore) DeleteDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, viewId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetViewPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, viewId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetViewStore_DeleteDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetViewPolicy'
+type MockDatasetViewStore_DeleteDatasetViewPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetViewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockDatasetViewStore_Expecter) DeleteDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}) *MockDatasetViewStore_DeleteDatasetViewPolicy_Call {
+	return &MockDatasetViewStore_DeleteDatasetViewPolicy_Call{Call: _e.mock.On("DeleteDatasetViewPolicy", ctx, viewId, audienceType, audienceId)}
+}
+
+func (_c *MockDatasetViewStore_DeleteDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockDatasetViewStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_DeleteDatasetViewPolicy_Call) Return(_a0 error) *MockDatasetViewStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_DeleteDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockDatasetViewStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId
+func (_m *MockDatasetViewStore) DeleteDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDefaultDatasetView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetViewStore_DeleteDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDefaultDatasetView'
+type MockDatasetViewStore_DeleteDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// DeleteDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetViewStore_Expecter) DeleteDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}) *MockDatasetViewStore_DeleteDefaultDatasetView_Call {
+	return &MockDatasetViewStore_DeleteDefaultDatasetView_Call{Call: _e.mock.On("DeleteDefaultDatasetView", ctx, userId, datasetId)}
+}
+
+func (_c *MockDatasetViewStore_DeleteDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID)) *MockDatasetViewStore_DeleteDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_DeleteDefaultDatasetView_Call) Return(_a0 error) *MockDatasetViewStore_DeleteDefaultDatasetView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_DeleteDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockDatasetViewStore_DeleteDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViewById provides a mock function with given fields: ctx, viewId
+func (_m *MockDatasetViewStore) GetDatasetViewById(ctx context.Context, viewId uuid.UUID) (models.DatasetView, error) {
+	ret := _m.Called(ctx, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViewById")
+	}
+
+	var r0 models.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetView, error)); ok {
+		return rf(ctx, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetView); ok {
+		r0 = rf(ctx, viewId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetView)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetViewStore_GetDatasetViewById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViewById'
+type MockDatasetViewStore_GetDatasetViewById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViewById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+func (_e *MockDatasetViewStore_Expecter) GetDatasetViewById(ctx interface{}, viewId interface{}) *MockDatasetViewStore_GetDatasetViewById_Call {
+	return &MockDatasetViewStore_GetDatasetViewById_Call{Call: _e.mock.On("GetDatasetViewById", ctx, viewId)}
+}
+
+func (_c *MockDatasetViewStore_GetDatasetViewById_Call) Run(run func(ctx context.Context, viewId uuid.UUID)) *MockDatasetViewStore_GetDatasetViewById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_GetDatasetViewById_Call) Return(_a0 models.DatasetView, _a1 error) *MockDatasetViewStore_GetDatasetViewById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_GetDatasetViewById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetView, error)) *MockDatasetViewStore_GetDatasetViewById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViewPolicies provides a mock function with given fields: ctx, viewId
+func (_m *MockDatasetViewStore) GetDatasetViewPolicies(ctx context.Context, viewId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViewPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetViewStore_GetDatasetViewPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViewPolicies'
+type MockDatasetViewStore_GetDatasetViewPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViewPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+func (_e *MockDatasetViewStore_Expecter) GetDatasetViewPolicies(ctx interface{}, viewId interface{}) *MockDatasetViewStore_GetDatasetViewPolicies_Call {
+	return &MockDatasetViewStore_GetDatasetViewPolicies_Call{Call: _e.mock.On("GetDatasetViewPolicies", ctx, viewId)}
+}
+
+func (_c *MockDatasetViewStore_GetDatasetViewPolicies_Call) Run(run func(ctx context.Context, viewId uuid.UUID)) *MockDatasetViewStore_GetDatasetViewPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_GetDatasetViewPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockDatasetViewStore_GetDatasetViewPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_GetDatasetViewPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockDatasetViewStore_GetDatasetViewPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViews provides a mock function with given fields: ctx, datasetId
+func (_m *MockDatasetViewStore) GetDatasetViews(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetView, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViews")
+	}
+
+	var r0 []models.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetView, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetView); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetViewStore_GetDatasetViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViews'
+type MockDatasetViewStore_GetDatasetViews_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockDatasetViewStore_Expecter) GetDatasetViews(ctx interface{}, datasetId interface{}) *MockDatasetViewStore_GetDatasetViews_Call {
+	return &MockDatasetViewStore_GetDatasetViews_Call{Call: _e.mock.On("GetDatasetViews", ctx, datasetId)}
+}
+
+func (_c *MockDatasetViewStore_GetDatasetViews_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockDatasetViewStore_GetDatasetViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_GetDatasetViews_Call) Return(_a0 []models.DatasetView, _a1 error) *MockDatasetViewStore_GetDatasetViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_GetDatasetViews_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetView, error)) *MockDatasetViewStore_GetDatasetViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId
+func (_m *MockDatasetViewStore) GetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) (*models.DatasetViewDefault, error) {
+	ret := _m.Called(ctx, userId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultDatasetView")
+	}
+
+	var r0 *models.DatasetViewDefault
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.DatasetViewDefault, error)); ok {
+		return rf(ctx, userId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.DatasetViewDefault); ok {
+		r0 = rf(ctx, userId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DatasetViewDefault)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetViewStore_GetDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultDatasetView'
+type MockDatasetViewStore_GetDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// GetDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockDatasetViewStore_Expecter) GetDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}) *MockDatasetViewStore_GetDefaultDatasetView_Call {
+	return &MockDatasetViewStore_GetDefaultDatasetView_Call{Call: _e.mock.On("GetDefaultDatasetView", ctx, userId, datasetId)}
+}
+
+func (_c *MockDatasetViewStore_GetDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID)) *MockDatasetViewStore_GetDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_GetDefaultDatasetView_Call) Return(_a0 *models.DatasetViewDefault, _a1 error) *MockDatasetViewStore_GetDefaultDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_GetDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.DatasetViewDefault, error)) *MockDatasetViewStore_GetDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId, viewId
+func (_m *MockDatasetViewStore) SetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) (models.DatasetViewDefault, error) {
+	ret := _m.Called(ctx, userId, datasetId, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDefaultDatasetView")
+	}
+
+	var r0 models.DatasetViewDefault
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (models.DatasetViewDefault, error)); ok {
+		return rf(ctx, userId, datasetId, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) models.DatasetViewDefault); ok {
+		r0 = rf(ctx, userId, datasetId, viewId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetViewDefault)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetViewStore_SetDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDefaultDatasetView'
+type MockDatasetViewStore_SetDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// SetDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+func (_e *MockDatasetViewStore_Expecter) SetDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}, viewId interface{}) *MockDatasetViewStore_SetDefaultDatasetView_Call {
+	return &MockDatasetViewStore_SetDefaultDatasetView_Call{Call: _e.mock.On("SetDefaultDatasetView", ctx, userId, datasetId, viewId)}
+}
+
+func (_c *MockDatasetViewStore_SetDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID)) *MockDatasetViewStore_SetDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_SetDefaultDatasetView_Call) Return(_a0 models.DatasetViewDefault, _a1 error) *MockDatasetViewStore_SetDefaultDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_SetDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (models.DatasetViewDefault, error)) *MockDatasetViewStore_SetDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetView provides a mock function with given fields: ctx, viewId, params
+func (_m *MockDatasetViewStore) UpdateDatasetView(ctx context.Context, viewId uuid.UUID, params models.UpdateDatasetViewParams) (models.DatasetView, error) {
+	ret := _m.Called(ctx, viewId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetView")
+	}
+
+	var r0 models.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) (models.DatasetView, error)); ok {
+		return rf(ctx, viewId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) models.DatasetView); ok {
+		r0 = rf(ctx, viewId, params)
+	} else {
+		r0 = ret.Get(0).(models.DatasetView)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) error); ok {
+		r1 = rf(ctx, viewId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetViewStore_UpdateDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetView'
+type MockDatasetViewStore_UpdateDatasetView_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - params models.UpdateDatasetViewParams
+func (_e *MockDatasetViewStore_Expecter) UpdateDatasetView(ctx interface{}, viewId interface{}, params interface{}) *MockDatasetViewStore_UpdateDatasetView_Call {
+	return &MockDatasetViewStore_UpdateDatasetView_Call{Call: _e.mock.On("UpdateDatasetView", ctx, viewId, params)}
+}
+
+func (_c *MockDatasetViewStore_UpdateDatasetView_Call) Run(run func(ctx context.Context, viewId uuid.UUID, params models.UpdateDatasetViewParams)) *MockDatasetViewStore_UpdateDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetViewParams))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_UpdateDatasetView_Call) Return(_a0 models.DatasetView, _a1 error) *MockDatasetViewStore_UpdateDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_UpdateDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) (models.DatasetView, error)) *MockDatasetViewStore_UpdateDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceId, privilege
+func (_m *MockDatasetViewStore) UpdateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDatasetViewPolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, viewId, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatasetViewStore_UpdateDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetViewPolicy'
+type MockDatasetViewStore_UpdateDatasetViewPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateDatasetViewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockDatasetViewStore_Expecter) UpdateDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceId interface{}, privilege interface{}) *MockDatasetViewStore_UpdateDatasetViewPolicy_Call {
+	return &MockDatasetViewStore_UpdateDatasetViewPolicy_Call{Call: _e.mock.On("UpdateDatasetViewPolicy", ctx, viewId, audienceId, privilege)}
+}
+
+func (_c *MockDatasetViewStore_UpdateDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockDatasetViewStore_UpdateDatasetViewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_UpdateDatasetViewPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockDatasetViewStore_UpdateDatasetViewPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_UpdateDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockDatasetViewStore_UpdateDatasetViewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithDatasetViewTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockDatasetViewStore) WithDatasetViewTransaction(ctx context.Context, fn func(store.DatasetViewStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithDatasetViewTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetViewStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatasetViewStore_WithDatasetViewTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetViewTransaction'
+type MockDatasetViewStore_WithDatasetViewTransaction_Call struct {
+	*mock.Call
+}
+
+// WithDatasetViewTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.DatasetViewStore) error
+func (_e *MockDatasetViewStore_Expecter) WithDatasetViewTransaction(ctx interface{}, fn interface{}) *MockDatasetViewStore_WithDatasetViewTransaction_Call {
+	return &MockDatasetViewStore_WithDatasetViewTransaction_Call{Call: _e.mock.On("WithDatasetViewTransaction", ctx, fn)}
+}
+
+func (_c *MockDatasetViewStore_WithDatasetViewTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetViewStore) error)) *MockDatasetViewStore_WithDatasetViewTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.DatasetViewStore) error))
+	})
+	return _c
+}
+
+func (_c *MockDatasetViewStore_WithDatasetViewTransaction_Call) Return(_a0 error) *MockDatasetViewStore_WithDatasetViewTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatasetViewStore_WithDatasetViewTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetViewStore) error) error) *MockDatasetViewStore_WithDatasetViewTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDatasetViewStore creates a new instance of MockDatasetViewStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDatasetViewStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDatasetViewStore {
+	mock := &MockDatasetViewStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,592 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockFxRateStore is an autogenerated mock type for the FxRateStore type
+type MockFxRateStore struct {
+	mock.Mock
+}
+
+type MockFxRateStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockFxRateStore) EXPECT() *MockFxRateStore_Expecter {
+	return &MockFxRateStore_Expecter{mock: &_m.Mock}
+}
+
+// CountFxRates provides a mock function with given fields: ctx, filters
+func (_m *MockFxRateStore) CountFxRates(ctx context.Context, filters models.FxRateFilters) (int64, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFxRates")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) (int64, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) int64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FxRateFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxRateStore_CountFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFxRates'
+type MockFxRateStore_CountFxRates_Call struct {
+	*mock.Call
+}
+
+// CountFxRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FxRateFilters
+func (_e *MockFxRateStore_Expecter) CountFxRates(ctx interface{}, filters interface{}) *MockFxRateStore_CountFxRates_Call {
+	return &MockFxRateStore_CountFxRates_Call{Call: _e.mock.On("CountFxRates", ctx, filters)}
+}
+
+func (_c *MockFxRateStore_CountFxRates_Call) Run(run func(ctx context.Context, filters models.FxRateFilters)) *MockFxRateStore_CountFxRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FxRateFilters))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_CountFxRates_Call) Return(_a0 int64, _a1 error) *MockFxRateStore_CountFxRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxRateStore_CountFxRates_Call) RunAndReturn(run func(context.Context, models.FxRateFilters) (int64, error)) *MockFxRateStore_CountFxRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateFxRateSource provides a mock function with given fields: ctx, params
+func (_m *MockFxRateStore) CreateFxRateSource(ctx context.Context, params models.CreateFxRateSourceParams) (models.FxRateSource, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateFxRateSource")
+	}
+
+	var r0 models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateFxRateSourceParams) (models.FxRateSource, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateFxRateSourceParams) models.FxRateSource); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.FxRateSource)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateFxRateSourceParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxRateStore_CreateFxRateSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFxRateSource'
+type MockFxRateStore_CreateFxRateSource_Call struct {
+	*mock.Call
+}
+
+// CreateFxRateSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateFxRateSourceParams
+func (_e *MockFxRateStore_Expecter) CreateFxRateSource(ctx interface{}, params interface{}) *MockFxRateStore_CreateFxRateSource_Call {
+	return &MockFxRateStore_CreateFxRateSource_Call{Call: _e.mock.On("CreateFxRateSource", ctx, params)}
+}
+
+func (_c *MockFxRateStore_CreateFxRateSource_Call) Run(run func(ctx context.Context, params models.CreateFxRateSourceParams)) *MockFxRateStore_CreateFxRateSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateFxRateSourceParams))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_CreateFxRateSource_Call) Return(_a0 models.FxRateSource, _a1 error) *MockFxRateStore_CreateFxRateSource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxRateStore_CreateFxRateSource_Call) RunAndReturn(run func(context.Context, models.CreateFxRateSourceParams) (models.FxRateSource, error)) *MockFxRateStore_CreateFxRateSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFxRateSource provides a mock function with given fields: ctx, sourceId, deletedBy
+func (_m *MockFxRateStore) DeleteFxRateSource(ctx context.Context, sourceId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, sourceId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFxRateSource")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, sourceId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxRateStore_DeleteFxRateSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFxRateSource'
+type MockFxRateStore_DeleteFxRateSource_Call struct {
+	*mock.Call
+}
+
+// DeleteFxRateSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockFxRateStore_Expecter) DeleteFxRateSource(ctx interface{}, sourceId interface{}, deletedBy interface{}) *MockFxRateStore_DeleteFxRateSource_Call {
+	return &MockFxRateStore_DeleteFxRateSource_Call{Call: _e.mock.On("DeleteFxRateSource", ctx, sourceId, deletedBy)}
+}
+
+func (_c *MockFxRateStore_DeleteFxRateSource_Call) Run(run func(ctx context.Context, sourceId uuid.UUID, deletedBy uuid.UUID)) *MockFxRateStore_DeleteFxRateSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_DeleteFxRateSource_Call) Return(_a0 error) *MockFxRateStore_DeleteFxRateSource_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxRateStore_DeleteFxRateSource_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockFxRateStore_DeleteFxRateSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateOnDate provides a mock function with given fields: ctx, baseCurrency, quoteCurrency, date
+func (_m *MockFxRateStore) GetFxRateOnDate(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time) (models.FxRate, error) {
+	ret := _m.Called(ctx, baseCurrency, quoteCurrency, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateOnDate")
+	}
+
+	var r0 models.FxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (models.FxRate, error)); ok {
+		return rf(ctx, baseCurrency, quoteCurrency, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) models.FxRate); ok {
+		r0 = rf(ctx, baseCurrency, quoteCurrency, date)
+	} else {
+		r0 = ret.Get(0).(models.FxRate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, baseCurrency, quoteCurrency, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxRateStore_GetFxRateOnDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateOnDate'
+type MockFxRateStore_GetFxRateOnDate_Call struct {
+	*mock.Call
+}
+
+// GetFxRateOnDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - baseCurrency string
+//   - quoteCurrency string
+//   - date time.Time
+func (_e *MockFxRateStore_Expecter) GetFxRateOnDate(ctx interface{}, baseCurrency interface{}, quoteCurrency interface{}, date interface{}) *MockFxRateStore_GetFxRateOnDate_Call {
+	return &MockFxRateStore_GetFxRateOnDate_Call{Call: _e.mock.On("GetFxRateOnDate", ctx, baseCurrency, quoteCurrency, date)}
+}
+
+func (_c *MockFxRateStore_GetFxRateOnDate_Call) Run(run func(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time)) *MockFxRateStore_GetFxRateOnDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_GetFxRateOnDate_Call) Return(_a0 models.FxRate, _a1 error) *MockFxRateStore_GetFxRateOnDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxRateStore_GetFxRateOnDate_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (models.FxRate, error)) *MockFxRateStore_GetFxRateOnDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateSourceById provides a mock function with given fields: ctx, sourceId
+func (_m *MockFxRateStore) GetFxRateSourceById(ctx context.Context, sourceId uuid.UUID) (models.FxRateSource, error) {
+	ret := _m.Called(ctx, sourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateSourceById")
+	}
+
+	var r0 models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.FxRateSource, error)); ok {
+		return rf(ctx, sourceId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.FxRateSource); ok {
+		r0 = rf(ctx, sourceId)
+	} else {
+		r0 = ret.Get(0).(models.FxRateSource)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sourceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxRateStore_GetFxRateSourceById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateSourceById'
+type MockFxRateStore_GetFxRateSourceById_Call struct {
+	*mock.Call
+}
+
+// GetFxRateSourceById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+func (_e *MockFxRateStore_Expecter) GetFxRateSourceById(ctx interface{}, sourceId interface{}) *MockFxRateStore_GetFxRateSourceById_Call {
+	return &MockFxRateStore_GetFxRateSourceById_Call{Call: _e.mock.On("GetFxRateSourceById", ctx, sourceId)}
+}
+
+func (_c *MockFxRateStore_GetFxRateSourceById_Call) Run(run func(ctx context.Context, sourceId uuid.UUID)) *MockFxRateStore_GetFxRateSourceById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_GetFxRateSourceById_Call) Return(_a0 models.FxRateSource, _a1 error) *MockFxRateStore_GetFxRateSourceById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxRateStore_GetFxRateSourceById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.FxRateSource, error)) *MockFxRateStore_GetFxRateSourceById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateSources provides a mock function with given fields: ctx
+func (_m *MockFxRateStore) GetFxRateSources(ctx context.Context) ([]models.FxRateSource, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateSources")
+	}
+
+	var r0 []models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.FxRateSource, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.FxRateSource); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FxRateSource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxRateStore_GetFxRateSources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateSources'
+type MockFxRateStore_GetFxRateSources_Call struct {
+	*mock.Call
+}
+
+// GetFxRateSources is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockFxRateStore_Expecter) GetFxRateSources(ctx interface{}) *MockFxRateStore_GetFxRateSources_Call {
+	return &MockFxRateStore_GetFxRateSources_Call{Call: _e.mock.On("GetFxRateSources", ctx)}
+}
+
+func (_c *MockFxRateStore_GetFxRateSources_Call) Run(run func(ctx context.Context)) *MockFxRateStore_GetFxRateSources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_GetFxRateSources_Call) Return(_a0 []models.FxRateSource, _a1 error) *MockFxRateStore_GetFxRateSources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxRateStore_GetFxRateSources_Call) RunAndReturn(run func(context.Context) ([]models.FxRateSource, error)) *MockFxRateStore_GetFxRateSources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRates provides a mock function with given fields: ctx, filters
+func (_m *MockFxRateStore) GetFxRates(ctx context.Context, filters models.FxRateFilters) ([]models.FxRate, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRates")
+	}
+
+	var r0 []models.FxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) ([]models.FxRate, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) []models.FxRate); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FxRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FxRateFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxRateStore_GetFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRates'
+type MockFxRateStore_GetFxRates_Call struct {
+	*mock.Call
+}
+
+// GetFxRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FxRateFilters
+func (_e *MockFxRateStore_Expecter) GetFxRates(ctx interface{}, filters interface{}) *MockFxRateStore_GetFxRates_Call {
+	return &MockFxRateStore_GetFxRates_Call{Call: _e.mock.On("GetFxRates", ctx, filters)}
+}
+
+func (_c *MockFxRateStore_GetFxRates_Call) Run(run func(ctx context.Context, filters models.FxRateFilters)) *MockFxRateStore_GetFxRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FxRateFilters))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_GetFxRates_Call) Return(_a0 []models.FxRate, _a1 error) *MockFxRateStore_GetFxRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxRateStore_GetFxRates_Call) RunAndReturn(run func(context.Context, models.FxRateFilters) ([]models.FxRate, error)) *MockFxRateStore_GetFxRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestFxRateDate provides a mock function with given fields: ctx, sourceId
+func (_m *MockFxRateStore) GetLatestFxRateDate(ctx context.Context, sourceId uuid.UUID) (*time.Time, error) {
+	ret := _m.Called(ctx, sourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestFxRateDate")
+	}
+
+	var r0 *time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*time.Time, error)); ok {
+		return rf(ctx, sourceId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *time.Time); ok {
+		r0 = rf(ctx, sourceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sourceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFxRateStore_GetLatestFxRateDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestFxRateDate'
+type MockFxRateStore_GetLatestFxRateDate_Call struct {
+	*mock.Call
+}
+
+// GetLatestFxRateDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+func (_e *MockFxRateStore_Expecter) GetLatestFxRateDate(ctx interface{}, sourceId interface{}) *MockFxRateStore_GetLatestFxRateDate_Call {
+	return &MockFxRateStore_GetLatestFxRateDate_Call{Call: _e.mock.On("GetLatestFxRateDate", ctx, sourceId)}
+}
+
+func (_c *MockFxRateStore_GetLatestFxRateDate_Call) Run(run func(ctx context.Context, sourceId uuid.UUID)) *MockFxRateStore_GetLatestFxRateDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_GetLatestFxRateDate_Call) Return(_a0 *time.Time, _a1 error) *MockFxRateStore_GetLatestFxRateDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFxRateStore_GetLatestFxRateDate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*time.Time, error)) *MockFxRateStore_GetLatestFxRateDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkFxRateSourceImported provides a mock function with given fields: ctx, sourceId, importedAt
+func (_m *MockFxRateStore) MarkFxRateSourceImported(ctx context.Context, sourceId uuid.UUID, importedAt time.Time) error {
+	ret := _m.Called(ctx, sourceId, importedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFxRateSourceImported")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, sourceId, importedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxRateStore_MarkFxRateSourceImported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFxRateSourceImported'
+type MockFxRateStore_MarkFxRateSourceImported_Call struct {
+	*mock.Call
+}
+
+// MarkFxRateSourceImported is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+//   - importedAt time.Time
+func (_e *MockFxRateStore_Expecter) MarkFxRateSourceImported(ctx interface{}, sourceId interface{}, importedAt interface{}) *MockFxRateStore_MarkFxRateSourceImported_Call {
+	return &MockFxRateStore_MarkFxRateSourceImported_Call{Call: _e.mock.On("MarkFxRateSourceImported", ctx, sourceId, importedAt)}
+}
+
+func (_c *MockFxRateStore_MarkFxRateSourceImported_Call) Run(run func(ctx context.Context, sourceId uuid.UUID, importedAt time.Time)) *MockFxRateStore_MarkFxRateSourceImported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_MarkFxRateSourceImported_Call) Return(_a0 error) *MockFxRateStore_MarkFxRateSourceImported_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxRateStore_MarkFxRateSourceImported_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockFxRateStore_MarkFxRateSourceImported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertFxRates provides a mock function with given fields: ctx, orgId, sourceId, rates
+func (_m *MockFxRateStore) UpsertFxRates(ctx context.Context, orgId uuid.UUID, sourceId uuid.UUID, rates []models.FxRateParams) error {
+	ret := _m.Called(ctx, orgId, sourceId, rates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertFxRates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []models.FxRateParams) error); ok {
+		r0 = rf(ctx, orgId, sourceId, rates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFxRateStore_UpsertFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertFxRates'
+type MockFxRateStore_UpsertFxRates_Call struct {
+	*mock.Call
+}
+
+// UpsertFxRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - sourceId uuid.UUID
+//   - rates []models.FxRateParams
+func (_e *MockFxRateStore_Expecter) UpsertFxRates(ctx interface{}, orgId interface{}, sourceId interface{}, rates interface{}) *MockFxRateStore_UpsertFxRates_Call {
+	return &MockFxRateStore_UpsertFxRates_Call{Call: _e.mock.On("UpsertFxRates", ctx, orgId, sourceId, rates)}
+}
+
+func (_c *MockFxRateStore_UpsertFxRates_Call) Run(run func(ctx context.Context, orgId uuid.UUID, sourceId uuid.UUID, rates []models.FxRateParams)) *MockFxRateStore_UpsertFxRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]models.FxRateParams))
+	})
+	return _c
+}
+
+func (_c *MockFxRateStore_UpsertFxRates_Call) Return(_a0 error) *MockFxRateStore_UpsertFxRates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFxRateStore_UpsertFxRates_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []models.FxRateParams) error) *MockFxRateStore_UpsertFxRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockFxRateStore creates a new instance of MockFxRateStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockFxRateStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockFxRateStore {
+	mock := &MockFxRateStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -148,6 +148,53 @@ func (_c *MockPageStore_CreatePagePolicy_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// DeletePage provides a mock function with given fields: ctx, pageId
+func (_m *MockPageStore) DeletePage(ctx context.Context, pageId uuid.UUID) error {
+	ret := _m.Called(ctx, pageId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, pageId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPageStore_DeletePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePage'
+type MockPageStore_DeletePage_Call struct {
+	*mock.Call
+}
+
+// DeletePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+func (_e *MockPageStore_Expecter) DeletePage(ctx interface{}, pageId interface{}) *MockPageStore_DeletePage_Call {
+	return &MockPageStore_DeletePage_Call{Call: _e.mock.On("DeletePage", ctx, pageId)}
+}
+
+func (_c *MockPageStore_DeletePage_Call) Run(run func(ctx context.Context, pageId uuid.UUID)) *MockPageStore_DeletePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockPageStore_DeletePage_Call) Return(_a0 error) *MockPageStore_DeletePage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPageStore_DeletePage_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockPageStore_DeletePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeletePagePolicy provides a mock function with given fields: ctx, pageId, audienceType, audienceId
 func (_m *MockPageStore) DeletePagePolicy(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
 	ret := _m.Called(ctx, pageId, audienceType, audienceId)
@@ -493,6 +540,66 @@ func (_c *MockPageStore_GetPagesPolicies_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// UpdatePage provides a mock function with given fields: ctx, pageId, params
+func (_m *MockPageStore) UpdatePage(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams) (*models.Page, error) {
+	ret := _m.Called(ctx, pageId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePage")
+	}
+
+	var r0 *models.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePageParams) (*models.Page, error)); ok {
+		return rf(ctx, pageId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePageParams) *models.Page); ok {
+		r0 = rf(ctx, pageId, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdatePageParams) error); ok {
+		r1 = rf(ctx, pageId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPageStore_UpdatePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePage'
+type MockPageStore_UpdatePage_Call struct {
+	*mock.Call
+}
+
+// UpdatePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+//   - params models.UpdatePageParams
+func (_e *MockPageStore_Expecter) UpdatePage(ctx interface{}, pageId interface{}, params interface{}) *MockPageStore_UpdatePage_Call {
+	return &MockPageStore_UpdatePage_Call{Call: _e.mock.On("UpdatePage", ctx, pageId, params)}
+}
+
+func (_c *MockPageStore_UpdatePage_Call) Run(run func(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams)) *MockPageStore_UpdatePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdatePageParams))
+	})
+	return _c
+}
+
+func (_c *MockPageStore_UpdatePage_Call) Return(_a0 *models.Page, _a1 error) *MockPageStore_UpdatePage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPageStore_UpdatePage_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdatePageParams) (*models.Page, error)) *MockPageStore_UpdatePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePagePolicy provides a mock function with given fields: ctx, pageId, audienceId, privilege
 func (_m *MockPageStore) UpdatePagePolicy(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
 	ret := _m.Called(ctx, pageId, audienceId, privilege)
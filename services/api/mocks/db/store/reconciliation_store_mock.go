@@ -0,0 +1,1294 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	store "github.com/Zampfi/application-platform/services/api/db/store"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockReconciliationStore is an autogenerated mock type for the ReconciliationStore type
+type MockReconciliationStore struct {
+	mock.Mock
+}
+
+type MockReconciliationStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReconciliationStore) EXPECT() *MockReconciliationStore_Expecter {
+	return &MockReconciliationStore_Expecter{mock: &_m.Mock}
+}
+
+// AdjustReconciliationRunCounts provides a mock function with given fields: ctx, runId, deltas
+func (_m *MockReconciliationStore) AdjustReconciliationRunCounts(ctx context.Context, runId uuid.UUID, deltas models.ReconciliationRunCountDeltas) error {
+	ret := _m.Called(ctx, runId, deltas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustReconciliationRunCounts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationRunCountDeltas) error); ok {
+		r0 = rf(ctx, runId, deltas)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationStore_AdjustReconciliationRunCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustReconciliationRunCounts'
+type MockReconciliationStore_AdjustReconciliationRunCounts_Call struct {
+	*mock.Call
+}
+
+// AdjustReconciliationRunCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - deltas models.ReconciliationRunCountDeltas
+func (_e *MockReconciliationStore_Expecter) AdjustReconciliationRunCounts(ctx interface{}, runId interface{}, deltas interface{}) *MockReconciliationStore_AdjustReconciliationRunCounts_Call {
+	return &MockReconciliationStore_AdjustReconciliationRunCounts_Call{Call: _e.mock.On("AdjustReconciliationRunCounts", ctx, runId, deltas)}
+}
+
+func (_c *MockReconciliationStore_AdjustReconciliationRunCounts_Call) Run(run func(ctx context.Context, runId uuid.UUID, deltas models.ReconciliationRunCountDeltas)) *MockReconciliationStore_AdjustReconciliationRunCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationRunCountDeltas))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_AdjustReconciliationRunCounts_Call) Return(_a0 error) *MockReconciliationStore_AdjustReconciliationRunCounts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationStore_AdjustReconciliationRunCounts_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationRunCountDeltas) error) *MockReconciliationStore_AdjustReconciliationRunCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteReconciliationRun provides a mock function with given fields: ctx, runId, params
+func (_m *MockReconciliationStore) CompleteReconciliationRun(ctx context.Context, runId uuid.UUID, params models.CompleteReconciliationRunParams) error {
+	ret := _m.Called(ctx, runId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteReconciliationRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.CompleteReconciliationRunParams) error); ok {
+		r0 = rf(ctx, runId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationStore_CompleteReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteReconciliationRun'
+type MockReconciliationStore_CompleteReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// CompleteReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - params models.CompleteReconciliationRunParams
+func (_e *MockReconciliationStore_Expecter) CompleteReconciliationRun(ctx interface{}, runId interface{}, params interface{}) *MockReconciliationStore_CompleteReconciliationRun_Call {
+	return &MockReconciliationStore_CompleteReconciliationRun_Call{Call: _e.mock.On("CompleteReconciliationRun", ctx, runId, params)}
+}
+
+func (_c *MockReconciliationStore_CompleteReconciliationRun_Call) Run(run func(ctx context.Context, runId uuid.UUID, params models.CompleteReconciliationRunParams)) *MockReconciliationStore_CompleteReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.CompleteReconciliationRunParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_CompleteReconciliationRun_Call) Return(_a0 error) *MockReconciliationStore_CompleteReconciliationRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationStore_CompleteReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.CompleteReconciliationRunParams) error) *MockReconciliationStore_CompleteReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReconciliationExceptions provides a mock function with given fields: ctx, runId, filters
+func (_m *MockReconciliationStore) CountReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) (int64, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReconciliationExceptions")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) (int64, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) int64); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_CountReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReconciliationExceptions'
+type MockReconciliationStore_CountReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// CountReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationExceptionFilters
+func (_e *MockReconciliationStore_Expecter) CountReconciliationExceptions(ctx interface{}, runId interface{}, filters interface{}) *MockReconciliationStore_CountReconciliationExceptions_Call {
+	return &MockReconciliationStore_CountReconciliationExceptions_Call{Call: _e.mock.On("CountReconciliationExceptions", ctx, runId, filters)}
+}
+
+func (_c *MockReconciliationStore_CountReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters)) *MockReconciliationStore_CountReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationExceptionFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_CountReconciliationExceptions_Call) Return(_a0 int64, _a1 error) *MockReconciliationStore_CountReconciliationExceptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_CountReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) (int64, error)) *MockReconciliationStore_CountReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReconciliationMatches provides a mock function with given fields: ctx, runId, filters
+func (_m *MockReconciliationStore) CountReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) (int64, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReconciliationMatches")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) (int64, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) int64); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_CountReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReconciliationMatches'
+type MockReconciliationStore_CountReconciliationMatches_Call struct {
+	*mock.Call
+}
+
+// CountReconciliationMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationMatchFilters
+func (_e *MockReconciliationStore_Expecter) CountReconciliationMatches(ctx interface{}, runId interface{}, filters interface{}) *MockReconciliationStore_CountReconciliationMatches_Call {
+	return &MockReconciliationStore_CountReconciliationMatches_Call{Call: _e.mock.On("CountReconciliationMatches", ctx, runId, filters)}
+}
+
+func (_c *MockReconciliationStore_CountReconciliationMatches_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters)) *MockReconciliationStore_CountReconciliationMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationMatchFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_CountReconciliationMatches_Call) Return(_a0 int64, _a1 error) *MockReconciliationStore_CountReconciliationMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_CountReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) (int64, error)) *MockReconciliationStore_CountReconciliationMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliation provides a mock function with given fields: ctx, params
+func (_m *MockReconciliationStore) CreateReconciliation(ctx context.Context, params models.CreateReconciliationParams) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliation")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReconciliationParams) (models.Reconciliation, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReconciliationParams) models.Reconciliation); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateReconciliationParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_CreateReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliation'
+type MockReconciliationStore_CreateReconciliation_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateReconciliationParams
+func (_e *MockReconciliationStore_Expecter) CreateReconciliation(ctx interface{}, params interface{}) *MockReconciliationStore_CreateReconciliation_Call {
+	return &MockReconciliationStore_CreateReconciliation_Call{Call: _e.mock.On("CreateReconciliation", ctx, params)}
+}
+
+func (_c *MockReconciliationStore_CreateReconciliation_Call) Run(run func(ctx context.Context, params models.CreateReconciliationParams)) *MockReconciliationStore_CreateReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateReconciliationParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_CreateReconciliation_Call) Return(_a0 models.Reconciliation, _a1 error) *MockReconciliationStore_CreateReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_CreateReconciliation_Call) RunAndReturn(run func(context.Context, models.CreateReconciliationParams) (models.Reconciliation, error)) *MockReconciliationStore_CreateReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationExceptions provides a mock function with given fields: ctx, runId, side, rows, skipped
+func (_m *MockReconciliationStore) CreateReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow, skipped bool) error {
+	ret := _m.Called(ctx, runId, side, rows, skipped)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliationExceptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []models.ReconciliationRow, bool) error); ok {
+		r0 = rf(ctx, runId, side, rows, skipped)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationStore_CreateReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationExceptions'
+type MockReconciliationStore_CreateReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - side string
+//   - rows []models.ReconciliationRow
+//   - skipped bool
+func (_e *MockReconciliationStore_Expecter) CreateReconciliationExceptions(ctx interface{}, runId interface{}, side interface{}, rows interface{}, skipped interface{}) *MockReconciliationStore_CreateReconciliationExceptions_Call {
+	return &MockReconciliationStore_CreateReconciliationExceptions_Call{Call: _e.mock.On("CreateReconciliationExceptions", ctx, runId, side, rows, skipped)}
+}
+
+func (_c *MockReconciliationStore_CreateReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow, skipped bool)) *MockReconciliationStore_CreateReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]models.ReconciliationRow), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_CreateReconciliationExceptions_Call) Return(_a0 error) *MockReconciliationStore_CreateReconciliationExceptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationStore_CreateReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []models.ReconciliationRow, bool) error) *MockReconciliationStore_CreateReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationMatches provides a mock function with given fields: ctx, runId, params
+func (_m *MockReconciliationStore) CreateReconciliationMatches(ctx context.Context, runId uuid.UUID, params []models.CreateReconciliationMatchParams) error {
+	ret := _m.Called(ctx, runId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliationMatches")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.CreateReconciliationMatchParams) error); ok {
+		r0 = rf(ctx, runId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationStore_CreateReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationMatches'
+type MockReconciliationStore_CreateReconciliationMatches_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - params []models.CreateReconciliationMatchParams
+func (_e *MockReconciliationStore_Expecter) CreateReconciliationMatches(ctx interface{}, runId interface{}, params interface{}) *MockReconciliationStore_CreateReconciliationMatches_Call {
+	return &MockReconciliationStore_CreateReconciliationMatches_Call{Call: _e.mock.On("CreateReconciliationMatches", ctx, runId, params)}
+}
+
+func (_c *MockReconciliationStore_CreateReconciliationMatches_Call) Run(run func(ctx context.Context, runId uuid.UUID, params []models.CreateReconciliationMatchParams)) *MockReconciliationStore_CreateReconciliationMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.CreateReconciliationMatchParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_CreateReconciliationMatches_Call) Return(_a0 error) *MockReconciliationStore_CreateReconciliationMatches_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationStore_CreateReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, []models.CreateReconciliationMatchParams) error) *MockReconciliationStore_CreateReconciliationMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationRun provides a mock function with given fields: ctx, reconciliationId, createdBy
+func (_m *MockReconciliationStore) CreateReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, createdBy uuid.UUID) (models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, createdBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliationRun")
+	}
+
+	var r0 models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, createdBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, createdBy)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, reconciliationId, createdBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_CreateReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationRun'
+type MockReconciliationStore_CreateReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - createdBy uuid.UUID
+func (_e *MockReconciliationStore_Expecter) CreateReconciliationRun(ctx interface{}, reconciliationId interface{}, createdBy interface{}) *MockReconciliationStore_CreateReconciliationRun_Call {
+	return &MockReconciliationStore_CreateReconciliationRun_Call{Call: _e.mock.On("CreateReconciliationRun", ctx, reconciliationId, createdBy)}
+}
+
+func (_c *MockReconciliationStore_CreateReconciliationRun_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, createdBy uuid.UUID)) *MockReconciliationStore_CreateReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_CreateReconciliationRun_Call) Return(_a0 models.ReconciliationRun, _a1 error) *MockReconciliationStore_CreateReconciliationRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_CreateReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationRun, error)) *MockReconciliationStore_CreateReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReconciliation provides a mock function with given fields: ctx, reconciliationId, deletedBy
+func (_m *MockReconciliationStore) DeleteReconciliation(ctx context.Context, reconciliationId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, reconciliationId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReconciliation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, reconciliationId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationStore_DeleteReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReconciliation'
+type MockReconciliationStore_DeleteReconciliation_Call struct {
+	*mock.Call
+}
+
+// DeleteReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockReconciliationStore_Expecter) DeleteReconciliation(ctx interface{}, reconciliationId interface{}, deletedBy interface{}) *MockReconciliationStore_DeleteReconciliation_Call {
+	return &MockReconciliationStore_DeleteReconciliation_Call{Call: _e.mock.On("DeleteReconciliation", ctx, reconciliationId, deletedBy)}
+}
+
+func (_c *MockReconciliationStore_DeleteReconciliation_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, deletedBy uuid.UUID)) *MockReconciliationStore_DeleteReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_DeleteReconciliation_Call) Return(_a0 error) *MockReconciliationStore_DeleteReconciliation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationStore_DeleteReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockReconciliationStore_DeleteReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestReconciliationRun provides a mock function with given fields: ctx, reconciliationId, status
+func (_m *MockReconciliationStore) GetLatestReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, status string) (models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestReconciliationRun")
+	}
+
+	var r0 models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (models.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) models.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, status)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, reconciliationId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_GetLatestReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestReconciliationRun'
+type MockReconciliationStore_GetLatestReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// GetLatestReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - status string
+func (_e *MockReconciliationStore_Expecter) GetLatestReconciliationRun(ctx interface{}, reconciliationId interface{}, status interface{}) *MockReconciliationStore_GetLatestReconciliationRun_Call {
+	return &MockReconciliationStore_GetLatestReconciliationRun_Call{Call: _e.mock.On("GetLatestReconciliationRun", ctx, reconciliationId, status)}
+}
+
+func (_c *MockReconciliationStore_GetLatestReconciliationRun_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, status string)) *MockReconciliationStore_GetLatestReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetLatestReconciliationRun_Call) Return(_a0 models.ReconciliationRun, _a1 error) *MockReconciliationStore_GetLatestReconciliationRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetLatestReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (models.ReconciliationRun, error)) *MockReconciliationStore_GetLatestReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationById provides a mock function with given fields: ctx, reconciliationId
+func (_m *MockReconciliationStore) GetReconciliationById(ctx context.Context, reconciliationId uuid.UUID) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, reconciliationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationById")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Reconciliation, error)); ok {
+		return rf(ctx, reconciliationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Reconciliation); ok {
+		r0 = rf(ctx, reconciliationId)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, reconciliationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_GetReconciliationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationById'
+type MockReconciliationStore_GetReconciliationById_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+func (_e *MockReconciliationStore_Expecter) GetReconciliationById(ctx interface{}, reconciliationId interface{}) *MockReconciliationStore_GetReconciliationById_Call {
+	return &MockReconciliationStore_GetReconciliationById_Call{Call: _e.mock.On("GetReconciliationById", ctx, reconciliationId)}
+}
+
+func (_c *MockReconciliationStore_GetReconciliationById_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID)) *MockReconciliationStore_GetReconciliationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationById_Call) Return(_a0 models.Reconciliation, _a1 error) *MockReconciliationStore_GetReconciliationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Reconciliation, error)) *MockReconciliationStore_GetReconciliationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationExceptions provides a mock function with given fields: ctx, runId, filters
+func (_m *MockReconciliationStore) GetReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationExceptions")
+	}
+
+	var r0 []models.ReconciliationException
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) []models.ReconciliationException); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReconciliationException)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_GetReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationExceptions'
+type MockReconciliationStore_GetReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationExceptionFilters
+func (_e *MockReconciliationStore_Expecter) GetReconciliationExceptions(ctx interface{}, runId interface{}, filters interface{}) *MockReconciliationStore_GetReconciliationExceptions_Call {
+	return &MockReconciliationStore_GetReconciliationExceptions_Call{Call: _e.mock.On("GetReconciliationExceptions", ctx, runId, filters)}
+}
+
+func (_c *MockReconciliationStore_GetReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters)) *MockReconciliationStore_GetReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationExceptionFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationExceptions_Call) Return(_a0 []models.ReconciliationException, _a1 error) *MockReconciliationStore_GetReconciliationExceptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error)) *MockReconciliationStore_GetReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationMatch provides a mock function with given fields: ctx, runId, matchId
+func (_m *MockReconciliationStore) GetReconciliationMatch(ctx context.Context, runId uuid.UUID, matchId uuid.UUID) (models.ReconciliationMatch, error) {
+	ret := _m.Called(ctx, runId, matchId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationMatch")
+	}
+
+	var r0 models.ReconciliationMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationMatch, error)); ok {
+		return rf(ctx, runId, matchId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.ReconciliationMatch); ok {
+		r0 = rf(ctx, runId, matchId)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationMatch)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, runId, matchId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_GetReconciliationMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationMatch'
+type MockReconciliationStore_GetReconciliationMatch_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationMatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - matchId uuid.UUID
+func (_e *MockReconciliationStore_Expecter) GetReconciliationMatch(ctx interface{}, runId interface{}, matchId interface{}) *MockReconciliationStore_GetReconciliationMatch_Call {
+	return &MockReconciliationStore_GetReconciliationMatch_Call{Call: _e.mock.On("GetReconciliationMatch", ctx, runId, matchId)}
+}
+
+func (_c *MockReconciliationStore_GetReconciliationMatch_Call) Run(run func(ctx context.Context, runId uuid.UUID, matchId uuid.UUID)) *MockReconciliationStore_GetReconciliationMatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationMatch_Call) Return(_a0 models.ReconciliationMatch, _a1 error) *MockReconciliationStore_GetReconciliationMatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationMatch_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationMatch, error)) *MockReconciliationStore_GetReconciliationMatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationMatches provides a mock function with given fields: ctx, runId, filters
+func (_m *MockReconciliationStore) GetReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationMatches")
+	}
+
+	var r0 []models.ReconciliationMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) []models.ReconciliationMatch); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReconciliationMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_GetReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationMatches'
+type MockReconciliationStore_GetReconciliationMatches_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationMatchFilters
+func (_e *MockReconciliationStore_Expecter) GetReconciliationMatches(ctx interface{}, runId interface{}, filters interface{}) *MockReconciliationStore_GetReconciliationMatches_Call {
+	return &MockReconciliationStore_GetReconciliationMatches_Call{Call: _e.mock.On("GetReconciliationMatches", ctx, runId, filters)}
+}
+
+func (_c *MockReconciliationStore_GetReconciliationMatches_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters)) *MockReconciliationStore_GetReconciliationMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationMatchFilters))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationMatches_Call) Return(_a0 []models.ReconciliationMatch, _a1 error) *MockReconciliationStore_GetReconciliationMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error)) *MockReconciliationStore_GetReconciliationMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationRunById provides a mock function with given fields: ctx, runId
+func (_m *MockReconciliationStore) GetReconciliationRunById(ctx context.Context, runId uuid.UUID) (models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, runId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationRunById")
+	}
+
+	var r0 models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.ReconciliationRun, error)); ok {
+		return rf(ctx, runId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.ReconciliationRun); ok {
+		r0 = rf(ctx, runId)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, runId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_GetReconciliationRunById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationRunById'
+type MockReconciliationStore_GetReconciliationRunById_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationRunById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+func (_e *MockReconciliationStore_Expecter) GetReconciliationRunById(ctx interface{}, runId interface{}) *MockReconciliationStore_GetReconciliationRunById_Call {
+	return &MockReconciliationStore_GetReconciliationRunById_Call{Call: _e.mock.On("GetReconciliationRunById", ctx, runId)}
+}
+
+func (_c *MockReconciliationStore_GetReconciliationRunById_Call) Run(run func(ctx context.Context, runId uuid.UUID)) *MockReconciliationStore_GetReconciliationRunById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationRunById_Call) Return(_a0 models.ReconciliationRun, _a1 error) *MockReconciliationStore_GetReconciliationRunById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationRunById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.ReconciliationRun, error)) *MockReconciliationStore_GetReconciliationRunById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationRuns provides a mock function with given fields: ctx, reconciliationId, limit
+func (_m *MockReconciliationStore) GetReconciliationRuns(ctx context.Context, reconciliationId uuid.UUID, limit int) ([]models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationRuns")
+	}
+
+	var r0 []models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReconciliationRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, reconciliationId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_GetReconciliationRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationRuns'
+type MockReconciliationStore_GetReconciliationRuns_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - limit int
+func (_e *MockReconciliationStore_Expecter) GetReconciliationRuns(ctx interface{}, reconciliationId interface{}, limit interface{}) *MockReconciliationStore_GetReconciliationRuns_Call {
+	return &MockReconciliationStore_GetReconciliationRuns_Call{Call: _e.mock.On("GetReconciliationRuns", ctx, reconciliationId, limit)}
+}
+
+func (_c *MockReconciliationStore_GetReconciliationRuns_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, limit int)) *MockReconciliationStore_GetReconciliationRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationRuns_Call) Return(_a0 []models.ReconciliationRun, _a1 error) *MockReconciliationStore_GetReconciliationRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliationRuns_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]models.ReconciliationRun, error)) *MockReconciliationStore_GetReconciliationRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliations provides a mock function with given fields: ctx
+func (_m *MockReconciliationStore) GetReconciliations(ctx context.Context) ([]models.Reconciliation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliations")
+	}
+
+	var r0 []models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Reconciliation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Reconciliation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reconciliation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_GetReconciliations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliations'
+type MockReconciliationStore_GetReconciliations_Call struct {
+	*mock.Call
+}
+
+// GetReconciliations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReconciliationStore_Expecter) GetReconciliations(ctx interface{}) *MockReconciliationStore_GetReconciliations_Call {
+	return &MockReconciliationStore_GetReconciliations_Call{Call: _e.mock.On("GetReconciliations", ctx)}
+}
+
+func (_c *MockReconciliationStore_GetReconciliations_Call) Run(run func(ctx context.Context)) *MockReconciliationStore_GetReconciliations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliations_Call) Return(_a0 []models.Reconciliation, _a1 error) *MockReconciliationStore_GetReconciliations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_GetReconciliations_Call) RunAndReturn(run func(context.Context) ([]models.Reconciliation, error)) *MockReconciliationStore_GetReconciliations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenReconciliationExceptions provides a mock function with given fields: ctx, runId, side, rows
+func (_m *MockReconciliationStore) ReopenReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow) error {
+	ret := _m.Called(ctx, runId, side, rows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenReconciliationExceptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []models.ReconciliationRow) error); ok {
+		r0 = rf(ctx, runId, side, rows)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationStore_ReopenReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenReconciliationExceptions'
+type MockReconciliationStore_ReopenReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// ReopenReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - side string
+//   - rows []models.ReconciliationRow
+func (_e *MockReconciliationStore_Expecter) ReopenReconciliationExceptions(ctx interface{}, runId interface{}, side interface{}, rows interface{}) *MockReconciliationStore_ReopenReconciliationExceptions_Call {
+	return &MockReconciliationStore_ReopenReconciliationExceptions_Call{Call: _e.mock.On("ReopenReconciliationExceptions", ctx, runId, side, rows)}
+}
+
+func (_c *MockReconciliationStore_ReopenReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow)) *MockReconciliationStore_ReopenReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]models.ReconciliationRow))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_ReopenReconciliationExceptions_Call) Return(_a0 error) *MockReconciliationStore_ReopenReconciliationExceptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationStore_ReopenReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []models.ReconciliationRow) error) *MockReconciliationStore_ReopenReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveReconciliationExceptions provides a mock function with given fields: ctx, runId, side, rowIds, matchId
+func (_m *MockReconciliationStore) ResolveReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rowIds []string, matchId uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, runId, side, rowIds, matchId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveReconciliationExceptions")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, runId, side, rowIds, matchId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, uuid.UUID) int64); ok {
+		r0 = rf(ctx, runId, side, rowIds, matchId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []string, uuid.UUID) error); ok {
+		r1 = rf(ctx, runId, side, rowIds, matchId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_ResolveReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveReconciliationExceptions'
+type MockReconciliationStore_ResolveReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// ResolveReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - side string
+//   - rowIds []string
+//   - matchId uuid.UUID
+func (_e *MockReconciliationStore_Expecter) ResolveReconciliationExceptions(ctx interface{}, runId interface{}, side interface{}, rowIds interface{}, matchId interface{}) *MockReconciliationStore_ResolveReconciliationExceptions_Call {
+	return &MockReconciliationStore_ResolveReconciliationExceptions_Call{Call: _e.mock.On("ResolveReconciliationExceptions", ctx, runId, side, rowIds, matchId)}
+}
+
+func (_c *MockReconciliationStore_ResolveReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, side string, rowIds []string, matchId uuid.UUID)) *MockReconciliationStore_ResolveReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]string), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_ResolveReconciliationExceptions_Call) Return(_a0 int64, _a1 error) *MockReconciliationStore_ResolveReconciliationExceptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_ResolveReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []string, uuid.UUID) (int64, error)) *MockReconciliationStore_ResolveReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReconciliation provides a mock function with given fields: ctx, reconciliationId, params
+func (_m *MockReconciliationStore) UpdateReconciliation(ctx context.Context, reconciliationId uuid.UUID, params models.UpdateReconciliationParams) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, reconciliationId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReconciliation")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateReconciliationParams) (models.Reconciliation, error)); ok {
+		return rf(ctx, reconciliationId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateReconciliationParams) models.Reconciliation); ok {
+		r0 = rf(ctx, reconciliationId, params)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateReconciliationParams) error); ok {
+		r1 = rf(ctx, reconciliationId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReconciliationStore_UpdateReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliation'
+type MockReconciliationStore_UpdateReconciliation_Call struct {
+	*mock.Call
+}
+
+// UpdateReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - params models.UpdateReconciliationParams
+func (_e *MockReconciliationStore_Expecter) UpdateReconciliation(ctx interface{}, reconciliationId interface{}, params interface{}) *MockReconciliationStore_UpdateReconciliation_Call {
+	return &MockReconciliationStore_UpdateReconciliation_Call{Call: _e.mock.On("UpdateReconciliation", ctx, reconciliationId, params)}
+}
+
+func (_c *MockReconciliationStore_UpdateReconciliation_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, params models.UpdateReconciliationParams)) *MockReconciliationStore_UpdateReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateReconciliationParams))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_UpdateReconciliation_Call) Return(_a0 models.Reconciliation, _a1 error) *MockReconciliationStore_UpdateReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReconciliationStore_UpdateReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateReconciliationParams) (models.Reconciliation, error)) *MockReconciliationStore_UpdateReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReconciliationMatchStatus provides a mock function with given fields: ctx, runId, matchId, status, updatedBy
+func (_m *MockReconciliationStore) UpdateReconciliationMatchStatus(ctx context.Context, runId uuid.UUID, matchId uuid.UUID, status string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, runId, matchId, status, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReconciliationMatchStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, runId, matchId, status, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationStore_UpdateReconciliationMatchStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliationMatchStatus'
+type MockReconciliationStore_UpdateReconciliationMatchStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateReconciliationMatchStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - matchId uuid.UUID
+//   - status string
+//   - updatedBy uuid.UUID
+func (_e *MockReconciliationStore_Expecter) UpdateReconciliationMatchStatus(ctx interface{}, runId interface{}, matchId interface{}, status interface{}, updatedBy interface{}) *MockReconciliationStore_UpdateReconciliationMatchStatus_Call {
+	return &MockReconciliationStore_UpdateReconciliationMatchStatus_Call{Call: _e.mock.On("UpdateReconciliationMatchStatus", ctx, runId, matchId, status, updatedBy)}
+}
+
+func (_c *MockReconciliationStore_UpdateReconciliationMatchStatus_Call) Run(run func(ctx context.Context, runId uuid.UUID, matchId uuid.UUID, status string, updatedBy uuid.UUID)) *MockReconciliationStore_UpdateReconciliationMatchStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_UpdateReconciliationMatchStatus_Call) Return(_a0 error) *MockReconciliationStore_UpdateReconciliationMatchStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationStore_UpdateReconciliationMatchStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) error) *MockReconciliationStore_UpdateReconciliationMatchStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReconciliationRunWorkflowId provides a mock function with given fields: ctx, runId, workflowId
+func (_m *MockReconciliationStore) UpdateReconciliationRunWorkflowId(ctx context.Context, runId uuid.UUID, workflowId string) error {
+	ret := _m.Called(ctx, runId, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReconciliationRunWorkflowId")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, runId, workflowId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliationRunWorkflowId'
+type MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call struct {
+	*mock.Call
+}
+
+// UpdateReconciliationRunWorkflowId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - workflowId string
+func (_e *MockReconciliationStore_Expecter) UpdateReconciliationRunWorkflowId(ctx interface{}, runId interface{}, workflowId interface{}) *MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call {
+	return &MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call{Call: _e.mock.On("UpdateReconciliationRunWorkflowId", ctx, runId, workflowId)}
+}
+
+func (_c *MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call) Run(run func(ctx context.Context, runId uuid.UUID, workflowId string)) *MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call) Return(_a0 error) *MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *MockReconciliationStore_UpdateReconciliationRunWorkflowId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithReconciliationTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockReconciliationStore) WithReconciliationTransaction(ctx context.Context, fn func(store.ReconciliationStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithReconciliationTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.ReconciliationStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReconciliationStore_WithReconciliationTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithReconciliationTransaction'
+type MockReconciliationStore_WithReconciliationTransaction_Call struct {
+	*mock.Call
+}
+
+// WithReconciliationTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.ReconciliationStore) error
+func (_e *MockReconciliationStore_Expecter) WithReconciliationTransaction(ctx interface{}, fn interface{}) *MockReconciliationStore_WithReconciliationTransaction_Call {
+	return &MockReconciliationStore_WithReconciliationTransaction_Call{Call: _e.mock.On("WithReconciliationTransaction", ctx, fn)}
+}
+
+func (_c *MockReconciliationStore_WithReconciliationTransaction_Call) Run(run func(ctx context.Context, fn func(store.ReconciliationStore) error)) *MockReconciliationStore_WithReconciliationTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.ReconciliationStore) error))
+	})
+	return _c
+}
+
+func (_c *MockReconciliationStore_WithReconciliationTransaction_Call) Return(_a0 error) *MockReconciliationStore_WithReconciliationTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReconciliationStore_WithReconciliationTransaction_Call) RunAndReturn(run func(context.Context, func(store.ReconciliationStore) error) error) *MockReconciliationStore_WithReconciliationTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReconciliationStore creates a new instance of MockReconciliationStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReconciliationStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReconciliationStore {
+	mock := &MockReconciliationStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
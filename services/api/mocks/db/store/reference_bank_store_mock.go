@@ -0,0 +1,202 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockReferenceBankStore is an autogenerated mock type for the ReferenceBankStore type
+type MockReferenceBankStore struct {
+	mock.Mock
+}
+
+type MockReferenceBankStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReferenceBankStore) EXPECT() *MockReferenceBankStore_Expecter {
+	return &MockReferenceBankStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateReferenceBank provides a mock function with given fields: ctx, params
+func (_m *MockReferenceBankStore) CreateReferenceBank(ctx context.Context, params models.CreateReferenceBankParams) (models.ReferenceBank, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReferenceBank")
+	}
+
+	var r0 models.ReferenceBank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) models.ReferenceBank); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.ReferenceBank)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateReferenceBankParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferenceBankStore_CreateReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReferenceBank'
+type MockReferenceBankStore_CreateReferenceBank_Call struct {
+	*mock.Call
+}
+
+// CreateReferenceBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateReferenceBankParams
+func (_e *MockReferenceBankStore_Expecter) CreateReferenceBank(ctx interface{}, params interface{}) *MockReferenceBankStore_CreateReferenceBank_Call {
+	return &MockReferenceBankStore_CreateReferenceBank_Call{Call: _e.mock.On("CreateReferenceBank", ctx, params)}
+}
+
+func (_c *MockReferenceBankStore_CreateReferenceBank_Call) Run(run func(ctx context.Context, params models.CreateReferenceBankParams)) *MockReferenceBankStore_CreateReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateReferenceBankParams))
+	})
+	return _c
+}
+
+func (_c *MockReferenceBankStore_CreateReferenceBank_Call) Return(_a0 models.ReferenceBank, _a1 error) *MockReferenceBankStore_CreateReferenceBank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferenceBankStore_CreateReferenceBank_Call) RunAndReturn(run func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)) *MockReferenceBankStore_CreateReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReferenceBank provides a mock function with given fields: ctx, bankId, deletedBy
+func (_m *MockReferenceBankStore) DeleteReferenceBank(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, bankId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReferenceBank")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, bankId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReferenceBankStore_DeleteReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReferenceBank'
+type MockReferenceBankStore_DeleteReferenceBank_Call struct {
+	*mock.Call
+}
+
+// DeleteReferenceBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bankId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockReferenceBankStore_Expecter) DeleteReferenceBank(ctx interface{}, bankId interface{}, deletedBy interface{}) *MockReferenceBankStore_DeleteReferenceBank_Call {
+	return &MockReferenceBankStore_DeleteReferenceBank_Call{Call: _e.mock.On("DeleteReferenceBank", ctx, bankId, deletedBy)}
+}
+
+func (_c *MockReferenceBankStore_DeleteReferenceBank_Call) Run(run func(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID)) *MockReferenceBankStore_DeleteReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockReferenceBankStore_DeleteReferenceBank_Call) Return(_a0 error) *MockReferenceBankStore_DeleteReferenceBank_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferenceBankStore_DeleteReferenceBank_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockReferenceBankStore_DeleteReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferenceBanks provides a mock function with given fields: ctx
+func (_m *MockReferenceBankStore) GetReferenceBanks(ctx context.Context) ([]models.ReferenceBank, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferenceBanks")
+	}
+
+	var r0 []models.ReferenceBank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ReferenceBank, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ReferenceBank); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReferenceBank)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferenceBankStore_GetReferenceBanks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferenceBanks'
+type MockReferenceBankStore_GetReferenceBanks_Call struct {
+	*mock.Call
+}
+
+// GetReferenceBanks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReferenceBankStore_Expecter) GetReferenceBanks(ctx interface{}) *MockReferenceBankStore_GetReferenceBanks_Call {
+	return &MockReferenceBankStore_GetReferenceBanks_Call{Call: _e.mock.On("GetReferenceBanks", ctx)}
+}
+
+func (_c *MockReferenceBankStore_GetReferenceBanks_Call) Run(run func(ctx context.Context)) *MockReferenceBankStore_GetReferenceBanks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReferenceBankStore_GetReferenceBanks_Call) Return(_a0 []models.ReferenceBank, _a1 error) *MockReferenceBankStore_GetReferenceBanks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferenceBankStore_GetReferenceBanks_Call) RunAndReturn(run func(context.Context) ([]models.ReferenceBank, error)) *MockReferenceBankStore_GetReferenceBanks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReferenceBankStore creates a new instance of MockReferenceBankStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReferenceBankStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReferenceBankStore {
+	mock := &MockReferenceBankStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
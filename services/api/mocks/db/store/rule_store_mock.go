@@ -293,6 +293,55 @@ func (_c *MockRuleStore_GetRules_Call) RunAndReturn(run func(context.Context, mo
 	return _c
 }
 
+// SetRuleEnabled provides a mock function with given fields: ctx, ruleId, isEnabled, updatedBy
+func (_m *MockRuleStore) SetRuleEnabled(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, ruleId, isEnabled, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRuleEnabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, uuid.UUID) error); ok {
+		r0 = rf(ctx, ruleId, isEnabled, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRuleStore_SetRuleEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRuleEnabled'
+type MockRuleStore_SetRuleEnabled_Call struct {
+	*mock.Call
+}
+
+// SetRuleEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+//   - isEnabled bool
+//   - updatedBy uuid.UUID
+func (_e *MockRuleStore_Expecter) SetRuleEnabled(ctx interface{}, ruleId interface{}, isEnabled interface{}, updatedBy interface{}) *MockRuleStore_SetRuleEnabled_Call {
+	return &MockRuleStore_SetRuleEnabled_Call{Call: _e.mock.On("SetRuleEnabled", ctx, ruleId, isEnabled, updatedBy)}
+}
+
+func (_c *MockRuleStore_SetRuleEnabled_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID)) *MockRuleStore_SetRuleEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRuleStore_SetRuleEnabled_Call) Return(_a0 error) *MockRuleStore_SetRuleEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRuleStore_SetRuleEnabled_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, uuid.UUID) error) *MockRuleStore_SetRuleEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRule provides a mock function with given fields: ctx, ruleId, params
 func (_m *MockRuleStore) UpdateRule(ctx context.Context, ruleId uuid.UUID, params models.UpdateRuleParams) error {
 	ret := _m.Called(ctx, ruleId, params)
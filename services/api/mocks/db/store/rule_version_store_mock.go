@@ -0,0 +1,322 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockRuleVersionStore is an autogenerated mock type for the RuleVersionStore type
+type MockRuleVersionStore struct {
+	mock.Mock
+}
+
+type MockRuleVersionStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRuleVersionStore) EXPECT() *MockRuleVersionStore_Expecter {
+	return &MockRuleVersionStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateRuleRuns provides a mock function with given fields: ctx, params
+func (_m *MockRuleVersionStore) CreateRuleRuns(ctx context.Context, params []models.CreateRuleRunParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRuleRuns")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.CreateRuleRunParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRuleVersionStore_CreateRuleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRuleRuns'
+type MockRuleVersionStore_CreateRuleRuns_Call struct {
+	*mock.Call
+}
+
+// CreateRuleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params []models.CreateRuleRunParams
+func (_e *MockRuleVersionStore_Expecter) CreateRuleRuns(ctx interface{}, params interface{}) *MockRuleVersionStore_CreateRuleRuns_Call {
+	return &MockRuleVersionStore_CreateRuleRuns_Call{Call: _e.mock.On("CreateRuleRuns", ctx, params)}
+}
+
+func (_c *MockRuleVersionStore_CreateRuleRuns_Call) Run(run func(ctx context.Context, params []models.CreateRuleRunParams)) *MockRuleVersionStore_CreateRuleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.CreateRuleRunParams))
+	})
+	return _c
+}
+
+func (_c *MockRuleVersionStore_CreateRuleRuns_Call) Return(_a0 error) *MockRuleVersionStore_CreateRuleRuns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRuleVersionStore_CreateRuleRuns_Call) RunAndReturn(run func(context.Context, []models.CreateRuleRunParams) error) *MockRuleVersionStore_CreateRuleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRuleVersion provides a mock function with given fields: ctx, ruleId, changeType, createdBy
+func (_m *MockRuleVersionStore) CreateRuleVersion(ctx context.Context, ruleId uuid.UUID, changeType models.RuleChangeType, createdBy uuid.UUID) (models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId, changeType, createdBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRuleVersion")
+	}
+
+	var r0 models.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) (models.RuleVersion, error)); ok {
+		return rf(ctx, ruleId, changeType, createdBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) models.RuleVersion); ok {
+		r0 = rf(ctx, ruleId, changeType, createdBy)
+	} else {
+		r0 = ret.Get(0).(models.RuleVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) error); ok {
+		r1 = rf(ctx, ruleId, changeType, createdBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleVersionStore_CreateRuleVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRuleVersion'
+type MockRuleVersionStore_CreateRuleVersion_Call struct {
+	*mock.Call
+}
+
+// CreateRuleVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+//   - changeType models.RuleChangeType
+//   - createdBy uuid.UUID
+func (_e *MockRuleVersionStore_Expecter) CreateRuleVersion(ctx interface{}, ruleId interface{}, changeType interface{}, createdBy interface{}) *MockRuleVersionStore_CreateRuleVersion_Call {
+	return &MockRuleVersionStore_CreateRuleVersion_Call{Call: _e.mock.On("CreateRuleVersion", ctx, ruleId, changeType, createdBy)}
+}
+
+func (_c *MockRuleVersionStore_CreateRuleVersion_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, changeType models.RuleChangeType, createdBy uuid.UUID)) *MockRuleVersionStore_CreateRuleVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.RuleChangeType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRuleVersionStore_CreateRuleVersion_Call) Return(_a0 models.RuleVersion, _a1 error) *MockRuleVersionStore_CreateRuleVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleVersionStore_CreateRuleVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) (models.RuleVersion, error)) *MockRuleVersionStore_CreateRuleVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuleRuns provides a mock function with given fields: ctx, ruleIds, limit
+func (_m *MockRuleVersionStore) GetRuleRuns(ctx context.Context, ruleIds []uuid.UUID, limit int) ([]models.RuleRun, error) {
+	ret := _m.Called(ctx, ruleIds, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleRuns")
+	}
+
+	var r0 []models.RuleRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) ([]models.RuleRun, error)); ok {
+		return rf(ctx, ruleIds, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) []models.RuleRun); ok {
+		r0 = rf(ctx, ruleIds, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RuleRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, int) error); ok {
+		r1 = rf(ctx, ruleIds, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleVersionStore_GetRuleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleRuns'
+type MockRuleVersionStore_GetRuleRuns_Call struct {
+	*mock.Call
+}
+
+// GetRuleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleIds []uuid.UUID
+//   - limit int
+func (_e *MockRuleVersionStore_Expecter) GetRuleRuns(ctx interface{}, ruleIds interface{}, limit interface{}) *MockRuleVersionStore_GetRuleRuns_Call {
+	return &MockRuleVersionStore_GetRuleRuns_Call{Call: _e.mock.On("GetRuleRuns", ctx, ruleIds, limit)}
+}
+
+func (_c *MockRuleVersionStore_GetRuleRuns_Call) Run(run func(ctx context.Context, ruleIds []uuid.UUID, limit int)) *MockRuleVersionStore_GetRuleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRuleVersionStore_GetRuleRuns_Call) Return(_a0 []models.RuleRun, _a1 error) *MockRuleVersionStore_GetRuleRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleVersionStore_GetRuleRuns_Call) RunAndReturn(run func(context.Context, []uuid.UUID, int) ([]models.RuleRun, error)) *MockRuleVersionStore_GetRuleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuleVersion provides a mock function with given fields: ctx, ruleId, version
+func (_m *MockRuleVersionStore) GetRuleVersion(ctx context.Context, ruleId uuid.UUID, version int) (models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleVersion")
+	}
+
+	var r0 models.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (models.RuleVersion, error)); ok {
+		return rf(ctx, ruleId, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) models.RuleVersion); ok {
+		r0 = rf(ctx, ruleId, version)
+	} else {
+		r0 = ret.Get(0).(models.RuleVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, ruleId, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleVersionStore_GetRuleVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleVersion'
+type MockRuleVersionStore_GetRuleVersion_Call struct {
+	*mock.Call
+}
+
+// GetRuleVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+//   - version int
+func (_e *MockRuleVersionStore_Expecter) GetRuleVersion(ctx interface{}, ruleId interface{}, version interface{}) *MockRuleVersionStore_GetRuleVersion_Call {
+	return &MockRuleVersionStore_GetRuleVersion_Call{Call: _e.mock.On("GetRuleVersion", ctx, ruleId, version)}
+}
+
+func (_c *MockRuleVersionStore_GetRuleVersion_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, version int)) *MockRuleVersionStore_GetRuleVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRuleVersionStore_GetRuleVersion_Call) Return(_a0 models.RuleVersion, _a1 error) *MockRuleVersionStore_GetRuleVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleVersionStore_GetRuleVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) (models.RuleVersion, error)) *MockRuleVersionStore_GetRuleVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuleVersions provides a mock function with given fields: ctx, ruleId
+func (_m *MockRuleVersionStore) GetRuleVersions(ctx context.Context, ruleId uuid.UUID) ([]models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuleVersions")
+	}
+
+	var r0 []models.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.RuleVersion, error)); ok {
+		return rf(ctx, ruleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.RuleVersion); ok {
+		r0 = rf(ctx, ruleId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RuleVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ruleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRuleVersionStore_GetRuleVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleVersions'
+type MockRuleVersionStore_GetRuleVersions_Call struct {
+	*mock.Call
+}
+
+// GetRuleVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+func (_e *MockRuleVersionStore_Expecter) GetRuleVersions(ctx interface{}, ruleId interface{}) *MockRuleVersionStore_GetRuleVersions_Call {
+	return &MockRuleVersionStore_GetRuleVersions_Call{Call: _e.mock.On("GetRuleVersions", ctx, ruleId)}
+}
+
+func (_c *MockRuleVersionStore_GetRuleVersions_Call) Run(run func(ctx context.Context, ruleId uuid.UUID)) *MockRuleVersionStore_GetRuleVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockRuleVersionStore_GetRuleVersions_Call) Return(_a0 []models.RuleVersion, _a1 error) *MockRuleVersionStore_GetRuleVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRuleVersionStore_GetRuleVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.RuleVersion, error)) *MockRuleVersionStore_GetRuleVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRuleVersionStore creates a new instance of MockRuleVersionStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRuleVersionStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRuleVersionStore {
+	mock := &MockRuleVersionStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
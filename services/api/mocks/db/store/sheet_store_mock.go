@@ -83,6 +83,53 @@ func (_c *MockSheetStore_CreateSheet_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DeleteSheet provides a mock function with given fields: ctx, sheetId
+func (_m *MockSheetStore) DeleteSheet(ctx context.Context, sheetId uuid.UUID) error {
+	ret := _m.Called(ctx, sheetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSheet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sheetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSheetStore_DeleteSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSheet'
+type MockSheetStore_DeleteSheet_Call struct {
+	*mock.Call
+}
+
+// DeleteSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+func (_e *MockSheetStore_Expecter) DeleteSheet(ctx interface{}, sheetId interface{}) *MockSheetStore_DeleteSheet_Call {
+	return &MockSheetStore_DeleteSheet_Call{Call: _e.mock.On("DeleteSheet", ctx, sheetId)}
+}
+
+func (_c *MockSheetStore_DeleteSheet_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockSheetStore_DeleteSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockSheetStore_DeleteSheet_Call) Return(_a0 error) *MockSheetStore_DeleteSheet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSheetStore_DeleteSheet_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockSheetStore_DeleteSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSheetById provides a mock function with given fields: ctx, sheetId
 func (_m *MockSheetStore) GetSheetById(ctx context.Context, sheetId uuid.UUID) (*models.Sheet, error) {
 	ret := _m.Called(ctx, sheetId)
@@ -12,6 +12,8 @@ import (
 
 	store "github.com/Zampfi/application-platform/services/api/db/store"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -28,6 +30,334 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
+// AdjustReconciliationRunCounts provides a mock function with given fields: ctx, runId, deltas
+func (_m *MockStore) AdjustReconciliationRunCounts(ctx context.Context, runId uuid.UUID, deltas models.ReconciliationRunCountDeltas) error {
+	ret := _m.Called(ctx, runId, deltas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustReconciliationRunCounts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationRunCountDeltas) error); ok {
+		r0 = rf(ctx, runId, deltas)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_AdjustReconciliationRunCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustReconciliationRunCounts'
+type MockStore_AdjustReconciliationRunCounts_Call struct {
+	*mock.Call
+}
+
+// AdjustReconciliationRunCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - deltas models.ReconciliationRunCountDeltas
+func (_e *MockStore_Expecter) AdjustReconciliationRunCounts(ctx interface{}, runId interface{}, deltas interface{}) *MockStore_AdjustReconciliationRunCounts_Call {
+	return &MockStore_AdjustReconciliationRunCounts_Call{Call: _e.mock.On("AdjustReconciliationRunCounts", ctx, runId, deltas)}
+}
+
+func (_c *MockStore_AdjustReconciliationRunCounts_Call) Run(run func(ctx context.Context, runId uuid.UUID, deltas models.ReconciliationRunCountDeltas)) *MockStore_AdjustReconciliationRunCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationRunCountDeltas))
+	})
+	return _c
+}
+
+func (_c *MockStore_AdjustReconciliationRunCounts_Call) Return(_a0 error) *MockStore_AdjustReconciliationRunCounts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_AdjustReconciliationRunCounts_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationRunCountDeltas) error) *MockStore_AdjustReconciliationRunCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteReconciliationRun provides a mock function with given fields: ctx, runId, params
+func (_m *MockStore) CompleteReconciliationRun(ctx context.Context, runId uuid.UUID, params models.CompleteReconciliationRunParams) error {
+	ret := _m.Called(ctx, runId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteReconciliationRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.CompleteReconciliationRunParams) error); ok {
+		r0 = rf(ctx, runId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CompleteReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteReconciliationRun'
+type MockStore_CompleteReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// CompleteReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - params models.CompleteReconciliationRunParams
+func (_e *MockStore_Expecter) CompleteReconciliationRun(ctx interface{}, runId interface{}, params interface{}) *MockStore_CompleteReconciliationRun_Call {
+	return &MockStore_CompleteReconciliationRun_Call{Call: _e.mock.On("CompleteReconciliationRun", ctx, runId, params)}
+}
+
+func (_c *MockStore_CompleteReconciliationRun_Call) Run(run func(ctx context.Context, runId uuid.UUID, params models.CompleteReconciliationRunParams)) *MockStore_CompleteReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.CompleteReconciliationRunParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CompleteReconciliationRun_Call) Return(_a0 error) *MockStore_CompleteReconciliationRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CompleteReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.CompleteReconciliationRunParams) error) *MockStore_CompleteReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountFxRates provides a mock function with given fields: ctx, filters
+func (_m *MockStore) CountFxRates(ctx context.Context, filters models.FxRateFilters) (int64, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountFxRates")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) (int64, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) int64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FxRateFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountFxRates'
+type MockStore_CountFxRates_Call struct {
+	*mock.Call
+}
+
+// CountFxRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FxRateFilters
+func (_e *MockStore_Expecter) CountFxRates(ctx interface{}, filters interface{}) *MockStore_CountFxRates_Call {
+	return &MockStore_CountFxRates_Call{Call: _e.mock.On("CountFxRates", ctx, filters)}
+}
+
+func (_c *MockStore_CountFxRates_Call) Run(run func(ctx context.Context, filters models.FxRateFilters)) *MockStore_CountFxRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FxRateFilters))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountFxRates_Call) Return(_a0 int64, _a1 error) *MockStore_CountFxRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountFxRates_Call) RunAndReturn(run func(context.Context, models.FxRateFilters) (int64, error)) *MockStore_CountFxRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReconciliationExceptions provides a mock function with given fields: ctx, runId, filters
+func (_m *MockStore) CountReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) (int64, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReconciliationExceptions")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) (int64, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) int64); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReconciliationExceptions'
+type MockStore_CountReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// CountReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationExceptionFilters
+func (_e *MockStore_Expecter) CountReconciliationExceptions(ctx interface{}, runId interface{}, filters interface{}) *MockStore_CountReconciliationExceptions_Call {
+	return &MockStore_CountReconciliationExceptions_Call{Call: _e.mock.On("CountReconciliationExceptions", ctx, runId, filters)}
+}
+
+func (_c *MockStore_CountReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters)) *MockStore_CountReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationExceptionFilters))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountReconciliationExceptions_Call) Return(_a0 int64, _a1 error) *MockStore_CountReconciliationExceptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) (int64, error)) *MockStore_CountReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountReconciliationMatches provides a mock function with given fields: ctx, runId, filters
+func (_m *MockStore) CountReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) (int64, error) {
+	ret := _m.Called(ctx, runId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountReconciliationMatches")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) (int64, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) int64); ok {
+		r0 = rf(ctx, runId, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CountReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountReconciliationMatches'
+type MockStore_CountReconciliationMatches_Call struct {
+	*mock.Call
+}
+
+// CountReconciliationMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - filters models.ReconciliationMatchFilters
+func (_e *MockStore_Expecter) CountReconciliationMatches(ctx interface{}, runId interface{}, filters interface{}) *MockStore_CountReconciliationMatches_Call {
+	return &MockStore_CountReconciliationMatches_Call{Call: _e.mock.On("CountReconciliationMatches", ctx, runId, filters)}
+}
+
+func (_c *MockStore_CountReconciliationMatches_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters)) *MockStore_CountReconciliationMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationMatchFilters))
+	})
+	return _c
+}
+
+func (_c *MockStore_CountReconciliationMatches_Call) Return(_a0 int64, _a1 error) *MockStore_CountReconciliationMatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CountReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) (int64, error)) *MockStore_CountReconciliationMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAuditLog provides a mock function with given fields: ctx, auditLog
+func (_m *MockStore) CreateAuditLog(ctx context.Context, auditLog models.AuditLog) (*models.AuditLog, error) {
+	ret := _m.Called(ctx, auditLog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditLog")
+	}
+
+	var r0 *models.AuditLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditLog) (*models.AuditLog, error)); ok {
+		return rf(ctx, auditLog)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.AuditLog) *models.AuditLog); ok {
+		r0 = rf(ctx, auditLog)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.AuditLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.AuditLog) error); ok {
+		r1 = rf(ctx, auditLog)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateAuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditLog'
+type MockStore_CreateAuditLog_Call struct {
+	*mock.Call
+}
+
+// CreateAuditLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auditLog models.AuditLog
+func (_e *MockStore_Expecter) CreateAuditLog(ctx interface{}, auditLog interface{}) *MockStore_CreateAuditLog_Call {
+	return &MockStore_CreateAuditLog_Call{Call: _e.mock.On("CreateAuditLog", ctx, auditLog)}
+}
+
+func (_c *MockStore_CreateAuditLog_Call) Run(run func(ctx context.Context, auditLog models.AuditLog)) *MockStore_CreateAuditLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.AuditLog))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateAuditLog_Call) Return(_a0 *models.AuditLog, _a1 error) *MockStore_CreateAuditLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateAuditLog_Call) RunAndReturn(run func(context.Context, models.AuditLog) (*models.AuditLog, error)) *MockStore_CreateAuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateConnection provides a mock function with given fields: ctx, connection
 func (_m *MockStore) CreateConnection(ctx context.Context, connection *models.CreateConnectionParams) (uuid.UUID, error) {
 	ret := _m.Called(ctx, connection)
@@ -303,29 +633,27 @@ func (_c *MockStore_CreateDatasetAction_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// CreateDatasetFileUpload provides a mock function with given fields: ctx, datasetFileUpload
-func (_m *MockStore) CreateDatasetFileUpload(ctx context.Context, datasetFileUpload *models.DatasetFileUpload) (*models.DatasetFileUpload, error) {
-	ret := _m.Called(ctx, datasetFileUpload)
+// CreateDatasetAlert provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateDatasetAlert(ctx context.Context, params models.CreateDatasetAlertParams) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDatasetFileUpload")
+		panic("no return value specified for CreateDatasetAlert")
 	}
 
-	var r0 *models.DatasetFileUpload
+	var r0 models.DatasetAlert
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.DatasetFileUpload) (*models.DatasetFileUpload, error)); ok {
-		return rf(ctx, datasetFileUpload)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertParams) (models.DatasetAlert, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.DatasetFileUpload) *models.DatasetFileUpload); ok {
-		r0 = rf(ctx, datasetFileUpload)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertParams) models.DatasetAlert); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.DatasetFileUpload)
-		}
+		r0 = ret.Get(0).(models.DatasetAlert)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.DatasetFileUpload) error); ok {
-		r1 = rf(ctx, datasetFileUpload)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetAlertParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -333,58 +661,56 @@ func (_m *MockStore) CreateDatasetFileUpload(ctx context.Context, datasetFileUpl
 	return r0, r1
 }
 
-// MockStore_CreateDatasetFileUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetFileUpload'
-type MockStore_CreateDatasetFileUpload_Call struct {
+// MockStore_CreateDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetAlert'
+type MockStore_CreateDatasetAlert_Call struct {
 	*mock.Call
 }
 
-// CreateDatasetFileUpload is a helper method to define mock.On call
+// CreateDatasetAlert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetFileUpload *models.DatasetFileUpload
-func (_e *MockStore_Expecter) CreateDatasetFileUpload(ctx interface{}, datasetFileUpload interface{}) *MockStore_CreateDatasetFileUpload_Call {
-	return &MockStore_CreateDatasetFileUpload_Call{Call: _e.mock.On("CreateDatasetFileUpload", ctx, datasetFileUpload)}
+//   - params models.CreateDatasetAlertParams
+func (_e *MockStore_Expecter) CreateDatasetAlert(ctx interface{}, params interface{}) *MockStore_CreateDatasetAlert_Call {
+	return &MockStore_CreateDatasetAlert_Call{Call: _e.mock.On("CreateDatasetAlert", ctx, params)}
 }
 
-func (_c *MockStore_CreateDatasetFileUpload_Call) Run(run func(ctx context.Context, datasetFileUpload *models.DatasetFileUpload)) *MockStore_CreateDatasetFileUpload_Call {
+func (_c *MockStore_CreateDatasetAlert_Call) Run(run func(ctx context.Context, params models.CreateDatasetAlertParams)) *MockStore_CreateDatasetAlert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.DatasetFileUpload))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetAlertParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateDatasetFileUpload_Call) Return(_a0 *models.DatasetFileUpload, _a1 error) *MockStore_CreateDatasetFileUpload_Call {
+func (_c *MockStore_CreateDatasetAlert_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockStore_CreateDatasetAlert_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateDatasetFileUpload_Call) RunAndReturn(run func(context.Context, *models.DatasetFileUpload) (*models.DatasetFileUpload, error)) *MockStore_CreateDatasetFileUpload_Call {
+func (_c *MockStore_CreateDatasetAlert_Call) RunAndReturn(run func(context.Context, models.CreateDatasetAlertParams) (models.DatasetAlert, error)) *MockStore_CreateDatasetAlert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId, privilege
-func (_m *MockStore) CreateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId, audienceType, audienceId, privilege)
+// CreateDatasetAlertEvent provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateDatasetAlertEvent(ctx context.Context, params models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDatasetPolicy")
+		panic("no return value specified for CreateDatasetAlertEvent")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
+	var r0 models.DatasetAlertEvent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId, audienceType, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId, audienceType, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetAlertEventParams) models.DatasetAlertEvent); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Get(0).(models.DatasetAlertEvent)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, datasetId, audienceType, audienceId, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetAlertEventParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -392,61 +718,56 @@ func (_m *MockStore) CreateDatasetPolicy(ctx context.Context, datasetId uuid.UUI
 	return r0, r1
 }
 
-// MockStore_CreateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetPolicy'
-type MockStore_CreateDatasetPolicy_Call struct {
+// MockStore_CreateDatasetAlertEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetAlertEvent'
+type MockStore_CreateDatasetAlertEvent_Call struct {
 	*mock.Call
 }
 
-// CreateDatasetPolicy is a helper method to define mock.On call
+// CreateDatasetAlertEvent is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-//   - audienceType models.AudienceType
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockStore_Expecter) CreateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockStore_CreateDatasetPolicy_Call {
-	return &MockStore_CreateDatasetPolicy_Call{Call: _e.mock.On("CreateDatasetPolicy", ctx, datasetId, audienceType, audienceId, privilege)}
+//   - params models.CreateDatasetAlertEventParams
+func (_e *MockStore_Expecter) CreateDatasetAlertEvent(ctx interface{}, params interface{}) *MockStore_CreateDatasetAlertEvent_Call {
+	return &MockStore_CreateDatasetAlertEvent_Call{Call: _e.mock.On("CreateDatasetAlertEvent", ctx, params)}
 }
 
-func (_c *MockStore_CreateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_CreateDatasetPolicy_Call {
+func (_c *MockStore_CreateDatasetAlertEvent_Call) Run(run func(ctx context.Context, params models.CreateDatasetAlertEventParams)) *MockStore_CreateDatasetAlertEvent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetAlertEventParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_CreateDatasetPolicy_Call {
+func (_c *MockStore_CreateDatasetAlertEvent_Call) Return(_a0 models.DatasetAlertEvent, _a1 error) *MockStore_CreateDatasetAlertEvent_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_CreateDatasetPolicy_Call {
+func (_c *MockStore_CreateDatasetAlertEvent_Call) RunAndReturn(run func(context.Context, models.CreateDatasetAlertEventParams) (models.DatasetAlertEvent, error)) *MockStore_CreateDatasetAlertEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateFileUpload provides a mock function with given fields: ctx, fileUpload
-func (_m *MockStore) CreateFileUpload(ctx context.Context, fileUpload *models.FileUpload) (*models.FileUpload, error) {
-	ret := _m.Called(ctx, fileUpload)
+// CreateDatasetColumnPolicy provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateDatasetColumnPolicy(ctx context.Context, params models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateFileUpload")
+		panic("no return value specified for CreateDatasetColumnPolicy")
 	}
 
-	var r0 *models.FileUpload
+	var r0 models.DatasetColumnPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.FileUpload) (*models.FileUpload, error)); ok {
-		return rf(ctx, fileUpload)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.FileUpload) *models.FileUpload); ok {
-		r0 = rf(ctx, fileUpload)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetColumnPolicyParams) models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.FileUpload)
-		}
+		r0 = ret.Get(0).(models.DatasetColumnPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.FileUpload) error); ok {
-		r1 = rf(ctx, fileUpload)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetColumnPolicyParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -454,58 +775,56 @@ func (_m *MockStore) CreateFileUpload(ctx context.Context, fileUpload *models.Fi
 	return r0, r1
 }
 
-// MockStore_CreateFileUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFileUpload'
-type MockStore_CreateFileUpload_Call struct {
+// MockStore_CreateDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetColumnPolicy'
+type MockStore_CreateDatasetColumnPolicy_Call struct {
 	*mock.Call
 }
 
-// CreateFileUpload is a helper method to define mock.On call
+// CreateDatasetColumnPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fileUpload *models.FileUpload
-func (_e *MockStore_Expecter) CreateFileUpload(ctx interface{}, fileUpload interface{}) *MockStore_CreateFileUpload_Call {
-	return &MockStore_CreateFileUpload_Call{Call: _e.mock.On("CreateFileUpload", ctx, fileUpload)}
+//   - params models.CreateDatasetColumnPolicyParams
+func (_e *MockStore_Expecter) CreateDatasetColumnPolicy(ctx interface{}, params interface{}) *MockStore_CreateDatasetColumnPolicy_Call {
+	return &MockStore_CreateDatasetColumnPolicy_Call{Call: _e.mock.On("CreateDatasetColumnPolicy", ctx, params)}
 }
 
-func (_c *MockStore_CreateFileUpload_Call) Run(run func(ctx context.Context, fileUpload *models.FileUpload)) *MockStore_CreateFileUpload_Call {
+func (_c *MockStore_CreateDatasetColumnPolicy_Call) Run(run func(ctx context.Context, params models.CreateDatasetColumnPolicyParams)) *MockStore_CreateDatasetColumnPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.FileUpload))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetColumnPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateFileUpload_Call) Return(_a0 *models.FileUpload, _a1 error) *MockStore_CreateFileUpload_Call {
+func (_c *MockStore_CreateDatasetColumnPolicy_Call) Return(_a0 models.DatasetColumnPolicy, _a1 error) *MockStore_CreateDatasetColumnPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateFileUpload_Call) RunAndReturn(run func(context.Context, *models.FileUpload) (*models.FileUpload, error)) *MockStore_CreateFileUpload_Call {
+func (_c *MockStore_CreateDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, models.CreateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)) *MockStore_CreateDatasetColumnPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrganization provides a mock function with given fields: ctx, name, description, ownerId
-func (_m *MockStore) CreateOrganization(ctx context.Context, name string, description *string, ownerId uuid.UUID) (*models.Organization, error) {
-	ret := _m.Called(ctx, name, description, ownerId)
+// CreateDatasetExportSchedule provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateDatasetExportSchedule(ctx context.Context, params models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateOrganization")
+		panic("no return value specified for CreateDatasetExportSchedule")
 	}
 
-	var r0 *models.Organization
+	var r0 models.DatasetExportSchedule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)); ok {
-		return rf(ctx, name, description, ownerId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) *models.Organization); ok {
-		r0 = rf(ctx, name, description, ownerId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleParams) models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Organization)
-		}
+		r0 = ret.Get(0).(models.DatasetExportSchedule)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, *string, uuid.UUID) error); ok {
-		r1 = rf(ctx, name, description, ownerId)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetExportScheduleParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -513,60 +832,56 @@ func (_m *MockStore) CreateOrganization(ctx context.Context, name string, descri
 	return r0, r1
 }
 
-// MockStore_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
-type MockStore_CreateOrganization_Call struct {
+// MockStore_CreateDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetExportSchedule'
+type MockStore_CreateDatasetExportSchedule_Call struct {
 	*mock.Call
 }
 
-// CreateOrganization is a helper method to define mock.On call
+// CreateDatasetExportSchedule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-//   - description *string
-//   - ownerId uuid.UUID
-func (_e *MockStore_Expecter) CreateOrganization(ctx interface{}, name interface{}, description interface{}, ownerId interface{}) *MockStore_CreateOrganization_Call {
-	return &MockStore_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, name, description, ownerId)}
+//   - params models.CreateDatasetExportScheduleParams
+func (_e *MockStore_Expecter) CreateDatasetExportSchedule(ctx interface{}, params interface{}) *MockStore_CreateDatasetExportSchedule_Call {
+	return &MockStore_CreateDatasetExportSchedule_Call{Call: _e.mock.On("CreateDatasetExportSchedule", ctx, params)}
 }
 
-func (_c *MockStore_CreateOrganization_Call) Run(run func(ctx context.Context, name string, description *string, ownerId uuid.UUID)) *MockStore_CreateOrganization_Call {
+func (_c *MockStore_CreateDatasetExportSchedule_Call) Run(run func(ctx context.Context, params models.CreateDatasetExportScheduleParams)) *MockStore_CreateDatasetExportSchedule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*string), args[3].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetExportScheduleParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateOrganization_Call) Return(_a0 *models.Organization, _a1 error) *MockStore_CreateOrganization_Call {
+func (_c *MockStore_CreateDatasetExportSchedule_Call) Return(_a0 models.DatasetExportSchedule, _a1 error) *MockStore_CreateDatasetExportSchedule_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateOrganization_Call) RunAndReturn(run func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)) *MockStore_CreateOrganization_Call {
+func (_c *MockStore_CreateDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, models.CreateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)) *MockStore_CreateDatasetExportSchedule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrganizationInvitation provides a mock function with given fields: ctx, organizationId, targetEmail, privilege
-func (_m *MockStore) CreateOrganizationInvitation(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege) (*models.OrganizationInvitation, error) {
-	ret := _m.Called(ctx, organizationId, targetEmail, privilege)
+// CreateDatasetExportScheduleRun provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateDatasetExportScheduleRun(ctx context.Context, params models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateOrganizationInvitation")
+		panic("no return value specified for CreateDatasetExportScheduleRun")
 	}
 
-	var r0 *models.OrganizationInvitation
+	var r0 models.DatasetExportScheduleRun
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)); ok {
-		return rf(ctx, organizationId, targetEmail, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) *models.OrganizationInvitation); ok {
-		r0 = rf(ctx, organizationId, targetEmail, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetExportScheduleRunParams) models.DatasetExportScheduleRun); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.OrganizationInvitation)
-		}
+		r0 = ret.Get(0).(models.DatasetExportScheduleRun)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, organizationId, targetEmail, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetExportScheduleRunParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -574,60 +889,58 @@ func (_m *MockStore) CreateOrganizationInvitation(ctx context.Context, organizat
 	return r0, r1
 }
 
-// MockStore_CreateOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitation'
-type MockStore_CreateOrganizationInvitation_Call struct {
+// MockStore_CreateDatasetExportScheduleRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetExportScheduleRun'
+type MockStore_CreateDatasetExportScheduleRun_Call struct {
 	*mock.Call
 }
 
-// CreateOrganizationInvitation is a helper method to define mock.On call
+// CreateDatasetExportScheduleRun is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - targetEmail string
-//   - privilege models.ResourcePrivilege
-func (_e *MockStore_Expecter) CreateOrganizationInvitation(ctx interface{}, organizationId interface{}, targetEmail interface{}, privilege interface{}) *MockStore_CreateOrganizationInvitation_Call {
-	return &MockStore_CreateOrganizationInvitation_Call{Call: _e.mock.On("CreateOrganizationInvitation", ctx, organizationId, targetEmail, privilege)}
+//   - params models.CreateDatasetExportScheduleRunParams
+func (_e *MockStore_Expecter) CreateDatasetExportScheduleRun(ctx interface{}, params interface{}) *MockStore_CreateDatasetExportScheduleRun_Call {
+	return &MockStore_CreateDatasetExportScheduleRun_Call{Call: _e.mock.On("CreateDatasetExportScheduleRun", ctx, params)}
 }
 
-func (_c *MockStore_CreateOrganizationInvitation_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege)) *MockStore_CreateOrganizationInvitation_Call {
+func (_c *MockStore_CreateDatasetExportScheduleRun_Call) Run(run func(ctx context.Context, params models.CreateDatasetExportScheduleRunParams)) *MockStore_CreateDatasetExportScheduleRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetExportScheduleRunParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationInvitation_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockStore_CreateOrganizationInvitation_Call {
+func (_c *MockStore_CreateDatasetExportScheduleRun_Call) Return(_a0 models.DatasetExportScheduleRun, _a1 error) *MockStore_CreateDatasetExportScheduleRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)) *MockStore_CreateOrganizationInvitation_Call {
+func (_c *MockStore_CreateDatasetExportScheduleRun_Call) RunAndReturn(run func(context.Context, models.CreateDatasetExportScheduleRunParams) (models.DatasetExportScheduleRun, error)) *MockStore_CreateDatasetExportScheduleRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
-func (_m *MockStore) CreateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
-	ret := _m.Called(ctx, invitationId, status)
+// CreateDatasetFileUpload provides a mock function with given fields: ctx, datasetFileUpload
+func (_m *MockStore) CreateDatasetFileUpload(ctx context.Context, datasetFileUpload *models.DatasetFileUpload) (*models.DatasetFileUpload, error) {
+	ret := _m.Called(ctx, datasetFileUpload)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateOrganizationInvitationStatus")
+		panic("no return value specified for CreateDatasetFileUpload")
 	}
 
-	var r0 *models.OrganizationInvitationStatus
+	var r0 *models.DatasetFileUpload
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
-		return rf(ctx, invitationId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.DatasetFileUpload) (*models.DatasetFileUpload, error)); ok {
+		return rf(ctx, datasetFileUpload)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
-		r0 = rf(ctx, invitationId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.DatasetFileUpload) *models.DatasetFileUpload); ok {
+		r0 = rf(ctx, datasetFileUpload)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
+			r0 = ret.Get(0).(*models.DatasetFileUpload)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
-		r1 = rf(ctx, invitationId, status)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.DatasetFileUpload) error); ok {
+		r1 = rf(ctx, datasetFileUpload)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -635,59 +948,58 @@ func (_m *MockStore) CreateOrganizationInvitationStatus(ctx context.Context, inv
 	return r0, r1
 }
 
-// MockStore_CreateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitationStatus'
-type MockStore_CreateOrganizationInvitationStatus_Call struct {
+// MockStore_CreateDatasetFileUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetFileUpload'
+type MockStore_CreateDatasetFileUpload_Call struct {
 	*mock.Call
 }
 
-// CreateOrganizationInvitationStatus is a helper method to define mock.On call
+// CreateDatasetFileUpload is a helper method to define mock.On call
 //   - ctx context.Context
-//   - invitationId uuid.UUID
-//   - status models.InvitationStatus
-func (_e *MockStore_Expecter) CreateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockStore_CreateOrganizationInvitationStatus_Call {
-	return &MockStore_CreateOrganizationInvitationStatus_Call{Call: _e.mock.On("CreateOrganizationInvitationStatus", ctx, invitationId, status)}
+//   - datasetFileUpload *models.DatasetFileUpload
+func (_e *MockStore_Expecter) CreateDatasetFileUpload(ctx interface{}, datasetFileUpload interface{}) *MockStore_CreateDatasetFileUpload_Call {
+	return &MockStore_CreateDatasetFileUpload_Call{Call: _e.mock.On("CreateDatasetFileUpload", ctx, datasetFileUpload)}
 }
 
-func (_c *MockStore_CreateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockStore_CreateOrganizationInvitationStatus_Call {
+func (_c *MockStore_CreateDatasetFileUpload_Call) Run(run func(ctx context.Context, datasetFileUpload *models.DatasetFileUpload)) *MockStore_CreateDatasetFileUpload_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
+		run(args[0].(context.Context), args[1].(*models.DatasetFileUpload))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockStore_CreateOrganizationInvitationStatus_Call {
+func (_c *MockStore_CreateDatasetFileUpload_Call) Return(_a0 *models.DatasetFileUpload, _a1 error) *MockStore_CreateDatasetFileUpload_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockStore_CreateOrganizationInvitationStatus_Call {
+func (_c *MockStore_CreateDatasetFileUpload_Call) RunAndReturn(run func(context.Context, *models.DatasetFileUpload) (*models.DatasetFileUpload, error)) *MockStore_CreateDatasetFileUpload_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
-func (_m *MockStore) CreateOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
-	ret := _m.Called(ctx, organizationId, userId, status)
+// CreateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId, privilege
+func (_m *MockStore) CreateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, audienceType, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateOrganizationMembershipRequest")
+		panic("no return value specified for CreateDatasetPolicy")
 	}
 
-	var r0 *models.OrganizationMembershipRequest
+	var r0 *models.ResourceAudiencePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
-		return rf(ctx, organizationId, userId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, audienceType, audienceId, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
-		r0 = rf(ctx, organizationId, userId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, audienceType, audienceId, privilege)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
-		r1 = rf(ctx, organizationId, userId, status)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, datasetId, audienceType, audienceId, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -695,60 +1007,59 @@ func (_m *MockStore) CreateOrganizationMembershipRequest(ctx context.Context, or
 	return r0, r1
 }
 
-// MockStore_CreateOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationMembershipRequest'
-type MockStore_CreateOrganizationMembershipRequest_Call struct {
+// MockStore_CreateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetPolicy'
+type MockStore_CreateDatasetPolicy_Call struct {
 	*mock.Call
 }
 
-// CreateOrganizationMembershipRequest is a helper method to define mock.On call
+// CreateDatasetPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - userId uuid.UUID
-//   - status models.OrgMembershipStatus
-func (_e *MockStore_Expecter) CreateOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockStore_CreateOrganizationMembershipRequest_Call {
-	return &MockStore_CreateOrganizationMembershipRequest_Call{Call: _e.mock.On("CreateOrganizationMembershipRequest", ctx, organizationId, userId, status)}
+//   - datasetId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockStore_Expecter) CreateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockStore_CreateDatasetPolicy_Call {
+	return &MockStore_CreateDatasetPolicy_Call{Call: _e.mock.On("CreateDatasetPolicy", ctx, datasetId, audienceType, audienceId, privilege)}
 }
 
-func (_c *MockStore_CreateOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockStore_CreateOrganizationMembershipRequest_Call {
+func (_c *MockStore_CreateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_CreateDatasetPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockStore_CreateOrganizationMembershipRequest_Call {
+func (_c *MockStore_CreateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_CreateDatasetPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockStore_CreateOrganizationMembershipRequest_Call {
+func (_c *MockStore_CreateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_CreateDatasetPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceType, audienceId, privilege
-func (_m *MockStore) CreateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, orgId, audienceType, audienceId, privilege)
+// CreateDatasetRowPolicy provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateDatasetRowPolicy(ctx context.Context, params models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateOrganizationPolicy")
+		panic("no return value specified for CreateDatasetRowPolicy")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
+	var r0 models.DatasetRowPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, orgId, audienceType, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, orgId, audienceType, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetRowPolicyParams) models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Get(0).(models.DatasetRowPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, orgId, audienceType, audienceId, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetRowPolicyParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -756,61 +1067,56 @@ func (_m *MockStore) CreateOrganizationPolicy(ctx context.Context, orgId uuid.UU
 	return r0, r1
 }
 
-// MockStore_CreateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationPolicy'
-type MockStore_CreateOrganizationPolicy_Call struct {
+// MockStore_CreateDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetRowPolicy'
+type MockStore_CreateDatasetRowPolicy_Call struct {
 	*mock.Call
 }
 
-// CreateOrganizationPolicy is a helper method to define mock.On call
+// CreateDatasetRowPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - orgId uuid.UUID
-//   - audienceType models.AudienceType
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockStore_Expecter) CreateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockStore_CreateOrganizationPolicy_Call {
-	return &MockStore_CreateOrganizationPolicy_Call{Call: _e.mock.On("CreateOrganizationPolicy", ctx, orgId, audienceType, audienceId, privilege)}
+//   - params models.CreateDatasetRowPolicyParams
+func (_e *MockStore_Expecter) CreateDatasetRowPolicy(ctx interface{}, params interface{}) *MockStore_CreateDatasetRowPolicy_Call {
+	return &MockStore_CreateDatasetRowPolicy_Call{Call: _e.mock.On("CreateDatasetRowPolicy", ctx, params)}
 }
 
-func (_c *MockStore_CreateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_CreateOrganizationPolicy_Call {
+func (_c *MockStore_CreateDatasetRowPolicy_Call) Run(run func(ctx context.Context, params models.CreateDatasetRowPolicyParams)) *MockStore_CreateDatasetRowPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetRowPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_CreateOrganizationPolicy_Call {
+func (_c *MockStore_CreateDatasetRowPolicy_Call) Return(_a0 models.DatasetRowPolicy, _a1 error) *MockStore_CreateDatasetRowPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_CreateOrganizationPolicy_Call {
+func (_c *MockStore_CreateDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, models.CreateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)) *MockStore_CreateDatasetRowPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrganizationTeam provides a mock function with given fields: ctx, organizationId, team
-func (_m *MockStore) CreateOrganizationTeam(ctx context.Context, organizationId uuid.UUID, team models.Team) (*models.Team, error) {
-	ret := _m.Called(ctx, organizationId, team)
+// CreateDatasetStatusWorkflow provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateDatasetStatusWorkflow(ctx context.Context, params models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateOrganizationTeam")
+		panic("no return value specified for CreateDatasetStatusWorkflow")
 	}
 
-	var r0 *models.Team
+	var r0 models.DatasetStatusWorkflow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) (*models.Team, error)); ok {
-		return rf(ctx, organizationId, team)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) *models.Team); ok {
-		r0 = rf(ctx, organizationId, team)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetStatusWorkflowParams) models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Team)
-		}
+		r0 = ret.Get(0).(models.DatasetStatusWorkflow)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.Team) error); ok {
-		r1 = rf(ctx, organizationId, team)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetStatusWorkflowParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -818,59 +1124,56 @@ func (_m *MockStore) CreateOrganizationTeam(ctx context.Context, organizationId
 	return r0, r1
 }
 
-// MockStore_CreateOrganizationTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationTeam'
-type MockStore_CreateOrganizationTeam_Call struct {
+// MockStore_CreateDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetStatusWorkflow'
+type MockStore_CreateDatasetStatusWorkflow_Call struct {
 	*mock.Call
 }
 
-// CreateOrganizationTeam is a helper method to define mock.On call
+// CreateDatasetStatusWorkflow is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - team models.Team
-func (_e *MockStore_Expecter) CreateOrganizationTeam(ctx interface{}, organizationId interface{}, team interface{}) *MockStore_CreateOrganizationTeam_Call {
-	return &MockStore_CreateOrganizationTeam_Call{Call: _e.mock.On("CreateOrganizationTeam", ctx, organizationId, team)}
+//   - params models.CreateDatasetStatusWorkflowParams
+func (_e *MockStore_Expecter) CreateDatasetStatusWorkflow(ctx interface{}, params interface{}) *MockStore_CreateDatasetStatusWorkflow_Call {
+	return &MockStore_CreateDatasetStatusWorkflow_Call{Call: _e.mock.On("CreateDatasetStatusWorkflow", ctx, params)}
 }
 
-func (_c *MockStore_CreateOrganizationTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, team models.Team)) *MockStore_CreateOrganizationTeam_Call {
+func (_c *MockStore_CreateDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, params models.CreateDatasetStatusWorkflowParams)) *MockStore_CreateDatasetStatusWorkflow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.Team))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetStatusWorkflowParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationTeam_Call) Return(_a0 *models.Team, _a1 error) *MockStore_CreateOrganizationTeam_Call {
+func (_c *MockStore_CreateDatasetStatusWorkflow_Call) Return(_a0 models.DatasetStatusWorkflow, _a1 error) *MockStore_CreateDatasetStatusWorkflow_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateOrganizationTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.Team) (*models.Team, error)) *MockStore_CreateOrganizationTeam_Call {
+func (_c *MockStore_CreateDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, models.CreateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)) *MockStore_CreateDatasetStatusWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePage provides a mock function with given fields: ctx, name, description
-func (_m *MockStore) CreatePage(ctx context.Context, name string, description string) (*models.Page, error) {
-	ret := _m.Called(ctx, name, description)
+// CreateDatasetView provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateDatasetView(ctx context.Context, params models.CreateDatasetViewParams) (models.DatasetView, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePage")
+		panic("no return value specified for CreateDatasetView")
 	}
 
-	var r0 *models.Page
+	var r0 models.DatasetView
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Page, error)); ok {
-		return rf(ctx, name, description)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetViewParams) (models.DatasetView, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Page); ok {
-		r0 = rf(ctx, name, description)
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateDatasetViewParams) models.DatasetView); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Page)
-		}
+		r0 = ret.Get(0).(models.DatasetView)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, name, description)
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateDatasetViewParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -878,51 +1181,50 @@ func (_m *MockStore) CreatePage(ctx context.Context, name string, description st
 	return r0, r1
 }
 
-// MockStore_CreatePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePage'
-type MockStore_CreatePage_Call struct {
+// MockStore_CreateDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetView'
+type MockStore_CreateDatasetView_Call struct {
 	*mock.Call
 }
 
-// CreatePage is a helper method to define mock.On call
+// CreateDatasetView is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-//   - description string
-func (_e *MockStore_Expecter) CreatePage(ctx interface{}, name interface{}, description interface{}) *MockStore_CreatePage_Call {
-	return &MockStore_CreatePage_Call{Call: _e.mock.On("CreatePage", ctx, name, description)}
+//   - params models.CreateDatasetViewParams
+func (_e *MockStore_Expecter) CreateDatasetView(ctx interface{}, params interface{}) *MockStore_CreateDatasetView_Call {
+	return &MockStore_CreateDatasetView_Call{Call: _e.mock.On("CreateDatasetView", ctx, params)}
 }
 
-func (_c *MockStore_CreatePage_Call) Run(run func(ctx context.Context, name string, description string)) *MockStore_CreatePage_Call {
+func (_c *MockStore_CreateDatasetView_Call) Run(run func(ctx context.Context, params models.CreateDatasetViewParams)) *MockStore_CreateDatasetView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(models.CreateDatasetViewParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreatePage_Call) Return(_a0 *models.Page, _a1 error) *MockStore_CreatePage_Call {
+func (_c *MockStore_CreateDatasetView_Call) Return(_a0 models.DatasetView, _a1 error) *MockStore_CreateDatasetView_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreatePage_Call) RunAndReturn(run func(context.Context, string, string) (*models.Page, error)) *MockStore_CreatePage_Call {
+func (_c *MockStore_CreateDatasetView_Call) RunAndReturn(run func(context.Context, models.CreateDatasetViewParams) (models.DatasetView, error)) *MockStore_CreateDatasetView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePagePolicy provides a mock function with given fields: ctx, pageId, audienceType, audienceId, privilege
-func (_m *MockStore) CreatePagePolicy(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, pageId, audienceType, audienceId, privilege)
+// CreateDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceType, audienceId, privilege
+func (_m *MockStore) CreateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId, audienceType, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePagePolicy")
+		panic("no return value specified for CreateDatasetViewPolicy")
 	}
 
 	var r0 *models.ResourceAudiencePolicy
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, pageId, audienceType, audienceId, privilege)
+		return rf(ctx, viewId, audienceType, audienceId, privilege)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, pageId, audienceType, audienceId, privilege)
+		r0 = rf(ctx, viewId, audienceType, audienceId, privilege)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
@@ -930,7 +1232,7 @@ func (_m *MockStore) CreatePagePolicy(ctx context.Context, pageId uuid.UUID, aud
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, pageId, audienceType, audienceId, privilege)
+		r1 = rf(ctx, viewId, audienceType, audienceId, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -938,59 +1240,61 @@ func (_m *MockStore) CreatePagePolicy(ctx context.Context, pageId uuid.UUID, aud
 	return r0, r1
 }
 
-// MockStore_CreatePagePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePagePolicy'
-type MockStore_CreatePagePolicy_Call struct {
+// MockStore_CreateDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatasetViewPolicy'
+type MockStore_CreateDatasetViewPolicy_Call struct {
 	*mock.Call
 }
 
-// CreatePagePolicy is a helper method to define mock.On call
+// CreateDatasetViewPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pageId uuid.UUID
+//   - viewId uuid.UUID
 //   - audienceType models.AudienceType
 //   - audienceId uuid.UUID
 //   - privilege models.ResourcePrivilege
-func (_e *MockStore_Expecter) CreatePagePolicy(ctx interface{}, pageId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockStore_CreatePagePolicy_Call {
-	return &MockStore_CreatePagePolicy_Call{Call: _e.mock.On("CreatePagePolicy", ctx, pageId, audienceType, audienceId, privilege)}
+func (_e *MockStore_Expecter) CreateDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockStore_CreateDatasetViewPolicy_Call {
+	return &MockStore_CreateDatasetViewPolicy_Call{Call: _e.mock.On("CreateDatasetViewPolicy", ctx, viewId, audienceType, audienceId, privilege)}
 }
 
-func (_c *MockStore_CreatePagePolicy_Call) Run(run func(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_CreatePagePolicy_Call {
+func (_c *MockStore_CreateDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_CreateDatasetViewPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreatePagePolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_CreatePagePolicy_Call {
+func (_c *MockStore_CreateDatasetViewPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_CreateDatasetViewPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreatePagePolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_CreatePagePolicy_Call {
+func (_c *MockStore_CreateDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_CreateDatasetViewPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePaymentsConfig provides a mock function with given fields: ctx, paymentsConfig
-func (_m *MockStore) CreatePaymentsConfig(ctx context.Context, paymentsConfig models.PaymentsConfig) (models.PaymentsConfig, error) {
-	ret := _m.Called(ctx, paymentsConfig)
+// CreateFileUpload provides a mock function with given fields: ctx, fileUpload
+func (_m *MockStore) CreateFileUpload(ctx context.Context, fileUpload *models.FileUpload) (*models.FileUpload, error) {
+	ret := _m.Called(ctx, fileUpload)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePaymentsConfig")
+		panic("no return value specified for CreateFileUpload")
 	}
 
-	var r0 models.PaymentsConfig
+	var r0 *models.FileUpload
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.PaymentsConfig) (models.PaymentsConfig, error)); ok {
-		return rf(ctx, paymentsConfig)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.FileUpload) (*models.FileUpload, error)); ok {
+		return rf(ctx, fileUpload)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.PaymentsConfig) models.PaymentsConfig); ok {
-		r0 = rf(ctx, paymentsConfig)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.FileUpload) *models.FileUpload); ok {
+		r0 = rf(ctx, fileUpload)
 	} else {
-		r0 = ret.Get(0).(models.PaymentsConfig)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.FileUpload)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.PaymentsConfig) error); ok {
-		r1 = rf(ctx, paymentsConfig)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.FileUpload) error); ok {
+		r1 = rf(ctx, fileUpload)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -998,105 +1302,115 @@ func (_m *MockStore) CreatePaymentsConfig(ctx context.Context, paymentsConfig mo
 	return r0, r1
 }
 
-// MockStore_CreatePaymentsConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePaymentsConfig'
-type MockStore_CreatePaymentsConfig_Call struct {
+// MockStore_CreateFileUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFileUpload'
+type MockStore_CreateFileUpload_Call struct {
 	*mock.Call
 }
 
-// CreatePaymentsConfig is a helper method to define mock.On call
+// CreateFileUpload is a helper method to define mock.On call
 //   - ctx context.Context
-//   - paymentsConfig models.PaymentsConfig
-func (_e *MockStore_Expecter) CreatePaymentsConfig(ctx interface{}, paymentsConfig interface{}) *MockStore_CreatePaymentsConfig_Call {
-	return &MockStore_CreatePaymentsConfig_Call{Call: _e.mock.On("CreatePaymentsConfig", ctx, paymentsConfig)}
+//   - fileUpload *models.FileUpload
+func (_e *MockStore_Expecter) CreateFileUpload(ctx interface{}, fileUpload interface{}) *MockStore_CreateFileUpload_Call {
+	return &MockStore_CreateFileUpload_Call{Call: _e.mock.On("CreateFileUpload", ctx, fileUpload)}
 }
 
-func (_c *MockStore_CreatePaymentsConfig_Call) Run(run func(ctx context.Context, paymentsConfig models.PaymentsConfig)) *MockStore_CreatePaymentsConfig_Call {
+func (_c *MockStore_CreateFileUpload_Call) Run(run func(ctx context.Context, fileUpload *models.FileUpload)) *MockStore_CreateFileUpload_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.PaymentsConfig))
+		run(args[0].(context.Context), args[1].(*models.FileUpload))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreatePaymentsConfig_Call) Return(_a0 models.PaymentsConfig, _a1 error) *MockStore_CreatePaymentsConfig_Call {
+func (_c *MockStore_CreateFileUpload_Call) Return(_a0 *models.FileUpload, _a1 error) *MockStore_CreateFileUpload_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreatePaymentsConfig_Call) RunAndReturn(run func(context.Context, models.PaymentsConfig) (models.PaymentsConfig, error)) *MockStore_CreatePaymentsConfig_Call {
+func (_c *MockStore_CreateFileUpload_Call) RunAndReturn(run func(context.Context, *models.FileUpload) (*models.FileUpload, error)) *MockStore_CreateFileUpload_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRule provides a mock function with given fields: ctx, params
-func (_m *MockStore) CreateRule(ctx context.Context, params models.CreateRuleParams) error {
+// CreateFxRateSource provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateFxRateSource(ctx context.Context, params models.CreateFxRateSourceParams) (models.FxRateSource, error) {
 	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateRule")
+		panic("no return value specified for CreateFxRateSource")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.CreateRuleParams) error); ok {
+	var r0 models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateFxRateSourceParams) (models.FxRateSource, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateFxRateSourceParams) models.FxRateSource); ok {
 		r0 = rf(ctx, params)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.FxRateSource)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateFxRateSourceParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_CreateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRule'
-type MockStore_CreateRule_Call struct {
+// MockStore_CreateFxRateSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateFxRateSource'
+type MockStore_CreateFxRateSource_Call struct {
 	*mock.Call
 }
 
-// CreateRule is a helper method to define mock.On call
+// CreateFxRateSource is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params models.CreateRuleParams
-func (_e *MockStore_Expecter) CreateRule(ctx interface{}, params interface{}) *MockStore_CreateRule_Call {
-	return &MockStore_CreateRule_Call{Call: _e.mock.On("CreateRule", ctx, params)}
+//   - params models.CreateFxRateSourceParams
+func (_e *MockStore_Expecter) CreateFxRateSource(ctx interface{}, params interface{}) *MockStore_CreateFxRateSource_Call {
+	return &MockStore_CreateFxRateSource_Call{Call: _e.mock.On("CreateFxRateSource", ctx, params)}
 }
 
-func (_c *MockStore_CreateRule_Call) Run(run func(ctx context.Context, params models.CreateRuleParams)) *MockStore_CreateRule_Call {
+func (_c *MockStore_CreateFxRateSource_Call) Run(run func(ctx context.Context, params models.CreateFxRateSourceParams)) *MockStore_CreateFxRateSource_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.CreateRuleParams))
+		run(args[0].(context.Context), args[1].(models.CreateFxRateSourceParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateRule_Call) Return(_a0 error) *MockStore_CreateRule_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_CreateFxRateSource_Call) Return(_a0 models.FxRateSource, _a1 error) *MockStore_CreateFxRateSource_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateRule_Call) RunAndReturn(run func(context.Context, models.CreateRuleParams) error) *MockStore_CreateRule_Call {
+func (_c *MockStore_CreateFxRateSource_Call) RunAndReturn(run func(context.Context, models.CreateFxRateSourceParams) (models.FxRateSource, error)) *MockStore_CreateFxRateSource_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSSOConfig provides a mock function with given fields: ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain
-func (_m *MockStore) CreateSSOConfig(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string) (*models.OrganizationSSOConfig, error) {
-	ret := _m.Called(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+// CreateOrganization provides a mock function with given fields: ctx, name, description, ownerId
+func (_m *MockStore) CreateOrganization(ctx context.Context, name string, description *string, ownerId uuid.UUID) (*models.Organization, error) {
+	ret := _m.Called(ctx, name, description, ownerId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSSOConfig")
+		panic("no return value specified for CreateOrganization")
 	}
 
-	var r0 *models.OrganizationSSOConfig
+	var r0 *models.Organization
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)); ok {
-		return rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)); ok {
+		return rf(ctx, name, description, ownerId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) *models.OrganizationSSOConfig); ok {
-		r0 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *string, uuid.UUID) *models.Organization); ok {
+		r0 = rf(ctx, name, description, ownerId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+			r0 = ret.Get(0).(*models.Organization)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) error); ok {
-		r1 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *string, uuid.UUID) error); ok {
+		r1 = rf(ctx, name, description, ownerId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1104,109 +1418,121 @@ func (_m *MockStore) CreateSSOConfig(ctx context.Context, organizationId uuid.UU
 	return r0, r1
 }
 
-// MockStore_CreateSSOConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSSOConfig'
-type MockStore_CreateSSOConfig_Call struct {
+// MockStore_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type MockStore_CreateOrganization_Call struct {
 	*mock.Call
 }
 
-// CreateSSOConfig is a helper method to define mock.On call
+// CreateOrganization is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - ssoProviderID string
-//   - ssoProviderName string
-//   - ssoConfig json.RawMessage
-//   - emailDomain string
-func (_e *MockStore_Expecter) CreateSSOConfig(ctx interface{}, organizationId interface{}, ssoProviderID interface{}, ssoProviderName interface{}, ssoConfig interface{}, emailDomain interface{}) *MockStore_CreateSSOConfig_Call {
-	return &MockStore_CreateSSOConfig_Call{Call: _e.mock.On("CreateSSOConfig", ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)}
+//   - name string
+//   - description *string
+//   - ownerId uuid.UUID
+func (_e *MockStore_Expecter) CreateOrganization(ctx interface{}, name interface{}, description interface{}, ownerId interface{}) *MockStore_CreateOrganization_Call {
+	return &MockStore_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", ctx, name, description, ownerId)}
 }
 
-func (_c *MockStore_CreateSSOConfig_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string)) *MockStore_CreateSSOConfig_Call {
+func (_c *MockStore_CreateOrganization_Call) Run(run func(ctx context.Context, name string, description *string, ownerId uuid.UUID)) *MockStore_CreateOrganization_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(json.RawMessage), args[5].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(*string), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSSOConfig_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockStore_CreateSSOConfig_Call {
+func (_c *MockStore_CreateOrganization_Call) Return(_a0 *models.Organization, _a1 error) *MockStore_CreateOrganization_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSSOConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)) *MockStore_CreateSSOConfig_Call {
+func (_c *MockStore_CreateOrganization_Call) RunAndReturn(run func(context.Context, string, *string, uuid.UUID) (*models.Organization, error)) *MockStore_CreateOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSchedules provides a mock function with given fields: ctx, schedules
-func (_m *MockStore) CreateSchedules(ctx context.Context, schedules []models.CreateScheduleParams) error {
-	ret := _m.Called(ctx, schedules)
+// CreateOrganizationInvitation provides a mock function with given fields: ctx, organizationId, targetEmail, privilege
+func (_m *MockStore) CreateOrganizationInvitation(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege) (*models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationId, targetEmail, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSchedules")
+		panic("no return value specified for CreateOrganizationInvitation")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []models.CreateScheduleParams) error); ok {
-		r0 = rf(ctx, schedules)
+	var r0 *models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationId, targetEmail, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) *models.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationId, targetEmail, privilege)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitation)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, organizationId, targetEmail, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_CreateSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchedules'
-type MockStore_CreateSchedules_Call struct {
+// MockStore_CreateOrganizationInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitation'
+type MockStore_CreateOrganizationInvitation_Call struct {
 	*mock.Call
 }
 
-// CreateSchedules is a helper method to define mock.On call
+// CreateOrganizationInvitation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - schedules []models.CreateScheduleParams
-func (_e *MockStore_Expecter) CreateSchedules(ctx interface{}, schedules interface{}) *MockStore_CreateSchedules_Call {
-	return &MockStore_CreateSchedules_Call{Call: _e.mock.On("CreateSchedules", ctx, schedules)}
+//   - organizationId uuid.UUID
+//   - targetEmail string
+//   - privilege models.ResourcePrivilege
+func (_e *MockStore_Expecter) CreateOrganizationInvitation(ctx interface{}, organizationId interface{}, targetEmail interface{}, privilege interface{}) *MockStore_CreateOrganizationInvitation_Call {
+	return &MockStore_CreateOrganizationInvitation_Call{Call: _e.mock.On("CreateOrganizationInvitation", ctx, organizationId, targetEmail, privilege)}
 }
 
-func (_c *MockStore_CreateSchedules_Call) Run(run func(ctx context.Context, schedules []models.CreateScheduleParams)) *MockStore_CreateSchedules_Call {
+func (_c *MockStore_CreateOrganizationInvitation_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, targetEmail string, privilege models.ResourcePrivilege)) *MockStore_CreateOrganizationInvitation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]models.CreateScheduleParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSchedules_Call) Return(_a0 error) *MockStore_CreateSchedules_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_CreateOrganizationInvitation_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockStore_CreateOrganizationInvitation_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSchedules_Call) RunAndReturn(run func(context.Context, []models.CreateScheduleParams) error) *MockStore_CreateSchedules_Call {
+func (_c *MockStore_CreateOrganizationInvitation_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, models.ResourcePrivilege) (*models.OrganizationInvitation, error)) *MockStore_CreateOrganizationInvitation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateSheet provides a mock function with given fields: ctx, sheet
-func (_m *MockStore) CreateSheet(ctx context.Context, sheet models.Sheet) (*models.Sheet, error) {
-	ret := _m.Called(ctx, sheet)
+// CreateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
+func (_m *MockStore) CreateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
+	ret := _m.Called(ctx, invitationId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSheet")
+		panic("no return value specified for CreateOrganizationInvitationStatus")
 	}
 
-	var r0 *models.Sheet
+	var r0 *models.OrganizationInvitationStatus
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) (*models.Sheet, error)); ok {
-		return rf(ctx, sheet)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
+		return rf(ctx, invitationId, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) *models.Sheet); ok {
-		r0 = rf(ctx, sheet)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
+		r0 = rf(ctx, invitationId, status)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Sheet)
+			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.Sheet) error); ok {
-		r1 = rf(ctx, sheet)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
+		r1 = rf(ctx, invitationId, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1214,58 +1540,59 @@ func (_m *MockStore) CreateSheet(ctx context.Context, sheet models.Sheet) (*mode
 	return r0, r1
 }
 
-// MockStore_CreateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSheet'
-type MockStore_CreateSheet_Call struct {
+// MockStore_CreateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationInvitationStatus'
+type MockStore_CreateOrganizationInvitationStatus_Call struct {
 	*mock.Call
 }
 
-// CreateSheet is a helper method to define mock.On call
+// CreateOrganizationInvitationStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - sheet models.Sheet
-func (_e *MockStore_Expecter) CreateSheet(ctx interface{}, sheet interface{}) *MockStore_CreateSheet_Call {
-	return &MockStore_CreateSheet_Call{Call: _e.mock.On("CreateSheet", ctx, sheet)}
+//   - invitationId uuid.UUID
+//   - status models.InvitationStatus
+func (_e *MockStore_Expecter) CreateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockStore_CreateOrganizationInvitationStatus_Call {
+	return &MockStore_CreateOrganizationInvitationStatus_Call{Call: _e.mock.On("CreateOrganizationInvitationStatus", ctx, invitationId, status)}
 }
 
-func (_c *MockStore_CreateSheet_Call) Run(run func(ctx context.Context, sheet models.Sheet)) *MockStore_CreateSheet_Call {
+func (_c *MockStore_CreateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockStore_CreateOrganizationInvitationStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.Sheet))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockStore_CreateSheet_Call {
+func (_c *MockStore_CreateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockStore_CreateOrganizationInvitationStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateSheet_Call) RunAndReturn(run func(context.Context, models.Sheet) (*models.Sheet, error)) *MockStore_CreateSheet_Call {
+func (_c *MockStore_CreateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockStore_CreateOrganizationInvitationStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTeamMembership provides a mock function with given fields: ctx, teamId, userId
-func (_m *MockStore) CreateTeamMembership(ctx context.Context, teamId uuid.UUID, userId uuid.UUID) (*models.TeamMembership, error) {
-	ret := _m.Called(ctx, teamId, userId)
+// CreateOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
+func (_m *MockStore) CreateOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId, userId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTeamMembership")
+		panic("no return value specified for CreateOrganizationMembershipRequest")
 	}
 
-	var r0 *models.TeamMembership
+	var r0 *models.OrganizationMembershipRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)); ok {
-		return rf(ctx, teamId, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId, userId, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.TeamMembership); ok {
-		r0 = rf(ctx, teamId, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId, userId, status)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.TeamMembership)
+			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r1 = rf(ctx, teamId, userId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
+		r1 = rf(ctx, organizationId, userId, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1273,59 +1600,5813 @@ func (_m *MockStore) CreateTeamMembership(ctx context.Context, teamId uuid.UUID,
 	return r0, r1
 }
 
-// MockStore_CreateTeamMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeamMembership'
-type MockStore_CreateTeamMembership_Call struct {
+// MockStore_CreateOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationMembershipRequest'
+type MockStore_CreateOrganizationMembershipRequest_Call struct {
 	*mock.Call
 }
 
-// CreateTeamMembership is a helper method to define mock.On call
+// CreateOrganizationMembershipRequest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - teamId uuid.UUID
+//   - organizationId uuid.UUID
 //   - userId uuid.UUID
-func (_e *MockStore_Expecter) CreateTeamMembership(ctx interface{}, teamId interface{}, userId interface{}) *MockStore_CreateTeamMembership_Call {
-	return &MockStore_CreateTeamMembership_Call{Call: _e.mock.On("CreateTeamMembership", ctx, teamId, userId)}
+//   - status models.OrgMembershipStatus
+func (_e *MockStore_Expecter) CreateOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockStore_CreateOrganizationMembershipRequest_Call {
+	return &MockStore_CreateOrganizationMembershipRequest_Call{Call: _e.mock.On("CreateOrganizationMembershipRequest", ctx, organizationId, userId, status)}
 }
 
-func (_c *MockStore_CreateTeamMembership_Call) Run(run func(ctx context.Context, teamId uuid.UUID, userId uuid.UUID)) *MockStore_CreateTeamMembership_Call {
+func (_c *MockStore_CreateOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockStore_CreateOrganizationMembershipRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateTeamMembership_Call) Return(_a0 *models.TeamMembership, _a1 error) *MockStore_CreateTeamMembership_Call {
+func (_c *MockStore_CreateOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockStore_CreateOrganizationMembershipRequest_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateTeamMembership_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)) *MockStore_CreateTeamMembership_Call {
+func (_c *MockStore_CreateOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockStore_CreateOrganizationMembershipRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
-func (_m *MockStore) CreateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
-	ret := _m.Called(ctx, widgetInstance)
+// CreateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceType, audienceId, privilege
+func (_m *MockStore) CreateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId, audienceType, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateWidgetInstance")
+		panic("no return value specified for CreateOrganizationPolicy")
 	}
 
-	var r0 *models.WidgetInstance
+	var r0 *models.ResourceAudiencePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
-		return rf(ctx, widgetInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId, audienceType, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, orgId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationPolicy'
+type MockStore_CreateOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockStore_Expecter) CreateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockStore_CreateOrganizationPolicy_Call {
+	return &MockStore_CreateOrganizationPolicy_Call{Call: _e.mock.On("CreateOrganizationPolicy", ctx, orgId, audienceType, audienceId, privilege)}
+}
+
+func (_c *MockStore_CreateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_CreateOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_CreateOrganizationPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_CreateOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrganizationTeam provides a mock function with given fields: ctx, organizationId, team
+func (_m *MockStore) CreateOrganizationTeam(ctx context.Context, organizationId uuid.UUID, team models.Team) (*models.Team, error) {
+	ret := _m.Called(ctx, organizationId, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrganizationTeam")
+	}
+
+	var r0 *models.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) (*models.Team, error)); ok {
+		return rf(ctx, organizationId, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) *models.Team); ok {
+		r0 = rf(ctx, organizationId, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.Team) error); ok {
+		r1 = rf(ctx, organizationId, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateOrganizationTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganizationTeam'
+type MockStore_CreateOrganizationTeam_Call struct {
+	*mock.Call
+}
+
+// CreateOrganizationTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - team models.Team
+func (_e *MockStore_Expecter) CreateOrganizationTeam(ctx interface{}, organizationId interface{}, team interface{}) *MockStore_CreateOrganizationTeam_Call {
+	return &MockStore_CreateOrganizationTeam_Call{Call: _e.mock.On("CreateOrganizationTeam", ctx, organizationId, team)}
+}
+
+func (_c *MockStore_CreateOrganizationTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, team models.Team)) *MockStore_CreateOrganizationTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.Team))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateOrganizationTeam_Call) Return(_a0 *models.Team, _a1 error) *MockStore_CreateOrganizationTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateOrganizationTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.Team) (*models.Team, error)) *MockStore_CreateOrganizationTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePage provides a mock function with given fields: ctx, name, description
+func (_m *MockStore) CreatePage(ctx context.Context, name string, description string) (*models.Page, error) {
+	ret := _m.Called(ctx, name, description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePage")
+	}
+
+	var r0 *models.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Page, error)); ok {
+		return rf(ctx, name, description)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Page); ok {
+		r0 = rf(ctx, name, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, description)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreatePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePage'
+type MockStore_CreatePage_Call struct {
+	*mock.Call
+}
+
+// CreatePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - description string
+func (_e *MockStore_Expecter) CreatePage(ctx interface{}, name interface{}, description interface{}) *MockStore_CreatePage_Call {
+	return &MockStore_CreatePage_Call{Call: _e.mock.On("CreatePage", ctx, name, description)}
+}
+
+func (_c *MockStore_CreatePage_Call) Run(run func(ctx context.Context, name string, description string)) *MockStore_CreatePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreatePage_Call) Return(_a0 *models.Page, _a1 error) *MockStore_CreatePage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreatePage_Call) RunAndReturn(run func(context.Context, string, string) (*models.Page, error)) *MockStore_CreatePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePagePolicy provides a mock function with given fields: ctx, pageId, audienceType, audienceId, privilege
+func (_m *MockStore) CreatePagePolicy(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, pageId, audienceType, audienceId, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePagePolicy")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, pageId, audienceType, audienceId, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, pageId, audienceType, audienceId, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, pageId, audienceType, audienceId, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreatePagePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePagePolicy'
+type MockStore_CreatePagePolicy_Call struct {
+	*mock.Call
+}
+
+// CreatePagePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockStore_Expecter) CreatePagePolicy(ctx interface{}, pageId interface{}, audienceType interface{}, audienceId interface{}, privilege interface{}) *MockStore_CreatePagePolicy_Call {
+	return &MockStore_CreatePagePolicy_Call{Call: _e.mock.On("CreatePagePolicy", ctx, pageId, audienceType, audienceId, privilege)}
+}
+
+func (_c *MockStore_CreatePagePolicy_Call) Run(run func(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_CreatePagePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID), args[4].(models.ResourcePrivilege))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreatePagePolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_CreatePagePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreatePagePolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_CreatePagePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePaymentsConfig provides a mock function with given fields: ctx, paymentsConfig
+func (_m *MockStore) CreatePaymentsConfig(ctx context.Context, paymentsConfig models.PaymentsConfig) (models.PaymentsConfig, error) {
+	ret := _m.Called(ctx, paymentsConfig)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePaymentsConfig")
+	}
+
+	var r0 models.PaymentsConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.PaymentsConfig) (models.PaymentsConfig, error)); ok {
+		return rf(ctx, paymentsConfig)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.PaymentsConfig) models.PaymentsConfig); ok {
+		r0 = rf(ctx, paymentsConfig)
+	} else {
+		r0 = ret.Get(0).(models.PaymentsConfig)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.PaymentsConfig) error); ok {
+		r1 = rf(ctx, paymentsConfig)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreatePaymentsConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePaymentsConfig'
+type MockStore_CreatePaymentsConfig_Call struct {
+	*mock.Call
+}
+
+// CreatePaymentsConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentsConfig models.PaymentsConfig
+func (_e *MockStore_Expecter) CreatePaymentsConfig(ctx interface{}, paymentsConfig interface{}) *MockStore_CreatePaymentsConfig_Call {
+	return &MockStore_CreatePaymentsConfig_Call{Call: _e.mock.On("CreatePaymentsConfig", ctx, paymentsConfig)}
+}
+
+func (_c *MockStore_CreatePaymentsConfig_Call) Run(run func(ctx context.Context, paymentsConfig models.PaymentsConfig)) *MockStore_CreatePaymentsConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.PaymentsConfig))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreatePaymentsConfig_Call) Return(_a0 models.PaymentsConfig, _a1 error) *MockStore_CreatePaymentsConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreatePaymentsConfig_Call) RunAndReturn(run func(context.Context, models.PaymentsConfig) (models.PaymentsConfig, error)) *MockStore_CreatePaymentsConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliation provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateReconciliation(ctx context.Context, params models.CreateReconciliationParams) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliation")
+	}
+
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReconciliationParams) (models.Reconciliation, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReconciliationParams) models.Reconciliation); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.Reconciliation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateReconciliationParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliation'
+type MockStore_CreateReconciliation_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateReconciliationParams
+func (_e *MockStore_Expecter) CreateReconciliation(ctx interface{}, params interface{}) *MockStore_CreateReconciliation_Call {
+	return &MockStore_CreateReconciliation_Call{Call: _e.mock.On("CreateReconciliation", ctx, params)}
+}
+
+func (_c *MockStore_CreateReconciliation_Call) Run(run func(ctx context.Context, params models.CreateReconciliationParams)) *MockStore_CreateReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateReconciliationParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateReconciliation_Call) Return(_a0 models.Reconciliation, _a1 error) *MockStore_CreateReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateReconciliation_Call) RunAndReturn(run func(context.Context, models.CreateReconciliationParams) (models.Reconciliation, error)) *MockStore_CreateReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationExceptions provides a mock function with given fields: ctx, runId, side, rows, skipped
+func (_m *MockStore) CreateReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow, skipped bool) error {
+	ret := _m.Called(ctx, runId, side, rows, skipped)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliationExceptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []models.ReconciliationRow, bool) error); ok {
+		r0 = rf(ctx, runId, side, rows, skipped)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CreateReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationExceptions'
+type MockStore_CreateReconciliationExceptions_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - side string
+//   - rows []models.ReconciliationRow
+//   - skipped bool
+func (_e *MockStore_Expecter) CreateReconciliationExceptions(ctx interface{}, runId interface{}, side interface{}, rows interface{}, skipped interface{}) *MockStore_CreateReconciliationExceptions_Call {
+	return &MockStore_CreateReconciliationExceptions_Call{Call: _e.mock.On("CreateReconciliationExceptions", ctx, runId, side, rows, skipped)}
+}
+
+func (_c *MockStore_CreateReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow, skipped bool)) *MockStore_CreateReconciliationExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]models.ReconciliationRow), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateReconciliationExceptions_Call) Return(_a0 error) *MockStore_CreateReconciliationExceptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []models.ReconciliationRow, bool) error) *MockStore_CreateReconciliationExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationMatches provides a mock function with given fields: ctx, runId, params
+func (_m *MockStore) CreateReconciliationMatches(ctx context.Context, runId uuid.UUID, params []models.CreateReconciliationMatchParams) error {
+	ret := _m.Called(ctx, runId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliationMatches")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.CreateReconciliationMatchParams) error); ok {
+		r0 = rf(ctx, runId, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CreateReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationMatches'
+type MockStore_CreateReconciliationMatches_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationMatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runId uuid.UUID
+//   - params []models.CreateReconciliationMatchParams
+func (_e *MockStore_Expecter) CreateReconciliationMatches(ctx interface{}, runId interface{}, params interface{}) *MockStore_CreateReconciliationMatches_Call {
+	return &MockStore_CreateReconciliationMatches_Call{Call: _e.mock.On("CreateReconciliationMatches", ctx, runId, params)}
+}
+
+func (_c *MockStore_CreateReconciliationMatches_Call) Run(run func(ctx context.Context, runId uuid.UUID, params []models.CreateReconciliationMatchParams)) *MockStore_CreateReconciliationMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].([]models.CreateReconciliationMatchParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateReconciliationMatches_Call) Return(_a0 error) *MockStore_CreateReconciliationMatches_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, []models.CreateReconciliationMatchParams) error) *MockStore_CreateReconciliationMatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReconciliationRun provides a mock function with given fields: ctx, reconciliationId, createdBy
+func (_m *MockStore) CreateReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, createdBy uuid.UUID) (models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, createdBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReconciliationRun")
+	}
+
+	var r0 models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, createdBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, createdBy)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, reconciliationId, createdBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReconciliationRun'
+type MockStore_CreateReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// CreateReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - createdBy uuid.UUID
+func (_e *MockStore_Expecter) CreateReconciliationRun(ctx interface{}, reconciliationId interface{}, createdBy interface{}) *MockStore_CreateReconciliationRun_Call {
+	return &MockStore_CreateReconciliationRun_Call{Call: _e.mock.On("CreateReconciliationRun", ctx, reconciliationId, createdBy)}
+}
+
+func (_c *MockStore_CreateReconciliationRun_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, createdBy uuid.UUID)) *MockStore_CreateReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateReconciliationRun_Call) Return(_a0 models.ReconciliationRun, _a1 error) *MockStore_CreateReconciliationRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationRun, error)) *MockStore_CreateReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReferenceBank provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateReferenceBank(ctx context.Context, params models.CreateReferenceBankParams) (models.ReferenceBank, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReferenceBank")
+	}
+
+	var r0 models.ReferenceBank
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateReferenceBankParams) models.ReferenceBank); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.ReferenceBank)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateReferenceBankParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReferenceBank'
+type MockStore_CreateReferenceBank_Call struct {
+	*mock.Call
+}
+
+// CreateReferenceBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateReferenceBankParams
+func (_e *MockStore_Expecter) CreateReferenceBank(ctx interface{}, params interface{}) *MockStore_CreateReferenceBank_Call {
+	return &MockStore_CreateReferenceBank_Call{Call: _e.mock.On("CreateReferenceBank", ctx, params)}
+}
+
+func (_c *MockStore_CreateReferenceBank_Call) Run(run func(ctx context.Context, params models.CreateReferenceBankParams)) *MockStore_CreateReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateReferenceBankParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateReferenceBank_Call) Return(_a0 models.ReferenceBank, _a1 error) *MockStore_CreateReferenceBank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateReferenceBank_Call) RunAndReturn(run func(context.Context, models.CreateReferenceBankParams) (models.ReferenceBank, error)) *MockStore_CreateReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRule provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateRule(ctx context.Context, params models.CreateRuleParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateRuleParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CreateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRule'
+type MockStore_CreateRule_Call struct {
+	*mock.Call
+}
+
+// CreateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateRuleParams
+func (_e *MockStore_Expecter) CreateRule(ctx interface{}, params interface{}) *MockStore_CreateRule_Call {
+	return &MockStore_CreateRule_Call{Call: _e.mock.On("CreateRule", ctx, params)}
+}
+
+func (_c *MockStore_CreateRule_Call) Run(run func(ctx context.Context, params models.CreateRuleParams)) *MockStore_CreateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateRuleParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRule_Call) Return(_a0 error) *MockStore_CreateRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateRule_Call) RunAndReturn(run func(context.Context, models.CreateRuleParams) error) *MockStore_CreateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRuleRuns provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateRuleRuns(ctx context.Context, params []models.CreateRuleRunParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRuleRuns")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.CreateRuleRunParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CreateRuleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRuleRuns'
+type MockStore_CreateRuleRuns_Call struct {
+	*mock.Call
+}
+
+// CreateRuleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params []models.CreateRuleRunParams
+func (_e *MockStore_Expecter) CreateRuleRuns(ctx interface{}, params interface{}) *MockStore_CreateRuleRuns_Call {
+	return &MockStore_CreateRuleRuns_Call{Call: _e.mock.On("CreateRuleRuns", ctx, params)}
+}
+
+func (_c *MockStore_CreateRuleRuns_Call) Run(run func(ctx context.Context, params []models.CreateRuleRunParams)) *MockStore_CreateRuleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.CreateRuleRunParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRuleRuns_Call) Return(_a0 error) *MockStore_CreateRuleRuns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateRuleRuns_Call) RunAndReturn(run func(context.Context, []models.CreateRuleRunParams) error) *MockStore_CreateRuleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRuleVersion provides a mock function with given fields: ctx, ruleId, changeType, createdBy
+func (_m *MockStore) CreateRuleVersion(ctx context.Context, ruleId uuid.UUID, changeType models.RuleChangeType, createdBy uuid.UUID) (models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId, changeType, createdBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRuleVersion")
+	}
+
+	var r0 models.RuleVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) (models.RuleVersion, error)); ok {
+		return rf(ctx, ruleId, changeType, createdBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) models.RuleVersion); ok {
+		r0 = rf(ctx, ruleId, changeType, createdBy)
+	} else {
+		r0 = ret.Get(0).(models.RuleVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) error); ok {
+		r1 = rf(ctx, ruleId, changeType, createdBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateRuleVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRuleVersion'
+type MockStore_CreateRuleVersion_Call struct {
+	*mock.Call
+}
+
+// CreateRuleVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleId uuid.UUID
+//   - changeType models.RuleChangeType
+//   - createdBy uuid.UUID
+func (_e *MockStore_Expecter) CreateRuleVersion(ctx interface{}, ruleId interface{}, changeType interface{}, createdBy interface{}) *MockStore_CreateRuleVersion_Call {
+	return &MockStore_CreateRuleVersion_Call{Call: _e.mock.On("CreateRuleVersion", ctx, ruleId, changeType, createdBy)}
+}
+
+func (_c *MockStore_CreateRuleVersion_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, changeType models.RuleChangeType, createdBy uuid.UUID)) *MockStore_CreateRuleVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.RuleChangeType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateRuleVersion_Call) Return(_a0 models.RuleVersion, _a1 error) *MockStore_CreateRuleVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateRuleVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.RuleChangeType, uuid.UUID) (models.RuleVersion, error)) *MockStore_CreateRuleVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSSOConfig provides a mock function with given fields: ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain
+func (_m *MockStore) CreateSSOConfig(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSSOConfig")
+	}
+
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, json.RawMessage, string) error); ok {
+		r1 = rf(ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSSOConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSSOConfig'
+type MockStore_CreateSSOConfig_Call struct {
+	*mock.Call
+}
+
+// CreateSSOConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - ssoProviderID string
+//   - ssoProviderName string
+//   - ssoConfig json.RawMessage
+//   - emailDomain string
+func (_e *MockStore_Expecter) CreateSSOConfig(ctx interface{}, organizationId interface{}, ssoProviderID interface{}, ssoProviderName interface{}, ssoConfig interface{}, emailDomain interface{}) *MockStore_CreateSSOConfig_Call {
+	return &MockStore_CreateSSOConfig_Call{Call: _e.mock.On("CreateSSOConfig", ctx, organizationId, ssoProviderID, ssoProviderName, ssoConfig, emailDomain)}
+}
+
+func (_c *MockStore_CreateSSOConfig_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, ssoProviderID string, ssoProviderName string, ssoConfig json.RawMessage, emailDomain string)) *MockStore_CreateSSOConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(json.RawMessage), args[5].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSSOConfig_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockStore_CreateSSOConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSSOConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, json.RawMessage, string) (*models.OrganizationSSOConfig, error)) *MockStore_CreateSSOConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSchedules provides a mock function with given fields: ctx, schedules
+func (_m *MockStore) CreateSchedules(ctx context.Context, schedules []models.CreateScheduleParams) error {
+	ret := _m.Called(ctx, schedules)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSchedules")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.CreateScheduleParams) error); ok {
+		r0 = rf(ctx, schedules)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_CreateSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSchedules'
+type MockStore_CreateSchedules_Call struct {
+	*mock.Call
+}
+
+// CreateSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schedules []models.CreateScheduleParams
+func (_e *MockStore_Expecter) CreateSchedules(ctx interface{}, schedules interface{}) *MockStore_CreateSchedules_Call {
+	return &MockStore_CreateSchedules_Call{Call: _e.mock.On("CreateSchedules", ctx, schedules)}
+}
+
+func (_c *MockStore_CreateSchedules_Call) Run(run func(ctx context.Context, schedules []models.CreateScheduleParams)) *MockStore_CreateSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.CreateScheduleParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSchedules_Call) Return(_a0 error) *MockStore_CreateSchedules_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_CreateSchedules_Call) RunAndReturn(run func(context.Context, []models.CreateScheduleParams) error) *MockStore_CreateSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSheet provides a mock function with given fields: ctx, sheet
+func (_m *MockStore) CreateSheet(ctx context.Context, sheet models.Sheet) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheet)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSheet")
+	}
+
+	var r0 *models.Sheet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) (*models.Sheet, error)); ok {
+		return rf(ctx, sheet)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Sheet) *models.Sheet); ok {
+		r0 = rf(ctx, sheet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Sheet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Sheet) error); ok {
+		r1 = rf(ctx, sheet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSheet'
+type MockStore_CreateSheet_Call struct {
+	*mock.Call
+}
+
+// CreateSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheet models.Sheet
+func (_e *MockStore_Expecter) CreateSheet(ctx interface{}, sheet interface{}) *MockStore_CreateSheet_Call {
+	return &MockStore_CreateSheet_Call{Call: _e.mock.On("CreateSheet", ctx, sheet)}
+}
+
+func (_c *MockStore_CreateSheet_Call) Run(run func(ctx context.Context, sheet models.Sheet)) *MockStore_CreateSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Sheet))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockStore_CreateSheet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateSheet_Call) RunAndReturn(run func(context.Context, models.Sheet) (*models.Sheet, error)) *MockStore_CreateSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTag provides a mock function with given fields: ctx, params
+func (_m *MockStore) CreateTag(ctx context.Context, params models.CreateTagParams) (models.Tag, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateTagParams) (models.Tag, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateTagParams) models.Tag); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateTagParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type MockStore_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateTagParams
+func (_e *MockStore_Expecter) CreateTag(ctx interface{}, params interface{}) *MockStore_CreateTag_Call {
+	return &MockStore_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, params)}
+}
+
+func (_c *MockStore_CreateTag_Call) Run(run func(ctx context.Context, params models.CreateTagParams)) *MockStore_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateTagParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateTag_Call) Return(_a0 models.Tag, _a1 error) *MockStore_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateTag_Call) RunAndReturn(run func(context.Context, models.CreateTagParams) (models.Tag, error)) *MockStore_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTeamMembership provides a mock function with given fields: ctx, teamId, userId
+func (_m *MockStore) CreateTeamMembership(ctx context.Context, teamId uuid.UUID, userId uuid.UUID) (*models.TeamMembership, error) {
+	ret := _m.Called(ctx, teamId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTeamMembership")
+	}
+
+	var r0 *models.TeamMembership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)); ok {
+		return rf(ctx, teamId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.TeamMembership); ok {
+		r0 = rf(ctx, teamId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TeamMembership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateTeamMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeamMembership'
+type MockStore_CreateTeamMembership_Call struct {
+	*mock.Call
+}
+
+// CreateTeamMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockStore_Expecter) CreateTeamMembership(ctx interface{}, teamId interface{}, userId interface{}) *MockStore_CreateTeamMembership_Call {
+	return &MockStore_CreateTeamMembership_Call{Call: _e.mock.On("CreateTeamMembership", ctx, teamId, userId)}
+}
+
+func (_c *MockStore_CreateTeamMembership_Call) Run(run func(ctx context.Context, teamId uuid.UUID, userId uuid.UUID)) *MockStore_CreateTeamMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateTeamMembership_Call) Return(_a0 *models.TeamMembership, _a1 error) *MockStore_CreateTeamMembership_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateTeamMembership_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)) *MockStore_CreateTeamMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
+func (_m *MockStore) CreateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWidgetInstance")
+	}
+
+	var r0 *models.WidgetInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstance)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
+		r1 = rf(ctx, widgetInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_CreateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWidgetInstance'
+type MockStore_CreateWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// CreateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstance *models.WidgetInstance
+func (_e *MockStore_Expecter) CreateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockStore_CreateWidgetInstance_Call {
+	return &MockStore_CreateWidgetInstance_Call{Call: _e.mock.On("CreateWidgetInstance", ctx, widgetInstance)}
+}
+
+func (_c *MockStore_CreateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockStore_CreateWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+	})
+	return _c
+}
+
+func (_c *MockStore_CreateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockStore_CreateWidgetInstance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_CreateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockStore_CreateWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockStore) DeleteDataset(ctx context.Context, dataset models.Dataset) error {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDataset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) error); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDataset'
+type MockStore_DeleteDataset_Call struct {
+	*mock.Call
+}
+
+// DeleteDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset models.Dataset
+func (_e *MockStore_Expecter) DeleteDataset(ctx interface{}, dataset interface{}) *MockStore_DeleteDataset_Call {
+	return &MockStore_DeleteDataset_Call{Call: _e.mock.On("DeleteDataset", ctx, dataset)}
+}
+
+func (_c *MockStore_DeleteDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockStore_DeleteDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Dataset))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDataset_Call) Return(_a0 error) *MockStore_DeleteDataset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) error) *MockStore_DeleteDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetAlert provides a mock function with given fields: ctx, alertId, deletedBy
+func (_m *MockStore) DeleteDatasetAlert(ctx context.Context, alertId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, alertId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetAlert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, alertId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetAlert'
+type MockStore_DeleteDatasetAlert_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteDatasetAlert(ctx interface{}, alertId interface{}, deletedBy interface{}) *MockStore_DeleteDatasetAlert_Call {
+	return &MockStore_DeleteDatasetAlert_Call{Call: _e.mock.On("DeleteDatasetAlert", ctx, alertId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteDatasetAlert_Call) Run(run func(ctx context.Context, alertId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteDatasetAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetAlert_Call) Return(_a0 error) *MockStore_DeleteDatasetAlert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteDatasetAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetColumnPolicy provides a mock function with given fields: ctx, policyId, deletedBy
+func (_m *MockStore) DeleteDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, policyId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetColumnPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, policyId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetColumnPolicy'
+type MockStore_DeleteDatasetColumnPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetColumnPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteDatasetColumnPolicy(ctx interface{}, policyId interface{}, deletedBy interface{}) *MockStore_DeleteDatasetColumnPolicy_Call {
+	return &MockStore_DeleteDatasetColumnPolicy_Call{Call: _e.mock.On("DeleteDatasetColumnPolicy", ctx, policyId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteDatasetColumnPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteDatasetColumnPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetColumnPolicy_Call) Return(_a0 error) *MockStore_DeleteDatasetColumnPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteDatasetColumnPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetExportSchedule provides a mock function with given fields: ctx, scheduleId, deletedBy
+func (_m *MockStore) DeleteDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, scheduleId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetExportSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, scheduleId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetExportSchedule'
+type MockStore_DeleteDatasetExportSchedule_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetExportSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteDatasetExportSchedule(ctx interface{}, scheduleId interface{}, deletedBy interface{}) *MockStore_DeleteDatasetExportSchedule_Call {
+	return &MockStore_DeleteDatasetExportSchedule_Call{Call: _e.mock.On("DeleteDatasetExportSchedule", ctx, scheduleId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteDatasetExportSchedule_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteDatasetExportSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetExportSchedule_Call) Return(_a0 error) *MockStore_DeleteDatasetExportSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteDatasetExportSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId
+func (_m *MockStore) DeleteDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, datasetId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, datasetId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetPolicy'
+type MockStore_DeleteDatasetPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockStore_Expecter) DeleteDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}) *MockStore_DeleteDatasetPolicy_Call {
+	return &MockStore_DeleteDatasetPolicy_Call{Call: _e.mock.On("DeleteDatasetPolicy", ctx, datasetId, audienceType, audienceId)}
+}
+
+func (_c *MockStore_DeleteDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockStore_DeleteDatasetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetPolicy_Call) Return(_a0 error) *MockStore_DeleteDatasetPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockStore_DeleteDatasetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetRowPolicy provides a mock function with given fields: ctx, policyId, deletedBy
+func (_m *MockStore) DeleteDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, policyId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetRowPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, policyId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetRowPolicy'
+type MockStore_DeleteDatasetRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteDatasetRowPolicy(ctx interface{}, policyId interface{}, deletedBy interface{}) *MockStore_DeleteDatasetRowPolicy_Call {
+	return &MockStore_DeleteDatasetRowPolicy_Call{Call: _e.mock.On("DeleteDatasetRowPolicy", ctx, policyId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteDatasetRowPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteDatasetRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetRowPolicy_Call) Return(_a0 error) *MockStore_DeleteDatasetRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteDatasetRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetStatusWorkflow provides a mock function with given fields: ctx, workflowId, deletedBy
+func (_m *MockStore) DeleteDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, workflowId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetStatusWorkflow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, workflowId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetStatusWorkflow'
+type MockStore_DeleteDatasetStatusWorkflow_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetStatusWorkflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteDatasetStatusWorkflow(ctx interface{}, workflowId interface{}, deletedBy interface{}) *MockStore_DeleteDatasetStatusWorkflow_Call {
+	return &MockStore_DeleteDatasetStatusWorkflow_Call{Call: _e.mock.On("DeleteDatasetStatusWorkflow", ctx, workflowId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, workflowId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetStatusWorkflow_Call) Return(_a0 error) *MockStore_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteDatasetStatusWorkflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetView provides a mock function with given fields: ctx, viewId, deletedBy
+func (_m *MockStore) DeleteDatasetView(ctx context.Context, viewId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, viewId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, viewId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetView'
+type MockStore_DeleteDatasetView_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteDatasetView(ctx interface{}, viewId interface{}, deletedBy interface{}) *MockStore_DeleteDatasetView_Call {
+	return &MockStore_DeleteDatasetView_Call{Call: _e.mock.On("DeleteDatasetView", ctx, viewId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteDatasetView_Call) Run(run func(ctx context.Context, viewId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetView_Call) Return(_a0 error) *MockStore_DeleteDatasetView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceType, audienceId
+func (_m *MockStore) DeleteDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, viewId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDatasetViewPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, viewId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetViewPolicy'
+type MockStore_DeleteDatasetViewPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteDatasetViewPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockStore_Expecter) DeleteDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceType interface{}, audienceId interface{}) *MockStore_DeleteDatasetViewPolicy_Call {
+	return &MockStore_DeleteDatasetViewPolicy_Call{Call: _e.mock.On("DeleteDatasetViewPolicy", ctx, viewId, audienceType, audienceId)}
+}
+
+func (_c *MockStore_DeleteDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetViewPolicy_Call) Return(_a0 error) *MockStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockStore_DeleteDatasetViewPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId
+func (_m *MockStore) DeleteDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) error {
+	ret := _m.Called(ctx, userId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDefaultDatasetView")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userId, datasetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDefaultDatasetView'
+type MockStore_DeleteDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// DeleteDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) DeleteDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}) *MockStore_DeleteDefaultDatasetView_Call {
+	return &MockStore_DeleteDefaultDatasetView_Call{Call: _e.mock.On("DeleteDefaultDatasetView", ctx, userId, datasetId)}
+}
+
+func (_c *MockStore_DeleteDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID)) *MockStore_DeleteDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteDefaultDatasetView_Call) Return(_a0 error) *MockStore_DeleteDefaultDatasetView_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFxRateSource provides a mock function with given fields: ctx, sourceId, deletedBy
+func (_m *MockStore) DeleteFxRateSource(ctx context.Context, sourceId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, sourceId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFxRateSource")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, sourceId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteFxRateSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFxRateSource'
+type MockStore_DeleteFxRateSource_Call struct {
+	*mock.Call
+}
+
+// DeleteFxRateSource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteFxRateSource(ctx interface{}, sourceId interface{}, deletedBy interface{}) *MockStore_DeleteFxRateSource_Call {
+	return &MockStore_DeleteFxRateSource_Call{Call: _e.mock.On("DeleteFxRateSource", ctx, sourceId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteFxRateSource_Call) Run(run func(ctx context.Context, sourceId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteFxRateSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteFxRateSource_Call) Return(_a0 error) *MockStore_DeleteFxRateSource_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteFxRateSource_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteFxRateSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId
+func (_m *MockStore) DeleteOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, orgId, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOrganizationPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, orgId, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationPolicy'
+type MockStore_DeleteOrganizationPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteOrganizationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+//   - audienceId uuid.UUID
+func (_e *MockStore_Expecter) DeleteOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}) *MockStore_DeleteOrganizationPolicy_Call {
+	return &MockStore_DeleteOrganizationPolicy_Call{Call: _e.mock.On("DeleteOrganizationPolicy", ctx, orgId, audienceId)}
+}
+
+func (_c *MockStore_DeleteOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID)) *MockStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteOrganizationPolicy_Call) Return(_a0 error) *MockStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteOrganizationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePage provides a mock function with given fields: ctx, pageId
+func (_m *MockStore) DeletePage(ctx context.Context, pageId uuid.UUID) error {
+	ret := _m.Called(ctx, pageId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, pageId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeletePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePage'
+type MockStore_DeletePage_Call struct {
+	*mock.Call
+}
+
+// DeletePage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+func (_e *MockStore_Expecter) DeletePage(ctx interface{}, pageId interface{}) *MockStore_DeletePage_Call {
+	return &MockStore_DeletePage_Call{Call: _e.mock.On("DeletePage", ctx, pageId)}
+}
+
+func (_c *MockStore_DeletePage_Call) Run(run func(ctx context.Context, pageId uuid.UUID)) *MockStore_DeletePage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeletePage_Call) Return(_a0 error) *MockStore_DeletePage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeletePage_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeletePage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePagePolicy provides a mock function with given fields: ctx, pageId, audienceType, audienceId
+func (_m *MockStore) DeletePagePolicy(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
+	ret := _m.Called(ctx, pageId, audienceType, audienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePagePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
+		r0 = rf(ctx, pageId, audienceType, audienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeletePagePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePagePolicy'
+type MockStore_DeletePagePolicy_Call struct {
+	*mock.Call
+}
+
+// DeletePagePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+//   - audienceType models.AudienceType
+//   - audienceId uuid.UUID
+func (_e *MockStore_Expecter) DeletePagePolicy(ctx interface{}, pageId interface{}, audienceType interface{}, audienceId interface{}) *MockStore_DeletePagePolicy_Call {
+	return &MockStore_DeletePagePolicy_Call{Call: _e.mock.On("DeletePagePolicy", ctx, pageId, audienceType, audienceId)}
+}
+
+func (_c *MockStore_DeletePagePolicy_Call) Run(run func(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockStore_DeletePagePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeletePagePolicy_Call) Return(_a0 error) *MockStore_DeletePagePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeletePagePolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockStore_DeletePagePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePaymentsConfigById provides a mock function with given fields: ctx, paymentsConfigId
+func (_m *MockStore) DeletePaymentsConfigById(ctx context.Context, paymentsConfigId uuid.UUID) error {
+	ret := _m.Called(ctx, paymentsConfigId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePaymentsConfigById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, paymentsConfigId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeletePaymentsConfigById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePaymentsConfigById'
+type MockStore_DeletePaymentsConfigById_Call struct {
+	*mock.Call
+}
+
+// DeletePaymentsConfigById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentsConfigId uuid.UUID
+func (_e *MockStore_Expecter) DeletePaymentsConfigById(ctx interface{}, paymentsConfigId interface{}) *MockStore_DeletePaymentsConfigById_Call {
+	return &MockStore_DeletePaymentsConfigById_Call{Call: _e.mock.On("DeletePaymentsConfigById", ctx, paymentsConfigId)}
+}
+
+func (_c *MockStore_DeletePaymentsConfigById_Call) Run(run func(ctx context.Context, paymentsConfigId uuid.UUID)) *MockStore_DeletePaymentsConfigById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeletePaymentsConfigById_Call) Return(_a0 error) *MockStore_DeletePaymentsConfigById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeletePaymentsConfigById_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeletePaymentsConfigById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReconciliation provides a mock function with given fields: ctx, reconciliationId, deletedBy
+func (_m *MockStore) DeleteReconciliation(ctx context.Context, reconciliationId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, reconciliationId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReconciliation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, reconciliationId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReconciliation'
+type MockStore_DeleteReconciliation_Call struct {
+	*mock.Call
+}
+
+// DeleteReconciliation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteReconciliation(ctx interface{}, reconciliationId interface{}, deletedBy interface{}) *MockStore_DeleteReconciliation_Call {
+	return &MockStore_DeleteReconciliation_Call{Call: _e.mock.On("DeleteReconciliation", ctx, reconciliationId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteReconciliation_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteReconciliation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteReconciliation_Call) Return(_a0 error) *MockStore_DeleteReconciliation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteReconciliation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteReferenceBank provides a mock function with given fields: ctx, bankId, deletedBy
+func (_m *MockStore) DeleteReferenceBank(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, bankId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReferenceBank")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, bankId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteReferenceBank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteReferenceBank'
+type MockStore_DeleteReferenceBank_Call struct {
+	*mock.Call
+}
+
+// DeleteReferenceBank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bankId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteReferenceBank(ctx interface{}, bankId interface{}, deletedBy interface{}) *MockStore_DeleteReferenceBank_Call {
+	return &MockStore_DeleteReferenceBank_Call{Call: _e.mock.On("DeleteReferenceBank", ctx, bankId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteReferenceBank_Call) Run(run func(ctx context.Context, bankId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteReferenceBank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteReferenceBank_Call) Return(_a0 error) *MockStore_DeleteReferenceBank_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteReferenceBank_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteReferenceBank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRule provides a mock function with given fields: ctx, params
+func (_m *MockStore) DeleteRule(ctx context.Context, params models.DeleteRuleParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DeleteRuleParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRule'
+type MockStore_DeleteRule_Call struct {
+	*mock.Call
+}
+
+// DeleteRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.DeleteRuleParams
+func (_e *MockStore_Expecter) DeleteRule(ctx interface{}, params interface{}) *MockStore_DeleteRule_Call {
+	return &MockStore_DeleteRule_Call{Call: _e.mock.On("DeleteRule", ctx, params)}
+}
+
+func (_c *MockStore_DeleteRule_Call) Run(run func(ctx context.Context, params models.DeleteRuleParams)) *MockStore_DeleteRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DeleteRuleParams))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteRule_Call) Return(_a0 error) *MockStore_DeleteRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteRule_Call) RunAndReturn(run func(context.Context, models.DeleteRuleParams) error) *MockStore_DeleteRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSheet provides a mock function with given fields: ctx, sheetId
+func (_m *MockStore) DeleteSheet(ctx context.Context, sheetId uuid.UUID) error {
+	ret := _m.Called(ctx, sheetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSheet")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sheetId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSheet'
+type MockStore_DeleteSheet_Call struct {
+	*mock.Call
+}
+
+// DeleteSheet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sheetId uuid.UUID
+func (_e *MockStore_Expecter) DeleteSheet(ctx interface{}, sheetId interface{}) *MockStore_DeleteSheet_Call {
+	return &MockStore_DeleteSheet_Call{Call: _e.mock.On("DeleteSheet", ctx, sheetId)}
+}
+
+func (_c *MockStore_DeleteSheet_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockStore_DeleteSheet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteSheet_Call) Return(_a0 error) *MockStore_DeleteSheet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteSheet_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteSheet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, tagId, deletedBy
+func (_m *MockStore) DeleteTag(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, tagId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, tagId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockStore_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockStore_Expecter) DeleteTag(ctx interface{}, tagId interface{}, deletedBy interface{}) *MockStore_DeleteTag_Call {
+	return &MockStore_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, tagId, deletedBy)}
+}
+
+func (_c *MockStore_DeleteTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID)) *MockStore_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteTag_Call) Return(_a0 error) *MockStore_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeam provides a mock function with given fields: ctx, organizationId, teamId
+func (_m *MockStore) DeleteTeam(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID) error {
+	ret := _m.Called(ctx, organizationId, teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, organizationId, teamId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type MockStore_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - teamId uuid.UUID
+func (_e *MockStore_Expecter) DeleteTeam(ctx interface{}, organizationId interface{}, teamId interface{}) *MockStore_DeleteTeam_Call {
+	return &MockStore_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, organizationId, teamId)}
+}
+
+func (_c *MockStore_DeleteTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID)) *MockStore_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteTeam_Call) Return(_a0 error) *MockStore_DeleteTeam_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeamMembership provides a mock function with given fields: ctx, teamId, teamMembershipId
+func (_m *MockStore) DeleteTeamMembership(ctx context.Context, teamId uuid.UUID, teamMembershipId uuid.UUID) error {
+	ret := _m.Called(ctx, teamId, teamMembershipId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeamMembership")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, teamId, teamMembershipId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteTeamMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeamMembership'
+type MockStore_DeleteTeamMembership_Call struct {
+	*mock.Call
+}
+
+// DeleteTeamMembership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamId uuid.UUID
+//   - teamMembershipId uuid.UUID
+func (_e *MockStore_Expecter) DeleteTeamMembership(ctx interface{}, teamId interface{}, teamMembershipId interface{}) *MockStore_DeleteTeamMembership_Call {
+	return &MockStore_DeleteTeamMembership_Call{Call: _e.mock.On("DeleteTeamMembership", ctx, teamId, teamMembershipId)}
+}
+
+func (_c *MockStore_DeleteTeamMembership_Call) Run(run func(ctx context.Context, teamId uuid.UUID, teamMembershipId uuid.UUID)) *MockStore_DeleteTeamMembership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteTeamMembership_Call) Return(_a0 error) *MockStore_DeleteTeamMembership_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteTeamMembership_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteTeamMembership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWidgetInstance provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockStore) DeleteWidgetInstance(ctx context.Context, widgetInstanceID uuid.UUID) error {
+	ret := _m.Called(ctx, widgetInstanceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidgetInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, widgetInstanceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_DeleteWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWidgetInstance'
+type MockStore_DeleteWidgetInstance_Call struct {
+	*mock.Call
+}
+
+// DeleteWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstanceID uuid.UUID
+func (_e *MockStore_Expecter) DeleteWidgetInstance(ctx interface{}, widgetInstanceID interface{}) *MockStore_DeleteWidgetInstance_Call {
+	return &MockStore_DeleteWidgetInstance_Call{Call: _e.mock.On("DeleteWidgetInstance", ctx, widgetInstanceID)}
+}
+
+func (_c *MockStore_DeleteWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockStore_DeleteWidgetInstance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteWidgetInstance_Call) Return(_a0 error) *MockStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_DeleteWidgetInstance_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeleteWidgetInstance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllConnectors provides a mock function with given fields: ctx
+func (_m *MockStore) GetAllConnectors(ctx context.Context) ([]models.ConnectorWithActiveConnectionsCount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllConnectors")
+	}
+
+	var r0 []models.ConnectorWithActiveConnectionsCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ConnectorWithActiveConnectionsCount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ConnectorWithActiveConnectionsCount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ConnectorWithActiveConnectionsCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetAllConnectors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllConnectors'
+type MockStore_GetAllConnectors_Call struct {
+	*mock.Call
+}
+
+// GetAllConnectors is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetAllConnectors(ctx interface{}) *MockStore_GetAllConnectors_Call {
+	return &MockStore_GetAllConnectors_Call{Call: _e.mock.On("GetAllConnectors", ctx)}
+}
+
+func (_c *MockStore_GetAllConnectors_Call) Run(run func(ctx context.Context)) *MockStore_GetAllConnectors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetAllConnectors_Call) Return(_a0 []models.ConnectorWithActiveConnectionsCount, _a1 error) *MockStore_GetAllConnectors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetAllConnectors_Call) RunAndReturn(run func(context.Context) ([]models.ConnectorWithActiveConnectionsCount, error)) *MockStore_GetAllConnectors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllFileUploads provides a mock function with given fields: ctx
+func (_m *MockStore) GetAllFileUploads(ctx context.Context) ([]*models.FileUpload, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllFileUploads")
+	}
+
+	var r0 []*models.FileUpload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.FileUpload, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*models.FileUpload); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.FileUpload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetAllFileUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllFileUploads'
+type MockStore_GetAllFileUploads_Call struct {
+	*mock.Call
+}
+
+// GetAllFileUploads is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetAllFileUploads(ctx interface{}) *MockStore_GetAllFileUploads_Call {
+	return &MockStore_GetAllFileUploads_Call{Call: _e.mock.On("GetAllFileUploads", ctx)}
+}
+
+func (_c *MockStore_GetAllFileUploads_Call) Run(run func(ctx context.Context)) *MockStore_GetAllFileUploads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetAllFileUploads_Call) Return(_a0 []*models.FileUpload, _a1 error) *MockStore_GetAllFileUploads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetAllFileUploads_Call) RunAndReturn(run func(context.Context) ([]*models.FileUpload, error)) *MockStore_GetAllFileUploads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuditLogsByOrganizationId provides a mock function with given fields: ctx, organizationId, kind
+func (_m *MockStore) GetAuditLogsByOrganizationId(ctx context.Context, organizationId uuid.UUID, kind models.AuditLogKind) ([]models.AuditLog, error) {
+	ret := _m.Called(ctx, organizationId, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditLogsByOrganizationId")
+	}
+
+	var r0 []models.AuditLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AuditLogKind) ([]models.AuditLog, error)); ok {
+		return rf(ctx, organizationId, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AuditLogKind) []models.AuditLog); ok {
+		r0 = rf(ctx, organizationId, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AuditLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.AuditLogKind) error); ok {
+		r1 = rf(ctx, organizationId, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetAuditLogsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditLogsByOrganizationId'
+type MockStore_GetAuditLogsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetAuditLogsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - kind models.AuditLogKind
+func (_e *MockStore_Expecter) GetAuditLogsByOrganizationId(ctx interface{}, organizationId interface{}, kind interface{}) *MockStore_GetAuditLogsByOrganizationId_Call {
+	return &MockStore_GetAuditLogsByOrganizationId_Call{Call: _e.mock.On("GetAuditLogsByOrganizationId", ctx, organizationId, kind)}
+}
+
+func (_c *MockStore_GetAuditLogsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, kind models.AuditLogKind)) *MockStore_GetAuditLogsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AuditLogKind))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetAuditLogsByOrganizationId_Call) Return(_a0 []models.AuditLog, _a1 error) *MockStore_GetAuditLogsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetAuditLogsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AuditLogKind) ([]models.AuditLog, error)) *MockStore_GetAuditLogsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionByID provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetConnectionByID(ctx context.Context, id uuid.UUID) (*models.Connection, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionByID")
+	}
+
+	var r0 *models.Connection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Connection, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Connection); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Connection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetConnectionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionByID'
+type MockStore_GetConnectionByID_Call struct {
+	*mock.Call
+}
+
+// GetConnectionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetConnectionByID(ctx interface{}, id interface{}) *MockStore_GetConnectionByID_Call {
+	return &MockStore_GetConnectionByID_Call{Call: _e.mock.On("GetConnectionByID", ctx, id)}
+}
+
+func (_c *MockStore_GetConnectionByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetConnectionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetConnectionByID_Call) Return(_a0 *models.Connection, _a1 error) *MockStore_GetConnectionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetConnectionByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Connection, error)) *MockStore_GetConnectionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectionPolicies provides a mock function with given fields: ctx, connectionId
+func (_m *MockStore) GetConnectionPolicies(ctx context.Context, connectionId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, connectionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectionPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, connectionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, connectionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, connectionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetConnectionPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionPolicies'
+type MockStore_GetConnectionPolicies_Call struct {
+	*mock.Call
+}
+
+// GetConnectionPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connectionId uuid.UUID
+func (_e *MockStore_Expecter) GetConnectionPolicies(ctx interface{}, connectionId interface{}) *MockStore_GetConnectionPolicies_Call {
+	return &MockStore_GetConnectionPolicies_Call{Call: _e.mock.On("GetConnectionPolicies", ctx, connectionId)}
+}
+
+func (_c *MockStore_GetConnectionPolicies_Call) Run(run func(ctx context.Context, connectionId uuid.UUID)) *MockStore_GetConnectionPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetConnectionPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetConnectionPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetConnectionPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetConnectionPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnections provides a mock function with given fields: ctx
+func (_m *MockStore) GetConnections(ctx context.Context) ([]models.Connection, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnections")
+	}
+
+	var r0 []models.Connection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Connection, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Connection); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Connection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnections'
+type MockStore_GetConnections_Call struct {
+	*mock.Call
+}
+
+// GetConnections is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetConnections(ctx interface{}) *MockStore_GetConnections_Call {
+	return &MockStore_GetConnections_Call{Call: _e.mock.On("GetConnections", ctx)}
+}
+
+func (_c *MockStore_GetConnections_Call) Run(run func(ctx context.Context)) *MockStore_GetConnections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetConnections_Call) Return(_a0 []models.Connection, _a1 error) *MockStore_GetConnections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetConnections_Call) RunAndReturn(run func(context.Context) ([]models.Connection, error)) *MockStore_GetConnections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConnectorById provides a mock function with given fields: ctx, id
+func (_m *MockStore) GetConnectorById(ctx context.Context, id uuid.UUID) (*models.Connector, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConnectorById")
+	}
+
+	var r0 *models.Connector
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Connector, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Connector); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Connector)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetConnectorById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectorById'
+type MockStore_GetConnectorById_Call struct {
+	*mock.Call
+}
+
+// GetConnectorById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockStore_Expecter) GetConnectorById(ctx interface{}, id interface{}) *MockStore_GetConnectorById_Call {
+	return &MockStore_GetConnectorById_Call{Call: _e.mock.On("GetConnectorById", ctx, id)}
+}
+
+func (_c *MockStore_GetConnectorById_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetConnectorById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetConnectorById_Call) Return(_a0 *models.Connector, _a1 error) *MockStore_GetConnectorById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetConnectorById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Connector, error)) *MockStore_GetConnectorById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetActionFromActionId provides a mock function with given fields: ctx, actionId
+func (_m *MockStore) GetDatasetActionFromActionId(ctx context.Context, actionId string) (*models.DatasetAction, error) {
+	ret := _m.Called(ctx, actionId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetActionFromActionId")
+	}
+
+	var r0 *models.DatasetAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.DatasetAction, error)); ok {
+		return rf(ctx, actionId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.DatasetAction); ok {
+		r0 = rf(ctx, actionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DatasetAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, actionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetActionFromActionId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActionFromActionId'
+type MockStore_GetDatasetActionFromActionId_Call struct {
+	*mock.Call
+}
+
+// GetDatasetActionFromActionId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actionId string
+func (_e *MockStore_Expecter) GetDatasetActionFromActionId(ctx interface{}, actionId interface{}) *MockStore_GetDatasetActionFromActionId_Call {
+	return &MockStore_GetDatasetActionFromActionId_Call{Call: _e.mock.On("GetDatasetActionFromActionId", ctx, actionId)}
+}
+
+func (_c *MockStore_GetDatasetActionFromActionId_Call) Run(run func(ctx context.Context, actionId string)) *MockStore_GetDatasetActionFromActionId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetActionFromActionId_Call) Return(_a0 *models.DatasetAction, _a1 error) *MockStore_GetDatasetActionFromActionId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetActionFromActionId_Call) RunAndReturn(run func(context.Context, string) (*models.DatasetAction, error)) *MockStore_GetDatasetActionFromActionId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetActions provides a mock function with given fields: ctx, organizationId, filters
+func (_m *MockStore) GetDatasetActions(ctx context.Context, organizationId uuid.UUID, filters models.DatasetActionFilters) ([]models.DatasetAction, error) {
+	ret := _m.Called(ctx, organizationId, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetActions")
+	}
+
+	var r0 []models.DatasetAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]models.DatasetAction, error)); ok {
+		return rf(ctx, organizationId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) []models.DatasetAction); ok {
+		r0 = rf(ctx, organizationId, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetActionFilters) error); ok {
+		r1 = rf(ctx, organizationId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActions'
+type MockStore_GetDatasetActions_Call struct {
+	*mock.Call
+}
+
+// GetDatasetActions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - filters models.DatasetActionFilters
+func (_e *MockStore_Expecter) GetDatasetActions(ctx interface{}, organizationId interface{}, filters interface{}) *MockStore_GetDatasetActions_Call {
+	return &MockStore_GetDatasetActions_Call{Call: _e.mock.On("GetDatasetActions", ctx, organizationId, filters)}
+}
+
+func (_c *MockStore_GetDatasetActions_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, filters models.DatasetActionFilters)) *MockStore_GetDatasetActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetActionFilters))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetActions_Call) Return(_a0 []models.DatasetAction, _a1 error) *MockStore_GetDatasetActions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetActions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]models.DatasetAction, error)) *MockStore_GetDatasetActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlertById provides a mock function with given fields: ctx, alertId
+func (_m *MockStore) GetDatasetAlertById(ctx context.Context, alertId uuid.UUID) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, alertId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlertById")
+	}
+
+	var r0 models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetAlert, error)); ok {
+		return rf(ctx, alertId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetAlert); ok {
+		r0 = rf(ctx, alertId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetAlert)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, alertId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetAlertById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlertById'
+type MockStore_GetDatasetAlertById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlertById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetAlertById(ctx interface{}, alertId interface{}) *MockStore_GetDatasetAlertById_Call {
+	return &MockStore_GetDatasetAlertById_Call{Call: _e.mock.On("GetDatasetAlertById", ctx, alertId)}
+}
+
+func (_c *MockStore_GetDatasetAlertById_Call) Run(run func(ctx context.Context, alertId uuid.UUID)) *MockStore_GetDatasetAlertById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetAlertById_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockStore_GetDatasetAlertById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetAlertById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetAlert, error)) *MockStore_GetDatasetAlertById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlertEvents provides a mock function with given fields: ctx, alertId, limit
+func (_m *MockStore) GetDatasetAlertEvents(ctx context.Context, alertId uuid.UUID, limit int) ([]models.DatasetAlertEvent, error) {
+	ret := _m.Called(ctx, alertId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlertEvents")
+	}
+
+	var r0 []models.DatasetAlertEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.DatasetAlertEvent, error)); ok {
+		return rf(ctx, alertId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.DatasetAlertEvent); ok {
+		r0 = rf(ctx, alertId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetAlertEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, alertId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetAlertEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlertEvents'
+type MockStore_GetDatasetAlertEvents_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlertEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alertId uuid.UUID
+//   - limit int
+func (_e *MockStore_Expecter) GetDatasetAlertEvents(ctx interface{}, alertId interface{}, limit interface{}) *MockStore_GetDatasetAlertEvents_Call {
+	return &MockStore_GetDatasetAlertEvents_Call{Call: _e.mock.On("GetDatasetAlertEvents", ctx, alertId, limit)}
+}
+
+func (_c *MockStore_GetDatasetAlertEvents_Call) Run(run func(ctx context.Context, alertId uuid.UUID, limit int)) *MockStore_GetDatasetAlertEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetAlertEvents_Call) Return(_a0 []models.DatasetAlertEvent, _a1 error) *MockStore_GetDatasetAlertEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetAlertEvents_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]models.DatasetAlertEvent, error)) *MockStore_GetDatasetAlertEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetAlerts provides a mock function with given fields: ctx, datasetId
+func (_m *MockStore) GetDatasetAlerts(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetAlert, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetAlerts")
+	}
+
+	var r0 []models.DatasetAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetAlert, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetAlert); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetAlerts'
+type MockStore_GetDatasetAlerts_Call struct {
+	*mock.Call
+}
+
+// GetDatasetAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetAlerts(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetAlerts_Call {
+	return &MockStore_GetDatasetAlerts_Call{Call: _e.mock.On("GetDatasetAlerts", ctx, datasetId)}
+}
+
+func (_c *MockStore_GetDatasetAlerts_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetAlerts_Call) Return(_a0 []models.DatasetAlert, _a1 error) *MockStore_GetDatasetAlerts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetAlerts_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetAlert, error)) *MockStore_GetDatasetAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetById provides a mock function with given fields: ctx, datasetId
+func (_m *MockStore) GetDatasetById(ctx context.Context, datasetId string) (*models.Dataset, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetById")
+	}
+
+	var r0 *models.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Dataset, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Dataset); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetById'
+type MockStore_GetDatasetById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId string
+func (_e *MockStore_Expecter) GetDatasetById(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetById_Call {
+	return &MockStore_GetDatasetById_Call{Call: _e.mock.On("GetDatasetById", ctx, datasetId)}
+}
+
+func (_c *MockStore_GetDatasetById_Call) Run(run func(ctx context.Context, datasetId string)) *MockStore_GetDatasetById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetById_Call) Return(_a0 *models.Dataset, _a1 error) *MockStore_GetDatasetById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetById_Call) RunAndReturn(run func(context.Context, string) (*models.Dataset, error)) *MockStore_GetDatasetById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockStore) GetDatasetColumnPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPolicies")
+	}
+
+	var r0 []models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetColumnPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetColumnPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPolicies'
+type MockStore_GetDatasetColumnPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetColumnPolicies(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetColumnPolicies_Call {
+	return &MockStore_GetDatasetColumnPolicies_Call{Call: _e.mock.On("GetDatasetColumnPolicies", ctx, datasetId)}
+}
+
+func (_c *MockStore_GetDatasetColumnPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetColumnPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetColumnPolicies_Call) Return(_a0 []models.DatasetColumnPolicy, _a1 error) *MockStore_GetDatasetColumnPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetColumnPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetColumnPolicy, error)) *MockStore_GetDatasetColumnPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPoliciesForUser provides a mock function with given fields: ctx, datasetId, userId
+func (_m *MockStore) GetDatasetColumnPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, datasetId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPoliciesForUser")
+	}
+
+	var r0 []models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, datasetId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, datasetId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetColumnPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetColumnPoliciesForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPoliciesForUser'
+type MockStore_GetDatasetColumnPoliciesForUser_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPoliciesForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetColumnPoliciesForUser(ctx interface{}, datasetId interface{}, userId interface{}) *MockStore_GetDatasetColumnPoliciesForUser_Call {
+	return &MockStore_GetDatasetColumnPoliciesForUser_Call{Call: _e.mock.On("GetDatasetColumnPoliciesForUser", ctx, datasetId, userId)}
+}
+
+func (_c *MockStore_GetDatasetColumnPoliciesForUser_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID)) *MockStore_GetDatasetColumnPoliciesForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetColumnPoliciesForUser_Call) Return(_a0 []models.DatasetColumnPolicy, _a1 error) *MockStore_GetDatasetColumnPoliciesForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetColumnPoliciesForUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetColumnPolicy, error)) *MockStore_GetDatasetColumnPoliciesForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetColumnPolicyById provides a mock function with given fields: ctx, policyId
+func (_m *MockStore) GetDatasetColumnPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, policyId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetColumnPolicyById")
+	}
+
+	var r0 models.DatasetColumnPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, policyId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, policyId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetColumnPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, policyId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetColumnPolicyById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetColumnPolicyById'
+type MockStore_GetDatasetColumnPolicyById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetColumnPolicyById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetColumnPolicyById(ctx interface{}, policyId interface{}) *MockStore_GetDatasetColumnPolicyById_Call {
+	return &MockStore_GetDatasetColumnPolicyById_Call{Call: _e.mock.On("GetDatasetColumnPolicyById", ctx, policyId)}
+}
+
+func (_c *MockStore_GetDatasetColumnPolicyById_Call) Run(run func(ctx context.Context, policyId uuid.UUID)) *MockStore_GetDatasetColumnPolicyById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetColumnPolicyById_Call) Return(_a0 models.DatasetColumnPolicy, _a1 error) *MockStore_GetDatasetColumnPolicyById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetColumnPolicyById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetColumnPolicy, error)) *MockStore_GetDatasetColumnPolicyById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetCount provides a mock function with given fields: ctx, filters
+func (_m *MockStore) GetDatasetCount(ctx context.Context, filters models.DatasetFilters) (int64, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) (int64, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) int64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetCount'
+type MockStore_GetDatasetCount_Call struct {
+	*mock.Call
+}
+
+// GetDatasetCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.DatasetFilters
+func (_e *MockStore_Expecter) GetDatasetCount(ctx interface{}, filters interface{}) *MockStore_GetDatasetCount_Call {
+	return &MockStore_GetDatasetCount_Call{Call: _e.mock.On("GetDatasetCount", ctx, filters)}
+}
+
+func (_c *MockStore_GetDatasetCount_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockStore_GetDatasetCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetCount_Call) Return(_a0 int64, _a1 error) *MockStore_GetDatasetCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetCount_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) (int64, error)) *MockStore_GetDatasetCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportScheduleById provides a mock function with given fields: ctx, scheduleId
+func (_m *MockStore) GetDatasetExportScheduleById(ctx context.Context, scheduleId uuid.UUID) (models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, scheduleId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportScheduleById")
+	}
+
+	var r0 models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, scheduleId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, scheduleId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetExportSchedule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, scheduleId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetExportScheduleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportScheduleById'
+type MockStore_GetDatasetExportScheduleById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportScheduleById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetExportScheduleById(ctx interface{}, scheduleId interface{}) *MockStore_GetDatasetExportScheduleById_Call {
+	return &MockStore_GetDatasetExportScheduleById_Call{Call: _e.mock.On("GetDatasetExportScheduleById", ctx, scheduleId)}
+}
+
+func (_c *MockStore_GetDatasetExportScheduleById_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID)) *MockStore_GetDatasetExportScheduleById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetExportScheduleById_Call) Return(_a0 models.DatasetExportSchedule, _a1 error) *MockStore_GetDatasetExportScheduleById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetExportScheduleById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetExportSchedule, error)) *MockStore_GetDatasetExportScheduleById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportScheduleRuns provides a mock function with given fields: ctx, scheduleId, limit
+func (_m *MockStore) GetDatasetExportScheduleRuns(ctx context.Context, scheduleId uuid.UUID, limit int) ([]models.DatasetExportScheduleRun, error) {
+	ret := _m.Called(ctx, scheduleId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportScheduleRuns")
+	}
+
+	var r0 []models.DatasetExportScheduleRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.DatasetExportScheduleRun, error)); ok {
+		return rf(ctx, scheduleId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.DatasetExportScheduleRun); ok {
+		r0 = rf(ctx, scheduleId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetExportScheduleRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, scheduleId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetExportScheduleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportScheduleRuns'
+type MockStore_GetDatasetExportScheduleRuns_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportScheduleRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+//   - limit int
+func (_e *MockStore_Expecter) GetDatasetExportScheduleRuns(ctx interface{}, scheduleId interface{}, limit interface{}) *MockStore_GetDatasetExportScheduleRuns_Call {
+	return &MockStore_GetDatasetExportScheduleRuns_Call{Call: _e.mock.On("GetDatasetExportScheduleRuns", ctx, scheduleId, limit)}
+}
+
+func (_c *MockStore_GetDatasetExportScheduleRuns_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID, limit int)) *MockStore_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetExportScheduleRuns_Call) Return(_a0 []models.DatasetExportScheduleRun, _a1 error) *MockStore_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetExportScheduleRuns_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]models.DatasetExportScheduleRun, error)) *MockStore_GetDatasetExportScheduleRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetExportSchedules provides a mock function with given fields: ctx, datasetId
+func (_m *MockStore) GetDatasetExportSchedules(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetExportSchedules")
+	}
+
+	var r0 []models.DatasetExportSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetExportSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetExportSchedules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetExportSchedules'
+type MockStore_GetDatasetExportSchedules_Call struct {
+	*mock.Call
+}
+
+// GetDatasetExportSchedules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetExportSchedules(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetExportSchedules_Call {
+	return &MockStore_GetDatasetExportSchedules_Call{Call: _e.mock.On("GetDatasetExportSchedules", ctx, datasetId)}
+}
+
+func (_c *MockStore_GetDatasetExportSchedules_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetExportSchedules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetExportSchedules_Call) Return(_a0 []models.DatasetExportSchedule, _a1 error) *MockStore_GetDatasetExportSchedules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetExportSchedules_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetExportSchedule, error)) *MockStore_GetDatasetExportSchedules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetFileUploadByDatasetId provides a mock function with given fields: ctx, datasetId
+func (_m *MockStore) GetDatasetFileUploadByDatasetId(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetFileUpload, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetFileUploadByDatasetId")
+	}
+
+	var r0 []models.DatasetFileUpload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetFileUpload, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetFileUpload); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetFileUpload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetFileUploadByDatasetId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploadByDatasetId'
+type MockStore_GetDatasetFileUploadByDatasetId_Call struct {
+	*mock.Call
+}
+
+// GetDatasetFileUploadByDatasetId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetFileUploadByDatasetId(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetFileUploadByDatasetId_Call {
+	return &MockStore_GetDatasetFileUploadByDatasetId_Call{Call: _e.mock.On("GetDatasetFileUploadByDatasetId", ctx, datasetId)}
+}
+
+func (_c *MockStore_GetDatasetFileUploadByDatasetId_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetFileUploadByDatasetId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetFileUploadByDatasetId_Call) Return(_a0 []models.DatasetFileUpload, _a1 error) *MockStore_GetDatasetFileUploadByDatasetId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetFileUploadByDatasetId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetFileUpload, error)) *MockStore_GetDatasetFileUploadByDatasetId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetFileUploadById provides a mock function with given fields: ctx, fileUploadId
+func (_m *MockStore) GetDatasetFileUploadById(ctx context.Context, fileUploadId uuid.UUID) (models.DatasetFileUpload, error) {
+	ret := _m.Called(ctx, fileUploadId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetFileUploadById")
+	}
+
+	var r0 models.DatasetFileUpload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetFileUpload, error)); ok {
+		return rf(ctx, fileUploadId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetFileUpload); ok {
+		r0 = rf(ctx, fileUploadId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetFileUpload)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, fileUploadId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetFileUploadById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploadById'
+type MockStore_GetDatasetFileUploadById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetFileUploadById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fileUploadId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetFileUploadById(ctx interface{}, fileUploadId interface{}) *MockStore_GetDatasetFileUploadById_Call {
+	return &MockStore_GetDatasetFileUploadById_Call{Call: _e.mock.On("GetDatasetFileUploadById", ctx, fileUploadId)}
+}
+
+func (_c *MockStore_GetDatasetFileUploadById_Call) Run(run func(ctx context.Context, fileUploadId uuid.UUID)) *MockStore_GetDatasetFileUploadById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetFileUploadById_Call) Return(_a0 models.DatasetFileUpload, _a1 error) *MockStore_GetDatasetFileUploadById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetFileUploadById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetFileUpload, error)) *MockStore_GetDatasetFileUploadById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockStore) GetDatasetPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPolicies'
+type MockStore_GetDatasetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetPolicies(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetPolicies_Call {
+	return &MockStore_GetDatasetPolicies_Call{Call: _e.mock.On("GetDatasetPolicies", ctx, datasetId)}
+}
+
+func (_c *MockStore_GetDatasetPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetDatasetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetDatasetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetPoliciesByEmail provides a mock function with given fields: ctx, datasetId, email
+func (_m *MockStore) GetDatasetPoliciesByEmail(ctx context.Context, datasetId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetPoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, datasetId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPoliciesByEmail'
+type MockStore_GetDatasetPoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetDatasetPoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - email string
+func (_e *MockStore_Expecter) GetDatasetPoliciesByEmail(ctx interface{}, datasetId interface{}, email interface{}) *MockStore_GetDatasetPoliciesByEmail_Call {
+	return &MockStore_GetDatasetPoliciesByEmail_Call{Call: _e.mock.On("GetDatasetPoliciesByEmail", ctx, datasetId, email)}
+}
+
+func (_c *MockStore_GetDatasetPoliciesByEmail_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, email string)) *MockStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetDatasetPoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowAssignment provides a mock function with given fields: ctx, datasetId, rowId
+func (_m *MockStore) GetDatasetRowAssignment(ctx context.Context, datasetId uuid.UUID, rowId string) (models.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, datasetId, rowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowAssignment")
+	}
+
+	var r0 models.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (models.DatasetRowAssignment, error)); ok {
+		return rf(ctx, datasetId, rowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) models.DatasetRowAssignment); ok {
+		r0 = rf(ctx, datasetId, rowId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowAssignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, datasetId, rowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetRowAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowAssignment'
+type MockStore_GetDatasetRowAssignment_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowAssignment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - rowId string
+func (_e *MockStore_Expecter) GetDatasetRowAssignment(ctx interface{}, datasetId interface{}, rowId interface{}) *MockStore_GetDatasetRowAssignment_Call {
+	return &MockStore_GetDatasetRowAssignment_Call{Call: _e.mock.On("GetDatasetRowAssignment", ctx, datasetId, rowId)}
+}
+
+func (_c *MockStore_GetDatasetRowAssignment_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, rowId string)) *MockStore_GetDatasetRowAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowAssignment_Call) Return(_a0 models.DatasetRowAssignment, _a1 error) *MockStore_GetDatasetRowAssignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowAssignment_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (models.DatasetRowAssignment, error)) *MockStore_GetDatasetRowAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowAssignmentsForAssignee provides a mock function with given fields: ctx, assigneeId
+func (_m *MockStore) GetDatasetRowAssignmentsForAssignee(ctx context.Context, assigneeId uuid.UUID) ([]models.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, assigneeId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowAssignmentsForAssignee")
+	}
+
+	var r0 []models.DatasetRowAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetRowAssignment, error)); ok {
+		return rf(ctx, assigneeId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetRowAssignment); ok {
+		r0 = rf(ctx, assigneeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetRowAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, assigneeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetRowAssignmentsForAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowAssignmentsForAssignee'
+type MockStore_GetDatasetRowAssignmentsForAssignee_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowAssignmentsForAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assigneeId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetRowAssignmentsForAssignee(ctx interface{}, assigneeId interface{}) *MockStore_GetDatasetRowAssignmentsForAssignee_Call {
+	return &MockStore_GetDatasetRowAssignmentsForAssignee_Call{Call: _e.mock.On("GetDatasetRowAssignmentsForAssignee", ctx, assigneeId)}
+}
+
+func (_c *MockStore_GetDatasetRowAssignmentsForAssignee_Call) Run(run func(ctx context.Context, assigneeId uuid.UUID)) *MockStore_GetDatasetRowAssignmentsForAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowAssignmentsForAssignee_Call) Return(_a0 []models.DatasetRowAssignment, _a1 error) *MockStore_GetDatasetRowAssignmentsForAssignee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowAssignmentsForAssignee_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetRowAssignment, error)) *MockStore_GetDatasetRowAssignmentsForAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPolicies provides a mock function with given fields: ctx, datasetId
+func (_m *MockStore) GetDatasetRowPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPolicies")
+	}
+
+	var r0 []models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetRowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPolicies'
+type MockStore_GetDatasetRowPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetRowPolicies(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetRowPolicies_Call {
+	return &MockStore_GetDatasetRowPolicies_Call{Call: _e.mock.On("GetDatasetRowPolicies", ctx, datasetId)}
+}
+
+func (_c *MockStore_GetDatasetRowPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowPolicies_Call) Return(_a0 []models.DatasetRowPolicy, _a1 error) *MockStore_GetDatasetRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetRowPolicy, error)) *MockStore_GetDatasetRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPoliciesForUser provides a mock function with given fields: ctx, datasetId, userId
+func (_m *MockStore) GetDatasetRowPoliciesForUser(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID) ([]models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, datasetId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPoliciesForUser")
+	}
+
+	var r0 []models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, datasetId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) []models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, datasetId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetRowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetRowPoliciesForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPoliciesForUser'
+type MockStore_GetDatasetRowPoliciesForUser_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPoliciesForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetRowPoliciesForUser(ctx interface{}, datasetId interface{}, userId interface{}) *MockStore_GetDatasetRowPoliciesForUser_Call {
+	return &MockStore_GetDatasetRowPoliciesForUser_Call{Call: _e.mock.On("GetDatasetRowPoliciesForUser", ctx, datasetId, userId)}
+}
+
+func (_c *MockStore_GetDatasetRowPoliciesForUser_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, userId uuid.UUID)) *MockStore_GetDatasetRowPoliciesForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowPoliciesForUser_Call) Return(_a0 []models.DatasetRowPolicy, _a1 error) *MockStore_GetDatasetRowPoliciesForUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowPoliciesForUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) ([]models.DatasetRowPolicy, error)) *MockStore_GetDatasetRowPoliciesForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetRowPolicyById provides a mock function with given fields: ctx, policyId
+func (_m *MockStore) GetDatasetRowPolicyById(ctx context.Context, policyId uuid.UUID) (models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, policyId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetRowPolicyById")
+	}
+
+	var r0 models.DatasetRowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, policyId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, policyId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetRowPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, policyId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetRowPolicyById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetRowPolicyById'
+type MockStore_GetDatasetRowPolicyById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetRowPolicyById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetRowPolicyById(ctx interface{}, policyId interface{}) *MockStore_GetDatasetRowPolicyById_Call {
+	return &MockStore_GetDatasetRowPolicyById_Call{Call: _e.mock.On("GetDatasetRowPolicyById", ctx, policyId)}
+}
+
+func (_c *MockStore_GetDatasetRowPolicyById_Call) Run(run func(ctx context.Context, policyId uuid.UUID)) *MockStore_GetDatasetRowPolicyById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowPolicyById_Call) Return(_a0 models.DatasetRowPolicy, _a1 error) *MockStore_GetDatasetRowPolicyById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetRowPolicyById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetRowPolicy, error)) *MockStore_GetDatasetRowPolicyById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetStatusWorkflowById provides a mock function with given fields: ctx, workflowId
+func (_m *MockStore) GetDatasetStatusWorkflowById(ctx context.Context, workflowId uuid.UUID) (models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetStatusWorkflowById")
+	}
+
+	var r0 models.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, workflowId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, workflowId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetStatusWorkflow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, workflowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetStatusWorkflowById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetStatusWorkflowById'
+type MockStore_GetDatasetStatusWorkflowById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetStatusWorkflowById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetStatusWorkflowById(ctx interface{}, workflowId interface{}) *MockStore_GetDatasetStatusWorkflowById_Call {
+	return &MockStore_GetDatasetStatusWorkflowById_Call{Call: _e.mock.On("GetDatasetStatusWorkflowById", ctx, workflowId)}
+}
+
+func (_c *MockStore_GetDatasetStatusWorkflowById_Call) Run(run func(ctx context.Context, workflowId uuid.UUID)) *MockStore_GetDatasetStatusWorkflowById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetStatusWorkflowById_Call) Return(_a0 models.DatasetStatusWorkflow, _a1 error) *MockStore_GetDatasetStatusWorkflowById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetStatusWorkflowById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetStatusWorkflow, error)) *MockStore_GetDatasetStatusWorkflowById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetStatusWorkflows provides a mock function with given fields: ctx, datasetId
+func (_m *MockStore) GetDatasetStatusWorkflows(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetStatusWorkflows")
+	}
+
+	var r0 []models.DatasetStatusWorkflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetStatusWorkflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetStatusWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetStatusWorkflows'
+type MockStore_GetDatasetStatusWorkflows_Call struct {
+	*mock.Call
+}
+
+// GetDatasetStatusWorkflows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetStatusWorkflows(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetStatusWorkflows_Call {
+	return &MockStore_GetDatasetStatusWorkflows_Call{Call: _e.mock.On("GetDatasetStatusWorkflows", ctx, datasetId)}
+}
+
+func (_c *MockStore_GetDatasetStatusWorkflows_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetStatusWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetStatusWorkflows_Call) Return(_a0 []models.DatasetStatusWorkflow, _a1 error) *MockStore_GetDatasetStatusWorkflows_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetStatusWorkflows_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetStatusWorkflow, error)) *MockStore_GetDatasetStatusWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViewById provides a mock function with given fields: ctx, viewId
+func (_m *MockStore) GetDatasetViewById(ctx context.Context, viewId uuid.UUID) (models.DatasetView, error) {
+	ret := _m.Called(ctx, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViewById")
+	}
+
+	var r0 models.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetView, error)); ok {
+		return rf(ctx, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetView); ok {
+		r0 = rf(ctx, viewId)
+	} else {
+		r0 = ret.Get(0).(models.DatasetView)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetViewById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViewById'
+type MockStore_GetDatasetViewById_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViewById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetViewById(ctx interface{}, viewId interface{}) *MockStore_GetDatasetViewById_Call {
+	return &MockStore_GetDatasetViewById_Call{Call: _e.mock.On("GetDatasetViewById", ctx, viewId)}
+}
+
+func (_c *MockStore_GetDatasetViewById_Call) Run(run func(ctx context.Context, viewId uuid.UUID)) *MockStore_GetDatasetViewById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetViewById_Call) Return(_a0 models.DatasetView, _a1 error) *MockStore_GetDatasetViewById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetViewById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetView, error)) *MockStore_GetDatasetViewById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViewPolicies provides a mock function with given fields: ctx, viewId
+func (_m *MockStore) GetDatasetViewPolicies(ctx context.Context, viewId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViewPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, viewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetViewPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViewPolicies'
+type MockStore_GetDatasetViewPolicies_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViewPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetViewPolicies(ctx interface{}, viewId interface{}) *MockStore_GetDatasetViewPolicies_Call {
+	return &MockStore_GetDatasetViewPolicies_Call{Call: _e.mock.On("GetDatasetViewPolicies", ctx, viewId)}
+}
+
+func (_c *MockStore_GetDatasetViewPolicies_Call) Run(run func(ctx context.Context, viewId uuid.UUID)) *MockStore_GetDatasetViewPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetViewPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetDatasetViewPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetViewPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetDatasetViewPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetViews provides a mock function with given fields: ctx, datasetId
+func (_m *MockStore) GetDatasetViews(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetView, error) {
+	ret := _m.Called(ctx, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetViews")
+	}
+
+	var r0 []models.DatasetView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetView, error)); ok {
+		return rf(ctx, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetView); ok {
+		r0 = rf(ctx, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DatasetView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetViews'
+type MockStore_GetDatasetViews_Call struct {
+	*mock.Call
+}
+
+// GetDatasetViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) GetDatasetViews(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetViews_Call {
+	return &MockStore_GetDatasetViews_Call{Call: _e.mock.On("GetDatasetViews", ctx, datasetId)}
+}
+
+func (_c *MockStore_GetDatasetViews_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetViews_Call) Return(_a0 []models.DatasetView, _a1 error) *MockStore_GetDatasetViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetViews_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetView, error)) *MockStore_GetDatasetViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDatasetsAll provides a mock function with given fields: ctx, filters
+func (_m *MockStore) GetDatasetsAll(ctx context.Context, filters models.DatasetFilters) ([]models.Dataset, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatasetsAll")
+	}
+
+	var r0 []models.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) ([]models.Dataset, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) []models.Dataset); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDatasetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetsAll'
+type MockStore_GetDatasetsAll_Call struct {
+	*mock.Call
+}
+
+// GetDatasetsAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.DatasetFilters
+func (_e *MockStore_Expecter) GetDatasetsAll(ctx interface{}, filters interface{}) *MockStore_GetDatasetsAll_Call {
+	return &MockStore_GetDatasetsAll_Call{Call: _e.mock.On("GetDatasetsAll", ctx, filters)}
+}
+
+func (_c *MockStore_GetDatasetsAll_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockStore_GetDatasetsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDatasetsAll_Call) Return(_a0 []models.Dataset, _a1 error) *MockStore_GetDatasetsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDatasetsAll_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) ([]models.Dataset, error)) *MockStore_GetDatasetsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId
+func (_m *MockStore) GetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID) (*models.DatasetViewDefault, error) {
+	ret := _m.Called(ctx, userId, datasetId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultDatasetView")
+	}
+
+	var r0 *models.DatasetViewDefault
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.DatasetViewDefault, error)); ok {
+		return rf(ctx, userId, datasetId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.DatasetViewDefault); ok {
+		r0 = rf(ctx, userId, datasetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DatasetViewDefault)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultDatasetView'
+type MockStore_GetDefaultDatasetView_Call struct {
+	*mock.Call
+}
+
+// GetDefaultDatasetView is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+func (_e *MockStore_Expecter) GetDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}) *MockStore_GetDefaultDatasetView_Call {
+	return &MockStore_GetDefaultDatasetView_Call{Call: _e.mock.On("GetDefaultDatasetView", ctx, userId, datasetId)}
+}
+
+func (_c *MockStore_GetDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID)) *MockStore_GetDefaultDatasetView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetDefaultDatasetView_Call) Return(_a0 *models.DatasetViewDefault, _a1 error) *MockStore_GetDefaultDatasetView_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.DatasetViewDefault, error)) *MockStore_GetDefaultDatasetView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFileUploadByIds provides a mock function with given fields: ctx, ids
+func (_m *MockStore) GetFileUploadByIds(ctx context.Context, ids []uuid.UUID) ([]models.FileUpload, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFileUploadByIds")
+	}
+
+	var r0 []models.FileUpload
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]models.FileUpload, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []models.FileUpload); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FileUpload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFileUploadByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFileUploadByIds'
+type MockStore_GetFileUploadByIds_Call struct {
+	*mock.Call
+}
+
+// GetFileUploadByIds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+func (_e *MockStore_Expecter) GetFileUploadByIds(ctx interface{}, ids interface{}) *MockStore_GetFileUploadByIds_Call {
+	return &MockStore_GetFileUploadByIds_Call{Call: _e.mock.On("GetFileUploadByIds", ctx, ids)}
+}
+
+func (_c *MockStore_GetFileUploadByIds_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockStore_GetFileUploadByIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFileUploadByIds_Call) Return(_a0 []models.FileUpload, _a1 error) *MockStore_GetFileUploadByIds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFileUploadByIds_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]models.FileUpload, error)) *MockStore_GetFileUploadByIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlattenedResourceAudiencePolicies provides a mock function with given fields: ctx, filters
+func (_m *MockStore) GetFlattenedResourceAudiencePolicies(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlattenedResourceAudiencePolicies")
+	}
+
+	var r0 []models.FlattenedResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) []models.FlattenedResourceAudiencePolicy); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FlattenedResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFlattenedResourceAudiencePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlattenedResourceAudiencePolicies'
+type MockStore_GetFlattenedResourceAudiencePolicies_Call struct {
+	*mock.Call
+}
+
+// GetFlattenedResourceAudiencePolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FlattenedResourceAudiencePoliciesFilters
+func (_e *MockStore_Expecter) GetFlattenedResourceAudiencePolicies(ctx interface{}, filters interface{}) *MockStore_GetFlattenedResourceAudiencePolicies_Call {
+	return &MockStore_GetFlattenedResourceAudiencePolicies_Call{Call: _e.mock.On("GetFlattenedResourceAudiencePolicies", ctx, filters)}
+}
+
+func (_c *MockStore_GetFlattenedResourceAudiencePolicies_Call) Run(run func(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters)) *MockStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FlattenedResourceAudiencePoliciesFilters))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFlattenedResourceAudiencePolicies_Call) Return(_a0 []models.FlattenedResourceAudiencePolicy, _a1 error) *MockStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFlattenedResourceAudiencePolicies_Call) RunAndReturn(run func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)) *MockStore_GetFlattenedResourceAudiencePolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateOnDate provides a mock function with given fields: ctx, baseCurrency, quoteCurrency, date
+func (_m *MockStore) GetFxRateOnDate(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time) (models.FxRate, error) {
+	ret := _m.Called(ctx, baseCurrency, quoteCurrency, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateOnDate")
+	}
+
+	var r0 models.FxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (models.FxRate, error)); ok {
+		return rf(ctx, baseCurrency, quoteCurrency, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) models.FxRate); ok {
+		r0 = rf(ctx, baseCurrency, quoteCurrency, date)
+	} else {
+		r0 = ret.Get(0).(models.FxRate)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, baseCurrency, quoteCurrency, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFxRateOnDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateOnDate'
+type MockStore_GetFxRateOnDate_Call struct {
+	*mock.Call
+}
+
+// GetFxRateOnDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - baseCurrency string
+//   - quoteCurrency string
+//   - date time.Time
+func (_e *MockStore_Expecter) GetFxRateOnDate(ctx interface{}, baseCurrency interface{}, quoteCurrency interface{}, date interface{}) *MockStore_GetFxRateOnDate_Call {
+	return &MockStore_GetFxRateOnDate_Call{Call: _e.mock.On("GetFxRateOnDate", ctx, baseCurrency, quoteCurrency, date)}
+}
+
+func (_c *MockStore_GetFxRateOnDate_Call) Run(run func(ctx context.Context, baseCurrency string, quoteCurrency string, date time.Time)) *MockStore_GetFxRateOnDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFxRateOnDate_Call) Return(_a0 models.FxRate, _a1 error) *MockStore_GetFxRateOnDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFxRateOnDate_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (models.FxRate, error)) *MockStore_GetFxRateOnDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateSourceById provides a mock function with given fields: ctx, sourceId
+func (_m *MockStore) GetFxRateSourceById(ctx context.Context, sourceId uuid.UUID) (models.FxRateSource, error) {
+	ret := _m.Called(ctx, sourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateSourceById")
+	}
+
+	var r0 models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.FxRateSource, error)); ok {
+		return rf(ctx, sourceId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.FxRateSource); ok {
+		r0 = rf(ctx, sourceId)
+	} else {
+		r0 = ret.Get(0).(models.FxRateSource)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sourceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFxRateSourceById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateSourceById'
+type MockStore_GetFxRateSourceById_Call struct {
+	*mock.Call
+}
+
+// GetFxRateSourceById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+func (_e *MockStore_Expecter) GetFxRateSourceById(ctx interface{}, sourceId interface{}) *MockStore_GetFxRateSourceById_Call {
+	return &MockStore_GetFxRateSourceById_Call{Call: _e.mock.On("GetFxRateSourceById", ctx, sourceId)}
+}
+
+func (_c *MockStore_GetFxRateSourceById_Call) Run(run func(ctx context.Context, sourceId uuid.UUID)) *MockStore_GetFxRateSourceById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFxRateSourceById_Call) Return(_a0 models.FxRateSource, _a1 error) *MockStore_GetFxRateSourceById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFxRateSourceById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.FxRateSource, error)) *MockStore_GetFxRateSourceById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRateSources provides a mock function with given fields: ctx
+func (_m *MockStore) GetFxRateSources(ctx context.Context) ([]models.FxRateSource, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRateSources")
+	}
+
+	var r0 []models.FxRateSource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.FxRateSource, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.FxRateSource); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FxRateSource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFxRateSources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRateSources'
+type MockStore_GetFxRateSources_Call struct {
+	*mock.Call
+}
+
+// GetFxRateSources is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetFxRateSources(ctx interface{}) *MockStore_GetFxRateSources_Call {
+	return &MockStore_GetFxRateSources_Call{Call: _e.mock.On("GetFxRateSources", ctx)}
+}
+
+func (_c *MockStore_GetFxRateSources_Call) Run(run func(ctx context.Context)) *MockStore_GetFxRateSources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFxRateSources_Call) Return(_a0 []models.FxRateSource, _a1 error) *MockStore_GetFxRateSources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFxRateSources_Call) RunAndReturn(run func(context.Context) ([]models.FxRateSource, error)) *MockStore_GetFxRateSources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFxRates provides a mock function with given fields: ctx, filters
+func (_m *MockStore) GetFxRates(ctx context.Context, filters models.FxRateFilters) ([]models.FxRate, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFxRates")
+	}
+
+	var r0 []models.FxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) ([]models.FxRate, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.FxRateFilters) []models.FxRate); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FxRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.FxRateFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFxRates'
+type MockStore_GetFxRates_Call struct {
+	*mock.Call
+}
+
+// GetFxRates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.FxRateFilters
+func (_e *MockStore_Expecter) GetFxRates(ctx interface{}, filters interface{}) *MockStore_GetFxRates_Call {
+	return &MockStore_GetFxRates_Call{Call: _e.mock.On("GetFxRates", ctx, filters)}
+}
+
+func (_c *MockStore_GetFxRates_Call) Run(run func(ctx context.Context, filters models.FxRateFilters)) *MockStore_GetFxRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.FxRateFilters))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetFxRates_Call) Return(_a0 []models.FxRate, _a1 error) *MockStore_GetFxRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetFxRates_Call) RunAndReturn(run func(context.Context, models.FxRateFilters) ([]models.FxRate, error)) *MockStore_GetFxRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestFxRateDate provides a mock function with given fields: ctx, sourceId
+func (_m *MockStore) GetLatestFxRateDate(ctx context.Context, sourceId uuid.UUID) (*time.Time, error) {
+	ret := _m.Called(ctx, sourceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestFxRateDate")
+	}
+
+	var r0 *time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*time.Time, error)); ok {
+		return rf(ctx, sourceId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *time.Time); ok {
+		r0 = rf(ctx, sourceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, sourceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestFxRateDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestFxRateDate'
+type MockStore_GetLatestFxRateDate_Call struct {
+	*mock.Call
+}
+
+// GetLatestFxRateDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+func (_e *MockStore_Expecter) GetLatestFxRateDate(ctx interface{}, sourceId interface{}) *MockStore_GetLatestFxRateDate_Call {
+	return &MockStore_GetLatestFxRateDate_Call{Call: _e.mock.On("GetLatestFxRateDate", ctx, sourceId)}
+}
+
+func (_c *MockStore_GetLatestFxRateDate_Call) Run(run func(ctx context.Context, sourceId uuid.UUID)) *MockStore_GetLatestFxRateDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestFxRateDate_Call) Return(_a0 *time.Time, _a1 error) *MockStore_GetLatestFxRateDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestFxRateDate_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*time.Time, error)) *MockStore_GetLatestFxRateDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestReconciliationRun provides a mock function with given fields: ctx, reconciliationId, status
+func (_m *MockStore) GetLatestReconciliationRun(ctx context.Context, reconciliationId uuid.UUID, status string) (models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestReconciliationRun")
+	}
+
+	var r0 models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (models.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) models.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, status)
+	} else {
+		r0 = ret.Get(0).(models.ReconciliationRun)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, reconciliationId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetLatestReconciliationRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestReconciliationRun'
+type MockStore_GetLatestReconciliationRun_Call struct {
+	*mock.Call
+}
+
+// GetLatestReconciliationRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reconciliationId uuid.UUID
+//   - status string
+func (_e *MockStore_Expecter) GetLatestReconciliationRun(ctx interface{}, reconciliationId interface{}, status interface{}) *MockStore_GetLatestReconciliationRun_Call {
+	return &MockStore_GetLatestReconciliationRun_Call{Call: _e.mock.On("GetLatestReconciliationRun", ctx, reconciliationId, status)}
+}
+
+func (_c *MockStore_GetLatestReconciliationRun_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, status string)) *MockStore_GetLatestReconciliationRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetLatestReconciliationRun_Call) Return(_a0 models.ReconciliationRun, _a1 error) *MockStore_GetLatestReconciliationRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetLatestReconciliationRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (models.ReconciliationRun, error)) *MockStore_GetLatestReconciliationRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationById provides a mock function with given fields: ctx, organizationId
+func (_m *MockStore) GetOrganizationById(ctx context.Context, organizationId string) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationById")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationById'
+type MockStore_GetOrganizationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId string
+func (_e *MockStore_Expecter) GetOrganizationById(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationById_Call {
+	return &MockStore_GetOrganizationById_Call{Call: _e.mock.On("GetOrganizationById", ctx, organizationId)}
+}
+
+func (_c *MockStore_GetOrganizationById_Call) Run(run func(ctx context.Context, organizationId string)) *MockStore_GetOrganizationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationById_Call) Return(_a0 *models.Organization, _a1 error) *MockStore_GetOrganizationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationById_Call) RunAndReturn(run func(context.Context, string) (*models.Organization, error)) *MockStore_GetOrganizationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationById provides a mock function with given fields: ctx, invitationId
+func (_m *MockStore) GetOrganizationInvitationById(ctx context.Context, invitationId uuid.UUID) (*models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, invitationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationById")
+	}
+
+	var r0 *models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)); ok {
+		return rf(ctx, invitationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.OrganizationInvitation); ok {
+		r0 = rf(ctx, invitationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, invitationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationInvitationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationById'
+type MockStore_GetOrganizationInvitationById_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitationId uuid.UUID
+func (_e *MockStore_Expecter) GetOrganizationInvitationById(ctx interface{}, invitationId interface{}) *MockStore_GetOrganizationInvitationById_Call {
+	return &MockStore_GetOrganizationInvitationById_Call{Call: _e.mock.On("GetOrganizationInvitationById", ctx, invitationId)}
+}
+
+func (_c *MockStore_GetOrganizationInvitationById_Call) Run(run func(ctx context.Context, invitationId uuid.UUID)) *MockStore_GetOrganizationInvitationById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationInvitationById_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationInvitationById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)) *MockStore_GetOrganizationInvitationById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAll provides a mock function with given fields: ctx
+func (_m *MockStore) GetOrganizationInvitationsAll(ctx context.Context) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAll")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationInvitationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAll'
+type MockStore_GetOrganizationInvitationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetOrganizationInvitationsAll(ctx interface{}) *MockStore_GetOrganizationInvitationsAll_Call {
+	return &MockStore_GetOrganizationInvitationsAll_Call{Call: _e.mock.On("GetOrganizationInvitationsAll", ctx)}
+}
+
+func (_c *MockStore_GetOrganizationInvitationsAll_Call) Run(run func(ctx context.Context)) *MockStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationInvitationsAll_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationInvitationsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationInvitation, error)) *MockStore_GetOrganizationInvitationsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsAndMembershipRequests provides a mock function with given fields: ctx, organizationId
+func (_m *MockStore) GetOrganizationInvitationsAndMembershipRequests(ctx context.Context, organizationId uuid.UUID) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsAndMembershipRequests")
+	}
+
+	var r0 *models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Organization); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationInvitationsAndMembershipRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAndMembershipRequests'
+type MockStore_GetOrganizationInvitationsAndMembershipRequests_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsAndMembershipRequests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockStore_Expecter) GetOrganizationInvitationsAndMembershipRequests(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	return &MockStore_GetOrganizationInvitationsAndMembershipRequests_Call{Call: _e.mock.On("GetOrganizationInvitationsAndMembershipRequests", ctx, organizationId)}
+}
+
+func (_c *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call) Return(_a0 *models.Organization, _a1 error) *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Organization, error)) *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationInvitationsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockStore) GetOrganizationInvitationsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationInvitation, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationInvitationsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationInvitation); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationInvitationsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsByOrganizationId'
+type MockStore_GetOrganizationInvitationsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationInvitationsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockStore_Expecter) GetOrganizationInvitationsByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationInvitationsByOrganizationId_Call {
+	return &MockStore_GetOrganizationInvitationsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationInvitationsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockStore_GetOrganizationInvitationsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationInvitationsByOrganizationId_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationInvitationsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)) *MockStore_GetOrganizationInvitationsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsAll provides a mock function with given fields: ctx
+func (_m *MockStore) GetOrganizationMembershipRequestsAll(ctx context.Context) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsAll")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationMembershipRequestsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsAll'
+type MockStore_GetOrganizationMembershipRequestsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetOrganizationMembershipRequestsAll(ctx interface{}) *MockStore_GetOrganizationMembershipRequestsAll_Call {
+	return &MockStore_GetOrganizationMembershipRequestsAll_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsAll", ctx)}
+}
+
+func (_c *MockStore_GetOrganizationMembershipRequestsAll_Call) Run(run func(ctx context.Context)) *MockStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationMembershipRequestsAll_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationMembershipRequestsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationMembershipRequest, error)) *MockStore_GetOrganizationMembershipRequestsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockStore) GetOrganizationMembershipRequestsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationMembershipRequestsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsByOrganizationId'
+type MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationMembershipRequestsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockStore_Expecter) GetOrganizationMembershipRequestsByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	return &MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)) *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicies provides a mock function with given fields: ctx, orgId
+func (_m *MockStore) GetOrganizationPolicies(ctx context.Context, orgId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicies'
+type MockStore_GetOrganizationPolicies_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgId uuid.UUID
+func (_e *MockStore_Expecter) GetOrganizationPolicies(ctx interface{}, orgId interface{}) *MockStore_GetOrganizationPolicies_Call {
+	return &MockStore_GetOrganizationPolicies_Call{Call: _e.mock.On("GetOrganizationPolicies", ctx, orgId)}
+}
+
+func (_c *MockStore_GetOrganizationPolicies_Call) Run(run func(ctx context.Context, orgId uuid.UUID)) *MockStore_GetOrganizationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetOrganizationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPoliciesByEmail provides a mock function with given fields: ctx, organizationId, email
+func (_m *MockStore) GetOrganizationPoliciesByEmail(ctx context.Context, organizationId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, organizationId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPoliciesByEmail'
+type MockStore_GetOrganizationPoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - email string
+func (_e *MockStore_Expecter) GetOrganizationPoliciesByEmail(ctx interface{}, organizationId interface{}, email interface{}) *MockStore_GetOrganizationPoliciesByEmail_Call {
+	return &MockStore_GetOrganizationPoliciesByEmail_Call{Call: _e.mock.On("GetOrganizationPoliciesByEmail", ctx, organizationId, email)}
+}
+
+func (_c *MockStore_GetOrganizationPoliciesByEmail_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, email string)) *MockStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetOrganizationPoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationPolicyByUser provides a mock function with given fields: ctx, organizationId, userId
+func (_m *MockStore) GetOrganizationPolicyByUser(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, organizationId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationPolicyByUser")
+	}
+
+	var r0 *models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, organizationId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, organizationId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationPolicyByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicyByUser'
+type MockStore_GetOrganizationPolicyByUser_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationPolicyByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockStore_Expecter) GetOrganizationPolicyByUser(ctx interface{}, organizationId interface{}, userId interface{}) *MockStore_GetOrganizationPolicyByUser_Call {
+	return &MockStore_GetOrganizationPolicyByUser_Call{Call: _e.mock.On("GetOrganizationPolicyByUser", ctx, organizationId, userId)}
+}
+
+func (_c *MockStore_GetOrganizationPolicyByUser_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID)) *MockStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationPolicyByUser_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationPolicyByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)) *MockStore_GetOrganizationPolicyByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationSSOConfigsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockStore) GetOrganizationSSOConfigsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationSSOConfigsByOrganizationId")
+	}
+
+	var r0 []models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrganizationSSOConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationSSOConfigsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationSSOConfigsByOrganizationId'
+type MockStore_GetOrganizationSSOConfigsByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationSSOConfigsByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockStore_Expecter) GetOrganizationSSOConfigsByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	return &MockStore_GetOrganizationSSOConfigsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationSSOConfigsByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call) Return(_a0 []models.OrganizationSSOConfig, _a1 error) *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)) *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationsAll provides a mock function with given fields: ctx
+func (_m *MockStore) GetOrganizationsAll(ctx context.Context) ([]models.Organization, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationsAll")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Organization, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Organization); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsAll'
+type MockStore_GetOrganizationsAll_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationsAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStore_Expecter) GetOrganizationsAll(ctx interface{}) *MockStore_GetOrganizationsAll_Call {
+	return &MockStore_GetOrganizationsAll_Call{Call: _e.mock.On("GetOrganizationsAll", ctx)}
+}
+
+func (_c *MockStore_GetOrganizationsAll_Call) Run(run func(ctx context.Context)) *MockStore_GetOrganizationsAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationsAll_Call) Return(_a0 []models.Organization, _a1 error) *MockStore_GetOrganizationsAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationsAll_Call) RunAndReturn(run func(context.Context) ([]models.Organization, error)) *MockStore_GetOrganizationsAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrganizationsByMemberId provides a mock function with given fields: ctx, memberId
+func (_m *MockStore) GetOrganizationsByMemberId(ctx context.Context, memberId uuid.UUID) ([]models.Organization, error) {
+	ret := _m.Called(ctx, memberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrganizationsByMemberId")
+	}
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Organization, error)); ok {
+		return rf(ctx, memberId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Organization); ok {
+		r0 = rf(ctx, memberId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, memberId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetOrganizationsByMemberId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsByMemberId'
+type MockStore_GetOrganizationsByMemberId_Call struct {
+	*mock.Call
+}
+
+// GetOrganizationsByMemberId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - memberId uuid.UUID
+func (_e *MockStore_Expecter) GetOrganizationsByMemberId(ctx interface{}, memberId interface{}) *MockStore_GetOrganizationsByMemberId_Call {
+	return &MockStore_GetOrganizationsByMemberId_Call{Call: _e.mock.On("GetOrganizationsByMemberId", ctx, memberId)}
+}
+
+func (_c *MockStore_GetOrganizationsByMemberId_Call) Run(run func(ctx context.Context, memberId uuid.UUID)) *MockStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationsByMemberId_Call) Return(_a0 []models.Organization, _a1 error) *MockStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetOrganizationsByMemberId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Organization, error)) *MockStore_GetOrganizationsByMemberId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPageById provides a mock function with given fields: ctx, pageId
+func (_m *MockStore) GetPageById(ctx context.Context, pageId uuid.UUID) (*models.Page, error) {
+	ret := _m.Called(ctx, pageId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPageById")
+	}
+
+	var r0 *models.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Page, error)); ok {
+		return rf(ctx, pageId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Page); ok {
+		r0 = rf(ctx, pageId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, pageId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPageById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPageById'
+type MockStore_GetPageById_Call struct {
+	*mock.Call
+}
+
+// GetPageById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+func (_e *MockStore_Expecter) GetPageById(ctx interface{}, pageId interface{}) *MockStore_GetPageById_Call {
+	return &MockStore_GetPageById_Call{Call: _e.mock.On("GetPageById", ctx, pageId)}
+}
+
+func (_c *MockStore_GetPageById_Call) Run(run func(ctx context.Context, pageId uuid.UUID)) *MockStore_GetPageById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPageById_Call) Return(_a0 *models.Page, _a1 error) *MockStore_GetPageById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPageById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Page, error)) *MockStore_GetPageById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPagePoliciesByEmail provides a mock function with given fields: ctx, pageId, email
+func (_m *MockStore) GetPagePoliciesByEmail(ctx context.Context, pageId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, pageId, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPagePoliciesByEmail")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, pageId, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, pageId, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, pageId, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPagePoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPagePoliciesByEmail'
+type MockStore_GetPagePoliciesByEmail_Call struct {
+	*mock.Call
+}
+
+// GetPagePoliciesByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pageId uuid.UUID
+//   - email string
+func (_e *MockStore_Expecter) GetPagePoliciesByEmail(ctx interface{}, pageId interface{}, email interface{}) *MockStore_GetPagePoliciesByEmail_Call {
+	return &MockStore_GetPagePoliciesByEmail_Call{Call: _e.mock.On("GetPagePoliciesByEmail", ctx, pageId, email)}
+}
+
+func (_c *MockStore_GetPagePoliciesByEmail_Call) Run(run func(ctx context.Context, pageId uuid.UUID, email string)) *MockStore_GetPagePoliciesByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPagePoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetPagePoliciesByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPagePoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetPagePoliciesByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPagesAll provides a mock function with given fields: ctx, filters
+func (_m *MockStore) GetPagesAll(ctx context.Context, filters models.PageFilters) ([]models.Page, error) {
+	ret := _m.Called(ctx, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPagesAll")
+	}
+
+	var r0 []models.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.PageFilters) ([]models.Page, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.PageFilters) []models.Page); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.PageFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPagesAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPagesAll'
+type MockStore_GetPagesAll_Call struct {
+	*mock.Call
+}
+
+// GetPagesAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters models.PageFilters
+func (_e *MockStore_Expecter) GetPagesAll(ctx interface{}, filters interface{}) *MockStore_GetPagesAll_Call {
+	return &MockStore_GetPagesAll_Call{Call: _e.mock.On("GetPagesAll", ctx, filters)}
+}
+
+func (_c *MockStore_GetPagesAll_Call) Run(run func(ctx context.Context, filters models.PageFilters)) *MockStore_GetPagesAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.PageFilters))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPagesAll_Call) Return(_a0 []models.Page, _a1 error) *MockStore_GetPagesAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPagesAll_Call) RunAndReturn(run func(context.Context, models.PageFilters) ([]models.Page, error)) *MockStore_GetPagesAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPagesByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockStore) GetPagesByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.Page, error) {
+	ret := _m.Called(ctx, organizationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPagesByOrganizationId")
+	}
+
+	var r0 []models.Page
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Page, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Page); ok {
+		r0 = rf(ctx, organizationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Page)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_GetPagesByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPagesByOrganizationId'
+type MockStore_GetPagesByOrganizationId_Call struct {
+	*mock.Call
+}
+
+// GetPagesByOrganizationId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationId uuid.UUID
+func (_e *MockStore_Expecter) GetPagesByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetPagesByOrganizationId_Call {
+	return &MockStore_GetPagesByOrganizationId_Call{Call: _e.mock.On("GetPagesByOrganizationId", ctx, organizationId)}
+}
+
+func (_c *MockStore_GetPagesByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetPagesByOrganizationId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPagesByOrganizationId_Call) Return(_a0 []models.Page, _a1 error) *MockStore_GetPagesByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_GetPagesByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Page, error)) *MockStore_GetPagesByOrganizationId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPagesPolicies provides a mock function with given fields: ctx, pageId
+func (_m *MockStore) GetPagesPolicies(ctx context.Context, pageId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, pageId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPagesPolicies")
+	}
+
+	var r0 []models.ResourceAudiencePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, pageId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
-		r0 = rf(ctx, widgetInstance)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, pageId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.WidgetInstance)
+			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
-		r1 = rf(ctx, widgetInstance)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, pageId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1333,436 +7414,521 @@ func (_m *MockStore) CreateWidgetInstance(ctx context.Context, widgetInstance *m
 	return r0, r1
 }
 
-// MockStore_CreateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWidgetInstance'
-type MockStore_CreateWidgetInstance_Call struct {
+// MockStore_GetPagesPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPagesPolicies'
+type MockStore_GetPagesPolicies_Call struct {
 	*mock.Call
 }
 
-// CreateWidgetInstance is a helper method to define mock.On call
+// GetPagesPolicies is a helper method to define mock.On call
 //   - ctx context.Context
-//   - widgetInstance *models.WidgetInstance
-func (_e *MockStore_Expecter) CreateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockStore_CreateWidgetInstance_Call {
-	return &MockStore_CreateWidgetInstance_Call{Call: _e.mock.On("CreateWidgetInstance", ctx, widgetInstance)}
+//   - pageId uuid.UUID
+func (_e *MockStore_Expecter) GetPagesPolicies(ctx interface{}, pageId interface{}) *MockStore_GetPagesPolicies_Call {
+	return &MockStore_GetPagesPolicies_Call{Call: _e.mock.On("GetPagesPolicies", ctx, pageId)}
 }
 
-func (_c *MockStore_CreateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockStore_CreateWidgetInstance_Call {
+func (_c *MockStore_GetPagesPolicies_Call) Run(run func(ctx context.Context, pageId uuid.UUID)) *MockStore_GetPagesPolicies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockStore_CreateWidgetInstance_Call {
+func (_c *MockStore_GetPagesPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetPagesPolicies_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_CreateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockStore_CreateWidgetInstance_Call {
+func (_c *MockStore_GetPagesPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetPagesPolicies_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteDataset provides a mock function with given fields: ctx, dataset
-func (_m *MockStore) DeleteDataset(ctx context.Context, dataset models.Dataset) error {
-	ret := _m.Called(ctx, dataset)
+// GetPaymentsConfigsByOrganizationId provides a mock function with given fields: ctx, organizationId
+func (_m *MockStore) GetPaymentsConfigsByOrganizationId(ctx context.Context, organizationId string) (models.PaymentsConfig, error) {
+	ret := _m.Called(ctx, organizationId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteDataset")
+		panic("no return value specified for GetPaymentsConfigsByOrganizationId")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) error); ok {
-		r0 = rf(ctx, dataset)
+	var r0 models.PaymentsConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.PaymentsConfig, error)); ok {
+		return rf(ctx, organizationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.PaymentsConfig); ok {
+		r0 = rf(ctx, organizationId)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.PaymentsConfig)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, organizationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDataset'
-type MockStore_DeleteDataset_Call struct {
+// MockStore_GetPaymentsConfigsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentsConfigsByOrganizationId'
+type MockStore_GetPaymentsConfigsByOrganizationId_Call struct {
 	*mock.Call
 }
 
-// DeleteDataset is a helper method to define mock.On call
+// GetPaymentsConfigsByOrganizationId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dataset models.Dataset
-func (_e *MockStore_Expecter) DeleteDataset(ctx interface{}, dataset interface{}) *MockStore_DeleteDataset_Call {
-	return &MockStore_DeleteDataset_Call{Call: _e.mock.On("DeleteDataset", ctx, dataset)}
+//   - organizationId string
+func (_e *MockStore_Expecter) GetPaymentsConfigsByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetPaymentsConfigsByOrganizationId_Call {
+	return &MockStore_GetPaymentsConfigsByOrganizationId_Call{Call: _e.mock.On("GetPaymentsConfigsByOrganizationId", ctx, organizationId)}
 }
 
-func (_c *MockStore_DeleteDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockStore_DeleteDataset_Call {
+func (_c *MockStore_GetPaymentsConfigsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId string)) *MockStore_GetPaymentsConfigsByOrganizationId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.Dataset))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteDataset_Call) Return(_a0 error) *MockStore_DeleteDataset_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_GetPaymentsConfigsByOrganizationId_Call) Return(_a0 models.PaymentsConfig, _a1 error) *MockStore_GetPaymentsConfigsByOrganizationId_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) error) *MockStore_DeleteDataset_Call {
+func (_c *MockStore_GetPaymentsConfigsByOrganizationId_Call) RunAndReturn(run func(context.Context, string) (models.PaymentsConfig, error)) *MockStore_GetPaymentsConfigsByOrganizationId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceType, audienceId
-func (_m *MockStore) DeleteDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
-	ret := _m.Called(ctx, datasetId, audienceType, audienceId)
+// GetPrimarySSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockStore) GetPrimarySSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteDatasetPolicy")
+		panic("no return value specified for GetPrimarySSOConfigByDomain")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
-		r0 = rf(ctx, datasetId, audienceType, audienceId)
+	var r0 *models.OrganizationSSOConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDatasetPolicy'
-type MockStore_DeleteDatasetPolicy_Call struct {
+// MockStore_GetPrimarySSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrimarySSOConfigByDomain'
+type MockStore_GetPrimarySSOConfigByDomain_Call struct {
 	*mock.Call
 }
 
-// DeleteDatasetPolicy is a helper method to define mock.On call
+// GetPrimarySSOConfigByDomain is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-//   - audienceType models.AudienceType
-//   - audienceId uuid.UUID
-func (_e *MockStore_Expecter) DeleteDatasetPolicy(ctx interface{}, datasetId interface{}, audienceType interface{}, audienceId interface{}) *MockStore_DeleteDatasetPolicy_Call {
-	return &MockStore_DeleteDatasetPolicy_Call{Call: _e.mock.On("DeleteDatasetPolicy", ctx, datasetId, audienceType, audienceId)}
+//   - domain string
+func (_e *MockStore_Expecter) GetPrimarySSOConfigByDomain(ctx interface{}, domain interface{}) *MockStore_GetPrimarySSOConfigByDomain_Call {
+	return &MockStore_GetPrimarySSOConfigByDomain_Call{Call: _e.mock.On("GetPrimarySSOConfigByDomain", ctx, domain)}
 }
 
-func (_c *MockStore_DeleteDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockStore_DeleteDatasetPolicy_Call {
+func (_c *MockStore_GetPrimarySSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockStore_GetPrimarySSOConfigByDomain_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteDatasetPolicy_Call) Return(_a0 error) *MockStore_DeleteDatasetPolicy_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_GetPrimarySSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockStore_GetPrimarySSOConfigByDomain_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockStore_DeleteDatasetPolicy_Call {
+func (_c *MockStore_GetPrimarySSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockStore_GetPrimarySSOConfigByDomain_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId
-func (_m *MockStore) DeleteOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID) error {
-	ret := _m.Called(ctx, orgId, audienceId)
+// GetReconciliationById provides a mock function with given fields: ctx, reconciliationId
+func (_m *MockStore) GetReconciliationById(ctx context.Context, reconciliationId uuid.UUID) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, reconciliationId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteOrganizationPolicy")
+		panic("no return value specified for GetReconciliationById")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, orgId, audienceId)
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Reconciliation, error)); ok {
+		return rf(ctx, reconciliationId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Reconciliation); ok {
+		r0 = rf(ctx, reconciliationId)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.Reconciliation)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, reconciliationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOrganizationPolicy'
-type MockStore_DeleteOrganizationPolicy_Call struct {
+// MockStore_GetReconciliationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationById'
+type MockStore_GetReconciliationById_Call struct {
 	*mock.Call
 }
 
-// DeleteOrganizationPolicy is a helper method to define mock.On call
+// GetReconciliationById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - orgId uuid.UUID
-//   - audienceId uuid.UUID
-func (_e *MockStore_Expecter) DeleteOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}) *MockStore_DeleteOrganizationPolicy_Call {
-	return &MockStore_DeleteOrganizationPolicy_Call{Call: _e.mock.On("DeleteOrganizationPolicy", ctx, orgId, audienceId)}
+//   - reconciliationId uuid.UUID
+func (_e *MockStore_Expecter) GetReconciliationById(ctx interface{}, reconciliationId interface{}) *MockStore_GetReconciliationById_Call {
+	return &MockStore_GetReconciliationById_Call{Call: _e.mock.On("GetReconciliationById", ctx, reconciliationId)}
 }
 
-func (_c *MockStore_DeleteOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID)) *MockStore_DeleteOrganizationPolicy_Call {
+func (_c *MockStore_GetReconciliationById_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID)) *MockStore_GetReconciliationById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteOrganizationPolicy_Call) Return(_a0 error) *MockStore_DeleteOrganizationPolicy_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_GetReconciliationById_Call) Return(_a0 models.Reconciliation, _a1 error) *MockStore_GetReconciliationById_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteOrganizationPolicy_Call {
+func (_c *MockStore_GetReconciliationById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Reconciliation, error)) *MockStore_GetReconciliationById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeletePagePolicy provides a mock function with given fields: ctx, pageId, audienceType, audienceId
-func (_m *MockStore) DeletePagePolicy(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID) error {
-	ret := _m.Called(ctx, pageId, audienceType, audienceId)
+// GetReconciliationExceptions provides a mock function with given fields: ctx, runId, filters
+func (_m *MockStore) GetReconciliationExceptions(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error) {
+	ret := _m.Called(ctx, runId, filters)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeletePagePolicy")
+		panic("no return value specified for GetReconciliationExceptions")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error); ok {
-		r0 = rf(ctx, pageId, audienceType, audienceId)
+	var r0 []models.ReconciliationException
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) []models.ReconciliationException); ok {
+		r0 = rf(ctx, runId, filters)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReconciliationException)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeletePagePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePagePolicy'
-type MockStore_DeletePagePolicy_Call struct {
+// MockStore_GetReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationExceptions'
+type MockStore_GetReconciliationExceptions_Call struct {
 	*mock.Call
 }
 
-// DeletePagePolicy is a helper method to define mock.On call
+// GetReconciliationExceptions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pageId uuid.UUID
-//   - audienceType models.AudienceType
-//   - audienceId uuid.UUID
-func (_e *MockStore_Expecter) DeletePagePolicy(ctx interface{}, pageId interface{}, audienceType interface{}, audienceId interface{}) *MockStore_DeletePagePolicy_Call {
-	return &MockStore_DeletePagePolicy_Call{Call: _e.mock.On("DeletePagePolicy", ctx, pageId, audienceType, audienceId)}
+//   - runId uuid.UUID
+//   - filters models.ReconciliationExceptionFilters
+func (_e *MockStore_Expecter) GetReconciliationExceptions(ctx interface{}, runId interface{}, filters interface{}) *MockStore_GetReconciliationExceptions_Call {
+	return &MockStore_GetReconciliationExceptions_Call{Call: _e.mock.On("GetReconciliationExceptions", ctx, runId, filters)}
 }
 
-func (_c *MockStore_DeletePagePolicy_Call) Run(run func(ctx context.Context, pageId uuid.UUID, audienceType models.AudienceType, audienceId uuid.UUID)) *MockStore_DeletePagePolicy_Call {
+func (_c *MockStore_GetReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationExceptionFilters)) *MockStore_GetReconciliationExceptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.AudienceType), args[3].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationExceptionFilters))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeletePagePolicy_Call) Return(_a0 error) *MockStore_DeletePagePolicy_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_GetReconciliationExceptions_Call) Return(_a0 []models.ReconciliationException, _a1 error) *MockStore_GetReconciliationExceptions_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeletePagePolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.AudienceType, uuid.UUID) error) *MockStore_DeletePagePolicy_Call {
+func (_c *MockStore_GetReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationExceptionFilters) ([]models.ReconciliationException, error)) *MockStore_GetReconciliationExceptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeletePaymentsConfigById provides a mock function with given fields: ctx, paymentsConfigId
-func (_m *MockStore) DeletePaymentsConfigById(ctx context.Context, paymentsConfigId uuid.UUID) error {
-	ret := _m.Called(ctx, paymentsConfigId)
+// GetReconciliationMatch provides a mock function with given fields: ctx, runId, matchId
+func (_m *MockStore) GetReconciliationMatch(ctx context.Context, runId uuid.UUID, matchId uuid.UUID) (models.ReconciliationMatch, error) {
+	ret := _m.Called(ctx, runId, matchId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeletePaymentsConfigById")
+		panic("no return value specified for GetReconciliationMatch")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, paymentsConfigId)
+	var r0 models.ReconciliationMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationMatch, error)); ok {
+		return rf(ctx, runId, matchId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.ReconciliationMatch); ok {
+		r0 = rf(ctx, runId, matchId)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.ReconciliationMatch)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, runId, matchId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeletePaymentsConfigById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePaymentsConfigById'
-type MockStore_DeletePaymentsConfigById_Call struct {
+// MockStore_GetReconciliationMatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationMatch'
+type MockStore_GetReconciliationMatch_Call struct {
 	*mock.Call
 }
 
-// DeletePaymentsConfigById is a helper method to define mock.On call
+// GetReconciliationMatch is a helper method to define mock.On call
 //   - ctx context.Context
-//   - paymentsConfigId uuid.UUID
-func (_e *MockStore_Expecter) DeletePaymentsConfigById(ctx interface{}, paymentsConfigId interface{}) *MockStore_DeletePaymentsConfigById_Call {
-	return &MockStore_DeletePaymentsConfigById_Call{Call: _e.mock.On("DeletePaymentsConfigById", ctx, paymentsConfigId)}
+//   - runId uuid.UUID
+//   - matchId uuid.UUID
+func (_e *MockStore_Expecter) GetReconciliationMatch(ctx interface{}, runId interface{}, matchId interface{}) *MockStore_GetReconciliationMatch_Call {
+	return &MockStore_GetReconciliationMatch_Call{Call: _e.mock.On("GetReconciliationMatch", ctx, runId, matchId)}
 }
 
-func (_c *MockStore_DeletePaymentsConfigById_Call) Run(run func(ctx context.Context, paymentsConfigId uuid.UUID)) *MockStore_DeletePaymentsConfigById_Call {
+func (_c *MockStore_GetReconciliationMatch_Call) Run(run func(ctx context.Context, runId uuid.UUID, matchId uuid.UUID)) *MockStore_GetReconciliationMatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeletePaymentsConfigById_Call) Return(_a0 error) *MockStore_DeletePaymentsConfigById_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_GetReconciliationMatch_Call) Return(_a0 models.ReconciliationMatch, _a1 error) *MockStore_GetReconciliationMatch_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeletePaymentsConfigById_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockStore_DeletePaymentsConfigById_Call {
+func (_c *MockStore_GetReconciliationMatch_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (models.ReconciliationMatch, error)) *MockStore_GetReconciliationMatch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRule provides a mock function with given fields: ctx, params
-func (_m *MockStore) DeleteRule(ctx context.Context, params models.DeleteRuleParams) error {
-	ret := _m.Called(ctx, params)
+// GetReconciliationMatches provides a mock function with given fields: ctx, runId, filters
+func (_m *MockStore) GetReconciliationMatches(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error) {
+	ret := _m.Called(ctx, runId, filters)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRule")
+		panic("no return value specified for GetReconciliationMatches")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.DeleteRuleParams) error); ok {
-		r0 = rf(ctx, params)
+	var r0 []models.ReconciliationMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error)); ok {
+		return rf(ctx, runId, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) []models.ReconciliationMatch); ok {
+		r0 = rf(ctx, runId, filters)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReconciliationMatch)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) error); ok {
+		r1 = rf(ctx, runId, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRule'
-type MockStore_DeleteRule_Call struct {
+// MockStore_GetReconciliationMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationMatches'
+type MockStore_GetReconciliationMatches_Call struct {
 	*mock.Call
 }
 
-// DeleteRule is a helper method to define mock.On call
+// GetReconciliationMatches is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params models.DeleteRuleParams
-func (_e *MockStore_Expecter) DeleteRule(ctx interface{}, params interface{}) *MockStore_DeleteRule_Call {
-	return &MockStore_DeleteRule_Call{Call: _e.mock.On("DeleteRule", ctx, params)}
+//   - runId uuid.UUID
+//   - filters models.ReconciliationMatchFilters
+func (_e *MockStore_Expecter) GetReconciliationMatches(ctx interface{}, runId interface{}, filters interface{}) *MockStore_GetReconciliationMatches_Call {
+	return &MockStore_GetReconciliationMatches_Call{Call: _e.mock.On("GetReconciliationMatches", ctx, runId, filters)}
 }
 
-func (_c *MockStore_DeleteRule_Call) Run(run func(ctx context.Context, params models.DeleteRuleParams)) *MockStore_DeleteRule_Call {
+func (_c *MockStore_GetReconciliationMatches_Call) Run(run func(ctx context.Context, runId uuid.UUID, filters models.ReconciliationMatchFilters)) *MockStore_GetReconciliationMatches_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.DeleteRuleParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.ReconciliationMatchFilters))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteRule_Call) Return(_a0 error) *MockStore_DeleteRule_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_GetReconciliationMatches_Call) Return(_a0 []models.ReconciliationMatch, _a1 error) *MockStore_GetReconciliationMatches_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteRule_Call) RunAndReturn(run func(context.Context, models.DeleteRuleParams) error) *MockStore_DeleteRule_Call {
+func (_c *MockStore_GetReconciliationMatches_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.ReconciliationMatchFilters) ([]models.ReconciliationMatch, error)) *MockStore_GetReconciliationMatches_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTeam provides a mock function with given fields: ctx, organizationId, teamId
-func (_m *MockStore) DeleteTeam(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID) error {
-	ret := _m.Called(ctx, organizationId, teamId)
+// GetReconciliationRunById provides a mock function with given fields: ctx, runId
+func (_m *MockStore) GetReconciliationRunById(ctx context.Context, runId uuid.UUID) (models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, runId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTeam")
+		panic("no return value specified for GetReconciliationRunById")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, organizationId, teamId)
+	var r0 models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.ReconciliationRun, error)); ok {
+		return rf(ctx, runId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.ReconciliationRun); ok {
+		r0 = rf(ctx, runId)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.ReconciliationRun)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, runId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
-type MockStore_DeleteTeam_Call struct {
+// MockStore_GetReconciliationRunById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationRunById'
+type MockStore_GetReconciliationRunById_Call struct {
 	*mock.Call
 }
 
-// DeleteTeam is a helper method to define mock.On call
+// GetReconciliationRunById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - teamId uuid.UUID
-func (_e *MockStore_Expecter) DeleteTeam(ctx interface{}, organizationId interface{}, teamId interface{}) *MockStore_DeleteTeam_Call {
-	return &MockStore_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", ctx, organizationId, teamId)}
+//   - runId uuid.UUID
+func (_e *MockStore_Expecter) GetReconciliationRunById(ctx interface{}, runId interface{}) *MockStore_GetReconciliationRunById_Call {
+	return &MockStore_GetReconciliationRunById_Call{Call: _e.mock.On("GetReconciliationRunById", ctx, runId)}
 }
 
-func (_c *MockStore_DeleteTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID)) *MockStore_DeleteTeam_Call {
+func (_c *MockStore_GetReconciliationRunById_Call) Run(run func(ctx context.Context, runId uuid.UUID)) *MockStore_GetReconciliationRunById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteTeam_Call) Return(_a0 error) *MockStore_DeleteTeam_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_GetReconciliationRunById_Call) Return(_a0 models.ReconciliationRun, _a1 error) *MockStore_GetReconciliationRunById_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteTeam_Call {
+func (_c *MockStore_GetReconciliationRunById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.ReconciliationRun, error)) *MockStore_GetReconciliationRunById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTeamMembership provides a mock function with given fields: ctx, teamId, teamMembershipId
-func (_m *MockStore) DeleteTeamMembership(ctx context.Context, teamId uuid.UUID, teamMembershipId uuid.UUID) error {
-	ret := _m.Called(ctx, teamId, teamMembershipId)
+// GetReconciliationRuns provides a mock function with given fields: ctx, reconciliationId, limit
+func (_m *MockStore) GetReconciliationRuns(ctx context.Context, reconciliationId uuid.UUID, limit int) ([]models.ReconciliationRun, error) {
+	ret := _m.Called(ctx, reconciliationId, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTeamMembership")
+		panic("no return value specified for GetReconciliationRuns")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, teamId, teamMembershipId)
+	var r0 []models.ReconciliationRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.ReconciliationRun, error)); ok {
+		return rf(ctx, reconciliationId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.ReconciliationRun); ok {
+		r0 = rf(ctx, reconciliationId, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReconciliationRun)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, reconciliationId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_DeleteTeamMembership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeamMembership'
-type MockStore_DeleteTeamMembership_Call struct {
+// MockStore_GetReconciliationRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationRuns'
+type MockStore_GetReconciliationRuns_Call struct {
 	*mock.Call
 }
 
-// DeleteTeamMembership is a helper method to define mock.On call
+// GetReconciliationRuns is a helper method to define mock.On call
 //   - ctx context.Context
-//   - teamId uuid.UUID
-//   - teamMembershipId uuid.UUID
-func (_e *MockStore_Expecter) DeleteTeamMembership(ctx interface{}, teamId interface{}, teamMembershipId interface{}) *MockStore_DeleteTeamMembership_Call {
-	return &MockStore_DeleteTeamMembership_Call{Call: _e.mock.On("DeleteTeamMembership", ctx, teamId, teamMembershipId)}
+//   - reconciliationId uuid.UUID
+//   - limit int
+func (_e *MockStore_Expecter) GetReconciliationRuns(ctx interface{}, reconciliationId interface{}, limit interface{}) *MockStore_GetReconciliationRuns_Call {
+	return &MockStore_GetReconciliationRuns_Call{Call: _e.mock.On("GetReconciliationRuns", ctx, reconciliationId, limit)}
 }
 
-func (_c *MockStore_DeleteTeamMembership_Call) Run(run func(ctx context.Context, teamId uuid.UUID, teamMembershipId uuid.UUID)) *MockStore_DeleteTeamMembership_Call {
+func (_c *MockStore_GetReconciliationRuns_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, limit int)) *MockStore_GetReconciliationRuns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteTeamMembership_Call) Return(_a0 error) *MockStore_DeleteTeamMembership_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_GetReconciliationRuns_Call) Return(_a0 []models.ReconciliationRun, _a1 error) *MockStore_GetReconciliationRuns_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_DeleteTeamMembership_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockStore_DeleteTeamMembership_Call {
+func (_c *MockStore_GetReconciliationRuns_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) ([]models.ReconciliationRun, error)) *MockStore_GetReconciliationRuns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllConnectors provides a mock function with given fields: ctx
-func (_m *MockStore) GetAllConnectors(ctx context.Context) ([]models.ConnectorWithActiveConnectionsCount, error) {
+// GetReconciliations provides a mock function with given fields: ctx
+func (_m *MockStore) GetReconciliations(ctx context.Context) ([]models.Reconciliation, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllConnectors")
+		panic("no return value specified for GetReconciliations")
 	}
 
-	var r0 []models.ConnectorWithActiveConnectionsCount
+	var r0 []models.Reconciliation
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ConnectorWithActiveConnectionsCount, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Reconciliation, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []models.ConnectorWithActiveConnectionsCount); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Reconciliation); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ConnectorWithActiveConnectionsCount)
+			r0 = ret.Get(0).([]models.Reconciliation)
 		}
 	}
 
@@ -1775,52 +7941,52 @@ func (_m *MockStore) GetAllConnectors(ctx context.Context) ([]models.ConnectorWi
 	return r0, r1
 }
 
-// MockStore_GetAllConnectors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllConnectors'
-type MockStore_GetAllConnectors_Call struct {
+// MockStore_GetReconciliations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliations'
+type MockStore_GetReconciliations_Call struct {
 	*mock.Call
 }
 
-// GetAllConnectors is a helper method to define mock.On call
+// GetReconciliations is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetAllConnectors(ctx interface{}) *MockStore_GetAllConnectors_Call {
-	return &MockStore_GetAllConnectors_Call{Call: _e.mock.On("GetAllConnectors", ctx)}
+func (_e *MockStore_Expecter) GetReconciliations(ctx interface{}) *MockStore_GetReconciliations_Call {
+	return &MockStore_GetReconciliations_Call{Call: _e.mock.On("GetReconciliations", ctx)}
 }
 
-func (_c *MockStore_GetAllConnectors_Call) Run(run func(ctx context.Context)) *MockStore_GetAllConnectors_Call {
+func (_c *MockStore_GetReconciliations_Call) Run(run func(ctx context.Context)) *MockStore_GetReconciliations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetAllConnectors_Call) Return(_a0 []models.ConnectorWithActiveConnectionsCount, _a1 error) *MockStore_GetAllConnectors_Call {
+func (_c *MockStore_GetReconciliations_Call) Return(_a0 []models.Reconciliation, _a1 error) *MockStore_GetReconciliations_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetAllConnectors_Call) RunAndReturn(run func(context.Context) ([]models.ConnectorWithActiveConnectionsCount, error)) *MockStore_GetAllConnectors_Call {
+func (_c *MockStore_GetReconciliations_Call) RunAndReturn(run func(context.Context) ([]models.Reconciliation, error)) *MockStore_GetReconciliations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllFileUploads provides a mock function with given fields: ctx
-func (_m *MockStore) GetAllFileUploads(ctx context.Context) ([]*models.FileUpload, error) {
+// GetReferenceBanks provides a mock function with given fields: ctx
+func (_m *MockStore) GetReferenceBanks(ctx context.Context) ([]models.ReferenceBank, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllFileUploads")
+		panic("no return value specified for GetReferenceBanks")
 	}
 
-	var r0 []*models.FileUpload
+	var r0 []models.ReferenceBank
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*models.FileUpload, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.ReferenceBank, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*models.FileUpload); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []models.ReferenceBank); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.FileUpload)
+			r0 = ret.Get(0).([]models.ReferenceBank)
 		}
 	}
 
@@ -1833,57 +7999,55 @@ func (_m *MockStore) GetAllFileUploads(ctx context.Context) ([]*models.FileUploa
 	return r0, r1
 }
 
-// MockStore_GetAllFileUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllFileUploads'
-type MockStore_GetAllFileUploads_Call struct {
+// MockStore_GetReferenceBanks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferenceBanks'
+type MockStore_GetReferenceBanks_Call struct {
 	*mock.Call
 }
 
-// GetAllFileUploads is a helper method to define mock.On call
+// GetReferenceBanks is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetAllFileUploads(ctx interface{}) *MockStore_GetAllFileUploads_Call {
-	return &MockStore_GetAllFileUploads_Call{Call: _e.mock.On("GetAllFileUploads", ctx)}
+func (_e *MockStore_Expecter) GetReferenceBanks(ctx interface{}) *MockStore_GetReferenceBanks_Call {
+	return &MockStore_GetReferenceBanks_Call{Call: _e.mock.On("GetReferenceBanks", ctx)}
 }
 
-func (_c *MockStore_GetAllFileUploads_Call) Run(run func(ctx context.Context)) *MockStore_GetAllFileUploads_Call {
+func (_c *MockStore_GetReferenceBanks_Call) Run(run func(ctx context.Context)) *MockStore_GetReferenceBanks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetAllFileUploads_Call) Return(_a0 []*models.FileUpload, _a1 error) *MockStore_GetAllFileUploads_Call {
+func (_c *MockStore_GetReferenceBanks_Call) Return(_a0 []models.ReferenceBank, _a1 error) *MockStore_GetReferenceBanks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetAllFileUploads_Call) RunAndReturn(run func(context.Context) ([]*models.FileUpload, error)) *MockStore_GetAllFileUploads_Call {
+func (_c *MockStore_GetReferenceBanks_Call) RunAndReturn(run func(context.Context) ([]models.ReferenceBank, error)) *MockStore_GetReferenceBanks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConnectionByID provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetConnectionByID(ctx context.Context, id uuid.UUID) (*models.Connection, error) {
-	ret := _m.Called(ctx, id)
+// GetRuleById provides a mock function with given fields: ctx, ruleId
+func (_m *MockStore) GetRuleById(ctx context.Context, ruleId uuid.UUID) (models.Rule, error) {
+	ret := _m.Called(ctx, ruleId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConnectionByID")
+		panic("no return value specified for GetRuleById")
 	}
 
-	var r0 *models.Connection
+	var r0 models.Rule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Connection, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Rule, error)); ok {
+		return rf(ctx, ruleId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Connection); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Rule); ok {
+		r0 = rf(ctx, ruleId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Connection)
-		}
+		r0 = ret.Get(0).(models.Rule)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+		r1 = rf(ctx, ruleId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1891,58 +8055,58 @@ func (_m *MockStore) GetConnectionByID(ctx context.Context, id uuid.UUID) (*mode
 	return r0, r1
 }
 
-// MockStore_GetConnectionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionByID'
-type MockStore_GetConnectionByID_Call struct {
+// MockStore_GetRuleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleById'
+type MockStore_GetRuleById_Call struct {
 	*mock.Call
 }
 
-// GetConnectionByID is a helper method to define mock.On call
+// GetRuleById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) GetConnectionByID(ctx interface{}, id interface{}) *MockStore_GetConnectionByID_Call {
-	return &MockStore_GetConnectionByID_Call{Call: _e.mock.On("GetConnectionByID", ctx, id)}
+//   - ruleId uuid.UUID
+func (_e *MockStore_Expecter) GetRuleById(ctx interface{}, ruleId interface{}) *MockStore_GetRuleById_Call {
+	return &MockStore_GetRuleById_Call{Call: _e.mock.On("GetRuleById", ctx, ruleId)}
 }
 
-func (_c *MockStore_GetConnectionByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetConnectionByID_Call {
+func (_c *MockStore_GetRuleById_Call) Run(run func(ctx context.Context, ruleId uuid.UUID)) *MockStore_GetRuleById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetConnectionByID_Call) Return(_a0 *models.Connection, _a1 error) *MockStore_GetConnectionByID_Call {
+func (_c *MockStore_GetRuleById_Call) Return(_a0 models.Rule, _a1 error) *MockStore_GetRuleById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetConnectionByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Connection, error)) *MockStore_GetConnectionByID_Call {
+func (_c *MockStore_GetRuleById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Rule, error)) *MockStore_GetRuleById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConnectionPolicies provides a mock function with given fields: ctx, connectionId
-func (_m *MockStore) GetConnectionPolicies(ctx context.Context, connectionId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, connectionId)
+// GetRuleByIds provides a mock function with given fields: ctx, ruleIds
+func (_m *MockStore) GetRuleByIds(ctx context.Context, ruleIds []uuid.UUID) ([]models.Rule, error) {
+	ret := _m.Called(ctx, ruleIds)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConnectionPolicies")
+		panic("no return value specified for GetRuleByIds")
 	}
 
-	var r0 []models.ResourceAudiencePolicy
+	var r0 []models.Rule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, connectionId)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]models.Rule, error)); ok {
+		return rf(ctx, ruleIds)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, connectionId)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []models.Rule); ok {
+		r0 = rf(ctx, ruleIds)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+			r0 = ret.Get(0).([]models.Rule)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, connectionId)
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
+		r1 = rf(ctx, ruleIds)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1950,58 +8114,58 @@ func (_m *MockStore) GetConnectionPolicies(ctx context.Context, connectionId uui
 	return r0, r1
 }
 
-// MockStore_GetConnectionPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectionPolicies'
-type MockStore_GetConnectionPolicies_Call struct {
+// MockStore_GetRuleByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleByIds'
+type MockStore_GetRuleByIds_Call struct {
 	*mock.Call
 }
 
-// GetConnectionPolicies is a helper method to define mock.On call
+// GetRuleByIds is a helper method to define mock.On call
 //   - ctx context.Context
-//   - connectionId uuid.UUID
-func (_e *MockStore_Expecter) GetConnectionPolicies(ctx interface{}, connectionId interface{}) *MockStore_GetConnectionPolicies_Call {
-	return &MockStore_GetConnectionPolicies_Call{Call: _e.mock.On("GetConnectionPolicies", ctx, connectionId)}
+//   - ruleIds []uuid.UUID
+func (_e *MockStore_Expecter) GetRuleByIds(ctx interface{}, ruleIds interface{}) *MockStore_GetRuleByIds_Call {
+	return &MockStore_GetRuleByIds_Call{Call: _e.mock.On("GetRuleByIds", ctx, ruleIds)}
 }
 
-func (_c *MockStore_GetConnectionPolicies_Call) Run(run func(ctx context.Context, connectionId uuid.UUID)) *MockStore_GetConnectionPolicies_Call {
+func (_c *MockStore_GetRuleByIds_Call) Run(run func(ctx context.Context, ruleIds []uuid.UUID)) *MockStore_GetRuleByIds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].([]uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetConnectionPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetConnectionPolicies_Call {
+func (_c *MockStore_GetRuleByIds_Call) Return(_a0 []models.Rule, _a1 error) *MockStore_GetRuleByIds_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetConnectionPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetConnectionPolicies_Call {
+func (_c *MockStore_GetRuleByIds_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]models.Rule, error)) *MockStore_GetRuleByIds_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConnections provides a mock function with given fields: ctx
-func (_m *MockStore) GetConnections(ctx context.Context) ([]models.Connection, error) {
-	ret := _m.Called(ctx)
+// GetRuleRuns provides a mock function with given fields: ctx, ruleIds, limit
+func (_m *MockStore) GetRuleRuns(ctx context.Context, ruleIds []uuid.UUID, limit int) ([]models.RuleRun, error) {
+	ret := _m.Called(ctx, ruleIds, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConnections")
+		panic("no return value specified for GetRuleRuns")
 	}
 
-	var r0 []models.Connection
+	var r0 []models.RuleRun
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Connection, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) ([]models.RuleRun, error)); ok {
+		return rf(ctx, ruleIds, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []models.Connection); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, int) []models.RuleRun); ok {
+		r0 = rf(ctx, ruleIds, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Connection)
+			r0 = ret.Get(0).([]models.RuleRun)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, int) error); ok {
+		r1 = rf(ctx, ruleIds, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2009,57 +8173,57 @@ func (_m *MockStore) GetConnections(ctx context.Context) ([]models.Connection, e
 	return r0, r1
 }
 
-// MockStore_GetConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnections'
-type MockStore_GetConnections_Call struct {
+// MockStore_GetRuleRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleRuns'
+type MockStore_GetRuleRuns_Call struct {
 	*mock.Call
 }
 
-// GetConnections is a helper method to define mock.On call
+// GetRuleRuns is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetConnections(ctx interface{}) *MockStore_GetConnections_Call {
-	return &MockStore_GetConnections_Call{Call: _e.mock.On("GetConnections", ctx)}
+//   - ruleIds []uuid.UUID
+//   - limit int
+func (_e *MockStore_Expecter) GetRuleRuns(ctx interface{}, ruleIds interface{}, limit interface{}) *MockStore_GetRuleRuns_Call {
+	return &MockStore_GetRuleRuns_Call{Call: _e.mock.On("GetRuleRuns", ctx, ruleIds, limit)}
 }
 
-func (_c *MockStore_GetConnections_Call) Run(run func(ctx context.Context)) *MockStore_GetConnections_Call {
+func (_c *MockStore_GetRuleRuns_Call) Run(run func(ctx context.Context, ruleIds []uuid.UUID, limit int)) *MockStore_GetRuleRuns_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetConnections_Call) Return(_a0 []models.Connection, _a1 error) *MockStore_GetConnections_Call {
+func (_c *MockStore_GetRuleRuns_Call) Return(_a0 []models.RuleRun, _a1 error) *MockStore_GetRuleRuns_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetConnections_Call) RunAndReturn(run func(context.Context) ([]models.Connection, error)) *MockStore_GetConnections_Call {
+func (_c *MockStore_GetRuleRuns_Call) RunAndReturn(run func(context.Context, []uuid.UUID, int) ([]models.RuleRun, error)) *MockStore_GetRuleRuns_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConnectorById provides a mock function with given fields: ctx, id
-func (_m *MockStore) GetConnectorById(ctx context.Context, id uuid.UUID) (*models.Connector, error) {
-	ret := _m.Called(ctx, id)
+// GetRuleVersion provides a mock function with given fields: ctx, ruleId, version
+func (_m *MockStore) GetRuleVersion(ctx context.Context, ruleId uuid.UUID, version int) (models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId, version)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConnectorById")
+		panic("no return value specified for GetRuleVersion")
 	}
 
-	var r0 *models.Connector
+	var r0 models.RuleVersion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Connector, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (models.RuleVersion, error)); ok {
+		return rf(ctx, ruleId, version)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Connector); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) models.RuleVersion); ok {
+		r0 = rf(ctx, ruleId, version)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Connector)
-		}
+		r0 = ret.Get(0).(models.RuleVersion)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = rf(ctx, ruleId, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2067,58 +8231,59 @@ func (_m *MockStore) GetConnectorById(ctx context.Context, id uuid.UUID) (*model
 	return r0, r1
 }
 
-// MockStore_GetConnectorById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConnectorById'
-type MockStore_GetConnectorById_Call struct {
+// MockStore_GetRuleVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleVersion'
+type MockStore_GetRuleVersion_Call struct {
 	*mock.Call
 }
 
-// GetConnectorById is a helper method to define mock.On call
+// GetRuleVersion is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-func (_e *MockStore_Expecter) GetConnectorById(ctx interface{}, id interface{}) *MockStore_GetConnectorById_Call {
-	return &MockStore_GetConnectorById_Call{Call: _e.mock.On("GetConnectorById", ctx, id)}
+//   - ruleId uuid.UUID
+//   - version int
+func (_e *MockStore_Expecter) GetRuleVersion(ctx interface{}, ruleId interface{}, version interface{}) *MockStore_GetRuleVersion_Call {
+	return &MockStore_GetRuleVersion_Call{Call: _e.mock.On("GetRuleVersion", ctx, ruleId, version)}
 }
 
-func (_c *MockStore_GetConnectorById_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockStore_GetConnectorById_Call {
+func (_c *MockStore_GetRuleVersion_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, version int)) *MockStore_GetRuleVersion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetConnectorById_Call) Return(_a0 *models.Connector, _a1 error) *MockStore_GetConnectorById_Call {
+func (_c *MockStore_GetRuleVersion_Call) Return(_a0 models.RuleVersion, _a1 error) *MockStore_GetRuleVersion_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetConnectorById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Connector, error)) *MockStore_GetConnectorById_Call {
+func (_c *MockStore_GetRuleVersion_Call) RunAndReturn(run func(context.Context, uuid.UUID, int) (models.RuleVersion, error)) *MockStore_GetRuleVersion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetActionFromActionId provides a mock function with given fields: ctx, actionId
-func (_m *MockStore) GetDatasetActionFromActionId(ctx context.Context, actionId string) (*models.DatasetAction, error) {
-	ret := _m.Called(ctx, actionId)
+// GetRuleVersions provides a mock function with given fields: ctx, ruleId
+func (_m *MockStore) GetRuleVersions(ctx context.Context, ruleId uuid.UUID) ([]models.RuleVersion, error) {
+	ret := _m.Called(ctx, ruleId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetActionFromActionId")
+		panic("no return value specified for GetRuleVersions")
 	}
 
-	var r0 *models.DatasetAction
+	var r0 []models.RuleVersion
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.DatasetAction, error)); ok {
-		return rf(ctx, actionId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.RuleVersion, error)); ok {
+		return rf(ctx, ruleId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.DatasetAction); ok {
-		r0 = rf(ctx, actionId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.RuleVersion); ok {
+		r0 = rf(ctx, ruleId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.DatasetAction)
+			r0 = ret.Get(0).([]models.RuleVersion)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, actionId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ruleId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2126,58 +8291,58 @@ func (_m *MockStore) GetDatasetActionFromActionId(ctx context.Context, actionId
 	return r0, r1
 }
 
-// MockStore_GetDatasetActionFromActionId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActionFromActionId'
-type MockStore_GetDatasetActionFromActionId_Call struct {
+// MockStore_GetRuleVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleVersions'
+type MockStore_GetRuleVersions_Call struct {
 	*mock.Call
 }
 
-// GetDatasetActionFromActionId is a helper method to define mock.On call
+// GetRuleVersions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - actionId string
-func (_e *MockStore_Expecter) GetDatasetActionFromActionId(ctx interface{}, actionId interface{}) *MockStore_GetDatasetActionFromActionId_Call {
-	return &MockStore_GetDatasetActionFromActionId_Call{Call: _e.mock.On("GetDatasetActionFromActionId", ctx, actionId)}
+//   - ruleId uuid.UUID
+func (_e *MockStore_Expecter) GetRuleVersions(ctx interface{}, ruleId interface{}) *MockStore_GetRuleVersions_Call {
+	return &MockStore_GetRuleVersions_Call{Call: _e.mock.On("GetRuleVersions", ctx, ruleId)}
 }
 
-func (_c *MockStore_GetDatasetActionFromActionId_Call) Run(run func(ctx context.Context, actionId string)) *MockStore_GetDatasetActionFromActionId_Call {
+func (_c *MockStore_GetRuleVersions_Call) Run(run func(ctx context.Context, ruleId uuid.UUID)) *MockStore_GetRuleVersions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetDatasetActionFromActionId_Call) Return(_a0 *models.DatasetAction, _a1 error) *MockStore_GetDatasetActionFromActionId_Call {
+func (_c *MockStore_GetRuleVersions_Call) Return(_a0 []models.RuleVersion, _a1 error) *MockStore_GetRuleVersions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetDatasetActionFromActionId_Call) RunAndReturn(run func(context.Context, string) (*models.DatasetAction, error)) *MockStore_GetDatasetActionFromActionId_Call {
+func (_c *MockStore_GetRuleVersions_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.RuleVersion, error)) *MockStore_GetRuleVersions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetActions provides a mock function with given fields: ctx, organizationId, filters
-func (_m *MockStore) GetDatasetActions(ctx context.Context, organizationId uuid.UUID, filters models.DatasetActionFilters) ([]models.DatasetAction, error) {
-	ret := _m.Called(ctx, organizationId, filters)
+// GetRules provides a mock function with given fields: ctx, params
+func (_m *MockStore) GetRules(ctx context.Context, params models.FilterRuleParams) (map[string]map[string][]models.Rule, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetActions")
+		panic("no return value specified for GetRules")
 	}
 
-	var r0 []models.DatasetAction
+	var r0 map[string]map[string][]models.Rule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]models.DatasetAction, error)); ok {
-		return rf(ctx, organizationId, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, models.FilterRuleParams) (map[string]map[string][]models.Rule, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetActionFilters) []models.DatasetAction); ok {
-		r0 = rf(ctx, organizationId, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, models.FilterRuleParams) map[string]map[string][]models.Rule); ok {
+		r0 = rf(ctx, params)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DatasetAction)
+			r0 = ret.Get(0).(map[string]map[string][]models.Rule)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetActionFilters) error); ok {
-		r1 = rf(ctx, organizationId, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, models.FilterRuleParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2185,59 +8350,58 @@ func (_m *MockStore) GetDatasetActions(ctx context.Context, organizationId uuid.
 	return r0, r1
 }
 
-// MockStore_GetDatasetActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetActions'
-type MockStore_GetDatasetActions_Call struct {
+// MockStore_GetRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRules'
+type MockStore_GetRules_Call struct {
 	*mock.Call
 }
 
-// GetDatasetActions is a helper method to define mock.On call
+// GetRules is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - filters models.DatasetActionFilters
-func (_e *MockStore_Expecter) GetDatasetActions(ctx interface{}, organizationId interface{}, filters interface{}) *MockStore_GetDatasetActions_Call {
-	return &MockStore_GetDatasetActions_Call{Call: _e.mock.On("GetDatasetActions", ctx, organizationId, filters)}
+//   - params models.FilterRuleParams
+func (_e *MockStore_Expecter) GetRules(ctx interface{}, params interface{}) *MockStore_GetRules_Call {
+	return &MockStore_GetRules_Call{Call: _e.mock.On("GetRules", ctx, params)}
 }
 
-func (_c *MockStore_GetDatasetActions_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, filters models.DatasetActionFilters)) *MockStore_GetDatasetActions_Call {
+func (_c *MockStore_GetRules_Call) Run(run func(ctx context.Context, params models.FilterRuleParams)) *MockStore_GetRules_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetActionFilters))
+		run(args[0].(context.Context), args[1].(models.FilterRuleParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetDatasetActions_Call) Return(_a0 []models.DatasetAction, _a1 error) *MockStore_GetDatasetActions_Call {
+func (_c *MockStore_GetRules_Call) Return(_a0 map[string]map[string][]models.Rule, _a1 error) *MockStore_GetRules_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetDatasetActions_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetActionFilters) ([]models.DatasetAction, error)) *MockStore_GetDatasetActions_Call {
+func (_c *MockStore_GetRules_Call) RunAndReturn(run func(context.Context, models.FilterRuleParams) (map[string]map[string][]models.Rule, error)) *MockStore_GetRules_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetById provides a mock function with given fields: ctx, datasetId
-func (_m *MockStore) GetDatasetById(ctx context.Context, datasetId string) (*models.Dataset, error) {
-	ret := _m.Called(ctx, datasetId)
+// GetSSOConfigByDomain provides a mock function with given fields: ctx, domain
+func (_m *MockStore) GetSSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
+	ret := _m.Called(ctx, domain)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetById")
+		panic("no return value specified for GetSSOConfigByDomain")
 	}
 
-	var r0 *models.Dataset
+	var r0 *models.OrganizationSSOConfig
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Dataset, error)); ok {
-		return rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
+		return rf(ctx, domain)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Dataset); ok {
-		r0 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
+		r0 = rf(ctx, domain)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Dataset)
+			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, datasetId)
+		r1 = rf(ctx, domain)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2245,56 +8409,58 @@ func (_m *MockStore) GetDatasetById(ctx context.Context, datasetId string) (*mod
 	return r0, r1
 }
 
-// MockStore_GetDatasetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetById'
-type MockStore_GetDatasetById_Call struct {
+// MockStore_GetSSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSSOConfigByDomain'
+type MockStore_GetSSOConfigByDomain_Call struct {
 	*mock.Call
 }
 
-// GetDatasetById is a helper method to define mock.On call
+// GetSSOConfigByDomain is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId string
-func (_e *MockStore_Expecter) GetDatasetById(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetById_Call {
-	return &MockStore_GetDatasetById_Call{Call: _e.mock.On("GetDatasetById", ctx, datasetId)}
+//   - domain string
+func (_e *MockStore_Expecter) GetSSOConfigByDomain(ctx interface{}, domain interface{}) *MockStore_GetSSOConfigByDomain_Call {
+	return &MockStore_GetSSOConfigByDomain_Call{Call: _e.mock.On("GetSSOConfigByDomain", ctx, domain)}
 }
 
-func (_c *MockStore_GetDatasetById_Call) Run(run func(ctx context.Context, datasetId string)) *MockStore_GetDatasetById_Call {
+func (_c *MockStore_GetSSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockStore_GetSSOConfigByDomain_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetDatasetById_Call) Return(_a0 *models.Dataset, _a1 error) *MockStore_GetDatasetById_Call {
+func (_c *MockStore_GetSSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockStore_GetSSOConfigByDomain_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetDatasetById_Call) RunAndReturn(run func(context.Context, string) (*models.Dataset, error)) *MockStore_GetDatasetById_Call {
+func (_c *MockStore_GetSSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockStore_GetSSOConfigByDomain_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetCount provides a mock function with given fields: ctx, filters
-func (_m *MockStore) GetDatasetCount(ctx context.Context, filters models.DatasetFilters) (int64, error) {
-	ret := _m.Called(ctx, filters)
+// GetSchedulesByConnectionID provides a mock function with given fields: ctx, connectionID
+func (_m *MockStore) GetSchedulesByConnectionID(ctx context.Context, connectionID uuid.UUID) ([]models.Schedule, error) {
+	ret := _m.Called(ctx, connectionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetCount")
+		panic("no return value specified for GetSchedulesByConnectionID")
 	}
 
-	var r0 int64
+	var r0 []models.Schedule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) (int64, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Schedule, error)); ok {
+		return rf(ctx, connectionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) int64); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Schedule); ok {
+		r0 = rf(ctx, connectionID)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Schedule)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
-		r1 = rf(ctx, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, connectionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2302,58 +8468,58 @@ func (_m *MockStore) GetDatasetCount(ctx context.Context, filters models.Dataset
 	return r0, r1
 }
 
-// MockStore_GetDatasetCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetCount'
-type MockStore_GetDatasetCount_Call struct {
+// MockStore_GetSchedulesByConnectionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSchedulesByConnectionID'
+type MockStore_GetSchedulesByConnectionID_Call struct {
 	*mock.Call
 }
 
-// GetDatasetCount is a helper method to define mock.On call
+// GetSchedulesByConnectionID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters models.DatasetFilters
-func (_e *MockStore_Expecter) GetDatasetCount(ctx interface{}, filters interface{}) *MockStore_GetDatasetCount_Call {
-	return &MockStore_GetDatasetCount_Call{Call: _e.mock.On("GetDatasetCount", ctx, filters)}
+//   - connectionID uuid.UUID
+func (_e *MockStore_Expecter) GetSchedulesByConnectionID(ctx interface{}, connectionID interface{}) *MockStore_GetSchedulesByConnectionID_Call {
+	return &MockStore_GetSchedulesByConnectionID_Call{Call: _e.mock.On("GetSchedulesByConnectionID", ctx, connectionID)}
 }
 
-func (_c *MockStore_GetDatasetCount_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockStore_GetDatasetCount_Call {
+func (_c *MockStore_GetSchedulesByConnectionID_Call) Run(run func(ctx context.Context, connectionID uuid.UUID)) *MockStore_GetSchedulesByConnectionID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetDatasetCount_Call) Return(_a0 int64, _a1 error) *MockStore_GetDatasetCount_Call {
+func (_c *MockStore_GetSchedulesByConnectionID_Call) Return(_a0 []models.Schedule, _a1 error) *MockStore_GetSchedulesByConnectionID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetDatasetCount_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) (int64, error)) *MockStore_GetDatasetCount_Call {
+func (_c *MockStore_GetSchedulesByConnectionID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Schedule, error)) *MockStore_GetSchedulesByConnectionID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetFileUploadByDatasetId provides a mock function with given fields: ctx, datasetId
-func (_m *MockStore) GetDatasetFileUploadByDatasetId(ctx context.Context, datasetId uuid.UUID) ([]models.DatasetFileUpload, error) {
-	ret := _m.Called(ctx, datasetId)
+// GetSheetById provides a mock function with given fields: ctx, sheetId
+func (_m *MockStore) GetSheetById(ctx context.Context, sheetId uuid.UUID) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheetId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetFileUploadByDatasetId")
+		panic("no return value specified for GetSheetById")
 	}
 
-	var r0 []models.DatasetFileUpload
+	var r0 *models.Sheet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.DatasetFileUpload, error)); ok {
-		return rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Sheet, error)); ok {
+		return rf(ctx, sheetId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.DatasetFileUpload); ok {
-		r0 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Sheet); ok {
+		r0 = rf(ctx, sheetId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.DatasetFileUpload)
+			r0 = ret.Get(0).(*models.Sheet)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, datasetId)
+		r1 = rf(ctx, sheetId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2361,56 +8527,58 @@ func (_m *MockStore) GetDatasetFileUploadByDatasetId(ctx context.Context, datase
 	return r0, r1
 }
 
-// MockStore_GetDatasetFileUploadByDatasetId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploadByDatasetId'
-type MockStore_GetDatasetFileUploadByDatasetId_Call struct {
+// MockStore_GetSheetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetById'
+type MockStore_GetSheetById_Call struct {
 	*mock.Call
 }
 
-// GetDatasetFileUploadByDatasetId is a helper method to define mock.On call
+// GetSheetById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-func (_e *MockStore_Expecter) GetDatasetFileUploadByDatasetId(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetFileUploadByDatasetId_Call {
-	return &MockStore_GetDatasetFileUploadByDatasetId_Call{Call: _e.mock.On("GetDatasetFileUploadByDatasetId", ctx, datasetId)}
+//   - sheetId uuid.UUID
+func (_e *MockStore_Expecter) GetSheetById(ctx interface{}, sheetId interface{}) *MockStore_GetSheetById_Call {
+	return &MockStore_GetSheetById_Call{Call: _e.mock.On("GetSheetById", ctx, sheetId)}
 }
 
-func (_c *MockStore_GetDatasetFileUploadByDatasetId_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetFileUploadByDatasetId_Call {
+func (_c *MockStore_GetSheetById_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockStore_GetSheetById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetDatasetFileUploadByDatasetId_Call) Return(_a0 []models.DatasetFileUpload, _a1 error) *MockStore_GetDatasetFileUploadByDatasetId_Call {
+func (_c *MockStore_GetSheetById_Call) Return(_a0 *models.Sheet, _a1 error) *MockStore_GetSheetById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetDatasetFileUploadByDatasetId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.DatasetFileUpload, error)) *MockStore_GetDatasetFileUploadByDatasetId_Call {
+func (_c *MockStore_GetSheetById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Sheet, error)) *MockStore_GetSheetById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetFileUploadById provides a mock function with given fields: ctx, fileUploadId
-func (_m *MockStore) GetDatasetFileUploadById(ctx context.Context, fileUploadId uuid.UUID) (models.DatasetFileUpload, error) {
-	ret := _m.Called(ctx, fileUploadId)
+// GetSheetsAll provides a mock function with given fields: ctx, filters
+func (_m *MockStore) GetSheetsAll(ctx context.Context, filters models.SheetFilters) ([]models.Sheet, error) {
+	ret := _m.Called(ctx, filters)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetFileUploadById")
+		panic("no return value specified for GetSheetsAll")
 	}
 
-	var r0 models.DatasetFileUpload
+	var r0 []models.Sheet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.DatasetFileUpload, error)); ok {
-		return rf(ctx, fileUploadId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) ([]models.Sheet, error)); ok {
+		return rf(ctx, filters)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.DatasetFileUpload); ok {
-		r0 = rf(ctx, fileUploadId)
+	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) []models.Sheet); ok {
+		r0 = rf(ctx, filters)
 	} else {
-		r0 = ret.Get(0).(models.DatasetFileUpload)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Sheet)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, fileUploadId)
+	if rf, ok := ret.Get(1).(func(context.Context, models.SheetFilters) error); ok {
+		r1 = rf(ctx, filters)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2418,58 +8586,56 @@ func (_m *MockStore) GetDatasetFileUploadById(ctx context.Context, fileUploadId
 	return r0, r1
 }
 
-// MockStore_GetDatasetFileUploadById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetFileUploadById'
-type MockStore_GetDatasetFileUploadById_Call struct {
+// MockStore_GetSheetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetsAll'
+type MockStore_GetSheetsAll_Call struct {
 	*mock.Call
 }
 
-// GetDatasetFileUploadById is a helper method to define mock.On call
+// GetSheetsAll is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fileUploadId uuid.UUID
-func (_e *MockStore_Expecter) GetDatasetFileUploadById(ctx interface{}, fileUploadId interface{}) *MockStore_GetDatasetFileUploadById_Call {
-	return &MockStore_GetDatasetFileUploadById_Call{Call: _e.mock.On("GetDatasetFileUploadById", ctx, fileUploadId)}
+//   - filters models.SheetFilters
+func (_e *MockStore_Expecter) GetSheetsAll(ctx interface{}, filters interface{}) *MockStore_GetSheetsAll_Call {
+	return &MockStore_GetSheetsAll_Call{Call: _e.mock.On("GetSheetsAll", ctx, filters)}
 }
 
-func (_c *MockStore_GetDatasetFileUploadById_Call) Run(run func(ctx context.Context, fileUploadId uuid.UUID)) *MockStore_GetDatasetFileUploadById_Call {
+func (_c *MockStore_GetSheetsAll_Call) Run(run func(ctx context.Context, filters models.SheetFilters)) *MockStore_GetSheetsAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(models.SheetFilters))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetDatasetFileUploadById_Call) Return(_a0 models.DatasetFileUpload, _a1 error) *MockStore_GetDatasetFileUploadById_Call {
+func (_c *MockStore_GetSheetsAll_Call) Return(_a0 []models.Sheet, _a1 error) *MockStore_GetSheetsAll_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetDatasetFileUploadById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.DatasetFileUpload, error)) *MockStore_GetDatasetFileUploadById_Call {
+func (_c *MockStore_GetSheetsAll_Call) RunAndReturn(run func(context.Context, models.SheetFilters) ([]models.Sheet, error)) *MockStore_GetSheetsAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetPolicies provides a mock function with given fields: ctx, datasetId
-func (_m *MockStore) GetDatasetPolicies(ctx context.Context, datasetId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId)
+// GetTagById provides a mock function with given fields: ctx, tagId
+func (_m *MockStore) GetTagById(ctx context.Context, tagId uuid.UUID) (models.Tag, error) {
+	ret := _m.Called(ctx, tagId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetPolicies")
+		panic("no return value specified for GetTagById")
 	}
 
-	var r0 []models.ResourceAudiencePolicy
+	var r0 models.Tag
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Tag, error)); ok {
+		return rf(ctx, tagId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Tag); ok {
+		r0 = rf(ctx, tagId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Get(0).(models.Tag)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, datasetId)
+		r1 = rf(ctx, tagId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2477,58 +8643,58 @@ func (_m *MockStore) GetDatasetPolicies(ctx context.Context, datasetId uuid.UUID
 	return r0, r1
 }
 
-// MockStore_GetDatasetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPolicies'
-type MockStore_GetDatasetPolicies_Call struct {
+// MockStore_GetTagById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagById'
+type MockStore_GetTagById_Call struct {
 	*mock.Call
 }
 
-// GetDatasetPolicies is a helper method to define mock.On call
+// GetTagById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-func (_e *MockStore_Expecter) GetDatasetPolicies(ctx interface{}, datasetId interface{}) *MockStore_GetDatasetPolicies_Call {
-	return &MockStore_GetDatasetPolicies_Call{Call: _e.mock.On("GetDatasetPolicies", ctx, datasetId)}
+//   - tagId uuid.UUID
+func (_e *MockStore_Expecter) GetTagById(ctx interface{}, tagId interface{}) *MockStore_GetTagById_Call {
+	return &MockStore_GetTagById_Call{Call: _e.mock.On("GetTagById", ctx, tagId)}
 }
 
-func (_c *MockStore_GetDatasetPolicies_Call) Run(run func(ctx context.Context, datasetId uuid.UUID)) *MockStore_GetDatasetPolicies_Call {
+func (_c *MockStore_GetTagById_Call) Run(run func(ctx context.Context, tagId uuid.UUID)) *MockStore_GetTagById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetDatasetPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetDatasetPolicies_Call {
+func (_c *MockStore_GetTagById_Call) Return(_a0 models.Tag, _a1 error) *MockStore_GetTagById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetDatasetPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetDatasetPolicies_Call {
+func (_c *MockStore_GetTagById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Tag, error)) *MockStore_GetTagById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetPoliciesByEmail provides a mock function with given fields: ctx, datasetId, email
-func (_m *MockStore) GetDatasetPoliciesByEmail(ctx context.Context, datasetId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId, email)
+// GetTags provides a mock function with given fields: ctx
+func (_m *MockStore) GetTags(ctx context.Context) ([]models.Tag, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetPoliciesByEmail")
+		panic("no return value specified for GetTags")
 	}
 
-	var r0 []models.ResourceAudiencePolicy
+	var r0 []models.Tag
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId, email)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Tag, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId, email)
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Tag); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+			r0 = ret.Get(0).([]models.Tag)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, datasetId, email)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2536,59 +8702,57 @@ func (_m *MockStore) GetDatasetPoliciesByEmail(ctx context.Context, datasetId uu
 	return r0, r1
 }
 
-// MockStore_GetDatasetPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetPoliciesByEmail'
-type MockStore_GetDatasetPoliciesByEmail_Call struct {
+// MockStore_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockStore_GetTags_Call struct {
 	*mock.Call
 }
 
-// GetDatasetPoliciesByEmail is a helper method to define mock.On call
+// GetTags is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-//   - email string
-func (_e *MockStore_Expecter) GetDatasetPoliciesByEmail(ctx interface{}, datasetId interface{}, email interface{}) *MockStore_GetDatasetPoliciesByEmail_Call {
-	return &MockStore_GetDatasetPoliciesByEmail_Call{Call: _e.mock.On("GetDatasetPoliciesByEmail", ctx, datasetId, email)}
+func (_e *MockStore_Expecter) GetTags(ctx interface{}) *MockStore_GetTags_Call {
+	return &MockStore_GetTags_Call{Call: _e.mock.On("GetTags", ctx)}
 }
 
-func (_c *MockStore_GetDatasetPoliciesByEmail_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, email string)) *MockStore_GetDatasetPoliciesByEmail_Call {
+func (_c *MockStore_GetTags_Call) Run(run func(ctx context.Context)) *MockStore_GetTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetDatasetPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetDatasetPoliciesByEmail_Call {
+func (_c *MockStore_GetTags_Call) Return(_a0 []models.Tag, _a1 error) *MockStore_GetTags_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetDatasetPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetDatasetPoliciesByEmail_Call {
+func (_c *MockStore_GetTags_Call) RunAndReturn(run func(context.Context) ([]models.Tag, error)) *MockStore_GetTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDatasetsAll provides a mock function with given fields: ctx, filters
-func (_m *MockStore) GetDatasetsAll(ctx context.Context, filters models.DatasetFilters) ([]models.Dataset, error) {
-	ret := _m.Called(ctx, filters)
+// GetTeam provides a mock function with given fields: ctx, organizationId, teamId
+func (_m *MockStore) GetTeam(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID) (*models.Team, error) {
+	ret := _m.Called(ctx, organizationId, teamId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatasetsAll")
+		panic("no return value specified for GetTeam")
 	}
 
-	var r0 []models.Dataset
+	var r0 *models.Team
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) ([]models.Dataset, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.Team, error)); ok {
+		return rf(ctx, organizationId, teamId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.DatasetFilters) []models.Dataset); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.Team); ok {
+		r0 = rf(ctx, organizationId, teamId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Dataset)
+			r0 = ret.Get(0).(*models.Team)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.DatasetFilters) error); ok {
-		r1 = rf(ctx, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId, teamId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2596,58 +8760,59 @@ func (_m *MockStore) GetDatasetsAll(ctx context.Context, filters models.DatasetF
 	return r0, r1
 }
 
-// MockStore_GetDatasetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatasetsAll'
-type MockStore_GetDatasetsAll_Call struct {
+// MockStore_GetTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeam'
+type MockStore_GetTeam_Call struct {
 	*mock.Call
 }
 
-// GetDatasetsAll is a helper method to define mock.On call
+// GetTeam is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters models.DatasetFilters
-func (_e *MockStore_Expecter) GetDatasetsAll(ctx interface{}, filters interface{}) *MockStore_GetDatasetsAll_Call {
-	return &MockStore_GetDatasetsAll_Call{Call: _e.mock.On("GetDatasetsAll", ctx, filters)}
+//   - organizationId uuid.UUID
+//   - teamId uuid.UUID
+func (_e *MockStore_Expecter) GetTeam(ctx interface{}, organizationId interface{}, teamId interface{}) *MockStore_GetTeam_Call {
+	return &MockStore_GetTeam_Call{Call: _e.mock.On("GetTeam", ctx, organizationId, teamId)}
 }
 
-func (_c *MockStore_GetDatasetsAll_Call) Run(run func(ctx context.Context, filters models.DatasetFilters)) *MockStore_GetDatasetsAll_Call {
+func (_c *MockStore_GetTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID)) *MockStore_GetTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.DatasetFilters))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetDatasetsAll_Call) Return(_a0 []models.Dataset, _a1 error) *MockStore_GetDatasetsAll_Call {
+func (_c *MockStore_GetTeam_Call) Return(_a0 *models.Team, _a1 error) *MockStore_GetTeam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetDatasetsAll_Call) RunAndReturn(run func(context.Context, models.DatasetFilters) ([]models.Dataset, error)) *MockStore_GetDatasetsAll_Call {
+func (_c *MockStore_GetTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.Team, error)) *MockStore_GetTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFileUploadByIds provides a mock function with given fields: ctx, ids
-func (_m *MockStore) GetFileUploadByIds(ctx context.Context, ids []uuid.UUID) ([]models.FileUpload, error) {
-	ret := _m.Called(ctx, ids)
+// GetTeamByName provides a mock function with given fields: ctx, organizationId, name
+func (_m *MockStore) GetTeamByName(ctx context.Context, organizationId uuid.UUID, name string) ([]models.Team, error) {
+	ret := _m.Called(ctx, organizationId, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFileUploadByIds")
+		panic("no return value specified for GetTeamByName")
 	}
 
-	var r0 []models.FileUpload
+	var r0 []models.Team
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]models.FileUpload, error)); ok {
-		return rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.Team, error)); ok {
+		return rf(ctx, organizationId, name)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []models.FileUpload); ok {
-		r0 = rf(ctx, ids)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.Team); ok {
+		r0 = rf(ctx, organizationId, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.FileUpload)
+			r0 = ret.Get(0).([]models.Team)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = rf(ctx, ids)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, organizationId, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2655,58 +8820,59 @@ func (_m *MockStore) GetFileUploadByIds(ctx context.Context, ids []uuid.UUID) ([
 	return r0, r1
 }
 
-// MockStore_GetFileUploadByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFileUploadByIds'
-type MockStore_GetFileUploadByIds_Call struct {
+// MockStore_GetTeamByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamByName'
+type MockStore_GetTeamByName_Call struct {
 	*mock.Call
 }
 
-// GetFileUploadByIds is a helper method to define mock.On call
+// GetTeamByName is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ids []uuid.UUID
-func (_e *MockStore_Expecter) GetFileUploadByIds(ctx interface{}, ids interface{}) *MockStore_GetFileUploadByIds_Call {
-	return &MockStore_GetFileUploadByIds_Call{Call: _e.mock.On("GetFileUploadByIds", ctx, ids)}
+//   - organizationId uuid.UUID
+//   - name string
+func (_e *MockStore_Expecter) GetTeamByName(ctx interface{}, organizationId interface{}, name interface{}) *MockStore_GetTeamByName_Call {
+	return &MockStore_GetTeamByName_Call{Call: _e.mock.On("GetTeamByName", ctx, organizationId, name)}
 }
 
-func (_c *MockStore_GetFileUploadByIds_Call) Run(run func(ctx context.Context, ids []uuid.UUID)) *MockStore_GetFileUploadByIds_Call {
+func (_c *MockStore_GetTeamByName_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, name string)) *MockStore_GetTeamByName_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetFileUploadByIds_Call) Return(_a0 []models.FileUpload, _a1 error) *MockStore_GetFileUploadByIds_Call {
+func (_c *MockStore_GetTeamByName_Call) Return(_a0 []models.Team, _a1 error) *MockStore_GetTeamByName_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetFileUploadByIds_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]models.FileUpload, error)) *MockStore_GetFileUploadByIds_Call {
+func (_c *MockStore_GetTeamByName_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.Team, error)) *MockStore_GetTeamByName_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlattenedResourceAudiencePolicies provides a mock function with given fields: ctx, filters
-func (_m *MockStore) GetFlattenedResourceAudiencePolicies(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, filters)
+// GetTeamMembershipById provides a mock function with given fields: ctx, teamMembershipId
+func (_m *MockStore) GetTeamMembershipById(ctx context.Context, teamMembershipId uuid.UUID) (*models.TeamMembership, error) {
+	ret := _m.Called(ctx, teamMembershipId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFlattenedResourceAudiencePolicies")
+		panic("no return value specified for GetTeamMembershipById")
 	}
 
-	var r0 []models.FlattenedResourceAudiencePolicy
+	var r0 *models.TeamMembership
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.TeamMembership, error)); ok {
+		return rf(ctx, teamMembershipId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) []models.FlattenedResourceAudiencePolicy); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.TeamMembership); ok {
+		r0 = rf(ctx, teamMembershipId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.FlattenedResourceAudiencePolicy)
+			r0 = ret.Get(0).(*models.TeamMembership)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) error); ok {
-		r1 = rf(ctx, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamMembershipId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2714,58 +8880,58 @@ func (_m *MockStore) GetFlattenedResourceAudiencePolicies(ctx context.Context, f
 	return r0, r1
 }
 
-// MockStore_GetFlattenedResourceAudiencePolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlattenedResourceAudiencePolicies'
-type MockStore_GetFlattenedResourceAudiencePolicies_Call struct {
+// MockStore_GetTeamMembershipById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMembershipById'
+type MockStore_GetTeamMembershipById_Call struct {
 	*mock.Call
 }
 
-// GetFlattenedResourceAudiencePolicies is a helper method to define mock.On call
+// GetTeamMembershipById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters models.FlattenedResourceAudiencePoliciesFilters
-func (_e *MockStore_Expecter) GetFlattenedResourceAudiencePolicies(ctx interface{}, filters interface{}) *MockStore_GetFlattenedResourceAudiencePolicies_Call {
-	return &MockStore_GetFlattenedResourceAudiencePolicies_Call{Call: _e.mock.On("GetFlattenedResourceAudiencePolicies", ctx, filters)}
+//   - teamMembershipId uuid.UUID
+func (_e *MockStore_Expecter) GetTeamMembershipById(ctx interface{}, teamMembershipId interface{}) *MockStore_GetTeamMembershipById_Call {
+	return &MockStore_GetTeamMembershipById_Call{Call: _e.mock.On("GetTeamMembershipById", ctx, teamMembershipId)}
 }
 
-func (_c *MockStore_GetFlattenedResourceAudiencePolicies_Call) Run(run func(ctx context.Context, filters models.FlattenedResourceAudiencePoliciesFilters)) *MockStore_GetFlattenedResourceAudiencePolicies_Call {
+func (_c *MockStore_GetTeamMembershipById_Call) Run(run func(ctx context.Context, teamMembershipId uuid.UUID)) *MockStore_GetTeamMembershipById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.FlattenedResourceAudiencePoliciesFilters))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetFlattenedResourceAudiencePolicies_Call) Return(_a0 []models.FlattenedResourceAudiencePolicy, _a1 error) *MockStore_GetFlattenedResourceAudiencePolicies_Call {
+func (_c *MockStore_GetTeamMembershipById_Call) Return(_a0 *models.TeamMembership, _a1 error) *MockStore_GetTeamMembershipById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetFlattenedResourceAudiencePolicies_Call) RunAndReturn(run func(context.Context, models.FlattenedResourceAudiencePoliciesFilters) ([]models.FlattenedResourceAudiencePolicy, error)) *MockStore_GetFlattenedResourceAudiencePolicies_Call {
+func (_c *MockStore_GetTeamMembershipById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.TeamMembership, error)) *MockStore_GetTeamMembershipById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationById provides a mock function with given fields: ctx, organizationId
-func (_m *MockStore) GetOrganizationById(ctx context.Context, organizationId string) (*models.Organization, error) {
-	ret := _m.Called(ctx, organizationId)
+// GetTeamMembershipByUserIdTeamId provides a mock function with given fields: ctx, userId, teamId
+func (_m *MockStore) GetTeamMembershipByUserIdTeamId(ctx context.Context, userId uuid.UUID, teamId uuid.UUID) (*models.TeamMembership, error) {
+	ret := _m.Called(ctx, userId, teamId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationById")
+		panic("no return value specified for GetTeamMembershipByUserIdTeamId")
 	}
 
-	var r0 *models.Organization
+	var r0 *models.TeamMembership
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Organization, error)); ok {
-		return rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)); ok {
+		return rf(ctx, userId, teamId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Organization); ok {
-		r0 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.TeamMembership); ok {
+		r0 = rf(ctx, userId, teamId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Organization)
+			r0 = ret.Get(0).(*models.TeamMembership)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, teamId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2773,58 +8939,59 @@ func (_m *MockStore) GetOrganizationById(ctx context.Context, organizationId str
 	return r0, r1
 }
 
-// MockStore_GetOrganizationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationById'
-type MockStore_GetOrganizationById_Call struct {
+// MockStore_GetTeamMembershipByUserIdTeamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMembershipByUserIdTeamId'
+type MockStore_GetTeamMembershipByUserIdTeamId_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationById is a helper method to define mock.On call
+// GetTeamMembershipByUserIdTeamId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId string
-func (_e *MockStore_Expecter) GetOrganizationById(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationById_Call {
-	return &MockStore_GetOrganizationById_Call{Call: _e.mock.On("GetOrganizationById", ctx, organizationId)}
+//   - userId uuid.UUID
+//   - teamId uuid.UUID
+func (_e *MockStore_Expecter) GetTeamMembershipByUserIdTeamId(ctx interface{}, userId interface{}, teamId interface{}) *MockStore_GetTeamMembershipByUserIdTeamId_Call {
+	return &MockStore_GetTeamMembershipByUserIdTeamId_Call{Call: _e.mock.On("GetTeamMembershipByUserIdTeamId", ctx, userId, teamId)}
 }
 
-func (_c *MockStore_GetOrganizationById_Call) Run(run func(ctx context.Context, organizationId string)) *MockStore_GetOrganizationById_Call {
+func (_c *MockStore_GetTeamMembershipByUserIdTeamId_Call) Run(run func(ctx context.Context, userId uuid.UUID, teamId uuid.UUID)) *MockStore_GetTeamMembershipByUserIdTeamId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationById_Call) Return(_a0 *models.Organization, _a1 error) *MockStore_GetOrganizationById_Call {
+func (_c *MockStore_GetTeamMembershipByUserIdTeamId_Call) Return(_a0 *models.TeamMembership, _a1 error) *MockStore_GetTeamMembershipByUserIdTeamId_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationById_Call) RunAndReturn(run func(context.Context, string) (*models.Organization, error)) *MockStore_GetOrganizationById_Call {
+func (_c *MockStore_GetTeamMembershipByUserIdTeamId_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)) *MockStore_GetTeamMembershipByUserIdTeamId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationInvitationById provides a mock function with given fields: ctx, invitationId
-func (_m *MockStore) GetOrganizationInvitationById(ctx context.Context, invitationId uuid.UUID) (*models.OrganizationInvitation, error) {
-	ret := _m.Called(ctx, invitationId)
+// GetTeamMemberships provides a mock function with given fields: ctx, teamId
+func (_m *MockStore) GetTeamMemberships(ctx context.Context, teamId uuid.UUID) ([]models.TeamMembership, error) {
+	ret := _m.Called(ctx, teamId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationInvitationById")
+		panic("no return value specified for GetTeamMemberships")
 	}
 
-	var r0 *models.OrganizationInvitation
+	var r0 []models.TeamMembership
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)); ok {
-		return rf(ctx, invitationId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.TeamMembership, error)); ok {
+		return rf(ctx, teamId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.OrganizationInvitation); ok {
-		r0 = rf(ctx, invitationId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.TeamMembership); ok {
+		r0 = rf(ctx, teamId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.OrganizationInvitation)
+			r0 = ret.Get(0).([]models.TeamMembership)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, invitationId)
+		r1 = rf(ctx, teamId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2832,58 +8999,58 @@ func (_m *MockStore) GetOrganizationInvitationById(ctx context.Context, invitati
 	return r0, r1
 }
 
-// MockStore_GetOrganizationInvitationById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationById'
-type MockStore_GetOrganizationInvitationById_Call struct {
+// MockStore_GetTeamMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMemberships'
+type MockStore_GetTeamMemberships_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationInvitationById is a helper method to define mock.On call
+// GetTeamMemberships is a helper method to define mock.On call
 //   - ctx context.Context
-//   - invitationId uuid.UUID
-func (_e *MockStore_Expecter) GetOrganizationInvitationById(ctx interface{}, invitationId interface{}) *MockStore_GetOrganizationInvitationById_Call {
-	return &MockStore_GetOrganizationInvitationById_Call{Call: _e.mock.On("GetOrganizationInvitationById", ctx, invitationId)}
+//   - teamId uuid.UUID
+func (_e *MockStore_Expecter) GetTeamMemberships(ctx interface{}, teamId interface{}) *MockStore_GetTeamMemberships_Call {
+	return &MockStore_GetTeamMemberships_Call{Call: _e.mock.On("GetTeamMemberships", ctx, teamId)}
 }
 
-func (_c *MockStore_GetOrganizationInvitationById_Call) Run(run func(ctx context.Context, invitationId uuid.UUID)) *MockStore_GetOrganizationInvitationById_Call {
+func (_c *MockStore_GetTeamMemberships_Call) Run(run func(ctx context.Context, teamId uuid.UUID)) *MockStore_GetTeamMemberships_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationInvitationById_Call) Return(_a0 *models.OrganizationInvitation, _a1 error) *MockStore_GetOrganizationInvitationById_Call {
+func (_c *MockStore_GetTeamMemberships_Call) Return(_a0 []models.TeamMembership, _a1 error) *MockStore_GetTeamMemberships_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationInvitationById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.OrganizationInvitation, error)) *MockStore_GetOrganizationInvitationById_Call {
+func (_c *MockStore_GetTeamMemberships_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.TeamMembership, error)) *MockStore_GetTeamMemberships_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationInvitationsAll provides a mock function with given fields: ctx
-func (_m *MockStore) GetOrganizationInvitationsAll(ctx context.Context) ([]models.OrganizationInvitation, error) {
-	ret := _m.Called(ctx)
+// GetTeams provides a mock function with given fields: ctx, organizationId
+func (_m *MockStore) GetTeams(ctx context.Context, organizationId uuid.UUID) ([]models.Team, error) {
+	ret := _m.Called(ctx, organizationId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationInvitationsAll")
+		panic("no return value specified for GetTeams")
 	}
 
-	var r0 []models.OrganizationInvitation
+	var r0 []models.Team
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationInvitation, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Team, error)); ok {
+		return rf(ctx, organizationId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationInvitation); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Team); ok {
+		r0 = rf(ctx, organizationId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.OrganizationInvitation)
+			r0 = ret.Get(0).([]models.Team)
 		}
 	}
-
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, organizationId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2891,57 +9058,58 @@ func (_m *MockStore) GetOrganizationInvitationsAll(ctx context.Context) ([]model
 	return r0, r1
 }
 
-// MockStore_GetOrganizationInvitationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAll'
-type MockStore_GetOrganizationInvitationsAll_Call struct {
+// MockStore_GetTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeams'
+type MockStore_GetTeams_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationInvitationsAll is a helper method to define mock.On call
+// GetTeams is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetOrganizationInvitationsAll(ctx interface{}) *MockStore_GetOrganizationInvitationsAll_Call {
-	return &MockStore_GetOrganizationInvitationsAll_Call{Call: _e.mock.On("GetOrganizationInvitationsAll", ctx)}
+//   - organizationId uuid.UUID
+func (_e *MockStore_Expecter) GetTeams(ctx interface{}, organizationId interface{}) *MockStore_GetTeams_Call {
+	return &MockStore_GetTeams_Call{Call: _e.mock.On("GetTeams", ctx, organizationId)}
 }
 
-func (_c *MockStore_GetOrganizationInvitationsAll_Call) Run(run func(ctx context.Context)) *MockStore_GetOrganizationInvitationsAll_Call {
+func (_c *MockStore_GetTeams_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetTeams_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationInvitationsAll_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockStore_GetOrganizationInvitationsAll_Call {
+func (_c *MockStore_GetTeams_Call) Return(_a0 []models.Team, _a1 error) *MockStore_GetTeams_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationInvitationsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationInvitation, error)) *MockStore_GetOrganizationInvitationsAll_Call {
+func (_c *MockStore_GetTeams_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Team, error)) *MockStore_GetTeams_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationInvitationsAndMembershipRequests provides a mock function with given fields: ctx, organizationId
-func (_m *MockStore) GetOrganizationInvitationsAndMembershipRequests(ctx context.Context, organizationId uuid.UUID) (*models.Organization, error) {
-	ret := _m.Called(ctx, organizationId)
+// GetUserById provides a mock function with given fields: ctx, userId
+func (_m *MockStore) GetUserById(ctx context.Context, userId string) (*models.User, error) {
+	ret := _m.Called(ctx, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationInvitationsAndMembershipRequests")
+		panic("no return value specified for GetUserById")
 	}
 
-	var r0 *models.Organization
+	var r0 *models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Organization, error)); ok {
-		return rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
+		return rf(ctx, userId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Organization); ok {
-		r0 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
+		r0 = rf(ctx, userId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Organization)
+			r0 = ret.Get(0).(*models.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2949,58 +9117,58 @@ func (_m *MockStore) GetOrganizationInvitationsAndMembershipRequests(ctx context
 	return r0, r1
 }
 
-// MockStore_GetOrganizationInvitationsAndMembershipRequests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsAndMembershipRequests'
-type MockStore_GetOrganizationInvitationsAndMembershipRequests_Call struct {
+// MockStore_GetUserById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserById'
+type MockStore_GetUserById_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationInvitationsAndMembershipRequests is a helper method to define mock.On call
+// GetUserById is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-func (_e *MockStore_Expecter) GetOrganizationInvitationsAndMembershipRequests(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call {
-	return &MockStore_GetOrganizationInvitationsAndMembershipRequests_Call{Call: _e.mock.On("GetOrganizationInvitationsAndMembershipRequests", ctx, organizationId)}
+//   - userId string
+func (_e *MockStore_Expecter) GetUserById(ctx interface{}, userId interface{}) *MockStore_GetUserById_Call {
+	return &MockStore_GetUserById_Call{Call: _e.mock.On("GetUserById", ctx, userId)}
 }
 
-func (_c *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+func (_c *MockStore_GetUserById_Call) Run(run func(ctx context.Context, userId string)) *MockStore_GetUserById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call) Return(_a0 *models.Organization, _a1 error) *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+func (_c *MockStore_GetUserById_Call) Return(_a0 *models.User, _a1 error) *MockStore_GetUserById_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Organization, error)) *MockStore_GetOrganizationInvitationsAndMembershipRequests_Call {
+func (_c *MockStore_GetUserById_Call) RunAndReturn(run func(context.Context, string) (*models.User, error)) *MockStore_GetUserById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationInvitationsByOrganizationId provides a mock function with given fields: ctx, organizationId
-func (_m *MockStore) GetOrganizationInvitationsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationInvitation, error) {
-	ret := _m.Called(ctx, organizationId)
+// GetUsersAll provides a mock function with given fields: ctx
+func (_m *MockStore) GetUsersAll(ctx context.Context) ([]models.User, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationInvitationsByOrganizationId")
+		panic("no return value specified for GetUsersAll")
 	}
 
-	var r0 []models.OrganizationInvitation
+	var r0 []models.User
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)); ok {
-		return rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.User, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationInvitation); ok {
-		r0 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context) []models.User); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.OrganizationInvitation)
+			r0 = ret.Get(0).([]models.User)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3008,58 +9176,55 @@ func (_m *MockStore) GetOrganizationInvitationsByOrganizationId(ctx context.Cont
 	return r0, r1
 }
 
-// MockStore_GetOrganizationInvitationsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationInvitationsByOrganizationId'
-type MockStore_GetOrganizationInvitationsByOrganizationId_Call struct {
+// MockStore_GetUsersAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersAll'
+type MockStore_GetUsersAll_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationInvitationsByOrganizationId is a helper method to define mock.On call
+// GetUsersAll is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-func (_e *MockStore_Expecter) GetOrganizationInvitationsByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationInvitationsByOrganizationId_Call {
-	return &MockStore_GetOrganizationInvitationsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationInvitationsByOrganizationId", ctx, organizationId)}
+func (_e *MockStore_Expecter) GetUsersAll(ctx interface{}) *MockStore_GetUsersAll_Call {
+	return &MockStore_GetUsersAll_Call{Call: _e.mock.On("GetUsersAll", ctx)}
 }
 
-func (_c *MockStore_GetOrganizationInvitationsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetOrganizationInvitationsByOrganizationId_Call {
+func (_c *MockStore_GetUsersAll_Call) Run(run func(ctx context.Context)) *MockStore_GetUsersAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationInvitationsByOrganizationId_Call) Return(_a0 []models.OrganizationInvitation, _a1 error) *MockStore_GetOrganizationInvitationsByOrganizationId_Call {
+func (_c *MockStore_GetUsersAll_Call) Return(_a0 []models.User, _a1 error) *MockStore_GetUsersAll_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationInvitationsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationInvitation, error)) *MockStore_GetOrganizationInvitationsByOrganizationId_Call {
+func (_c *MockStore_GetUsersAll_Call) RunAndReturn(run func(context.Context) ([]models.User, error)) *MockStore_GetUsersAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationMembershipRequestsAll provides a mock function with given fields: ctx
-func (_m *MockStore) GetOrganizationMembershipRequestsAll(ctx context.Context) ([]models.OrganizationMembershipRequest, error) {
-	ret := _m.Called(ctx)
+// GetWidgetInstanceByID provides a mock function with given fields: ctx, widgetInstanceID
+func (_m *MockStore) GetWidgetInstanceByID(ctx context.Context, widgetInstanceID uuid.UUID) (models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstanceID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationMembershipRequestsAll")
+		panic("no return value specified for GetWidgetInstanceByID")
 	}
 
-	var r0 []models.OrganizationMembershipRequest
+	var r0 models.WidgetInstance
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]models.OrganizationMembershipRequest, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstanceID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []models.OrganizationMembershipRequest); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstanceID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
-		}
+		r0 = ret.Get(0).(models.WidgetInstance)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, widgetInstanceID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3067,57 +9232,56 @@ func (_m *MockStore) GetOrganizationMembershipRequestsAll(ctx context.Context) (
 	return r0, r1
 }
 
-// MockStore_GetOrganizationMembershipRequestsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsAll'
-type MockStore_GetOrganizationMembershipRequestsAll_Call struct {
+// MockStore_GetWidgetInstanceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetInstanceByID'
+type MockStore_GetWidgetInstanceByID_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationMembershipRequestsAll is a helper method to define mock.On call
+// GetWidgetInstanceByID is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetOrganizationMembershipRequestsAll(ctx interface{}) *MockStore_GetOrganizationMembershipRequestsAll_Call {
-	return &MockStore_GetOrganizationMembershipRequestsAll_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsAll", ctx)}
+//   - widgetInstanceID uuid.UUID
+func (_e *MockStore_Expecter) GetWidgetInstanceByID(ctx interface{}, widgetInstanceID interface{}) *MockStore_GetWidgetInstanceByID_Call {
+	return &MockStore_GetWidgetInstanceByID_Call{Call: _e.mock.On("GetWidgetInstanceByID", ctx, widgetInstanceID)}
 }
 
-func (_c *MockStore_GetOrganizationMembershipRequestsAll_Call) Run(run func(ctx context.Context)) *MockStore_GetOrganizationMembershipRequestsAll_Call {
+func (_c *MockStore_GetWidgetInstanceByID_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockStore_GetWidgetInstanceByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationMembershipRequestsAll_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockStore_GetOrganizationMembershipRequestsAll_Call {
+func (_c *MockStore_GetWidgetInstanceByID_Call) Return(_a0 models.WidgetInstance, _a1 error) *MockStore_GetWidgetInstanceByID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationMembershipRequestsAll_Call) RunAndReturn(run func(context.Context) ([]models.OrganizationMembershipRequest, error)) *MockStore_GetOrganizationMembershipRequestsAll_Call {
+func (_c *MockStore_GetWidgetInstanceByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.WidgetInstance, error)) *MockStore_GetWidgetInstanceByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationMembershipRequestsByOrganizationId provides a mock function with given fields: ctx, organizationId
-func (_m *MockStore) GetOrganizationMembershipRequestsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationMembershipRequest, error) {
-	ret := _m.Called(ctx, organizationId)
+// GetWidgetTemplate provides a mock function with given fields: ctx, widgetType
+func (_m *MockStore) GetWidgetTemplate(ctx context.Context, widgetType string) (models.Widget, error) {
+	ret := _m.Called(ctx, widgetType)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationMembershipRequestsByOrganizationId")
+		panic("no return value specified for GetWidgetTemplate")
 	}
 
-	var r0 []models.OrganizationMembershipRequest
+	var r0 models.Widget
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)); ok {
-		return rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Widget, error)); ok {
+		return rf(ctx, widgetType)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationMembershipRequest); ok {
-		r0 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Widget); ok {
+		r0 = rf(ctx, widgetType)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.OrganizationMembershipRequest)
-		}
+		r0 = ret.Get(0).(models.Widget)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, widgetType)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3125,58 +9289,56 @@ func (_m *MockStore) GetOrganizationMembershipRequestsByOrganizationId(ctx conte
 	return r0, r1
 }
 
-// MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationMembershipRequestsByOrganizationId'
-type MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call struct {
+// MockStore_GetWidgetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetTemplate'
+type MockStore_GetWidgetTemplate_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationMembershipRequestsByOrganizationId is a helper method to define mock.On call
+// GetWidgetTemplate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-func (_e *MockStore_Expecter) GetOrganizationMembershipRequestsByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
-	return &MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationMembershipRequestsByOrganizationId", ctx, organizationId)}
+//   - widgetType string
+func (_e *MockStore_Expecter) GetWidgetTemplate(ctx interface{}, widgetType interface{}) *MockStore_GetWidgetTemplate_Call {
+	return &MockStore_GetWidgetTemplate_Call{Call: _e.mock.On("GetWidgetTemplate", ctx, widgetType)}
 }
 
-func (_c *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+func (_c *MockStore_GetWidgetTemplate_Call) Run(run func(ctx context.Context, widgetType string)) *MockStore_GetWidgetTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call) Return(_a0 []models.OrganizationMembershipRequest, _a1 error) *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+func (_c *MockStore_GetWidgetTemplate_Call) Return(_a0 models.Widget, _a1 error) *MockStore_GetWidgetTemplate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationMembershipRequest, error)) *MockStore_GetOrganizationMembershipRequestsByOrganizationId_Call {
+func (_c *MockStore_GetWidgetTemplate_Call) RunAndReturn(run func(context.Context, string) (models.Widget, error)) *MockStore_GetWidgetTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationPolicies provides a mock function with given fields: ctx, orgId
-func (_m *MockStore) GetOrganizationPolicies(ctx context.Context, orgId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, orgId)
+// MarkDatasetAlertEventNotified provides a mock function with given fields: ctx, eventId
+func (_m *MockStore) MarkDatasetAlertEventNotified(ctx context.Context, eventId uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, eventId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationPolicies")
+		panic("no return value specified for MarkDatasetAlertEventNotified")
 	}
 
-	var r0 []models.ResourceAudiencePolicy
+	var r0 bool
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, orgId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, eventId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, orgId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, eventId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, orgId)
+		r1 = rf(ctx, eventId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3184,237 +9346,254 @@ func (_m *MockStore) GetOrganizationPolicies(ctx context.Context, orgId uuid.UUI
 	return r0, r1
 }
 
-// MockStore_GetOrganizationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicies'
-type MockStore_GetOrganizationPolicies_Call struct {
+// MockStore_MarkDatasetAlertEventNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDatasetAlertEventNotified'
+type MockStore_MarkDatasetAlertEventNotified_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationPolicies is a helper method to define mock.On call
+// MarkDatasetAlertEventNotified is a helper method to define mock.On call
 //   - ctx context.Context
-//   - orgId uuid.UUID
-func (_e *MockStore_Expecter) GetOrganizationPolicies(ctx interface{}, orgId interface{}) *MockStore_GetOrganizationPolicies_Call {
-	return &MockStore_GetOrganizationPolicies_Call{Call: _e.mock.On("GetOrganizationPolicies", ctx, orgId)}
+//   - eventId uuid.UUID
+func (_e *MockStore_Expecter) MarkDatasetAlertEventNotified(ctx interface{}, eventId interface{}) *MockStore_MarkDatasetAlertEventNotified_Call {
+	return &MockStore_MarkDatasetAlertEventNotified_Call{Call: _e.mock.On("MarkDatasetAlertEventNotified", ctx, eventId)}
 }
 
-func (_c *MockStore_GetOrganizationPolicies_Call) Run(run func(ctx context.Context, orgId uuid.UUID)) *MockStore_GetOrganizationPolicies_Call {
+func (_c *MockStore_MarkDatasetAlertEventNotified_Call) Run(run func(ctx context.Context, eventId uuid.UUID)) *MockStore_MarkDatasetAlertEventNotified_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetOrganizationPolicies_Call {
+func (_c *MockStore_MarkDatasetAlertEventNotified_Call) Return(_a0 bool, _a1 error) *MockStore_MarkDatasetAlertEventNotified_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetOrganizationPolicies_Call {
+func (_c *MockStore_MarkDatasetAlertEventNotified_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockStore_MarkDatasetAlertEventNotified_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationPoliciesByEmail provides a mock function with given fields: ctx, organizationId, email
-func (_m *MockStore) GetOrganizationPoliciesByEmail(ctx context.Context, organizationId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, organizationId, email)
+// MarkFxRateSourceImported provides a mock function with given fields: ctx, sourceId, importedAt
+func (_m *MockStore) MarkFxRateSourceImported(ctx context.Context, sourceId uuid.UUID, importedAt time.Time) error {
+	ret := _m.Called(ctx, sourceId, importedAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationPoliciesByEmail")
+		panic("no return value specified for MarkFxRateSourceImported")
 	}
 
-	var r0 []models.ResourceAudiencePolicy
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, organizationId, email)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, organizationId, email)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, sourceId, importedAt)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_MarkFxRateSourceImported_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkFxRateSourceImported'
+type MockStore_MarkFxRateSourceImported_Call struct {
+	*mock.Call
+}
+
+// MarkFxRateSourceImported is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceId uuid.UUID
+//   - importedAt time.Time
+func (_e *MockStore_Expecter) MarkFxRateSourceImported(ctx interface{}, sourceId interface{}, importedAt interface{}) *MockStore_MarkFxRateSourceImported_Call {
+	return &MockStore_MarkFxRateSourceImported_Call{Call: _e.mock.On("MarkFxRateSourceImported", ctx, sourceId, importedAt)}
+}
+
+func (_c *MockStore_MarkFxRateSourceImported_Call) Run(run func(ctx context.Context, sourceId uuid.UUID, importedAt time.Time)) *MockStore_MarkFxRateSourceImported_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockStore_MarkFxRateSourceImported_Call) Return(_a0 error) *MockStore_MarkFxRateSourceImported_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_MarkFxRateSourceImported_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *MockStore_MarkFxRateSourceImported_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeTags provides a mock function with given fields: ctx, sourceTagId, targetTagId, targetAliases, updatedBy
+func (_m *MockStore) MergeTags(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, sourceTagId, targetTagId, targetAliases, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTags")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, organizationId, email)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []string, uuid.UUID) error); ok {
+		r0 = rf(ctx, sourceTagId, targetTagId, targetAliases, updatedBy)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetOrganizationPoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPoliciesByEmail'
-type MockStore_GetOrganizationPoliciesByEmail_Call struct {
+// MockStore_MergeTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTags'
+type MockStore_MergeTags_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationPoliciesByEmail is a helper method to define mock.On call
+// MergeTags is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - email string
-func (_e *MockStore_Expecter) GetOrganizationPoliciesByEmail(ctx interface{}, organizationId interface{}, email interface{}) *MockStore_GetOrganizationPoliciesByEmail_Call {
-	return &MockStore_GetOrganizationPoliciesByEmail_Call{Call: _e.mock.On("GetOrganizationPoliciesByEmail", ctx, organizationId, email)}
+//   - sourceTagId uuid.UUID
+//   - targetTagId uuid.UUID
+//   - targetAliases []string
+//   - updatedBy uuid.UUID
+func (_e *MockStore_Expecter) MergeTags(ctx interface{}, sourceTagId interface{}, targetTagId interface{}, targetAliases interface{}, updatedBy interface{}) *MockStore_MergeTags_Call {
+	return &MockStore_MergeTags_Call{Call: _e.mock.On("MergeTags", ctx, sourceTagId, targetTagId, targetAliases, updatedBy)}
 }
 
-func (_c *MockStore_GetOrganizationPoliciesByEmail_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, email string)) *MockStore_GetOrganizationPoliciesByEmail_Call {
+func (_c *MockStore_MergeTags_Call) Run(run func(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID)) *MockStore_MergeTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]string), args[4].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationPoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetOrganizationPoliciesByEmail_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_MergeTags_Call) Return(_a0 error) *MockStore_MergeTags_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationPoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetOrganizationPoliciesByEmail_Call {
+func (_c *MockStore_MergeTags_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []string, uuid.UUID) error) *MockStore_MergeTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationPolicyByUser provides a mock function with given fields: ctx, organizationId, userId
-func (_m *MockStore) GetOrganizationPolicyByUser(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, organizationId, userId)
+// RenameTag provides a mock function with given fields: ctx, tagId, name, path, aliases, updatedBy
+func (_m *MockStore) RenameTag(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, tagId, name, path, aliases, updatedBy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationPolicyByUser")
+		panic("no return value specified for RenameTag")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, organizationId, userId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, organizationId, userId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r1 = rf(ctx, organizationId, userId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, []string, uuid.UUID) error); ok {
+		r0 = rf(ctx, tagId, name, path, aliases, updatedBy)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetOrganizationPolicyByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationPolicyByUser'
-type MockStore_GetOrganizationPolicyByUser_Call struct {
+// MockStore_RenameTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTag'
+type MockStore_RenameTag_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationPolicyByUser is a helper method to define mock.On call
+// RenameTag is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - userId uuid.UUID
-func (_e *MockStore_Expecter) GetOrganizationPolicyByUser(ctx interface{}, organizationId interface{}, userId interface{}) *MockStore_GetOrganizationPolicyByUser_Call {
-	return &MockStore_GetOrganizationPolicyByUser_Call{Call: _e.mock.On("GetOrganizationPolicyByUser", ctx, organizationId, userId)}
+//   - tagId uuid.UUID
+//   - name string
+//   - path string
+//   - aliases []string
+//   - updatedBy uuid.UUID
+func (_e *MockStore_Expecter) RenameTag(ctx interface{}, tagId interface{}, name interface{}, path interface{}, aliases interface{}, updatedBy interface{}) *MockStore_RenameTag_Call {
+	return &MockStore_RenameTag_Call{Call: _e.mock.On("RenameTag", ctx, tagId, name, path, aliases, updatedBy)}
 }
 
-func (_c *MockStore_GetOrganizationPolicyByUser_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID)) *MockStore_GetOrganizationPolicyByUser_Call {
+func (_c *MockStore_RenameTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID)) *MockStore_RenameTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].([]string), args[5].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationPolicyByUser_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_GetOrganizationPolicyByUser_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_RenameTag_Call) Return(_a0 error) *MockStore_RenameTag_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationPolicyByUser_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.ResourceAudiencePolicy, error)) *MockStore_GetOrganizationPolicyByUser_Call {
+func (_c *MockStore_RenameTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, []string, uuid.UUID) error) *MockStore_RenameTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationSSOConfigsByOrganizationId provides a mock function with given fields: ctx, organizationId
-func (_m *MockStore) GetOrganizationSSOConfigsByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.OrganizationSSOConfig, error) {
-	ret := _m.Called(ctx, organizationId)
+// ReopenReconciliationExceptions provides a mock function with given fields: ctx, runId, side, rows
+func (_m *MockStore) ReopenReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow) error {
+	ret := _m.Called(ctx, runId, side, rows)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationSSOConfigsByOrganizationId")
-	}
-
-	var r0 []models.OrganizationSSOConfig
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)); ok {
-		return rf(ctx, organizationId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.OrganizationSSOConfig); ok {
-		r0 = rf(ctx, organizationId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.OrganizationSSOConfig)
-		}
+		panic("no return value specified for ReopenReconciliationExceptions")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, organizationId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []models.ReconciliationRow) error); ok {
+		r0 = rf(ctx, runId, side, rows)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetOrganizationSSOConfigsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationSSOConfigsByOrganizationId'
-type MockStore_GetOrganizationSSOConfigsByOrganizationId_Call struct {
+// MockStore_ReopenReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenReconciliationExceptions'
+type MockStore_ReopenReconciliationExceptions_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationSSOConfigsByOrganizationId is a helper method to define mock.On call
+// ReopenReconciliationExceptions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-func (_e *MockStore_Expecter) GetOrganizationSSOConfigsByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call {
-	return &MockStore_GetOrganizationSSOConfigsByOrganizationId_Call{Call: _e.mock.On("GetOrganizationSSOConfigsByOrganizationId", ctx, organizationId)}
+//   - runId uuid.UUID
+//   - side string
+//   - rows []models.ReconciliationRow
+func (_e *MockStore_Expecter) ReopenReconciliationExceptions(ctx interface{}, runId interface{}, side interface{}, rows interface{}) *MockStore_ReopenReconciliationExceptions_Call {
+	return &MockStore_ReopenReconciliationExceptions_Call{Call: _e.mock.On("ReopenReconciliationExceptions", ctx, runId, side, rows)}
 }
 
-func (_c *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+func (_c *MockStore_ReopenReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, side string, rows []models.ReconciliationRow)) *MockStore_ReopenReconciliationExceptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]models.ReconciliationRow))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call) Return(_a0 []models.OrganizationSSOConfig, _a1 error) *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_ReopenReconciliationExceptions_Call) Return(_a0 error) *MockStore_ReopenReconciliationExceptions_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.OrganizationSSOConfig, error)) *MockStore_GetOrganizationSSOConfigsByOrganizationId_Call {
+func (_c *MockStore_ReopenReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []models.ReconciliationRow) error) *MockStore_ReopenReconciliationExceptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationsAll provides a mock function with given fields: ctx
-func (_m *MockStore) GetOrganizationsAll(ctx context.Context) ([]models.Organization, error) {
-	ret := _m.Called(ctx)
+// ResolveReconciliationExceptions provides a mock function with given fields: ctx, runId, side, rowIds, matchId
+func (_m *MockStore) ResolveReconciliationExceptions(ctx context.Context, runId uuid.UUID, side string, rowIds []string, matchId uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, runId, side, rowIds, matchId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationsAll")
+		panic("no return value specified for ResolveReconciliationExceptions")
 	}
 
-	var r0 []models.Organization
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Organization, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, runId, side, rowIds, matchId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []models.Organization); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, uuid.UUID) int64); ok {
+		r0 = rf(ctx, runId, side, rowIds, matchId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Organization)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []string, uuid.UUID) error); ok {
+		r1 = rf(ctx, runId, side, rowIds, matchId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3422,57 +9601,59 @@ func (_m *MockStore) GetOrganizationsAll(ctx context.Context) ([]models.Organiza
 	return r0, r1
 }
 
-// MockStore_GetOrganizationsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsAll'
-type MockStore_GetOrganizationsAll_Call struct {
+// MockStore_ResolveReconciliationExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveReconciliationExceptions'
+type MockStore_ResolveReconciliationExceptions_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationsAll is a helper method to define mock.On call
+// ResolveReconciliationExceptions is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetOrganizationsAll(ctx interface{}) *MockStore_GetOrganizationsAll_Call {
-	return &MockStore_GetOrganizationsAll_Call{Call: _e.mock.On("GetOrganizationsAll", ctx)}
+//   - runId uuid.UUID
+//   - side string
+//   - rowIds []string
+//   - matchId uuid.UUID
+func (_e *MockStore_Expecter) ResolveReconciliationExceptions(ctx interface{}, runId interface{}, side interface{}, rowIds interface{}, matchId interface{}) *MockStore_ResolveReconciliationExceptions_Call {
+	return &MockStore_ResolveReconciliationExceptions_Call{Call: _e.mock.On("ResolveReconciliationExceptions", ctx, runId, side, rowIds, matchId)}
 }
 
-func (_c *MockStore_GetOrganizationsAll_Call) Run(run func(ctx context.Context)) *MockStore_GetOrganizationsAll_Call {
+func (_c *MockStore_ResolveReconciliationExceptions_Call) Run(run func(ctx context.Context, runId uuid.UUID, side string, rowIds []string, matchId uuid.UUID)) *MockStore_ResolveReconciliationExceptions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].([]string), args[4].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationsAll_Call) Return(_a0 []models.Organization, _a1 error) *MockStore_GetOrganizationsAll_Call {
+func (_c *MockStore_ResolveReconciliationExceptions_Call) Return(_a0 int64, _a1 error) *MockStore_ResolveReconciliationExceptions_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationsAll_Call) RunAndReturn(run func(context.Context) ([]models.Organization, error)) *MockStore_GetOrganizationsAll_Call {
+func (_c *MockStore_ResolveReconciliationExceptions_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, []string, uuid.UUID) (int64, error)) *MockStore_ResolveReconciliationExceptions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrganizationsByMemberId provides a mock function with given fields: ctx, memberId
-func (_m *MockStore) GetOrganizationsByMemberId(ctx context.Context, memberId uuid.UUID) ([]models.Organization, error) {
-	ret := _m.Called(ctx, memberId)
+// SetDefaultDatasetView provides a mock function with given fields: ctx, userId, datasetId, viewId
+func (_m *MockStore) SetDefaultDatasetView(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID) (models.DatasetViewDefault, error) {
+	ret := _m.Called(ctx, userId, datasetId, viewId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrganizationsByMemberId")
+		panic("no return value specified for SetDefaultDatasetView")
 	}
 
-	var r0 []models.Organization
+	var r0 models.DatasetViewDefault
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Organization, error)); ok {
-		return rf(ctx, memberId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (models.DatasetViewDefault, error)); ok {
+		return rf(ctx, userId, datasetId, viewId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Organization); ok {
-		r0 = rf(ctx, memberId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) models.DatasetViewDefault); ok {
+		r0 = rf(ctx, userId, datasetId, viewId)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Organization)
-		}
+		r0 = ret.Get(0).(models.DatasetViewDefault)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, memberId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userId, datasetId, viewId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3480,117 +9661,109 @@ func (_m *MockStore) GetOrganizationsByMemberId(ctx context.Context, memberId uu
 	return r0, r1
 }
 
-// MockStore_GetOrganizationsByMemberId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrganizationsByMemberId'
-type MockStore_GetOrganizationsByMemberId_Call struct {
+// MockStore_SetDefaultDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDefaultDatasetView'
+type MockStore_SetDefaultDatasetView_Call struct {
 	*mock.Call
 }
 
-// GetOrganizationsByMemberId is a helper method to define mock.On call
+// SetDefaultDatasetView is a helper method to define mock.On call
 //   - ctx context.Context
-//   - memberId uuid.UUID
-func (_e *MockStore_Expecter) GetOrganizationsByMemberId(ctx interface{}, memberId interface{}) *MockStore_GetOrganizationsByMemberId_Call {
-	return &MockStore_GetOrganizationsByMemberId_Call{Call: _e.mock.On("GetOrganizationsByMemberId", ctx, memberId)}
+//   - userId uuid.UUID
+//   - datasetId uuid.UUID
+//   - viewId uuid.UUID
+func (_e *MockStore_Expecter) SetDefaultDatasetView(ctx interface{}, userId interface{}, datasetId interface{}, viewId interface{}) *MockStore_SetDefaultDatasetView_Call {
+	return &MockStore_SetDefaultDatasetView_Call{Call: _e.mock.On("SetDefaultDatasetView", ctx, userId, datasetId, viewId)}
 }
 
-func (_c *MockStore_GetOrganizationsByMemberId_Call) Run(run func(ctx context.Context, memberId uuid.UUID)) *MockStore_GetOrganizationsByMemberId_Call {
+func (_c *MockStore_SetDefaultDatasetView_Call) Run(run func(ctx context.Context, userId uuid.UUID, datasetId uuid.UUID, viewId uuid.UUID)) *MockStore_SetDefaultDatasetView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationsByMemberId_Call) Return(_a0 []models.Organization, _a1 error) *MockStore_GetOrganizationsByMemberId_Call {
+func (_c *MockStore_SetDefaultDatasetView_Call) Return(_a0 models.DatasetViewDefault, _a1 error) *MockStore_SetDefaultDatasetView_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetOrganizationsByMemberId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Organization, error)) *MockStore_GetOrganizationsByMemberId_Call {
+func (_c *MockStore_SetDefaultDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (models.DatasetViewDefault, error)) *MockStore_SetDefaultDatasetView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPageById provides a mock function with given fields: ctx, pageId
-func (_m *MockStore) GetPageById(ctx context.Context, pageId uuid.UUID) (*models.Page, error) {
-	ret := _m.Called(ctx, pageId)
+// SetRuleEnabled provides a mock function with given fields: ctx, ruleId, isEnabled, updatedBy
+func (_m *MockStore) SetRuleEnabled(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, ruleId, isEnabled, updatedBy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPageById")
-	}
-
-	var r0 *models.Page
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Page, error)); ok {
-		return rf(ctx, pageId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Page); ok {
-		r0 = rf(ctx, pageId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Page)
-		}
+		panic("no return value specified for SetRuleEnabled")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, pageId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, uuid.UUID) error); ok {
+		r0 = rf(ctx, ruleId, isEnabled, updatedBy)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetPageById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPageById'
-type MockStore_GetPageById_Call struct {
+// MockStore_SetRuleEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRuleEnabled'
+type MockStore_SetRuleEnabled_Call struct {
 	*mock.Call
 }
 
-// GetPageById is a helper method to define mock.On call
+// SetRuleEnabled is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pageId uuid.UUID
-func (_e *MockStore_Expecter) GetPageById(ctx interface{}, pageId interface{}) *MockStore_GetPageById_Call {
-	return &MockStore_GetPageById_Call{Call: _e.mock.On("GetPageById", ctx, pageId)}
+//   - ruleId uuid.UUID
+//   - isEnabled bool
+//   - updatedBy uuid.UUID
+func (_e *MockStore_Expecter) SetRuleEnabled(ctx interface{}, ruleId interface{}, isEnabled interface{}, updatedBy interface{}) *MockStore_SetRuleEnabled_Call {
+	return &MockStore_SetRuleEnabled_Call{Call: _e.mock.On("SetRuleEnabled", ctx, ruleId, isEnabled, updatedBy)}
 }
 
-func (_c *MockStore_GetPageById_Call) Run(run func(ctx context.Context, pageId uuid.UUID)) *MockStore_GetPageById_Call {
+func (_c *MockStore_SetRuleEnabled_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, isEnabled bool, updatedBy uuid.UUID)) *MockStore_SetRuleEnabled_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPageById_Call) Return(_a0 *models.Page, _a1 error) *MockStore_GetPageById_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_SetRuleEnabled_Call) Return(_a0 error) *MockStore_SetRuleEnabled_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetPageById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Page, error)) *MockStore_GetPageById_Call {
+func (_c *MockStore_SetRuleEnabled_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, uuid.UUID) error) *MockStore_SetRuleEnabled_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPagePoliciesByEmail provides a mock function with given fields: ctx, pageId, email
-func (_m *MockStore) GetPagePoliciesByEmail(ctx context.Context, pageId uuid.UUID, email string) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, pageId, email)
+// UpdateConnectionPolicy provides a mock function with given fields: ctx, connectionId, audienceId, privilege
+func (_m *MockStore) UpdateConnectionPolicy(ctx context.Context, connectionId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, connectionId, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPagePoliciesByEmail")
+		panic("no return value specified for UpdateConnectionPolicy")
 	}
 
-	var r0 []models.ResourceAudiencePolicy
+	var r0 *models.ResourceAudiencePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, pageId, email)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, connectionId, audienceId, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, pageId, email)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, connectionId, audienceId, privilege)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, pageId, email)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, connectionId, audienceId, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3598,59 +9771,60 @@ func (_m *MockStore) GetPagePoliciesByEmail(ctx context.Context, pageId uuid.UUI
 	return r0, r1
 }
 
-// MockStore_GetPagePoliciesByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPagePoliciesByEmail'
-type MockStore_GetPagePoliciesByEmail_Call struct {
+// MockStore_UpdateConnectionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConnectionPolicy'
+type MockStore_UpdateConnectionPolicy_Call struct {
 	*mock.Call
 }
 
-// GetPagePoliciesByEmail is a helper method to define mock.On call
+// UpdateConnectionPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pageId uuid.UUID
-//   - email string
-func (_e *MockStore_Expecter) GetPagePoliciesByEmail(ctx interface{}, pageId interface{}, email interface{}) *MockStore_GetPagePoliciesByEmail_Call {
-	return &MockStore_GetPagePoliciesByEmail_Call{Call: _e.mock.On("GetPagePoliciesByEmail", ctx, pageId, email)}
+//   - connectionId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockStore_Expecter) UpdateConnectionPolicy(ctx interface{}, connectionId interface{}, audienceId interface{}, privilege interface{}) *MockStore_UpdateConnectionPolicy_Call {
+	return &MockStore_UpdateConnectionPolicy_Call{Call: _e.mock.On("UpdateConnectionPolicy", ctx, connectionId, audienceId, privilege)}
 }
 
-func (_c *MockStore_GetPagePoliciesByEmail_Call) Run(run func(ctx context.Context, pageId uuid.UUID, email string)) *MockStore_GetPagePoliciesByEmail_Call {
+func (_c *MockStore_UpdateConnectionPolicy_Call) Run(run func(ctx context.Context, connectionId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_UpdateConnectionPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPagePoliciesByEmail_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetPagePoliciesByEmail_Call {
+func (_c *MockStore_UpdateConnectionPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_UpdateConnectionPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetPagePoliciesByEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetPagePoliciesByEmail_Call {
+func (_c *MockStore_UpdateConnectionPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_UpdateConnectionPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPagesAll provides a mock function with given fields: ctx, filters
-func (_m *MockStore) GetPagesAll(ctx context.Context, filters models.PageFilters) ([]models.Page, error) {
-	ret := _m.Called(ctx, filters)
+// UpdateDataset provides a mock function with given fields: ctx, dataset
+func (_m *MockStore) UpdateDataset(ctx context.Context, dataset models.Dataset) (uuid.UUID, error) {
+	ret := _m.Called(ctx, dataset)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPagesAll")
+		panic("no return value specified for UpdateDataset")
 	}
 
-	var r0 []models.Page
+	var r0 uuid.UUID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.PageFilters) ([]models.Page, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) (uuid.UUID, error)); ok {
+		return rf(ctx, dataset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.PageFilters) []models.Page); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) uuid.UUID); ok {
+		r0 = rf(ctx, dataset)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Page)
+			r0 = ret.Get(0).(uuid.UUID)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.PageFilters) error); ok {
-		r1 = rf(ctx, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, models.Dataset) error); ok {
+		r1 = rf(ctx, dataset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3658,174 +9832,152 @@ func (_m *MockStore) GetPagesAll(ctx context.Context, filters models.PageFilters
 	return r0, r1
 }
 
-// MockStore_GetPagesAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPagesAll'
-type MockStore_GetPagesAll_Call struct {
+// MockStore_UpdateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataset'
+type MockStore_UpdateDataset_Call struct {
 	*mock.Call
 }
 
-// GetPagesAll is a helper method to define mock.On call
+// UpdateDataset is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters models.PageFilters
-func (_e *MockStore_Expecter) GetPagesAll(ctx interface{}, filters interface{}) *MockStore_GetPagesAll_Call {
-	return &MockStore_GetPagesAll_Call{Call: _e.mock.On("GetPagesAll", ctx, filters)}
+//   - dataset models.Dataset
+func (_e *MockStore_Expecter) UpdateDataset(ctx interface{}, dataset interface{}) *MockStore_UpdateDataset_Call {
+	return &MockStore_UpdateDataset_Call{Call: _e.mock.On("UpdateDataset", ctx, dataset)}
 }
 
-func (_c *MockStore_GetPagesAll_Call) Run(run func(ctx context.Context, filters models.PageFilters)) *MockStore_GetPagesAll_Call {
+func (_c *MockStore_UpdateDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockStore_UpdateDataset_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.PageFilters))
+		run(args[0].(context.Context), args[1].(models.Dataset))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPagesAll_Call) Return(_a0 []models.Page, _a1 error) *MockStore_GetPagesAll_Call {
+func (_c *MockStore_UpdateDataset_Call) Return(_a0 uuid.UUID, _a1 error) *MockStore_UpdateDataset_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetPagesAll_Call) RunAndReturn(run func(context.Context, models.PageFilters) ([]models.Page, error)) *MockStore_GetPagesAll_Call {
+func (_c *MockStore_UpdateDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) (uuid.UUID, error)) *MockStore_UpdateDataset_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPagesByOrganizationId provides a mock function with given fields: ctx, organizationId
-func (_m *MockStore) GetPagesByOrganizationId(ctx context.Context, organizationId uuid.UUID) ([]models.Page, error) {
-	ret := _m.Called(ctx, organizationId)
+// UpdateDatasetActionConfig provides a mock function with given fields: ctx, actionId, config
+func (_m *MockStore) UpdateDatasetActionConfig(ctx context.Context, actionId string, config map[string]interface{}) error {
+	ret := _m.Called(ctx, actionId, config)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPagesByOrganizationId")
-	}
-
-	var r0 []models.Page
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Page, error)); ok {
-		return rf(ctx, organizationId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Page); ok {
-		r0 = rf(ctx, organizationId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Page)
-		}
+		panic("no return value specified for UpdateDatasetActionConfig")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, organizationId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) error); ok {
+		r0 = rf(ctx, actionId, config)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetPagesByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPagesByOrganizationId'
-type MockStore_GetPagesByOrganizationId_Call struct {
+// MockStore_UpdateDatasetActionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionConfig'
+type MockStore_UpdateDatasetActionConfig_Call struct {
 	*mock.Call
 }
 
-// GetPagesByOrganizationId is a helper method to define mock.On call
+// UpdateDatasetActionConfig is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-func (_e *MockStore_Expecter) GetPagesByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetPagesByOrganizationId_Call {
-	return &MockStore_GetPagesByOrganizationId_Call{Call: _e.mock.On("GetPagesByOrganizationId", ctx, organizationId)}
+//   - actionId string
+//   - config map[string]interface{}
+func (_e *MockStore_Expecter) UpdateDatasetActionConfig(ctx interface{}, actionId interface{}, config interface{}) *MockStore_UpdateDatasetActionConfig_Call {
+	return &MockStore_UpdateDatasetActionConfig_Call{Call: _e.mock.On("UpdateDatasetActionConfig", ctx, actionId, config)}
 }
 
-func (_c *MockStore_GetPagesByOrganizationId_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetPagesByOrganizationId_Call {
+func (_c *MockStore_UpdateDatasetActionConfig_Call) Run(run func(ctx context.Context, actionId string, config map[string]interface{})) *MockStore_UpdateDatasetActionConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].(map[string]interface{}))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPagesByOrganizationId_Call) Return(_a0 []models.Page, _a1 error) *MockStore_GetPagesByOrganizationId_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateDatasetActionConfig_Call) Return(_a0 error) *MockStore_UpdateDatasetActionConfig_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetPagesByOrganizationId_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Page, error)) *MockStore_GetPagesByOrganizationId_Call {
+func (_c *MockStore_UpdateDatasetActionConfig_Call) RunAndReturn(run func(context.Context, string, map[string]interface{}) error) *MockStore_UpdateDatasetActionConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPagesPolicies provides a mock function with given fields: ctx, pageId
-func (_m *MockStore) GetPagesPolicies(ctx context.Context, pageId uuid.UUID) ([]models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, pageId)
+// UpdateDatasetActionStatus provides a mock function with given fields: ctx, actionId, status
+func (_m *MockStore) UpdateDatasetActionStatus(ctx context.Context, actionId string, status string) error {
+	ret := _m.Called(ctx, actionId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPagesPolicies")
-	}
-
-	var r0 []models.ResourceAudiencePolicy
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, pageId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, pageId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.ResourceAudiencePolicy)
-		}
+		panic("no return value specified for UpdateDatasetActionStatus")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, pageId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, actionId, status)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetPagesPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPagesPolicies'
-type MockStore_GetPagesPolicies_Call struct {
+// MockStore_UpdateDatasetActionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionStatus'
+type MockStore_UpdateDatasetActionStatus_Call struct {
 	*mock.Call
 }
 
-// GetPagesPolicies is a helper method to define mock.On call
+// UpdateDatasetActionStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pageId uuid.UUID
-func (_e *MockStore_Expecter) GetPagesPolicies(ctx interface{}, pageId interface{}) *MockStore_GetPagesPolicies_Call {
-	return &MockStore_GetPagesPolicies_Call{Call: _e.mock.On("GetPagesPolicies", ctx, pageId)}
+//   - actionId string
+//   - status string
+func (_e *MockStore_Expecter) UpdateDatasetActionStatus(ctx interface{}, actionId interface{}, status interface{}) *MockStore_UpdateDatasetActionStatus_Call {
+	return &MockStore_UpdateDatasetActionStatus_Call{Call: _e.mock.On("UpdateDatasetActionStatus", ctx, actionId, status)}
 }
 
-func (_c *MockStore_GetPagesPolicies_Call) Run(run func(ctx context.Context, pageId uuid.UUID)) *MockStore_GetPagesPolicies_Call {
+func (_c *MockStore_UpdateDatasetActionStatus_Call) Run(run func(ctx context.Context, actionId string, status string)) *MockStore_UpdateDatasetActionStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPagesPolicies_Call) Return(_a0 []models.ResourceAudiencePolicy, _a1 error) *MockStore_GetPagesPolicies_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateDatasetActionStatus_Call) Return(_a0 error) *MockStore_UpdateDatasetActionStatus_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetPagesPolicies_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.ResourceAudiencePolicy, error)) *MockStore_GetPagesPolicies_Call {
+func (_c *MockStore_UpdateDatasetActionStatus_Call) RunAndReturn(run func(context.Context, string, string) error) *MockStore_UpdateDatasetActionStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPaymentsConfigsByOrganizationId provides a mock function with given fields: ctx, organizationId
-func (_m *MockStore) GetPaymentsConfigsByOrganizationId(ctx context.Context, organizationId string) (models.PaymentsConfig, error) {
-	ret := _m.Called(ctx, organizationId)
+// UpdateDatasetAlert provides a mock function with given fields: ctx, alertId, params
+func (_m *MockStore) UpdateDatasetAlert(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertParams) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, alertId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPaymentsConfigsByOrganizationId")
+		panic("no return value specified for UpdateDatasetAlert")
 	}
 
-	var r0 models.PaymentsConfig
+	var r0 models.DatasetAlert
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (models.PaymentsConfig, error)); ok {
-		return rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) (models.DatasetAlert, error)); ok {
+		return rf(ctx, alertId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) models.PaymentsConfig); ok {
-		r0 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) models.DatasetAlert); ok {
+		r0 = rf(ctx, alertId, params)
 	} else {
-		r0 = ret.Get(0).(models.PaymentsConfig)
+		r0 = ret.Get(0).(models.DatasetAlert)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) error); ok {
+		r1 = rf(ctx, alertId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3833,58 +9985,57 @@ func (_m *MockStore) GetPaymentsConfigsByOrganizationId(ctx context.Context, org
 	return r0, r1
 }
 
-// MockStore_GetPaymentsConfigsByOrganizationId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentsConfigsByOrganizationId'
-type MockStore_GetPaymentsConfigsByOrganizationId_Call struct {
+// MockStore_UpdateDatasetAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlert'
+type MockStore_UpdateDatasetAlert_Call struct {
 	*mock.Call
 }
 
-// GetPaymentsConfigsByOrganizationId is a helper method to define mock.On call
+// UpdateDatasetAlert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId string
-func (_e *MockStore_Expecter) GetPaymentsConfigsByOrganizationId(ctx interface{}, organizationId interface{}) *MockStore_GetPaymentsConfigsByOrganizationId_Call {
-	return &MockStore_GetPaymentsConfigsByOrganizationId_Call{Call: _e.mock.On("GetPaymentsConfigsByOrganizationId", ctx, organizationId)}
+//   - alertId uuid.UUID
+//   - params models.UpdateDatasetAlertParams
+func (_e *MockStore_Expecter) UpdateDatasetAlert(ctx interface{}, alertId interface{}, params interface{}) *MockStore_UpdateDatasetAlert_Call {
+	return &MockStore_UpdateDatasetAlert_Call{Call: _e.mock.On("UpdateDatasetAlert", ctx, alertId, params)}
 }
 
-func (_c *MockStore_GetPaymentsConfigsByOrganizationId_Call) Run(run func(ctx context.Context, organizationId string)) *MockStore_GetPaymentsConfigsByOrganizationId_Call {
+func (_c *MockStore_UpdateDatasetAlert_Call) Run(run func(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertParams)) *MockStore_UpdateDatasetAlert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetAlertParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPaymentsConfigsByOrganizationId_Call) Return(_a0 models.PaymentsConfig, _a1 error) *MockStore_GetPaymentsConfigsByOrganizationId_Call {
+func (_c *MockStore_UpdateDatasetAlert_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockStore_UpdateDatasetAlert_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetPaymentsConfigsByOrganizationId_Call) RunAndReturn(run func(context.Context, string) (models.PaymentsConfig, error)) *MockStore_GetPaymentsConfigsByOrganizationId_Call {
+func (_c *MockStore_UpdateDatasetAlert_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetAlertParams) (models.DatasetAlert, error)) *MockStore_UpdateDatasetAlert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPrimarySSOConfigByDomain provides a mock function with given fields: ctx, domain
-func (_m *MockStore) GetPrimarySSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
-	ret := _m.Called(ctx, domain)
+// UpdateDatasetAlertSnooze provides a mock function with given fields: ctx, alertId, snoozedUntil, updatedBy
+func (_m *MockStore) UpdateDatasetAlertSnooze(ctx context.Context, alertId uuid.UUID, snoozedUntil *time.Time, updatedBy uuid.UUID) (models.DatasetAlert, error) {
+	ret := _m.Called(ctx, alertId, snoozedUntil, updatedBy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrimarySSOConfigByDomain")
+		panic("no return value specified for UpdateDatasetAlertSnooze")
 	}
 
-	var r0 *models.OrganizationSSOConfig
+	var r0 models.DatasetAlert
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
-		return rf(ctx, domain)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, uuid.UUID) (models.DatasetAlert, error)); ok {
+		return rf(ctx, alertId, snoozedUntil, updatedBy)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
-		r0 = rf(ctx, domain)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *time.Time, uuid.UUID) models.DatasetAlert); ok {
+		r0 = rf(ctx, alertId, snoozedUntil, updatedBy)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
-		}
+		r0 = ret.Get(0).(models.DatasetAlert)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, domain)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *time.Time, uuid.UUID) error); ok {
+		r1 = rf(ctx, alertId, snoozedUntil, updatedBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -3892,115 +10043,106 @@ func (_m *MockStore) GetPrimarySSOConfigByDomain(ctx context.Context, domain str
 	return r0, r1
 }
 
-// MockStore_GetPrimarySSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrimarySSOConfigByDomain'
-type MockStore_GetPrimarySSOConfigByDomain_Call struct {
+// MockStore_UpdateDatasetAlertSnooze_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlertSnooze'
+type MockStore_UpdateDatasetAlertSnooze_Call struct {
 	*mock.Call
 }
 
-// GetPrimarySSOConfigByDomain is a helper method to define mock.On call
+// UpdateDatasetAlertSnooze is a helper method to define mock.On call
 //   - ctx context.Context
-//   - domain string
-func (_e *MockStore_Expecter) GetPrimarySSOConfigByDomain(ctx interface{}, domain interface{}) *MockStore_GetPrimarySSOConfigByDomain_Call {
-	return &MockStore_GetPrimarySSOConfigByDomain_Call{Call: _e.mock.On("GetPrimarySSOConfigByDomain", ctx, domain)}
+//   - alertId uuid.UUID
+//   - snoozedUntil *time.Time
+//   - updatedBy uuid.UUID
+func (_e *MockStore_Expecter) UpdateDatasetAlertSnooze(ctx interface{}, alertId interface{}, snoozedUntil interface{}, updatedBy interface{}) *MockStore_UpdateDatasetAlertSnooze_Call {
+	return &MockStore_UpdateDatasetAlertSnooze_Call{Call: _e.mock.On("UpdateDatasetAlertSnooze", ctx, alertId, snoozedUntil, updatedBy)}
 }
 
-func (_c *MockStore_GetPrimarySSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockStore_GetPrimarySSOConfigByDomain_Call {
+func (_c *MockStore_UpdateDatasetAlertSnooze_Call) Run(run func(ctx context.Context, alertId uuid.UUID, snoozedUntil *time.Time, updatedBy uuid.UUID)) *MockStore_UpdateDatasetAlertSnooze_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*time.Time), args[3].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPrimarySSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockStore_GetPrimarySSOConfigByDomain_Call {
+func (_c *MockStore_UpdateDatasetAlertSnooze_Call) Return(_a0 models.DatasetAlert, _a1 error) *MockStore_UpdateDatasetAlertSnooze_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetPrimarySSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockStore_GetPrimarySSOConfigByDomain_Call {
+func (_c *MockStore_UpdateDatasetAlertSnooze_Call) RunAndReturn(run func(context.Context, uuid.UUID, *time.Time, uuid.UUID) (models.DatasetAlert, error)) *MockStore_UpdateDatasetAlertSnooze_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRuleById provides a mock function with given fields: ctx, ruleId
-func (_m *MockStore) GetRuleById(ctx context.Context, ruleId uuid.UUID) (models.Rule, error) {
-	ret := _m.Called(ctx, ruleId)
+// UpdateDatasetAlertState provides a mock function with given fields: ctx, alertId, params
+func (_m *MockStore) UpdateDatasetAlertState(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertStateParams) error {
+	ret := _m.Called(ctx, alertId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRuleById")
-	}
-
-	var r0 models.Rule
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Rule, error)); ok {
-		return rf(ctx, ruleId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Rule); ok {
-		r0 = rf(ctx, ruleId)
-	} else {
-		r0 = ret.Get(0).(models.Rule)
+		panic("no return value specified for UpdateDatasetAlertState")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, ruleId)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetAlertStateParams) error); ok {
+		r0 = rf(ctx, alertId, params)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetRuleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleById'
-type MockStore_GetRuleById_Call struct {
+// MockStore_UpdateDatasetAlertState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetAlertState'
+type MockStore_UpdateDatasetAlertState_Call struct {
 	*mock.Call
 }
 
-// GetRuleById is a helper method to define mock.On call
+// UpdateDatasetAlertState is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ruleId uuid.UUID
-func (_e *MockStore_Expecter) GetRuleById(ctx interface{}, ruleId interface{}) *MockStore_GetRuleById_Call {
-	return &MockStore_GetRuleById_Call{Call: _e.mock.On("GetRuleById", ctx, ruleId)}
+//   - alertId uuid.UUID
+//   - params models.UpdateDatasetAlertStateParams
+func (_e *MockStore_Expecter) UpdateDatasetAlertState(ctx interface{}, alertId interface{}, params interface{}) *MockStore_UpdateDatasetAlertState_Call {
+	return &MockStore_UpdateDatasetAlertState_Call{Call: _e.mock.On("UpdateDatasetAlertState", ctx, alertId, params)}
 }
 
-func (_c *MockStore_GetRuleById_Call) Run(run func(ctx context.Context, ruleId uuid.UUID)) *MockStore_GetRuleById_Call {
+func (_c *MockStore_UpdateDatasetAlertState_Call) Run(run func(ctx context.Context, alertId uuid.UUID, params models.UpdateDatasetAlertStateParams)) *MockStore_UpdateDatasetAlertState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetAlertStateParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRuleById_Call) Return(_a0 models.Rule, _a1 error) *MockStore_GetRuleById_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateDatasetAlertState_Call) Return(_a0 error) *MockStore_UpdateDatasetAlertState_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetRuleById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Rule, error)) *MockStore_GetRuleById_Call {
+func (_c *MockStore_UpdateDatasetAlertState_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetAlertStateParams) error) *MockStore_UpdateDatasetAlertState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRuleByIds provides a mock function with given fields: ctx, ruleIds
-func (_m *MockStore) GetRuleByIds(ctx context.Context, ruleIds []uuid.UUID) ([]models.Rule, error) {
-	ret := _m.Called(ctx, ruleIds)
+// UpdateDatasetColumnPolicy provides a mock function with given fields: ctx, policyId, params
+func (_m *MockStore) UpdateDatasetColumnPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error) {
+	ret := _m.Called(ctx, policyId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRuleByIds")
+		panic("no return value specified for UpdateDatasetColumnPolicy")
 	}
 
-	var r0 []models.Rule
+	var r0 models.DatasetColumnPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) ([]models.Rule, error)); ok {
-		return rf(ctx, ruleIds)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)); ok {
+		return rf(ctx, policyId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) []models.Rule); ok {
-		r0 = rf(ctx, ruleIds)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) models.DatasetColumnPolicy); ok {
+		r0 = rf(ctx, policyId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Rule)
-		}
+		r0 = ret.Get(0).(models.DatasetColumnPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID) error); ok {
-		r1 = rf(ctx, ruleIds)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) error); ok {
+		r1 = rf(ctx, policyId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4008,58 +10150,57 @@ func (_m *MockStore) GetRuleByIds(ctx context.Context, ruleIds []uuid.UUID) ([]m
 	return r0, r1
 }
 
-// MockStore_GetRuleByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuleByIds'
-type MockStore_GetRuleByIds_Call struct {
+// MockStore_UpdateDatasetColumnPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetColumnPolicy'
+type MockStore_UpdateDatasetColumnPolicy_Call struct {
 	*mock.Call
 }
 
-// GetRuleByIds is a helper method to define mock.On call
+// UpdateDatasetColumnPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ruleIds []uuid.UUID
-func (_e *MockStore_Expecter) GetRuleByIds(ctx interface{}, ruleIds interface{}) *MockStore_GetRuleByIds_Call {
-	return &MockStore_GetRuleByIds_Call{Call: _e.mock.On("GetRuleByIds", ctx, ruleIds)}
+//   - policyId uuid.UUID
+//   - params models.UpdateDatasetColumnPolicyParams
+func (_e *MockStore_Expecter) UpdateDatasetColumnPolicy(ctx interface{}, policyId interface{}, params interface{}) *MockStore_UpdateDatasetColumnPolicy_Call {
+	return &MockStore_UpdateDatasetColumnPolicy_Call{Call: _e.mock.On("UpdateDatasetColumnPolicy", ctx, policyId, params)}
 }
 
-func (_c *MockStore_GetRuleByIds_Call) Run(run func(ctx context.Context, ruleIds []uuid.UUID)) *MockStore_GetRuleByIds_Call {
+func (_c *MockStore_UpdateDatasetColumnPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetColumnPolicyParams)) *MockStore_UpdateDatasetColumnPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetColumnPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRuleByIds_Call) Return(_a0 []models.Rule, _a1 error) *MockStore_GetRuleByIds_Call {
+func (_c *MockStore_UpdateDatasetColumnPolicy_Call) Return(_a0 models.DatasetColumnPolicy, _a1 error) *MockStore_UpdateDatasetColumnPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetRuleByIds_Call) RunAndReturn(run func(context.Context, []uuid.UUID) ([]models.Rule, error)) *MockStore_GetRuleByIds_Call {
+func (_c *MockStore_UpdateDatasetColumnPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetColumnPolicyParams) (models.DatasetColumnPolicy, error)) *MockStore_UpdateDatasetColumnPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRules provides a mock function with given fields: ctx, params
-func (_m *MockStore) GetRules(ctx context.Context, params models.FilterRuleParams) (map[string]map[string][]models.Rule, error) {
-	ret := _m.Called(ctx, params)
+// UpdateDatasetExportSchedule provides a mock function with given fields: ctx, scheduleId, params
+func (_m *MockStore) UpdateDatasetExportSchedule(ctx context.Context, scheduleId uuid.UUID, params models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error) {
+	ret := _m.Called(ctx, scheduleId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRules")
+		panic("no return value specified for UpdateDatasetExportSchedule")
 	}
 
-	var r0 map[string]map[string][]models.Rule
+	var r0 models.DatasetExportSchedule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.FilterRuleParams) (map[string]map[string][]models.Rule, error)); ok {
-		return rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)); ok {
+		return rf(ctx, scheduleId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.FilterRuleParams) map[string]map[string][]models.Rule); ok {
-		r0 = rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) models.DatasetExportSchedule); ok {
+		r0 = rf(ctx, scheduleId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]map[string][]models.Rule)
-		}
+		r0 = ret.Get(0).(models.DatasetExportSchedule)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.FilterRuleParams) error); ok {
-		r1 = rf(ctx, params)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) error); ok {
+		r1 = rf(ctx, scheduleId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4067,117 +10208,107 @@ func (_m *MockStore) GetRules(ctx context.Context, params models.FilterRuleParam
 	return r0, r1
 }
 
-// MockStore_GetRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRules'
-type MockStore_GetRules_Call struct {
+// MockStore_UpdateDatasetExportSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetExportSchedule'
+type MockStore_UpdateDatasetExportSchedule_Call struct {
 	*mock.Call
 }
 
-// GetRules is a helper method to define mock.On call
-//   - ctx context.Context
-//   - params models.FilterRuleParams
-func (_e *MockStore_Expecter) GetRules(ctx interface{}, params interface{}) *MockStore_GetRules_Call {
-	return &MockStore_GetRules_Call{Call: _e.mock.On("GetRules", ctx, params)}
+// UpdateDatasetExportSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleId uuid.UUID
+//   - params models.UpdateDatasetExportScheduleParams
+func (_e *MockStore_Expecter) UpdateDatasetExportSchedule(ctx interface{}, scheduleId interface{}, params interface{}) *MockStore_UpdateDatasetExportSchedule_Call {
+	return &MockStore_UpdateDatasetExportSchedule_Call{Call: _e.mock.On("UpdateDatasetExportSchedule", ctx, scheduleId, params)}
 }
 
-func (_c *MockStore_GetRules_Call) Run(run func(ctx context.Context, params models.FilterRuleParams)) *MockStore_GetRules_Call {
+func (_c *MockStore_UpdateDatasetExportSchedule_Call) Run(run func(ctx context.Context, scheduleId uuid.UUID, params models.UpdateDatasetExportScheduleParams)) *MockStore_UpdateDatasetExportSchedule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.FilterRuleParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetExportScheduleParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRules_Call) Return(_a0 map[string]map[string][]models.Rule, _a1 error) *MockStore_GetRules_Call {
+func (_c *MockStore_UpdateDatasetExportSchedule_Call) Return(_a0 models.DatasetExportSchedule, _a1 error) *MockStore_UpdateDatasetExportSchedule_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetRules_Call) RunAndReturn(run func(context.Context, models.FilterRuleParams) (map[string]map[string][]models.Rule, error)) *MockStore_GetRules_Call {
+func (_c *MockStore_UpdateDatasetExportSchedule_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleParams) (models.DatasetExportSchedule, error)) *MockStore_UpdateDatasetExportSchedule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSSOConfigByDomain provides a mock function with given fields: ctx, domain
-func (_m *MockStore) GetSSOConfigByDomain(ctx context.Context, domain string) (*models.OrganizationSSOConfig, error) {
-	ret := _m.Called(ctx, domain)
+// UpdateDatasetExportScheduleRun provides a mock function with given fields: ctx, runId, params
+func (_m *MockStore) UpdateDatasetExportScheduleRun(ctx context.Context, runId uuid.UUID, params models.UpdateDatasetExportScheduleRunParams) error {
+	ret := _m.Called(ctx, runId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSSOConfigByDomain")
-	}
-
-	var r0 *models.OrganizationSSOConfig
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.OrganizationSSOConfig, error)); ok {
-		return rf(ctx, domain)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.OrganizationSSOConfig); ok {
-		r0 = rf(ctx, domain)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.OrganizationSSOConfig)
-		}
+		panic("no return value specified for UpdateDatasetExportScheduleRun")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, domain)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleRunParams) error); ok {
+		r0 = rf(ctx, runId, params)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetSSOConfigByDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSSOConfigByDomain'
-type MockStore_GetSSOConfigByDomain_Call struct {
+// MockStore_UpdateDatasetExportScheduleRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetExportScheduleRun'
+type MockStore_UpdateDatasetExportScheduleRun_Call struct {
 	*mock.Call
 }
 
-// GetSSOConfigByDomain is a helper method to define mock.On call
+// UpdateDatasetExportScheduleRun is a helper method to define mock.On call
 //   - ctx context.Context
-//   - domain string
-func (_e *MockStore_Expecter) GetSSOConfigByDomain(ctx interface{}, domain interface{}) *MockStore_GetSSOConfigByDomain_Call {
-	return &MockStore_GetSSOConfigByDomain_Call{Call: _e.mock.On("GetSSOConfigByDomain", ctx, domain)}
+//   - runId uuid.UUID
+//   - params models.UpdateDatasetExportScheduleRunParams
+func (_e *MockStore_Expecter) UpdateDatasetExportScheduleRun(ctx interface{}, runId interface{}, params interface{}) *MockStore_UpdateDatasetExportScheduleRun_Call {
+	return &MockStore_UpdateDatasetExportScheduleRun_Call{Call: _e.mock.On("UpdateDatasetExportScheduleRun", ctx, runId, params)}
 }
 
-func (_c *MockStore_GetSSOConfigByDomain_Call) Run(run func(ctx context.Context, domain string)) *MockStore_GetSSOConfigByDomain_Call {
+func (_c *MockStore_UpdateDatasetExportScheduleRun_Call) Run(run func(ctx context.Context, runId uuid.UUID, params models.UpdateDatasetExportScheduleRunParams)) *MockStore_UpdateDatasetExportScheduleRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetExportScheduleRunParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSSOConfigByDomain_Call) Return(_a0 *models.OrganizationSSOConfig, _a1 error) *MockStore_GetSSOConfigByDomain_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateDatasetExportScheduleRun_Call) Return(_a0 error) *MockStore_UpdateDatasetExportScheduleRun_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_GetSSOConfigByDomain_Call) RunAndReturn(run func(context.Context, string) (*models.OrganizationSSOConfig, error)) *MockStore_GetSSOConfigByDomain_Call {
+func (_c *MockStore_UpdateDatasetExportScheduleRun_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetExportScheduleRunParams) error) *MockStore_UpdateDatasetExportScheduleRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSchedulesByConnectionID provides a mock function with given fields: ctx, connectionID
-func (_m *MockStore) GetSchedulesByConnectionID(ctx context.Context, connectionID uuid.UUID) ([]models.Schedule, error) {
-	ret := _m.Called(ctx, connectionID)
+// UpdateDatasetFileUploadStatus provides a mock function with given fields: ctx, id, fileAllignmentStatus, metadata
+func (_m *MockStore) UpdateDatasetFileUploadStatus(ctx context.Context, id uuid.UUID, fileAllignmentStatus models.DatasetFileAllignmentStatus, metadata models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error) {
+	ret := _m.Called(ctx, id, fileAllignmentStatus, metadata)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSchedulesByConnectionID")
+		panic("no return value specified for UpdateDatasetFileUploadStatus")
 	}
 
-	var r0 []models.Schedule
+	var r0 *models.DatasetFileUpload
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Schedule, error)); ok {
-		return rf(ctx, connectionID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error)); ok {
+		return rf(ctx, id, fileAllignmentStatus, metadata)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Schedule); ok {
-		r0 = rf(ctx, connectionID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) *models.DatasetFileUpload); ok {
+		r0 = rf(ctx, id, fileAllignmentStatus, metadata)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Schedule)
+			r0 = ret.Get(0).(*models.DatasetFileUpload)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, connectionID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) error); ok {
+		r1 = rf(ctx, id, fileAllignmentStatus, metadata)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4185,58 +10316,60 @@ func (_m *MockStore) GetSchedulesByConnectionID(ctx context.Context, connectionI
 	return r0, r1
 }
 
-// MockStore_GetSchedulesByConnectionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSchedulesByConnectionID'
-type MockStore_GetSchedulesByConnectionID_Call struct {
+// MockStore_UpdateDatasetFileUploadStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetFileUploadStatus'
+type MockStore_UpdateDatasetFileUploadStatus_Call struct {
 	*mock.Call
 }
 
-// GetSchedulesByConnectionID is a helper method to define mock.On call
+// UpdateDatasetFileUploadStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - connectionID uuid.UUID
-func (_e *MockStore_Expecter) GetSchedulesByConnectionID(ctx interface{}, connectionID interface{}) *MockStore_GetSchedulesByConnectionID_Call {
-	return &MockStore_GetSchedulesByConnectionID_Call{Call: _e.mock.On("GetSchedulesByConnectionID", ctx, connectionID)}
+//   - id uuid.UUID
+//   - fileAllignmentStatus models.DatasetFileAllignmentStatus
+//   - metadata models.DatasetFileUploadMetadata
+func (_e *MockStore_Expecter) UpdateDatasetFileUploadStatus(ctx interface{}, id interface{}, fileAllignmentStatus interface{}, metadata interface{}) *MockStore_UpdateDatasetFileUploadStatus_Call {
+	return &MockStore_UpdateDatasetFileUploadStatus_Call{Call: _e.mock.On("UpdateDatasetFileUploadStatus", ctx, id, fileAllignmentStatus, metadata)}
 }
 
-func (_c *MockStore_GetSchedulesByConnectionID_Call) Run(run func(ctx context.Context, connectionID uuid.UUID)) *MockStore_GetSchedulesByConnectionID_Call {
+func (_c *MockStore_UpdateDatasetFileUploadStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, fileAllignmentStatus models.DatasetFileAllignmentStatus, metadata models.DatasetFileUploadMetadata)) *MockStore_UpdateDatasetFileUploadStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetFileAllignmentStatus), args[3].(models.DatasetFileUploadMetadata))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSchedulesByConnectionID_Call) Return(_a0 []models.Schedule, _a1 error) *MockStore_GetSchedulesByConnectionID_Call {
+func (_c *MockStore_UpdateDatasetFileUploadStatus_Call) Return(_a0 *models.DatasetFileUpload, _a1 error) *MockStore_UpdateDatasetFileUploadStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSchedulesByConnectionID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Schedule, error)) *MockStore_GetSchedulesByConnectionID_Call {
+func (_c *MockStore_UpdateDatasetFileUploadStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error)) *MockStore_UpdateDatasetFileUploadStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSheetById provides a mock function with given fields: ctx, sheetId
-func (_m *MockStore) GetSheetById(ctx context.Context, sheetId uuid.UUID) (*models.Sheet, error) {
-	ret := _m.Called(ctx, sheetId)
+// UpdateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceId, privilege
+func (_m *MockStore) UpdateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, datasetId, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSheetById")
+		panic("no return value specified for UpdateDatasetPolicy")
 	}
 
-	var r0 *models.Sheet
+	var r0 *models.ResourceAudiencePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Sheet, error)); ok {
-		return rf(ctx, sheetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, datasetId, audienceId, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Sheet); ok {
-		r0 = rf(ctx, sheetId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, datasetId, audienceId, privilege)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Sheet)
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, sheetId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, datasetId, audienceId, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4244,58 +10377,58 @@ func (_m *MockStore) GetSheetById(ctx context.Context, sheetId uuid.UUID) (*mode
 	return r0, r1
 }
 
-// MockStore_GetSheetById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetById'
-type MockStore_GetSheetById_Call struct {
+// MockStore_UpdateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetPolicy'
+type MockStore_UpdateDatasetPolicy_Call struct {
 	*mock.Call
 }
 
-// GetSheetById is a helper method to define mock.On call
+// UpdateDatasetPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - sheetId uuid.UUID
-func (_e *MockStore_Expecter) GetSheetById(ctx interface{}, sheetId interface{}) *MockStore_GetSheetById_Call {
-	return &MockStore_GetSheetById_Call{Call: _e.mock.On("GetSheetById", ctx, sheetId)}
+//   - datasetId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockStore_Expecter) UpdateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceId interface{}, privilege interface{}) *MockStore_UpdateDatasetPolicy_Call {
+	return &MockStore_UpdateDatasetPolicy_Call{Call: _e.mock.On("UpdateDatasetPolicy", ctx, datasetId, audienceId, privilege)}
 }
 
-func (_c *MockStore_GetSheetById_Call) Run(run func(ctx context.Context, sheetId uuid.UUID)) *MockStore_GetSheetById_Call {
+func (_c *MockStore_UpdateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_UpdateDatasetPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSheetById_Call) Return(_a0 *models.Sheet, _a1 error) *MockStore_GetSheetById_Call {
+func (_c *MockStore_UpdateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_UpdateDatasetPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSheetById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Sheet, error)) *MockStore_GetSheetById_Call {
+func (_c *MockStore_UpdateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_UpdateDatasetPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSheetsAll provides a mock function with given fields: ctx, filters
-func (_m *MockStore) GetSheetsAll(ctx context.Context, filters models.SheetFilters) ([]models.Sheet, error) {
-	ret := _m.Called(ctx, filters)
+// UpdateDatasetRowPolicy provides a mock function with given fields: ctx, policyId, params
+func (_m *MockStore) UpdateDatasetRowPolicy(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error) {
+	ret := _m.Called(ctx, policyId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSheetsAll")
+		panic("no return value specified for UpdateDatasetRowPolicy")
 	}
 
-	var r0 []models.Sheet
+	var r0 models.DatasetRowPolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) ([]models.Sheet, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)); ok {
+		return rf(ctx, policyId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.SheetFilters) []models.Sheet); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) models.DatasetRowPolicy); ok {
+		r0 = rf(ctx, policyId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Sheet)
-		}
+		r0 = ret.Get(0).(models.DatasetRowPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.SheetFilters) error); ok {
-		r1 = rf(ctx, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) error); ok {
+		r1 = rf(ctx, policyId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4303,58 +10436,57 @@ func (_m *MockStore) GetSheetsAll(ctx context.Context, filters models.SheetFilte
 	return r0, r1
 }
 
-// MockStore_GetSheetsAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSheetsAll'
-type MockStore_GetSheetsAll_Call struct {
+// MockStore_UpdateDatasetRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetRowPolicy'
+type MockStore_UpdateDatasetRowPolicy_Call struct {
 	*mock.Call
 }
 
-// GetSheetsAll is a helper method to define mock.On call
+// UpdateDatasetRowPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filters models.SheetFilters
-func (_e *MockStore_Expecter) GetSheetsAll(ctx interface{}, filters interface{}) *MockStore_GetSheetsAll_Call {
-	return &MockStore_GetSheetsAll_Call{Call: _e.mock.On("GetSheetsAll", ctx, filters)}
+//   - policyId uuid.UUID
+//   - params models.UpdateDatasetRowPolicyParams
+func (_e *MockStore_Expecter) UpdateDatasetRowPolicy(ctx interface{}, policyId interface{}, params interface{}) *MockStore_UpdateDatasetRowPolicy_Call {
+	return &MockStore_UpdateDatasetRowPolicy_Call{Call: _e.mock.On("UpdateDatasetRowPolicy", ctx, policyId, params)}
 }
 
-func (_c *MockStore_GetSheetsAll_Call) Run(run func(ctx context.Context, filters models.SheetFilters)) *MockStore_GetSheetsAll_Call {
+func (_c *MockStore_UpdateDatasetRowPolicy_Call) Run(run func(ctx context.Context, policyId uuid.UUID, params models.UpdateDatasetRowPolicyParams)) *MockStore_UpdateDatasetRowPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.SheetFilters))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetRowPolicyParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetSheetsAll_Call) Return(_a0 []models.Sheet, _a1 error) *MockStore_GetSheetsAll_Call {
+func (_c *MockStore_UpdateDatasetRowPolicy_Call) Return(_a0 models.DatasetRowPolicy, _a1 error) *MockStore_UpdateDatasetRowPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetSheetsAll_Call) RunAndReturn(run func(context.Context, models.SheetFilters) ([]models.Sheet, error)) *MockStore_GetSheetsAll_Call {
+func (_c *MockStore_UpdateDatasetRowPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetRowPolicyParams) (models.DatasetRowPolicy, error)) *MockStore_UpdateDatasetRowPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeam provides a mock function with given fields: ctx, organizationId, teamId
-func (_m *MockStore) GetTeam(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID) (*models.Team, error) {
-	ret := _m.Called(ctx, organizationId, teamId)
+// UpdateDatasetStatusWorkflow provides a mock function with given fields: ctx, workflowId, params
+func (_m *MockStore) UpdateDatasetStatusWorkflow(ctx context.Context, workflowId uuid.UUID, params models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error) {
+	ret := _m.Called(ctx, workflowId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeam")
+		panic("no return value specified for UpdateDatasetStatusWorkflow")
 	}
 
-	var r0 *models.Team
+	var r0 models.DatasetStatusWorkflow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.Team, error)); ok {
-		return rf(ctx, organizationId, teamId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)); ok {
+		return rf(ctx, workflowId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.Team); ok {
-		r0 = rf(ctx, organizationId, teamId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) models.DatasetStatusWorkflow); ok {
+		r0 = rf(ctx, workflowId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Team)
-		}
+		r0 = ret.Get(0).(models.DatasetStatusWorkflow)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r1 = rf(ctx, organizationId, teamId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) error); ok {
+		r1 = rf(ctx, workflowId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4362,59 +10494,57 @@ func (_m *MockStore) GetTeam(ctx context.Context, organizationId uuid.UUID, team
 	return r0, r1
 }
 
-// MockStore_GetTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeam'
-type MockStore_GetTeam_Call struct {
+// MockStore_UpdateDatasetStatusWorkflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetStatusWorkflow'
+type MockStore_UpdateDatasetStatusWorkflow_Call struct {
 	*mock.Call
 }
 
-// GetTeam is a helper method to define mock.On call
+// UpdateDatasetStatusWorkflow is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - teamId uuid.UUID
-func (_e *MockStore_Expecter) GetTeam(ctx interface{}, organizationId interface{}, teamId interface{}) *MockStore_GetTeam_Call {
-	return &MockStore_GetTeam_Call{Call: _e.mock.On("GetTeam", ctx, organizationId, teamId)}
+//   - workflowId uuid.UUID
+//   - params models.UpdateDatasetStatusWorkflowParams
+func (_e *MockStore_Expecter) UpdateDatasetStatusWorkflow(ctx interface{}, workflowId interface{}, params interface{}) *MockStore_UpdateDatasetStatusWorkflow_Call {
+	return &MockStore_UpdateDatasetStatusWorkflow_Call{Call: _e.mock.On("UpdateDatasetStatusWorkflow", ctx, workflowId, params)}
 }
 
-func (_c *MockStore_GetTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, teamId uuid.UUID)) *MockStore_GetTeam_Call {
+func (_c *MockStore_UpdateDatasetStatusWorkflow_Call) Run(run func(ctx context.Context, workflowId uuid.UUID, params models.UpdateDatasetStatusWorkflowParams)) *MockStore_UpdateDatasetStatusWorkflow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetStatusWorkflowParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetTeam_Call) Return(_a0 *models.Team, _a1 error) *MockStore_GetTeam_Call {
+func (_c *MockStore_UpdateDatasetStatusWorkflow_Call) Return(_a0 models.DatasetStatusWorkflow, _a1 error) *MockStore_UpdateDatasetStatusWorkflow_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.Team, error)) *MockStore_GetTeam_Call {
+func (_c *MockStore_UpdateDatasetStatusWorkflow_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetStatusWorkflowParams) (models.DatasetStatusWorkflow, error)) *MockStore_UpdateDatasetStatusWorkflow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeamByName provides a mock function with given fields: ctx, organizationId, name
-func (_m *MockStore) GetTeamByName(ctx context.Context, organizationId uuid.UUID, name string) ([]models.Team, error) {
-	ret := _m.Called(ctx, organizationId, name)
+// UpdateDatasetView provides a mock function with given fields: ctx, viewId, params
+func (_m *MockStore) UpdateDatasetView(ctx context.Context, viewId uuid.UUID, params models.UpdateDatasetViewParams) (models.DatasetView, error) {
+	ret := _m.Called(ctx, viewId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeamByName")
+		panic("no return value specified for UpdateDatasetView")
 	}
 
-	var r0 []models.Team
+	var r0 models.DatasetView
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) ([]models.Team, error)); ok {
-		return rf(ctx, organizationId, name)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) (models.DatasetView, error)); ok {
+		return rf(ctx, viewId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) []models.Team); ok {
-		r0 = rf(ctx, organizationId, name)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) models.DatasetView); ok {
+		r0 = rf(ctx, viewId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Team)
-		}
+		r0 = ret.Get(0).(models.DatasetView)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
-		r1 = rf(ctx, organizationId, name)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) error); ok {
+		r1 = rf(ctx, viewId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4422,59 +10552,59 @@ func (_m *MockStore) GetTeamByName(ctx context.Context, organizationId uuid.UUID
 	return r0, r1
 }
 
-// MockStore_GetTeamByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamByName'
-type MockStore_GetTeamByName_Call struct {
+// MockStore_UpdateDatasetView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetView'
+type MockStore_UpdateDatasetView_Call struct {
 	*mock.Call
 }
 
-// GetTeamByName is a helper method to define mock.On call
+// UpdateDatasetView is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - name string
-func (_e *MockStore_Expecter) GetTeamByName(ctx interface{}, organizationId interface{}, name interface{}) *MockStore_GetTeamByName_Call {
-	return &MockStore_GetTeamByName_Call{Call: _e.mock.On("GetTeamByName", ctx, organizationId, name)}
+//   - viewId uuid.UUID
+//   - params models.UpdateDatasetViewParams
+func (_e *MockStore_Expecter) UpdateDatasetView(ctx interface{}, viewId interface{}, params interface{}) *MockStore_UpdateDatasetView_Call {
+	return &MockStore_UpdateDatasetView_Call{Call: _e.mock.On("UpdateDatasetView", ctx, viewId, params)}
 }
 
-func (_c *MockStore_GetTeamByName_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, name string)) *MockStore_GetTeamByName_Call {
+func (_c *MockStore_UpdateDatasetView_Call) Run(run func(ctx context.Context, viewId uuid.UUID, params models.UpdateDatasetViewParams)) *MockStore_UpdateDatasetView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateDatasetViewParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetTeamByName_Call) Return(_a0 []models.Team, _a1 error) *MockStore_GetTeamByName_Call {
+func (_c *MockStore_UpdateDatasetView_Call) Return(_a0 models.DatasetView, _a1 error) *MockStore_UpdateDatasetView_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetTeamByName_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) ([]models.Team, error)) *MockStore_GetTeamByName_Call {
+func (_c *MockStore_UpdateDatasetView_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateDatasetViewParams) (models.DatasetView, error)) *MockStore_UpdateDatasetView_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeamMembershipById provides a mock function with given fields: ctx, teamMembershipId
-func (_m *MockStore) GetTeamMembershipById(ctx context.Context, teamMembershipId uuid.UUID) (*models.TeamMembership, error) {
-	ret := _m.Called(ctx, teamMembershipId)
+// UpdateDatasetViewPolicy provides a mock function with given fields: ctx, viewId, audienceId, privilege
+func (_m *MockStore) UpdateDatasetViewPolicy(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, viewId, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeamMembershipById")
+		panic("no return value specified for UpdateDatasetViewPolicy")
 	}
 
-	var r0 *models.TeamMembership
+	var r0 *models.ResourceAudiencePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.TeamMembership, error)); ok {
-		return rf(ctx, teamMembershipId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, viewId, audienceId, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.TeamMembership); ok {
-		r0 = rf(ctx, teamMembershipId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, viewId, audienceId, privilege)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.TeamMembership)
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, teamMembershipId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, viewId, audienceId, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4482,58 +10612,60 @@ func (_m *MockStore) GetTeamMembershipById(ctx context.Context, teamMembershipId
 	return r0, r1
 }
 
-// MockStore_GetTeamMembershipById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMembershipById'
-type MockStore_GetTeamMembershipById_Call struct {
+// MockStore_UpdateDatasetViewPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetViewPolicy'
+type MockStore_UpdateDatasetViewPolicy_Call struct {
 	*mock.Call
 }
 
-// GetTeamMembershipById is a helper method to define mock.On call
+// UpdateDatasetViewPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - teamMembershipId uuid.UUID
-func (_e *MockStore_Expecter) GetTeamMembershipById(ctx interface{}, teamMembershipId interface{}) *MockStore_GetTeamMembershipById_Call {
-	return &MockStore_GetTeamMembershipById_Call{Call: _e.mock.On("GetTeamMembershipById", ctx, teamMembershipId)}
+//   - viewId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockStore_Expecter) UpdateDatasetViewPolicy(ctx interface{}, viewId interface{}, audienceId interface{}, privilege interface{}) *MockStore_UpdateDatasetViewPolicy_Call {
+	return &MockStore_UpdateDatasetViewPolicy_Call{Call: _e.mock.On("UpdateDatasetViewPolicy", ctx, viewId, audienceId, privilege)}
 }
 
-func (_c *MockStore_GetTeamMembershipById_Call) Run(run func(ctx context.Context, teamMembershipId uuid.UUID)) *MockStore_GetTeamMembershipById_Call {
+func (_c *MockStore_UpdateDatasetViewPolicy_Call) Run(run func(ctx context.Context, viewId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_UpdateDatasetViewPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetTeamMembershipById_Call) Return(_a0 *models.TeamMembership, _a1 error) *MockStore_GetTeamMembershipById_Call {
+func (_c *MockStore_UpdateDatasetViewPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_UpdateDatasetViewPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetTeamMembershipById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.TeamMembership, error)) *MockStore_GetTeamMembershipById_Call {
+func (_c *MockStore_UpdateDatasetViewPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_UpdateDatasetViewPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeamMembershipByUserIdTeamId provides a mock function with given fields: ctx, userId, teamId
-func (_m *MockStore) GetTeamMembershipByUserIdTeamId(ctx context.Context, userId uuid.UUID, teamId uuid.UUID) (*models.TeamMembership, error) {
-	ret := _m.Called(ctx, userId, teamId)
+// UpdateFileUploadStatus provides a mock function with given fields: ctx, fileUploadId, status
+func (_m *MockStore) UpdateFileUploadStatus(ctx context.Context, fileUploadId uuid.UUID, status models.FileUploadStatus) (*models.FileUpload, error) {
+	ret := _m.Called(ctx, fileUploadId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeamMembershipByUserIdTeamId")
+		panic("no return value specified for UpdateFileUploadStatus")
 	}
 
-	var r0 *models.TeamMembership
+	var r0 *models.FileUpload
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)); ok {
-		return rf(ctx, userId, teamId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.FileUploadStatus) (*models.FileUpload, error)); ok {
+		return rf(ctx, fileUploadId, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *models.TeamMembership); ok {
-		r0 = rf(ctx, userId, teamId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.FileUploadStatus) *models.FileUpload); ok {
+		r0 = rf(ctx, fileUploadId, status)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.TeamMembership)
+			r0 = ret.Get(0).(*models.FileUpload)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r1 = rf(ctx, userId, teamId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.FileUploadStatus) error); ok {
+		r1 = rf(ctx, fileUploadId, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4541,59 +10673,59 @@ func (_m *MockStore) GetTeamMembershipByUserIdTeamId(ctx context.Context, userId
 	return r0, r1
 }
 
-// MockStore_GetTeamMembershipByUserIdTeamId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMembershipByUserIdTeamId'
-type MockStore_GetTeamMembershipByUserIdTeamId_Call struct {
+// MockStore_UpdateFileUploadStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFileUploadStatus'
+type MockStore_UpdateFileUploadStatus_Call struct {
 	*mock.Call
 }
 
-// GetTeamMembershipByUserIdTeamId is a helper method to define mock.On call
+// UpdateFileUploadStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId uuid.UUID
-//   - teamId uuid.UUID
-func (_e *MockStore_Expecter) GetTeamMembershipByUserIdTeamId(ctx interface{}, userId interface{}, teamId interface{}) *MockStore_GetTeamMembershipByUserIdTeamId_Call {
-	return &MockStore_GetTeamMembershipByUserIdTeamId_Call{Call: _e.mock.On("GetTeamMembershipByUserIdTeamId", ctx, userId, teamId)}
+//   - fileUploadId uuid.UUID
+//   - status models.FileUploadStatus
+func (_e *MockStore_Expecter) UpdateFileUploadStatus(ctx interface{}, fileUploadId interface{}, status interface{}) *MockStore_UpdateFileUploadStatus_Call {
+	return &MockStore_UpdateFileUploadStatus_Call{Call: _e.mock.On("UpdateFileUploadStatus", ctx, fileUploadId, status)}
 }
 
-func (_c *MockStore_GetTeamMembershipByUserIdTeamId_Call) Run(run func(ctx context.Context, userId uuid.UUID, teamId uuid.UUID)) *MockStore_GetTeamMembershipByUserIdTeamId_Call {
+func (_c *MockStore_UpdateFileUploadStatus_Call) Run(run func(ctx context.Context, fileUploadId uuid.UUID, status models.FileUploadStatus)) *MockStore_UpdateFileUploadStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.FileUploadStatus))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetTeamMembershipByUserIdTeamId_Call) Return(_a0 *models.TeamMembership, _a1 error) *MockStore_GetTeamMembershipByUserIdTeamId_Call {
+func (_c *MockStore_UpdateFileUploadStatus_Call) Return(_a0 *models.FileUpload, _a1 error) *MockStore_UpdateFileUploadStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetTeamMembershipByUserIdTeamId_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*models.TeamMembership, error)) *MockStore_GetTeamMembershipByUserIdTeamId_Call {
+func (_c *MockStore_UpdateFileUploadStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.FileUploadStatus) (*models.FileUpload, error)) *MockStore_UpdateFileUploadStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeamMemberships provides a mock function with given fields: ctx, teamId
-func (_m *MockStore) GetTeamMemberships(ctx context.Context, teamId uuid.UUID) ([]models.TeamMembership, error) {
-	ret := _m.Called(ctx, teamId)
+// UpdateOrganizationCalendarSettings provides a mock function with given fields: ctx, organizationId, calendarSettings
+func (_m *MockStore) UpdateOrganizationCalendarSettings(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, calendarSettings)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeamMemberships")
+		panic("no return value specified for UpdateOrganizationCalendarSettings")
 	}
 
-	var r0 []models.TeamMembership
+	var r0 *models.Organization
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.TeamMembership, error)); ok {
-		return rf(ctx, teamId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, calendarSettings)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.TeamMembership); ok {
-		r0 = rf(ctx, teamId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, calendarSettings)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.TeamMembership)
+			r0 = ret.Get(0).(*models.Organization)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, teamId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, calendarSettings)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4601,58 +10733,59 @@ func (_m *MockStore) GetTeamMemberships(ctx context.Context, teamId uuid.UUID) (
 	return r0, r1
 }
 
-// MockStore_GetTeamMemberships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMemberships'
-type MockStore_GetTeamMemberships_Call struct {
+// MockStore_UpdateOrganizationCalendarSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationCalendarSettings'
+type MockStore_UpdateOrganizationCalendarSettings_Call struct {
 	*mock.Call
 }
 
-// GetTeamMemberships is a helper method to define mock.On call
+// UpdateOrganizationCalendarSettings is a helper method to define mock.On call
 //   - ctx context.Context
-//   - teamId uuid.UUID
-func (_e *MockStore_Expecter) GetTeamMemberships(ctx interface{}, teamId interface{}) *MockStore_GetTeamMemberships_Call {
-	return &MockStore_GetTeamMemberships_Call{Call: _e.mock.On("GetTeamMemberships", ctx, teamId)}
+//   - organizationId uuid.UUID
+//   - calendarSettings json.RawMessage
+func (_e *MockStore_Expecter) UpdateOrganizationCalendarSettings(ctx interface{}, organizationId interface{}, calendarSettings interface{}) *MockStore_UpdateOrganizationCalendarSettings_Call {
+	return &MockStore_UpdateOrganizationCalendarSettings_Call{Call: _e.mock.On("UpdateOrganizationCalendarSettings", ctx, organizationId, calendarSettings)}
 }
 
-func (_c *MockStore_GetTeamMemberships_Call) Run(run func(ctx context.Context, teamId uuid.UUID)) *MockStore_GetTeamMemberships_Call {
+func (_c *MockStore_UpdateOrganizationCalendarSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, calendarSettings json.RawMessage)) *MockStore_UpdateOrganizationCalendarSettings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetTeamMemberships_Call) Return(_a0 []models.TeamMembership, _a1 error) *MockStore_GetTeamMemberships_Call {
+func (_c *MockStore_UpdateOrganizationCalendarSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockStore_UpdateOrganizationCalendarSettings_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetTeamMemberships_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.TeamMembership, error)) *MockStore_GetTeamMemberships_Call {
+func (_c *MockStore_UpdateOrganizationCalendarSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockStore_UpdateOrganizationCalendarSettings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTeams provides a mock function with given fields: ctx, organizationId
-func (_m *MockStore) GetTeams(ctx context.Context, organizationId uuid.UUID) ([]models.Team, error) {
-	ret := _m.Called(ctx, organizationId)
+// UpdateOrganizationExportSettings provides a mock function with given fields: ctx, organizationId, exportSettings
+func (_m *MockStore) UpdateOrganizationExportSettings(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage) (*models.Organization, error) {
+	ret := _m.Called(ctx, organizationId, exportSettings)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTeams")
+		panic("no return value specified for UpdateOrganizationExportSettings")
 	}
 
-	var r0 []models.Team
+	var r0 *models.Organization
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Team, error)); ok {
-		return rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)); ok {
+		return rf(ctx, organizationId, exportSettings)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Team); ok {
-		r0 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) *models.Organization); ok {
+		r0 = rf(ctx, organizationId, exportSettings)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Team)
+			r0 = ret.Get(0).(*models.Organization)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, organizationId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, organizationId, exportSettings)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4660,58 +10793,59 @@ func (_m *MockStore) GetTeams(ctx context.Context, organizationId uuid.UUID) ([]
 	return r0, r1
 }
 
-// MockStore_GetTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeams'
-type MockStore_GetTeams_Call struct {
+// MockStore_UpdateOrganizationExportSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationExportSettings'
+type MockStore_UpdateOrganizationExportSettings_Call struct {
 	*mock.Call
 }
 
-// GetTeams is a helper method to define mock.On call
+// UpdateOrganizationExportSettings is a helper method to define mock.On call
 //   - ctx context.Context
 //   - organizationId uuid.UUID
-func (_e *MockStore_Expecter) GetTeams(ctx interface{}, organizationId interface{}) *MockStore_GetTeams_Call {
-	return &MockStore_GetTeams_Call{Call: _e.mock.On("GetTeams", ctx, organizationId)}
+//   - exportSettings json.RawMessage
+func (_e *MockStore_Expecter) UpdateOrganizationExportSettings(ctx interface{}, organizationId interface{}, exportSettings interface{}) *MockStore_UpdateOrganizationExportSettings_Call {
+	return &MockStore_UpdateOrganizationExportSettings_Call{Call: _e.mock.On("UpdateOrganizationExportSettings", ctx, organizationId, exportSettings)}
 }
 
-func (_c *MockStore_GetTeams_Call) Run(run func(ctx context.Context, organizationId uuid.UUID)) *MockStore_GetTeams_Call {
+func (_c *MockStore_UpdateOrganizationExportSettings_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, exportSettings json.RawMessage)) *MockStore_UpdateOrganizationExportSettings_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetTeams_Call) Return(_a0 []models.Team, _a1 error) *MockStore_GetTeams_Call {
+func (_c *MockStore_UpdateOrganizationExportSettings_Call) Return(_a0 *models.Organization, _a1 error) *MockStore_UpdateOrganizationExportSettings_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetTeams_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.Team, error)) *MockStore_GetTeams_Call {
+func (_c *MockStore_UpdateOrganizationExportSettings_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (*models.Organization, error)) *MockStore_UpdateOrganizationExportSettings_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserById provides a mock function with given fields: ctx, userId
-func (_m *MockStore) GetUserById(ctx context.Context, userId string) (*models.User, error) {
-	ret := _m.Called(ctx, userId)
+// UpdateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
+func (_m *MockStore) UpdateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
+	ret := _m.Called(ctx, invitationId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserById")
+		panic("no return value specified for UpdateOrganizationInvitationStatus")
 	}
 
-	var r0 *models.User
+	var r0 *models.OrganizationInvitationStatus
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.User, error)); ok {
-		return rf(ctx, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
+		return rf(ctx, invitationId, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *models.User); ok {
-		r0 = rf(ctx, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
+		r0 = rf(ctx, invitationId, status)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.User)
+			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, userId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
+		r1 = rf(ctx, invitationId, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4719,58 +10853,59 @@ func (_m *MockStore) GetUserById(ctx context.Context, userId string) (*models.Us
 	return r0, r1
 }
 
-// MockStore_GetUserById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserById'
-type MockStore_GetUserById_Call struct {
+// MockStore_UpdateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationStatus'
+type MockStore_UpdateOrganizationInvitationStatus_Call struct {
 	*mock.Call
 }
 
-// GetUserById is a helper method to define mock.On call
+// UpdateOrganizationInvitationStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId string
-func (_e *MockStore_Expecter) GetUserById(ctx interface{}, userId interface{}) *MockStore_GetUserById_Call {
-	return &MockStore_GetUserById_Call{Call: _e.mock.On("GetUserById", ctx, userId)}
+//   - invitationId uuid.UUID
+//   - status models.InvitationStatus
+func (_e *MockStore_Expecter) UpdateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockStore_UpdateOrganizationInvitationStatus_Call {
+	return &MockStore_UpdateOrganizationInvitationStatus_Call{Call: _e.mock.On("UpdateOrganizationInvitationStatus", ctx, invitationId, status)}
 }
 
-func (_c *MockStore_GetUserById_Call) Run(run func(ctx context.Context, userId string)) *MockStore_GetUserById_Call {
+func (_c *MockStore_UpdateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockStore_UpdateOrganizationInvitationStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserById_Call) Return(_a0 *models.User, _a1 error) *MockStore_GetUserById_Call {
+func (_c *MockStore_UpdateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockStore_UpdateOrganizationInvitationStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUserById_Call) RunAndReturn(run func(context.Context, string) (*models.User, error)) *MockStore_GetUserById_Call {
+func (_c *MockStore_UpdateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockStore_UpdateOrganizationInvitationStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUsersAll provides a mock function with given fields: ctx
-func (_m *MockStore) GetUsersAll(ctx context.Context) ([]models.User, error) {
-	ret := _m.Called(ctx)
+// UpdateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId, privilege
+func (_m *MockStore) UpdateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, orgId, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUsersAll")
+		panic("no return value specified for UpdateOrganizationPolicy")
 	}
 
-	var r0 []models.User
+	var r0 *models.ResourceAudiencePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]models.User, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, orgId, audienceId, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []models.User); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, orgId, audienceId, privilege)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.User)
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, orgId, audienceId, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4778,55 +10913,60 @@ func (_m *MockStore) GetUsersAll(ctx context.Context) ([]models.User, error) {
 	return r0, r1
 }
 
-// MockStore_GetUsersAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersAll'
-type MockStore_GetUsersAll_Call struct {
+// MockStore_UpdateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationPolicy'
+type MockStore_UpdateOrganizationPolicy_Call struct {
 	*mock.Call
 }
 
-// GetUsersAll is a helper method to define mock.On call
+// UpdateOrganizationPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockStore_Expecter) GetUsersAll(ctx interface{}) *MockStore_GetUsersAll_Call {
-	return &MockStore_GetUsersAll_Call{Call: _e.mock.On("GetUsersAll", ctx)}
+//   - orgId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockStore_Expecter) UpdateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}, privilege interface{}) *MockStore_UpdateOrganizationPolicy_Call {
+	return &MockStore_UpdateOrganizationPolicy_Call{Call: _e.mock.On("UpdateOrganizationPolicy", ctx, orgId, audienceId, privilege)}
 }
 
-func (_c *MockStore_GetUsersAll_Call) Run(run func(ctx context.Context)) *MockStore_GetUsersAll_Call {
+func (_c *MockStore_UpdateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_UpdateOrganizationPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUsersAll_Call) Return(_a0 []models.User, _a1 error) *MockStore_GetUsersAll_Call {
+func (_c *MockStore_UpdateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_UpdateOrganizationPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetUsersAll_Call) RunAndReturn(run func(context.Context) ([]models.User, error)) *MockStore_GetUsersAll_Call {
+func (_c *MockStore_UpdateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_UpdateOrganizationPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWidgetInstanceByID provides a mock function with given fields: ctx, widgetInstanceID
-func (_m *MockStore) GetWidgetInstanceByID(ctx context.Context, widgetInstanceID uuid.UUID) (models.WidgetInstance, error) {
-	ret := _m.Called(ctx, widgetInstanceID)
+// UpdatePage provides a mock function with given fields: ctx, pageId, params
+func (_m *MockStore) UpdatePage(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams) (*models.Page, error) {
+	ret := _m.Called(ctx, pageId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWidgetInstanceByID")
+		panic("no return value specified for UpdatePage")
 	}
 
-	var r0 models.WidgetInstance
+	var r0 *models.Page
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.WidgetInstance, error)); ok {
-		return rf(ctx, widgetInstanceID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePageParams) (*models.Page, error)); ok {
+		return rf(ctx, pageId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.WidgetInstance); ok {
-		r0 = rf(ctx, widgetInstanceID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdatePageParams) *models.Page); ok {
+		r0 = rf(ctx, pageId, params)
 	} else {
-		r0 = ret.Get(0).(models.WidgetInstance)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Page)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, widgetInstanceID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdatePageParams) error); ok {
+		r1 = rf(ctx, pageId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4834,56 +10974,59 @@ func (_m *MockStore) GetWidgetInstanceByID(ctx context.Context, widgetInstanceID
 	return r0, r1
 }
 
-// MockStore_GetWidgetInstanceByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetInstanceByID'
-type MockStore_GetWidgetInstanceByID_Call struct {
+// MockStore_UpdatePage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePage'
+type MockStore_UpdatePage_Call struct {
 	*mock.Call
 }
 
-// GetWidgetInstanceByID is a helper method to define mock.On call
+// UpdatePage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - widgetInstanceID uuid.UUID
-func (_e *MockStore_Expecter) GetWidgetInstanceByID(ctx interface{}, widgetInstanceID interface{}) *MockStore_GetWidgetInstanceByID_Call {
-	return &MockStore_GetWidgetInstanceByID_Call{Call: _e.mock.On("GetWidgetInstanceByID", ctx, widgetInstanceID)}
+//   - pageId uuid.UUID
+//   - params models.UpdatePageParams
+func (_e *MockStore_Expecter) UpdatePage(ctx interface{}, pageId interface{}, params interface{}) *MockStore_UpdatePage_Call {
+	return &MockStore_UpdatePage_Call{Call: _e.mock.On("UpdatePage", ctx, pageId, params)}
 }
 
-func (_c *MockStore_GetWidgetInstanceByID_Call) Run(run func(ctx context.Context, widgetInstanceID uuid.UUID)) *MockStore_GetWidgetInstanceByID_Call {
+func (_c *MockStore_UpdatePage_Call) Run(run func(ctx context.Context, pageId uuid.UUID, params models.UpdatePageParams)) *MockStore_UpdatePage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdatePageParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetWidgetInstanceByID_Call) Return(_a0 models.WidgetInstance, _a1 error) *MockStore_GetWidgetInstanceByID_Call {
+func (_c *MockStore_UpdatePage_Call) Return(_a0 *models.Page, _a1 error) *MockStore_UpdatePage_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetWidgetInstanceByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.WidgetInstance, error)) *MockStore_GetWidgetInstanceByID_Call {
+func (_c *MockStore_UpdatePage_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdatePageParams) (*models.Page, error)) *MockStore_UpdatePage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWidgetTemplate provides a mock function with given fields: ctx, widgetType
-func (_m *MockStore) GetWidgetTemplate(ctx context.Context, widgetType string) (models.Widget, error) {
-	ret := _m.Called(ctx, widgetType)
+// UpdatePagePolicy provides a mock function with given fields: ctx, pageId, audienceId, privilege
+func (_m *MockStore) UpdatePagePolicy(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
+	ret := _m.Called(ctx, pageId, audienceId, privilege)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWidgetTemplate")
+		panic("no return value specified for UpdatePagePolicy")
 	}
 
-	var r0 models.Widget
+	var r0 *models.ResourceAudiencePolicy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Widget, error)); ok {
-		return rf(ctx, widgetType)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
+		return rf(ctx, pageId, audienceId, privilege)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) models.Widget); ok {
-		r0 = rf(ctx, widgetType)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
+		r0 = rf(ctx, pageId, audienceId, privilege)
 	} else {
-		r0 = ret.Get(0).(models.Widget)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, widgetType)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
+		r1 = rf(ctx, pageId, audienceId, privilege)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4891,58 +11034,58 @@ func (_m *MockStore) GetWidgetTemplate(ctx context.Context, widgetType string) (
 	return r0, r1
 }
 
-// MockStore_GetWidgetTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWidgetTemplate'
-type MockStore_GetWidgetTemplate_Call struct {
+// MockStore_UpdatePagePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePagePolicy'
+type MockStore_UpdatePagePolicy_Call struct {
 	*mock.Call
 }
 
-// GetWidgetTemplate is a helper method to define mock.On call
+// UpdatePagePolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - widgetType string
-func (_e *MockStore_Expecter) GetWidgetTemplate(ctx interface{}, widgetType interface{}) *MockStore_GetWidgetTemplate_Call {
-	return &MockStore_GetWidgetTemplate_Call{Call: _e.mock.On("GetWidgetTemplate", ctx, widgetType)}
+//   - pageId uuid.UUID
+//   - audienceId uuid.UUID
+//   - privilege models.ResourcePrivilege
+func (_e *MockStore_Expecter) UpdatePagePolicy(ctx interface{}, pageId interface{}, audienceId interface{}, privilege interface{}) *MockStore_UpdatePagePolicy_Call {
+	return &MockStore_UpdatePagePolicy_Call{Call: _e.mock.On("UpdatePagePolicy", ctx, pageId, audienceId, privilege)}
 }
 
-func (_c *MockStore_GetWidgetTemplate_Call) Run(run func(ctx context.Context, widgetType string)) *MockStore_GetWidgetTemplate_Call {
+func (_c *MockStore_UpdatePagePolicy_Call) Run(run func(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_UpdatePagePolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
 	})
 	return _c
 }
 
-func (_c *MockStore_GetWidgetTemplate_Call) Return(_a0 models.Widget, _a1 error) *MockStore_GetWidgetTemplate_Call {
+func (_c *MockStore_UpdatePagePolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_UpdatePagePolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_GetWidgetTemplate_Call) RunAndReturn(run func(context.Context, string) (models.Widget, error)) *MockStore_GetWidgetTemplate_Call {
+func (_c *MockStore_UpdatePagePolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_UpdatePagePolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateConnectionPolicy provides a mock function with given fields: ctx, connectionId, audienceId, privilege
-func (_m *MockStore) UpdateConnectionPolicy(ctx context.Context, connectionId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, connectionId, audienceId, privilege)
+// UpdatePaymentsConfig provides a mock function with given fields: ctx, paymentsConfigId, config
+func (_m *MockStore) UpdatePaymentsConfig(ctx context.Context, paymentsConfigId uuid.UUID, config json.RawMessage) (models.PaymentsConfig, error) {
+	ret := _m.Called(ctx, paymentsConfigId, config)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateConnectionPolicy")
+		panic("no return value specified for UpdatePaymentsConfig")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
+	var r0 models.PaymentsConfig
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, connectionId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (models.PaymentsConfig, error)); ok {
+		return rf(ctx, paymentsConfigId, config)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, connectionId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) models.PaymentsConfig); ok {
+		r0 = rf(ctx, paymentsConfigId, config)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Get(0).(models.PaymentsConfig)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, connectionId, audienceId, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
+		r1 = rf(ctx, paymentsConfigId, config)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -4950,60 +11093,57 @@ func (_m *MockStore) UpdateConnectionPolicy(ctx context.Context, connectionId uu
 	return r0, r1
 }
 
-// MockStore_UpdateConnectionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConnectionPolicy'
-type MockStore_UpdateConnectionPolicy_Call struct {
+// MockStore_UpdatePaymentsConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePaymentsConfig'
+type MockStore_UpdatePaymentsConfig_Call struct {
 	*mock.Call
 }
 
-// UpdateConnectionPolicy is a helper method to define mock.On call
+// UpdatePaymentsConfig is a helper method to define mock.On call
 //   - ctx context.Context
-//   - connectionId uuid.UUID
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockStore_Expecter) UpdateConnectionPolicy(ctx interface{}, connectionId interface{}, audienceId interface{}, privilege interface{}) *MockStore_UpdateConnectionPolicy_Call {
-	return &MockStore_UpdateConnectionPolicy_Call{Call: _e.mock.On("UpdateConnectionPolicy", ctx, connectionId, audienceId, privilege)}
+//   - paymentsConfigId uuid.UUID
+//   - config json.RawMessage
+func (_e *MockStore_Expecter) UpdatePaymentsConfig(ctx interface{}, paymentsConfigId interface{}, config interface{}) *MockStore_UpdatePaymentsConfig_Call {
+	return &MockStore_UpdatePaymentsConfig_Call{Call: _e.mock.On("UpdatePaymentsConfig", ctx, paymentsConfigId, config)}
 }
 
-func (_c *MockStore_UpdateConnectionPolicy_Call) Run(run func(ctx context.Context, connectionId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_UpdateConnectionPolicy_Call {
+func (_c *MockStore_UpdatePaymentsConfig_Call) Run(run func(ctx context.Context, paymentsConfigId uuid.UUID, config json.RawMessage)) *MockStore_UpdatePaymentsConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateConnectionPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_UpdateConnectionPolicy_Call {
+func (_c *MockStore_UpdatePaymentsConfig_Call) Return(_a0 models.PaymentsConfig, _a1 error) *MockStore_UpdatePaymentsConfig_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateConnectionPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_UpdateConnectionPolicy_Call {
+func (_c *MockStore_UpdatePaymentsConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (models.PaymentsConfig, error)) *MockStore_UpdatePaymentsConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDataset provides a mock function with given fields: ctx, dataset
-func (_m *MockStore) UpdateDataset(ctx context.Context, dataset models.Dataset) (uuid.UUID, error) {
-	ret := _m.Called(ctx, dataset)
+// UpdatePaymentsConfigStatus provides a mock function with given fields: ctx, paymentsConfigId, status
+func (_m *MockStore) UpdatePaymentsConfigStatus(ctx context.Context, paymentsConfigId uuid.UUID, status models.PaymentsConfigStatus) (models.PaymentsConfig, error) {
+	ret := _m.Called(ctx, paymentsConfigId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDataset")
+		panic("no return value specified for UpdatePaymentsConfigStatus")
 	}
 
-	var r0 uuid.UUID
+	var r0 models.PaymentsConfig
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) (uuid.UUID, error)); ok {
-		return rf(ctx, dataset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.PaymentsConfigStatus) (models.PaymentsConfig, error)); ok {
+		return rf(ctx, paymentsConfigId, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.Dataset) uuid.UUID); ok {
-		r0 = rf(ctx, dataset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.PaymentsConfigStatus) models.PaymentsConfig); ok {
+		r0 = rf(ctx, paymentsConfigId, status)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
-		}
+		r0 = ret.Get(0).(models.PaymentsConfig)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.Dataset) error); ok {
-		r1 = rf(ctx, dataset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.PaymentsConfigStatus) error); ok {
+		r1 = rf(ctx, paymentsConfigId, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5011,396 +11151,371 @@ func (_m *MockStore) UpdateDataset(ctx context.Context, dataset models.Dataset)
 	return r0, r1
 }
 
-// MockStore_UpdateDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDataset'
-type MockStore_UpdateDataset_Call struct {
+// MockStore_UpdatePaymentsConfigStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePaymentsConfigStatus'
+type MockStore_UpdatePaymentsConfigStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateDataset is a helper method to define mock.On call
+// UpdatePaymentsConfigStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dataset models.Dataset
-func (_e *MockStore_Expecter) UpdateDataset(ctx interface{}, dataset interface{}) *MockStore_UpdateDataset_Call {
-	return &MockStore_UpdateDataset_Call{Call: _e.mock.On("UpdateDataset", ctx, dataset)}
+//   - paymentsConfigId uuid.UUID
+//   - status models.PaymentsConfigStatus
+func (_e *MockStore_Expecter) UpdatePaymentsConfigStatus(ctx interface{}, paymentsConfigId interface{}, status interface{}) *MockStore_UpdatePaymentsConfigStatus_Call {
+	return &MockStore_UpdatePaymentsConfigStatus_Call{Call: _e.mock.On("UpdatePaymentsConfigStatus", ctx, paymentsConfigId, status)}
 }
 
-func (_c *MockStore_UpdateDataset_Call) Run(run func(ctx context.Context, dataset models.Dataset)) *MockStore_UpdateDataset_Call {
+func (_c *MockStore_UpdatePaymentsConfigStatus_Call) Run(run func(ctx context.Context, paymentsConfigId uuid.UUID, status models.PaymentsConfigStatus)) *MockStore_UpdatePaymentsConfigStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.Dataset))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.PaymentsConfigStatus))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateDataset_Call) Return(_a0 uuid.UUID, _a1 error) *MockStore_UpdateDataset_Call {
+func (_c *MockStore_UpdatePaymentsConfigStatus_Call) Return(_a0 models.PaymentsConfig, _a1 error) *MockStore_UpdatePaymentsConfigStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateDataset_Call) RunAndReturn(run func(context.Context, models.Dataset) (uuid.UUID, error)) *MockStore_UpdateDataset_Call {
+func (_c *MockStore_UpdatePaymentsConfigStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.PaymentsConfigStatus) (models.PaymentsConfig, error)) *MockStore_UpdatePaymentsConfigStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetActionConfig provides a mock function with given fields: ctx, actionId, config
-func (_m *MockStore) UpdateDatasetActionConfig(ctx context.Context, actionId string, config map[string]interface{}) error {
-	ret := _m.Called(ctx, actionId, config)
+// UpdatePendingOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
+func (_m *MockStore) UpdatePendingOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
+	ret := _m.Called(ctx, organizationId, userId, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetActionConfig")
+		panic("no return value specified for UpdatePendingOrganizationMembershipRequest")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}) error); ok {
-		r0 = rf(ctx, actionId, config)
+	var r0 *models.OrganizationMembershipRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
+		return rf(ctx, organizationId, userId, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
+		r0 = rf(ctx, organizationId, userId, status)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
+		r1 = rf(ctx, organizationId, userId, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_UpdateDatasetActionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionConfig'
-type MockStore_UpdateDatasetActionConfig_Call struct {
+// MockStore_UpdatePendingOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePendingOrganizationMembershipRequest'
+type MockStore_UpdatePendingOrganizationMembershipRequest_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetActionConfig is a helper method to define mock.On call
+// UpdatePendingOrganizationMembershipRequest is a helper method to define mock.On call
 //   - ctx context.Context
-//   - actionId string
-//   - config map[string]interface{}
-func (_e *MockStore_Expecter) UpdateDatasetActionConfig(ctx interface{}, actionId interface{}, config interface{}) *MockStore_UpdateDatasetActionConfig_Call {
-	return &MockStore_UpdateDatasetActionConfig_Call{Call: _e.mock.On("UpdateDatasetActionConfig", ctx, actionId, config)}
+//   - organizationId uuid.UUID
+//   - userId uuid.UUID
+//   - status models.OrgMembershipStatus
+func (_e *MockStore_Expecter) UpdatePendingOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockStore_UpdatePendingOrganizationMembershipRequest_Call {
+	return &MockStore_UpdatePendingOrganizationMembershipRequest_Call{Call: _e.mock.On("UpdatePendingOrganizationMembershipRequest", ctx, organizationId, userId, status)}
 }
 
-func (_c *MockStore_UpdateDatasetActionConfig_Call) Run(run func(ctx context.Context, actionId string, config map[string]interface{})) *MockStore_UpdateDatasetActionConfig_Call {
+func (_c *MockStore_UpdatePendingOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockStore_UpdatePendingOrganizationMembershipRequest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(map[string]interface{}))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateDatasetActionConfig_Call) Return(_a0 error) *MockStore_UpdateDatasetActionConfig_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_UpdatePendingOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockStore_UpdatePendingOrganizationMembershipRequest_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateDatasetActionConfig_Call) RunAndReturn(run func(context.Context, string, map[string]interface{}) error) *MockStore_UpdateDatasetActionConfig_Call {
+func (_c *MockStore_UpdatePendingOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockStore_UpdatePendingOrganizationMembershipRequest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetActionStatus provides a mock function with given fields: ctx, actionId, status
-func (_m *MockStore) UpdateDatasetActionStatus(ctx context.Context, actionId string, status string) error {
-	ret := _m.Called(ctx, actionId, status)
+// UpdateReconciliation provides a mock function with given fields: ctx, reconciliationId, params
+func (_m *MockStore) UpdateReconciliation(ctx context.Context, reconciliationId uuid.UUID, params models.UpdateReconciliationParams) (models.Reconciliation, error) {
+	ret := _m.Called(ctx, reconciliationId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetActionStatus")
+		panic("no return value specified for UpdateReconciliation")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, actionId, status)
+	var r0 models.Reconciliation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateReconciliationParams) (models.Reconciliation, error)); ok {
+		return rf(ctx, reconciliationId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateReconciliationParams) models.Reconciliation); ok {
+		r0 = rf(ctx, reconciliationId, params)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.Reconciliation)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateReconciliationParams) error); ok {
+		r1 = rf(ctx, reconciliationId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockStore_UpdateDatasetActionStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetActionStatus'
-type MockStore_UpdateDatasetActionStatus_Call struct {
+// MockStore_UpdateReconciliation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliation'
+type MockStore_UpdateReconciliation_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetActionStatus is a helper method to define mock.On call
+// UpdateReconciliation is a helper method to define mock.On call
 //   - ctx context.Context
-//   - actionId string
-//   - status string
-func (_e *MockStore_Expecter) UpdateDatasetActionStatus(ctx interface{}, actionId interface{}, status interface{}) *MockStore_UpdateDatasetActionStatus_Call {
-	return &MockStore_UpdateDatasetActionStatus_Call{Call: _e.mock.On("UpdateDatasetActionStatus", ctx, actionId, status)}
+//   - reconciliationId uuid.UUID
+//   - params models.UpdateReconciliationParams
+func (_e *MockStore_Expecter) UpdateReconciliation(ctx interface{}, reconciliationId interface{}, params interface{}) *MockStore_UpdateReconciliation_Call {
+	return &MockStore_UpdateReconciliation_Call{Call: _e.mock.On("UpdateReconciliation", ctx, reconciliationId, params)}
 }
 
-func (_c *MockStore_UpdateDatasetActionStatus_Call) Run(run func(ctx context.Context, actionId string, status string)) *MockStore_UpdateDatasetActionStatus_Call {
+func (_c *MockStore_UpdateReconciliation_Call) Run(run func(ctx context.Context, reconciliationId uuid.UUID, params models.UpdateReconciliationParams)) *MockStore_UpdateReconciliation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateReconciliationParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateDatasetActionStatus_Call) Return(_a0 error) *MockStore_UpdateDatasetActionStatus_Call {
-	_c.Call.Return(_a0)
+func (_c *MockStore_UpdateReconciliation_Call) Return(_a0 models.Reconciliation, _a1 error) *MockStore_UpdateReconciliation_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateDatasetActionStatus_Call) RunAndReturn(run func(context.Context, string, string) error) *MockStore_UpdateDatasetActionStatus_Call {
+func (_c *MockStore_UpdateReconciliation_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateReconciliationParams) (models.Reconciliation, error)) *MockStore_UpdateReconciliation_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetFileUploadStatus provides a mock function with given fields: ctx, id, fileAllignmentStatus, metadata
-func (_m *MockStore) UpdateDatasetFileUploadStatus(ctx context.Context, id uuid.UUID, fileAllignmentStatus models.DatasetFileAllignmentStatus, metadata models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error) {
-	ret := _m.Called(ctx, id, fileAllignmentStatus, metadata)
+// UpdateReconciliationMatchStatus provides a mock function with given fields: ctx, runId, matchId, status, updatedBy
+func (_m *MockStore) UpdateReconciliationMatchStatus(ctx context.Context, runId uuid.UUID, matchId uuid.UUID, status string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, runId, matchId, status, updatedBy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetFileUploadStatus")
-	}
-
-	var r0 *models.DatasetFileUpload
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error)); ok {
-		return rf(ctx, id, fileAllignmentStatus, metadata)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) *models.DatasetFileUpload); ok {
-		r0 = rf(ctx, id, fileAllignmentStatus, metadata)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.DatasetFileUpload)
-		}
+		panic("no return value specified for UpdateReconciliationMatchStatus")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) error); ok {
-		r1 = rf(ctx, id, fileAllignmentStatus, metadata)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, runId, matchId, status, updatedBy)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateDatasetFileUploadStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetFileUploadStatus'
-type MockStore_UpdateDatasetFileUploadStatus_Call struct {
+// MockStore_UpdateReconciliationMatchStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliationMatchStatus'
+type MockStore_UpdateReconciliationMatchStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetFileUploadStatus is a helper method to define mock.On call
+// UpdateReconciliationMatchStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id uuid.UUID
-//   - fileAllignmentStatus models.DatasetFileAllignmentStatus
-//   - metadata models.DatasetFileUploadMetadata
-func (_e *MockStore_Expecter) UpdateDatasetFileUploadStatus(ctx interface{}, id interface{}, fileAllignmentStatus interface{}, metadata interface{}) *MockStore_UpdateDatasetFileUploadStatus_Call {
-	return &MockStore_UpdateDatasetFileUploadStatus_Call{Call: _e.mock.On("UpdateDatasetFileUploadStatus", ctx, id, fileAllignmentStatus, metadata)}
+//   - runId uuid.UUID
+//   - matchId uuid.UUID
+//   - status string
+//   - updatedBy uuid.UUID
+func (_e *MockStore_Expecter) UpdateReconciliationMatchStatus(ctx interface{}, runId interface{}, matchId interface{}, status interface{}, updatedBy interface{}) *MockStore_UpdateReconciliationMatchStatus_Call {
+	return &MockStore_UpdateReconciliationMatchStatus_Call{Call: _e.mock.On("UpdateReconciliationMatchStatus", ctx, runId, matchId, status, updatedBy)}
 }
 
-func (_c *MockStore_UpdateDatasetFileUploadStatus_Call) Run(run func(ctx context.Context, id uuid.UUID, fileAllignmentStatus models.DatasetFileAllignmentStatus, metadata models.DatasetFileUploadMetadata)) *MockStore_UpdateDatasetFileUploadStatus_Call {
+func (_c *MockStore_UpdateReconciliationMatchStatus_Call) Run(run func(ctx context.Context, runId uuid.UUID, matchId uuid.UUID, status string, updatedBy uuid.UUID)) *MockStore_UpdateReconciliationMatchStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.DatasetFileAllignmentStatus), args[3].(models.DatasetFileUploadMetadata))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string), args[4].(uuid.UUID))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateDatasetFileUploadStatus_Call) Return(_a0 *models.DatasetFileUpload, _a1 error) *MockStore_UpdateDatasetFileUploadStatus_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateReconciliationMatchStatus_Call) Return(_a0 error) *MockStore_UpdateReconciliationMatchStatus_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateDatasetFileUploadStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.DatasetFileAllignmentStatus, models.DatasetFileUploadMetadata) (*models.DatasetFileUpload, error)) *MockStore_UpdateDatasetFileUploadStatus_Call {
+func (_c *MockStore_UpdateReconciliationMatchStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string, uuid.UUID) error) *MockStore_UpdateReconciliationMatchStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDatasetPolicy provides a mock function with given fields: ctx, datasetId, audienceId, privilege
-func (_m *MockStore) UpdateDatasetPolicy(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, datasetId, audienceId, privilege)
+// UpdateReconciliationRunWorkflowId provides a mock function with given fields: ctx, runId, workflowId
+func (_m *MockStore) UpdateReconciliationRunWorkflowId(ctx context.Context, runId uuid.UUID, workflowId string) error {
+	ret := _m.Called(ctx, runId, workflowId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDatasetPolicy")
-	}
-
-	var r0 *models.ResourceAudiencePolicy
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, datasetId, audienceId, privilege)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, datasetId, audienceId, privilege)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
-		}
+		panic("no return value specified for UpdateReconciliationRunWorkflowId")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, datasetId, audienceId, privilege)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, runId, workflowId)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateDatasetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDatasetPolicy'
-type MockStore_UpdateDatasetPolicy_Call struct {
+// MockStore_UpdateReconciliationRunWorkflowId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReconciliationRunWorkflowId'
+type MockStore_UpdateReconciliationRunWorkflowId_Call struct {
 	*mock.Call
 }
 
-// UpdateDatasetPolicy is a helper method to define mock.On call
+// UpdateReconciliationRunWorkflowId is a helper method to define mock.On call
 //   - ctx context.Context
-//   - datasetId uuid.UUID
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockStore_Expecter) UpdateDatasetPolicy(ctx interface{}, datasetId interface{}, audienceId interface{}, privilege interface{}) *MockStore_UpdateDatasetPolicy_Call {
-	return &MockStore_UpdateDatasetPolicy_Call{Call: _e.mock.On("UpdateDatasetPolicy", ctx, datasetId, audienceId, privilege)}
+//   - runId uuid.UUID
+//   - workflowId string
+func (_e *MockStore_Expecter) UpdateReconciliationRunWorkflowId(ctx interface{}, runId interface{}, workflowId interface{}) *MockStore_UpdateReconciliationRunWorkflowId_Call {
+	return &MockStore_UpdateReconciliationRunWorkflowId_Call{Call: _e.mock.On("UpdateReconciliationRunWorkflowId", ctx, runId, workflowId)}
 }
 
-func (_c *MockStore_UpdateDatasetPolicy_Call) Run(run func(ctx context.Context, datasetId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_UpdateDatasetPolicy_Call {
+func (_c *MockStore_UpdateReconciliationRunWorkflowId_Call) Run(run func(ctx context.Context, runId uuid.UUID, workflowId string)) *MockStore_UpdateReconciliationRunWorkflowId_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateDatasetPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_UpdateDatasetPolicy_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateReconciliationRunWorkflowId_Call) Return(_a0 error) *MockStore_UpdateReconciliationRunWorkflowId_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateDatasetPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_UpdateDatasetPolicy_Call {
+func (_c *MockStore_UpdateReconciliationRunWorkflowId_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *MockStore_UpdateReconciliationRunWorkflowId_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateFileUploadStatus provides a mock function with given fields: ctx, fileUploadId, status
-func (_m *MockStore) UpdateFileUploadStatus(ctx context.Context, fileUploadId uuid.UUID, status models.FileUploadStatus) (*models.FileUpload, error) {
-	ret := _m.Called(ctx, fileUploadId, status)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UpdateFileUploadStatus")
-	}
+// UpdateRule provides a mock function with given fields: ctx, ruleId, params
+func (_m *MockStore) UpdateRule(ctx context.Context, ruleId uuid.UUID, params models.UpdateRuleParams) error {
+	ret := _m.Called(ctx, ruleId, params)
 
-	var r0 *models.FileUpload
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.FileUploadStatus) (*models.FileUpload, error)); ok {
-		return rf(ctx, fileUploadId, status)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.FileUploadStatus) *models.FileUpload); ok {
-		r0 = rf(ctx, fileUploadId, status)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.FileUpload)
-		}
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRule")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.FileUploadStatus) error); ok {
-		r1 = rf(ctx, fileUploadId, status)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateRuleParams) error); ok {
+		r0 = rf(ctx, ruleId, params)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateFileUploadStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFileUploadStatus'
-type MockStore_UpdateFileUploadStatus_Call struct {
+// MockStore_UpdateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRule'
+type MockStore_UpdateRule_Call struct {
 	*mock.Call
 }
 
-// UpdateFileUploadStatus is a helper method to define mock.On call
+// UpdateRule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fileUploadId uuid.UUID
-//   - status models.FileUploadStatus
-func (_e *MockStore_Expecter) UpdateFileUploadStatus(ctx interface{}, fileUploadId interface{}, status interface{}) *MockStore_UpdateFileUploadStatus_Call {
-	return &MockStore_UpdateFileUploadStatus_Call{Call: _e.mock.On("UpdateFileUploadStatus", ctx, fileUploadId, status)}
+//   - ruleId uuid.UUID
+//   - params models.UpdateRuleParams
+func (_e *MockStore_Expecter) UpdateRule(ctx interface{}, ruleId interface{}, params interface{}) *MockStore_UpdateRule_Call {
+	return &MockStore_UpdateRule_Call{Call: _e.mock.On("UpdateRule", ctx, ruleId, params)}
 }
 
-func (_c *MockStore_UpdateFileUploadStatus_Call) Run(run func(ctx context.Context, fileUploadId uuid.UUID, status models.FileUploadStatus)) *MockStore_UpdateFileUploadStatus_Call {
+func (_c *MockStore_UpdateRule_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, params models.UpdateRuleParams)) *MockStore_UpdateRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.FileUploadStatus))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateRuleParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateFileUploadStatus_Call) Return(_a0 *models.FileUpload, _a1 error) *MockStore_UpdateFileUploadStatus_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateRule_Call) Return(_a0 error) *MockStore_UpdateRule_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateFileUploadStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.FileUploadStatus) (*models.FileUpload, error)) *MockStore_UpdateFileUploadStatus_Call {
+func (_c *MockStore_UpdateRule_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateRuleParams) error) *MockStore_UpdateRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateOrganizationInvitationStatus provides a mock function with given fields: ctx, invitationId, status
-func (_m *MockStore) UpdateOrganizationInvitationStatus(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus) (*models.OrganizationInvitationStatus, error) {
-	ret := _m.Called(ctx, invitationId, status)
+// UpdateRulePriority provides a mock function with given fields: ctx, params
+func (_m *MockStore) UpdateRulePriority(ctx context.Context, params models.UpdateRulePriorityParams) error {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateOrganizationInvitationStatus")
-	}
-
-	var r0 *models.OrganizationInvitationStatus
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)); ok {
-		return rf(ctx, invitationId, status)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.InvitationStatus) *models.OrganizationInvitationStatus); ok {
-		r0 = rf(ctx, invitationId, status)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.OrganizationInvitationStatus)
-		}
+		panic("no return value specified for UpdateRulePriority")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.InvitationStatus) error); ok {
-		r1 = rf(ctx, invitationId, status)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.UpdateRulePriorityParams) error); ok {
+		r0 = rf(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateOrganizationInvitationStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationInvitationStatus'
-type MockStore_UpdateOrganizationInvitationStatus_Call struct {
+// MockStore_UpdateRulePriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRulePriority'
+type MockStore_UpdateRulePriority_Call struct {
 	*mock.Call
 }
 
-// UpdateOrganizationInvitationStatus is a helper method to define mock.On call
+// UpdateRulePriority is a helper method to define mock.On call
 //   - ctx context.Context
-//   - invitationId uuid.UUID
-//   - status models.InvitationStatus
-func (_e *MockStore_Expecter) UpdateOrganizationInvitationStatus(ctx interface{}, invitationId interface{}, status interface{}) *MockStore_UpdateOrganizationInvitationStatus_Call {
-	return &MockStore_UpdateOrganizationInvitationStatus_Call{Call: _e.mock.On("UpdateOrganizationInvitationStatus", ctx, invitationId, status)}
+//   - params models.UpdateRulePriorityParams
+func (_e *MockStore_Expecter) UpdateRulePriority(ctx interface{}, params interface{}) *MockStore_UpdateRulePriority_Call {
+	return &MockStore_UpdateRulePriority_Call{Call: _e.mock.On("UpdateRulePriority", ctx, params)}
 }
 
-func (_c *MockStore_UpdateOrganizationInvitationStatus_Call) Run(run func(ctx context.Context, invitationId uuid.UUID, status models.InvitationStatus)) *MockStore_UpdateOrganizationInvitationStatus_Call {
+func (_c *MockStore_UpdateRulePriority_Call) Run(run func(ctx context.Context, params models.UpdateRulePriorityParams)) *MockStore_UpdateRulePriority_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.InvitationStatus))
+		run(args[0].(context.Context), args[1].(models.UpdateRulePriorityParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateOrganizationInvitationStatus_Call) Return(_a0 *models.OrganizationInvitationStatus, _a1 error) *MockStore_UpdateOrganizationInvitationStatus_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_UpdateRulePriority_Call) Return(_a0 error) *MockStore_UpdateRulePriority_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateOrganizationInvitationStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.InvitationStatus) (*models.OrganizationInvitationStatus, error)) *MockStore_UpdateOrganizationInvitationStatus_Call {
+func (_c *MockStore_UpdateRulePriority_Call) RunAndReturn(run func(context.Context, models.UpdateRulePriorityParams) error) *MockStore_UpdateRulePriority_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateOrganizationPolicy provides a mock function with given fields: ctx, orgId, audienceId, privilege
-func (_m *MockStore) UpdateOrganizationPolicy(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, orgId, audienceId, privilege)
+// UpdateSheet provides a mock function with given fields: ctx, sheet
+func (_m *MockStore) UpdateSheet(ctx context.Context, sheet *models.Sheet) (*models.Sheet, error) {
+	ret := _m.Called(ctx, sheet)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateOrganizationPolicy")
+		panic("no return value specified for UpdateSheet")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
+	var r0 *models.Sheet
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, orgId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) (*models.Sheet, error)); ok {
+		return rf(ctx, sheet)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, orgId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) *models.Sheet); ok {
+		r0 = rf(ctx, sheet)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
+			r0 = ret.Get(0).(*models.Sheet)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, orgId, audienceId, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Sheet) error); ok {
+		r1 = rf(ctx, sheet)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5408,60 +11523,56 @@ func (_m *MockStore) UpdateOrganizationPolicy(ctx context.Context, orgId uuid.UU
 	return r0, r1
 }
 
-// MockStore_UpdateOrganizationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOrganizationPolicy'
-type MockStore_UpdateOrganizationPolicy_Call struct {
+// MockStore_UpdateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSheet'
+type MockStore_UpdateSheet_Call struct {
 	*mock.Call
 }
 
-// UpdateOrganizationPolicy is a helper method to define mock.On call
+// UpdateSheet is a helper method to define mock.On call
 //   - ctx context.Context
-//   - orgId uuid.UUID
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockStore_Expecter) UpdateOrganizationPolicy(ctx interface{}, orgId interface{}, audienceId interface{}, privilege interface{}) *MockStore_UpdateOrganizationPolicy_Call {
-	return &MockStore_UpdateOrganizationPolicy_Call{Call: _e.mock.On("UpdateOrganizationPolicy", ctx, orgId, audienceId, privilege)}
+//   - sheet *models.Sheet
+func (_e *MockStore_Expecter) UpdateSheet(ctx interface{}, sheet interface{}) *MockStore_UpdateSheet_Call {
+	return &MockStore_UpdateSheet_Call{Call: _e.mock.On("UpdateSheet", ctx, sheet)}
 }
 
-func (_c *MockStore_UpdateOrganizationPolicy_Call) Run(run func(ctx context.Context, orgId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_UpdateOrganizationPolicy_Call {
+func (_c *MockStore_UpdateSheet_Call) Run(run func(ctx context.Context, sheet *models.Sheet)) *MockStore_UpdateSheet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(*models.Sheet))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateOrganizationPolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_UpdateOrganizationPolicy_Call {
+func (_c *MockStore_UpdateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockStore_UpdateSheet_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdateOrganizationPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_UpdateOrganizationPolicy_Call {
+func (_c *MockStore_UpdateSheet_Call) RunAndReturn(run func(context.Context, *models.Sheet) (*models.Sheet, error)) *MockStore_UpdateSheet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePagePolicy provides a mock function with given fields: ctx, pageId, audienceId, privilege
-func (_m *MockStore) UpdatePagePolicy(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error) {
-	ret := _m.Called(ctx, pageId, audienceId, privilege)
+// UpdateTag provides a mock function with given fields: ctx, tagId, params
+func (_m *MockStore) UpdateTag(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams) (models.Tag, error) {
+	ret := _m.Called(ctx, tagId, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePagePolicy")
+		panic("no return value specified for UpdateTag")
 	}
 
-	var r0 *models.ResourceAudiencePolicy
+	var r0 models.Tag
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)); ok {
-		return rf(ctx, pageId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateTagParams) (models.Tag, error)); ok {
+		return rf(ctx, tagId, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) *models.ResourceAudiencePolicy); ok {
-		r0 = rf(ctx, pageId, audienceId, privilege)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateTagParams) models.Tag); ok {
+		r0 = rf(ctx, tagId, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.ResourceAudiencePolicy)
-		}
+		r0 = ret.Get(0).(models.Tag)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) error); ok {
-		r1 = rf(ctx, pageId, audienceId, privilege)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateTagParams) error); ok {
+		r1 = rf(ctx, tagId, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5469,58 +11580,59 @@ func (_m *MockStore) UpdatePagePolicy(ctx context.Context, pageId uuid.UUID, aud
 	return r0, r1
 }
 
-// MockStore_UpdatePagePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePagePolicy'
-type MockStore_UpdatePagePolicy_Call struct {
+// MockStore_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type MockStore_UpdateTag_Call struct {
 	*mock.Call
 }
 
-// UpdatePagePolicy is a helper method to define mock.On call
+// UpdateTag is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pageId uuid.UUID
-//   - audienceId uuid.UUID
-//   - privilege models.ResourcePrivilege
-func (_e *MockStore_Expecter) UpdatePagePolicy(ctx interface{}, pageId interface{}, audienceId interface{}, privilege interface{}) *MockStore_UpdatePagePolicy_Call {
-	return &MockStore_UpdatePagePolicy_Call{Call: _e.mock.On("UpdatePagePolicy", ctx, pageId, audienceId, privilege)}
+//   - tagId uuid.UUID
+//   - params models.UpdateTagParams
+func (_e *MockStore_Expecter) UpdateTag(ctx interface{}, tagId interface{}, params interface{}) *MockStore_UpdateTag_Call {
+	return &MockStore_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, tagId, params)}
 }
 
-func (_c *MockStore_UpdatePagePolicy_Call) Run(run func(ctx context.Context, pageId uuid.UUID, audienceId uuid.UUID, privilege models.ResourcePrivilege)) *MockStore_UpdatePagePolicy_Call {
+func (_c *MockStore_UpdateTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams)) *MockStore_UpdateTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.ResourcePrivilege))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateTagParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdatePagePolicy_Call) Return(_a0 *models.ResourceAudiencePolicy, _a1 error) *MockStore_UpdatePagePolicy_Call {
+func (_c *MockStore_UpdateTag_Call) Return(_a0 models.Tag, _a1 error) *MockStore_UpdateTag_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdatePagePolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.ResourcePrivilege) (*models.ResourceAudiencePolicy, error)) *MockStore_UpdatePagePolicy_Call {
+func (_c *MockStore_UpdateTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateTagParams) (models.Tag, error)) *MockStore_UpdateTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePaymentsConfig provides a mock function with given fields: ctx, paymentsConfigId, config
-func (_m *MockStore) UpdatePaymentsConfig(ctx context.Context, paymentsConfigId uuid.UUID, config json.RawMessage) (models.PaymentsConfig, error) {
-	ret := _m.Called(ctx, paymentsConfigId, config)
+// UpdateTeam provides a mock function with given fields: ctx, organizationId, team
+func (_m *MockStore) UpdateTeam(ctx context.Context, organizationId uuid.UUID, team models.Team) (*models.Team, error) {
+	ret := _m.Called(ctx, organizationId, team)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePaymentsConfig")
+		panic("no return value specified for UpdateTeam")
 	}
 
-	var r0 models.PaymentsConfig
+	var r0 *models.Team
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) (models.PaymentsConfig, error)); ok {
-		return rf(ctx, paymentsConfigId, config)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) (*models.Team, error)); ok {
+		return rf(ctx, organizationId, team)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, json.RawMessage) models.PaymentsConfig); ok {
-		r0 = rf(ctx, paymentsConfigId, config)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) *models.Team); ok {
+		r0 = rf(ctx, organizationId, team)
 	} else {
-		r0 = ret.Get(0).(models.PaymentsConfig)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Team)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, json.RawMessage) error); ok {
-		r1 = rf(ctx, paymentsConfigId, config)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.Team) error); ok {
+		r1 = rf(ctx, organizationId, team)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5528,57 +11640,59 @@ func (_m *MockStore) UpdatePaymentsConfig(ctx context.Context, paymentsConfigId
 	return r0, r1
 }
 
-// MockStore_UpdatePaymentsConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePaymentsConfig'
-type MockStore_UpdatePaymentsConfig_Call struct {
+// MockStore_UpdateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeam'
+type MockStore_UpdateTeam_Call struct {
 	*mock.Call
 }
 
-// UpdatePaymentsConfig is a helper method to define mock.On call
+// UpdateTeam is a helper method to define mock.On call
 //   - ctx context.Context
-//   - paymentsConfigId uuid.UUID
-//   - config json.RawMessage
-func (_e *MockStore_Expecter) UpdatePaymentsConfig(ctx interface{}, paymentsConfigId interface{}, config interface{}) *MockStore_UpdatePaymentsConfig_Call {
-	return &MockStore_UpdatePaymentsConfig_Call{Call: _e.mock.On("UpdatePaymentsConfig", ctx, paymentsConfigId, config)}
+//   - organizationId uuid.UUID
+//   - team models.Team
+func (_e *MockStore_Expecter) UpdateTeam(ctx interface{}, organizationId interface{}, team interface{}) *MockStore_UpdateTeam_Call {
+	return &MockStore_UpdateTeam_Call{Call: _e.mock.On("UpdateTeam", ctx, organizationId, team)}
 }
 
-func (_c *MockStore_UpdatePaymentsConfig_Call) Run(run func(ctx context.Context, paymentsConfigId uuid.UUID, config json.RawMessage)) *MockStore_UpdatePaymentsConfig_Call {
+func (_c *MockStore_UpdateTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, team models.Team)) *MockStore_UpdateTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(json.RawMessage))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.Team))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdatePaymentsConfig_Call) Return(_a0 models.PaymentsConfig, _a1 error) *MockStore_UpdatePaymentsConfig_Call {
+func (_c *MockStore_UpdateTeam_Call) Return(_a0 *models.Team, _a1 error) *MockStore_UpdateTeam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdatePaymentsConfig_Call) RunAndReturn(run func(context.Context, uuid.UUID, json.RawMessage) (models.PaymentsConfig, error)) *MockStore_UpdatePaymentsConfig_Call {
+func (_c *MockStore_UpdateTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.Team) (*models.Team, error)) *MockStore_UpdateTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePaymentsConfigStatus provides a mock function with given fields: ctx, paymentsConfigId, status
-func (_m *MockStore) UpdatePaymentsConfigStatus(ctx context.Context, paymentsConfigId uuid.UUID, status models.PaymentsConfigStatus) (models.PaymentsConfig, error) {
-	ret := _m.Called(ctx, paymentsConfigId, status)
+// UpdateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
+func (_m *MockStore) UpdateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
+	ret := _m.Called(ctx, widgetInstance)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePaymentsConfigStatus")
+		panic("no return value specified for UpdateWidgetInstance")
 	}
 
-	var r0 models.PaymentsConfig
+	var r0 *models.WidgetInstance
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.PaymentsConfigStatus) (models.PaymentsConfig, error)); ok {
-		return rf(ctx, paymentsConfigId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
+		return rf(ctx, widgetInstance)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.PaymentsConfigStatus) models.PaymentsConfig); ok {
-		r0 = rf(ctx, paymentsConfigId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
+		r0 = rf(ctx, widgetInstance)
 	} else {
-		r0 = ret.Get(0).(models.PaymentsConfig)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WidgetInstance)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.PaymentsConfigStatus) error); ok {
-		r1 = rf(ctx, paymentsConfigId, status)
+	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
+		r1 = rf(ctx, widgetInstance)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5586,59 +11700,56 @@ func (_m *MockStore) UpdatePaymentsConfigStatus(ctx context.Context, paymentsCon
 	return r0, r1
 }
 
-// MockStore_UpdatePaymentsConfigStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePaymentsConfigStatus'
-type MockStore_UpdatePaymentsConfigStatus_Call struct {
+// MockStore_UpdateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWidgetInstance'
+type MockStore_UpdateWidgetInstance_Call struct {
 	*mock.Call
 }
-
-// UpdatePaymentsConfigStatus is a helper method to define mock.On call
-//   - ctx context.Context
-//   - paymentsConfigId uuid.UUID
-//   - status models.PaymentsConfigStatus
-func (_e *MockStore_Expecter) UpdatePaymentsConfigStatus(ctx interface{}, paymentsConfigId interface{}, status interface{}) *MockStore_UpdatePaymentsConfigStatus_Call {
-	return &MockStore_UpdatePaymentsConfigStatus_Call{Call: _e.mock.On("UpdatePaymentsConfigStatus", ctx, paymentsConfigId, status)}
+
+// UpdateWidgetInstance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - widgetInstance *models.WidgetInstance
+func (_e *MockStore_Expecter) UpdateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockStore_UpdateWidgetInstance_Call {
+	return &MockStore_UpdateWidgetInstance_Call{Call: _e.mock.On("UpdateWidgetInstance", ctx, widgetInstance)}
 }
 
-func (_c *MockStore_UpdatePaymentsConfigStatus_Call) Run(run func(ctx context.Context, paymentsConfigId uuid.UUID, status models.PaymentsConfigStatus)) *MockStore_UpdatePaymentsConfigStatus_Call {
+func (_c *MockStore_UpdateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockStore_UpdateWidgetInstance_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.PaymentsConfigStatus))
+		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdatePaymentsConfigStatus_Call) Return(_a0 models.PaymentsConfig, _a1 error) *MockStore_UpdatePaymentsConfigStatus_Call {
+func (_c *MockStore_UpdateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockStore_UpdateWidgetInstance_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdatePaymentsConfigStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.PaymentsConfigStatus) (models.PaymentsConfig, error)) *MockStore_UpdatePaymentsConfigStatus_Call {
+func (_c *MockStore_UpdateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockStore_UpdateWidgetInstance_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePendingOrganizationMembershipRequest provides a mock function with given fields: ctx, organizationId, userId, status
-func (_m *MockStore) UpdatePendingOrganizationMembershipRequest(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error) {
-	ret := _m.Called(ctx, organizationId, userId, status)
+// UpsertDatasetRowAssignment provides a mock function with given fields: ctx, params
+func (_m *MockStore) UpsertDatasetRowAssignment(ctx context.Context, params models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error) {
+	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePendingOrganizationMembershipRequest")
+		panic("no return value specified for UpsertDatasetRowAssignment")
 	}
 
-	var r0 *models.OrganizationMembershipRequest
+	var r0 models.DatasetRowAssignment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)); ok {
-		return rf(ctx, organizationId, userId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error)); ok {
+		return rf(ctx, params)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) *models.OrganizationMembershipRequest); ok {
-		r0 = rf(ctx, organizationId, userId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, models.UpsertDatasetRowAssignmentParams) models.DatasetRowAssignment); ok {
+		r0 = rf(ctx, params)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.OrganizationMembershipRequest)
-		}
+		r0 = ret.Get(0).(models.DatasetRowAssignment)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) error); ok {
-		r1 = rf(ctx, organizationId, userId, status)
+	if rf, ok := ret.Get(1).(func(context.Context, models.UpsertDatasetRowAssignmentParams) error); ok {
+		r1 = rf(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5646,48 +11757,46 @@ func (_m *MockStore) UpdatePendingOrganizationMembershipRequest(ctx context.Cont
 	return r0, r1
 }
 
-// MockStore_UpdatePendingOrganizationMembershipRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePendingOrganizationMembershipRequest'
-type MockStore_UpdatePendingOrganizationMembershipRequest_Call struct {
+// MockStore_UpsertDatasetRowAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertDatasetRowAssignment'
+type MockStore_UpsertDatasetRowAssignment_Call struct {
 	*mock.Call
 }
 
-// UpdatePendingOrganizationMembershipRequest is a helper method to define mock.On call
+// UpsertDatasetRowAssignment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - userId uuid.UUID
-//   - status models.OrgMembershipStatus
-func (_e *MockStore_Expecter) UpdatePendingOrganizationMembershipRequest(ctx interface{}, organizationId interface{}, userId interface{}, status interface{}) *MockStore_UpdatePendingOrganizationMembershipRequest_Call {
-	return &MockStore_UpdatePendingOrganizationMembershipRequest_Call{Call: _e.mock.On("UpdatePendingOrganizationMembershipRequest", ctx, organizationId, userId, status)}
+//   - params models.UpsertDatasetRowAssignmentParams
+func (_e *MockStore_Expecter) UpsertDatasetRowAssignment(ctx interface{}, params interface{}) *MockStore_UpsertDatasetRowAssignment_Call {
+	return &MockStore_UpsertDatasetRowAssignment_Call{Call: _e.mock.On("UpsertDatasetRowAssignment", ctx, params)}
 }
 
-func (_c *MockStore_UpdatePendingOrganizationMembershipRequest_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID, status models.OrgMembershipStatus)) *MockStore_UpdatePendingOrganizationMembershipRequest_Call {
+func (_c *MockStore_UpsertDatasetRowAssignment_Call) Run(run func(ctx context.Context, params models.UpsertDatasetRowAssignmentParams)) *MockStore_UpsertDatasetRowAssignment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(models.OrgMembershipStatus))
+		run(args[0].(context.Context), args[1].(models.UpsertDatasetRowAssignmentParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdatePendingOrganizationMembershipRequest_Call) Return(_a0 *models.OrganizationMembershipRequest, _a1 error) *MockStore_UpdatePendingOrganizationMembershipRequest_Call {
+func (_c *MockStore_UpsertDatasetRowAssignment_Call) Return(_a0 models.DatasetRowAssignment, _a1 error) *MockStore_UpsertDatasetRowAssignment_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStore_UpdatePendingOrganizationMembershipRequest_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, models.OrgMembershipStatus) (*models.OrganizationMembershipRequest, error)) *MockStore_UpdatePendingOrganizationMembershipRequest_Call {
+func (_c *MockStore_UpsertDatasetRowAssignment_Call) RunAndReturn(run func(context.Context, models.UpsertDatasetRowAssignmentParams) (models.DatasetRowAssignment, error)) *MockStore_UpsertDatasetRowAssignment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRule provides a mock function with given fields: ctx, ruleId, params
-func (_m *MockStore) UpdateRule(ctx context.Context, ruleId uuid.UUID, params models.UpdateRuleParams) error {
-	ret := _m.Called(ctx, ruleId, params)
+// UpsertFxRates provides a mock function with given fields: ctx, orgId, sourceId, rates
+func (_m *MockStore) UpsertFxRates(ctx context.Context, orgId uuid.UUID, sourceId uuid.UUID, rates []models.FxRateParams) error {
+	ret := _m.Called(ctx, orgId, sourceId, rates)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateRule")
+		panic("no return value specified for UpsertFxRates")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateRuleParams) error); ok {
-		r0 = rf(ctx, ruleId, params)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []models.FxRateParams) error); ok {
+		r0 = rf(ctx, orgId, sourceId, rates)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -5695,47 +11804,48 @@ func (_m *MockStore) UpdateRule(ctx context.Context, ruleId uuid.UUID, params mo
 	return r0
 }
 
-// MockStore_UpdateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRule'
-type MockStore_UpdateRule_Call struct {
+// MockStore_UpsertFxRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertFxRates'
+type MockStore_UpsertFxRates_Call struct {
 	*mock.Call
 }
 
-// UpdateRule is a helper method to define mock.On call
+// UpsertFxRates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - ruleId uuid.UUID
-//   - params models.UpdateRuleParams
-func (_e *MockStore_Expecter) UpdateRule(ctx interface{}, ruleId interface{}, params interface{}) *MockStore_UpdateRule_Call {
-	return &MockStore_UpdateRule_Call{Call: _e.mock.On("UpdateRule", ctx, ruleId, params)}
+//   - orgId uuid.UUID
+//   - sourceId uuid.UUID
+//   - rates []models.FxRateParams
+func (_e *MockStore_Expecter) UpsertFxRates(ctx interface{}, orgId interface{}, sourceId interface{}, rates interface{}) *MockStore_UpsertFxRates_Call {
+	return &MockStore_UpsertFxRates_Call{Call: _e.mock.On("UpsertFxRates", ctx, orgId, sourceId, rates)}
 }
 
-func (_c *MockStore_UpdateRule_Call) Run(run func(ctx context.Context, ruleId uuid.UUID, params models.UpdateRuleParams)) *MockStore_UpdateRule_Call {
+func (_c *MockStore_UpsertFxRates_Call) Run(run func(ctx context.Context, orgId uuid.UUID, sourceId uuid.UUID, rates []models.FxRateParams)) *MockStore_UpsertFxRates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateRuleParams))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]models.FxRateParams))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateRule_Call) Return(_a0 error) *MockStore_UpdateRule_Call {
+func (_c *MockStore_UpsertFxRates_Call) Return(_a0 error) *MockStore_UpsertFxRates_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateRule_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateRuleParams) error) *MockStore_UpdateRule_Call {
+func (_c *MockStore_UpsertFxRates_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []models.FxRateParams) error) *MockStore_UpsertFxRates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRulePriority provides a mock function with given fields: ctx, params
-func (_m *MockStore) UpdateRulePriority(ctx context.Context, params models.UpdateRulePriorityParams) error {
-	ret := _m.Called(ctx, params)
+// WithAuditLogTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockStore) WithAuditLogTransaction(ctx context.Context, fn func(store.AuditLogStore) error) error {
+	ret := _m.Called(ctx, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateRulePriority")
+		panic("no return value specified for WithAuditLogTransaction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.UpdateRulePriorityParams) error); ok {
-		r0 = rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.AuditLogStore) error) error); ok {
+		r0 = rf(ctx, fn)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -5743,223 +11853,186 @@ func (_m *MockStore) UpdateRulePriority(ctx context.Context, params models.Updat
 	return r0
 }
 
-// MockStore_UpdateRulePriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRulePriority'
-type MockStore_UpdateRulePriority_Call struct {
+// MockStore_WithAuditLogTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithAuditLogTransaction'
+type MockStore_WithAuditLogTransaction_Call struct {
 	*mock.Call
 }
 
-// UpdateRulePriority is a helper method to define mock.On call
+// WithAuditLogTransaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params models.UpdateRulePriorityParams
-func (_e *MockStore_Expecter) UpdateRulePriority(ctx interface{}, params interface{}) *MockStore_UpdateRulePriority_Call {
-	return &MockStore_UpdateRulePriority_Call{Call: _e.mock.On("UpdateRulePriority", ctx, params)}
+//   - fn func(store.AuditLogStore) error
+func (_e *MockStore_Expecter) WithAuditLogTransaction(ctx interface{}, fn interface{}) *MockStore_WithAuditLogTransaction_Call {
+	return &MockStore_WithAuditLogTransaction_Call{Call: _e.mock.On("WithAuditLogTransaction", ctx, fn)}
 }
 
-func (_c *MockStore_UpdateRulePriority_Call) Run(run func(ctx context.Context, params models.UpdateRulePriorityParams)) *MockStore_UpdateRulePriority_Call {
+func (_c *MockStore_WithAuditLogTransaction_Call) Run(run func(ctx context.Context, fn func(store.AuditLogStore) error)) *MockStore_WithAuditLogTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.UpdateRulePriorityParams))
+		run(args[0].(context.Context), args[1].(func(store.AuditLogStore) error))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateRulePriority_Call) Return(_a0 error) *MockStore_UpdateRulePriority_Call {
+func (_c *MockStore_WithAuditLogTransaction_Call) Return(_a0 error) *MockStore_WithAuditLogTransaction_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateRulePriority_Call) RunAndReturn(run func(context.Context, models.UpdateRulePriorityParams) error) *MockStore_UpdateRulePriority_Call {
+func (_c *MockStore_WithAuditLogTransaction_Call) RunAndReturn(run func(context.Context, func(store.AuditLogStore) error) error) *MockStore_WithAuditLogTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateSheet provides a mock function with given fields: ctx, sheet
-func (_m *MockStore) UpdateSheet(ctx context.Context, sheet *models.Sheet) (*models.Sheet, error) {
-	ret := _m.Called(ctx, sheet)
+// WithDatasetAlertTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockStore) WithDatasetAlertTransaction(ctx context.Context, fn func(store.DatasetAlertStore) error) error {
+	ret := _m.Called(ctx, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateSheet")
-	}
-
-	var r0 *models.Sheet
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) (*models.Sheet, error)); ok {
-		return rf(ctx, sheet)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Sheet) *models.Sheet); ok {
-		r0 = rf(ctx, sheet)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Sheet)
-		}
+		panic("no return value specified for WithDatasetAlertTransaction")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.Sheet) error); ok {
-		r1 = rf(ctx, sheet)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetAlertStore) error) error); ok {
+		r0 = rf(ctx, fn)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateSheet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSheet'
-type MockStore_UpdateSheet_Call struct {
+// MockStore_WithDatasetAlertTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetAlertTransaction'
+type MockStore_WithDatasetAlertTransaction_Call struct {
 	*mock.Call
 }
 
-// UpdateSheet is a helper method to define mock.On call
+// WithDatasetAlertTransaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - sheet *models.Sheet
-func (_e *MockStore_Expecter) UpdateSheet(ctx interface{}, sheet interface{}) *MockStore_UpdateSheet_Call {
-	return &MockStore_UpdateSheet_Call{Call: _e.mock.On("UpdateSheet", ctx, sheet)}
+//   - fn func(store.DatasetAlertStore) error
+func (_e *MockStore_Expecter) WithDatasetAlertTransaction(ctx interface{}, fn interface{}) *MockStore_WithDatasetAlertTransaction_Call {
+	return &MockStore_WithDatasetAlertTransaction_Call{Call: _e.mock.On("WithDatasetAlertTransaction", ctx, fn)}
 }
 
-func (_c *MockStore_UpdateSheet_Call) Run(run func(ctx context.Context, sheet *models.Sheet)) *MockStore_UpdateSheet_Call {
+func (_c *MockStore_WithDatasetAlertTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetAlertStore) error)) *MockStore_WithDatasetAlertTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.Sheet))
+		run(args[0].(context.Context), args[1].(func(store.DatasetAlertStore) error))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateSheet_Call) Return(_a0 *models.Sheet, _a1 error) *MockStore_UpdateSheet_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_WithDatasetAlertTransaction_Call) Return(_a0 error) *MockStore_WithDatasetAlertTransaction_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateSheet_Call) RunAndReturn(run func(context.Context, *models.Sheet) (*models.Sheet, error)) *MockStore_UpdateSheet_Call {
+func (_c *MockStore_WithDatasetAlertTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetAlertStore) error) error) *MockStore_WithDatasetAlertTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTeam provides a mock function with given fields: ctx, organizationId, team
-func (_m *MockStore) UpdateTeam(ctx context.Context, organizationId uuid.UUID, team models.Team) (*models.Team, error) {
-	ret := _m.Called(ctx, organizationId, team)
+// WithDatasetExportScheduleTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockStore) WithDatasetExportScheduleTransaction(ctx context.Context, fn func(store.DatasetExportScheduleStore) error) error {
+	ret := _m.Called(ctx, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateTeam")
-	}
-
-	var r0 *models.Team
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) (*models.Team, error)); ok {
-		return rf(ctx, organizationId, team)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.Team) *models.Team); ok {
-		r0 = rf(ctx, organizationId, team)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Team)
-		}
+		panic("no return value specified for WithDatasetExportScheduleTransaction")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.Team) error); ok {
-		r1 = rf(ctx, organizationId, team)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetExportScheduleStore) error) error); ok {
+		r0 = rf(ctx, fn)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeam'
-type MockStore_UpdateTeam_Call struct {
+// MockStore_WithDatasetExportScheduleTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetExportScheduleTransaction'
+type MockStore_WithDatasetExportScheduleTransaction_Call struct {
 	*mock.Call
 }
 
-// UpdateTeam is a helper method to define mock.On call
+// WithDatasetExportScheduleTransaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - organizationId uuid.UUID
-//   - team models.Team
-func (_e *MockStore_Expecter) UpdateTeam(ctx interface{}, organizationId interface{}, team interface{}) *MockStore_UpdateTeam_Call {
-	return &MockStore_UpdateTeam_Call{Call: _e.mock.On("UpdateTeam", ctx, organizationId, team)}
+//   - fn func(store.DatasetExportScheduleStore) error
+func (_e *MockStore_Expecter) WithDatasetExportScheduleTransaction(ctx interface{}, fn interface{}) *MockStore_WithDatasetExportScheduleTransaction_Call {
+	return &MockStore_WithDatasetExportScheduleTransaction_Call{Call: _e.mock.On("WithDatasetExportScheduleTransaction", ctx, fn)}
 }
 
-func (_c *MockStore_UpdateTeam_Call) Run(run func(ctx context.Context, organizationId uuid.UUID, team models.Team)) *MockStore_UpdateTeam_Call {
+func (_c *MockStore_WithDatasetExportScheduleTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetExportScheduleStore) error)) *MockStore_WithDatasetExportScheduleTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.Team))
+		run(args[0].(context.Context), args[1].(func(store.DatasetExportScheduleStore) error))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateTeam_Call) Return(_a0 *models.Team, _a1 error) *MockStore_UpdateTeam_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_WithDatasetExportScheduleTransaction_Call) Return(_a0 error) *MockStore_WithDatasetExportScheduleTransaction_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateTeam_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.Team) (*models.Team, error)) *MockStore_UpdateTeam_Call {
+func (_c *MockStore_WithDatasetExportScheduleTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetExportScheduleStore) error) error) *MockStore_WithDatasetExportScheduleTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateWidgetInstance provides a mock function with given fields: ctx, widgetInstance
-func (_m *MockStore) UpdateWidgetInstance(ctx context.Context, widgetInstance *models.WidgetInstance) (*models.WidgetInstance, error) {
-	ret := _m.Called(ctx, widgetInstance)
+// WithDatasetTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockStore) WithDatasetTransaction(ctx context.Context, fn func(store.DatasetStore) error) error {
+	ret := _m.Called(ctx, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateWidgetInstance")
-	}
-
-	var r0 *models.WidgetInstance
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)); ok {
-		return rf(ctx, widgetInstance)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, *models.WidgetInstance) *models.WidgetInstance); ok {
-		r0 = rf(ctx, widgetInstance)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.WidgetInstance)
-		}
+		panic("no return value specified for WithDatasetTransaction")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *models.WidgetInstance) error); ok {
-		r1 = rf(ctx, widgetInstance)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetStore) error) error); ok {
+		r0 = rf(ctx, fn)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockStore_UpdateWidgetInstance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWidgetInstance'
-type MockStore_UpdateWidgetInstance_Call struct {
+// MockStore_WithDatasetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetTransaction'
+type MockStore_WithDatasetTransaction_Call struct {
 	*mock.Call
 }
 
-// UpdateWidgetInstance is a helper method to define mock.On call
+// WithDatasetTransaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - widgetInstance *models.WidgetInstance
-func (_e *MockStore_Expecter) UpdateWidgetInstance(ctx interface{}, widgetInstance interface{}) *MockStore_UpdateWidgetInstance_Call {
-	return &MockStore_UpdateWidgetInstance_Call{Call: _e.mock.On("UpdateWidgetInstance", ctx, widgetInstance)}
+//   - fn func(store.DatasetStore) error
+func (_e *MockStore_Expecter) WithDatasetTransaction(ctx interface{}, fn interface{}) *MockStore_WithDatasetTransaction_Call {
+	return &MockStore_WithDatasetTransaction_Call{Call: _e.mock.On("WithDatasetTransaction", ctx, fn)}
 }
 
-func (_c *MockStore_UpdateWidgetInstance_Call) Run(run func(ctx context.Context, widgetInstance *models.WidgetInstance)) *MockStore_UpdateWidgetInstance_Call {
+func (_c *MockStore_WithDatasetTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetStore) error)) *MockStore_WithDatasetTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.WidgetInstance))
+		run(args[0].(context.Context), args[1].(func(store.DatasetStore) error))
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdateWidgetInstance_Call) Return(_a0 *models.WidgetInstance, _a1 error) *MockStore_UpdateWidgetInstance_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockStore_WithDatasetTransaction_Call) Return(_a0 error) *MockStore_WithDatasetTransaction_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_UpdateWidgetInstance_Call) RunAndReturn(run func(context.Context, *models.WidgetInstance) (*models.WidgetInstance, error)) *MockStore_UpdateWidgetInstance_Call {
+func (_c *MockStore_WithDatasetTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetStore) error) error) *MockStore_WithDatasetTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// WithDatasetTransaction provides a mock function with given fields: ctx, fn
-func (_m *MockStore) WithDatasetTransaction(ctx context.Context, fn func(store.DatasetStore) error) error {
+// WithDatasetViewTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockStore) WithDatasetViewTransaction(ctx context.Context, fn func(store.DatasetViewStore) error) error {
 	ret := _m.Called(ctx, fn)
 
 	if len(ret) == 0 {
-		panic("no return value specified for WithDatasetTransaction")
+		panic("no return value specified for WithDatasetViewTransaction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetStore) error) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.DatasetViewStore) error) error); ok {
 		r0 = rf(ctx, fn)
 	} else {
 		r0 = ret.Error(0)
@@ -5968,31 +12041,31 @@ func (_m *MockStore) WithDatasetTransaction(ctx context.Context, fn func(store.D
 	return r0
 }
 
-// MockStore_WithDatasetTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetTransaction'
-type MockStore_WithDatasetTransaction_Call struct {
+// MockStore_WithDatasetViewTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithDatasetViewTransaction'
+type MockStore_WithDatasetViewTransaction_Call struct {
 	*mock.Call
 }
 
-// WithDatasetTransaction is a helper method to define mock.On call
+// WithDatasetViewTransaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fn func(store.DatasetStore) error
-func (_e *MockStore_Expecter) WithDatasetTransaction(ctx interface{}, fn interface{}) *MockStore_WithDatasetTransaction_Call {
-	return &MockStore_WithDatasetTransaction_Call{Call: _e.mock.On("WithDatasetTransaction", ctx, fn)}
+//   - fn func(store.DatasetViewStore) error
+func (_e *MockStore_Expecter) WithDatasetViewTransaction(ctx interface{}, fn interface{}) *MockStore_WithDatasetViewTransaction_Call {
+	return &MockStore_WithDatasetViewTransaction_Call{Call: _e.mock.On("WithDatasetViewTransaction", ctx, fn)}
 }
 
-func (_c *MockStore_WithDatasetTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetStore) error)) *MockStore_WithDatasetTransaction_Call {
+func (_c *MockStore_WithDatasetViewTransaction_Call) Run(run func(ctx context.Context, fn func(store.DatasetViewStore) error)) *MockStore_WithDatasetViewTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(func(store.DatasetStore) error))
+		run(args[0].(context.Context), args[1].(func(store.DatasetViewStore) error))
 	})
 	return _c
 }
 
-func (_c *MockStore_WithDatasetTransaction_Call) Return(_a0 error) *MockStore_WithDatasetTransaction_Call {
+func (_c *MockStore_WithDatasetViewTransaction_Call) Return(_a0 error) *MockStore_WithDatasetViewTransaction_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockStore_WithDatasetTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetStore) error) error) *MockStore_WithDatasetTransaction_Call {
+func (_c *MockStore_WithDatasetViewTransaction_Call) RunAndReturn(run func(context.Context, func(store.DatasetViewStore) error) error) *MockStore_WithDatasetViewTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6091,6 +12164,53 @@ func (_c *MockStore_WithPageTransaction_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// WithReconciliationTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockStore) WithReconciliationTransaction(ctx context.Context, fn func(store.ReconciliationStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithReconciliationTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(store.ReconciliationStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_WithReconciliationTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithReconciliationTransaction'
+type MockStore_WithReconciliationTransaction_Call struct {
+	*mock.Call
+}
+
+// WithReconciliationTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(store.ReconciliationStore) error
+func (_e *MockStore_Expecter) WithReconciliationTransaction(ctx interface{}, fn interface{}) *MockStore_WithReconciliationTransaction_Call {
+	return &MockStore_WithReconciliationTransaction_Call{Call: _e.mock.On("WithReconciliationTransaction", ctx, fn)}
+}
+
+func (_c *MockStore_WithReconciliationTransaction_Call) Run(run func(ctx context.Context, fn func(store.ReconciliationStore) error)) *MockStore_WithReconciliationTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(store.ReconciliationStore) error))
+	})
+	return _c
+}
+
+func (_c *MockStore_WithReconciliationTransaction_Call) Return(_a0 error) *MockStore_WithReconciliationTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_WithReconciliationTransaction_Call) RunAndReturn(run func(context.Context, func(store.ReconciliationStore) error) error) *MockStore_WithReconciliationTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithTeamTransaction provides a mock function with given fields: ctx, fn
 func (_m *MockStore) WithTeamTransaction(ctx context.Context, fn func(store.TeamStore) error) error {
 	ret := _m.Called(ctx, fn)
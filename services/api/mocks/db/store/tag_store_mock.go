@@ -0,0 +1,418 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_store
+
+import (
+	context "context"
+
+	models "github.com/Zampfi/application-platform/services/api/db/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// MockTagStore is an autogenerated mock type for the TagStore type
+type MockTagStore struct {
+	mock.Mock
+}
+
+type MockTagStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTagStore) EXPECT() *MockTagStore_Expecter {
+	return &MockTagStore_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: ctx, params
+func (_m *MockTagStore) CreateTag(ctx context.Context, params models.CreateTagParams) (models.Tag, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateTagParams) (models.Tag, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.CreateTagParams) models.Tag); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.CreateTagParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagStore_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type MockTagStore_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params models.CreateTagParams
+func (_e *MockTagStore_Expecter) CreateTag(ctx interface{}, params interface{}) *MockTagStore_CreateTag_Call {
+	return &MockTagStore_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, params)}
+}
+
+func (_c *MockTagStore_CreateTag_Call) Run(run func(ctx context.Context, params models.CreateTagParams)) *MockTagStore_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.CreateTagParams))
+	})
+	return _c
+}
+
+func (_c *MockTagStore_CreateTag_Call) Return(_a0 models.Tag, _a1 error) *MockTagStore_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagStore_CreateTag_Call) RunAndReturn(run func(context.Context, models.CreateTagParams) (models.Tag, error)) *MockTagStore_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, tagId, deletedBy
+func (_m *MockTagStore) DeleteTag(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID) error {
+	ret := _m.Called(ctx, tagId, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, tagId, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagStore_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockTagStore_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - deletedBy uuid.UUID
+func (_e *MockTagStore_Expecter) DeleteTag(ctx interface{}, tagId interface{}, deletedBy interface{}) *MockTagStore_DeleteTag_Call {
+	return &MockTagStore_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, tagId, deletedBy)}
+}
+
+func (_c *MockTagStore_DeleteTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, deletedBy uuid.UUID)) *MockTagStore_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagStore_DeleteTag_Call) Return(_a0 error) *MockTagStore_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagStore_DeleteTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *MockTagStore_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagById provides a mock function with given fields: ctx, tagId
+func (_m *MockTagStore) GetTagById(ctx context.Context, tagId uuid.UUID) (models.Tag, error) {
+	ret := _m.Called(ctx, tagId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagById")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Tag, error)); ok {
+		return rf(ctx, tagId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Tag); ok {
+		r0 = rf(ctx, tagId)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, tagId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagStore_GetTagById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagById'
+type MockTagStore_GetTagById_Call struct {
+	*mock.Call
+}
+
+// GetTagById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+func (_e *MockTagStore_Expecter) GetTagById(ctx interface{}, tagId interface{}) *MockTagStore_GetTagById_Call {
+	return &MockTagStore_GetTagById_Call{Call: _e.mock.On("GetTagById", ctx, tagId)}
+}
+
+func (_c *MockTagStore_GetTagById_Call) Run(run func(ctx context.Context, tagId uuid.UUID)) *MockTagStore_GetTagById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagStore_GetTagById_Call) Return(_a0 models.Tag, _a1 error) *MockTagStore_GetTagById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagStore_GetTagById_Call) RunAndReturn(run func(context.Context, uuid.UUID) (models.Tag, error)) *MockTagStore_GetTagById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTags provides a mock function with given fields: ctx
+func (_m *MockTagStore) GetTags(ctx context.Context) ([]models.Tag, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTags")
+	}
+
+	var r0 []models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Tag, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Tag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagStore_GetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTags'
+type MockTagStore_GetTags_Call struct {
+	*mock.Call
+}
+
+// GetTags is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockTagStore_Expecter) GetTags(ctx interface{}) *MockTagStore_GetTags_Call {
+	return &MockTagStore_GetTags_Call{Call: _e.mock.On("GetTags", ctx)}
+}
+
+func (_c *MockTagStore_GetTags_Call) Run(run func(ctx context.Context)) *MockTagStore_GetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockTagStore_GetTags_Call) Return(_a0 []models.Tag, _a1 error) *MockTagStore_GetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagStore_GetTags_Call) RunAndReturn(run func(context.Context) ([]models.Tag, error)) *MockTagStore_GetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeTags provides a mock function with given fields: ctx, sourceTagId, targetTagId, targetAliases, updatedBy
+func (_m *MockTagStore) MergeTags(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, sourceTagId, targetTagId, targetAliases, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, []string, uuid.UUID) error); ok {
+		r0 = rf(ctx, sourceTagId, targetTagId, targetAliases, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagStore_MergeTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTags'
+type MockTagStore_MergeTags_Call struct {
+	*mock.Call
+}
+
+// MergeTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceTagId uuid.UUID
+//   - targetTagId uuid.UUID
+//   - targetAliases []string
+//   - updatedBy uuid.UUID
+func (_e *MockTagStore_Expecter) MergeTags(ctx interface{}, sourceTagId interface{}, targetTagId interface{}, targetAliases interface{}, updatedBy interface{}) *MockTagStore_MergeTags_Call {
+	return &MockTagStore_MergeTags_Call{Call: _e.mock.On("MergeTags", ctx, sourceTagId, targetTagId, targetAliases, updatedBy)}
+}
+
+func (_c *MockTagStore_MergeTags_Call) Run(run func(ctx context.Context, sourceTagId uuid.UUID, targetTagId uuid.UUID, targetAliases []string, updatedBy uuid.UUID)) *MockTagStore_MergeTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].([]string), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagStore_MergeTags_Call) Return(_a0 error) *MockTagStore_MergeTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagStore_MergeTags_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, []string, uuid.UUID) error) *MockTagStore_MergeTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameTag provides a mock function with given fields: ctx, tagId, name, path, aliases, updatedBy
+func (_m *MockTagStore) RenameTag(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID) error {
+	ret := _m.Called(ctx, tagId, name, path, aliases, updatedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, []string, uuid.UUID) error); ok {
+		r0 = rf(ctx, tagId, name, path, aliases, updatedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTagStore_RenameTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameTag'
+type MockTagStore_RenameTag_Call struct {
+	*mock.Call
+}
+
+// RenameTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - name string
+//   - path string
+//   - aliases []string
+//   - updatedBy uuid.UUID
+func (_e *MockTagStore_Expecter) RenameTag(ctx interface{}, tagId interface{}, name interface{}, path interface{}, aliases interface{}, updatedBy interface{}) *MockTagStore_RenameTag_Call {
+	return &MockTagStore_RenameTag_Call{Call: _e.mock.On("RenameTag", ctx, tagId, name, path, aliases, updatedBy)}
+}
+
+func (_c *MockTagStore_RenameTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, name string, path string, aliases []string, updatedBy uuid.UUID)) *MockTagStore_RenameTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].([]string), args[5].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockTagStore_RenameTag_Call) Return(_a0 error) *MockTagStore_RenameTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTagStore_RenameTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, []string, uuid.UUID) error) *MockTagStore_RenameTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, tagId, params
+func (_m *MockTagStore) UpdateTag(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams) (models.Tag, error) {
+	ret := _m.Called(ctx, tagId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTag")
+	}
+
+	var r0 models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateTagParams) (models.Tag, error)); ok {
+		return rf(ctx, tagId, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.UpdateTagParams) models.Tag); ok {
+		r0 = rf(ctx, tagId, params)
+	} else {
+		r0 = ret.Get(0).(models.Tag)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.UpdateTagParams) error); ok {
+		r1 = rf(ctx, tagId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTagStore_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type MockTagStore_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagId uuid.UUID
+//   - params models.UpdateTagParams
+func (_e *MockTagStore_Expecter) UpdateTag(ctx interface{}, tagId interface{}, params interface{}) *MockTagStore_UpdateTag_Call {
+	return &MockTagStore_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, tagId, params)}
+}
+
+func (_c *MockTagStore_UpdateTag_Call) Run(run func(ctx context.Context, tagId uuid.UUID, params models.UpdateTagParams)) *MockTagStore_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.UpdateTagParams))
+	})
+	return _c
+}
+
+func (_c *MockTagStore_UpdateTag_Call) Return(_a0 models.Tag, _a1 error) *MockTagStore_UpdateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTagStore_UpdateTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.UpdateTagParams) (models.Tag, error)) *MockTagStore_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTagStore creates a new instance of MockTagStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTagStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTagStore {
+	mock := &MockTagStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mock_models
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockJoinConfig is an autogenerated mock type for the JoinConfig type
+type MockJoinConfig struct {
+	mock.Mock
+}
+
+type MockJoinConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockJoinConfig) EXPECT() *MockJoinConfig_Expecter {
+	return &MockJoinConfig_Expecter{mock: &_m.Mock}
+}
+
+// GetJoinClause provides a mock function with no fields
+func (_m *MockJoinConfig) GetJoinClause() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJoinClause")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockJoinConfig_GetJoinClause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJoinClause'
+type MockJoinConfig_GetJoinClause_Call struct {
+	*mock.Call
+}
+
+// GetJoinClause is a helper method to define mock.On call
+func (_e *MockJoinConfig_Expecter) GetJoinClause() *MockJoinConfig_GetJoinClause_Call {
+	return &MockJoinConfig_GetJoinClause_Call{Call: _e.mock.On("GetJoinClause")}
+}
+
+func (_c *MockJoinConfig_GetJoinClause_Call) Run(run func()) *MockJoinConfig_GetJoinClause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockJoinConfig_GetJoinClause_Call) Return(_a0 string) *MockJoinConfig_GetJoinClause_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJoinConfig_GetJoinClause_Call) RunAndReturn(run func() string) *MockJoinConfig_GetJoinClause_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockJoinConfig creates a new instance of MockJoinConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockJoinConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockJoinConfig {
+	mock := &MockJoinConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,8 +1,9 @@
 package constants
 
 const (
-	SignedDownloadUrlConfigCustomName = "custom_name"
-	SignedDownloadUrlConfigTtl        = "url_ttl"
+	SignedDownloadUrlConfigCustomName  = "custom_name"
+	SignedDownloadUrlConfigTtl         = "url_ttl"
+	SignedDownloadUrlConfigContentType = "content_type"
 )
 
 const (
@@ -5,4 +5,5 @@ import "time"
 type DownloadParams struct {
 	CustomDownloadName *string
 	UrlTtlInMinutes    *time.Duration
+	ContentType        *string
 }
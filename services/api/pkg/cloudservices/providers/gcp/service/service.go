@@ -52,10 +52,15 @@ func (gs *gcpService) GetSignedUrlToDownload(ctx context.Context, objectName str
 			return nil, err
 		}
 
+		queryParams := url.Values{}
 		if configs.CustomDownloadName != nil {
-			queryParams := url.Values{}
 			encodedFileName := url.QueryEscape(*configs.CustomDownloadName)
 			queryParams.Set("response-content-disposition", fmt.Sprintf("attachment; filename=\"%s\"", encodedFileName))
+		}
+		if configs.ContentType != nil {
+			queryParams.Set("response-content-type", *configs.ContentType)
+		}
+		if len(queryParams) > 0 {
 			options.QueryParameters = queryParams
 		}
 
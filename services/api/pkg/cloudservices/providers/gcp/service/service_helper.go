@@ -10,6 +10,7 @@ import (
 	cloudstorageconstants "github.com/Zampfi/application-platform/services/api/pkg/cloudservices/constants"
 	"github.com/Zampfi/application-platform/services/api/pkg/cloudservices/providers/gcp/constants"
 	"github.com/Zampfi/application-platform/services/api/pkg/cloudservices/providers/gcp/errors"
+	"github.com/Zampfi/application-platform/services/api/pkg/cloudservices/providers/gcp/helpers"
 	"github.com/Zampfi/application-platform/services/api/pkg/cloudservices/providers/gcp/models"
 )
 
@@ -37,6 +38,10 @@ func (gs *gcpService) getSignedUrlToDownloadConfigs(optionalConfigs []cloudservi
 				urlTtl := time.Duration(constants.SignedUrlExpiryTimeInMinutes) * time.Minute
 				configs.UrlTtlInMinutes = &urlTtl
 			}
+		case cloudstorageconstants.SignedDownloadUrlConfigContentType:
+			if contentType, ok := config.Value.(string); ok {
+				configs.ContentType = &contentType
+			}
 
 		default:
 			return models.DownloadParams{}, errors.ErrInvalidDownloadParams
@@ -59,7 +64,15 @@ func (gs *gcpService) getSignedUrlToUploadForBytes(ctx context.Context, objectNa
 		Expires:        time.Now().Add(time.Duration(constants.SignedUrlExpiryTimeInMinutes) * time.Minute),
 	}
 
-	url, err := client.Bucket(gs.defaultBucket).SignedURL(objectName, options)
+	bucket, filePath, err := helpers.ExtractBucketAndPath(objectName)
+	if err == errors.ErrDefaultGcsBucket {
+		bucket = gs.defaultBucket
+		filePath = objectName
+	} else if err != nil {
+		return cloudservicemodels.SignedUrlToUpload{}, err
+	}
+
+	url, err := client.Bucket(bucket).SignedURL(filePath, options)
 	if err != nil {
 		return cloudservicemodels.SignedUrlToUpload{}, err
 	}
@@ -48,6 +48,19 @@ func TestGetSignedUrlToDownloadConfigs(t *testing.T) {
 				UrlTtlInMinutes: durationPtr(30 * time.Minute),
 			},
 		},
+		{
+			name: "success with content type",
+			configs: []cloudservicemodels.GetDownloadsignedUrlConfigs{
+				{
+					Key:   cloudstorageconstants.SignedDownloadUrlConfigContentType,
+					Value: "application/x-ndjson",
+				},
+			},
+			wantErr: false,
+			expectedParams: gcpmodels.DownloadParams{
+				ContentType: stringPtr("application/x-ndjson"),
+			},
+		},
 		{
 			name: "error with invalid config key",
 			configs: []cloudservicemodels.GetDownloadsignedUrlConfigs{
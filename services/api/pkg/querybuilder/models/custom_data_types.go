@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"regexp"
 
 	dataplaformconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
 	datasetsconstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
@@ -24,11 +25,14 @@ type CustomDataTypeInterface interface {
 func (c *CustomDataTypeConfig) ValidateConfig() error {
 	switch c.Type {
 	case dataplaformconstants.DatabricksColumnCustomTypeAmount:
-		config, ok := c.Config.(*AmountCustomTypeConfig)
-		if !ok {
+		switch config := c.Config.(type) {
+		case *AmountCustomTypeConfig:
+			return config.Validate()
+		case *FxRateAmountCustomTypeConfig:
+			return config.Validate()
+		default:
 			return errors.ErrInvalidCustomDataTypeConfig
 		}
-		return config.Validate()
 	default:
 		return errors.ErrInvalidCustomDataType
 	}
@@ -62,3 +66,76 @@ func (c *AmountCustomTypeConfig) GetGroupByColumn() string {
 func (c *AmountCustomTypeConfig) GetAggregationColumn() string {
 	return fmt.Sprintf("(%s%s->>'%s')::double", datasetsconstants.ZampFxColumnPrefix, c.AmountColumn, c.FxCurrency)
 }
+
+var (
+	currencyCodePattern   = regexp.MustCompile(`^[A-Z]{3}$`)
+	sqlIdentifierPattern  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	organizationIdPattern = regexp.MustCompile(`^[0-9a-fA-F-]{36}$`)
+)
+
+// FxRateAmountCustomTypeConfig converts amounts to FxCurrency with the rates of the organization joined from RatesTable,
+// for datasets without a precomputed fx column. Each row uses the latest rate of its currency on or before its
+// DateColumn, or the latest rate when there is no date column. Rows of currencies without a rate convert to NULL.
+type FxRateAmountCustomTypeConfig struct {
+	CurrencyColumn string
+	FxCurrency     string
+	AmountColumn   string
+	DateColumn     string
+	// RatesTable is the warehouse table of the rates of every organization, one base_currency is worth rate
+	// quote_currency on rate_date. RatesAlias names the join of the rates of this amount column.
+	RatesTable     string
+	RatesAlias     string
+	OrganizationId string
+}
+
+func (c *FxRateAmountCustomTypeConfig) Validate() error {
+	if c.CurrencyColumn == "" || c.AmountColumn == "" || !currencyCodePattern.MatchString(c.FxCurrency) ||
+		c.RatesTable == "" || !sqlIdentifierPattern.MatchString(c.RatesAlias) || !organizationIdPattern.MatchString(c.OrganizationId) {
+		return errors.ErrInvalidCustomDataType
+	}
+	return nil
+}
+
+func (c *FxRateAmountCustomTypeConfig) GetSelectColumn() string {
+	return fmt.Sprintf("%s AS \"%s\", '%s' AS \"%s\"", c.convertedAmount(), c.AmountColumn, c.FxCurrency, c.CurrencyColumn)
+}
+
+func (c *FxRateAmountCustomTypeConfig) GetFilterColumn() string {
+	return c.convertedAmount()
+}
+
+func (c *FxRateAmountCustomTypeConfig) GetGroupByColumn() string {
+	return c.convertedAmount()
+}
+
+func (c *FxRateAmountCustomTypeConfig) GetAggregationColumn() string {
+	return c.convertedAmount()
+}
+
+// GetJoinClause joins the rates converting each currency to FxCurrency as the days they apply, from their date until
+// the date of the next rate. A rate based on FxCurrency is inverted on the days without a rate quoted in it.
+func (c *FxRateAmountCustomTypeConfig) GetJoinClause() string {
+	rates := fmt.Sprintf("SELECT base_currency AS currency, rate_date, rate, 1 AS direct FROM %s"+
+		" WHERE organization_id = '%s' AND quote_currency = '%s' AND rate > 0"+
+		" UNION ALL SELECT quote_currency AS currency, rate_date, 1 / rate AS rate, 0 AS direct FROM %s"+
+		" WHERE organization_id = '%s' AND base_currency = '%s' AND rate > 0",
+		c.RatesTable, c.OrganizationId, c.FxCurrency, c.RatesTable, c.OrganizationId, c.FxCurrency)
+	rankedRates := fmt.Sprintf("SELECT currency, rate_date, rate, ROW_NUMBER() OVER (PARTITION BY currency, rate_date ORDER BY direct DESC) AS rate_rank"+
+		" FROM (%s) fx_rates", rates)
+	periods := fmt.Sprintf("SELECT currency AS _zamp_fx_currency, rate_date AS _zamp_fx_valid_from,"+
+		" LEAD(rate_date) OVER (PARTITION BY currency ORDER BY rate_date) AS _zamp_fx_valid_to, rate AS _zamp_fx_rate"+
+		" FROM (%s) fx_ranked_rates WHERE rate_rank = 1", rankedRates)
+
+	period := fmt.Sprintf("%s._zamp_fx_valid_to IS NULL", c.RatesAlias)
+	if c.DateColumn != "" {
+		period = fmt.Sprintf("%s._zamp_fx_valid_from <= CAST(%s AS DATE) AND (%s._zamp_fx_valid_to IS NULL OR %s._zamp_fx_valid_to > CAST(%s AS DATE))",
+			c.RatesAlias, c.DateColumn, c.RatesAlias, c.RatesAlias, c.DateColumn)
+	}
+
+	return fmt.Sprintf("LEFT JOIN (%s) %s ON %s._zamp_fx_currency = UPPER(%s) AND %s", periods, c.RatesAlias, c.RatesAlias, c.CurrencyColumn, period)
+}
+
+// convertedAmount multiplies the amount with the rate joined for the currency and the day of the row
+func (c *FxRateAmountCustomTypeConfig) convertedAmount() string {
+	return fmt.Sprintf("(%s::double * CASE UPPER(%s) WHEN '%s' THEN 1 ELSE %s._zamp_fx_rate END)", c.AmountColumn, c.CurrencyColumn, c.FxCurrency, c.RatesAlias)
+}
@@ -36,6 +36,12 @@ type QueryConfig struct {
 type TableConfig struct {
 	DatasetId string         `json:"dataset_id"`
 	Columns   []ColumnConfig `json:"columns"`
+	Joins     []JoinConfig   `json:"joins"`
+}
+
+// JoinConfig is joined to the dataset table, the custom data types of the columns read the columns it adds
+type JoinConfig interface {
+	GetJoinClause() string
 }
 
 type ColumnConfig struct {
@@ -134,6 +134,11 @@ func (qb *queryBuilder) buildSelectQuery(ctx context.Context, queryConfig models
 			constants.ZampDataset,
 			queryConfig.TableConfig.DatasetId))
 		params[fmt.Sprintf("%s%s", constants.ZampDataset, queryConfig.TableConfig.DatasetId)] = queryConfig.TableConfig.DatasetId
+
+		for _, join := range queryConfig.TableConfig.Joins {
+			queryBuilder.WriteString(" ")
+			queryBuilder.WriteString(join.GetJoinClause())
+		}
 	}
 
 	// Adding filters
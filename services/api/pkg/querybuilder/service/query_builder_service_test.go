@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	dataplatformCustomConstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/constants"
 	dataplatformConstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
 	"github.com/Zampfi/application-platform/services/api/pkg/querybuilder/constants"
 	"github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
@@ -493,6 +494,53 @@ func (s *ServiceTestSuite) TestSelectToSQL() {
 			expectedParams: map[string]interface{}{},
 			expectError:    true,
 		},
+		{
+			name: "Select with amounts converted by fx rates",
+			queryConfig: func() models.QueryConfig {
+				fxConfig := &models.FxRateAmountCustomTypeConfig{
+					CurrencyColumn: "currency",
+					AmountColumn:   "amount",
+					DateColumn:     "paid_at",
+					FxCurrency:     "EUR",
+					RatesTable:     "`zamp`.`platform`.`fx_rates`",
+					RatesAlias:     "_zamp_fx_rates_0",
+					OrganizationId: "4f9f0b57-2c9c-4a55-9d67-0e6f1b3b8f11",
+				}
+				return models.QueryConfig{
+					TableConfig: models.TableConfig{
+						DatasetId: "payments",
+						Columns: []models.ColumnConfig{
+							{
+								Column: "id",
+							},
+							{
+								Column:   "amount",
+								Datatype: &dataTypeDecimal,
+								CustomDataConfig: &models.CustomDataTypeConfig{
+									Type:   dataplatformCustomConstants.DatabricksColumnCustomTypeAmount,
+									Config: fxConfig,
+								},
+							},
+						},
+						Joins: []models.JoinConfig{fxConfig},
+					},
+				}
+			}(),
+			expectedSQL: "SELECT id, (amount::double * CASE UPPER(currency) WHEN 'EUR' THEN 1 ELSE _zamp_fx_rates_0._zamp_fx_rate END)" +
+				" AS \"amount\", 'EUR' AS \"currency\" FROM {{.zamp_payments}}" +
+				" LEFT JOIN (SELECT currency AS _zamp_fx_currency, rate_date AS _zamp_fx_valid_from," +
+				" LEAD(rate_date) OVER (PARTITION BY currency ORDER BY rate_date) AS _zamp_fx_valid_to, rate AS _zamp_fx_rate" +
+				" FROM (SELECT currency, rate_date, rate, ROW_NUMBER() OVER (PARTITION BY currency, rate_date ORDER BY direct DESC) AS rate_rank" +
+				" FROM (SELECT base_currency AS currency, rate_date, rate, 1 AS direct FROM `zamp`.`platform`.`fx_rates`" +
+				" WHERE organization_id = '4f9f0b57-2c9c-4a55-9d67-0e6f1b3b8f11' AND quote_currency = 'EUR' AND rate > 0" +
+				" UNION ALL SELECT quote_currency AS currency, rate_date, 1 / rate AS rate, 0 AS direct FROM `zamp`.`platform`.`fx_rates`" +
+				" WHERE organization_id = '4f9f0b57-2c9c-4a55-9d67-0e6f1b3b8f11' AND base_currency = 'EUR' AND rate > 0) fx_rates)" +
+				" fx_ranked_rates WHERE rate_rank = 1) _zamp_fx_rates_0" +
+				" ON _zamp_fx_rates_0._zamp_fx_currency = UPPER(currency)" +
+				" AND _zamp_fx_rates_0._zamp_fx_valid_from <= CAST(paid_at AS DATE)" +
+				" AND (_zamp_fx_rates_0._zamp_fx_valid_to IS NULL OR _zamp_fx_rates_0._zamp_fx_valid_to > CAST(paid_at AS DATE))",
+			expectedParams: map[string]interface{}{"zamp_payments": "payments"},
+		},
 	}
 
 	for _, tc := range testCases {
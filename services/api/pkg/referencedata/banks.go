@@ -0,0 +1,71 @@
+package referencedata
+
+import "strings"
+
+// Bank is an entry of the bank directory, datasets store banks by their Code. Country is the ISO 3166-1 alpha-3
+// code of the country the bank is headquartered in and BIC its head office BIC, both are empty when unknown.
+type Bank struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Country string `json:"country,omitempty"`
+	BIC     string `json:"bic,omitempty"`
+}
+
+// banks is the directory shared by every organization, organizations add their own banks on top of it
+var banks = []Bank{
+	{Code: "ADCB", Name: "Abu Dhabi Commercial Bank", Country: "ARE", BIC: "ADCBAEAA"},
+	{Code: "ALRAJHI", Name: "Al Rajhi Bank", Country: "SAU", BIC: "RJHISARI"},
+	{Code: "ANB", Name: "Arab National Bank", Country: "SAU", BIC: "ARNBSARI"},
+	{Code: "ASPIRE", Name: "Aspire", Country: "SGP"},
+	{Code: "AXIS", Name: "Axis Bank", Country: "IND", BIC: "AXISINBB"},
+	{Code: "BCL", Name: "BCL"},
+	{Code: "BL", Name: "BL"},
+	{Code: "BMO", Name: "Bank of Montreal", Country: "CAN", BIC: "BOFMCAM2"},
+	{Code: "BNQMISR", Name: "Banque Misr", Country: "EGY", BIC: "BMISEGCX"},
+	{Code: "BNY", Name: "BNY Mellon", Country: "USA", BIC: "IRVTUS3N"},
+	{Code: "BOG", Name: "BOG"},
+	{Code: "BR", Name: "BR"},
+	{Code: "CB", Name: "CB"},
+	{Code: "CIB", Name: "Commercial International Bank", Country: "EGY", BIC: "CIBEEGCX"},
+	{Code: "CLEARWATER", Name: "Clearwater"},
+	{Code: "CRB", Name: "Cross River Bank", Country: "USA", BIC: "CRBTUS33"},
+	{Code: "DB", Name: "Deutsche Bank", Country: "DEU", BIC: "DEUTDEFF"},
+	{Code: "ENBD", Name: "Emirates NBD", Country: "ARE", BIC: "EBILAEAD"},
+	{Code: "FAB", Name: "First Abu Dhabi Bank", Country: "ARE", BIC: "NBADAEAA"},
+	{Code: "GIB", Name: "Gulf International Bank", Country: "BHR", BIC: "GULFBHBM"},
+	{Code: "HDFC", Name: "HDFC Bank", Country: "IND", BIC: "HDFCINBB"},
+	{Code: "HIGHFI", Name: "HighFi"},
+	{Code: "HSBC", Name: "HSBC", Country: "GBR", BIC: "MIDLGB22"},
+	{Code: "IB", Name: "IB"},
+	{Code: "ICICI", Name: "ICICI Bank", Country: "IND", BIC: "ICICINBB"},
+	{Code: "IS", Name: "IS"},
+	{Code: "JPM", Name: "J.P. Morgan", Country: "USA", BIC: "CHASUS33"},
+	{Code: "JPMC", Name: "JPMorgan Chase", Country: "USA", BIC: "CHASUS33"},
+	{Code: "KYRIBA", Name: "Kyriba"},
+	{Code: "LB", Name: "LB"},
+	{Code: "MASHREQ", Name: "Mashreq Bank", Country: "ARE", BIC: "BOMLAEAD"},
+	{Code: "MERCURY", Name: "Mercury", Country: "USA"},
+	{Code: "MT", Name: "MT"},
+	{Code: "PB", Name: "PB"},
+	{Code: "RBI", Name: "RBI"},
+	{Code: "SAB", Name: "Saudi Awwal Bank", Country: "SAU", BIC: "SABBSARI"},
+	{Code: "SBI", Name: "State Bank of India", Country: "IND", BIC: "SBININBB"},
+	{Code: "SMBC", Name: "Sumitomo Mitsui Banking Corporation", Country: "JPN", BIC: "SMBCJPJT"},
+	{Code: "SPK", Name: "SPK"},
+	{Code: "STR", Name: "STR"},
+	{Code: "SVB", Name: "Silicon Valley Bank", Country: "USA", BIC: "SVBKUS6S"},
+	{Code: "TS", Name: "TS"},
+	{Code: "TSC", Name: "TSC"},
+	{Code: "UC", Name: "UC"},
+	{Code: "WT", Name: "WT"},
+}
+
+// Banks returns the shared bank directory
+func Banks() []Bank {
+	return append([]Bank(nil), banks...)
+}
+
+// NormalizeBankCode is the form bank codes are stored and compared in
+func NormalizeBankCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
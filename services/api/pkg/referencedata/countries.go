@@ -0,0 +1,41 @@
+package referencedata
+
+import "strings"
+
+var (
+	countriesByAlpha2 = map[string]Country{}
+	countriesByAlpha3 = map[string]Country{}
+)
+
+func init() {
+	for _, country := range countries {
+		countriesByAlpha2[country.Alpha2] = country
+		countriesByAlpha3[country.Alpha3] = country
+	}
+}
+
+// Countries returns every ISO 3166-1 country ordered by alpha-3 code
+func Countries() []Country {
+	return append([]Country(nil), countries...)
+}
+
+// CountryByAlpha3 only matches the exact alpha-3 code, the form countries are stored in
+func CountryByAlpha3(code string) (Country, bool) {
+	country, ok := countriesByAlpha3[code]
+	return country, ok
+}
+
+// LookupCountry matches an alpha-2 or alpha-3 code in any case
+func LookupCountry(code string) (Country, bool) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	switch len(normalized) {
+	case 2:
+		country, ok := countriesByAlpha2[normalized]
+		return country, ok
+	case 3:
+		country, ok := countriesByAlpha3[normalized]
+		return country, ok
+	default:
+		return Country{}, false
+	}
+}
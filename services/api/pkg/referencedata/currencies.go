@@ -0,0 +1,27 @@
+package referencedata
+
+import "strings"
+
+var currenciesByCode = map[string]Currency{}
+
+func init() {
+	for _, currency := range currencies {
+		currenciesByCode[currency.Code] = currency
+	}
+}
+
+// Currencies returns every ISO 4217 currency ordered by code
+func Currencies() []Currency {
+	return append([]Currency(nil), currencies...)
+}
+
+// CurrencyByCode only matches the exact code, the form currencies are stored in
+func CurrencyByCode(code string) (Currency, bool) {
+	currency, ok := currenciesByCode[code]
+	return currency, ok
+}
+
+// LookupCurrency matches a code in any case
+func LookupCurrency(code string) (Currency, bool) {
+	return CurrencyByCode(strings.ToUpper(strings.TrimSpace(code)))
+}
@@ -0,0 +1,82 @@
+package referencedata
+
+import "sort"
+
+// Kind is the kind of reference data a dataset column holds, the values match the custom types of dataset columns
+type Kind string
+
+const (
+	KindCountry  Kind = "country"
+	KindCurrency Kind = "currency"
+	KindBank     Kind = "bank"
+)
+
+// Directory is the bank directory of an organization, the shared banks plus the ones the organization added
+type Directory struct {
+	banks map[string]Bank
+}
+
+// NewDirectory builds the directory of an organization from its own banks and the shared ones
+func NewDirectory(organizationBanks []Bank) *Directory {
+	directory := &Directory{banks: make(map[string]Bank, len(banks)+len(organizationBanks))}
+	for _, bank := range banks {
+		directory.banks[bank.Code] = bank
+	}
+	for _, bank := range organizationBanks {
+		directory.banks[bank.Code] = bank
+	}
+	return directory
+}
+
+// Bank only matches the exact code, the form banks are stored in
+func (d *Directory) Bank(code string) (Bank, bool) {
+	bank, ok := d.banks[code]
+	return bank, ok
+}
+
+// Banks returns the banks of the directory ordered by code
+func (d *Directory) Banks() []Bank {
+	directoryBanks := make([]Bank, 0, len(d.banks))
+	for _, bank := range d.banks {
+		directoryBanks = append(directoryBanks, bank)
+	}
+	sort.Slice(directoryBanks, func(i, j int) bool {
+		return directoryBanks[i].Code < directoryBanks[j].Code
+	})
+	return directoryBanks
+}
+
+// Valid reports whether the value is exactly the stored form of a known entry of the kind
+func (d *Directory) Valid(kind Kind, value string) bool {
+	_, ok := d.DisplayName(kind, value)
+	return ok
+}
+
+// DisplayName returns the name shown to users for a stored country, currency or bank code
+func (d *Directory) DisplayName(kind Kind, value string) (string, bool) {
+	switch kind {
+	case KindCountry:
+		if country, ok := CountryByAlpha3(value); ok {
+			return country.Name, true
+		}
+	case KindCurrency:
+		if currency, ok := CurrencyByCode(value); ok {
+			return currency.Name, true
+		}
+	case KindBank:
+		if bank, ok := d.Bank(value); ok {
+			return bank.Name, true
+		}
+	}
+	return "", false
+}
+
+// IsBuiltInBank reports whether the code belongs to the shared directory, organizations cannot redefine those
+func IsBuiltInBank(code string) bool {
+	for _, bank := range banks {
+		if bank.Code == code {
+			return true
+		}
+	}
+	return false
+}
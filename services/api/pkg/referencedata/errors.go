@@ -0,0 +1,21 @@
+package referencedata
+
+import "errors"
+
+const (
+	ErrInvalidIBANFormatMessage   = "ERR_INVALID_IBAN_FORMAT"
+	ErrInvalidIBANCountryMessage  = "ERR_INVALID_IBAN_COUNTRY"
+	ErrInvalidIBANLengthMessage   = "ERR_INVALID_IBAN_LENGTH"
+	ErrInvalidIBANChecksumMessage = "ERR_INVALID_IBAN_CHECKSUM"
+	ErrInvalidBICFormatMessage    = "ERR_INVALID_BIC_FORMAT"
+	ErrInvalidBICCountryMessage   = "ERR_INVALID_BIC_COUNTRY"
+)
+
+var (
+	ErrInvalidIBANFormat   = errors.New(ErrInvalidIBANFormatMessage)
+	ErrInvalidIBANCountry  = errors.New(ErrInvalidIBANCountryMessage)
+	ErrInvalidIBANLength   = errors.New(ErrInvalidIBANLengthMessage)
+	ErrInvalidIBANChecksum = errors.New(ErrInvalidIBANChecksumMessage)
+	ErrInvalidBICFormat    = errors.New(ErrInvalidBICFormatMessage)
+	ErrInvalidBICCountry   = errors.New(ErrInvalidBICCountryMessage)
+)
@@ -0,0 +1,127 @@
+package referencedata
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// ibanLengths is the length of the IBANs of every country of the SWIFT IBAN registry
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+var (
+	ibanPattern = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+	bicPattern  = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+)
+
+// IBAN is a validated international bank account number split into its parts
+type IBAN struct {
+	Value       string `json:"value"`
+	Country     string `json:"country"`
+	CheckDigits string `json:"check_digits"`
+	BBAN        string `json:"bban"`
+}
+
+// BIC is a validated business identifier code split into its parts, Branch is empty for 8 character codes
+type BIC struct {
+	Value    string `json:"value"`
+	BankCode string `json:"bank_code"`
+	Country  string `json:"country"`
+	Location string `json:"location"`
+	Branch   string `json:"branch,omitempty"`
+}
+
+// NormalizeIBAN drops the spaces of the printed form and upper cases the rest
+func NormalizeIBAN(value string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(value), ""))
+}
+
+// ParseIBAN checks the format, the length registered for the country and the mod-97 checksum of the value
+func ParseIBAN(value string) (IBAN, error) {
+	normalized := NormalizeIBAN(value)
+	if !ibanPattern.MatchString(normalized) {
+		return IBAN{}, ErrInvalidIBANFormat
+	}
+
+	length, ok := ibanLengths[normalized[:2]]
+	if !ok {
+		return IBAN{}, ErrInvalidIBANCountry
+	}
+	if len(normalized) != length {
+		return IBAN{}, ErrInvalidIBANLength
+	}
+
+	if !validIBANChecksum(normalized) {
+		return IBAN{}, ErrInvalidIBANChecksum
+	}
+
+	return IBAN{
+		Value:       normalized,
+		Country:     normalized[:2],
+		CheckDigits: normalized[2:4],
+		BBAN:        normalized[4:],
+	}, nil
+}
+
+// ValidateIBAN is ParseIBAN for callers that only need to know the value is valid
+func ValidateIBAN(value string) error {
+	_, err := ParseIBAN(value)
+	return err
+}
+
+// validIBANChecksum moves the country and check digits to the end, replaces letters with 10 to 35 and expects the
+// resulting number to be 1 modulo 97
+func validIBANChecksum(iban string) bool {
+	var digits strings.Builder
+	for _, char := range iban[4:] + iban[:4] {
+		if char >= 'A' && char <= 'Z' {
+			digits.WriteString(big.NewInt(int64(char-'A') + 10).String())
+			continue
+		}
+		digits.WriteRune(char)
+	}
+
+	number, ok := new(big.Int).SetString(digits.String(), 10)
+	if !ok {
+		return false
+	}
+	return new(big.Int).Mod(number, big.NewInt(97)).Int64() == 1
+}
+
+// ParseBIC checks the format of the value and that its country is a known ISO 3166-1 alpha-2 code. Kosovo has no
+// ISO code yet but banks there are issued BICs with XK.
+func ParseBIC(value string) (BIC, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if !bicPattern.MatchString(normalized) {
+		return BIC{}, ErrInvalidBICFormat
+	}
+
+	country := normalized[4:6]
+	if _, ok := countriesByAlpha2[country]; !ok && country != "XK" {
+		return BIC{}, ErrInvalidBICCountry
+	}
+
+	return BIC{
+		Value:    normalized,
+		BankCode: normalized[:4],
+		Country:  country,
+		Location: normalized[6:8],
+		Branch:   normalized[8:],
+	}, nil
+}
+
+// ValidateBIC is ParseBIC for callers that only need to know the value is valid
+func ValidateBIC(value string) error {
+	_, err := ParseBIC(value)
+	return err
+}
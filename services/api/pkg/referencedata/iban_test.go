@@ -0,0 +1,100 @@
+package referencedata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIBAN(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    IBAN
+		expectedErr error
+	}{
+		{
+			name:     "printed form",
+			value:    "gb82 west 1234 5698 7654 32",
+			expected: IBAN{Value: "GB82WEST12345698765432", Country: "GB", CheckDigits: "82", BBAN: "WEST12345698765432"},
+		},
+		{
+			name:     "letters in the bban",
+			value:    "FR1420041010050500013M02606",
+			expected: IBAN{Value: "FR1420041010050500013M02606", Country: "FR", CheckDigits: "14", BBAN: "20041010050500013M02606"},
+		},
+		{
+			name:     "shortest registered length",
+			value:    "NO9386011117947",
+			expected: IBAN{Value: "NO9386011117947", Country: "NO", CheckDigits: "93", BBAN: "86011117947"},
+		},
+		{name: "empty", value: "", expectedErr: ErrInvalidIBANFormat},
+		{name: "symbols", value: "DE89-3704-0044-0532-0130-00", expectedErr: ErrInvalidIBANFormat},
+		{name: "country without iban", value: "US12345678901234567890", expectedErr: ErrInvalidIBANCountry},
+		{name: "wrong length", value: "DE8937040044053201300", expectedErr: ErrInvalidIBANLength},
+		{name: "wrong check digits", value: "DE88370400440532013000", expectedErr: ErrInvalidIBANChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iban, err := ParseIBAN(tt.value)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, iban)
+		})
+	}
+}
+
+func TestParseBIC(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    BIC
+		expectedErr error
+	}{
+		{
+			name:     "head office",
+			value:    "deutdeff",
+			expected: BIC{Value: "DEUTDEFF", BankCode: "DEUT", Country: "DE", Location: "FF"},
+		},
+		{
+			name:     "branch",
+			value:    "DEUTDEFF500",
+			expected: BIC{Value: "DEUTDEFF500", BankCode: "DEUT", Country: "DE", Location: "FF", Branch: "500"},
+		},
+		{
+			name:     "kosovo",
+			value:    "RBKOXKPR",
+			expected: BIC{Value: "RBKOXKPR", BankCode: "RBKO", Country: "XK", Location: "PR"},
+		},
+		{name: "too short", value: "DEUTDE", expectedErr: ErrInvalidBICFormat},
+		{name: "partial branch", value: "DEUTDEFF50", expectedErr: ErrInvalidBICFormat},
+		{name: "digits in bank code", value: "DE1TDEFF", expectedErr: ErrInvalidBICFormat},
+		{name: "unknown country", value: "DEUTZZFF", expectedErr: ErrInvalidBICCountry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bic, err := ParseBIC(tt.value)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, bic)
+		})
+	}
+}
+
+func TestBuiltInBankBICs(t *testing.T) {
+	for _, bank := range Banks() {
+		if bank.BIC == "" {
+			continue
+		}
+		assert.NoError(t, ValidateBIC(bank.BIC), bank.Code)
+	}
+}
@@ -0,0 +1,89 @@
+package referencedata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupCountry(t *testing.T) {
+	country, ok := LookupCountry("in")
+	assert.True(t, ok)
+	assert.Equal(t, Country{Alpha2: "IN", Alpha3: "IND", Name: "India"}, country)
+
+	country, ok = LookupCountry(" ind ")
+	assert.True(t, ok)
+	assert.Equal(t, "IN", country.Alpha2)
+
+	_, ok = LookupCountry("XYZ")
+	assert.False(t, ok)
+
+	_, ok = LookupCountry("INDIA")
+	assert.False(t, ok)
+}
+
+func TestCountryByAlpha3(t *testing.T) {
+	_, ok := CountryByAlpha3("IND")
+	assert.True(t, ok)
+
+	_, ok = CountryByAlpha3("ind")
+	assert.False(t, ok)
+
+	_, ok = CountryByAlpha3("IN")
+	assert.False(t, ok)
+}
+
+func TestCurrencies(t *testing.T) {
+	minorUnits := map[string]int{"INR": 2, "JPY": 0, "KWD": 3, "CLF": 4}
+	for code, expected := range minorUnits {
+		currency, ok := CurrencyByCode(code)
+		assert.True(t, ok, code)
+		assert.Equal(t, expected, currency.MinorUnits, code)
+	}
+
+	_, ok := CurrencyByCode("inr")
+	assert.False(t, ok)
+
+	currency, ok := LookupCurrency("inr")
+	assert.True(t, ok)
+	assert.Equal(t, "INR", currency.Code)
+
+	_, ok = LookupCurrency("XYZ")
+	assert.False(t, ok)
+
+	assert.Len(t, Currencies(), len(currencies))
+}
+
+func TestDirectory(t *testing.T) {
+	directory := NewDirectory([]Bank{
+		{Code: "ACME", Name: "Acme Bank", Country: "USA"},
+		{Code: "CRB", Name: "Cross River"},
+	})
+
+	bank, ok := directory.Bank("ACME")
+	assert.True(t, ok)
+	assert.Equal(t, "Acme Bank", bank.Name)
+
+	bank, ok = directory.Bank("CRB")
+	assert.True(t, ok)
+	assert.Equal(t, "Cross River", bank.Name)
+
+	_, ok = directory.Bank("acme")
+	assert.False(t, ok)
+
+	assert.Len(t, directory.Banks(), len(banks)+1)
+	assert.Equal(t, "ACME", directory.Banks()[0].Code)
+
+	assert.True(t, directory.Valid(KindBank, "HDFC"))
+	assert.False(t, directory.Valid(KindBank, "XYZ"))
+	assert.True(t, directory.Valid(KindCountry, "IND"))
+	assert.False(t, directory.Valid(KindCountry, "IN"))
+	assert.False(t, directory.Valid(Kind("amount"), "IND"))
+
+	name, ok := directory.DisplayName(KindCurrency, "EUR")
+	assert.True(t, ok)
+	assert.Equal(t, "Euro", name)
+
+	assert.True(t, IsBuiltInBank("CRB"))
+	assert.False(t, IsBuiltInBank("ACME"))
+}
@@ -0,0 +1,233 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Sheet is a single worksheet, Rows hold the cell values in the order of Header.
+// Numbers, booleans and time.Time values are written as typed cells, everything else as text.
+type Sheet struct {
+	Name   string
+	Header []string
+	Rows   [][]interface{}
+}
+
+const (
+	styleDefault = iota
+	styleHeader
+	styleDate
+	styleDateTime
+)
+
+// excelEpoch is day zero of the 1900 date system, shifted by the 1900 leap year bug
+var excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// Write encodes sheet as an xlsx workbook with a bold, frozen header row
+func Write(w io.Writer, sheet Sheet) error {
+	zw := zip.NewWriter(w)
+
+	name := sheet.Name
+	if name == "" {
+		name = "Sheet1"
+	}
+
+	staticParts := []struct {
+		path    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXml},
+		{"_rels/.rels", rootRelsXml},
+		{"xl/workbook.xml", fmt.Sprintf(workbookXml, escape(sanitizeSheetName(name)))},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXml},
+		{"xl/styles.xml", stylesXml},
+	}
+	for _, part := range staticParts {
+		fw, err := zw.Create(part.path)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(fw, part.content); err != nil {
+			return err
+		}
+	}
+
+	fw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	if err := writeWorksheet(fw, sheet); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+func writeWorksheet(w io.Writer, sheet Sheet) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	bw.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if len(sheet.Header) > 0 {
+		bw.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	}
+	bw.WriteString(`<sheetData>`)
+
+	rowNum := 1
+	if len(sheet.Header) > 0 {
+		header := make([]interface{}, len(sheet.Header))
+		for i, h := range sheet.Header {
+			header[i] = h
+		}
+		writeRow(bw, rowNum, header, styleHeader)
+		rowNum++
+	}
+	for _, row := range sheet.Rows {
+		writeRow(bw, rowNum, row, styleDefault)
+		rowNum++
+	}
+
+	bw.WriteString(`</sheetData></worksheet>`)
+
+	return bw.Flush()
+}
+
+func writeRow(bw *bufio.Writer, rowNum int, values []interface{}, style int) {
+	fmt.Fprintf(bw, `<row r="%d">`, rowNum)
+	for i, value := range values {
+		writeCell(bw, cellRef(i, rowNum), value, style)
+	}
+	bw.WriteString(`</row>`)
+}
+
+func writeCell(bw *bufio.Writer, ref string, value interface{}, style int) {
+	styleAttr := ""
+	if style != styleDefault {
+		styleAttr = fmt.Sprintf(` s="%d"`, style)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return
+	case bool:
+		b := "0"
+		if v {
+			b = "1"
+		}
+		fmt.Fprintf(bw, `<c r="%s"%s t="b"><v>%s</v></c>`, ref, styleAttr, b)
+	case time.Time:
+		cellStyle := styleDateTime
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 && v.Nanosecond() == 0 {
+			cellStyle = styleDate
+		}
+		fmt.Fprintf(bw, `<c r="%s" s="%d"><v>%s</v></c>`, ref, cellStyle, formatNumber(excelSerial(v)))
+	case *time.Time:
+		if v == nil {
+			return
+		}
+		writeCell(bw, ref, *v, style)
+	default:
+		if number, ok := toFloat(v); ok && !math.IsNaN(number) && !math.IsInf(number, 0) {
+			fmt.Fprintf(bw, `<c r="%s"%s><v>%s</v></c>`, ref, styleAttr, formatNumber(number))
+			return
+		}
+		fmt.Fprintf(bw, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, styleAttr, escape(fmt.Sprintf("%v", v)))
+	}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// excelSerial converts t to the fractional days since the excel epoch, the wall clock of t is kept as is
+func excelSerial(t time.Time) float64 {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	return wall.Sub(excelEpoch).Hours() / 24
+}
+
+// cellRef returns the A1 style reference of a zero based column index
+func cellRef(col int, row int) string {
+	name := ""
+	for col >= 0 {
+		name = string(rune('A'+col%26)) + name
+		col = col/26 - 1
+	}
+	return name + strconv.Itoa(row)
+}
+
+// escape escapes xml special characters and drops the control characters xml 1.0 does not allow
+func escape(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '&':
+			sb.WriteString("&amp;")
+		case r == '<':
+			sb.WriteString("&lt;")
+		case r == '>':
+			sb.WriteString("&gt;")
+		case r == '"':
+			sb.WriteString("&quot;")
+		case r == '\t' || r == '\n' || r == '\r':
+			sb.WriteRune(r)
+		case r < 0x20 || r == utf8.RuneError || r == 0xFFFE || r == 0xFFFF:
+			continue
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// sanitizeSheetName applies the excel sheet name rules, at most 31 characters and none of []:*?/\
+func sanitizeSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+
+	runes := []rune(name)
+	if len(runes) > 31 {
+		runes = runes[:31]
+	}
+	return string(runes)
+}
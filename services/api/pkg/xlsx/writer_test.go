@@ -0,0 +1,68 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readPart(t *testing.T, data []byte, name string) string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	for _, f := range zr.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		require.NoError(t, err)
+		defer rc.Close()
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	t.Fatalf("part %s not found", name)
+	return ""
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	err := Write(&buf, Sheet{
+		Name:   "Payouts: March",
+		Header: []string{"Id", "Amount", "Settled", "Paid at", "Note"},
+		Rows: [][]interface{}{
+			{int64(1), 12.5, true, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), "a < b & c"},
+			{int64(2), nil, false, time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), "x\x01y"},
+		},
+	})
+	require.NoError(t, err)
+
+	workbook := readPart(t, buf.Bytes(), "xl/workbook.xml")
+	assert.Contains(t, workbook, `<sheet name="Payouts_ March" sheetId="1" r:id="rId1"/>`)
+
+	sheet := readPart(t, buf.Bytes(), "xl/worksheets/sheet1.xml")
+	assert.Contains(t, sheet, `<pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/>`)
+	assert.Contains(t, sheet, `<c r="A1" s="1" t="inlineStr"><is><t xml:space="preserve">Id</t></is></c>`)
+	assert.Contains(t, sheet, `<c r="B2"><v>12.5</v></c>`)
+	assert.Contains(t, sheet, `<c r="C2" t="b"><v>1</v></c>`)
+	assert.Contains(t, sheet, `<c r="D2" s="2"><v>45717</v></c>`)
+	assert.Contains(t, sheet, `<c r="D3" s="3"><v>45717.5</v></c>`)
+	assert.Contains(t, sheet, `a &lt; b &amp; c`)
+	assert.Contains(t, sheet, `>xy<`)
+	assert.NotContains(t, sheet, `r="B3"`)
+}
+
+func TestCellRef(t *testing.T) {
+	assert.Equal(t, "A1", cellRef(0, 1))
+	assert.Equal(t, "Z2", cellRef(25, 2))
+	assert.Equal(t, "AA3", cellRef(26, 3))
+	assert.Equal(t, "BA4", cellRef(52, 4))
+}
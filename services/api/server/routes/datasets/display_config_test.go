@@ -90,7 +90,7 @@ func TestGetDatasetDisplayConfig(t *testing.T) {
 				c.Next()
 			})
 
-			registerRoutes(g, mockDatasetService, mockStore, mockFileUploadService)
+			registerRoutes(g, mockDatasetService, mockStore, mockFileUploadService, nil)
 
 			// Create a test request
 			req, err := http.NewRequest("GET", tt.path, nil)
@@ -217,7 +217,7 @@ func TestSetDatasetDisplayConfig(t *testing.T) {
 				c.Next()
 			})
 
-			registerRoutes(g, mockDatasetService, mockStore, mockFileUploadService)
+			registerRoutes(g, mockDatasetService, mockStore, mockFileUploadService, nil)
 
 			// Create a test request
 			var bodyReader *bytes.Reader
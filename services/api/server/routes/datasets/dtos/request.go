@@ -1,15 +1,19 @@
 package dtos
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	dataplatformdataconstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
 	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
 	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
 	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
 )
 
 type GetDataRequest struct {
@@ -224,3 +228,233 @@ type ConfirmDatasetImportRequest struct {
 type SetDatasetDisplayConfigRequest struct {
 	DisplayConfig []datasetmodels.DisplayConfig `json:"display_config"`
 }
+
+type DatasetRowPolicyRequest struct {
+	AudienceType storemodels.AudienceType  `json:"audience_type"`
+	AudienceId   uuid.UUID                 `json:"audience_id"`
+	Title        string                    `json:"title" binding:"required"`
+	Description  string                    `json:"description"`
+	Filters      datasetmodels.FilterModel `json:"filters"`
+}
+
+func (r *DatasetRowPolicyRequest) ToModel() datasetmodels.DatasetRowPolicyParams {
+	return datasetmodels.DatasetRowPolicyParams{
+		AudienceType: r.AudienceType,
+		AudienceId:   r.AudienceId,
+		Title:        r.Title,
+		Description:  r.Description,
+		Filters:      r.Filters,
+	}
+}
+
+type DatasetColumnPolicyRequest struct {
+	AudienceType storemodels.AudienceType       `json:"audience_type"`
+	AudienceId   uuid.UUID                      `json:"audience_id"`
+	Column       string                         `json:"column"`
+	Action       storemodels.ColumnPolicyAction `json:"action" binding:"required"`
+	MaskType     *storemodels.ColumnMaskType    `json:"mask_type"`
+}
+
+func (r *DatasetColumnPolicyRequest) ToModel() datasetmodels.DatasetColumnPolicyParams {
+	return datasetmodels.DatasetColumnPolicyParams{
+		AudienceType: r.AudienceType,
+		AudienceId:   r.AudienceId,
+		Column:       r.Column,
+		Action:       r.Action,
+		MaskType:     r.MaskType,
+	}
+}
+
+type DatasetViewRequest struct {
+	Name          string                 `json:"name" binding:"required"`
+	Description   string                 `json:"description"`
+	QueryConfig   GetDataRequest         `json:"query_config"`
+	ColumnOrder   []string               `json:"column_order"`
+	DisplayConfig map[string]interface{} `json:"display_config"`
+}
+
+func (r *DatasetViewRequest) ToModel() datasetmodels.DatasetViewParams {
+	return datasetmodels.DatasetViewParams{
+		Name:          r.Name,
+		Description:   r.Description,
+		QueryConfig:   r.QueryConfig.ToModel(),
+		ColumnOrder:   r.ColumnOrder,
+		DisplayConfig: r.DisplayConfig,
+	}
+}
+
+type DeleteDatasetViewAudienceRequest struct {
+	AudienceType storemodels.AudienceType `json:"audience_type" binding:"required"`
+	AudienceId   uuid.UUID                `json:"audience_id" binding:"required"`
+}
+
+type SetDefaultDatasetViewRequest struct {
+	ViewId uuid.UUID `json:"view_id" binding:"required"`
+}
+
+type DatasetExportScheduleDestination struct {
+	Provider   string   `json:"provider,omitempty"`
+	Bucket     string   `json:"bucket,omitempty"`
+	Prefix     string   `json:"prefix,omitempty"`
+	Recipients []string `json:"recipients,omitempty"`
+}
+
+type DatasetExportScheduleRequest struct {
+	Name              string                           `json:"name" binding:"required"`
+	QueryConfig       GetDataRequest                   `json:"query_config"`
+	Format            string                           `json:"format"`
+	CronExpression    string                           `json:"cron_expression" binding:"required"`
+	Timezone          string                           `json:"timezone"`
+	DestinationType   string                           `json:"destination_type" binding:"required"`
+	DestinationConfig DatasetExportScheduleDestination `json:"destination_config"`
+	IsEnabled         *bool                            `json:"is_enabled"`
+}
+
+func (r *DatasetExportScheduleRequest) ToModel() datasetmodels.DatasetExportScheduleParams {
+	return datasetmodels.DatasetExportScheduleParams{
+		Name:            r.Name,
+		QueryConfig:     r.QueryConfig.ToModel(),
+		Format:          datasetConstants.ExportFormat(strings.ToLower(r.Format)),
+		CronExpression:  r.CronExpression,
+		Timezone:        r.Timezone,
+		DestinationType: datasetConstants.ExportDestinationType(r.DestinationType),
+		DestinationConfig: datasetmodels.DatasetExportScheduleDestination{
+			Provider:   r.DestinationConfig.Provider,
+			Bucket:     r.DestinationConfig.Bucket,
+			Prefix:     r.DestinationConfig.Prefix,
+			Recipients: r.DestinationConfig.Recipients,
+		},
+		IsEnabled: r.IsEnabled,
+	}
+}
+
+type DatasetAlertRequest struct {
+	Name           string                              `json:"name" binding:"required"`
+	Metric         datasetmodels.DatasetAlertMetric    `json:"metric"`
+	Condition      datasetmodels.DatasetAlertCondition `json:"condition"`
+	CronExpression string                              `json:"cron_expression" binding:"required"`
+	Timezone       string                              `json:"timezone"`
+	Recipients     []string                            `json:"recipients"`
+	WebhookUrl     string                              `json:"webhook_url"`
+	IsEnabled      *bool                               `json:"is_enabled"`
+}
+
+func (r *DatasetAlertRequest) ToModel() datasetmodels.DatasetAlertParams {
+	return datasetmodels.DatasetAlertParams{
+		Name:           r.Name,
+		Metric:         r.Metric,
+		Condition:      r.Condition,
+		CronExpression: r.CronExpression,
+		Timezone:       r.Timezone,
+		Recipients:     r.Recipients,
+		WebhookUrl:     r.WebhookUrl,
+		IsEnabled:      r.IsEnabled,
+	}
+}
+
+type SnoozeDatasetAlertRequest struct {
+	Until time.Time `json:"until" binding:"required"`
+}
+
+type DatasetStatusWorkflowRequest struct {
+	Column       string                                   `json:"column"`
+	InitialState string                                   `json:"initial_state" binding:"required"`
+	States       []datasetmodels.StatusWorkflowState      `json:"states" binding:"required"`
+	Transitions  []datasetmodels.StatusWorkflowTransition `json:"transitions"`
+}
+
+func (r *DatasetStatusWorkflowRequest) ToModel() datasetmodels.DatasetStatusWorkflowParams {
+	return datasetmodels.DatasetStatusWorkflowParams{
+		Column:       r.Column,
+		InitialState: r.InitialState,
+		States:       r.States,
+		Transitions:  r.Transitions,
+	}
+}
+
+type TransitionDatasetRowStatusRequest struct {
+	Column string `json:"column" binding:"required"`
+	State  string `json:"state" binding:"required"`
+}
+
+type DatasetRowAssignmentRequest struct {
+	AssigneeId *uuid.UUID `json:"assignee_id"`
+	DueDate    *string    `json:"due_date"`
+}
+
+func (r *DatasetRowAssignmentRequest) ToModel() (datasetmodels.DatasetRowAssignmentParams, error) {
+	params := datasetmodels.DatasetRowAssignmentParams{AssigneeId: r.AssigneeId}
+	if r.DueDate != nil {
+		dueDate, err := time.Parse(time.DateOnly, *r.DueDate)
+		if err != nil {
+			return datasetmodels.DatasetRowAssignmentParams{}, fmt.Errorf("invalid due date, expected YYYY-MM-DD: %s", *r.DueDate)
+		}
+		params.DueDate = &dueDate
+	}
+	return params, nil
+}
+
+// DatasetRuleRequest writes value to column, or with assignments writes several columns. Column then names the
+// assignment the rule is prioritized with and defaults to the first one.
+type DatasetRuleRequest struct {
+	Title       string                      `json:"title" binding:"required"`
+	Description string                      `json:"description"`
+	Column      string                      `json:"column"`
+	Filters     datasetmodels.FilterModel   `json:"filters"`
+	Value       interface{}                 `json:"value"`
+	Assignments []rulemodels.RuleAssignment `json:"assignments"`
+}
+
+func (r *DatasetRuleRequest) ToModel() datasetmodels.DatasetRuleParams {
+	return datasetmodels.DatasetRuleParams{
+		Title:       r.Title,
+		Description: r.Description,
+		Column:      r.Column,
+		Filters:     r.Filters,
+		Value:       r.Value,
+		Assignments: r.Assignments,
+	}
+}
+
+type SetDatasetRuleEnabledRequest struct {
+	IsEnabled *bool `json:"is_enabled" binding:"required"`
+}
+
+type DatasetRulePreviewRequest struct {
+	RuleId      *uuid.UUID                  `json:"rule_id"`
+	Priority    int                         `json:"priority"`
+	Column      string                      `json:"column"`
+	Filters     datasetmodels.FilterModel   `json:"filters"`
+	Value       interface{}                 `json:"value"`
+	Assignments []rulemodels.RuleAssignment `json:"assignments"`
+}
+
+func (r *DatasetRulePreviewRequest) ToModel() datasetmodels.DatasetRulePreviewParams {
+	return datasetmodels.DatasetRulePreviewParams{
+		DatasetRuleParams: datasetmodels.DatasetRuleParams{
+			Column:      r.Column,
+			Filters:     r.Filters,
+			Value:       r.Value,
+			Assignments: r.Assignments,
+		},
+		RuleId:   r.RuleId,
+		Priority: r.Priority,
+	}
+}
+
+// ParseDatasetRuleBundle reads a rule bundle sent as json or, for any other content type, as yaml
+func ParseDatasetRuleBundle(body []byte, contentType string) (datasetmodels.DatasetRuleBundle, error) {
+	var bundle datasetmodels.DatasetRuleBundle
+
+	var err error
+	if strings.HasPrefix(contentType, "application/json") {
+		err = json.Unmarshal(body, &bundle)
+	} else {
+		err = yaml.Unmarshal(body, &bundle)
+	}
+	if err != nil {
+		return datasetmodels.DatasetRuleBundle{}, fmt.Errorf("invalid rule bundle: %w", err)
+	}
+
+	return bundle, nil
+}
@@ -51,3 +51,45 @@ func TestRegisterDatasetRequest_ToModel(t *testing.T) {
 	assert.Equal(t, request.MVConfig, result.MVConfig)
 	assert.Equal(t, request.Provider, result.Provider)
 }
+
+func TestParseDatasetRuleBundle(t *testing.T) {
+	yamlBundle := `
+version: 1
+columns:
+  - column: category
+    rules:
+      - title: Travel
+        value: travel
+        filters:
+          logical_operator: AND
+          conditions:
+            - column: vendor
+              operator: in
+              value: [Uber, Lyft]
+      - title: Office
+        value: office
+        is_enabled: false
+        filters:
+          conditions:
+            - column: vendor
+              operator: eq
+              value: Staples
+`
+	bundle, err := ParseDatasetRuleBundle([]byte(yamlBundle), "application/yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, bundle.Version)
+	assert.Len(t, bundle.Columns, 1)
+	assert.Equal(t, "category", bundle.Columns[0].Column)
+	assert.Len(t, bundle.Columns[0].Rules, 2)
+	assert.Nil(t, bundle.Columns[0].Rules[0].IsEnabled)
+	assert.Equal(t, []interface{}{"Uber", "Lyft"}, bundle.Columns[0].Rules[0].Filters.Conditions[0].Value)
+	assert.False(t, *bundle.Columns[0].Rules[1].IsEnabled)
+
+	jsonBundle := `{"version": 1, "columns": [{"column": "category", "rules": [{"title": "Travel", "value": "travel", "filters": {"conditions": [{"column": "vendor", "operator": "eq", "value": "Uber"}]}}]}]}`
+	bundle, err = ParseDatasetRuleBundle([]byte(jsonBundle), "application/json; charset=utf-8")
+	assert.NoError(t, err)
+	assert.Equal(t, "vendor", bundle.Columns[0].Rules[0].Filters.Conditions[0].Column)
+
+	_, err = ParseDatasetRuleBundle([]byte("version: [1"), "application/yaml")
+	assert.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 
 	dataplatformDataTypesConstants "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/constants"
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	rulemodels "github.com/Zampfi/application-platform/services/api/core/rules/models"
 
 	"github.com/google/uuid"
 )
@@ -148,3 +149,550 @@ func (u *DatasetAction) FromModel(model datasetmodels.DatasetAction) {
 type GetDatasetDisplayConfigResponse struct {
 	DisplayConfig []datasetmodels.DisplayConfig `json:"display_config"`
 }
+
+type DatasetRowPolicy struct {
+	ID           uuid.UUID                 `json:"id"`
+	DatasetId    uuid.UUID                 `json:"dataset_id"`
+	AudienceType string                    `json:"audience_type"`
+	AudienceId   uuid.UUID                 `json:"audience_id"`
+	Title        string                    `json:"title"`
+	Description  string                    `json:"description"`
+	Filters      datasetmodels.FilterModel `json:"filters"`
+	CreatedBy    uuid.UUID                 `json:"created_by"`
+	UpdatedBy    uuid.UUID                 `json:"updated_by"`
+	CreatedAt    time.Time                 `json:"created_at"`
+	UpdatedAt    time.Time                 `json:"updated_at"`
+}
+
+func (p *DatasetRowPolicy) FromModel(model datasetmodels.DatasetRowPolicy) {
+	p.ID = model.ID
+	p.DatasetId = model.DatasetId
+	p.AudienceType = string(model.AudienceType)
+	p.AudienceId = model.AudienceId
+	p.Title = model.Title
+	p.Description = model.Description
+	p.Filters = model.Filters
+	p.CreatedBy = model.CreatedBy
+	p.UpdatedBy = model.UpdatedBy
+	p.CreatedAt = model.CreatedAt
+	p.UpdatedAt = model.UpdatedAt
+}
+
+type DatasetColumnPolicy struct {
+	ID           uuid.UUID `json:"id"`
+	DatasetId    uuid.UUID `json:"dataset_id"`
+	AudienceType string    `json:"audience_type"`
+	AudienceId   uuid.UUID `json:"audience_id"`
+	Column       string    `json:"column"`
+	Action       string    `json:"action"`
+	MaskType     *string   `json:"mask_type"`
+	CreatedBy    uuid.UUID `json:"created_by"`
+	UpdatedBy    uuid.UUID `json:"updated_by"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (p *DatasetColumnPolicy) FromModel(model datasetmodels.DatasetColumnPolicy) {
+	p.ID = model.ID
+	p.DatasetId = model.DatasetId
+	p.AudienceType = string(model.AudienceType)
+	p.AudienceId = model.AudienceId
+	p.Column = model.Column
+	p.Action = string(model.Action)
+	if model.MaskType != nil {
+		maskType := string(*model.MaskType)
+		p.MaskType = &maskType
+	}
+	p.CreatedBy = model.CreatedBy
+	p.UpdatedBy = model.UpdatedBy
+	p.CreatedAt = model.CreatedAt
+	p.UpdatedAt = model.UpdatedAt
+}
+
+type DatasetView struct {
+	ID            uuid.UUID              `json:"id"`
+	DatasetId     uuid.UUID              `json:"dataset_id"`
+	OwnerId       uuid.UUID              `json:"owner_id"`
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	QueryConfig   GetDataRequest         `json:"query_config"`
+	ColumnOrder   []string               `json:"column_order"`
+	DisplayConfig map[string]interface{} `json:"display_config"`
+	IsDefault     bool                   `json:"is_default"`
+	CreatedBy     uuid.UUID              `json:"created_by"`
+	UpdatedBy     uuid.UUID              `json:"updated_by"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+}
+
+func (v *DatasetView) FromModel(model datasetmodels.DatasetView) {
+	v.ID = model.ID
+	v.DatasetId = model.DatasetId
+	v.OwnerId = model.OwnerId
+	v.Name = model.Name
+	v.Description = model.Description
+	v.QueryConfig = GetDataRequest{
+		Filters:      model.QueryConfig.Filters,
+		Aggregations: model.QueryConfig.Aggregations,
+		GroupBy:      model.QueryConfig.GroupBy,
+		OrderBy:      model.QueryConfig.OrderBy,
+		FxCurrency:   model.QueryConfig.FxCurrency,
+	}
+	v.ColumnOrder = model.ColumnOrder
+	if v.ColumnOrder == nil {
+		v.ColumnOrder = []string{}
+	}
+	v.DisplayConfig = model.DisplayConfig
+	v.IsDefault = model.IsDefault
+	v.CreatedBy = model.CreatedBy
+	v.UpdatedBy = model.UpdatedBy
+	v.CreatedAt = model.CreatedAt
+	v.UpdatedAt = model.UpdatedAt
+}
+
+type DatasetExportSchedule struct {
+	ID                uuid.UUID                        `json:"id"`
+	DatasetId         uuid.UUID                        `json:"dataset_id"`
+	OwnerId           uuid.UUID                        `json:"owner_id"`
+	Name              string                           `json:"name"`
+	QueryConfig       GetDataRequest                   `json:"query_config"`
+	Format            string                           `json:"format"`
+	CronExpression    string                           `json:"cron_expression"`
+	Timezone          string                           `json:"timezone"`
+	DestinationType   string                           `json:"destination_type"`
+	DestinationConfig DatasetExportScheduleDestination `json:"destination_config"`
+	IsEnabled         bool                             `json:"is_enabled"`
+	CreatedBy         uuid.UUID                        `json:"created_by"`
+	UpdatedBy         uuid.UUID                        `json:"updated_by"`
+	CreatedAt         time.Time                        `json:"created_at"`
+	UpdatedAt         time.Time                        `json:"updated_at"`
+}
+
+func (s *DatasetExportSchedule) FromModel(model datasetmodels.DatasetExportSchedule) {
+	s.ID = model.ID
+	s.DatasetId = model.DatasetId
+	s.OwnerId = model.OwnerId
+	s.Name = model.Name
+	s.QueryConfig = GetDataRequest{
+		Filters:      model.QueryConfig.Filters,
+		Aggregations: model.QueryConfig.Aggregations,
+		GroupBy:      model.QueryConfig.GroupBy,
+		OrderBy:      model.QueryConfig.OrderBy,
+		FxCurrency:   model.QueryConfig.FxCurrency,
+	}
+	s.Format = string(model.Format)
+	s.CronExpression = model.CronExpression
+	s.Timezone = model.Timezone
+	s.DestinationType = string(model.DestinationType)
+	s.DestinationConfig = DatasetExportScheduleDestination{
+		Provider:   model.DestinationConfig.Provider,
+		Bucket:     model.DestinationConfig.Bucket,
+		Prefix:     model.DestinationConfig.Prefix,
+		Recipients: model.DestinationConfig.Recipients,
+	}
+	s.IsEnabled = model.IsEnabled
+	s.CreatedBy = model.CreatedBy
+	s.UpdatedBy = model.UpdatedBy
+	s.CreatedAt = model.CreatedAt
+	s.UpdatedAt = model.UpdatedAt
+}
+
+type DatasetExportScheduleRun struct {
+	ID          uuid.UUID  `json:"id"`
+	ScheduleId  uuid.UUID  `json:"schedule_id"`
+	WorkflowId  string     `json:"workflow_id"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+func (r *DatasetExportScheduleRun) FromModel(model datasetmodels.DatasetExportScheduleRun) {
+	r.ID = model.ID
+	r.ScheduleId = model.ScheduleId
+	r.WorkflowId = model.WorkflowId
+	r.Status = model.Status
+	r.Error = model.Error
+	r.StartedAt = model.StartedAt
+	r.CompletedAt = model.CompletedAt
+}
+
+type DatasetAlert struct {
+	ID              uuid.UUID                           `json:"id"`
+	DatasetId       uuid.UUID                           `json:"dataset_id"`
+	OwnerId         uuid.UUID                           `json:"owner_id"`
+	Name            string                              `json:"name"`
+	Metric          datasetmodels.DatasetAlertMetric    `json:"metric"`
+	Condition       datasetmodels.DatasetAlertCondition `json:"condition"`
+	CronExpression  string                              `json:"cron_expression"`
+	Timezone        string                              `json:"timezone"`
+	Recipients      []string                            `json:"recipients"`
+	WebhookUrl      string                              `json:"webhook_url"`
+	IsEnabled       bool                                `json:"is_enabled"`
+	State           string                              `json:"state"`
+	LastValue       *float64                            `json:"last_value"`
+	LastEvaluatedAt *time.Time                          `json:"last_evaluated_at"`
+	LastError       string                              `json:"last_error"`
+	SnoozedUntil    *time.Time                          `json:"snoozed_until"`
+	CreatedBy       uuid.UUID                           `json:"created_by"`
+	UpdatedBy       uuid.UUID                           `json:"updated_by"`
+	CreatedAt       time.Time                           `json:"created_at"`
+	UpdatedAt       time.Time                           `json:"updated_at"`
+}
+
+func (a *DatasetAlert) FromModel(model datasetmodels.DatasetAlert) {
+	a.ID = model.ID
+	a.DatasetId = model.DatasetId
+	a.OwnerId = model.OwnerId
+	a.Name = model.Name
+	a.Metric = model.Metric
+	a.Condition = model.Condition
+	a.CronExpression = model.CronExpression
+	a.Timezone = model.Timezone
+	a.Recipients = model.Recipients
+	a.WebhookUrl = model.WebhookUrl
+	a.IsEnabled = model.IsEnabled
+	a.State = model.State
+	a.LastValue = model.LastValue
+	a.LastEvaluatedAt = model.LastEvaluatedAt
+	a.LastError = model.LastError
+	a.SnoozedUntil = model.SnoozedUntil
+	a.CreatedBy = model.CreatedBy
+	a.UpdatedBy = model.UpdatedBy
+	a.CreatedAt = model.CreatedAt
+	a.UpdatedAt = model.UpdatedAt
+}
+
+type DatasetAlertEvent struct {
+	ID              uuid.UUID `json:"id"`
+	AlertId         uuid.UUID `json:"alert_id"`
+	Status          string    `json:"status"`
+	Value           float64   `json:"value"`
+	ComparisonValue *float64  `json:"comparison_value"`
+	Score           *float64  `json:"score"`
+	Message         string    `json:"message"`
+	Notified        bool      `json:"notified"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func (e *DatasetAlertEvent) FromModel(model datasetmodels.DatasetAlertEvent) {
+	e.ID = model.ID
+	e.AlertId = model.AlertId
+	e.Status = model.Status
+	e.Value = model.Value
+	e.ComparisonValue = model.ComparisonValue
+	e.Score = model.Score
+	e.Message = model.Message
+	e.Notified = model.Notified
+	e.CreatedAt = model.CreatedAt
+}
+
+type DatasetStatusWorkflow struct {
+	ID           uuid.UUID                                `json:"id"`
+	DatasetId    uuid.UUID                                `json:"dataset_id"`
+	Column       string                                   `json:"column"`
+	InitialState string                                   `json:"initial_state"`
+	States       []datasetmodels.StatusWorkflowState      `json:"states"`
+	Transitions  []datasetmodels.StatusWorkflowTransition `json:"transitions"`
+	CreatedBy    uuid.UUID                                `json:"created_by"`
+	UpdatedBy    uuid.UUID                                `json:"updated_by"`
+	CreatedAt    time.Time                                `json:"created_at"`
+	UpdatedAt    time.Time                                `json:"updated_at"`
+}
+
+func (w *DatasetStatusWorkflow) FromModel(model datasetmodels.DatasetStatusWorkflow) {
+	w.ID = model.ID
+	w.DatasetId = model.DatasetId
+	w.Column = model.Column
+	w.InitialState = model.InitialState
+	w.States = model.States
+	w.Transitions = model.Transitions
+	w.CreatedBy = model.CreatedBy
+	w.UpdatedBy = model.UpdatedBy
+	w.CreatedAt = model.CreatedAt
+	w.UpdatedAt = model.UpdatedAt
+}
+
+type DatasetRowAssignment struct {
+	DatasetId  uuid.UUID  `json:"dataset_id"`
+	RowId      string     `json:"row_id"`
+	AssigneeId *uuid.UUID `json:"assignee_id"`
+	DueDate    *string    `json:"due_date"`
+	UpdatedBy  uuid.UUID  `json:"updated_by"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func (a *DatasetRowAssignment) FromModel(model datasetmodels.DatasetRowAssignment) {
+	a.DatasetId = model.DatasetId
+	a.RowId = model.RowId
+	a.AssigneeId = model.AssigneeId
+	if model.DueDate != nil {
+		dueDate := model.DueDate.Format(time.DateOnly)
+		a.DueDate = &dueDate
+	}
+	a.UpdatedBy = model.UpdatedBy
+	a.UpdatedAt = model.UpdatedAt
+}
+
+type DatasetQueueItem struct {
+	DatasetRowAssignment
+	DatasetTitle string                 `json:"dataset_title"`
+	Statuses     map[string]string      `json:"statuses"`
+	Row          map[string]interface{} `json:"row"`
+	IsOverdue    bool                   `json:"is_overdue"`
+}
+
+func (i *DatasetQueueItem) FromModel(model datasetmodels.DatasetQueueItem) {
+	i.DatasetRowAssignment.FromModel(model.Assignment)
+	i.DatasetTitle = model.DatasetTitle
+	i.Statuses = model.Statuses
+	i.Row = model.Row
+	i.IsOverdue = model.IsOverdue
+}
+
+// DatasetRule lists the assignments of every rule, a rule with a single value assigns it to its column
+type DatasetRule struct {
+	ID          uuid.UUID                   `json:"id"`
+	DatasetId   uuid.UUID                   `json:"dataset_id"`
+	Column      string                      `json:"column"`
+	Title       string                      `json:"title"`
+	Description string                      `json:"description"`
+	Filters     datasetmodels.FilterModel   `json:"filters"`
+	Value       string                      `json:"value"`
+	Assignments []rulemodels.RuleAssignment `json:"assignments"`
+	Priority    int                         `json:"priority"`
+	IsEnabled   bool                        `json:"is_enabled"`
+	CreatedBy   uuid.UUID                   `json:"created_by"`
+	CreatedAt   time.Time                   `json:"created_at"`
+	UpdatedBy   uuid.UUID                   `json:"updated_by"`
+	UpdatedAt   time.Time                   `json:"updated_at"`
+}
+
+func (r *DatasetRule) FromModel(model rulemodels.Rule) {
+	r.ID = model.ID
+	r.DatasetId = model.DatasetId
+	r.Column = model.Column
+	r.Title = model.Title
+	r.Description = model.Description
+	r.Filters = datasetmodels.RuleFilters(model)
+	r.Value = model.Value
+	r.Assignments = model.GetAssignments()
+	r.Priority = model.Priority
+	r.IsEnabled = model.IsEnabled
+	r.CreatedBy = model.CreatedBy
+	r.CreatedAt = model.CreatedAt
+	r.UpdatedBy = model.UpdatedBy
+	r.UpdatedAt = model.UpdatedAt
+}
+
+type DatasetRuleChange struct {
+	Rule     DatasetRule           `json:"rule"`
+	Action   DatasetAction         `json:"action"`
+	Warnings []DatasetRuleConflict `json:"warnings"`
+}
+
+func (c *DatasetRuleChange) FromModel(model datasetmodels.DatasetRuleChange) {
+	c.Rule.FromModel(model.Rule)
+	c.Action.FromModel(model.Action)
+	c.Warnings = make([]DatasetRuleConflict, len(model.Warnings))
+	for i, warning := range model.Warnings {
+		c.Warnings[i].FromModel(warning)
+	}
+}
+
+type DatasetRulePreview struct {
+	Column          string                  `json:"column"`
+	ProposedValue   interface{}             `json:"proposed_value"`
+	Priority        int                     `json:"priority"`
+	MatchCount      int64                   `json:"match_count"`
+	OverriddenCount int64                   `json:"overridden_count"`
+	Rows            []DatasetRulePreviewRow `json:"rows"`
+	Overrides       []DatasetRuleOverride   `json:"overrides"`
+}
+
+type DatasetRulePreviewRow struct {
+	RowId          string      `json:"row_id"`
+	CurrentValue   interface{} `json:"current_value"`
+	ProposedValue  interface{} `json:"proposed_value"`
+	EffectiveValue interface{} `json:"effective_value"`
+	OverriddenBy   *uuid.UUID  `json:"overridden_by"`
+}
+
+type DatasetRuleOverride struct {
+	RuleId     uuid.UUID `json:"rule_id"`
+	Title      string    `json:"title"`
+	Priority   int       `json:"priority"`
+	Value      string    `json:"value"`
+	MatchCount int64     `json:"match_count"`
+}
+
+func (p *DatasetRulePreview) FromModel(model datasetmodels.DatasetRulePreview) {
+	p.Column = model.Column
+	p.ProposedValue = model.ProposedValue
+	p.Priority = model.Priority
+	p.MatchCount = model.MatchCount
+	p.OverriddenCount = model.OverriddenCount
+
+	p.Rows = make([]DatasetRulePreviewRow, len(model.Rows))
+	for i, row := range model.Rows {
+		p.Rows[i] = DatasetRulePreviewRow(row)
+	}
+
+	p.Overrides = make([]DatasetRuleOverride, len(model.Overrides))
+	for i, override := range model.Overrides {
+		p.Overrides[i] = DatasetRuleOverride(override)
+	}
+}
+
+type DatasetRuleConflict struct {
+	Type         string      `json:"type"`
+	RuleId       uuid.UUID   `json:"rule_id"`
+	OtherRuleIds []uuid.UUID `json:"other_rule_ids"`
+	RowCount     *int64      `json:"row_count"`
+	Sampled      bool        `json:"sampled"`
+	Source       string      `json:"source"`
+}
+
+func (c *DatasetRuleConflict) FromModel(model datasetmodels.DatasetRuleConflict) {
+	c.Type = string(model.Type)
+	c.RuleId = model.RuleId
+	c.OtherRuleIds = model.OtherRuleIds
+	if c.OtherRuleIds == nil {
+		c.OtherRuleIds = []uuid.UUID{}
+	}
+	c.RowCount = model.RowCount
+	c.Sampled = model.Sampled
+	c.Source = string(model.Source)
+}
+
+type DatasetRuleAnalysis struct {
+	Column    string                `json:"column"`
+	RuleCount int                   `json:"rule_count"`
+	Truncated bool                  `json:"truncated"`
+	Conflicts []DatasetRuleConflict `json:"conflicts"`
+}
+
+func (a *DatasetRuleAnalysis) FromModel(model datasetmodels.DatasetRuleAnalysis) {
+	a.Column = model.Column
+	a.RuleCount = model.RuleCount
+	a.Truncated = model.Truncated
+	a.Conflicts = make([]DatasetRuleConflict, len(model.Conflicts))
+	for i, conflict := range model.Conflicts {
+		a.Conflicts[i].FromModel(conflict)
+	}
+}
+
+type DatasetRuleVersionChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+type DatasetRuleVersion struct {
+	Version     int                         `json:"version"`
+	ChangeType  string                      `json:"change_type"`
+	Title       string                      `json:"title"`
+	Description string                      `json:"description"`
+	Value       string                      `json:"value"`
+	Assignments []rulemodels.RuleAssignment `json:"assignments"`
+	Filters     datasetmodels.FilterModel   `json:"filters"`
+	IsEnabled   bool                        `json:"is_enabled"`
+	CreatedAt   time.Time                   `json:"created_at"`
+	CreatedBy   uuid.UUID                   `json:"created_by"`
+	Changes     []DatasetRuleVersionChange  `json:"changes"`
+}
+
+func (v *DatasetRuleVersion) FromModel(model datasetmodels.DatasetRuleVersion) {
+	v.Version = model.Version
+	v.ChangeType = string(model.ChangeType)
+	v.Title = model.Title
+	v.Description = model.Description
+	v.Value = model.Value
+	v.Assignments = model.Assignments
+	if v.Assignments == nil {
+		v.Assignments = []rulemodels.RuleAssignment{}
+	}
+	v.Filters = model.Filters
+	v.IsEnabled = model.IsEnabled
+	v.CreatedAt = model.CreatedAt
+	v.CreatedBy = model.CreatedBy
+	v.Changes = make([]DatasetRuleVersionChange, len(model.Changes))
+	for i, change := range model.Changes {
+		v.Changes[i] = DatasetRuleVersionChange{Field: change.Field, From: change.From, To: change.To}
+	}
+}
+
+type DatasetRuleRun struct {
+	ActionId    string    `json:"action_id"`
+	MatchedRows int64     `json:"matched_rows"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type DatasetRuleStats struct {
+	RuleId         uuid.UUID        `json:"rule_id"`
+	Title          string           `json:"title"`
+	Column         string           `json:"column"`
+	Priority       int              `json:"priority"`
+	IsEnabled      bool             `json:"is_enabled"`
+	AttributedRows *int64           `json:"attributed_rows"`
+	Runs           []DatasetRuleRun `json:"runs"`
+	IsStale        bool             `json:"is_stale"`
+}
+
+func (s *DatasetRuleStats) FromModel(model datasetmodels.DatasetRuleStats) {
+	s.RuleId = model.RuleId
+	s.Title = model.Title
+	s.Column = model.Column
+	s.Priority = model.Priority
+	s.IsEnabled = model.IsEnabled
+	s.AttributedRows = model.AttributedRows
+	s.Runs = make([]DatasetRuleRun, len(model.Runs))
+	for i, run := range model.Runs {
+		s.Runs[i] = DatasetRuleRun{ActionId: run.ActionId, MatchedRows: run.MatchedRows, CreatedAt: run.CreatedAt}
+	}
+	s.IsStale = model.IsStale
+}
+
+type DatasetRuleBundleDiff struct {
+	Column  string                     `json:"column"`
+	Title   string                     `json:"title"`
+	RuleId  *uuid.UUID                 `json:"rule_id"`
+	Changes []DatasetRuleVersionChange `json:"changes"`
+}
+
+type DatasetRuleBundleImport struct {
+	DryRun    bool                    `json:"dry_run"`
+	Added     []DatasetRuleBundleDiff `json:"added"`
+	Changed   []DatasetRuleBundleDiff `json:"changed"`
+	Removed   []DatasetRuleBundleDiff `json:"removed"`
+	Unchanged int                     `json:"unchanged"`
+	Actions   []DatasetAction         `json:"actions"`
+}
+
+func (i *DatasetRuleBundleImport) FromModel(model datasetmodels.DatasetRuleBundleImport) {
+	i.DryRun = model.DryRun
+	i.Added = fromDatasetRuleBundleDiffs(model.Added)
+	i.Changed = fromDatasetRuleBundleDiffs(model.Changed)
+	i.Removed = fromDatasetRuleBundleDiffs(model.Removed)
+	i.Unchanged = model.Unchanged
+	i.Actions = make([]DatasetAction, len(model.Actions))
+	for j, action := range model.Actions {
+		i.Actions[j].FromModel(action)
+	}
+}
+
+func fromDatasetRuleBundleDiffs(models []datasetmodels.DatasetRuleBundleDiff) []DatasetRuleBundleDiff {
+	diffs := make([]DatasetRuleBundleDiff, len(models))
+	for i, model := range models {
+		diffs[i] = DatasetRuleBundleDiff{
+			Column:  model.Column,
+			Title:   model.Title,
+			RuleId:  model.RuleId,
+			Changes: make([]DatasetRuleVersionChange, len(model.Changes)),
+		}
+		for j, change := range model.Changes {
+			diffs[i].Changes[j] = DatasetRuleVersionChange{Field: change.Field, From: change.From, To: change.To}
+		}
+	}
+	return diffs
+}
@@ -2,12 +2,17 @@ package datasets
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/Zampfi/application-platform/services/api/core/auditlogs"
 	actionmodels "github.com/Zampfi/application-platform/services/api/core/dataplatform/actions/models"
 	dataplatformDataModels "github.com/Zampfi/application-platform/services/api/core/dataplatform/data/models"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	datasetErrors "github.com/Zampfi/application-platform/services/api/core/datasets/errors"
 	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
 	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
 	"github.com/Zampfi/application-platform/services/api/core/fileimports"
@@ -15,6 +20,8 @@ import (
 	querybuildermodels "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/models"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
 
 	apictx "github.com/Zampfi/application-platform/services/api/helper/context"
 
@@ -43,22 +50,15 @@ func GetFilterConfig(c *gin.Context, svc datasetservice.DatasetService) {
 func GetData(c *gin.Context, svc datasetservice.DatasetService) {
 	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
 
-	jsonStringConfig := c.Query("query_config")
-	if jsonStringConfig == "" {
-		jsonStringConfig = "{}"
-	}
-
-	var getDataRequest dtos.GetDataRequest
-	if err := json.Unmarshal([]byte(jsonStringConfig), &getDataRequest); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON format: " + err.Error()})
+	queryConfig, status, err := parseDataParams(c, svc, ctx)
+	if err != nil {
+		c.JSON(status, gin.H{"error": err.Error()})
 		return
 	}
 
-	queryConfig := getDataRequest.ToModel()
-
 	data, err := svc.GetDataByDatasetId(c, ctx.MerchantID, ctx.DatasetID, queryConfig)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(dataErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -102,7 +102,7 @@ func UpdateDatasetData(c *gin.Context, svc datasetservice.DatasetService) {
 
 	datasetAction, err := svc.UpdateDatasetData(c, ctx.MerchantID, datasetId, updateDatasetParams)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(statusWorkflowErrorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -607,21 +607,20 @@ func CreateDatasetExportAction(c *gin.Context, svc datasetservice.DatasetService
 	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
 	_, userId, _ := apictx.GetAuthFromContext(c)
 
-	jsonStringConfig := c.Query("query_config")
-	if jsonStringConfig == "" {
-		jsonStringConfig = "{}"
-	}
-
-	var getDataRequest dtos.GetDataRequest
-	if err := json.Unmarshal([]byte(jsonStringConfig), &getDataRequest); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON format: " + err.Error()})
+	queryConfig, status, err := parseDataParams(c, svc, ctx)
+	if err != nil {
+		c.JSON(status, gin.H{"error": err.Error()})
 		return
 	}
 
-	queryConfig := getDataRequest.ToModel()
+	format := datasetConstants.ExportFormat(strings.ToLower(c.DefaultQuery("format", string(datasetConstants.ExportFormatCSV))))
 
-	workflowID, err := svc.CreateDatasetExportAction(c, ctx.MerchantID, ctx.DatasetID, queryConfig, *userId)
+	workflowID, err := svc.CreateDatasetExportAction(c, ctx.MerchantID, ctx.DatasetID, queryConfig, format, *userId)
 	if err != nil {
+		if errors.Is(err, datasetErrors.ErrInvalidExportFormat) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -828,3 +827,1972 @@ func GetFileImportsHistory(c *gin.Context, svc datasetservice.DatasetService, fi
 	c.JSON(http.StatusOK, gin.H{"file_uploads": fileUploads})
 
 }
+
+func GetDatasetRowPolicies(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	policies, err := svc.GetDatasetRowPolicies(c, datasetId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetRowPolicy, len(policies))
+	for i, policy := range policies {
+		response[i].FromModel(policy)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateDatasetRowPolicy(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.DatasetRowPolicyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy, err := svc.CreateDatasetRowPolicy(c, ctx.MerchantID, *ctx.UserID, datasetId, request.ToModel())
+	if err != nil {
+		c.JSON(rowPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRowPolicy{}
+	response.FromModel(policy)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateDatasetRowPolicy(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	policyId, err := uuid.Parse(c.Param("policyId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid policy id"})
+		return
+	}
+
+	var request dtos.DatasetRowPolicyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy, err := svc.UpdateDatasetRowPolicy(c, ctx.MerchantID, *ctx.UserID, datasetId, policyId, request.ToModel())
+	if err != nil {
+		c.JSON(rowPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRowPolicy{}
+	response.FromModel(policy)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteDatasetRowPolicy(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	policyId, err := uuid.Parse(c.Param("policyId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid policy id"})
+		return
+	}
+
+	if err := svc.DeleteDatasetRowPolicy(c, *ctx.UserID, datasetId, policyId); err != nil {
+		c.JSON(rowPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "dataset row policy deleted"})
+}
+
+func PreviewDatasetRowPolicies(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	previewUserId, err := uuid.Parse(c.Query("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user id"})
+		return
+	}
+
+	jsonStringConfig := c.Query("query_config")
+	if jsonStringConfig == "" {
+		jsonStringConfig = "{}"
+	}
+
+	var getDataRequest dtos.GetDataRequest
+	if err := json.Unmarshal([]byte(jsonStringConfig), &getDataRequest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid JSON format: " + err.Error()})
+		return
+	}
+
+	data, err := svc.PreviewDatasetDataForUser(c, ctx.MerchantID, ctx.DatasetID, previewUserId, getDataRequest.ToModel())
+	if err != nil {
+		c.JSON(dataErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	description := ""
+	if data.Description != nil {
+		description = *data.Description
+	}
+
+	response := dtos.GetDataResponse{
+		Title:       data.Title,
+		Description: description,
+		Data:        dtos.DatasetData{},
+	}
+	response.Data.FromModel(data)
+	c.JSON(http.StatusOK, response)
+}
+
+func rowPolicyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, datasetErrors.ErrRowPolicyNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, datasetErrors.ErrInvalidRowPolicyAudienceType),
+		errors.Is(err, datasetErrors.ErrEmptyRowPolicyFilters),
+		errors.Is(err, datasetErrors.ErrInvalidRowPolicyColumn):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func GetDatasetColumnPolicies(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	policies, err := svc.GetDatasetColumnPolicies(c, datasetId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetColumnPolicy, len(policies))
+	for i, policy := range policies {
+		response[i].FromModel(policy)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateDatasetColumnPolicy(c *gin.Context, svc datasetservice.DatasetService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.DatasetColumnPolicyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy, err := svc.CreateDatasetColumnPolicy(c, ctx.MerchantID, *ctx.UserID, datasetId, request.ToModel())
+	if err != nil {
+		c.JSON(columnPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetColumnPolicy{}
+	response.FromModel(policy)
+
+	emitColumnPolicyAuditLog(c, auditLogService, datasetId, datasetConstants.AuditLogEventColumnPolicyCreated, response)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateDatasetColumnPolicy(c *gin.Context, svc datasetservice.DatasetService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	policyId, err := uuid.Parse(c.Param("policyId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid policy id"})
+		return
+	}
+
+	var request dtos.DatasetColumnPolicyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy, err := svc.UpdateDatasetColumnPolicy(c, ctx.MerchantID, *ctx.UserID, datasetId, policyId, request.ToModel())
+	if err != nil {
+		c.JSON(columnPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetColumnPolicy{}
+	response.FromModel(policy)
+
+	emitColumnPolicyAuditLog(c, auditLogService, datasetId, datasetConstants.AuditLogEventColumnPolicyUpdated, response)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteDatasetColumnPolicy(c *gin.Context, svc datasetservice.DatasetService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	policyId, err := uuid.Parse(c.Param("policyId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid policy id"})
+		return
+	}
+
+	policy, err := svc.DeleteDatasetColumnPolicy(c, *ctx.UserID, datasetId, policyId)
+	if err != nil {
+		c.JSON(columnPolicyErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	deletedPolicy := dtos.DatasetColumnPolicy{}
+	deletedPolicy.FromModel(policy)
+
+	emitColumnPolicyAuditLog(c, auditLogService, datasetId, datasetConstants.AuditLogEventColumnPolicyDeleted, deletedPolicy)
+
+	c.JSON(http.StatusOK, gin.H{"message": "dataset column policy deleted"})
+}
+
+// emitColumnPolicyAuditLog records a column policy change, a failure to record it does not fail the request
+func emitColumnPolicyAuditLog(c *gin.Context, auditLogService auditlogs.AuditLogServiceWithResource, datasetId uuid.UUID, eventName string, policy dtos.DatasetColumnPolicy) {
+	logger := apictx.GetLoggerFromCtx(c)
+
+	payload := map[string]interface{}{
+		"policy_id":     policy.ID,
+		"audience_type": policy.AudienceType,
+		"audience_id":   policy.AudienceId,
+		"column":        policy.Column,
+		"action":        policy.Action,
+		"mask_type":     policy.MaskType,
+	}
+
+	if err := auditLogService.EmitAuditLog(c, datasetId, dbmodels.AuditLogKindInfo, eventName, payload); err != nil {
+		logger.Error("failed to emit column policy audit log", zap.String("dataset_id", datasetId.String()), zap.String("event", eventName), zap.Error(err))
+	}
+}
+
+func columnPolicyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, datasetErrors.ErrColumnPolicyNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, datasetErrors.ErrInvalidColumnPolicyAudienceType),
+		errors.Is(err, datasetErrors.ErrInvalidColumnPolicyColumn),
+		errors.Is(err, datasetErrors.ErrInvalidColumnPolicyAction),
+		errors.Is(err, datasetErrors.ErrInvalidColumnPolicyMaskType):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func dataErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, datasetErrors.ErrColumnNotFilterable),
+		errors.Is(err, datasetErrors.ErrColumnNotAccessible),
+		errors.Is(err, datasetErrors.ErrPreviewUserNoDatasetAccess),
+		errors.Is(err, datasetErrors.ErrNoUserForDataPolicies):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// parseDataParams reads the query_config query param. When a view_id is also given, the filters, sorts and grouping
+// saved in the view are used instead and only the pagination and totals of query_config are kept.
+func parseDataParams(c *gin.Context, svc datasetservice.DatasetService, ctx middleware.DatasetContext) (models.DatasetParams, int, error) {
+	jsonStringConfig := c.Query("query_config")
+	if jsonStringConfig == "" {
+		jsonStringConfig = "{}"
+	}
+
+	var getDataRequest dtos.GetDataRequest
+	if err := json.Unmarshal([]byte(jsonStringConfig), &getDataRequest); err != nil {
+		return models.DatasetParams{}, http.StatusBadRequest, errors.New("invalid JSON format: " + err.Error())
+	}
+
+	queryConfig := getDataRequest.ToModel()
+
+	viewIdStr := c.Query("view_id")
+	if viewIdStr == "" {
+		return queryConfig, http.StatusOK, nil
+	}
+
+	viewId, err := uuid.Parse(viewIdStr)
+	if err != nil {
+		return models.DatasetParams{}, http.StatusBadRequest, errors.New("invalid view id")
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		return models.DatasetParams{}, http.StatusBadRequest, errors.New("invalid dataset id")
+	}
+
+	view, err := svc.GetDatasetView(c, datasetId, viewId)
+	if err != nil {
+		return models.DatasetParams{}, datasetViewErrorStatus(err), err
+	}
+
+	return view.ApplyTo(queryConfig), http.StatusOK, nil
+}
+
+func GetDatasetViews(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	views, err := svc.GetDatasetViews(c, *ctx.UserID, datasetId)
+	if err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetView, len(views))
+	for i, view := range views {
+		response[i].FromModel(view)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetDatasetView(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	viewId, err := uuid.Parse(c.Param("viewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid view id"})
+		return
+	}
+
+	view, err := svc.GetDatasetView(c, datasetId, viewId)
+	if err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetView{}
+	response.FromModel(view)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateDatasetView(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.DatasetViewRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	view, err := svc.CreateDatasetView(c, ctx.MerchantID, *ctx.UserID, datasetId, request.ToModel())
+	if err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetView{}
+	response.FromModel(view)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateDatasetView(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	viewId, err := uuid.Parse(c.Param("viewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid view id"})
+		return
+	}
+
+	var request dtos.DatasetViewRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	view, err := svc.UpdateDatasetView(c, *ctx.UserID, datasetId, viewId, request.ToModel())
+	if err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetView{}
+	response.FromModel(view)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteDatasetView(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	viewId, err := uuid.Parse(c.Param("viewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid view id"})
+		return
+	}
+
+	if err := svc.DeleteDatasetView(c, *ctx.UserID, datasetId, viewId); err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "dataset view deleted"})
+}
+
+func GetDatasetViewAudiences(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	viewId, err := uuid.Parse(c.Param("viewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid view id"})
+		return
+	}
+
+	audiences, err := svc.GetDatasetViewAudiences(c, datasetId, viewId)
+	if err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, audiences)
+}
+
+func AddDatasetViewAudience(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	viewId, err := uuid.Parse(c.Param("viewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid view id"})
+		return
+	}
+
+	var request dtos.AddAudienceRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy, err := svc.AddAudienceToDatasetView(c, *ctx.UserID, datasetId, viewId, dbmodels.AudienceType(request.AudienceType), request.AudienceId, dbmodels.ResourcePrivilege(request.Role))
+	if err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+func UpdateDatasetViewAudience(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	viewId, err := uuid.Parse(c.Param("viewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid view id"})
+		return
+	}
+
+	var request dtos.UpdateAudienceRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy, err := svc.UpdateDatasetViewAudiencePrivilege(c, *ctx.UserID, datasetId, viewId, request.AudiencId, dbmodels.ResourcePrivilege(request.Role))
+	if err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+func DeleteDatasetViewAudience(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	viewId, err := uuid.Parse(c.Param("viewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid view id"})
+		return
+	}
+
+	var request dtos.DeleteDatasetViewAudienceRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := svc.RemoveAudienceFromDatasetView(c, *ctx.UserID, datasetId, viewId, request.AudienceType, request.AudienceId); err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "success"})
+}
+
+func GetDefaultDatasetView(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	view, err := svc.GetDefaultDatasetView(c, *ctx.UserID, datasetId)
+	if err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	if view == nil {
+		c.JSON(http.StatusOK, gin.H{"view": nil})
+		return
+	}
+
+	response := dtos.DatasetView{}
+	response.FromModel(*view)
+
+	c.JSON(http.StatusOK, gin.H{"view": response})
+}
+
+func SetDefaultDatasetView(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.SetDefaultDatasetViewRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	view, err := svc.SetDefaultDatasetView(c, *ctx.UserID, datasetId, request.ViewId)
+	if err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetView{}
+	response.FromModel(view)
+
+	c.JSON(http.StatusOK, gin.H{"view": response})
+}
+
+func ClearDefaultDatasetView(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	if err := svc.ClearDefaultDatasetView(c, *ctx.UserID, datasetId); err != nil {
+		c.JSON(datasetViewErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "default dataset view cleared"})
+}
+
+func datasetViewErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, datasetErrors.ErrDatasetViewNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, datasetErrors.ErrDatasetViewAccessForbidden),
+		errors.Is(err, datasetErrors.ErrCannotRemoveDatasetViewOwner):
+		return http.StatusForbidden
+	case errors.Is(err, datasetErrors.ErrEmptyDatasetViewName),
+		errors.Is(err, datasetErrors.ErrInvalidDatasetViewPrivilege),
+		errors.Is(err, datasetErrors.ErrInvalidDatasetViewAudienceType),
+		errors.Is(err, datasetErrors.ErrDatasetViewAudienceAlreadyAdded):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func GetDatasetExportSchedules(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	schedules, err := svc.GetDatasetExportSchedules(c, datasetId)
+	if err != nil {
+		c.JSON(datasetExportScheduleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetExportSchedule, len(schedules))
+	for i, schedule := range schedules {
+		response[i].FromModel(schedule)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetDatasetExportSchedule(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	scheduleId, err := uuid.Parse(c.Param("scheduleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid schedule id"})
+		return
+	}
+
+	schedule, err := svc.GetDatasetExportSchedule(c, datasetId, scheduleId)
+	if err != nil {
+		c.JSON(datasetExportScheduleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetExportSchedule{}
+	response.FromModel(schedule)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateDatasetExportSchedule(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.DatasetExportScheduleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := svc.CreateDatasetExportSchedule(c, ctx.MerchantID, *ctx.UserID, datasetId, request.ToModel())
+	if err != nil {
+		c.JSON(datasetExportScheduleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetExportSchedule{}
+	response.FromModel(schedule)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateDatasetExportSchedule(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	scheduleId, err := uuid.Parse(c.Param("scheduleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid schedule id"})
+		return
+	}
+
+	var request dtos.DatasetExportScheduleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	schedule, err := svc.UpdateDatasetExportSchedule(c, *ctx.UserID, datasetId, scheduleId, request.ToModel())
+	if err != nil {
+		c.JSON(datasetExportScheduleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetExportSchedule{}
+	response.FromModel(schedule)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteDatasetExportSchedule(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	scheduleId, err := uuid.Parse(c.Param("scheduleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid schedule id"})
+		return
+	}
+
+	if err := svc.DeleteDatasetExportSchedule(c, *ctx.UserID, datasetId, scheduleId); err != nil {
+		c.JSON(datasetExportScheduleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "dataset export schedule deleted"})
+}
+
+func GetDatasetExportScheduleRuns(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	scheduleId, err := uuid.Parse(c.Param("scheduleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid schedule id"})
+		return
+	}
+
+	runs, err := svc.GetDatasetExportScheduleRuns(c, datasetId, scheduleId)
+	if err != nil {
+		c.JSON(datasetExportScheduleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetExportScheduleRun, len(runs))
+	for i, run := range runs {
+		response[i].FromModel(run)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func datasetExportScheduleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, datasetErrors.ErrDatasetExportScheduleNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, datasetErrors.ErrExportScheduleAccessForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, datasetErrors.ErrEmptyDatasetExportScheduleName),
+		errors.Is(err, datasetErrors.ErrInvalidExportFormat),
+		errors.Is(err, datasetErrors.ErrInvalidCronExpression),
+		errors.Is(err, datasetErrors.ErrInvalidTimezone),
+		errors.Is(err, datasetErrors.ErrInvalidExportDestination):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func GetDatasetAlerts(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	alerts, err := svc.GetDatasetAlerts(c, datasetId)
+	if err != nil {
+		c.JSON(datasetAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetAlert, len(alerts))
+	for i, alert := range alerts {
+		response[i].FromModel(alert)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetDatasetAlert(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	alertId, err := uuid.Parse(c.Param("alertId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert id"})
+		return
+	}
+
+	alert, err := svc.GetDatasetAlert(c, datasetId, alertId)
+	if err != nil {
+		c.JSON(datasetAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetAlert{}
+	response.FromModel(alert)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateDatasetAlert(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.DatasetAlertRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	alert, err := svc.CreateDatasetAlert(c, ctx.MerchantID, *ctx.UserID, datasetId, request.ToModel())
+	if err != nil {
+		c.JSON(datasetAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetAlert{}
+	response.FromModel(alert)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateDatasetAlert(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	alertId, err := uuid.Parse(c.Param("alertId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert id"})
+		return
+	}
+
+	var request dtos.DatasetAlertRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	alert, err := svc.UpdateDatasetAlert(c, *ctx.UserID, datasetId, alertId, request.ToModel())
+	if err != nil {
+		c.JSON(datasetAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetAlert{}
+	response.FromModel(alert)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteDatasetAlert(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	alertId, err := uuid.Parse(c.Param("alertId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert id"})
+		return
+	}
+
+	if err := svc.DeleteDatasetAlert(c, *ctx.UserID, datasetId, alertId); err != nil {
+		c.JSON(datasetAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "dataset alert deleted"})
+}
+
+func SnoozeDatasetAlert(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	alertId, err := uuid.Parse(c.Param("alertId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert id"})
+		return
+	}
+
+	var request dtos.SnoozeDatasetAlertRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	alert, err := svc.SnoozeDatasetAlert(c, *ctx.UserID, datasetId, alertId, request.Until)
+	if err != nil {
+		c.JSON(datasetAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetAlert{}
+	response.FromModel(alert)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UnsnoozeDatasetAlert(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	alertId, err := uuid.Parse(c.Param("alertId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert id"})
+		return
+	}
+
+	alert, err := svc.UnsnoozeDatasetAlert(c, *ctx.UserID, datasetId, alertId)
+	if err != nil {
+		c.JSON(datasetAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetAlert{}
+	response.FromModel(alert)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetDatasetAlertEvents(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	alertId, err := uuid.Parse(c.Param("alertId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid alert id"})
+		return
+	}
+
+	events, err := svc.GetDatasetAlertEvents(c, datasetId, alertId)
+	if err != nil {
+		c.JSON(datasetAlertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetAlertEvent, len(events))
+	for i, event := range events {
+		response[i].FromModel(event)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func datasetAlertErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, datasetErrors.ErrDatasetAlertNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, datasetErrors.ErrDatasetAlertAccessForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, datasetErrors.ErrEmptyDatasetAlertName),
+		errors.Is(err, datasetErrors.ErrInvalidAlertMetric),
+		errors.Is(err, datasetErrors.ErrInvalidAlertCondition),
+		errors.Is(err, datasetErrors.ErrInvalidAlertRecipients),
+		errors.Is(err, datasetErrors.ErrInvalidAlertWebhookUrl),
+		errors.Is(err, datasetErrors.ErrInvalidAlertSnooze),
+		errors.Is(err, datasetErrors.ErrInvalidCronExpression),
+		errors.Is(err, datasetErrors.ErrInvalidTimezone):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func GetDatasetStatusWorkflows(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	workflows, err := svc.GetDatasetStatusWorkflows(c, datasetId)
+	if err != nil {
+		c.JSON(statusWorkflowErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetStatusWorkflow, len(workflows))
+	for i, workflow := range workflows {
+		response[i].FromModel(workflow)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateDatasetStatusWorkflow(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.DatasetStatusWorkflowRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	workflow, err := svc.CreateDatasetStatusWorkflow(c, ctx.MerchantID, *ctx.UserID, datasetId, request.ToModel())
+	if err != nil {
+		c.JSON(statusWorkflowErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetStatusWorkflow{}
+	response.FromModel(workflow)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateDatasetStatusWorkflow(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	workflowId, err := uuid.Parse(c.Param("workflowId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid status workflow id"})
+		return
+	}
+
+	var request dtos.DatasetStatusWorkflowRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	workflow, err := svc.UpdateDatasetStatusWorkflow(c, ctx.MerchantID, *ctx.UserID, datasetId, workflowId, request.ToModel())
+	if err != nil {
+		c.JSON(statusWorkflowErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetStatusWorkflow{}
+	response.FromModel(workflow)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteDatasetStatusWorkflow(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	workflowId, err := uuid.Parse(c.Param("workflowId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid status workflow id"})
+		return
+	}
+
+	if err := svc.DeleteDatasetStatusWorkflow(c, *ctx.UserID, datasetId, workflowId); err != nil {
+		c.JSON(statusWorkflowErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "dataset status workflow deleted"})
+}
+
+func TransitionDatasetRowStatus(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.TransitionDatasetRowStatusRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	datasetAction, err := svc.TransitionDatasetRowStatus(c, ctx.MerchantID, *ctx.UserID, datasetId, c.Param("rowId"), request.Column, request.State)
+	if err != nil {
+		c.JSON(statusWorkflowErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetAction{}
+	response.FromModel(datasetAction)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetDatasetRowAssignment(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	assignment, err := svc.GetDatasetRowAssignment(c, datasetId, c.Param("rowId"))
+	if err != nil {
+		c.JSON(statusWorkflowErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	if assignment == nil {
+		c.JSON(http.StatusOK, nil)
+		return
+	}
+
+	response := dtos.DatasetRowAssignment{}
+	response.FromModel(*assignment)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func AssignDatasetRow(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.DatasetRowAssignmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	params, err := request.ToModel()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	assignment, err := svc.AssignDatasetRow(c, ctx.MerchantID, *ctx.UserID, datasetId, c.Param("rowId"), params)
+	if err != nil {
+		c.JSON(statusWorkflowErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRowAssignment{}
+	response.FromModel(assignment)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetMyQueue(c *gin.Context, svc datasetservice.DatasetService) {
+	_, userId, merchantIds := apictx.GetAuthFromContext(c)
+	if userId == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+	if len(merchantIds) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid merchant id"})
+		return
+	}
+
+	items, err := svc.GetMyQueue(c, merchantIds[0], *userId)
+	if err != nil {
+		c.JSON(statusWorkflowErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetQueueItem, len(items))
+	for i, item := range items {
+		response[i].FromModel(item)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func statusWorkflowErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, datasetErrors.ErrStatusWorkflowNotFound),
+		errors.Is(err, datasetErrors.ErrDatasetRowNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, datasetErrors.ErrStatusWorkflowExists):
+		return http.StatusConflict
+	case errors.Is(err, datasetErrors.ErrStatusTransitionForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, datasetErrors.ErrInvalidStatusWorkflowColumn),
+		errors.Is(err, datasetErrors.ErrInvalidStatusWorkflowStates),
+		errors.Is(err, datasetErrors.ErrInvalidStatusWorkflowTransitions),
+		errors.Is(err, datasetErrors.ErrInvalidStatusValue),
+		errors.Is(err, datasetErrors.ErrInvalidStatusTransition),
+		errors.Is(err, datasetErrors.ErrInvalidRowAssignee):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func GetDatasetRules(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	rules, err := svc.GetDatasetRules(c, ctx.MerchantID, datasetId)
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetRule, len(rules))
+	for i, rule := range rules {
+		response[i].FromModel(rule)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetDatasetRule(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	ruleId, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	rule, err := svc.GetDatasetRule(c, datasetId, ruleId)
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRule{}
+	response.FromModel(rule)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateDatasetRule(c *gin.Context, svc datasetservice.DatasetService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.DatasetRuleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	change, err := svc.CreateDatasetRule(c, ctx.MerchantID, *ctx.UserID, datasetId, request.ToModel())
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRuleChange{}
+	response.FromModel(change)
+
+	emitRuleAuditLog(c, auditLogService, datasetId, datasetConstants.AuditLogEventRuleCreated, response)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateDatasetRule(c *gin.Context, svc datasetservice.DatasetService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	ruleId, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	var request dtos.DatasetRuleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	change, err := svc.UpdateDatasetRule(c, ctx.MerchantID, *ctx.UserID, datasetId, ruleId, request.ToModel())
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRuleChange{}
+	response.FromModel(change)
+
+	emitRuleAuditLog(c, auditLogService, datasetId, datasetConstants.AuditLogEventRuleUpdated, response)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func SetDatasetRuleEnabled(c *gin.Context, svc datasetservice.DatasetService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	ruleId, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	var request dtos.SetDatasetRuleEnabledRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	change, err := svc.SetDatasetRuleEnabled(c, ctx.MerchantID, *ctx.UserID, datasetId, ruleId, *request.IsEnabled)
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRuleChange{}
+	response.FromModel(change)
+
+	eventName := datasetConstants.AuditLogEventRuleDisabled
+	if *request.IsEnabled {
+		eventName = datasetConstants.AuditLogEventRuleEnabled
+	}
+	emitRuleAuditLog(c, auditLogService, datasetId, eventName, response)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteDatasetRule(c *gin.Context, svc datasetservice.DatasetService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	ruleId, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	change, err := svc.DeleteDatasetRule(c, ctx.MerchantID, *ctx.UserID, datasetId, ruleId)
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRuleChange{}
+	response.FromModel(change)
+
+	emitRuleAuditLog(c, auditLogService, datasetId, datasetConstants.AuditLogEventRuleDeleted, response)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PreviewDatasetRule shows what a candidate rule would change, nothing is saved or applied
+func PreviewDatasetRule(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	var request dtos.DatasetRulePreviewRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	preview, err := svc.PreviewDatasetRule(c, ctx.MerchantID, datasetId, request.ToModel())
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRulePreview{}
+	response.FromModel(preview)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func AnalyzeDatasetRules(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	analyses, err := svc.AnalyzeDatasetRules(c, ctx.MerchantID, datasetId, c.Query("column"))
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetRuleAnalysis, len(analyses))
+	for i, analysis := range analyses {
+		response[i].FromModel(analysis)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetDatasetRuleVersions(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	ruleId, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	versions, err := svc.GetDatasetRuleVersions(c, ctx.MerchantID, datasetId, ruleId)
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetRuleVersion, len(versions))
+	for i, version := range versions {
+		response[i].FromModel(version)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func RestoreDatasetRuleVersion(c *gin.Context, svc datasetservice.DatasetService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	ruleId, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule version"})
+		return
+	}
+
+	change, err := svc.RestoreDatasetRuleVersion(c, ctx.MerchantID, *ctx.UserID, datasetId, ruleId, version)
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRuleChange{}
+	response.FromModel(change)
+
+	emitRuleAuditLog(c, auditLogService, datasetId, datasetConstants.AuditLogEventRuleRestored, response)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetDatasetRuleStats(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	ruleId, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid rule id"})
+		return
+	}
+
+	stats, err := svc.GetDatasetRuleStats(c, ctx.MerchantID, datasetId, ruleId)
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRuleStats{}
+	response.FromModel(stats)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetDatasetRulesStats lists the effectiveness of every rule of the dataset so that stale rules can be pruned
+func GetDatasetRulesStats(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	stats, err := svc.GetDatasetRulesStats(c, ctx.MerchantID, datasetId)
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.DatasetRuleStats, len(stats))
+	for i, ruleStats := range stats {
+		response[i].FromModel(ruleStats)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ExportDatasetRuleBundle downloads the rules of the dataset as a yaml bundle, or as json with format=json.
+// Repeated column parameters restrict the bundle to these columns.
+func ExportDatasetRuleBundle(c *gin.Context, svc datasetservice.DatasetService) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	format := c.DefaultQuery("format", "yaml")
+	if format != "yaml" && format != "json" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid format, expected yaml or json"})
+		return
+	}
+
+	bundle, err := svc.ExportDatasetRuleBundle(c, ctx.MerchantID, datasetId, c.QueryArray("column"))
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=rules_%s.%s", datasetId.String(), format))
+	if format == "json" {
+		c.JSON(http.StatusOK, bundle)
+		return
+	}
+
+	body, err := yaml.Marshal(bundle)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Data(http.StatusOK, "application/yaml", body)
+}
+
+// ImportDatasetRuleBundle replaces the rules of the columns of a bundle, with dry_run=true only the diff is returned
+func ImportDatasetRuleBundle(c *gin.Context, svc datasetservice.DatasetService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	ctx := c.MustGet("datasetContext").(middleware.DatasetContext)
+	if ctx.UserID == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return
+	}
+
+	datasetId, err := uuid.Parse(ctx.DatasetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid dataset id"})
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	bundle, err := dtos.ParseDatasetRuleBundle(body, c.ContentType())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	dryRun := c.Query("dry_run") == "true"
+	result, err := svc.ImportDatasetRuleBundle(c, ctx.MerchantID, *ctx.UserID, datasetId, models.ImportDatasetRuleBundleParams{
+		Bundle: bundle,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		c.JSON(ruleErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.DatasetRuleBundleImport{}
+	response.FromModel(result)
+
+	if !dryRun && len(response.Actions) > 0 {
+		logger := apictx.GetLoggerFromCtx(c)
+		payload := map[string]interface{}{
+			"added":     response.Added,
+			"changed":   response.Changed,
+			"removed":   response.Removed,
+			"unchanged": response.Unchanged,
+		}
+		if err := auditLogService.EmitAuditLog(c, datasetId, dbmodels.AuditLogKindInfo, datasetConstants.AuditLogEventRuleBundleImported, payload); err != nil {
+			logger.Error("failed to emit rule bundle audit log", zap.String("dataset_id", datasetId.String()), zap.Error(err))
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// emitRuleAuditLog records a rule change with the action that re-applied the rules of its column
+func emitRuleAuditLog(c *gin.Context, auditLogService auditlogs.AuditLogServiceWithResource, datasetId uuid.UUID, eventName string, change dtos.DatasetRuleChange) {
+	logger := apictx.GetLoggerFromCtx(c)
+
+	payload := map[string]interface{}{
+		"rule_id":    change.Rule.ID,
+		"title":      change.Rule.Title,
+		"column":     change.Rule.Column,
+		"value":      change.Rule.Value,
+		"filters":    change.Rule.Filters,
+		"priority":   change.Rule.Priority,
+		"is_enabled": change.Rule.IsEnabled,
+		"action_id":  change.Action.ActionId,
+	}
+
+	if err := auditLogService.EmitAuditLog(c, datasetId, dbmodels.AuditLogKindInfo, eventName, payload); err != nil {
+		logger.Error("failed to emit rule audit log", zap.String("dataset_id", datasetId.String()), zap.String("event", eventName), zap.Error(err))
+	}
+}
+
+func ruleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, datasetErrors.ErrRuleNotFound),
+		errors.Is(err, datasetErrors.ErrRuleVersionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, datasetErrors.ErrEmptyRuleTitle),
+		errors.Is(err, datasetErrors.ErrInvalidRuleColumn),
+		errors.Is(err, datasetErrors.ErrEmptyRuleFilters),
+		errors.Is(err, datasetErrors.ErrInvalidRuleFilterColumn),
+		errors.Is(err, datasetErrors.ErrEmptyRuleValue),
+		errors.Is(err, datasetErrors.ErrInvalidRuleBundle),
+		errors.Is(err, datasetErrors.ErrUnsupportedRuleBundleVersion),
+		errors.Is(err, datasetErrors.ErrInvalidRuleAssignment),
+		errors.Is(err, datasetErrors.ErrInvalidRuleLookup),
+		errors.Is(err, datasetErrors.ErrRuleAssignmentNotPreviewable),
+		errors.Is(err, datasetErrors.ErrInvalidStatusValue),
+		errors.Is(err, datasetErrors.ErrInvalidTagValue):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -2,8 +2,10 @@ package datasets
 
 import (
 	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	"github.com/Zampfi/application-platform/services/api/core/auditlogs"
 	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
 	"github.com/Zampfi/application-platform/services/api/core/fileimports"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/db/store"
 	"github.com/Zampfi/application-platform/services/api/server/middleware"
 	"github.com/gin-gonic/gin"
@@ -13,7 +15,8 @@ import (
 func RegisterDatasetRoutes(e *gin.RouterGroup, serverCfg *serverconfig.ServerConfig, datasetService datasetservice.DatasetService) error {
 
 	fileUploadService := fileimports.NewFileImportService(serverCfg.DefaultS3Client, serverCfg.Store, serverCfg.Env.AWSDefaultBucketName)
-	err := registerRoutes(e, datasetService, serverCfg.Store, fileUploadService)
+	auditLogService := auditlogs.NewAuditLogService(serverCfg).WithResource(dbmodels.ResourceTypeDataset)
+	err := registerRoutes(e, datasetService, serverCfg.Store, fileUploadService, auditLogService)
 	if err != nil {
 		return err
 	}
@@ -22,7 +25,7 @@ func RegisterDatasetRoutes(e *gin.RouterGroup, serverCfg *serverconfig.ServerCon
 
 }
 
-func registerRoutes(e *gin.RouterGroup, datasetService datasetservice.DatasetService, store store.Store, fileUploadService fileimports.FileImportService) error {
+func registerRoutes(e *gin.RouterGroup, datasetService datasetservice.DatasetService, store store.Store, fileUploadService fileimports.FileImportService, auditLogService auditlogs.AuditLogServiceWithResource) error {
 	datasetGroup := e.Group("/datasets")
 	datasetGroup.Use(middleware.ValidateDatasetAccess(store))
 	{
@@ -79,6 +82,106 @@ func registerRoutes(e *gin.RouterGroup, datasetService datasetservice.DatasetSer
 			SetDatasetDisplayConfig(c, datasetService)
 		})
 
+		datasetGroup.GET("/:datasetId/views", func(c *gin.Context) {
+			GetDatasetViews(c, datasetService)
+		})
+		datasetGroup.POST("/:datasetId/views", func(c *gin.Context) {
+			CreateDatasetView(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/views/default", func(c *gin.Context) {
+			GetDefaultDatasetView(c, datasetService)
+		})
+		datasetGroup.PUT("/:datasetId/views/default", func(c *gin.Context) {
+			SetDefaultDatasetView(c, datasetService)
+		})
+		datasetGroup.DELETE("/:datasetId/views/default", func(c *gin.Context) {
+			ClearDefaultDatasetView(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/views/:viewId", func(c *gin.Context) {
+			GetDatasetView(c, datasetService)
+		})
+		datasetGroup.PATCH("/:datasetId/views/:viewId", func(c *gin.Context) {
+			UpdateDatasetView(c, datasetService)
+		})
+		datasetGroup.DELETE("/:datasetId/views/:viewId", func(c *gin.Context) {
+			DeleteDatasetView(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/views/:viewId/audiences", func(c *gin.Context) {
+			GetDatasetViewAudiences(c, datasetService)
+		})
+		datasetGroup.POST("/:datasetId/views/:viewId/audiences", func(c *gin.Context) {
+			AddDatasetViewAudience(c, datasetService)
+		})
+		datasetGroup.PATCH("/:datasetId/views/:viewId/audiences", func(c *gin.Context) {
+			UpdateDatasetViewAudience(c, datasetService)
+		})
+		datasetGroup.DELETE("/:datasetId/views/:viewId/audiences", func(c *gin.Context) {
+			DeleteDatasetViewAudience(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/export-schedules", func(c *gin.Context) {
+			GetDatasetExportSchedules(c, datasetService)
+		})
+		datasetGroup.POST("/:datasetId/export-schedules", func(c *gin.Context) {
+			CreateDatasetExportSchedule(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/export-schedules/:scheduleId", func(c *gin.Context) {
+			GetDatasetExportSchedule(c, datasetService)
+		})
+		datasetGroup.PATCH("/:datasetId/export-schedules/:scheduleId", func(c *gin.Context) {
+			UpdateDatasetExportSchedule(c, datasetService)
+		})
+		datasetGroup.DELETE("/:datasetId/export-schedules/:scheduleId", func(c *gin.Context) {
+			DeleteDatasetExportSchedule(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/export-schedules/:scheduleId/runs", func(c *gin.Context) {
+			GetDatasetExportScheduleRuns(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/alerts", func(c *gin.Context) {
+			GetDatasetAlerts(c, datasetService)
+		})
+		datasetGroup.POST("/:datasetId/alerts", func(c *gin.Context) {
+			CreateDatasetAlert(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/alerts/:alertId", func(c *gin.Context) {
+			GetDatasetAlert(c, datasetService)
+		})
+		datasetGroup.PATCH("/:datasetId/alerts/:alertId", func(c *gin.Context) {
+			UpdateDatasetAlert(c, datasetService)
+		})
+		datasetGroup.DELETE("/:datasetId/alerts/:alertId", func(c *gin.Context) {
+			DeleteDatasetAlert(c, datasetService)
+		})
+		datasetGroup.PUT("/:datasetId/alerts/:alertId/snooze", func(c *gin.Context) {
+			SnoozeDatasetAlert(c, datasetService)
+		})
+		datasetGroup.DELETE("/:datasetId/alerts/:alertId/snooze", func(c *gin.Context) {
+			UnsnoozeDatasetAlert(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/alerts/:alertId/events", func(c *gin.Context) {
+			GetDatasetAlertEvents(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/status-workflows", func(c *gin.Context) {
+			GetDatasetStatusWorkflows(c, datasetService)
+		})
+		datasetGroup.POST("/:datasetId/rows/:rowId/status", func(c *gin.Context) {
+			TransitionDatasetRowStatus(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/rows/:rowId/assignment", func(c *gin.Context) {
+			GetDatasetRowAssignment(c, datasetService)
+		})
+		datasetGroup.PUT("/:datasetId/rows/:rowId/assignment", func(c *gin.Context) {
+			AssignDatasetRow(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/rules", func(c *gin.Context) {
+			GetDatasetRules(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/rules/:ruleId", func(c *gin.Context) {
+			GetDatasetRule(c, datasetService)
+		})
+		datasetGroup.GET("/:datasetId/rules/:ruleId/versions", func(c *gin.Context) {
+			GetDatasetRuleVersions(c, datasetService)
+		})
+
 	}
 
 	datasetCRUDGroup := e.Group("/datasets")
@@ -122,6 +225,9 @@ func registerRoutes(e *gin.RouterGroup, datasetService datasetservice.DatasetSer
 		datasetCRUDGroup.PATCH("/rules/priority", func(c *gin.Context) {
 			UpdateRulesPriority(c, datasetService)
 		})
+		datasetCRUDGroup.GET("/my-queue", func(c *gin.Context) {
+			GetMyQueue(c, datasetService)
+		})
 	}
 
 	datasetAdminGroup := e.Group("/datasets")
@@ -134,6 +240,79 @@ func registerRoutes(e *gin.RouterGroup, datasetService datasetservice.DatasetSer
 		datasetAdminGroup.DELETE("/:datasetId", func(c *gin.Context) {
 			DeleteDataset(c, datasetService)
 		})
+
+		datasetAdminGroup.GET("/:datasetId/row-policies", func(c *gin.Context) {
+			GetDatasetRowPolicies(c, datasetService)
+		})
+		datasetAdminGroup.POST("/:datasetId/row-policies", func(c *gin.Context) {
+			CreateDatasetRowPolicy(c, datasetService)
+		})
+		datasetAdminGroup.PATCH("/:datasetId/row-policies/:policyId", func(c *gin.Context) {
+			UpdateDatasetRowPolicy(c, datasetService)
+		})
+		datasetAdminGroup.DELETE("/:datasetId/row-policies/:policyId", func(c *gin.Context) {
+			DeleteDatasetRowPolicy(c, datasetService)
+		})
+		datasetAdminGroup.GET("/:datasetId/row-policies/preview", func(c *gin.Context) {
+			PreviewDatasetRowPolicies(c, datasetService)
+		})
+
+		datasetAdminGroup.POST("/:datasetId/status-workflows", func(c *gin.Context) {
+			CreateDatasetStatusWorkflow(c, datasetService)
+		})
+		datasetAdminGroup.PATCH("/:datasetId/status-workflows/:workflowId", func(c *gin.Context) {
+			UpdateDatasetStatusWorkflow(c, datasetService)
+		})
+		datasetAdminGroup.DELETE("/:datasetId/status-workflows/:workflowId", func(c *gin.Context) {
+			DeleteDatasetStatusWorkflow(c, datasetService)
+		})
+
+		datasetAdminGroup.POST("/:datasetId/rules", func(c *gin.Context) {
+			CreateDatasetRule(c, datasetService, auditLogService)
+		})
+		datasetAdminGroup.POST("/:datasetId/rules/preview", func(c *gin.Context) {
+			PreviewDatasetRule(c, datasetService)
+		})
+		datasetAdminGroup.GET("/:datasetId/rules/analysis", func(c *gin.Context) {
+			AnalyzeDatasetRules(c, datasetService)
+		})
+		datasetAdminGroup.GET("/:datasetId/rules/stats", func(c *gin.Context) {
+			GetDatasetRulesStats(c, datasetService)
+		})
+		datasetAdminGroup.GET("/:datasetId/rules/bundle", func(c *gin.Context) {
+			ExportDatasetRuleBundle(c, datasetService)
+		})
+		datasetAdminGroup.POST("/:datasetId/rules/bundle/import", func(c *gin.Context) {
+			ImportDatasetRuleBundle(c, datasetService, auditLogService)
+		})
+		datasetAdminGroup.GET("/:datasetId/rules/:ruleId/stats", func(c *gin.Context) {
+			GetDatasetRuleStats(c, datasetService)
+		})
+		datasetAdminGroup.POST("/:datasetId/rules/:ruleId/versions/:version/restore", func(c *gin.Context) {
+			RestoreDatasetRuleVersion(c, datasetService, auditLogService)
+		})
+		datasetAdminGroup.PATCH("/:datasetId/rules/:ruleId", func(c *gin.Context) {
+			UpdateDatasetRule(c, datasetService, auditLogService)
+		})
+		datasetAdminGroup.PUT("/:datasetId/rules/:ruleId/enabled", func(c *gin.Context) {
+			SetDatasetRuleEnabled(c, datasetService, auditLogService)
+		})
+		datasetAdminGroup.DELETE("/:datasetId/rules/:ruleId", func(c *gin.Context) {
+			DeleteDatasetRule(c, datasetService, auditLogService)
+		})
+
+		datasetAdminGroup.GET("/:datasetId/column-policies", func(c *gin.Context) {
+			GetDatasetColumnPolicies(c, datasetService)
+		})
+		datasetAdminGroup.POST("/:datasetId/column-policies", func(c *gin.Context) {
+			CreateDatasetColumnPolicy(c, datasetService, auditLogService)
+		})
+		datasetAdminGroup.PATCH("/:datasetId/column-policies/:policyId", func(c *gin.Context) {
+			UpdateDatasetColumnPolicy(c, datasetService, auditLogService)
+		})
+		datasetAdminGroup.DELETE("/:datasetId/column-policies/:policyId", func(c *gin.Context) {
+			DeleteDatasetColumnPolicy(c, datasetService, auditLogService)
+		})
 	}
 
 	return nil
@@ -141,7 +141,7 @@ func TestUpdateDataset(t *testing.T) {
 				c.Next()
 			})
 
-			registerRoutes(g, mockDatasetService, mockStore, mockFileUploadService)
+			registerRoutes(g, mockDatasetService, mockStore, mockFileUploadService, nil)
 
 			// Create test request
 			var bodyReader *bytes.Reader
@@ -271,7 +271,7 @@ func TestConfirmDatasetImport(t *testing.T) {
 				c.Next()
 			})
 
-			registerRoutes(g, mockDatasetService, mockStore, mockFileUploadService)
+			registerRoutes(g, mockDatasetService, mockStore, mockFileUploadService, nil)
 
 			// Create test request
 			var bodyReader *bytes.Reader
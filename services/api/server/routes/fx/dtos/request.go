@@ -0,0 +1,39 @@
+package dtos
+
+import (
+	"github.com/Zampfi/application-platform/services/api/core/fx/models"
+	"github.com/google/uuid"
+)
+
+type FxRateSourceRequest struct {
+	Name         string                    `json:"name" binding:"required"`
+	SourceType   string                    `json:"source_type" binding:"required"`
+	ConnectionId *uuid.UUID                `json:"connection_id"`
+	DatasetId    *uuid.UUID                `json:"dataset_id"`
+	Config       models.FxRateSourceConfig `json:"config"`
+}
+
+func (r *FxRateSourceRequest) ToModel() models.FxRateSourceParams {
+	return models.FxRateSourceParams{
+		Name:         r.Name,
+		SourceType:   r.SourceType,
+		ConnectionId: r.ConnectionId,
+		DatasetId:    r.DatasetId,
+		Config:       r.Config,
+	}
+}
+
+type FxRatesQuery struct {
+	Base      []string `form:"base"`
+	Quote     []string `form:"quote"`
+	StartDate string   `form:"start_date"`
+	EndDate   string   `form:"end_date"`
+	Page      int      `form:"page"`
+	PageSize  int      `form:"page_size"`
+}
+
+type FxRateQuery struct {
+	Base  string `form:"base" binding:"required"`
+	Quote string `form:"quote" binding:"required"`
+	Date  string `form:"date"`
+}
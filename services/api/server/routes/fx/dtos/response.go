@@ -0,0 +1,108 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/fx/models"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type FxRateSource struct {
+	ID             uuid.UUID                 `json:"id"`
+	Name           string                    `json:"name"`
+	SourceType     string                    `json:"source_type"`
+	ConnectionId   *uuid.UUID                `json:"connection_id"`
+	DatasetId      *uuid.UUID                `json:"dataset_id"`
+	Config         models.FxRateSourceConfig `json:"config"`
+	LastImportedAt *time.Time                `json:"last_imported_at"`
+	CreatedBy      uuid.UUID                 `json:"created_by"`
+	CreatedAt      time.Time                 `json:"created_at"`
+	UpdatedAt      time.Time                 `json:"updated_at"`
+}
+
+func (s *FxRateSource) FromModel(model models.FxRateSource) {
+	s.ID = model.ID
+	s.Name = model.Name
+	s.SourceType = model.SourceType
+	s.ConnectionId = model.ConnectionId
+	s.DatasetId = model.DatasetId
+	s.Config = model.Config
+	s.LastImportedAt = model.LastImportedAt
+	s.CreatedBy = model.CreatedBy
+	s.CreatedAt = model.CreatedAt
+	s.UpdatedAt = model.UpdatedAt
+}
+
+type FxRateImportResult struct {
+	ImportedRates int     `json:"imported_rates"`
+	FromDate      *string `json:"from_date"`
+	ToDate        *string `json:"to_date"`
+}
+
+func (r *FxRateImportResult) FromModel(model models.FxRateImportResult) {
+	r.ImportedRates = model.ImportedRates
+	r.FromDate = formatOptionalDate(model.FromDate)
+	r.ToDate = formatOptionalDate(model.ToDate)
+}
+
+type FxRate struct {
+	ID             uuid.UUID `json:"id"`
+	FxRateSourceId uuid.UUID `json:"fx_rate_source_id"`
+	RateDate       string    `json:"rate_date"`
+	BaseCurrency   string    `json:"base_currency"`
+	QuoteCurrency  string    `json:"quote_currency"`
+	Rate           float64   `json:"rate"`
+}
+
+func (r *FxRate) FromSchema(schema dbmodels.FxRate) {
+	r.ID = schema.ID
+	r.FxRateSourceId = schema.FxRateSourceId
+	r.RateDate = schema.RateDate.Format(time.DateOnly)
+	r.BaseCurrency = schema.BaseCurrency
+	r.QuoteCurrency = schema.QuoteCurrency
+	r.Rate = schema.Rate
+}
+
+type FxRatesPage struct {
+	Rates      []FxRate `json:"rates"`
+	TotalCount int64    `json:"total_count"`
+	Page       int      `json:"page"`
+	PageSize   int      `json:"page_size"`
+}
+
+func (p *FxRatesPage) FromModel(model models.FxRatesPage) {
+	p.Rates = make([]FxRate, len(model.Rates))
+	for i, rate := range model.Rates {
+		p.Rates[i].FromSchema(rate)
+	}
+	p.TotalCount = model.TotalCount
+	p.Page = model.Page
+	p.PageSize = model.PageSize
+}
+
+type EffectiveFxRate struct {
+	BaseCurrency  string  `json:"base_currency"`
+	QuoteCurrency string  `json:"quote_currency"`
+	Date          string  `json:"date"`
+	RateDate      string  `json:"rate_date"`
+	Rate          float64 `json:"rate"`
+	Inverted      bool    `json:"inverted"`
+}
+
+func (r *EffectiveFxRate) FromModel(model models.EffectiveFxRate) {
+	r.BaseCurrency = model.BaseCurrency
+	r.QuoteCurrency = model.QuoteCurrency
+	r.Date = model.Date.Format(time.DateOnly)
+	r.RateDate = model.RateDate.Format(time.DateOnly)
+	r.Rate = model.Rate
+	r.Inverted = model.Inverted
+}
+
+func formatOptionalDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format(time.DateOnly)
+	return &formatted
+}
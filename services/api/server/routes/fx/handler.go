@@ -0,0 +1,277 @@
+package fx
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/fx/constants"
+	fxErrors "github.com/Zampfi/application-platform/services/api/core/fx/errors"
+	fxservice "github.com/Zampfi/application-platform/services/api/core/fx/service"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/Zampfi/application-platform/services/api/server/routes/fx/dtos"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func GetFxRateSources(c *gin.Context, svc fxservice.FxService) {
+	sources, err := svc.GetFxRateSources(c)
+	if err != nil {
+		c.JSON(fxErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.FxRateSource, len(sources))
+	for i, source := range sources {
+		response[i].FromModel(source)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetFxRateSource(c *gin.Context, svc fxservice.FxService) {
+	sourceId, ok := getSourceId(c)
+	if !ok {
+		return
+	}
+
+	source, err := svc.GetFxRateSource(c, sourceId)
+	if err != nil {
+		c.JSON(fxErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.FxRateSource{}
+	response.FromModel(source)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateFxRateSource(c *gin.Context, svc fxservice.FxService) {
+	userId, orgId, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	var request dtos.FxRateSourceRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	source, err := svc.CreateFxRateSource(c, orgId, userId, request.ToModel())
+	if err != nil {
+		c.JSON(fxErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.FxRateSource{}
+	response.FromModel(source)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteFxRateSource(c *gin.Context, svc fxservice.FxService) {
+	userId, _, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	sourceId, ok := getSourceId(c)
+	if !ok {
+		return
+	}
+
+	if err := svc.DeleteFxRateSource(c, userId, sourceId); err != nil {
+		c.JSON(fxErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "fx rate source deleted"})
+}
+
+// ImportFxRatesFromFile imports the csv file uploaded in the file field of a multipart form
+func ImportFxRatesFromFile(c *gin.Context, svc fxservice.FxService) {
+	userId, _, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	sourceId, ok := getSourceId(c)
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, constants.FxMaxFileSizeBytes)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	result, err := svc.ImportFxRatesFromFile(c, userId, sourceId, file)
+	if err != nil {
+		c.JSON(fxErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.FxRateImportResult{}
+	response.FromModel(result)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func ImportFxRatesFromConnector(c *gin.Context, svc fxservice.FxService) {
+	userId, _, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	sourceId, ok := getSourceId(c)
+	if !ok {
+		return
+	}
+
+	result, err := svc.ImportFxRatesFromConnector(c, userId, sourceId)
+	if err != nil {
+		c.JSON(fxErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.FxRateImportResult{}
+	response.FromModel(result)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetFxRates(c *gin.Context, svc fxservice.FxService) {
+	var query dtos.FxRatesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := storemodels.FxRateFilters{
+		BaseCurrencies:  query.Base,
+		QuoteCurrencies: query.Quote,
+		Page:            query.Page,
+		PageSize:        query.PageSize,
+	}
+	var err error
+	if filters.StartDate, err = parseOptionalDate(query.StartDate); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid start date"})
+		return
+	}
+	if filters.EndDate, err = parseOptionalDate(query.EndDate); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid end date"})
+		return
+	}
+
+	page, err := svc.GetFxRates(c, filters)
+	if err != nil {
+		c.JSON(fxErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.FxRatesPage{}
+	response.FromModel(page)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetFxRate returns the rate of the pair on the date of the query, today when it has none
+func GetFxRate(c *gin.Context, svc fxservice.FxService) {
+	var query dtos.FxRateQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	date := time.Now().UTC().Truncate(24 * time.Hour)
+	if query.Date != "" {
+		parsed, err := time.Parse(time.DateOnly, query.Date)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date " + query.Date})
+			return
+		}
+		date = parsed
+	}
+
+	rate, err := svc.GetFxRate(c, query.Base, query.Quote, date)
+	if err != nil {
+		c.JSON(fxErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.EffectiveFxRate{}
+	response.FromModel(rate)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func getUserAndOrganization(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	_, userId, orgIds := apicontext.GetAuthFromContext(c)
+	if userId == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	if len(orgIds) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "no organization ids found"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return *userId, orgIds[0], true
+}
+
+func getSourceId(c *gin.Context) (uuid.UUID, bool) {
+	sourceId, err := uuid.Parse(c.Param("sourceId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid fx rate source id"})
+		return uuid.Nil, false
+	}
+
+	return sourceId, true
+}
+
+func parseOptionalDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+func fxErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, fxErrors.ErrFxRateAccessForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, fxErrors.ErrFxRateSourceNotFound),
+		errors.Is(err, fxErrors.ErrFxRateNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, fxErrors.ErrEmptyFxRateSourceName),
+		errors.Is(err, fxErrors.ErrInvalidFxRateSourceType),
+		errors.Is(err, fxErrors.ErrInvalidFxRateSourceConfig),
+		errors.Is(err, fxErrors.ErrFxRateSourceConnection),
+		errors.Is(err, fxErrors.ErrFxRateSourceDataset),
+		errors.Is(err, fxErrors.ErrFxRateSourceTypeMismatch),
+		errors.Is(err, fxErrors.ErrInvalidFxRateFile),
+		errors.Is(err, fxErrors.ErrInvalidFxRateRow),
+		errors.Is(err, fxErrors.ErrFxRateImportTooManyRows),
+		errors.Is(err, fxErrors.ErrInvalidCurrency):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,48 @@
+package fx
+
+import (
+	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	fxservice "github.com/Zampfi/application-platform/services/api/core/fx/service"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterFxRoutes(e *gin.RouterGroup, serverCfg *serverconfig.ServerConfig, datasetService datasetservice.DatasetService) error {
+	fxService := fxservice.NewFxService(serverCfg.Store, datasetService)
+
+	fxGroup := e.Group("/fx")
+	{
+		fxGroup.GET("/sources", func(c *gin.Context) {
+			GetFxRateSources(c, fxService)
+		})
+
+		fxGroup.POST("/sources", func(c *gin.Context) {
+			CreateFxRateSource(c, fxService)
+		})
+
+		fxGroup.GET("/sources/:sourceId", func(c *gin.Context) {
+			GetFxRateSource(c, fxService)
+		})
+
+		fxGroup.DELETE("/sources/:sourceId", func(c *gin.Context) {
+			DeleteFxRateSource(c, fxService)
+		})
+
+		fxGroup.POST("/sources/:sourceId/file-imports", func(c *gin.Context) {
+			ImportFxRatesFromFile(c, fxService)
+		})
+
+		fxGroup.POST("/sources/:sourceId/connector-imports", func(c *gin.Context) {
+			ImportFxRatesFromConnector(c, fxService)
+		})
+
+		fxGroup.GET("/rates", func(c *gin.Context) {
+			GetFxRates(c, fxService)
+		})
+
+		fxGroup.GET("/rate", func(c *gin.Context) {
+			GetFxRate(c, fxService)
+		})
+	}
+	return nil
+}
@@ -23,3 +23,14 @@ type DeleteMemberRequest struct {
 type ApproveOrganizationMembershipRequestRequest struct {
 	UserId uuid.UUID `json:"user_id"`
 }
+
+type UpdateCalendarSettingsRequest struct {
+	Timezone             string `json:"timezone"`
+	FiscalYearStartMonth int    `json:"fiscal_year_start_month"`
+	WeekStart            string `json:"week_start"`
+	Pattern              string `json:"pattern"`
+}
+
+type UpdateExportSettingsRequest struct {
+	Buckets map[string]string `json:"buckets"`
+}
@@ -1,9 +1,12 @@
 package organizations
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/Zampfi/application-platform/services/api/core/organizations"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/exports"
 	"github.com/Zampfi/application-platform/services/api/core/organizations/teams"
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	dtos "github.com/Zampfi/application-platform/services/api/server/routes/organizations/dtos"
@@ -310,3 +313,98 @@ func removeUserFromTeam(c *gin.Context, svc organizations.OrganizationService) {
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "user removed from team successfully"})
 }
+
+func getCalendarSettings(c *gin.Context, svc organizations.OrganizationService) {
+	orgIdStr := c.Param("orgId")
+
+	orgId, err := uuid.Parse(orgIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+		return
+	}
+
+	settings, err := svc.GetCalendarSettings(c, orgId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "something went wrong"})
+		return
+	}
+	c.JSON(http.StatusOK, settings)
+}
+
+func updateCalendarSettings(c *gin.Context, svc organizations.OrganizationService) {
+	orgIdStr := c.Param("orgId")
+
+	orgId, err := uuid.Parse(orgIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+		return
+	}
+
+	var requestBody dtos.UpdateCalendarSettingsRequest
+	if err := c.BindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	settings, err := svc.UpdateCalendarSettings(c, orgId, calendar.Settings{
+		Timezone:             requestBody.Timezone,
+		FiscalYearStartMonth: requestBody.FiscalYearStartMonth,
+		WeekStart:            requestBody.WeekStart,
+		Pattern:              requestBody.Pattern,
+	})
+	if err != nil {
+		if errors.Is(err, calendar.ErrInvalidSettings) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "something went wrong"})
+		return
+	}
+	c.JSON(http.StatusOK, settings)
+}
+
+func getExportSettings(c *gin.Context, svc organizations.OrganizationService) {
+	orgIdStr := c.Param("orgId")
+
+	orgId, err := uuid.Parse(orgIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+		return
+	}
+
+	settings, err := svc.GetExportSettings(c, orgId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "something went wrong"})
+		return
+	}
+	c.JSON(http.StatusOK, settings)
+}
+
+func updateExportSettings(c *gin.Context, svc organizations.OrganizationService) {
+	orgIdStr := c.Param("orgId")
+
+	orgId, err := uuid.Parse(orgIdStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+		return
+	}
+
+	var requestBody dtos.UpdateExportSettingsRequest
+	if err := c.BindJSON(&requestBody); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+		return
+	}
+
+	settings, err := svc.UpdateExportSettings(c, orgId, exports.Settings{
+		Buckets: requestBody.Buckets,
+	})
+	if err != nil {
+		if errors.Is(err, exports.ErrInvalidSettings) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "something went wrong"})
+		return
+	}
+	c.JSON(http.StatusOK, settings)
+}
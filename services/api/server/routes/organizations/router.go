@@ -73,5 +73,21 @@ func registerRoutes(e *gin.RouterGroup, orgService organizations.OrganizationSer
 			removeUserFromTeam(c, orgService)
 		})
 
+		orgGroup.GET("/:orgId/calendar-settings", func(c *gin.Context) {
+			getCalendarSettings(c, orgService)
+		})
+
+		orgGroup.PUT("/:orgId/calendar-settings", func(c *gin.Context) {
+			updateCalendarSettings(c, orgService)
+		})
+
+		orgGroup.GET("/:orgId/export-settings", func(c *gin.Context) {
+			getExportSettings(c, orgService)
+		})
+
+		orgGroup.PUT("/:orgId/export-settings", func(c *gin.Context) {
+			updateExportSettings(c, orgService)
+		})
+
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,6 +12,8 @@ import (
 
 	serverconfig "github.com/Zampfi/application-platform/services/api/config"
 	"github.com/Zampfi/application-platform/services/api/core/organizations"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/calendar"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/exports"
 	"github.com/Zampfi/application-platform/services/api/core/organizations/teams"
 	"github.com/Zampfi/application-platform/services/api/db/models"
 	mockOrganization "github.com/Zampfi/application-platform/services/api/mocks/core/organizations"
@@ -1378,3 +1381,189 @@ func TestRemoveUserFromTeam(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateCalendarSettings(t *testing.T) {
+	validOrgID := uuid.New()
+	settings := calendar.Settings{Timezone: "Asia/Kolkata", FiscalYearStartMonth: 4, WeekStart: "sunday", Pattern: calendar.Pattern445}
+
+	tests := []struct {
+		name           string
+		orgID          string
+		payload        interface{}
+		setupMock      func(*mockOrganization.MockOrganizationService)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:  "success",
+			orgID: validOrgID.String(),
+			payload: map[string]interface{}{
+				"timezone":                "Asia/Kolkata",
+				"fiscal_year_start_month": 4,
+				"week_start":              "sunday",
+				"pattern":                 "4-4-5",
+			},
+			setupMock: func(mockService *mockOrganization.MockOrganizationService) {
+				mockService.EXPECT().UpdateCalendarSettings(mock.Anything, validOrgID, settings).Return(settings, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"timezone":                "Asia/Kolkata",
+				"fiscal_year_start_month": float64(4),
+				"week_start":              "sunday",
+				"pattern":                 "4-4-5",
+			},
+		},
+		{
+			name:           "invalid organization id",
+			orgID:          "invalid-uuid",
+			payload:        map[string]interface{}{},
+			setupMock:      func(mockService *mockOrganization.MockOrganizationService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "invalid organization id",
+			},
+		},
+		{
+			name:    "invalid settings",
+			orgID:   validOrgID.String(),
+			payload: map[string]interface{}{"fiscal_year_start_month": 13},
+			setupMock: func(mockService *mockOrganization.MockOrganizationService) {
+				mockService.EXPECT().UpdateCalendarSettings(mock.Anything, validOrgID, calendar.Settings{FiscalYearStartMonth: 13}).
+					Return(calendar.Settings{}, fmt.Errorf("%w: fiscal year start month must be between 1 and 12", calendar.ErrInvalidSettings))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "invalid calendar settings: fiscal year start month must be between 1 and 12",
+			},
+		},
+		{
+			name:    "service error",
+			orgID:   validOrgID.String(),
+			payload: map[string]interface{}{},
+			setupMock: func(mockService *mockOrganization.MockOrganizationService) {
+				mockService.EXPECT().UpdateCalendarSettings(mock.Anything, validOrgID, calendar.Settings{}).Return(calendar.Settings{}, errors.New("forbidden"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: map[string]interface{}{
+				"error": "something went wrong",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mockOrganization.NewMockOrganizationService(t)
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			routerGroup := router.Group("/")
+			registerRoutes(routerGroup, mockService)
+
+			tt.setupMock(mockService)
+
+			w := httptest.NewRecorder()
+
+			payloadBytes, _ := json.Marshal(tt.payload)
+			req, _ := http.NewRequest("PUT", "/organizations/"+tt.orgID+"/calendar-settings", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBody, response)
+		})
+	}
+}
+
+func TestUpdateExportSettings(t *testing.T) {
+	validOrgID := uuid.New()
+	settings := exports.Settings{Buckets: map[string]string{"gcs": "acme-exports"}}
+
+	tests := []struct {
+		name           string
+		orgID          string
+		payload        interface{}
+		setupMock      func(*mockOrganization.MockOrganizationService)
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:    "success",
+			orgID:   validOrgID.String(),
+			payload: map[string]interface{}{"buckets": map[string]string{"gcs": "acme-exports"}},
+			setupMock: func(mockService *mockOrganization.MockOrganizationService) {
+				mockService.EXPECT().UpdateExportSettings(mock.Anything, validOrgID, settings).Return(settings, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"buckets": map[string]interface{}{"gcs": "acme-exports"},
+			},
+		},
+		{
+			name:           "invalid organization id",
+			orgID:          "invalid-uuid",
+			payload:        map[string]interface{}{},
+			setupMock:      func(mockService *mockOrganization.MockOrganizationService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "invalid organization id",
+			},
+		},
+		{
+			name:    "invalid settings",
+			orgID:   validOrgID.String(),
+			payload: map[string]interface{}{"buckets": map[string]string{"ftp": "acme-exports"}},
+			setupMock: func(mockService *mockOrganization.MockOrganizationService) {
+				mockService.EXPECT().UpdateExportSettings(mock.Anything, validOrgID, exports.Settings{Buckets: map[string]string{"ftp": "acme-exports"}}).
+					Return(exports.Settings{}, fmt.Errorf("%w: unknown provider ftp", exports.ErrInvalidSettings))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"error": "invalid export settings: unknown provider ftp",
+			},
+		},
+		{
+			name:    "service error",
+			orgID:   validOrgID.String(),
+			payload: map[string]interface{}{},
+			setupMock: func(mockService *mockOrganization.MockOrganizationService) {
+				mockService.EXPECT().UpdateExportSettings(mock.Anything, validOrgID, exports.Settings{}).Return(exports.Settings{}, errors.New("forbidden"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: map[string]interface{}{
+				"error": "something went wrong",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := mockOrganization.NewMockOrganizationService(t)
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			routerGroup := router.Group("/")
+			registerRoutes(routerGroup, mockService)
+
+			tt.setupMock(mockService)
+
+			w := httptest.NewRecorder()
+
+			payloadBytes, _ := json.Marshal(tt.payload)
+			req, _ := http.NewRequest("PUT", "/organizations/"+tt.orgID+"/export-settings", bytes.NewBuffer(payloadBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			var response interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedBody, response)
+		})
+	}
+}
@@ -20,3 +20,18 @@ type AddAudienceRequest struct {
 type BulkAddAudienceRequest struct {
 	Audiences []AddAudienceRequest `json:"audiences"`
 }
+
+type CreatePageRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+type UpdatePageRequest struct {
+	Name            *string  `json:"name"`
+	Description     *string  `json:"description"`
+	FractionalIndex *float64 `json:"fractional_index"`
+}
+
+type DuplicatePageRequest struct {
+	Name string `json:"name"`
+}
@@ -1,14 +1,17 @@
 package pages
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/Zampfi/application-platform/services/api/core/auditlogs"
 	"github.com/Zampfi/application-platform/services/api/core/pages"
 	models "github.com/Zampfi/application-platform/services/api/db/models"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	"github.com/Zampfi/application-platform/services/api/server/routes/pages/dtos"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 func handleGetPagesAll(c *gin.Context, pageService pages.PagesService) {
@@ -158,3 +161,126 @@ func handleGetPagesByOrganizationId(c *gin.Context, svc pages.PagesService) {
 
 	c.JSON(http.StatusOK, pages)
 }
+
+func createPage(c *gin.Context, svc pages.PagesService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	var payload dtos.CreatePageRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := svc.CreatePage(c, pages.CreatePagePayload{PageName: payload.Name, PageDescription: payload.Description})
+	if err != nil {
+		c.JSON(pageErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitPageAuditLog(c, auditLogService, page.ID, pages.AuditLogEventPageCreated, map[string]interface{}{"name": page.Name})
+
+	c.JSON(http.StatusOK, page)
+}
+
+func updatePage(c *gin.Context, svc pages.PagesService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	pageId, err := uuid.Parse(c.Param("pageId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page ID"})
+		return
+	}
+
+	var payload dtos.UpdatePageRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// a fractional index of zero makes the database place the page last, it is only used on insert
+	if payload.FractionalIndex != nil && *payload.FractionalIndex <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "fractional index must be positive"})
+		return
+	}
+
+	page, err := svc.UpdatePage(c, pageId, models.UpdatePageParams{
+		Name:            payload.Name,
+		Description:     payload.Description,
+		FractionalIndex: payload.FractionalIndex,
+	})
+	if err != nil {
+		c.JSON(pageErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitPageAuditLog(c, auditLogService, pageId, pages.AuditLogEventPageUpdated, map[string]interface{}{
+		"name":             payload.Name,
+		"description":      payload.Description,
+		"fractional_index": payload.FractionalIndex,
+	})
+
+	c.JSON(http.StatusOK, page)
+}
+
+func duplicatePage(c *gin.Context, svc pages.PagesService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	pageId, err := uuid.Parse(c.Param("pageId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page ID"})
+		return
+	}
+
+	var payload dtos.DuplicatePageRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&payload); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	page, err := svc.DuplicatePage(c, pageId, payload.Name)
+	if err != nil {
+		c.JSON(pageErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitPageAuditLog(c, auditLogService, page.ID, pages.AuditLogEventPageDuplicated, map[string]interface{}{
+		"name":           page.Name,
+		"source_page_id": pageId,
+	})
+
+	c.JSON(http.StatusOK, page)
+}
+
+func deletePage(c *gin.Context, svc pages.PagesService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	pageId, err := uuid.Parse(c.Param("pageId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page ID"})
+		return
+	}
+
+	if err := svc.DeletePage(c, pageId); err != nil {
+		c.JSON(pageErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitPageAuditLog(c, auditLogService, pageId, pages.AuditLogEventPageDeleted, map[string]interface{}{})
+
+	c.JSON(http.StatusOK, gin.H{"message": "success"})
+}
+
+func emitPageAuditLog(c *gin.Context, auditLogService auditlogs.AuditLogServiceWithResource, pageId uuid.UUID, eventName string, payload map[string]interface{}) {
+	logger := apicontext.GetLoggerFromCtx(c)
+
+	if err := auditLogService.EmitAuditLog(c, pageId, models.AuditLogKindInfo, eventName, payload); err != nil {
+		logger.Error("failed to emit page audit log", zap.String("page_id", pageId.String()), zap.String("event", eventName), zap.Error(err))
+	}
+}
+
+func pageErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, pages.ErrPageNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, pages.ErrPageAccessForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, pages.ErrEmptyPageName):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -2,8 +2,10 @@ package pages
 
 import (
 	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	"github.com/Zampfi/application-platform/services/api/core/auditlogs"
 	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
 	"github.com/Zampfi/application-platform/services/api/core/pages"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/pkg/cache"
 	"github.com/Zampfi/application-platform/services/api/server/routes/pages/sheets"
 	"github.com/gin-gonic/gin"
@@ -16,8 +18,14 @@ func RegisterPagesRoutes(e *gin.RouterGroup, datasetService datasetservice.Datas
 
 }
 func registerRoutes(e *gin.RouterGroup, pageService pages.PagesService, datasetService datasetservice.DatasetService, cacheService cache.CacheClient, serverCfg *serverconfig.ServerConfig) {
+	auditLogService := auditlogs.NewAuditLogService(serverCfg).WithResource(dbmodels.ResourceTypePage)
+
 	pagesGroup := e.Group("/pages")
 	{
+		pagesGroup.POST("/", func(c *gin.Context) {
+			createPage(c, pageService, auditLogService)
+		})
+
 		pagesGroup.GET("/get-pages", func(c *gin.Context) {
 			handleGetPagesAll(c, pageService)
 		})
@@ -30,6 +38,18 @@ func registerRoutes(e *gin.RouterGroup, pageService pages.PagesService, datasetS
 			handleGetPagesByID(c, pageService)
 		})
 
+		pagesGroup.PATCH("/:pageId", func(c *gin.Context) {
+			updatePage(c, pageService, auditLogService)
+		})
+
+		pagesGroup.DELETE("/:pageId", func(c *gin.Context) {
+			deletePage(c, pageService, auditLogService)
+		})
+
+		pagesGroup.POST("/:pageId/duplicate", func(c *gin.Context) {
+			duplicatePage(c, pageService, auditLogService)
+		})
+
 		pagesGroup.GET("/:pageId/audiences", func(c *gin.Context) {
 			handleGetPageAudiences(c, pageService)
 		})
@@ -2,6 +2,7 @@ package pages
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,6 +23,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
 )
 
 func setupRouter(t *testing.T) (*gin.Engine, *mock_pages_service.MockPagesService, *serverconfig.ServerConfig) {
@@ -807,3 +809,277 @@ func TestHandleGetPagesByOrganizationId(t *testing.T) {
 		})
 	}
 }
+
+type auditLogStub struct {
+	events []string
+	err    error
+}
+
+func (a *auditLogStub) EmitAuditLog(ctx context.Context, resourceId uuid.UUID, auditLogKind models.AuditLogKind, eventName string, payload map[string]interface{}) error {
+	a.events = append(a.events, eventName)
+	return a.err
+}
+
+func TestCreatePage(t *testing.T) {
+	pageID := uuid.New()
+
+	tests := []struct {
+		name         string
+		body         string
+		setupMock    func(*mock_pages_service.MockPagesService)
+		auditErr     error
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name: "success",
+			body: `{"name":"Revenue","description":"Monthly numbers"}`,
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().CreatePage(mock.Anything, mock.MatchedBy(func(payload pages.CreatePagePayload) bool {
+					return payload.PageName == "Revenue" && payload.PageDescription == "Monthly numbers"
+				})).Return(&models.Page{ID: pageID, Name: "Revenue"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventPageCreated},
+		},
+		{
+			// a failed audit log does not fail the request
+			name: "audit log failure",
+			body: `{"name":"Revenue"}`,
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().CreatePage(mock.Anything, mock.Anything).Return(&models.Page{ID: pageID, Name: "Revenue"}, nil)
+			},
+			auditErr:     errors.New("kafka unavailable"),
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventPageCreated},
+		},
+		{
+			name:         "missing name",
+			body:         `{"description":"Monthly numbers"}`,
+			setupMock:    func(m *mock_pages_service.MockPagesService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "blank name",
+			body: `{"name":" "}`,
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().CreatePage(mock.Anything, mock.Anything).Return(nil, pages.ErrEmptyPageName)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, _ := setupRouter(t)
+			tt.setupMock(mockService)
+			auditLogService := &auditLogStub{err: tt.auditErr}
+
+			router.POST("/pages", func(c *gin.Context) {
+				apicontext.AddLoggerToGinContext(c, zap.NewNop())
+				createPage(c, mockService, auditLogService)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/pages", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
+
+func TestUpdatePage(t *testing.T) {
+	pageID := uuid.New()
+	name := "Revenue"
+
+	tests := []struct {
+		name         string
+		pageID       string
+		body         string
+		setupMock    func(*mock_pages_service.MockPagesService)
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name:   "rename and reorder",
+			pageID: pageID.String(),
+			body:   `{"name":"Revenue","fractional_index":1.5}`,
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().UpdatePage(mock.Anything, pageID, mock.MatchedBy(func(params models.UpdatePageParams) bool {
+					return *params.Name == name && *params.FractionalIndex == 1.5 && params.Description == nil
+				})).Return(&models.Page{ID: pageID, Name: name, FractionalIndex: 1.5}, nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventPageUpdated},
+		},
+		{
+			name:         "invalid page id",
+			pageID:       "invalid-uuid",
+			body:         `{"name":"Revenue"}`,
+			setupMock:    func(m *mock_pages_service.MockPagesService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "fractional index is not positive",
+			pageID:       pageID.String(),
+			body:         `{"fractional_index":0}`,
+			setupMock:    func(m *mock_pages_service.MockPagesService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "not a page admin",
+			pageID: pageID.String(),
+			body:   `{"name":"Revenue"}`,
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().UpdatePage(mock.Anything, pageID, mock.Anything).Return(nil, pages.ErrPageAccessForbidden)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:   "page not found",
+			pageID: pageID.String(),
+			body:   `{"name":"Revenue"}`,
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().UpdatePage(mock.Anything, pageID, mock.Anything).Return(nil, pages.ErrPageNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, _ := setupRouter(t)
+			tt.setupMock(mockService)
+			auditLogService := &auditLogStub{}
+
+			router.PATCH("/pages/:pageId", func(c *gin.Context) {
+				apicontext.AddLoggerToGinContext(c, zap.NewNop())
+				updatePage(c, mockService, auditLogService)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPatch, fmt.Sprintf("/pages/%s", tt.pageID), bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
+
+func TestDuplicatePage(t *testing.T) {
+	pageID := uuid.New()
+	duplicatedPageID := uuid.New()
+
+	tests := []struct {
+		name         string
+		body         string
+		setupMock    func(*mock_pages_service.MockPagesService)
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name: "without a body",
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().DuplicatePage(mock.Anything, pageID, "").Return(&models.Page{ID: duplicatedPageID, Name: "Revenue (copy)"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventPageDuplicated},
+		},
+		{
+			name: "with a name",
+			body: `{"name":"Board pack"}`,
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().DuplicatePage(mock.Anything, pageID, "Board pack").Return(&models.Page{ID: duplicatedPageID, Name: "Board pack"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventPageDuplicated},
+		},
+		{
+			name: "page not found",
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().DuplicatePage(mock.Anything, pageID, "").Return(nil, pages.ErrPageNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, _ := setupRouter(t)
+			tt.setupMock(mockService)
+			auditLogService := &auditLogStub{}
+
+			router.POST("/pages/:pageId/duplicate", func(c *gin.Context) {
+				apicontext.AddLoggerToGinContext(c, zap.NewNop())
+				duplicatePage(c, mockService, auditLogService)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/pages/%s/duplicate", pageID), bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
+
+func TestDeletePage(t *testing.T) {
+	pageID := uuid.New()
+
+	tests := []struct {
+		name         string
+		setupMock    func(*mock_pages_service.MockPagesService)
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name: "success",
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().DeletePage(mock.Anything, pageID).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventPageDeleted},
+		},
+		{
+			name: "not a page admin",
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().DeletePage(mock.Anything, pageID).Return(pages.ErrPageAccessForbidden)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "service error",
+			setupMock: func(m *mock_pages_service.MockPagesService) {
+				m.EXPECT().DeletePage(mock.Anything, pageID).Return(errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, _ := setupRouter(t)
+			tt.setupMock(mockService)
+			auditLogService := &auditLogStub{}
+
+			router.DELETE("/pages/:pageId", func(c *gin.Context) {
+				apicontext.AddLoggerToGinContext(c, zap.NewNop())
+				deletePage(c, mockService, auditLogService)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("/pages/%s", pageID), nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
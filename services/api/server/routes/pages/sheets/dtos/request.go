@@ -0,0 +1,22 @@
+package dtos
+
+import (
+	"github.com/Zampfi/application-platform/services/api/core/sheets/models"
+)
+
+type CreateSheetRequest struct {
+	Name        string              `json:"name" binding:"required"`
+	Description *string             `json:"description"`
+	SheetConfig *models.SheetConfig `json:"sheet_config"`
+}
+
+type UpdateSheetRequest struct {
+	Name            string              `json:"name"`
+	Description     *string             `json:"description"`
+	FractionalIndex *float64            `json:"fractional_index"`
+	SheetConfig     *models.SheetConfig `json:"sheet_config"`
+}
+
+type DuplicateSheetRequest struct {
+	Name string `json:"name"`
+}
@@ -26,6 +26,7 @@ type SheetConfig struct {
 	NativeFilterConfig []NativeFilterConfig `json:"native_filter_config"`
 	SheetLayout        []WidgetGroupLayout  `json:"sheet_layout"`
 	Currency           *SheetCurrencyConfig `json:"currency,omitempty"`
+	Variables          []SheetVariable      `json:"variables,omitempty"`
 }
 
 type SheetCurrencyConfig struct {
@@ -33,6 +34,20 @@ type SheetCurrencyConfig struct {
 	DefaultCurrency    string `json:"default_currency,omitempty"`
 }
 
+type SheetVariable struct {
+	Name         string          `json:"name"`
+	Label        string          `json:"label,omitempty"`
+	Type         string          `json:"type"`
+	DefaultValue string          `json:"default_value"`
+	Source       *VariableSource `json:"source,omitempty"`
+	Options      []interface{}   `json:"options,omitempty"`
+}
+
+type VariableSource struct {
+	DatasetID string `json:"dataset_id"`
+	Column    string `json:"column"`
+}
+
 type WidgetGroupLayout struct {
 	Name          string      `json:"name,omitempty"`
 	Layout        *Layout     `json:"layout,omitempty"`
@@ -76,6 +91,7 @@ func (s *SheetResponse) NewSheetResponse(sheet *dbmodels.Sheet) error {
 
 type SheetFilterConfig struct {
 	NativeFilterConfig []NativeFilterConfig `json:"native_filter_config"`
+	Variables          []SheetVariable      `json:"variables,omitempty"`
 }
 
 type NativeFilterConfig struct {
@@ -114,4 +130,22 @@ func (s *SheetFilterConfig) NewSheetFilterConfig(filterConfig *models.FilterOpti
 			DefaultValue:   filter.DefaultValue,
 		})
 	}
+
+	for _, variable := range filterConfig.Variables {
+		var source *VariableSource
+		if variable.Source != nil {
+			source = &VariableSource{
+				DatasetID: variable.Source.DatasetId.String(),
+				Column:    variable.Source.Column,
+			}
+		}
+		s.Variables = append(s.Variables, SheetVariable{
+			Name:         variable.Name,
+			Label:        variable.Label,
+			Type:         variable.Type,
+			DefaultValue: variable.DefaultValue,
+			Source:       source,
+			Options:      variable.Options,
+		})
+	}
 }
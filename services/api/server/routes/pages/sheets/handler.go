@@ -1,13 +1,19 @@
 package sheets
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/Zampfi/application-platform/services/api/core/auditlogs"
+	"github.com/Zampfi/application-platform/services/api/core/pages"
 	"github.com/Zampfi/application-platform/services/api/core/sheets"
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	"github.com/Zampfi/application-platform/services/api/server/routes/pages/sheets/dtos"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 func handleGetSheetsAll(c *gin.Context, sheetService sheets.SheetsService) {
@@ -84,3 +90,213 @@ func handleGetSheetFilters(c *gin.Context, sheetService sheets.SheetsService) {
 
 	c.JSON(200, sheetFilterConfigDto)
 }
+
+// requirePageSheet stops requests for a sheet that is not on the page of the route
+func requirePageSheet(sheetService sheets.SheetsService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pageId, err := uuid.Parse(c.Param("pageId"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid page id"})
+			return
+		}
+
+		sheetId, err := uuid.Parse(c.Param("sheetId"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid sheet id"})
+			return
+		}
+
+		sheet, err := sheetService.GetSheetById(c, sheetId)
+		if err != nil {
+			c.AbortWithStatusJSON(sheetErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		if sheet.PageId != pageId {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": sheets.ErrSheetNotFound.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func handleCreateSheet(c *gin.Context, sheetService sheets.SheetsService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	pageId, err := uuid.Parse(c.Param("pageId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page id"})
+		return
+	}
+
+	var payload dtos.CreateSheetRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sheet := sheetmodels.Sheet{
+		Name:        payload.Name,
+		Description: payload.Description,
+		PageId:      pageId,
+	}
+	if payload.SheetConfig != nil {
+		sheet.SheetConfig = *payload.SheetConfig
+	}
+
+	createdSheet, err := sheetService.CreateSheet(c, sheet)
+	if err != nil {
+		c.JSON(sheetErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitSheetAuditLog(c, auditLogService, pageId, pages.AuditLogEventSheetCreated, map[string]interface{}{
+		"sheet_id": createdSheet.ID,
+		"name":     createdSheet.Name,
+	})
+
+	respondWithSheet(c, createdSheet)
+}
+
+func handleUpdateSheet(c *gin.Context, sheetService sheets.SheetsService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	pageId, sheetId, ok := parsePageSheetIds(c)
+	if !ok {
+		return
+	}
+
+	var payload dtos.UpdateSheetRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sheet := sheetmodels.Sheet{
+		ID:          sheetId,
+		Name:        payload.Name,
+		Description: payload.Description,
+	}
+	if payload.FractionalIndex != nil {
+		// a fractional index of zero makes the database place the sheet last, it is only used on insert
+		if *payload.FractionalIndex <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "fractional index must be positive"})
+			return
+		}
+		sheet.FractionalIndex = *payload.FractionalIndex
+	}
+	if payload.SheetConfig != nil {
+		if payload.SheetConfig.Version == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": sheets.ErrInvalidSheetConfig.Error() + ": version is required"})
+			return
+		}
+		sheet.SheetConfig = *payload.SheetConfig
+	}
+
+	updatedSheet, err := sheetService.UpdateSheet(c, &sheet)
+	if err != nil {
+		c.JSON(sheetErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitSheetAuditLog(c, auditLogService, pageId, pages.AuditLogEventSheetUpdated, map[string]interface{}{
+		"sheet_id":         sheetId,
+		"name":             payload.Name,
+		"description":      payload.Description,
+		"fractional_index": payload.FractionalIndex,
+		"sheet_config":     payload.SheetConfig,
+	})
+
+	respondWithSheet(c, updatedSheet)
+}
+
+func handleDuplicateSheet(c *gin.Context, sheetService sheets.SheetsService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	pageId, sheetId, ok := parsePageSheetIds(c)
+	if !ok {
+		return
+	}
+
+	var payload dtos.DuplicateSheetRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&payload); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	duplicatedSheet, err := sheetService.DuplicateSheet(c, sheetId, payload.Name)
+	if err != nil {
+		c.JSON(sheetErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitSheetAuditLog(c, auditLogService, pageId, pages.AuditLogEventSheetDuplicated, map[string]interface{}{
+		"sheet_id":        duplicatedSheet.ID,
+		"name":            duplicatedSheet.Name,
+		"source_sheet_id": sheetId,
+	})
+
+	respondWithSheet(c, duplicatedSheet)
+}
+
+func handleDeleteSheet(c *gin.Context, sheetService sheets.SheetsService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	pageId, sheetId, ok := parsePageSheetIds(c)
+	if !ok {
+		return
+	}
+
+	if err := sheetService.DeleteSheet(c, sheetId); err != nil {
+		c.JSON(sheetErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitSheetAuditLog(c, auditLogService, pageId, pages.AuditLogEventSheetDeleted, map[string]interface{}{"sheet_id": sheetId})
+
+	c.JSON(http.StatusOK, gin.H{"message": "success"})
+}
+
+func parsePageSheetIds(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	pageId, err := uuid.Parse(c.Param("pageId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page id"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	sheetId, err := uuid.Parse(c.Param("sheetId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sheet id"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return pageId, sheetId, true
+}
+
+func respondWithSheet(c *gin.Context, sheet *dbmodels.Sheet) {
+	sheetResponse := dtos.SheetResponse{}
+	if err := sheetResponse.NewSheetResponse(sheet); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, sheetResponse)
+}
+
+func emitSheetAuditLog(c *gin.Context, auditLogService auditlogs.AuditLogServiceWithResource, pageId uuid.UUID, eventName string, payload map[string]interface{}) {
+	logger := apicontext.GetLoggerFromCtx(c)
+
+	if err := auditLogService.EmitAuditLog(c, pageId, dbmodels.AuditLogKindInfo, eventName, payload); err != nil {
+		logger.Error("failed to emit sheet audit log", zap.String("page_id", pageId.String()), zap.String("event", eventName), zap.Error(err))
+	}
+}
+
+func sheetErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, sheets.ErrSheetNotFound),
+		errors.Is(err, pages.ErrPageNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, pages.ErrPageAccessForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, sheets.ErrEmptySheetName),
+		errors.Is(err, sheets.ErrInvalidSheetConfig):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -2,15 +2,21 @@ package sheets
 
 import (
 	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	"github.com/Zampfi/application-platform/services/api/core/auditlogs"
 	datasetsService "github.com/Zampfi/application-platform/services/api/core/datasets/service"
 	"github.com/Zampfi/application-platform/services/api/core/sheets"
+	widgetservice "github.com/Zampfi/application-platform/services/api/core/widgets/service"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
 	"github.com/Zampfi/application-platform/services/api/pkg/cache"
+	"github.com/Zampfi/application-platform/services/api/server/routes/pages/sheets/widgets"
 	"github.com/gin-gonic/gin"
 )
 
 func RegisterSheetsRoutes(sheetsGroup *gin.RouterGroup, datasetService datasetsService.DatasetService, cacheService cache.CacheClient, serverCfg *serverconfig.ServerConfig) {
 
 	sheetService := sheets.NewSheetsService(serverCfg.Store, datasetService, cacheService)
+	widgetService := widgetservice.NewWidgetsService(serverCfg.Store, datasetService)
+	auditLogService := auditlogs.NewAuditLogService(serverCfg).WithResource(dbmodels.ResourceTypePage)
 
 	sheetsGroup.GET("/", func(c *gin.Context) {
 		handleGetSheetsAll(c, sheetService)
@@ -23,4 +29,25 @@ func RegisterSheetsRoutes(sheetsGroup *gin.RouterGroup, datasetService datasetsS
 	sheetsGroup.GET("/:sheetId/filters", func(c *gin.Context) {
 		handleGetSheetFilters(c, sheetService)
 	})
+
+	sheetsGroup.POST("/", func(c *gin.Context) {
+		handleCreateSheet(c, sheetService, auditLogService)
+	})
+
+	sheetGroup := sheetsGroup.Group("/:sheetId", requirePageSheet(sheetService))
+	{
+		sheetGroup.PATCH("", func(c *gin.Context) {
+			handleUpdateSheet(c, sheetService, auditLogService)
+		})
+
+		sheetGroup.DELETE("", func(c *gin.Context) {
+			handleDeleteSheet(c, sheetService, auditLogService)
+		})
+
+		sheetGroup.POST("/duplicate", func(c *gin.Context) {
+			handleDuplicateSheet(c, sheetService, auditLogService)
+		})
+
+		widgets.RegisterWidgetInstancesRoutes(sheetGroup.Group("/widgets"), widgetService, auditLogService)
+	}
 }
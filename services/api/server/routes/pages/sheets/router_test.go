@@ -1,6 +1,8 @@
 package sheets
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,7 +11,11 @@ import (
 	"testing"
 
 	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	"github.com/Zampfi/application-platform/services/api/core/pages"
+	"github.com/Zampfi/application-platform/services/api/core/sheets"
+	sheetmodels "github.com/Zampfi/application-platform/services/api/core/sheets/models"
 	"github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	mock_datasetsService "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
 	mock_sheets "github.com/Zampfi/application-platform/services/api/mocks/core/sheets"
 	mockcache "github.com/Zampfi/application-platform/services/api/mocks/pkg/cache"
@@ -17,6 +23,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
 )
 
 func setupRouter(t *testing.T) (*gin.Engine, *mock_sheets.MockSheetsService, *serverconfig.ServerConfig) {
@@ -237,3 +244,246 @@ func TestHandleGetSheetByID(t *testing.T) {
 		})
 	}
 }
+
+type auditLogStub struct {
+	events []string
+}
+
+func (a *auditLogStub) EmitAuditLog(ctx context.Context, resourceId uuid.UUID, auditLogKind models.AuditLogKind, eventName string, payload map[string]interface{}) error {
+	a.events = append(a.events, eventName)
+	return nil
+}
+
+// setupSheetRouter registers the create, update, duplicate and delete routes the way RegisterSheetsRoutes does
+func setupSheetRouter(t *testing.T) (*gin.Engine, *mock_sheets.MockSheetsService, *auditLogStub) {
+	router, mockService, _ := setupRouter(t)
+	auditLogService := &auditLogStub{}
+
+	router.Use(func(c *gin.Context) {
+		apicontext.AddLoggerToGinContext(c, zap.NewNop())
+	})
+	sheetsGroup := router.Group("/pages/:pageId/sheets")
+	sheetsGroup.POST("/", func(c *gin.Context) {
+		handleCreateSheet(c, mockService, auditLogService)
+	})
+	sheetGroup := sheetsGroup.Group("/:sheetId", requirePageSheet(mockService))
+	sheetGroup.PATCH("", func(c *gin.Context) {
+		handleUpdateSheet(c, mockService, auditLogService)
+	})
+	sheetGroup.DELETE("", func(c *gin.Context) {
+		handleDeleteSheet(c, mockService, auditLogService)
+	})
+	sheetGroup.POST("/duplicate", func(c *gin.Context) {
+		handleDuplicateSheet(c, mockService, auditLogService)
+	})
+
+	return router, mockService, auditLogService
+}
+
+func TestHandleCreateSheet(t *testing.T) {
+	pageId := uuid.New()
+	sheetId := uuid.New()
+
+	tests := []struct {
+		name         string
+		body         string
+		setupMock    func(*mock_sheets.MockSheetsService)
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name: "success",
+			body: `{"name":"Summary","sheet_config":{"version":"1.0"}}`,
+			setupMock: func(m *mock_sheets.MockSheetsService) {
+				m.EXPECT().CreateSheet(mock.Anything, mock.MatchedBy(func(sheet sheetmodels.Sheet) bool {
+					return sheet.PageId == pageId && sheet.Name == "Summary" && sheet.SheetConfig.Version == "1.0"
+				})).Return(&models.Sheet{ID: sheetId, PageId: pageId, Name: "Summary", SheetConfig: json.RawMessage(`{"version":"1.0"}`)}, nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventSheetCreated},
+		},
+		{
+			name:         "missing name",
+			body:         `{"sheet_config":{"version":"1.0"}}`,
+			setupMock:    func(m *mock_sheets.MockSheetsService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid sheet config",
+			body: `{"name":"Summary","sheet_config":{"version":"1.0","sheet_layout":[{}]}}`,
+			setupMock: func(m *mock_sheets.MockSheetsService) {
+				m.EXPECT().CreateSheet(mock.Anything, mock.Anything).Return(nil, sheets.ErrInvalidSheetConfig)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "not a page admin",
+			body: `{"name":"Summary"}`,
+			setupMock: func(m *mock_sheets.MockSheetsService) {
+				m.EXPECT().CreateSheet(mock.Anything, mock.Anything).Return(nil, pages.ErrPageAccessForbidden)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, auditLogService := setupSheetRouter(t)
+			tt.setupMock(mockService)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/pages/%s/sheets/", pageId), bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
+
+func TestHandleUpdateSheet(t *testing.T) {
+	pageId := uuid.New()
+	sheetId := uuid.New()
+	sheet := &models.Sheet{ID: sheetId, PageId: pageId, Name: "Summary", SheetConfig: json.RawMessage(`{"version":"1.0"}`)}
+
+	tests := []struct {
+		name         string
+		pageId       uuid.UUID
+		body         string
+		setupMock    func(*mock_sheets.MockSheetsService)
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name:   "success",
+			pageId: pageId,
+			body:   `{"name":"Overview","fractional_index":2}`,
+			setupMock: func(m *mock_sheets.MockSheetsService) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(sheet, nil)
+				m.EXPECT().UpdateSheet(mock.Anything, mock.MatchedBy(func(update *sheetmodels.Sheet) bool {
+					return update.ID == sheetId && update.Name == "Overview" && update.FractionalIndex == 2
+				})).Return(&models.Sheet{ID: sheetId, PageId: pageId, Name: "Overview", FractionalIndex: 2, SheetConfig: sheet.SheetConfig}, nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventSheetUpdated},
+		},
+		{
+			name:   "sheet of another page",
+			pageId: uuid.New(),
+			body:   `{"name":"Overview"}`,
+			setupMock: func(m *mock_sheets.MockSheetsService) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(sheet, nil)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "sheet not found",
+			pageId: pageId,
+			body:   `{"name":"Overview"}`,
+			setupMock: func(m *mock_sheets.MockSheetsService) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(nil, sheets.ErrSheetNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "sheet config without a version",
+			pageId: pageId,
+			body:   `{"sheet_config":{"sheet_layout":[]}}`,
+			setupMock: func(m *mock_sheets.MockSheetsService) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(sheet, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "fractional index is not positive",
+			pageId: pageId,
+			body:   `{"fractional_index":-1}`,
+			setupMock: func(m *mock_sheets.MockSheetsService) {
+				m.EXPECT().GetSheetById(mock.Anything, sheetId).Return(sheet, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, auditLogService := setupSheetRouter(t)
+			tt.setupMock(mockService)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPatch, fmt.Sprintf("/pages/%s/sheets/%s", tt.pageId, sheetId), bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
+
+func TestHandleDuplicateSheet(t *testing.T) {
+	pageId := uuid.New()
+	sheetId := uuid.New()
+	duplicatedSheetId := uuid.New()
+
+	router, mockService, auditLogService := setupSheetRouter(t)
+	mockService.EXPECT().GetSheetById(mock.Anything, sheetId).Return(&models.Sheet{ID: sheetId, PageId: pageId}, nil)
+	mockService.EXPECT().DuplicateSheet(mock.Anything, sheetId, "").Return(&models.Sheet{
+		ID:          duplicatedSheetId,
+		PageId:      pageId,
+		Name:        "Summary (copy)",
+		SheetConfig: json.RawMessage(`{"version":"1.0"}`),
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/pages/%s/sheets/%s/duplicate", pageId, sheetId), nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), duplicatedSheetId.String())
+	assert.Equal(t, []string{pages.AuditLogEventSheetDuplicated}, auditLogService.events)
+}
+
+func TestHandleDeleteSheet(t *testing.T) {
+	pageId := uuid.New()
+	sheetId := uuid.New()
+
+	tests := []struct {
+		name         string
+		deleteErr    error
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name:         "success",
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventSheetDeleted},
+		},
+		{
+			name:         "not a page admin",
+			deleteErr:    pages.ErrPageAccessForbidden,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "service error",
+			deleteErr:    errors.New("service error"),
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, auditLogService := setupSheetRouter(t)
+			mockService.EXPECT().GetSheetById(mock.Anything, sheetId).Return(&models.Sheet{ID: sheetId, PageId: pageId}, nil)
+			mockService.EXPECT().DeleteSheet(mock.Anything, sheetId).Return(tt.deleteErr)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("/pages/%s/sheets/%s", pageId, sheetId), nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/Zampfi/application-platform/services/api/core/widgets/models"
+)
+
+type CreateWidgetInstanceRequest struct {
+	WidgetType    string              `json:"widget_type" binding:"required"`
+	Title         string              `json:"title"`
+	DataMappings  models.DataMappings `json:"data_mappings"`
+	DisplayConfig *json.RawMessage    `json:"display_config"`
+}
+
+type UpdateWidgetInstanceRequest struct {
+	WidgetType    string               `json:"widget_type"`
+	Title         string               `json:"title"`
+	DataMappings  *models.DataMappings `json:"data_mappings"`
+	DisplayConfig *json.RawMessage     `json:"display_config"`
+}
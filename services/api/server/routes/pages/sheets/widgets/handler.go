@@ -0,0 +1,227 @@
+package widgets
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Zampfi/application-platform/services/api/core/auditlogs"
+	"github.com/Zampfi/application-platform/services/api/core/pages"
+	"github.com/Zampfi/application-platform/services/api/core/sheets"
+	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	widgetservice "github.com/Zampfi/application-platform/services/api/core/widgets/service"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/Zampfi/application-platform/services/api/server/routes/pages/sheets/widgets/dtos"
+	widgetdtos "github.com/Zampfi/application-platform/services/api/server/routes/widgets/dtos"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type widgetInstanceRoute struct {
+	pageId           uuid.UUID
+	sheetId          uuid.UUID
+	widgetInstanceId uuid.UUID
+}
+
+// requireSheetWidgetInstance stops requests for a widget instance that is not on the sheet of the route
+func requireSheetWidgetInstance(widgetService widgetservice.WidgetsService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route, ok := parseWidgetInstanceRoute(c)
+		if !ok {
+			c.Abort()
+			return
+		}
+
+		widgetInstance, err := widgetService.GetWidgetInstance(c, route.widgetInstanceId)
+		if err != nil {
+			c.AbortWithStatusJSON(widgetInstanceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		if widgetInstance.SheetID != route.sheetId {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": widgetservice.ErrWidgetInstanceNotFound.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func handleCreateWidgetInstance(c *gin.Context, widgetService widgetservice.WidgetsService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	pageId, err := uuid.Parse(c.Param("pageId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page id"})
+		return
+	}
+
+	sheetId, err := uuid.Parse(c.Param("sheetId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sheet id"})
+		return
+	}
+
+	var payload dtos.CreateWidgetInstanceRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	widgetInstance, err := widgetService.CreateWidgetInstance(c, widgetmodels.WidgetInstance{
+		WidgetType:    payload.WidgetType,
+		SheetID:       sheetId,
+		Title:         payload.Title,
+		DataMappings:  payload.DataMappings,
+		DisplayConfig: payload.DisplayConfig,
+	})
+	if err != nil {
+		c.JSON(widgetInstanceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitWidgetInstanceAuditLog(c, auditLogService, pageId, pages.AuditLogEventWidgetInstanceCreated, map[string]interface{}{
+		"sheet_id":           sheetId,
+		"widget_instance_id": widgetInstance.ID,
+		"widget_type":        widgetInstance.WidgetType,
+		"title":              widgetInstance.Title,
+	})
+
+	respondWithWidgetInstance(c, widgetInstance)
+}
+
+func handleUpdateWidgetInstance(c *gin.Context, widgetService widgetservice.WidgetsService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	route, ok := parseWidgetInstanceRoute(c)
+	if !ok {
+		return
+	}
+
+	var payload dtos.UpdateWidgetInstanceRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	update := widgetmodels.WidgetInstance{
+		ID:            route.widgetInstanceId,
+		WidgetType:    payload.WidgetType,
+		Title:         payload.Title,
+		DisplayConfig: payload.DisplayConfig,
+	}
+	if payload.DataMappings != nil {
+		if payload.DataMappings.Version == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": widgetservice.ErrInvalidDataMappings.Error() + ": version is required"})
+			return
+		}
+		update.DataMappings = *payload.DataMappings
+	}
+
+	widgetInstance, err := widgetService.UpdateWidgetInstance(c, update)
+	if err != nil {
+		c.JSON(widgetInstanceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitWidgetInstanceAuditLog(c, auditLogService, route.pageId, pages.AuditLogEventWidgetInstanceUpdated, map[string]interface{}{
+		"sheet_id":           route.sheetId,
+		"widget_instance_id": route.widgetInstanceId,
+		"widget_type":        payload.WidgetType,
+		"title":              payload.Title,
+		"data_mappings":      payload.DataMappings,
+		"display_config":     payload.DisplayConfig,
+	})
+
+	respondWithWidgetInstance(c, widgetInstance)
+}
+
+func handleDuplicateWidgetInstance(c *gin.Context, widgetService widgetservice.WidgetsService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	route, ok := parseWidgetInstanceRoute(c)
+	if !ok {
+		return
+	}
+
+	widgetInstance, err := widgetService.DuplicateWidgetInstance(c, route.widgetInstanceId)
+	if err != nil {
+		c.JSON(widgetInstanceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitWidgetInstanceAuditLog(c, auditLogService, route.pageId, pages.AuditLogEventWidgetInstanceDuplicated, map[string]interface{}{
+		"sheet_id":                  route.sheetId,
+		"widget_instance_id":        widgetInstance.ID,
+		"source_widget_instance_id": route.widgetInstanceId,
+	})
+
+	respondWithWidgetInstance(c, widgetInstance)
+}
+
+func handleDeleteWidgetInstance(c *gin.Context, widgetService widgetservice.WidgetsService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	route, ok := parseWidgetInstanceRoute(c)
+	if !ok {
+		return
+	}
+
+	if err := widgetService.DeleteWidgetInstance(c, route.widgetInstanceId); err != nil {
+		c.JSON(widgetInstanceErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	emitWidgetInstanceAuditLog(c, auditLogService, route.pageId, pages.AuditLogEventWidgetInstanceDeleted, map[string]interface{}{
+		"sheet_id":           route.sheetId,
+		"widget_instance_id": route.widgetInstanceId,
+	})
+
+	c.JSON(http.StatusOK, gin.H{"message": "success"})
+}
+
+func parseWidgetInstanceRoute(c *gin.Context) (widgetInstanceRoute, bool) {
+	route := widgetInstanceRoute{}
+
+	var err error
+	if route.pageId, err = uuid.Parse(c.Param("pageId")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page id"})
+		return route, false
+	}
+	if route.sheetId, err = uuid.Parse(c.Param("sheetId")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sheet id"})
+		return route, false
+	}
+	if route.widgetInstanceId, err = uuid.Parse(c.Param("widgetInstanceId")); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid widget instance id"})
+		return route, false
+	}
+
+	return route, true
+}
+
+func respondWithWidgetInstance(c *gin.Context, widgetInstance *widgetmodels.WidgetInstance) {
+	response, err := widgetdtos.NewWidgetInstanceResponse(widgetInstance)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func emitWidgetInstanceAuditLog(c *gin.Context, auditLogService auditlogs.AuditLogServiceWithResource, pageId uuid.UUID, eventName string, payload map[string]interface{}) {
+	logger := apicontext.GetLoggerFromCtx(c)
+
+	if err := auditLogService.EmitAuditLog(c, pageId, dbmodels.AuditLogKindInfo, eventName, payload); err != nil {
+		logger.Error("failed to emit widget instance audit log", zap.String("page_id", pageId.String()), zap.String("event", eventName), zap.Error(err))
+	}
+}
+
+func widgetInstanceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, widgetservice.ErrWidgetInstanceNotFound),
+		errors.Is(err, sheets.ErrSheetNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, pages.ErrPageAccessForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, widgetservice.ErrUnknownWidgetType),
+		errors.Is(err, widgetservice.ErrInvalidDataMappings):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
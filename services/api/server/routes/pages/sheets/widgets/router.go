@@ -0,0 +1,28 @@
+package widgets
+
+import (
+	"github.com/Zampfi/application-platform/services/api/core/auditlogs"
+	widgetservice "github.com/Zampfi/application-platform/services/api/core/widgets/service"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterWidgetInstancesRoutes(widgetsGroup *gin.RouterGroup, widgetService widgetservice.WidgetsService, auditLogService auditlogs.AuditLogServiceWithResource) {
+	widgetsGroup.POST("/", func(c *gin.Context) {
+		handleCreateWidgetInstance(c, widgetService, auditLogService)
+	})
+
+	widgetInstanceGroup := widgetsGroup.Group("/:widgetInstanceId", requireSheetWidgetInstance(widgetService))
+	{
+		widgetInstanceGroup.PATCH("", func(c *gin.Context) {
+			handleUpdateWidgetInstance(c, widgetService, auditLogService)
+		})
+
+		widgetInstanceGroup.DELETE("", func(c *gin.Context) {
+			handleDeleteWidgetInstance(c, widgetService, auditLogService)
+		})
+
+		widgetInstanceGroup.POST("/duplicate", func(c *gin.Context) {
+			handleDuplicateWidgetInstance(c, widgetService, auditLogService)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package widgets
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Zampfi/application-platform/services/api/core/pages"
+	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	widgetservice "github.com/Zampfi/application-platform/services/api/core/widgets/service"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	mock_widgets "github.com/Zampfi/application-platform/services/api/mocks/core/widgets/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+type auditLogStub struct {
+	events []string
+}
+
+func (a *auditLogStub) EmitAuditLog(ctx context.Context, resourceId uuid.UUID, auditLogKind dbmodels.AuditLogKind, eventName string, payload map[string]interface{}) error {
+	a.events = append(a.events, eventName)
+	return nil
+}
+
+func setupRouter(t *testing.T) (*gin.Engine, *mock_widgets.MockWidgetsService, *auditLogStub) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		apicontext.AddLoggerToGinContext(c, zap.NewNop())
+	})
+
+	mockService := mock_widgets.NewMockWidgetsService(t)
+	auditLogService := &auditLogStub{}
+	RegisterWidgetInstancesRoutes(router.Group("/pages/:pageId/sheets/:sheetId/widgets"), mockService, auditLogService)
+
+	return router, mockService, auditLogService
+}
+
+func TestHandleCreateWidgetInstance(t *testing.T) {
+	pageId := uuid.New()
+	sheetId := uuid.New()
+	widgetInstanceId := uuid.New()
+
+	tests := []struct {
+		name         string
+		body         string
+		setupMock    func(*mock_widgets.MockWidgetsService)
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name: "success",
+			body: `{"widget_type":"bar_chart","title":"Sales","data_mappings":{"version":"1","mappings":[{"dataset_id":"d1"}]}}`,
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().CreateWidgetInstance(mock.Anything, mock.MatchedBy(func(instance widgetmodels.WidgetInstance) bool {
+					return instance.SheetID == sheetId && instance.WidgetType == "bar_chart" && len(instance.DataMappings.Mappings) == 1
+				})).Return(&widgetmodels.WidgetInstance{ID: widgetInstanceId, SheetID: sheetId, WidgetType: "bar_chart", Title: "Sales"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventWidgetInstanceCreated},
+		},
+		{
+			name:         "missing widget type",
+			body:         `{"title":"Sales"}`,
+			setupMock:    func(m *mock_widgets.MockWidgetsService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid data mappings",
+			body: `{"widget_type":"bar_chart","data_mappings":{"version":"1"}}`,
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().CreateWidgetInstance(mock.Anything, mock.Anything).Return(nil, widgetservice.ErrInvalidDataMappings)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "not a page admin",
+			body: `{"widget_type":"bar_chart"}`,
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().CreateWidgetInstance(mock.Anything, mock.Anything).Return(nil, pages.ErrPageAccessForbidden)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, auditLogService := setupRouter(t)
+			tt.setupMock(mockService)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/pages/%s/sheets/%s/widgets/", pageId, sheetId), bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
+
+func TestHandleUpdateWidgetInstance(t *testing.T) {
+	pageId := uuid.New()
+	sheetId := uuid.New()
+	widgetInstanceId := uuid.New()
+	widgetInstance := widgetmodels.WidgetInstance{ID: widgetInstanceId, SheetID: sheetId, WidgetType: "bar_chart"}
+
+	tests := []struct {
+		name         string
+		body         string
+		setupMock    func(*mock_widgets.MockWidgetsService)
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name: "success",
+			body: `{"title":"Monthly sales"}`,
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetWidgetInstance(mock.Anything, widgetInstanceId).Return(widgetInstance, nil)
+				m.EXPECT().UpdateWidgetInstance(mock.Anything, widgetmodels.WidgetInstance{ID: widgetInstanceId, Title: "Monthly sales"}).
+					Return(&widgetmodels.WidgetInstance{ID: widgetInstanceId, SheetID: sheetId, Title: "Monthly sales"}, nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventWidgetInstanceUpdated},
+		},
+		{
+			name: "widget instance of another sheet",
+			body: `{"title":"Monthly sales"}`,
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetWidgetInstance(mock.Anything, widgetInstanceId).Return(widgetmodels.WidgetInstance{ID: widgetInstanceId, SheetID: uuid.New()}, nil)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "data mappings without a version",
+			body: `{"data_mappings":{"mappings":[]}}`,
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetWidgetInstance(mock.Anything, widgetInstanceId).Return(widgetInstance, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "unknown widget type",
+			body: `{"widget_type":"gauge"}`,
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetWidgetInstance(mock.Anything, widgetInstanceId).Return(widgetInstance, nil)
+				m.EXPECT().UpdateWidgetInstance(mock.Anything, mock.Anything).Return(nil, widgetservice.ErrUnknownWidgetType)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, auditLogService := setupRouter(t)
+			tt.setupMock(mockService)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPatch, fmt.Sprintf("/pages/%s/sheets/%s/widgets/%s", pageId, sheetId, widgetInstanceId), bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
+
+func TestHandleDuplicateWidgetInstance(t *testing.T) {
+	pageId := uuid.New()
+	sheetId := uuid.New()
+	widgetInstanceId := uuid.New()
+	duplicatedWidgetInstanceId := uuid.New()
+
+	router, mockService, auditLogService := setupRouter(t)
+	mockService.EXPECT().GetWidgetInstance(mock.Anything, widgetInstanceId).Return(widgetmodels.WidgetInstance{ID: widgetInstanceId, SheetID: sheetId}, nil)
+	mockService.EXPECT().DuplicateWidgetInstance(mock.Anything, widgetInstanceId).Return(&widgetmodels.WidgetInstance{
+		ID:      duplicatedWidgetInstanceId,
+		SheetID: sheetId,
+		Title:   "Sales (copy)",
+	}, nil)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/pages/%s/sheets/%s/widgets/%s/duplicate", pageId, sheetId, widgetInstanceId), nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), duplicatedWidgetInstanceId.String())
+	assert.Equal(t, []string{pages.AuditLogEventWidgetInstanceDuplicated}, auditLogService.events)
+}
+
+func TestHandleDeleteWidgetInstance(t *testing.T) {
+	pageId := uuid.New()
+	sheetId := uuid.New()
+	widgetInstanceId := uuid.New()
+
+	tests := []struct {
+		name         string
+		widgetId     string
+		setupMock    func(*mock_widgets.MockWidgetsService)
+		expectedCode int
+		wantEvents   []string
+	}{
+		{
+			name:     "success",
+			widgetId: widgetInstanceId.String(),
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetWidgetInstance(mock.Anything, widgetInstanceId).Return(widgetmodels.WidgetInstance{ID: widgetInstanceId, SheetID: sheetId}, nil)
+				m.EXPECT().DeleteWidgetInstance(mock.Anything, widgetInstanceId).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			wantEvents:   []string{pages.AuditLogEventWidgetInstanceDeleted},
+		},
+		{
+			name:         "invalid widget instance id",
+			widgetId:     "invalid-uuid",
+			setupMock:    func(m *mock_widgets.MockWidgetsService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:     "widget instance not found",
+			widgetId: widgetInstanceId.String(),
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetWidgetInstance(mock.Anything, widgetInstanceId).Return(widgetmodels.WidgetInstance{}, widgetservice.ErrWidgetInstanceNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:     "service error",
+			widgetId: widgetInstanceId.String(),
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetWidgetInstance(mock.Anything, widgetInstanceId).Return(widgetmodels.WidgetInstance{ID: widgetInstanceId, SheetID: sheetId}, nil)
+				m.EXPECT().DeleteWidgetInstance(mock.Anything, widgetInstanceId).Return(errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockService, auditLogService := setupRouter(t)
+			tt.setupMock(mockService)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("/pages/%s/sheets/%s/widgets/%s", pageId, sheetId, tt.widgetId), nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.wantEvents, auditLogService.events)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package dtos
+
+import (
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	"github.com/google/uuid"
+)
+
+type ReconciliationRequest struct {
+	Name           string                    `json:"name" binding:"required"`
+	Description    string                    `json:"description"`
+	LeftDatasetId  uuid.UUID                 `json:"left_dataset_id"`
+	RightDatasetId uuid.UUID                 `json:"right_dataset_id"`
+	Left           models.ReconciliationSide `json:"left"`
+	Right          models.ReconciliationSide `json:"right"`
+	Rules          []models.MatchRule        `json:"rules" binding:"required"`
+}
+
+func (r *ReconciliationRequest) ToModel() models.ReconciliationParams {
+	return models.ReconciliationParams{
+		Name:           r.Name,
+		Description:    r.Description,
+		LeftDatasetId:  r.LeftDatasetId,
+		RightDatasetId: r.RightDatasetId,
+		Left:           r.Left,
+		Right:          r.Right,
+		Rules:          r.Rules,
+	}
+}
+
+type ManualMatchRequest struct {
+	LeftRowIds  []string `json:"left_row_ids" binding:"required"`
+	RightRowIds []string `json:"right_row_ids" binding:"required"`
+}
+
+func (r *ManualMatchRequest) ToModel() models.ManualMatchParams {
+	return models.ManualMatchParams{
+		LeftRowIds:  r.LeftRowIds,
+		RightRowIds: r.RightRowIds,
+	}
+}
+
+type PageQuery struct {
+	Page     int `form:"page"`
+	PageSize int `form:"page_size"`
+}
+
+type ReconciliationMatchesQuery struct {
+	PageQuery
+	Status []string `form:"status"`
+}
+
+type ReconciliationExceptionsQuery struct {
+	PageQuery
+	Side   []string `form:"side"`
+	Status []string `form:"status"`
+}
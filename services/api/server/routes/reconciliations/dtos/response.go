@@ -0,0 +1,139 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	"github.com/google/uuid"
+)
+
+type Reconciliation struct {
+	ID              uuid.UUID                 `json:"id"`
+	OwnerId         uuid.UUID                 `json:"owner_id"`
+	Name            string                    `json:"name"`
+	Description     string                    `json:"description"`
+	LeftDatasetId   uuid.UUID                 `json:"left_dataset_id"`
+	RightDatasetId  uuid.UUID                 `json:"right_dataset_id"`
+	Left            models.ReconciliationSide `json:"left"`
+	Right           models.ReconciliationSide `json:"right"`
+	Rules           []models.MatchRule        `json:"rules"`
+	ResultDatasetId *uuid.UUID                `json:"result_dataset_id"`
+	CreatedBy       uuid.UUID                 `json:"created_by"`
+	UpdatedBy       uuid.UUID                 `json:"updated_by"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+}
+
+func (r *Reconciliation) FromModel(model models.Reconciliation) {
+	r.ID = model.ID
+	r.OwnerId = model.OwnerId
+	r.Name = model.Name
+	r.Description = model.Description
+	r.LeftDatasetId = model.LeftDatasetId
+	r.RightDatasetId = model.RightDatasetId
+	r.Left = model.Left
+	r.Right = model.Right
+	r.Rules = model.Rules
+	r.ResultDatasetId = model.ResultDatasetId
+	r.CreatedBy = model.CreatedBy
+	r.UpdatedBy = model.UpdatedBy
+	r.CreatedAt = model.CreatedAt
+	r.UpdatedAt = model.UpdatedAt
+}
+
+type ReconciliationRun struct {
+	ID                  uuid.UUID  `json:"id"`
+	ReconciliationId    uuid.UUID  `json:"reconciliation_id"`
+	Status              string     `json:"status"`
+	LeftRowCount        int        `json:"left_row_count"`
+	RightRowCount       int        `json:"right_row_count"`
+	MatchCount          int        `json:"match_count"`
+	LeftExceptionCount  int        `json:"left_exception_count"`
+	RightExceptionCount int        `json:"right_exception_count"`
+	LeftSkippedCount    int        `json:"left_skipped_count"`
+	RightSkippedCount   int        `json:"right_skipped_count"`
+	Error               string     `json:"error"`
+	StartedAt           time.Time  `json:"started_at"`
+	CompletedAt         *time.Time `json:"completed_at"`
+	CreatedBy           uuid.UUID  `json:"created_by"`
+}
+
+func (r *ReconciliationRun) FromModel(model dbmodels.ReconciliationRun) {
+	r.ID = model.ID
+	r.ReconciliationId = model.ReconciliationId
+	r.Status = model.Status
+	r.LeftRowCount = model.LeftRowCount
+	r.RightRowCount = model.RightRowCount
+	r.MatchCount = model.MatchCount
+	r.LeftExceptionCount = model.LeftExceptionCount
+	r.RightExceptionCount = model.RightExceptionCount
+	r.LeftSkippedCount = model.LeftSkippedCount
+	r.RightSkippedCount = model.RightSkippedCount
+	r.Error = model.Error
+	r.StartedAt = model.StartedAt
+	r.CompletedAt = model.CompletedAt
+	r.CreatedBy = model.CreatedBy
+}
+
+type ReconciliationMatch struct {
+	MatchId     uuid.UUID                    `json:"match_id"`
+	RuleName    string                       `json:"rule_name"`
+	MatchType   string                       `json:"match_type"`
+	Status      string                       `json:"status"`
+	LeftRows    []dbmodels.ReconciliationRow `json:"left_rows"`
+	RightRows   []dbmodels.ReconciliationRow `json:"right_rows"`
+	LeftAmount  *float64                     `json:"left_amount"`
+	RightAmount *float64                     `json:"right_amount"`
+	CreatedBy   *uuid.UUID                   `json:"created_by"`
+	UpdatedBy   *uuid.UUID                   `json:"updated_by"`
+	CreatedAt   time.Time                    `json:"created_at"`
+	UpdatedAt   time.Time                    `json:"updated_at"`
+}
+
+func (m *ReconciliationMatch) FromModel(model models.ReconciliationMatch) {
+	m.MatchId = model.MatchId
+	m.RuleName = model.RuleName
+	m.MatchType = model.MatchType
+	m.Status = model.Status
+	m.LeftRows = model.LeftRows
+	m.RightRows = model.RightRows
+	m.LeftAmount = model.LeftAmount
+	m.RightAmount = model.RightAmount
+	m.CreatedBy = model.CreatedBy
+	m.UpdatedBy = model.UpdatedBy
+	m.CreatedAt = model.CreatedAt
+	m.UpdatedAt = model.UpdatedAt
+}
+
+type ReconciliationMatchesResponse struct {
+	Matches    []ReconciliationMatch `json:"matches"`
+	TotalCount int64                 `json:"total_count"`
+}
+
+type ReconciliationException struct {
+	Side            string     `json:"side"`
+	RowId           string     `json:"row_id"`
+	Amount          *float64   `json:"amount"`
+	TransactionDate *time.Time `json:"transaction_date"`
+	Reference       string     `json:"reference"`
+	Status          string     `json:"status"`
+	Skipped         bool       `json:"skipped"`
+	MatchId         *uuid.UUID `json:"match_id"`
+}
+
+func (e *ReconciliationException) FromModel(model dbmodels.ReconciliationException) {
+	e.Side = model.Side
+	e.RowId = model.RowId
+	e.Amount = model.Amount
+	e.TransactionDate = model.TransactionDate
+	e.Reference = model.Reference
+	e.Status = model.Status
+	e.Skipped = model.Skipped
+	e.MatchId = model.MatchId
+}
+
+type ReconciliationExceptionsResponse struct {
+	Exceptions []ReconciliationException `json:"exceptions"`
+	TotalCount int64                     `json:"total_count"`
+}
@@ -0,0 +1,358 @@
+package reconciliations
+
+import (
+	"errors"
+	"net/http"
+
+	reconciliationErrors "github.com/Zampfi/application-platform/services/api/core/reconciliations/errors"
+	reconciliationservice "github.com/Zampfi/application-platform/services/api/core/reconciliations/service"
+	storemodels "github.com/Zampfi/application-platform/services/api/db/models"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/Zampfi/application-platform/services/api/server/routes/reconciliations/dtos"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func GetReconciliations(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	reconciliations, err := svc.GetReconciliations(c)
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.Reconciliation, len(reconciliations))
+	for i, reconciliation := range reconciliations {
+		response[i].FromModel(reconciliation)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetReconciliation(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	reconciliationId, err := uuid.Parse(c.Param("reconciliationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reconciliation id"})
+		return
+	}
+
+	reconciliation, err := svc.GetReconciliation(c, reconciliationId)
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.Reconciliation{}
+	response.FromModel(reconciliation)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateReconciliation(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	userId, orgId, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	var request dtos.ReconciliationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reconciliation, err := svc.CreateReconciliation(c, orgId, userId, request.ToModel())
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.Reconciliation{}
+	response.FromModel(reconciliation)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateReconciliation(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	userId, _, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	reconciliationId, err := uuid.Parse(c.Param("reconciliationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reconciliation id"})
+		return
+	}
+
+	var request dtos.ReconciliationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reconciliation, err := svc.UpdateReconciliation(c, userId, reconciliationId, request.ToModel())
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.Reconciliation{}
+	response.FromModel(reconciliation)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteReconciliation(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	userId, _, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	reconciliationId, err := uuid.Parse(c.Param("reconciliationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reconciliation id"})
+		return
+	}
+
+	if err := svc.DeleteReconciliation(c, userId, reconciliationId); err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func StartReconciliationRun(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	userId, orgId, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	reconciliationId, err := uuid.Parse(c.Param("reconciliationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reconciliation id"})
+		return
+	}
+
+	run, err := svc.StartReconciliationRun(c, orgId, userId, reconciliationId)
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.ReconciliationRun{}
+	response.FromModel(run)
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+func GetReconciliationRuns(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	reconciliationId, err := uuid.Parse(c.Param("reconciliationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reconciliation id"})
+		return
+	}
+
+	runs, err := svc.GetReconciliationRuns(c, reconciliationId)
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.ReconciliationRun, len(runs))
+	for i, run := range runs {
+		response[i].FromModel(run)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetReconciliationRun(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	reconciliationId, runId, ok := getReconciliationAndRunIds(c)
+	if !ok {
+		return
+	}
+
+	run, err := svc.GetReconciliationRun(c, reconciliationId, runId)
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.ReconciliationRun{}
+	response.FromModel(run)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetReconciliationMatches(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	reconciliationId, runId, ok := getReconciliationAndRunIds(c)
+	if !ok {
+		return
+	}
+
+	var query dtos.ReconciliationMatchesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := svc.GetReconciliationMatches(c, reconciliationId, runId, storemodels.ReconciliationMatchFilters{
+		Statuses: query.Status,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	})
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.ReconciliationMatchesResponse{
+		Matches:    make([]dtos.ReconciliationMatch, len(page.Matches)),
+		TotalCount: page.TotalCount,
+	}
+	for i, match := range page.Matches {
+		response.Matches[i].FromModel(match)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func GetReconciliationExceptions(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	reconciliationId, runId, ok := getReconciliationAndRunIds(c)
+	if !ok {
+		return
+	}
+
+	var query dtos.ReconciliationExceptionsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := svc.GetReconciliationExceptions(c, reconciliationId, runId, storemodels.ReconciliationExceptionFilters{
+		Sides:    query.Side,
+		Statuses: query.Status,
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	})
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.ReconciliationExceptionsResponse{
+		Exceptions: make([]dtos.ReconciliationException, len(page.Exceptions)),
+		TotalCount: page.TotalCount,
+	}
+	for i, exception := range page.Exceptions {
+		response.Exceptions[i].FromModel(exception)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateManualMatch(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	userId, orgId, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	reconciliationId, runId, ok := getReconciliationAndRunIds(c)
+	if !ok {
+		return
+	}
+
+	var request dtos.ManualMatchRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	match, err := svc.CreateManualMatch(c, orgId, userId, reconciliationId, runId, request.ToModel())
+	if err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.ReconciliationMatch{}
+	response.FromModel(match)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UnmatchReconciliationMatch(c *gin.Context, svc reconciliationservice.ReconciliationService) {
+	userId, orgId, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	reconciliationId, runId, ok := getReconciliationAndRunIds(c)
+	if !ok {
+		return
+	}
+
+	matchId, err := uuid.Parse(c.Param("matchId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid match id"})
+		return
+	}
+
+	if err := svc.UnmatchReconciliationMatch(c, orgId, userId, reconciliationId, runId, matchId); err != nil {
+		c.JSON(reconciliationErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func getUserAndOrganization(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	_, userId, orgIds := apicontext.GetAuthFromContext(c)
+	if userId == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	if len(orgIds) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "no organization ids found"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return *userId, orgIds[0], true
+}
+
+func getReconciliationAndRunIds(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	reconciliationId, err := uuid.Parse(c.Param("reconciliationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid reconciliation id"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	runId, err := uuid.Parse(c.Param("runId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid run id"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return reconciliationId, runId, true
+}
+
+func reconciliationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, reconciliationErrors.ErrReconciliationNotFound),
+		errors.Is(err, reconciliationErrors.ErrReconciliationRunNotFound),
+		errors.Is(err, reconciliationErrors.ErrReconciliationMatchNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, reconciliationErrors.ErrReconciliationAccessForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, reconciliationErrors.ErrReconciliationRunInProgress),
+		errors.Is(err, reconciliationErrors.ErrReconciliationRunNotCompleted),
+		errors.Is(err, reconciliationErrors.ErrReconciliationMatchUnmatched):
+		return http.StatusConflict
+	case errors.Is(err, reconciliationErrors.ErrEmptyReconciliationName),
+		errors.Is(err, reconciliationErrors.ErrInvalidReconciliationSide),
+		errors.Is(err, reconciliationErrors.ErrInvalidMatchRules),
+		errors.Is(err, reconciliationErrors.ErrInvalidManualMatch):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,64 @@
+package reconciliations
+
+import (
+	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	reconciliationservice "github.com/Zampfi/application-platform/services/api/core/reconciliations/service"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterReconciliationRoutes(e *gin.RouterGroup, serverCfg *serverconfig.ServerConfig, datasetService datasetservice.DatasetService) error {
+	reconciliationService := reconciliationservice.NewReconciliationService(serverCfg.Store, datasetService, serverCfg.TemporalSdk)
+
+	reconciliationGroup := e.Group("/reconciliations")
+	{
+		reconciliationGroup.GET("", func(c *gin.Context) {
+			GetReconciliations(c, reconciliationService)
+		})
+
+		reconciliationGroup.POST("", func(c *gin.Context) {
+			CreateReconciliation(c, reconciliationService)
+		})
+
+		reconciliationGroup.GET("/:reconciliationId", func(c *gin.Context) {
+			GetReconciliation(c, reconciliationService)
+		})
+
+		reconciliationGroup.PUT("/:reconciliationId", func(c *gin.Context) {
+			UpdateReconciliation(c, reconciliationService)
+		})
+
+		reconciliationGroup.DELETE("/:reconciliationId", func(c *gin.Context) {
+			DeleteReconciliation(c, reconciliationService)
+		})
+
+		reconciliationGroup.POST("/:reconciliationId/runs", func(c *gin.Context) {
+			StartReconciliationRun(c, reconciliationService)
+		})
+
+		reconciliationGroup.GET("/:reconciliationId/runs", func(c *gin.Context) {
+			GetReconciliationRuns(c, reconciliationService)
+		})
+
+		reconciliationGroup.GET("/:reconciliationId/runs/:runId", func(c *gin.Context) {
+			GetReconciliationRun(c, reconciliationService)
+		})
+
+		reconciliationGroup.GET("/:reconciliationId/runs/:runId/matches", func(c *gin.Context) {
+			GetReconciliationMatches(c, reconciliationService)
+		})
+
+		reconciliationGroup.POST("/:reconciliationId/runs/:runId/matches", func(c *gin.Context) {
+			CreateManualMatch(c, reconciliationService)
+		})
+
+		reconciliationGroup.DELETE("/:reconciliationId/runs/:runId/matches/:matchId", func(c *gin.Context) {
+			UnmatchReconciliationMatch(c, reconciliationService)
+		})
+
+		reconciliationGroup.GET("/:reconciliationId/runs/:runId/exceptions", func(c *gin.Context) {
+			GetReconciliationExceptions(c, reconciliationService)
+		})
+	}
+	return nil
+}
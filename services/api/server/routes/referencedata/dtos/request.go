@@ -0,0 +1,28 @@
+package dtos
+
+import "github.com/Zampfi/application-platform/services/api/core/referencedata/models"
+
+type BankRequest struct {
+	Code    string `json:"code" binding:"required"`
+	Name    string `json:"name" binding:"required"`
+	Country string `json:"country"`
+	BIC     string `json:"bic"`
+}
+
+func (r *BankRequest) ToModel() models.BankParams {
+	return models.BankParams{
+		Code:    r.Code,
+		Name:    r.Name,
+		Country: r.Country,
+		BIC:     r.BIC,
+	}
+}
+
+type LookupQuery struct {
+	Kind  string `form:"kind" binding:"required"`
+	Value string `form:"value" binding:"required"`
+}
+
+type ValidateRequest struct {
+	Value string `json:"value" binding:"required"`
+}
@@ -0,0 +1,45 @@
+package dtos
+
+import (
+	"github.com/Zampfi/application-platform/services/api/core/referencedata/models"
+	"github.com/google/uuid"
+)
+
+type Bank struct {
+	ID      *uuid.UUID `json:"id,omitempty"`
+	Code    string     `json:"code"`
+	Name    string     `json:"name"`
+	Country string     `json:"country,omitempty"`
+	BIC     string     `json:"bic,omitempty"`
+	Custom  bool       `json:"custom"`
+}
+
+func (b *Bank) FromModel(model models.Bank) {
+	b.ID = model.ID
+	b.Code = model.Code
+	b.Name = model.Name
+	b.Country = model.Country
+	b.BIC = model.BIC
+	b.Custom = model.ID != nil
+}
+
+type LookupResult struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+}
+
+func (r *LookupResult) FromModel(model models.LookupResult) {
+	r.Kind = string(model.Kind)
+	r.Value = model.Value
+	r.Code = model.Code
+	r.Name = model.Name
+}
+
+// ValidationResult carries the parsed IBAN or BIC when the value is valid and the error code otherwise
+type ValidationResult struct {
+	Valid   bool        `json:"valid"`
+	Error   string      `json:"error,omitempty"`
+	Details interface{} `json:"details,omitempty"`
+}
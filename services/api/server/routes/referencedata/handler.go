@@ -0,0 +1,166 @@
+package referencedata
+
+import (
+	"errors"
+	"net/http"
+
+	referencedataErrors "github.com/Zampfi/application-platform/services/api/core/referencedata/errors"
+	referencedataservice "github.com/Zampfi/application-platform/services/api/core/referencedata/service"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/Zampfi/application-platform/services/api/pkg/referencedata"
+	"github.com/Zampfi/application-platform/services/api/server/routes/referencedata/dtos"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func GetCountries(c *gin.Context, svc referencedataservice.ReferenceDataService) {
+	c.JSON(http.StatusOK, svc.GetCountries())
+}
+
+func GetCurrencies(c *gin.Context, svc referencedataservice.ReferenceDataService) {
+	c.JSON(http.StatusOK, svc.GetCurrencies())
+}
+
+func GetBanks(c *gin.Context, svc referencedataservice.ReferenceDataService) {
+	banks, err := svc.GetBanks(c)
+	if err != nil {
+		c.JSON(referenceDataErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.Bank, len(banks))
+	for i, bank := range banks {
+		response[i].FromModel(bank)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateBank(c *gin.Context, svc referencedataservice.ReferenceDataService) {
+	userId, orgId, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	var request dtos.BankRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	bank, err := svc.CreateBank(c, orgId, userId, request.ToModel())
+	if err != nil {
+		c.JSON(referenceDataErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.Bank{}
+	response.FromModel(bank)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteBank(c *gin.Context, svc referencedataservice.ReferenceDataService) {
+	userId, _, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	bankId, err := uuid.Parse(c.Param("bankId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid bank id"})
+		return
+	}
+
+	if err := svc.DeleteBank(c, userId, bankId); err != nil {
+		c.JSON(referenceDataErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "bank deleted"})
+}
+
+func Lookup(c *gin.Context, svc referencedataservice.ReferenceDataService) {
+	var query dtos.LookupQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := svc.Lookup(c, referencedata.Kind(query.Kind), query.Value)
+	if err != nil {
+		c.JSON(referenceDataErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.LookupResult{}
+	response.FromModel(result)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ValidateIBAN answers 200 for invalid values too, the result tells why the value was rejected
+func ValidateIBAN(c *gin.Context) {
+	var request dtos.ValidateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	iban, err := referencedata.ParseIBAN(request.Value)
+	if err != nil {
+		c.JSON(http.StatusOK, dtos.ValidationResult{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ValidationResult{Valid: true, Details: iban})
+}
+
+func ValidateBIC(c *gin.Context) {
+	var request dtos.ValidateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	bic, err := referencedata.ParseBIC(request.Value)
+	if err != nil {
+		c.JSON(http.StatusOK, dtos.ValidationResult{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ValidationResult{Valid: true, Details: bic})
+}
+
+func getUserAndOrganization(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	_, userId, orgIds := apicontext.GetAuthFromContext(c)
+	if userId == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	if len(orgIds) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "no organization ids found"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return *userId, orgIds[0], true
+}
+
+func referenceDataErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, referencedataErrors.ErrReferenceDataNotFound),
+		errors.Is(err, referencedataErrors.ErrBankNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, referencedataErrors.ErrBankCodeExists):
+		return http.StatusConflict
+	case errors.Is(err, referencedataErrors.ErrInvalidReferenceDataKind),
+		errors.Is(err, referencedataErrors.ErrInvalidBankCode),
+		errors.Is(err, referencedataErrors.ErrEmptyBankName),
+		errors.Is(err, referencedataErrors.ErrInvalidBankCountry),
+		errors.Is(err, referencedata.ErrInvalidBICFormat),
+		errors.Is(err, referencedata.ErrInvalidBICCountry):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,47 @@
+package referencedata
+
+import (
+	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	referencedataservice "github.com/Zampfi/application-platform/services/api/core/referencedata/service"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterReferenceDataRoutes(e *gin.RouterGroup, serverCfg *serverconfig.ServerConfig) error {
+	referenceDataService := referencedataservice.NewReferenceDataService(serverCfg.Store)
+
+	referenceDataGroup := e.Group("/reference-data")
+	{
+		referenceDataGroup.GET("/countries", func(c *gin.Context) {
+			GetCountries(c, referenceDataService)
+		})
+
+		referenceDataGroup.GET("/currencies", func(c *gin.Context) {
+			GetCurrencies(c, referenceDataService)
+		})
+
+		referenceDataGroup.GET("/banks", func(c *gin.Context) {
+			GetBanks(c, referenceDataService)
+		})
+
+		referenceDataGroup.POST("/banks", func(c *gin.Context) {
+			CreateBank(c, referenceDataService)
+		})
+
+		referenceDataGroup.DELETE("/banks/:bankId", func(c *gin.Context) {
+			DeleteBank(c, referenceDataService)
+		})
+
+		referenceDataGroup.GET("/lookup", func(c *gin.Context) {
+			Lookup(c, referenceDataService)
+		})
+
+		referenceDataGroup.POST("/iban/validate", func(c *gin.Context) {
+			ValidateIBAN(c)
+		})
+
+		referenceDataGroup.POST("/bic/validate", func(c *gin.Context) {
+			ValidateBIC(c)
+		})
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package dtos
+
+import (
+	"github.com/Zampfi/application-platform/services/api/core/tags/models"
+	"github.com/google/uuid"
+)
+
+type TagRequest struct {
+	ParentId    *uuid.UUID `json:"parent_id"`
+	Name        string     `json:"name" binding:"required"`
+	Color       *string    `json:"color"`
+	Description *string    `json:"description"`
+	Aliases     []string   `json:"aliases"`
+}
+
+func (r *TagRequest) ToModel() models.TagParams {
+	return models.TagParams{
+		ParentId:    r.ParentId,
+		Name:        r.Name,
+		Color:       r.Color,
+		Description: r.Description,
+		Aliases:     r.Aliases,
+	}
+}
+
+type UpdateTagRequest struct {
+	Color       *string  `json:"color"`
+	Description *string  `json:"description"`
+	Aliases     []string `json:"aliases"`
+}
+
+func (r *UpdateTagRequest) ToModel() models.TagUpdateParams {
+	return models.TagUpdateParams{
+		Color:       r.Color,
+		Description: r.Description,
+		Aliases:     r.Aliases,
+	}
+}
+
+type RenameTagRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type MergeTagsRequest struct {
+	TargetTagId uuid.UUID `json:"target_tag_id" binding:"required"`
+}
@@ -0,0 +1,67 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/tags/models"
+	"github.com/google/uuid"
+)
+
+type Tag struct {
+	ID          uuid.UUID  `json:"id"`
+	ParentId    *uuid.UUID `json:"parent_id"`
+	Name        string     `json:"name"`
+	Path        string     `json:"path"`
+	Color       *string    `json:"color"`
+	Description *string    `json:"description"`
+	Aliases     []string   `json:"aliases"`
+	CreatedBy   uuid.UUID  `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func (t *Tag) FromModel(model models.Tag) {
+	t.ID = model.ID
+	t.ParentId = model.ParentId
+	t.Name = model.Name
+	t.Path = model.Path
+	t.Color = model.Color
+	t.Description = model.Description
+	t.Aliases = model.Aliases
+	t.CreatedBy = model.CreatedBy
+	t.CreatedAt = model.CreatedAt
+	t.UpdatedAt = model.UpdatedAt
+}
+
+type TagRewrite struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type TagRewriteFailure struct {
+	DatasetId uuid.UUID `json:"dataset_id"`
+	Column    string    `json:"column"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Error     string    `json:"error"`
+}
+
+type TagRewriteResult struct {
+	Tag              Tag                 `json:"tag"`
+	Rewrites         []TagRewrite        `json:"rewrites"`
+	DatasetActionIds []string            `json:"dataset_action_ids"`
+	Failures         []TagRewriteFailure `json:"failures"`
+}
+
+func (r *TagRewriteResult) FromModel(model models.TagRewriteResult) {
+	r.Tag.FromModel(model.Tag)
+	r.Rewrites = make([]TagRewrite, len(model.Rewrites))
+	for i, rewrite := range model.Rewrites {
+		r.Rewrites[i] = TagRewrite{From: rewrite.From, To: rewrite.To}
+	}
+	r.DatasetActionIds = model.DatasetActionIds
+	r.Failures = make([]TagRewriteFailure, len(model.Failures))
+	for i, failure := range model.Failures {
+		r.Failures[i] = TagRewriteFailure(failure)
+	}
+}
@@ -0,0 +1,202 @@
+package tags
+
+import (
+	"errors"
+	"net/http"
+
+	tagErrors "github.com/Zampfi/application-platform/services/api/core/tags/errors"
+	tagservice "github.com/Zampfi/application-platform/services/api/core/tags/service"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/Zampfi/application-platform/services/api/server/routes/tags/dtos"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func GetTags(c *gin.Context, svc tagservice.TagService) {
+	tags, err := svc.GetTags(c)
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := make([]dtos.Tag, len(tags))
+	for i, tag := range tags {
+		response[i].FromModel(tag)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func CreateTag(c *gin.Context, svc tagservice.TagService) {
+	userId, orgId, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	var request dtos.TagRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tag, err := svc.CreateTag(c, orgId, userId, request.ToModel())
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.Tag{}
+	response.FromModel(tag)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateTag(c *gin.Context, svc tagservice.TagService) {
+	userId, _, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	tagId, ok := getTagId(c)
+	if !ok {
+		return
+	}
+
+	var request dtos.UpdateTagRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tag, err := svc.UpdateTag(c, userId, tagId, request.ToModel())
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.Tag{}
+	response.FromModel(tag)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func DeleteTag(c *gin.Context, svc tagservice.TagService) {
+	userId, _, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	tagId, ok := getTagId(c)
+	if !ok {
+		return
+	}
+
+	if err := svc.DeleteTag(c, userId, tagId); err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "tag deleted"})
+}
+
+func RenameTag(c *gin.Context, svc tagservice.TagService) {
+	userId, orgId, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	tagId, ok := getTagId(c)
+	if !ok {
+		return
+	}
+
+	var request dtos.RenameTagRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := svc.RenameTag(c, orgId, userId, tagId, request.Name)
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.TagRewriteResult{}
+	response.FromModel(result)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func MergeTags(c *gin.Context, svc tagservice.TagService) {
+	userId, orgId, ok := getUserAndOrganization(c)
+	if !ok {
+		return
+	}
+
+	tagId, ok := getTagId(c)
+	if !ok {
+		return
+	}
+
+	var request dtos.MergeTagsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := svc.MergeTags(c, orgId, userId, tagId, request.TargetTagId)
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	response := dtos.TagRewriteResult{}
+	response.FromModel(result)
+
+	c.JSON(http.StatusOK, response)
+}
+
+func getTagId(c *gin.Context) (uuid.UUID, bool) {
+	tagId, err := uuid.Parse(c.Param("tagId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid tag id"})
+		return uuid.Nil, false
+	}
+	return tagId, true
+}
+
+func getUserAndOrganization(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	_, userId, orgIds := apicontext.GetAuthFromContext(c)
+	if userId == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "user ID not found"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	if len(orgIds) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "no organization ids found"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return *userId, orgIds[0], true
+}
+
+func tagErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, tagErrors.ErrTagNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, tagErrors.ErrTagAccessForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, tagErrors.ErrTagExists),
+		errors.Is(err, tagErrors.ErrTagHasChildren):
+		return http.StatusConflict
+	case errors.Is(err, tagErrors.ErrTagParentNotFound),
+		errors.Is(err, tagErrors.ErrEmptyTagName),
+		errors.Is(err, tagErrors.ErrInvalidTagName),
+		errors.Is(err, tagErrors.ErrInvalidTagColor),
+		errors.Is(err, tagErrors.ErrInvalidTagAlias),
+		errors.Is(err, tagErrors.ErrInvalidTagMerge):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
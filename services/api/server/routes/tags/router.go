@@ -0,0 +1,40 @@
+package tags
+
+import (
+	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	datasetservice "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	tagservice "github.com/Zampfi/application-platform/services/api/core/tags/service"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterTagRoutes(e *gin.RouterGroup, serverCfg *serverconfig.ServerConfig, datasetService datasetservice.DatasetService) error {
+	tagService := tagservice.NewTagService(serverCfg.Store, datasetService)
+
+	tagsGroup := e.Group("/tags")
+	{
+		tagsGroup.GET("", func(c *gin.Context) {
+			GetTags(c, tagService)
+		})
+
+		tagsGroup.POST("", func(c *gin.Context) {
+			CreateTag(c, tagService)
+		})
+
+		tagsGroup.PATCH("/:tagId", func(c *gin.Context) {
+			UpdateTag(c, tagService)
+		})
+
+		tagsGroup.DELETE("/:tagId", func(c *gin.Context) {
+			DeleteTag(c, tagService)
+		})
+
+		tagsGroup.POST("/:tagId/rename", func(c *gin.Context) {
+			RenameTag(c, tagService)
+		})
+
+		tagsGroup.POST("/:tagId/merge", func(c *gin.Context) {
+			MergeTags(c, tagService)
+		})
+	}
+	return nil
+}
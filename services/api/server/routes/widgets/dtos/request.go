@@ -3,13 +3,29 @@ package dtos
 import (
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
 	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	"github.com/google/uuid"
 )
 
 type WidgetQueryParams struct {
-	Filters     []WidgetFilters `json:"filters"`
-	TimeColumns []ColumnMapping `json:"time_columns"`
-	Periodicity *string         `json:"periodicity,omitempty"`
-	Currency    *string         `json:"currency,omitempty"`
+	Filters     []WidgetFilters   `json:"filters"`
+	TimeColumns []ColumnMapping   `json:"time_columns"`
+	Periodicity *string           `json:"periodicity,omitempty"`
+	Currency    *string           `json:"currency,omitempty"`
+	Selections  []WidgetSelection `json:"selections,omitempty"`
+	Variables   map[string]string `json:"variables,omitempty"`
+}
+
+// WidgetSelection is a selection made in another widget of the sheet, the values are keyed by the alias of the fields
+type WidgetSelection struct {
+	WidgetInstanceID uuid.UUID              `json:"widget_instance_id"`
+	Values           map[string]interface{} `json:"values"`
+}
+
+func (w *WidgetSelection) ToModel() widgetmodels.WidgetSelection {
+	return widgetmodels.WidgetSelection{
+		WidgetInstanceID: w.WidgetInstanceID,
+		Values:           w.Values,
+	}
 }
 
 type ColumnMapping struct {
@@ -54,10 +70,17 @@ func (w *WidgetQueryParams) ToModels() widgetmodels.GetWidgetInstanceDataQueryPa
 		})
 	}
 
+	var selections []widgetmodels.WidgetSelection
+	for _, selection := range w.Selections {
+		selections = append(selections, selection.ToModel())
+	}
+
 	return widgetmodels.GetWidgetInstanceDataQueryParams{
 		Filters:     widgetFilters,
 		TimeColumns: timeColumns,
 		Periodicity: w.Periodicity,
 		Currency:    w.Currency,
+		Selections:  selections,
+		Variables:   w.Variables,
 	}
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -98,4 +100,20 @@ func TestWidgetQueryParamsToModels(t *testing.T) {
 		assert.Equal(t, "dataset1", result.Filters[0].DatasetID)
 		assert.Nil(t, result.Filters[0].Pagination)
 	})
+
+	t.Run("selections", func(t *testing.T) {
+		t.Parallel()
+		widgetInstanceID := uuid.New()
+		params := WidgetQueryParams{
+			Selections: []WidgetSelection{
+				{WidgetInstanceID: widgetInstanceID, Values: map[string]interface{}{"vendor": "Acme"}},
+			},
+		}
+
+		result := params.ToModels()
+
+		assert.Equal(t, []widgetmodels.WidgetSelection{
+			{WidgetInstanceID: widgetInstanceID, Values: map[string]interface{}{"vendor": "Acme"}},
+		}, result.Selections)
+	})
 }
@@ -28,13 +28,16 @@ type Column struct {
 
 // Remove the pointer receiver method and replace with constructor
 func NewWidgetInstanceDataResponse(qrs []datasetmodels.DatasetData, periodicity string, currency *string) *WidgetInstanceDataResponse {
-	widgetInstanceDataResponse := WidgetInstanceDataResponse{
+	return &WidgetInstanceDataResponse{
 		Status:      "success",
 		Periodicity: periodicity,
 		Currency:    currency,
-		Result:      []Result{},
+		Result:      newResults(qrs),
 	}
+}
 
+func newResults(qrs []datasetmodels.DatasetData) []Result {
+	results := []Result{}
 	for _, qr := range qrs {
 		columns := make([]Column, len(qr.Columns))
 		for i, col := range qr.Columns {
@@ -44,14 +47,45 @@ func NewWidgetInstanceDataResponse(qrs []datasetmodels.DatasetData, periodicity
 			}
 		}
 
-		widgetInstanceDataResponse.Result = append(widgetInstanceDataResponse.Result, Result{
+		results = append(results, Result{
 			RowCount: len(qr.Rows),
 			Columns:  columns,
 			Data:     qr.Rows,
 		})
 	}
+	return results
+}
+
+type SheetDataResponse struct {
+	Status      string                      `json:"status"`
+	Periodicity string                      `json:"periodicity"`
+	Currency    *string                     `json:"currency"`
+	Widgets     map[string]WidgetDataResult `json:"widgets"`
+}
 
-	return &widgetInstanceDataResponse
+// WidgetDataResult is the data of a widget instance of the sheet, or the error it failed to load with
+type WidgetDataResult struct {
+	Result []Result `json:"result,omitempty"`
+	Error  string   `json:"error,omitempty"`
+}
+
+func NewSheetDataResponse(sheetData map[uuid.UUID]widgetmodels.WidgetInstanceData, periodicity string, currency *string) *SheetDataResponse {
+	response := &SheetDataResponse{
+		Status:      "success",
+		Periodicity: periodicity,
+		Currency:    currency,
+		Widgets:     make(map[string]WidgetDataResult, len(sheetData)),
+	}
+
+	for widgetInstanceId, widgetData := range sheetData {
+		if widgetData.Err != nil {
+			response.Widgets[widgetInstanceId.String()] = WidgetDataResult{Error: widgetData.Err.Error()}
+			continue
+		}
+		response.Widgets[widgetInstanceId.String()] = WidgetDataResult{Result: newResults(widgetData.Data)}
+	}
+
+	return response
 }
 
 type WidgetInstanceResponse struct {
@@ -118,3 +152,36 @@ func transformDefaultFilter(filter datasetmodels.FilterModel) []DefaultFilterVal
 	}
 	return defaultFilters
 }
+
+type DrillThroughResponse struct {
+	TargetType string                `json:"target_type"`
+	SheetID    *uuid.UUID            `json:"sheet_id,omitempty"`
+	PageID     *uuid.UUID            `json:"page_id,omitempty"`
+	DatasetID  string                `json:"dataset_id,omitempty"`
+	Filters    []DrillThroughFilters `json:"filters"`
+}
+
+// DrillThroughFilters are the filters of a dataset of the target, in the shape of the filters of the data requests
+type DrillThroughFilters struct {
+	DatasetID string                    `json:"dataset_id"`
+	Filters   datasetmodels.FilterModel `json:"filters"`
+}
+
+func NewDrillThroughResponse(target widgetmodels.DrillThroughTarget) DrillThroughResponse {
+	response := DrillThroughResponse{
+		TargetType: target.TargetType,
+		SheetID:    target.SheetID,
+		PageID:     target.PageID,
+		DatasetID:  target.DatasetID,
+		Filters:    make([]DrillThroughFilters, 0, len(target.Filters)),
+	}
+
+	for _, filter := range target.Filters {
+		response.Filters = append(response.Filters, DrillThroughFilters{
+			DatasetID: filter.DatasetID,
+			Filters:   filter.Filters,
+		})
+	}
+
+	return response
+}
@@ -2,6 +2,7 @@ package dtos
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	datasetmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
@@ -236,6 +237,57 @@ func TestTransformDefaultFilter(t *testing.T) {
 }
 
 // Helper function for string pointers
+func TestNewDrillThroughResponse(t *testing.T) {
+	sheetID := uuid.New()
+	pageID := uuid.New()
+	filters := datasetmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetmodels.Filter{{Column: "vendor", Operator: "eq", Value: "Acme"}}}
+
+	got := NewDrillThroughResponse(widgetmodels.DrillThroughTarget{
+		TargetType: widgetmodels.DrillThroughTargetSheet,
+		SheetID:    &sheetID,
+		PageID:     &pageID,
+		Filters:    []widgetmodels.WidgetFilters{{DatasetID: "transactions", Filters: filters}},
+	})
+
+	assert.Equal(t, DrillThroughResponse{
+		TargetType: "sheet",
+		SheetID:    &sheetID,
+		PageID:     &pageID,
+		Filters:    []DrillThroughFilters{{DatasetID: "transactions", Filters: filters}},
+	}, got)
+
+	got = NewDrillThroughResponse(widgetmodels.DrillThroughTarget{TargetType: widgetmodels.DrillThroughTargetDataset, DatasetID: "transactions"})
+	assert.Equal(t, []DrillThroughFilters{}, got.Filters)
+}
+
+func TestNewSheetDataResponse(t *testing.T) {
+	loaded := uuid.New()
+	failed := uuid.New()
+	currency := "USD"
+
+	got := NewSheetDataResponse(map[uuid.UUID]widgetmodels.WidgetInstanceData{
+		loaded: {Data: []datasetmodels.DatasetData{{QueryResult: dataplatformmodels.QueryResult{
+			Columns: []dataplatformmodels.ColumnMetadata{{Name: "amount", DatabaseType: "DECIMAL"}},
+			Rows:    dataplatformmodels.Rows{{"amount": 120}},
+		}}}},
+		failed: {Err: errors.New("failed to get widget instance data")},
+	}, "monthly", &currency)
+
+	assert.Equal(t, &SheetDataResponse{
+		Status:      "success",
+		Periodicity: "monthly",
+		Currency:    &currency,
+		Widgets: map[string]WidgetDataResult{
+			loaded.String(): {Result: []Result{{
+				RowCount: 1,
+				Columns:  []Column{{ColumnName: "amount", ColumnType: "DECIMAL"}},
+				Data:     []map[string]any{{"amount": 120}},
+			}}},
+			failed.String(): {Error: "failed to get widget instance data"},
+		},
+	}, got)
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
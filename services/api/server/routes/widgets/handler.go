@@ -2,8 +2,10 @@ package widgets
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
+	"github.com/Zampfi/application-platform/services/api/core/sheets"
 	widgetservice "github.com/Zampfi/application-platform/services/api/core/widgets/service"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	"github.com/Zampfi/application-platform/services/api/server/routes/widgets/dtos"
@@ -27,53 +29,85 @@ func GetWidgetInstanceData(c *gin.Context, widgetService widgetservice.WidgetsSe
 		return
 	}
 
-	// Get individual query parameters
-	filtersStr := c.Query("filters")
-	timeColumnsStr := c.Query("time_columns")
-	periodicity := c.Query("periodicity")
-	currency := c.Query("currency")
-
-	queryParams := dtos.WidgetQueryParams{
-		Filters: []dtos.WidgetFilters{},
+	queryParams, ok := parseWidgetQueryParams(c)
+	if !ok {
+		return
 	}
 
-	if filtersStr != "" {
-		if err := json.Unmarshal([]byte(filtersStr), &queryParams.Filters); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid filters format"})
+	queryParamModels := queryParams.ToModels()
+	widgetInstanceData, err := widgetService.GetWidgetInstanceData(c, orgId, widgetInstanceId, queryParamModels)
+	if err != nil {
+		if errors.Is(err, widgetservice.ErrInvalidSelection) || errors.Is(err, widgetservice.ErrInvalidVariable) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get widget instance data"})
+		return
 	}
 
-	if timeColumnsStr != "" {
-		if err := json.Unmarshal([]byte(timeColumnsStr), &queryParams.TimeColumns); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid time columns format"})
-			return
-		}
+	periodicityValue := "daily"
+	if queryParams.Periodicity != nil {
+		periodicityValue = *queryParams.Periodicity
 	}
 
-	if periodicity != "" {
-		queryParams.Periodicity = &periodicity
+	resp := dtos.NewWidgetInstanceDataResponse(widgetInstanceData, periodicityValue, queryParams.Currency)
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func GetSheetData(c *gin.Context, widgetService widgetservice.WidgetsService) {
+	ctxLogger := apicontext.GetLoggerFromCtx(c)
+
+	_, _, orgIds := apicontext.GetAuthFromContext(c)
+	if len(orgIds) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "no organization ids found"})
+		return
 	}
 
-	if currency != "" {
-		queryParams.Currency = &currency
+	orgId := orgIds[0]
+
+	sheetId, err := uuid.Parse(c.Param("sheetId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sheet ID"})
+		return
 	}
 
-	queryParamModels := queryParams.ToModels()
-	widgetInstanceData, err := widgetService.GetWidgetInstanceData(c, orgId, widgetInstanceId, queryParamModels)
+	queryParams, ok := parseWidgetQueryParams(c)
+	if !ok {
+		return
+	}
+
+	sheetData, err := widgetService.GetSheetData(c, orgId, sheetId, queryParams.ToModels())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get widget instance data"})
+		ctxLogger.Info("failed to get sheet data", zap.Error(err))
+		if errors.Is(err, sheets.ErrSheetNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, widgetservice.ErrInvalidVariable) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get sheet data"})
 		return
 	}
 
+	// only the selections of the user are worth telling them about, the other failures stay in the logs
+	for widgetInstanceId, widgetData := range sheetData {
+		if widgetData.Err == nil || errors.Is(widgetData.Err, widgetservice.ErrInvalidSelection) {
+			continue
+		}
+		ctxLogger.Error("failed to get widget instance data", zap.String("widget_instance_id", widgetInstanceId.String()), zap.Error(widgetData.Err))
+		widgetData.Err = errors.New("failed to get widget instance data")
+		sheetData[widgetInstanceId] = widgetData
+	}
+
 	periodicityValue := "daily"
 	if queryParams.Periodicity != nil {
 		periodicityValue = *queryParams.Periodicity
 	}
 
-	resp := dtos.NewWidgetInstanceDataResponse(widgetInstanceData, periodicityValue, queryParams.Currency)
-
-	c.JSON(http.StatusOK, resp)
+	c.JSON(http.StatusOK, dtos.NewSheetDataResponse(sheetData, periodicityValue, queryParams.Currency))
 }
 
 func GetWidgetInstance(c *gin.Context, widgetService widgetservice.WidgetsService) {
@@ -102,3 +136,104 @@ func GetWidgetInstance(c *gin.Context, widgetService widgetservice.WidgetsServic
 
 	c.JSON(http.StatusOK, resp)
 }
+
+func GetWidgetDrillThrough(c *gin.Context, widgetService widgetservice.WidgetsService) {
+	ctxLogger := apicontext.GetLoggerFromCtx(c)
+
+	_, _, orgIds := apicontext.GetAuthFromContext(c)
+	if len(orgIds) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "no organization ids found"})
+		return
+	}
+
+	orgId := orgIds[0]
+
+	widgetInstanceId, err := uuid.Parse(c.Param("widgetInstanceId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid widget instance ID"})
+		return
+	}
+
+	selection := dtos.WidgetSelection{}
+	if selectionStr := c.Query("selection"); selectionStr != "" {
+		if err := json.Unmarshal([]byte(selectionStr), &selection.Values); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid selection format"})
+			return
+		}
+	}
+
+	queryParams, ok := parseWidgetQueryParams(c)
+	if !ok {
+		return
+	}
+
+	target, err := widgetService.GetDrillThroughTarget(c, orgId, widgetInstanceId, selection.ToModel(), queryParams.ToModels())
+	if err != nil {
+		ctxLogger.Info("failed to get drill-through target", zap.Error(err))
+		switch {
+		case errors.Is(err, widgetservice.ErrWidgetInstanceNotFound), errors.Is(err, sheets.ErrSheetNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, widgetservice.ErrNoDrillThrough), errors.Is(err, widgetservice.ErrInvalidSelection):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get drill-through target"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.NewDrillThroughResponse(target))
+}
+
+// parseWidgetQueryParams reads the filter state of the sheet from the query, it answers the request itself when the
+// query is malformed
+func parseWidgetQueryParams(c *gin.Context) (dtos.WidgetQueryParams, bool) {
+	// Get individual query parameters
+	filtersStr := c.Query("filters")
+	timeColumnsStr := c.Query("time_columns")
+	selectionsStr := c.Query("selections")
+	variablesStr := c.Query("variables")
+	periodicity := c.Query("periodicity")
+	currency := c.Query("currency")
+
+	queryParams := dtos.WidgetQueryParams{
+		Filters: []dtos.WidgetFilters{},
+	}
+
+	if filtersStr != "" {
+		if err := json.Unmarshal([]byte(filtersStr), &queryParams.Filters); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid filters format"})
+			return queryParams, false
+		}
+	}
+
+	if timeColumnsStr != "" {
+		if err := json.Unmarshal([]byte(timeColumnsStr), &queryParams.TimeColumns); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid time columns format"})
+			return queryParams, false
+		}
+	}
+
+	if selectionsStr != "" {
+		if err := json.Unmarshal([]byte(selectionsStr), &queryParams.Selections); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid selections format"})
+			return queryParams, false
+		}
+	}
+
+	if variablesStr != "" {
+		if err := json.Unmarshal([]byte(variablesStr), &queryParams.Variables); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid variables format"})
+			return queryParams, false
+		}
+	}
+
+	if periodicity != "" {
+		queryParams.Periodicity = &periodicity
+	}
+
+	if currency != "" {
+		queryParams.Currency = &currency
+	}
+
+	return queryParams, true
+}
@@ -3,12 +3,15 @@ package widgets
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	datasetsmodels "github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/sheets"
 	widgetmodels "github.com/Zampfi/application-platform/services/api/core/widgets/models"
+	widgetservice "github.com/Zampfi/application-platform/services/api/core/widgets/service"
 	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
 	mock_widgets "github.com/Zampfi/application-platform/services/api/mocks/core/widgets/service"
 	dataplatformmodels "github.com/Zampfi/application-platform/services/api/pkg/dataplatform/models"
@@ -463,3 +466,225 @@ func TestGetWidgetInstanceDataHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestGetWidgetDrillThroughHandler(t *testing.T) {
+	orgID := uuid.New()
+	widgetID := uuid.New()
+	sheetID := uuid.New()
+
+	tests := []struct {
+		name           string
+		widgetID       string
+		query          string
+		setupMock      func(*mock_widgets.MockWidgetsService)
+		expectedStatus int
+	}{
+		{
+			name:     "success",
+			widgetID: widgetID.String(),
+			query:    `?selection={"vendor":"Acme"}&periodicity=month`,
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetDrillThroughTarget(mock.Anything, orgID, widgetID, widgetmodels.WidgetSelection{Values: map[string]interface{}{"vendor": "Acme"}}, mock.MatchedBy(func(params widgetmodels.GetWidgetInstanceDataQueryParams) bool {
+					return params.Periodicity != nil && *params.Periodicity == "month"
+				})).Return(widgetmodels.DrillThroughTarget{
+					TargetType: widgetmodels.DrillThroughTargetSheet,
+					SheetID:    &sheetID,
+					Filters: []widgetmodels.WidgetFilters{{
+						DatasetID: "transactions",
+						Filters:   datasetsmodels.FilterModel{LogicalOperator: "AND", Conditions: []datasetsmodels.Filter{{Column: "vendor", Operator: "eq", Value: "Acme"}}},
+					}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid widget ID",
+			widgetID:       "invalid-uuid",
+			setupMock:      func(m *mock_widgets.MockWidgetsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid selection",
+			widgetID:       widgetID.String(),
+			query:          `?selection=vendor`,
+			setupMock:      func(m *mock_widgets.MockWidgetsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:     "no drill-through",
+			widgetID: widgetID.String(),
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetDrillThroughTarget(mock.Anything, orgID, widgetID, mock.Anything, mock.Anything).Return(widgetmodels.DrillThroughTarget{}, widgetservice.ErrNoDrillThrough)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:     "widget instance not found",
+			widgetID: widgetID.String(),
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetDrillThroughTarget(mock.Anything, orgID, widgetID, mock.Anything, mock.Anything).Return(widgetmodels.DrillThroughTarget{}, widgetservice.ErrWidgetInstanceNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			apicontext.AddAuthToGinContext(c, "user", uuid.New(), []uuid.UUID{orgID})
+			c.Params = []gin.Param{{Key: "widgetInstanceId", Value: tt.widgetID}}
+			c.Request = httptest.NewRequest("GET", "/"+tt.query, nil)
+
+			mockService := mock_widgets.NewMockWidgetsService(t)
+			tt.setupMock(mockService)
+
+			GetWidgetDrillThrough(c, mockService)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var response map[string]interface{}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, "sheet", response["target_type"])
+				assert.Equal(t, sheetID.String(), response["sheet_id"])
+				assert.Len(t, response["filters"], 1)
+			}
+		})
+	}
+}
+
+func TestGetWidgetInstanceDataHandlerInvalidSelection(t *testing.T) {
+	orgID := uuid.New()
+	widgetID := uuid.New()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	apicontext.AddAuthToGinContext(c, "user", uuid.New(), []uuid.UUID{orgID})
+	c.Params = []gin.Param{{Key: "widgetInstanceId", Value: widgetID.String()}}
+	c.Request = httptest.NewRequest("GET", `/?selections=[{"widget_instance_id":"`+uuid.NewString()+`","values":{"region":"EU"}}]`, nil)
+
+	mockService := mock_widgets.NewMockWidgetsService(t)
+	mockService.EXPECT().GetWidgetInstanceData(mock.Anything, orgID, widgetID, mock.MatchedBy(func(params widgetmodels.GetWidgetInstanceDataQueryParams) bool {
+		return len(params.Selections) == 1 && params.Selections[0].Values["region"] == "EU"
+	})).Return(nil, widgetservice.ErrInvalidSelection)
+
+	GetWidgetInstanceData(c, mockService)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetWidgetInstanceDataHandlerInvalidVariable(t *testing.T) {
+	orgID := uuid.New()
+	widgetID := uuid.New()
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	apicontext.AddAuthToGinContext(c, "user", uuid.New(), []uuid.UUID{orgID})
+	c.Params = []gin.Param{{Key: "widgetInstanceId", Value: widgetID.String()}}
+	c.Request = httptest.NewRequest("GET", `/?variables={"min_amount":"lots"}`, nil)
+
+	mockService := mock_widgets.NewMockWidgetsService(t)
+	mockService.EXPECT().GetWidgetInstanceData(mock.Anything, orgID, widgetID, mock.MatchedBy(func(params widgetmodels.GetWidgetInstanceDataQueryParams) bool {
+		return params.Variables["min_amount"] == "lots"
+	})).Return(nil, fmt.Errorf("%w: \"lots\" is not a number", widgetservice.ErrInvalidVariable))
+
+	GetWidgetInstanceData(c, mockService)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetSheetDataHandler(t *testing.T) {
+	orgID := uuid.New()
+	sheetID := uuid.New()
+	loaded := uuid.New()
+	failed := uuid.New()
+	invalid := uuid.New()
+
+	tests := []struct {
+		name           string
+		sheetID        string
+		query          string
+		setupMock      func(*mock_widgets.MockWidgetsService)
+		expectedStatus int
+		checkResponse  func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:    "success with per widget errors",
+			sheetID: sheetID.String(),
+			query:   `?filters=[{"dataset_id":"invoices","filters":{"logical_operator":"AND","conditions":[{"column":"status","operator":"eq","value":"open"}]}}]&periodicity=monthly`,
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetSheetData(mock.Anything, orgID, sheetID, mock.MatchedBy(func(params widgetmodels.GetWidgetInstanceDataQueryParams) bool {
+					return len(params.Filters) == 1 && params.Filters[0].DatasetID == "invoices"
+				})).Return(map[uuid.UUID]widgetmodels.WidgetInstanceData{
+					loaded: {Data: []datasetsmodels.DatasetData{{QueryResult: dataplatformmodels.QueryResult{
+						Columns: []dataplatformmodels.ColumnMetadata{{Name: "amount"}},
+						Rows:    dataplatformmodels.Rows{{"amount": 120}},
+					}}}},
+					failed:  {Err: errors.New("connection reset by peer")},
+					invalid: {Err: fmt.Errorf("%w: widget instance has no field region", widgetservice.ErrInvalidSelection)},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, response map[string]interface{}) {
+				assert.Equal(t, "monthly", response["periodicity"])
+				widgets := response["widgets"].(map[string]interface{})
+				assert.Len(t, widgets[loaded.String()].(map[string]interface{})["result"], 1)
+				assert.Equal(t, "failed to get widget instance data", widgets[failed.String()].(map[string]interface{})["error"])
+				assert.Contains(t, widgets[invalid.String()].(map[string]interface{})["error"], "region")
+			},
+		},
+		{
+			name:           "invalid sheet ID",
+			sheetID:        "invalid-uuid",
+			setupMock:      func(m *mock_widgets.MockWidgetsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid filters",
+			sheetID:        sheetID.String(),
+			query:          `?filters=status`,
+			setupMock:      func(m *mock_widgets.MockWidgetsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "sheet not found",
+			sheetID: sheetID.String(),
+			setupMock: func(m *mock_widgets.MockWidgetsService) {
+				m.EXPECT().GetSheetData(mock.Anything, orgID, sheetID, mock.Anything).Return(nil, sheets.ErrSheetNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			apicontext.AddAuthToGinContext(c, "user", uuid.New(), []uuid.UUID{orgID})
+			c.Params = []gin.Param{{Key: "sheetId", Value: tt.sheetID}}
+			c.Request = httptest.NewRequest("GET", "/"+tt.query, nil)
+
+			mockService := mock_widgets.NewMockWidgetsService(t)
+			tt.setupMock(mockService)
+
+			GetSheetData(c, mockService)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.checkResponse != nil {
+				var response map[string]interface{}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				tt.checkResponse(t, response)
+			}
+		})
+	}
+}
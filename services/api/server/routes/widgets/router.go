@@ -19,6 +19,17 @@ func RegisterWidgetRoutes(e *gin.RouterGroup, serverCfg *serverconfig.ServerConf
 		widgetGroup.GET("/:widgetInstanceId/instance", func(c *gin.Context) {
 			GetWidgetInstance(c, widgetService)
 		})
+
+		widgetGroup.GET("/:widgetInstanceId/drill-through", func(c *gin.Context) {
+			GetWidgetDrillThrough(c, widgetService)
+		})
+	}
+
+	sheetGroup := e.Group("/sheets")
+	{
+		sheetGroup.GET("/:sheetId/data", func(c *gin.Context) {
+			GetSheetData(c, widgetService)
+		})
 	}
 	return nil
 }
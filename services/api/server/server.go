@@ -9,9 +9,13 @@ import (
 	authroutes "github.com/Zampfi/application-platform/services/api/server/routes/auth"
 	"github.com/Zampfi/application-platform/services/api/server/routes/connections"
 	"github.com/Zampfi/application-platform/services/api/server/routes/datasets"
+	"github.com/Zampfi/application-platform/services/api/server/routes/fx"
 	healthroute "github.com/Zampfi/application-platform/services/api/server/routes/health"
 	"github.com/Zampfi/application-platform/services/api/server/routes/organizations"
 	"github.com/Zampfi/application-platform/services/api/server/routes/pages"
+	"github.com/Zampfi/application-platform/services/api/server/routes/reconciliations"
+	"github.com/Zampfi/application-platform/services/api/server/routes/referencedata"
+	"github.com/Zampfi/application-platform/services/api/server/routes/tags"
 
 	// 	organizationRouter "github.com/Zampfi/application-platform/services/api/services/organizations/router"
 	dataplatformservice "github.com/Zampfi/application-platform/services/api/core/dataplatform"
@@ -89,6 +93,30 @@ func setupRouter(serverCfg *serverconfig.ServerConfig, logger *zap.Logger, query
 		return nil, err
 	}
 
+	err = reconciliations.RegisterReconciliationRoutes(authenticatedRoutes, serverCfg, datasetService)
+	if err != nil {
+		logger.Error("failed to register reconciliation routes", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	err = fx.RegisterFxRoutes(authenticatedRoutes, serverCfg, datasetService)
+	if err != nil {
+		logger.Error("failed to register fx routes", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	err = referencedata.RegisterReferenceDataRoutes(authenticatedRoutes, serverCfg)
+	if err != nil {
+		logger.Error("failed to register reference data routes", zap.String("error", err.Error()))
+		return nil, err
+	}
+
+	err = tags.RegisterTagRoutes(authenticatedRoutes, serverCfg, datasetService)
+	if err != nil {
+		logger.Error("failed to register tag routes", zap.String("error", err.Error()))
+		return nil, err
+	}
+
 	// register webhooks routes
 	webhooksGroup := r.Group("/webhooks")
 	userName := serverCfg.DataPlatformConfig.ActionsConfig.WebhookConfig.UserName
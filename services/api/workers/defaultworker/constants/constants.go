@@ -1,14 +1,22 @@
 package constants
 
 const (
-	DatasetExportTemporalActivity = "DatasetExportTemporalActivity"
+	DatasetExportTemporalActivity            = "DatasetExportTemporalActivity"
+	StartDatasetExportScheduleRunActivity    = "StartDatasetExportScheduleRunActivity"
+	CompleteDatasetExportScheduleRunActivity = "CompleteDatasetExportScheduleRunActivity"
+	EvaluateDatasetAlertActivity             = "EvaluateDatasetAlertActivity"
+	RunReconciliationActivity                = "RunReconciliationActivity"
+	FailReconciliationRunActivity            = "FailReconciliationRunActivity"
 )
 
 const DefaultTaskQueueName = "application-platform-default"
 
 const (
-	DatasetExportWorkflowName     = "ApplicationPlatformDatasetExportWorkflowExecute"
-	DatasetFileImportWorkflowName = "ApplicationPlatformDatasetFileImportWorkflowExecute"
+	DatasetExportWorkflowName         = "ApplicationPlatformDatasetExportWorkflowExecute"
+	DatasetFileImportWorkflowName     = "ApplicationPlatformDatasetFileImportWorkflowExecute"
+	DatasetExportScheduleWorkflowName = "ApplicationPlatformDatasetExportScheduleWorkflowExecute"
+	DatasetAlertWorkflowName          = "ApplicationPlatformDatasetAlertWorkflowExecute"
+	ReconciliationRunWorkflowName     = "ApplicationPlatformReconciliationRunWorkflowExecute"
 )
 
 const (
@@ -13,8 +13,11 @@ import (
 	"github.com/Zampfi/application-platform/services/api/pkg/logging"
 	querybuilderservice "github.com/Zampfi/application-platform/services/api/pkg/querybuilder/service"
 	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/workflows/datasetalert"
 	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/workflows/datasetexport"
 	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/workflows/fileimport"
+	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/workflows/reconciliation"
+	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/workflows/scheduledexport"
 	"github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/models"
 	"github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/worker"
 	"github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/workflow"
@@ -131,11 +134,26 @@ func (w *defaultWorker) Run(ctx context.Context, datasetService datasetService.D
 	}
 	fileImportActivities := fileImportWorkflow.GetActivities()
 
+	datasetExportScheduleWorkflow, err := scheduledexport.InitDatasetExportSchedule(w.serverConfig, datasetService)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize dataset export schedule workflow: %w", err)
+	}
+	datasetExportScheduleActivities := datasetExportScheduleWorkflow.GetActivities()
+
+	datasetAlertWorkflow := datasetalert.InitDatasetAlert(w.serverConfig, datasetService)
+	datasetAlertActivities := datasetAlertWorkflow.GetActivities()
+
+	reconciliationWorkflow := reconciliation.InitReconciliation(w.serverConfig, datasetService)
+	reconciliationActivities := reconciliationWorkflow.GetActivities()
+
 	// Combine activities
 	allActivities := append(
 		datasetExportActivities,
 		fileImportActivities...,
 	)
+	allActivities = append(allActivities, datasetExportScheduleActivities...)
+	allActivities = append(allActivities, datasetAlertActivities...)
+	allActivities = append(allActivities, reconciliationActivities...)
 
 	// Initialize the worker with the following configuration:
 	// - TaskQueue: The queue this worker will poll for tasks
@@ -151,6 +169,15 @@ func (w *defaultWorker) Run(ctx context.Context, datasetService datasetService.D
 			{
 				Function: fileImportWorkflow.ApplicationPlatformDatasetFileImportWorkflowExecute,
 			},
+			{
+				Function: datasetExportScheduleWorkflow.ApplicationPlatformDatasetExportScheduleWorkflowExecute,
+			},
+			{
+				Function: datasetAlertWorkflow.ApplicationPlatformDatasetAlertWorkflowExecute,
+			},
+			{
+				Function: reconciliationWorkflow.ApplicationPlatformReconciliationRunWorkflowExecute,
+			},
 		},
 		Activities: allActivities,
 		Options: models.WorkerOptions{
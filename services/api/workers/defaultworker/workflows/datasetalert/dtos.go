@@ -0,0 +1,31 @@
+package datasetalert
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type baseParams struct {
+	userId uuid.UUID
+	orgIds []uuid.UUID
+}
+
+func (p baseParams) GetAccessControlParams() (uuid.UUID, []uuid.UUID) {
+	return p.userId, p.orgIds
+}
+
+// webhookPayload is posted to the webhook of an alert on every state change, receivers can dedupe retried deliveries on EventId
+type webhookPayload struct {
+	EventId         uuid.UUID `json:"event_id"`
+	AlertId         uuid.UUID `json:"alert_id"`
+	AlertName       string    `json:"alert_name"`
+	DatasetId       uuid.UUID `json:"dataset_id"`
+	DatasetName     string    `json:"dataset_name"`
+	Status          string    `json:"status"`
+	Value           float64   `json:"value"`
+	ComparisonValue *float64  `json:"comparison_value,omitempty"`
+	Score           *float64  `json:"score,omitempty"`
+	Message         string    `json:"message"`
+	EvaluatedAt     time.Time `json:"evaluated_at"`
+}
@@ -0,0 +1,176 @@
+package datasetalert
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	datasetService "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	"github.com/Zampfi/application-platform/services/api/core/mailer"
+	apihelper "github.com/Zampfi/application-platform/services/api/helper"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	"github.com/Zampfi/application-platform/services/api/workers/common/workflowutil"
+	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	"github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/activity"
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	emailTimeFormat         = "2 Jan 2006 15:04 MST"
+	notificationMaxAttempts = 3
+)
+
+type datasetAlertWorkflow struct {
+	datasetService datasetService.DatasetService
+	mailerService  mailer.MailerService
+	httpClient     apihelper.HTTPClient
+}
+
+func InitDatasetAlert(serverConfig *serverconfig.ServerConfig, datasetService datasetService.DatasetService) datasetAlertWorkflow {
+	mailerService := mailer.NewMailerService(serverConfig.SparkpostClient, serverConfig.Env.ZampEmailUpdatesFrom, serverConfig.Env.EmailTemplatesPath)
+
+	return datasetAlertWorkflow{
+		datasetService: datasetService,
+		mailerService:  mailerService,
+		httpClient:     &http.Client{Timeout: datasetConstants.DatasetAlertWebhookTimeout},
+	}
+}
+
+// ApplicationPlatformDatasetAlertWorkflowExecute runs once per firing of the temporal schedule of a dataset alert.
+// The evaluation activity records the new state of the alert, the recipients and the webhook of the alert are only
+// notified when the state changed and the alert is not snoozed.
+func (d datasetAlertWorkflow) ApplicationPlatformDatasetAlertWorkflowExecute(wtx workflow.Context, params models.DatasetAlertWorkflowParams) (interface{}, error) {
+	defer workflowutil.PanicRecoveryHook(wtx)
+
+	logger := workflow.GetLogger(wtx)
+
+	if params.OwnerId == uuid.Nil {
+		return nil, fmt.Errorf("owner id is required")
+	}
+	if params.OrganizationId == uuid.Nil {
+		return nil, fmt.Errorf("organization id is required")
+	}
+
+	paramsBase := baseParams{
+		userId: params.OwnerId,
+		orgIds: []uuid.UUID{params.OrganizationId},
+	}
+
+	wtx = workflowutil.AddAccessControlParamsToWorkflowCtx(wtx, &paramsBase)
+	wtx = workflow.WithActivityOptions(wtx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+	})
+
+	// a retried evaluation would compare against the state the failed attempt already recorded and drop the transition
+	evaluateCtx := workflow.WithRetryPolicy(wtx, temporal.RetryPolicy{MaximumAttempts: 1})
+
+	var evaluation models.DatasetAlertEvaluation
+	err := workflow.ExecuteActivity(evaluateCtx, d.datasetService.EvaluateDatasetAlertActivity, params).Get(wtx, &evaluation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate dataset alert: %w", err)
+	}
+
+	if evaluation.Skip {
+		logger.Info("dataset alert evaluation skipped", "alert_id", params.AlertId.String())
+		return nil, nil
+	}
+
+	if !evaluation.Notify {
+		return evaluation.State, nil
+	}
+
+	notifyCtx := workflow.WithRetryPolicy(wtx, temporal.RetryPolicy{MaximumAttempts: notificationMaxAttempts})
+
+	var notifyErr error
+	if len(evaluation.Recipients) > 0 {
+		err = workflow.ExecuteActivity(notifyCtx, d.NotifyDatasetAlertByEmailActivity, evaluation).Get(wtx, nil)
+		if err != nil {
+			logger.Error("failed to email dataset alert recipients", "alert_id", params.AlertId.String(), "error", err.Error())
+			notifyErr = err
+		}
+	}
+
+	if evaluation.WebhookUrl != "" {
+		err = workflow.ExecuteActivity(notifyCtx, d.DeliverDatasetAlertWebhookActivity, evaluation).Get(wtx, nil)
+		if err != nil {
+			logger.Error("failed to deliver dataset alert webhook", "alert_id", params.AlertId.String(), "error", err.Error())
+			notifyErr = err
+		}
+	}
+
+	if notifyErr != nil {
+		return nil, notifyErr
+	}
+
+	return evaluation.State, nil
+}
+
+func (d datasetAlertWorkflow) NotifyDatasetAlertByEmailActivity(ctx context.Context, evaluation models.DatasetAlertEvaluation) error {
+	return d.mailerService.SendDatasetAlertEmail(ctx, mailer.DatasetAlertEmailData{
+		RecipientEmails: evaluation.Recipients,
+		AlertName:       evaluation.AlertName,
+		DatasetName:     evaluation.DatasetName,
+		Status:          evaluation.EventStatus,
+		Message:         evaluation.Result.Message,
+		EvaluatedAt:     evaluation.EvaluatedAt.UTC().Format(emailTimeFormat),
+	})
+}
+
+func (d datasetAlertWorkflow) DeliverDatasetAlertWebhookActivity(ctx context.Context, evaluation models.DatasetAlertEvaluation) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	payload := webhookPayload{
+		EventId:         evaluation.EventId,
+		AlertId:         evaluation.AlertId,
+		AlertName:       evaluation.AlertName,
+		DatasetId:       evaluation.DatasetId,
+		DatasetName:     evaluation.DatasetName,
+		Status:          evaluation.EventStatus,
+		Value:           evaluation.Result.Value,
+		ComparisonValue: evaluation.Result.ComparisonValue,
+		Score:           evaluation.Result.Score,
+		Message:         evaluation.Result.Message,
+		EvaluatedAt:     evaluation.EvaluatedAt,
+	}
+
+	_, resp, err := apihelper.HttpPost(d.httpClient, evaluation.WebhookUrl, payload, map[string]string{"Content-Type": "application/json"})
+	if err != nil {
+		logger.Error("failed to post dataset alert webhook", zap.String("alert_id", evaluation.AlertId.String()), zap.Error(err))
+		return err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("dataset alert webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (d datasetAlertWorkflow) GetActivities() []activity.Activity {
+	return []activity.Activity{
+		{
+			Function: d.datasetService.EvaluateDatasetAlertActivity,
+			RegisterOptions: activity.RegisterActivityOptions{
+				Name: constants.EvaluateDatasetAlertActivity,
+			},
+		},
+		{
+			Function: d.NotifyDatasetAlertByEmailActivity,
+			RegisterOptions: activity.RegisterActivityOptions{
+				DisableAlreadyRegisteredCheck: true,
+			},
+		},
+		{
+			Function: d.DeliverDatasetAlertWebhookActivity,
+			RegisterOptions: activity.RegisterActivityOptions{
+				DisableAlreadyRegisteredCheck: true,
+			},
+		},
+	}
+}
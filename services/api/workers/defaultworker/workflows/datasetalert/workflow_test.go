@@ -0,0 +1,175 @@
+package datasetalert
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/mailer"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mock_service "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
+	mock_mailer "github.com/Zampfi/application-platform/services/api/mocks/core/mailer"
+	mock_helper "github.com/Zampfi/application-platform/services/api/mocks/helper"
+	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestDatasetAlertWorkflow(t *testing.T) {
+	params := models.DatasetAlertWorkflowParams{
+		AlertId:            uuid.New(),
+		TemporalScheduleId: "dataset-alert-current",
+		OrganizationId:     uuid.New(),
+		OwnerId:            uuid.New(),
+	}
+
+	previous := 40000.0
+	firing := models.DatasetAlertEvaluation{
+		AlertId:     params.AlertId,
+		AlertName:   "Unreconciled amount",
+		DatasetId:   uuid.New(),
+		DatasetName: "Payouts",
+		State:       dbmodels.DatasetAlertStateFiring,
+		Result: models.DatasetAlertMetricResult{
+			Value:           62000,
+			ComparisonValue: &previous,
+			Breached:        true,
+			Message:         "value 62000 > 50000",
+		},
+		EventId:     uuid.New(),
+		EventStatus: dbmodels.DatasetAlertEventStatusFiring,
+		Notify:      true,
+		Recipients:  []string{"finance@example.com"},
+		WebhookUrl:  "https://hooks.example.com/alerts",
+		EvaluatedAt: time.Date(2025, 4, 2, 9, 0, 0, 0, time.UTC),
+	}
+
+	okResponse := func() *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}
+	}
+
+	tests := []struct {
+		name       string
+		params     models.DatasetAlertWorkflowParams
+		mockSetup  func(*mock_service.MockDatasetService, *mock_mailer.MockMailerService, *mock_helper.MockHTTPClient)
+		wantResult interface{}
+		wantErr    bool
+	}{
+		{
+			name:   "Notifies recipients and the webhook when the alert starts firing",
+			params: params,
+			mockSetup: func(ds *mock_service.MockDatasetService, ms *mock_mailer.MockMailerService, hc *mock_helper.MockHTTPClient) {
+				ds.EXPECT().EvaluateDatasetAlertActivity(mock.Anything, params).Return(firing, nil)
+				ms.EXPECT().SendDatasetAlertEmail(mock.Anything, mock.MatchedBy(func(data mailer.DatasetAlertEmailData) bool {
+					return data.Status == "FIRING" && data.AlertName == "Unreconciled amount" && data.EvaluatedAt == "2 Apr 2025 09:00 UTC"
+				})).Return(nil)
+				hc.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+					var payload webhookPayload
+					if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+						return false
+					}
+					return req.URL.String() == firing.WebhookUrl && payload.EventId == firing.EventId && payload.Status == "FIRING" && *payload.ComparisonValue == previous
+				})).Return(okResponse(), nil)
+			},
+			wantResult: dbmodels.DatasetAlertStateFiring,
+		},
+		{
+			name:   "Does not notify when the state did not change",
+			params: params,
+			mockSetup: func(ds *mock_service.MockDatasetService, ms *mock_mailer.MockMailerService, hc *mock_helper.MockHTTPClient) {
+				ds.EXPECT().EvaluateDatasetAlertActivity(mock.Anything, params).
+					Return(models.DatasetAlertEvaluation{AlertId: params.AlertId, State: dbmodels.DatasetAlertStateFiring}, nil)
+			},
+			wantResult: dbmodels.DatasetAlertStateFiring,
+		},
+		{
+			name:   "Skips evaluations of disabled alerts",
+			params: params,
+			mockSetup: func(ds *mock_service.MockDatasetService, ms *mock_mailer.MockMailerService, hc *mock_helper.MockHTTPClient) {
+				ds.EXPECT().EvaluateDatasetAlertActivity(mock.Anything, params).Return(models.DatasetAlertEvaluation{Skip: true}, nil)
+			},
+		},
+		{
+			name:   "Fails when the webhook rejects the notification",
+			params: params,
+			mockSetup: func(ds *mock_service.MockDatasetService, ms *mock_mailer.MockMailerService, hc *mock_helper.MockHTTPClient) {
+				ds.EXPECT().EvaluateDatasetAlertActivity(mock.Anything, params).Return(firing, nil)
+				ms.EXPECT().SendDatasetAlertEmail(mock.Anything, mock.Anything).Return(nil)
+				hc.EXPECT().Do(mock.Anything).RunAndReturn(func(req *http.Request) (*http.Response, error) {
+					return &http.Response{StatusCode: http.StatusInternalServerError, Body: io.NopCloser(strings.NewReader(""))}, nil
+				}).Times(notificationMaxAttempts)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Fails when the evaluation fails",
+			params: params,
+			mockSetup: func(ds *mock_service.MockDatasetService, ms *mock_mailer.MockMailerService, hc *mock_helper.MockHTTPClient) {
+				ds.EXPECT().EvaluateDatasetAlertActivity(mock.Anything, params).Return(models.DatasetAlertEvaluation{}, assert.AnError).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Returns error when owner ID is nil",
+			params: models.DatasetAlertWorkflowParams{AlertId: params.AlertId, OrganizationId: params.OrganizationId},
+			mockSetup: func(ds *mock_service.MockDatasetService, ms *mock_mailer.MockMailerService, hc *mock_helper.MockHTTPClient) {
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+
+			mockDatasetService := mock_service.NewMockDatasetService(t)
+			mockMailerService := mock_mailer.NewMockMailerService(t)
+			mockHttpClient := mock_helper.NewMockHTTPClient(t)
+			tt.mockSetup(mockDatasetService, mockMailerService, mockHttpClient)
+
+			workflow := datasetAlertWorkflow{
+				datasetService: mockDatasetService,
+				mailerService:  mockMailerService,
+				httpClient:     mockHttpClient,
+			}
+
+			env.RegisterActivity(workflow.datasetService.EvaluateDatasetAlertActivity)
+			env.RegisterActivity(workflow.NotifyDatasetAlertByEmailActivity)
+			env.RegisterActivity(workflow.DeliverDatasetAlertWebhookActivity)
+
+			env.ExecuteWorkflow(workflow.ApplicationPlatformDatasetAlertWorkflowExecute, tt.params)
+
+			assert.True(t, env.IsWorkflowCompleted())
+			if tt.wantErr {
+				assert.Error(t, env.GetWorkflowError())
+				return
+			}
+
+			assert.NoError(t, env.GetWorkflowError())
+			if tt.wantResult != nil {
+				var result interface{}
+				assert.NoError(t, env.GetWorkflowResult(&result))
+				assert.Equal(t, tt.wantResult, result)
+			}
+		})
+	}
+}
+
+func TestGetActivities(t *testing.T) {
+	workflow := datasetAlertWorkflow{
+		datasetService: mock_service.NewMockDatasetService(t),
+	}
+
+	activities := workflow.GetActivities()
+
+	assert.Len(t, activities, 3)
+	assert.Equal(t, constants.EvaluateDatasetAlertActivity, activities[0].RegisterOptions.Name)
+	assert.True(t, activities[1].RegisterOptions.DisableAlreadyRegisteredCheck)
+}
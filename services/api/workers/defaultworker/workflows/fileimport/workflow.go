@@ -194,6 +194,15 @@ func (f fileImportWorkflow) RegisterExitPayloadInDB(ctx context.Context, initPar
 		return "", fmt.Errorf("could not update dataset action config: %s", err.Error())
 	}
 
+	// reference data issues are reported with the preview for users to fix, they do not fail the import
+	var validationErrors []string
+	if len(exitParams.NormalizationResult.DataPreview.Rows) > 0 {
+		validationErrors, err = f.datasetService.ValidateFileImportPreview(ctx, initParams.DatasetId, exitParams.NormalizationResult.DataPreview.Rows)
+		if err != nil {
+			logger.Warn("failed to validate file import preview", zap.Error(err))
+		}
+	}
+
 	err = f.datasetService.UpdateDatasetFileUploadStatus(ctx, initParams.DatasetFileUploadId, models.UpdateDatasetFileUploadParams{
 		FileAllignmentStatus: dbmodels.DatasetFileAllignmentStatusCompleted,
 		Metadata: dbmodels.DatasetFileUploadMetadata{
@@ -204,6 +213,7 @@ func (f fileImportWorkflow) RegisterExitPayloadInDB(ctx context.Context, initPar
 			ExtractedMetadata: dbmodels.ExtractedMetadata{
 				Data: exitParams.NormalizationResult.ExtractedMetadata,
 			},
+			ValidationErrors: validationErrors,
 		},
 	})
 	if err != nil {
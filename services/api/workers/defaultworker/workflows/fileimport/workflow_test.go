@@ -250,6 +250,39 @@ func TestRegisterExitPayloadInDB(t *testing.T) {
 			},
 			expectedError: false,
 		},
+		{
+			name: "Registers reference data issues of the preview",
+			initPayload: models.FileImportWorkflowInitPayload{
+				DatasetActionId:     uuid.New(),
+				DatasetFileUploadId: uuid.New(),
+				DatasetId:           uuid.New(),
+			},
+			exitPayload: models.FileImportWorkflowExitPayload{
+				TransformedFilePath: "transformed/path",
+				NormalizationResult: models.AINormalizationWorkflowResponse{
+					DataPreview: dbmodels.DatasetPreview{
+						Columns: []string{"country"},
+						Rows:    []map[string]interface{}{{"country": "XYZ"}},
+					},
+				},
+			},
+			setupMocks: func(mockDatasetService *mock_datasetservice.MockDatasetService, mockFileUploadsService *mock_fileimports.MockFileImportService, mockTemporalSdk *mock_temporal.MockTemporalService, mockDatasetStore *mock_store.MockDatasetStore) {
+				mockDatasetService.EXPECT().
+					UpdateDatasetActionStatus(mock.Anything, mock.Anything, constants.DatasetActionStatusSuccessful).
+					Return(nil)
+
+				mockDatasetService.EXPECT().
+					ValidateFileImportPreview(mock.Anything, mock.Anything, []map[string]interface{}{{"country": "XYZ"}}).
+					Return([]string{"row 1: XYZ is not a valid country for column country"}, nil)
+
+				mockDatasetService.EXPECT().
+					UpdateDatasetFileUploadStatus(mock.Anything, mock.Anything, mock.MatchedBy(func(params models.UpdateDatasetFileUploadParams) bool {
+						return len(params.Metadata.ValidationErrors) == 1
+					})).
+					Return(nil)
+			},
+			expectedError: false,
+		},
 		{
 			name: "Failed to Update Dataset Action Status",
 			initPayload: models.FileImportWorkflowInitPayload{
@@ -0,0 +1,94 @@
+package reconciliation
+
+import (
+	"fmt"
+	"time"
+
+	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	datasetService "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	reconciliationconstants "github.com/Zampfi/application-platform/services/api/core/reconciliations/constants"
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	reconciliationService "github.com/Zampfi/application-platform/services/api/core/reconciliations/service"
+	"github.com/Zampfi/application-platform/services/api/workers/common/workflowutil"
+	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	"github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/activity"
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const runMaxAttempts = 3
+
+type reconciliationWorkflow struct {
+	reconciliationService reconciliationService.ReconciliationService
+}
+
+func InitReconciliation(serverConfig *serverconfig.ServerConfig, datasetService datasetService.DatasetService) reconciliationWorkflow {
+	return reconciliationWorkflow{
+		reconciliationService: reconciliationService.NewReconciliationService(serverConfig.Store, datasetService, serverConfig.TemporalSdk),
+	}
+}
+
+// ApplicationPlatformReconciliationRunWorkflowExecute executes one run of a reconciliation. The run activity saves
+// its results in a single transaction so it can be retried, a run that still fails is marked as failed.
+func (r reconciliationWorkflow) ApplicationPlatformReconciliationRunWorkflowExecute(wtx workflow.Context, params models.ReconciliationRunWorkflowParams) (interface{}, error) {
+	defer workflowutil.PanicRecoveryHook(wtx)
+
+	logger := workflow.GetLogger(wtx)
+
+	if params.UserId == uuid.Nil {
+		return nil, fmt.Errorf("user id is required")
+	}
+	if params.OrganizationId == uuid.Nil {
+		return nil, fmt.Errorf("organization id is required")
+	}
+	if params.RunId == uuid.Nil {
+		return nil, fmt.Errorf("run id is required")
+	}
+
+	paramsBase := baseParams{
+		userId: params.UserId,
+		orgIds: []uuid.UUID{params.OrganizationId},
+	}
+
+	wtx = workflowutil.AddAccessControlParamsToWorkflowCtx(wtx, &paramsBase)
+
+	runCtx := workflow.WithActivityOptions(wtx, workflow.ActivityOptions{
+		StartToCloseTimeout: reconciliationconstants.ReconciliationRunTimeout,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: runMaxAttempts},
+	})
+
+	var result models.ReconciliationRunResult
+	err := workflow.ExecuteActivity(runCtx, r.reconciliationService.RunReconciliationActivity, params).Get(wtx, &result)
+	if err == nil {
+		return result.Status, nil
+	}
+
+	logger.Error("reconciliation run failed", "run_id", params.RunId.String(), "error", err.Error())
+
+	failCtx := workflow.WithActivityOptions(wtx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+	})
+	if failErr := workflow.ExecuteActivity(failCtx, r.reconciliationService.FailReconciliationRunActivity, params, err.Error()).Get(wtx, nil); failErr != nil {
+		logger.Error("failed to record reconciliation run failure", "run_id", params.RunId.String(), "error", failErr.Error())
+	}
+
+	return nil, fmt.Errorf("failed to run reconciliation: %w", err)
+}
+
+func (r reconciliationWorkflow) GetActivities() []activity.Activity {
+	return []activity.Activity{
+		{
+			Function: r.reconciliationService.RunReconciliationActivity,
+			RegisterOptions: activity.RegisterActivityOptions{
+				Name: constants.RunReconciliationActivity,
+			},
+		},
+		{
+			Function: r.reconciliationService.FailReconciliationRunActivity,
+			RegisterOptions: activity.RegisterActivityOptions{
+				Name: constants.FailReconciliationRunActivity,
+			},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package reconciliation
+
+import (
+	"testing"
+
+	"github.com/Zampfi/application-platform/services/api/core/reconciliations/models"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mock_service "github.com/Zampfi/application-platform/services/api/mocks/core/reconciliations/service"
+	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestReconciliationRunWorkflow(t *testing.T) {
+	params := models.ReconciliationRunWorkflowParams{
+		ReconciliationId: uuid.New(),
+		RunId:            uuid.New(),
+		OrganizationId:   uuid.New(),
+		UserId:           uuid.New(),
+	}
+
+	tests := []struct {
+		name       string
+		params     models.ReconciliationRunWorkflowParams
+		mockSetup  func(*mock_service.MockReconciliationService)
+		wantResult interface{}
+		wantErr    bool
+	}{
+		{
+			name:   "Runs the reconciliation",
+			params: params,
+			mockSetup: func(rs *mock_service.MockReconciliationService) {
+				rs.EXPECT().RunReconciliationActivity(mock.Anything, params).Return(models.ReconciliationRunResult{
+					Status:     dbmodels.ReconciliationRunStatusSuccessful,
+					MatchCount: 12,
+				}, nil)
+			},
+			wantResult: dbmodels.ReconciliationRunStatusSuccessful,
+		},
+		{
+			name:   "Marks the run as failed when matching keeps failing",
+			params: params,
+			mockSetup: func(rs *mock_service.MockReconciliationService) {
+				rs.EXPECT().RunReconciliationActivity(mock.Anything, params).Return(models.ReconciliationRunResult{}, assert.AnError).Times(runMaxAttempts)
+				rs.EXPECT().FailReconciliationRunActivity(mock.Anything, params, mock.AnythingOfType("string")).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Returns error when user ID is nil",
+			params:    models.ReconciliationRunWorkflowParams{ReconciliationId: params.ReconciliationId, RunId: params.RunId, OrganizationId: params.OrganizationId},
+			mockSetup: func(rs *mock_service.MockReconciliationService) {},
+			wantErr:   true,
+		},
+		{
+			name:      "Returns error when run ID is nil",
+			params:    models.ReconciliationRunWorkflowParams{ReconciliationId: params.ReconciliationId, OrganizationId: params.OrganizationId, UserId: params.UserId},
+			mockSetup: func(rs *mock_service.MockReconciliationService) {},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+
+			mockReconciliationService := mock_service.NewMockReconciliationService(t)
+			tt.mockSetup(mockReconciliationService)
+
+			workflow := reconciliationWorkflow{
+				reconciliationService: mockReconciliationService,
+			}
+
+			env.RegisterActivity(workflow.reconciliationService.RunReconciliationActivity)
+			env.RegisterActivity(workflow.reconciliationService.FailReconciliationRunActivity)
+
+			env.ExecuteWorkflow(workflow.ApplicationPlatformReconciliationRunWorkflowExecute, tt.params)
+
+			assert.True(t, env.IsWorkflowCompleted())
+			if tt.wantErr {
+				assert.Error(t, env.GetWorkflowError())
+				return
+			}
+
+			assert.NoError(t, env.GetWorkflowError())
+			var result interface{}
+			assert.NoError(t, env.GetWorkflowResult(&result))
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
+
+func TestGetActivities(t *testing.T) {
+	workflow := reconciliationWorkflow{
+		reconciliationService: mock_service.NewMockReconciliationService(t),
+	}
+
+	activities := workflow.GetActivities()
+
+	assert.Len(t, activities, 2)
+	assert.Equal(t, constants.RunReconciliationActivity, activities[0].RegisterOptions.Name)
+	assert.Equal(t, constants.FailReconciliationRunActivity, activities[1].RegisterOptions.Name)
+}
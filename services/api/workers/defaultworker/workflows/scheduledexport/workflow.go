@@ -0,0 +1,286 @@
+package scheduledexport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"time"
+
+	serverconfig "github.com/Zampfi/application-platform/services/api/config"
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	datasetService "github.com/Zampfi/application-platform/services/api/core/datasets/service"
+	"github.com/Zampfi/application-platform/services/api/core/mailer"
+	"github.com/Zampfi/application-platform/services/api/core/organizations/exports"
+	"github.com/Zampfi/application-platform/services/api/db/store"
+	apihelper "github.com/Zampfi/application-platform/services/api/helper"
+	apicontext "github.com/Zampfi/application-platform/services/api/helper/context"
+	cloudserviceconstants "github.com/Zampfi/application-platform/services/api/pkg/cloudservices/constants"
+	cloudservicemodels "github.com/Zampfi/application-platform/services/api/pkg/cloudservices/models"
+	cloudservice "github.com/Zampfi/application-platform/services/api/pkg/cloudservices/service"
+	"github.com/Zampfi/application-platform/services/api/pkg/s3"
+	"github.com/Zampfi/application-platform/services/api/workers/common/workflowutil"
+	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	"github.com/Zampfi/workflow-sdk-go/workflowmanagers/temporal/activity"
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+)
+
+const emailTimeFormat = "2 Jan 2006 15:04 MST"
+
+type datasetExportScheduleWorkflow struct {
+	datasetService datasetService.DatasetService
+	cloudService   cloudservice.CloudService
+	s3Client       s3.S3Client
+	mailerService  mailer.MailerService
+	userStore      store.UserStore
+}
+
+func InitDatasetExportSchedule(serverConfig *serverconfig.ServerConfig, datasetService datasetService.DatasetService) (datasetExportScheduleWorkflow, error) {
+	cloudService, err := cloudservice.NewCloudService("GCP", *serverConfig.Env)
+	if err != nil {
+		return datasetExportScheduleWorkflow{}, err
+	}
+
+	mailerService := mailer.NewMailerService(serverConfig.SparkpostClient, serverConfig.Env.ZampEmailUpdatesFrom, serverConfig.Env.EmailTemplatesPath)
+
+	return datasetExportScheduleWorkflow{
+		datasetService: datasetService,
+		cloudService:   cloudService,
+		s3Client:       serverConfig.DefaultS3Client,
+		mailerService:  mailerService,
+		userStore:      serverConfig.Store,
+	}, nil
+}
+
+// ApplicationPlatformDatasetExportScheduleWorkflowExecute runs once per firing of the temporal schedule of a dataset export schedule.
+// It exports the saved query of the schedule with the export activity, delivers the file and records the outcome in the run history,
+// the owner of the schedule is emailed when the export or the delivery fails.
+func (d datasetExportScheduleWorkflow) ApplicationPlatformDatasetExportScheduleWorkflowExecute(wtx workflow.Context, params models.DatasetExportScheduleWorkflowParams) (interface{}, error) {
+	defer workflowutil.PanicRecoveryHook(wtx)
+
+	logger := workflow.GetLogger(wtx)
+
+	if params.OwnerId == uuid.Nil {
+		return nil, fmt.Errorf("owner id is required")
+	}
+	if params.OrganizationId == uuid.Nil {
+		return nil, fmt.Errorf("organization id is required")
+	}
+
+	paramsBase := baseParams{
+		userId: params.OwnerId,
+		orgIds: []uuid.UUID{params.OrganizationId},
+	}
+
+	wtx = workflowutil.AddAccessControlParamsToWorkflowCtx(wtx, &paramsBase)
+	wtx = workflow.WithActivityOptions(wtx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+	})
+
+	// starting a run creates its dataset action and run records, retrying it would record the run twice
+	startCtx := workflow.WithRetryPolicy(wtx, temporal.RetryPolicy{MaximumAttempts: 1})
+
+	var plan models.DatasetExportScheduleRunPlan
+	err := workflow.ExecuteActivity(startCtx, d.datasetService.StartDatasetExportScheduleRunActivity, params, workflow.GetInfo(wtx).WorkflowExecution.ID).Get(wtx, &plan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start dataset export schedule run: %w", err)
+	}
+
+	if plan.Skip {
+		logger.Info("dataset export schedule run skipped", "schedule_id", params.ScheduleId.String())
+		return nil, nil
+	}
+
+	runErr := workflow.ExecuteActivity(wtx, d.datasetService.DatasetExportTemporalActivity, plan.ExportParams, plan.DatasetId, params.OwnerId, []uuid.UUID{params.OrganizationId}, plan.WorkflowId).Get(wtx, nil)
+	if runErr == nil {
+		runErr = workflow.ExecuteActivity(wtx, d.DeliverDatasetExportActivity, plan).Get(wtx, nil)
+	}
+
+	if runErr != nil {
+		errorMessage := getRunErrorMessage(runErr)
+
+		err = workflow.ExecuteActivity(wtx, d.NotifyDatasetExportScheduleFailureActivity, params, plan, errorMessage).Get(wtx, nil)
+		if err != nil {
+			logger.Error("failed to notify dataset export schedule owner", "error", err.Error())
+		}
+
+		err = workflow.ExecuteActivity(wtx, d.datasetService.CompleteDatasetExportScheduleRunActivity, params, plan, errorMessage).Get(wtx, nil)
+		if err != nil {
+			logger.Error("failed to record failed dataset export schedule run", "error", err.Error())
+		}
+
+		return nil, runErr
+	}
+
+	err = workflow.ExecuteActivity(wtx, d.datasetService.CompleteDatasetExportScheduleRunActivity, params, plan, "").Get(wtx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete dataset export schedule run: %w", err)
+	}
+
+	return plan.ExportParams.ExportPath, nil
+}
+
+// DeliverDatasetExportActivity copies the exported file to the bucket of the schedule or emails a download link to its recipients
+func (d datasetExportScheduleWorkflow) DeliverDatasetExportActivity(ctx context.Context, plan models.DatasetExportScheduleRunPlan) error {
+	logger := apicontext.GetLoggerFromCtx(ctx)
+
+	fileName := path.Base(plan.ExportParams.ExportPath)
+
+	switch plan.DestinationType {
+	case datasetConstants.ExportDestinationTypeStorage:
+		data, err := d.readExportFile(ctx, plan.ExportParams.ExportPath)
+		if err != nil {
+			logger.Error("failed to read export file", zap.Error(err))
+			return err
+		}
+
+		objectKey, err := exports.ObjectKey(plan.DestinationConfig.Prefix, fileName)
+		if err != nil {
+			logger.Error("invalid export destination prefix", zap.String("prefix", plan.DestinationConfig.Prefix), zap.Error(err))
+			return err
+		}
+
+		if err := d.uploadExportFile(ctx, plan.DestinationConfig, objectKey, data); err != nil {
+			logger.Error("failed to upload export file to destination", zap.String("bucket", plan.DestinationConfig.Bucket), zap.Error(err))
+			return err
+		}
+
+		return nil
+	case datasetConstants.ExportDestinationTypeEmail:
+		downloadUrl, err := d.cloudService.GetSignedUrlToDownload(ctx, plan.ExportParams.ExportPath, []cloudservicemodels.GetDownloadsignedUrlConfigs{
+			{Key: cloudserviceconstants.SignedDownloadUrlConfigTtl, Value: datasetConstants.DatasetExportEmailLinkTtl},
+			{Key: cloudserviceconstants.SignedDownloadUrlConfigCustomName, Value: fileName},
+			{Key: cloudserviceconstants.SignedDownloadUrlConfigContentType, Value: datasetConstants.ExportFormatContentTypes[plan.ExportParams.Format]},
+		})
+		if err != nil {
+			logger.Error("failed to get export download url", zap.Error(err))
+			return fmt.Errorf("failed to get export download url: %w", err)
+		}
+
+		return d.mailerService.SendDatasetExportEmail(ctx, mailer.DatasetExportEmailData{
+			RecipientEmails: plan.DestinationConfig.Recipients,
+			ScheduleName:    plan.ScheduleName,
+			DatasetName:     plan.DatasetName,
+			DownloadLink:    *downloadUrl,
+			LinkExpiresOn:   time.Now().Add(datasetConstants.DatasetExportEmailLinkTtl).UTC().Format(emailTimeFormat),
+		})
+	default:
+		return fmt.Errorf("unsupported export destination type %s", plan.DestinationType)
+	}
+}
+
+func (d datasetExportScheduleWorkflow) NotifyDatasetExportScheduleFailureActivity(ctx context.Context, params models.DatasetExportScheduleWorkflowParams, plan models.DatasetExportScheduleRunPlan, errorMessage string) error {
+	ctx = apicontext.AddAuthToContext(ctx, "user", params.OwnerId, []uuid.UUID{params.OrganizationId})
+
+	owner, err := d.userStore.GetUserById(ctx, params.OwnerId.String())
+	if err != nil {
+		return fmt.Errorf("failed to get dataset export schedule owner: %w", err)
+	}
+
+	return d.mailerService.SendDatasetExportFailureEmail(ctx, mailer.DatasetExportFailureEmailData{
+		RecipientEmail: owner.Email,
+		ScheduleName:   plan.ScheduleName,
+		DatasetName:    plan.DatasetName,
+		FailedAt:       time.Now().UTC().Format(emailTimeFormat),
+		Error:          errorMessage,
+	})
+}
+
+func (d datasetExportScheduleWorkflow) readExportFile(ctx context.Context, exportPath string) ([]byte, error) {
+	downloadUrl, err := d.cloudService.GetSignedUrlToDownload(ctx, exportPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export download url: %w", err)
+	}
+
+	data, resp, err := apihelper.HttpGet(&http.Client{}, *downloadUrl, map[string]string{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download export: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download export: status %d", resp.StatusCode)
+	}
+
+	return data, nil
+}
+
+// uploadExportFile writes the file to the bucket the run plan resolved from the export settings of the organization
+func (d datasetExportScheduleWorkflow) uploadExportFile(ctx context.Context, destination models.DatasetExportScheduleDestination, objectKey string, data []byte) error {
+	if destination.Bucket == "" {
+		return fmt.Errorf("no bucket to export to on %s", destination.Provider)
+	}
+
+	switch destination.Provider {
+	case datasetConstants.ExportStorageProviderGCS:
+		_, err := d.cloudService.UploadFileToCloud(ctx, fmt.Sprintf("gs://%s/%s", destination.Bucket, objectKey), data)
+		return err
+	case datasetConstants.ExportStorageProviderS3:
+		uploadUrl, err := d.s3Client.GenerateUploadURL(ctx, destination.Bucket, objectKey, time.Now().Add(datasetConstants.DatasetExportUploadUrlTtl), "")
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadUrl, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusMultipleChoices {
+			return fmt.Errorf("failed to upload export to s3: status %d", resp.StatusCode)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported export storage provider %s", destination.Provider)
+	}
+}
+
+// getRunErrorMessage drops the temporal activity envelope from err, the message is shown in the run history and the failure email
+func getRunErrorMessage(err error) string {
+	var applicationErr *temporal.ApplicationError
+	if errors.As(err, &applicationErr) {
+		return applicationErr.Error()
+	}
+
+	return err.Error()
+}
+
+func (d datasetExportScheduleWorkflow) GetActivities() []activity.Activity {
+	return []activity.Activity{
+		{
+			Function: d.datasetService.StartDatasetExportScheduleRunActivity,
+			RegisterOptions: activity.RegisterActivityOptions{
+				Name: constants.StartDatasetExportScheduleRunActivity,
+			},
+		},
+		{
+			Function: d.datasetService.CompleteDatasetExportScheduleRunActivity,
+			RegisterOptions: activity.RegisterActivityOptions{
+				Name: constants.CompleteDatasetExportScheduleRunActivity,
+			},
+		},
+		{
+			Function: d.DeliverDatasetExportActivity,
+			RegisterOptions: activity.RegisterActivityOptions{
+				DisableAlreadyRegisteredCheck: true,
+			},
+		},
+		{
+			Function: d.NotifyDatasetExportScheduleFailureActivity,
+			RegisterOptions: activity.RegisterActivityOptions{
+				DisableAlreadyRegisteredCheck: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,151 @@
+package scheduledexport
+
+import (
+	"testing"
+
+	datasetConstants "github.com/Zampfi/application-platform/services/api/core/datasets/constants"
+	"github.com/Zampfi/application-platform/services/api/core/datasets/models"
+	"github.com/Zampfi/application-platform/services/api/core/mailer"
+	dbmodels "github.com/Zampfi/application-platform/services/api/db/models"
+	mock_service "github.com/Zampfi/application-platform/services/api/mocks/core/datasets/service"
+	mock_mailer "github.com/Zampfi/application-platform/services/api/mocks/core/mailer"
+	mock_store "github.com/Zampfi/application-platform/services/api/mocks/db/store"
+	mock_cloudservice "github.com/Zampfi/application-platform/services/api/mocks/pkg/cloudservices/service"
+	"github.com/Zampfi/application-platform/services/api/workers/defaultworker/constants"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestDatasetExportScheduleWorkflow(t *testing.T) {
+	params := models.DatasetExportScheduleWorkflowParams{
+		ScheduleId:         uuid.New(),
+		TemporalScheduleId: "dataset-export-schedule-current",
+		OrganizationId:     uuid.New(),
+		OwnerId:            uuid.New(),
+	}
+
+	plan := models.DatasetExportScheduleRunPlan{
+		RunId:           uuid.New(),
+		WorkflowId:      "run-workflow",
+		ScheduleName:    "Morning payouts",
+		DatasetId:       uuid.New(),
+		DatasetName:     "Payouts",
+		ExportParams:    models.DatasetExportParams{Format: datasetConstants.ExportFormatCSV, ExportPath: "exports/payouts/run-workflow.csv"},
+		DestinationType: datasetConstants.ExportDestinationTypeEmail,
+		DestinationConfig: models.DatasetExportScheduleDestination{
+			Recipients: []string{"finance@example.com"},
+		},
+	}
+
+	downloadUrl := "https://storage.example.com/exports/payouts/run-workflow.csv"
+
+	tests := []struct {
+		name       string
+		params     models.DatasetExportScheduleWorkflowParams
+		mockSetup  func(*mock_service.MockDatasetService, *mock_cloudservice.MockCloudService, *mock_mailer.MockMailerService, *mock_store.MockUserStore)
+		wantResult interface{}
+		wantErr    bool
+	}{
+		{
+			name:   "Emails the export to the recipients",
+			params: params,
+			mockSetup: func(ds *mock_service.MockDatasetService, cs *mock_cloudservice.MockCloudService, ms *mock_mailer.MockMailerService, us *mock_store.MockUserStore) {
+				ds.EXPECT().StartDatasetExportScheduleRunActivity(mock.Anything, params, mock.AnythingOfType("string")).Return(plan, nil)
+				ds.EXPECT().DatasetExportTemporalActivity(mock.Anything, plan.ExportParams, plan.DatasetId, params.OwnerId, []uuid.UUID{params.OrganizationId}, plan.WorkflowId).
+					Return(plan.ExportParams.ExportPath, nil)
+				cs.EXPECT().GetSignedUrlToDownload(mock.Anything, plan.ExportParams.ExportPath, mock.Anything).Return(&downloadUrl, nil)
+				ms.EXPECT().SendDatasetExportEmail(mock.Anything, mock.MatchedBy(func(data mailer.DatasetExportEmailData) bool {
+					return data.DownloadLink == downloadUrl && data.ScheduleName == "Morning payouts" && len(data.RecipientEmails) == 1
+				})).Return(nil)
+				ds.EXPECT().CompleteDatasetExportScheduleRunActivity(mock.Anything, params, plan, "").Return(nil)
+			},
+			wantResult: "exports/payouts/run-workflow.csv",
+		},
+		{
+			name:   "Skips runs of inactive schedules",
+			params: params,
+			mockSetup: func(ds *mock_service.MockDatasetService, cs *mock_cloudservice.MockCloudService, ms *mock_mailer.MockMailerService, us *mock_store.MockUserStore) {
+				ds.EXPECT().StartDatasetExportScheduleRunActivity(mock.Anything, params, mock.AnythingOfType("string")).
+					Return(models.DatasetExportScheduleRunPlan{Skip: true}, nil)
+			},
+			wantResult: nil,
+		},
+		{
+			name:   "Notifies the owner when the export fails",
+			params: params,
+			mockSetup: func(ds *mock_service.MockDatasetService, cs *mock_cloudservice.MockCloudService, ms *mock_mailer.MockMailerService, us *mock_store.MockUserStore) {
+				ds.EXPECT().StartDatasetExportScheduleRunActivity(mock.Anything, params, mock.AnythingOfType("string")).Return(plan, nil)
+				ds.EXPECT().DatasetExportTemporalActivity(mock.Anything, plan.ExportParams, plan.DatasetId, params.OwnerId, []uuid.UUID{params.OrganizationId}, plan.WorkflowId).
+					Return("", assert.AnError)
+				us.EXPECT().GetUserById(mock.Anything, params.OwnerId.String()).Return(&dbmodels.User{Email: "owner@example.com"}, nil)
+				ms.EXPECT().SendDatasetExportFailureEmail(mock.Anything, mock.MatchedBy(func(data mailer.DatasetExportFailureEmailData) bool {
+					return data.RecipientEmail == "owner@example.com" && data.Error == assert.AnError.Error()
+				})).Return(nil)
+				ds.EXPECT().CompleteDatasetExportScheduleRunActivity(mock.Anything, params, plan, assert.AnError.Error()).Return(nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:   "Returns error when owner ID is nil",
+			params: models.DatasetExportScheduleWorkflowParams{ScheduleId: params.ScheduleId, OrganizationId: params.OrganizationId},
+			mockSetup: func(ds *mock_service.MockDatasetService, cs *mock_cloudservice.MockCloudService, ms *mock_mailer.MockMailerService, us *mock_store.MockUserStore) {
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+
+			mockDatasetService := mock_service.NewMockDatasetService(t)
+			mockCloudService := mock_cloudservice.NewMockCloudService(t)
+			mockMailerService := mock_mailer.NewMockMailerService(t)
+			mockUserStore := mock_store.NewMockUserStore(t)
+			tt.mockSetup(mockDatasetService, mockCloudService, mockMailerService, mockUserStore)
+
+			workflow := datasetExportScheduleWorkflow{
+				datasetService: mockDatasetService,
+				cloudService:   mockCloudService,
+				mailerService:  mockMailerService,
+				userStore:      mockUserStore,
+			}
+
+			env.RegisterActivity(workflow.datasetService.StartDatasetExportScheduleRunActivity)
+			env.RegisterActivity(workflow.datasetService.DatasetExportTemporalActivity)
+			env.RegisterActivity(workflow.datasetService.CompleteDatasetExportScheduleRunActivity)
+			env.RegisterActivity(workflow.DeliverDatasetExportActivity)
+			env.RegisterActivity(workflow.NotifyDatasetExportScheduleFailureActivity)
+
+			env.ExecuteWorkflow(workflow.ApplicationPlatformDatasetExportScheduleWorkflowExecute, tt.params)
+
+			assert.True(t, env.IsWorkflowCompleted())
+			if tt.wantErr {
+				assert.Error(t, env.GetWorkflowError())
+				return
+			}
+
+			assert.NoError(t, env.GetWorkflowError())
+			if tt.wantResult != nil {
+				var result interface{}
+				assert.NoError(t, env.GetWorkflowResult(&result))
+				assert.Equal(t, tt.wantResult, result)
+			}
+		})
+	}
+}
+
+func TestGetActivities(t *testing.T) {
+	workflow := datasetExportScheduleWorkflow{
+		datasetService: mock_service.NewMockDatasetService(t),
+	}
+
+	activities := workflow.GetActivities()
+
+	assert.Len(t, activities, 4)
+	assert.Equal(t, constants.StartDatasetExportScheduleRunActivity, activities[0].RegisterOptions.Name)
+	assert.Equal(t, constants.CompleteDatasetExportScheduleRunActivity, activities[1].RegisterOptions.Name)
+}
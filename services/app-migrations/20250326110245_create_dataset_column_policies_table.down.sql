@@ -0,0 +1 @@
+DROP TABLE IF EXISTS app.dataset_column_policies;
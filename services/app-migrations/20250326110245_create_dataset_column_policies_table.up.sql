@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS app.dataset_column_policies (
+    dataset_column_policy_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    resource_audience_type TEXT NOT NULL,
+    resource_audience_id uuid NOT NULL,
+    column_name TEXT NOT NULL,
+    action TEXT NOT NULL,
+    mask_type TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_dataset_column_policies_dataset_audience_column ON app.dataset_column_policies (dataset_id, resource_audience_type, resource_audience_id, column_name) WHERE deleted_at IS NULL;
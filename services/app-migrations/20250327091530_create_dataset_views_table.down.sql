@@ -0,0 +1,6 @@
+DELETE FROM app.resource_audience_policies WHERE resource_type = 'dataset_view';
+DELETE FROM app.resource_privileges WHERE resource_type = 'dataset_view';
+DELETE FROM app.resource_types WHERE name = 'dataset_view';
+
+DROP TABLE IF EXISTS app.dataset_view_defaults;
+DROP TABLE IF EXISTS app.dataset_views;
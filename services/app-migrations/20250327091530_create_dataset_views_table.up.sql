@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS app.dataset_views (
+    dataset_view_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    owner_id uuid NOT NULL REFERENCES app.users(user_id),
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    query_config JSONB NOT NULL DEFAULT '{}',
+    column_order JSONB NOT NULL DEFAULT '[]',
+    display_config JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_dataset_views_dataset_id ON app.dataset_views (dataset_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS app.dataset_view_defaults (
+    user_id uuid NOT NULL REFERENCES app.users(user_id),
+    dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    dataset_view_id uuid NOT NULL REFERENCES app.dataset_views(dataset_view_id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, dataset_id)
+);
+
+INSERT INTO "app"."resource_types" ("name", "table_name") VALUES ('dataset_view', 'dataset_views');
+
+INSERT INTO "app"."resource_privileges" ("privilege", "resource_type", "description") VALUES
+('admin', 'dataset_view', 'Admin can edit, share and delete a saved dataset view'),
+('viewer', 'dataset_view', 'Viewer can apply a saved dataset view');
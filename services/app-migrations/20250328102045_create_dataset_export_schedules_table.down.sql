@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS app.dataset_export_schedule_runs;
+DROP TABLE IF EXISTS app.dataset_export_schedules;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS app.dataset_export_schedules (
+    dataset_export_schedule_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    owner_id uuid NOT NULL REFERENCES app.users(user_id),
+    name TEXT NOT NULL,
+    query_config JSONB NOT NULL DEFAULT '{}',
+    format TEXT NOT NULL DEFAULT 'csv',
+    cron_expression TEXT NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    destination_type TEXT NOT NULL,
+    destination_config JSONB NOT NULL DEFAULT '{}',
+    temporal_schedule_id TEXT NOT NULL,
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_dataset_export_schedules_dataset_id ON app.dataset_export_schedules (dataset_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS app.dataset_export_schedule_runs (
+    dataset_export_schedule_run_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    dataset_export_schedule_id uuid NOT NULL REFERENCES app.dataset_export_schedules(dataset_export_schedule_id),
+    workflow_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    file_path TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_dataset_export_schedule_runs_schedule_id ON app.dataset_export_schedule_runs (dataset_export_schedule_id, started_at DESC);
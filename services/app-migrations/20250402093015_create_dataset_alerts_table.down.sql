@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS app.dataset_alert_events;
+DROP TABLE IF EXISTS app.dataset_alerts;
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS app.dataset_alerts (
+    dataset_alert_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    owner_id uuid NOT NULL REFERENCES app.users(user_id),
+    name TEXT NOT NULL,
+    metric JSONB NOT NULL DEFAULT '{}',
+    condition JSONB NOT NULL DEFAULT '{}',
+    cron_expression TEXT NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    recipients JSONB NOT NULL DEFAULT '[]',
+    webhook_url TEXT NOT NULL DEFAULT '',
+    temporal_schedule_id TEXT NOT NULL,
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    state TEXT NOT NULL DEFAULT 'OK',
+    last_value DOUBLE PRECISION,
+    last_evaluated_at TIMESTAMP WITH TIME ZONE,
+    last_error TEXT NOT NULL DEFAULT '',
+    snoozed_until TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_dataset_alerts_dataset_id ON app.dataset_alerts (dataset_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS app.dataset_alert_events (
+    dataset_alert_event_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    dataset_alert_id uuid NOT NULL REFERENCES app.dataset_alerts(dataset_alert_id),
+    status TEXT NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    comparison_value DOUBLE PRECISION,
+    score DOUBLE PRECISION,
+    message TEXT NOT NULL DEFAULT '',
+    notified BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_dataset_alert_events_alert_id ON app.dataset_alert_events (dataset_alert_id, created_at DESC);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS app.reconciliation_exceptions;
+DROP TABLE IF EXISTS app.reconciliation_matches;
+DROP TABLE IF EXISTS app.reconciliation_runs;
+DROP TABLE IF EXISTS app.reconciliations;
@@ -0,0 +1,75 @@
+CREATE TABLE IF NOT EXISTS app.reconciliations (
+    reconciliation_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    owner_id uuid NOT NULL REFERENCES app.users(user_id),
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    left_dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    right_dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    left_config JSONB NOT NULL DEFAULT '{}',
+    right_config JSONB NOT NULL DEFAULT '{}',
+    rules JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reconciliations_organization_id ON app.reconciliations (organization_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS app.reconciliation_runs (
+    reconciliation_run_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    reconciliation_id uuid NOT NULL REFERENCES app.reconciliations(reconciliation_id),
+    status TEXT NOT NULL,
+    workflow_id TEXT NOT NULL DEFAULT '',
+    left_row_count INTEGER NOT NULL DEFAULT 0,
+    right_row_count INTEGER NOT NULL DEFAULT 0,
+    match_count INTEGER NOT NULL DEFAULT 0,
+    left_exception_count INTEGER NOT NULL DEFAULT 0,
+    right_exception_count INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_by uuid NOT NULL REFERENCES app.users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reconciliation_runs_reconciliation_id ON app.reconciliation_runs (reconciliation_id, started_at DESC);
+
+CREATE TABLE IF NOT EXISTS app.reconciliation_matches (
+    reconciliation_match_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    reconciliation_run_id uuid NOT NULL REFERENCES app.reconciliation_runs(reconciliation_run_id),
+    match_id uuid NOT NULL,
+    rule_name TEXT NOT NULL DEFAULT '',
+    match_type TEXT NOT NULL,
+    status TEXT NOT NULL,
+    left_rows JSONB NOT NULL DEFAULT '[]',
+    right_rows JSONB NOT NULL DEFAULT '[]',
+    left_amount DOUBLE PRECISION,
+    right_amount DOUBLE PRECISION,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reconciliation_matches_run_match_id ON app.reconciliation_matches (reconciliation_run_id, match_id);
+CREATE INDEX IF NOT EXISTS idx_reconciliation_matches_run_status ON app.reconciliation_matches (reconciliation_run_id, status);
+
+CREATE TABLE IF NOT EXISTS app.reconciliation_exceptions (
+    reconciliation_exception_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    reconciliation_run_id uuid NOT NULL REFERENCES app.reconciliation_runs(reconciliation_run_id),
+    side TEXT NOT NULL,
+    row_id TEXT NOT NULL,
+    amount DOUBLE PRECISION,
+    transaction_date TIMESTAMP WITH TIME ZONE,
+    reference TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL,
+    match_id uuid,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reconciliation_exceptions_run_side_row ON app.reconciliation_exceptions (reconciliation_run_id, side, row_id);
+CREATE INDEX IF NOT EXISTS idx_reconciliation_exceptions_run_status ON app.reconciliation_exceptions (reconciliation_run_id, side, status);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS app.fx_rates;
+DROP TABLE IF EXISTS app.fx_rate_sources;
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS app.fx_rate_sources (
+    fx_rate_source_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    name TEXT NOT NULL,
+    source_type TEXT NOT NULL,
+    connection_id uuid REFERENCES app.connections(id),
+    dataset_id uuid REFERENCES app.datasets(dataset_id),
+    config JSONB NOT NULL DEFAULT '{}',
+    last_imported_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fx_rate_sources_organization_id ON app.fx_rate_sources (organization_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS app.fx_rates (
+    fx_rate_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    fx_rate_source_id uuid NOT NULL REFERENCES app.fx_rate_sources(fx_rate_source_id),
+    rate_date DATE NOT NULL,
+    base_currency TEXT NOT NULL,
+    quote_currency TEXT NOT NULL,
+    rate NUMERIC(28, 12) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_fx_rates_organization_date_pair ON app.fx_rates (organization_id, base_currency, quote_currency, rate_date);
+CREATE INDEX IF NOT EXISTS idx_fx_rates_organization_quote_currency ON app.fx_rates (organization_id, quote_currency, rate_date DESC);
+CREATE INDEX IF NOT EXISTS idx_fx_rates_source_id ON app.fx_rates (fx_rate_source_id, rate_date DESC);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS app.reference_banks (
+    reference_bank_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    code TEXT NOT NULL,
+    name TEXT NOT NULL,
+    country TEXT,
+    bic TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reference_banks_organization_code ON app.reference_banks (organization_id, code) WHERE deleted_at IS NULL;
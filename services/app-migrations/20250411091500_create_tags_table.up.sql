@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS app.tags (
+    tag_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    parent_id uuid REFERENCES app.tags(tag_id),
+    name TEXT NOT NULL,
+    path TEXT NOT NULL,
+    color TEXT,
+    description TEXT,
+    aliases JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_organization_path ON app.tags (organization_id, lower(path)) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tags_parent_id ON app.tags (parent_id) WHERE deleted_at IS NULL;
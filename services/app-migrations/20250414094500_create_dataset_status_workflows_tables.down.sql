@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS app.dataset_row_assignments;
+DROP TABLE IF EXISTS app.dataset_status_workflows;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS app.dataset_status_workflows (
+    dataset_status_workflow_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    column_name TEXT NOT NULL,
+    initial_state TEXT NOT NULL,
+    states JSONB NOT NULL DEFAULT '[]',
+    transitions JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+    deleted_by uuid REFERENCES app.users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_dataset_status_workflows_dataset_column ON app.dataset_status_workflows (dataset_id, column_name) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS app.dataset_row_assignments (
+    dataset_row_assignment_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL REFERENCES app.organizations(organization_id),
+    dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    row_id TEXT NOT NULL,
+    assignee_id uuid REFERENCES app.users(user_id),
+    due_date DATE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_by uuid NOT NULL REFERENCES app.users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_dataset_row_assignments_dataset_row ON app.dataset_row_assignments (dataset_id, row_id);
+CREATE INDEX IF NOT EXISTS idx_dataset_row_assignments_assignee ON app.dataset_row_assignments (assignee_id, due_date);
@@ -0,0 +1 @@
+ALTER TABLE app.rules DROP COLUMN IF EXISTS is_enabled;
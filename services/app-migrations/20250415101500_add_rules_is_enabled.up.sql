@@ -0,0 +1 @@
+ALTER TABLE app.rules ADD COLUMN IF NOT EXISTS is_enabled BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS app.rule_runs;
+DROP TABLE IF EXISTS app.rule_versions;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS app.rule_versions (
+    rule_version_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    rule_id uuid NOT NULL REFERENCES app.rules(rule_id),
+    dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    version INTEGER NOT NULL,
+    change_type TEXT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    value TEXT NOT NULL,
+    filter_config JSONB NOT NULL,
+    is_enabled BOOLEAN NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL REFERENCES app.users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rule_versions_rule_version ON app.rule_versions (rule_id, version);
+
+-- existing rules start their history with their current state as version 1, the next change of a rule takes the
+-- version after the latest one of the rule
+INSERT INTO app.rule_versions (rule_id, dataset_id, version, change_type, title, description, value, filter_config, is_enabled, created_at, created_by)
+SELECT rule_id, dataset_id, 1, 'created', title, COALESCE(description, ''), value, filter_config, is_enabled, updated_at, updated_by
+FROM app.rules
+WHERE deleted_at IS NULL
+ON CONFLICT (rule_id, version) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS app.rule_runs (
+    rule_run_id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    rule_id uuid NOT NULL REFERENCES app.rules(rule_id),
+    dataset_id uuid NOT NULL REFERENCES app.datasets(dataset_id),
+    action_id TEXT NOT NULL,
+    matched_rows BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_rule_runs_rule_created_at ON app.rule_runs (rule_id, created_at DESC);
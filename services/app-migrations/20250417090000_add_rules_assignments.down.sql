@@ -0,0 +1,5 @@
+ALTER TABLE app.rule_versions DROP COLUMN IF EXISTS assignments;
+
+DROP INDEX IF EXISTS app.idx_rules_assignments;
+
+ALTER TABLE app.rules DROP COLUMN IF EXISTS assignments;
@@ -0,0 +1,5 @@
+ALTER TABLE app.rules ADD COLUMN IF NOT EXISTS assignments JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS idx_rules_assignments ON app.rules USING GIN (assignments);
+
+ALTER TABLE app.rule_versions ADD COLUMN IF NOT EXISTS assignments JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,7 @@
+DELETE FROM app.widgets WHERE type IN ('area_chart', 'stacked_bar_chart', 'data_table', 'waterfall_chart', 'funnel_chart', 'heatmap');
+
+UPDATE app.widgets
+SET template_schema = template_schema - 'supports_cumulative'
+WHERE type = 'line_chart';
+
+DELETE FROM app.widget_types WHERE name IN ('stacked_bar_chart', 'data_table', 'waterfall_chart', 'funnel_chart', 'heatmap');
@@ -0,0 +1,251 @@
+INSERT INTO app.widget_types (name, description) VALUES
+    ('stacked_bar_chart', 'Stacked bar chart widget'),
+    ('data_table', 'Paginated data table widget'),
+    ('waterfall_chart', 'Waterfall chart widget'),
+    ('funnel_chart', 'Funnel chart widget'),
+    ('heatmap', 'Calendar heatmap widget')
+ON CONFLICT (name) DO NOTHING;
+
+-- Line and area charts can show running totals of the y-axis
+UPDATE app.widgets
+SET template_schema = template_schema || '{"supports_cumulative": true}'::jsonb
+WHERE type = 'line_chart';
+
+INSERT INTO app.widgets (
+    name,
+    type,
+    template_schema,
+    created_at,
+    updated_at,
+    deleted_at
+)
+VALUES
+(
+    'Area Chart',
+    'area_chart',
+    '{
+        "mappings": {
+            "dataset_id": {
+                "required": true
+            },
+            "fields": {
+                "x_axis": {
+                    "name": "X-axis",
+                    "type": "dimension",
+                    "required": true,
+                    "description": "Categories to compare",
+                    "allowed_types": ["string", "datetime"],
+                    "cardinality": "single"
+                },
+                "y_axis": {
+                    "name": "Y-axis",
+                    "type": "measure",
+                    "required": true,
+                    "description": "Values to show",
+                    "allowed_types": ["number"],
+                    "allowed_aggregations": ["sum", "avg", "count", "max", "min"],
+                    "cardinality": "single"
+                },
+                "group_by": {
+                    "max_fields": 2,
+                    "allowed_types": ["string", "datetime"],
+                    "required": false,
+                    "cardinality": "multiple"
+                }
+            }
+        },
+        "mappings_cardinality": "single",
+        "supports_group_by": true,
+        "supports_cumulative": true
+    }'::jsonb,
+    NOW(),
+    NOW(),
+    NULL
+),
+(
+    'Stacked Bar Chart',
+    'stacked_bar_chart',
+    '{
+        "mappings": {
+            "dataset_id": {
+                "required": true
+            },
+            "fields": {
+                "x_axis": {
+                    "name": "X-axis",
+                    "type": "dimension",
+                    "required": true,
+                    "description": "Categories to compare",
+                    "allowed_types": ["string", "datetime"],
+                    "cardinality": "single"
+                },
+                "y_axis": {
+                    "name": "Y-axis",
+                    "type": "measure",
+                    "required": true,
+                    "description": "Values to stack",
+                    "allowed_types": ["number"],
+                    "allowed_aggregations": ["sum", "avg", "count", "max", "min"],
+                    "cardinality": "single"
+                },
+                "group_by": {
+                    "name": "Stack by",
+                    "type": "dimension",
+                    "max_fields": 1,
+                    "allowed_types": ["string", "datetime"],
+                    "required": true,
+                    "description": "Segments of every bar",
+                    "cardinality": "multiple"
+                }
+            }
+        },
+        "mappings_cardinality": "single",
+        "supports_group_by": true
+    }'::jsonb,
+    NOW(),
+    NOW(),
+    NULL
+),
+(
+    'Data Table',
+    'data_table',
+    '{
+        "mappings": {
+            "dataset_id": {
+                "required": true
+            },
+            "fields": {
+                "columns": {
+                    "name": "Columns",
+                    "type": "dimension",
+                    "required": true,
+                    "description": "Columns to list, columns with an aggregation summarise the others",
+                    "allowed_types": ["string", "datetime", "number", "boolean"],
+                    "allowed_aggregations": ["sum", "avg", "count", "max", "min"],
+                    "cardinality": "multiple"
+                }
+            }
+        },
+        "mappings_cardinality": "single",
+        "supports_group_by": false,
+        "supports_pagination": true
+    }'::jsonb,
+    NOW(),
+    NOW(),
+    NULL
+),
+(
+    'Waterfall Chart',
+    'waterfall_chart',
+    '{
+        "mappings": {
+            "dataset_id": {
+                "required": true
+            },
+            "fields": {
+                "x_axis": {
+                    "name": "Steps",
+                    "type": "dimension",
+                    "required": true,
+                    "description": "Movements between the opening and the closing balance",
+                    "allowed_types": ["string", "datetime"],
+                    "cardinality": "single"
+                },
+                "y_axis": {
+                    "name": "Movements",
+                    "type": "measure",
+                    "required": true,
+                    "description": "Amount of every movement",
+                    "allowed_types": ["number"],
+                    "allowed_aggregations": ["sum", "avg", "count", "max", "min"],
+                    "cardinality": "single"
+                },
+                "opening_value": {
+                    "name": "Opening balance",
+                    "type": "measure",
+                    "required": false,
+                    "description": "Balance the movements start from",
+                    "allowed_types": ["number"],
+                    "allowed_aggregations": ["sum", "avg", "count", "max", "min"],
+                    "cardinality": "single"
+                }
+            }
+        },
+        "mappings_cardinality": "multiple",
+        "supports_group_by": false
+    }'::jsonb,
+    NOW(),
+    NOW(),
+    NULL
+),
+(
+    'Funnel Chart',
+    'funnel_chart',
+    '{
+        "mappings": {
+            "dataset_id": {
+                "required": true
+            },
+            "fields": {
+                "stages": {
+                    "name": "Stages",
+                    "type": "dimension",
+                    "required": true,
+                    "description": "Stages of the funnel",
+                    "allowed_types": ["string"],
+                    "cardinality": "single"
+                },
+                "values": {
+                    "name": "Values",
+                    "type": "measure",
+                    "required": true,
+                    "description": "Value reaching every stage",
+                    "allowed_types": ["number"],
+                    "allowed_aggregations": ["sum", "avg", "count", "max", "min"],
+                    "cardinality": "single"
+                }
+            }
+        },
+        "mappings_cardinality": "single",
+        "supports_group_by": false
+    }'::jsonb,
+    NOW(),
+    NOW(),
+    NULL
+),
+(
+    'Calendar Heatmap',
+    'heatmap',
+    '{
+        "mappings": {
+            "dataset_id": {
+                "required": true
+            },
+            "fields": {
+                "date": {
+                    "name": "Date",
+                    "type": "dimension",
+                    "required": true,
+                    "description": "Date of every cell",
+                    "allowed_types": ["datetime"],
+                    "cardinality": "single"
+                },
+                "values": {
+                    "name": "Values",
+                    "type": "measure",
+                    "required": true,
+                    "description": "Intensity of every cell",
+                    "allowed_types": ["number"],
+                    "allowed_aggregations": ["sum", "avg", "count", "max", "min"],
+                    "cardinality": "single"
+                }
+            }
+        },
+        "mappings_cardinality": "single",
+        "supports_group_by": false
+    }'::jsonb,
+    NOW(),
+    NOW(),
+    NULL
+)
+ON CONFLICT (type) DO NOTHING;
@@ -0,0 +1 @@
+ALTER TABLE app.organizations ADD COLUMN IF NOT EXISTS calendar_settings JSONB NOT NULL DEFAULT '{}';
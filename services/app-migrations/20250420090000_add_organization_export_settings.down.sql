@@ -0,0 +1 @@
+ALTER TABLE app.organizations DROP COLUMN IF EXISTS export_settings;
@@ -0,0 +1 @@
+ALTER TABLE app.organizations ADD COLUMN IF NOT EXISTS export_settings JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,6 @@
+ALTER TABLE app.reconciliation_exceptions DROP COLUMN IF EXISTS skipped;
+
+ALTER TABLE app.reconciliation_runs DROP COLUMN IF EXISTS right_skipped_count;
+ALTER TABLE app.reconciliation_runs DROP COLUMN IF EXISTS left_skipped_count;
+
+ALTER TABLE app.reconciliations DROP COLUMN IF EXISTS result_dataset_id;
@@ -0,0 +1,6 @@
+ALTER TABLE app.reconciliations ADD COLUMN IF NOT EXISTS result_dataset_id uuid REFERENCES app.datasets(dataset_id);
+
+ALTER TABLE app.reconciliation_runs ADD COLUMN IF NOT EXISTS left_skipped_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE app.reconciliation_runs ADD COLUMN IF NOT EXISTS right_skipped_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE app.reconciliation_exceptions ADD COLUMN IF NOT EXISTS skipped BOOLEAN NOT NULL DEFAULT false;